@@ -25,6 +25,7 @@ import (
 	gitevents "github.com/harness/gitness/app/events/git"
 	repoevents "github.com/harness/gitness/app/events/repo"
 	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/services/publickey"
 	"github.com/harness/gitness/app/services/refcache"
 	"github.com/harness/gitness/app/services/settings"
 	"github.com/harness/gitness/app/services/usergroup"
@@ -62,6 +63,7 @@ type Controller struct {
 	lfsStore            store.LFSObjectStore
 	auditService        audit.Service
 	userGroupService    usergroup.Service
+	signatureVerifier   publickey.SignatureVerifyService
 }
 
 func NewController(
@@ -83,6 +85,7 @@ func NewController(
 	lfsStore store.LFSObjectStore,
 	auditService audit.Service,
 	userGroupService usergroup.Service,
+	signatureVerifier publickey.SignatureVerifyService,
 ) *Controller {
 	return &Controller{
 		authorizer:          authorizer,
@@ -103,6 +106,7 @@ func NewController(
 		lfsStore:            lfsStore,
 		auditService:        auditService,
 		userGroupService:    userGroupService,
+		signatureVerifier:   signatureVerifier,
 	}
 }
 
@@ -0,0 +1,46 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githook
+
+import (
+	"github.com/harness/gitness/types"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var rejectedPushes = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "gitness",
+	Subsystem: "githook",
+	Name:      "rejected_pushes_total",
+	Help:      "Number of pushes rejected by protection rules, by violation code.",
+}, []string{"reason"})
+
+// reportRejectedPush counts a push rejected by the rule violations once for each distinct violation code.
+func reportRejectedPush(ruleViolations []types.RuleViolations) {
+	reasons := map[string]struct{}{}
+	for _, ruleViolation := range ruleViolations {
+		if !ruleViolation.IsCritical() {
+			continue
+		}
+		for _, violation := range ruleViolation.Violations {
+			reasons[violation.Code] = struct{}{}
+		}
+	}
+
+	for reason := range reasons {
+		rejectedPushes.WithLabelValues(reason).Inc()
+	}
+}
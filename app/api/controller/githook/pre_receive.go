@@ -212,7 +212,8 @@ func (c *Controller) processPushProtection(
 	if err = c.processObjects(
 		ctx, rgit,
 		repo, principal, refUpdates,
-		out.FileSizeLimit, out.PrincipalCommitterMatch, violationsInput,
+		out.FileSizeLimit, out.PrincipalCommitterMatch, out.RequireSignedCommits, out.RestrictedPaths,
+		out.PushSizeLimit, out.ObjectCountLimit, violationsInput,
 		in, output,
 	); err != nil {
 		return nil, fmt.Errorf("failed to process pre-receive objects: %w", err)
@@ -351,6 +352,7 @@ func processRuleViolations(
 
 	if criticalViolation {
 		output.Error = ptr.String("Blocked by protection rules.")
+		reportRejectedPush(ruleViolations)
 	}
 }
 
@@ -17,12 +17,16 @@ package githook
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/harness/gitness/app/api/controller"
 	"github.com/harness/gitness/app/services/protection"
 	"github.com/harness/gitness/app/services/settings"
 	"github.com/harness/gitness/git"
 	"github.com/harness/gitness/git/hook"
+	"github.com/harness/gitness/git/sha"
 	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
 
 	"github.com/gotidy/ptr"
 )
@@ -35,6 +39,10 @@ func (c *Controller) processObjects(
 	refUpdates changedRefs,
 	sizeLimit int64,
 	principalCommitterMatch bool,
+	requireSignedCommits bool,
+	restrictedPaths []string,
+	pushSizeLimit int64,
+	objectCountLimit int64,
 	violationsInput *protection.PushViolationsInput,
 	in types.GithookPreReceiveInput,
 	output *hook.Output,
@@ -43,6 +51,19 @@ func (c *Controller) processObjects(
 		return nil
 	}
 
+	if len(restrictedPaths) > 0 {
+		changed, err := c.findRestrictedPathChanges(ctx, rgit, repo, in, restrictedPaths)
+		if err != nil {
+			return fmt.Errorf("failed to find changes of restricted paths: %w", err)
+		}
+
+		if len(changed) > 0 {
+			printRestrictedPathChanges(output, changed)
+		}
+
+		violationsInput.ChangedRestrictedPaths = changed
+	}
+
 	// TODO: Remove this once push rules implementation and migration are complete.
 	settingsSizeLimit, err := settings.RepoGet(
 		ctx,
@@ -84,7 +105,9 @@ func (c *Controller) processObjects(
 		return fmt.Errorf("failed to check settings for Git LFS enabled: %w", err)
 	}
 
-	if sizeLimit == 0 && !principalCommitterMatch && !gitLFSEnabled {
+	countObjects := (pushSizeLimit > 0 || objectCountLimit > 0) && !in.Internal
+
+	if sizeLimit == 0 && !principalCommitterMatch && !gitLFSEnabled && !requireSignedCommits && !countObjects {
 		return nil
 	}
 
@@ -111,6 +134,14 @@ func (c *Controller) processObjects(
 		preReceiveObjsIn.FindLFSPointersParams = &git.FindLFSPointersParams{}
 	}
 
+	if requireSignedCommits && !in.Internal {
+		preReceiveObjsIn.FindCommitsParams = &git.FindCommitsParams{}
+	}
+
+	if countObjects {
+		preReceiveObjsIn.CountObjectsParams = &git.CountObjectsParams{}
+	}
+
 	preReceiveObjsOut, err := rgit.ProcessPreReceiveObjects(
 		ctx,
 		preReceiveObjsIn,
@@ -163,6 +194,27 @@ func (c *Controller) processObjects(
 		}
 	}
 
+	if preReceiveObjsOut.FindCommitsOutput != nil {
+		unsigned, err := c.findUnsignedCommits(ctx, repo, preReceiveObjsOut.FindCommitsOutput.Commits)
+		if err != nil {
+			return err
+		}
+
+		if len(unsigned) > 0 {
+			printUnsignedCommits(output, unsigned)
+		}
+
+		violationsInput.RequireSignedCommits = requireSignedCommits
+		violationsInput.UnsignedCommitCount = int64(len(unsigned))
+	}
+
+	if preReceiveObjsOut.CountObjectsOutput != nil {
+		violationsInput.PushSizeLimit = pushSizeLimit
+		violationsInput.PushSize = preReceiveObjsOut.CountObjectsOutput.Size
+		violationsInput.ObjectCountLimit = objectCountLimit
+		violationsInput.ObjectCount = preReceiveObjsOut.CountObjectsOutput.Count
+	}
+
 	violationsInput.FileSizeLimit = sizeLimit
 	violationsInput.FindOversizeFilesOutput = preReceiveObjsOut.FindOversizeFilesOutput
 	violationsInput.PrincipalCommitterMatch = principalCommitterMatch
@@ -172,3 +224,87 @@ func (c *Controller) processObjects(
 
 	return nil
 }
+
+// findUnsignedCommits returns the commits which don't have a good signature of a key registered by the committer.
+func (c *Controller) findUnsignedCommits(
+	ctx context.Context,
+	repo *types.RepositoryCore,
+	gitCommits []git.Commit,
+) ([]*types.Commit, error) {
+	commits := make([]*types.Commit, len(gitCommits))
+	for i := range gitCommits {
+		commits[i] = controller.MapCommit(&gitCommits[i])
+	}
+
+	// the results aren't stored, the commits are still in quarantine and the push might get rejected.
+	err := c.signatureVerifier.NewVerifySession(repo.ID).VerifyCommits(ctx, commits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify signature of commits: %w", err)
+	}
+
+	var unsigned []*types.Commit
+	for _, commit := range commits {
+		if commit.Signature == nil || commit.Signature.Result != enum.GitSignatureGood {
+			unsigned = append(unsigned, commit)
+		}
+	}
+
+	return unsigned, nil
+}
+
+// findRestrictedPathChanges returns the files changed by the branch updates of the push
+// which match any of the restricted paths.
+func (c *Controller) findRestrictedPathChanges(
+	ctx context.Context,
+	rgit RestrictedGIT,
+	repo *types.RepositoryCore,
+	in types.GithookPreReceiveInput,
+	restrictedPaths []string,
+) ([]string, error) {
+	seen := map[string]struct{}{}
+	var changed []string
+
+	for _, refUpdate := range in.RefUpdates {
+		if refUpdate.New.IsNil() || !strings.HasPrefix(refUpdate.Ref, gitReferenceNamePrefixBranch) {
+			continue
+		}
+
+		// in case the branch was just created - compare against the default branch, or against nothing.
+		baseSHA, ok, err := GetBaseSHAForScanningChanges(
+			ctx,
+			rgit,
+			repo,
+			in.Environment,
+			in.RefUpdates,
+			refUpdate,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get base sha: %w", err)
+		}
+		if !ok {
+			baseSHA = sha.EmptyTree
+		}
+
+		out, err := rgit.DiffFileNames(ctx, &git.DiffParams{
+			ReadParams: git.ReadParams{
+				RepoUID:             repo.GitUID,
+				AlternateObjectDirs: in.Environment.AlternateObjectDirs,
+			},
+			BaseRef: baseSHA.String(),
+			HeadRef: refUpdate.New.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get changed files of %q: %w", refUpdate.Ref, err)
+		}
+
+		for _, filePath := range protection.MatchRestrictedPaths(restrictedPaths, out.Files) {
+			if _, ok := seen[filePath]; ok {
+				continue
+			}
+			seen[filePath] = struct{}{}
+			changed = append(changed, filePath)
+		}
+	}
+
+	return changed, nil
+}
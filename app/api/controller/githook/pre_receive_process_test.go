@@ -0,0 +1,156 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githook
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/git/api"
+	"github.com/harness/gitness/git/hook"
+	"github.com/harness/gitness/git/sha"
+	gittypes "github.com/harness/gitness/git/types"
+	"github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRepoUID = "abcdrestricted"
+
+// testRepo is a git repository in the repos root of a git service.
+type testRepo struct {
+	t   *testing.T
+	dir string
+}
+
+func newTestRepo(t *testing.T) (*git.Service, *testRepo) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	root := t.TempDir()
+	adapter, err := api.New(gittypes.Config{}, nil, nil)
+	require.NoError(t, err)
+	service, err := git.New(gittypes.Config{Root: root}, adapter, nil, nil)
+	require.NoError(t, err)
+
+	dir := filepath.Join(root, "repos", testRepoUID[0:2], testRepoUID[2:4], testRepoUID[4:]+".git")
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	repo := &testRepo{t: t, dir: dir}
+	repo.run("init", "--initial-branch=main")
+	return service, repo
+}
+
+func (r *testRepo) run(args ...string) string {
+	args = append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+	cmd := exec.Command("git", args...)
+	cmd.Dir = r.dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(r.t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func (r *testRepo) write(path, content string) {
+	fullPath := filepath.Join(r.dir, path)
+	require.NoError(r.t, os.MkdirAll(filepath.Dir(fullPath), 0o700))
+	require.NoError(r.t, os.WriteFile(fullPath, []byte(content), 0o600))
+	r.run("add", path)
+}
+
+func (r *testRepo) commit(message string) sha.SHA {
+	r.run("commit", "-m", message)
+	return sha.Must(r.run("rev-parse", "HEAD"))
+}
+
+func TestFindRestrictedPathChanges(t *testing.T) {
+	service, repo := newTestRepo(t)
+	repo.write("deploy/prod.yaml", "replicas: 1")
+	repo.write("deploy/staging.yaml", "replicas: 1")
+	repo.write("README.md", "readme")
+	base := repo.commit("initial")
+
+	repo.run("checkout", "-b", "feature")
+	repo.write("docs/guide.md", "guide")
+	docs := repo.commit("docs")
+	repo.write("deploy/prod.yaml", "replicas: 2")
+	deploy := repo.commit("deploy")
+
+	repo.run("checkout", "-b", "rename", base.String())
+	require.NoError(t, os.MkdirAll(filepath.Join(repo.dir, "other"), 0o700))
+	repo.run("mv", "deploy/staging.yaml", "other/staging.yaml")
+	renamed := repo.commit("rename")
+
+	repo.run("checkout", "-b", "delete", base.String())
+	repo.run("rm", "deploy/prod.yaml")
+	deleted := repo.commit("delete")
+	repo.run("checkout", "main")
+
+	tests := []struct {
+		name       string
+		refUpdates []hook.ReferenceUpdate
+		want       []string
+	}{
+		{
+			name:       "unrestricted change",
+			refUpdates: []hook.ReferenceUpdate{{Ref: "refs/heads/feature", Old: base, New: docs}},
+		},
+		{
+			name:       "restricted change",
+			refUpdates: []hook.ReferenceUpdate{{Ref: "refs/heads/feature", Old: docs, New: deploy}},
+			want:       []string{"deploy/prod.yaml"},
+		},
+		{
+			// a new branch is compared against the default branch.
+			name:       "new branch",
+			refUpdates: []hook.ReferenceUpdate{{Ref: "refs/heads/feature", Old: sha.Nil, New: deploy}},
+			want:       []string{"deploy/prod.yaml"},
+		},
+		{
+			name:       "rename out of restricted path",
+			refUpdates: []hook.ReferenceUpdate{{Ref: "refs/heads/rename", Old: base, New: renamed}},
+			want:       []string{"deploy/staging.yaml"},
+		},
+		{
+			name:       "deletion",
+			refUpdates: []hook.ReferenceUpdate{{Ref: "refs/heads/delete", Old: base, New: deleted}},
+			want:       []string{"deploy/prod.yaml"},
+		},
+		{
+			name:       "deleted branch",
+			refUpdates: []hook.ReferenceUpdate{{Ref: "refs/heads/feature", Old: deploy, New: sha.Nil}},
+		},
+		{
+			name:       "tag",
+			refUpdates: []hook.ReferenceUpdate{{Ref: "refs/tags/v1", Old: sha.Nil, New: deploy}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := types.GithookPreReceiveInput{PreReceiveInput: hook.PreReceiveInput{RefUpdates: tt.refUpdates}}
+			repoCore := &types.RepositoryCore{GitUID: testRepoUID, DefaultBranch: "main"}
+
+			changed, err := (&Controller{}).findRestrictedPathChanges(context.Background(), service, repoCore, in,
+				[]string{"deploy"})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, changed)
+		})
+	}
+}
@@ -20,10 +20,16 @@ import (
 
 	"github.com/harness/gitness/git"
 	"github.com/harness/gitness/git/hook"
+	"github.com/harness/gitness/types"
 
 	"github.com/fatih/color"
 )
 
+const (
+	maxPrintedUnsignedCommits = 10
+	maxPrintedRestrictedPaths = 10
+)
+
 var (
 	colorScanHeader            = color.New(color.FgHiWhite, color.Underline)
 	colorScanSummary           = color.New(color.FgHiRed, color.Bold)
@@ -170,6 +176,72 @@ func printCommitterMismatch(
 	)
 }
 
+func printUnsignedCommits(
+	output *hook.Output,
+	commits []*types.Commit,
+) {
+	output.Messages = append(
+		output.Messages,
+		colorScanHeader.Sprintf("Push contains commits without a verified signature:"),
+		"", // add empty line for making it visually more consumable
+	)
+
+	for i, commit := range commits {
+		if i == maxPrintedUnsignedCommits {
+			break
+		}
+
+		result := "unsigned"
+		if commit.Signature != nil {
+			result = string(commit.Signature.Result)
+		}
+
+		output.Messages = append(
+			output.Messages,
+			fmt.Sprintf("  %s    Committer: %s    Signature: %s", commit.SHA, commit.Committer.Identity.Email, result),
+			"", // add empty line for making it visually more consumable
+		)
+	}
+
+	output.Messages = append(
+		output.Messages,
+		colorScanSummary.Sprintf(
+			"%d %s found without a signature of a key registered by the committer",
+			len(commits), singularOrPlural("commit", len(commits) > 1),
+		),
+		"", "", // add two empty lines for making it visually more consumable
+	)
+}
+
+func printRestrictedPathChanges(
+	output *hook.Output,
+	filePaths []string,
+) {
+	output.Messages = append(
+		output.Messages,
+		colorScanHeader.Sprintf("Push contains changes to restricted paths:"),
+		"", // add empty line for making it visually more consumable
+	)
+
+	for i, filePath := range filePaths {
+		if i == maxPrintedRestrictedPaths {
+			break
+		}
+
+		output.Messages = append(output.Messages, fmt.Sprintf("  %s", filePath))
+	}
+
+	output.Messages = append(
+		output.Messages,
+		"", // add empty line for making it visually more consumable
+		colorScanSummary.Sprintf(
+			"%d %s found in restricted paths",
+			len(filePaths), singularOrPlural("changed file", len(filePaths) > 1),
+		),
+		"", "", // add two empty lines for making it visually more consumable
+	)
+}
+
 func printLFSPointers(
 	output *hook.Output,
 	lfsInfos []git.LFSInfo,
@@ -20,6 +20,7 @@ import (
 	eventsgit "github.com/harness/gitness/app/events/git"
 	eventsrepo "github.com/harness/gitness/app/events/repo"
 	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/services/publickey"
 	"github.com/harness/gitness/app/services/refcache"
 	"github.com/harness/gitness/app/services/settings"
 	"github.com/harness/gitness/app/services/usergroup"
@@ -67,6 +68,7 @@ func ProvideController(
 	lfsStore store.LFSObjectStore,
 	auditService audit.Service,
 	userGroupService usergroup.Service,
+	signatureVerifier publickey.SignatureVerifyService,
 ) *Controller {
 	ctrl := NewController(
 		authorizer,
@@ -87,6 +89,7 @@ func ProvideController(
 		lfsStore,
 		auditService,
 		userGroupService,
+		signatureVerifier,
 	)
 
 	// TODO: improve wiring if possible
@@ -15,8 +15,10 @@
 package reposettings
 
 import (
+	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/services/settings"
 
+	"github.com/bmatcuk/doublestar/v4"
 	"github.com/gotidy/ptr"
 )
 
@@ -24,12 +26,29 @@ import (
 type GeneralSettings struct {
 	FileSizeLimit *int64 `json:"file_size_limit" yaml:"file_size_limit" description:"file size limit in bytes"`
 	GitLFSEnabled *bool  `json:"git_lfs_enabled" yaml:"git_lfs_enabled"`
+
+	// ArtifactPublishTagPattern is the pattern of the tags which are published as generic artifacts.
+	ArtifactPublishTagPattern *string `json:"artifact_publish_tag_pattern" yaml:"artifact_publish_tag_pattern"`
+	// ArtifactPublishRegistry is the identifier of the registry of the root space the tags are published to.
+	ArtifactPublishRegistry *string `json:"artifact_publish_registry" yaml:"artifact_publish_registry"`
+}
+
+func (s *GeneralSettings) sanitize() error {
+	if s.ArtifactPublishTagPattern != nil && *s.ArtifactPublishTagPattern != "" &&
+		!doublestar.ValidatePattern(*s.ArtifactPublishTagPattern) {
+		return usererror.BadRequest("Invalid artifact publish tag pattern.")
+	}
+
+	return nil
 }
 
 func GetDefaultGeneralSettings() *GeneralSettings {
 	return &GeneralSettings{
 		FileSizeLimit: ptr.Int64(settings.DefaultFileSizeLimit),
 		GitLFSEnabled: ptr.Bool(settings.DefaultGitLFSEnabled),
+
+		ArtifactPublishTagPattern: ptr.String(settings.DefaultArtifactPublishTagPattern),
+		ArtifactPublishRegistry:   ptr.String(settings.DefaultArtifactPublishRegistry),
 	}
 }
 
@@ -37,6 +56,8 @@ func GetGeneralSettingsMappings(s *GeneralSettings) []settings.SettingHandler {
 	return []settings.SettingHandler{
 		settings.Mapping(settings.KeyFileSizeLimit, s.FileSizeLimit),
 		settings.Mapping(settings.KeyGitLFSEnabled, s.GitLFSEnabled),
+		settings.Mapping(settings.KeyArtifactPublishTagPattern, s.ArtifactPublishTagPattern),
+		settings.Mapping(settings.KeyArtifactPublishRegistry, s.ArtifactPublishRegistry),
 	}
 }
 
@@ -57,5 +78,19 @@ func GetGeneralSettingsAsKeyValues(s *GeneralSettings) []settings.KeyValue {
 		})
 	}
 
+	if s.ArtifactPublishTagPattern != nil {
+		kvs = append(kvs, settings.KeyValue{
+			Key:   settings.KeyArtifactPublishTagPattern,
+			Value: s.ArtifactPublishTagPattern,
+		})
+	}
+
+	if s.ArtifactPublishRegistry != nil {
+		kvs = append(kvs, settings.KeyValue{
+			Key:   settings.KeyArtifactPublishRegistry,
+			Value: s.ArtifactPublishRegistry,
+		})
+	}
+
 	return kvs
 }
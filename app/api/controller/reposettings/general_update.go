@@ -40,6 +40,10 @@ func (c *Controller) GeneralUpdate(
 		return nil, err
 	}
 
+	if err = in.sanitize(); err != nil {
+		return nil, err
+	}
+
 	// read old settings values
 	old := GetDefaultGeneralSettings()
 	oldMappings := GetGeneralSettingsMappings(old)
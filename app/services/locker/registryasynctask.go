@@ -17,6 +17,7 @@ package locker
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -32,3 +33,21 @@ func (l Locker) LockResource(
 
 	return unlockFn, nil
 }
+
+// LockRegistryIndex locks the index of the given type of a registry, so rebuilds of the same index can't run
+// concurrently on different instances.
+func (l Locker) LockRegistryIndex(
+	ctx context.Context,
+	registryID int64,
+	indexType string,
+	expiry time.Duration,
+) (func(), error) {
+	key := strconv.FormatInt(registryID, 10) + "/index/" + indexType
+
+	unlockFn, err := l.lock(ctx, namespaceRegistry, key, expiry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock %s index of registry %d: %w", indexType, registryID, err)
+	}
+
+	return unlockFn, nil
+}
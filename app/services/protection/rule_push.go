@@ -87,6 +87,35 @@ func (p *Push) Violations(
 		)
 	}
 
+	if p.Push.RequireSignedCommits && in.RequireSignedCommits &&
+		in.UnsignedCommitCount > 0 {
+		violations.Addf(codePushRequireSignedCommits,
+			"Signature verification failed for total of %d commit(s).",
+			in.UnsignedCommitCount,
+		)
+	}
+
+	if restricted := MatchRestrictedPaths(p.Push.RestrictedPaths, in.ChangedRestrictedPaths); len(restricted) > 0 {
+		violations.Addf(codePushRestrictedPaths,
+			"Changes to %d restricted file(s) are not allowed.",
+			len(restricted),
+		)
+	}
+
+	if p.Push.PushSizeLimit > 0 && in.PushSize > p.Push.PushSizeLimit {
+		violations.Addf(codePushSizeLimit,
+			"Push size of %d bytes exceeds the limit of %d bytes.",
+			in.PushSize, p.Push.PushSizeLimit,
+		)
+	}
+
+	if p.Push.ObjectCountLimit > 0 && in.ObjectCount > p.Push.ObjectCountLimit {
+		violations.Addf(codePushObjectCountLimit,
+			"Push contains %d new objects, exceeding the limit of %d objects.",
+			in.ObjectCount, p.Push.ObjectCountLimit,
+		)
+	}
+
 	bypassable := p.Bypass.matches(ctx, in.Actor, in.IsRepoOwner, in.ResolveUserGroupID)
 	violations.Bypassable = bypassable
 	violations.Bypassed = bypassable
@@ -109,5 +138,9 @@ func (p *Push) Sanitize() error {
 		return fmt.Errorf("bypass: %w", err)
 	}
 
+	if err := p.Push.Sanitize(); err != nil {
+		return fmt.Errorf("push: %w", err)
+	}
+
 	return nil
 }
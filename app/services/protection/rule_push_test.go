@@ -0,0 +1,149 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protection
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/types"
+)
+
+func TestPush_Violations(t *testing.T) {
+	actor := &types.Principal{ID: 42}
+
+	tests := []struct {
+		name        string
+		push        Push
+		in          PushViolationsInput
+		expCodes    []string
+		expBypassed bool
+	}{
+		{
+			name: "all-signed",
+			push: Push{Push: DefPush{RequireSignedCommits: true}},
+			in:   PushViolationsInput{Actor: actor, RequireSignedCommits: true},
+		},
+		{
+			name:     "unsigned",
+			push:     Push{Push: DefPush{RequireSignedCommits: true}},
+			in:       PushViolationsInput{Actor: actor, RequireSignedCommits: true, UnsignedCommitCount: 2},
+			expCodes: []string{codePushRequireSignedCommits},
+		},
+		{
+			name: "unsigned-not-required-by-rule",
+			push: Push{},
+			in:   PushViolationsInput{Actor: actor, RequireSignedCommits: true, UnsignedCommitCount: 2},
+		},
+		{
+			name: "unsigned-bypassed",
+			push: Push{
+				Bypass: DefBypass{UserIDs: []int64{actor.ID}},
+				Push:   DefPush{RequireSignedCommits: true},
+			},
+			in:          PushViolationsInput{Actor: actor, RequireSignedCommits: true, UnsignedCommitCount: 1},
+			expCodes:    []string{codePushRequireSignedCommits},
+			expBypassed: true,
+		},
+		{
+			name:     "restricted-paths",
+			push:     Push{Push: DefPush{RestrictedPaths: []string{"deploy"}}},
+			in:       PushViolationsInput{Actor: actor, ChangedRestrictedPaths: []string{"deploy/prod.yaml"}},
+			expCodes: []string{codePushRestrictedPaths},
+		},
+		{
+			name: "restricted-paths-of-other-rule",
+			push: Push{Push: DefPush{RestrictedPaths: []string{"charts/**"}}},
+			in:   PushViolationsInput{Actor: actor, ChangedRestrictedPaths: []string{"deploy/prod.yaml"}},
+		},
+		{
+			name: "push-size-within-limit",
+			push: Push{Push: DefPush{PushSizeLimit: 1000}},
+			in:   PushViolationsInput{Actor: actor, PushSizeLimit: 1000, PushSize: 1000},
+		},
+		{
+			name:     "push-size-exceeded",
+			push:     Push{Push: DefPush{PushSizeLimit: 1000}},
+			in:       PushViolationsInput{Actor: actor, PushSizeLimit: 1000, PushSize: 1001},
+			expCodes: []string{codePushSizeLimit},
+		},
+		{
+			name: "push-size-exceeded-limit-of-other-rule",
+			push: Push{Push: DefPush{PushSizeLimit: 2000}},
+			in:   PushViolationsInput{Actor: actor, PushSizeLimit: 1000, PushSize: 1500},
+		},
+		{
+			name: "object-count-exceeded",
+			push: Push{Push: DefPush{PushSizeLimit: 1000, ObjectCountLimit: 10}},
+			in: PushViolationsInput{
+				Actor:            actor,
+				PushSizeLimit:    1000,
+				PushSize:         1001,
+				ObjectCountLimit: 10,
+				ObjectCount:      11,
+			},
+			expCodes: []string{codePushSizeLimit, codePushObjectCountLimit},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := test.push.Violations(context.Background(), &test.in)
+			if err != nil {
+				t.Fatalf("got an error: %s", err.Error())
+			}
+
+			if len(out.Violations) != 1 {
+				t.Fatalf("expected a single rule violations entry, got %d", len(out.Violations))
+			}
+
+			violations := out.Violations[0]
+			if want, got := len(test.expCodes), len(violations.Violations); want != got {
+				t.Fatalf("violation count: want=%d got=%d", want, got)
+			}
+			for i, violation := range violations.Violations {
+				if want, got := test.expCodes[i], violation.Code; want != got {
+					t.Errorf("violation %d code mismatch: want=%s got=%s", i, want, got)
+				}
+			}
+
+			if want, got := test.expBypassed, violations.Bypassed; want != got {
+				t.Errorf("bypassed: want=%t got=%t", want, got)
+			}
+		})
+	}
+}
+
+func TestMatchRestrictedPaths(t *testing.T) {
+	def := DefPush{RestrictedPaths: []string{"/deploy", "**/*.tf", "config/secrets.yaml"}}
+	if err := def.Sanitize(); err != nil {
+		t.Fatalf("def invalid: %s", err.Error())
+	}
+
+	got := MatchRestrictedPaths(def.RestrictedPaths, []string{
+		"deploy/prod/values.yaml",
+		"deployment.md",
+		"infra/main.tf",
+		"config/secrets.yaml",
+		"config/app.yaml",
+		"README.md",
+	})
+	want := []string{"deploy/prod/values.yaml", "infra/main.tf", "config/secrets.yaml"}
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("want=%v got=%v", want, got)
+	}
+}
@@ -81,6 +81,20 @@ func (s pushRuleSet) PushVerify(
 		out.PrincipalCommitterMatch = out.PrincipalCommitterMatch || rOut.PrincipalCommitterMatch
 
 		out.SecretScanningEnabled = out.SecretScanningEnabled || rOut.SecretScanningEnabled
+
+		out.RequireSignedCommits = out.RequireSignedCommits || rOut.RequireSignedCommits
+
+		out.RestrictedPaths = append(out.RestrictedPaths, rOut.RestrictedPaths...)
+
+		if out.PushSizeLimit == 0 ||
+			(rOut.PushSizeLimit > 0 && out.PushSizeLimit > rOut.PushSizeLimit) {
+			out.PushSizeLimit = rOut.PushSizeLimit
+		}
+
+		if out.ObjectCountLimit == 0 ||
+			(rOut.ObjectCountLimit > 0 && out.ObjectCountLimit > rOut.ObjectCountLimit) {
+			out.ObjectCountLimit = rOut.ObjectCountLimit
+		}
 	}
 
 	return out, violations, nil
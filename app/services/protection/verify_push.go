@@ -16,6 +16,10 @@ package protection
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
 
 	"github.com/harness/gitness/git"
 	"github.com/harness/gitness/types"
@@ -25,6 +29,10 @@ const (
 	codePushFileSizeLimit           = "push.file.size.limit"
 	codePushPrincipalCommitterMatch = "push.principal.committer.match"
 	codeSecretScanningEnabled       = "push.secret.scanning.enabled"
+	codePushRequireSignedCommits    = "push.require.signed.commits"
+	codePushRestrictedPaths         = "push.restricted.paths"
+	codePushSizeLimit               = "push.size.limit"
+	codePushObjectCountLimit        = "push.object.count.limit"
 )
 
 type (
@@ -47,6 +55,14 @@ type (
 		CommitterMismatchCount  int64
 		SecretScanningEnabled   bool
 		FoundSecretCount        int
+		RequireSignedCommits    bool
+		UnsignedCommitCount     int64
+		// ChangedRestrictedPaths are the changed files matching the restricted paths of any push rule.
+		ChangedRestrictedPaths []string
+		PushSizeLimit          int64
+		PushSize               int64
+		ObjectCountLimit       int64
+		ObjectCount            int64
 	}
 
 	PushViolationsOutput struct {
@@ -57,6 +73,10 @@ type (
 		FileSizeLimit           int64
 		PrincipalCommitterMatch bool
 		SecretScanningEnabled   bool
+		RequireSignedCommits    bool
+		RestrictedPaths         []string
+		PushSizeLimit           int64
+		ObjectCountLimit        int64
 		Protections             map[int64]PushProtection
 	}
 
@@ -73,13 +93,24 @@ type (
 		FileSizeLimit           int64 `json:"file_size_limit"`
 		PrincipalCommitterMatch bool  `json:"principal_committer_match"`
 		SecretScanningEnabled   bool  `json:"secret_scanning_enabled"`
+		RequireSignedCommits    bool  `json:"require_signed_commits"`
+		// RestrictedPaths are file path patterns which can only be modified by principals bypassing the rule.
+		RestrictedPaths []string `json:"restricted_paths"`
+		// PushSizeLimit is the limit of the total (uncompressed) size in bytes of all new objects of a push.
+		PushSizeLimit int64 `json:"push_size_limit"`
+		// ObjectCountLimit is the limit of the number of new objects of a push.
+		ObjectCountLimit int64 `json:"object_count_limit"`
 	}
 )
 
 func (in *PushViolationsInput) HasViolations() bool {
 	return in.FindOversizeFilesOutput != nil && (in.FindOversizeFilesOutput.Total > 0) ||
 		in.CommitterMismatchCount > 0 ||
-		in.FoundSecretCount > 0
+		in.FoundSecretCount > 0 ||
+		in.UnsignedCommitCount > 0 ||
+		len(in.ChangedRestrictedPaths) > 0 ||
+		in.PushSizeLimit > 0 && in.PushSize > in.PushSizeLimit ||
+		in.ObjectCountLimit > 0 && in.ObjectCount > in.ObjectCountLimit
 }
 
 func (v *DefPush) PushVerify(
@@ -90,5 +121,60 @@ func (v *DefPush) PushVerify(
 		FileSizeLimit:           v.FileSizeLimit,
 		PrincipalCommitterMatch: v.PrincipalCommitterMatch,
 		SecretScanningEnabled:   v.SecretScanningEnabled,
+		RequireSignedCommits:    v.RequireSignedCommits,
+		RestrictedPaths:         v.RestrictedPaths,
+		PushSizeLimit:           v.PushSizeLimit,
+		ObjectCountLimit:        v.ObjectCountLimit,
 	}, nil, nil
 }
+
+func (v *DefPush) Sanitize() error {
+	if v.PushSizeLimit < 0 {
+		return errors.New("push size limit can't be negative")
+	}
+
+	if v.ObjectCountLimit < 0 {
+		return errors.New("object count limit can't be negative")
+	}
+
+	for i, pattern := range v.RestrictedPaths {
+		// paths are relative to the repository root, a leading slash is accepted for convenience.
+		pattern = strings.TrimPrefix(pattern, "/")
+		if err := patternValidate(pattern); err != nil {
+			return fmt.Errorf("restricted path %q: %w", v.RestrictedPaths[i], err)
+		}
+		v.RestrictedPaths[i] = pattern
+	}
+
+	return nil
+}
+
+// MatchRestrictedPaths returns the file paths which match any of the restricted path patterns.
+// A pattern matching a directory restricts all files within it.
+func MatchRestrictedPaths(patterns []string, filePaths []string) []string {
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	var matches []string
+	for _, filePath := range filePaths {
+		if restrictedPathMatches(patterns, filePath) {
+			matches = append(matches, filePath)
+		}
+	}
+
+	return matches
+}
+
+func restrictedPathMatches(patterns []string, filePath string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimPrefix(pattern, "/")
+		for p := filePath; p != "." && p != "/" && p != ""; p = path.Dir(p) {
+			if patternMatches(pattern, p) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -100,3 +100,24 @@ func SystemGetRequired[T any](
 
 	return out, nil
 }
+
+// RegistryGet is a helper method for getting a setting of a specific type for a registry.
+func RegistryGet[T any](
+	ctx context.Context,
+	s *Service,
+	registryID int64,
+	key Key,
+	dflt T,
+) (T, error) {
+	var out T
+	ok, err := s.RegistryGet(ctx, registryID, key, &out)
+	if err != nil {
+		return out, err
+	}
+
+	if !ok {
+		return dflt, nil
+	}
+
+	return out, nil
+}
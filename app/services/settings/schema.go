@@ -0,0 +1,133 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Definition describes a setting: its key, its default value and which values are valid.
+type Definition interface {
+	Key() Key
+	// Default returns the value which applies while the setting isn't set.
+	Default() any
+	// Decode decodes the raw value of the setting and validates it.
+	Decode(raw json.RawMessage) (any, error)
+}
+
+// Define returns the definition of a setting of type T. The validate function is optional.
+func Define[T any](key Key, dflt T, validate func(T) error) TypedDefinition[T] {
+	return TypedDefinition[T]{
+		key:      key,
+		dflt:     dflt,
+		validate: validate,
+	}
+}
+
+var _ Definition = TypedDefinition[any]{}
+
+// TypedDefinition is the definition of a setting of type T.
+type TypedDefinition[T any] struct {
+	key      Key
+	dflt     T
+	validate func(T) error
+}
+
+func (d TypedDefinition[T]) Key() Key {
+	return d.key
+}
+
+func (d TypedDefinition[T]) Default() any {
+	return d.dflt
+}
+
+func (d TypedDefinition[T]) Decode(raw json.RawMessage) (any, error) {
+	return d.DecodeTyped(raw)
+}
+
+// DecodeTyped decodes the raw value of the setting and validates it.
+func (d TypedDefinition[T]) DecodeTyped(raw json.RawMessage) (T, error) {
+	var v T
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return v, fmt.Errorf("invalid value of setting %q: %w", d.key, err)
+	}
+
+	if err := d.Validate(v); err != nil {
+		return v, err
+	}
+
+	return v, nil
+}
+
+// Validate returns an error if the value isn't valid for the setting.
+func (d TypedDefinition[T]) Validate(v T) error {
+	if d.validate == nil {
+		return nil
+	}
+
+	if err := d.validate(v); err != nil {
+		return fmt.Errorf("invalid value of setting %q: %w", d.key, err)
+	}
+
+	return nil
+}
+
+// Schema is a list of setting definitions.
+type Schema []Definition
+
+// Find returns the definition of the setting with the given key.
+func (s Schema) Find(key Key) (Definition, bool) {
+	for _, d := range s {
+		if d.Key() == key {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+// Decode decodes and validates the raw values of the settings. A JSON null value resets a setting,
+// it's returned as KeyValue with a nil Value.
+func (s Schema) Decode(values map[Key]json.RawMessage) ([]KeyValue, error) {
+	kvs := make([]KeyValue, 0, len(values))
+	for _, d := range s {
+		raw, ok := values[d.Key()]
+		if !ok {
+			continue
+		}
+
+		if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+			kvs = append(kvs, KeyValue{Key: d.Key()})
+			continue
+		}
+
+		v, err := d.Decode(raw)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, KeyValue{Key: d.Key(), Value: v})
+	}
+
+	if len(kvs) != len(values) {
+		for key := range values {
+			if _, ok := s.Find(key); !ok {
+				return nil, fmt.Errorf("unknown setting %q", key)
+			}
+		}
+	}
+
+	return kvs, nil
+}
@@ -96,6 +96,26 @@ func (s *Service) SetMany(
 	return nil
 }
 
+// Delete deletes the setting with the given key for the given scope, its default value applies afterwards.
+func (s *Service) Delete(
+	ctx context.Context,
+	scope enum.SettingsScope,
+	scopeID int64,
+	key Key,
+) error {
+	err := s.settingsStore.Delete(
+		ctx,
+		scope,
+		scopeID,
+		string(key),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete setting in store: %w", err)
+	}
+
+	return nil
+}
+
 // Get returns the value of the setting with the given key for the given scope.
 func (s *Service) Get(
 	ctx context.Context,
@@ -0,0 +1,95 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	"context"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+// RegistrySet sets the value of the setting with the given key for the given registry.
+func (s *Service) RegistrySet(
+	ctx context.Context,
+	registryID int64,
+	key Key,
+	value any,
+) error {
+	return s.Set(
+		ctx,
+		enum.SettingsScopeRegistry,
+		registryID,
+		key,
+		value,
+	)
+}
+
+// RegistrySetMany sets the value of the settings with the given keys for the given registry.
+func (s *Service) RegistrySetMany(
+	ctx context.Context,
+	registryID int64,
+	keyValues ...KeyValue,
+) error {
+	return s.SetMany(
+		ctx,
+		enum.SettingsScopeRegistry,
+		registryID,
+		keyValues...,
+	)
+}
+
+// RegistryGet returns the value of the setting with the given key for the given registry.
+func (s *Service) RegistryGet(
+	ctx context.Context,
+	registryID int64,
+	key Key,
+	out any,
+) (bool, error) {
+	return s.Get(
+		ctx,
+		enum.SettingsScopeRegistry,
+		registryID,
+		key,
+		out,
+	)
+}
+
+// RegistryMap maps all available settings using the provided handlers for the given registry.
+func (s *Service) RegistryMap(
+	ctx context.Context,
+	registryID int64,
+	handlers ...SettingHandler,
+) error {
+	return s.Map(
+		ctx,
+		enum.SettingsScopeRegistry,
+		registryID,
+		handlers...,
+	)
+}
+
+// RegistryDelete deletes the setting with the given key for the given registry.
+func (s *Service) RegistryDelete(
+	ctx context.Context,
+	registryID int64,
+	key Key,
+) error {
+	return s.Delete(
+		ctx,
+		enum.SettingsScopeRegistry,
+		registryID,
+		key,
+	)
+}
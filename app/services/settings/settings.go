@@ -28,4 +28,30 @@ var (
 	DefaultPrincipalCommitterMatch     = false
 	KeyGitLFSEnabled               Key = "git_lfs_enabled"
 	DefaultGitLFSEnabled               = true
+	// KeyArtifactPublishTagPattern [string] is the pattern of the tags which are published as generic artifacts.
+	KeyArtifactPublishTagPattern     Key = "artifact_publish_tag_pattern"
+	DefaultArtifactPublishTagPattern     = string("")
+	// KeyArtifactPublishRegistry [string] is the identifier of the registry the tags are published to.
+	KeyArtifactPublishRegistry     Key = "artifact_publish_registry"
+	DefaultArtifactPublishRegistry     = string("")
+	// KeyRegistryPolicy [json] holds the default policies of the artifact registries of a space.
+	KeyRegistryPolicy Key = "registry_policy"
+
+	// Registry scope keys, see the schema of the registry settings for their types and defaults.
+
+	// KeyRegistryRetentionDays [int64] is the number of days versions are kept for, 0 keeps them forever.
+	KeyRegistryRetentionDays Key = "retention_days"
+	// KeyRegistryImmutable [bool] prevents existing versions from being overwritten.
+	KeyRegistryImmutable Key = "immutable"
+	// KeyRegistryRequireSignatures [bool] rejects uploads of packages which aren't signed.
+	KeyRegistryRequireSignatures Key = "require_signatures"
+	// KeyRegistryQuota [json] holds the storage and bandwidth quota of the registry.
+	KeyRegistryQuota Key = "quota"
+	// KeyRegistryDownloadStatsPrivacy [bool] only keeps aggregated daily download counters.
+	KeyRegistryDownloadStatsPrivacy Key = "download_stats_privacy"
+	// KeyRegistryDeletionApproval [bool] makes deletes of artifacts wait for the approval of a second user.
+	KeyRegistryDeletionApproval Key = "deletion_approval"
+	// KeyRegistryAutoQuarantineSeverity [string] quarantines the versions found affected by vulnerabilities of
+	// this severity or above, NONE turns it off.
+	KeyRegistryAutoQuarantineSeverity Key = "auto_quarantine_severity"
 )
@@ -30,6 +30,12 @@ var (
 	errPrivateNetworkNotAllowed = errors.New("private network not allowed")
 )
 
+// NewHTTPClient returns a client which applies the network restrictions of the webhook config, it's used
+// by other services which call user provided URLs.
+func NewHTTPClient(config Config) *http.Client {
+	return newHTTPClient(config.AllowLoopback, config.AllowPrivateNetwork, false)
+}
+
 func newHTTPClient(allowLoopback bool, allowPrivateNetwork bool, disableSSLVerification bool) *http.Client {
 	// Clone http.DefaultTransport (used by http.DefaultClient)
 	tr := http.DefaultTransport.(*http.Transport).Clone() //nolint:errcheck
@@ -140,7 +140,7 @@ func (w *WebhookExecutor) triggerWebhooks(
 		}
 
 		// execute trigger and store output in result
-		results[i].Execution, results[i].Err = w.executeWebhook(ctx, webhook, triggerID, triggerType, body, nil)
+		results[i].Execution, results[i].Err = w.executeWebhook(ctx, webhook, triggerID, triggerType, body, nil, "")
 	}
 
 	return results, nil
@@ -149,6 +149,16 @@ func (w *WebhookExecutor) triggerWebhooks(
 func (w *WebhookExecutor) RetriggerWebhookExecution(
 	ctx context.Context,
 	webhookExecutionID int64,
+) (*TriggerResult, error) {
+	return w.ReplayWebhookExecution(ctx, webhookExecutionID, "")
+}
+
+// ReplayWebhookExecution sends the request body of a past webhook execution again. If url isn't empty the request
+// is sent to url instead of the url of the webhook, and the latest execution result of the webhook isn't updated.
+func (w *WebhookExecutor) ReplayWebhookExecution(
+	ctx context.Context,
+	webhookExecutionID int64,
+	url string,
 ) (*TriggerResult, error) {
 	// find execution
 	webhookExecution, err := w.webhookExecutorStore.Find(ctx, webhookExecutionID)
@@ -176,7 +186,7 @@ func (w *WebhookExecutor) RetriggerWebhookExecution(
 	// NOTE: bBuff.Write(v) will always return (len(v), nil) - no need to error handle
 	body.WriteString(webhookExecution.Request.Body)
 
-	newExecution, err := w.executeWebhook(ctx, webhook, triggerID, triggerType, body, &webhookExecution.ID)
+	newExecution, err := w.executeWebhook(ctx, webhook, triggerID, triggerType, body, &webhookExecution.ID, url)
 	return &TriggerResult{
 		TriggerID:   triggerID,
 		TriggerType: triggerType,
@@ -186,10 +196,29 @@ func (w *WebhookExecutor) RetriggerWebhookExecution(
 	}, nil
 }
 
+// TriggerWebhook executes a single webhook with the provided body, no matter whether the webhook is enabled
+// or registered for the trigger. It's used to send test deliveries.
+func (w *WebhookExecutor) TriggerWebhook(
+	ctx context.Context,
+	webhook *types.WebhookCore,
+	triggerID string,
+	triggerType enum.WebhookTrigger,
+	body any,
+) *TriggerResult {
+	execution, err := w.executeWebhook(ctx, webhook, triggerID, triggerType, body, nil, "")
+	return &TriggerResult{
+		TriggerID:   triggerID,
+		TriggerType: triggerType,
+		Webhook:     webhook,
+		Execution:   execution,
+		Err:         err,
+	}
+}
+
 //nolint:gocognit // refactor into smaller chunks if necessary.
 func (w *WebhookExecutor) executeWebhook(
 	ctx context.Context, webhook *types.WebhookCore, triggerID string,
-	triggerType enum.WebhookTrigger, body any, rerunOfID *int64,
+	triggerType enum.WebhookTrigger, body any, rerunOfID *int64, urlOverride string,
 ) (*types.WebhookExecutionCore, error) {
 	// build execution entry on the fly (save no matter what)
 	execution := types.WebhookExecutionCore{
@@ -215,7 +244,9 @@ func (w *WebhookExecutor) executeWebhook(
 		}
 
 		// update latest execution result of webhook IFF it's different from before (best effort)
-		if webhook.LatestExecutionResult == nil || *webhook.LatestExecutionResult != execution.Result {
+		// deliveries to another url don't tell anything about the webhook itself.
+		if urlOverride == "" &&
+			(webhook.LatestExecutionResult == nil || *webhook.LatestExecutionResult != execution.Result) {
 			_, err = w.webhookExecutorStore.UpdateOptLock(oCtx, webhook, &execution)
 			if err != nil {
 				log.Ctx(ctx).Warn().Err(err).Msgf(
@@ -230,7 +261,7 @@ func (w *WebhookExecutor) executeWebhook(
 	defer cancel()
 
 	// create request from webhook and body
-	req, err := w.prepareHTTPRequest(ctx, &execution, triggerType, webhook, body)
+	req, err := w.prepareHTTPRequest(ctx, &execution, triggerType, webhook, body, urlOverride)
 	if err != nil {
 		return &execution, err
 	}
@@ -291,13 +322,18 @@ func (w *WebhookExecutor) executeWebhook(
 // prepareHTTPRequest prepares a new http.Request object for the webhook using the provided body as request body.
 // All execution.Request.XXX values are set accordingly.
 // NOTE: if the body is an io.Reader, the value is used as response body as is, otherwise it'll be JSON serialized.
+// The request is sent to urlOverride instead of the url of the webhook if it isn't empty.
 func (w *WebhookExecutor) prepareHTTPRequest(
 	ctx context.Context, execution *types.WebhookExecutionCore,
-	triggerType enum.WebhookTrigger, webhook *types.WebhookCore, body any,
+	triggerType enum.WebhookTrigger, webhook *types.WebhookCore, body any, urlOverride string,
 ) (*http.Request, error) {
-	url, err := w.webhookURLProvider.GetWebhookURL(ctx, webhook)
-	if err != nil {
-		return nil, fmt.Errorf("webhook url is not resolvable: %w", err)
+	url := urlOverride
+	if url == "" {
+		var err error
+		url, err = w.webhookURLProvider.GetWebhookURL(ctx, webhook)
+		if err != nil {
+			return nil, fmt.Errorf("webhook url is not resolvable: %w", err)
+		}
 	}
 	execution.Request.URL = url
 
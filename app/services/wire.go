@@ -36,6 +36,8 @@ import (
 	"github.com/harness/gitness/job"
 	"github.com/harness/gitness/registry/job/handler"
 	registryasyncprocessing "github.com/harness/gitness/registry/services/asyncprocessing"
+	registryreindexing "github.com/harness/gitness/registry/services/reindexing"
+	registrytagpublish "github.com/harness/gitness/registry/services/tagpublish"
 	registrywebhooks "github.com/harness/gitness/registry/services/webhook"
 
 	"github.com/google/wire"
@@ -64,6 +66,19 @@ type Services struct {
 	Branch                         *branch.Service
 	registryAsyncProcessingService *registryasyncprocessing.Service
 	languageAnalyzer               languageanalyzer.LanguageAnalyzer
+	RegistryTrashPurge             *handler.JobTrashPurge
+	RegistryGarbageMetrics         *handler.JobGarbageMetrics
+	RegistryEventOutbox            *handler.JobEventOutbox
+	RegistryFailedUploadsPurge     *handler.JobFailedUploadsPurge
+	registryTagPublishService      *registrytagpublish.Service
+	RegistryUsageSnapshot          *handler.JobUsageSnapshot
+	RegistryStatsRefresh           *handler.JobStatsRefresh
+	RegistryWebhookPayloadsPurge   *handler.JobWebhookPayloadsPurge
+	RegistryScheduledDeletions     *handler.JobScheduledDeletions
+	RegistryVulnerabilitySync      *handler.JobVulnerabilitySync
+	RegistrySearchIndex            *handler.JobSearchIndex
+	RegistryDownloadStatsRollup    *handler.JobDownloadStatsRollup
+	registryReindexingService      *registryreindexing.Service
 }
 
 type GitspaceServices struct {
@@ -116,6 +131,19 @@ func ProvideServices(
 	registryAsyncProcessingService *registryasyncprocessing.Service,
 	registryJobRpmRegistryIndex *handler.JobRpmRegistryIndex,
 	languageAnalyzer languageanalyzer.LanguageAnalyzer,
+	registryTrashPurge *handler.JobTrashPurge,
+	registryGarbageMetrics *handler.JobGarbageMetrics,
+	registryEventOutbox *handler.JobEventOutbox,
+	registryFailedUploadsPurge *handler.JobFailedUploadsPurge,
+	registryTagPublishService *registrytagpublish.Service,
+	registryUsageSnapshot *handler.JobUsageSnapshot,
+	registryStatsRefresh *handler.JobStatsRefresh,
+	registryWebhookPayloadsPurge *handler.JobWebhookPayloadsPurge,
+	registryScheduledDeletions *handler.JobScheduledDeletions,
+	registryVulnerabilitySync *handler.JobVulnerabilitySync,
+	registrySearchIndex *handler.JobSearchIndex,
+	registryDownloadStatsRollup *handler.JobDownloadStatsRollup,
+	registryReindexingService *registryreindexing.Service,
 ) Services {
 	return Services{
 		Webhook:                        webhooksSvc,
@@ -136,5 +164,18 @@ func ProvideServices(
 		Branch:                         branchSvc,
 		registryAsyncProcessingService: registryAsyncProcessingService,
 		languageAnalyzer:               languageAnalyzer,
+		RegistryTrashPurge:             registryTrashPurge,
+		RegistryGarbageMetrics:         registryGarbageMetrics,
+		RegistryEventOutbox:            registryEventOutbox,
+		RegistryFailedUploadsPurge:     registryFailedUploadsPurge,
+		registryTagPublishService:      registryTagPublishService,
+		RegistryUsageSnapshot:          registryUsageSnapshot,
+		RegistryStatsRefresh:           registryStatsRefresh,
+		RegistryWebhookPayloadsPurge:   registryWebhookPayloadsPurge,
+		RegistryScheduledDeletions:     registryScheduledDeletions,
+		RegistryVulnerabilitySync:      registryVulnerabilitySync,
+		RegistrySearchIndex:            registrySearchIndex,
+		RegistryDownloadStatsRollup:    registryDownloadStatsRollup,
+		registryReindexingService:      registryReindexingService,
 	}
 }
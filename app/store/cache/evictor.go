@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/gob"
+	"time"
 
 	"github.com/harness/gitness/pubsub"
 
@@ -43,19 +44,26 @@ func NewEvictor[T any](
 }
 
 func (e Evictor[T]) Subscribe(ctx context.Context, fn func(key T) error) {
+	e.SubscribeChanges(ctx, func(key T, _ time.Time) error {
+		return fn(key)
+	})
+}
+
+// SubscribeChanges is like Subscribe, but it also passes the time the eviction was published at, which tells
+// since when the cached value is stale. The time is zero for events published by older instances.
+func (e Evictor[T]) SubscribeChanges(ctx context.Context, fn func(key T, changed time.Time) error) {
 	if e.bus == nil {
 		return
 	}
 
 	_ = e.bus.Subscribe(ctx, e.topicName, func(payload []byte) error {
-		var key T
-		err := gob.NewDecoder(bytes.NewReader(payload)).Decode(&key)
+		key, changed, err := decodeEviction[T](payload)
 		if err != nil {
 			log.Ctx(ctx).Warn().Err(err).Msgf("failed to process update event from type: %T", key)
 			return err
 		}
 
-		return fn(key)
+		return fn(key, changed)
 	}, pubsub.WithChannelNamespace(e.nameSpace))
 }
 
@@ -65,7 +73,7 @@ func (e Evictor[T]) Evict(ctx context.Context, key T) {
 	}
 
 	buf := bytes.NewBuffer(nil)
-	_ = gob.NewEncoder(buf).Encode(key)
+	_ = gob.NewEncoder(buf).Encode(eviction[T]{EvictedKey: key, EvictedAt: time.Now().UnixMilli()})
 
 	err := e.bus.Publish(
 		ctx,
@@ -77,3 +85,21 @@ func (e Evictor[T]) Evict(ctx context.Context, key T) {
 		log.Ctx(ctx).Warn().Err(err).Msgf("failed to publish update event for type %T", key)
 	}
 }
+
+// eviction is the payload of eviction events. The field names are unlikely to clash with the fields of the keys,
+// so payloads of older instances, which only contain the key, fail to decode as an eviction.
+type eviction[T any] struct {
+	EvictedKey T
+	EvictedAt  int64
+}
+
+func decodeEviction[T any](payload []byte) (T, time.Time, error) {
+	var e eviction[T]
+	if err := gob.NewDecoder(bytes.NewReader(payload)).Decode(&e); err == nil {
+		return e.EvictedKey, time.UnixMilli(e.EvictedAt), nil
+	}
+
+	var key T
+	err := gob.NewDecoder(bytes.NewReader(payload)).Decode(&key)
+	return key, time.Time{}, err
+}
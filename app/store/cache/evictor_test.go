@@ -0,0 +1,64 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+)
+
+type evictorTestKey struct {
+	ID   int64
+	Name string
+}
+
+func TestDecodeEviction(t *testing.T) {
+	t.Run("decodes the key and the time of the eviction", func(t *testing.T) {
+		evictedAt := time.UnixMilli(time.Now().UnixMilli())
+		buf := bytes.NewBuffer(nil)
+		err := gob.NewEncoder(buf).Encode(eviction[*evictorTestKey]{
+			EvictedKey: &evictorTestKey{ID: 1, Name: "reg"},
+			EvictedAt:  evictedAt.UnixMilli(),
+		})
+		if err != nil {
+			t.Fatalf("failed to encode eviction: %v", err)
+		}
+
+		key, changed, err := decodeEviction[*evictorTestKey](buf.Bytes())
+		if err != nil {
+			t.Fatalf("failed to decode eviction: %v", err)
+		}
+		if key.ID != 1 || key.Name != "reg" || !changed.Equal(evictedAt) {
+			t.Errorf("unexpected eviction %v at %v", key, changed)
+		}
+	})
+
+	t.Run("decodes the key published by older instances", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		if err := gob.NewEncoder(buf).Encode(&evictorTestKey{ID: 1, Name: "reg"}); err != nil {
+			t.Fatalf("failed to encode key: %v", err)
+		}
+
+		key, changed, err := decodeEviction[*evictorTestKey](buf.Bytes())
+		if err != nil {
+			t.Fatalf("failed to decode eviction: %v", err)
+		}
+		if key.ID != 1 || key.Name != "reg" || !changed.IsZero() {
+			t.Errorf("unexpected eviction %v at %v", key, changed)
+		}
+	})
+}
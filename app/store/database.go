@@ -380,6 +380,14 @@ type (
 			key string,
 			value json.RawMessage,
 		) error
+
+		// Delete deletes the setting with the given key for the provided scope, if it exists.
+		Delete(
+			ctx context.Context,
+			scope enum.SettingsScope,
+			scopeID int64,
+			key string,
+		) error
 	}
 
 	// MembershipStore defines the membership data storage.
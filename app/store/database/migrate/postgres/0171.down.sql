@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS manifests_deleted_at;
+DROP INDEX IF EXISTS tags_deleted_at;
+DROP INDEX IF EXISTS manifests_registry_id_deleted_at;
+DROP INDEX IF EXISTS tags_registry_id_deleted_at;
+
+ALTER TABLE artifacts DROP COLUMN IF EXISTS artifact_deleted_at;
+ALTER TABLE manifests DROP COLUMN IF EXISTS manifest_deleted_at;
+ALTER TABLE tags DROP COLUMN IF EXISTS tag_deleted_at;
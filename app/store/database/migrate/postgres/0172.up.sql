@@ -0,0 +1,20 @@
+CREATE TABLE registry_index_builds
+(
+    registry_index_build_id           SERIAL PRIMARY KEY,
+    registry_index_build_registry_id  INTEGER NOT NULL
+        REFERENCES registries (registry_id) ON DELETE CASCADE,
+    registry_index_build_task_key     TEXT NOT NULL,
+    registry_index_build_kind         TEXT NOT NULL,
+    registry_index_build_image        TEXT NOT NULL,
+    registry_index_build_run_id       TEXT NOT NULL,
+    registry_index_build_status       registry_task_status NOT NULL,
+    registry_index_build_error        TEXT NOT NULL,
+    registry_index_build_principal_id INTEGER NOT NULL,
+    registry_index_build_started_at   BIGINT NOT NULL,
+    registry_index_build_duration     BIGINT NOT NULL
+);
+
+CREATE INDEX registry_index_builds_registry_id
+    ON registry_index_builds (registry_index_build_registry_id, registry_index_build_id);
+CREATE INDEX registry_index_builds_run_id
+    ON registry_index_builds (registry_index_build_run_id);
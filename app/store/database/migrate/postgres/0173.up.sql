@@ -0,0 +1,12 @@
+CREATE TABLE artifact_metadata_history
+(
+    artifact_metadata_history_id          SERIAL PRIMARY KEY,
+    artifact_metadata_history_artifact_id INTEGER NOT NULL
+        REFERENCES artifacts (artifact_id) ON DELETE CASCADE,
+    artifact_metadata_history_revision    INTEGER NOT NULL,
+    artifact_metadata_history_diff        JSONB NOT NULL,
+    artifact_metadata_history_created_by  INTEGER NOT NULL,
+    artifact_metadata_history_created_at  BIGINT NOT NULL,
+    CONSTRAINT unique_artifact_metadata_history_revision
+        UNIQUE (artifact_metadata_history_artifact_id, artifact_metadata_history_revision)
+);
@@ -0,0 +1,20 @@
+CREATE TABLE registry_notification_channels
+(
+    registry_notification_channel_id          SERIAL PRIMARY KEY,
+    registry_notification_channel_registry_id INTEGER NOT NULL
+        REFERENCES registries (registry_id) ON DELETE CASCADE,
+    registry_notification_channel_identifier  TEXT NOT NULL,
+    registry_notification_channel_type        TEXT NOT NULL,
+    registry_notification_channel_recipients  TEXT NOT NULL DEFAULT '',
+    registry_notification_channel_url         TEXT NOT NULL DEFAULT '',
+    registry_notification_channel_events      TEXT NOT NULL,
+    registry_notification_channel_template    TEXT NOT NULL DEFAULT '',
+    registry_notification_channel_rate_limit  INTEGER NOT NULL,
+    registry_notification_channel_enabled     BOOLEAN NOT NULL,
+    registry_notification_channel_created_at  BIGINT NOT NULL,
+    registry_notification_channel_updated_at  BIGINT NOT NULL,
+    registry_notification_channel_created_by  INTEGER NOT NULL,
+    registry_notification_channel_updated_by  INTEGER NOT NULL,
+    CONSTRAINT unique_registry_notification_channel_identifier
+        UNIQUE (registry_notification_channel_registry_id, registry_notification_channel_identifier)
+);
@@ -0,0 +1,20 @@
+CREATE TABLE registry_failed_uploads
+(
+    registry_failed_upload_id           SERIAL PRIMARY KEY,
+    registry_failed_upload_uuid         TEXT NOT NULL UNIQUE,
+    registry_failed_upload_registry_id  INTEGER NOT NULL
+        REFERENCES registries (registry_id) ON DELETE CASCADE,
+    registry_failed_upload_package_type TEXT NOT NULL,
+    registry_failed_upload_filename     TEXT NOT NULL DEFAULT '',
+    registry_failed_upload_sha256       TEXT NOT NULL,
+    registry_failed_upload_size         BIGINT NOT NULL,
+    registry_failed_upload_error        TEXT NOT NULL,
+    registry_failed_upload_created_by   INTEGER NOT NULL,
+    registry_failed_upload_created_at   BIGINT NOT NULL,
+    registry_failed_upload_expires_at   BIGINT NOT NULL
+);
+
+CREATE INDEX registry_failed_uploads_registry_id
+    ON registry_failed_uploads (registry_failed_upload_registry_id, registry_failed_upload_created_at);
+CREATE INDEX registry_failed_uploads_expires_at
+    ON registry_failed_uploads (registry_failed_upload_expires_at);
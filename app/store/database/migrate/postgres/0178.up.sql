@@ -0,0 +1,15 @@
+CREATE TABLE registry_upload_failure_stats
+(
+    registry_upload_failure_stat_registry_id    INTEGER NOT NULL
+        REFERENCES registries (registry_id) ON DELETE CASCADE,
+    registry_upload_failure_stat_package_type   TEXT NOT NULL,
+    registry_upload_failure_stat_error_class    TEXT NOT NULL,
+    registry_upload_failure_stat_day            BIGINT NOT NULL,
+    registry_upload_failure_stat_count          BIGINT NOT NULL,
+    registry_upload_failure_stat_last_failed_at BIGINT NOT NULL,
+    PRIMARY KEY (registry_upload_failure_stat_registry_id, registry_upload_failure_stat_package_type,
+                 registry_upload_failure_stat_error_class, registry_upload_failure_stat_day)
+);
+
+CREATE INDEX registry_upload_failure_stats_day
+    ON registry_upload_failure_stats (registry_upload_failure_stat_day);
@@ -0,0 +1,10 @@
+DELETE FROM settings WHERE setting_registry_id IS NOT NULL;
+
+DROP INDEX settings_sys_key;
+DROP INDEX settings_registry_id_key;
+
+ALTER TABLE settings DROP COLUMN setting_registry_id;
+
+CREATE UNIQUE INDEX settings_sys_key
+    ON settings (LOWER(setting_key))
+    WHERE setting_repo_id IS NULL AND setting_space_id IS NULL;
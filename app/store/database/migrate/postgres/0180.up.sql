@@ -0,0 +1,14 @@
+ALTER TABLE settings
+    ADD COLUMN setting_registry_id INTEGER
+        CONSTRAINT fk_settings_registry_id
+            REFERENCES registries (registry_id) ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX settings_registry_id_key
+    ON settings (setting_registry_id, LOWER(setting_key))
+    WHERE setting_registry_id IS NOT NULL;
+
+DROP INDEX settings_sys_key;
+
+CREATE UNIQUE INDEX settings_sys_key
+    ON settings (LOWER(setting_key))
+    WHERE setting_repo_id IS NULL AND setting_space_id IS NULL AND setting_registry_id IS NULL;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS registry_usage_snapshots;
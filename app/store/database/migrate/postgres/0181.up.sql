@@ -0,0 +1,11 @@
+CREATE TABLE registry_usage_snapshots
+(
+    registry_usage_snapshot_space_id        INTEGER NOT NULL
+        REFERENCES spaces (space_id) ON DELETE CASCADE,
+    registry_usage_snapshot_day             BIGINT NOT NULL,
+    registry_usage_snapshot_registry_count  BIGINT NOT NULL,
+    registry_usage_snapshot_storage_bytes   BIGINT NOT NULL,
+    registry_usage_snapshot_bandwidth_bytes BIGINT NOT NULL,
+    registry_usage_snapshot_created         BIGINT NOT NULL,
+    PRIMARY KEY (registry_usage_snapshot_space_id, registry_usage_snapshot_day)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS image_stats;
+DROP TABLE IF EXISTS registry_stats;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS recent_activities;
@@ -0,0 +1,15 @@
+CREATE TABLE recent_activities
+(
+    recent_activity_id           SERIAL PRIMARY KEY,
+    recent_activity_principal_id INTEGER NOT NULL,
+    recent_activity_kind         TEXT NOT NULL,
+    recent_activity_image_id     INTEGER NOT NULL
+        REFERENCES images (image_id) ON DELETE CASCADE,
+    recent_activity_version      TEXT NOT NULL DEFAULT '',
+    recent_activity_at           BIGINT NOT NULL,
+    CONSTRAINT unique_recent_activity_principal_kind_image
+        UNIQUE (recent_activity_principal_id, recent_activity_kind, recent_activity_image_id)
+);
+
+CREATE INDEX recent_activities_principal_id_kind_at
+    ON recent_activities (recent_activity_principal_id, recent_activity_kind, recent_activity_at);
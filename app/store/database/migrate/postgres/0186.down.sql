@@ -0,0 +1 @@
+DROP INDEX IF EXISTS registry_webhook_executions_created;
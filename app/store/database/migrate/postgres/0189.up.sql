@@ -0,0 +1,14 @@
+CREATE TABLE quarantine_access_attempts
+(
+    quarantine_access_attempt_id           SERIAL PRIMARY KEY,
+    quarantine_access_attempt_registry_id  INTEGER NOT NULL
+        REFERENCES registries (registry_id) ON DELETE CASCADE,
+    quarantine_access_attempt_image_name   TEXT NOT NULL,
+    quarantine_access_attempt_version      TEXT NOT NULL DEFAULT '',
+    quarantine_access_attempt_principal_id INTEGER,
+    quarantine_access_attempt_created      BIGINT NOT NULL
+);
+
+CREATE INDEX quarantine_access_attempts_registry_id_image_name_version
+    ON quarantine_access_attempts (quarantine_access_attempt_registry_id, quarantine_access_attempt_image_name,
+                                   quarantine_access_attempt_version);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS package_denylist_overrides;
+DROP TABLE IF EXISTS package_denylist_entries;
@@ -0,0 +1,20 @@
+CREATE TABLE artifact_provenances
+(
+    artifact_provenance_artifact_id     INTEGER PRIMARY KEY
+        REFERENCES artifacts (artifact_id) ON DELETE CASCADE,
+    artifact_provenance_pipeline_system TEXT NOT NULL DEFAULT '',
+    artifact_provenance_pipeline_id     TEXT NOT NULL DEFAULT '',
+    artifact_provenance_run_url         TEXT NOT NULL DEFAULT '',
+    artifact_provenance_commit_sha      TEXT NOT NULL DEFAULT '',
+    artifact_provenance_created_by      INTEGER NOT NULL,
+    artifact_provenance_created         BIGINT NOT NULL
+);
+
+CREATE INDEX artifact_provenances_pipeline_system_pipeline_id
+    ON artifact_provenances (artifact_provenance_pipeline_system, artifact_provenance_pipeline_id);
+
+CREATE INDEX artifact_provenances_run_url
+    ON artifact_provenances (artifact_provenance_run_url);
+
+CREATE INDEX artifact_provenances_commit_sha
+    ON artifact_provenances (artifact_provenance_commit_sha);
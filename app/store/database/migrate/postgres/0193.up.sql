@@ -0,0 +1,23 @@
+CREATE TABLE artifact_search_documents
+(
+    artifact_search_document_artifact_id   INTEGER PRIMARY KEY
+        REFERENCES artifacts (artifact_id) ON DELETE CASCADE,
+    artifact_search_document_name          TEXT    NOT NULL DEFAULT '',
+    artifact_search_document_description   TEXT    NOT NULL DEFAULT '',
+    artifact_search_document_keywords      TEXT    NOT NULL DEFAULT '',
+    artifact_search_document_authors       TEXT    NOT NULL DEFAULT '',
+    artifact_search_document_dependencies  TEXT    NOT NULL DEFAULT '',
+    artifact_search_document_updated       BIGINT  NOT NULL,
+    -- punctuation is replaced by spaces so names like "left-pad" or "org.example" are split into their words,
+    -- the same way the sqlite tokenizer splits them.
+    artifact_search_document_vector        TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', regexp_replace(artifact_search_document_name, '[^[:alnum:]]+', ' ', 'g')), 'A') ||
+        setweight(to_tsvector('simple', regexp_replace(artifact_search_document_keywords, '[^[:alnum:]]+', ' ', 'g')), 'B') ||
+        setweight(to_tsvector('simple', regexp_replace(artifact_search_document_description, '[^[:alnum:]]+', ' ', 'g')), 'C') ||
+        setweight(to_tsvector('simple', regexp_replace(artifact_search_document_authors, '[^[:alnum:]]+', ' ', 'g')), 'D') ||
+        setweight(to_tsvector('simple', regexp_replace(artifact_search_document_dependencies, '[^[:alnum:]]+', ' ', 'g')), 'D')
+    ) STORED
+);
+
+CREATE INDEX artifact_search_documents_vector
+    ON artifact_search_documents USING GIN (artifact_search_document_vector);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS firewall_approvals;
+
+ALTER TABLE upstream_proxy_configs
+    DROP COLUMN IF EXISTS upstream_proxy_config_firewall_delay_hours;
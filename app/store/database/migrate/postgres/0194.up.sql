@@ -0,0 +1,17 @@
+ALTER TABLE upstream_proxy_configs
+    ADD COLUMN upstream_proxy_config_firewall_delay_hours INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE firewall_approvals
+(
+    firewall_approval_id          SERIAL PRIMARY KEY,
+    firewall_approval_registry_id INTEGER NOT NULL
+        REFERENCES registries (registry_id) ON DELETE CASCADE,
+    firewall_approval_package     TEXT NOT NULL,
+    firewall_approval_version     TEXT NOT NULL,
+    firewall_approval_reason      TEXT NOT NULL,
+    firewall_approval_created_by  INTEGER NOT NULL,
+    firewall_approval_created     BIGINT NOT NULL
+);
+
+CREATE UNIQUE INDEX firewall_approvals_registry_id_package_version
+    ON firewall_approvals (firewall_approval_registry_id, firewall_approval_package, firewall_approval_version);
@@ -0,0 +1,10 @@
+CREATE TABLE upstream_provenances
+(
+    upstream_provenance_artifact_id INTEGER NOT NULL
+        REFERENCES artifacts (artifact_id) ON DELETE CASCADE,
+    upstream_provenance_file_name   TEXT NOT NULL,
+    upstream_provenance_source_url  TEXT NOT NULL DEFAULT '',
+    upstream_provenance_checksums   TEXT NOT NULL DEFAULT '{}',
+    upstream_provenance_first_seen  BIGINT NOT NULL,
+    PRIMARY KEY (upstream_provenance_artifact_id, upstream_provenance_file_name)
+);
@@ -0,0 +1,57 @@
+DROP VIEW IF EXISTS download_stat_counts;
+
+CREATE TABLE download_stats_unpartitioned
+(
+    download_stat_id          INTEGER NOT NULL DEFAULT nextval('download_stats_download_stat_id_seq') PRIMARY KEY,
+    download_stat_artifact_id INTEGER NOT NULL
+        CONSTRAINT fk_artifacts_artifact_id
+            REFERENCES artifacts (artifact_id) ON DELETE CASCADE,
+    download_stat_timestamp   BIGINT NOT NULL,
+    download_stat_created_at  BIGINT NOT NULL,
+    download_stat_updated_at  BIGINT NOT NULL,
+    download_stat_created_by  INTEGER,
+    download_stat_updated_by  INTEGER,
+    download_stat_count       BIGINT NOT NULL DEFAULT 1,
+    download_stat_day         BIGINT
+);
+
+INSERT INTO download_stats_unpartitioned (download_stat_id, download_stat_artifact_id, download_stat_timestamp,
+                                          download_stat_created_at, download_stat_updated_at,
+                                          download_stat_created_by, download_stat_updated_by)
+SELECT download_stat_id, download_stat_artifact_id, download_stat_timestamp,
+       download_stat_created_at, download_stat_updated_at,
+       download_stat_created_by, download_stat_updated_by
+FROM download_stats;
+
+-- the daily counts which aren't backed by recorded downloads were aggregated counters.
+INSERT INTO download_stats_unpartitioned (download_stat_artifact_id, download_stat_timestamp,
+                                          download_stat_created_at, download_stat_updated_at,
+                                          download_stat_created_by, download_stat_updated_by,
+                                          download_stat_count, download_stat_day)
+SELECT dd.download_stat_daily_artifact_id, dd.download_stat_daily_day,
+       dd.download_stat_daily_day, dd.download_stat_daily_day, 0, 0,
+       dd.download_stat_daily_count - COALESCE(d.download_count, 0), dd.download_stat_daily_day
+FROM download_stats_daily dd
+LEFT JOIN (SELECT download_stat_artifact_id,
+                  download_stat_timestamp - download_stat_timestamp % 86400000 AS download_day,
+                  COUNT(*)                                                     AS download_count
+           FROM download_stats
+           WHERE download_stat_timestamp < (SELECT download_stat_rollup_until FROM download_stat_rollups)
+           GROUP BY 1, 2) d
+    ON d.download_stat_artifact_id = dd.download_stat_daily_artifact_id
+        AND d.download_day = dd.download_stat_daily_day
+WHERE dd.download_stat_daily_count > COALESCE(d.download_count, 0);
+
+ALTER SEQUENCE download_stats_download_stat_id_seq OWNED BY NONE;
+DROP TABLE download_stats;
+ALTER TABLE download_stats_unpartitioned RENAME TO download_stats;
+ALTER INDEX download_stats_unpartitioned_pkey RENAME TO download_stats_pkey;
+ALTER SEQUENCE download_stats_download_stat_id_seq OWNED BY download_stats.download_stat_id;
+
+CREATE INDEX download_stat_artifact_id ON download_stats (download_stat_artifact_id);
+CREATE UNIQUE INDEX download_stats_artifact_id_day
+    ON download_stats (download_stat_artifact_id, download_stat_day)
+    WHERE download_stat_day IS NOT NULL;
+
+DROP TABLE IF EXISTS download_stat_rollups;
+DROP TABLE IF EXISTS download_stats_daily;
@@ -0,0 +1,80 @@
+CREATE TABLE download_stats_daily
+(
+    download_stat_daily_artifact_id INTEGER NOT NULL
+        REFERENCES artifacts (artifact_id) ON DELETE CASCADE,
+    download_stat_daily_day         BIGINT NOT NULL,
+    download_stat_daily_count       BIGINT NOT NULL,
+    PRIMARY KEY (download_stat_daily_artifact_id, download_stat_daily_day)
+);
+
+CREATE INDEX download_stats_daily_day ON download_stats_daily (download_stat_daily_day);
+
+CREATE TABLE download_stat_rollups
+(
+    download_stat_rollup_id    INTEGER PRIMARY KEY CHECK (download_stat_rollup_id = 1),
+    download_stat_rollup_until BIGINT NOT NULL
+);
+
+INSERT INTO download_stat_rollups (download_stat_rollup_id, download_stat_rollup_until)
+VALUES (1, (EXTRACT(EPOCH FROM now())::BIGINT / 86400) * 86400000);
+
+-- the aggregated counters and the downloads of the past days move to the daily table.
+INSERT INTO download_stats_daily (download_stat_daily_artifact_id, download_stat_daily_day, download_stat_daily_count)
+SELECT download_stat_artifact_id,
+       COALESCE(download_stat_day, download_stat_timestamp - download_stat_timestamp % 86400000),
+       SUM(download_stat_count)
+FROM download_stats
+WHERE download_stat_day IS NOT NULL
+   OR download_stat_timestamp < (SELECT download_stat_rollup_until FROM download_stat_rollups)
+GROUP BY 1, 2;
+
+DELETE FROM download_stats WHERE download_stat_day IS NOT NULL;
+
+-- download_stats only keeps the downloads of the registries with detailed stats, partitioned by month. The rows
+-- stored so far are kept in the default partition, the job creates the partitions of the next months.
+CREATE TABLE download_stats_partitioned
+(
+    download_stat_id          INTEGER NOT NULL DEFAULT nextval('download_stats_download_stat_id_seq'),
+    download_stat_artifact_id INTEGER NOT NULL
+        CONSTRAINT fk_artifacts_artifact_id
+            REFERENCES artifacts (artifact_id) ON DELETE CASCADE,
+    download_stat_timestamp   BIGINT NOT NULL,
+    download_stat_created_at  BIGINT NOT NULL,
+    download_stat_updated_at  BIGINT NOT NULL,
+    download_stat_created_by  INTEGER,
+    download_stat_updated_by  INTEGER,
+    PRIMARY KEY (download_stat_id, download_stat_timestamp)
+) PARTITION BY RANGE (download_stat_timestamp);
+
+CREATE TABLE download_stats_default PARTITION OF download_stats_partitioned DEFAULT;
+
+INSERT INTO download_stats_partitioned (download_stat_id, download_stat_artifact_id, download_stat_timestamp,
+                                        download_stat_created_at, download_stat_updated_at,
+                                        download_stat_created_by, download_stat_updated_by)
+SELECT download_stat_id, download_stat_artifact_id, download_stat_timestamp,
+       download_stat_created_at, download_stat_updated_at,
+       download_stat_created_by, download_stat_updated_by
+FROM download_stats;
+
+ALTER SEQUENCE download_stats_download_stat_id_seq OWNED BY NONE;
+DROP TABLE download_stats;
+ALTER TABLE download_stats_partitioned RENAME TO download_stats;
+ALTER INDEX download_stats_partitioned_pkey RENAME TO download_stats_pkey;
+ALTER SEQUENCE download_stats_download_stat_id_seq OWNED BY download_stats.download_stat_id;
+
+CREATE INDEX download_stat_artifact_id ON download_stats (download_stat_artifact_id);
+CREATE INDEX download_stat_timestamp ON download_stats (download_stat_timestamp);
+
+-- download_stat_counts are the downloads of each version per day, the days rolled up are read from the daily
+-- table and the downloads since from download_stats.
+CREATE VIEW download_stat_counts AS
+SELECT download_stat_daily_artifact_id AS download_stat_artifact_id,
+       download_stat_daily_day         AS download_stat_day,
+       download_stat_daily_count       AS download_stat_count
+FROM download_stats_daily
+UNION ALL
+SELECT download_stat_artifact_id,
+       download_stat_timestamp - download_stat_timestamp % 86400000 AS download_stat_day,
+       1                                                            AS download_stat_count
+FROM download_stats
+WHERE download_stat_timestamp >= (SELECT download_stat_rollup_until FROM download_stat_rollups);
@@ -0,0 +1,16 @@
+ALTER TABLE tags ADD COLUMN tag_deleted_at BIGINT;
+ALTER TABLE manifests ADD COLUMN manifest_deleted_at BIGINT;
+ALTER TABLE artifacts ADD COLUMN artifact_deleted_at BIGINT;
+
+CREATE INDEX tags_registry_id_deleted_at
+    ON tags(tag_registry_id, tag_deleted_at)
+    WHERE tag_deleted_at IS NOT NULL;
+CREATE INDEX manifests_registry_id_deleted_at
+    ON manifests(manifest_registry_id, manifest_deleted_at)
+    WHERE manifest_deleted_at IS NOT NULL;
+CREATE INDEX tags_deleted_at
+    ON tags(tag_deleted_at)
+    WHERE tag_deleted_at IS NOT NULL;
+CREATE INDEX manifests_deleted_at
+    ON manifests(manifest_deleted_at)
+    WHERE manifest_deleted_at IS NOT NULL;
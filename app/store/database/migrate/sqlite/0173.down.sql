@@ -0,0 +1 @@
+DROP TABLE IF EXISTS artifact_metadata_history;
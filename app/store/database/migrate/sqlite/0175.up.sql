@@ -0,0 +1,12 @@
+CREATE TABLE image_descriptions
+(
+    image_description_id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    image_description_image_id   INTEGER NOT NULL
+        REFERENCES images (image_id) ON DELETE CASCADE,
+    image_description_revision   INTEGER NOT NULL,
+    image_description_content    TEXT NOT NULL,
+    image_description_created_by INTEGER NOT NULL,
+    image_description_created_at BIGINT NOT NULL,
+    CONSTRAINT unique_image_description_revision
+        UNIQUE (image_description_image_id, image_description_revision)
+);
@@ -0,0 +1,13 @@
+CREATE TABLE registry_event_outbox
+(
+    registry_event_outbox_id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    registry_event_outbox_kind            TEXT NOT NULL,
+    registry_event_outbox_payload         BLOB NOT NULL,
+    registry_event_outbox_attempts        INTEGER NOT NULL DEFAULT 0,
+    registry_event_outbox_next_attempt_at BIGINT NOT NULL,
+    registry_event_outbox_last_error      TEXT NOT NULL DEFAULT '',
+    registry_event_outbox_created_at      BIGINT NOT NULL
+);
+
+CREATE INDEX registry_event_outbox_next_attempt_at
+    ON registry_event_outbox (registry_event_outbox_next_attempt_at);
@@ -0,0 +1,20 @@
+CREATE TABLE registry_jobs
+(
+    registry_job_id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    registry_job_uuid        TEXT NOT NULL UNIQUE,
+    registry_job_registry_id INTEGER NOT NULL
+        REFERENCES registries (registry_id) ON DELETE CASCADE,
+    registry_job_kind        TEXT NOT NULL,
+    registry_job_state       TEXT NOT NULL,
+    registry_job_progress    INTEGER NOT NULL DEFAULT 0,
+    registry_job_log         TEXT NOT NULL DEFAULT '',
+    registry_job_error       TEXT NOT NULL DEFAULT '',
+    registry_job_created_by  INTEGER NOT NULL,
+    registry_job_created_at  BIGINT NOT NULL,
+    registry_job_updated_at  BIGINT NOT NULL,
+    registry_job_started_at  BIGINT NOT NULL DEFAULT 0,
+    registry_job_finished_at BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX registry_jobs_registry_id
+    ON registry_jobs (registry_job_registry_id, registry_job_created_at);
@@ -0,0 +1,40 @@
+DELETE FROM settings WHERE setting_registry_id IS NOT NULL;
+
+DROP INDEX settings_sys_key;
+DROP INDEX settings_registry_id_key;
+
+-- sqlite can't drop a column referencing another table, the table is recreated without it instead.
+CREATE TABLE settings_old (
+ setting_id INTEGER PRIMARY KEY AUTOINCREMENT
+,setting_space_id INTEGER
+,setting_repo_id INTEGER
+,setting_key TEXT NOT NULL
+,setting_value TEXT
+
+,CONSTRAINT fk_settings_space_id FOREIGN KEY (setting_space_id)
+    REFERENCES spaces (space_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_settings_repo_id FOREIGN KEY (setting_repo_id)
+    REFERENCES repositories (repo_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
+
+INSERT INTO settings_old (setting_id, setting_space_id, setting_repo_id, setting_key, setting_value)
+SELECT setting_id, setting_space_id, setting_repo_id, setting_key, setting_value FROM settings;
+
+DROP TABLE settings;
+ALTER TABLE settings_old RENAME TO settings;
+
+CREATE UNIQUE INDEX settings_space_id_key
+	ON settings(setting_space_id, LOWER(setting_key))
+	WHERE setting_space_id IS NOT NULL;
+
+CREATE UNIQUE INDEX settings_repo_id_key
+	ON settings(setting_repo_id, LOWER(setting_key))
+	WHERE setting_repo_id IS NOT NULL;
+
+CREATE UNIQUE INDEX settings_sys_key
+    ON settings (LOWER(setting_key))
+    WHERE setting_repo_id IS NULL AND setting_space_id IS NULL;
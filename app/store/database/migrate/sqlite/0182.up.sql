@@ -0,0 +1,23 @@
+CREATE TABLE registry_stats
+(
+    registry_stats_registry_id    INTEGER PRIMARY KEY
+        REFERENCES registries (registry_id) ON DELETE CASCADE,
+    registry_stats_artifact_count BIGINT NOT NULL,
+    registry_stats_size           BIGINT NOT NULL,
+    registry_stats_download_count BIGINT NOT NULL,
+    registry_stats_refreshed_at   BIGINT NOT NULL
+);
+
+CREATE TABLE image_stats
+(
+    image_stats_image_id           INTEGER PRIMARY KEY
+        REFERENCES images (image_id) ON DELETE CASCADE,
+    image_stats_registry_id        INTEGER NOT NULL
+        REFERENCES registries (registry_id) ON DELETE CASCADE,
+    image_stats_latest_artifact_id INTEGER
+        REFERENCES artifacts (artifact_id) ON DELETE SET NULL,
+    image_stats_download_count     BIGINT NOT NULL,
+    image_stats_refreshed_at       BIGINT NOT NULL
+);
+
+CREATE INDEX image_stats_registry_id ON image_stats (image_stats_registry_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS download_stats_artifact_id_day;
+
+ALTER TABLE download_stats DROP COLUMN download_stat_day;
+ALTER TABLE download_stats DROP COLUMN download_stat_count;
@@ -0,0 +1,6 @@
+ALTER TABLE download_stats ADD COLUMN download_stat_count BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE download_stats ADD COLUMN download_stat_day BIGINT;
+
+CREATE UNIQUE INDEX download_stats_artifact_id_day
+    ON download_stats (download_stat_artifact_id, download_stat_day)
+    WHERE download_stat_day IS NOT NULL;
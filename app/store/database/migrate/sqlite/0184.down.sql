@@ -0,0 +1,3 @@
+ALTER TABLE images DROP COLUMN image_star_count;
+
+DROP TABLE IF EXISTS image_stars;
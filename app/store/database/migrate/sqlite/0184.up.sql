@@ -0,0 +1,13 @@
+CREATE TABLE image_stars
+(
+    image_star_image_id     INTEGER NOT NULL
+        REFERENCES images (image_id) ON DELETE CASCADE,
+    image_star_principal_id INTEGER NOT NULL,
+    image_star_created_at   BIGINT NOT NULL,
+    PRIMARY KEY (image_star_image_id, image_star_principal_id)
+);
+
+CREATE INDEX image_stars_principal_id_created_at
+    ON image_stars (image_star_principal_id, image_star_created_at);
+
+ALTER TABLE images ADD COLUMN image_star_count INTEGER NOT NULL DEFAULT 0;
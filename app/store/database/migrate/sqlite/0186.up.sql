@@ -0,0 +1,2 @@
+CREATE INDEX registry_webhook_executions_created
+    ON registry_webhook_executions (registry_webhook_execution_created);
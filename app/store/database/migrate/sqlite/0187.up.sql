@@ -0,0 +1,22 @@
+CREATE TABLE deletion_requests
+(
+    deletion_request_id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    deletion_request_registry_id  INTEGER NOT NULL
+        REFERENCES registries (registry_id) ON DELETE CASCADE,
+    deletion_request_image_name   TEXT NOT NULL,
+    deletion_request_version      TEXT NOT NULL DEFAULT '',
+    deletion_request_state        TEXT NOT NULL,
+    deletion_request_requested_by INTEGER NOT NULL,
+    deletion_request_reviewed_by  INTEGER,
+    deletion_request_comment      TEXT NOT NULL DEFAULT '',
+    deletion_request_created      BIGINT NOT NULL,
+    deletion_request_updated      BIGINT NOT NULL,
+    deletion_request_expires      BIGINT NOT NULL
+);
+
+CREATE INDEX deletion_requests_registry_id_created
+    ON deletion_requests (deletion_request_registry_id, deletion_request_created);
+
+CREATE UNIQUE INDEX deletion_requests_registry_id_image_name_version_pending
+    ON deletion_requests (deletion_request_registry_id, deletion_request_image_name, deletion_request_version)
+    WHERE deletion_request_state = 'PENDING';
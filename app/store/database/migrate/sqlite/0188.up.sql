@@ -0,0 +1,25 @@
+CREATE TABLE scheduled_deletions
+(
+    scheduled_deletion_id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    scheduled_deletion_registry_id INTEGER NOT NULL
+        REFERENCES registries (registry_id) ON DELETE CASCADE,
+    scheduled_deletion_image_name  TEXT NOT NULL,
+    scheduled_deletion_version     TEXT NOT NULL DEFAULT '',
+    scheduled_deletion_state       TEXT NOT NULL,
+    scheduled_deletion_execute_at  BIGINT NOT NULL,
+    scheduled_deletion_created_by  INTEGER NOT NULL,
+    scheduled_deletion_canceled_by INTEGER,
+    scheduled_deletion_error       TEXT NOT NULL DEFAULT '',
+    scheduled_deletion_created     BIGINT NOT NULL,
+    scheduled_deletion_updated     BIGINT NOT NULL
+);
+
+CREATE INDEX scheduled_deletions_registry_id_created
+    ON scheduled_deletions (scheduled_deletion_registry_id, scheduled_deletion_created);
+
+CREATE INDEX scheduled_deletions_state_execute_at
+    ON scheduled_deletions (scheduled_deletion_state, scheduled_deletion_execute_at);
+
+CREATE UNIQUE INDEX scheduled_deletions_registry_id_image_name_version_scheduled
+    ON scheduled_deletions (scheduled_deletion_registry_id, scheduled_deletion_image_name, scheduled_deletion_version)
+    WHERE scheduled_deletion_state = 'SCHEDULED';
@@ -0,0 +1,39 @@
+CREATE TABLE package_denylist_entries
+(
+    package_denylist_entry_id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    package_denylist_entry_space_id      INTEGER NOT NULL
+        REFERENCES spaces (space_id) ON DELETE CASCADE,
+    package_denylist_entry_package_type  TEXT NOT NULL DEFAULT '',
+    package_denylist_entry_name          TEXT NOT NULL,
+    package_denylist_entry_version_range TEXT NOT NULL DEFAULT '',
+    package_denylist_entry_reason        TEXT NOT NULL DEFAULT '',
+    package_denylist_entry_source        TEXT NOT NULL,
+    package_denylist_entry_external_id   TEXT NOT NULL DEFAULT '',
+    package_denylist_entry_created_by    INTEGER NOT NULL,
+    package_denylist_entry_created       BIGINT NOT NULL
+);
+
+CREATE UNIQUE INDEX package_denylist_entries_space_id_package_type_name_version_range
+    ON package_denylist_entries (package_denylist_entry_space_id, package_denylist_entry_package_type,
+                                 package_denylist_entry_name, package_denylist_entry_version_range);
+
+CREATE INDEX package_denylist_entries_name
+    ON package_denylist_entries (package_denylist_entry_name);
+
+CREATE TABLE package_denylist_overrides
+(
+    package_denylist_override_id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    package_denylist_override_entry_id    INTEGER NOT NULL
+        REFERENCES package_denylist_entries (package_denylist_entry_id) ON DELETE CASCADE,
+    package_denylist_override_registry_id INTEGER NOT NULL
+        REFERENCES registries (registry_id) ON DELETE CASCADE,
+    package_denylist_override_reason      TEXT NOT NULL,
+    package_denylist_override_created_by  INTEGER NOT NULL,
+    package_denylist_override_created     BIGINT NOT NULL
+);
+
+CREATE UNIQUE INDEX package_denylist_overrides_entry_id_registry_id
+    ON package_denylist_overrides (package_denylist_override_entry_id, package_denylist_override_registry_id);
+
+CREATE INDEX package_denylist_overrides_registry_id
+    ON package_denylist_overrides (package_denylist_override_registry_id);
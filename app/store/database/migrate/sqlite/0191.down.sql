@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS artifact_vulnerabilities;
+DROP TABLE IF EXISTS vulnerabilities;
@@ -0,0 +1,22 @@
+CREATE TABLE vulnerabilities
+(
+    vulnerability_id       TEXT PRIMARY KEY,
+    vulnerability_summary  TEXT NOT NULL DEFAULT '',
+    vulnerability_severity TEXT NOT NULL DEFAULT 'UNKNOWN',
+    vulnerability_aliases  TEXT NOT NULL DEFAULT '',
+    vulnerability_modified BIGINT NOT NULL,
+    vulnerability_updated  BIGINT NOT NULL
+);
+
+CREATE TABLE artifact_vulnerabilities
+(
+    artifact_vulnerability_artifact_id      INTEGER NOT NULL
+        REFERENCES artifacts (artifact_id) ON DELETE CASCADE,
+    artifact_vulnerability_vulnerability_id TEXT NOT NULL
+        REFERENCES vulnerabilities (vulnerability_id) ON DELETE CASCADE,
+    artifact_vulnerability_created          BIGINT NOT NULL,
+    PRIMARY KEY (artifact_vulnerability_artifact_id, artifact_vulnerability_vulnerability_id)
+);
+
+CREATE INDEX artifact_vulnerabilities_vulnerability_id
+    ON artifact_vulnerabilities (artifact_vulnerability_vulnerability_id);
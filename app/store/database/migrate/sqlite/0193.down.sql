@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS artifact_search_documents_after_insert;
+DROP TRIGGER IF EXISTS artifact_search_documents_after_update;
+DROP TRIGGER IF EXISTS artifact_search_documents_before_delete;
+DROP TRIGGER IF EXISTS artifact_search_documents_before_update;
+DROP TABLE IF EXISTS artifact_search_fts;
+DROP TABLE IF EXISTS artifact_search_documents;
@@ -0,0 +1,61 @@
+CREATE TABLE artifact_search_documents
+(
+    artifact_search_document_artifact_id   INTEGER PRIMARY KEY
+        REFERENCES artifacts (artifact_id) ON DELETE CASCADE,
+    artifact_search_document_name          TEXT    NOT NULL DEFAULT '',
+    artifact_search_document_description   TEXT    NOT NULL DEFAULT '',
+    artifact_search_document_keywords      TEXT    NOT NULL DEFAULT '',
+    artifact_search_document_authors       TEXT    NOT NULL DEFAULT '',
+    artifact_search_document_dependencies  TEXT    NOT NULL DEFAULT '',
+    artifact_search_document_updated       BIGINT  NOT NULL
+);
+
+-- the full-text index only holds the terms, the text is read from artifact_search_documents. The triggers keep it
+-- in sync, the docid of a row is the artifact id.
+CREATE VIRTUAL TABLE artifact_search_fts USING fts4
+(
+    content="artifact_search_documents",
+    artifact_search_document_name,
+    artifact_search_document_keywords,
+    artifact_search_document_description,
+    artifact_search_document_authors,
+    artifact_search_document_dependencies
+);
+
+CREATE TRIGGER artifact_search_documents_before_update
+    BEFORE UPDATE
+    ON artifact_search_documents
+BEGIN
+    DELETE FROM artifact_search_fts WHERE docid = old.artifact_search_document_artifact_id;
+END;
+
+CREATE TRIGGER artifact_search_documents_before_delete
+    BEFORE DELETE
+    ON artifact_search_documents
+BEGIN
+    DELETE FROM artifact_search_fts WHERE docid = old.artifact_search_document_artifact_id;
+END;
+
+CREATE TRIGGER artifact_search_documents_after_update
+    AFTER UPDATE
+    ON artifact_search_documents
+BEGIN
+    INSERT INTO artifact_search_fts (docid, artifact_search_document_name, artifact_search_document_keywords,
+                                     artifact_search_document_description, artifact_search_document_authors,
+                                     artifact_search_document_dependencies)
+    VALUES (new.artifact_search_document_artifact_id, new.artifact_search_document_name,
+            new.artifact_search_document_keywords, new.artifact_search_document_description,
+            new.artifact_search_document_authors, new.artifact_search_document_dependencies);
+END;
+
+CREATE TRIGGER artifact_search_documents_after_insert
+    AFTER INSERT
+    ON artifact_search_documents
+BEGIN
+    INSERT INTO artifact_search_fts (docid, artifact_search_document_name, artifact_search_document_keywords,
+                                     artifact_search_document_description, artifact_search_document_authors,
+                                     artifact_search_document_dependencies)
+    VALUES (new.artifact_search_document_artifact_id, new.artifact_search_document_name,
+            new.artifact_search_document_keywords, new.artifact_search_document_description,
+            new.artifact_search_document_authors, new.artifact_search_document_dependencies);
+END;
@@ -0,0 +1,32 @@
+DROP VIEW IF EXISTS download_stat_counts;
+DROP INDEX IF EXISTS download_stat_timestamp;
+
+ALTER TABLE download_stats ADD COLUMN download_stat_count BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE download_stats ADD COLUMN download_stat_day BIGINT;
+
+-- the daily counts which aren't backed by recorded downloads were aggregated counters.
+INSERT INTO download_stats (download_stat_artifact_id, download_stat_timestamp,
+                            download_stat_created_at, download_stat_updated_at,
+                            download_stat_created_by, download_stat_updated_by,
+                            download_stat_count, download_stat_day)
+SELECT dd.download_stat_daily_artifact_id, dd.download_stat_daily_day,
+       dd.download_stat_daily_day, dd.download_stat_daily_day, 0, 0,
+       dd.download_stat_daily_count - COALESCE(d.download_count, 0), dd.download_stat_daily_day
+FROM download_stats_daily dd
+LEFT JOIN (SELECT download_stat_artifact_id,
+                  download_stat_timestamp - download_stat_timestamp % 86400000 AS download_day,
+                  COUNT(*)                                                     AS download_count
+           FROM download_stats
+           WHERE download_stat_day IS NULL
+             AND download_stat_timestamp < (SELECT download_stat_rollup_until FROM download_stat_rollups)
+           GROUP BY 1, 2) d
+    ON d.download_stat_artifact_id = dd.download_stat_daily_artifact_id
+        AND d.download_day = dd.download_stat_daily_day
+WHERE dd.download_stat_daily_count > COALESCE(d.download_count, 0);
+
+CREATE UNIQUE INDEX download_stats_artifact_id_day
+    ON download_stats (download_stat_artifact_id, download_stat_day)
+    WHERE download_stat_day IS NOT NULL;
+
+DROP TABLE IF EXISTS download_stat_rollups;
+DROP TABLE IF EXISTS download_stats_daily;
@@ -0,0 +1,51 @@
+CREATE TABLE download_stats_daily
+(
+    download_stat_daily_artifact_id INTEGER NOT NULL
+        REFERENCES artifacts (artifact_id) ON DELETE CASCADE,
+    download_stat_daily_day         BIGINT NOT NULL,
+    download_stat_daily_count       BIGINT NOT NULL,
+    PRIMARY KEY (download_stat_daily_artifact_id, download_stat_daily_day)
+);
+
+CREATE INDEX download_stats_daily_day ON download_stats_daily (download_stat_daily_day);
+
+CREATE TABLE download_stat_rollups
+(
+    download_stat_rollup_id    INTEGER PRIMARY KEY CHECK (download_stat_rollup_id = 1),
+    download_stat_rollup_until BIGINT NOT NULL
+);
+
+INSERT INTO download_stat_rollups (download_stat_rollup_id, download_stat_rollup_until)
+VALUES (1, (CAST(strftime('%s', 'now') AS INTEGER) / 86400) * 86400000);
+
+-- the aggregated counters and the downloads of the past days move to the daily table.
+INSERT INTO download_stats_daily (download_stat_daily_artifact_id, download_stat_daily_day, download_stat_daily_count)
+SELECT download_stat_artifact_id,
+       COALESCE(download_stat_day, download_stat_timestamp - download_stat_timestamp % 86400000),
+       SUM(download_stat_count)
+FROM download_stats
+WHERE download_stat_day IS NOT NULL
+   OR download_stat_timestamp < (SELECT download_stat_rollup_until FROM download_stat_rollups)
+GROUP BY 1, 2;
+
+DELETE FROM download_stats WHERE download_stat_day IS NOT NULL;
+
+DROP INDEX IF EXISTS download_stats_artifact_id_day;
+ALTER TABLE download_stats DROP COLUMN download_stat_day;
+ALTER TABLE download_stats DROP COLUMN download_stat_count;
+
+CREATE INDEX download_stat_timestamp ON download_stats (download_stat_timestamp);
+
+-- download_stat_counts are the downloads of each version per day, the days rolled up are read from the daily
+-- table and the downloads since from download_stats.
+CREATE VIEW download_stat_counts AS
+SELECT download_stat_daily_artifact_id AS download_stat_artifact_id,
+       download_stat_daily_day         AS download_stat_day,
+       download_stat_daily_count       AS download_stat_count
+FROM download_stats_daily
+UNION ALL
+SELECT download_stat_artifact_id,
+       download_stat_timestamp - download_stat_timestamp % 86400000 AS download_stat_day,
+       1                                                            AS download_stat_count
+FROM download_stats
+WHERE download_stat_timestamp >= (SELECT download_stat_rollup_until FROM download_stat_rollups);
@@ -46,11 +46,12 @@ type SettingsStore struct {
 
 // setting is an internal representation used to store setting data in the database.
 type setting struct {
-	ID      int64           `db:"setting_id"`
-	SpaceID null.Int        `db:"setting_space_id"`
-	RepoID  null.Int        `db:"setting_repo_id"`
-	Key     string          `db:"setting_key"`
-	Value   json.RawMessage `db:"setting_value"`
+	ID         int64           `db:"setting_id"`
+	SpaceID    null.Int        `db:"setting_space_id"`
+	RepoID     null.Int        `db:"setting_repo_id"`
+	RegistryID null.Int        `db:"setting_registry_id"`
+	Key        string          `db:"setting_key"`
+	Value      json.RawMessage `db:"setting_value"`
 }
 
 const (
@@ -58,6 +59,7 @@ const (
 		 setting_id
 		,setting_space_id
 		,setting_repo_id
+		,setting_registry_id
 		,setting_key
 		,setting_value`
 )
@@ -78,8 +80,10 @@ func (s *SettingsStore) Find(
 		stmt = stmt.Where("setting_space_id = ?", scopeID)
 	case enum.SettingsScopeRepo:
 		stmt = stmt.Where("setting_repo_id = ?", scopeID)
+	case enum.SettingsScopeRegistry:
+		stmt = stmt.Where("setting_registry_id = ?", scopeID)
 	case enum.SettingsScopeSystem:
-		stmt = stmt.Where("setting_repo_id IS NULL AND setting_space_id IS NULL")
+		stmt = stmt.Where("setting_repo_id IS NULL AND setting_space_id IS NULL AND setting_registry_id IS NULL")
 	default:
 		return nil, fmt.Errorf("setting scope %q is not supported", scope)
 	}
@@ -124,8 +128,10 @@ func (s *SettingsStore) FindMany(
 		stmt = stmt.Where("setting_space_id = ?", scopeID)
 	case enum.SettingsScopeRepo:
 		stmt = stmt.Where("setting_repo_id = ?", scopeID)
+	case enum.SettingsScopeRegistry:
+		stmt = stmt.Where("setting_registry_id = ?", scopeID)
 	case enum.SettingsScopeSystem:
-		stmt = stmt.Where("setting_repo_id IS NULL AND setting_space_id IS NULL")
+		stmt = stmt.Where("setting_repo_id IS NULL AND setting_space_id IS NULL AND setting_registry_id IS NULL")
 	default:
 		return nil, fmt.Errorf("setting scope %q is not supported", scope)
 	}
@@ -162,21 +168,26 @@ func (s *SettingsStore) Upsert(ctx context.Context,
 		Columns(
 			"setting_space_id",
 			"setting_repo_id",
+			"setting_registry_id",
 			"setting_key",
 			"setting_value",
 		)
 
 	switch scope {
 	case enum.SettingsScopeSpace:
-		stmt = stmt.Values(null.IntFrom(scopeID), null.Int{}, key, value)
+		stmt = stmt.Values(null.IntFrom(scopeID), null.Int{}, null.Int{}, key, value)
 		stmt = stmt.Suffix(`ON CONFLICT (setting_space_id, LOWER(setting_key)) WHERE setting_space_id IS NOT NULL DO`)
 	case enum.SettingsScopeRepo:
-		stmt = stmt.Values(null.Int{}, null.IntFrom(scopeID), key, value)
+		stmt = stmt.Values(null.Int{}, null.IntFrom(scopeID), null.Int{}, key, value)
 		stmt = stmt.Suffix(`ON CONFLICT (setting_repo_id, LOWER(setting_key)) WHERE setting_repo_id IS NOT NULL DO`)
+	case enum.SettingsScopeRegistry:
+		stmt = stmt.Values(null.Int{}, null.Int{}, null.IntFrom(scopeID), key, value)
+		stmt = stmt.Suffix(`ON CONFLICT (setting_registry_id, LOWER(setting_key))
+			WHERE setting_registry_id IS NOT NULL DO`)
 	case enum.SettingsScopeSystem:
-		stmt = stmt.Values(null.Int{}, null.Int{}, key, value)
+		stmt = stmt.Values(null.Int{}, null.Int{}, null.Int{}, key, value)
 		stmt = stmt.Suffix(`ON CONFLICT (LOWER(setting_key)) 
-			WHERE setting_repo_id IS NULL AND setting_space_id IS NULL DO`)
+			WHERE setting_repo_id IS NULL AND setting_space_id IS NULL AND setting_registry_id IS NULL DO`)
 	default:
 		return fmt.Errorf("setting scope %q is not supported", scope)
 	}
@@ -205,3 +216,40 @@ func (s *SettingsStore) Upsert(ctx context.Context,
 
 	return nil
 }
+
+func (s *SettingsStore) Delete(
+	ctx context.Context,
+	scope enum.SettingsScope,
+	scopeID int64,
+	key string,
+) error {
+	stmt := database.Builder.
+		Delete("settings").
+		Where("LOWER(setting_key) = ?", strings.ToLower(key))
+
+	switch scope {
+	case enum.SettingsScopeSpace:
+		stmt = stmt.Where("setting_space_id = ?", scopeID)
+	case enum.SettingsScopeRepo:
+		stmt = stmt.Where("setting_repo_id = ?", scopeID)
+	case enum.SettingsScopeRegistry:
+		stmt = stmt.Where("setting_registry_id = ?", scopeID)
+	case enum.SettingsScopeSystem:
+		stmt = stmt.Where("setting_repo_id IS NULL AND setting_space_id IS NULL AND setting_registry_id IS NULL")
+	default:
+		return fmt.Errorf("setting scope %q is not supported", scope)
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sql, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Delete query failed")
+	}
+
+	return nil
+}
@@ -95,12 +95,22 @@ func migrator(ctx context.Context, db *sqlx.DB) error {
 
 // ProvideDatabase provides a database connection.
 func ProvideDatabase(ctx context.Context, config database.Config) (*sqlx.DB, error) {
-	return database.ConnectAndMigrate(
+	db, err := database.ConnectAndMigrate(
 		ctx,
 		config.Driver,
 		config.Datasource,
 		migrator,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	db.SetMaxOpenConns(config.MaxOpenConns)
+	db.SetMaxIdleConns(config.MaxIdleConns)
+	db.SetConnMaxLifetime(config.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(config.ConnMaxIdleTime)
+
+	return db, nil
 }
 
 // ProvidePrincipalStore provides a principal store.
@@ -0,0 +1,63 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"slices"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// Actor identifies a principal in the actor chain of an operation.
+type Actor struct {
+	ID   int64              `json:"id"`
+	UID  string             `json:"uid"`
+	Type enum.PrincipalType `json:"type"`
+}
+
+// ActorFromPrincipal returns the actor of the given principal.
+func ActorFromPrincipal(principal types.Principal) Actor {
+	return Actor{
+		ID:   principal.ID,
+		UID:  principal.UID,
+		Type: principal.Type,
+	}
+}
+
+// AppendActor returns a context recording that the operations performed with it are executed on behalf
+// of the given actor, e.g. when an admin acts as a service account or through support tooling.
+// Nested calls extend the chain, the real actor comes first.
+func AppendActor(ctx context.Context, actor Actor) context.Context {
+	chain := append(slices.Clone(GetActorChain(ctx)), actor)
+	return context.WithValue(ctx, actorChainKey, chain)
+}
+
+// GetActorChain returns the real actors recorded in the context, or nil if there are none.
+func GetActorChain(ctx context.Context) []Actor {
+	chain, ok := ctx.Value(actorChainKey).([]Actor)
+	if !ok {
+		return nil
+	}
+
+	return chain
+}
+
+// ActorChain returns the actor chain of an operation performed by the effective principal: the real
+// actors recorded in the context followed by the principal itself.
+func ActorChain(ctx context.Context, principal types.Principal) []Actor {
+	return append(slices.Clone(GetActorChain(ctx)), ActorFromPrincipal(principal))
+}
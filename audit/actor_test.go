@@ -0,0 +1,54 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestActorChain(t *testing.T) {
+	admin := Actor{ID: 1, UID: "admin", Type: enum.PrincipalTypeUser}
+	support := Actor{ID: 2, UID: "support", Type: enum.PrincipalTypeService}
+	sa := types.Principal{ID: 3, UID: "sa", Type: enum.PrincipalTypeServiceAccount}
+
+	t.Run("returns the principal when no actor is present", func(t *testing.T) {
+		chain := ActorChain(context.Background(), sa)
+		if len(chain) != 1 || chain[0] != ActorFromPrincipal(sa) {
+			t.Errorf("expected only the principal, got %v", chain)
+		}
+	})
+
+	t.Run("returns the real actors first", func(t *testing.T) {
+		ctx := AppendActor(AppendActor(context.Background(), admin), support)
+		chain := ActorChain(ctx, sa)
+		if len(chain) != 3 || chain[0] != admin || chain[1] != support || chain[2] != ActorFromPrincipal(sa) {
+			t.Errorf("expected admin, support and the principal, got %v", chain)
+		}
+	})
+
+	t.Run("does not modify the chain of the parent context", func(t *testing.T) {
+		parent := AppendActor(context.Background(), admin)
+		_ = AppendActor(parent, support)
+		_ = ActorChain(parent, sa)
+		chain := GetActorChain(parent)
+		if len(chain) != 1 || chain[0] != admin {
+			t.Errorf("expected only admin, got %v", chain)
+		}
+	})
+}
@@ -76,19 +76,26 @@ func (a Action) Validate() error {
 type ResourceType string
 
 const (
-	ResourceTypeRepository            ResourceType = "repository"
-	ResourceTypeBranchRule            ResourceType = "branch_rule"
-	ResourceTypeBranch                ResourceType = "branch"
-	ResourceTypeTag                   ResourceType = "tag"
-	ResourceTypeTagRule               ResourceType = "tag_rule"
-	ResourceTypePushRule              ResourceType = "push_rule"
-	ResourceTypePullRequest           ResourceType = "pull_request"
-	ResourceTypeRepositorySettings    ResourceType = "repository_settings"
-	ResourceTypeCodeWebhook           ResourceType = "code_webhook"
-	ResourceTypeRegistry              ResourceType = "registry"
-	ResourceTypeRegistryUpstreamProxy ResourceType = "registry_upstream_proxy"
-	ResourceTypeRegistryWebhook       ResourceType = "registry_webhook"
-	ResourceTypeRegistryArtifact      ResourceType = "registry_artifact"
+	ResourceTypeRepository                ResourceType = "repository"
+	ResourceTypeBranchRule                ResourceType = "branch_rule"
+	ResourceTypeBranch                    ResourceType = "branch"
+	ResourceTypeTag                       ResourceType = "tag"
+	ResourceTypeTagRule                   ResourceType = "tag_rule"
+	ResourceTypePushRule                  ResourceType = "push_rule"
+	ResourceTypePullRequest               ResourceType = "pull_request"
+	ResourceTypeRepositorySettings        ResourceType = "repository_settings"
+	ResourceTypeCodeWebhook               ResourceType = "code_webhook"
+	ResourceTypeRegistry                  ResourceType = "registry"
+	ResourceTypeRegistryUpstreamProxy     ResourceType = "registry_upstream_proxy"
+	ResourceTypeRegistryWebhook           ResourceType = "registry_webhook"
+	ResourceTypeRegistryArtifact          ResourceType = "registry_artifact"
+	ResourceTypeRegistryPolicy            ResourceType = "registry_policy"
+	ResourceTypeRegistrySettings          ResourceType = "registry_settings"
+	ResourceTypeRegistryDeletionRequest   ResourceType = "registry_deletion_request"
+	ResourceTypeRegistryScheduledDeletion ResourceType = "registry_scheduled_deletion"
+	ResourceTypeRegistryPackageDenylist   ResourceType = "registry_package_denylist"
+	ResourceTypeRegistryDenylistOverride  ResourceType = "registry_denylist_override"
+	ResourceTypeRegistryFirewallApproval  ResourceType = "registry_firewall_approval"
 )
 
 func (a ResourceType) Validate() error {
@@ -105,7 +112,14 @@ func (a ResourceType) Validate() error {
 		ResourceTypeRegistry,
 		ResourceTypeRegistryUpstreamProxy,
 		ResourceTypeRegistryWebhook,
-		ResourceTypeRegistryArtifact:
+		ResourceTypeRegistryArtifact,
+		ResourceTypeRegistryPolicy,
+		ResourceTypeRegistrySettings,
+		ResourceTypeRegistryDeletionRequest,
+		ResourceTypeRegistryScheduledDeletion,
+		ResourceTypeRegistryPackageDenylist,
+		ResourceTypeRegistryDenylistOverride,
+		ResourceTypeRegistryFirewallApproval:
 		return nil
 
 	default:
@@ -166,6 +180,9 @@ type Event struct {
 	ClientIP      string
 	RequestMethod string
 	Data          map[string]string // internal data like correlationID/requestID
+	// ActorChain lists the real actors followed by the effective principal when an operation is
+	// performed on behalf of another principal.
+	ActorChain []Actor
 }
 
 func (e *Event) Validate() error {
@@ -242,6 +259,12 @@ func WithRequestMethod(value string) FuncOption {
 	}
 }
 
+func WithActorChain(chain []Actor) FuncOption {
+	return func(e *Event) {
+		e.ActorChain = chain
+	}
+}
+
 func WithData(keyValues ...string) FuncOption {
 	return func(e *Event) {
 		if e.Data == nil {
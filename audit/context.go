@@ -23,6 +23,8 @@ const (
 	requestID
 	requestMethod
 	pathKey
+	actorChainKey
+	regionKey
 )
 
 // GetRealIP returns IP address from context.
@@ -35,6 +37,16 @@ func GetRealIP(ctx context.Context) string {
 	return ip
 }
 
+// GetRegion returns the region the client declared it's in from context.
+func GetRegion(ctx context.Context) string {
+	region, ok := ctx.Value(regionKey).(string)
+	if !ok {
+		return ""
+	}
+
+	return region
+}
+
 // GetPath returns Path from context.
 func GetPath(ctx context.Context) string {
 	path, ok := ctx.Value(pathKey).(string)
@@ -25,6 +25,7 @@ var (
 	trueClientIP  = http.CanonicalHeaderKey("True-Client-IP")
 	xForwardedFor = http.CanonicalHeaderKey("X-Forwarded-For")
 	xRealIP       = http.CanonicalHeaderKey("X-Real-IP")
+	clientRegion  = http.CanonicalHeaderKey("X-Client-Region")
 )
 
 // Middleware process request headers to fill internal info data.
@@ -35,6 +36,9 @@ func Middleware() func(next http.Handler) http.Handler {
 			if rip := RealIP(r); rip != "" {
 				ctx = context.WithValue(ctx, realIPKey, rip)
 			}
+			if region := r.Header.Get(clientRegion); region != "" {
+				ctx = context.WithValue(ctx, regionKey, strings.ToLower(strings.TrimSpace(region)))
+			}
 
 			ctx = context.WithValue(ctx, pathKey, r.URL.Path)
 			ctx = context.WithValue(ctx, requestMethod, r.Method)
@@ -273,8 +273,12 @@ func getSanitizedMachineName() (string, error) {
 // ProvideDatabaseConfig loads the database config from the main config.
 func ProvideDatabaseConfig(config *types.Config) database.Config {
 	return database.Config{
-		Driver:     config.Database.Driver,
-		Datasource: config.Database.Datasource,
+		Driver:          config.Database.Driver,
+		Datasource:      config.Database.Datasource,
+		MaxOpenConns:    config.Database.MaxOpenConns,
+		MaxIdleConns:    config.Database.MaxIdleConns,
+		ConnMaxLifetime: config.Database.ConnMaxLifetime,
+		ConnMaxIdleTime: config.Database.ConnMaxIdleTime,
 	}
 }
 
@@ -310,6 +314,7 @@ func ProvideGitConfig(config *types.Config) gittypes.Config {
 			Mode:     config.Git.LastCommitCache.Mode,
 			Duration: config.Git.LastCommitCache.Duration,
 		},
+		SecretScanningRules: config.Git.SecretScanningRules,
 	}
 }
 
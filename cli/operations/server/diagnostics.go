@@ -0,0 +1,126 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	runtimepprof "runtime/pprof"
+	"time"
+
+	middlewareauthn "github.com/harness/gitness/app/api/middleware/authn"
+	middlewareprincipal "github.com/harness/gitness/app/api/middleware/principal"
+	"github.com/harness/gitness/app/api/render"
+	"github.com/harness/gitness/app/auth/authn"
+	gitnesshttp "github.com/harness/gitness/http"
+	"github.com/harness/gitness/registry/app/api/middleware"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/version"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// DiagnosticsServer is an auxiliary server exposing pprof, goroutine dumps and a snapshot of
+// the registry operations in flight, to debug production issues without redeploying.
+type DiagnosticsServer struct {
+	*gitnesshttp.Server
+}
+
+// RuntimeStats is a snapshot of the go runtime of the instance.
+type RuntimeStats struct {
+	Version      string        `json:"version"`
+	GoVersion    string        `json:"go_version"`
+	Uptime       time.Duration `json:"uptime"`
+	NumCPU       int           `json:"num_cpu"`
+	GOMAXPROCS   int           `json:"gomaxprocs"`
+	Goroutines   int           `json:"goroutines"`
+	HeapAlloc    uint64        `json:"heap_alloc"`
+	HeapInuse    uint64        `json:"heap_inuse"`
+	Sys          uint64        `json:"sys"`
+	NumGC        uint32        `json:"num_gc"`
+	PauseTotalNs uint64        `json:"pause_total_ns"`
+}
+
+// ProvideDiagnosticsServer provides the diagnostics server, it returns nil if the server is disabled.
+func ProvideDiagnosticsServer(
+	config *types.Config,
+	authenticator authn.Authenticator,
+	inFlightTracker *middleware.InFlightTracker,
+) *DiagnosticsServer {
+	if !config.Diagnostics.Enabled {
+		return nil
+	}
+
+	return &DiagnosticsServer{
+		gitnesshttp.NewServer(
+			gitnesshttp.Config{
+				Host: config.Diagnostics.Host,
+				Port: config.Diagnostics.Port,
+			},
+			newDiagnosticsHandler(authenticator, inFlightTracker, time.Now()),
+		),
+	}
+}
+
+func newDiagnosticsHandler(
+	authenticator authn.Authenticator,
+	inFlightTracker *middleware.InFlightTracker,
+	started time.Time,
+) http.Handler {
+	r := chi.NewRouter()
+
+	r.Use(middlewareauthn.Attempt(authenticator))
+	r.Use(middlewareprincipal.RestrictToAdmin())
+
+	r.Route("/debug", func(r chi.Router) {
+		r.HandleFunc("/pprof/cmdline", pprof.Cmdline)
+		r.HandleFunc("/pprof/profile", pprof.Profile)
+		r.HandleFunc("/pprof/symbol", pprof.Symbol)
+		r.HandleFunc("/pprof/trace", pprof.Trace)
+		// the index serves the named profiles as well, e.g. /debug/pprof/heap.
+		r.HandleFunc("/pprof/*", pprof.Index)
+
+		r.Get("/goroutines", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			_ = runtimepprof.Lookup("goroutine").WriteTo(w, 2)
+		})
+
+		r.Get("/runtime", func(w http.ResponseWriter, _ *http.Request) {
+			var mem runtime.MemStats
+			runtime.ReadMemStats(&mem)
+
+			render.JSON(w, http.StatusOK, RuntimeStats{
+				Version:      version.Version.String(),
+				GoVersion:    runtime.Version(),
+				Uptime:       time.Since(started),
+				NumCPU:       runtime.NumCPU(),
+				GOMAXPROCS:   runtime.GOMAXPROCS(0),
+				Goroutines:   runtime.NumGoroutine(),
+				HeapAlloc:    mem.HeapAlloc,
+				HeapInuse:    mem.HeapInuse,
+				Sys:          mem.Sys,
+				NumGC:        mem.NumGC,
+				PauseTotalNs: mem.PauseTotalNs,
+			})
+		})
+
+		r.Get("/registry/inflight", func(w http.ResponseWriter, _ *http.Request) {
+			render.JSON(w, http.StatusOK, inFlightTracker.Snapshot())
+		})
+	})
+
+	return r
+}
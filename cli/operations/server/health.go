@@ -0,0 +1,79 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/harness/gitness/events"
+	gitenum "github.com/harness/gitness/git/enum"
+	"github.com/harness/gitness/http"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/types"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jmoiron/sqlx"
+)
+
+// ProvideHealthServer provides the health server with checks for all remote dependencies
+// of the configuration, it returns nil if the health server is disabled.
+func ProvideHealthServer(
+	config *types.Config,
+	db *sqlx.DB,
+	storageDriver storagedriver.StorageDriver,
+	redisClient redis.UniversalClient,
+) *http.HealthServer {
+	if !config.Health.Enabled {
+		return nil
+	}
+
+	checks := []http.HealthCheck{
+		{
+			Name:  "database",
+			Check: db.PingContext,
+		},
+		{
+			Name: "blob_storage",
+			Check: func(ctx context.Context) error {
+				_, err := storageDriver.Stat(ctx, "/")
+				if errors.As(err, &storagedriver.PathNotFoundError{}) {
+					// the storage is reachable, it's just empty.
+					return nil
+				}
+				return err
+			},
+		},
+	}
+
+	pingRedis := func(ctx context.Context) error {
+		return redisClient.Ping(ctx).Err()
+	}
+	if config.Git.LastCommitCache.Mode == gitenum.LastCommitCacheModeRedis {
+		checks = append(checks, http.HealthCheck{Name: "cache", Check: pingRedis})
+	}
+	if config.Events.Mode == events.ModeRedis {
+		checks = append(checks, http.HealthCheck{Name: "events", Check: pingRedis})
+	}
+
+	return http.NewHealthServer(
+		http.HealthConfig{
+			Host:    config.Health.Host,
+			Port:    config.Health.Port,
+			Timeout: config.Health.CheckTimeout,
+		},
+		checks...,
+	)
+}
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/harness/gitness/app/pipeline/logger"
+	"github.com/harness/gitness/http"
 	"github.com/harness/gitness/profiler"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/version"
@@ -105,6 +106,83 @@ func (c *command) run(*kingpin.ParseContext) error {
 			return err
 		}
 
+		if system.services.RegistryTrashPurge != nil {
+			if err := system.services.RegistryTrashPurge.Register(gCtx); err != nil {
+				log.Error().Err(err).Msg("failed to register registry trash purge")
+				return err
+			}
+		}
+
+		if system.services.RegistryGarbageMetrics != nil {
+			if err := system.services.RegistryGarbageMetrics.Register(gCtx); err != nil {
+				log.Error().Err(err).Msg("failed to register registry garbage metrics")
+				return err
+			}
+		}
+
+		if system.services.RegistryEventOutbox != nil {
+			if err := system.services.RegistryEventOutbox.Register(gCtx); err != nil {
+				log.Error().Err(err).Msg("failed to register registry event outbox")
+				return err
+			}
+		}
+
+		if system.services.RegistryFailedUploadsPurge != nil {
+			if err := system.services.RegistryFailedUploadsPurge.Register(gCtx); err != nil {
+				log.Error().Err(err).Msg("failed to register registry failed uploads purge")
+				return err
+			}
+		}
+
+		if system.services.RegistryUsageSnapshot != nil {
+			if err := system.services.RegistryUsageSnapshot.Register(gCtx); err != nil {
+				log.Error().Err(err).Msg("failed to register registry usage snapshot")
+				return err
+			}
+		}
+
+		if system.services.RegistryStatsRefresh != nil {
+			if err := system.services.RegistryStatsRefresh.Register(gCtx); err != nil {
+				log.Error().Err(err).Msg("failed to register registry stats refresh")
+				return err
+			}
+		}
+
+		if system.services.RegistryWebhookPayloadsPurge != nil {
+			if err := system.services.RegistryWebhookPayloadsPurge.Register(gCtx); err != nil {
+				log.Error().Err(err).Msg("failed to register registry webhook payloads purge")
+				return err
+			}
+		}
+
+		if system.services.RegistryScheduledDeletions != nil {
+			if err := system.services.RegistryScheduledDeletions.Register(gCtx); err != nil {
+				log.Error().Err(err).Msg("failed to register registry scheduled deletions")
+				return err
+			}
+		}
+
+		if system.services.RegistryVulnerabilitySync != nil {
+			if err := system.services.RegistryVulnerabilitySync.Register(gCtx); err != nil {
+				log.Error().Err(err).Msg("failed to register registry vulnerability sync")
+				return err
+			}
+		}
+
+		if system.services.RegistrySearchIndex != nil {
+			if err := system.services.RegistrySearchIndex.Register(gCtx); err != nil {
+				log.Error().Err(err).Msg("failed to register registry search index")
+				return err
+			}
+		}
+
+		if system.services.RegistryDownloadStatsRollup != nil {
+			if err := system.services.RegistryDownloadStatsRollup.Register(gCtx); err != nil {
+				log.Error().Err(err).Msg("failed to register registry download stats rollup")
+				return err
+			}
+		}
+
 		return system.services.JobScheduler.Run(gCtx)
 	})
 
@@ -115,6 +193,12 @@ func (c *command) run(*kingpin.ParseContext) error {
 	gMetric, shutdownMetricServerFn := system.metricServer.ListenAndServe()
 	g.Go(gMetric.Wait)
 
+	gHealth, shutdownHealthServerFn := system.healthServer.ListenAndServe()
+	g.Go(gHealth.Wait)
+
+	gDiagnostics, shutdownDiagnosticsServerFn := system.diagnostics.ListenAndServe()
+	g.Go(gDiagnostics.Wait)
+
 	if c.enableCI {
 		// start populating plugins
 		g.Go(func() error {
@@ -156,6 +240,13 @@ func (c *command) run(*kingpin.ParseContext) error {
 	stop()
 	log.Info().Msg("shutting down gracefully (press Ctrl+C again to force)")
 
+	// fail the readiness first so load balancers stop sending new requests before the server stops.
+	if drainer, ok := system.healthServer.(http.Drainer); ok {
+		drainer.Drain()
+		log.Info().Dur("drain_delay", config.Health.DrainDelay).Msg("draining before shutdown")
+		time.Sleep(config.Health.DrainDelay)
+	}
+
 	// shutdown servers gracefully
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.GracefulShutdownTime)
 	defer cancel()
@@ -174,6 +265,14 @@ func (c *command) run(*kingpin.ParseContext) error {
 		log.Err(sErr).Msg("failed to shutdown metric server gracefully")
 	}
 
+	if sErr := shutdownHealthServerFn(shutdownCtx); sErr != nil {
+		log.Err(sErr).Msg("failed to shutdown health server gracefully")
+	}
+
+	if sErr := shutdownDiagnosticsServerFn(shutdownCtx); sErr != nil {
+		log.Err(sErr).Msg("failed to shutdown diagnostics server gracefully")
+	}
+
 	// shutdown instrumentation
 	err = system.services.Instrumentation.Close(shutdownCtx)
 	if err != nil {
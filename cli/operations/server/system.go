@@ -34,6 +34,8 @@ type System struct {
 	poller          *poller.Poller
 	services        services.Services
 	metricServer    http.ListenAndServeServer
+	healthServer    http.ListenAndServeServer
+	diagnostics     http.ListenAndServeServer
 }
 
 func ProvideNoOpMetricServer() http.ListenAndServeServer {
@@ -48,7 +50,18 @@ func NewSystem(
 	resolverManager *resolver.Manager,
 	services services.Services,
 	metricServer http.ListenAndServeServer,
+	healthServer *http.HealthServer,
+	diagnosticsServer *DiagnosticsServer,
 ) *System {
+	var health http.ListenAndServeServer = http.NoOpListenAndServeServer{}
+	if healthServer != nil {
+		health = healthServer
+	}
+	var diagnostics http.ListenAndServeServer = http.NoOpListenAndServeServer{}
+	if diagnosticsServer != nil {
+		diagnostics = diagnosticsServer
+	}
+
 	return &System{
 		bootstrap:       bootstrap,
 		server:          server,
@@ -57,5 +70,7 @@ func NewSystem(
 		resolverManager: resolverManager,
 		services:        services,
 		metricServer:    metricServer,
+		healthServer:    health,
+		diagnostics:     diagnostics,
 	}
 }
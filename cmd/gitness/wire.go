@@ -154,6 +154,22 @@ import (
 	gopackageutils "github.com/harness/gitness/registry/app/utils/gopackage"
 	registryhandlers "github.com/harness/gitness/registry/job"
 	registryindex "github.com/harness/gitness/registry/services/asyncprocessing"
+	registryconcurrency "github.com/harness/gitness/registry/services/concurrency"
+	registrydeletion "github.com/harness/gitness/registry/services/deletion"
+	registrydeletionapproval "github.com/harness/gitness/registry/services/deletionapproval"
+	registrydenylist "github.com/harness/gitness/registry/services/denylist"
+	registryfirewalldelay "github.com/harness/gitness/registry/services/firewalldelay"
+	registrynotification "github.com/harness/gitness/registry/services/notification"
+	registryoutbox "github.com/harness/gitness/registry/services/outbox"
+	recentactivity "github.com/harness/gitness/registry/services/recentactivity"
+	registryjob "github.com/harness/gitness/registry/services/registryjob"
+	registrystats "github.com/harness/gitness/registry/services/registrystats"
+	registryusage "github.com/harness/gitness/registry/services/registryusage"
+	registryreindexing "github.com/harness/gitness/registry/services/reindexing"
+	registrytagpublish "github.com/harness/gitness/registry/services/tagpublish"
+	registrytrash "github.com/harness/gitness/registry/services/trash"
+	registryupstreamprovenance "github.com/harness/gitness/registry/services/upstreamprovenance"
+	registryvulnerability "github.com/harness/gitness/registry/services/vulnerability"
 	registrywebhooks "github.com/harness/gitness/registry/services/webhook"
 	"github.com/harness/gitness/ssh"
 	"github.com/harness/gitness/store/database/dbtx"
@@ -184,6 +200,8 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 		services.ProvideGitspaceServices,
 		server.WireSet,
 		cliserver.ProvideNoOpMetricServer,
+		cliserver.ProvideHealthServer,
+		cliserver.ProvideDiagnosticsServer,
 		url.WireSet,
 		spaceSvc.ProvideNoopResourceMover,
 		spaceSvc.WireSet,
@@ -323,6 +341,22 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 		usage.WireSet,
 		registryevents.WireSet,
 		registrywebhooks.WireSet,
+		registrytrash.WireSet,
+		registrynotification.WireSet,
+		registryoutbox.WireSet,
+		registryconcurrency.WireSet,
+		registryjob.WireSet,
+		registryusage.WireSet,
+		recentactivity.WireSet,
+		registrydeletionapproval.WireSet,
+		registrydeletion.WireSet,
+		registrydenylist.WireSet,
+		registryfirewalldelay.WireSet,
+		registryupstreamprovenance.WireSet,
+		registryvulnerability.WireSet,
+		registrystats.WireSet,
+		registryreindexing.WireSet,
+		registrytagpublish.WireSet,
 		gitspacedeleteevents.WireSet,
 		gitspacedeleteeventservice.WireSet,
 		registryindex.WireSet,
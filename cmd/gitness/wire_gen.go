@@ -140,6 +140,7 @@ import (
 	"github.com/harness/gitness/pubsub"
 	api2 "github.com/harness/gitness/registry/app/api"
 	cargo3 "github.com/harness/gitness/registry/app/api/controller/pkg/cargo"
+	"github.com/harness/gitness/registry/app/api/controller/pkg/content"
 	"github.com/harness/gitness/registry/app/api/controller/pkg/generic"
 	gopackage2 "github.com/harness/gitness/registry/app/api/controller/pkg/gopackage"
 	huggingface2 "github.com/harness/gitness/registry/app/api/controller/pkg/huggingface"
@@ -157,6 +158,7 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/base"
 	cargo2 "github.com/harness/gitness/registry/app/pkg/cargo"
 	"github.com/harness/gitness/registry/app/pkg/docker"
+	"github.com/harness/gitness/registry/app/pkg/failedupload"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	generic2 "github.com/harness/gitness/registry/app/pkg/generic"
 	"github.com/harness/gitness/registry/app/pkg/gopackage"
@@ -170,6 +172,7 @@ import (
 	"github.com/harness/gitness/registry/app/services/hook"
 	publicaccess2 "github.com/harness/gitness/registry/app/services/publicaccess"
 	refcache2 "github.com/harness/gitness/registry/app/services/refcache"
+	"github.com/harness/gitness/registry/app/services/registrypolicy"
 	storage2 "github.com/harness/gitness/registry/app/storage"
 	cache2 "github.com/harness/gitness/registry/app/store/cache"
 	database2 "github.com/harness/gitness/registry/app/store/database"
@@ -178,6 +181,22 @@ import (
 	"github.com/harness/gitness/registry/gc"
 	job2 "github.com/harness/gitness/registry/job"
 	asyncprocessing2 "github.com/harness/gitness/registry/services/asyncprocessing"
+	"github.com/harness/gitness/registry/services/concurrency"
+	"github.com/harness/gitness/registry/services/deletion"
+	"github.com/harness/gitness/registry/services/deletionapproval"
+	"github.com/harness/gitness/registry/services/denylist"
+	"github.com/harness/gitness/registry/services/firewalldelay"
+	notification2 "github.com/harness/gitness/registry/services/notification"
+	"github.com/harness/gitness/registry/services/outbox"
+	"github.com/harness/gitness/registry/services/recentactivity"
+	"github.com/harness/gitness/registry/services/registryjob"
+	"github.com/harness/gitness/registry/services/registrystats"
+	"github.com/harness/gitness/registry/services/registryusage"
+	"github.com/harness/gitness/registry/services/reindexing"
+	"github.com/harness/gitness/registry/services/tagpublish"
+	"github.com/harness/gitness/registry/services/trash"
+	"github.com/harness/gitness/registry/services/upstreamprovenance"
+	"github.com/harness/gitness/registry/services/vulnerability"
 	webhook3 "github.com/harness/gitness/registry/services/webhook"
 	"github.com/harness/gitness/ssh"
 	"github.com/harness/gitness/store/database/dbtx"
@@ -224,12 +243,13 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	repoIDCache := cache.ProvideRepoIDCache(ctx, repoStore, evictor, cacheEvictor)
 	repoRefCache := cache.ProvideRepoRefCache(ctx, repoStore, evictor, cacheEvictor)
 	repoFinder := refcache.ProvideRepoFinder(repoStore, spacePathCache, repoIDCache, repoRefCache, cacheEvictor)
-	mediaTypesRepository := database2.ProvideMediaTypeDao(db)
-	registryRepository := database2.ProvideRegistryDao(db, mediaTypesRepository)
+	limits := database2.ProvideQueryLimits(config)
+	mediaTypesRepository := database2.ProvideMediaTypeDao(db, limits)
+	registryRepository := database2.ProvideRegistryDao(db, mediaTypesRepository, limits)
 	evictor2 := cache2.ProvideEvictorRegistryCore(pubSub)
 	registryIDCache := cache2.ProvideRegistryIDCache(ctx, registryRepository, evictor2)
 	registryRootRefCache := cache2.ProvideRegRootRefCache(ctx, registryRepository, evictor2)
-	upstreamProxyConfigRepository := database2.ProvideUpstreamDao(db, registryRepository, spaceFinder)
+	upstreamProxyConfigRepository := database2.ProvideUpstreamDao(db, registryRepository, spaceFinder, limits)
 	evictor3 := cache2.ProvideEvictorUpstreamProxy(pubSub)
 	upstreamProxyRegistryIDCache := cache2.ProvideUpstreamProxyRegistryIDCache(ctx, upstreamProxyConfigRepository, evictor3)
 	upstreamProxyFinder := refcache2.ProvideUpstreamProxyFinder(upstreamProxyConfigRepository, upstreamProxyRegistryIDCache, evictor3)
@@ -546,7 +566,7 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	if err != nil {
 		return nil, err
 	}
-	githookController := githook.ProvideController(authorizer, principalStore, repoStore, repoFinder, reporter9, eventsReporter, gitInterface, pullReqStore, provider, protectionManager, clientFactory, resourceLimiter, settingsService, preReceiveExtender, updateExtender, postReceiveExtender, streamer, lfsObjectStore, auditService, usergroupService)
+	githookController := githook.ProvideController(authorizer, principalStore, repoStore, repoFinder, reporter9, eventsReporter, gitInterface, pullReqStore, provider, protectionManager, clientFactory, resourceLimiter, settingsService, preReceiveExtender, updateExtender, postReceiveExtender, streamer, lfsObjectStore, auditService, usergroupService, signatureVerifyService)
 	serviceaccountController := serviceaccount.NewController(principalUID, authorizer, principalStore, spaceStore, repoStore, tokenStore)
 	principalController := principal.ProvideController(principalStore, authorizer)
 	usergroupController := usergroup2.ProvideController(userGroupStore, spaceStore, spaceFinder, authorizer, usergroupService)
@@ -568,7 +588,7 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	migrateLabel := migrate.ProvideLabelImporter(transactor, labelStore, labelValueStore, spaceStore)
 	migrateController := migrate2.ProvideController(authorizer, publicaccessService, gitInterface, provider, pullReq, rule, migrateWebhook, migrateLabel, resourceLimiter, auditService, repoIdentifier, transactor, spaceStore, repoStore, spaceFinder, repoFinder, eventsReporter)
 	openapiService := openapi.ProvideOpenAPIService()
-	blobRepository := database2.ProvideBlobDao(db, mediaTypesRepository)
+	blobRepository := database2.ProvideBlobDao(db, mediaTypesRepository, limits)
 	storageDriver, err := api2.DefaultStorageProvider(ctx, config)
 	if err != nil {
 		return nil, err
@@ -579,59 +599,94 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	ociBlobStoreFactory := docker.ProvideOciBlobStore(storageService)
 	bucketService := docker.ProvideBucketService(ociBlobStoreFactory)
 	app := docker.NewApp(ctx, blobRepository, spaceStore, config, storageService, storageResolver, gcService, bucketService)
-	manifestRepository := database2.ProvideManifestDao(db, mediaTypesRepository)
-	manifestReferenceRepository := database2.ProvideManifestRefDao(db)
-	tagRepository := database2.ProvideTagDao(db)
-	imageRepository := database2.ProvideImageDao(db)
-	artifactRepository := database2.ProvideArtifactDao(db)
-	layerRepository := database2.ProvideLayerDao(db, mediaTypesRepository)
+	manifestRepository := database2.ProvideManifestDao(db, mediaTypesRepository, limits)
+	manifestReferenceRepository := database2.ProvideManifestRefDao(db, limits)
+	tagRepository := database2.ProvideTagDao(db, limits)
+	imageRepository := database2.ProvideImageDao(db, limits)
+	artifactRepository := database2.ProvideArtifactDao(db, transactor, limits)
+	layerRepository := database2.ProvideLayerDao(db, mediaTypesRepository, limits)
 	eventReporter := docker.ProvideReporter()
-	ociImageIndexMappingRepository := database2.ProvideOCIImageIndexMappingDao(db)
+	ociImageIndexMappingRepository := database2.ProvideOCIImageIndexMappingDao(db, limits)
 	artifactReporter, err := artifact.ProvideArtifactReporter(eventsSystem)
 	if err != nil {
 		return nil, err
 	}
-	manifestService := docker.ManifestServiceProvider(registryRepository, manifestRepository, blobRepository, mediaTypesRepository, manifestReferenceRepository, tagRepository, imageRepository, artifactRepository, layerRepository, gcService, transactor, eventReporter, spaceFinder, ociImageIndexMappingRepository, artifactReporter, provider, auditService)
-	registryBlobRepository := database2.ProvideRegistryBlobDao(db)
-	bandwidthStatRepository := database2.ProvideBandwidthStatDao(db)
-	downloadStatRepository := database2.ProvideDownloadStatDao(db)
-	quarantineArtifactRepository := database2.ProvideQuarantineArtifactDao(db)
+	eventOutboxRepository := database2.ProvideEventOutboxDao(db, limits)
+	outboxOutbox := outbox.ProvideOutbox(eventOutboxRepository, artifactReporter, auditService)
+	manifestService := docker.ManifestServiceProvider(registryRepository, manifestRepository, blobRepository, mediaTypesRepository, manifestReferenceRepository, tagRepository, imageRepository, artifactRepository, layerRepository, gcService, transactor, eventReporter, spaceFinder, ociImageIndexMappingRepository, provider, auditService, outboxOutbox)
+	registryBlobRepository := database2.ProvideRegistryBlobDao(db, limits)
+	bandwidthStatRepository := database2.ProvideBandwidthStatDao(db, limits)
+	registrypolicyService := registrypolicy.ProvideService(settingsService, spaceFinder)
+	downloadStatModeResolver := registrypolicy.ProvideDownloadStatModes(registrypolicyService, registryFinder)
+	downloadStatRepository := database2.ProvideDownloadStatDao(db, downloadStatModeResolver, limits)
+	recentActivityRepository := database2.ProvideRecentActivityDao(db, limits)
+	recentactivityService := recentactivity.ProvideService(recentActivityRepository, downloadStatModeResolver)
+	deletionRequestRepository := database2.ProvideDeletionRequestDao(db, limits)
+	deletionapprovalService := deletionapproval.ProvideService(deletionRequestRepository, registrypolicyService, config)
+	scheduledDeletionRepository := database2.ProvideScheduledDeletionDao(db, limits)
+	deletionService := deletion.ProvideService(scheduledDeletionRepository, principalStore, config)
+	quarantineArtifactRepository := database2.ProvideQuarantineArtifactDao(db, limits)
 	replicationReporter, err := replication.ProvideNoOpReplicationReporter()
 	if err != nil {
 		return nil, err
 	}
-	blobActionHook := hook.ProvideBlobCommitHook()
+	compositeBlobActionHook := hook.ProvideBlobActionHookRegistry()
+	blobActionHook := hook.ProvideBlobCommitHook(compositeBlobActionHook)
 	localRegistry := docker.LocalRegistryProvider(app, manifestService, blobRepository, registryRepository, registryFinder, manifestRepository, registryBlobRepository, mediaTypesRepository, tagRepository, imageRepository, artifactRepository, bandwidthStatRepository, downloadStatRepository, gcService, transactor, quarantineArtifactRepository, replicationReporter, blobActionHook)
+	dockerImporter := docker.ImporterProvider(localRegistry, config)
+	exporter := docker.ExporterProvider(localRegistry)
 	proxyController := docker.ProvideProxyController(localRegistry, manifestService, secretService, spaceFinder)
 	remoteRegistry := docker.RemoteRegistryProvider(localRegistry, app, upstreamProxyConfigRepository, spaceFinder, secretService, proxyController)
-	quarantineService := quarantine.ProvideService(quarantineArtifactRepository, manifestRepository)
+	quarantineAccessAttemptRepository := database2.ProvideQuarantineAccessAttemptDao(db, limits)
+	quarantineService := quarantine.ProvideService(quarantineArtifactRepository, manifestRepository, quarantineAccessAttemptRepository)
 	evictor4 := quarantine.ProvideEvictorQuarantine(pubSub)
 	cache3 := quarantine.ProvideQuarantineCache(ctx, quarantineService, evictor4)
 	finder := quarantine.ProvideFinder(quarantineService, cache3, evictor4)
-	coreController := pkg.CoreControllerProvider(registryRepository, finder)
+	packageDenylistEntryRepository := database2.ProvidePackageDenylistEntryDao(db, limits)
+	packageDenylistOverrideRepository := database2.ProvidePackageDenylistOverrideDao(db, limits)
+	denylistService := denylist.ProvideService(packageDenylistEntryRepository, packageDenylistOverrideRepository, spaceFinder)
+	vulnerabilityRepository := database2.ProvideVulnerabilityDao(db, limits)
+	vulnerabilityService := vulnerability.ProvideService(vulnerabilityRepository, registryRepository, quarantineArtifactRepository, finder, registrypolicyService, config)
+	dependencyFirewallChecker := denylist.ProvideFirewallChecker(denylistService, registryFinder)
+	coreController := pkg.CoreControllerProvider(registryRepository, finder, dependencyFirewallChecker, denylistService)
 	dbStore := docker.DBStoreProvider(blobRepository, imageRepository, artifactRepository, bandwidthStatRepository, downloadStatRepository, manifestRepository, quarantineArtifactRepository)
 	dockerController := docker.ControllerProvider(localRegistry, remoteRegistry, coreController, spaceStore, authorizer, dbStore, spaceFinder)
 	evictor5 := publicaccess2.ProvideEvictorPublicAccess(pubSub)
 	publicaccessCache := publicaccess2.ProvidePublicAccessCache(ctx, publicaccessService, evictor5)
 	cacheService := publicaccess2.ProvideRegistryPublicAccess(publicaccessService, publicaccessCache, evictor5)
-	handler := api2.NewHandlerProvider(dockerController, spaceFinder, spaceStore, tokenStore, controller, authenticator, provider, authorizer, config, registryFinder, cacheService, auditService)
+	handler := api2.NewHandlerProvider(dockerController, spaceFinder, spaceStore, tokenStore, controller, authenticator, provider, authorizer, config, registryFinder, cacheService, auditService, recentactivityService)
 	registryOCIHandler := router.OCIHandlerProvider(handler)
-	genericBlobRepository := database2.ProvideGenericBlobDao(db)
-	nodesRepository := database2.ProvideNodeDao(db)
+	genericBlobRepository := database2.ProvideGenericBlobDao(db, limits)
+	nodesRepository := database2.ProvideNodeDao(db, limits)
 	fileManager := filemanager.Provider(registryRepository, genericBlobRepository, nodesRepository, transactor, config, storageService, bucketService, replicationReporter, blobActionHook)
-	cleanupPolicyRepository := database2.ProvideCleanupPolicyDao(db, transactor)
+	failedUploadRepository := database2.ProvideFailedUploadDao(db, limits)
+	uploadFailureStatsRepository := database2.ProvideUploadFailureStatsDao(db, limits)
+	recorder := failedupload.ProvideRecorder(config, fileManager, failedUploadRepository, uploadFailureStatsRepository)
+	concurrencyLimiter := concurrency.ProvideLimiter(config)
+	registryJobRepository := database2.ProvideRegistryJobDao(db, limits)
+	registryjobService, err := registryjob.ProvideService(jobScheduler, executor, registryJobRepository)
+	if err != nil {
+		return nil, err
+	}
+	registryUsageSnapshotRepository := database2.ProvideRegistryUsageSnapshotDao(db, limits)
+	registryusageService := registryusage.ProvideService(registryRepository, bandwidthStatRepository, registryUsageSnapshotRepository, spaceFinder)
+	cleanupPolicyRepository := database2.ProvideCleanupPolicyDao(db, transactor, limits)
 	accessor := dbtx.ProvideAccessor(accessorTx)
-	webhooksRepository := database2.ProvideWebhookDao(db)
-	webhooksExecutionRepository := database2.ProvideWebhookExecutionDao(db)
+	webhooksRepository := database2.ProvideWebhookDao(db, limits)
+	webhooksExecutionRepository := database2.ProvideWebhookExecutionDao(db, limits)
 	readerFactory3, err := artifact.ProvideReaderFactory(eventsSystem)
 	if err != nil {
 		return nil, err
 	}
-	service3, err := webhook3.ProvideService(ctx, webhookConfig, transactor, readerFactory3, webhooksRepository, webhooksExecutionRepository, spaceStore, provider, principalStore, urlProvider, spacePathStore, secretService, registryRepository, encrypter, spaceFinder)
+	mailerMailer := mailer.ProvideMailClient(config)
+	notificationChannelRepository := database2.ProvideNotificationChannelDao(db, limits)
+	dispatcher := notification2.ProvideDispatcher(webhookConfig, notificationChannelRepository, mailerMailer, encrypter)
+	payloadSealer := webhook3.ProvidePayloadSealer(config, encrypter)
+	service3, err := webhook3.ProvideService(ctx, webhookConfig, transactor, readerFactory3, webhooksRepository, webhooksExecutionRepository, spaceStore, provider, principalStore, urlProvider, spacePathStore, secretService, registryRepository, encrypter, spaceFinder, manifestRepository, bandwidthStatRepository, registrypolicyService, dispatcher, payloadSealer)
 	if err != nil {
 		return nil, err
 	}
-	taskRepository := database2.ProvideTaskRepository(db, transactor)
+	taskRepository := database2.ProvideTaskRepository(db, transactor, limits)
 	taskSourceRepository := database2.ProvideTaskSourceRepository(db, transactor)
 	taskEventRepository := database2.ProvideTaskEventRepository(db)
 	asyncprocessingReporter, err := asyncprocessing.ProvideAsyncProcessingReporter(transactor, eventsSystem, taskRepository, taskSourceRepository, taskEventRepository)
@@ -641,41 +696,52 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	registryHelper := cargo.LocalRegistryHelperProvider(fileManager, artifactRepository, spaceFinder)
 	interfacesRegistryHelper := helpers.ProvideRegistryHelper(artifactRepository, fileManager, imageRepository, artifactReporter, asyncprocessingReporter, transactor, provider, config)
 	packageWrapper := helpers.ProvidePackageWrapperProvider(interfacesRegistryHelper, registryFinder, registryHelper)
-	apiHandler := router.APIHandlerProvider(registryRepository, upstreamProxyConfigRepository, fileManager, tagRepository, manifestRepository, cleanupPolicyRepository, imageRepository, spaceFinder, transactor, accessor, authenticator, provider, authorizer, auditService, artifactRepository, webhooksRepository, webhooksExecutionRepository, service3, spacePathStore, artifactReporter, downloadStatRepository, config, registryBlobRepository, registryFinder, asyncprocessingReporter, registryHelper, spaceController, quarantineArtifactRepository, spaceStore, packageWrapper, cacheService, finder, storageService, app)
-	packageTagRepository := database2.ProvidePackageTagDao(db)
-	localBase := base.LocalBaseProvider(registryRepository, registryFinder, fileManager, transactor, imageRepository, artifactRepository, nodesRepository, packageTagRepository, authorizer, spaceFinder, auditService)
+	trashService := trash.ProvideService(transactor, manifestRepository, tagRepository, artifactRepository, imageRepository, gcService, config)
+	indexBuildRepository := database2.ProvideIndexBuildDao(db, limits)
+	artifactMetadataHistoryRepository := database2.ProvideArtifactMetadataHistoryDao(db, limits)
+	artifactProvenanceRepository := database2.ProvideArtifactProvenanceDao(db, limits)
+	artifactSearchRepository := database2.ProvideArtifactSearchDao(db, limits)
+	firewallApprovalRepository := database2.ProvideFirewallApprovalDao(db, limits)
+	firewalldelayService := firewalldelay.ProvideService(firewallApprovalRepository)
+	upstreamProvenanceRepository := database2.ProvideUpstreamProvenanceDao(db, limits)
+	upstreamprovenanceService := upstreamprovenance.ProvideService(artifactRepository, upstreamProvenanceRepository)
+	garbageRepository := database2.ProvideGarbageDao(db, limits)
+	imageDescriptionRepository := database2.ProvideImageDescriptionDao(db, limits)
+	imageStarRepository := database2.ProvideImageStarDao(db, limits)
+	apiHandler := router.APIHandlerProvider(registryRepository, upstreamProxyConfigRepository, fileManager, tagRepository, manifestRepository, cleanupPolicyRepository, imageRepository, spaceFinder, transactor, accessor, authenticator, provider, authorizer, auditService, artifactRepository, webhooksRepository, webhooksExecutionRepository, service3, spacePathStore, artifactReporter, downloadStatRepository, config, registryBlobRepository, registryFinder, asyncprocessingReporter, registryHelper, spaceController, quarantineArtifactRepository, spaceStore, packageWrapper, cacheService, finder, storageService, registrypolicyService, indexBuildRepository, app, trashService, artifactMetadataHistoryRepository, garbageRepository, notificationChannelRepository, dispatcher, imageDescriptionRepository, outboxOutbox, failedUploadRepository, uploadFailureStatsRepository, concurrencyLimiter, registryJobRepository, registryjobService, registryusageService, imageStarRepository, recentactivityService, deletionapprovalService, deletionService, quarantineAccessAttemptRepository, denylistService, vulnerabilityService, artifactProvenanceRepository, dockerImporter, exporter, artifactSearchRepository, firewalldelayService, upstreamprovenanceService)
+	packageTagRepository := database2.ProvidePackageTagDao(db, limits)
+	localBase := base.LocalBaseProvider(registryRepository, registryFinder, fileManager, transactor, imageRepository, artifactRepository, nodesRepository, packageTagRepository, authorizer, spaceFinder, auditService, denylistService, config)
 	mavenDBStore := maven.DBStoreProvider(registryRepository, imageRepository, artifactRepository, spaceStore, bandwidthStatRepository, downloadStatRepository, nodesRepository, upstreamProxyConfigRepository)
 	mavenLocalRegistry := maven.LocalRegistryProvider(localBase, mavenDBStore, transactor, fileManager)
 	mavenController := maven.ProvideProxyController(mavenLocalRegistry, secretService, spaceFinder)
 	mavenRemoteRegistry := maven.RemoteRegistryProvider(mavenDBStore, transactor, mavenLocalRegistry, mavenController)
-	dependencyFirewallChecker := helpers.NewNoopDependencyFirewallChecker()
-	controller2 := maven.ControllerProvider(mavenLocalRegistry, mavenRemoteRegistry, authorizer, mavenDBStore, spaceFinder, finder, dependencyFirewallChecker)
-	mavenHandler := api2.NewMavenHandlerProvider(controller2, spaceStore, tokenStore, controller, authenticator, authorizer, spaceFinder, registryFinder, cacheService, auditService)
+	controller2 := maven.ControllerProvider(mavenLocalRegistry, mavenRemoteRegistry, authorizer, mavenDBStore, spaceFinder, finder, dependencyFirewallChecker, denylistService)
+	mavenHandler := api2.NewMavenHandlerProvider(controller2, spaceStore, tokenStore, controller, authenticator, authorizer, spaceFinder, registryFinder, cacheService, auditService, recentactivityService)
 	handler2 := router.MavenHandlerProvider(mavenHandler)
 	genericDBStore := generic.DBStoreProvider(imageRepository, artifactRepository, bandwidthStatRepository, downloadStatRepository, registryRepository)
 	genericLocalRegistry := generic2.LocalRegistryProvider(localBase, fileManager, upstreamProxyConfigRepository, transactor, registryRepository, imageRepository, artifactRepository, provider)
 	localRegistryHelper := generic2.LocalRegistryHelperProvider(genericLocalRegistry, localBase)
 	proxy := generic2.ProxyProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, spaceFinder, secretService, localRegistryHelper)
 	genericController := generic.ControllerProvider(spaceStore, authorizer, fileManager, genericDBStore, transactor, spaceFinder, genericLocalRegistry, proxy, finder, dependencyFirewallChecker, auditService)
-	packagesHandler := api2.NewPackageHandlerProvider(registryRepository, downloadStatRepository, bandwidthStatRepository, spaceStore, tokenStore, controller, authenticator, provider, authorizer, spaceFinder, registryFinder, fileManager, finder, packageWrapper, auditService, artifactRepository)
-	genericHandler := api2.NewGenericHandlerProvider(spaceStore, genericController, tokenStore, controller, authenticator, provider, authorizer, packagesHandler, spaceFinder, registryFinder, auditService)
+	packagesHandler := api2.NewPackageHandlerProvider(registryRepository, downloadStatRepository, bandwidthStatRepository, spaceStore, tokenStore, controller, authenticator, provider, authorizer, spaceFinder, registryFinder, fileManager, finder, packageWrapper, auditService, artifactRepository, recentactivityService)
+	genericHandler := api2.NewGenericHandlerProvider(spaceStore, genericController, tokenStore, controller, authenticator, provider, authorizer, packagesHandler, spaceFinder, registryFinder, auditService, recentactivityService)
 	handler3 := router.GenericHandlerProvider(genericHandler)
 	pythonLocalRegistry := python.LocalRegistryProvider(localBase, fileManager, upstreamProxyConfigRepository, transactor, registryRepository, registryFinder, imageRepository, artifactRepository, provider)
 	pythonLocalRegistryHelper := python.LocalRegistryHelperProvider(pythonLocalRegistry, localBase)
-	pythonProxy := python.ProxyProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, spaceFinder, secretService, pythonLocalRegistryHelper)
+	pythonProxy := python.ProxyProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, spaceFinder, secretService, pythonLocalRegistryHelper, firewalldelayService, upstreamprovenanceService)
 	pythonController := python2.ControllerProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, pythonLocalRegistry, pythonProxy, finder, dependencyFirewallChecker)
 	pythonHandler := api2.NewPythonHandlerProvider(pythonController, packagesHandler)
-	nugetLocalRegistry := nuget.LocalRegistryProvider(localBase, fileManager, upstreamProxyConfigRepository, transactor, registryRepository, imageRepository, artifactRepository, provider)
+	nugetLocalRegistry := nuget.LocalRegistryProvider(localBase, fileManager, upstreamProxyConfigRepository, transactor, registryRepository, imageRepository, artifactRepository, provider, recorder)
 	nugetLocalRegistryHelper := nuget.LocalRegistryHelperProvider(nugetLocalRegistry, localBase)
 	nugetProxy := nuget.ProxyProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, spaceFinder, secretService, nugetLocalRegistryHelper)
 	nugetController := nuget2.ControllerProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, nugetLocalRegistry, nugetProxy, finder, dependencyFirewallChecker)
 	nugetHandler := api2.NewNugetHandlerProvider(nugetController, packagesHandler)
 	npmLocalRegistry := npm.LocalRegistryProvider(localBase, fileManager, upstreamProxyConfigRepository, transactor, packageTagRepository, registryRepository, imageRepository, artifactRepository, nodesRepository, provider)
 	npmLocalRegistryHelper := npm.LocalRegistryHelperProvider(npmLocalRegistry, localBase)
-	npmProxy := npm.ProxyProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, spaceFinder, secretService, npmLocalRegistryHelper)
+	npmProxy := npm.ProxyProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, spaceFinder, secretService, npmLocalRegistryHelper, firewalldelayService, upstreamprovenanceService)
 	npmController := npm2.ControllerProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, downloadStatRepository, provider, npmLocalRegistry, npmProxy, finder, dependencyFirewallChecker)
 	npmHandler := api2.NewNPMHandlerProvider(npmController, packagesHandler)
-	rpmRegistryHelper := rpm.RegistryHelperProvider(localBase, fileManager, asyncprocessingReporter)
+	rpmRegistryHelper := rpm.RegistryHelperProvider(localBase, fileManager, asyncprocessingReporter, recorder)
 	rpmLocalRegistry := rpm.LocalRegistryProvider(localBase, fileManager, upstreamProxyConfigRepository, transactor, registryRepository, imageRepository, artifactRepository, provider, rpmRegistryHelper)
 	rpmProxy := rpm.ProxyProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, localBase, rpmRegistryHelper, spaceFinder, secretService)
 	rpmController := rpm2.ControllerProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, rpmLocalRegistry, rpmProxy, asyncprocessingReporter, dependencyFirewallChecker)
@@ -693,8 +759,11 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	huggingfaceLocalRegistry := huggingface.LocalRegistryProvider(localBase, fileManager, upstreamProxyConfigRepository, transactor, registryRepository, imageRepository, artifactRepository, provider)
 	huggingfaceController := huggingface2.ProvideController(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, huggingfaceLocalRegistry, finder)
 	huggingfaceHandler := huggingface3.ProvideHandler(huggingfaceController, packagesHandler)
-	handler4 := router.PackageHandlerProvider(packagesHandler, mavenHandler, genericHandler, pythonHandler, nugetHandler, npmHandler, rpmHandler, cargoHandler, gopackageHandler, huggingfaceHandler, spaceFinder, cacheService)
-	appRouter := router.AppRouterProvider(registryOCIHandler, apiHandler, handler2, handler3, handler4)
+	contentController := content.ControllerProvider(spaceFinder, registryFinder, authorizer, genericBlobRepository, nodesRepository, blobRepository, registryBlobRepository, fileManager, storageService)
+	contentHandler := api2.NewContentHandlerProvider(contentController, packagesHandler)
+	handler4 := router.PackageHandlerProvider(packagesHandler, mavenHandler, genericHandler, pythonHandler, nugetHandler, npmHandler, rpmHandler, cargoHandler, gopackageHandler, huggingfaceHandler, contentHandler, spaceFinder, cacheService)
+	inFlightTracker := router.InFlightTrackerProvider()
+	appRouter := router.AppRouterProvider(registryOCIHandler, apiHandler, handler2, handler3, handler4, inFlightTracker)
 	readerFactory4, err := events3.ProvideReaderFactory(eventsSystem)
 	if err != nil {
 		return nil, err
@@ -753,7 +822,6 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	if err != nil {
 		return nil, err
 	}
-	mailerMailer := mailer.ProvideMailClient(config)
 	notificationClient := notification.ProvideMailClient(mailerMailer)
 	notificationConfig := server.ProvideNotificationConfig(config)
 	notificationService, err := notification.ProvideNotificationService(ctx, notificationClient, notificationConfig, eventsReaderFactory, pullReqStore, repoStore, principalInfoView, principalInfoCache, pullReqReviewerStore, pullReqActivityStore, spacePathStore, provider)
@@ -829,7 +897,7 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 		return nil, err
 	}
 	asyncprocessingConfig := asyncprocessing2.ProvideRegistryPostProcessingConfig(config)
-	asyncprocessingService, err := asyncprocessing2.ProvideService(ctx, transactor, rpmHelper, registryHelper, gopackageRegistryHelper, lockerLocker, readerFactory12, asyncprocessingConfig, registryRepository, taskRepository, taskSourceRepository, taskEventRepository, eventsSystem, asyncprocessingReporter, packageWrapper)
+	asyncprocessingService, err := asyncprocessing2.ProvideService(ctx, transactor, rpmHelper, registryHelper, gopackageRegistryHelper, lockerLocker, readerFactory12, asyncprocessingConfig, registryRepository, taskRepository, taskSourceRepository, taskEventRepository, indexBuildRepository, eventsSystem, asyncprocessingReporter, packageWrapper, concurrencyLimiter)
 	if err != nil {
 		return nil, err
 	}
@@ -841,8 +909,70 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	if err != nil {
 		return nil, err
 	}
-	servicesServices := services.ProvideServices(webhookService, pullreqService, triggerService, jobScheduler, collectorJob, sizeCalculator, repoService, cleanupService, notificationService, keywordsearchService, gitspaceServices, instrumentService, consumer, repositoryCount, service3, branchService, asyncprocessingService, jobRpmRegistryIndex, languageAnalyzer)
+	jobTrashPurge, err := job2.ProvideJobTrashPurge(config, jobScheduler, executor, trashService)
+	if err != nil {
+		return nil, err
+	}
+	jobGarbageMetrics, err := job2.ProvideJobGarbageMetrics(config, jobScheduler, executor, garbageRepository, spaceFinder)
+	if err != nil {
+		return nil, err
+	}
+	jobEventOutbox, err := job2.ProvideJobEventOutbox(config, jobScheduler, executor, outboxOutbox)
+	if err != nil {
+		return nil, err
+	}
+	jobFailedUploadsPurge, err := job2.ProvideJobFailedUploadsPurge(config, jobScheduler, executor, recorder)
+	if err != nil {
+		return nil, err
+	}
+	jobUsageSnapshot, err := job2.ProvideJobUsageSnapshot(config, jobScheduler, executor, registryusageService)
+	if err != nil {
+		return nil, err
+	}
+	registrystatsConfig := registrystats.ProvideConfig(config)
+	registryStatsRepository := database2.ProvideRegistryStatsDao(db, limits)
+	registrystatsService, err := registrystats.ProvideService(ctx, registrystatsConfig, readerFactory3, registryStatsRepository)
+	if err != nil {
+		return nil, err
+	}
+	jobStatsRefresh, err := job2.ProvideJobStatsRefresh(config, jobScheduler, executor, registrystatsService)
+	if err != nil {
+		return nil, err
+	}
+	jobWebhookPayloadsPurge, err := job2.ProvideJobWebhookPayloadsPurge(config, jobScheduler, executor, webhooksExecutionRepository)
+	if err != nil {
+		return nil, err
+	}
+	jobScheduledDeletions, err := job2.ProvideJobScheduledDeletions(config, jobScheduler, executor, deletionService)
+	if err != nil {
+		return nil, err
+	}
+	jobVulnerabilitySync, err := job2.ProvideJobVulnerabilitySync(config, jobScheduler, executor, vulnerabilityService)
+	if err != nil {
+		return nil, err
+	}
+	jobSearchIndex, err := job2.ProvideJobSearchIndex(config, jobScheduler, executor, artifactSearchRepository)
+	if err != nil {
+		return nil, err
+	}
+	jobDownloadStatsRollup, err := job2.ProvideJobDownloadStatsRollup(config, jobScheduler, executor, transactor, downloadStatRepository)
+	if err != nil {
+		return nil, err
+	}
+	tagpublishConfig := tagpublish.ProvideConfig(config)
+	tagpublishService, err := tagpublish.ProvideService(ctx, tagpublishConfig, readerFactory, repoFinder, spaceFinder, registryFinder, principalStore, settingsService, authorizer, gitInterface, genericController)
+	if err != nil {
+		return nil, err
+	}
+	reindexingConfig := reindexing.ProvideConfig(config)
+	reindexingService, err := reindexing.ProvideService(ctx, reindexingConfig, readerFactory3, asyncprocessingReporter)
+	if err != nil {
+		return nil, err
+	}
+	servicesServices := services.ProvideServices(webhookService, pullreqService, triggerService, jobScheduler, collectorJob, sizeCalculator, repoService, cleanupService, notificationService, keywordsearchService, gitspaceServices, instrumentService, consumer, repositoryCount, service3, branchService, asyncprocessingService, jobRpmRegistryIndex, languageAnalyzer, jobTrashPurge, jobGarbageMetrics, jobEventOutbox, jobFailedUploadsPurge, tagpublishService, jobUsageSnapshot, jobStatsRefresh, jobWebhookPayloadsPurge, jobScheduledDeletions, jobVulnerabilitySync, jobSearchIndex, jobDownloadStatsRollup, reindexingService)
 	listenAndServeServer := server.ProvideNoOpMetricServer()
-	serverSystem := server.NewSystem(bootstrapBootstrap, serverServer, sshServer, poller, resolverManager, servicesServices, listenAndServeServer)
+	healthServer := server.ProvideHealthServer(config, db, storageDriver, universalClient)
+	diagnosticsServer := server.ProvideDiagnosticsServer(config, authenticator, inFlightTracker)
+	serverSystem := server.NewSystem(bootstrapBootstrap, serverServer, sshServer, poller, resolverManager, servicesServices, listenAndServeServer, healthServer, diagnosticsServer)
 	return serverSystem, nil
 }
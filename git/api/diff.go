@@ -645,8 +645,14 @@ func (g *Git) DiffFileName(ctx context.Context,
 	headRef string,
 	mergeBase bool,
 	ignoreWhitespace bool,
+	alternates ...string,
 ) ([]string, error) {
-	cmd := command.New("diff", command.WithFlag("--name-only"))
+	// without rename detection a renamed file is listed with both its old and its new name.
+	cmd := command.New("diff",
+		command.WithFlag("--name-only"),
+		command.WithFlag("--no-renames"),
+		command.WithAlternateObjectDirs(alternates...),
+	)
 	if mergeBase {
 		cmd.Add(command.WithFlag("--merge-base"))
 	}
@@ -79,17 +79,36 @@ type FindLFSPointersOutput struct {
 	Total    int64
 }
 
+// CountObjectsParams requests the number and total (uncompressed) size of all new objects of the push.
+type CountObjectsParams struct{}
+
+type CountObjectsOutput struct {
+	Count int64
+	Size  int64
+}
+
+// FindCommitsParams requests all new commits of the push, e.g. to verify their signatures.
+type FindCommitsParams struct{}
+
+type FindCommitsOutput struct {
+	Commits []Commit
+}
+
 type ProcessPreReceiveObjectsParams struct {
 	ReadParams
 	FindOversizeFilesParams     *FindOversizeFilesParams
 	FindCommitterMismatchParams *FindCommitterMismatchParams
 	FindLFSPointersParams       *FindLFSPointersParams
+	FindCommitsParams           *FindCommitsParams
+	CountObjectsParams          *CountObjectsParams
 }
 
 type ProcessPreReceiveObjectsOutput struct {
 	FindOversizeFilesOutput     *FindOversizeFilesOutput
 	FindCommitterMismatchOutput *FindCommitterMismatchOutput
 	FindLFSPointersOutput       *FindLFSPointersOutput
+	FindCommitsOutput           *FindCommitsOutput
+	CountObjectsOutput          *CountObjectsOutput
 }
 
 func (s *Service) ProcessPreReceiveObjects(
@@ -97,7 +116,8 @@ func (s *Service) ProcessPreReceiveObjects(
 	params ProcessPreReceiveObjectsParams,
 ) (ProcessPreReceiveObjectsOutput, error) {
 	if params.FindOversizeFilesParams == nil && params.FindCommitterMismatchParams == nil &&
-		params.FindLFSPointersParams == nil {
+		params.FindLFSPointersParams == nil && params.FindCommitsParams == nil &&
+		params.CountObjectsParams == nil {
 		return ProcessPreReceiveObjectsOutput{}, nil
 	}
 
@@ -126,6 +146,10 @@ func (s *Service) ProcessPreReceiveObjects(
 
 	var output ProcessPreReceiveObjectsOutput
 
+	if params.CountObjectsParams != nil {
+		output.CountObjectsOutput = countObjects(objects)
+	}
+
 	if params.FindOversizeFilesParams != nil {
 		output.FindOversizeFilesOutput = findOversizeFiles(
 			objects, params.FindOversizeFilesParams,
@@ -160,9 +184,34 @@ func (s *Service) ProcessPreReceiveObjects(
 
 		output.FindLFSPointersOutput = out
 	}
+
+	if params.FindCommitsParams != nil {
+		out, err := findCommits(
+			ctx,
+			objects,
+			repoPath,
+			params.ReadParams.AlternateObjectDirs,
+		)
+		if err != nil {
+			return ProcessPreReceiveObjectsOutput{}, err
+		}
+
+		output.FindCommitsOutput = out
+	}
 	return output, nil
 }
 
+func countObjects(objects []parser.BatchCheckObject) *CountObjectsOutput {
+	out := &CountObjectsOutput{
+		Count: int64(len(objects)),
+	}
+	for _, obj := range objects {
+		out.Size += obj.Size
+	}
+
+	return out
+}
+
 func findOversizeFiles(
 	objects []parser.BatchCheckObject,
 	findOversizeFilesParams *FindOversizeFilesParams,
@@ -257,6 +306,42 @@ func findCommitterMismatch(
 	}, nil
 }
 
+func findCommits(
+	ctx context.Context,
+	objects []parser.BatchCheckObject,
+	repoPath string,
+	alternateObjectDirs []string,
+) (*FindCommitsOutput, error) {
+	var commitSHAs []sha.SHA
+	for _, obj := range objects {
+		if obj.Type == string(TreeNodeTypeCommit) {
+			commitSHAs = append(commitSHAs, obj.SHA)
+		}
+	}
+
+	if len(commitSHAs) == 0 {
+		return &FindCommitsOutput{}, nil
+	}
+
+	gitCommits, err := api.CatFileCommits(ctx, repoPath, alternateObjectDirs, commitSHAs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read new commits: %w", err)
+	}
+
+	commits := make([]Commit, len(gitCommits))
+	for i := range gitCommits {
+		commit, err := mapCommit(&gitCommits[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to map commit: %w", err)
+		}
+		commits[i] = *commit
+	}
+
+	return &FindCommitsOutput{
+		Commits: commits,
+	}, nil
+}
+
 func (s *Service) findLFSPointers(
 	ctx context.Context,
 	objects []parser.BatchCheckObject,
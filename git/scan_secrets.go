@@ -89,10 +89,7 @@ func (s *Service) ScanSecrets(ctx context.Context, params *ScanSecretsParams) (*
 			return fmt.Errorf("failed to setup .gitleaksignore file in share repo: %w", err)
 		}
 
-		detector, err := detect.NewDetectorDefaultConfig()
-		if err != nil {
-			return fmt.Errorf("failed to create a new gitleaks detector with default config: %w", err)
-		}
+		detector := detect.NewDetector(s.secretScanningConfig)
 		if fsGitleaksIgnorePath != "" {
 			if err := detector.AddGitleaksIgnore(fsGitleaksIgnorePath); err != nil {
 				return fmt.Errorf("failed to load .gitleaksignore file from path %q: %w", fsGitleaksIgnorePath, err)
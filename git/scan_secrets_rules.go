@@ -0,0 +1,144 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+	gitleaksconfig "github.com/zricethezav/gitleaks/v8/config"
+)
+
+// SecretScanningRule is an additional rule used when scanning pushes for secrets.
+type SecretScanningRule struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	// Regex matches the secret, SecretGroup selects the group of the regex that holds the secret.
+	Regex       string `json:"regex"`
+	SecretGroup int    `json:"secret_group"`
+	// Entropy is the minimum shannon entropy of the secret, matches below it are ignored.
+	Entropy float64 `json:"entropy"`
+	// Keywords (optional) skip the regex for content which doesn't contain any of them.
+	Keywords []string `json:"keywords"`
+}
+
+// builtinSecretScanningRules detect credentials of package registries which aren't covered by
+// the default gitleaks rules. Harness access tokens (PAT or SAT) are covered by the default rules.
+var builtinSecretScanningRules = []SecretScanningRule{
+	{
+		ID:          "registry-docker-config-auth",
+		Description: "Identified a registry credential in a docker config file.",
+		Regex:       `"auth"\s*:\s*"([A-Za-z0-9+/]{16,}={0,2})"`,
+		SecretGroup: 1,
+		Entropy:     3,
+		Keywords:    []string{`"auth"`},
+	},
+	{
+		ID:          "registry-npmrc-auth",
+		Description: "Identified a registry credential in an npmrc file.",
+		Regex:       `//\S+/:_(?:authToken|auth|password)\s*=\s*([^\s$]\S{7,})`,
+		SecretGroup: 1,
+		Entropy:     3,
+		Keywords:    []string{"_authtoken", "_auth", "_password"},
+	},
+	{
+		ID:          "registry-url-credentials",
+		Description: "Identified credentials embedded in a registry URL.",
+		Regex:       `\bhttps?://[^\s:/@]+:([^\s:/@${}]{8,})@[^\s/]+`,
+		SecretGroup: 1,
+		Entropy:     3.5,
+		Keywords:    []string{"://"},
+	},
+}
+
+// parseSecretScanningRules parses a JSON list of secret scanning rules.
+func parseSecretScanningRules(raw string) ([]SecretScanningRule, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	var rules []SecretScanningRule
+	if err := json.Unmarshal([]byte(raw), &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse secret scanning rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// newSecretScanningConfig returns the default gitleaks config extended with the built-in and the provided rules.
+func newSecretScanningConfig(rules []SecretScanningRule) (gitleaksconfig.Config, error) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	if err := v.ReadConfig(strings.NewReader(gitleaksconfig.DefaultConfig)); err != nil {
+		return gitleaksconfig.Config{}, fmt.Errorf("failed to read default gitleaks config: %w", err)
+	}
+
+	var vc gitleaksconfig.ViperConfig
+	if err := v.Unmarshal(&vc); err != nil {
+		return gitleaksconfig.Config{}, fmt.Errorf("failed to unmarshal default gitleaks config: %w", err)
+	}
+
+	cfg, err := vc.Translate()
+	if err != nil {
+		return gitleaksconfig.Config{}, fmt.Errorf("failed to translate default gitleaks config: %w", err)
+	}
+
+	for _, r := range slices.Concat(builtinSecretScanningRules, rules) {
+		rule, err := r.toGitleaksRule()
+		if err != nil {
+			return gitleaksconfig.Config{}, err
+		}
+
+		if _, exists := cfg.Rules[rule.RuleID]; !exists {
+			cfg.OrderedRules = append(cfg.OrderedRules, rule.RuleID)
+		}
+		cfg.Rules[rule.RuleID] = rule
+		cfg.Keywords = append(cfg.Keywords, rule.Keywords...)
+	}
+
+	return cfg, nil
+}
+
+func (r SecretScanningRule) toGitleaksRule() (gitleaksconfig.Rule, error) {
+	regex, err := regexp.Compile(r.Regex)
+	if err != nil {
+		return gitleaksconfig.Rule{}, fmt.Errorf("invalid regex of secret scanning rule %q: %w", r.ID, err)
+	}
+
+	keywords := make([]string, len(r.Keywords))
+	for i, k := range r.Keywords {
+		keywords[i] = strings.ToLower(k)
+	}
+
+	rule := gitleaksconfig.Rule{
+		RuleID:      r.ID,
+		Description: r.Description,
+		Regex:       regex,
+		SecretGroup: r.SecretGroup,
+		Entropy:     r.Entropy,
+		Keywords:    keywords,
+		Tags:        []string{},
+	}
+	if err := rule.Validate(); err != nil {
+		return gitleaksconfig.Rule{}, fmt.Errorf("invalid secret scanning rule: %w", err)
+	}
+
+	return rule, nil
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/harness/gitness/git/hook"
 	"github.com/harness/gitness/git/storage"
 	"github.com/harness/gitness/git/types"
+
+	gitleaksconfig "github.com/zricethezav/gitleaks/v8/config"
 )
 
 const (
@@ -39,6 +41,8 @@ type Service struct {
 	store             storage.Store
 	gitHookPath       string
 	reposGraveyard    string
+
+	secretScanningConfig gitleaksconfig.Config
 }
 
 func New(
@@ -65,6 +69,16 @@ func New(
 		return nil, err
 	}
 
+	secretScanningRules, err := parseSecretScanningRules(config.SecretScanningRules)
+	if err != nil {
+		return nil, err
+	}
+
+	secretScanningConfig, err := newSecretScanningConfig(secretScanningRules)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Service{
 		reposRoot:         reposRoot,
 		sharedRepoRoot:    sharedRepoDir,
@@ -73,6 +87,8 @@ func New(
 		hookClientFactory: hookClientFactory,
 		store:             storage,
 		gitHookPath:       config.HookPath,
+
+		secretScanningConfig: secretScanningConfig,
 	}, nil
 }
 
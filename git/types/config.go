@@ -34,6 +34,10 @@ type Config struct {
 
 	// LastCommitCache holds configuration options for the last commit cache.
 	LastCommitCache LastCommitCacheConfig
+
+	// SecretScanningRules (optional) is a JSON list of rules used in addition to the default rules
+	// when scanning pushes for secrets.
+	SecretScanningRules string
 }
 
 // LastCommitCacheConfig holds configuration options for the last commit cache.
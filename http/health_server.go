@@ -0,0 +1,193 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	HealthStatusOK          = "ok"
+	HealthStatusUnavailable = "unavailable"
+	HealthStatusDraining    = "draining"
+
+	// DefaultHealthCheckTimeout is the time a single dependency check may take if no timeout is configured.
+	DefaultHealthCheckTimeout = 2 * time.Second
+)
+
+// Drainer is implemented by auxiliary servers which should report the service as not ready
+// before the main server is shut down, so load balancers stop routing new requests to it.
+type Drainer interface {
+	Drain()
+}
+
+// HealthCheck checks the connectivity of a single dependency of the service.
+type HealthCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// HealthConfig defines the config of a health server.
+type HealthConfig struct {
+	Host    string
+	Port    int
+	Timeout time.Duration
+}
+
+// HealthReport is the response body of the health endpoints.
+type HealthReport struct {
+	Status string                       `json:"status"`
+	Checks map[string]HealthCheckStatus `json:"checks,omitempty"`
+}
+
+// HealthCheckStatus is the status of a single dependency.
+type HealthCheckStatus struct {
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// HealthServer is an auxiliary server exposing the liveness (/healthz) and the readiness (/readyz)
+// of the service, e.g. for kubernetes probes. Liveness doesn't depend on the dependencies of the
+// service, otherwise an outage of the database would restart all instances. Readiness fails if
+// any dependency check fails or once the server is draining.
+type HealthServer struct {
+	config   HealthConfig
+	checks   []HealthCheck
+	draining atomic.Bool
+}
+
+var _ ListenAndServeServer = (*HealthServer)(nil)
+var _ Drainer = (*HealthServer)(nil)
+
+func NewHealthServer(config HealthConfig, checks ...HealthCheck) *HealthServer {
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultHealthCheckTimeout
+	}
+
+	return &HealthServer{
+		config: config,
+		checks: checks,
+	}
+}
+
+// ListenAndServe starts the health server.
+func (s *HealthServer) ListenAndServe() (*errgroup.Group, ShutdownFunction) {
+	return NewServer(
+		Config{
+			Host: s.config.Host,
+			Port: s.config.Port,
+		},
+		s.Handler(),
+	).ListenAndServe()
+}
+
+// Drain marks the service as not ready, the liveness isn't affected.
+func (s *HealthServer) Drain() {
+	s.draining.Store(true)
+}
+
+// Handler returns the http handler of the health endpoints.
+func (s *HealthServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", s.handleLiveness)
+	mux.HandleFunc("/readyz", s.handleReadiness)
+	return mux
+}
+
+func (s *HealthServer) handleLiveness(w http.ResponseWriter, _ *http.Request) {
+	writeHealthReport(w, HealthReport{Status: HealthStatusOK})
+}
+
+func (s *HealthServer) handleReadiness(w http.ResponseWriter, r *http.Request) {
+	report := s.Check(r.Context())
+	if s.draining.Load() {
+		report.Status = HealthStatusDraining
+	}
+	writeHealthReport(w, report)
+}
+
+// Check runs all dependency checks concurrently and returns their status.
+func (s *HealthServer) Check(ctx context.Context) HealthReport {
+	report := HealthReport{
+		Status: HealthStatusOK,
+		Checks: make(map[string]HealthCheckStatus, len(s.checks)),
+	}
+
+	var mx sync.Mutex
+	var wg sync.WaitGroup
+	for _, check := range s.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			status := runHealthCheck(ctx, check, s.config.Timeout)
+
+			mx.Lock()
+			defer mx.Unlock()
+			report.Checks[check.Name] = status
+			if status.Status != HealthStatusOK {
+				report.Status = HealthStatusUnavailable
+			}
+		}()
+	}
+	wg.Wait()
+
+	return report
+}
+
+func runHealthCheck(ctx context.Context, check HealthCheck, timeout time.Duration) HealthCheckStatus {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("health check panicked: %v", r)
+			}
+		}()
+		return check.Check(ctx)
+	}()
+
+	status := HealthCheckStatus{
+		Status:   HealthStatusOK,
+		Duration: time.Since(start).String(),
+	}
+	if err != nil {
+		status.Status = HealthStatusUnavailable
+		status.Error = err.Error()
+	}
+	return status
+}
+
+func writeHealthReport(w http.ResponseWriter, report HealthReport) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if report.Status == HealthStatusOK {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(report)
+}
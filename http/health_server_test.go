@@ -0,0 +1,72 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHealthServer(t *testing.T) {
+	var dbErr error
+	s := NewHealthServer(HealthConfig{},
+		HealthCheck{Name: "database", Check: func(context.Context) error { return dbErr }},
+		HealthCheck{Name: "events", Check: func(context.Context) error { return nil }},
+	)
+
+	get := func(path string) (int, HealthReport) {
+		rec := httptest.NewRecorder()
+		s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+		var report HealthReport
+		if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+			t.Fatalf("failed to decode report of %s: %v", path, err)
+		}
+		return rec.Code, report
+	}
+
+	code, report := get("/readyz")
+	if code != http.StatusOK || report.Status != HealthStatusOK || len(report.Checks) != 2 {
+		t.Fatalf("expected ready, got %d %+v", code, report)
+	}
+
+	dbErr = errors.New("connection refused")
+	code, report = get("/readyz")
+	if code != http.StatusServiceUnavailable || report.Status != HealthStatusUnavailable {
+		t.Fatalf("expected not ready, got %d %+v", code, report)
+	}
+	if report.Checks["database"].Error != "connection refused" || report.Checks["events"].Status != HealthStatusOK {
+		t.Errorf("unexpected checks %+v", report.Checks)
+	}
+
+	// liveness doesn't depend on the dependencies.
+	if code, _ = get("/healthz"); code != http.StatusOK {
+		t.Errorf("expected live, got %d", code)
+	}
+
+	dbErr = nil
+	s.Drain()
+	code, report = get("/readyz")
+	if code != http.StatusServiceUnavailable || report.Status != HealthStatusDraining {
+		t.Fatalf("expected draining, got %d %+v", code, report)
+	}
+	if code, _ = get("/healthz"); code != http.StatusOK {
+		t.Errorf("expected live while draining, got %d", code)
+	}
+}
@@ -0,0 +1,518 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"net/http"
+	"reflect"
+	"slices"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/services/settings"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/capability"
+	"github.com/harness/gitness/registry/app/services/registrypolicy"
+	"github.com/harness/gitness/registry/types"
+	gitnesstypes "github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// registryConfigPlan holds the changes which reconcile the configuration of a registry with a desired state
+// document, along with the writes which apply them.
+type registryConfigPlan struct {
+	changes []api.RegistryConfigChange
+
+	settings         []settings.KeyValue
+	cleanupPolicies  *[]types.CleanupPolicy
+	upstreamProxies  []int64
+	upstreamsChanged bool
+	createWebhooks   []*gitnesstypes.WebhookCore
+	updateWebhooks   []*gitnesstypes.WebhookCore
+	deleteWebhooks   []string
+}
+
+func (p *registryConfigPlan) add(
+	resource api.RegistryConfigResource,
+	identifier string,
+	action api.RegistryConfigAction,
+) {
+	p.changes = append(p.changes, api.RegistryConfigChange{
+		Resource:   resource,
+		Identifier: identifier,
+		Action:     action,
+	})
+}
+
+// ApplyRegistryConfig reconciles the configuration of a virtual registry with a desired state document. Only the
+// sections set in the document are reconciled, and applying the same document again makes no changes.
+func (c *APIController) ApplyRegistryConfig(
+	ctx context.Context,
+	r api.ApplyRegistryConfigRequestObject,
+) (api.ApplyRegistryConfigResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return applyRegistryConfig400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return applyRegistryConfig400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ApplyRegistryConfig401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ApplyRegistryConfig403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	if r.Body == nil {
+		return applyRegistryConfig400Error(errors.New("a configuration document is required")), nil
+	}
+	if regInfo.RegistryType != api.RegistryTypeVIRTUAL {
+		return applyRegistryConfig400Error(
+			fmt.Errorf("not allowed to apply the configuration of %s registry", regInfo.RegistryType),
+		), nil
+	}
+	if r.Body.Webhooks != nil {
+		if err = capability.Check(regInfo.PackageType, capability.Webhooks); err != nil {
+			return api.ApplyRegistryConfig501JSONResponse{
+				NotImplementedJSONResponse: api.NotImplementedJSONResponse(*GetNotImplementedResponse(err)),
+			}, nil
+		}
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return applyRegistryConfig500Error(err), nil
+	}
+
+	plan, err := c.planRegistryConfig(ctx, registry, regInfo, api.RegistryConfigDocument(*r.Body))
+	if err != nil {
+		var userErr *usererror.Error
+		if errors.As(err, &userErr) {
+			return applyRegistryConfig400Error(userErr), nil
+		}
+		return applyRegistryConfig500Error(err), nil
+	}
+
+	dryRun := r.Params.DryRun != nil && bool(*r.Params.DryRun)
+	if !dryRun && len(plan.changes) > 0 {
+		if err = c.applyRegistryConfigPlan(ctx, registry, plan, session.Principal, regInfo.ParentRef); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msgf("failed to apply configuration of registry: %s", registry.Name)
+			return applyRegistryConfig500Error(fmt.Errorf("failed to apply registry configuration: %w", err)), nil
+		}
+	}
+
+	return api.ApplyRegistryConfig200JSONResponse{
+		RegistryApplyResponseJSONResponse: api.RegistryApplyResponseJSONResponse{
+			Data: api.RegistryApplyResult{
+				DryRun:  dryRun,
+				Changes: plan.changes,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// planRegistryConfig compares the sections set in the document with the current configuration of the registry.
+// Errors caused by an invalid document are returned as user errors.
+func (c *APIController) planRegistryConfig(
+	ctx context.Context,
+	registry *types.Registry,
+	regInfo *types.RegistryRequestBaseInfo,
+	doc api.RegistryConfigDocument,
+) (*registryConfigPlan, error) {
+	plan := &registryConfigPlan{changes: make([]api.RegistryConfigChange, 0)}
+	if doc.Settings != nil {
+		if err := c.planRegistrySettings(ctx, plan, registry.ID, *doc.Settings); err != nil {
+			return nil, err
+		}
+	}
+	if doc.CleanupPolicy != nil {
+		if err := c.planCleanupPolicies(ctx, plan, registry.ID, *doc.CleanupPolicy); err != nil {
+			return nil, err
+		}
+	}
+	if doc.UpstreamProxies != nil {
+		if err := c.planUpstreamProxies(ctx, plan, registry, *doc.UpstreamProxies); err != nil {
+			return nil, err
+		}
+	}
+	if doc.Webhooks != nil {
+		if err := c.planWebhooks(ctx, plan, regInfo, *doc.Webhooks); err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+// planRegistrySettings plans the settings of the registry, settings missing from the document are reset so
+// they're inherited again.
+func (c *APIController) planRegistrySettings(
+	ctx context.Context,
+	plan *registryConfigPlan,
+	registryID int64,
+	body api.RegistrySettingsRequest,
+) error {
+	values, err := toRegistrySettings(body)
+	if err != nil {
+		return usererror.BadRequest(err.Error())
+	}
+	desired := make(map[settings.Key]any, len(values))
+	for _, kv := range values {
+		if kv.Value != nil {
+			desired[kv.Key] = kv.Value
+		}
+	}
+
+	current, err := c.RegistryPolicyService.RegistrySettingValues(ctx, registryID)
+	if err != nil {
+		return err
+	}
+
+	for _, definition := range registrypolicy.Schema {
+		key := definition.Key()
+		want, wanted := desired[key]
+		have, had := current[key]
+		switch {
+		case wanted && !had:
+			plan.add(api.RegistryConfigResourceSETTING, string(key), api.RegistryConfigActionCREATED)
+		case !wanted && had:
+			plan.add(api.RegistryConfigResourceSETTING, string(key), api.RegistryConfigActionDELETED)
+		case wanted && !reflect.DeepEqual(want, have):
+			plan.add(api.RegistryConfigResourceSETTING, string(key), api.RegistryConfigActionUPDATED)
+		default:
+			continue
+		}
+		plan.settings = append(plan.settings, settings.KeyValue{Key: key, Value: want})
+	}
+	return nil
+}
+
+// planCleanupPolicies plans the cleanup policies of the registry, they're matched by name.
+func (c *APIController) planCleanupPolicies(
+	ctx context.Context,
+	plan *registryConfigPlan,
+	registryID int64,
+	policies []api.CleanupPolicy,
+) error {
+	desired := make([]types.CleanupPolicy, 0, len(policies))
+	names := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		entity, err := getCleanupPolicyEntity(policy, registryID)
+		if err != nil {
+			return err
+		}
+		if names[entity.Name] {
+			return usererror.BadRequestf("cleanup policy %q is defined more than once", entity.Name)
+		}
+		names[entity.Name] = true
+		desired = append(desired, *entity)
+	}
+
+	existing, err := c.CleanupPolicyStore.GetByRegistryID(ctx, registryID)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]types.CleanupPolicy)
+	if existing != nil {
+		for _, policy := range *existing {
+			current[policy.Name] = policy
+		}
+	}
+
+	changed := false
+	for _, want := range desired {
+		have, ok := current[want.Name]
+		switch {
+		case !ok:
+			plan.add(api.RegistryConfigResourceCLEANUPPOLICY, want.Name, api.RegistryConfigActionCREATED)
+		case !sameCleanupPolicy(want, have):
+			plan.add(api.RegistryConfigResourceCLEANUPPOLICY, want.Name, api.RegistryConfigActionUPDATED)
+		default:
+			continue
+		}
+		changed = true
+	}
+	for _, name := range slices.Sorted(maps.Keys(current)) {
+		if !names[name] {
+			plan.add(api.RegistryConfigResourceCLEANUPPOLICY, name, api.RegistryConfigActionDELETED)
+			changed = true
+		}
+	}
+
+	if changed {
+		plan.cleanupPolicies = &desired
+	}
+	return nil
+}
+
+// planUpstreamProxies plans the upstream proxies of the registry. An upstream proxy which moves to another
+// position is reported as updated, as the order decides which upstream proxy is asked first.
+func (c *APIController) planUpstreamProxies(
+	ctx context.Context,
+	plan *registryConfigPlan,
+	registry *types.Registry,
+	identifiers []string,
+) error {
+	positions := make(map[string]int, len(identifiers))
+	for i, identifier := range identifiers {
+		if _, ok := positions[identifier]; ok {
+			return usererror.BadRequestf("upstream proxy %q is listed more than once", identifier)
+		}
+		positions[identifier] = i
+	}
+
+	var ids []int64
+	if len(identifiers) > 0 {
+		var err error
+		ids, err = c.resolveUpstreamProxyIDs(ctx, registry, identifiers, registry.ParentID)
+		if err != nil {
+			return usererror.BadRequest(err.Error())
+		}
+		if len(ids) != len(identifiers) {
+			found := c.getUpstreamProxyKeys(ctx, ids)
+			for _, identifier := range identifiers {
+				if !slices.Contains(found, identifier) {
+					return usererror.BadRequestf("upstream proxy %q not found", identifier)
+				}
+			}
+		}
+	}
+
+	current := c.getUpstreamProxyKeys(ctx, registry.UpstreamProxies)
+	for i, identifier := range identifiers {
+		j := slices.Index(current, identifier)
+		switch {
+		case j < 0:
+			plan.add(api.RegistryConfigResourceUPSTREAMPROXY, identifier, api.RegistryConfigActionCREATED)
+		case j != i:
+			plan.add(api.RegistryConfigResourceUPSTREAMPROXY, identifier, api.RegistryConfigActionUPDATED)
+		}
+	}
+	for _, identifier := range current {
+		if _, ok := positions[identifier]; !ok {
+			plan.add(api.RegistryConfigResourceUPSTREAMPROXY, identifier, api.RegistryConfigActionDELETED)
+		}
+	}
+
+	if !slices.Equal(ids, registry.UpstreamProxies) {
+		plan.upstreamProxies = ids
+		plan.upstreamsChanged = true
+	}
+	return nil
+}
+
+// planWebhooks plans the external webhooks of the registry, they're matched by identifier. Internal webhooks
+// aren't managed by the document.
+func (c *APIController) planWebhooks(
+	ctx context.Context,
+	plan *registryConfigPlan,
+	regInfo *types.RegistryRequestBaseInfo,
+	webhooks []api.WebhookRequest,
+) error {
+	existing, err := c.WebhooksRepository.ListByRegistry(ctx, "", "", math.MaxInt, 0, "", regInfo.RegistryID)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]*gitnesstypes.WebhookCore, len(existing))
+	for _, webhook := range existing {
+		if webhook.Type != enum.WebhookTypeInternal {
+			current[webhook.Identifier] = webhook
+		}
+	}
+
+	identifiers := make(map[string]bool, len(webhooks))
+	for _, webhookRequest := range webhooks {
+		if err = validateWebhookRequest(webhookRequest); err != nil {
+			return usererror.BadRequest(err.Error())
+		}
+		if identifiers[webhookRequest.Identifier] {
+			return usererror.BadRequestf("webhook %q is defined more than once", webhookRequest.Identifier)
+		}
+		identifiers[webhookRequest.Identifier] = true
+
+		webhook, err := c.RegistryMetadataHelper.MapToWebhookCore(ctx, webhookRequest, regInfo)
+		if err != nil {
+			return usererror.BadRequestf("invalid webhook %q: %s", webhookRequest.Identifier, err)
+		}
+
+		have, ok := current[webhook.Identifier]
+		switch {
+		case !ok:
+			plan.add(api.RegistryConfigResourceWEBHOOK, webhook.Identifier, api.RegistryConfigActionCREATED)
+			webhook.Type = enum.WebhookTypeExternal
+			plan.createWebhooks = append(plan.createWebhooks, webhook)
+		case !sameWebhook(webhook, have):
+			plan.add(api.RegistryConfigResourceWEBHOOK, webhook.Identifier, api.RegistryConfigActionUPDATED)
+			plan.updateWebhooks = append(plan.updateWebhooks, webhook)
+		}
+	}
+	for _, identifier := range slices.Sorted(maps.Keys(current)) {
+		if !identifiers[identifier] {
+			plan.add(api.RegistryConfigResourceWEBHOOK, identifier, api.RegistryConfigActionDELETED)
+			plan.deleteWebhooks = append(plan.deleteWebhooks, identifier)
+		}
+	}
+	return nil
+}
+
+// applyRegistryConfigPlan writes all changes of the plan in a single transaction.
+func (c *APIController) applyRegistryConfigPlan(
+	ctx context.Context,
+	registry *types.Registry,
+	plan *registryConfigPlan,
+	principal gitnesstypes.Principal,
+	parentRef string,
+) error {
+	err := c.tx.WithTx(ctx, func(ctx context.Context) error {
+		if len(plan.settings) > 0 {
+			if err := c.RegistryPolicyService.UpdateRegistrySettings(ctx, registry.ID, plan.settings); err != nil {
+				return err
+			}
+		}
+		if plan.cleanupPolicies != nil {
+			ids, err := c.CleanupPolicyStore.GetIDsByRegistryID(ctx, registry.ID)
+			if err != nil {
+				return err
+			}
+			if err = c.CleanupPolicyStore.ModifyCleanupPolicies(ctx, plan.cleanupPolicies, ids); err != nil {
+				return fmt.Errorf("failed to update cleanup policies: %w", err)
+			}
+		}
+		if plan.upstreamsChanged {
+			updated := *registry
+			updated.UpstreamProxies = plan.upstreamProxies
+			if err := c.updateRegistryWithAudit(ctx, registry, &updated, principal, parentRef); err != nil {
+				return fmt.Errorf("failed to update upstream proxies: %w", err)
+			}
+		}
+		for _, webhook := range plan.createWebhooks {
+			webhook.CreatedBy = principal.ID
+			if err := c.WebhooksRepository.Create(ctx, webhook); err != nil {
+				return fmt.Errorf("failed to create webhook %s: %w", webhook.Identifier, err)
+			}
+		}
+		for _, webhook := range plan.updateWebhooks {
+			if err := c.WebhooksRepository.Update(ctx, webhook); err != nil {
+				return fmt.Errorf("failed to update webhook %s: %w", webhook.Identifier, err)
+			}
+		}
+		for _, identifier := range plan.deleteWebhooks {
+			if err := c.WebhooksRepository.DeleteByRegistryAndIdentifier(ctx, registry.ID, identifier); err != nil {
+				return fmt.Errorf("failed to delete webhook %s: %w", identifier, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if plan.upstreamsChanged {
+		if registry.PackageType == api.PackageTypeRPM {
+			c.PostProcessingReporter.BuildRegistryIndex(ctx, registry.ID, make([]types.SourceRef, 0))
+		} else {
+			err = c.PackageWrapper.ReportBuildRegistryIndexEvent(ctx, registry.ID, make([]types.SourceRef, 0))
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("failed to report build registry index event")
+			}
+		}
+	}
+	return nil
+}
+
+func sameCleanupPolicy(a, b types.CleanupPolicy) bool {
+	return a.ExpiryTime == b.ExpiryTime &&
+		sameStrings(a.PackagePrefix, b.PackagePrefix) &&
+		sameStrings(a.VersionPrefix, b.VersionPrefix)
+}
+
+func sameWebhook(a, b *gitnesstypes.WebhookCore) bool {
+	if len(a.ExtraHeaders) > 0 || len(b.ExtraHeaders) > 0 {
+		if !reflect.DeepEqual(a.ExtraHeaders, b.ExtraHeaders) {
+			return false
+		}
+	}
+	triggers := func(w *gitnesstypes.WebhookCore) []string {
+		s := make([]string, len(w.Triggers))
+		for i, t := range w.Triggers {
+			s[i] = string(t)
+		}
+		return s
+	}
+	return a.DisplayName == b.DisplayName &&
+		a.Description == b.Description &&
+		a.URL == b.URL &&
+		a.Enabled == b.Enabled &&
+		a.Insecure == b.Insecure &&
+		a.SecretIdentifier == b.SecretIdentifier &&
+		a.SecretSpaceID == b.SecretSpaceID &&
+		sameStrings(triggers(a), triggers(b))
+}
+
+// sameStrings tells whether both lists hold the same strings, regardless of their order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+func applyRegistryConfig400Error(err error) api.ApplyRegistryConfigResponseObject {
+	return api.ApplyRegistryConfig400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func applyRegistryConfig500Error(err error) api.ApplyRegistryConfigResponseObject {
+	return api.ApplyRegistryConfig500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
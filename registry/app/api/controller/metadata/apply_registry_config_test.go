@@ -0,0 +1,128 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	coretypes "github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanCleanupPolicies(t *testing.T) {
+	days := func(d int) int64 { return (time.Duration(d) * 24 * time.Hour).Milliseconds() }
+	existing := []types.CleanupPolicy{
+		{Name: "keep", PackagePrefix: []string{"a", "b"}, VersionPrefix: []string{"1"}, ExpiryTime: days(7)},
+		{Name: "change", PackagePrefix: []string{"a"}, VersionPrefix: []string{"1"}, ExpiryTime: days(7)},
+		{Name: "drop", PackagePrefix: []string{"a"}, VersionPrefix: []string{"1"}, ExpiryTime: days(7)},
+	}
+	policy := func(name string, expireDays int, packagePrefix ...string) api.CleanupPolicy {
+		return api.CleanupPolicy{
+			Name:          &name,
+			ExpireDays:    &expireDays,
+			PackagePrefix: &packagePrefix,
+			VersionPrefix: &[]string{"1"},
+		}
+	}
+
+	cleanupPolicyRepo := new(mocks.CleanupPolicyRepository)
+	cleanupPolicyRepo.On("GetByRegistryID", mock.Anything, int64(1)).Return(&existing, nil)
+	c := &APIController{CleanupPolicyStore: cleanupPolicyRepo}
+
+	plan := &registryConfigPlan{}
+	err := c.planCleanupPolicies(context.Background(), plan, 1, []api.CleanupPolicy{
+		policy("keep", 7, "b", "a"),
+		policy("change", 14, "a"),
+		policy("new", 7, "a"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []api.RegistryConfigChange{
+		{Resource: api.RegistryConfigResourceCLEANUPPOLICY, Identifier: "change", Action: api.RegistryConfigActionUPDATED},
+		{Resource: api.RegistryConfigResourceCLEANUPPOLICY, Identifier: "new", Action: api.RegistryConfigActionCREATED},
+		{Resource: api.RegistryConfigResourceCLEANUPPOLICY, Identifier: "drop", Action: api.RegistryConfigActionDELETED},
+	}, plan.changes)
+	require.NotNil(t, plan.cleanupPolicies)
+	assert.Len(t, *plan.cleanupPolicies, 3)
+
+	// applying the current state again changes nothing.
+	plan = &registryConfigPlan{}
+	err = c.planCleanupPolicies(context.Background(), plan, 1, []api.CleanupPolicy{
+		policy("keep", 7, "a", "b"),
+		policy("change", 7, "a"),
+		policy("drop", 7, "a"),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, plan.changes)
+	assert.Nil(t, plan.cleanupPolicies)
+
+	err = c.planCleanupPolicies(context.Background(), &registryConfigPlan{}, 1, []api.CleanupPolicy{
+		policy("keep", 7, "a"),
+		policy("keep", 14, "a"),
+	})
+	require.Error(t, err)
+}
+
+func TestPlanWebhooks(t *testing.T) {
+	regInfo := &types.RegistryRequestBaseInfo{RegistryID: 1}
+	existing := []*coretypes.WebhookCore{
+		{
+			Identifier: "same", DisplayName: "same", URL: "https://example.com/same", Enabled: true,
+			Type:     enum.WebhookTypeExternal,
+			Triggers: []enum.WebhookTrigger{enum.WebhookTriggerArtifactCreated, enum.WebhookTriggerArtifactDeleted},
+		},
+		{Identifier: "changed", DisplayName: "changed", URL: "https://example.com/old", Type: enum.WebhookTypeExternal},
+		{Identifier: "removed", DisplayName: "removed", URL: "https://example.com/removed", Type: enum.WebhookTypeExternal},
+		{Identifier: internalWebhookIdentifier, URL: "https://example.com/internal", Type: enum.WebhookTypeInternal},
+	}
+	desired := []*coretypes.WebhookCore{
+		{
+			Identifier: "same", DisplayName: "same", URL: "https://example.com/same", Enabled: true,
+			Triggers: []enum.WebhookTrigger{enum.WebhookTriggerArtifactDeleted, enum.WebhookTriggerArtifactCreated},
+		},
+		{Identifier: "changed", DisplayName: "changed", URL: "https://example.com/new"},
+		{Identifier: "added", DisplayName: "added", URL: "https://example.com/added"},
+	}
+
+	webhooksRepo := new(mocks.WebhooksRepository)
+	webhooksRepo.On("ListByRegistry", mock.Anything, "", "", mock.Anything, 0, "", int64(1)).Return(existing, nil)
+	metadataHelper := new(mocks.RegistryMetadataHelper)
+	requests := make([]api.WebhookRequest, 0, len(desired))
+	for _, webhook := range desired {
+		request := api.WebhookRequest{Identifier: webhook.Identifier, Name: webhook.DisplayName, Url: webhook.URL}
+		metadataHelper.On("MapToWebhookCore", mock.Anything, request, regInfo).Return(webhook, nil)
+		requests = append(requests, request)
+	}
+	c := &APIController{WebhooksRepository: webhooksRepo, RegistryMetadataHelper: metadataHelper}
+
+	plan := &registryConfigPlan{}
+	require.NoError(t, c.planWebhooks(context.Background(), plan, regInfo, requests))
+	assert.Equal(t, []api.RegistryConfigChange{
+		{Resource: api.RegistryConfigResourceWEBHOOK, Identifier: "changed", Action: api.RegistryConfigActionUPDATED},
+		{Resource: api.RegistryConfigResourceWEBHOOK, Identifier: "added", Action: api.RegistryConfigActionCREATED},
+		{Resource: api.RegistryConfigResourceWEBHOOK, Identifier: "removed", Action: api.RegistryConfigActionDELETED},
+	}, plan.changes)
+	require.Len(t, plan.createWebhooks, 1)
+	assert.Equal(t, enum.WebhookTypeExternal, plan.createWebhooks[0].Type)
+	assert.Equal(t, []string{"removed"}, plan.deleteWebhooks)
+}
@@ -0,0 +1,170 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/deletionapproval"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ApproveDeletionRequest approves a pending deletion request and deletes the artifact or version on behalf of
+// the approver. The request goes back to pending if the delete fails, so it can be approved again.
+func (c *APIController) ApproveDeletionRequest(
+	ctx context.Context,
+	r api.ApproveDeletionRequestRequestObject,
+) (api.ApproveDeletionRequestResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return approveDeletionRequest400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return approveDeletionRequest400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionArtifactsDelete,
+	); err != nil {
+		return approveDeletionRequestAuthError(err), nil
+	}
+
+	repoEntity, err := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.ParentID, regInfo.RegistryIdentifier)
+	if err != nil {
+		return approveDeletionRequest404Error("registry doesn't exist with this key"), nil
+	}
+
+	deletionRequest, err := c.DeletionApprovalService.Find(ctx, repoEntity.ID, int64(r.DeletionRequestId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return approveDeletionRequest404Error(fmt.Sprintf("deletion request %d not found", r.DeletionRequestId)), nil
+	}
+	if err != nil {
+		return approveDeletionRequest500Error(err), nil
+	}
+
+	img, err := c.ImageStore.GetByName(ctx, regInfo.RegistryID, deletionRequest.ImageName)
+	if err != nil {
+		//nolint:nilerr
+		return approveDeletionRequest404Error("artifact doesn't exist with this key"), nil
+	}
+
+	if err = c.checkDeletionReviewer(ctx, session, space, regInfo.RegistryIdentifier, img); err != nil {
+		return approveDeletionRequestAuthError(err), nil
+	}
+
+	err = c.DeletionApprovalService.Approve(ctx, deletionRequest, session.Principal.ID)
+	if errors.Is(err, deletionapproval.ErrSelfApproval) {
+		return api.ApproveDeletionRequest403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+	if errors.Is(err, deletionapproval.ErrNotPending) {
+		return api.ApproveDeletionRequest409JSONResponse{
+			ConflictJSONResponse: api.ConflictJSONResponse(
+				*GetErrorResponse(http.StatusConflict,
+					fmt.Sprintf("deletion request %d is %s", deletionRequest.ID, deletionRequest.State)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return approveDeletionRequest500Error(err), nil
+	}
+
+	deletionAudit := audit.WithData("deletion request", strconv.FormatInt(deletionRequest.ID, 10))
+	if deletionRequest.Version == "" {
+		err = c.deleteArtifact(ctx, regInfo, repoEntity.Name, session.Principal, img, deletionAudit)
+	} else {
+		err = c.deleteArtifactVersion(ctx, regInfo, repoEntity.Name, session.Principal, img,
+			deletionRequest.Version, deletionAudit)
+	}
+	if err != nil {
+		if revertErr := c.DeletionApprovalService.Revert(ctx, deletionRequest); revertErr != nil {
+			log.Ctx(ctx).Warn().Err(revertErr).Msgf("failed to revert deletion request %d", deletionRequest.ID)
+		}
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return approveDeletionRequest404Error(fmt.Sprintf("artifact version '%s' not found for artifact '%s'",
+				deletionRequest.Version, deletionRequest.ImageName)), nil
+		}
+		return approveDeletionRequest500Error(err), nil
+	}
+
+	c.auditDeletionRequest(ctx, session.Principal, regInfo.ParentRef, repoEntity.Name, deletionRequest,
+		audit.ActionUpdated)
+
+	return api.ApproveDeletionRequest200JSONResponse{
+		DeletionRequestResponseJSONResponse: deletionRequestResponse(deletionRequest),
+	}, nil
+}
+
+func approveDeletionRequestAuthError(err error) api.ApproveDeletionRequestResponseObject {
+	statusCode, message := HandleAuthError(err)
+	if statusCode == http.StatusUnauthorized {
+		return api.ApproveDeletionRequest401JSONResponse{
+			UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+				*GetErrorResponse(http.StatusUnauthorized, message),
+			),
+		}
+	}
+	return api.ApproveDeletionRequest403JSONResponse{
+		UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+			*GetErrorResponse(http.StatusForbidden, message),
+		),
+	}
+}
+
+func approveDeletionRequest400Error(err error) api.ApproveDeletionRequestResponseObject {
+	return api.ApproveDeletionRequest400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func approveDeletionRequest404Error(message string) api.ApproveDeletionRequestResponseObject {
+	return api.ApproveDeletionRequest404JSONResponse{
+		NotFoundJSONResponse: api.NotFoundJSONResponse(
+			*GetErrorResponse(http.StatusNotFound, message),
+		),
+	}
+}
+
+func approveDeletionRequest500Error(err error) api.ApproveDeletionRequestResponseObject {
+	return api.ApproveDeletionRequest500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/firewalldelay"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ApproveFirewallVersion lets a version held back by the firewall delay of an upstream proxy through before the
+// delay has passed.
+func (c *APIController) ApproveFirewallVersion(
+	ctx context.Context,
+	r api.ApproveFirewallVersionRequestObject,
+) (api.ApproveFirewallVersionResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return approveFirewallVersion400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return approveFirewallVersion400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ApproveFirewallVersion401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ApproveFirewallVersion403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	if r.Body == nil {
+		return approveFirewallVersion400Error(firewalldelay.ErrApprovalReasonRequired), nil
+	}
+	upstream, err := c.getFirewallUpstream(ctx, regInfo)
+	if err != nil {
+		return approveFirewallVersion400Error(err), nil
+	}
+
+	approval, err := c.FirewallDelayService.Approve(ctx, upstream, string(r.Artifact), string(r.Version),
+		session.Principal.ID, r.Body.Reason)
+	switch {
+	case errors.Is(err, firewalldelay.ErrApprovalReasonRequired), errors.Is(err, firewalldelay.ErrInvalidApproval):
+		return approveFirewallVersion400Error(err), nil
+	case errors.Is(err, store.ErrDuplicate):
+		return api.ApproveFirewallVersion409JSONResponse{
+			ConflictJSONResponse: api.ConflictJSONResponse(
+				*GetErrorResponse(http.StatusConflict,
+					fmt.Sprintf("version %s of %s is already approved", r.Version, r.Artifact)),
+			),
+		}, nil
+	case err != nil:
+		return approveFirewallVersion500Error(err), nil
+	}
+
+	c.auditFirewallApproval(ctx, session.Principal, regInfo.ParentRef, regInfo.RegistryIdentifier, approval,
+		audit.ActionCreated)
+
+	return api.ApproveFirewallVersion200JSONResponse{
+		FirewallApprovalResponseJSONResponse: api.FirewallApprovalResponseJSONResponse{
+			Data:   mapToAPIFirewallApproval(approval),
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func approveFirewallVersion400Error(err error) api.ApproveFirewallVersionResponseObject {
+	return api.ApproveFirewallVersion400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func approveFirewallVersion500Error(err error) api.ApproveFirewallVersionResponseObject {
+	return api.ApproveFirewallVersion500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
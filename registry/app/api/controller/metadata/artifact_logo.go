@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"  // registers the GIF decoder for logos
+	_ "image/jpeg" // registers the JPEG decoder for logos
+	_ "image/png"  // registers the PNG decoder for logos
+	"net/http"
+	"slices"
+
+	"github.com/harness/gitness/registry/types"
+)
+
+const (
+	maxArtifactLogoSize      = 256 * 1024
+	maxArtifactLogoDimension = 1024
+	artifactLogoCacheControl = "private, max-age=300"
+)
+
+var artifactLogoContentTypes = []string{"image/png", "image/jpeg", "image/gif"}
+
+// getArtifactLogoPath returns the path of the logo of an image. Logos are kept out of the file tree of the
+// packages and keyed by the UUID of the image, so a logo doesn't carry over to a new image with the same name.
+func getArtifactLogoPath(img *types.Image) string {
+	return "/.logos/" + img.UUID
+}
+
+// validateArtifactLogo checks that the logo is a PNG, JPEG or GIF image which isn't larger than
+// maxArtifactLogoDimension pixels on either side, it returns the content type of the logo.
+func validateArtifactLogo(content []byte) (string, error) {
+	if len(content) == 0 {
+		return "", fmt.Errorf("logo is empty")
+	}
+	if len(content) > maxArtifactLogoSize {
+		return "", fmt.Errorf("logo can't be larger than %d bytes", maxArtifactLogoSize)
+	}
+	contentType := http.DetectContentType(content)
+	if !slices.Contains(artifactLogoContentTypes, contentType) {
+		return "", fmt.Errorf("logo must be a PNG, JPEG or GIF image, got %s", contentType)
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("invalid logo: %w", err)
+	}
+	if config.Width > maxArtifactLogoDimension || config.Height > maxArtifactLogoDimension {
+		return "", fmt.Errorf("logo can't be larger than %dx%d pixels", maxArtifactLogoDimension,
+			maxArtifactLogoDimension)
+	}
+	return contentType, nil
+}
+
+func artifactLogoETag(sha256 string) string {
+	return `"` + sha256 + `"`
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	buf := bytes.Buffer{}
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestValidateArtifactLogo(t *testing.T) {
+	t.Run("accepts a png logo", func(t *testing.T) {
+		contentType, err := validateArtifactLogo(encodePNG(t, 64, 64))
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", contentType)
+	})
+
+	t.Run("rejects an empty logo", func(t *testing.T) {
+		_, err := validateArtifactLogo(nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects a logo which isn't an image", func(t *testing.T) {
+		_, err := validateArtifactLogo([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"))
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects a logo with too many pixels", func(t *testing.T) {
+		_, err := validateArtifactLogo(encodePNG(t, maxArtifactLogoDimension+1, 1))
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects a logo which is too large", func(t *testing.T) {
+		content := append(encodePNG(t, 1, 1), make([]byte, maxArtifactLogoSize)...)
+		_, err := validateArtifactLogo(content)
+		assert.Error(t, err)
+	})
+}
@@ -99,6 +99,29 @@ func GetMavenArtifactDetail(
 		Version:    artifact.Version,
 		Size:       &sizeVal,
 	}
+	if len(mavenMetadata.Variants) > 0 {
+		variants := make([]artifactapi.GradleVariant, 0, len(mavenMetadata.Variants))
+		for _, v := range mavenMetadata.Variants {
+			variant := artifactapi.GradleVariant{
+				Name:         v.Name,
+				Files:        &v.Files,
+				Dependencies: &v.Dependencies,
+			}
+			if len(v.Attributes) > 0 {
+				variant.Attributes = &v.Attributes
+			}
+			variants = append(variants, variant)
+		}
+		groupID, artifactID, _ := strings.Cut(image.Name, ":")
+		err := artifactDetail.FromMavenArtifactDetailConfig(artifactapi.MavenArtifactDetailConfig{
+			GroupId:    &groupID,
+			ArtifactId: &artifactID,
+			Variants:   &variants,
+		})
+		if err != nil {
+			log.Error().Err(err).Msgf("failed to set maven artifact detail config for image: %s", image.Name)
+		}
+	}
 	return *artifactDetail
 }
 
@@ -144,6 +167,7 @@ func mapToRegistryArtifactMetadata(artifact types.ArtifactMetadata) *artifactapi
 		LastModified:       &lastModified,
 		PackageType:        packageType,
 		DownloadsCount:     &artifact.DownloadCount,
+		StarCount:          &artifact.StarCount,
 		IsQuarantined:      &artifact.IsQuarantined,
 		ArtifactType:       artifact.ArtifactType,
 	}
@@ -211,6 +235,12 @@ func GetTagMetadata(
 			IsQuarantined:    &tag.IsQuarantined,
 			QuarantineReason: &tag.QuarantineReason,
 		}
+		if !tag.DeletedAt.IsZero() {
+			isDeleted := true
+			deletedAt := GetTimeInMs(tag.DeletedAt)
+			artifactVersionMetadata.IsDeleted = &isDeleted
+			artifactVersionMetadata.DeletedAt = &deletedAt
+		}
 
 		artifactVersionMetadataList = append(artifactVersionMetadataList, *artifactVersionMetadata)
 	}
@@ -823,12 +853,17 @@ func GetArtifactSummary(artifact types.ImageMetadata) *artifactapi.ArtifactSumma
 		CreatedAt:      &createdAt,
 		ModifiedAt:     &modifiedAt,
 		DownloadsCount: &artifact.DownloadCount,
+		StarCount:      &artifact.StarCount,
+		Starred:        &artifact.Starred,
 		ImageName:      artifact.Name,
 		PackageType:    artifact.PackageType,
 		ArtifactType:   artifact.ArtifactType,
 		RegistryUUID:   artifact.RegistryUUID,
 		Uuid:           artifact.UUID,
 	}
+	if artifact.Description != "" {
+		artifactVersionSummary.Description = &artifact.Description
+	}
 	response := &artifactapi.ArtifactSummaryResponseJSONResponse{
 		Data:   *artifactVersionSummary,
 		Status: artifactapi.StatusSUCCESS,
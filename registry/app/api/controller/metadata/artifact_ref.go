@@ -0,0 +1,71 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/store"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// resolveArtifactRef returns the name of the artifact and of the version the path parameters of a mutating
+// request refer to. Both parameters can be names or UUIDs, UUIDs stay the same across renames and
+// re-creations which makes them the stable reference for automation. A UUID which doesn't belong to the
+// registry is treated as a name, so the lookup by name reports it as missing.
+func (c *APIController) resolveArtifactRef(
+	ctx context.Context,
+	registryID int64,
+	artifactRef artifact.ArtifactPathParam,
+	versionRef artifact.VersionPathParam,
+) (artifact.ArtifactPathParam, artifact.VersionPathParam) {
+	if isUUID(string(artifactRef)) {
+		img, err := c.ImageStore.GetByUUID(ctx, string(artifactRef))
+		switch {
+		case err == nil && img.RegistryID == registryID:
+			artifactRef = artifact.ArtifactPathParam(img.Name)
+		case err != nil && !errors.Is(err, store.ErrResourceNotFound):
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to resolve artifact UUID %s", artifactRef)
+		}
+	}
+	if versionRef == "" || !isUUID(string(versionRef)) {
+		return artifactRef, versionRef
+	}
+
+	art, err := c.ArtifactStore.GetByUUID(ctx, string(versionRef))
+	if err != nil {
+		if !errors.Is(err, store.ErrResourceNotFound) {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to resolve version UUID %s", versionRef)
+		}
+		return artifactRef, versionRef
+	}
+	img, err := c.ImageStore.Get(ctx, art.ImageID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to find the artifact of version UUID %s", versionRef)
+		return artifactRef, versionRef
+	}
+	if img.RegistryID != registryID || img.Name != string(artifactRef) {
+		return artifactRef, versionRef
+	}
+	return artifactRef, artifact.VersionPathParam(art.Version)
+}
+
+func isUUID(ref string) bool {
+	return uuid.Validate(ref) == nil
+}
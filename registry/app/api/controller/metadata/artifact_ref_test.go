@@ -0,0 +1,64 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResolveArtifactRef(t *testing.T) {
+	const (
+		imageUUID    = "3f1c6c1e-6a1b-4f0e-9a57-6f9d3c2b8e10"
+		artifactUUID = "8d4e2a90-1b7c-4c6d-a3f2-5e0b9c7d1a24"
+		otherUUID    = "c0ffee00-0000-4000-8000-000000000000"
+	)
+	ctx := context.Background()
+	imageStore := new(mocks.ImageRepository)
+	artifactStore := new(mocks.ArtifactRepository)
+	img := &types.Image{ID: 7, RegistryID: 1, Name: "app", UUID: imageUUID}
+	imageStore.On("GetByUUID", mock.Anything, imageUUID).Return(img, nil)
+	imageStore.On("GetByUUID", mock.Anything, otherUUID).Return(nil, store.ErrResourceNotFound)
+	imageStore.On("Get", mock.Anything, int64(7)).Return(img, nil)
+	artifactStore.On("GetByUUID", mock.Anything, artifactUUID).
+		Return(&types.Artifact{ImageID: 7, Version: "1.2.3"}, nil)
+	c := &APIController{ImageStore: imageStore, ArtifactStore: artifactStore}
+
+	name, version := c.resolveArtifactRef(ctx, 1, imageUUID, artifactUUID)
+	assert.Equal(t, artifact.ArtifactPathParam("app"), name)
+	assert.Equal(t, artifact.VersionPathParam("1.2.3"), version)
+
+	name, version = c.resolveArtifactRef(ctx, 1, "app", "1.0.0")
+	assert.Equal(t, artifact.ArtifactPathParam("app"), name)
+	assert.Equal(t, artifact.VersionPathParam("1.0.0"), version)
+
+	// UUIDs of other registries and unknown UUIDs are left as they are.
+	name, _ = c.resolveArtifactRef(ctx, 2, imageUUID, "")
+	assert.Equal(t, artifact.ArtifactPathParam(imageUUID), name)
+	name, _ = c.resolveArtifactRef(ctx, 1, otherUUID, "")
+	assert.Equal(t, artifact.ArtifactPathParam(otherUUID), name)
+
+	// the version has to belong to the artifact.
+	_, version = c.resolveArtifactRef(ctx, 1, "other", artifactUUID)
+	assert.Equal(t, artifact.VersionPathParam(artifactUUID), version)
+}
@@ -0,0 +1,261 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// GetArtifactVersionProvenance returns the pipeline execution which published an artifact version.
+func (c *APIController) GetArtifactVersionProvenance(
+	ctx context.Context,
+	r api.GetArtifactVersionProvenanceRequestObject,
+) (api.GetArtifactVersionProvenanceResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return getProvenance400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return getProvenance400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return api.GetArtifactVersionProvenance403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	var artifactType *api.ArtifactType
+	if r.Params.ArtifactType != nil {
+		artifactType, err = ValidateAndGetArtifactType(regInfo.PackageType, string(*r.Params.ArtifactType))
+		if err != nil {
+			return getProvenance400Error(err), nil
+		}
+	}
+
+	image := string(r.Artifact)
+	version := string(r.Version)
+	art, err := c.getVersionArtifact(ctx, regInfo, image, version, artifactType, types.WithoutMetadata())
+	if err != nil {
+		if errors.Is(err, store2.ErrResourceNotFound) {
+			return getProvenance404Error(err), nil
+		}
+		return getProvenance500Error(err), nil
+	}
+
+	provenance, err := c.ProvenanceRepository.Find(ctx, art.ID)
+	if err != nil {
+		if errors.Is(err, store2.ErrResourceNotFound) {
+			return getProvenance404Error(
+				fmt.Errorf("no pipeline execution is recorded for artifact: %s@%s", image, version),
+			), nil
+		}
+		log.Ctx(ctx).Error().Msgf("failed to get provenance of artifact: %s@%s with error: %v", image, version, err)
+		return getProvenance500Error(fmt.Errorf("failed to get provenance: %w", err)), nil
+	}
+
+	return api.GetArtifactVersionProvenance200JSONResponse{
+		ArtifactProvenanceResponseJSONResponse: api.ArtifactProvenanceResponseJSONResponse{
+			Data:   mapToAPIArtifactProvenance(provenance),
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// UpdateArtifactVersionProvenance records the pipeline execution which published an artifact version, it is the
+// path for the clients which cannot send the pipeline headers on upload.
+func (c *APIController) UpdateArtifactVersionProvenance(
+	ctx context.Context,
+	r api.UpdateArtifactVersionProvenanceRequestObject,
+) (api.UpdateArtifactVersionProvenanceResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return updateProvenance400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return updateProvenance400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionArtifactsUpload)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return api.UpdateArtifactVersionProvenance403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	if r.Body == nil {
+		return updateProvenance400Error(fmt.Errorf("request body is required")), nil
+	}
+	pipeline := mapFromAPIPipelineExecution(api.PipelineExecution(*r.Body))
+	if !pipeline.HasReference() {
+		return updateProvenance400Error(
+			fmt.Errorf("one of pipelineId, runUrl and commitSha is required"),
+		), nil
+	}
+
+	var artifactType *api.ArtifactType
+	if r.Params.ArtifactType != nil {
+		artifactType, err = ValidateAndGetArtifactType(regInfo.PackageType, string(*r.Params.ArtifactType))
+		if err != nil {
+			return updateProvenance400Error(err), nil
+		}
+	}
+
+	image := string(r.Artifact)
+	version := string(r.Version)
+	art, err := c.getVersionArtifact(ctx, regInfo, image, version, artifactType, types.WithoutMetadata())
+	if err != nil {
+		if errors.Is(err, store2.ErrResourceNotFound) {
+			return api.UpdateArtifactVersionProvenance404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse(
+					*GetErrorResponse(http.StatusNotFound, err.Error()),
+				),
+			}, nil
+		}
+		return updateProvenance500Error(err), nil
+	}
+
+	provenance := &types.ArtifactProvenance{
+		ArtifactID: art.ID,
+		Pipeline:   pipeline,
+	}
+	if session != nil {
+		provenance.CreatedBy = session.Principal.ID
+	}
+	if err = c.ProvenanceRepository.Upsert(ctx, provenance); err != nil {
+		log.Ctx(ctx).Error().Msgf("failed to update provenance of artifact: %s@%s with error: %v", image, version, err)
+		return updateProvenance500Error(fmt.Errorf("failed to update provenance: %w", err)), nil
+	}
+
+	return api.UpdateArtifactVersionProvenance200JSONResponse{
+		ArtifactProvenanceResponseJSONResponse: api.ArtifactProvenanceResponseJSONResponse{
+			Data:   mapToAPIArtifactProvenance(provenance),
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func mapFromAPIPipelineExecution(pipeline api.PipelineExecution) types.PipelineExecution {
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return strings.TrimSpace(*s)
+	}
+	return types.PipelineExecution{
+		System:     value(pipeline.System),
+		PipelineID: value(pipeline.PipelineId),
+		RunURL:     value(pipeline.RunUrl),
+		CommitSHA:  value(pipeline.CommitSha),
+	}
+}
+
+func mapToAPIArtifactProvenance(provenance *types.ArtifactProvenance) api.ArtifactProvenance {
+	value := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}
+	dto := api.ArtifactProvenance{
+		Pipeline: api.PipelineExecution{
+			System:     value(provenance.Pipeline.System),
+			PipelineId: value(provenance.Pipeline.PipelineID),
+			RunUrl:     value(provenance.Pipeline.RunURL),
+			CommitSha:  value(provenance.Pipeline.CommitSHA),
+		},
+		CreatedAt: GetTimeInMs(provenance.CreatedAt),
+	}
+	if provenance.CreatedBy != 0 {
+		dto.CreatedBy = &provenance.CreatedBy
+	}
+	return dto
+}
+
+func getProvenance400Error(err error) api.GetArtifactVersionProvenanceResponseObject {
+	return api.GetArtifactVersionProvenance400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func getProvenance404Error(err error) api.GetArtifactVersionProvenanceResponseObject {
+	return api.GetArtifactVersionProvenance404JSONResponse{
+		NotFoundJSONResponse: api.NotFoundJSONResponse(
+			*GetErrorResponse(http.StatusNotFound, err.Error()),
+		),
+	}
+}
+
+func getProvenance500Error(err error) api.GetArtifactVersionProvenanceResponseObject {
+	return api.GetArtifactVersionProvenance500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func updateProvenance400Error(err error) api.UpdateArtifactVersionProvenanceResponseObject {
+	return api.UpdateArtifactVersionProvenance400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func updateProvenance500Error(err error) api.UpdateArtifactVersionProvenanceResponseObject {
+	return api.UpdateArtifactVersionProvenance500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -25,6 +25,9 @@ import (
 	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/pkg/commons"
+	helmutil "github.com/harness/gitness/registry/app/utils/helm"
+	rpmutil "github.com/harness/gitness/registry/app/utils/rpm"
+	"github.com/harness/gitness/registry/app/utils/validation"
 	"github.com/harness/gitness/registry/types"
 	gitnessenum "github.com/harness/gitness/types/enum"
 
@@ -179,6 +182,462 @@ func (c *APIController) getManifestConfig(
 	return &config, nil
 }
 
+// setRpmSigningConfig stores the GPG settings of an RPM registry in its config. The signing key
+// and the trusted keys are validated upfront so a broken configuration does not surface only when
+// the index is rebuilt.
+func (c *APIController) setRpmSigningConfig(
+	ctx context.Context,
+	registry *types.Registry,
+	dto api.RegistryRequest,
+) error {
+	if registry.PackageType != api.PackageTypeRPM {
+		return nil
+	}
+	virtualConfig, err := dto.Config.AsVirtualConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get virtualConfig: %w", err)
+	}
+	if virtualConfig.RpmSigning == nil {
+		return nil
+	}
+	signing := virtualConfig.RpmSigning
+	signingConfig := &types.RpmSigningConfig{}
+	if signing.VerifyPackageSignatures != nil {
+		signingConfig.VerifyPackageSignatures = *signing.VerifyPackageSignatures
+	}
+	if signing.TrustedKeys != nil {
+		signingConfig.TrustedKeys = *signing.TrustedKeys
+	}
+	if signingConfig.VerifyPackageSignatures && len(signingConfig.TrustedKeys) == 0 {
+		return fmt.Errorf("trusted keys are required to verify package signatures")
+	}
+	if _, err = rpmutil.ReadTrustedKeys(signingConfig.TrustedKeys); err != nil {
+		return err
+	}
+	if signing.SigningKeySecretIdentifier != nil && *signing.SigningKeySecretIdentifier != "" {
+		signingConfig.SigningKeySecretIdentifier = *signing.SigningKeySecretIdentifier
+		signingConfig.SigningKeySecretSpaceID, err = c.RegistryMetadataHelper.GetSecretSpaceID(ctx,
+			signing.SigningKeySecretSpacePath)
+		if err != nil {
+			return err
+		}
+	}
+	if registry.Config == nil {
+		registry.Config = &types.RegistryConfig{}
+	}
+	registry.Config.RpmSigning = signingConfig
+	return nil
+}
+
+func (c *APIController) getRpmSigningConfig(
+	ctx context.Context,
+	registry *types.Registry,
+) *api.RpmSigningConfig {
+	if registry.Config == nil || registry.Config.RpmSigning == nil {
+		return nil
+	}
+	signingConfig := registry.Config.RpmSigning
+	trustedKeys := signingConfig.TrustedKeys
+	response := &api.RpmSigningConfig{
+		VerifyPackageSignatures: &signingConfig.VerifyPackageSignatures,
+		TrustedKeys:             &trustedKeys,
+	}
+	if signingConfig.SigningKeySecretIdentifier != "" {
+		response.SigningKeySecretIdentifier = &signingConfig.SigningKeySecretIdentifier
+		space, err := c.SpaceFinder.FindByID(ctx, signingConfig.SigningKeySecretSpaceID)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to find signing key secret space for registry: %s",
+				registry.Name)
+		} else {
+			response.SigningKeySecretSpacePath = &space.Path
+		}
+	}
+	return response
+}
+
+// setHelmProvenanceConfig stores the keys trusted for chart provenance signatures in the config of a Helm
+// registry.
+func setHelmProvenanceConfig(
+	registry *types.Registry,
+	dto api.RegistryRequest,
+) error {
+	if registry.PackageType != api.PackageTypeHELM || dto.Config == nil || dto.Config.Type != api.RegistryTypeVIRTUAL {
+		return nil
+	}
+	virtualConfig, err := dto.Config.AsVirtualConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get virtualConfig: %w", err)
+	}
+	if virtualConfig.HelmProvenance == nil {
+		return nil
+	}
+	provenanceConfig := &types.HelmProvenanceConfig{}
+	if virtualConfig.HelmProvenance.TrustedKeys != nil {
+		provenanceConfig.TrustedKeys = *virtualConfig.HelmProvenance.TrustedKeys
+	}
+	if _, err = helmutil.ReadTrustedKeys(provenanceConfig.TrustedKeys); err != nil {
+		return err
+	}
+	if registry.Config == nil {
+		registry.Config = &types.RegistryConfig{}
+	}
+	registry.Config.HelmProvenance = provenanceConfig
+	return nil
+}
+
+func getHelmProvenanceConfig(registry *types.Registry) *api.HelmProvenanceConfig {
+	if registry.Config == nil || registry.Config.HelmProvenance == nil {
+		return nil
+	}
+	trustedKeys := registry.Config.HelmProvenance.TrustedKeys
+	return &api.HelmProvenanceConfig{
+		TrustedKeys: &trustedKeys,
+	}
+}
+
+// setValidationRules stores the upload validation rules and the default artifact type in the config of a
+// virtual registry.
+func setValidationRules(
+	registry *types.Registry,
+	dto api.RegistryRequest,
+) error {
+	if dto.Config == nil || dto.Config.Type != api.RegistryTypeVIRTUAL {
+		return nil
+	}
+	virtualConfig, err := dto.Config.AsVirtualConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get virtualConfig: %w", err)
+	}
+	if virtualConfig.DefaultArtifactType != nil && registry.PackageType != api.PackageTypeHUGGINGFACE {
+		return fmt.Errorf("default artifact type is not supported for package type %s", registry.PackageType)
+	}
+	if virtualConfig.ValidationRules == nil && virtualConfig.DefaultArtifactType == nil {
+		return nil
+	}
+	if registry.Config == nil {
+		registry.Config = &types.RegistryConfig{}
+	}
+	if virtualConfig.DefaultArtifactType != nil {
+		registry.Config.DefaultArtifactType = virtualConfig.DefaultArtifactType
+	}
+	if virtualConfig.ValidationRules == nil {
+		return nil
+	}
+	rules := &types.ValidationRulesConfig{}
+	if virtualConfig.ValidationRules.RequiredMetadataFields != nil {
+		rules.RequiredMetadataFields = *virtualConfig.ValidationRules.RequiredMetadataFields
+	}
+	if virtualConfig.ValidationRules.VersionFormat != nil {
+		rules.VersionFormat = types.VersionFormat(*virtualConfig.ValidationRules.VersionFormat)
+	}
+	if virtualConfig.ValidationRules.VersionPattern != nil {
+		rules.VersionPattern = *virtualConfig.ValidationRules.VersionPattern
+	}
+	if virtualConfig.ValidationRules.MaxFileSize != nil {
+		rules.MaxFileSize = *virtualConfig.ValidationRules.MaxFileSize
+	}
+	if err = validation.CheckRules(rules); err != nil {
+		return err
+	}
+	registry.Config.ValidationRules = rules
+	return nil
+}
+
+func getValidationRules(registry *types.Registry) *api.ValidationRulesConfig {
+	if registry.Config == nil || registry.Config.ValidationRules == nil {
+		return nil
+	}
+	rules := registry.Config.ValidationRules
+	requiredMetadataFields := rules.RequiredMetadataFields
+	versionFormat := api.ValidationRulesConfigVersionFormat(rules.VersionFormat)
+	if versionFormat == "" {
+		versionFormat = api.ANY
+	}
+	return &api.ValidationRulesConfig{
+		RequiredMetadataFields: &requiredMetadataFields,
+		VersionFormat:          &versionFormat,
+		VersionPattern:         optionalString(rules.VersionPattern),
+		MaxFileSize:            &rules.MaxFileSize,
+	}
+}
+
+// setQuotaConfig stores the usage limits quota alerts are raised against in the config of a virtual registry.
+func setQuotaConfig(
+	registry *types.Registry,
+	dto api.RegistryRequest,
+) error {
+	if dto.Config == nil || dto.Config.Type != api.RegistryTypeVIRTUAL {
+		return nil
+	}
+	virtualConfig, err := dto.Config.AsVirtualConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get virtualConfig: %w", err)
+	}
+	if virtualConfig.Quota == nil {
+		return nil
+	}
+	quota, err := toQuotaConfig(virtualConfig.Quota)
+	if err != nil {
+		return err
+	}
+	if registry.Config == nil {
+		registry.Config = &types.RegistryConfig{}
+	}
+	registry.Config.Quota = quota
+	return nil
+}
+
+func toQuotaConfig(dto *api.QuotaConfig) (*types.QuotaConfig, error) {
+	quota := &types.QuotaConfig{}
+	if dto.StorageLimit != nil {
+		quota.StorageLimit = *dto.StorageLimit
+	}
+	if dto.BandwidthLimit != nil {
+		quota.BandwidthLimit = *dto.BandwidthLimit
+	}
+	if dto.Thresholds != nil {
+		quota.Thresholds = *dto.Thresholds
+	}
+	if quota.StorageLimit < 0 || quota.BandwidthLimit < 0 {
+		return nil, fmt.Errorf("quota limits must not be negative")
+	}
+	for _, t := range quota.Thresholds {
+		if t < 1 || t > 100 {
+			return nil, fmt.Errorf("invalid quota threshold %d, thresholds must be between 1 and 100", t)
+		}
+	}
+	return quota, nil
+}
+
+func getQuotaConfig(registry *types.Registry) *api.QuotaConfig {
+	if registry.Config == nil {
+		return nil
+	}
+	return fromQuotaConfig(registry.Config.Quota)
+}
+
+func fromQuotaConfig(quota *types.QuotaConfig) *api.QuotaConfig {
+	if quota == nil {
+		return nil
+	}
+	thresholds := quota.GetThresholds()
+	return &api.QuotaConfig{
+		StorageLimit:   &quota.StorageLimit,
+		BandwidthLimit: &quota.BandwidthLimit,
+		Thresholds:     &thresholds,
+	}
+}
+
+func setDownloadRateLimit(
+	registry *types.Registry,
+	dto api.RegistryRequest,
+) error {
+	if dto.Config == nil || dto.Config.Type != api.RegistryTypeVIRTUAL {
+		return nil
+	}
+	virtualConfig, err := dto.Config.AsVirtualConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get virtualConfig: %w", err)
+	}
+	if virtualConfig.DownloadRateLimit == nil {
+		return nil
+	}
+	limit := &types.DownloadRateLimitConfig{}
+	for _, f := range []struct {
+		dst *int64
+		src *int64
+	}{
+		{&limit.Default, virtualConfig.DownloadRateLimit.Default},
+		{&limit.Anonymous, virtualConfig.DownloadRateLimit.Anonymous},
+		{&limit.User, virtualConfig.DownloadRateLimit.User},
+		{&limit.ServiceAccount, virtualConfig.DownloadRateLimit.ServiceAccount},
+	} {
+		if f.src == nil {
+			continue
+		}
+		if *f.src < 0 {
+			return fmt.Errorf("download rate limits must not be negative")
+		}
+		*f.dst = *f.src
+	}
+	if registry.Config == nil {
+		registry.Config = &types.RegistryConfig{}
+	}
+	registry.Config.DownloadRateLimit = limit
+	return nil
+}
+
+func getDownloadRateLimit(registry *types.Registry) *api.DownloadRateLimitConfig {
+	if registry.Config == nil || registry.Config.DownloadRateLimit == nil {
+		return nil
+	}
+	limit := registry.Config.DownloadRateLimit
+	return &api.DownloadRateLimitConfig{
+		Default:        &limit.Default,
+		Anonymous:      &limit.Anonymous,
+		User:           &limit.User,
+		ServiceAccount: &limit.ServiceAccount,
+	}
+}
+
+func setListPreferences(
+	registry *types.Registry,
+	dto api.RegistryRequest,
+) error {
+	if dto.Config == nil || dto.Config.Type != api.RegistryTypeVIRTUAL {
+		return nil
+	}
+	virtualConfig, err := dto.Config.AsVirtualConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get virtualConfig: %w", err)
+	}
+	if virtualConfig.ListPreferences == nil {
+		return nil
+	}
+	preferences := &types.ListPreferencesConfig{}
+	if virtualConfig.ListPreferences.DefaultSort != nil {
+		switch sort := types.ListSort(*virtualConfig.ListPreferences.DefaultSort); sort {
+		case types.ListSortSemver, types.ListSortRecency:
+			preferences.DefaultSort = sort
+		default:
+			return fmt.Errorf("unsupported default sort: %s", sort)
+		}
+	}
+	if virtualConfig.ListPreferences.PageSize != nil {
+		pageSize := *virtualConfig.ListPreferences.PageSize
+		if pageSize < 1 || pageSize > types.MaxListPageSize {
+			return fmt.Errorf("page size must be between 1 and %d", types.MaxListPageSize)
+		}
+		preferences.PageSize = pageSize
+	}
+	if registry.Config == nil {
+		registry.Config = &types.RegistryConfig{}
+	}
+	registry.Config.ListPreferences = preferences
+	return nil
+}
+
+func getListPreferences(registry *types.Registry) *api.ListPreferencesConfig {
+	if registry.Config == nil || registry.Config.ListPreferences == nil {
+		return nil
+	}
+	preferences := registry.Config.ListPreferences
+	result := &api.ListPreferencesConfig{}
+	if preferences.DefaultSort != "" {
+		sort := api.ListSort(preferences.DefaultSort)
+		result.DefaultSort = &sort
+	}
+	if preferences.PageSize > 0 {
+		result.PageSize = &preferences.PageSize
+	}
+	return result
+}
+
+// setRegistryPolicy stores the policies which override the ones a virtual registry inherits from its spaces.
+func setRegistryPolicy(
+	registry *types.Registry,
+	dto api.RegistryRequest,
+) error {
+	if dto.Config == nil || dto.Config.Type != api.RegistryTypeVIRTUAL {
+		return nil
+	}
+	virtualConfig, err := dto.Config.AsVirtualConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get virtualConfig: %w", err)
+	}
+	if virtualConfig.Policy == nil {
+		return nil
+	}
+	policy, err := toRegistryPolicy(virtualConfig.Policy)
+	if err != nil {
+		return err
+	}
+	if registry.Config == nil {
+		registry.Config = &types.RegistryConfig{}
+	}
+	registry.Config.Policy = policy
+	return nil
+}
+
+func getRegistryPolicy(registry *types.Registry) *api.RegistryPolicy {
+	if registry.Config == nil || registry.Config.Policy == nil {
+		return nil
+	}
+	return fromRegistryPolicy(registry.Config.Policy)
+}
+
+func toRegistryPolicy(dto *api.RegistryPolicy) (*types.RegistryPolicy, error) {
+	if dto.RetentionDays != nil && *dto.RetentionDays < 0 {
+		return nil, fmt.Errorf("retention days must not be negative")
+	}
+	policy := &types.RegistryPolicy{
+		RetentionDays:        dto.RetentionDays,
+		Immutable:            dto.Immutable,
+		RequireSignatures:    dto.RequireSignatures,
+		DownloadStatsPrivacy: dto.DownloadStatsPrivacy,
+		DeletionApproval:     dto.DeletionApproval,
+	}
+	if dto.AutoQuarantineSeverity != nil {
+		severity := types.VulnerabilitySeverity(*dto.AutoQuarantineSeverity)
+		if !severity.IsValid() {
+			return nil, fmt.Errorf("invalid auto quarantine severity %q", severity)
+		}
+		policy.AutoQuarantineSeverity = &severity
+	}
+	if dto.Quota != nil {
+		quota, err := toQuotaConfig(dto.Quota)
+		if err != nil {
+			return nil, err
+		}
+		policy.Quota = quota
+	}
+	return policy, nil
+}
+
+func fromRegistryPolicy(policy *types.RegistryPolicy) *api.RegistryPolicy {
+	dto := &api.RegistryPolicy{
+		RetentionDays:        policy.RetentionDays,
+		Immutable:            policy.Immutable,
+		RequireSignatures:    policy.RequireSignatures,
+		Quota:                fromQuotaConfig(policy.Quota),
+		DownloadStatsPrivacy: policy.DownloadStatsPrivacy,
+		DeletionApproval:     policy.DeletionApproval,
+	}
+	if policy.AutoQuarantineSeverity != nil {
+		severity := api.RegistryPolicyAutoQuarantineSeverity(*policy.AutoQuarantineSeverity)
+		dto.AutoQuarantineSeverity = &severity
+	}
+	return dto
+}
+
+// setDeletionProtection applies the deletion protection toggle of the request, the setting of the existing
+// registry is kept when the request doesn't specify it.
+func setDeletionProtection(registry *types.Registry, existing *types.Registry, dto api.RegistryRequest) {
+	enabled := existing != nil && existing.IsDeletionProtected()
+	if dto.DeletionProtection != nil {
+		enabled = *dto.DeletionProtection
+	}
+	if registry.Config == nil {
+		if !enabled {
+			return
+		}
+		registry.Config = &types.RegistryConfig{}
+	}
+	registry.Config.DeletionProtection = enabled
+}
+
+func getDeletionProtection(registry *types.Registry) *bool {
+	enabled := registry.IsDeletionProtected()
+	return &enabled
+}
+
+func getDefaultArtifactType(registry *types.Registry) *api.ArtifactType {
+	if registry.Config == nil {
+		return nil
+	}
+	return registry.Config.DefaultArtifactType
+}
+
 func (c *APIController) setUpstreamProxyIDs(
 	ctx context.Context,
 	registry *types.Registry,
@@ -197,9 +656,25 @@ func (c *APIController) setUpstreamProxyIDs(
 		return nil
 	}
 
+	upstreamProxies, err := c.resolveUpstreamProxyIDs(ctx, registry, *virtualConfig.UpstreamProxies, parentID)
+	if err != nil {
+		return err
+	}
+	registry.UpstreamProxies = upstreamProxies
+	return nil
+}
+
+// resolveUpstreamProxyIDs returns the IDs of the upstream proxies with the given identifiers which are visible
+// from the parent space, in the order of the identifiers. Identifiers which aren't found are skipped.
+func (c *APIController) resolveUpstreamProxyIDs(
+	ctx context.Context,
+	registry *types.Registry,
+	identifiers []string,
+	parentID int64,
+) ([]int64, error) {
 	parentIDs, err := c.SpaceStore.GetAncestorIDs(ctx, parentID)
 	if err != nil {
-		return fmt.Errorf("failed to get ancestors upstream proxies: %w", err)
+		return nil, fmt.Errorf("failed to get ancestors upstream proxies: %w", err)
 	}
 	repos, err := c.RegistryRepository.GetAll(
 		ctx,
@@ -216,11 +691,11 @@ func (c *APIController) setUpstreamProxyIDs(
 	if repos == nil || err != nil {
 		err := fmt.Errorf("no repositories found for parentID: %d", parentID)
 		log.Ctx(ctx).Debug().Err(err).Msg("Failed to fetch repositories")
-		return err
+		return nil, err
 	}
 
 	var upstreamProxies []int64
-	for _, proxy := range *virtualConfig.UpstreamProxies {
+	for _, proxy := range identifiers {
 		for _, repo := range *repos {
 			if repo.RegIdentifier == proxy {
 				regID, err := strconv.ParseInt(repo.RegID, 10, 64)
@@ -229,15 +704,13 @@ func (c *APIController) setUpstreamProxyIDs(
 				}
 				// cycle detection: ensure adding regID as upstream to registry.ID doesn't create a cycle
 				if err := c.assertNoCycleOnAdd(ctx, registry.ID, regID, registry.Name); err != nil {
-					return err
+					return nil, err
 				}
 				upstreamProxies = append(upstreamProxies, regID)
 			}
 		}
 	}
-
-	registry.UpstreamProxies = upstreamProxies
-	return nil
+	return upstreamProxies, nil
 }
 
 func (c *APIController) assertNoCycleOnAdd(
@@ -341,22 +814,33 @@ func (c *APIController) CreateVirtualRepositoryResponse(
 	labels := registry.Labels
 
 	config := api.RegistryConfig{}
-	_ = config.FromVirtualConfig(api.VirtualConfig{UpstreamProxies: &upstreamProxyKeys})
+	_ = config.FromVirtualConfig(api.VirtualConfig{
+		UpstreamProxies:     &upstreamProxyKeys,
+		RpmSigning:          c.getRpmSigningConfig(ctx, registry),
+		HelmProvenance:      getHelmProvenanceConfig(registry),
+		ValidationRules:     getValidationRules(registry),
+		DefaultArtifactType: getDefaultArtifactType(registry),
+		Quota:               getQuotaConfig(registry),
+		Policy:              getRegistryPolicy(registry),
+		DownloadRateLimit:   getDownloadRateLimit(registry),
+		ListPreferences:     getListPreferences(registry),
+	})
 	response := &api.RegistryResponseJSONResponse{
 		Data: api.Registry{
-			Uuid:           registry.UUID,
-			Identifier:     registry.Name,
-			Description:    &registry.Description,
-			Url:            registryURL,
-			PackageType:    registry.PackageType,
-			AllowedPattern: &allowedPattern,
-			BlockedPattern: &blockedPattern,
-			CreatedAt:      &createdAt,
-			ModifiedAt:     &modifiedAt,
-			CleanupPolicy:  CreateCleanupPolicyResponse(cleanupPolicies),
-			Config:         &config,
-			Labels:         &labels,
-			IsPublic:       isPublic,
+			Uuid:               registry.UUID,
+			Identifier:         registry.Name,
+			Description:        &registry.Description,
+			Url:                registryURL,
+			PackageType:        registry.PackageType,
+			AllowedPattern:     &allowedPattern,
+			BlockedPattern:     &blockedPattern,
+			CreatedAt:          &createdAt,
+			ModifiedAt:         &modifiedAt,
+			CleanupPolicy:      CreateCleanupPolicyResponse(cleanupPolicies),
+			Config:             &config,
+			Labels:             &labels,
+			IsPublic:           isPublic,
+			DeletionProtection: getDeletionProtection(registry),
 		},
 		Status: api.StatusSUCCESS,
 	}
@@ -408,6 +892,9 @@ func (c *APIController) CreateUpstreamProxyResponseJSONResponse(
 	if upstreamproxy.FirewallMode != nil {
 		config.UpstreamProxyConfigFirewallMode = (*api.UpstreamProxyConfigFirewallMode)(upstreamproxy.FirewallMode)
 	}
+	if upstreamproxy.FirewallDelayHours > 0 {
+		config.UpstreamProxyConfigFirewallDelayHours = &upstreamproxy.FirewallDelayHours
+	}
 
 	if upstreamproxy.Config != nil && upstreamproxy.Config.RemoteUrlSuffix != "" {
 		config.RemoteUrlSuffix = &upstreamproxy.Config.RemoteUrlSuffix
@@ -415,21 +902,23 @@ func (c *APIController) CreateUpstreamProxyResponseJSONResponse(
 
 	registryConfig := &api.RegistryConfig{}
 	_ = registryConfig.FromUpstreamConfig(config)
+	deletionProtection := upstreamproxy.Config != nil && upstreamproxy.Config.DeletionProtection
 
 	response := &api.RegistryResponseJSONResponse{
 		Data: api.Registry{
-			Uuid:           upstreamproxy.RegistryUUID,
-			Identifier:     upstreamproxy.RepoKey,
-			Description:    &upstreamproxy.Description,
-			Labels:         &upstreamproxy.Labels,
-			PackageType:    upstreamproxy.PackageType,
-			Url:            upstreamproxy.RepoURL,
-			AllowedPattern: &allowedPattern,
-			BlockedPattern: &blockedPattern,
-			CreatedAt:      &createdAt,
-			ModifiedAt:     &modifiedAt,
-			Config:         registryConfig,
-			IsPublic:       isPublic,
+			Uuid:               upstreamproxy.RegistryUUID,
+			Identifier:         upstreamproxy.RepoKey,
+			Description:        &upstreamproxy.Description,
+			Labels:             &upstreamproxy.Labels,
+			PackageType:        upstreamproxy.PackageType,
+			Url:                upstreamproxy.RepoURL,
+			AllowedPattern:     &allowedPattern,
+			BlockedPattern:     &blockedPattern,
+			CreatedAt:          &createdAt,
+			ModifiedAt:         &modifiedAt,
+			Config:             registryConfig,
+			IsPublic:           isPublic,
+			DeletionProtection: &deletionProtection,
 		},
 		Status: api.StatusSUCCESS,
 	}
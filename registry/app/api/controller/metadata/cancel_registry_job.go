@@ -0,0 +1,117 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/registryjob"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// CancelRegistryJob cancels a queued or running job of a registry, the job keeps running until its operation
+// notices the cancellation.
+func (c *APIController) CancelRegistryJob(
+	ctx context.Context,
+	r api.CancelRegistryJobRequestObject,
+) (api.CancelRegistryJobResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return cancelRegistryJob400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return cancelRegistryJob400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.CancelRegistryJob401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.CancelRegistryJob403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	job, err := c.RegistryJobStore.GetByUUID(ctx, regInfo.RegistryID, string(r.JobUuid))
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return api.CancelRegistryJob404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, fmt.Sprintf("registry job '%s' not found", r.JobUuid)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return cancelRegistryJob500Error(err), nil
+	}
+
+	err = c.RegistryJobService.Cancel(ctx, job)
+	if errors.Is(err, registryjob.ErrJobCompleted) {
+		return api.CancelRegistryJob409JSONResponse{
+			ConflictJSONResponse: api.ConflictJSONResponse(
+				*GetErrorResponse(http.StatusConflict, fmt.Sprintf("registry job '%s' is already completed", r.JobUuid)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return cancelRegistryJob500Error(err), nil
+	}
+
+	return api.CancelRegistryJob200JSONResponse{
+		RegistryJobResponseJSONResponse: api.RegistryJobResponseJSONResponse{
+			Data:   mapToAPIRegistryJob(job),
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func cancelRegistryJob400Error(err error) api.CancelRegistryJobResponseObject {
+	return api.CancelRegistryJob400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func cancelRegistryJob500Error(err error) api.CancelRegistryJobResponseObject {
+	return api.CancelRegistryJob500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/deletion"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// CancelScheduledDeletion cancels a scheduled deletion whose execution didn't start yet, the artifact is kept.
+func (c *APIController) CancelScheduledDeletion(
+	ctx context.Context,
+	r api.CancelScheduledDeletionRequestObject,
+) (api.CancelScheduledDeletionResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return cancelScheduledDeletion400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return cancelScheduledDeletion400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionArtifactsDelete,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.CancelScheduledDeletion401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.CancelScheduledDeletion403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	scheduled, err := c.DeletionService.Find(ctx, regInfo.RegistryID, int64(r.ScheduledDeletionId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return api.CancelScheduledDeletion404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound,
+					fmt.Sprintf("scheduled deletion %d not found", r.ScheduledDeletionId)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return cancelScheduledDeletion500Error(err), nil
+	}
+
+	err = c.DeletionService.Cancel(ctx, scheduled, session.Principal.ID)
+	if errors.Is(err, deletion.ErrNotScheduled) {
+		return api.CancelScheduledDeletion409JSONResponse{
+			ConflictJSONResponse: api.ConflictJSONResponse(
+				*GetErrorResponse(http.StatusConflict,
+					fmt.Sprintf("scheduled deletion %d is %s", scheduled.ID, scheduled.State)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return cancelScheduledDeletion500Error(err), nil
+	}
+
+	c.auditScheduledDeletion(ctx, session.Principal, regInfo.ParentRef, regInfo.RegistryIdentifier, scheduled,
+		audit.ActionUpdated)
+
+	return api.CancelScheduledDeletion200JSONResponse{
+		ScheduledDeletionResponseJSONResponse: scheduledDeletionResponse(scheduled),
+	}, nil
+}
+
+func cancelScheduledDeletion400Error(err error) api.CancelScheduledDeletionResponseObject {
+	return api.CancelScheduledDeletion400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func cancelScheduledDeletion500Error(err error) api.CancelScheduledDeletionResponseObject {
+	return api.CancelScheduledDeletion500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
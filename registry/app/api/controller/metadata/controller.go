@@ -31,9 +31,23 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	"github.com/harness/gitness/registry/app/pkg/quarantine"
 	"github.com/harness/gitness/registry/app/services/refcache"
+	"github.com/harness/gitness/registry/app/services/registrypolicy"
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/app/utils/cargo"
+	"github.com/harness/gitness/registry/services/concurrency"
+	"github.com/harness/gitness/registry/services/deletion"
+	"github.com/harness/gitness/registry/services/deletionapproval"
+	"github.com/harness/gitness/registry/services/denylist"
+	"github.com/harness/gitness/registry/services/firewalldelay"
+	"github.com/harness/gitness/registry/services/notification"
+	"github.com/harness/gitness/registry/services/outbox"
+	"github.com/harness/gitness/registry/services/recentactivity"
+	"github.com/harness/gitness/registry/services/registryjob"
+	"github.com/harness/gitness/registry/services/registryusage"
+	"github.com/harness/gitness/registry/services/trash"
+	"github.com/harness/gitness/registry/services/upstreamprovenance"
+	"github.com/harness/gitness/registry/services/vulnerability"
 	webhook "github.com/harness/gitness/registry/services/webhook"
 	"github.com/harness/gitness/store/database/dbtx"
 )
@@ -45,41 +59,70 @@ var errPublicAccessToArtifactRegistriesDisabled = usererror.BadRequest(
 
 // APIController simple struct.
 type APIController struct {
-	ImageStore                   store.ImageRepository
-	fileManager                  filemanager.FileManager
-	BlobStore                    store.BlobRepository
-	GenericBlobStore             store.GenericBlobRepository
-	RegistryRepository           store.RegistryRepository
-	UpstreamProxyStore           store.UpstreamProxyConfigRepository
-	TagStore                     store.TagRepository
-	ManifestStore                store.ManifestRepository
-	CleanupPolicyStore           store.CleanupPolicyRepository
-	SpaceFinder                  interfaces.SpaceFinder
-	tx                           dbtx.Transactor
-	URLProvider                  urlprovider.Provider
-	Authorizer                   authz.Authorizer
-	AuditService                 audit.Service
-	ArtifactStore                store.ArtifactRepository
-	WebhooksRepository           store.WebhooksRepository
-	WebhooksExecutionRepository  store.WebhooksExecutionRepository
-	RegistryMetadataHelper       interfaces.RegistryMetadataHelper
-	WebhookService               webhook.ServiceInterface
-	ArtifactEventReporter        *registryevents.Reporter
-	DownloadStatRepository       store.DownloadStatRepository
-	SetupDetailsAuthHeaderPrefix string
-	RegistryBlobStore            store.RegistryBlobRepository
-	RegFinder                    refcache.RegistryFinder
-	PostProcessingReporter       *registrypostprocessingevents.Reporter
-	CargoRegistryHelper          cargo.RegistryHelper
-	SpaceController              *spacecontroller.Controller
-	QuarantineArtifactRepository store.QuarantineArtifactRepository
-	QuarantineFinder             quarantine.Finder
-	SpaceStore                   gstore.SpaceStore
-	UntaggedImagesEnabled        func(ctx context.Context) bool
-	PackageWrapper               interfaces.PackageWrapper
-	PublicAccess                 publicaccess.Service
-	StorageService               *storage.Service
-	app                          *docker.App
+	ImageStore                    store.ImageRepository
+	fileManager                   filemanager.FileManager
+	BlobStore                     store.BlobRepository
+	GenericBlobStore              store.GenericBlobRepository
+	RegistryRepository            store.RegistryRepository
+	UpstreamProxyStore            store.UpstreamProxyConfigRepository
+	TagStore                      store.TagRepository
+	ManifestStore                 store.ManifestRepository
+	CleanupPolicyStore            store.CleanupPolicyRepository
+	SpaceFinder                   interfaces.SpaceFinder
+	tx                            dbtx.Transactor
+	URLProvider                   urlprovider.Provider
+	Authorizer                    authz.Authorizer
+	AuditService                  audit.Service
+	ArtifactStore                 store.ArtifactRepository
+	WebhooksRepository            store.WebhooksRepository
+	WebhooksExecutionRepository   store.WebhooksExecutionRepository
+	RegistryMetadataHelper        interfaces.RegistryMetadataHelper
+	WebhookService                webhook.ServiceInterface
+	ArtifactEventReporter         *registryevents.Reporter
+	DownloadStatRepository        store.DownloadStatRepository
+	SetupDetailsAuthHeaderPrefix  string
+	RegistryBlobStore             store.RegistryBlobRepository
+	RegFinder                     refcache.RegistryFinder
+	PostProcessingReporter        *registrypostprocessingevents.Reporter
+	CargoRegistryHelper           cargo.RegistryHelper
+	SpaceController               *spacecontroller.Controller
+	QuarantineArtifactRepository  store.QuarantineArtifactRepository
+	QuarantineFinder              quarantine.Finder
+	SpaceStore                    gstore.SpaceStore
+	UntaggedImagesEnabled         func(ctx context.Context) bool
+	PackageWrapper                interfaces.PackageWrapper
+	PublicAccess                  publicaccess.Service
+	StorageService                *storage.Service
+	RegistryPolicyService         *registrypolicy.Service
+	IndexBuildRepository          store.IndexBuildRepository
+	app                           *docker.App
+	TrashService                  *trash.Service
+	MetadataHistoryRepository     store.ArtifactMetadataHistoryRepository
+	GarbageRepository             store.GarbageRepository
+	NotificationChannelRepository store.NotificationChannelRepository
+	NotificationDispatcher        *notification.Dispatcher
+	ImageDescriptionRepository    store.ImageDescriptionRepository
+	Outbox                        *outbox.Outbox
+	FailedUploadStore             store.FailedUploadRepository
+	UploadFailureStatsStore       store.UploadFailureStatsRepository
+	ConcurrencyLimiter            *concurrency.Limiter
+	RegistryJobStore              store.RegistryJobRepository
+	RegistryJobService            *registryjob.Service
+	RegistryUsageService          *registryusage.Service
+	ImageStarRepository           store.ImageStarRepository
+	RecentActivityService         *recentactivity.Service
+	DeletionApprovalService       *deletionapproval.Service
+	DeletionService               *deletion.Service
+	QuarantineAccessAttemptStore  store.QuarantineAccessAttemptRepository
+	PackageDenylistService        *denylist.Service
+	VulnerabilityService          *vulnerability.Service
+	ProvenanceRepository          store.ArtifactProvenanceRepository
+	OCIImporter                   *docker.Importer
+	OCIExporter                   *docker.Exporter
+	SearchRepository              store.ArtifactSearchRepository
+	FirewallDelayService          *firewalldelay.Service
+	UpstreamProvenanceService     *upstreamprovenance.Service
+	syncLimiter                   *principalRateLimiter
 }
 
 func NewAPIController(
@@ -115,41 +158,98 @@ func NewAPIController(
 	packageWrapper interfaces.PackageWrapper,
 	publicAccess publicaccess.Service,
 	storageService *storage.Service,
+	registryPolicyService *registrypolicy.Service,
+	indexBuildRepository store.IndexBuildRepository,
 	app *docker.App,
+	trashService *trash.Service,
+	metadataHistoryRepository store.ArtifactMetadataHistoryRepository,
+	garbageRepository store.GarbageRepository,
+	notificationChannelRepository store.NotificationChannelRepository,
+	notificationDispatcher *notification.Dispatcher,
+	imageDescriptionRepository store.ImageDescriptionRepository,
+	eventOutbox *outbox.Outbox,
+	failedUploadDao store.FailedUploadRepository,
+	uploadFailureStatsDao store.UploadFailureStatsRepository,
+	concurrencyLimiter *concurrency.Limiter,
+	registryJobDao store.RegistryJobRepository,
+	registryJobService *registryjob.Service,
+	registryUsageService *registryusage.Service,
+	imageStarRepository store.ImageStarRepository,
+	recentActivityService *recentactivity.Service,
+	deletionApprovalService *deletionapproval.Service,
+	deletionService *deletion.Service,
+	quarantineAccessAttemptStore store.QuarantineAccessAttemptRepository,
+	packageDenylistService *denylist.Service,
+	vulnerabilityService *vulnerability.Service,
+	provenanceRepository store.ArtifactProvenanceRepository,
+	ociImporter *docker.Importer,
+	ociExporter *docker.Exporter,
+	searchRepository store.ArtifactSearchRepository,
+	firewallDelayService *firewalldelay.Service,
+	upstreamProvenanceService *upstreamprovenance.Service,
 ) *APIController {
 	return &APIController{
-		fileManager:                  fileManager,
-		RegistryRepository:           repositoryStore,
-		UpstreamProxyStore:           upstreamProxyStore,
-		TagStore:                     tagStore,
-		ManifestStore:                manifestStore,
-		CleanupPolicyStore:           cleanupPolicyStore,
-		ImageStore:                   imageStore,
-		SpaceFinder:                  spaceFinder,
-		tx:                           tx,
-		URLProvider:                  urlProvider,
-		Authorizer:                   authorizer,
-		AuditService:                 auditService,
-		ArtifactStore:                artifactStore,
-		WebhooksRepository:           webhooksRepository,
-		WebhooksExecutionRepository:  webhooksExecutionRepository,
-		RegistryMetadataHelper:       registryMetadataHelper,
-		WebhookService:               webhookService,
-		ArtifactEventReporter:        artifactEventReporter,
-		DownloadStatRepository:       downloadStatRepository,
-		SetupDetailsAuthHeaderPrefix: setupDetailsAuthHeaderPrefix,
-		RegistryBlobStore:            registryBlobStore,
-		RegFinder:                    regFinder,
-		PostProcessingReporter:       postProcessingReporter,
-		CargoRegistryHelper:          cargoRegistryHelper,
-		SpaceController:              spaceController,
-		QuarantineArtifactRepository: quarantineArtifactRepository,
-		QuarantineFinder:             quarantineFinder,
-		SpaceStore:                   spaceStore,
-		UntaggedImagesEnabled:        untaggedImagesEnabled,
-		PackageWrapper:               packageWrapper,
-		PublicAccess:                 publicAccess,
-		StorageService:               storageService,
-		app:                          app,
+		fileManager:                   fileManager,
+		RegistryRepository:            repositoryStore,
+		UpstreamProxyStore:            upstreamProxyStore,
+		TagStore:                      tagStore,
+		ManifestStore:                 manifestStore,
+		CleanupPolicyStore:            cleanupPolicyStore,
+		ImageStore:                    imageStore,
+		SpaceFinder:                   spaceFinder,
+		tx:                            tx,
+		URLProvider:                   urlProvider,
+		Authorizer:                    authorizer,
+		AuditService:                  auditService,
+		ArtifactStore:                 artifactStore,
+		WebhooksRepository:            webhooksRepository,
+		WebhooksExecutionRepository:   webhooksExecutionRepository,
+		RegistryMetadataHelper:        registryMetadataHelper,
+		WebhookService:                webhookService,
+		ArtifactEventReporter:         artifactEventReporter,
+		DownloadStatRepository:        downloadStatRepository,
+		SetupDetailsAuthHeaderPrefix:  setupDetailsAuthHeaderPrefix,
+		RegistryBlobStore:             registryBlobStore,
+		RegFinder:                     regFinder,
+		PostProcessingReporter:        postProcessingReporter,
+		CargoRegistryHelper:           cargoRegistryHelper,
+		SpaceController:               spaceController,
+		QuarantineArtifactRepository:  quarantineArtifactRepository,
+		QuarantineFinder:              quarantineFinder,
+		SpaceStore:                    spaceStore,
+		UntaggedImagesEnabled:         untaggedImagesEnabled,
+		PackageWrapper:                packageWrapper,
+		PublicAccess:                  publicAccess,
+		StorageService:                storageService,
+		RegistryPolicyService:         registryPolicyService,
+		IndexBuildRepository:          indexBuildRepository,
+		app:                           app,
+		TrashService:                  trashService,
+		MetadataHistoryRepository:     metadataHistoryRepository,
+		GarbageRepository:             garbageRepository,
+		NotificationChannelRepository: notificationChannelRepository,
+		NotificationDispatcher:        notificationDispatcher,
+		ImageDescriptionRepository:    imageDescriptionRepository,
+		Outbox:                        eventOutbox,
+		FailedUploadStore:             failedUploadDao,
+		UploadFailureStatsStore:       uploadFailureStatsDao,
+		ConcurrencyLimiter:            concurrencyLimiter,
+		RegistryJobStore:              registryJobDao,
+		RegistryJobService:            registryJobService,
+		RegistryUsageService:          registryUsageService,
+		ImageStarRepository:           imageStarRepository,
+		RecentActivityService:         recentActivityService,
+		DeletionApprovalService:       deletionApprovalService,
+		DeletionService:               deletionService,
+		QuarantineAccessAttemptStore:  quarantineAccessAttemptStore,
+		PackageDenylistService:        packageDenylistService,
+		VulnerabilityService:          vulnerabilityService,
+		ProvenanceRepository:          provenanceRepository,
+		OCIImporter:                   ociImporter,
+		OCIExporter:                   ociExporter,
+		SearchRepository:              searchRepository,
+		FirewallDelayService:          firewallDelayService,
+		UpstreamProvenanceService:     upstreamProvenanceService,
+		syncLimiter:                   newPrincipalRateLimiter(syncRequestRate, syncRequestBurst),
 	}
 }
@@ -0,0 +1,109 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// CreateNotificationChannel adds a channel which the selected events of the registry are delivered to.
+func (c *APIController) CreateNotificationChannel(
+	ctx context.Context,
+	r api.CreateNotificationChannelRequestObject,
+) (api.CreateNotificationChannelResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return createNotificationChannel400Error(err.Error())
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return createNotificationChannel400Error(err.Error())
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		log.Ctx(ctx).Error().Msgf("permission check failed while creating notification channel for registry: %s, "+
+			"error: %v", regInfo.RegistryIdentifier, err)
+		return api.CreateNotificationChannel403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	if r.Body == nil {
+		return createNotificationChannel400Error("request body is required")
+	}
+	req := api.NotificationChannelRequest(*r.Body)
+	if req.Identifier == "" {
+		return createNotificationChannel400Error("identifier is required")
+	}
+	now := time.Now()
+	channel := &types.NotificationChannel{
+		RegistryID: regInfo.RegistryID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		CreatedBy:  session.Principal.ID,
+		UpdatedBy:  session.Principal.ID,
+	}
+	if err = c.applyNotificationChannelRequest(channel, req); err != nil {
+		return createNotificationChannel400Error(err.Error())
+	}
+
+	if err = c.NotificationChannelRepository.Create(ctx, channel); err != nil {
+		if isDuplicateKeyError(err) {
+			return createNotificationChannel400Error("notification channel with identifier " + req.Identifier +
+				" already exists")
+		}
+		log.Ctx(ctx).Error().Err(err).Msgf("failed to create notification channel: %s", req.Identifier)
+		return api.CreateNotificationChannel500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	return api.CreateNotificationChannel201JSONResponse{
+		NotificationChannelResponseJSONResponse: api.NotificationChannelResponseJSONResponse{
+			Data:   toAPINotificationChannel(channel),
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func createNotificationChannel400Error(message string) (api.CreateNotificationChannelResponseObject, error) {
+	return api.CreateNotificationChannel400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, message),
+		),
+	}, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/denylist"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+var errEmptyPackageDenylistEntry = errors.New("the package denylist entry is required")
+
+// CreatePackageDenylistEntry adds an entry to the package denylist of the space, it denies the package in all
+// registries of the space and of its subspaces.
+func (c *APIController) CreatePackageDenylistEntry(
+	ctx context.Context,
+	r api.CreatePackageDenylistEntryRequestObject,
+) (api.CreatePackageDenylistEntryResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return createPackageDenylistEntry400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return createPackageDenylistEntry400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryEdit,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.CreatePackageDenylistEntry401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.CreatePackageDenylistEntry403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	if r.Body == nil {
+		return createPackageDenylistEntry400Error(errEmptyPackageDenylistEntry), nil
+	}
+	entry := &registryTypes.PackageDenylistEntry{
+		SpaceID:   space.ID,
+		Name:      r.Body.Name,
+		Reason:    r.Body.Reason,
+		Source:    registryTypes.PackageDenylistSourceManual,
+		CreatedBy: session.Principal.ID,
+	}
+	if r.Body.PackageType != nil {
+		entry.PackageType = string(*r.Body.PackageType)
+	}
+	if r.Body.VersionRange != nil {
+		entry.VersionRange = *r.Body.VersionRange
+	}
+
+	err = c.PackageDenylistService.Create(ctx, entry)
+	if errors.Is(err, denylist.ErrInvalidEntry) {
+		return createPackageDenylistEntry400Error(err), nil
+	}
+	if errors.Is(err, store.ErrDuplicate) {
+		return api.CreatePackageDenylistEntry409JSONResponse{
+			ConflictJSONResponse: api.ConflictJSONResponse(
+				*GetErrorResponse(http.StatusConflict,
+					fmt.Sprintf("package %s is already denied for versions '%s'", entry.Name, entry.VersionRange)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return api.CreatePackageDenylistEntry500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	c.auditPackageDenylistEntry(ctx, session.Principal, space.Path, entry, audit.ActionCreated)
+
+	return api.CreatePackageDenylistEntry201JSONResponse{
+		PackageDenylistEntryResponseJSONResponse: api.PackageDenylistEntryResponseJSONResponse{
+			Data:   mapToAPIPackageDenylistEntry(entry),
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func createPackageDenylistEntry400Error(err error) api.CreatePackageDenylistEntryResponseObject {
+	return api.CreatePackageDenylistEntry400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/api/utils"
+	"github.com/harness/gitness/registry/services/notification"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store"
 	"github.com/harness/gitness/types/enum"
@@ -206,6 +207,19 @@ func (c *APIController) QuarantineFilePath(
 		}, nil
 	}
 
+	event := &notification.Event{
+		Type:       types.NotificationEventQuarantine,
+		RegistryID: regInfo.RegistryID,
+		Registry:   regInfo.RegistryIdentifier,
+		Artifact:   artifactName,
+		Principal:  session.Principal.DisplayName,
+		Reason:     reason,
+	}
+	if version != nil {
+		event.Version = *version
+	}
+	c.notify(ctx, event)
+
 	// Evict cache after creating quarantine entry
 	if version != nil {
 		c.QuarantineFinder.EvictCache(ctx, regInfo.RegistryID, artifactName, *version, artifactType)
@@ -26,6 +26,8 @@ import (
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/capability"
+	"github.com/harness/gitness/registry/services/firewalldelay"
 	registrytypes "github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/types"
 	gitnessenum "github.com/harness/gitness/types/enum"
@@ -109,6 +111,15 @@ func (c *APIController) CreateRegistry(
 		}, nil
 	}
 
+	if registryRequest.Config.Type == artifact.RegistryTypeUPSTREAM &&
+		c.PackageWrapper.IsValidPackageType(string(registryRequest.PackageType)) {
+		if err = capability.Check(registryRequest.PackageType, capability.UpstreamProxy); err != nil {
+			return artifact.CreateRegistry501JSONResponse{
+				NotImplementedJSONResponse: artifact.NotImplementedJSONResponse(*GetNotImplementedResponse(err)),
+			}, nil
+		}
+	}
+
 	if registryRequest.Config.Type == artifact.RegistryTypeVIRTUAL {
 		return c.createVirtualRegistry(ctx, registryRequest, regInfo, session, parentRef)
 	}
@@ -122,6 +133,7 @@ func (c *APIController) CreateRegistry(
 		//nolint:nilerr
 		return throwCreateRegistry400Error(err), nil
 	}
+	setDeletionProtection(registry, nil, registryRequest)
 
 	err = c.tx.WithTx(
 		ctx, func(ctx context.Context) error {
@@ -196,6 +208,28 @@ func (c *APIController) createVirtualRegistry(
 	if err != nil {
 		return throwCreateRegistry400Error(err), nil
 	}
+	if err = c.setRpmSigningConfig(ctx, registry, registryRequest); err != nil {
+		return throwCreateRegistry400Error(err), nil
+	}
+	if err = setHelmProvenanceConfig(registry, registryRequest); err != nil {
+		return throwCreateRegistry400Error(err), nil
+	}
+	if err = setValidationRules(registry, registryRequest); err != nil {
+		return throwCreateRegistry400Error(err), nil
+	}
+	if err = setQuotaConfig(registry, registryRequest); err != nil {
+		return throwCreateRegistry400Error(err), nil
+	}
+	if err = setRegistryPolicy(registry, registryRequest); err != nil {
+		return throwCreateRegistry400Error(err), nil
+	}
+	if err = setDownloadRateLimit(registry, registryRequest); err != nil {
+		return throwCreateRegistry400Error(err), nil
+	}
+	if err = setListPreferences(registry, registryRequest); err != nil {
+		return throwCreateRegistry400Error(err), nil
+	}
+	setDeletionProtection(registry, nil, registryRequest)
 	id, err := c.createRegistry(ctx, registry, string(parentRef), &session.Principal, false)
 	if err != nil {
 		if isDuplicateKeyError(err) {
@@ -274,6 +308,7 @@ func (c *APIController) createUpstreamProxyWithAudit(
 		audit.NewResource(audit.ResourceTypeRegistryUpstreamProxy, registry.Name),
 		audit.ActionCreated,
 		parentRef,
+		audit.WithActorChain(audit.ActorChain(ctx, principal)),
 		audit.WithNewObject(registryObject),
 	)
 	if auditErr != nil {
@@ -323,6 +358,7 @@ func (c *APIController) createRegistry(
 			audit.NewResource(audit.ResourceTypeRegistry, registry.Name),
 			audit.ActionCreated,
 			parentRef,
+			audit.WithActorChain(audit.ActorChain(ctx, *principal)),
 			audit.WithNewObject(
 				audit.RegistryObject{
 					Registry: *registry,
@@ -432,6 +468,13 @@ func (c *APIController) CreateUpstreamProxyEntity(
 	if config.Url != nil {
 		upstreamProxyConfigEntity.URL = *config.Url
 	}
+	if config.UpstreamProxyConfigFirewallDelayHours != nil {
+		hours := *config.UpstreamProxyConfigFirewallDelayHours
+		if err := firewalldelay.ValidateDelay(dto.PackageType, hours); err != nil {
+			return nil, nil, usererror.BadRequest(err.Error())
+		}
+		upstreamProxyConfigEntity.FirewallDelayHours = hours
+	}
 	if config.Source != nil && len(string(*config.Source)) > 0 {
 		ok := c.PackageWrapper.ValidateUpstreamSource(string(dto.PackageType), string(*config.Source))
 		if !ok {
@@ -228,6 +228,7 @@ func TestCreateRegistry(t *testing.T) {
 					audit.ActionCreated,
 					"root",
 					mock.Anything,
+					mock.Anything,
 				).Return(nil).Once()
 
 				// Setup registry repo mock.
@@ -238,7 +239,7 @@ func TestCreateRegistry(t *testing.T) {
 
 				packageFactory := factory.NewPackageFactory()
 				packageFactory.Register(pkg.NewDockerPackageType(nil))
-				mockPackageWrapper := helpers.NewPackageWrapper(packageFactory, mockRegFinder)
+				mockPackageWrapper := helpers.NewPackageWrapper(packageFactory, mockRegFinder, nil)
 
 				// Create controller with updated signature.
 				return metadata.NewAPIController(
@@ -276,7 +277,35 @@ func TestCreateRegistry(t *testing.T) {
 					mockPackageWrapper,
 					mockPublicAccessService,
 					nil, // storageService.
+					nil, // registryPolicyService.
+					nil, // indexBuildRepository.
 					nil, // app.
+					nil, // trashService.
+					nil, // metadataHistoryRepository.
+					nil, // garbageRepository.
+					nil, // notificationChannelRepository.
+					nil, // notificationDispatcher.
+					nil, // imageDescriptionRepository.
+					nil, // eventOutbox.
+					nil, // failedUploadDao.
+					nil, // uploadFailureStatsDao.
+					nil, // concurrencyLimiter.
+					nil, // registryJobDao.
+					nil, // registryJobService.
+					nil, // registryUsageService.
+					nil, // imageStarRepository.
+					nil, // recentActivityService.
+					nil, // deletionApprovalService.
+					nil, // deletionService.
+					nil, // quarantineAccessAttemptStore.
+					nil, // packageDenylistService.
+					nil, // vulnerabilityService.
+					nil, // provenanceRepository.
+					nil, // ociImporter.
+					nil, // ociExporter.
+					nil, // searchRepository.
+					nil, // firewallDelayService.
+					nil, // upstreamProvenanceService.
 				)
 			},
 		},
@@ -366,7 +395,35 @@ func TestCreateRegistry(t *testing.T) {
 					mockPackageWrapper,
 					mockPublicAccessService,
 					nil, // storageService.
+					nil, // registryPolicyService.
+					nil, // indexBuildRepository.
 					nil, // app.
+					nil, // trashService.
+					nil, // metadataHistoryRepository.
+					nil, // garbageRepository.
+					nil, // notificationChannelRepository.
+					nil, // notificationDispatcher.
+					nil, // imageDescriptionRepository.
+					nil, // eventOutbox.
+					nil, // failedUploadDao.
+					nil, // uploadFailureStatsDao.
+					nil, // concurrencyLimiter.
+					nil, // registryJobDao.
+					nil, // registryJobService.
+					nil, // registryUsageService.
+					nil, // imageStarRepository.
+					nil, // recentActivityService.
+					nil, // deletionApprovalService.
+					nil, // deletionService.
+					nil, // quarantineAccessAttemptStore.
+					nil, // packageDenylistService.
+					nil, // vulnerabilityService.
+					nil, // provenanceRepository.
+					nil, // ociImporter.
+					nil, // ociExporter.
+					nil, // searchRepository.
+					nil, // firewallDelayService.
+					nil, // upstreamProvenanceService.
 				)
 			},
 		},
@@ -437,7 +494,8 @@ func TestCreateRegistry(t *testing.T) {
 				//nolint:errcheck
 				logCalled := controller.AuditService.(*mocks.AuditService).AssertCalled(t, "Log",
 					mock.Anything,
-					mock.Anything, mock.Anything, audit.ActionCreated, mock.Anything, mock.Anything)
+					mock.Anything, mock.Anything, audit.ActionCreated, mock.Anything, mock.Anything,
+					mock.Anything)
 				assert.True(t, logCalled, "Expected Log call not made")
 
 			case api.CreateRegistry400JSONResponse:
@@ -24,6 +24,7 @@ import (
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/request"
 	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/capability"
 	"github.com/harness/gitness/types/enum"
 
 	"github.com/rs/zerolog/log"
@@ -37,38 +38,8 @@ func (c *APIController) CreateWebhook(
 ) (api.CreateWebhookResponseObject, error) {
 	webhookRequest := api.WebhookRequest(*r.Body)
 
-	// Validate required fields
-	if webhookRequest.Identifier == "" {
-		return createWebhookBadRequestErrorResponse(fmt.Errorf("webhook identifier is required"))
-	}
-	if webhookRequest.Name == "" {
-		return createWebhookBadRequestErrorResponse(fmt.Errorf("webhook name is required"))
-	}
-	if webhookRequest.Url == "" {
-		return createWebhookBadRequestErrorResponse(fmt.Errorf("webhook url is required"))
-	}
-
-	// Validate URL format
-	parsedURL, err := url.Parse(webhookRequest.Url)
-	if err != nil {
-		return createWebhookBadRequestErrorResponse(fmt.Errorf("invalid webhook url format: %w", err))
-	}
-	if parsedURL.Scheme == "" || parsedURL.Host == "" {
-		return createWebhookBadRequestErrorResponse(fmt.Errorf("webhook url must be a valid URL with scheme and host"))
-	}
-	// Only allow http and https schemes (case-insensitive whitelist)
-	allowedSchemes := map[string]bool{
-		"http":  true,
-		"https": true,
-	}
-	if !allowedSchemes[strings.ToLower(parsedURL.Scheme)] {
-		return createWebhookBadRequestErrorResponse(fmt.Errorf("webhook url must use http or https scheme"))
-	}
-
-	if webhookRequest.Identifier == internalWebhookIdentifier {
-		return createWebhookBadRequestErrorResponse(
-			fmt.Errorf("webhook identifier %s is reserved", internalWebhookIdentifier),
-		)
+	if err := validateWebhookRequest(webhookRequest); err != nil {
+		return createWebhookBadRequestErrorResponse(err)
 	}
 	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
 	if err != nil {
@@ -80,6 +51,11 @@ func (c *APIController) CreateWebhook(
 			fmt.Errorf("not allowed to create webhook for %s registry", regInfo.RegistryType),
 		)
 	}
+	if err = capability.Check(regInfo.PackageType, capability.Webhooks); err != nil {
+		return api.CreateWebhook501JSONResponse{
+			NotImplementedJSONResponse: api.NotImplementedJSONResponse(*GetNotImplementedResponse(err)),
+		}, nil
+	}
 	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
 	if err != nil {
 		return createWebhookBadRequestErrorResponse(err)
@@ -153,6 +129,48 @@ func (c *APIController) CreateWebhook(
 	}, nil
 }
 
+// validateWebhookRequest checks the fields of a webhook which is about to be created.
+func validateWebhookRequest(webhookRequest api.WebhookRequest) error {
+	// Validate required fields
+	if webhookRequest.Identifier == "" {
+		return fmt.Errorf("webhook identifier is required")
+	}
+	if webhookRequest.Name == "" {
+		return fmt.Errorf("webhook name is required")
+	}
+	if webhookRequest.Url == "" {
+		return fmt.Errorf("webhook url is required")
+	}
+	if err := validateWebhookURL(webhookRequest.Url); err != nil {
+		return err
+	}
+
+	if webhookRequest.Identifier == internalWebhookIdentifier {
+		return fmt.Errorf("webhook identifier %s is reserved", internalWebhookIdentifier)
+	}
+	return nil
+}
+
+func validateWebhookURL(rawURL string) error {
+	// Validate URL format
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook url format: %w", err)
+	}
+	if parsedURL.Scheme == "" || parsedURL.Host == "" {
+		return fmt.Errorf("webhook url must be a valid URL with scheme and host")
+	}
+	// Only allow http and https schemes (case-insensitive whitelist)
+	allowedSchemes := map[string]bool{
+		"http":  true,
+		"https": true,
+	}
+	if !allowedSchemes[strings.ToLower(parsedURL.Scheme)] {
+		return fmt.Errorf("webhook url must use http or https scheme")
+	}
+	return nil
+}
+
 func createWebhookBadRequestErrorResponse(err error) (api.CreateWebhookResponseObject, error) {
 	return api.CreateWebhook400JSONResponse{
 		BadRequestJSONResponse: api.BadRequestJSONResponse(
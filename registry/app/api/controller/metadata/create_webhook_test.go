@@ -26,6 +26,7 @@ import (
 	"github.com/harness/gitness/registry/app/api/controller/metadata"
 	"github.com/harness/gitness/registry/app/api/controller/mocks"
 	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/capability"
 	registrytypes "github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/registry/utils"
 	coretypes "github.com/harness/gitness/types"
@@ -63,6 +64,7 @@ func TestCreateWebhook(t *testing.T) {
 					ParentID:           2,
 					ParentRef:          "root/parent",
 					RegistryType:       api.RegistryTypeVIRTUAL,
+					PackageType:        api.PackageTypeDOCKER,
 				}
 
 				space := &coretypes.SpaceCore{
@@ -181,6 +183,7 @@ func TestCreateWebhook(t *testing.T) {
 					ParentID:           2,
 					ParentRef:          "root/parent",
 					RegistryType:       api.RegistryTypeVIRTUAL,
+					PackageType:        api.PackageTypeDOCKER,
 				}
 				mockRegistryMetadataHelper.On("GetRegistryRequestBaseInfo", mock.Anything, "", "reg").
 					Return(regInfo, nil)
@@ -238,6 +241,42 @@ func TestCreateWebhook(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "webhooks_not_supported_for_package_type",
+			setupMocks: func(c *metadata.APIController) {
+				mockRegistryMetadataHelper := new(mocks.RegistryMetadataHelper)
+				regInfo := &registrytypes.RegistryRequestBaseInfo{
+					RegistryID:         1,
+					RegistryIdentifier: "reg",
+					ParentID:           2,
+					ParentRef:          "root/parent",
+					RegistryType:       api.RegistryTypeVIRTUAL,
+					PackageType:        api.PackageTypeNPM,
+				}
+				mockRegistryMetadataHelper.On("GetRegistryRequestBaseInfo", mock.Anything, "", "reg").
+					Return(regInfo, nil)
+				c.RegistryMetadataHelper = mockRegistryMetadataHelper
+			},
+			request: api.CreateWebhookRequestObject{
+				RegistryRef: "reg",
+				Body: &api.CreateWebhookJSONRequestBody{
+					Name:       testWebhookIdentifier,
+					Identifier: testWebhookIdentifier,
+					Url:        testWebhookURL,
+					Enabled:    true,
+				},
+			},
+			expectedResp: api.CreateWebhook501JSONResponse{
+				NotImplementedJSONResponse: api.NotImplementedJSONResponse{
+					Code:    "501",
+					Message: "webhooks is not supported for NPM registries",
+					Details: &map[string]any{
+						"packageType": api.PackageTypeNPM,
+						"capability":  capability.Webhooks,
+					},
+				},
+			},
+		},
 		{
 			name: "invalid_registry_reference",
 			setupMocks: func(c *metadata.APIController) {
@@ -274,6 +313,7 @@ func TestCreateWebhook(t *testing.T) {
 					ParentID:           2,
 					ParentRef:          "root/parent",
 					RegistryType:       api.RegistryTypeVIRTUAL,
+					PackageType:        api.PackageTypeDOCKER,
 				}
 				mockRegistryMetadataHelper.On("GetRegistryRequestBaseInfo", mock.Anything, "", "reg").
 					Return(regInfo, nil)
@@ -369,6 +409,7 @@ func TestCreateWebhook(t *testing.T) {
 					ParentID:           2,
 					ParentRef:          "root/parent",
 					RegistryType:       api.RegistryTypeVIRTUAL,
+					PackageType:        api.PackageTypeDOCKER,
 				}
 
 				permissionChecks := []coretypes.PermissionCheck{
@@ -424,6 +465,7 @@ func TestCreateWebhook(t *testing.T) {
 					ParentID:           2,
 					ParentRef:          "root/parent",
 					RegistryType:       api.RegistryTypeVIRTUAL,
+					PackageType:        api.PackageTypeDOCKER,
 				}
 
 				permissionChecks := []coretypes.PermissionCheck{
@@ -536,6 +578,11 @@ func TestCreateWebhook(t *testing.T) {
 				assert.Equal(t, expected.Code, actualResp.Code, "Error code should match")
 				assert.Equal(t, expected.Message, actualResp.Message, "Error message should match")
 
+			case api.CreateWebhook501JSONResponse:
+				actualResp, ok := resp.(api.CreateWebhook501JSONResponse)
+				assert.True(t, ok, "Expected 501 response")
+				assert.Equal(t, expected, actualResp, "Error should name the package type and the capability")
+
 			default:
 				t.Fatalf("Unexpected response type: %T", tt.expectedResp)
 			}
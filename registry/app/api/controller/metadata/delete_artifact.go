@@ -24,7 +24,10 @@ import (
 	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/api/utils"
+	"github.com/harness/gitness/registry/app/pkg/dispatch"
+	"github.com/harness/gitness/registry/services/notification"
 	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 
 	"github.com/rs/zerolog/log"
@@ -41,6 +44,9 @@ func (c *APIController) DeleteArtifact(ctx context.Context, r artifact.DeleteArt
 			),
 		}, nil
 	}
+
+	r.Artifact, _ = c.resolveArtifactRef(ctx, regInfo.RegistryID, r.Artifact, "")
+
 	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
 	if err != nil {
 		return artifact.DeleteArtifact400JSONResponse{
@@ -83,9 +89,25 @@ func (c *APIController) DeleteArtifact(ctx context.Context, r artifact.DeleteArt
 			),
 		}, nil
 	}
+	approvalRequired, err := c.DeletionApprovalService.Required(ctx, repoEntity)
+	if err != nil {
+		return throwDeleteArtifact500Error(err), err
+	}
+	if repoEntity.IsDeletionProtected() && !approvalRequired {
+		c.notify(ctx, &notification.Event{
+			Type:       registryTypes.NotificationEventProtectedDeletion,
+			RegistryID: repoEntity.ID,
+			Registry:   repoEntity.Name,
+			Artifact:   string(r.Artifact),
+			Principal:  session.Principal.DisplayName,
+		})
+		return artifact.DeleteArtifact409JSONResponse{
+			ConflictJSONResponse: deletionProtectedError(repoEntity.Name),
+		}, nil
+	}
 
 	artifactName := string(r.Artifact)
-	_, err = c.ImageStore.GetByName(ctx, regInfo.RegistryID, artifactName)
+	img, err := c.ImageStore.GetByName(ctx, regInfo.RegistryID, artifactName)
 	if err != nil {
 		//nolint:nilerr
 		return artifact.DeleteArtifact404JSONResponse{
@@ -95,59 +117,91 @@ func (c *APIController) DeleteArtifact(ctx context.Context, r artifact.DeleteArt
 		}, nil
 	}
 
+	// the artifact is deleted once a second user approves the request.
+	if approvalRequired {
+		deletionRequest, err := c.requestDeletion(ctx, regInfo.ParentRef, repoEntity, session.Principal,
+			artifactName, "")
+		if err != nil {
+			return throwDeleteArtifact500Error(err), err
+		}
+		return artifact.DeleteArtifact202JSONResponse{
+			DeletionRequestResponseJSONResponse: deletionRequestResponse(deletionRequest),
+		}, nil
+	}
+
+	if err = c.deleteArtifact(ctx, regInfo, repoEntity.Name, session.Principal, img); err != nil {
+		return throwDeleteArtifact500Error(err), err
+	}
+
+	return artifact.DeleteArtifact200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// deleteArtifact deletes the image with all its versions on behalf of the principal, auditOptions are added to
+// the audit log of the deletion.
+func (c *APIController) deleteArtifact(
+	ctx context.Context,
+	regInfo *registryTypes.RegistryRequestBaseInfo,
+	registryName string,
+	principal types.Principal,
+	img *registryTypes.Image,
+	auditOptions ...audit.Option,
+) error {
+	artifactName := img.Name
+	auditResource := audit.NewResource(audit.ResourceTypeRegistryArtifact, artifactName)
+	auditOptions = append([]audit.Option{
+		audit.WithActorChain(audit.ActorChain(ctx, principal)),
+		audit.WithData("registry name", registryName),
+		audit.WithData("artifact name", artifactName),
+	}, auditOptions...)
+
+	// the audit log is stored in the outbox within the transaction of the deletion.
+	events := &outboxEvents{}
+	stageEvents := func(ctx context.Context) error {
+		return events.add(c.Outbox.Audit(ctx, principal, auditResource, audit.ActionDeleted,
+			regInfo.ParentRef, auditOptions...))
+	}
+
+	var err error
 	//nolint:exhaustive
-	switch regInfo.PackageType {
-	case artifact.PackageTypeDOCKER:
-		err = c.deleteOCIImage(ctx, regInfo, artifactName)
-	case artifact.PackageTypeHELM:
-		err = c.deleteOCIImage(ctx, regInfo, artifactName)
-	case artifact.PackageTypeGENERIC:
-		err = c.deleteGenericImage(ctx, regInfo, artifactName)
-	case artifact.PackageTypeMAVEN:
-		err = c.deleteGenericImage(ctx, regInfo, artifactName)
-	case artifact.PackageTypePYTHON:
-		err = c.deleteGenericImage(ctx, regInfo, artifactName)
-	case artifact.PackageTypeNPM:
-		err = c.deleteGenericImage(ctx, regInfo, artifactName)
-	case artifact.PackageTypeNUGET:
-		err = c.deleteGenericImage(ctx, regInfo, artifactName)
-	case artifact.PackageTypeRPM:
-		err = fmt.Errorf("delete artifact not supported for rpm")
-	case artifact.PackageTypeGO:
-		err = c.deleteGenericImage(ctx, regInfo, artifactName)
-	case artifact.PackageTypeHUGGINGFACE:
-		err = fmt.Errorf("unsupported package type: %s", regInfo.PackageType)
+	switch dispatch.For(regInfo.PackageType).ArtifactDeletion {
+	case dispatch.DeleteOCI:
+		err = c.deleteOCIImage(ctx, regInfo, img, stageEvents)
+	case dispatch.DeleteFiles:
+		err = c.deleteGenericImage(ctx, regInfo, img, stageEvents)
+	case dispatch.DeleteUnsupported:
+		err = fmt.Errorf("delete artifact not supported for %s", regInfo.PackageType)
 	default:
 		err = c.PackageWrapper.DeleteArtifact(ctx, regInfo, artifactName)
 	}
 
 	if err != nil {
-		return throwDeleteArtifact500Error(err), err
+		return err
 	}
+	// the quarantine entries of the image were deleted along with it.
+	c.QuarantineFinder.EvictImage(ctx, regInfo.RegistryID, artifactName)
 
-	auditErr := c.AuditService.Log(
-		ctx,
-		session.Principal,
-		audit.NewResource(audit.ResourceTypeRegistryArtifact, string(r.Artifact)),
-		audit.ActionDeleted,
-		regInfo.ParentRef,
-		audit.WithData("registry name", repoEntity.Name),
-		audit.WithData("artifact name", string(r.Artifact)),
-	)
-	if auditErr != nil {
-		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for delete tag operation: %s", auditErr)
+	if events.stored() {
+		c.Outbox.Publish(ctx, events.events...)
+	} else {
+		auditErr := c.AuditService.Log(
+			ctx, principal, auditResource, audit.ActionDeleted, regInfo.ParentRef, auditOptions...,
+		)
+		if auditErr != nil {
+			log.Ctx(ctx).Warn().Msgf("failed to insert audit log for delete tag operation: %s", auditErr)
+		}
 	}
-
-	return artifact.DeleteArtifact200JSONResponse{
-		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
-	}, nil
+	return nil
 }
 
 func (c *APIController) deleteOCIImage(
 	ctx context.Context,
 	regInfo *registryTypes.RegistryRequestBaseInfo,
-	artifactName string,
+	img *registryTypes.Image,
+	stageEvents func(ctx context.Context) error,
 ) error {
+	artifactName := img.Name
 	err := c.tx.WithTx(
 		ctx, func(ctx context.Context) error {
 			// Delete manifests linked to the image
@@ -168,6 +222,12 @@ func (c *APIController) deleteOCIImage(
 				return fmt.Errorf("failed to delete versions: %w", err)
 			}
 
+			// Delete the logo of the image, it's kept outside the file tree of the image
+			err = c.fileManager.DeleteFile(ctx, regInfo.RegistryID, getArtifactLogoPath(img))
+			if err != nil {
+				return fmt.Errorf("failed to delete artifact logo: %w", err)
+			}
+
 			// Delete image
 			err = c.ImageStore.DeleteByImageNameAndRegID(
 				ctx, regInfo.RegistryID, artifactName,
@@ -175,7 +235,7 @@ func (c *APIController) deleteOCIImage(
 			if err != nil {
 				return fmt.Errorf("failed to delete artifact: %w", err)
 			}
-			return nil
+			return stageEvents(ctx)
 		},
 	)
 	return err
@@ -184,8 +244,10 @@ func (c *APIController) deleteOCIImage(
 func (c *APIController) deleteGenericImage(
 	ctx context.Context,
 	regInfo *registryTypes.RegistryRequestBaseInfo,
-	artifactName string,
+	img *registryTypes.Image,
+	stageEvents func(ctx context.Context) error,
 ) error {
+	artifactName := img.Name
 	err := c.tx.WithTx(
 		ctx, func(ctx context.Context) error {
 			// Get File Path
@@ -203,6 +265,11 @@ func (c *APIController) deleteGenericImage(
 			if err != nil {
 				return fmt.Errorf("failed to delete versions: %w", err)
 			}
+			// Delete the logo of the image, it's kept outside the file tree of the image
+			err = c.fileManager.DeleteFile(ctx, regInfo.RegistryID, getArtifactLogoPath(img))
+			if err != nil {
+				return fmt.Errorf("failed to delete artifact logo: %w", err)
+			}
 			// Delete image
 			err = c.ImageStore.DeleteByImageNameAndRegID(
 				ctx, regInfo.RegistryID, artifactName,
@@ -210,7 +277,7 @@ func (c *APIController) deleteGenericImage(
 			if err != nil {
 				return fmt.Errorf("failed to delete artifact: %w", err)
 			}
-			return nil
+			return stageEvents(ctx)
 		},
 	)
 	return err
@@ -0,0 +1,124 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+func (c *APIController) DeleteArtifactLogo(
+	ctx context.Context,
+	r api.DeleteArtifactLogoRequestObject,
+) (api.DeleteArtifactLogoResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return deleteArtifactLogo400Error(err), nil
+	}
+
+	r.Artifact, _ = c.resolveArtifactRef(ctx, regInfo.RegistryID, r.Artifact, "")
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return deleteArtifactLogo400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.DeleteArtifactLogo401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.DeleteArtifactLogo403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	var artifactType *api.ArtifactType
+	if r.Params.ArtifactType != nil {
+		artifactType, err = ValidateAndGetArtifactType(regInfo.PackageType, string(*r.Params.ArtifactType))
+		if err != nil {
+			return deleteArtifactLogo400Error(err), nil
+		}
+	}
+
+	img, err := c.ImageStore.GetByNameAndType(ctx, regInfo.RegistryID, string(r.Artifact), artifactType)
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return deleteArtifactLogo404Error("artifact doesn't exist with this name"), nil
+	}
+	if err != nil {
+		return deleteArtifactLogo500Error(err), nil
+	}
+
+	logoPath := getArtifactLogoPath(img)
+	_, _, err = c.fileManager.HeadFile(ctx, logoPath, regInfo.RegistryID)
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return deleteArtifactLogo404Error("artifact doesn't have a logo"), nil
+	}
+	if err != nil {
+		return deleteArtifactLogo500Error(err), nil
+	}
+	if err = c.fileManager.DeleteFile(ctx, regInfo.RegistryID, logoPath); err != nil {
+		return deleteArtifactLogo500Error(err), nil
+	}
+
+	return api.DeleteArtifactLogo200JSONResponse{
+		SuccessJSONResponse: api.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+func deleteArtifactLogo400Error(err error) api.DeleteArtifactLogoResponseObject {
+	return api.DeleteArtifactLogo400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func deleteArtifactLogo404Error(message string) api.DeleteArtifactLogoResponseObject {
+	return api.DeleteArtifactLogo404JSONResponse{
+		NotFoundJSONResponse: api.NotFoundJSONResponse(
+			*GetErrorResponse(http.StatusNotFound, message),
+		),
+	}
+}
+
+func deleteArtifactLogo500Error(err error) api.DeleteArtifactLogoResponseObject {
+	return api.DeleteArtifactLogo500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
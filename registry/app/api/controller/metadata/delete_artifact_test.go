@@ -63,6 +63,7 @@ func TestDeleteArtifact(t *testing.T) {
 				mockImageStore := new(mocks.ImageRepository)
 				mockTx := new(mocks.Transaction)
 				mockAuditService := new(mocks.AuditService)
+				mockQuarantineFinder := new(mocks.QuarantineFinder)
 
 				space := &coretypes.SpaceCore{ID: 2}
 				regInfo := &types.RegistryRequestBaseInfo{
@@ -112,6 +113,7 @@ func TestDeleteArtifact(t *testing.T) {
 					"test-artifact",
 				).Return(artifact, nil)
 				mockTx.On("WithTx", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+				mockQuarantineFinder.On("EvictImage", mock.Anything, int64(1), "test-artifact").Return()
 				mockAuditService.On(
 					"Log",
 					mock.Anything,
@@ -121,6 +123,7 @@ func TestDeleteArtifact(t *testing.T) {
 					"root/parent",
 					mock.Anything,
 					mock.Anything,
+					mock.Anything,
 				).Return(nil)
 
 				c.SpaceFinder = mockSpaceFinder
@@ -130,6 +133,7 @@ func TestDeleteArtifact(t *testing.T) {
 				c.ImageStore = mockImageStore
 				c.tx = mockTx
 				c.AuditService = mockAuditService
+				c.QuarantineFinder = mockQuarantineFinder
 			},
 			request: api.DeleteArtifactRequestObject{
 				RegistryRef: "reg",
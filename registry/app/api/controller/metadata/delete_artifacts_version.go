@@ -25,7 +25,10 @@ import (
 	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/api/utils"
+	registryevents "github.com/harness/gitness/registry/app/events/artifact"
 	"github.com/harness/gitness/registry/app/manifest/manifestlist"
+	"github.com/harness/gitness/registry/app/pkg/dispatch"
+	"github.com/harness/gitness/registry/services/notification"
 	"github.com/harness/gitness/registry/services/webhook"
 	registryTypes "github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store"
@@ -48,6 +51,9 @@ func (c *APIController) DeleteArtifactVersion(ctx context.Context, r artifact.De
 			),
 		}, err
 	}
+
+	r.Artifact, r.Version = c.resolveArtifactRef(ctx, regInfo.RegistryID, r.Artifact, r.Version)
+
 	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
 	if err != nil {
 		return artifact.DeleteArtifactVersion400JSONResponse{
@@ -90,10 +96,26 @@ func (c *APIController) DeleteArtifactVersion(ctx context.Context, r artifact.De
 			),
 		}, nil
 	}
+	approvalRequired, err := c.DeletionApprovalService.Required(ctx, repoEntity)
+	if err != nil {
+		return throwDeleteArtifactVersion500Error(err), nil
+	}
+	if repoEntity.IsDeletionProtected() && !approvalRequired {
+		c.notify(ctx, &notification.Event{
+			Type:       registryTypes.NotificationEventProtectedDeletion,
+			RegistryID: repoEntity.ID,
+			Registry:   repoEntity.Name,
+			Artifact:   string(r.Artifact),
+			Version:    string(r.Version),
+			Principal:  session.Principal.DisplayName,
+		})
+		return artifact.DeleteArtifactVersion409JSONResponse{
+			ConflictJSONResponse: deletionProtectedError(repoEntity.Name),
+		}, nil
+	}
 
 	artifactName := string(r.Artifact)
 	versionName := string(r.Version)
-	registryName := repoEntity.Name
 
 	imageInfo, err := c.ImageStore.GetByName(ctx, regInfo.RegistryID, artifactName)
 	if err != nil {
@@ -105,44 +127,19 @@ func (c *APIController) DeleteArtifactVersion(ctx context.Context, r artifact.De
 		}, nil
 	}
 
-	//nolint: exhaustive
-	switch regInfo.PackageType {
-	case artifact.PackageTypeDOCKER:
-		err = c.deleteOciVersionWithAudit(ctx, regInfo, registryName, session.Principal, artifactName,
-			versionName)
-	case artifact.PackageTypeHELM:
-		err = c.deleteOciVersionWithAudit(ctx, regInfo, registryName, session.Principal, artifactName,
-			versionName)
-	case artifact.PackageTypeNPM:
-		err = c.deleteVersion(ctx, regInfo, imageInfo, artifactName, versionName)
-	case artifact.PackageTypeMAVEN:
-		err = c.deleteVersion(ctx, regInfo, imageInfo, artifactName, versionName)
-	case artifact.PackageTypePYTHON:
-		err = c.deleteVersion(ctx, regInfo, imageInfo, artifactName, versionName)
-	case artifact.PackageTypeGENERIC:
-		err = c.deleteVersion(ctx, regInfo, imageInfo, artifactName, versionName)
-	case artifact.PackageTypeNUGET:
-		err = c.deleteVersion(ctx, regInfo, imageInfo, artifactName, versionName)
-	case artifact.PackageTypeRPM:
-		err = c.deleteVersion(ctx, regInfo, imageInfo, artifactName, versionName)
-		if err != nil {
-			break
-		}
-		c.PostProcessingReporter.BuildRegistryIndex(ctx, regInfo.RegistryID, make([]registryTypes.SourceRef, 0))
-	case artifact.PackageTypeGO:
-		err = c.deleteVersion(ctx, regInfo, imageInfo, artifactName, versionName)
+	// the version is deleted once a second user approves the request.
+	if approvalRequired {
+		deletionRequest, err := c.requestDeletion(ctx, regInfo.ParentRef, repoEntity, session.Principal,
+			artifactName, versionName)
 		if err != nil {
-			break
+			return throwDeleteArtifactVersion500Error(err), nil
 		}
-		c.sendArtifactDeletedWebhookEvent(
-			ctx, session.Principal.ID, regInfo.RegistryID, regInfo.PackageType,
-			artifactName, versionName,
-		)
-		c.PostProcessingReporter.BuildPackageIndex(ctx, regInfo.RegistryID, artifactName)
-	default:
-		err = c.PackageWrapper.DeleteArtifactVersion(ctx, regInfo, imageInfo, artifactName, versionName)
+		return artifact.DeleteArtifactVersion202JSONResponse{
+			DeletionRequestResponseJSONResponse: deletionRequestResponse(deletionRequest),
+		}, nil
 	}
 
+	err = c.deleteArtifactVersion(ctx, regInfo, repoEntity.Name, session.Principal, imageInfo, versionName)
 	if err != nil {
 		if errors.Is(err, store.ErrResourceNotFound) {
 			return artifact.DeleteArtifactVersion404JSONResponse{
@@ -157,30 +154,122 @@ func (c *APIController) DeleteArtifactVersion(ctx context.Context, r artifact.De
 		return throwDeleteArtifactVersion500Error(err), nil
 	}
 
-	auditErr := c.AuditService.Log(
-		ctx,
-		session.Principal,
-		audit.NewResource(audit.ResourceTypeRegistry, artifactName),
-		audit.ActionDeleted,
-		regInfo.ParentRef,
+	return artifact.DeleteArtifactVersion200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// deleteArtifactVersion deletes the version of the image on behalf of the principal, auditOptions are added to
+// the audit log of the deletion.
+func (c *APIController) deleteArtifactVersion(
+	ctx context.Context,
+	regInfo *registryTypes.RegistryRequestBaseInfo,
+	registryName string,
+	principal types.Principal,
+	imageInfo *registryTypes.Image,
+	versionName string,
+	auditOptions ...audit.Option,
+) error {
+	artifactName := imageInfo.Name
+	auditResource := audit.NewResource(audit.ResourceTypeRegistry, artifactName)
+	auditOptions = append([]audit.Option{
+		audit.WithActorChain(audit.ActorChain(ctx, principal)),
 		audit.WithData("registry name", registryName),
 		audit.WithData("artifact name", artifactName),
 		audit.WithData("version name", versionName),
-	)
-	if auditErr != nil {
-		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for delete artifact operation: %s", auditErr)
+	}, auditOptions...)
+
+	// the events of the deletion are stored in the outbox within its transaction, so they aren't lost if we
+	// crash right after the commit.
+	events := &outboxEvents{}
+	stageEvents := func(ctx context.Context) error {
+		if regInfo.PackageType == artifact.PackageTypeGO {
+			payload := webhook.GetArtifactDeletedPayloadForCommonArtifacts(
+				principal.ID, regInfo.RegistryID, regInfo.PackageType, artifactName, versionName,
+			)
+			if err := events.add(c.Outbox.ArtifactDeleted(ctx, &payload)); err != nil {
+				return err
+			}
+		}
+		return events.add(c.Outbox.Audit(ctx, principal, auditResource, audit.ActionDeleted,
+			regInfo.ParentRef, auditOptions...))
 	}
 
-	return artifact.DeleteArtifactVersion200JSONResponse{
-		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
-	}, nil
+	var err error
+	//nolint: exhaustive
+	switch handlers := dispatch.For(regInfo.PackageType); handlers.VersionDeletion {
+	case dispatch.DeleteOCI:
+		err = c.deleteOciVersionWithAudit(ctx, regInfo, registryName, principal, artifactName,
+			versionName, events, stageEvents)
+	case dispatch.DeleteFiles:
+		err = c.deleteVersion(ctx, regInfo, imageInfo, artifactName, versionName, stageEvents)
+	case dispatch.DeleteIndexed:
+		var sources []registryTypes.SourceRef
+		sources, err = c.deleteIndexedVersion(ctx, regInfo, imageInfo, artifactName, versionName, stageEvents)
+		if err != nil {
+			break
+		}
+		// the index which listed the version is rebuilt without it.
+		if handlers.Indexing == dispatch.IndexGo {
+			c.PostProcessingReporter.BuildPackageIndex(ctx, regInfo.RegistryID, artifactName, sources)
+		} else {
+			c.PostProcessingReporter.BuildRegistryIndex(ctx, regInfo.RegistryID, sources)
+		}
+	case dispatch.DeleteUnsupported:
+		err = fmt.Errorf("delete version not supported for %s", regInfo.PackageType)
+	default:
+		err = c.PackageWrapper.DeleteArtifactVersion(ctx, regInfo, imageInfo, artifactName, versionName)
+	}
+
+	if err != nil {
+		return err
+	}
+	// the quarantine entries of the version were deleted along with it, OCI versions are cached by digest so
+	// the entries of the whole image are evicted.
+	c.QuarantineFinder.EvictImage(ctx, regInfo.RegistryID, artifactName)
+
+	if events.stored() {
+		c.Outbox.Publish(ctx, events.events...)
+	} else {
+		auditErr := c.AuditService.Log(
+			ctx, principal, auditResource, audit.ActionDeleted, regInfo.ParentRef, auditOptions...,
+		)
+		if auditErr != nil {
+			log.Ctx(ctx).Warn().Msgf("failed to insert audit log for delete artifact operation: %s", auditErr)
+		}
+	}
+	return nil
 }
 
 func (c *APIController) deleteOciVersionWithAudit(
 	ctx context.Context, regInfo *registryTypes.RegistryRequestBaseInfo,
 	registryName string, principal types.Principal, artifactName string, versionName string,
+	events *outboxEvents, stageEvents func(ctx context.Context) error,
 ) error {
-	var existingDigest digest.Digest
+	// stageDeleted stores the artifact-deleted and artifact-soft-deleted events along with the events of the caller.
+	stageDeleted := func(ctx context.Context, existingDigest digest.Digest) error {
+		if existingDigest != "" {
+			payload := webhook.GetArtifactDeletedPayload(ctx, principal.ID, regInfo.RegistryID,
+				regInfo.RegistryUUID, regInfo.ParentRef, registryName, versionName, existingDigest.String(),
+				regInfo.RootIdentifier, regInfo.PackageType, artifactName, c.URLProvider, c.UntaggedImagesEnabled(ctx))
+			if err := events.add(c.Outbox.ArtifactDeleted(ctx, &payload)); err != nil {
+				return err
+			}
+		}
+		err := events.add(c.Outbox.ArtifactSoftDeleted(ctx, &registryevents.ArtifactSoftDeletedPayload{
+			RegistryID:   regInfo.RegistryID,
+			RegistryUUID: regInfo.RegistryUUID,
+			PrincipalID:  principal.ID,
+			ArtifactType: regInfo.PackageType,
+			Image:        artifactName,
+			Version:      versionName,
+		}))
+		if err != nil {
+			return err
+		}
+		return stageEvents(ctx)
+	}
+
 	//nolint:nestif
 	if c.UntaggedImagesEnabled(ctx) {
 		err := c.tx.WithTx(
@@ -209,60 +298,67 @@ func (c *APIController) deleteOciVersionWithAudit(
 							versionName, parentsDigests)
 					}
 				}
-				err = c.ManifestStore.Delete(ctx, regInfo.RegistryID, existingManifest.ID)
-				if err != nil {
-					return err
-				}
-				existingDigest = d
-				_, err = c.TagStore.DeleteTagByManifestID(ctx, regInfo.RegistryID, existingManifest.ID)
-				if err != nil {
-					return fmt.Errorf("failed to delete tags for: %s, err: %w", versionName, err)
-				}
-				err = c.ArtifactStore.DeleteByVersionAndImageName(ctx, artifactName, dgst.String(), regInfo.RegistryID)
+				// the manifest, its tags and its artifact are moved to the trash, they are purged from there along
+				// with the image once it has no manifests left.
+				err = c.ManifestStore.SoftDelete(ctx, regInfo.RegistryID, existingManifest.ID)
 				if err != nil {
 					return err
 				}
-
-				count, err := c.ManifestStore.CountByImageName(ctx, regInfo.RegistryID, artifactName)
+				err = c.ArtifactStore.SoftDeleteByVersionAndImageName(
+					ctx, artifactName, dgst.String(), regInfo.RegistryID,
+				)
 				if err != nil {
 					return err
 				}
-				if count < 1 {
-					err = c.ImageStore.DeleteByImageNameAndRegID(
-						ctx, regInfo.RegistryID, artifactName,
-					)
-					if err != nil {
-						return err
-					}
-				}
-				return nil
+				return stageDeleted(ctx, d)
 			})
 		if err != nil {
 			return fmt.Errorf("failed to delete artifact version: %w", err)
 		}
-	} else {
-		existingDigest = c.getTagDigest(ctx, regInfo.RegistryID, artifactName, versionName)
-		err := c.TagStore.DeleteTag(ctx, regInfo.RegistryID, artifactName, versionName)
-		if err != nil {
-			return err
-		}
-	}
-	if existingDigest != "" {
-		payload := webhook.GetArtifactDeletedPayload(ctx, principal.ID, regInfo.RegistryID,
-			registryName, versionName, existingDigest.String(), regInfo.RootIdentifier,
-			regInfo.PackageType, artifactName, c.URLProvider, c.UntaggedImagesEnabled(ctx))
-		c.ArtifactEventReporter.ArtifactDeleted(ctx, &payload)
+		return nil
 	}
 
-	return nil
+	return c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			existingDigest := c.getTagDigest(ctx, regInfo.RegistryID, artifactName, versionName)
+			err := c.TagStore.DeleteTag(ctx, regInfo.RegistryID, artifactName, versionName)
+			if err != nil {
+				return err
+			}
+			return stageDeleted(ctx, existingDigest)
+		})
 }
 
+// deleteIndexedVersion deletes a version of a package type which keeps an index of its versions, and returns
+// the sources to report with the index update so the deleted version can be removed from the index.
+func (c *APIController) deleteIndexedVersion(
+	ctx context.Context,
+	regInfo *registryTypes.RegistryRequestBaseInfo,
+	imageInfo *registryTypes.Image,
+	artifactName string,
+	versionName string,
+	stageEvents func(ctx context.Context) error,
+) ([]registryTypes.SourceRef, error) {
+	a, err := c.ArtifactStore.GetByName(ctx, imageInfo.ID, versionName)
+	if err != nil {
+		return nil, fmt.Errorf("version doesn't exist with for image %v: %w", imageInfo.Name, err)
+	}
+	err = c.deleteVersion(ctx, regInfo, imageInfo, artifactName, versionName, stageEvents)
+	if err != nil {
+		return nil, err
+	}
+	return []registryTypes.SourceRef{{Type: registryTypes.SourceTypeArtifactDeleted, ID: a.ID}}, nil
+}
+
+// deleteVersion deletes the version and its files, stageEvents stores the events of the deletion within the
+// same transaction.
 func (c *APIController) deleteVersion(
 	ctx context.Context,
 	regInfo *registryTypes.RegistryRequestBaseInfo,
 	imageInfo *registryTypes.Image,
 	artifactName string,
 	versionName string,
+	stageEvents func(ctx context.Context) error,
 ) error {
 	_, err := c.ArtifactStore.GetByName(ctx, imageInfo.ID, versionName)
 	if err != nil {
@@ -296,7 +392,7 @@ func (c *APIController) deleteVersion(
 				return fmt.Errorf("failed to delete image: %w", err)
 			}
 
-			return nil
+			return stageEvents(ctx)
 		},
 	)
 
@@ -307,21 +403,6 @@ func (c *APIController) deleteVersion(
 	return nil
 }
 
-func (c *APIController) sendArtifactDeletedWebhookEvent(
-	ctx context.Context, principalID int64,
-	registryID int64, packageType artifact.PackageType,
-	artifact string, version string,
-) {
-	payload := webhook.GetArtifactDeletedPayloadForCommonArtifacts(
-		principalID,
-		registryID,
-		packageType,
-		artifact,
-		version,
-	)
-	c.ArtifactEventReporter.ArtifactDeleted(ctx, &payload)
-}
-
 func throwDeleteArtifactVersion500Error(err error) artifact.DeleteArtifactVersion500JSONResponse {
 	return artifact.DeleteArtifactVersion500JSONResponse{
 		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
@@ -0,0 +1,91 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+func (c *APIController) DeleteNotificationChannel(
+	ctx context.Context,
+	r api.DeleteNotificationChannelRequestObject,
+) (api.DeleteNotificationChannelResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return api.DeleteNotificationChannel400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return api.DeleteNotificationChannel400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		log.Ctx(ctx).Error().Msgf("permission check failed while deleting notification channel for registry: %s, "+
+			"error: %v", regInfo.RegistryIdentifier, err)
+		return api.DeleteNotificationChannel403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	identifier := string(r.ChannelIdentifier)
+	err = c.NotificationChannelRepository.DeleteByRegistryAndIdentifier(ctx, regInfo.RegistryID, identifier)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return api.DeleteNotificationChannel404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "notification channel '"+identifier+"' not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msgf("failed to delete notification channel: %s", identifier)
+		return api.DeleteNotificationChannel500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	return api.DeleteNotificationChannel200JSONResponse{
+		SuccessJSONResponse: api.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
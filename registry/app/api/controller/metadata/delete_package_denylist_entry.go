@@ -0,0 +1,103 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// DeletePackageDenylistEntry removes an entry from the package denylist of the space, along with the overrides of
+// registries.
+func (c *APIController) DeletePackageDenylistEntry(
+	ctx context.Context,
+	r api.DeletePackageDenylistEntryRequestObject,
+) (api.DeletePackageDenylistEntryResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return deletePackageDenylistEntry400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return deletePackageDenylistEntry400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryEdit,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.DeletePackageDenylistEntry401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.DeletePackageDenylistEntry403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	entry, err := c.PackageDenylistService.Delete(ctx, space.ID, int64(r.DenylistEntryId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return api.DeletePackageDenylistEntry404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound,
+					fmt.Sprintf("package denylist entry %d not found", r.DenylistEntryId)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return api.DeletePackageDenylistEntry500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	c.auditPackageDenylistEntry(ctx, session.Principal, space.Path, entry, audit.ActionDeleted)
+
+	return api.DeletePackageDenylistEntry200JSONResponse{
+		SuccessJSONResponse: api.SuccessJSONResponse{
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func deletePackageDenylistEntry400Error(err error) api.DeletePackageDenylistEntryResponseObject {
+	return api.DeletePackageDenylistEntry400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
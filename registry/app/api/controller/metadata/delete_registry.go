@@ -90,6 +90,11 @@ func (c *APIController) DeleteRegistry(
 			),
 		}, nil
 	}
+	if repoEntity.IsDeletionProtected() {
+		return artifact.DeleteRegistry409JSONResponse{
+			ConflictJSONResponse: deletionProtectedError(repoEntity.Name),
+		}, nil
+	}
 
 	err = c.checkIfRegistryUsedAsUpstream(
 		ctx, regInfo, repoEntity.Name, repoEntity.ID,
@@ -131,6 +136,14 @@ func (c *APIController) DeleteRegistry(
 	}, nil
 }
 
+// deletionProtectedError returns the error of deletes which are rejected by the deletion protection of a registry.
+func deletionProtectedError(registryName string) artifact.ConflictJSONResponse {
+	return artifact.ConflictJSONResponse(*GetErrorResponse(
+		http.StatusConflict,
+		fmt.Sprintf("registry '%s' has deletion protection enabled, it has to be disabled first", registryName),
+	))
+}
+
 func (c *APIController) checkIfRegistryUsedAsUpstream(
 	ctx context.Context,
 	regInfo *registrytypes.RegistryRequestBaseInfo,
@@ -192,6 +205,7 @@ func (c *APIController) deleteRegistryWithAudit(
 		audit.NewResource(typeRegistry, registry.Name),
 		audit.ActionDeleted,
 		parentRef,
+		audit.WithActorChain(audit.ActorChain(ctx, principal)),
 		audit.WithOldObject(
 			audit.RegistryObject{
 				Registry: *registry,
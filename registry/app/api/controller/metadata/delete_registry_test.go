@@ -123,6 +123,7 @@ func TestDeleteRegistry(t *testing.T) {
 					mock.AnythingOfType("string"),
 					mock.AnythingOfType("string"),
 					mock.AnythingOfType("audit.Option"),
+					mock.AnythingOfType("audit.Option"),
 				).Return(nil)
 				// Simply return nil for the transaction - we're testing the controller logic, not transaction details
 				mockTx.On("WithTx", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil)
@@ -282,6 +283,76 @@ func TestDeleteRegistry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "registry_deletion_protected",
+			setupMocks: func(c *APIController) {
+				mockSpaceFinder := new(mocks.SpaceFinder)
+				mockRegistryRepository := new(mocks.RegistryRepository)
+				mockAuthorizer := new(mocks.Authorizer)
+				mockRegistryMetadataHelper := new(mocks.RegistryMetadataHelper)
+
+				space := &coretypes.SpaceCore{ID: 2}
+				regInfo := &types.RegistryRequestBaseInfo{
+					RegistryID:         1,
+					RegistryIdentifier: "reg",
+					ParentID:           2,
+					ParentRef:          "root/parent",
+				}
+
+				registry := &types.Registry{
+					ID:          1,
+					Name:        "reg",
+					ParentID:    2,
+					Type:        "virtual",
+					PackageType: "pypi",
+					Config:      &types.RegistryConfig{DeletionProtection: true},
+				}
+
+				permissionChecks := []coretypes.PermissionCheck{
+					{
+						Scope:      coretypes.Scope{SpacePath: "root/parent"},
+						Resource:   coretypes.Resource{Type: enum.ResourceTypeRegistry, Identifier: "reg"},
+						Permission: enum.PermissionRegistryDelete,
+					},
+				}
+
+				mockSpaceFinder.On("FindByRef", mock.Anything, "root/parent").Return(space, nil)
+				mockRegistryMetadataHelper.On("GetRegistryRequestBaseInfo", mock.Anything, "", "reg").Return(regInfo,
+					nil)
+				mockRegistryMetadataHelper.On(
+					"GetPermissionChecks",
+					space,
+					"reg",
+					enum.PermissionRegistryDelete,
+				).Return(permissionChecks)
+				mockAuthorizer.On(
+					"CheckAll",
+					mock.Anything,
+					mock.AnythingOfType("*auth.Session"),
+					permissionChecks[0],
+				).Return(true, nil)
+				mockRegistryRepository.On(
+					"GetByParentIDAndName",
+					mock.Anything,
+					regInfo.ParentID,
+					regInfo.RegistryIdentifier,
+				).Return(registry, nil)
+
+				c.SpaceFinder = mockSpaceFinder
+				c.RegistryRepository = mockRegistryRepository
+				c.Authorizer = mockAuthorizer
+				c.RegistryMetadataHelper = mockRegistryMetadataHelper
+			},
+			request: api.DeleteRegistryRequestObject{
+				RegistryRef: "reg",
+			},
+			expectedResp: api.DeleteRegistry409JSONResponse{
+				ConflictJSONResponse: api.ConflictJSONResponse{
+					Code:    "409",
+					Message: "registry 'reg' has deletion protection enabled, it has to be disabled first",
+				},
+			},
+		},
 		{
 			name: "success_case_native_registry",
 			setupMocks: func(c *APIController) {
@@ -362,6 +433,7 @@ func TestDeleteRegistry(t *testing.T) {
 					mock.AnythingOfType("string"),
 					mock.AnythingOfType("string"),
 					mock.AnythingOfType("audit.Option"),
+					mock.AnythingOfType("audit.Option"),
 				).Return(nil)
 				// Simply return nil for the transaction - we're testing the controller logic, not transaction details
 				mockTx.On("WithTx", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil)
@@ -430,6 +502,11 @@ func TestDeleteRegistry(t *testing.T) {
 				assert.True(t, ok, "Expected 404 not found response")
 				// Not checking fields as they're hardcoded in test data.
 
+			case api.DeleteRegistry409JSONResponse:
+				actualResp, ok := resp.(api.DeleteRegistry409JSONResponse)
+				assert.True(t, ok, "Expected 409 conflict response")
+				assert.Equal(t, tt.expectedResp, actualResp, "Conflict response should match")
+
 			default:
 				// Fallback to simple type equality for any other response types.
 				expectedType := fmt.Sprintf("%T", tt.expectedResp)
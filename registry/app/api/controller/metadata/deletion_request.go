@@ -0,0 +1,126 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"strconv"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// requestDeletion returns the pending request of the principal to delete the version of the image, the whole
+// image is deleted if the version is empty. The creation of a request is audited.
+func (c *APIController) requestDeletion(
+	ctx context.Context,
+	parentRef string,
+	registry *registryTypes.Registry,
+	principal types.Principal,
+	imageName string,
+	version string,
+) (*registryTypes.DeletionRequest, error) {
+	deletionRequest, created, err := c.DeletionApprovalService.Request(ctx, registry.ID, imageName, version,
+		principal.ID)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		c.auditDeletionRequest(ctx, principal, parentRef, registry.Name, deletionRequest, audit.ActionCreated)
+	}
+	return deletionRequest, nil
+}
+
+// checkDeletionReviewer checks that the principal of the session can approve or reject the requests to delete
+// the image, they have to own it or be an admin of the registry. The image is nil if it doesn't exist anymore.
+func (c *APIController) checkDeletionReviewer(
+	ctx context.Context,
+	session *auth.Session,
+	space *types.SpaceCore,
+	registryIdentifier string,
+	img *registryTypes.Image,
+) error {
+	if img != nil && img.CreatedBy == session.Principal.ID {
+		return nil
+	}
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, registryIdentifier,
+		enum.PermissionRegistryEdit)
+	return apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...)
+}
+
+func (c *APIController) auditDeletionRequest(
+	ctx context.Context,
+	principal types.Principal,
+	parentRef string,
+	registryName string,
+	deletionRequest *registryTypes.DeletionRequest,
+	action audit.Action,
+) {
+	err := c.AuditService.Log(
+		ctx,
+		principal,
+		audit.NewResource(audit.ResourceTypeRegistryDeletionRequest, strconv.FormatInt(deletionRequest.ID, 10)),
+		action,
+		parentRef,
+		audit.WithActorChain(audit.ActorChain(ctx, principal)),
+		audit.WithData("registry name", registryName),
+		audit.WithData("artifact name", deletionRequest.ImageName),
+		audit.WithData("version name", deletionRequest.Version),
+		audit.WithData("state", string(deletionRequest.State)),
+		audit.WithData("comment", deletionRequest.Comment),
+	)
+	if err != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for deletion request %d: %s", deletionRequest.ID, err)
+	}
+}
+
+func deletionRequestResponse(
+	deletionRequest *registryTypes.DeletionRequest,
+) artifact.DeletionRequestResponseJSONResponse {
+	return artifact.DeletionRequestResponseJSONResponse{
+		Data:   mapToAPIDeletionRequest(deletionRequest),
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+func mapToAPIDeletionRequest(deletionRequest *registryTypes.DeletionRequest) artifact.DeletionRequest {
+	dto := artifact.DeletionRequest{
+		Id:          deletionRequest.ID,
+		Artifact:    deletionRequest.ImageName,
+		State:       artifact.DeletionRequestState(deletionRequest.State),
+		RequestedBy: deletionRequest.RequestedBy,
+		ReviewedBy:  deletionRequest.ReviewedBy,
+		CreatedAt:   GetTimeInMs(deletionRequest.CreatedAt),
+		ExpiresAt:   GetTimeInMs(deletionRequest.ExpiresAt),
+	}
+	if deletionRequest.Version != "" {
+		dto.Version = &deletionRequest.Version
+	}
+	if deletionRequest.Comment != "" {
+		dto.Comment = &deletionRequest.Comment
+	}
+	if deletionRequest.ReviewedBy != nil {
+		reviewedAt := GetTimeInMs(deletionRequest.UpdatedAt)
+		dto.ReviewedAt = &reviewedAt
+	}
+	return dto
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/failedupload"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+func (c *APIController) DownloadFailedUpload(
+	ctx context.Context,
+	r api.DownloadFailedUploadRequestObject,
+) (api.DownloadFailedUploadResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return downloadFailedUpload400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return downloadFailedUpload400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionArtifactsDownload)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.DownloadFailedUpload401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.DownloadFailedUpload403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	upload, err := c.FailedUploadStore.GetByUUID(ctx, regInfo.RegistryID, string(r.FailedUploadUuid))
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return api.DownloadFailedUpload404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "failed upload doesn't exist with this uuid"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return downloadFailedUpload500Error(err), nil
+	}
+
+	fileReader, size, _, err := c.fileManager.DownloadFileByPath(ctx, failedupload.GetPath(upload),
+		regInfo.RegistryID, regInfo.RegistryIdentifier, regInfo.RootIdentifier, false)
+	if err != nil {
+		return downloadFailedUpload500Error(fmt.Errorf("failed to download failed upload: %w", err)), nil
+	}
+
+	filename := upload.Filename
+	if filename == "" {
+		filename = upload.UUID
+	}
+	return api.DownloadFailedUpload200ApplicationoctetStreamResponse{
+		Body: fileReader,
+		Headers: api.DownloadFailedUpload200ResponseHeaders{
+			ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": filename}),
+		},
+		ContentLength: size,
+	}, nil
+}
+
+func downloadFailedUpload400Error(err error) api.DownloadFailedUploadResponseObject {
+	return api.DownloadFailedUpload400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func downloadFailedUpload500Error(err error) api.DownloadFailedUploadResponseObject {
+	return api.DownloadFailedUpload500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
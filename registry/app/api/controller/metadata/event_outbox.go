@@ -0,0 +1,41 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"fmt"
+
+	registryTypes "github.com/harness/gitness/registry/types"
+)
+
+// outboxEvents collects the events a change stored in the outbox within its transaction, they're published once
+// the transaction committed.
+type outboxEvents struct {
+	events []*registryTypes.OutboxEvent
+}
+
+func (o *outboxEvents) add(event *registryTypes.OutboxEvent, err error) error {
+	if err != nil {
+		return fmt.Errorf("failed to store event in outbox: %w", err)
+	}
+	o.events = append(o.events, event)
+	return nil
+}
+
+// stored tells whether the events of the change went through the outbox. Changes which don't run in a
+// transaction of this controller report their events directly.
+func (o *outboxEvents) stored() bool {
+	return len(o.events) > 0
+}
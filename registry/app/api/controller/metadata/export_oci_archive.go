@@ -0,0 +1,220 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/docker"
+	"github.com/harness/gitness/registry/services/concurrency"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ExportOciArchive streams the tags of an image of a docker or helm registry as an OCI image layout tarball.
+func (c *APIController) ExportOciArchive(
+	ctx context.Context,
+	r api.ExportOciArchiveRequestObject,
+) (api.ExportOciArchiveResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return exportOciArchive400Error(err.Error()), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return exportOciArchive400Error(err.Error()), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionArtifactsDownload)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ExportOciArchive401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ExportOciArchive403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	if regInfo.PackageType != api.PackageTypeDOCKER && regInfo.PackageType != api.PackageTypeHELM {
+		return exportOciArchive400Error("only images of docker and helm registries can be exported"), nil
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return exportOciArchive500Error(fmt.Errorf("failed to get registry: %w", err)), nil
+	}
+
+	release, err := c.ConcurrencyLimiter.Acquire(ctx, regInfo.RootIdentifierID, concurrency.OperationExport)
+	var limitErr *concurrency.LimitError
+	if errors.As(err, &limitErr) {
+		return api.ExportOciArchive429JSONResponse{
+			TooManyRequestsJSONResponse: api.TooManyRequestsJSONResponse(
+				*GetErrorResponse(http.StatusTooManyRequests, limitErr.Error()),
+			),
+		}, nil
+	}
+	if err != nil {
+		return exportOciArchive500Error(err), nil
+	}
+	// the slot is handed over to the writer of the tarball, it's released here when the export fails before.
+	streaming := false
+	defer func() {
+		if !streaming {
+			release()
+		}
+	}()
+
+	info := pkg.RegistryInfo{
+		ArtifactInfo: &pkg.ArtifactInfo{
+			BaseInfo: &pkg.BaseInfo{
+				PathRoot:       strings.ToLower(regInfo.RootIdentifier),
+				RootIdentifier: regInfo.RootIdentifier,
+				RootParentID:   regInfo.RootIdentifierID,
+				ParentID:       regInfo.ParentID,
+			},
+			RegIdentifier: registry.Name,
+			RegistryID:    registry.ID,
+			Registry:      *registry,
+		},
+		PackageType: registry.PackageType,
+	}
+
+	image := string(r.Artifact)
+	var tags []string
+	if r.Params.Tag != nil {
+		tags = *r.Params.Tag
+	}
+	resolved, err := c.OCIExporter.Resolve(ctx, info, image, tags)
+	if errors.Is(err, docker.ErrExportTagNotFound) {
+		return api.ExportOciArchive404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}, nil
+	}
+	if err != nil {
+		return exportOciArchive500Error(err), nil
+	}
+
+	for _, tag := range resolved {
+		err = c.QuarantineFinder.CheckOCIManifestQuarantineStatus(ctx, registry.ID, image, tag.Name,
+			tag.Manifest.Digest.String())
+		if errors.Is(err, usererror.ErrQuarantinedArtifact) {
+			return exportOciArchive400Error(fmt.Sprintf("%s:%s is quarantined", image, tag.Name)), nil
+		}
+		if err != nil {
+			return exportOciArchive500Error(err), nil
+		}
+	}
+
+	c.auditOciExport(ctx, session, space.Path, registry.Name, image, len(resolved))
+
+	// the tarball is written while it's streamed, closing the reader once the response is sent stops the export
+	// of clients which disconnect.
+	pr, pw := io.Pipe()
+	streaming = true
+	go func() {
+		defer release()
+		err := c.OCIExporter.Write(ctx, info, image, resolved, pw)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msgf("failed to export image %s of registry %s", image, registry.Name)
+		}
+		_ = pw.CloseWithError(err)
+	}()
+
+	return api.ExportOciArchive200ApplicationxTarResponse{
+		Body: pr,
+		Headers: api.ExportOciArchive200ResponseHeaders{
+			ContentDisposition: fmt.Sprintf("attachment; filename=%q", exportFileName(image, resolved)),
+		},
+	}, nil
+}
+
+func (c *APIController) auditOciExport(
+	ctx context.Context,
+	session *auth.Session,
+	spacePath string,
+	registryName string,
+	image string,
+	tags int,
+) {
+	err := c.AuditService.Log(
+		ctx,
+		session.Principal,
+		audit.NewResource(audit.ResourceTypeRegistry, registryName),
+		audit.ActionDownloaded,
+		spacePath,
+		audit.WithActorChain(audit.ActorChain(ctx, session.Principal)),
+		audit.WithData("image", image),
+		audit.WithData("tags", strconv.Itoa(tags)),
+	)
+	if err != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for export archive operation: %s", err)
+	}
+}
+
+// exportFileName names the tarball after the image, and after the tag when a single one is exported.
+func exportFileName(image string, tags []docker.ExportTag) string {
+	name := strings.ReplaceAll(image, "/", "_")
+	if len(tags) == 1 {
+		name += "_" + tags[0].Name
+	}
+	return name + ".oci.tar"
+}
+
+func exportOciArchive400Error(message string) api.ExportOciArchiveResponseObject {
+	return api.ExportOciArchive400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, message),
+		),
+	}
+}
+
+func exportOciArchive500Error(err error) api.ExportOciArchiveResponseObject {
+	return api.ExportOciArchive500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata_test
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/concurrency"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportOciArchiveConcurrencyLimit(t *testing.T) {
+	limiter := concurrency.NewLimiter(concurrency.Config{
+		Limits: map[concurrency.Operation]int{concurrency.OperationExport: 1},
+	})
+	release, err := limiter.TryAcquire(3, concurrency.OperationExport)
+	require.NoError(t, err)
+	defer release()
+
+	controller := newOciArchiveController(enum.PermissionArtifactsDownload, limiter)
+	resp, err := controller.ExportOciArchive(context.Background(), api.ExportOciArchiveRequestObject{
+		RegistryRef: "reg",
+		Artifact:    "app",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, api.ExportOciArchive429JSONResponse{
+		TooManyRequestsJSONResponse: api.TooManyRequestsJSONResponse{
+			Code:    "429",
+			Message: "the account already runs the maximum of 1 concurrent export operations, retry later",
+		},
+	}, resp)
+
+	assert.Equal(t, 1, limiter.Running(3, concurrency.OperationExport))
+}
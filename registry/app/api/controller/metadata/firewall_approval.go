@@ -0,0 +1,80 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// getFirewallUpstream returns the upstream proxy of the registry, the firewall delay is only configured on
+// upstream proxies.
+func (c *APIController) getFirewallUpstream(
+	ctx context.Context,
+	regInfo *registryTypes.RegistryRequestBaseInfo,
+) (*registryTypes.UpstreamProxy, error) {
+	if regInfo.RegistryType != artifact.RegistryTypeUPSTREAM {
+		return nil, fmt.Errorf("registry %s isn't an upstream proxy", regInfo.RegistryIdentifier)
+	}
+	upstream, err := c.UpstreamProxyStore.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upstream proxy %s: %w", regInfo.RegistryIdentifier, err)
+	}
+	return upstream, nil
+}
+
+// auditFirewallApproval keeps the audit trail of the versions served before the firewall delay has passed, with
+// the reason they're let through.
+func (c *APIController) auditFirewallApproval(
+	ctx context.Context,
+	principal types.Principal,
+	parentRef string,
+	registryName string,
+	approval *registryTypes.FirewallApproval,
+	action audit.Action,
+) {
+	err := c.AuditService.Log(
+		ctx,
+		principal,
+		audit.NewResource(audit.ResourceTypeRegistryFirewallApproval, approval.Package),
+		action,
+		parentRef,
+		audit.WithActorChain(audit.ActorChain(ctx, principal)),
+		audit.WithData("registry name", registryName),
+		audit.WithData("version", approval.Version),
+		audit.WithData("reason", approval.Reason),
+	)
+	if err != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for firewall approval of %s@%s: %s",
+			approval.Package, approval.Version, err)
+	}
+}
+
+func mapToAPIFirewallApproval(approval *registryTypes.FirewallApproval) artifact.FirewallApproval {
+	return artifact.FirewallApproval{
+		Package:   approval.Package,
+		Version:   approval.Version,
+		Reason:    approval.Reason,
+		CreatedBy: approval.CreatedBy,
+		CreatedAt: GetTimeInMs(approval.CreatedAt),
+	}
+}
@@ -262,6 +262,15 @@ func (c *APIController) GetArtifactDetails(
 		isQuarantined := true
 		artifactDetails.IsQuarantined = &isQuarantined
 		artifactDetails.QuarantineReason = &quarantinedArtifacts[0].Reason
+		artifactDetails.QuarantineBlockedPulls, artifactDetails.QuarantineLastBlockedPullAt, err =
+			c.quarantineBlockedPulls(ctx, regInfo.RegistryID, image, version)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
 	} else {
 		isQuarantined := false
 		artifactDetails.IsQuarantined = &isQuarantined
@@ -306,7 +306,35 @@ func setupBasicController(_ *testing.T) *metadata.APIController {
 		nil, // packageWrapper
 		nil, // publicAccess
 		nil, // storageService
+		nil, // registryPolicyService
+		nil, // indexBuildRepository
 		nil, // app
+		nil, // trashService
+		nil, // metadataHistoryRepository
+		nil, // garbageRepository
+		nil, // notificationChannelRepository
+		nil, // notificationDispatcher
+		nil, // imageDescriptionRepository
+		nil, // eventOutbox
+		nil, // failedUploadDao
+		nil, // uploadFailureStatsDao
+		nil, // concurrencyLimiter
+		nil, // registryJobDao
+		nil, // registryJobService
+		nil, // registryUsageService
+		nil, // imageStarRepository
+		nil, // recentActivityService
+		nil, // deletionApprovalService
+		nil, // deletionService
+		nil, // quarantineAccessAttemptStore
+		nil, // packageDenylistService
+		nil, // vulnerabilityService
+		nil, // provenanceRepository
+		nil, // ociImporter
+		nil, // ociExporter
+		nil, // searchRepository
+		nil, // firewallDelayService
+		nil, // upstreamProvenanceService
 	)
 }
 
@@ -380,7 +408,35 @@ func setupControllerWithError(_ *testing.T, errorType string) *metadata.APIContr
 		nil, // packageWrapper
 		nil, // publicAccess
 		nil, // storageService
+		nil, // registryPolicyService
+		nil, // indexBuildRepository
 		nil, // app
+		nil, // trashService
+		nil, // metadataHistoryRepository
+		nil, // garbageRepository
+		nil, // notificationChannelRepository
+		nil, // notificationDispatcher
+		nil, // imageDescriptionRepository
+		nil, // eventOutbox
+		nil, // failedUploadDao
+		nil, // uploadFailureStatsDao
+		nil, // concurrencyLimiter
+		nil, // registryJobDao
+		nil, // registryJobService
+		nil, // registryUsageService
+		nil, // imageStarRepository
+		nil, // recentActivityService
+		nil, // deletionApprovalService
+		nil, // deletionService
+		nil, // quarantineAccessAttemptStore
+		nil, // packageDenylistService
+		nil, // vulnerabilityService
+		nil, // provenanceRepository
+		nil, // ociImporter
+		nil, // ociExporter
+		nil, // searchRepository
+		nil, // firewallDelayService
+		nil, // upstreamProvenanceService
 	)
 }
 
@@ -566,7 +622,35 @@ func setupSnapshotController(_ *testing.T, packageType artifact.PackageType) *me
 		nil, // packageWrapper
 		nil, // publicAccess
 		nil, // storageService
+		nil, // registryPolicyService
+		nil, // indexBuildRepository
 		nil, // app
+		nil, // trashService
+		nil, // metadataHistoryRepository
+		nil, // garbageRepository
+		nil, // notificationChannelRepository
+		nil, // notificationDispatcher
+		nil, // imageDescriptionRepository
+		nil, // eventOutbox
+		nil, // failedUploadDao
+		nil, // uploadFailureStatsDao
+		nil, // concurrencyLimiter
+		nil, // registryJobDao
+		nil, // registryJobService
+		nil, // registryUsageService
+		nil, // imageStarRepository
+		nil, // recentActivityService
+		nil, // deletionApprovalService
+		nil, // deletionService
+		nil, // quarantineAccessAttemptStore
+		nil, // packageDenylistService
+		nil, // vulnerabilityService
+		nil, // provenanceRepository
+		nil, // ociImporter
+		nil, // ociExporter
+		nil, // searchRepository
+		nil, // firewallDelayService
+		nil, // upstreamProvenanceService
 	)
 }
 
@@ -0,0 +1,117 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
+)
+
+// maxDownloadCountArtifacts limits the number of artifacts the download counts are requested for at once, it's
+// large enough for a page of artifacts.
+const maxDownloadCountArtifacts = 100
+
+// GetArtifactDownloadCounts returns the download counts of the artifacts of a registry. Listings of artifacts
+// don't compute them by default, clients fetch them for the artifacts of a page with this cheaper request.
+func (c *APIController) GetArtifactDownloadCounts(
+	ctx context.Context,
+	r artifact.GetArtifactDownloadCountsRequestObject,
+) (artifact.GetArtifactDownloadCountsResponseObject, error) {
+	names := uniqueArtifactNames(r.Params.Artifact)
+	if len(names) == 0 || len(names) > maxDownloadCountArtifacts {
+		return artifact.GetArtifactDownloadCounts400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest,
+					fmt.Sprintf("between 1 and %d artifacts are required", maxDownloadCountArtifacts)),
+			),
+		}, nil
+	}
+
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return artifact.GetArtifactDownloadCounts400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return artifact.GetArtifactDownloadCounts400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.GetArtifactDownloadCounts403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	counts, err := c.ArtifactStore.GetDownloadCountsByImageNames(ctx, regInfo.RegistryID, names)
+	if err != nil {
+		return artifact.GetArtifactDownloadCounts500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	downloadCounts := make([]artifact.ArtifactDownloadCount, 0, len(names))
+	for _, name := range names {
+		downloadCounts = append(downloadCounts, artifact.ArtifactDownloadCount{
+			Name:           name,
+			DownloadsCount: counts[name],
+		})
+	}
+	return artifact.GetArtifactDownloadCounts200JSONResponse{
+		ListArtifactDownloadCountResponseJSONResponse: artifact.ListArtifactDownloadCountResponseJSONResponse{
+			Data:   artifact.ListArtifactDownloadCount{DownloadCounts: downloadCounts},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func uniqueArtifactNames(names artifact.ArtifactNamesParam) []string {
+	seen := make(map[string]bool, len(names))
+	unique := make([]string, 0, len(names))
+	for _, name := range names {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		unique = append(unique, name)
+	}
+	return unique
+}
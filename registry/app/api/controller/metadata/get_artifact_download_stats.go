@@ -0,0 +1,197 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// maxDownloadStatsBuckets bounds the periods of a time series, a long range has to be asked by week or month.
+const maxDownloadStatsBuckets = 366
+
+// GetArtifactDownloadStats reports the downloads of each version of an artifact within a range of days, counted
+// by day, week or month.
+func (c *APIController) GetArtifactDownloadStats(
+	ctx context.Context,
+	r artifact.GetArtifactDownloadStatsRequestObject,
+) (artifact.GetArtifactDownloadStatsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return getArtifactDownloadStats400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return getArtifactDownloadStats400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		if errors.Is(err, apiauth.ErrUnauthorized) {
+			return artifact.GetArtifactDownloadStats401JSONResponse{
+				UnauthenticatedJSONResponse: artifact.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, err.Error()),
+				),
+			}, nil
+		}
+		return artifact.GetArtifactDownloadStats403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	from, to, err := getStatsDateRange(r.Params.From, r.Params.To, time.Now())
+	if err != nil {
+		return getArtifactDownloadStats400Error(err), nil
+	}
+	interval, err := getDownloadStatsInterval(r.Params.Interval)
+	if err != nil {
+		return getArtifactDownloadStats400Error(err), nil
+	}
+	if countDownloadStatsBuckets(from, to, interval) > maxDownloadStatsBuckets {
+		return getArtifactDownloadStats400Error(fmt.Errorf("range can't span more than %d periods of %s",
+			maxDownloadStatsBuckets, interval)), nil
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return getArtifactDownloadStats500Error(err), nil
+	}
+
+	var artifactType *artifact.ArtifactType
+	if r.Params.ArtifactType != nil {
+		artifactType, err = ValidateAndGetArtifactType(registry.PackageType, string(*r.Params.ArtifactType))
+		if err != nil {
+			return getArtifactDownloadStats400Error(err), nil
+		}
+	}
+	img, err := c.ImageStore.GetByNameAndType(ctx, registry.ID, string(r.Artifact), artifactType)
+	if err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return artifact.GetArtifactDownloadStats404JSONResponse{
+				NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+					*GetErrorResponse(http.StatusNotFound, "Artifact not found"),
+				),
+			}, nil
+		}
+		return getArtifactDownloadStats500Error(err), nil
+	}
+
+	// to is the last day of the range, the series stops at the start of the following day.
+	buckets, err := c.DownloadStatRepository.GetTimeSeries(ctx, img.ID, from, to.AddDate(0, 0, 1), interval)
+	if err != nil {
+		return getArtifactDownloadStats500Error(fmt.Errorf("failed to get download stats: %w", err)), nil
+	}
+
+	stats := mapToAPIArtifactDownloadStats(buckets)
+	stats.From = from.Format(statsDateLayout)
+	stats.To = to.Format(statsDateLayout)
+	stats.Interval = artifact.DownloadStatsInterval(interval)
+	return artifact.GetArtifactDownloadStats200JSONResponse{
+		ArtifactDownloadStatsResponseJSONResponse: artifact.ArtifactDownloadStatsResponseJSONResponse{
+			Data:   stats,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func getDownloadStatsInterval(param *artifact.DownloadStatsIntervalParam) (types.DownloadStatInterval, error) {
+	if param == nil || *param == "" {
+		return types.DownloadStatIntervalDay, nil
+	}
+	switch artifact.DownloadStatsInterval(*param) {
+	case artifact.DownloadStatsIntervalDAY:
+		return types.DownloadStatIntervalDay, nil
+	case artifact.DownloadStatsIntervalWEEK:
+		return types.DownloadStatIntervalWeek, nil
+	case artifact.DownloadStatsIntervalMONTH:
+		return types.DownloadStatIntervalMonth, nil
+	}
+	return "", fmt.Errorf("invalid interval %q", *param)
+}
+
+// countDownloadStatsBuckets returns the number of periods of interval the days from from to to overlap. It stops
+// counting past maxDownloadStatsBuckets.
+func countDownloadStatsBuckets(from, to time.Time, interval types.DownloadStatInterval) int {
+	count := 0
+	for start := interval.BucketStart(from); !start.After(to) && count <= maxDownloadStatsBuckets; {
+		count++
+		start = interval.Next(start)
+	}
+	return count
+}
+
+// mapToAPIArtifactDownloadStats groups the buckets, ordered by version and start, by version. The versions are
+// sorted by their downloads, most downloaded first.
+func mapToAPIArtifactDownloadStats(buckets []types.DownloadStatBucket) artifact.ArtifactDownloadStats {
+	stats := artifact.ArtifactDownloadStats{Versions: []artifact.ArtifactVersionDownloadStats{}}
+	for _, b := range buckets {
+		last := len(stats.Versions) - 1
+		if last < 0 || stats.Versions[last].Version != b.Version {
+			stats.Versions = append(stats.Versions, artifact.ArtifactVersionDownloadStats{
+				Version: b.Version,
+				Buckets: []artifact.DownloadStatsBucket{},
+			})
+			last++
+		}
+		version := &stats.Versions[last]
+		version.Buckets = append(version.Buckets, artifact.DownloadStatsBucket{
+			Start: b.Start.Format(statsDateLayout),
+			Count: b.Count,
+		})
+		version.TotalCount += b.Count
+		stats.TotalCount += b.Count
+	}
+	sort.SliceStable(stats.Versions, func(i, j int) bool {
+		return stats.Versions[i].TotalCount > stats.Versions[j].TotalCount
+	})
+	return stats
+}
+
+func getArtifactDownloadStats400Error(err error) artifact.GetArtifactDownloadStatsResponseObject {
+	return artifact.GetArtifactDownloadStats400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func getArtifactDownloadStats500Error(err error) artifact.GetArtifactDownloadStatsResponseObject {
+	return artifact.GetArtifactDownloadStats500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadStatIntervalBucketStart(t *testing.T) {
+	// Wednesday.
+	day := time.Date(2024, 3, 13, 17, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2024, 3, 13, 0, 0, 0, 0, time.UTC), types.DownloadStatIntervalDay.BucketStart(day))
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), types.DownloadStatIntervalWeek.BucketStart(day))
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), types.DownloadStatIntervalMonth.BucketStart(day))
+
+	sunday := time.Date(2024, 3, 17, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), types.DownloadStatIntervalWeek.BucketStart(sunday))
+}
+
+func TestCountDownloadStatsBuckets(t *testing.T) {
+	from := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 31, countDownloadStatsBuckets(from, to, types.DownloadStatIntervalDay))
+	assert.Equal(t, 5, countDownloadStatsBuckets(from, to, types.DownloadStatIntervalWeek))
+	assert.Equal(t, 3, countDownloadStatsBuckets(from, to, types.DownloadStatIntervalMonth))
+
+	to = from.AddDate(10, 0, 0)
+	assert.Equal(t, maxDownloadStatsBuckets+1, countDownloadStatsBuckets(from, to, types.DownloadStatIntervalDay))
+}
+
+func TestMapToAPIArtifactDownloadStats(t *testing.T) {
+	march := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	stats := mapToAPIArtifactDownloadStats([]types.DownloadStatBucket{
+		{Version: "1.0.0", Start: march, Count: 2},
+		{Version: "1.0.0", Start: april, Count: 1},
+		{Version: "2.0.0", Start: april, Count: 5},
+	})
+
+	assert.Equal(t, int64(8), stats.TotalCount)
+	require.Len(t, stats.Versions, 2)
+	assert.Equal(t, "2.0.0", stats.Versions[0].Version)
+	assert.Equal(t, int64(5), stats.Versions[0].TotalCount)
+	assert.Equal(t, "1.0.0", stats.Versions[1].Version)
+	assert.Equal(t, int64(3), stats.Versions[1].TotalCount)
+	require.Len(t, stats.Versions[1].Buckets, 2)
+	assert.Equal(t, "03/01/2024", stats.Versions[1].Buckets[0].Start)
+	assert.Equal(t, "04/01/2024", stats.Versions[1].Buckets[1].Start)
+
+	empty := mapToAPIArtifactDownloadStats(nil)
+	assert.NotNil(t, empty.Versions)
+	assert.Zero(t, empty.TotalCount)
+}
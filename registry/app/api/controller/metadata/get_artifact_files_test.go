@@ -369,10 +369,38 @@ func setupFilesController(_ *testing.T, packageType artifact.PackageType) *metad
 		nil,                        // quarantineFinder
 		nil,                        // spaceStore
 		func(_ context.Context) bool { return false }, // untaggedImagesEnabled
-		mockPackageWrapper,                            // packageWrapper
-		nil,                                           // publicAccess
-		nil,                                           // storageService
-		nil,                                           // app
+		mockPackageWrapper, // packageWrapper
+		nil,                // publicAccess
+		nil,                // storageService
+		nil,                // registryPolicyService
+		nil,                // indexBuildRepository
+		nil,                // app
+		nil,                // trashService
+		nil,                // metadataHistoryRepository
+		nil,                // garbageRepository
+		nil,                // notificationChannelRepository
+		nil,                // notificationDispatcher
+		nil,                // imageDescriptionRepository
+		nil,                // eventOutbox
+		nil,                // failedUploadDao
+		nil,                // uploadFailureStatsDao
+		nil,                // concurrencyLimiter
+		nil,                // registryJobDao
+		nil,                // registryJobService
+		nil,                // registryUsageService
+		nil,                // imageStarRepository
+		nil,                // recentActivityService
+		nil,                // deletionApprovalService
+		nil,                // deletionService
+		nil,                // quarantineAccessAttemptStore
+		nil,                // packageDenylistService
+		nil,                // vulnerabilityService
+		nil,                // provenanceRepository
+		nil,                // ociImporter
+		nil,                // ociExporter
+		nil,                // searchRepository
+		nil,                // firewallDelayService
+		nil,                // upstreamProvenanceService
 	)
 }
 
@@ -446,7 +474,35 @@ func setupFilesControllerWithError(_ *testing.T, errorType string) *metadata.API
 		nil, // packageWrapper
 		nil, // publicAccess
 		nil, // storageService
+		nil, // registryPolicyService
+		nil, // indexBuildRepository
 		nil, // app
+		nil, // trashService
+		nil, // metadataHistoryRepository
+		nil, // garbageRepository
+		nil, // notificationChannelRepository
+		nil, // notificationDispatcher
+		nil, // imageDescriptionRepository
+		nil, // eventOutbox
+		nil, // failedUploadDao
+		nil, // uploadFailureStatsDao
+		nil, // concurrencyLimiter
+		nil, // registryJobDao
+		nil, // registryJobService
+		nil, // registryUsageService
+		nil, // imageStarRepository
+		nil, // recentActivityService
+		nil, // deletionApprovalService
+		nil, // deletionService
+		nil, // quarantineAccessAttemptStore
+		nil, // packageDenylistService
+		nil, // vulnerabilityService
+		nil, // provenanceRepository
+		nil, // ociImporter
+		nil, // ociExporter
+		nil, // searchRepository
+		nil, // firewallDelayService
+		nil, // upstreamProvenanceService
 	)
 }
 
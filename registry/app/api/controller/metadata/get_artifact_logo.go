@@ -0,0 +1,155 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// GetArtifactLogo serves the logo of an artifact. The ETag of the logo is its sha256, which lets clients
+// revalidate cached logos without downloading them again.
+func (c *APIController) GetArtifactLogo(
+	ctx context.Context,
+	r api.GetArtifactLogoRequestObject,
+) (api.GetArtifactLogoResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return getArtifactLogo400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return getArtifactLogo400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.GetArtifactLogo401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.GetArtifactLogo403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	var artifactType *api.ArtifactType
+	if r.Params.ArtifactType != nil {
+		artifactType, err = ValidateAndGetArtifactType(regInfo.PackageType, string(*r.Params.ArtifactType))
+		if err != nil {
+			return getArtifactLogo400Error(err), nil
+		}
+	}
+
+	img, err := c.ImageStore.GetByNameAndType(ctx, regInfo.RegistryID, string(r.Artifact), artifactType)
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return getArtifactLogo404Error("artifact doesn't exist with this name"), nil
+	}
+	if err != nil {
+		return getArtifactLogo500Error(err), nil
+	}
+
+	logoPath := getArtifactLogoPath(img)
+	sha256, _, err := c.fileManager.HeadFile(ctx, logoPath, regInfo.RegistryID)
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return getArtifactLogo404Error("artifact doesn't have a logo"), nil
+	}
+	if err != nil {
+		return getArtifactLogo500Error(err), nil
+	}
+
+	etag := artifactLogoETag(sha256)
+	if r.Params.IfNoneMatch != nil && string(*r.Params.IfNoneMatch) == etag {
+		return api.GetArtifactLogo304Response{
+			Headers: api.GetArtifactLogo304ResponseHeaders{
+				CacheControl: artifactLogoCacheControl,
+				ETag:         etag,
+			},
+		}, nil
+	}
+
+	fileReader, _, _, err := c.fileManager.DownloadFileByPath(ctx, logoPath, regInfo.RegistryID,
+		regInfo.RegistryIdentifier, regInfo.RootIdentifier, false)
+	if err != nil {
+		return getArtifactLogo500Error(fmt.Errorf("failed to download logo: %w", err)), nil
+	}
+	defer fileReader.Close()
+	content, err := io.ReadAll(io.LimitReader(fileReader, maxArtifactLogoSize+1))
+	if err != nil {
+		return getArtifactLogo500Error(fmt.Errorf("failed to read logo: %w", err)), nil
+	}
+	contentType, err := validateArtifactLogo(content)
+	if err != nil {
+		return getArtifactLogo500Error(fmt.Errorf("stored logo is invalid: %w", err)), nil
+	}
+
+	return api.GetArtifactLogo200ImageResponse{
+		Body: bytes.NewReader(content),
+		Headers: api.GetArtifactLogo200ResponseHeaders{
+			CacheControl: artifactLogoCacheControl,
+			ETag:         etag,
+		},
+		ContentType:   contentType,
+		ContentLength: int64(len(content)),
+	}, nil
+}
+
+func getArtifactLogo400Error(err error) api.GetArtifactLogoResponseObject {
+	return api.GetArtifactLogo400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func getArtifactLogo404Error(message string) api.GetArtifactLogoResponseObject {
+	return api.GetArtifactLogo404JSONResponse{
+		NotFoundJSONResponse: api.NotFoundJSONResponse(
+			*GetErrorResponse(http.StatusNotFound, message),
+		),
+	}
+}
+
+func getArtifactLogo500Error(err error) api.GetArtifactLogoResponseObject {
+	return api.GetArtifactLogo500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/metadata"
+	"github.com/harness/gitness/registry/types"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/opencontainers/go-digest"
+)
+
+// artifactScanThrottle is the minimum interval between two on-demand scans of the same image digest.
+const artifactScanThrottle = 15 * time.Minute
+
+var errScanNotSupported = errors.New("vulnerability scans are only supported for OCI artifacts")
+
+func (c *APIController) GetArtifactScanStatus(
+	ctx context.Context,
+	r artifact.GetArtifactScanStatusRequestObject,
+) (artifact.GetArtifactScanStatusResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return getArtifactScanStatus400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return getArtifactScanStatus400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.GetArtifactScanStatus403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	if !isOCIPackageType(regInfo.PackageType) {
+		return getArtifactScanStatus400Error(errScanNotSupported), nil
+	}
+	art, err := c.getOCIArtifact(ctx, regInfo, string(r.Artifact), string(r.Version))
+	if err != nil {
+		if errors.Is(err, store2.ErrResourceNotFound) {
+			return artifact.GetArtifactScanStatus404JSONResponse{
+				NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+					*GetErrorResponse(http.StatusNotFound, err.Error()),
+				),
+			}, nil
+		}
+		return getArtifactScanStatus500Error(err), nil
+	}
+	status, err := metadata.GetScanStatus(art.Metadata)
+	if err != nil {
+		return getArtifactScanStatus500Error(err), nil
+	}
+
+	return artifact.GetArtifactScanStatus200JSONResponse{
+		ArtifactScanStatusResponseJSONResponse: artifact.ArtifactScanStatusResponseJSONResponse{
+			Data:   GetArtifactScanStatus(art.Version, status),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// GetArtifactScanStatus maps the stored scan status of an image digest to the API model.
+func GetArtifactScanStatus(dgst string, status metadata.ScanStatus) artifact.ArtifactScanStatus {
+	scanStatus := artifact.ArtifactScanStatus{
+		Digest:  dgst,
+		Outcome: artifact.ArtifactScanOutcomeNOTSCANNED,
+	}
+	switch status.Outcome {
+	case metadata.ScanOutcomePending:
+		scanStatus.Outcome = artifact.ArtifactScanOutcomePENDING
+	case metadata.ScanOutcomePassed:
+		scanStatus.Outcome = artifact.ArtifactScanOutcomePASSED
+	case metadata.ScanOutcomeFailed:
+		scanStatus.Outcome = artifact.ArtifactScanOutcomeFAILED
+	case metadata.ScanOutcomeError:
+		scanStatus.Outcome = artifact.ArtifactScanOutcomeERROR
+	}
+	if status.LastRequestedAt > 0 {
+		scanStatus.LastRequestedAt = optionalString(GetTimeInMs(time.UnixMilli(status.LastRequestedAt)))
+		scanStatus.NextRequestAllowedAt = optionalString(
+			GetTimeInMs(time.UnixMilli(status.LastRequestedAt).Add(artifactScanThrottle)))
+	}
+	if status.LastScannedAt > 0 {
+		scanStatus.LastScannedAt = optionalString(GetTimeInMs(time.UnixMilli(status.LastScannedAt)))
+	}
+	return scanStatus
+}
+
+// getOCIArtifact returns the artifact of an OCI image version. The version is the manifest digest
+// when untagged images are enabled and a tag otherwise.
+func (c *APIController) getOCIArtifact(
+	ctx context.Context,
+	regInfo *types.RegistryRequestBaseInfo,
+	image string,
+	version string,
+	opts ...types.QueryOption,
+) (*types.Artifact, error) {
+	var manifestDigest types.Digest
+	var err error
+	if c.UntaggedImagesEnabled(ctx) {
+		manifestDigest, err = types.NewDigest(digest.Digest(version))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		m, err2 := c.ManifestStore.FindManifestByTagName(ctx, regInfo.RegistryID, image, version)
+		if err2 != nil {
+			return nil, err2
+		}
+		manifestDigest, err = types.NewDigest(m.Digest)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	art, err := c.ArtifactStore.GetByRegistryImageAndVersion(
+		ctx, regInfo.RegistryID, image, manifestDigest.String(), opts...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get artifact %s@%s: %w", image, manifestDigest.String(), err)
+	}
+	return art, nil
+}
+
+func isOCIPackageType(packageType artifact.PackageType) bool {
+	return packageType == artifact.PackageTypeDOCKER || packageType == artifact.PackageTypeHELM
+}
+
+func getArtifactScanStatus400Error(err error) artifact.GetArtifactScanStatusResponseObject {
+	return artifact.GetArtifactScanStatus400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func getArtifactScanStatus500Error(err error) artifact.GetArtifactScanStatusResponseObject {
+	return artifact.GetArtifactScanStatus500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
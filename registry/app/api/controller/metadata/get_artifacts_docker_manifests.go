@@ -176,6 +176,11 @@ func (c *APIController) getManifestDetails(
 		isQuarantined := true
 		manifestDetails.IsQuarantined = &isQuarantined
 		manifestDetails.QuarantineReason = &quarantinedArtifacts[0].Reason
+		manifestDetails.QuarantineBlockedPulls, manifestDetails.QuarantineLastBlockedPullAt, err =
+			c.quarantineBlockedPulls(ctx, m.RegistryID, image.Name, dgst.String())
+		if err != nil {
+			return artifact.DockerManifestDetails{}, err
+		}
 	} else {
 		isQuarantined := false
 		manifestDetails.IsQuarantined = &isQuarantined
@@ -0,0 +1,165 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	helmmetadata "github.com/harness/gitness/registry/app/metadata/helm"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+func (c *APIController) GetHelmArtifactDependencies(
+	ctx context.Context,
+	r artifact.GetHelmArtifactDependenciesRequestObject,
+) (artifact.GetHelmArtifactDependenciesResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return getHelmArtifactDependencies400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return getHelmArtifactDependencies400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.GetHelmArtifactDependencies403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+	art, err := c.getOCIArtifact(ctx, regInfo, string(r.Artifact), string(r.Version))
+	if err != nil {
+		return getHelmArtifactDependenciesLookupError(err), nil
+	}
+	var chartMetadata helmmetadata.Metadata
+	if len(art.Metadata) > 0 {
+		if err = json.Unmarshal(art.Metadata, &chartMetadata); err != nil {
+			return getHelmArtifactDependencies500Error(
+				fmt.Errorf("failed to unmarshal helm chart metadata: %w", err)), nil
+		}
+	}
+	if chartMetadata.Name == "" {
+		// charts pushed before dependencies were recorded have no chart metadata
+		return artifact.GetHelmArtifactDependencies404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "dependency report not available for this chart version"),
+			),
+		}, nil
+	}
+
+	registryURL := c.URLProvider.RegistryURL(ctx, regInfo.RootIdentifier, regInfo.RegistryIdentifier)
+	return artifact.GetHelmArtifactDependencies200JSONResponse{
+		HelmArtifactDependenciesResponseJSONResponse: artifact.HelmArtifactDependenciesResponseJSONResponse{
+			Data:   GetHelmArtifactDependencies(chartMetadata, registryURL),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func GetHelmArtifactDependencies(
+	chartMetadata helmmetadata.Metadata,
+	registryURL string,
+) artifact.HelmArtifactDependencies {
+	dependencies := make([]artifact.HelmChartDependency, 0, len(chartMetadata.Dependencies))
+	var externalDependencies int64
+	for _, dep := range chartMetadata.Dependencies {
+		external := IsExternalHelmDependency(dep.Repository, registryURL)
+		if external {
+			externalDependencies++
+		}
+		dependencies = append(dependencies, artifact.HelmChartDependency{
+			Name:            dep.Name,
+			Alias:           optionalString(dep.Alias),
+			Version:         optionalString(dep.Version),
+			Repository:      optionalString(dep.Repository),
+			ResolvedVersion: optionalString(dep.ResolvedVersion),
+			External:        external,
+		})
+	}
+
+	provenance := artifact.HelmChartProvenance{
+		Status: artifact.HelmChartProvenanceStatusMISSING,
+		KeyId:  optionalString(chartMetadata.Provenance.KeyID),
+		Signer: optionalString(chartMetadata.Provenance.Signer),
+	}
+	switch chartMetadata.Provenance.Status {
+	case helmmetadata.ProvenanceStatusVerified:
+		provenance.Status = artifact.HelmChartProvenanceStatusVERIFIED
+	case helmmetadata.ProvenanceStatusUnverified:
+		provenance.Status = artifact.HelmChartProvenanceStatusUNVERIFIED
+	case helmmetadata.ProvenanceStatusMissing:
+	}
+
+	return artifact.HelmArtifactDependencies{
+		Chart:                chartMetadata.Name,
+		ChartVersion:         chartMetadata.Version,
+		Provenance:           provenance,
+		Dependencies:         dependencies,
+		ExternalDependencies: externalDependencies,
+	}
+}
+
+func getHelmArtifactDependenciesLookupError(err error) artifact.GetHelmArtifactDependenciesResponseObject {
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return artifact.GetHelmArtifactDependencies404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}
+	}
+	return getHelmArtifactDependencies500Error(err)
+}
+
+func getHelmArtifactDependencies400Error(err error) artifact.GetHelmArtifactDependenciesResponseObject {
+	return artifact.GetHelmArtifactDependencies400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func getHelmArtifactDependencies500Error(err error) artifact.GetHelmArtifactDependenciesResponseObject {
+	return artifact.GetHelmArtifactDependencies500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -21,6 +21,7 @@ import (
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store"
@@ -109,6 +110,7 @@ func (c *APIController) GetArtifactSummary(
 			),
 		}, nil
 	}
+	c.RecentActivityService.RecordView(ctx, registry.ID, image, metadata.ArtifactType)
 	return artifact.GetArtifactSummary200JSONResponse{
 		ArtifactSummaryResponseJSONResponse: *GetArtifactSummary(*metadata),
 	}, nil
@@ -136,6 +138,23 @@ func (c *APIController) getImageMetadata(
 		CreatedAt:     img.CreatedAt,
 		ArtifactType:  img.ArtifactType,
 	}
+	description, err := c.ImageDescriptionRepository.GetLatest(ctx, img.ID)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, err
+	}
+	if description != nil {
+		imgMetadata.Description = description.Content
+	}
+	imgMetadata.StarCount, err = c.ImageStarRepository.GetStarCount(ctx, img.ID)
+	if err != nil {
+		return nil, err
+	}
+	if session, ok := request.AuthSessionFrom(ctx); ok && !auth.IsAnonymousSession(session) {
+		imgMetadata.Starred, err = c.ImageStarRepository.IsStarred(ctx, img.ID, session.Principal.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
 	//nolint:nestif
 	if registry.PackageType == artifact.PackageTypeDOCKER || registry.PackageType == artifact.PackageTypeHELM {
 		if c.UntaggedImagesEnabled(ctx) {
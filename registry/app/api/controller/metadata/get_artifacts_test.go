@@ -251,7 +251,35 @@ func setupArtifactsControllerWithError(_ *testing.T, errorType string) *metadata
 		nil, // packageWrapper
 		nil, // publicAccess
 		nil, // storageService
+		nil, // registryPolicyService
+		nil, // indexBuildRepository
 		nil, // app
+		nil, // trashService
+		nil, // metadataHistoryRepository
+		nil, // garbageRepository
+		nil, // notificationChannelRepository
+		nil, // notificationDispatcher
+		nil, // imageDescriptionRepository
+		nil, // eventOutbox
+		nil, // failedUploadDao
+		nil, // uploadFailureStatsDao
+		nil, // concurrencyLimiter
+		nil, // registryJobDao
+		nil, // registryJobService
+		nil, // registryUsageService
+		nil, // imageStarRepository
+		nil, // recentActivityService
+		nil, // deletionApprovalService
+		nil, // deletionService
+		nil, // quarantineAccessAttemptStore
+		nil, // packageDenylistService
+		nil, // vulnerabilityService
+		nil, // provenanceRepository
+		nil, // ociImporter
+		nil, // ociExporter
+		nil, // searchRepository
+		nil, // firewallDelayService
+		nil, // upstreamProvenanceService
 	)
 }
 
@@ -416,7 +444,35 @@ func setupArtifactsSnapshotController(
 		mockPackageWrapper, // packageWrapper
 		nil,                // publicAccess
 		nil,                // storageService
+		nil,                // registryPolicyService
+		nil,                // indexBuildRepository
 		nil,                // app
+		nil,                // trashService
+		nil,                // metadataHistoryRepository
+		nil,                // garbageRepository
+		nil,                // notificationChannelRepository
+		nil,                // notificationDispatcher
+		nil,                // imageDescriptionRepository
+		nil,                // eventOutbox
+		nil,                // failedUploadDao
+		nil,                // uploadFailureStatsDao
+		nil,                // concurrencyLimiter
+		nil,                // registryJobDao
+		nil,                // registryJobService
+		nil,                // registryUsageService
+		nil,                // imageStarRepository
+		nil,                // recentActivityService
+		nil,                // deletionApprovalService
+		nil,                // deletionService
+		nil,                // quarantineAccessAttemptStore
+		nil,                // packageDenylistService
+		nil,                // vulnerabilityService
+		nil,                // provenanceRepository
+		nil,                // ociImporter
+		nil,                // ociExporter
+		nil,                // searchRepository
+		nil,                // firewallDelayService
+		nil,                // upstreamProvenanceService
 	)
 }
 
@@ -36,7 +36,7 @@ func (c *APIController) GetArtifactVersionSummary(
 	r artifact.GetArtifactVersionSummaryRequestObject,
 ) (artifact.GetArtifactVersionSummaryResponseObject, error) {
 	image, version, pkgType, isQuarantined, quarantineReason,
-		artifactType, artifactUUID, registryUUID, err := c.FetchArtifactSummary(ctx, r)
+		artifactType, artifactUUID, registryUUID, registryType, err := c.FetchArtifactSummary(ctx, r)
 	if err != nil {
 		if errors.Is(err, apiauth.ErrUnauthorized) {
 			return artifact.GetArtifactVersionSummary401JSONResponse{
@@ -59,26 +59,81 @@ func (c *APIController) GetArtifactVersionSummary(
 		}, nil
 	}
 
+	response := GetArtifactVersionSummary(image, pkgType, version, isQuarantined, quarantineReason, artifactType,
+		artifactUUID, registryUUID)
+	c.setArtifactOrigin(ctx, &response.Data, registryType)
+
 	return artifact.GetArtifactVersionSummary200JSONResponse{
-		ArtifactVersionSummaryResponseJSONResponse: *GetArtifactVersionSummary(image,
-			pkgType, version, isQuarantined, quarantineReason, artifactType, artifactUUID, registryUUID),
+		ArtifactVersionSummaryResponseJSONResponse: *response,
 	}, nil
 }
 
+// setArtifactOrigin tells the versions cached by an upstream proxy apart from the versions published to the
+// registry, with where the cached files were fetched from. The versions cached before the provenance was recorded
+// have none.
+func (c *APIController) setArtifactOrigin(
+	ctx context.Context,
+	summary *artifact.ArtifactVersionSummary,
+	registryType artifact.RegistryType,
+) {
+	origin := artifact.ArtifactOriginPUBLISHED
+	if registryType != artifact.RegistryTypeUPSTREAM {
+		summary.Origin = &origin
+		return
+	}
+	origin = artifact.ArtifactOriginPROXIED
+	summary.Origin = &origin
+
+	art, err := c.ArtifactStore.GetByUUID(ctx, summary.Uuid, types.WithoutMetadata())
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to get version %s to get its upstream provenance", summary.Uuid)
+		return
+	}
+	provenances, err := c.UpstreamProvenanceService.List(ctx, art.ID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to get the upstream provenance of version %s", summary.Uuid)
+		return
+	}
+	if len(provenances) > 0 {
+		summary.UpstreamProvenance = mapToAPIUpstreamProvenance(provenances)
+	}
+}
+
+func mapToAPIUpstreamProvenance(provenances []*types.UpstreamProvenance) *artifact.UpstreamProvenance {
+	files := make([]artifact.UpstreamFileProvenance, 0, len(provenances))
+	for _, p := range provenances {
+		files = append(files, artifact.UpstreamFileProvenance{
+			FileName:    p.FileName,
+			SourceUrl:   p.SourceURL,
+			Checksums:   p.Checksums,
+			FirstSeenAt: GetTimeInMs(p.FirstSeenAt),
+		})
+	}
+	// the files are listed the first cached first.
+	firstSeenAt := files[0].FirstSeenAt
+	return &artifact.UpstreamProvenance{
+		FirstSeenAt: &firstSeenAt,
+		Files:       files,
+	}
+}
+
 // FetchArtifactSummary helper function for common logic.
 func (c *APIController) FetchArtifactSummary(
 	ctx context.Context,
 	r artifact.GetArtifactVersionSummaryRequestObject,
-) (string, string, artifact.PackageType, bool, string, *artifact.ArtifactType, string, string, error) {
+) (
+	string, string, artifact.PackageType, bool, string, *artifact.ArtifactType, string, string, artifact.RegistryType,
+	error,
+) {
 	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
 
 	if err != nil {
-		return "", "", "", false, "", nil, "", "", fmt.Errorf("failed to get registry request base info: %w", err)
+		return "", "", "", false, "", nil, "", "", "", fmt.Errorf("failed to get registry request base info: %w", err)
 	}
 
 	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
 	if err != nil {
-		return "", "", "", false, "", nil, "", "", err
+		return "", "", "", false, "", nil, "", "", "", err
 	}
 
 	session, _ := request.AuthSessionFrom(ctx)
@@ -90,7 +145,7 @@ func (c *APIController) FetchArtifactSummary(
 		session,
 		permissionChecks...,
 	); err != nil {
-		return "", "", "", false, "", nil, "", "", err
+		return "", "", "", false, "", nil, "", "", "", err
 	}
 
 	image := string(r.Artifact)
@@ -107,14 +162,14 @@ func (c *APIController) FetchArtifactSummary(
 
 	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
 	if err != nil {
-		return "", "", "", false, "", nil, "", "", err
+		return "", "", "", false, "", nil, "", "", "", err
 	}
 
 	var artifactType *artifact.ArtifactType
 	if r.Params.ArtifactType != nil {
 		artifactType, err = ValidateAndGetArtifactType(registry.PackageType, string(*r.Params.ArtifactType))
 		if err != nil {
-			return "", "", "", false, "", nil, "", "", err
+			return "", "", "", false, "", nil, "", "", "", err
 		}
 	}
 
@@ -144,32 +199,32 @@ func (c *APIController) FetchArtifactSummary(
 			}
 			parsedDigest, err := types.NewDigest(digest.Digest(d))
 			if err != nil {
-				return "", "", "", false, "", nil, "", "", err
+				return "", "", "", false, "", nil, "", "", "", err
 			}
 			art, err := c.ArtifactStore.GetArtifactMetadata(ctx, regInfo.ParentID, regInfo.RegistryIdentifier, image,
 				parsedDigest.String(), artifactType)
 			if err != nil {
-				return "", "", "", false, "", nil, "", "", err
+				return "", "", "", false, "", nil, "", "", "", err
 			}
 
 			return image, version, art.PackageType, isQuarantined, quarantineReason,
-				art.ArtifactType, art.UUID, registry.UUID, nil
+				art.ArtifactType, art.UUID, registry.UUID, registry.Type, nil
 		} else {
 			ociVersion, err = c.TagStore.GetTagMetadata(ctx, regInfo.ParentID, regInfo.RegistryIdentifier, image, version)
 			if err != nil {
-				return "", "", "", false, "", nil, "", "", err
+				return "", "", "", false, "", nil, "", "", "", err
 			}
 			return image, ociVersion.Name, ociVersion.PackageType, isQuarantined, quarantineReason,
-				nil, ociVersion.ArtifactUUID, registry.UUID, nil
+				nil, ociVersion.ArtifactUUID, registry.UUID, registry.Type, nil
 		}
 	}
 	art, err := c.ArtifactStore.GetArtifactMetadata(ctx, regInfo.ParentID, regInfo.RegistryIdentifier, image,
 		version, artifactType)
 
 	if err != nil {
-		return "", "", "", false, "", nil, "", "", err
+		return "", "", "", false, "", nil, "", "", "", err
 	}
 
 	return image, art.Name, art.PackageType, isQuarantined, quarantineReason,
-		art.ArtifactType, art.UUID, registry.UUID, nil
+		art.ArtifactType, art.UUID, registry.UUID, registry.Type, nil
 }
@@ -113,6 +113,8 @@ func (c *APIController) GetAllArtifactVersions(
 		}
 	}
 
+	semverSort := applyVersionListPreferences(regInfo, registry, r.Params)
+
 	img, err := c.ImageStore.GetByNameAndType(ctx, registry.ID, image, artifactType)
 	if err != nil {
 		if errors.Is(err, store.ErrResourceNotFound) {
@@ -127,21 +129,51 @@ func (c *APIController) GetAllArtifactVersions(
 
 	//nolint:nestif
 	if registry.PackageType == artifact.PackageTypeDOCKER || registry.PackageType == artifact.PackageTypeHELM {
+		deleted := types.WithSoftDeleteFilter(softDeleteFilter(r.Params.IncludeDeleted, r.Params.OnlyDeleted))
+		var count int64
+		if c.UntaggedImagesEnabled(ctx) {
+			count, err = c.TagStore.CountOciVersionByRepoAndImage(
+				ctx, regInfo.ParentID, regInfo.RegistryIdentifier,
+				image, regInfo.searchTerm, deleted,
+			)
+			if err != nil {
+				return throw500Error(err)
+			}
+		} else {
+			count, err = c.TagStore.CountAllTagsByRepoAndImage(
+				ctx, regInfo.ParentID, regInfo.RegistryIdentifier,
+				image, regInfo.searchTerm, deleted,
+			)
+			if err != nil {
+				return throw500Error(err)
+			}
+		}
+
+		limit, offset := regInfo.limit, regInfo.offset
+		if semverSort {
+			limit, offset = int(count), 0
+		}
 		var ociVersions *[]types.OciVersionMetadata
 		if c.UntaggedImagesEnabled(ctx) {
 			ociVersions, err = c.TagStore.GetAllOciVersionsByRepoAndImage(
 				ctx, regInfo.ParentID, regInfo.RegistryIdentifier,
-				image, regInfo.sortByField, regInfo.sortByOrder, regInfo.limit, regInfo.offset, regInfo.searchTerm,
+				image, regInfo.sortByField, regInfo.sortByOrder, limit, offset, regInfo.searchTerm,
+				deleted,
 			)
 		} else {
 			ociVersions, err = c.TagStore.GetAllTagsByRepoAndImage(
 				ctx, regInfo.ParentID, regInfo.RegistryIdentifier,
-				image, regInfo.sortByField, regInfo.sortByOrder, regInfo.limit, regInfo.offset, regInfo.searchTerm,
+				image, regInfo.sortByField, regInfo.sortByOrder, limit, offset, regInfo.searchTerm,
+				deleted,
 			)
 		}
 		if err != nil {
 			return throw500Error(err)
 		}
+		if semverSort {
+			*ociVersions = pageBySemver(*ociVersions, func(v types.OciVersionMetadata) string { return v.Name },
+				regInfo.sortByOrder, regInfo.offset, regInfo.limit)
+		}
 
 		var digests []string
 		for _, ociVersion := range *ociVersions {
@@ -165,25 +197,6 @@ func (c *APIController) GetAllArtifactVersions(
 				(*ociVersions)[i].DownloadCount = counts[ociVersion.Digest]
 			}
 		}
-		var count int64
-		if c.UntaggedImagesEnabled(ctx) {
-			count, err = c.TagStore.CountOciVersionByRepoAndImage(
-				ctx, regInfo.ParentID, regInfo.RegistryIdentifier,
-				image, regInfo.searchTerm,
-			)
-			if err != nil {
-				return throw500Error(err)
-			}
-		} else {
-			count, err = c.TagStore.CountAllTagsByRepoAndImage(
-				ctx, regInfo.ParentID, regInfo.RegistryIdentifier,
-				image, regInfo.searchTerm,
-			)
-			if err != nil {
-				return throw500Error(err)
-			}
-		}
-
 		err = setDigestCount(ctx, *ociVersions)
 		if err != nil {
 			return throw500Error(err)
@@ -197,15 +210,23 @@ func (c *APIController) GetAllArtifactVersions(
 			),
 		}, nil
 	}
+	cnt, _ := c.ArtifactStore.CountAllVersionsByRepoAndImage(ctx, regInfo.ParentID, regInfo.RegistryIdentifier, image,
+		regInfo.searchTerm, artifactType)
+
+	limit, offset := regInfo.limit, regInfo.offset
+	if semverSort {
+		limit, offset = int(cnt), 0
+	}
 	metadata, err := c.ArtifactStore.GetAllVersionsByRepoAndImage(ctx, regInfo.RegistryID, image,
-		regInfo.sortByField, regInfo.sortByOrder, regInfo.limit, regInfo.offset,
+		regInfo.sortByField, regInfo.sortByOrder, limit, offset,
 		regInfo.searchTerm, artifactType)
 	if err != nil {
 		return throw500Error(err)
 	}
-
-	cnt, _ := c.ArtifactStore.CountAllVersionsByRepoAndImage(ctx, regInfo.ParentID, regInfo.RegistryIdentifier, image,
-		regInfo.searchTerm, artifactType)
+	if semverSort {
+		*metadata = pageBySemver(*metadata, func(v types.NonOCIArtifactMetadata) string { return v.Name },
+			regInfo.sortByOrder, regInfo.offset, regInfo.limit)
+	}
 
 	registryURL := c.URLProvider.RegistryURL(ctx, regInfo.RootIdentifier, regInfo.RegistryIdentifier)
 	if registry.PackageType == artifact.PackageTypeGENERIC {
@@ -310,3 +331,18 @@ func throw500Error(err error) (artifact.GetAllArtifactVersionsResponseObject, er
 		),
 	}, nil
 }
+
+// softDeleteFilter maps the include_deleted and only_deleted query parameters to a filter, only_deleted wins
+// when both are set. Soft-deleted versions are excluded by default.
+func softDeleteFilter(
+	includeDeleted *artifact.IncludeDeletedParam,
+	onlyDeleted *artifact.OnlyDeletedParam,
+) types.SoftDeleteFilter {
+	if onlyDeleted != nil && bool(*onlyDeleted) {
+		return types.SoftDeleteFilterOnly
+	}
+	if includeDeleted != nil && bool(*includeDeleted) {
+		return types.SoftDeleteFilterInclude
+	}
+	return types.SoftDeleteFilterExclude
+}
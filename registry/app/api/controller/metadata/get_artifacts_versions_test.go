@@ -324,10 +324,38 @@ func setupVersionsController(_ *testing.T, packageType artifact.PackageType) *me
 		nil,                        // quarantineFinder
 		nil,                        // spaceStore
 		func(_ context.Context) bool { return false }, // untaggedImagesEnabled
-		mockPackageWrapper,                            // packageWrapper
-		nil,                                           // publicAccess
-		nil,                                           // storageService
-		nil,                                           // app
+		mockPackageWrapper, // packageWrapper
+		nil,                // publicAccess
+		nil,                // storageService
+		nil,                // registryPolicyService
+		nil,                // indexBuildRepository
+		nil,                // app
+		nil,                // trashService
+		nil,                // metadataHistoryRepository
+		nil,                // garbageRepository
+		nil,                // notificationChannelRepository
+		nil,                // notificationDispatcher
+		nil,                // imageDescriptionRepository
+		nil,                // eventOutbox
+		nil,                // failedUploadDao
+		nil,                // uploadFailureStatsDao
+		nil,                // concurrencyLimiter
+		nil,                // registryJobDao
+		nil,                // registryJobService
+		nil,                // registryUsageService
+		nil,                // imageStarRepository
+		nil,                // recentActivityService
+		nil,                // deletionApprovalService
+		nil,                // deletionService
+		nil,                // quarantineAccessAttemptStore
+		nil,                // packageDenylistService
+		nil,                // vulnerabilityService
+		nil,                // provenanceRepository
+		nil,                // ociImporter
+		nil,                // ociExporter
+		nil,                // searchRepository
+		nil,                // firewallDelayService
+		nil,                // upstreamProvenanceService
 	)
 }
 
@@ -407,7 +435,35 @@ func setupVersionsControllerWithError(_ *testing.T, errorType string) *metadata.
 		nil, // packageWrapper
 		nil, // publicAccess
 		nil, // storageService
+		nil, // registryPolicyService
+		nil, // indexBuildRepository
 		nil, // app
+		nil, // trashService
+		nil, // metadataHistoryRepository
+		nil, // garbageRepository
+		nil, // notificationChannelRepository
+		nil, // notificationDispatcher
+		nil, // imageDescriptionRepository
+		nil, // eventOutbox
+		nil, // failedUploadDao
+		nil, // uploadFailureStatsDao
+		nil, // concurrencyLimiter
+		nil, // registryJobDao
+		nil, // registryJobService
+		nil, // registryUsageService
+		nil, // imageStarRepository
+		nil, // recentActivityService
+		nil, // deletionApprovalService
+		nil, // deletionService
+		nil, // quarantineAccessAttemptStore
+		nil, // packageDenylistService
+		nil, // vulnerabilityService
+		nil, // provenanceRepository
+		nil, // ociImporter
+		nil, // ociExporter
+		nil, // searchRepository
+		nil, // firewallDelayService
+		nil, // upstreamProvenanceService
 	)
 }
 
@@ -929,6 +929,17 @@ func (c *APIController) getAnonymousMavenClientSetupDetails(
 					},
 				},
 			},
+			{
+				//nolint:lll
+				Header: utils.StringPtr("To resolve Gradle plugins published to this registry, add the following to the project’s settings.gradle:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						//nolint:lll
+						Value: utils.StringPtr("pluginManagement {\n    repositories {\n        maven {\n            url \"<REGISTRY_URL>\"\n        }\n        gradlePluginPortal()\n    }\n}"),
+					},
+				},
+			},
 		},
 	})
 
@@ -1161,6 +1172,17 @@ func (c *APIController) getMavenClientSetupDetails(
 					},
 				},
 			},
+			{
+				//nolint:lll
+				Header: utils.StringPtr("To resolve Gradle plugins published to this registry, add the following to the project’s settings.gradle:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						//nolint:lll
+						Value: utils.StringPtr("pluginManagement {\n    repositories {\n        maven {\n            url \"<REGISTRY_URL>\"\n\n            credentials {\n               username \"<USERNAME>\"\n               password \"identity-token\"\n            }\n        }\n        gradlePluginPortal()\n    }\n}"),
+					},
+				},
+			},
 		},
 	})
 
@@ -588,7 +588,35 @@ func TestGenerateClientSetupDetails_WithUntaggedImages(t *testing.T) {
 				nil, // packageWrapper
 				nil, // publicAccess
 				nil, // storageService
+				nil, // registryPolicyService
+				nil, // indexBuildRepository
 				nil, // app
+				nil, // trashService
+				nil, // metadataHistoryRepository
+				nil, // garbageRepository
+				nil, // notificationChannelRepository
+				nil, // notificationDispatcher
+				nil, // imageDescriptionRepository
+				nil, // eventOutbox
+				nil, // failedUploadDao
+				nil, // uploadFailureStatsDao
+				nil, // concurrencyLimiter
+				nil, // registryJobDao
+				nil, // registryJobService
+				nil, // registryUsageService
+				nil, // imageStarRepository
+				nil, // recentActivityService
+				nil, // deletionApprovalService
+				nil, // deletionService
+				nil, // quarantineAccessAttemptStore
+				nil, // packageDenylistService
+				nil, // vulnerabilityService
+				nil, // provenanceRepository
+				nil, // ociImporter
+				nil, // ociExporter
+				nil, // searchRepository
+				nil, // firewallDelayService
+				nil, // upstreamProvenanceService
 			)
 
 			ctx := context.Background()
@@ -662,7 +690,35 @@ func TestGenerateClientSetupDetails_MavenWithGroupID(t *testing.T) {
 		nil, // packageWrapper
 		nil, // publicAccess
 		nil, // storageService
+		nil, // registryPolicyService
+		nil, // indexBuildRepository
 		nil, // app
+		nil, // trashService
+		nil, // metadataHistoryRepository
+		nil, // garbageRepository
+		nil, // notificationChannelRepository
+		nil, // notificationDispatcher
+		nil, // imageDescriptionRepository
+		nil, // eventOutbox
+		nil, // failedUploadDao
+		nil, // uploadFailureStatsDao
+		nil, // concurrencyLimiter
+		nil, // registryJobDao
+		nil, // registryJobService
+		nil, // registryUsageService
+		nil, // imageStarRepository
+		nil, // recentActivityService
+		nil, // deletionApprovalService
+		nil, // deletionService
+		nil, // quarantineAccessAttemptStore
+		nil, // packageDenylistService
+		nil, // vulnerabilityService
+		nil, // provenanceRepository
+		nil, // ociImporter
+		nil, // ociExporter
+		nil, // searchRepository
+		nil, // firewallDelayService
+		nil, // upstreamProvenanceService
 	)
 
 	ctx := context.Background()
@@ -797,7 +853,35 @@ func setupControllerForPackageType(_ *testing.T, packageType artifact.PackageTyp
 		nil, // packageWrapper
 		nil, // publicAccess
 		nil, // storageService
+		nil, // registryPolicyService
+		nil, // indexBuildRepository
 		nil, // app
+		nil, // trashService
+		nil, // metadataHistoryRepository
+		nil, // garbageRepository
+		nil, // notificationChannelRepository
+		nil, // notificationDispatcher
+		nil, // imageDescriptionRepository
+		nil, // eventOutbox
+		nil, // failedUploadDao
+		nil, // uploadFailureStatsDao
+		nil, // concurrencyLimiter
+		nil, // registryJobDao
+		nil, // registryJobService
+		nil, // registryUsageService
+		nil, // imageStarRepository
+		nil, // recentActivityService
+		nil, // deletionApprovalService
+		nil, // deletionService
+		nil, // quarantineAccessAttemptStore
+		nil, // packageDenylistService
+		nil, // vulnerabilityService
+		nil, // provenanceRepository
+		nil, // ociImporter
+		nil, // ociExporter
+		nil, // searchRepository
+		nil, // firewallDelayService
+		nil, // upstreamProvenanceService
 	)
 }
 
@@ -887,7 +971,35 @@ func setupControllerForError(_ *testing.T, errorType string) *metadata.APIContro
 		nil, // packageWrapper
 		nil, // publicAccess
 		nil, // storageService
+		nil, // registryPolicyService
+		nil, // indexBuildRepository
 		nil, // app
+		nil, // trashService
+		nil, // metadataHistoryRepository
+		nil, // garbageRepository
+		nil, // notificationChannelRepository
+		nil, // notificationDispatcher
+		nil, // imageDescriptionRepository
+		nil, // eventOutbox
+		nil, // failedUploadDao
+		nil, // uploadFailureStatsDao
+		nil, // concurrencyLimiter
+		nil, // registryJobDao
+		nil, // registryJobService
+		nil, // registryUsageService
+		nil, // imageStarRepository
+		nil, // recentActivityService
+		nil, // deletionApprovalService
+		nil, // deletionService
+		nil, // quarantineAccessAttemptStore
+		nil, // packageDenylistService
+		nil, // vulnerabilityService
+		nil, // provenanceRepository
+		nil, // ociImporter
+		nil, // ociExporter
+		nil, // searchRepository
+		nil, // firewallDelayService
+		nil, // upstreamProvenanceService
 	)
 }
 
@@ -1041,7 +1153,35 @@ func TestGenerateClientSetupDetailsSnapshot(t *testing.T) {
 				nil, // packageWrapper
 				nil, // publicAccess
 				nil, // storageService
+				nil, // registryPolicyService
+				nil, // indexBuildRepository
 				nil, // app
+				nil, // trashService
+				nil, // metadataHistoryRepository
+				nil, // garbageRepository
+				nil, // notificationChannelRepository
+				nil, // notificationDispatcher
+				nil, // imageDescriptionRepository
+				nil, // eventOutbox
+				nil, // failedUploadDao
+				nil, // uploadFailureStatsDao
+				nil, // concurrencyLimiter
+				nil, // registryJobDao
+				nil, // registryJobService
+				nil, // registryUsageService
+				nil, // imageStarRepository
+				nil, // recentActivityService
+				nil, // deletionApprovalService
+				nil, // deletionService
+				nil, // quarantineAccessAttemptStore
+				nil, // packageDenylistService
+				nil, // vulnerabilityService
+				nil, // provenanceRepository
+				nil, // ociImporter
+				nil, // ociExporter
+				nil, // searchRepository
+				nil, // firewallDelayService
+				nil, // upstreamProvenanceService
 			)
 
 			ctx := context.Background()
@@ -0,0 +1,108 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func (c *APIController) GetEffectiveRegistryPolicy(
+	ctx context.Context,
+	r artifact.GetEffectiveRegistryPolicyRequestObject,
+) (artifact.GetEffectiveRegistryPolicyResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return getEffectiveRegistryPolicy400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return getEffectiveRegistryPolicy400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.GetEffectiveRegistryPolicy403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return getEffectiveRegistryPolicy500Error(err), nil
+	}
+	effective, err := c.RegistryPolicyService.Resolve(ctx, registry)
+	if err != nil {
+		return getEffectiveRegistryPolicy500Error(err), nil
+	}
+
+	return artifact.GetEffectiveRegistryPolicy200JSONResponse{
+		EffectiveRegistryPolicyResponseJSONResponse: artifact.EffectiveRegistryPolicyResponseJSONResponse{
+			Data:   GetEffectiveRegistryPolicy(effective),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// GetEffectiveRegistryPolicy maps the resolved policy of a registry to the API model.
+func GetEffectiveRegistryPolicy(effective *registryTypes.EffectiveRegistryPolicy) artifact.EffectiveRegistryPolicy {
+	sources := make([]artifact.RegistryPolicySource, 0, len(effective.Sources))
+	for _, s := range effective.Sources {
+		source := artifact.RegistryPolicySource{
+			Field: artifact.RegistryPolicySourceField(s.Field),
+			Type:  artifact.RegistryPolicySourceType(s.Type),
+		}
+		if s.SpacePath != "" {
+			source.SpacePath = &s.SpacePath
+		}
+		sources = append(sources, source)
+	}
+	return artifact.EffectiveRegistryPolicy{
+		Policy:  *fromRegistryPolicy(&effective.Policy),
+		Sources: sources,
+	}
+}
+
+func getEffectiveRegistryPolicy400Error(err error) artifact.GetEffectiveRegistryPolicyResponseObject {
+	return artifact.GetEffectiveRegistryPolicy400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func getEffectiveRegistryPolicy500Error(err error) artifact.GetEffectiveRegistryPolicyResponseObject {
+	return artifact.GetEffectiveRegistryPolicy500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/capability"
+)
+
+// GetPackageTypeCapabilities returns which capabilities are supported for each package type, so clients can
+// hide the features which aren't available for the package type of a registry.
+func (c *APIController) GetPackageTypeCapabilities(
+	ctx context.Context,
+	_ artifact.GetPackageTypeCapabilitiesRequestObject,
+) (artifact.GetPackageTypeCapabilitiesResponseObject, error) {
+	session, _ := request.AuthSessionFrom(ctx)
+	if session == nil || auth.IsAnonymousSession(session) {
+		return artifact.GetPackageTypeCapabilities401JSONResponse{
+			UnauthenticatedJSONResponse: artifact.UnauthenticatedJSONResponse(
+				*GetErrorResponse(http.StatusUnauthorized, "authentication required"),
+			),
+		}, nil
+	}
+
+	matrix := capability.Matrix()
+	packageTypes := make([]artifact.PackageTypeCapabilities, len(matrix))
+	for i, m := range matrix {
+		capabilities := make([]artifact.PackageTypeCapability, len(capability.Capabilities))
+		for j, cp := range capability.Capabilities {
+			capabilities[j] = artifact.PackageTypeCapability{
+				Capability: artifact.Capability(cp),
+				Supported:  m.Supported[cp],
+			}
+		}
+		packageTypes[i] = artifact.PackageTypeCapabilities{
+			PackageType:  m.PackageType,
+			Capabilities: capabilities,
+		}
+	}
+
+	return artifact.GetPackageTypeCapabilities200JSONResponse{
+		PackageTypeCapabilitiesResponseJSONResponse: artifact.PackageTypeCapabilitiesResponseJSONResponse{
+			Data:   artifact.ListPackageTypeCapabilities{PackageTypes: packageTypes},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
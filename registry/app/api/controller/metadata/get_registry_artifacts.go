@@ -17,6 +17,7 @@ package metadata
 import (
 	"context"
 	"net/http"
+	"slices"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/request"
@@ -92,6 +93,7 @@ func (c *APIController) GetAllArtifactsByRegistry(
 		}, nil
 	}
 
+	includeDownloadCounts := includesDownloadCounts(r.Params)
 	var artifacts *[]types.ArtifactMetadata
 	var count int64
 	var artifactType *artifact.ArtifactType
@@ -110,6 +112,7 @@ func (c *APIController) GetAllArtifactsByRegistry(
 		artifacts, err = c.TagStore.GetAllArtifactsByRepo(
 			ctx, regInfo.ParentID, regInfo.RegistryIdentifier,
 			regInfo.sortByField, regInfo.sortByOrder, regInfo.limit, regInfo.offset, regInfo.searchTerm, regInfo.labels,
+			includeDownloadCounts,
 		)
 		count, _ = c.TagStore.CountAllArtifactsByRepo(
 			ctx, regInfo.ParentID, regInfo.RegistryIdentifier,
@@ -126,7 +129,7 @@ func (c *APIController) GetAllArtifactsByRegistry(
 		artifacts, err = c.ArtifactStore.GetArtifactsByRepo(
 			ctx, regInfo.ParentID, regInfo.RegistryIdentifier,
 			regInfo.sortByField, regInfo.sortByOrder, regInfo.limit, regInfo.offset, regInfo.searchTerm, regInfo.labels,
-			artifactType)
+			artifactType, includeDownloadCounts)
 		count, _ = c.ArtifactStore.CountArtifactsByRepo(
 			ctx, regInfo.ParentID, regInfo.RegistryIdentifier,
 			regInfo.searchTerm, regInfo.labels, artifactType)
@@ -147,13 +150,37 @@ func (c *APIController) GetAllArtifactsByRegistry(
 			),
 		}, nil
 	}
+	artifacts, err = c.enrichArtifactWithStarCounts(ctx, artifacts, registry.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("failed to fetch the star counts of artifacts")
+		return artifact.GetAllArtifactsByRegistry500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+	response := GetAllArtifactByRegistryResponse(artifacts, count, regInfo.pageNumber, regInfo.limit)
+	if !includeDownloadCounts {
+		// the download counts weren't computed, they are fetched with GetArtifactDownloadCounts instead.
+		for i := range response.Data.Artifacts {
+			response.Data.Artifacts[i].DownloadsCount = nil
+		}
+	}
 	return artifact.GetAllArtifactsByRegistry200JSONResponse{
-		ListRegistryArtifactResponseJSONResponse: *GetAllArtifactByRegistryResponse(
-			artifacts, count, regInfo.pageNumber, regInfo.limit,
-		),
+		ListRegistryArtifactResponseJSONResponse: *response,
 	}, nil
 }
 
+// includesDownloadCounts returns whether the download counts of the artifacts are requested, they are always
+// computed to sort the artifacts by them.
+func includesDownloadCounts(params artifact.GetAllArtifactsByRegistryParams) bool {
+	if params.SortField != nil && *params.SortField == "downloadsCount" {
+		return true
+	}
+	return params.Include != nil &&
+		slices.Contains(*params.Include, string(artifact.GetAllArtifactsByRegistryParamsIncludeDownloadCounts))
+}
+
 func (c *APIController) enrichArtifactWithQuarantineInfo(
 	ctx context.Context,
 	artifacts *[]types.ArtifactMetadata,
@@ -201,6 +228,33 @@ func (c *APIController) enrichArtifactWithQuarantineInfo(
 	return artifacts, nil
 }
 
+// enrichArtifactWithStarCounts sets the number of stars of the artifacts, which is kept on their images.
+func (c *APIController) enrichArtifactWithStarCounts(
+	ctx context.Context,
+	artifacts *[]types.ArtifactMetadata,
+	registryID int64,
+) (*[]types.ArtifactMetadata, error) {
+	if artifacts == nil || len(*artifacts) == 0 {
+		return artifacts, nil
+	}
+
+	imageNames := make([]string, 0, len(*artifacts))
+	for _, artifact := range *artifacts {
+		imageNames = append(imageNames, artifact.Name)
+	}
+
+	starCounts, err := c.ImageStarRepository.GetStarCountsByImageNames(ctx, registryID, imageNames)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range *artifacts {
+		artifact := &(*artifacts)[i]
+		artifact.StarCount = starCounts[artifact.Name]
+	}
+	return artifacts, nil
+}
+
 func (c *APIController) getAllArtifactsByRegistry400JsonResponse(err error) (
 	artifact.GetAllArtifactsByRegistryResponseObject, error,
 ) {
@@ -129,3 +129,22 @@ func TestGetRegistryArtifactMetadata(t *testing.T) {
 	assert.NotNil(t, metadata[1].Labels)
 	assert.Empty(t, *metadata[1].Labels)
 }
+
+func TestIncludesDownloadCounts(t *testing.T) {
+	downloadCounts := api.IncludeParam{string(api.GetAllArtifactsByRegistryParamsIncludeDownloadCounts)}
+	sortByDownloads := api.SortField("downloadsCount")
+	sortByName := api.SortField("name")
+
+	assert.False(t, includesDownloadCounts(api.GetAllArtifactsByRegistryParams{}))
+	assert.False(t, includesDownloadCounts(api.GetAllArtifactsByRegistryParams{
+		Include:   &api.IncludeParam{"other"},
+		SortField: &sortByName,
+	}))
+	assert.True(t, includesDownloadCounts(api.GetAllArtifactsByRegistryParams{Include: &downloadCounts}))
+	assert.True(t, includesDownloadCounts(api.GetAllArtifactsByRegistryParams{SortField: &sortByDownloads}))
+}
+
+func TestUniqueArtifactNames(t *testing.T) {
+	names := uniqueArtifactNames(api.ArtifactNamesParam{"app", "", "lib", "app"})
+	assert.Equal(t, []string{"app", "lib"}, names)
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
+)
+
+// GetRegistryGarbageStats reports the soft-deleted rows of the account of the space which wait to be purged.
+func (c *APIController) GetRegistryGarbageStats(
+	ctx context.Context,
+	r artifact.GetRegistryGarbageStatsRequestObject,
+) (artifact.GetRegistryGarbageStatsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return getRegistryGarbageStats400Error(err), nil
+	}
+
+	rootSpace, err := c.SpaceFinder.FindByID(ctx, regInfo.RootIdentifierID)
+	if err != nil {
+		return getRegistryGarbageStats400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		rootSpace,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryView,
+	); err != nil {
+		return artifact.GetRegistryGarbageStats403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	stats, err := c.GarbageRepository.GetStats(ctx, rootSpace.ID, time.Now())
+	if err != nil {
+		return getRegistryGarbageStats500Error(err), nil
+	}
+
+	data := artifact.RegistryGarbageStats{Stats: make([]artifact.RegistryGarbageStat, 0, len(stats))}
+	for _, stat := range stats {
+		data.Stats = append(data.Stats, artifact.RegistryGarbageStat{
+			Kind:  artifact.RegistryGarbageStatKind(stat.Kind),
+			Age:   artifact.RegistryGarbageStatAge(stat.Age),
+			Count: stat.Count,
+			Size:  stat.Size,
+		})
+		data.TotalCount += stat.Count
+		data.TotalSize += stat.Size
+	}
+
+	return artifact.GetRegistryGarbageStats200JSONResponse{
+		RegistryGarbageStatsResponseJSONResponse: artifact.RegistryGarbageStatsResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func getRegistryGarbageStats400Error(err error) artifact.GetRegistryGarbageStatsResponseObject {
+	return artifact.GetRegistryGarbageStats400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func getRegistryGarbageStats500Error(err error) artifact.GetRegistryGarbageStatsResponseObject {
+	return artifact.GetRegistryGarbageStats500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
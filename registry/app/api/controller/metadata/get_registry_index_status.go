@@ -0,0 +1,108 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/events/asyncprocessing"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func (c *APIController) GetRegistryIndexStatus(
+	ctx context.Context,
+	r artifact.GetRegistryIndexStatusRequestObject,
+) (artifact.GetRegistryIndexStatusResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return getRegistryIndexStatus400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return getRegistryIndexStatus400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.GetRegistryIndexStatus403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	task, err := c.PostProcessingReporter.TaskRepository.Find(ctx,
+		asyncprocessing.RegistryIndexTaskKey(regInfo.RegistryID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return artifact.GetRegistryIndexStatus200JSONResponse{
+			RegistryIndexStatusResponseJSONResponse: artifact.RegistryIndexStatusResponseJSONResponse{
+				Data:   artifact.RegistryIndexStatus{Status: artifact.RegistryIndexStatusStatusNONE},
+				Status: artifact.StatusSUCCESS,
+			},
+		}, nil
+	}
+	if err != nil {
+		return getRegistryIndexStatus500Error(err), nil
+	}
+
+	return artifact.GetRegistryIndexStatus200JSONResponse{
+		RegistryIndexStatusResponseJSONResponse: artifact.RegistryIndexStatusResponseJSONResponse{
+			Data:   GetRegistryIndexStatus(task),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// GetRegistryIndexStatus maps the task which rebuilds the index of a registry to the API model.
+func GetRegistryIndexStatus(task *registryTypes.Task) artifact.RegistryIndexStatus {
+	updatedAt := GetTimeInMs(task.UpdatedAt)
+	return artifact.RegistryIndexStatus{
+		Status:        artifact.RegistryIndexStatusStatus(strings.ToUpper(string(task.Status))),
+		RebuildQueued: task.RunAgain,
+		UpdatedAt:     &updatedAt,
+	}
+}
+
+func getRegistryIndexStatus400Error(err error) artifact.GetRegistryIndexStatusResponseObject {
+	return artifact.GetRegistryIndexStatus400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func getRegistryIndexStatus500Error(err error) artifact.GetRegistryIndexStatusResponseObject {
+	return artifact.GetRegistryIndexStatus500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
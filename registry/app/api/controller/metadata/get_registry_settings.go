@@ -0,0 +1,110 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func (c *APIController) GetRegistrySettings(
+	ctx context.Context,
+	r artifact.GetRegistrySettingsRequestObject,
+) (artifact.GetRegistrySettingsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return getRegistrySettings400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return getRegistrySettings400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.GetRegistrySettings403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return getRegistrySettings500Error(err), nil
+	}
+	registrySettings, err := c.RegistryPolicyService.EffectiveSettings(ctx, registry)
+	if err != nil {
+		return getRegistrySettings500Error(err), nil
+	}
+
+	return artifact.GetRegistrySettings200JSONResponse{
+		RegistrySettingsResponseJSONResponse: artifact.RegistrySettingsResponseJSONResponse{
+			Data:   GetRegistrySettings(registrySettings),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// GetRegistrySettings maps the effective settings of a registry to the API model.
+func GetRegistrySettings(registrySettings []registryTypes.RegistrySetting) []artifact.RegistrySetting {
+	result := make([]artifact.RegistrySetting, 0, len(registrySettings))
+	for _, s := range registrySettings {
+		source := artifact.RegistryPolicySource{
+			Field: artifact.RegistryPolicySourceField(s.Source.Field),
+			Type:  artifact.RegistryPolicySourceType(s.Source.Type),
+		}
+		if s.Source.SpacePath != "" {
+			source.SpacePath = &s.Source.SpacePath
+		}
+		result = append(result, artifact.RegistrySetting{
+			Key:     artifact.RegistrySettingKey(s.Key),
+			Value:   s.Value,
+			Default: s.Default,
+			Source:  source,
+		})
+	}
+	return result
+}
+
+func getRegistrySettings400Error(err error) artifact.GetRegistrySettingsResponseObject {
+	return artifact.GetRegistrySettings400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func getRegistrySettings500Error(err error) artifact.GetRegistrySettingsResponseObject {
+	return artifact.GetRegistrySettings500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
+)
+
+func (c *APIController) GetSpaceRegistryPolicy(
+	ctx context.Context,
+	r artifact.GetSpaceRegistryPolicyRequestObject,
+) (artifact.GetSpaceRegistryPolicyResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return getSpaceRegistryPolicy400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return getSpaceRegistryPolicy400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryView,
+	); err != nil {
+		return artifact.GetSpaceRegistryPolicy403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	policy, err := c.RegistryPolicyService.GetSpacePolicy(ctx, space.ID)
+	if err != nil {
+		return getSpaceRegistryPolicy500Error(err), nil
+	}
+
+	return artifact.GetSpaceRegistryPolicy200JSONResponse{
+		RegistryPolicyResponseJSONResponse: artifact.RegistryPolicyResponseJSONResponse{
+			Data:   *fromRegistryPolicy(policy),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func getSpaceRegistryPolicy400Error(err error) artifact.GetSpaceRegistryPolicyResponseObject {
+	return artifact.GetSpaceRegistryPolicy400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func getSpaceRegistryPolicy500Error(err error) artifact.GetSpaceRegistryPolicyResponseObject {
+	return artifact.GetSpaceRegistryPolicy500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
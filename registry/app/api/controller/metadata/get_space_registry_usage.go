@@ -0,0 +1,106 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
+)
+
+// GetSpaceRegistryUsage reports the usage of the registries of the space and of each space below it,
+// the usage of a space includes the usage of the spaces below it.
+func (c *APIController) GetSpaceRegistryUsage(
+	ctx context.Context,
+	r artifact.GetSpaceRegistryUsageRequestObject,
+) (artifact.GetSpaceRegistryUsageResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return getSpaceRegistryUsage400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return getSpaceRegistryUsage400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryView,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return artifact.GetSpaceRegistryUsage401JSONResponse{
+				UnauthenticatedJSONResponse: artifact.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return artifact.GetSpaceRegistryUsage403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	now := time.Now().UTC()
+	usages, err := c.RegistryUsageService.RollUp(ctx, space, now)
+	if err != nil {
+		return artifact.GetSpaceRegistryUsage500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	periodStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	data := artifact.SpaceRegistryUsageReport{
+		PeriodStart: periodStart.Format(statsDateLayout),
+		Spaces:      make([]artifact.SpaceRegistryUsage, 0, len(usages)),
+	}
+	for _, u := range usages {
+		data.Spaces = append(data.Spaces, artifact.SpaceRegistryUsage{
+			SpacePath:      u.SpacePath,
+			RegistryCount:  u.RegistryCount,
+			StorageBytes:   u.StorageBytes,
+			BandwidthBytes: u.BandwidthBytes,
+		})
+	}
+
+	return artifact.GetSpaceRegistryUsage200JSONResponse{
+		SpaceRegistryUsageResponseJSONResponse: artifact.SpaceRegistryUsageResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func getSpaceRegistryUsage400Error(err error) artifact.GetSpaceRegistryUsageResponseObject {
+	return artifact.GetSpaceRegistryUsage400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
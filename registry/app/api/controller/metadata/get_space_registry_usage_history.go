@@ -0,0 +1,111 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
+)
+
+// GetSpaceRegistryUsageHistory reports the daily snapshots of the registry usage of the space.
+func (c *APIController) GetSpaceRegistryUsageHistory(
+	ctx context.Context,
+	r artifact.GetSpaceRegistryUsageHistoryRequestObject,
+) (artifact.GetSpaceRegistryUsageHistoryResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return getSpaceRegistryUsageHistory400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return getSpaceRegistryUsageHistory400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryView,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return artifact.GetSpaceRegistryUsageHistory401JSONResponse{
+				UnauthenticatedJSONResponse: artifact.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return artifact.GetSpaceRegistryUsageHistory403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	from, to, err := getStatsDateRange(r.Params.From, r.Params.To, time.Now())
+	if err != nil {
+		return getSpaceRegistryUsageHistory400Error(err), nil
+	}
+
+	// snapshots are kept by day, the range includes the last day.
+	snapshots, err := c.RegistryUsageService.History(ctx, space, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return artifact.GetSpaceRegistryUsageHistory500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	data := artifact.SpaceRegistryUsageHistory{
+		SpacePath: space.Path,
+		From:      from.Format(statsDateLayout),
+		To:        to.Format(statsDateLayout),
+		Snapshots: make([]artifact.SpaceRegistryUsageSnapshot, 0, len(snapshots)),
+	}
+	for _, s := range snapshots {
+		data.Snapshots = append(data.Snapshots, artifact.SpaceRegistryUsageSnapshot{
+			Day:            s.Day.Format(statsDateLayout),
+			RegistryCount:  s.RegistryCount,
+			StorageBytes:   s.StorageBytes,
+			BandwidthBytes: s.BandwidthBytes,
+		})
+	}
+
+	return artifact.GetSpaceRegistryUsageHistory200JSONResponse{
+		SpaceRegistryUsageHistoryResponseJSONResponse: artifact.SpaceRegistryUsageHistoryResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func getSpaceRegistryUsageHistory400Error(err error) artifact.GetSpaceRegistryUsageHistoryResponseObject {
+	return artifact.GetSpaceRegistryUsageHistory400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
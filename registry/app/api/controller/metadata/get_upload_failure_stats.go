@@ -0,0 +1,114 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
+)
+
+// GetUploadFailureStats reports the failed uploads of the registries of the account of the space by registry,
+// package type and error class.
+func (c *APIController) GetUploadFailureStats(
+	ctx context.Context,
+	r artifact.GetUploadFailureStatsRequestObject,
+) (artifact.GetUploadFailureStatsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return getUploadFailureStats400Error(err), nil
+	}
+
+	rootSpace, err := c.SpaceFinder.FindByID(ctx, regInfo.RootIdentifierID)
+	if err != nil {
+		return getUploadFailureStats400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		rootSpace,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryView,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return artifact.GetUploadFailureStats401JSONResponse{
+				UnauthenticatedJSONResponse: artifact.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return artifact.GetUploadFailureStats403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	from, to, err := getStatsDateRange(r.Params.From, r.Params.To, time.Now())
+	if err != nil {
+		return getUploadFailureStats400Error(err), nil
+	}
+
+	// the counts are kept by day, the range includes the last day.
+	stats, err := c.UploadFailureStatsStore.GetStats(ctx, rootSpace.ID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return artifact.GetUploadFailureStats500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	data := artifact.UploadFailureStats{
+		From:  from.Format(statsDateLayout),
+		To:    to.Format(statsDateLayout),
+		Stats: make([]artifact.UploadFailureStat, 0, len(stats)),
+	}
+	for _, stat := range stats {
+		data.Stats = append(data.Stats, artifact.UploadFailureStat{
+			RegistryIdentifier: stat.RegistryName,
+			PackageType:        stat.PackageType,
+			ErrorClass:         artifact.UploadFailureStatErrorClass(strings.ToUpper(string(stat.ErrorClass))),
+			Count:              stat.Count,
+			LastFailedAt:       GetTimeInMs(stat.LastFailedAt),
+		})
+		data.TotalCount += stat.Count
+	}
+
+	return artifact.GetUploadFailureStats200JSONResponse{
+		UploadFailureStatsResponseJSONResponse: artifact.UploadFailureStatsResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func getUploadFailureStats400Error(err error) artifact.GetUploadFailureStatsResponseObject {
+	return artifact.GetUploadFailureStats400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
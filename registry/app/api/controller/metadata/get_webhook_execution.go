@@ -25,6 +25,7 @@ import (
 	"github.com/harness/gitness/app/api/request"
 	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/store"
+	gitnesstypes "github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 
 	"github.com/rs/zerolog/log"
@@ -86,6 +87,10 @@ func (c *APIController) GetWebhookExecution(
 		log.Ctx(ctx).Error().Msgf(getWebhookErrMsg, regInfo.RegistryRef, r.WebhookIdentifier, err)
 		return getWebhooksExecutionsInternalErrorResponse(fmt.Errorf("failed to find webhook execution: %w", err))
 	}
+	if err = c.WebhookService.OpenPayloads([]*gitnesstypes.WebhookExecutionCore{w}); err != nil {
+		log.Ctx(ctx).Error().Msgf(getWebhookErrMsg, regInfo.RegistryRef, r.WebhookIdentifier, err)
+		return getWebhooksExecutionsInternalErrorResponse(err)
+	}
 	webhookExecution, err := MapToWebhookExecutionResponseEntity(*w)
 	if err != nil {
 		log.Ctx(ctx).Error().Msgf(getWebhookErrMsg, regInfo.RegistryRef, r.WebhookIdentifier, err)
@@ -259,8 +259,11 @@ func TestGetWebhookExecution(t *testing.T) {
 			mockWebhooksRepo := new(mocks.WebhooksRepository)
 			mockWebhooksExecRepo := new(mocks.WebhooksExecutionRepository)
 
+			mockWebhookService := new(mocks.WebhookService)
+			mockWebhookService.On("OpenPayloads", mock.Anything).Return(nil).Maybe()
+
 			// Create controller
-			controller := &APIController{}
+			controller := &APIController{WebhookService: mockWebhookService}
 
 			// Setup mocks
 			tt.setupMocks(controller, mockSpaceFinder, mockAuthorizer, mockRegistryMetadataHelper, mockWebhooksRepo, mockWebhooksExecRepo)
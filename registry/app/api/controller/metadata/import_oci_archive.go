@@ -0,0 +1,212 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/docker"
+	"github.com/harness/gitness/registry/app/pkg/ocilayout"
+	"github.com/harness/gitness/registry/services/concurrency"
+	"github.com/harness/gitness/types/enum"
+
+	v2 "github.com/distribution/distribution/v3/registry/api/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// ImportOciArchive imports the images of an OCI image layout or docker save archive into a docker or helm
+// registry.
+func (c *APIController) ImportOciArchive(
+	ctx context.Context,
+	r api.ImportOciArchiveRequestObject,
+) (api.ImportOciArchiveResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return importOciArchive400Error(err.Error()), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return importOciArchive400Error(err.Error()), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionArtifactsUpload)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ImportOciArchive401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ImportOciArchive403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	if regInfo.PackageType != api.PackageTypeDOCKER && regInfo.PackageType != api.PackageTypeHELM {
+		return importOciArchive400Error("archives can only be imported into docker and helm registries"), nil
+	}
+	if regInfo.RegistryType != api.RegistryTypeVIRTUAL {
+		return importOciArchive400Error("archives can't be imported into upstream proxies"), nil
+	}
+	if r.Body == nil {
+		return importOciArchive400Error("archive is required"), nil
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return importOciArchive500Error(fmt.Errorf("failed to get registry: %w", err)), nil
+	}
+
+	release, err := c.ConcurrencyLimiter.Acquire(ctx, regInfo.RootIdentifierID, concurrency.OperationImport)
+	var limitErr *concurrency.LimitError
+	if errors.As(err, &limitErr) {
+		return api.ImportOciArchive429JSONResponse{
+			TooManyRequestsJSONResponse: api.TooManyRequestsJSONResponse(
+				*GetErrorResponse(http.StatusTooManyRequests, limitErr.Error()),
+			),
+		}, nil
+	}
+	if err != nil {
+		return importOciArchive500Error(err), nil
+	}
+	defer release()
+
+	urlBuilder, err := v2.NewURLBuilderFromString(c.URLProvider.RegistryURL(ctx), false)
+	if err != nil {
+		return importOciArchive500Error(fmt.Errorf("failed to build registry url: %w", err)), nil
+	}
+
+	info := pkg.RegistryInfo{
+		ArtifactInfo: &pkg.ArtifactInfo{
+			BaseInfo: &pkg.BaseInfo{
+				PathRoot:       strings.ToLower(regInfo.RootIdentifier),
+				RootIdentifier: regInfo.RootIdentifier,
+				RootParentID:   regInfo.RootIdentifierID,
+				ParentID:       regInfo.ParentID,
+			},
+			RegIdentifier: registry.Name,
+			RegistryID:    registry.ID,
+			Registry:      *registry,
+		},
+		URLBuilder:  urlBuilder,
+		PackageType: registry.PackageType,
+	}
+
+	image := ""
+	if r.Params.Image != nil {
+		image = string(*r.Params.Image)
+	}
+	result, err := c.OCIImporter.Import(ctx, info, r.Body, image)
+	if result != nil && len(result.Images) > 0 {
+		c.auditOciImport(ctx, session, space.Path, registry.Name, result)
+	}
+	if errors.Is(err, ocilayout.ErrInvalidArchive) || errors.Is(err, docker.ErrImportDenied) {
+		return importOciArchive400Error(err.Error()), nil
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msgf("failed to import archive into registry %s", registry.Name)
+		return importOciArchive500Error(err), nil
+	}
+
+	return api.ImportOciArchive200JSONResponse{
+		OciImportResponseJSONResponse: api.OciImportResponseJSONResponse{
+			Data:   toOciImportResult(result),
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func (c *APIController) auditOciImport(
+	ctx context.Context,
+	session *auth.Session,
+	spacePath string,
+	registryName string,
+	result *docker.ImportResult,
+) {
+	err := c.AuditService.Log(
+		ctx,
+		session.Principal,
+		audit.NewResource(audit.ResourceTypeRegistry, registryName),
+		audit.ActionUploaded,
+		spacePath,
+		audit.WithActorChain(audit.ActorChain(ctx, session.Principal)),
+		audit.WithData("images", strconv.Itoa(len(result.Images))),
+		audit.WithData("blobsUploaded", strconv.Itoa(result.BlobsUploaded)),
+		audit.WithData("blobsLinked", strconv.Itoa(result.BlobsLinked)),
+	)
+	if err != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for import archive operation: %s", err)
+	}
+}
+
+func toOciImportResult(result *docker.ImportResult) api.OciImportResult {
+	images := make([]api.OciImportedImage, 0, len(result.Images))
+	for _, image := range result.Images {
+		imported := api.OciImportedImage{
+			Image:         image.Image,
+			Digest:        image.Digest.String(),
+			Reconstructed: image.Reconstructed,
+		}
+		if image.Tag != "" {
+			tag := image.Tag
+			imported.Tag = &tag
+		}
+		images = append(images, imported)
+	}
+	return api.OciImportResult{
+		Images:        images,
+		BlobsUploaded: result.BlobsUploaded,
+		BlobsLinked:   result.BlobsLinked,
+	}
+}
+
+func importOciArchive400Error(message string) api.ImportOciArchiveResponseObject {
+	return api.ImportOciArchive400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, message),
+		),
+	}
+}
+
+func importOciArchive500Error(err error) api.ImportOciArchiveResponseObject {
+	return api.ImportOciArchive500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/controller/metadata"
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/concurrency"
+	"github.com/harness/gitness/registry/types"
+	coretypes "github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newOciArchiveController builds a controller for the docker registry "reg" of the root space 3 which archives
+// are imported into and exported from.
+func newOciArchiveController(
+	permission enum.Permission,
+	limiter *concurrency.Limiter,
+) *metadata.APIController {
+	mockSpaceFinder := new(mocks.SpaceFinder)
+	mockAuthorizer := new(mocks.Authorizer)
+	mockRegistryMetadataHelper := new(mocks.RegistryMetadataHelper)
+	mockRegistryRepo := new(mocks.RegistryRepository)
+
+	regInfo := &types.RegistryRequestBaseInfo{
+		RootIdentifierID:   3,
+		RegistryID:         1,
+		RegistryIdentifier: "reg",
+		ParentRef:          "root/parent",
+		RegistryType:       api.RegistryTypeVIRTUAL,
+		PackageType:        api.PackageTypeDOCKER,
+	}
+	space := &coretypes.SpaceCore{ID: 2, Path: "root/parent"}
+	var permissionChecks []coretypes.PermissionCheck
+	mockRegistryMetadataHelper.On("GetRegistryRequestBaseInfo", mock.Anything, "", "reg").Return(regInfo, nil)
+	mockSpaceFinder.On("FindByRef", mock.Anything, "root/parent").Return(space, nil)
+	mockRegistryMetadataHelper.On("GetPermissionChecks", space, "reg", permission).Return(permissionChecks)
+	mockAuthorizer.On("CheckAll", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	mockRegistryRepo.On("Get", mock.Anything, int64(1)).
+		Return(&types.Registry{ID: 1, Name: "reg", PackageType: api.PackageTypeDOCKER}, nil)
+
+	return &metadata.APIController{
+		SpaceFinder:            mockSpaceFinder,
+		Authorizer:             mockAuthorizer,
+		RegistryMetadataHelper: mockRegistryMetadataHelper,
+		RegistryRepository:     mockRegistryRepo,
+		ConcurrencyLimiter:     limiter,
+	}
+}
+
+func TestImportOciArchiveConcurrencyLimit(t *testing.T) {
+	limiter := concurrency.NewLimiter(concurrency.Config{
+		Limits: map[concurrency.Operation]int{concurrency.OperationImport: 1},
+	})
+	release, err := limiter.TryAcquire(3, concurrency.OperationImport)
+	require.NoError(t, err)
+	defer release()
+
+	controller := newOciArchiveController(enum.PermissionArtifactsUpload, limiter)
+	resp, err := controller.ImportOciArchive(context.Background(), api.ImportOciArchiveRequestObject{
+		RegistryRef: "reg",
+		Body:        strings.NewReader(""),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, api.ImportOciArchive429JSONResponse{
+		TooManyRequestsJSONResponse: api.TooManyRequestsJSONResponse{
+			Code:    "429",
+			Message: "the account already runs the maximum of 1 concurrent import operations, retry later",
+		},
+	}, resp)
+	assert.Equal(t, 1, limiter.Running(3, concurrency.OperationImport))
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+var errEmptyPackageDenylistImport = errors.New("the advisories to import are required")
+
+// ImportPackageDenylist adds the packages affected by OSV advisories to the package denylist of the space.
+func (c *APIController) ImportPackageDenylist(
+	ctx context.Context,
+	r api.ImportPackageDenylistRequestObject,
+) (api.ImportPackageDenylistResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return importPackageDenylist400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return importPackageDenylist400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryEdit,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ImportPackageDenylist401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ImportPackageDenylist403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	if r.Body == nil || len(r.Body.Advisories) == 0 {
+		return importPackageDenylist400Error(errEmptyPackageDenylistImport), nil
+	}
+
+	imported, skipped, err := c.PackageDenylistService.Import(ctx, space.ID, session.Principal.ID,
+		toAdvisories(r.Body.Advisories))
+	if err != nil {
+		return api.ImportPackageDenylist500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	auditErr := c.AuditService.Log(
+		ctx,
+		session.Principal,
+		audit.NewResource(audit.ResourceTypeRegistryPackageDenylist, space.Identifier),
+		audit.ActionUpdated,
+		space.Path,
+		audit.WithActorChain(audit.ActorChain(ctx, session.Principal)),
+		audit.WithData("advisories", strconv.Itoa(len(r.Body.Advisories))),
+		audit.WithData("imported", strconv.Itoa(imported)),
+		audit.WithData("skipped", strconv.Itoa(skipped)),
+	)
+	if auditErr != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for import package denylist operation: %s", auditErr)
+	}
+
+	return api.ImportPackageDenylist200JSONResponse{
+		PackageDenylistImportResponseJSONResponse: api.PackageDenylistImportResponseJSONResponse{
+			Data: api.PackageDenylistImportResult{
+				Imported: imported,
+				Skipped:  skipped,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func importPackageDenylist400Error(err error) api.ImportPackageDenylistResponseObject {
+	return api.ImportPackageDenylist400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const listDescriptionHistoryErrMsg = "failed to list description history of artifact: %s with error: %v"
+
+func (c *APIController) ListArtifactDescriptionHistory(
+	ctx context.Context,
+	r api.ListArtifactDescriptionHistoryRequestObject,
+) (api.ListArtifactDescriptionHistoryResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return listDescriptionHistory400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return listDescriptionHistory400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return api.ListArtifactDescriptionHistory403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	var artifactType *api.ArtifactType
+	if r.Params.ArtifactType != nil {
+		artifactType, err = ValidateAndGetArtifactType(regInfo.PackageType, string(*r.Params.ArtifactType))
+		if err != nil {
+			return listDescriptionHistory400Error(err), nil
+		}
+	}
+
+	image := string(r.Artifact)
+	img, err := c.ImageStore.GetByNameAndType(ctx, regInfo.RegistryID, image, artifactType)
+	if err != nil {
+		if errors.Is(err, store2.ErrResourceNotFound) {
+			return api.ListArtifactDescriptionHistory404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse(
+					*GetErrorResponse(http.StatusNotFound, "artifact doesn't exist with this name"),
+				),
+			}, nil
+		}
+		return listDescriptionHistory500Error(err), nil
+	}
+
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+	descriptions, err := c.ImageDescriptionRepository.ListForImage(ctx, img.ID, limit, offset)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(listDescriptionHistoryErrMsg, image, err)
+		return listDescriptionHistory500Error(fmt.Errorf("failed to list description history: %w", err)), nil
+	}
+	count, err := c.ImageDescriptionRepository.CountForImage(ctx, img.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(listDescriptionHistoryErrMsg, image, err)
+		return listDescriptionHistory500Error(fmt.Errorf("failed to get description history count: %w", err)), nil
+	}
+
+	revisions := make([]api.ArtifactDescription, 0, len(descriptions))
+	for _, description := range descriptions {
+		revisions = append(revisions, mapToAPIArtifactDescription(description))
+	}
+	pageCount := GetPageCount(count, limit)
+	currentPageSize := len(revisions)
+	return api.ListArtifactDescriptionHistory200JSONResponse{
+		ListArtifactDescriptionResponseJSONResponse: api.ListArtifactDescriptionResponseJSONResponse{
+			Data: api.ListArtifactDescription{
+				Descriptions: revisions,
+				ItemCount:    &count,
+				PageCount:    &pageCount,
+				PageIndex:    &pageNumber,
+				PageSize:     &currentPageSize,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func listDescriptionHistory400Error(err error) api.ListArtifactDescriptionHistoryResponseObject {
+	return api.ListArtifactDescriptionHistory400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func listDescriptionHistory500Error(err error) api.ListArtifactDescriptionHistoryResponseObject {
+	return api.ListArtifactDescriptionHistory500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
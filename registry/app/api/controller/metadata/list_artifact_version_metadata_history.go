@@ -0,0 +1,175 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const listMetadataHistoryErrMsg = "failed to list metadata history of artifact: %s@%s with error: %v"
+
+func (c *APIController) ListArtifactVersionMetadataHistory(
+	ctx context.Context,
+	r api.ListArtifactVersionMetadataHistoryRequestObject,
+) (api.ListArtifactVersionMetadataHistoryResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return listMetadataHistory400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return listMetadataHistory400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return api.ListArtifactVersionMetadataHistory403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	var artifactType *api.ArtifactType
+	if r.Params.ArtifactType != nil {
+		artifactType, err = ValidateAndGetArtifactType(regInfo.PackageType, string(*r.Params.ArtifactType))
+		if err != nil {
+			return listMetadataHistory400Error(err), nil
+		}
+	}
+
+	image := string(r.Artifact)
+	version := string(r.Version)
+	// only the ID of the artifact is needed, its metadata can be large.
+	art, err := c.getVersionArtifact(ctx, regInfo, image, version, artifactType, types.WithoutMetadata())
+	if err != nil {
+		if errors.Is(err, store2.ErrResourceNotFound) {
+			return api.ListArtifactVersionMetadataHistory404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse(
+					*GetErrorResponse(http.StatusNotFound, err.Error()),
+				),
+			}, nil
+		}
+		return listMetadataHistory500Error(err), nil
+	}
+
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+	changes, err := c.MetadataHistoryRepository.ListForArtifact(ctx, art.ID, limit, offset)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(listMetadataHistoryErrMsg, image, version, err)
+		return listMetadataHistory500Error(fmt.Errorf("failed to list metadata history: %w", err)), nil
+	}
+	count, err := c.MetadataHistoryRepository.CountForArtifact(ctx, art.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(listMetadataHistoryErrMsg, image, version, err)
+		return listMetadataHistory500Error(fmt.Errorf("failed to get metadata history count: %w", err)), nil
+	}
+
+	metadataChanges := make([]api.ArtifactMetadataChange, 0, len(changes))
+	for _, change := range changes {
+		metadataChange, err := mapToAPIArtifactMetadataChange(change)
+		if err != nil {
+			return listMetadataHistory500Error(err), nil
+		}
+		metadataChanges = append(metadataChanges, metadataChange)
+	}
+	pageCount := GetPageCount(count, limit)
+	currentPageSize := len(metadataChanges)
+	return api.ListArtifactVersionMetadataHistory200JSONResponse{
+		ListArtifactMetadataChangeResponseJSONResponse: api.ListArtifactMetadataChangeResponseJSONResponse{
+			Data: api.ListArtifactMetadataChange{
+				Changes:   metadataChanges,
+				ItemCount: &count,
+				PageCount: &pageCount,
+				PageIndex: &pageNumber,
+				PageSize:  &currentPageSize,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// getVersionArtifact finds the artifact of a version, versions of OCI registries are tags or manifest digests.
+func (c *APIController) getVersionArtifact(
+	ctx context.Context,
+	regInfo *types.RegistryRequestBaseInfo,
+	image string,
+	version string,
+	artifactType *api.ArtifactType,
+	opts ...types.QueryOption,
+) (*types.Artifact, error) {
+	if isOCIPackageType(regInfo.PackageType) {
+		return c.getOCIArtifact(ctx, regInfo, image, version, opts...)
+	}
+	if artifactType == nil {
+		return c.ArtifactStore.GetByRegistryImageAndVersion(ctx, regInfo.RegistryID, image, version, opts...)
+	}
+	return c.ArtifactStore.GetByRegistryImageVersionAndArtifactType(
+		ctx, regInfo.RegistryID, image, version, string(*artifactType), opts...,
+	)
+}
+
+func mapToAPIArtifactMetadataChange(change *types.ArtifactMetadataChange) (api.ArtifactMetadataChange, error) {
+	diff := map[string]api.MetadataFieldChange{}
+	if err := json.Unmarshal(change.Diff, &diff); err != nil {
+		return api.ArtifactMetadataChange{}, fmt.Errorf("failed to unmarshal metadata diff of revision %d: %w",
+			change.Revision, err)
+	}
+	return api.ArtifactMetadataChange{
+		Revision:  change.Revision,
+		ChangedBy: change.CreatedBy,
+		ChangedAt: GetTimeInMs(change.CreatedAt),
+		Diff:      diff,
+	}, nil
+}
+
+func listMetadataHistory400Error(err error) api.ListArtifactVersionMetadataHistoryResponseObject {
+	return api.ListArtifactVersionMetadataHistory400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func listMetadataHistory500Error(err error) api.ListArtifactVersionMetadataHistoryResponseObject {
+	return api.ListArtifactVersionMetadataHistory500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ListDeletionRequests lists the requests to delete artifacts of a registry, newest first.
+func (c *APIController) ListDeletionRequests(
+	ctx context.Context,
+	r api.ListDeletionRequestsRequestObject,
+) (api.ListDeletionRequestsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return listDeletionRequests400Error(err.Error()), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return listDeletionRequests400Error(err.Error()), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ListDeletionRequests401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ListDeletionRequests403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	var state *types.DeletionRequestState
+	if r.Params.State != nil {
+		s := types.DeletionRequestState(*r.Params.State)
+		switch s {
+		case types.DeletionRequestStatePending, types.DeletionRequestStateApproved,
+			types.DeletionRequestStateRejected, types.DeletionRequestStateExpired:
+		default:
+			return listDeletionRequests400Error(fmt.Sprintf("invalid state: %s", s)), nil
+		}
+		state = &s
+	}
+
+	deletionRequests, err := c.DeletionApprovalService.List(ctx, regInfo.RegistryID, state)
+	if err != nil {
+		return api.ListDeletionRequests500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError,
+					fmt.Sprintf("failed to list deletion requests: %v", err)),
+			),
+		}, nil
+	}
+
+	requests := make([]api.DeletionRequest, len(deletionRequests))
+	for i, deletionRequest := range deletionRequests {
+		requests[i] = mapToAPIDeletionRequest(deletionRequest)
+	}
+	return api.ListDeletionRequests200JSONResponse{
+		ListDeletionRequestsResponseJSONResponse: api.ListDeletionRequestsResponseJSONResponse{
+			Data:   api.ListDeletionRequests{Requests: requests},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func listDeletionRequests400Error(message string) api.ListDeletionRequestsResponseObject {
+	return api.ListDeletionRequests400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, message),
+		),
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const listFailedUploadsErrMsg = "failed to list failed uploads for registry: %s with error: %v"
+
+func (c *APIController) ListFailedUploads(
+	ctx context.Context,
+	r api.ListFailedUploadsRequestObject,
+) (api.ListFailedUploadsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return api.ListFailedUploads400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return api.ListFailedUploads400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ListFailedUploads401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ListFailedUploads403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+	uploads, err := c.FailedUploadStore.ListForRegistry(ctx, regInfo.RegistryID, limit, offset)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(listFailedUploadsErrMsg, regInfo.RegistryRef, err)
+		return listFailedUploadsInternalErrorResponse(fmt.Errorf("failed to list failed uploads: %w", err))
+	}
+	count, err := c.FailedUploadStore.CountForRegistry(ctx, regInfo.RegistryID)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(listFailedUploadsErrMsg, regInfo.RegistryRef, err)
+		return listFailedUploadsInternalErrorResponse(fmt.Errorf("failed to get failed uploads count: %w", err))
+	}
+
+	failedUploads := make([]api.FailedUpload, 0, len(uploads))
+	for _, upload := range uploads {
+		failedUploads = append(failedUploads, mapToAPIFailedUpload(upload))
+	}
+	pageCount := GetPageCount(count, limit)
+	currentPageSize := len(failedUploads)
+	return api.ListFailedUploads200JSONResponse{
+		ListFailedUploadResponseJSONResponse: api.ListFailedUploadResponseJSONResponse{
+			Data: api.ListFailedUpload{
+				Uploads:   failedUploads,
+				ItemCount: &count,
+				PageCount: &pageCount,
+				PageIndex: &pageNumber,
+				PageSize:  &currentPageSize,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func listFailedUploadsInternalErrorResponse(err error) (api.ListFailedUploadsResponseObject, error) {
+	return api.ListFailedUploads500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}, nil
+}
+
+func mapToAPIFailedUpload(upload *types.FailedUpload) api.FailedUpload {
+	failedUpload := api.FailedUpload{
+		Uuid:        upload.UUID,
+		PackageType: upload.PackageType,
+		Size:        upload.Size,
+		Sha256:      upload.Sha256,
+		Error:       upload.Error,
+		CreatedAt:   GetTimeInMs(upload.CreatedAt),
+		ExpiresAt:   GetTimeInMs(upload.ExpiresAt),
+	}
+	if upload.Filename != "" {
+		failedUpload.Filename = &upload.Filename
+	}
+	return failedUpload
+}
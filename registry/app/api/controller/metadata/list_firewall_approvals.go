@@ -0,0 +1,96 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ListFirewallApprovals lists the versions let through the firewall delay of the upstream proxy, newest first.
+func (c *APIController) ListFirewallApprovals(
+	ctx context.Context,
+	r api.ListFirewallApprovalsRequestObject,
+) (api.ListFirewallApprovalsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return listFirewallApprovals400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return listFirewallApprovals400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ListFirewallApprovals401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ListFirewallApprovals403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	approvals, err := c.FirewallDelayService.List(ctx, regInfo.RegistryID)
+	if err != nil {
+		return api.ListFirewallApprovals500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	dtos := make([]api.FirewallApproval, 0, len(approvals))
+	for _, approval := range approvals {
+		dtos = append(dtos, mapToAPIFirewallApproval(approval))
+	}
+
+	return api.ListFirewallApprovals200JSONResponse{
+		ListFirewallApprovalsResponseJSONResponse: api.ListFirewallApprovalsResponseJSONResponse{
+			Data: api.ListFirewallApprovals{
+				Approvals: dtos,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func listFirewallApprovals400Error(err error) api.ListFirewallApprovalsResponseObject {
+	return api.ListFirewallApprovals400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ListPackageDenylistEntries lists the entries of the package denylist of the space, without the entries of the
+// spaces above it.
+func (c *APIController) ListPackageDenylistEntries(
+	ctx context.Context,
+	r api.ListPackageDenylistEntriesRequestObject,
+) (api.ListPackageDenylistEntriesResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return listPackageDenylistEntries400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return listPackageDenylistEntries400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryView,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ListPackageDenylistEntries401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ListPackageDenylistEntries403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+	searchTerm := ""
+	if r.Params.SearchTerm != nil {
+		searchTerm = string(*r.Params.SearchTerm)
+	}
+
+	entries, count, err := c.PackageDenylistService.List(ctx, space.ID, searchTerm, limit, offset)
+	if err != nil {
+		return api.ListPackageDenylistEntries500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	dtos := make([]api.PackageDenylistEntry, 0, len(entries))
+	for _, entry := range entries {
+		dtos = append(dtos, mapToAPIPackageDenylistEntry(entry))
+	}
+	pageCount := GetPageCount(count, limit)
+
+	return api.ListPackageDenylistEntries200JSONResponse{
+		ListPackageDenylistEntriesResponseJSONResponse: api.ListPackageDenylistEntriesResponseJSONResponse{
+			Data: api.ListPackageDenylistEntries{
+				PageIndex: &pageNumber,
+				PageCount: &pageCount,
+				PageSize:  &limit,
+				ItemCount: &count,
+				Entries:   dtos,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func listPackageDenylistEntries400Error(err error) api.ListPackageDenylistEntriesResponseObject {
+	return api.ListPackageDenylistEntries400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ListPackageDenylistOverrides lists the entries of the package denylists which the registry is exempted from.
+func (c *APIController) ListPackageDenylistOverrides(
+	ctx context.Context,
+	r api.ListPackageDenylistOverridesRequestObject,
+) (api.ListPackageDenylistOverridesResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return listPackageDenylistOverrides400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return listPackageDenylistOverrides400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ListPackageDenylistOverrides401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ListPackageDenylistOverrides403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	overrides, entries, err := c.PackageDenylistService.ListOverrides(ctx, regInfo.RegistryID)
+	if err != nil {
+		return api.ListPackageDenylistOverrides500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	dtos := make([]api.PackageDenylistOverride, 0, len(overrides))
+	for _, override := range overrides {
+		dtos = append(dtos, mapToAPIPackageDenylistOverride(override, entries[override.EntryID]))
+	}
+
+	return api.ListPackageDenylistOverrides200JSONResponse{
+		ListPackageDenylistOverridesResponseJSONResponse: api.ListPackageDenylistOverridesResponseJSONResponse{
+			Data: api.ListPackageDenylistOverrides{
+				Overrides: dtos,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func listPackageDenylistOverrides400Error(err error) api.ListPackageDenylistOverridesResponseObject {
+	return api.ListPackageDenylistOverrides400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
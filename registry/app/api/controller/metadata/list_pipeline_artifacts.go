@@ -0,0 +1,138 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ListPipelineArtifacts lists the versions stored in the registries of the space which were published by the
+// pipeline executions matching the query.
+func (c *APIController) ListPipelineArtifacts(
+	ctx context.Context,
+	r api.ListPipelineArtifactsRequestObject,
+) (api.ListPipelineArtifactsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return listPipelineArtifacts400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return listPipelineArtifacts400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryView,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ListPipelineArtifacts401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ListPipelineArtifacts403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	pipeline := mapFromAPIPipelineExecution(api.PipelineExecution{
+		System:     (*string)(r.Params.PipelineSystem),
+		PipelineId: (*string)(r.Params.PipelineId),
+		RunUrl:     (*string)(r.Params.RunUrl),
+		CommitSha:  (*string)(r.Params.CommitSha),
+	})
+	if !pipeline.HasReference() {
+		return listPipelineArtifacts400Error(
+			fmt.Errorf("one of pipeline_id, run_url and commit_sha is required"),
+		), nil
+	}
+
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+
+	artifacts, err := c.ProvenanceRepository.ListArtifacts(ctx, space.ID, pipeline, limit, offset)
+	if err != nil {
+		return listPipelineArtifacts500Error(fmt.Errorf("failed to list artifacts of pipeline: %w", err)), nil
+	}
+	count, err := c.ProvenanceRepository.CountArtifacts(ctx, space.ID, pipeline)
+	if err != nil {
+		return listPipelineArtifacts500Error(fmt.Errorf("failed to count artifacts of pipeline: %w", err)), nil
+	}
+
+	dtos := make([]api.PipelineArtifact, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		dtos = append(dtos, mapToAPIPipelineArtifact(artifact))
+	}
+	pageCount := GetPageCount(count, limit)
+
+	return api.ListPipelineArtifacts200JSONResponse{
+		ListPipelineArtifactsResponseJSONResponse: api.ListPipelineArtifactsResponseJSONResponse{
+			Data: api.ListPipelineArtifacts{
+				PageIndex: &pageNumber,
+				PageCount: &pageCount,
+				PageSize:  &limit,
+				ItemCount: &count,
+				Artifacts: dtos,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func mapToAPIPipelineArtifact(artifact *types.ProvenanceArtifact) api.PipelineArtifact {
+	return api.PipelineArtifact{
+		RegistryIdentifier: artifact.RegistryName,
+		PackageType:        api.PackageType(artifact.PackageType),
+		Package:            artifact.Name,
+		Version:            artifact.Version,
+		Provenance:         mapToAPIArtifactProvenance(&artifact.Provenance),
+	}
+}
+
+func listPipelineArtifacts400Error(err error) api.ListPipelineArtifactsResponseObject {
+	return api.ListPipelineArtifacts400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func listPipelineArtifacts500Error(err error) api.ListPipelineArtifactsResponseObject {
+	return api.ListPipelineArtifacts500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
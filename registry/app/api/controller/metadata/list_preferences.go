@@ -0,0 +1,94 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// applyVersionListPreferences fills the sort and page size a version list request doesn't set from the list
+// preferences of the registry. It returns true when the versions have to be ordered by semantic version,
+// which the database can't do, so the caller has to fetch all versions and page them with pageBySemver.
+func applyVersionListPreferences(
+	regInfo *RegistryRequestInfo,
+	registry *types.Registry,
+	params artifact.GetAllArtifactVersionsParams,
+) bool {
+	if registry.Config == nil || registry.Config.ListPreferences == nil {
+		return false
+	}
+	preferences := registry.Config.ListPreferences
+
+	if params.Size == nil && preferences.PageSize > 0 {
+		regInfo.limit = preferences.PageSize
+		regInfo.offset = 0
+		if params.Page != nil {
+			regInfo.offset = preferences.PageSize * int(*params.Page)
+		}
+	}
+
+	if params.SortField != nil || preferences.DefaultSort == "" {
+		return false
+	}
+	if params.SortOrder == nil {
+		regInfo.sortByOrder = GetSortByOrder("DESC")
+	}
+	switch preferences.DefaultSort {
+	case types.ListSortRecency:
+		regInfo.sortByField = GetSortByField("lastModified", ArtifactVersionResource)
+	case types.ListSortSemver:
+		regInfo.sortByField = GetSortByField("name", ArtifactVersionResource)
+		return true
+	}
+	return false
+}
+
+// pageBySemver orders the versions by semantic version and returns the requested page of them.
+// Versions which aren't semantic versions rank below all the semantic ones and are ordered by name.
+func pageBySemver[T any](versions []T, name func(T) string, sortByOrder string, offset int, limit int) []T {
+	descending := sortByOrder == "DESC"
+	slices.SortStableFunc(versions, func(a, b T) int {
+		cmp := compareVersions(name(a), name(b))
+		if descending {
+			return -cmp
+		}
+		return cmp
+	})
+	if offset >= len(versions) {
+		return versions[:0]
+	}
+	return versions[offset:min(offset+limit, len(versions))]
+}
+
+func compareVersions(a, b string) int {
+	va, errA := semver.NewVersion(a)
+	vb, errB := semver.NewVersion(b)
+	switch {
+	case errA == nil && errB == nil:
+		return va.Compare(vb)
+	case errA == nil:
+		return 1
+	case errB == nil:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageBySemver(t *testing.T) {
+	versions := []string{"1.10.0", "latest", "1.2.0", "2.0.0-rc.1", "2.0.0", "1.9.3"}
+	name := func(v string) string { return v }
+
+	page := pageBySemver(versions, name, "DESC", 0, 3)
+	assert.Equal(t, []string{"2.0.0", "2.0.0-rc.1", "1.10.0"}, page)
+
+	page = pageBySemver(versions, name, "DESC", 3, 3)
+	assert.Equal(t, []string{"1.9.3", "1.2.0", "latest"}, page)
+
+	page = pageBySemver(versions, name, "ASC", 0, 2)
+	assert.Equal(t, []string{"latest", "1.2.0"}, page)
+
+	assert.Empty(t, pageBySemver(versions, name, "DESC", 6, 3))
+}
+
+func TestApplyVersionListPreferences(t *testing.T) {
+	registry := &types.Registry{Config: &types.RegistryConfig{
+		ListPreferences: &types.ListPreferencesConfig{DefaultSort: types.ListSortSemver, PageSize: 25},
+	}}
+
+	regInfo := &RegistryRequestInfo{limit: 10, sortByField: "created_at", sortByOrder: "ASC"}
+	page := artifact.PageNumber(2)
+	semverSort := applyVersionListPreferences(regInfo, registry, artifact.GetAllArtifactVersionsParams{Page: &page})
+	assert.True(t, semverSort)
+	assert.Equal(t, 25, regInfo.limit)
+	assert.Equal(t, 50, regInfo.offset)
+	assert.Equal(t, "DESC", regInfo.sortByOrder)
+
+	regInfo = &RegistryRequestInfo{limit: 10, sortByField: "name", sortByOrder: "ASC"}
+	size := artifact.PageSize(5)
+	sortField := artifact.SortField("name")
+	semverSort = applyVersionListPreferences(regInfo, registry, artifact.GetAllArtifactVersionsParams{
+		Size:      &size,
+		SortField: &sortField,
+	})
+	assert.False(t, semverSort)
+	assert.Equal(t, 10, regInfo.limit)
+	assert.Equal(t, "ASC", regInfo.sortByOrder)
+
+	registry.Config.ListPreferences.DefaultSort = types.ListSortRecency
+	regInfo = &RegistryRequestInfo{limit: 10, sortByField: "created_at", sortByOrder: "ASC"}
+	semverSort = applyVersionListPreferences(regInfo, registry, artifact.GetAllArtifactVersionsParams{})
+	assert.False(t, semverSort)
+	assert.Equal(t, "updated_at", regInfo.sortByField)
+	assert.Equal(t, "DESC", regInfo.sortByOrder)
+}
@@ -0,0 +1,105 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+)
+
+// ListRecentArtifacts lists the artifacts the current user recently viewed or pulled. Artifacts of registries
+// the user can't view anymore are left out.
+func (c *APIController) ListRecentArtifacts(
+	ctx context.Context,
+	r api.ListRecentArtifactsRequestObject,
+) (api.ListRecentArtifactsResponseObject, error) {
+	session, ok := request.AuthSessionFrom(ctx)
+	if !ok || auth.IsAnonymousSession(session) {
+		return api.ListRecentArtifacts401JSONResponse{
+			UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+				*GetErrorResponse(http.StatusUnauthorized, "recent artifacts require an authenticated user"),
+			),
+		}, nil
+	}
+
+	var kind *types.RecentActivityKind
+	if r.Params.Activity != nil {
+		k := types.RecentActivityKind(*r.Params.Activity)
+		if k != types.RecentActivityViewed && k != types.RecentActivityPulled {
+			return api.ListRecentArtifacts400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse(
+					*GetErrorResponse(http.StatusBadRequest, fmt.Sprintf("invalid activity: %s", k)),
+				),
+			}, nil
+		}
+		kind = &k
+	}
+
+	activities, err := c.RecentActivityService.List(ctx, session.Principal.ID, kind)
+	if err != nil {
+		return api.ListRecentArtifacts500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError,
+					fmt.Sprintf("failed to list recent artifacts: %v", err)),
+			),
+		}, nil
+	}
+
+	// the access to a registry is checked once for all of its recent artifacts.
+	registryPaths := make(map[int64]string)
+	artifacts := make([]api.RecentArtifact, 0, len(activities))
+	for _, activity := range activities {
+		registryPath, checked := registryPaths[activity.RegistryID]
+		if !checked {
+			registryPath = c.viewableRegistryPath(ctx, session, activity.RegistryParentID, activity.RegistryID,
+				activity.RegistryName)
+			registryPaths[activity.RegistryID] = registryPath
+		}
+		if registryPath == "" {
+			continue
+		}
+		artifacts = append(artifacts, mapToAPIRecentArtifact(activity, registryPath))
+	}
+
+	return api.ListRecentArtifacts200JSONResponse{
+		ListRecentArtifactResponseJSONResponse: api.ListRecentArtifactResponseJSONResponse{
+			Data:   api.ListRecentArtifact{Artifacts: artifacts},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func mapToAPIRecentArtifact(activity *types.RecentActivity, registryPath string) api.RecentArtifact {
+	var version *string
+	if activity.Version != "" {
+		version = &activity.Version
+	}
+	return api.RecentArtifact{
+		Name:               activity.ImageName,
+		RegistryIdentifier: activity.RegistryName,
+		RegistryPath:       registryPath,
+		PackageType:        activity.PackageType,
+		ArtifactType:       activity.ArtifactType,
+		Activity:           api.ArtifactActivity(activity.Kind),
+		Version:            version,
+		At:                 GetTimeInMs(activity.At),
+	}
+}
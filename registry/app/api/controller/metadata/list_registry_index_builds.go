@@ -0,0 +1,125 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const listIndexBuildsErrMsg = "failed to list index builds for registry: %s with error: %v"
+
+func (c *APIController) ListRegistryIndexBuilds(
+	ctx context.Context,
+	r api.ListRegistryIndexBuildsRequestObject,
+) (api.ListRegistryIndexBuildsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return listIndexBuildsInternalErrorResponse(err)
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return listIndexBuildsInternalErrorResponse(err)
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		log.Ctx(ctx).Error().Msgf("permission check failed while listing index builds for registry: %s, error: %v",
+			regInfo.RegistryIdentifier, err)
+		return api.ListRegistryIndexBuilds403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	size := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+	builds, err := c.IndexBuildRepository.ListForRegistry(ctx, regInfo.RegistryID, limit, int(pageNumber), size)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(listIndexBuildsErrMsg, regInfo.RegistryRef, err)
+		return listIndexBuildsInternalErrorResponse(fmt.Errorf("failed to list index builds: %w", err))
+	}
+	count, err := c.IndexBuildRepository.CountForRegistry(ctx, regInfo.RegistryID)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(listIndexBuildsErrMsg, regInfo.RegistryRef, err)
+		return listIndexBuildsInternalErrorResponse(fmt.Errorf("failed to get index builds count: %w", err))
+	}
+	indexBuilds := make([]api.RegistryIndexBuild, 0, len(builds))
+	for _, b := range builds {
+		indexBuilds = append(indexBuilds, mapToAPIIndexBuild(b))
+	}
+	pageCount := GetPageCount(count, limit)
+	currentPageSize := len(indexBuilds)
+	return api.ListRegistryIndexBuilds200JSONResponse{
+		ListRegistryIndexBuildResponseJSONResponse: api.ListRegistryIndexBuildResponseJSONResponse{
+			Data: api.ListRegistryIndexBuild{
+				Builds:    indexBuilds,
+				ItemCount: &count,
+				PageCount: &pageCount,
+				PageIndex: &pageNumber,
+				PageSize:  &currentPageSize,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func listIndexBuildsInternalErrorResponse(err error) (api.ListRegistryIndexBuildsResponseObject, error) {
+	return api.ListRegistryIndexBuilds500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}, nil
+}
+
+func mapToAPIIndexBuild(build *types.IndexBuild) api.RegistryIndexBuild {
+	indexBuild := api.RegistryIndexBuild{
+		Id:        build.ID,
+		IndexType: api.RegistryIndexBuildIndexTypeREGISTRY,
+		StartedAt: GetTimeInMs(build.StartedAt),
+		Status:    api.RegistryIndexBuildStatus(strings.ToUpper(string(build.Status))),
+	}
+	if build.Kind == types.TaskKindBuildPackageIndex {
+		indexBuild.IndexType = api.RegistryIndexBuildIndexTypePACKAGE
+		indexBuild.Package = &build.Image
+	}
+	if build.Error != "" {
+		indexBuild.Error = &build.Error
+	}
+	if build.Status != types.TaskStatusProcessing {
+		duration := build.Duration.Milliseconds()
+		indexBuild.Duration = &duration
+	}
+	return indexBuild
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const listRegistryJobsErrMsg = "failed to list jobs for registry: %s with error: %v"
+
+func (c *APIController) ListRegistryJobs(
+	ctx context.Context,
+	r api.ListRegistryJobsRequestObject,
+) (api.ListRegistryJobsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return api.ListRegistryJobs400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return api.ListRegistryJobs400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ListRegistryJobs401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ListRegistryJobs403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+	jobs, err := c.RegistryJobStore.ListForRegistry(ctx, regInfo.RegistryID, limit, offset)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(listRegistryJobsErrMsg, regInfo.RegistryRef, err)
+		return listRegistryJobsInternalErrorResponse(fmt.Errorf("failed to list registry jobs: %w", err))
+	}
+	count, err := c.RegistryJobStore.CountForRegistry(ctx, regInfo.RegistryID)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(listRegistryJobsErrMsg, regInfo.RegistryRef, err)
+		return listRegistryJobsInternalErrorResponse(fmt.Errorf("failed to get registry jobs count: %w", err))
+	}
+
+	registryJobs := make([]api.RegistryJob, 0, len(jobs))
+	for _, job := range jobs {
+		registryJobs = append(registryJobs, mapToAPIRegistryJob(job))
+	}
+	pageCount := GetPageCount(count, limit)
+	currentPageSize := len(registryJobs)
+	return api.ListRegistryJobs200JSONResponse{
+		ListRegistryJobResponseJSONResponse: api.ListRegistryJobResponseJSONResponse{
+			Data: api.ListRegistryJob{
+				Jobs:      registryJobs,
+				ItemCount: &count,
+				PageCount: &pageCount,
+				PageIndex: &pageNumber,
+				PageSize:  &currentPageSize,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func listRegistryJobsInternalErrorResponse(err error) (api.ListRegistryJobsResponseObject, error) {
+	return api.ListRegistryJobs500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}, nil
+}
+
+var registryJobStates = map[types.RegistryJobState]api.RegistryJobState{
+	types.RegistryJobStateQueued:    api.RegistryJobStateQUEUED,
+	types.RegistryJobStateRunning:   api.RegistryJobStateRUNNING,
+	types.RegistryJobStateSucceeded: api.RegistryJobStateSUCCEEDED,
+	types.RegistryJobStateFailed:    api.RegistryJobStateFAILED,
+	types.RegistryJobStateCanceled:  api.RegistryJobStateCANCELED,
+}
+
+func mapToAPIRegistryJob(job *types.RegistryJob) api.RegistryJob {
+	registryJob := api.RegistryJob{
+		Uuid:      job.UUID,
+		Kind:      string(job.Kind),
+		State:     registryJobStates[job.State],
+		Progress:  job.Progress,
+		CreatedBy: job.CreatedBy,
+		CreatedAt: GetTimeInMs(job.CreatedAt),
+	}
+	if job.Log != "" {
+		registryJob.Log = &job.Log
+	}
+	if job.Error != "" {
+		registryJob.Error = &job.Error
+	}
+	if !job.StartedAt.IsZero() {
+		startedAt := GetTimeInMs(job.StartedAt)
+		registryJob.StartedAt = &startedAt
+	}
+	if !job.FinishedAt.IsZero() {
+		finishedAt := GetTimeInMs(job.FinishedAt)
+		registryJob.FinishedAt = &finishedAt
+	}
+	return registryJob
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/capability"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func (c *APIController) ListRegistryTrash(
+	ctx context.Context,
+	r artifact.ListRegistryTrashRequestObject,
+) (artifact.ListRegistryTrashResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return listRegistryTrash400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return listRegistryTrash400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.ListRegistryTrash403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	if err = capability.Check(regInfo.PackageType, capability.SoftDelete); err != nil {
+		return listRegistryTrash501Error(err), nil
+	}
+
+	// a deleted tag is a trashed version when versions are tags, a deleted manifest otherwise
+	var trashed *[]registryTypes.TrashedOCIVersion
+	if c.UntaggedImagesEnabled(ctx) {
+		trashed, err = c.ManifestStore.ListDeletedManifests(ctx, regInfo.RegistryID)
+	} else {
+		trashed, err = c.TagStore.ListDeletedTags(ctx, regInfo.RegistryID)
+	}
+	if err != nil {
+		return listRegistryTrash500Error(err), nil
+	}
+
+	return artifact.ListRegistryTrash200JSONResponse{
+		RegistryTrashResponseJSONResponse: artifact.RegistryTrashResponseJSONResponse{
+			Data:   GetTrashedArtifactVersions(*trashed),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// GetTrashedArtifactVersions maps the soft-deleted versions of a registry to the API model, most recently
+// deleted first.
+func GetTrashedArtifactVersions(trashed []registryTypes.TrashedOCIVersion) []artifact.TrashedArtifactVersion {
+	sort.SliceStable(trashed, func(i, j int) bool {
+		return trashed[i].DeletedAt.After(trashed[j].DeletedAt)
+	})
+	versions := make([]artifact.TrashedArtifactVersion, 0, len(trashed))
+	for _, t := range trashed {
+		version := t.Tag
+		if version == "" {
+			version = t.Digest
+		}
+		versions = append(versions, artifact.TrashedArtifactVersion{
+			Package:   t.ImageName,
+			Version:   version,
+			Digest:    t.Digest,
+			DeletedAt: GetTimeInMs(t.DeletedAt),
+		})
+	}
+	return versions
+}
+
+func listRegistryTrash400Error(err error) artifact.ListRegistryTrashResponseObject {
+	return artifact.ListRegistryTrash400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func listRegistryTrash501Error(err error) artifact.ListRegistryTrashResponseObject {
+	return artifact.ListRegistryTrash501JSONResponse{
+		NotImplementedJSONResponse: artifact.NotImplementedJSONResponse(*GetNotImplementedResponse(err)),
+	}
+}
+
+func listRegistryTrash500Error(err error) artifact.ListRegistryTrashResponseObject {
+	return artifact.ListRegistryTrash500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ListScheduledDeletions lists the scheduled deletions of artifacts of a registry, newest first.
+func (c *APIController) ListScheduledDeletions(
+	ctx context.Context,
+	r api.ListScheduledDeletionsRequestObject,
+) (api.ListScheduledDeletionsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return listScheduledDeletions400Error(err.Error()), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return listScheduledDeletions400Error(err.Error()), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ListScheduledDeletions401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ListScheduledDeletions403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	var state *types.ScheduledDeletionState
+	if r.Params.State != nil {
+		s := types.ScheduledDeletionState(*r.Params.State)
+		switch s {
+		case types.ScheduledDeletionStateScheduled, types.ScheduledDeletionStateRunning,
+			types.ScheduledDeletionStateCanceled, types.ScheduledDeletionStateExecuted,
+			types.ScheduledDeletionStateFailed:
+		default:
+			return listScheduledDeletions400Error(fmt.Sprintf("invalid state: %s", s)), nil
+		}
+		state = &s
+	}
+
+	scheduled, err := c.DeletionService.List(ctx, regInfo.RegistryID, state)
+	if err != nil {
+		return api.ListScheduledDeletions500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError,
+					fmt.Sprintf("failed to list scheduled deletions: %v", err)),
+			),
+		}, nil
+	}
+
+	deletions := make([]api.ScheduledDeletion, len(scheduled))
+	for i, deletion := range scheduled {
+		deletions[i] = mapToAPIScheduledDeletion(deletion)
+	}
+	return api.ListScheduledDeletions200JSONResponse{
+		ListScheduledDeletionsResponseJSONResponse: api.ListScheduledDeletionsResponseJSONResponse{
+			Data:   api.ListScheduledDeletions{Deletions: deletions},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func listScheduledDeletions400Error(message string) api.ListScheduledDeletionsResponseObject {
+	return api.ListScheduledDeletions400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, message),
+		),
+	}
+}
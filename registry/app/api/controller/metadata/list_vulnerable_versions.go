@@ -0,0 +1,147 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ListVulnerableVersions lists the versions stored in the registries of the space which are affected by
+// vulnerabilities, along with their vulnerabilities.
+func (c *APIController) ListVulnerableVersions(
+	ctx context.Context,
+	r api.ListVulnerableVersionsRequestObject,
+) (api.ListVulnerableVersionsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return listVulnerableVersions400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return listVulnerableVersions400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryView,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ListVulnerableVersions401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ListVulnerableVersions403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	minSeverity := types.VulnerabilitySeverityUnknown
+	if r.Params.MinSeverity != nil {
+		minSeverity = types.VulnerabilitySeverity(*r.Params.MinSeverity)
+		if minSeverity == types.VulnerabilitySeverityNone || !minSeverity.IsValid() {
+			return listVulnerableVersions400Error(fmt.Errorf("invalid severity %q", minSeverity)), nil
+		}
+	}
+
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+	searchTerm := ""
+	if r.Params.SearchTerm != nil {
+		searchTerm = string(*r.Params.SearchTerm)
+	}
+
+	versions, count, err := c.VulnerabilityService.ListVulnerableVersions(
+		ctx, space.ID, minSeverity, searchTerm, limit, offset,
+	)
+	if err != nil {
+		return api.ListVulnerableVersions500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	dtos := make([]api.VulnerableVersion, 0, len(versions))
+	for _, version := range versions {
+		dtos = append(dtos, mapToAPIVulnerableVersion(version))
+	}
+	pageCount := GetPageCount(count, limit)
+
+	return api.ListVulnerableVersions200JSONResponse{
+		ListVulnerableVersionsResponseJSONResponse: api.ListVulnerableVersionsResponseJSONResponse{
+			Data: api.ListVulnerableVersions{
+				PageIndex: &pageNumber,
+				PageCount: &pageCount,
+				PageSize:  &limit,
+				ItemCount: &count,
+				Versions:  dtos,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func mapToAPIVulnerableVersion(version *types.VulnerableVersion) api.VulnerableVersion {
+	vulnerabilities := make([]api.Vulnerability, 0, len(version.Vulnerabilities))
+	for _, v := range version.Vulnerabilities {
+		aliases := v.Aliases
+		if aliases == nil {
+			aliases = []string{}
+		}
+		vulnerabilities = append(vulnerabilities, api.Vulnerability{
+			Id:         v.ID,
+			Summary:    v.Summary,
+			Severity:   api.VulnerabilitySeverity(v.Severity),
+			Aliases:    aliases,
+			ModifiedAt: GetTimeInMs(v.ModifiedAt),
+		})
+	}
+	return api.VulnerableVersion{
+		RegistryIdentifier: version.RegistryName,
+		PackageType:        api.PackageType(version.PackageType),
+		Package:            version.Name,
+		Version:            version.Version,
+		Severity:           api.VulnerabilitySeverity(version.Severity),
+		Vulnerabilities:    vulnerabilities,
+	}
+}
+
+func listVulnerableVersions400Error(err error) api.ListVulnerableVersionsResponseObject {
+	return api.ListVulnerableVersions400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
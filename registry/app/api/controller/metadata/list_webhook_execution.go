@@ -90,6 +90,10 @@ func (c *APIController) ListWebhookExecutions(
 		log.Ctx(ctx).Error().Msgf(listWebhooksErrMsg, regInfo.RegistryRef, r.WebhookIdentifier, err)
 		return listWebhooksExecutionsInternalErrorResponse(fmt.Errorf("failed to list webhook executions: %w", err))
 	}
+	if err = c.WebhookService.OpenPayloads(we); err != nil {
+		log.Ctx(ctx).Error().Msgf(listWebhooksErrMsg, regInfo.RegistryRef, r.WebhookIdentifier, err)
+		return listWebhooksExecutionsInternalErrorResponse(err)
+	}
 	webhookExecutions, err := mapToAPIListWebhooksExecutions(we)
 	if err != nil {
 		log.Ctx(ctx).Error().Msgf(listWebhooksErrMsg, regInfo.RegistryRef, r.WebhookIdentifier, err)
@@ -190,6 +194,8 @@ func mapTpAPITriggerType(trigger enum.WebhookTrigger) api.Trigger {
 		return api.TriggerARTIFACTCREATION
 	case enum.WebhookTriggerArtifactDeleted:
 		return api.TriggerARTIFACTDELETION
+	case enum.WebhookTriggerRegistryQuotaThreshold:
+		return api.TriggerREGISTRYQUOTATHRESHOLD
 	}
 	return ""
 }
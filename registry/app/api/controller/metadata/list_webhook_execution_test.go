@@ -619,9 +619,12 @@ func TestListWebhookExecutions(t *testing.T) {
 			mockWebhooksExecRepo := new(mocks.WebhooksExecutionRepository)
 			mockAuthorizer := new(mocks.Authorizer)
 			mockMetadataHelper := new(mocks.RegistryMetadataHelper)
+			mockWebhookService := new(mocks.WebhookService)
+			mockWebhookService.On("OpenPayloads", mock.Anything).Return(nil).Maybe()
 
 			// Create controller
 			controller := &APIController{
+				WebhookService:              mockWebhookService,
 				SpaceFinder:                 mockSpaceFinder,
 				RegistryRepository:          mockRegistryRepo,
 				WebhooksRepository:          mockWebhooksRepo,
@@ -0,0 +1,151 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/notification"
+	"github.com/harness/gitness/registry/types"
+)
+
+const (
+	defaultNotificationRateLimit = 10
+	maxNotificationRateLimit     = 3600
+)
+
+var notificationEvents = []api.NotificationEvent{
+	api.NotificationEventQUARANTINE,
+	api.NotificationEventPROTECTEDDELETION,
+	api.NotificationEventQUOTABREACH,
+}
+
+// applyNotificationChannelRequest validates the request and applies it to the channel. The URL of the channel
+// is kept when the request doesn't set one.
+func (c *APIController) applyNotificationChannelRequest(
+	channel *types.NotificationChannel,
+	req api.NotificationChannelRequest,
+) error {
+	if len(req.Events) == 0 {
+		return errors.New("at least one event is required")
+	}
+	events := make([]types.NotificationEventType, 0, len(req.Events))
+	for _, event := range req.Events {
+		if !slices.Contains(notificationEvents, event) {
+			return fmt.Errorf("invalid event: %s", event)
+		}
+		if !slices.Contains(events, types.NotificationEventType(event)) {
+			events = append(events, types.NotificationEventType(event))
+		}
+	}
+
+	rateLimit := defaultNotificationRateLimit
+	if req.RateLimit != nil {
+		rateLimit = *req.RateLimit
+	}
+	if rateLimit < 1 || rateLimit > maxNotificationRateLimit {
+		return fmt.Errorf("rate limit must be between 1 and %d notifications per hour", maxNotificationRateLimit)
+	}
+
+	template := ""
+	if req.Template != nil {
+		template = *req.Template
+	}
+	if template != "" {
+		if _, err := notification.ParseTemplate(template); err != nil {
+			return err
+		}
+	}
+
+	var recipients []string
+	switch req.Type {
+	case api.NotificationChannelTypeEMAIL:
+		if req.Recipients == nil || len(*req.Recipients) == 0 {
+			return errors.New("recipients are required for email channels")
+		}
+		for _, recipient := range *req.Recipients {
+			address, err := mail.ParseAddress(recipient)
+			if err != nil || strings.Contains(recipient, ",") {
+				return fmt.Errorf("invalid recipient: %s", recipient)
+			}
+			recipients = append(recipients, address.Address)
+		}
+		channel.URL = ""
+	case api.NotificationChannelTypeSLACK, api.NotificationChannelTypeTEAMS:
+		if req.Url != nil {
+			parsedURL, err := url.Parse(*req.Url)
+			if err != nil || parsedURL.Host == "" ||
+				(parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+				return errors.New("url must be a valid http or https URL")
+			}
+			if channel.URL, err = c.NotificationDispatcher.EncryptURL(*req.Url); err != nil {
+				return err
+			}
+		}
+		if channel.URL == "" {
+			return fmt.Errorf("url is required for %s channels", strings.ToLower(string(req.Type)))
+		}
+	default:
+		return fmt.Errorf("invalid channel type: %s", req.Type)
+	}
+
+	channel.Identifier = req.Identifier
+	channel.Type = types.NotificationChannelType(req.Type)
+	channel.Recipients = recipients
+	channel.Events = events
+	channel.Template = template
+	channel.RateLimit = rateLimit
+	channel.Enabled = req.Enabled
+	return nil
+}
+
+// notify delivers the event to the notification channels of the registry, if notifications are set up.
+func (c *APIController) notify(ctx context.Context, event *notification.Event) {
+	if c.NotificationDispatcher == nil {
+		return
+	}
+	c.NotificationDispatcher.Notify(ctx, event)
+}
+
+func toAPINotificationChannel(channel *types.NotificationChannel) api.NotificationChannel {
+	events := make([]api.NotificationEvent, len(channel.Events))
+	for i, event := range channel.Events {
+		events[i] = api.NotificationEvent(event)
+	}
+	apiChannel := api.NotificationChannel{
+		Identifier: channel.Identifier,
+		Type:       api.NotificationChannelType(channel.Type),
+		Events:     events,
+		RateLimit:  channel.RateLimit,
+		Enabled:    channel.Enabled,
+		CreatedAt:  GetTimeInMs(channel.CreatedAt),
+		ModifiedAt: GetTimeInMs(channel.UpdatedAt),
+	}
+	if channel.Type == types.NotificationChannelTypeEmail {
+		recipients := channel.Recipients
+		apiChannel.Recipients = &recipients
+	}
+	if channel.Template != "" {
+		apiChannel.Template = &channel.Template
+	}
+	return apiChannel
+}
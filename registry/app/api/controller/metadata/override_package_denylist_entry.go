@@ -0,0 +1,127 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/denylist"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// OverridePackageDenylistEntry exempts the registry from an entry of the package denylist of one of its spaces.
+func (c *APIController) OverridePackageDenylistEntry(
+	ctx context.Context,
+	r api.OverridePackageDenylistEntryRequestObject,
+) (api.OverridePackageDenylistEntryResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return overridePackageDenylistEntry400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return overridePackageDenylistEntry400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.OverridePackageDenylistEntry401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.OverridePackageDenylistEntry403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	if r.Body == nil {
+		return overridePackageDenylistEntry400Error(denylist.ErrOverrideReasonRequired), nil
+	}
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return overridePackageDenylistEntry500Error(err), nil
+	}
+
+	override, entry, err := c.PackageDenylistService.Override(ctx, registry, int64(r.DenylistEntryId),
+		session.Principal.ID, r.Body.Reason)
+	switch {
+	case errors.Is(err, denylist.ErrOverrideReasonRequired):
+		return overridePackageDenylistEntry400Error(err), nil
+	case errors.Is(err, store.ErrResourceNotFound):
+		return api.OverridePackageDenylistEntry404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound,
+					fmt.Sprintf("package denylist entry %d not found", r.DenylistEntryId)),
+			),
+		}, nil
+	case errors.Is(err, store.ErrDuplicate):
+		return api.OverridePackageDenylistEntry409JSONResponse{
+			ConflictJSONResponse: api.ConflictJSONResponse(
+				*GetErrorResponse(http.StatusConflict,
+					fmt.Sprintf("package denylist entry %d is already overridden", r.DenylistEntryId)),
+			),
+		}, nil
+	case err != nil:
+		return overridePackageDenylistEntry500Error(err), nil
+	}
+
+	c.auditPackageDenylistOverride(ctx, session.Principal, regInfo.ParentRef, regInfo.RegistryIdentifier, override,
+		entry, audit.ActionCreated)
+
+	return api.OverridePackageDenylistEntry200JSONResponse{
+		PackageDenylistOverrideResponseJSONResponse: api.PackageDenylistOverrideResponseJSONResponse{
+			Data:   mapToAPIPackageDenylistOverride(override, entry),
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func overridePackageDenylistEntry400Error(err error) api.OverridePackageDenylistEntryResponseObject {
+	return api.OverridePackageDenylistEntry400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func overridePackageDenylistEntry500Error(err error) api.OverridePackageDenylistEntryResponseObject {
+	return api.OverridePackageDenylistEntry500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/denylist"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+
+	"github.com/gotidy/ptr"
+	"github.com/rs/zerolog/log"
+)
+
+func (c *APIController) auditPackageDenylistEntry(
+	ctx context.Context,
+	principal types.Principal,
+	spacePath string,
+	entry *registryTypes.PackageDenylistEntry,
+	action audit.Action,
+) {
+	err := c.AuditService.Log(
+		ctx,
+		principal,
+		audit.NewResource(audit.ResourceTypeRegistryPackageDenylist, entry.Name),
+		action,
+		spacePath,
+		audit.WithActorChain(audit.ActorChain(ctx, principal)),
+		audit.WithData("entry id", strconv.FormatInt(entry.ID, 10)),
+		audit.WithData("package type", entry.PackageType),
+		audit.WithData("version range", entry.VersionRange),
+		audit.WithData("reason", entry.Reason),
+	)
+	if err != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for package denylist entry %d: %s", entry.ID, err)
+	}
+}
+
+// auditPackageDenylistOverride keeps the audit trail of the overrides, with the reason the registry is exempted
+// from the entry.
+func (c *APIController) auditPackageDenylistOverride(
+	ctx context.Context,
+	principal types.Principal,
+	parentRef string,
+	registryName string,
+	override *registryTypes.PackageDenylistOverride,
+	entry *registryTypes.PackageDenylistEntry,
+	action audit.Action,
+) {
+	err := c.AuditService.Log(
+		ctx,
+		principal,
+		audit.NewResource(audit.ResourceTypeRegistryDenylistOverride, entry.Name),
+		action,
+		parentRef,
+		audit.WithActorChain(audit.ActorChain(ctx, principal)),
+		audit.WithData("registry name", registryName),
+		audit.WithData("entry id", strconv.FormatInt(entry.ID, 10)),
+		audit.WithData("version range", entry.VersionRange),
+		audit.WithData("reason", override.Reason),
+	)
+	if err != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for override of package denylist entry %d: %s",
+			entry.ID, err)
+	}
+}
+
+func mapToAPIPackageDenylistEntry(entry *registryTypes.PackageDenylistEntry) artifact.PackageDenylistEntry {
+	dto := artifact.PackageDenylistEntry{
+		Id:        entry.ID,
+		Name:      entry.Name,
+		Reason:    entry.Reason,
+		Source:    artifact.PackageDenylistSource(entry.Source),
+		CreatedBy: entry.CreatedBy,
+		CreatedAt: GetTimeInMs(entry.CreatedAt),
+	}
+	if entry.PackageType != "" {
+		dto.PackageType = &entry.PackageType
+	}
+	if entry.VersionRange != "" {
+		dto.VersionRange = &entry.VersionRange
+	}
+	if entry.ExternalID != "" {
+		dto.ExternalId = &entry.ExternalID
+	}
+	return dto
+}
+
+func mapToAPIPackageDenylistOverride(
+	override *registryTypes.PackageDenylistOverride,
+	entry *registryTypes.PackageDenylistEntry,
+) artifact.PackageDenylistOverride {
+	return artifact.PackageDenylistOverride{
+		Entry:     mapToAPIPackageDenylistEntry(entry),
+		Reason:    override.Reason,
+		CreatedBy: override.CreatedBy,
+		CreatedAt: GetTimeInMs(override.CreatedAt),
+	}
+}
+
+func toAdvisories(advisories []artifact.OSVAdvisory) []denylist.Advisory {
+	result := make([]denylist.Advisory, 0, len(advisories))
+	for _, a := range advisories {
+		advisory := denylist.Advisory{
+			ID:      a.Id,
+			Summary: ptr.ToString(a.Summary),
+		}
+		if a.Affected != nil {
+			for _, affected := range *a.Affected {
+				advisory.Affected = append(advisory.Affected, toAffectedPackage(affected))
+			}
+		}
+		result = append(result, advisory)
+	}
+	return result
+}
+
+func toAffectedPackage(affected artifact.OSVAffected) denylist.AffectedPackage {
+	pkg := denylist.AffectedPackage{
+		Ecosystem: affected.Package.Ecosystem,
+		Name:      affected.Package.Name,
+	}
+	if affected.Versions != nil {
+		pkg.Versions = *affected.Versions
+	}
+	if affected.Ranges != nil {
+		for _, r := range *affected.Ranges {
+			affectedRange := denylist.AffectedRange{Type: r.Type}
+			if r.Events != nil {
+				for _, e := range *r.Events {
+					affectedRange.Events = append(affectedRange.Events, denylist.RangeEvent{
+						Introduced:   ptr.ToString(e.Introduced),
+						Fixed:        ptr.ToString(e.Fixed),
+						LastAffected: ptr.ToString(e.LastAffected),
+					})
+				}
+			}
+			pkg.Ranges = append(pkg.Ranges, affectedRange)
+		}
+	}
+	return pkg
+}
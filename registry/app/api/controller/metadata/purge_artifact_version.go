@@ -0,0 +1,194 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/capability"
+	"github.com/harness/gitness/registry/services/concurrency"
+	"github.com/harness/gitness/registry/services/notification"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/rs/zerolog/log"
+)
+
+func (c *APIController) PurgeArtifactVersion(
+	ctx context.Context,
+	r artifact.PurgeArtifactVersionRequestObject,
+) (artifact.PurgeArtifactVersionResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return purgeArtifactVersion400Error(err), nil
+	}
+
+	r.Artifact, r.Version = c.resolveArtifactRef(ctx, regInfo.RegistryID, r.Artifact, r.Version)
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return purgeArtifactVersion400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionArtifactsDelete)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.PurgeArtifactVersion403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	if err = capability.Check(regInfo.PackageType, capability.SoftDelete); err != nil {
+		return purgeArtifactVersion501Error(err), nil
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return purgeArtifactVersion500Error(err), nil
+	}
+	if registry.IsDeletionProtected() {
+		c.notify(ctx, &notification.Event{
+			Type:       registryTypes.NotificationEventProtectedDeletion,
+			RegistryID: registry.ID,
+			Registry:   registry.Name,
+			Artifact:   string(r.Artifact),
+			Version:    string(r.Version),
+			Principal:  session.Principal.DisplayName,
+		})
+		return artifact.PurgeArtifactVersion409JSONResponse{
+			ConflictJSONResponse: deletionProtectedError(registry.Name),
+		}, nil
+	}
+
+	release, err := c.ConcurrencyLimiter.Acquire(ctx, regInfo.RootIdentifierID, concurrency.OperationPurge)
+	var limitErr *concurrency.LimitError
+	if errors.As(err, &limitErr) {
+		return artifact.PurgeArtifactVersion429JSONResponse{
+			TooManyRequestsJSONResponse: artifact.TooManyRequestsJSONResponse(
+				*GetErrorResponse(http.StatusTooManyRequests, limitErr.Error()),
+			),
+		}, nil
+	}
+	if err != nil {
+		return purgeArtifactVersion500Error(err), nil
+	}
+	defer release()
+
+	artifactName := string(r.Artifact)
+	versionName := string(r.Version)
+	if err = c.purgeOciVersion(ctx, regInfo, artifactName, versionName); err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return artifact.PurgeArtifactVersion404JSONResponse{
+				NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+					*GetErrorResponse(
+						http.StatusNotFound,
+						fmt.Sprintf("artifact version '%s' not found in the trash of artifact '%s'",
+							versionName, artifactName),
+					),
+				),
+			}, nil
+		}
+		return purgeArtifactVersion500Error(err), nil
+	}
+
+	auditErr := c.AuditService.Log(
+		ctx,
+		session.Principal,
+		audit.NewResource(audit.ResourceTypeRegistry, artifactName),
+		audit.ActionDeleted,
+		regInfo.ParentRef,
+		audit.WithActorChain(audit.ActorChain(ctx, session.Principal)),
+		audit.WithData("registry name", regInfo.RegistryIdentifier),
+		audit.WithData("artifact name", artifactName),
+		audit.WithData("version name", versionName),
+		audit.WithData("operation", "purge"),
+	)
+	if auditErr != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for purge artifact operation: %s", auditErr)
+	}
+
+	return artifact.PurgeArtifactVersion200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// purgeOciVersion permanently deletes a version from the trash. The version is the manifest digest when untagged
+// images are enabled and a tag otherwise.
+func (c *APIController) purgeOciVersion(
+	ctx context.Context,
+	regInfo *registryTypes.RegistryRequestBaseInfo,
+	artifactName string,
+	versionName string,
+) error {
+	if !c.UntaggedImagesEnabled(ctx) {
+		purged, err := c.TagStore.PurgeTag(ctx, regInfo.RegistryID, artifactName, versionName)
+		if err != nil {
+			return err
+		}
+		if !purged {
+			return store.ErrResourceNotFound
+		}
+		return nil
+	}
+
+	dgst, err := registryTypes.NewDigest(digest.Digest(versionName))
+	if err != nil {
+		return err
+	}
+	m, err := c.ManifestStore.FindDeletedManifestByDigest(ctx, regInfo.RegistryID, artifactName, dgst)
+	if err != nil {
+		return err
+	}
+	return c.TrashService.PurgeManifest(ctx, m)
+}
+
+func purgeArtifactVersion400Error(err error) artifact.PurgeArtifactVersionResponseObject {
+	return artifact.PurgeArtifactVersion400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func purgeArtifactVersion501Error(err error) artifact.PurgeArtifactVersionResponseObject {
+	return artifact.PurgeArtifactVersion501JSONResponse{
+		NotImplementedJSONResponse: artifact.NotImplementedJSONResponse(*GetNotImplementedResponse(err)),
+	}
+}
+
+func purgeArtifactVersion500Error(err error) artifact.PurgeArtifactVersionResponseObject {
+	return artifact.PurgeArtifactVersion500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
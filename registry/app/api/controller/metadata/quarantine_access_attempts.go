@@ -0,0 +1,40 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+)
+
+// quarantineBlockedPulls returns the number of blocked pulls of the quarantined version of the image and the time
+// of the last one, which is nil if no pull was blocked.
+func (c *APIController) quarantineBlockedPulls(
+	ctx context.Context,
+	registryID int64,
+	image string,
+	version string,
+) (*int64, *string, error) {
+	summary, err := c.QuarantineAccessAttemptStore.GetSummary(ctx, registryID, image, version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var lastBlockedAt *string
+	if summary.LastAttemptedAt != nil {
+		lastAttemptedAt := GetTimeInMs(*summary.LastAttemptedAt)
+		lastBlockedAt = &lastAttemptedAt
+	}
+	return &summary.Count, lastBlockedAt, nil
+}
@@ -269,6 +269,8 @@ func (r *GitnessRegistryMetadataHelper) MapToInternalWebhookTriggers(
 			webhookTriggers = append(webhookTriggers, enum.WebhookTriggerArtifactCreated)
 		case api.TriggerARTIFACTDELETION:
 			webhookTriggers = append(webhookTriggers, enum.WebhookTriggerArtifactDeleted)
+		case api.TriggerREGISTRYQUOTATHRESHOLD:
+			webhookTriggers = append(webhookTriggers, enum.WebhookTriggerRegistryQuotaThreshold)
 		default:
 			invalidTriggers = append(invalidTriggers, string(trigger))
 		}
@@ -305,6 +307,8 @@ func (r *GitnessRegistryMetadataHelper) MapToAPIWebhookTriggers(triggers []enum.
 			webhookTriggers = append(webhookTriggers, api.TriggerARTIFACTCREATION)
 		case enum.WebhookTriggerArtifactDeleted:
 			webhookTriggers = append(webhookTriggers, api.TriggerARTIFACTDELETION)
+		case enum.WebhookTriggerRegistryQuotaThreshold:
+			webhookTriggers = append(webhookTriggers, api.TriggerREGISTRYQUOTATHRESHOLD)
 		}
 	}
 	return webhookTriggers
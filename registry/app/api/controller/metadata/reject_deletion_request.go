@@ -0,0 +1,150 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/deletionapproval"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// RejectDeletionRequest rejects a pending deletion request, the artifact is kept. The user who made the request
+// can withdraw it, the others have to be able to approve it.
+func (c *APIController) RejectDeletionRequest(
+	ctx context.Context,
+	r api.RejectDeletionRequestRequestObject,
+) (api.RejectDeletionRequestResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return rejectDeletionRequest400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return rejectDeletionRequest400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionArtifactsDelete,
+	); err != nil {
+		return rejectDeletionRequestAuthError(err), nil
+	}
+
+	repoEntity, err := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.ParentID, regInfo.RegistryIdentifier)
+	if err != nil {
+		return rejectDeletionRequest404Error("registry doesn't exist with this key"), nil
+	}
+
+	deletionRequest, err := c.DeletionApprovalService.Find(ctx, repoEntity.ID, int64(r.DeletionRequestId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return rejectDeletionRequest404Error(fmt.Sprintf("deletion request %d not found", r.DeletionRequestId)), nil
+	}
+	if err != nil {
+		return rejectDeletionRequest500Error(err), nil
+	}
+
+	if deletionRequest.RequestedBy != session.Principal.ID {
+		// requests of images which were deleted in the meantime can still be rejected by admins.
+		var img *registryTypes.Image
+		img, err = c.ImageStore.GetByName(ctx, regInfo.RegistryID, deletionRequest.ImageName)
+		if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+			return rejectDeletionRequest500Error(err), nil
+		}
+		if err = c.checkDeletionReviewer(ctx, session, space, regInfo.RegistryIdentifier, img); err != nil {
+			return rejectDeletionRequestAuthError(err), nil
+		}
+	}
+
+	comment := ""
+	if r.Body != nil && r.Body.Comment != nil {
+		comment = *r.Body.Comment
+	}
+
+	err = c.DeletionApprovalService.Reject(ctx, deletionRequest, session.Principal.ID, comment)
+	if errors.Is(err, deletionapproval.ErrNotPending) {
+		return api.RejectDeletionRequest409JSONResponse{
+			ConflictJSONResponse: api.ConflictJSONResponse(
+				*GetErrorResponse(http.StatusConflict,
+					fmt.Sprintf("deletion request %d is %s", deletionRequest.ID, deletionRequest.State)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return rejectDeletionRequest500Error(err), nil
+	}
+
+	c.auditDeletionRequest(ctx, session.Principal, regInfo.ParentRef, repoEntity.Name, deletionRequest,
+		audit.ActionUpdated)
+
+	return api.RejectDeletionRequest200JSONResponse{
+		DeletionRequestResponseJSONResponse: deletionRequestResponse(deletionRequest),
+	}, nil
+}
+
+func rejectDeletionRequestAuthError(err error) api.RejectDeletionRequestResponseObject {
+	statusCode, message := HandleAuthError(err)
+	if statusCode == http.StatusUnauthorized {
+		return api.RejectDeletionRequest401JSONResponse{
+			UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+				*GetErrorResponse(http.StatusUnauthorized, message),
+			),
+		}
+	}
+	return api.RejectDeletionRequest403JSONResponse{
+		UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+			*GetErrorResponse(http.StatusForbidden, message),
+		),
+	}
+}
+
+func rejectDeletionRequest400Error(err error) api.RejectDeletionRequestResponseObject {
+	return api.RejectDeletionRequest400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func rejectDeletionRequest404Error(message string) api.RejectDeletionRequestResponseObject {
+	return api.RejectDeletionRequest404JSONResponse{
+		NotFoundJSONResponse: api.NotFoundJSONResponse(
+			*GetErrorResponse(http.StatusNotFound, message),
+		),
+	}
+}
+
+func rejectDeletionRequest500Error(err error) api.RejectDeletionRequestResponseObject {
+	return api.RejectDeletionRequest500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
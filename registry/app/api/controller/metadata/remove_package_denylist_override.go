@@ -0,0 +1,113 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// RemovePackageDenylistOverride removes the exemption of the registry from an entry, the entry denies the package
+// in the registry again.
+func (c *APIController) RemovePackageDenylistOverride(
+	ctx context.Context,
+	r api.RemovePackageDenylistOverrideRequestObject,
+) (api.RemovePackageDenylistOverrideResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return removePackageDenylistOverride400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return removePackageDenylistOverride400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.RemovePackageDenylistOverride401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.RemovePackageDenylistOverride403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return removePackageDenylistOverride500Error(err), nil
+	}
+
+	override, entry, err := c.PackageDenylistService.RemoveOverride(ctx, registry, int64(r.DenylistEntryId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return api.RemovePackageDenylistOverride404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound,
+					fmt.Sprintf("override of package denylist entry %d not found", r.DenylistEntryId)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return removePackageDenylistOverride500Error(err), nil
+	}
+
+	c.auditPackageDenylistOverride(ctx, session.Principal, regInfo.ParentRef, regInfo.RegistryIdentifier, override,
+		entry, audit.ActionDeleted)
+
+	return api.RemovePackageDenylistOverride200JSONResponse{
+		SuccessJSONResponse: api.SuccessJSONResponse{
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func removePackageDenylistOverride400Error(err error) api.RemovePackageDenylistOverrideResponseObject {
+	return api.RemovePackageDenylistOverride400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func removePackageDenylistOverride500Error(err error) api.RemovePackageDenylistOverrideResponseObject {
+	return api.RemovePackageDenylistOverride500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
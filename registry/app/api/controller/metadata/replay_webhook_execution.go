@@ -0,0 +1,148 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	gitnesswebhook "github.com/harness/gitness/app/services/webhook"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const replayWebhookErrMsg = "failed to replay webhook execution for registry: %s, webhook: %s with error: %v"
+
+// ReplayWebhookExecution sends the stored payload of a past execution of a webhook again. The payload is sent to
+// the url of the request instead of the url of the webhook if it's set.
+func (c *APIController) ReplayWebhookExecution(
+	ctx context.Context,
+	r api.ReplayWebhookExecutionRequestObject,
+) (api.ReplayWebhookExecutionResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return replayWebhookExecutionInternalErrorResponse(err)
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return replayWebhookExecutionInternalErrorResponse(err)
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		log.Ctx(ctx).Error().Msgf("permission check failed while replaying webhook execution for registry: %s, error: %v",
+			regInfo.RegistryIdentifier, err)
+		return api.ReplayWebhookExecution403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	webhookExecutionID, err := strconv.ParseInt(string(r.WebhookExecutionId), 10, 64)
+	if err != nil || webhookExecutionID <= 0 {
+		return replayWebhookExecutionBadRequestResponse(
+			fmt.Sprintf("invalid webhook execution identifier: %s, err: %v", string(r.WebhookExecutionId), err))
+	}
+
+	url := ""
+	if r.Body != nil && r.Body.Url != nil && *r.Body.Url != "" {
+		url = *r.Body.Url
+		if err = validateWebhookURL(url); err != nil {
+			return replayWebhookExecutionBadRequestResponse(err.Error())
+		}
+	}
+
+	webhook, err := c.WebhooksRepository.GetByRegistryAndIdentifier(ctx, regInfo.RegistryID, string(r.WebhookIdentifier))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return replayWebhookExecutionNotFoundResponse(fmt.Sprintf("webhook '%s' not found", r.WebhookIdentifier))
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(replayWebhookErrMsg, regInfo.RegistryRef, r.WebhookIdentifier, err)
+		return replayWebhookExecutionInternalErrorResponse(fmt.Errorf("failed to find webhook: %w", err))
+	}
+
+	// executions of other webhooks can't be replayed through this webhook.
+	execution, err := c.WebhooksExecutionRepository.Find(ctx, webhookExecutionID)
+	if errors.Is(err, store.ErrResourceNotFound) || (err == nil && execution.WebhookID != webhook.ID) {
+		return replayWebhookExecutionNotFoundResponse(
+			fmt.Sprintf("webhook execution '%d' not found", webhookExecutionID))
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(replayWebhookErrMsg, regInfo.RegistryRef, r.WebhookIdentifier, err)
+		return replayWebhookExecutionInternalErrorResponse(fmt.Errorf("failed to find webhook execution: %w", err))
+	}
+
+	result, err := c.WebhookService.ReplayWebhookExecution(ctx, webhookExecutionID, url)
+	if errors.Is(err, gitnesswebhook.ErrWebhookNotRetriggerable) {
+		return replayWebhookExecutionBadRequestResponse(
+			fmt.Sprintf("the payload of webhook execution '%d' isn't available anymore", webhookExecutionID))
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(replayWebhookErrMsg, regInfo.RegistryRef, r.WebhookIdentifier, err)
+		return replayWebhookExecutionInternalErrorResponse(fmt.Errorf("failed to replay execution: %w", err))
+	}
+
+	webhookExecution, err := MapToWebhookExecutionResponseEntity(*result.Execution)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(replayWebhookErrMsg, regInfo.RegistryRef, r.WebhookIdentifier, err)
+		return replayWebhookExecutionInternalErrorResponse(err)
+	}
+	return api.ReplayWebhookExecution200JSONResponse{
+		WebhookExecutionResponseJSONResponse: api.WebhookExecutionResponseJSONResponse{
+			Data:   *webhookExecution,
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func replayWebhookExecutionBadRequestResponse(message string) (api.ReplayWebhookExecutionResponseObject, error) {
+	return api.ReplayWebhookExecution400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, message),
+		),
+	}, nil
+}
+
+func replayWebhookExecutionNotFoundResponse(message string) (api.ReplayWebhookExecutionResponseObject, error) {
+	return api.ReplayWebhookExecution404JSONResponse{
+		NotFoundJSONResponse: api.NotFoundJSONResponse(
+			*GetErrorResponse(http.StatusNotFound, message),
+		),
+	}, nil
+}
+
+func replayWebhookExecutionInternalErrorResponse(err error) (api.ReplayWebhookExecutionResponseObject, error) {
+	return api.ReplayWebhookExecution500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}, nil
+}
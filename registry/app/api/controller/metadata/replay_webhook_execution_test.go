@@ -0,0 +1,131 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll
+package metadata_test
+
+import (
+	"context"
+	"testing"
+
+	gitnesswebhook "github.com/harness/gitness/app/services/webhook"
+	"github.com/harness/gitness/registry/app/api/controller/metadata"
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	coretypes "github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayWebhookExecution(t *testing.T) {
+	replayURL := "https://example.com/replay"
+	invalidURL := "ftp://example.com"
+
+	tests := []struct {
+		name       string
+		body       *api.ReplayWebhookExecutionJSONRequestBody
+		execution  *coretypes.WebhookExecutionCore
+		replayErr  error
+		wantStatus int
+	}{
+		{
+			name:       "replays_to_another_url",
+			body:       &api.ReplayWebhookExecutionJSONRequestBody{Url: &replayURL},
+			execution:  &coretypes.WebhookExecutionCore{ID: 1, WebhookID: 1},
+			wantStatus: 200,
+		},
+		{
+			name:       "rejects_invalid_url",
+			body:       &api.ReplayWebhookExecutionJSONRequestBody{Url: &invalidURL},
+			wantStatus: 400,
+		},
+		{
+			name:       "execution_of_another_webhook",
+			execution:  &coretypes.WebhookExecutionCore{ID: 1, WebhookID: 2},
+			wantStatus: 404,
+		},
+		{
+			name:       "payload_not_retained",
+			execution:  &coretypes.WebhookExecutionCore{ID: 1, WebhookID: 1},
+			replayErr:  gitnesswebhook.ErrWebhookNotRetriggerable,
+			wantStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSpaceFinder := new(mocks.SpaceFinder)
+			mockWebhooksRepository := new(mocks.WebhooksRepository)
+			mockWebhooksExecutionRepository := new(mocks.WebhooksExecutionRepository)
+			mockAuthorizer := new(mocks.Authorizer)
+			mockRegistryMetadataHelper := new(mocks.RegistryMetadataHelper)
+			mockWebhookService := new(mocks.WebhookService)
+
+			regInfo := &types.RegistryRequestBaseInfo{RegistryID: 1, RegistryIdentifier: "reg", ParentRef: "root/parent"}
+			space := &coretypes.SpaceCore{ID: 2}
+			mockRegistryMetadataHelper.On("GetRegistryRequestBaseInfo", mock.Anything, "", "reg").Return(regInfo, nil)
+			mockSpaceFinder.On("FindByRef", mock.Anything, "root/parent").Return(space, nil)
+			mockRegistryMetadataHelper.On("GetPermissionChecks", space, "reg", enum.PermissionRegistryEdit).Return([]coretypes.PermissionCheck{})
+			mockAuthorizer.On("CheckAll", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+			if tt.execution != nil {
+				mockWebhooksRepository.On("GetByRegistryAndIdentifier", mock.Anything, int64(1), "webhook").
+					Return(&coretypes.WebhookCore{ID: 1, Identifier: "webhook"}, nil)
+				mockWebhooksExecutionRepository.On("Find", mock.Anything, int64(1)).Return(tt.execution, nil)
+			}
+			if tt.wantStatus == 200 {
+				mockWebhookService.On("ReplayWebhookExecution", mock.Anything, int64(1), replayURL).
+					Return(&gitnesswebhook.TriggerResult{Execution: webhookExecution}, nil)
+			} else if tt.replayErr != nil {
+				mockWebhookService.On("ReplayWebhookExecution", mock.Anything, int64(1), "").Return(nil, tt.replayErr)
+			}
+
+			controller := &metadata.APIController{
+				SpaceFinder:                 mockSpaceFinder,
+				WebhooksRepository:          mockWebhooksRepository,
+				WebhooksExecutionRepository: mockWebhooksExecutionRepository,
+				Authorizer:                  mockAuthorizer,
+				RegistryMetadataHelper:      mockRegistryMetadataHelper,
+				WebhookService:              mockWebhookService,
+			}
+
+			resp, err := controller.ReplayWebhookExecution(context.Background(), api.ReplayWebhookExecutionRequestObject{
+				RegistryRef:        "reg",
+				WebhookIdentifier:  "webhook",
+				WebhookExecutionId: "1",
+				Body:               tt.body,
+			})
+			require.NoError(t, err)
+
+			switch tt.wantStatus {
+			case 200:
+				r, ok := resp.(api.ReplayWebhookExecution200JSONResponse)
+				require.True(t, ok, "expected 200 response, got %T", resp)
+				assert.Equal(t, *webhookExecutionEntity, r.Data)
+			case 400:
+				_, ok := resp.(api.ReplayWebhookExecution400JSONResponse)
+				assert.True(t, ok, "expected 400 response, got %T", resp)
+			case 404:
+				_, ok := resp.(api.ReplayWebhookExecution404JSONResponse)
+				assert.True(t, ok, "expected 404 response, got %T", resp)
+			}
+			mockWebhooksRepository.AssertExpectations(t)
+			mockWebhooksExecutionRepository.AssertExpectations(t)
+			mockWebhookService.AssertExpectations(t)
+		})
+	}
+}
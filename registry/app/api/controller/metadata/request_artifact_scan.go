@@ -0,0 +1,143 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registryevents "github.com/harness/gitness/registry/app/events/artifact"
+	"github.com/harness/gitness/registry/app/metadata"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+func (c *APIController) RequestArtifactScan(
+	ctx context.Context,
+	r artifact.RequestArtifactScanRequestObject,
+) (artifact.RequestArtifactScanResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return requestArtifactScan400Error(err), nil
+	}
+
+	r.Artifact, r.Version = c.resolveArtifactRef(ctx, regInfo.RegistryID, r.Artifact, r.Version)
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return requestArtifactScan400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.RequestArtifactScan403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	if !isOCIPackageType(regInfo.PackageType) {
+		return requestArtifactScan400Error(errScanNotSupported), nil
+	}
+	image := string(r.Artifact)
+	art, err := c.getOCIArtifact(ctx, regInfo, image, string(r.Version))
+	if err != nil {
+		if errors.Is(err, store2.ErrResourceNotFound) {
+			return artifact.RequestArtifactScan404JSONResponse{
+				NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+					*GetErrorResponse(http.StatusNotFound, err.Error()),
+				),
+			}, nil
+		}
+		return requestArtifactScan500Error(err), nil
+	}
+	status, err := metadata.GetScanStatus(art.Metadata)
+	if err != nil {
+		return requestArtifactScan500Error(err), nil
+	}
+
+	now := time.Now()
+	if status.LastRequestedAt > 0 {
+		nextAllowed := time.UnixMilli(status.LastRequestedAt).Add(artifactScanThrottle)
+		if now.Before(nextAllowed) {
+			return artifact.RequestArtifactScan429JSONResponse{
+				TooManyRequestsJSONResponse: artifact.TooManyRequestsJSONResponse(
+					*GetErrorResponse(http.StatusTooManyRequests, fmt.Sprintf(
+						"a scan of %s@%s was already requested, retry after %s",
+						image, art.Version, nextAllowed.UTC().Format(time.RFC3339))),
+				),
+			}, nil
+		}
+	}
+
+	status.LastRequestedAt = now.UnixMilli()
+	status.Outcome = metadata.ScanOutcomePending
+	if session != nil {
+		status.RequestedBy = session.Principal.ID
+	}
+	rawMetadata, err := metadata.SetScanStatus(art.Metadata, status)
+	if err != nil {
+		return requestArtifactScan500Error(err), nil
+	}
+	if err = c.ArtifactStore.UpdateArtifactMetadata(ctx, rawMetadata, art.ID); err != nil {
+		return requestArtifactScan500Error(err), nil
+	}
+
+	c.ArtifactEventReporter.ArtifactScanRequested(ctx, &registryevents.ArtifactScanRequestedPayload{
+		RegistryID:   regInfo.RegistryID,
+		PrincipalID:  status.RequestedBy,
+		ArtifactType: regInfo.PackageType,
+		Image:        image,
+		Digest:       art.Version,
+		Priority:     registryevents.ScanPriorityHigh,
+	})
+
+	return artifact.RequestArtifactScan202JSONResponse{
+		ArtifactScanStatusResponseJSONResponse: artifact.ArtifactScanStatusResponseJSONResponse{
+			Data:   GetArtifactScanStatus(art.Version, status),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func requestArtifactScan400Error(err error) artifact.RequestArtifactScanResponseObject {
+	return artifact.RequestArtifactScan400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func requestArtifactScan500Error(err error) artifact.RequestArtifactScanResponseObject {
+	return artifact.RequestArtifactScan500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registryevents "github.com/harness/gitness/registry/app/events/artifact"
+	"github.com/harness/gitness/registry/app/pkg/capability"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/rs/zerolog/log"
+)
+
+func (c *APIController) RestoreArtifactVersion(
+	ctx context.Context,
+	r artifact.RestoreArtifactVersionRequestObject,
+) (artifact.RestoreArtifactVersionResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return restoreArtifactVersion400Error(err), nil
+	}
+
+	r.Artifact, r.Version = c.resolveArtifactRef(ctx, regInfo.RegistryID, r.Artifact, r.Version)
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return restoreArtifactVersion400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionArtifactsDelete)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.RestoreArtifactVersion403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	if err = capability.Check(regInfo.PackageType, capability.SoftDelete); err != nil {
+		return restoreArtifactVersion501Error(err), nil
+	}
+
+	artifactName := string(r.Artifact)
+	versionName := string(r.Version)
+	// the artifact-restored event is stored along with the restore, so it isn't lost if we crash after the commit.
+	events := &outboxEvents{}
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		if err := c.restoreOciVersion(ctx, regInfo, artifactName, versionName); err != nil {
+			return err
+		}
+		return events.add(c.Outbox.ArtifactRestored(ctx, &registryevents.ArtifactRestoredPayload{
+			RegistryID:   regInfo.RegistryID,
+			RegistryUUID: regInfo.RegistryUUID,
+			PrincipalID:  session.Principal.ID,
+			ArtifactType: regInfo.PackageType,
+			Image:        artifactName,
+			Version:      versionName,
+		}))
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return artifact.RestoreArtifactVersion404JSONResponse{
+				NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+					*GetErrorResponse(
+						http.StatusNotFound,
+						fmt.Sprintf("artifact version '%s' not found in the trash of artifact '%s'",
+							versionName, artifactName),
+					),
+				),
+			}, nil
+		}
+		return restoreArtifactVersion500Error(err), nil
+	}
+	c.Outbox.Publish(ctx, events.events...)
+
+	auditErr := c.AuditService.Log(
+		ctx,
+		session.Principal,
+		audit.NewResource(audit.ResourceTypeRegistry, artifactName),
+		audit.ActionUpdated,
+		regInfo.ParentRef,
+		audit.WithActorChain(audit.ActorChain(ctx, session.Principal)),
+		audit.WithData("registry name", regInfo.RegistryIdentifier),
+		audit.WithData("artifact name", artifactName),
+		audit.WithData("version name", versionName),
+		audit.WithData("operation", "restore"),
+	)
+	if auditErr != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for restore artifact operation: %s", auditErr)
+	}
+
+	return artifact.RestoreArtifactVersion200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// restoreOciVersion restores a version from the trash. The version is the manifest digest when untagged
+// images are enabled and a tag otherwise. Restoring a tag also restores its manifest if that was deleted.
+func (c *APIController) restoreOciVersion(
+	ctx context.Context,
+	regInfo *registryTypes.RegistryRequestBaseInfo,
+	artifactName string,
+	versionName string,
+) error {
+	var manifestID int64
+	if c.UntaggedImagesEnabled(ctx) {
+		dgst, err := registryTypes.NewDigest(digest.Digest(versionName))
+		if err != nil {
+			return err
+		}
+		m, err := c.ManifestStore.FindDeletedManifestByDigest(ctx, regInfo.RegistryID, artifactName, dgst)
+		if err != nil {
+			return err
+		}
+		manifestID = m.ID
+	} else {
+		id, err := c.TagStore.RestoreTag(ctx, regInfo.RegistryID, artifactName, versionName)
+		if err != nil {
+			return err
+		}
+		manifestID = id
+	}
+
+	restored, err := c.ManifestStore.Restore(ctx, regInfo.RegistryID, manifestID)
+	if err != nil {
+		return fmt.Errorf("failed to restore manifest: %w", err)
+	}
+	if !restored {
+		return nil
+	}
+
+	// the artifact was soft-deleted along with the manifest, the versions trashed before artifacts were
+	// soft-deleted lost their artifact and, when they were the last version, their image.
+	m, err := c.ManifestStore.Get(ctx, manifestID)
+	if err != nil {
+		return err
+	}
+	dgst, err := registryTypes.NewDigest(m.Digest)
+	if err != nil {
+		return err
+	}
+	image := &registryTypes.Image{
+		Name:       artifactName,
+		RegistryID: regInfo.RegistryID,
+		Enabled:    true,
+	}
+	if err = c.ImageStore.CreateOrUpdate(ctx, image); err != nil {
+		return fmt.Errorf("failed to restore image: %w", err)
+	}
+	a, err := c.ArtifactStore.GetByRegistryImageAndVersion(ctx, regInfo.RegistryID, artifactName, dgst.String())
+	switch {
+	case errors.Is(err, store.ErrResourceNotFound):
+		a = &registryTypes.Artifact{ImageID: image.ID, Version: dgst.String()}
+	case err != nil:
+		return fmt.Errorf("failed to find artifact: %w", err)
+	}
+	// CreateOrUpdate clears the deletion of an existing artifact and keeps its metadata.
+	if _, err = c.ArtifactStore.CreateOrUpdate(ctx, a); err != nil {
+		return fmt.Errorf("failed to restore artifact: %w", err)
+	}
+	return nil
+}
+
+func restoreArtifactVersion400Error(err error) artifact.RestoreArtifactVersionResponseObject {
+	return artifact.RestoreArtifactVersion400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func restoreArtifactVersion501Error(err error) artifact.RestoreArtifactVersionResponseObject {
+	return artifact.RestoreArtifactVersion501JSONResponse{
+		NotImplementedJSONResponse: artifact.NotImplementedJSONResponse(*GetNotImplementedResponse(err)),
+	}
+}
+
+func restoreArtifactVersion500Error(err error) artifact.RestoreArtifactVersionResponseObject {
+	return artifact.RestoreArtifactVersion500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/concurrency"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// RetryRegistryIndexBuild requests a new run of a failed index build. The index is rebuilt from scratch, as
+// the sources which triggered the failed run were claimed by it.
+func (c *APIController) RetryRegistryIndexBuild(
+	ctx context.Context,
+	r api.RetryRegistryIndexBuildRequestObject,
+) (api.RetryRegistryIndexBuildResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return retryIndexBuildInternalErrorResponse(err)
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return retryIndexBuildInternalErrorResponse(err)
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		log.Ctx(ctx).Error().Msgf("permission check failed while retrying index build for registry: %s, error: %v",
+			regInfo.RegistryIdentifier, err)
+		return api.RetryRegistryIndexBuild403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	buildID, err := strconv.ParseInt(string(r.IndexBuildId), 10, 64)
+	if err != nil || buildID <= 0 {
+		return retryIndexBuild400Error(fmt.Sprintf("invalid index build identifier: %s", string(r.IndexBuildId)))
+	}
+	build, err := c.IndexBuildRepository.Find(ctx, buildID)
+	if errors.Is(err, store.ErrResourceNotFound) || (err == nil && build.RegistryID != regInfo.RegistryID) {
+		return api.RetryRegistryIndexBuild404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, fmt.Sprintf("index build '%d' not found", buildID)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return retryIndexBuildInternalErrorResponse(fmt.Errorf("failed to find index build: %w", err))
+	}
+	if build.Status != types.TaskStatusFailure {
+		return retryIndexBuild400Error("only failed index builds can be retried")
+	}
+
+	if build.Kind == types.TaskKindBuildPackageIndex {
+		c.PostProcessingReporter.BuildPackageIndex(ctx, build.RegistryID, build.Image, make([]types.SourceRef, 0))
+	} else {
+		c.PostProcessingReporter.BuildRegistryIndex(ctx, build.RegistryID, make([]types.SourceRef, 0))
+	}
+
+	// the build is queued behind the running index builds of the account if it reached its limit.
+	limit := c.ConcurrencyLimiter.Limit(concurrency.OperationIndexBuild)
+	if running := c.ConcurrencyLimiter.Running(regInfo.RootIdentifierID, concurrency.OperationIndexBuild); limit > 0 &&
+		running >= limit {
+		return api.RetryRegistryIndexBuild202JSONResponse{
+			AcceptedJSONResponse: api.AcceptedJSONResponse{
+				Status: api.StatusSUCCESS,
+				Message: fmt.Sprintf("the account runs the maximum of %d concurrent index builds, "+
+					"the build starts once one of them completes", limit),
+			},
+		}, nil
+	}
+	return api.RetryRegistryIndexBuild200JSONResponse{
+		SuccessJSONResponse: api.SuccessJSONResponse{
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func retryIndexBuild400Error(message string) (api.RetryRegistryIndexBuildResponseObject, error) {
+	return api.RetryRegistryIndexBuild400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, message),
+		),
+	}, nil
+}
+
+func retryIndexBuildInternalErrorResponse(err error) (api.RetryRegistryIndexBuildResponseObject, error) {
+	return api.RetryRegistryIndexBuild500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll
+package metadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/controller/metadata"
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	coretypes "github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRetryRegistryIndexBuild(t *testing.T) {
+	tests := []struct {
+		name         string
+		buildID      string
+		setupMocks   func(*mocks.IndexBuildRepository)
+		expectedResp api.RetryRegistryIndexBuildResponseObject
+	}{
+		{
+			name:       "invalid_build_identifier",
+			buildID:    "invalid",
+			setupMocks: func(_ *mocks.IndexBuildRepository) {},
+			expectedResp: api.RetryRegistryIndexBuild400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Code:    "400",
+					Message: "invalid index build identifier: invalid",
+				},
+			},
+		},
+		{
+			name:    "build_not_found",
+			buildID: "7",
+			setupMocks: func(m *mocks.IndexBuildRepository) {
+				m.On("Find", mock.Anything, int64(7)).Return(nil, store.ErrResourceNotFound)
+			},
+			expectedResp: api.RetryRegistryIndexBuild404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Code:    "404",
+					Message: "index build '7' not found",
+				},
+			},
+		},
+		{
+			name:    "build_of_another_registry",
+			buildID: "7",
+			setupMocks: func(m *mocks.IndexBuildRepository) {
+				m.On("Find", mock.Anything, int64(7)).Return(&types.IndexBuild{
+					ID: 7, RegistryID: 2, Status: types.TaskStatusFailure,
+				}, nil)
+			},
+			expectedResp: api.RetryRegistryIndexBuild404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Code:    "404",
+					Message: "index build '7' not found",
+				},
+			},
+		},
+		{
+			name:    "build_not_failed",
+			buildID: "7",
+			setupMocks: func(m *mocks.IndexBuildRepository) {
+				m.On("Find", mock.Anything, int64(7)).Return(&types.IndexBuild{
+					ID: 7, RegistryID: 1, Status: types.TaskStatusSuccess,
+				}, nil)
+			},
+			expectedResp: api.RetryRegistryIndexBuild400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Code:    "400",
+					Message: "only failed index builds can be retried",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSpaceFinder := new(mocks.SpaceFinder)
+			mockAuthorizer := new(mocks.Authorizer)
+			mockRegistryMetadataHelper := new(mocks.RegistryMetadataHelper)
+			mockIndexBuildRepository := new(mocks.IndexBuildRepository)
+
+			regInfo := &types.RegistryRequestBaseInfo{
+				RegistryID:         1,
+				RegistryIdentifier: "reg",
+				ParentRef:          "root/parent",
+			}
+			space := &coretypes.SpaceCore{ID: 2}
+			var permissionChecks []coretypes.PermissionCheck
+			mockRegistryMetadataHelper.On("GetRegistryRequestBaseInfo", mock.Anything, "", "reg").Return(regInfo, nil)
+			mockSpaceFinder.On("FindByRef", mock.Anything, "root/parent").Return(space, nil)
+			mockRegistryMetadataHelper.On("GetPermissionChecks", space, regInfo.RegistryIdentifier, enum.PermissionRegistryEdit).Return(permissionChecks)
+			mockAuthorizer.On("CheckAll", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+			tt.setupMocks(mockIndexBuildRepository)
+
+			controller := &metadata.APIController{
+				SpaceFinder:            mockSpaceFinder,
+				Authorizer:             mockAuthorizer,
+				RegistryMetadataHelper: mockRegistryMetadataHelper,
+				IndexBuildRepository:   mockIndexBuildRepository,
+			}
+
+			resp, err := controller.RetryRegistryIndexBuild(context.Background(), api.RetryRegistryIndexBuildRequestObject{
+				RegistryRef:  "reg",
+				IndexBuildId: api.IndexBuildIdPathParam(tt.buildID),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResp, resp)
+
+			mockIndexBuildRepository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// RevokeFirewallApproval removes the approval of a version, it's held back again until the firewall delay of the
+// upstream proxy has passed.
+func (c *APIController) RevokeFirewallApproval(
+	ctx context.Context,
+	r api.RevokeFirewallApprovalRequestObject,
+) (api.RevokeFirewallApprovalResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return revokeFirewallApproval400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return revokeFirewallApproval400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.RevokeFirewallApproval401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.RevokeFirewallApproval403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	upstream, err := c.getFirewallUpstream(ctx, regInfo)
+	if err != nil {
+		return revokeFirewallApproval400Error(err), nil
+	}
+
+	approval, err := c.FirewallDelayService.Revoke(ctx, upstream, string(r.Artifact), string(r.Version))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return api.RevokeFirewallApproval404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound,
+					fmt.Sprintf("approval of version %s of %s not found", r.Version, r.Artifact)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return revokeFirewallApproval500Error(err), nil
+	}
+
+	c.auditFirewallApproval(ctx, session.Principal, regInfo.ParentRef, regInfo.RegistryIdentifier, approval,
+		audit.ActionDeleted)
+
+	return api.RevokeFirewallApproval200JSONResponse{
+		SuccessJSONResponse: api.SuccessJSONResponse{
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func revokeFirewallApproval400Error(err error) api.RevokeFirewallApprovalResponseObject {
+	return api.RevokeFirewallApproval400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func revokeFirewallApproval500Error(err error) api.RevokeFirewallApprovalResponseObject {
+	return api.RevokeFirewallApproval500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/deletion"
+	"github.com/harness/gitness/registry/services/notification"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ScheduleDeletion schedules the deletion of an artifact or a version after a delay, it can be canceled until
+// it's executed. Scheduling the deletion of an artifact twice returns the deletion which is already scheduled.
+func (c *APIController) ScheduleDeletion(
+	ctx context.Context,
+	r api.ScheduleDeletionRequestObject,
+) (api.ScheduleDeletionResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return scheduleDeletion400Error(err.Error()), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return scheduleDeletion400Error(err.Error()), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionArtifactsDelete,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.ScheduleDeletion401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.ScheduleDeletion403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	if r.Body == nil || r.Body.Artifact == "" {
+		return scheduleDeletion400Error("artifact is required"), nil
+	}
+	var version api.VersionPathParam
+	if r.Body.Version != nil {
+		version = api.VersionPathParam(*r.Body.Version)
+	}
+	artifactRef, versionRef := c.resolveArtifactRef(ctx, regInfo.RegistryID, api.ArtifactPathParam(r.Body.Artifact),
+		version)
+	artifactName, versionName := string(artifactRef), string(versionRef)
+
+	var delay *time.Duration
+	if r.Body.Delay != nil {
+		d, err := time.ParseDuration(*r.Body.Delay)
+		if err != nil {
+			return scheduleDeletion400Error(fmt.Sprintf("invalid delay: %s", *r.Body.Delay)), nil
+		}
+		delay = &d
+	}
+
+	repoEntity, err := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.ParentID, regInfo.RegistryIdentifier)
+	if err != nil {
+		return scheduleDeletion404Error("registry doesn't exist with this key"), nil
+	}
+	if repoEntity.IsDeletionProtected() {
+		c.notify(ctx, &notification.Event{
+			Type:       registryTypes.NotificationEventProtectedDeletion,
+			RegistryID: repoEntity.ID,
+			Registry:   repoEntity.Name,
+			Artifact:   artifactName,
+			Version:    versionName,
+			Principal:  session.Principal.DisplayName,
+		})
+		return api.ScheduleDeletion409JSONResponse{
+			ConflictJSONResponse: deletionProtectedError(repoEntity.Name),
+		}, nil
+	}
+
+	if _, err = c.ImageStore.GetByName(ctx, regInfo.RegistryID, artifactName); err != nil {
+		//nolint:nilerr
+		return scheduleDeletion404Error("artifact doesn't exist with this key"), nil
+	}
+
+	scheduled, created, err := c.DeletionService.Schedule(ctx, regInfo.RegistryID, artifactName, versionName,
+		session.Principal.ID, delay)
+	if errors.Is(err, deletion.ErrInvalidDelay) {
+		return scheduleDeletion400Error(err.Error()), nil
+	}
+	if err != nil {
+		return api.ScheduleDeletion500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+	if created {
+		c.auditScheduledDeletion(ctx, session.Principal, regInfo.ParentRef, repoEntity.Name, scheduled,
+			audit.ActionCreated)
+	}
+
+	return api.ScheduleDeletion200JSONResponse{
+		ScheduledDeletionResponseJSONResponse: scheduledDeletionResponse(scheduled),
+	}, nil
+}
+
+func scheduleDeletion400Error(message string) api.ScheduleDeletionResponseObject {
+	return api.ScheduleDeletion400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, message),
+		),
+	}
+}
+
+func scheduleDeletion404Error(message string) api.ScheduleDeletionResponseObject {
+	return api.ScheduleDeletion404JSONResponse{
+		NotFoundJSONResponse: api.NotFoundJSONResponse(
+			*GetErrorResponse(http.StatusNotFound, message),
+		),
+	}
+}
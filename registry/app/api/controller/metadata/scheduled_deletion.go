@@ -0,0 +1,121 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/harness/gitness/app/paths"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registryTypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ExecuteScheduledDeletion deletes the artifact or version of a due scheduled deletion on behalf of the principal
+// who scheduled it. Registries which were protected from deletes in the meantime keep their artifacts.
+func (c *APIController) ExecuteScheduledDeletion(
+	ctx context.Context,
+	principal *types.Principal,
+	deletion *registryTypes.ScheduledDeletion,
+) error {
+	registry, err := c.RegistryRepository.Get(ctx, deletion.RegistryID)
+	if err != nil {
+		return fmt.Errorf("failed to find registry %d: %w", deletion.RegistryID, err)
+	}
+	if registry.IsDeletionProtected() {
+		return fmt.Errorf("registry '%s' has deletion protection enabled", registry.Name)
+	}
+
+	space, err := c.SpaceFinder.FindByID(ctx, registry.ParentID)
+	if err != nil {
+		return fmt.Errorf("failed to find parent space of registry '%s': %w", registry.Name, err)
+	}
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, space.Path,
+		paths.Concatenate(space.Path, registry.Name))
+	if err != nil {
+		return err
+	}
+
+	img, err := c.ImageStore.GetByName(ctx, registry.ID, deletion.ImageName)
+	if err != nil {
+		return fmt.Errorf("failed to find artifact '%s': %w", deletion.ImageName, err)
+	}
+
+	scheduledAudit := audit.WithData("scheduled deletion", strconv.FormatInt(deletion.ID, 10))
+	if deletion.Version == "" {
+		return c.deleteArtifact(ctx, regInfo, registry.Name, *principal, img, scheduledAudit)
+	}
+	return c.deleteArtifactVersion(ctx, regInfo, registry.Name, *principal, img, deletion.Version, scheduledAudit)
+}
+
+func (c *APIController) auditScheduledDeletion(
+	ctx context.Context,
+	principal types.Principal,
+	parentRef string,
+	registryName string,
+	deletion *registryTypes.ScheduledDeletion,
+	action audit.Action,
+) {
+	err := c.AuditService.Log(
+		ctx,
+		principal,
+		audit.NewResource(audit.ResourceTypeRegistryScheduledDeletion, strconv.FormatInt(deletion.ID, 10)),
+		action,
+		parentRef,
+		audit.WithActorChain(audit.ActorChain(ctx, principal)),
+		audit.WithData("registry name", registryName),
+		audit.WithData("artifact name", deletion.ImageName),
+		audit.WithData("version name", deletion.Version),
+		audit.WithData("state", string(deletion.State)),
+		audit.WithData("execute at", GetTimeInMs(deletion.ExecuteAt)),
+	)
+	if err != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for scheduled deletion %d: %s", deletion.ID, err)
+	}
+}
+
+func scheduledDeletionResponse(
+	deletion *registryTypes.ScheduledDeletion,
+) artifact.ScheduledDeletionResponseJSONResponse {
+	return artifact.ScheduledDeletionResponseJSONResponse{
+		Data:   mapToAPIScheduledDeletion(deletion),
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+func mapToAPIScheduledDeletion(deletion *registryTypes.ScheduledDeletion) artifact.ScheduledDeletion {
+	dto := artifact.ScheduledDeletion{
+		Id:         deletion.ID,
+		Artifact:   deletion.ImageName,
+		State:      artifact.ScheduledDeletionState(deletion.State),
+		ExecuteAt:  GetTimeInMs(deletion.ExecuteAt),
+		CreatedBy:  deletion.CreatedBy,
+		CanceledBy: deletion.CanceledBy,
+		CreatedAt:  GetTimeInMs(deletion.CreatedAt),
+		UpdatedAt:  GetTimeInMs(deletion.UpdatedAt),
+	}
+	if deletion.Version != "" {
+		dto.Version = &deletion.Version
+	}
+	if deletion.Error != "" {
+		dto.Error = &deletion.Error
+	}
+	return dto
+}
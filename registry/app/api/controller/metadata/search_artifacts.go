@@ -0,0 +1,195 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	metadatapkg "github.com/harness/gitness/registry/app/metadata"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	// maxSearchTerms bounds the words of a search query, each of them is matched as a prefix.
+	maxSearchTerms = 10
+	// searchResultDescriptionLength is the length of the description returned with a result, it's a summary.
+	searchResultDescriptionLength = 300
+)
+
+// SearchArtifacts searches the metadata of the versions stored in the registries of the space.
+func (c *APIController) SearchArtifacts(
+	ctx context.Context,
+	r api.SearchArtifactsRequestObject,
+) (api.SearchArtifactsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.Params.SpaceRef), "")
+	if err != nil {
+		return searchArtifacts400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return searchArtifacts400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryView,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.SearchArtifacts401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.SearchArtifacts403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	terms := metadatapkg.SearchTerms(string(r.Params.Query))
+	if len(terms) == 0 {
+		return searchArtifacts400Error(fmt.Errorf("query must contain a word")), nil
+	}
+	if len(terms) > maxSearchTerms {
+		return searchArtifacts400Error(fmt.Errorf("query can't contain more than %d words", maxSearchTerms)), nil
+	}
+
+	var field types.ArtifactSearchField
+	if r.Params.Field != nil {
+		field, err = mapFromAPISearchField(api.ArtifactSearchField(*r.Params.Field))
+		if err != nil {
+			return searchArtifacts400Error(err), nil
+		}
+	}
+	var packageTypes []string
+	if r.Params.PackageType != nil {
+		packageTypes = *r.Params.PackageType
+	}
+
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+
+	query := types.ArtifactSearchQuery{
+		SpaceID:      space.ID,
+		Terms:        terms,
+		Field:        field,
+		PackageTypes: packageTypes,
+		Limit:        limit,
+		Offset:       offset,
+	}
+	results, err := c.SearchRepository.Search(ctx, query)
+	if err != nil {
+		return searchArtifacts500Error(fmt.Errorf("failed to search artifacts: %w", err)), nil
+	}
+	count, err := c.SearchRepository.Count(ctx, query)
+	if err != nil {
+		return searchArtifacts500Error(fmt.Errorf("failed to count search results: %w", err)), nil
+	}
+
+	dtos := make([]api.ArtifactSearchResult, 0, len(results))
+	for _, result := range results {
+		dtos = append(dtos, mapToAPIArtifactSearchResult(result))
+	}
+	pageCount := GetPageCount(count, limit)
+
+	return api.SearchArtifacts200JSONResponse{
+		SearchArtifactsResponseJSONResponse: api.SearchArtifactsResponseJSONResponse{
+			Data: api.ListArtifactSearchResults{
+				PageIndex: &pageNumber,
+				PageCount: &pageCount,
+				PageSize:  &limit,
+				ItemCount: &count,
+				Results:   dtos,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func mapFromAPISearchField(field api.ArtifactSearchField) (types.ArtifactSearchField, error) {
+	switch field {
+	case api.ArtifactSearchFieldName:
+		return types.ArtifactSearchFieldName, nil
+	case api.ArtifactSearchFieldDescription:
+		return types.ArtifactSearchFieldDescription, nil
+	case api.ArtifactSearchFieldKeywords:
+		return types.ArtifactSearchFieldKeywords, nil
+	case api.ArtifactSearchFieldAuthor:
+		return types.ArtifactSearchFieldAuthors, nil
+	case api.ArtifactSearchFieldDependency:
+		return types.ArtifactSearchFieldDependencies, nil
+	}
+	return "", fmt.Errorf("invalid search field %q", field)
+}
+
+func mapToAPIArtifactSearchResult(result *types.ArtifactSearchResult) api.ArtifactSearchResult {
+	dto := api.ArtifactSearchResult{
+		RegistryIdentifier: result.RegistryName,
+		PackageType:        api.PackageType(result.PackageType),
+		Package:            result.Name,
+		Version:            result.Version,
+		Rank:               result.Rank,
+	}
+	if description := summarizeDescription(result.Description); description != "" {
+		dto.Description = &description
+	}
+	return dto
+}
+
+// summarizeDescription returns the first line of a description, long lines are cut.
+func summarizeDescription(description string) string {
+	description = strings.TrimSpace(description)
+	if i := strings.IndexByte(description, '\n'); i >= 0 {
+		description = strings.TrimSpace(description[:i])
+	}
+	if utf8.RuneCountInString(description) <= searchResultDescriptionLength {
+		return description
+	}
+	return string([]rune(description)[:searchResultDescriptionLength]) + "…"
+}
+
+func searchArtifacts400Error(err error) api.SearchArtifactsResponseObject {
+	return api.SearchArtifacts400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func searchArtifacts500Error(err error) api.SearchArtifactsResponseObject {
+	return api.SearchArtifacts500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
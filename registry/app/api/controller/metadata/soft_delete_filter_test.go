@@ -0,0 +1,47 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+)
+
+func TestSoftDeleteFilter(t *testing.T) {
+	yes, no := true, false
+	tests := []struct {
+		name           string
+		includeDeleted *bool
+		onlyDeleted    *bool
+		want           types.SoftDeleteFilter
+	}{
+		{name: "default", want: types.SoftDeleteFilterExclude},
+		{name: "not included", includeDeleted: &no, onlyDeleted: &no, want: types.SoftDeleteFilterExclude},
+		{name: "included", includeDeleted: &yes, want: types.SoftDeleteFilterInclude},
+		{name: "only", onlyDeleted: &yes, want: types.SoftDeleteFilterOnly},
+		{name: "only wins", includeDeleted: &yes, onlyDeleted: &yes, want: types.SoftDeleteFilterOnly},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := softDeleteFilter((*artifact.IncludeDeletedParam)(tt.includeDeleted),
+				(*artifact.OnlyDeletedParam)(tt.onlyDeleted))
+			if got != tt.want {
+				t.Errorf("expected filter %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
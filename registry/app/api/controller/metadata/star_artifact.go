@@ -0,0 +1,316 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// starResult is the outcome of starring or unstarring an artifact, its error fields are mapped to the responses
+// of the operation.
+type starResult struct {
+	star            api.ArtifactStar
+	badRequest      error
+	unauthenticated bool
+	forbidden       error
+	notFound        bool
+	err             error
+}
+
+// StarArtifact stars an artifact for the current user, starring an artifact twice has no effect.
+func (c *APIController) StarArtifact(
+	ctx context.Context,
+	r api.StarArtifactRequestObject,
+) (api.StarArtifactResponseObject, error) {
+	var artifactType *string
+	if r.Params.ArtifactType != nil {
+		t := string(*r.Params.ArtifactType)
+		artifactType = &t
+	}
+	result := c.setArtifactStar(ctx, string(r.RegistryRef), string(r.Artifact), artifactType, true)
+	switch {
+	case result.badRequest != nil:
+		return api.StarArtifact400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, result.badRequest.Error()),
+			),
+		}, nil
+	case result.unauthenticated:
+		return api.StarArtifact401JSONResponse{
+			UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+				*GetErrorResponse(http.StatusUnauthorized, "stars require an authenticated user"),
+			),
+		}, nil
+	case result.forbidden != nil:
+		return api.StarArtifact403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, result.forbidden.Error()),
+			),
+		}, nil
+	case result.notFound:
+		return api.StarArtifact404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "artifact doesn't exist with this name"),
+			),
+		}, nil
+	case result.err != nil:
+		return api.StarArtifact500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, result.err.Error()),
+			),
+		}, nil
+	}
+	return api.StarArtifact200JSONResponse{
+		ArtifactStarResponseJSONResponse: api.ArtifactStarResponseJSONResponse{
+			Data:   result.star,
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// UnstarArtifact removes the star of the current user from an artifact.
+func (c *APIController) UnstarArtifact(
+	ctx context.Context,
+	r api.UnstarArtifactRequestObject,
+) (api.UnstarArtifactResponseObject, error) {
+	var artifactType *string
+	if r.Params.ArtifactType != nil {
+		t := string(*r.Params.ArtifactType)
+		artifactType = &t
+	}
+	result := c.setArtifactStar(ctx, string(r.RegistryRef), string(r.Artifact), artifactType, false)
+	switch {
+	case result.badRequest != nil:
+		return api.UnstarArtifact400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, result.badRequest.Error()),
+			),
+		}, nil
+	case result.unauthenticated:
+		return api.UnstarArtifact401JSONResponse{
+			UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+				*GetErrorResponse(http.StatusUnauthorized, "stars require an authenticated user"),
+			),
+		}, nil
+	case result.forbidden != nil:
+		return api.UnstarArtifact403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, result.forbidden.Error()),
+			),
+		}, nil
+	case result.notFound:
+		return api.UnstarArtifact404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "artifact doesn't exist with this name"),
+			),
+		}, nil
+	case result.err != nil:
+		return api.UnstarArtifact500JSONResponse{
+			InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, result.err.Error()),
+			),
+		}, nil
+	}
+	return api.UnstarArtifact200JSONResponse{
+		ArtifactStarResponseJSONResponse: api.ArtifactStarResponseJSONResponse{
+			Data:   result.star,
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// setArtifactStar stars or unstars the artifact for the current user. Stars are personal, so viewing the
+// registry is enough to star its artifacts.
+func (c *APIController) setArtifactStar(
+	ctx context.Context,
+	registryRef string,
+	image string,
+	artifactTypeParam *string,
+	starred bool,
+) starResult {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", registryRef)
+	if err != nil {
+		return starResult{badRequest: err}
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return starResult{badRequest: err}
+	}
+
+	session, ok := request.AuthSessionFrom(ctx)
+	if !ok || auth.IsAnonymousSession(session) {
+		return starResult{unauthenticated: true}
+	}
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return starResult{forbidden: err}
+	}
+
+	var artifactType *api.ArtifactType
+	if artifactTypeParam != nil {
+		artifactType, err = ValidateAndGetArtifactType(regInfo.PackageType, *artifactTypeParam)
+		if err != nil {
+			return starResult{badRequest: err}
+		}
+	}
+
+	img, err := c.ImageStore.GetByNameAndType(ctx, regInfo.RegistryID, image, artifactType)
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return starResult{notFound: true}
+	}
+	if err != nil {
+		return starResult{err: err}
+	}
+
+	var count int64
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		if starred {
+			_, err = c.ImageStarRepository.Star(ctx, img.ID, session.Principal.ID)
+		} else {
+			_, err = c.ImageStarRepository.Unstar(ctx, img.ID, session.Principal.ID)
+		}
+		if err != nil {
+			return err
+		}
+		count, err = c.ImageStarRepository.GetStarCount(ctx, img.ID)
+		return err
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("failed to update star of artifact: %s with error: %v", image, err)
+		return starResult{err: fmt.Errorf("failed to update artifact star: %w", err)}
+	}
+
+	return starResult{star: api.ArtifactStar{Starred: starred, StarCount: count}}
+}
+
+// ListStarredArtifacts lists the artifacts starred by the current user. Artifacts of registries the user can't
+// view anymore are left out of the page.
+func (c *APIController) ListStarredArtifacts(
+	ctx context.Context,
+	r api.ListStarredArtifactsRequestObject,
+) (api.ListStarredArtifactsResponseObject, error) {
+	session, ok := request.AuthSessionFrom(ctx)
+	if !ok || auth.IsAnonymousSession(session) {
+		return api.ListStarredArtifacts401JSONResponse{
+			UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+				*GetErrorResponse(http.StatusUnauthorized, "stars require an authenticated user"),
+			),
+		}, nil
+	}
+
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+	images, err := c.ImageStarRepository.ListByPrincipal(ctx, session.Principal.ID, limit, offset)
+	if err != nil {
+		return listStarredArtifacts500Error(fmt.Errorf("failed to list starred artifacts: %w", err)), nil
+	}
+	count, err := c.ImageStarRepository.CountByPrincipal(ctx, session.Principal.ID)
+	if err != nil {
+		return listStarredArtifacts500Error(fmt.Errorf("failed to count starred artifacts: %w", err)), nil
+	}
+
+	// the access to a registry is checked once for all of its starred artifacts.
+	registryPaths := make(map[int64]string)
+	artifacts := make([]api.StarredArtifact, 0, len(images))
+	for _, image := range images {
+		registryPath, checked := registryPaths[image.RegistryID]
+		if !checked {
+			registryPath = c.viewableRegistryPath(ctx, session, image.RegistryParentID, image.RegistryID,
+				image.RegistryName)
+			registryPaths[image.RegistryID] = registryPath
+		}
+		if registryPath == "" {
+			continue
+		}
+		artifacts = append(artifacts, mapToAPIStarredArtifact(image, registryPath))
+	}
+
+	pageCount := GetPageCount(count, limit)
+	currentPageSize := len(artifacts)
+	return api.ListStarredArtifacts200JSONResponse{
+		ListStarredArtifactResponseJSONResponse: api.ListStarredArtifactResponseJSONResponse{
+			Data: api.ListStarredArtifact{
+				Artifacts: artifacts,
+				ItemCount: &count,
+				PageCount: &pageCount,
+				PageIndex: &pageNumber,
+				PageSize:  &currentPageSize,
+			},
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// viewableRegistryPath returns the path of a registry, or an empty path if the user can't view the registry.
+func (c *APIController) viewableRegistryPath(
+	ctx context.Context,
+	session *auth.Session,
+	parentID int64,
+	registryID int64,
+	registryName string,
+) string {
+	space, err := c.SpaceFinder.FindByID(ctx, parentID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to find space of registry %d", registryID)
+		return ""
+	}
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, registryName,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return ""
+	}
+	return space.Path + "/" + registryName
+}
+
+func mapToAPIStarredArtifact(image *types.StarredImage, registryPath string) api.StarredArtifact {
+	return api.StarredArtifact{
+		Name:               image.ImageName,
+		RegistryIdentifier: image.RegistryName,
+		RegistryPath:       registryPath,
+		PackageType:        image.PackageType,
+		ArtifactType:       image.ArtifactType,
+		StarCount:          image.StarCount,
+		StarredAt:          GetTimeInMs(image.StarredAt),
+	}
+}
+
+func listStarredArtifacts500Error(err error) api.ListStarredArtifactsResponseObject {
+	return api.ListStarredArtifacts500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
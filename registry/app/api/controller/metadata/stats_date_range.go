@@ -0,0 +1,61 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+const (
+	// statsDateLayout is the format of the dates the stats APIs accept and report.
+	statsDateLayout = "01/02/2006"
+	// statsDefaultDays is the number of days reported when the range isn't given.
+	statsDefaultDays = 30
+)
+
+// getStatsDateRange returns the first and the last UTC day of the date range requested by the stats APIs.
+// The range ends today and starts statsDefaultDays before its end when not given.
+func getStatsDateRange(
+	fromParam *artifact.FromDateParam,
+	toParam *artifact.ToDateParam,
+	now time.Time,
+) (time.Time, time.Time, error) {
+	to := now.UTC().Truncate(24 * time.Hour)
+	if toParam != nil && *toParam != "" {
+		parsed, err := time.Parse(statsDateLayout, string(*toParam))
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid to date %q, expected format MM/DD/YYYY", *toParam)
+		}
+		to = parsed
+	}
+
+	from := to.AddDate(0, 0, -(statsDefaultDays - 1))
+	if fromParam != nil && *fromParam != "" {
+		parsed, err := time.Parse(statsDateLayout, string(*fromParam))
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid from date %q, expected format MM/DD/YYYY",
+				*fromParam)
+		}
+		from = parsed
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("from date must not be after to date")
+	}
+	return from, to, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStatsDateRange(t *testing.T) {
+	now := time.Date(2024, 3, 15, 17, 30, 0, 0, time.UTC)
+
+	from, to, err := getStatsDateRange(nil, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), to)
+
+	fromParam := artifact.FromDateParam("03/01/2024")
+	toParam := artifact.ToDateParam("03/10/2024")
+	from, to, err = getStatsDateRange(&fromParam, &toParam, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), to)
+
+	fromParam = "03/11/2024"
+	_, _, err = getStatsDateRange(&fromParam, &toParam, now)
+	assert.Error(t, err)
+
+	fromParam = "2024-03-01"
+	_, _, err = getStatsDateRange(&fromParam, nil, now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,259 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// syncRequestRate and syncRequestBurst limit the sync requests of a principal, indexers are expected to
+	// page through the versions rather than to poll.
+	syncRequestRate  = rate.Limit(5)
+	syncRequestBurst = 20
+
+	maxSyncPageSize = 100
+
+	syncCursorPrefix = "v1:"
+)
+
+var errInvalidSyncCursor = errors.New("invalid cursor")
+
+func (c *APIController) SyncArtifactVersions(
+	ctx context.Context,
+	r artifact.SyncArtifactVersionsRequestObject,
+) (artifact.SyncArtifactVersionsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return syncArtifactVersions400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return syncArtifactVersions400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.SyncArtifactVersions403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	var principalID int64
+	if session != nil {
+		principalID = session.Principal.ID
+	}
+	if !c.syncLimiter.allow(principalID) {
+		return artifact.SyncArtifactVersions429JSONResponse{
+			TooManyRequestsJSONResponse: artifact.TooManyRequestsJSONResponse(
+				*GetErrorResponse(http.StatusTooManyRequests, "too many sync requests, retry later"),
+			),
+		}, nil
+	}
+
+	var cursor string
+	if r.Params.Cursor != nil {
+		cursor = string(*r.Params.Cursor)
+	}
+	lastArtifactID, err := decodeSyncCursor(cursor)
+	if err != nil {
+		return syncArtifactVersions400Error(err), nil
+	}
+	size := GetPageLimit(r.Params.Size)
+	if size < 1 || size > maxSyncPageSize {
+		return syncArtifactVersions400Error(
+			fmt.Errorf("size must be between 1 and %d", maxSyncPageSize)), nil
+	}
+
+	image := string(r.Artifact)
+	if _, err = c.ImageStore.GetByName(ctx, regInfo.RegistryID, image); err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return artifact.SyncArtifactVersions404JSONResponse{
+				NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+					*GetErrorResponse(http.StatusNotFound, "artifact doesn't exist with this key"),
+				),
+			}, nil
+		}
+		return syncArtifactVersions500Error(err), nil
+	}
+
+	versions, err := c.ArtifactStore.GetArtifactsByRepoAndImageBatch(ctx, regInfo.RegistryID, image, size,
+		lastArtifactID)
+	if err != nil {
+		return syncArtifactVersions500Error(err), nil
+	}
+
+	page, err := getArtifactVersionSyncPage(*versions, size, lastArtifactID)
+	if err != nil {
+		return syncArtifactVersions500Error(err), nil
+	}
+	return artifact.SyncArtifactVersions200JSONResponse{
+		ArtifactVersionSyncResponseJSONResponse: artifact.ArtifactVersionSyncResponseJSONResponse{
+			Data:   *page,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func getArtifactVersionSyncPage(
+	versions []types.ArtifactMetadata,
+	size int,
+	lastArtifactID int64,
+) (*artifact.ArtifactVersionSyncPage, error) {
+	entries := make([]artifact.ArtifactVersionSyncEntry, 0, len(versions))
+	for _, v := range versions {
+		createdAt := GetTimeInMs(v.CreatedAt)
+		modifiedAt := GetTimeInMs(v.ModifiedAt)
+		entry := artifact.ArtifactVersionSyncEntry{
+			Uuid:       v.UUID,
+			Version:    v.Version,
+			CreatedAt:  &createdAt,
+			ModifiedAt: &modifiedAt,
+		}
+		if len(v.Metadata) > 0 {
+			metadata := map[string]interface{}{}
+			if err := json.Unmarshal(v.Metadata, &metadata); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal metadata of version %s: %w", v.Version, err)
+			}
+			entry.Metadata = &metadata
+		}
+		entries = append(entries, entry)
+		lastArtifactID = v.ID
+	}
+
+	return &artifact.ArtifactVersionSyncPage{
+		Versions:   entries,
+		NextCursor: encodeSyncCursor(lastArtifactID),
+		HasMore:    len(versions) == size,
+	}, nil
+}
+
+// encodeSyncCursor encodes the id of the last synced version. Version ids only grow, so the cursor stays valid
+// and fetches the versions pushed after it.
+func encodeSyncCursor(lastArtifactID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(syncCursorPrefix + strconv.FormatInt(lastArtifactID, 10)))
+}
+
+func decodeSyncCursor(cursor string) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errInvalidSyncCursor
+	}
+	id, ok := strings.CutPrefix(string(raw), syncCursorPrefix)
+	if !ok {
+		return 0, errInvalidSyncCursor
+	}
+	lastArtifactID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || lastArtifactID < 0 {
+		return 0, errInvalidSyncCursor
+	}
+	return lastArtifactID, nil
+}
+
+// principalRateLimiter limits the requests of every principal.
+// NOTE: the limiters are kept in memory, so they apply per instance.
+type principalRateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mx       sync.Mutex
+	limiters map[int64]*principalLimiter
+}
+
+type principalLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// principalLimiterTTL is how long the limiter of an idle principal is kept.
+const principalLimiterTTL = 10 * time.Minute
+
+func newPrincipalRateLimiter(limit rate.Limit, burst int) *principalRateLimiter {
+	return &principalRateLimiter{
+		limit:    limit,
+		burst:    burst,
+		limiters: make(map[int64]*principalLimiter),
+	}
+}
+
+func (l *principalRateLimiter) allow(principalID int64) bool {
+	if l == nil {
+		return true
+	}
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	now := time.Now()
+	for id, pl := range l.limiters {
+		if now.Sub(pl.lastSeen) > principalLimiterTTL {
+			delete(l.limiters, id)
+		}
+	}
+
+	pl, ok := l.limiters[principalID]
+	if !ok {
+		pl = &principalLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.limiters[principalID] = pl
+	}
+	pl.lastSeen = now
+	return pl.limiter.AllowN(now, 1)
+}
+
+func syncArtifactVersions400Error(err error) artifact.SyncArtifactVersionsResponseObject {
+	return artifact.SyncArtifactVersions400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func syncArtifactVersions500Error(err error) artifact.SyncArtifactVersionsResponseObject {
+	return artifact.SyncArtifactVersions500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCursor(t *testing.T) {
+	id, err := decodeSyncCursor("")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), id)
+
+	id, err = decodeSyncCursor(encodeSyncCursor(42))
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), id)
+
+	for _, cursor := range []string{"not base64!", "NDI", encodeSyncCursor(-1)} {
+		_, err = decodeSyncCursor(cursor)
+		assert.ErrorIs(t, err, errInvalidSyncCursor, cursor)
+	}
+}
+
+func TestGetArtifactVersionSyncPage(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	versions := []types.ArtifactMetadata{
+		{ID: 7, UUID: "a", Version: "1.0.0", CreatedAt: now, ModifiedAt: now,
+			Metadata: json.RawMessage(`{"size":10}`)},
+		{ID: 9, UUID: "b", Version: "1.1.0", CreatedAt: now, ModifiedAt: now},
+	}
+
+	page, err := getArtifactVersionSyncPage(versions, 2, 3)
+	require.NoError(t, err)
+	assert.True(t, page.HasMore)
+	assert.Len(t, page.Versions, 2)
+	assert.Equal(t, "1700000000000", *page.Versions[0].CreatedAt)
+	assert.InDelta(t, 10, (*page.Versions[0].Metadata)["size"], 0)
+	assert.Nil(t, page.Versions[1].Metadata)
+	assert.Equal(t, encodeSyncCursor(9), page.NextCursor)
+
+	// an empty page keeps the cursor it was fetched with.
+	page, err = getArtifactVersionSyncPage(nil, 2, 9)
+	require.NoError(t, err)
+	assert.False(t, page.HasMore)
+	assert.Empty(t, page.Versions)
+	assert.Equal(t, encodeSyncCursor(9), page.NextCursor)
+}
+
+func TestPrincipalRateLimiter(t *testing.T) {
+	l := newPrincipalRateLimiter(0, 2)
+	assert.True(t, l.allow(1))
+	assert.True(t, l.allow(1))
+	assert.False(t, l.allow(1))
+	assert.True(t, l.allow(2), "principals must not share their limit")
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrywebhook "github.com/harness/gitness/registry/services/webhook"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+func (c *APIController) TestWebhook(
+	ctx context.Context,
+	r api.TestWebhookRequestObject,
+) (api.TestWebhookResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return getTestWebhookInternalErrorResponse(err)
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return getTestWebhookInternalErrorResponse(err)
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		log.Ctx(ctx).Error().Msgf("permission check failed while testing webhook for registry: %s, error: %v",
+			regInfo.RegistryIdentifier, err)
+		return api.TestWebhook403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	if r.Body == nil {
+		return getTestWebhookBadRequestResponse(errors.New("trigger is required"))
+	}
+	triggers, err := c.RegistryMetadataHelper.MapToInternalWebhookTriggers([]api.Trigger{r.Body.Trigger})
+	if err != nil {
+		return getTestWebhookBadRequestResponse(err)
+	}
+
+	webhookIdentifier := string(r.WebhookIdentifier)
+	webhook, err := c.WebhooksRepository.GetByRegistryAndIdentifier(ctx, regInfo.RegistryID, webhookIdentifier)
+	if err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return api.TestWebhook404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse(
+					*GetErrorResponse(http.StatusNotFound, fmt.Sprintf("webhook '%s' not found", webhookIdentifier)),
+				),
+			}, nil
+		}
+		log.Ctx(ctx).Error().Msgf("failed to get webhook: %s with error: %v", webhookIdentifier, err)
+		return getTestWebhookInternalErrorResponse(fmt.Errorf("failed to get webhook"))
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return getTestWebhookInternalErrorResponse(fmt.Errorf("failed to get registry: %w", err))
+	}
+
+	result, err := c.WebhookService.TestWebhook(ctx, webhook, registry, &session.Principal, triggers[0])
+	if err != nil {
+		if errors.Is(err, registrywebhook.ErrTestTriggerNotSupported) {
+			return getTestWebhookBadRequestResponse(err)
+		}
+		return getTestWebhookInternalErrorResponse(fmt.Errorf("failed to test webhook: %w", err))
+	}
+	if result.Execution == nil {
+		return getTestWebhookInternalErrorResponse(fmt.Errorf("failed to test webhook: %w", result.Err))
+	}
+
+	// failures of the delivery itself are reported as part of the execution
+	webhookExecution, err := MapToWebhookExecutionResponseEntity(*result.Execution)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf(getWebhookErrMsg, regInfo.RegistryRef, r.WebhookIdentifier, err)
+		return getTestWebhookInternalErrorResponse(err)
+	}
+	return api.TestWebhook200JSONResponse{
+		WebhookExecutionResponseJSONResponse: api.WebhookExecutionResponseJSONResponse{
+			Data:   *webhookExecution,
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+//nolint:unparam
+func getTestWebhookBadRequestResponse(err error) (api.TestWebhookResponseObject, error) {
+	return api.TestWebhook400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}, nil
+}
+
+//nolint:unparam
+func getTestWebhookInternalErrorResponse(err error) (api.TestWebhookResponseObject, error) {
+	return api.TestWebhook500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}, nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll
+package metadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	gitnesswebhook "github.com/harness/gitness/app/services/webhook"
+	"github.com/harness/gitness/registry/app/api/controller/metadata"
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrywebhook "github.com/harness/gitness/registry/services/webhook"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	coretypes "github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTestWebhook(t *testing.T) {
+	principal := coretypes.Principal{ID: 3, UID: "user", Type: enum.PrincipalTypeUser}
+	ctx := request.WithAuthSession(context.Background(), &auth.Session{Principal: principal})
+
+	regInfo := &types.RegistryRequestBaseInfo{
+		RegistryID:         1,
+		RegistryIdentifier: "reg",
+		ParentRef:          "root/parent",
+	}
+	space := &coretypes.SpaceCore{ID: 2}
+	registry := &types.Registry{ID: 1, Name: "reg", ParentID: 2, RootParentID: 2}
+	webhook := &coretypes.WebhookCore{ID: 4, Identifier: "webhook", URL: "http://example.com"}
+
+	tests := []struct {
+		name         string
+		trigger      api.Trigger
+		setupMocks   func(*mocks.RegistryMetadataHelper, *mocks.WebhooksRepository, *mocks.RegistryRepository, *mocks.WebhookService)
+		expectedCode int
+	}{
+		{
+			name:    "success_case",
+			trigger: api.TriggerARTIFACTCREATION,
+			setupMocks: func(
+				helper *mocks.RegistryMetadataHelper,
+				webhooks *mocks.WebhooksRepository,
+				registries *mocks.RegistryRepository,
+				service *mocks.WebhookService,
+			) {
+				helper.On("MapToInternalWebhookTriggers", []api.Trigger{api.TriggerARTIFACTCREATION}).
+					Return([]enum.WebhookTrigger{enum.WebhookTriggerArtifactCreated}, nil)
+				webhooks.On("GetByRegistryAndIdentifier", mock.Anything, int64(1), "webhook").Return(webhook, nil)
+				registries.On("Get", mock.Anything, int64(1)).Return(registry, nil)
+				service.On("TestWebhook", mock.Anything, webhook, registry, &principal, enum.WebhookTriggerArtifactCreated).
+					Return(&gitnesswebhook.TriggerResult{
+						Webhook:     webhook,
+						Execution:   webhookExecution,
+						TriggerType: enum.WebhookTriggerArtifactCreated,
+					}, nil)
+			},
+			expectedCode: 200,
+		},
+		{
+			name:    "webhook_not_found",
+			trigger: api.TriggerARTIFACTDELETION,
+			setupMocks: func(
+				helper *mocks.RegistryMetadataHelper,
+				webhooks *mocks.WebhooksRepository,
+				_ *mocks.RegistryRepository,
+				_ *mocks.WebhookService,
+			) {
+				helper.On("MapToInternalWebhookTriggers", []api.Trigger{api.TriggerARTIFACTDELETION}).
+					Return([]enum.WebhookTrigger{enum.WebhookTriggerArtifactDeleted}, nil)
+				webhooks.On("GetByRegistryAndIdentifier", mock.Anything, int64(1), "webhook").
+					Return(nil, store.ErrResourceNotFound)
+			},
+			expectedCode: 404,
+		},
+		{
+			name:    "unsupported_trigger",
+			trigger: api.TriggerREGISTRYQUOTATHRESHOLD,
+			setupMocks: func(
+				helper *mocks.RegistryMetadataHelper,
+				webhooks *mocks.WebhooksRepository,
+				registries *mocks.RegistryRepository,
+				service *mocks.WebhookService,
+			) {
+				helper.On("MapToInternalWebhookTriggers", []api.Trigger{api.TriggerREGISTRYQUOTATHRESHOLD}).
+					Return([]enum.WebhookTrigger{enum.WebhookTriggerRegistryQuotaThreshold}, nil)
+				webhooks.On("GetByRegistryAndIdentifier", mock.Anything, int64(1), "webhook").Return(webhook, nil)
+				registries.On("Get", mock.Anything, int64(1)).Return(registry, nil)
+				service.On("TestWebhook", mock.Anything, webhook, registry, &principal, enum.WebhookTriggerRegistryQuotaThreshold).
+					Return(nil, registrywebhook.ErrTestTriggerNotSupported)
+			},
+			expectedCode: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSpaceFinder := new(mocks.SpaceFinder)
+			mockRegistryRepository := new(mocks.RegistryRepository)
+			mockWebhooksRepository := new(mocks.WebhooksRepository)
+			mockAuthorizer := new(mocks.Authorizer)
+			mockRegistryMetadataHelper := new(mocks.RegistryMetadataHelper)
+			mockWebhookService := new(mocks.WebhookService)
+
+			var permissionChecks []coretypes.PermissionCheck
+			mockRegistryMetadataHelper.On("GetRegistryRequestBaseInfo", mock.Anything, "", "reg").Return(regInfo, nil)
+			mockSpaceFinder.On("FindByRef", mock.Anything, "root/parent").Return(space, nil)
+			mockRegistryMetadataHelper.On("GetPermissionChecks", space, regInfo.RegistryIdentifier, enum.PermissionRegistryEdit).Return(permissionChecks)
+			mockAuthorizer.On("CheckAll", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+			tt.setupMocks(mockRegistryMetadataHelper, mockWebhooksRepository, mockRegistryRepository, mockWebhookService)
+
+			controller := &metadata.APIController{
+				SpaceFinder:            mockSpaceFinder,
+				RegistryRepository:     mockRegistryRepository,
+				WebhooksRepository:     mockWebhooksRepository,
+				Authorizer:             mockAuthorizer,
+				RegistryMetadataHelper: mockRegistryMetadataHelper,
+				WebhookService:         mockWebhookService,
+			}
+
+			resp, err := controller.TestWebhook(ctx, api.TestWebhookRequestObject{
+				RegistryRef:       "reg",
+				WebhookIdentifier: "webhook",
+				Body:              &api.TestWebhookJSONRequestBody{Trigger: tt.trigger},
+			})
+			assert.NoError(t, err)
+
+			switch tt.expectedCode {
+			case 200:
+				successResp, ok := resp.(api.TestWebhook200JSONResponse)
+				if assert.True(t, ok, "expected 200 response") {
+					assert.Equal(t, api.StatusSUCCESS, successResp.Status)
+					assert.Equal(t, *webhookExecutionEntity.Request, *successResp.Data.Request)
+					assert.Equal(t, *webhookExecutionEntity.Response, *successResp.Data.Response)
+				}
+			case 400:
+				errorResp, ok := resp.(api.TestWebhook400JSONResponse)
+				if assert.True(t, ok, "expected 400 response") {
+					assert.Equal(t, registrywebhook.ErrTestTriggerNotSupported.Error(), errorResp.Message)
+				}
+			case 404:
+				assert.IsType(t, api.TestWebhook404JSONResponse{}, resp, "expected 404 response")
+			}
+
+			mockSpaceFinder.AssertExpectations(t)
+			mockRegistryRepository.AssertExpectations(t)
+			mockWebhooksRepository.AssertExpectations(t)
+			mockRegistryMetadataHelper.AssertExpectations(t)
+			mockWebhookService.AssertExpectations(t)
+		})
+	}
+}
@@ -44,6 +44,8 @@ func (c *APIController) UpdateArtifactLabels(
 	}
 	regInfo, _ := c.GetRegistryRequestInfo(ctx, *registryRequestParams)
 
+	r.Artifact, _ = c.resolveArtifactRef(ctx, regInfo.RegistryID, r.Artifact, "")
+
 	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
 	if err != nil {
 		return artifact.UpdateArtifactLabels400JSONResponse{
@@ -0,0 +1,163 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// maxArtifactDescriptionLength limits the size of the markdown description of an artifact, in bytes.
+const maxArtifactDescriptionLength = 64 * 1024
+
+// UpdateArtifactDescription replaces the markdown description of an artifact. Every change is kept as a new
+// revision, an unchanged description isn't recorded again.
+func (c *APIController) UpdateArtifactDescription(
+	ctx context.Context,
+	r api.UpdateArtifactDescriptionRequestObject,
+) (api.UpdateArtifactDescriptionResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return updateArtifactDescription400Error(err.Error()), nil
+	}
+
+	r.Artifact, _ = c.resolveArtifactRef(ctx, regInfo.RegistryID, r.Artifact, "")
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return updateArtifactDescription400Error(err.Error()), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.UpdateArtifactDescription401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.UpdateArtifactDescription403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	if r.Body == nil {
+		return updateArtifactDescription400Error("request body is required"), nil
+	}
+	if len(r.Body.Description) > maxArtifactDescriptionLength {
+		return updateArtifactDescription400Error(
+			fmt.Sprintf("description can't be longer than %d bytes", maxArtifactDescriptionLength)), nil
+	}
+
+	var artifactType *api.ArtifactType
+	if r.Params.ArtifactType != nil {
+		artifactType, err = ValidateAndGetArtifactType(regInfo.PackageType, string(*r.Params.ArtifactType))
+		if err != nil {
+			return updateArtifactDescription400Error(err.Error()), nil
+		}
+	}
+
+	image := string(r.Artifact)
+	img, err := c.ImageStore.GetByNameAndType(ctx, regInfo.RegistryID, image, artifactType)
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return api.UpdateArtifactDescription404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "artifact doesn't exist with this name"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return updateArtifactDescription500Error(err), nil
+	}
+
+	latest, err := c.ImageDescriptionRepository.GetLatest(ctx, img.ID)
+	if err != nil && !errors.Is(err, store2.ErrResourceNotFound) {
+		return updateArtifactDescription500Error(fmt.Errorf("failed to get artifact description: %w", err)), nil
+	}
+	if latest != nil && latest.Content == r.Body.Description {
+		return updateArtifactDescription200Response(latest), nil
+	}
+
+	description := &types.ImageDescription{
+		ImageID:   img.ID,
+		Content:   r.Body.Description,
+		CreatedBy: session.Principal.ID,
+		CreatedAt: time.Now(),
+	}
+	if err = c.ImageDescriptionRepository.Create(ctx, description); err != nil {
+		log.Ctx(ctx).Error().Msgf("failed to update description of artifact: %s with error: %v", image, err)
+		return updateArtifactDescription500Error(fmt.Errorf("failed to update artifact description: %w", err)), nil
+	}
+	return updateArtifactDescription200Response(description), nil
+}
+
+func mapToAPIArtifactDescription(description *types.ImageDescription) api.ArtifactDescription {
+	return api.ArtifactDescription{
+		Revision:    description.Revision,
+		Description: description.Content,
+		ChangedBy:   description.CreatedBy,
+		ChangedAt:   GetTimeInMs(description.CreatedAt),
+	}
+}
+
+func updateArtifactDescription200Response(
+	description *types.ImageDescription,
+) api.UpdateArtifactDescription200JSONResponse {
+	return api.UpdateArtifactDescription200JSONResponse{
+		ArtifactDescriptionResponseJSONResponse: api.ArtifactDescriptionResponseJSONResponse{
+			Data:   mapToAPIArtifactDescription(description),
+			Status: api.StatusSUCCESS,
+		},
+	}
+}
+
+func updateArtifactDescription400Error(message string) api.UpdateArtifactDescriptionResponseObject {
+	return api.UpdateArtifactDescription400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, message),
+		),
+	}
+}
+
+func updateArtifactDescription500Error(err error) api.UpdateArtifactDescriptionResponseObject {
+	return api.UpdateArtifactDescription500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/metadata"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+func (c *APIController) UpdateArtifactScanStatus(
+	ctx context.Context,
+	r artifact.UpdateArtifactScanStatusRequestObject,
+) (artifact.UpdateArtifactScanStatusResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return updateArtifactScanStatus400Error(err), nil
+	}
+
+	r.Artifact, r.Version = c.resolveArtifactRef(ctx, regInfo.RegistryID, r.Artifact, r.Version)
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return updateArtifactScanStatus400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.UpdateArtifactScanStatus403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	if !isOCIPackageType(regInfo.PackageType) {
+		return updateArtifactScanStatus400Error(errScanNotSupported), nil
+	}
+	if r.Body == nil {
+		return updateArtifactScanStatus400Error(errors.New("scan outcome is required")), nil
+	}
+	var outcome metadata.ScanOutcome
+	switch r.Body.Outcome {
+	case artifact.ArtifactScanOutcomePASSED:
+		outcome = metadata.ScanOutcomePassed
+	case artifact.ArtifactScanOutcomeFAILED:
+		outcome = metadata.ScanOutcomeFailed
+	case artifact.ArtifactScanOutcomeERROR:
+		outcome = metadata.ScanOutcomeError
+	case artifact.ArtifactScanOutcomePENDING, artifact.ArtifactScanOutcomeNOTSCANNED:
+		return updateArtifactScanStatus400Error(
+			fmt.Errorf("invalid scan outcome %s, expected one of PASSED, FAILED or ERROR", r.Body.Outcome)), nil
+	default:
+		return updateArtifactScanStatus400Error(fmt.Errorf("unknown scan outcome %s", r.Body.Outcome)), nil
+	}
+
+	art, err := c.getOCIArtifact(ctx, regInfo, string(r.Artifact), string(r.Version))
+	if err != nil {
+		if errors.Is(err, store2.ErrResourceNotFound) {
+			return artifact.UpdateArtifactScanStatus404JSONResponse{
+				NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+					*GetErrorResponse(http.StatusNotFound, err.Error()),
+				),
+			}, nil
+		}
+		return updateArtifactScanStatus500Error(err), nil
+	}
+	status, err := metadata.GetScanStatus(art.Metadata)
+	if err != nil {
+		return updateArtifactScanStatus500Error(err), nil
+	}
+	status.Outcome = outcome
+	status.LastScannedAt = time.Now().UnixMilli()
+	rawMetadata, err := metadata.SetScanStatus(art.Metadata, status)
+	if err != nil {
+		return updateArtifactScanStatus500Error(err), nil
+	}
+	if err = c.ArtifactStore.UpdateArtifactMetadata(ctx, rawMetadata, art.ID); err != nil {
+		return updateArtifactScanStatus500Error(err), nil
+	}
+
+	return artifact.UpdateArtifactScanStatus200JSONResponse{
+		ArtifactScanStatusResponseJSONResponse: artifact.ArtifactScanStatusResponseJSONResponse{
+			Data:   GetArtifactScanStatus(art.Version, status),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func updateArtifactScanStatus400Error(err error) artifact.UpdateArtifactScanStatusResponseObject {
+	return artifact.UpdateArtifactScanStatus400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func updateArtifactScanStatus500Error(err error) artifact.UpdateArtifactScanStatusResponseObject {
+	return artifact.UpdateArtifactScanStatus500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
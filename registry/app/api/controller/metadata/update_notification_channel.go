@@ -0,0 +1,127 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// UpdateNotificationChannel replaces the configuration of a notification channel. The URL of a Slack or
+// MS Teams channel is kept when the request doesn't set one, as it's never returned by the API.
+func (c *APIController) UpdateNotificationChannel(
+	ctx context.Context,
+	r api.UpdateNotificationChannelRequestObject,
+) (api.UpdateNotificationChannelResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return updateNotificationChannel400Error(err.Error())
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return updateNotificationChannel400Error(err.Error())
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		log.Ctx(ctx).Error().Msgf("permission check failed while updating notification channel for registry: %s, "+
+			"error: %v", regInfo.RegistryIdentifier, err)
+		return api.UpdateNotificationChannel403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	if r.Body == nil {
+		return updateNotificationChannel400Error("request body is required")
+	}
+	identifier := string(r.ChannelIdentifier)
+	req := api.NotificationChannelRequest(*r.Body)
+	if req.Identifier != "" && req.Identifier != identifier {
+		return updateNotificationChannel400Error("identifier of a notification channel can't be changed")
+	}
+	req.Identifier = identifier
+
+	channel, err := c.NotificationChannelRepository.GetByRegistryAndIdentifier(ctx, regInfo.RegistryID, identifier)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return updateNotificationChannel404Error(identifier)
+	}
+	if err != nil {
+		return updateNotificationChannelInternalErrorResponse(err)
+	}
+	if err = c.applyNotificationChannelRequest(channel, req); err != nil {
+		return updateNotificationChannel400Error(err.Error())
+	}
+	channel.UpdatedAt = time.Now()
+	channel.UpdatedBy = session.Principal.ID
+
+	err = c.NotificationChannelRepository.Update(ctx, channel)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return updateNotificationChannel404Error(identifier)
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msgf("failed to update notification channel: %s", identifier)
+		return updateNotificationChannelInternalErrorResponse(err)
+	}
+
+	return api.UpdateNotificationChannel200JSONResponse{
+		NotificationChannelResponseJSONResponse: api.NotificationChannelResponseJSONResponse{
+			Data:   toAPINotificationChannel(channel),
+			Status: api.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func updateNotificationChannel400Error(message string) (api.UpdateNotificationChannelResponseObject, error) {
+	return api.UpdateNotificationChannel400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, message),
+		),
+	}, nil
+}
+
+func updateNotificationChannel404Error(identifier string) (api.UpdateNotificationChannelResponseObject, error) {
+	return api.UpdateNotificationChannel404JSONResponse{
+		NotFoundJSONResponse: api.NotFoundJSONResponse(
+			*GetErrorResponse(http.StatusNotFound, "notification channel '"+identifier+"' not found"),
+		),
+	}, nil
+}
+
+func updateNotificationChannelInternalErrorResponse(err error) (api.UpdateNotificationChannelResponseObject, error) {
+	return api.UpdateNotificationChannel500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}, nil
+}
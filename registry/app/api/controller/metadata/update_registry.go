@@ -28,6 +28,7 @@ import (
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/firewalldelay"
 	"github.com/harness/gitness/registry/types"
 	types2 "github.com/harness/gitness/types"
 	gitnessenum "github.com/harness/gitness/types/enum"
@@ -87,6 +88,19 @@ func (c *APIController) ModifyRegistry(
 		return throwModifyRegistry500Error(err), err
 	}
 
+	// only principals which are allowed to delete the registry may toggle its deletion protection
+	if r.Body.DeletionProtection != nil && *r.Body.DeletionProtection != repoEntity.IsDeletionProtected() {
+		permissionChecks = c.RegistryMetadataHelper.GetPermissionChecks(space,
+			regInfo.RegistryIdentifier, gitnessenum.PermissionRegistryDelete)
+		if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+			return artifact.ModifyRegistry403JSONResponse{
+				UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+					*GetErrorResponse(http.StatusForbidden, err.Error()),
+				),
+			}, nil
+		}
+	}
+
 	if string(repoEntity.Type) == string(artifact.RegistryTypeVIRTUAL) {
 		return c.updateVirtualRegistry(ctx, r, repoEntity, err, regInfo, session, space)
 	}
@@ -113,6 +127,7 @@ func (c *APIController) ModifyRegistry(
 		//nolint:nilerr
 		return throwModifyRegistry400Error(err), nil
 	}
+	setDeletionProtection(registry, repoEntity, artifact.RegistryRequest(*r.Body))
 	registry.ID = repoEntity.ID
 	upstreamproxy.ID = upstreamproxyEntity.ID
 	upstreamproxy.RegistryID = repoEntity.ID
@@ -197,6 +212,32 @@ func (c *APIController) updateVirtualRegistry(
 	if err != nil {
 		return throwModifyRegistry500Error(err), nil
 	}
+	if repoEntity.Config != nil {
+		config := *repoEntity.Config
+		registry.Config = &config
+	}
+	if err = c.setRpmSigningConfig(ctx, registry, artifact.RegistryRequest(*r.Body)); err != nil {
+		return throwModifyRegistry400Error(err), nil
+	}
+	if err = setHelmProvenanceConfig(registry, artifact.RegistryRequest(*r.Body)); err != nil {
+		return throwModifyRegistry400Error(err), nil
+	}
+	if err = setValidationRules(registry, artifact.RegistryRequest(*r.Body)); err != nil {
+		return throwModifyRegistry400Error(err), nil
+	}
+	if err = setQuotaConfig(registry, artifact.RegistryRequest(*r.Body)); err != nil {
+		return throwModifyRegistry400Error(err), nil
+	}
+	if err = setRegistryPolicy(registry, artifact.RegistryRequest(*r.Body)); err != nil {
+		return throwModifyRegistry400Error(err), nil
+	}
+	if err = setDownloadRateLimit(registry, artifact.RegistryRequest(*r.Body)); err != nil {
+		return throwModifyRegistry400Error(err), nil
+	}
+	if err = setListPreferences(registry, artifact.RegistryRequest(*r.Body)); err != nil {
+		return throwModifyRegistry400Error(err), nil
+	}
+	setDeletionProtection(registry, repoEntity, artifact.RegistryRequest(*r.Body))
 	if registry.PackageType == artifact.PackageTypeRPM {
 		c.PostProcessingReporter.BuildRegistryIndex(ctx, registry.ID, make([]types.SourceRef, 0))
 	} else {
@@ -259,6 +300,7 @@ func (c *APIController) updateUpstreamProxyWithAudit(
 			audit.NewResource(audit.ResourceTypeRegistryUpstreamProxy, registryName),
 			audit.ActionUpdated,
 			parentRef,
+			audit.WithActorChain(audit.ActorChain(ctx, principal)),
 			audit.WithOldObject(
 				audit.RegistryUpstreamProxyConfigObject{
 					ID:         existingUpstreamProxy.ID,
@@ -315,6 +357,7 @@ func (c *APIController) updateRegistryWithAudit(
 		audit.NewResource(audit.ResourceTypeRegistry, newRegistry.Name),
 		audit.ActionUpdated,
 		parentRef,
+		audit.WithActorChain(audit.ActorChain(ctx, principal)),
 		audit.WithOldObject(oldRegistry),
 		audit.WithNewObject(newRegistry),
 	)
@@ -322,9 +365,40 @@ func (c *APIController) updateRegistryWithAudit(
 		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for update registry operation: %s", auditErr)
 	}
 
+	if oldRegistry.IsDeletionProtected() != newRegistry.IsDeletionProtected() {
+		c.auditDeletionProtection(ctx, newRegistry, principal, parentRef)
+	}
+
 	return err
 }
 
+// auditDeletionProtection records the toggle of the deletion protection of a registry separately, so it can
+// be found without comparing the old and new registry objects.
+func (c *APIController) auditDeletionProtection(
+	ctx context.Context, registry *types.Registry, principal types2.Principal, parentRef string,
+) {
+	state := "disabled"
+	if registry.IsDeletionProtected() {
+		state = "enabled"
+	}
+	resourceType := audit.ResourceTypeRegistry
+	if registry.Type == artifact.RegistryTypeUPSTREAM {
+		resourceType = audit.ResourceTypeRegistryUpstreamProxy
+	}
+	auditErr := c.AuditService.Log(
+		ctx,
+		principal,
+		audit.NewResource(resourceType, registry.Name),
+		audit.ActionUpdated,
+		parentRef,
+		audit.WithActorChain(audit.ActorChain(ctx, principal)),
+		audit.WithData("deletion protection", state),
+	)
+	if auditErr != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for deletion protection toggle: %s", auditErr)
+	}
+}
+
 func (c *APIController) updatePublicAccess(
 	ctx context.Context,
 	parentRef string,
@@ -521,6 +595,14 @@ func (c *APIController) UpdateUpstreamProxyEntity(
 	if config.UpstreamProxyConfigFirewallMode != nil {
 		upstreamProxyConfigEntity.FirewallMode = string(*config.UpstreamProxyConfigFirewallMode)
 	}
+	upstreamProxyConfigEntity.FirewallDelayHours = u.FirewallDelayHours
+	if config.UpstreamProxyConfigFirewallDelayHours != nil {
+		hours := *config.UpstreamProxyConfigFirewallDelayHours
+		if err := firewalldelay.ValidateDelay(u.PackageType, hours); err != nil {
+			return nil, nil, usererror.BadRequest(err.Error())
+		}
+		upstreamProxyConfigEntity.FirewallDelayHours = hours
+	}
 	if config.Url != nil {
 		upstreamProxyConfigEntity.URL = *config.Url
 	}
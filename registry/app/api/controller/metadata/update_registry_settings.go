@@ -0,0 +1,145 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/services/settings"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/services/registrypolicy"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+var errEmptyRegistrySettings = errors.New("at least one setting is required")
+
+func (c *APIController) UpdateRegistrySettings(
+	ctx context.Context,
+	r artifact.UpdateRegistrySettingsRequestObject,
+) (artifact.UpdateRegistrySettingsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return updateRegistrySettings400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return updateRegistrySettings400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.UpdateRegistrySettings403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	if r.Body == nil || len(*r.Body) == 0 {
+		return updateRegistrySettings400Error(errEmptyRegistrySettings), nil
+	}
+	values, err := toRegistrySettings(artifact.RegistrySettingsRequest(*r.Body))
+	if err != nil {
+		return updateRegistrySettings400Error(err), nil
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return updateRegistrySettings500Error(err), nil
+	}
+	oldSettings, err := c.RegistryPolicyService.GetRegistrySettings(ctx, registry.ID)
+	if err != nil {
+		return updateRegistrySettings500Error(err), nil
+	}
+	if err = c.RegistryPolicyService.UpdateRegistrySettings(ctx, registry.ID, values); err != nil {
+		return updateRegistrySettings500Error(err), nil
+	}
+	newSettings, err := c.RegistryPolicyService.GetRegistrySettings(ctx, registry.ID)
+	if err != nil {
+		return updateRegistrySettings500Error(err), nil
+	}
+
+	auditErr := c.AuditService.Log(
+		ctx,
+		session.Principal,
+		audit.NewResource(audit.ResourceTypeRegistrySettings, registry.Name),
+		audit.ActionUpdated,
+		regInfo.ParentRef,
+		audit.WithActorChain(audit.ActorChain(ctx, session.Principal)),
+		audit.WithOldObject(oldSettings),
+		audit.WithNewObject(newSettings),
+	)
+	if auditErr != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for update registry settings operation: %s", auditErr)
+	}
+
+	registrySettings, err := c.RegistryPolicyService.EffectiveSettings(ctx, registry)
+	if err != nil {
+		return updateRegistrySettings500Error(err), nil
+	}
+
+	return artifact.UpdateRegistrySettings200JSONResponse{
+		RegistrySettingsResponseJSONResponse: artifact.RegistrySettingsResponseJSONResponse{
+			Data:   GetRegistrySettings(registrySettings),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// toRegistrySettings decodes and validates the settings of the request against the registry settings schema.
+func toRegistrySettings(body artifact.RegistrySettingsRequest) ([]settings.KeyValue, error) {
+	raw := make(map[settings.Key]json.RawMessage, len(body))
+	for key, value := range body {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of setting %q: %w", key, err)
+		}
+		raw[settings.Key(key)] = data
+	}
+	return registrypolicy.Schema.Decode(raw)
+}
+
+func updateRegistrySettings400Error(err error) artifact.UpdateRegistrySettingsResponseObject {
+	return artifact.UpdateRegistrySettings400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func updateRegistrySettings500Error(err error) artifact.UpdateRegistrySettingsResponseObject {
+	return artifact.UpdateRegistrySettings500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+var errEmptyRegistryPolicy = errors.New("the registry policy is required")
+
+func (c *APIController) UpdateSpaceRegistryPolicy(
+	ctx context.Context,
+	r artifact.UpdateSpaceRegistryPolicyRequestObject,
+) (artifact.UpdateSpaceRegistryPolicyResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return updateSpaceRegistryPolicy400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return updateSpaceRegistryPolicy400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryEdit,
+	); err != nil {
+		return artifact.UpdateSpaceRegistryPolicy403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	if r.Body == nil {
+		return updateSpaceRegistryPolicy400Error(errEmptyRegistryPolicy), nil
+	}
+	policy, err := toRegistryPolicy((*artifact.RegistryPolicy)(r.Body))
+	if err != nil {
+		return updateSpaceRegistryPolicy400Error(err), nil
+	}
+
+	oldPolicy, err := c.RegistryPolicyService.GetSpacePolicy(ctx, space.ID)
+	if err != nil {
+		return updateSpaceRegistryPolicy500Error(err), nil
+	}
+	if err = c.RegistryPolicyService.SetSpacePolicy(ctx, space.ID, policy); err != nil {
+		return updateSpaceRegistryPolicy500Error(err), nil
+	}
+
+	auditErr := c.AuditService.Log(
+		ctx,
+		session.Principal,
+		audit.NewResource(audit.ResourceTypeRegistryPolicy, space.Identifier),
+		audit.ActionUpdated,
+		space.Path,
+		audit.WithActorChain(audit.ActorChain(ctx, session.Principal)),
+		audit.WithOldObject(oldPolicy),
+		audit.WithNewObject(policy),
+	)
+	if auditErr != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for update registry policy operation: %s", auditErr)
+	}
+
+	return artifact.UpdateSpaceRegistryPolicy200JSONResponse{
+		RegistryPolicyResponseJSONResponse: artifact.RegistryPolicyResponseJSONResponse{
+			Data:   *fromRegistryPolicy(policy),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func updateSpaceRegistryPolicy400Error(err error) artifact.UpdateSpaceRegistryPolicyResponseObject {
+	return artifact.UpdateSpaceRegistryPolicy400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func updateSpaceRegistryPolicy500Error(err error) artifact.UpdateSpaceRegistryPolicyResponseObject {
+	return artifact.UpdateSpaceRegistryPolicy500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// UploadArtifactLogo stores the logo of an artifact via the file manager, replacing the existing logo.
+func (c *APIController) UploadArtifactLogo(
+	ctx context.Context,
+	r api.UploadArtifactLogoRequestObject,
+) (api.UploadArtifactLogoResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return uploadArtifactLogo400Error(err.Error()), nil
+	}
+
+	r.Artifact, _ = c.resolveArtifactRef(ctx, regInfo.RegistryID, r.Artifact, "")
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return uploadArtifactLogo400Error(err.Error()), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		statusCode, message := HandleAuthError(err)
+		if statusCode == http.StatusUnauthorized {
+			return api.UploadArtifactLogo401JSONResponse{
+				UnauthenticatedJSONResponse: api.UnauthenticatedJSONResponse(
+					*GetErrorResponse(http.StatusUnauthorized, message),
+				),
+			}, nil
+		}
+		return api.UploadArtifactLogo403JSONResponse{
+			UnauthorizedJSONResponse: api.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, message),
+			),
+		}, nil
+	}
+
+	var artifactType *api.ArtifactType
+	if r.Params.ArtifactType != nil {
+		artifactType, err = ValidateAndGetArtifactType(regInfo.PackageType, string(*r.Params.ArtifactType))
+		if err != nil {
+			return uploadArtifactLogo400Error(err.Error()), nil
+		}
+	}
+
+	if r.Body == nil {
+		return uploadArtifactLogo400Error("logo is required"), nil
+	}
+	content, err := io.ReadAll(io.LimitReader(r.Body, maxArtifactLogoSize+1))
+	if err != nil {
+		return uploadArtifactLogo400Error(fmt.Sprintf("failed to read logo: %v", err)), nil
+	}
+	if _, err = validateArtifactLogo(content); err != nil {
+		return uploadArtifactLogo400Error(err.Error()), nil
+	}
+
+	img, err := c.ImageStore.GetByNameAndType(ctx, regInfo.RegistryID, string(r.Artifact), artifactType)
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return api.UploadArtifactLogo404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "artifact doesn't exist with this name"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return uploadArtifactLogo500Error(err), nil
+	}
+
+	_, err = c.fileManager.UploadFile(ctx, getArtifactLogoPath(img), regInfo.RegistryID, regInfo.RootIdentifierID,
+		regInfo.RootIdentifier, nil, bytes.NewReader(content), session.Principal.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("failed to upload logo of artifact: %s with error: %v", img.Name, err)
+		return uploadArtifactLogo500Error(fmt.Errorf("failed to upload logo: %w", err)), nil
+	}
+
+	return api.UploadArtifactLogo200JSONResponse{
+		SuccessJSONResponse: api.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+func uploadArtifactLogo400Error(message string) api.UploadArtifactLogoResponseObject {
+	return api.UploadArtifactLogo400JSONResponse{
+		BadRequestJSONResponse: api.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, message),
+		),
+	}
+}
+
+func uploadArtifactLogo500Error(err error) api.UploadArtifactLogoResponseObject {
+	return api.UploadArtifactLogo500JSONResponse{
+		InternalServerErrorJSONResponse: api.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
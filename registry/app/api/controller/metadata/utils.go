@@ -34,6 +34,7 @@ import (
 	"github.com/harness/gitness/app/paths"
 	"github.com/harness/gitness/registry/app/api/interfaces"
 	a "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/capability"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 
 	"github.com/inhies/go-bytesize"
@@ -217,6 +218,18 @@ func GetErrorResponse(code int, message string) *a.Error {
 	}
 }
 
+// GetNotImplementedResponse returns the error for a capability which isn't supported for the package type of a
+// registry, its details name the package type and the capability.
+func GetNotImplementedResponse(err error) *a.Error {
+	resp := GetErrorResponse(http.StatusNotImplemented, err.Error())
+	var unsupported *capability.UnsupportedError
+	if errors.As(err, &unsupported) {
+		details := unsupported.Details()
+		resp.Details = &details
+	}
+	return resp
+}
+
 func GetSortByOrder(sortOrder string) string {
 	defaultSortOrder := "ASC"
 	decreasingSortOrder := "DESC"
@@ -380,6 +393,17 @@ func GetHelmPullCommand(image string, version string, registryURL string, byTag
 	return "helm pull oci://" + GetRepoURLWithoutProtocol(registryURL) + "/" + image + versionDelimiter + version
 }
 
+// IsExternalHelmDependency reports whether a Helm chart dependency is resolved from outside the registry.
+// Dependencies without a repository or with a file:// repository are vendored in the chart archive.
+func IsExternalHelmDependency(repository string, registryURL string) bool {
+	if repository == "" || strings.HasPrefix(repository, "file://") {
+		return false
+	}
+	registryRepo := strings.ToLower("oci://" + GetRepoURLWithoutProtocol(registryURL))
+	repository = strings.TrimSuffix(strings.ToLower(repository), "/")
+	return repository != registryRepo && !strings.HasPrefix(repository, registryRepo+"/")
+}
+
 func GetRPMDownloadCommand(artifact, version string) string {
 	downloadCommand := "yum install <ARTIFACT>-<VERSION>"
 
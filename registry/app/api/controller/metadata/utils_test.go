@@ -200,6 +200,53 @@ func TestGetHelmPullCommand(t *testing.T) {
 	}
 }
 
+func TestIsExternalHelmDependency(t *testing.T) {
+	registryURL := "https://example.com/root/helm-registry"
+	tests := []struct {
+		name       string
+		repository string
+		expected   bool
+	}{
+		{
+			name:       "vendored_subchart",
+			repository: "",
+			expected:   false,
+		},
+		{
+			name:       "local_path",
+			repository: "file://../common",
+			expected:   false,
+		},
+		{
+			name:       "same_registry",
+			repository: "oci://example.com/root/helm-registry",
+			expected:   false,
+		},
+		{
+			name:       "same_registry_nested",
+			repository: "oci://Example.com/root/helm-registry/charts/",
+			expected:   false,
+		},
+		{
+			name:       "registry_with_same_prefix",
+			repository: "oci://example.com/root/helm-registry-mirror",
+			expected:   true,
+		},
+		{
+			name:       "external_repository",
+			repository: "https://charts.bitnami.com/bitnami",
+			expected:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := metadata.IsExternalHelmDependency(tt.repository, registryURL)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestGetErrorResponse(t *testing.T) {
 	tests := []struct {
 		name     string
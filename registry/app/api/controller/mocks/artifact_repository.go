@@ -11,6 +11,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	types "github.com/harness/gitness/registry/types"
 )
 
@@ -25,8 +27,16 @@ func (_m *ArtifactRepository) GetByRegistryImageVersionAndArtifactType(
 	image string,
 	version string,
 	artifactType string,
+	opts ...types.QueryOption,
 ) (*types.Artifact, error) {
-	ret := _m.Called(ctx, registryID, image, version, artifactType)
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, registryID, image, version, artifactType)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByRegistryImageAndVersion")
@@ -34,19 +44,19 @@ func (_m *ArtifactRepository) GetByRegistryImageVersionAndArtifactType(
 
 	var r0 *types.Artifact
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, string) (*types.Artifact, error)); ok {
-		return rf(ctx, registryID, image, version, artifactType)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, string, ...types.QueryOption) (*types.Artifact, error)); ok {
+		return rf(ctx, registryID, image, version, artifactType, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, string) *types.Artifact); ok {
-		r0 = rf(ctx, registryID, image, version, artifactType)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, string, ...types.QueryOption) *types.Artifact); ok {
+		r0 = rf(ctx, registryID, image, version, artifactType, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Artifact)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string, string) error); ok {
-		r1 = rf(ctx, registryID, image, version, artifactType)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string, string, ...types.QueryOption) error); ok {
+		r1 = rf(ctx, registryID, image, version, artifactType, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -54,9 +64,16 @@ func (_m *ArtifactRepository) GetByRegistryImageVersionAndArtifactType(
 	return r0, r1
 }
 
-// GetByUUID provides a mock function with given fields: ctx, uuid
-func (_m *ArtifactRepository) GetByUUID(ctx context.Context, uuid string) (*types.Artifact, error) {
-	ret := _m.Called(ctx, uuid)
+// GetByUUID provides a mock function with given fields: ctx, uuid, opts
+func (_m *ArtifactRepository) GetByUUID(ctx context.Context, uuid string, opts ...types.QueryOption) (*types.Artifact, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, uuid)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByUUID")
@@ -64,17 +81,17 @@ func (_m *ArtifactRepository) GetByUUID(ctx context.Context, uuid string) (*type
 
 	var r0 *types.Artifact
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.Artifact, error)); ok {
-		return rf(ctx, uuid)
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...types.QueryOption) (*types.Artifact, error)); ok {
+		return rf(ctx, uuid, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *types.Artifact); ok {
-		r0 = rf(ctx, uuid)
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...types.QueryOption) *types.Artifact); ok {
+		r0 = rf(ctx, uuid, opts...)
 	} else {
 		r0 = ret.Get(0).(*types.Artifact)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, uuid)
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...types.QueryOption) error); ok {
+		r1 = rf(ctx, uuid, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -82,9 +99,16 @@ func (_m *ArtifactRepository) GetByUUID(ctx context.Context, uuid string) (*type
 	return r0, r1
 }
 
-// Get provides a mock function with given fields: ctx, id
-func (_m *ArtifactRepository) Get(ctx context.Context, id int64) (*types.Artifact, error) {
-	ret := _m.Called(ctx, id)
+// Get provides a mock function with given fields: ctx, id, opts
+func (_m *ArtifactRepository) Get(ctx context.Context, id int64, opts ...types.QueryOption) (*types.Artifact, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
@@ -92,17 +116,17 @@ func (_m *ArtifactRepository) Get(ctx context.Context, id int64) (*types.Artifac
 
 	var r0 *types.Artifact
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (*types.Artifact, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, ...types.QueryOption) (*types.Artifact, error)); ok {
+		return rf(ctx, id, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) *types.Artifact); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, ...types.QueryOption) *types.Artifact); ok {
+		r0 = rf(ctx, id, opts...)
 	} else {
 		r0 = ret.Get(0).(*types.Artifact)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, ...types.QueryOption) error); ok {
+		r1 = rf(ctx, id, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -278,6 +302,34 @@ func (_m *ArtifactRepository) CountLatestByName(ctx context.Context, regID int64
 	return r0, r1
 }
 
+// CountPackagesByImageName provides a mock function with given fields: ctx, regID, name, includePrerelease
+func (_m *ArtifactRepository) CountPackagesByImageName(ctx context.Context, regID int64, name string, includePrerelease bool) (int64, error) {
+	ret := _m.Called(ctx, regID, name, includePrerelease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPackagesByImageName")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, bool) (int64, error)); ok {
+		return rf(ctx, regID, name, includePrerelease)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, bool) int64); ok {
+		r0 = rf(ctx, regID, name, includePrerelease)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, bool) error); ok {
+		r1 = rf(ctx, regID, name, includePrerelease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateOrUpdate provides a mock function with given fields: ctx, _a1
 func (_m *ArtifactRepository) CreateOrUpdate(ctx context.Context, _a1 *types.Artifact) (int64, error) {
 	ret := _m.Called(ctx, _a1)
@@ -342,6 +394,24 @@ func (_m *ArtifactRepository) DeleteByVersionAndImageName(ctx context.Context, i
 	return r0
 }
 
+// SoftDeleteByVersionAndImageName provides a mock function with given fields: ctx, image, version, regID
+func (_m *ArtifactRepository) SoftDeleteByVersionAndImageName(ctx context.Context, image string, version string, regID int64) error {
+	ret := _m.Called(ctx, image, version, regID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteByVersionAndImageName")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) error); ok {
+		r0 = rf(ctx, image, version, regID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DuplicateArtifact provides a mock function with given fields: ctx, sourceArtifact, targetImageID
 func (_m *ArtifactRepository) DuplicateArtifact(ctx context.Context, sourceArtifact *types.Artifact, targetImageID int64) (*types.Artifact, error) {
 	ret := _m.Called(ctx, sourceArtifact, targetImageID)
@@ -402,9 +472,9 @@ func (_m *ArtifactRepository) GetAllArtifactsByParentID(ctx context.Context, id
 	return r0, r1
 }
 
-// GetAllArtifactsByRepo provides a mock function with given fields: ctx, registryID, batchSize, artifactID
-func (_m *ArtifactRepository) GetAllArtifactsByRepo(ctx context.Context, registryID int64, batchSize int, artifactID int64) (*[]types.ArtifactMetadata, error) {
-	ret := _m.Called(ctx, registryID, batchSize, artifactID)
+// GetAllArtifactsByRepo provides a mock function with given fields: ctx, registryID, batchSize, artifactID, batchTimeout
+func (_m *ArtifactRepository) GetAllArtifactsByRepo(ctx context.Context, registryID int64, batchSize int, artifactID int64, batchTimeout time.Duration) (*[]types.ArtifactMetadata, error) {
+	ret := _m.Called(ctx, registryID, batchSize, artifactID, batchTimeout)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllArtifactsByRepo")
@@ -412,19 +482,19 @@ func (_m *ArtifactRepository) GetAllArtifactsByRepo(ctx context.Context, registr
 
 	var r0 *[]types.ArtifactMetadata
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int64) (*[]types.ArtifactMetadata, error)); ok {
-		return rf(ctx, registryID, batchSize, artifactID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int64, time.Duration) (*[]types.ArtifactMetadata, error)); ok {
+		return rf(ctx, registryID, batchSize, artifactID, batchTimeout)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int64) *[]types.ArtifactMetadata); ok {
-		r0 = rf(ctx, registryID, batchSize, artifactID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int64, time.Duration) *[]types.ArtifactMetadata); ok {
+		r0 = rf(ctx, registryID, batchSize, artifactID, batchTimeout)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]types.ArtifactMetadata)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int, int64) error); ok {
-		r1 = rf(ctx, registryID, batchSize, artifactID)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int, int64, time.Duration) error); ok {
+		r1 = rf(ctx, registryID, batchSize, artifactID, batchTimeout)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -492,9 +562,9 @@ func (_m *ArtifactRepository) GetArtifactMetadata(ctx context.Context, id int64,
 	return r0, r1
 }
 
-// GetArtifactsByRepo provides a mock function with given fields: ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, artifactType
-func (_m *ArtifactRepository) GetArtifactsByRepo(ctx context.Context, parentID int64, repoKey string, sortByField string, sortByOrder string, limit int, offset int, search string, labels []string, artifactType *artifact.ArtifactType) (*[]types.ArtifactMetadata, error) {
-	ret := _m.Called(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, artifactType)
+// GetArtifactsByRepo provides a mock function with given fields: ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, artifactType, includeDownloadCount
+func (_m *ArtifactRepository) GetArtifactsByRepo(ctx context.Context, parentID int64, repoKey string, sortByField string, sortByOrder string, limit int, offset int, search string, labels []string, artifactType *artifact.ArtifactType, includeDownloadCount bool) (*[]types.ArtifactMetadata, error) {
+	ret := _m.Called(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, artifactType, includeDownloadCount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetArtifactsByRepo")
@@ -502,19 +572,19 @@ func (_m *ArtifactRepository) GetArtifactsByRepo(ctx context.Context, parentID i
 
 	var r0 *[]types.ArtifactMetadata
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, string, int, int, string, []string, *artifact.ArtifactType) (*[]types.ArtifactMetadata, error)); ok {
-		return rf(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, artifactType)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, string, int, int, string, []string, *artifact.ArtifactType, bool) (*[]types.ArtifactMetadata, error)); ok {
+		return rf(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, artifactType, includeDownloadCount)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, string, int, int, string, []string, *artifact.ArtifactType) *[]types.ArtifactMetadata); ok {
-		r0 = rf(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, artifactType)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, string, int, int, string, []string, *artifact.ArtifactType, bool) *[]types.ArtifactMetadata); ok {
+		r0 = rf(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, artifactType, includeDownloadCount)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]types.ArtifactMetadata)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string, string, int, int, string, []string, *artifact.ArtifactType) error); ok {
-		r1 = rf(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, artifactType)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string, string, int, int, string, []string, *artifact.ArtifactType, bool) error); ok {
+		r1 = rf(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, artifactType, includeDownloadCount)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -552,9 +622,16 @@ func (_m *ArtifactRepository) GetArtifactsByRepoAndImageBatch(ctx context.Contex
 	return r0, r1
 }
 
-// GetByName provides a mock function with given fields: ctx, imageID, version
-func (_m *ArtifactRepository) GetByName(ctx context.Context, imageID int64, version string) (*types.Artifact, error) {
-	ret := _m.Called(ctx, imageID, version)
+// GetByName provides a mock function with given fields: ctx, imageID, version, opts
+func (_m *ArtifactRepository) GetByName(ctx context.Context, imageID int64, version string, opts ...types.QueryOption) (*types.Artifact, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, imageID, version)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByName")
@@ -562,19 +639,19 @@ func (_m *ArtifactRepository) GetByName(ctx context.Context, imageID int64, vers
 
 	var r0 *types.Artifact
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*types.Artifact, error)); ok {
-		return rf(ctx, imageID, version)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, ...types.QueryOption) (*types.Artifact, error)); ok {
+		return rf(ctx, imageID, version, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *types.Artifact); ok {
-		r0 = rf(ctx, imageID, version)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, ...types.QueryOption) *types.Artifact); ok {
+		r0 = rf(ctx, imageID, version, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Artifact)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
-		r1 = rf(ctx, imageID, version)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, ...types.QueryOption) error); ok {
+		r1 = rf(ctx, imageID, version, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -582,9 +659,16 @@ func (_m *ArtifactRepository) GetByName(ctx context.Context, imageID int64, vers
 	return r0, r1
 }
 
-// GetByRegistryIDAndImage provides a mock function with given fields: ctx, registryID, image
-func (_m *ArtifactRepository) GetByRegistryIDAndImage(ctx context.Context, registryID int64, image string) (*[]types.Artifact, error) {
-	ret := _m.Called(ctx, registryID, image)
+// GetByRegistryIDAndImage provides a mock function with given fields: ctx, registryID, image, opts
+func (_m *ArtifactRepository) GetByRegistryIDAndImage(ctx context.Context, registryID int64, image string, opts ...types.QueryOption) (*[]types.Artifact, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, registryID, image)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByRegistryIDAndImage")
@@ -592,19 +676,19 @@ func (_m *ArtifactRepository) GetByRegistryIDAndImage(ctx context.Context, regis
 
 	var r0 *[]types.Artifact
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*[]types.Artifact, error)); ok {
-		return rf(ctx, registryID, image)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, ...types.QueryOption) (*[]types.Artifact, error)); ok {
+		return rf(ctx, registryID, image, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *[]types.Artifact); ok {
-		r0 = rf(ctx, registryID, image)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, ...types.QueryOption) *[]types.Artifact); ok {
+		r0 = rf(ctx, registryID, image, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]types.Artifact)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
-		r1 = rf(ctx, registryID, image)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, ...types.QueryOption) error); ok {
+		r1 = rf(ctx, registryID, image, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -612,9 +696,16 @@ func (_m *ArtifactRepository) GetByRegistryIDAndImage(ctx context.Context, regis
 	return r0, r1
 }
 
-// GetByRegistryImageAndVersion provides a mock function with given fields: ctx, registryID, image, version
-func (_m *ArtifactRepository) GetByRegistryImageAndVersion(ctx context.Context, registryID int64, image string, version string) (*types.Artifact, error) {
-	ret := _m.Called(ctx, registryID, image, version)
+// GetByRegistryImageAndVersion provides a mock function with given fields: ctx, registryID, image, version, opts
+func (_m *ArtifactRepository) GetByRegistryImageAndVersion(ctx context.Context, registryID int64, image string, version string, opts ...types.QueryOption) (*types.Artifact, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, registryID, image, version)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByRegistryImageAndVersion")
@@ -622,19 +713,49 @@ func (_m *ArtifactRepository) GetByRegistryImageAndVersion(ctx context.Context,
 
 	var r0 *types.Artifact
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) (*types.Artifact, error)); ok {
-		return rf(ctx, registryID, image, version)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, ...types.QueryOption) (*types.Artifact, error)); ok {
+		return rf(ctx, registryID, image, version, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) *types.Artifact); ok {
-		r0 = rf(ctx, registryID, image, version)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, ...types.QueryOption) *types.Artifact); ok {
+		r0 = rf(ctx, registryID, image, version, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Artifact)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
-		r1 = rf(ctx, registryID, image, version)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string, ...types.QueryOption) error); ok {
+		r1 = rf(ctx, registryID, image, version, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDownloadCountsByImageNames provides a mock function with given fields: ctx, registryID, imageNames
+func (_m *ArtifactRepository) GetDownloadCountsByImageNames(ctx context.Context, registryID int64, imageNames []string) (map[string]int64, error) {
+	ret := _m.Called(ctx, registryID, imageNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDownloadCountsByImageNames")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) (map[string]int64, error)); ok {
+		return rf(ctx, registryID, imageNames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) map[string]int64); ok {
+		r0 = rf(ctx, registryID, imageNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(ctx, registryID, imageNames)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -672,9 +793,9 @@ func (_m *ArtifactRepository) GetLatestArtifactMetadata(ctx context.Context, id
 	return r0, r1
 }
 
-// GetLatestArtifactsByRepo provides a mock function with given fields: ctx, registryID, batchSize, artifactID
-func (_m *ArtifactRepository) GetLatestArtifactsByRepo(ctx context.Context, registryID int64, batchSize int, artifactID int64) (*[]types.ArtifactMetadata, error) {
-	ret := _m.Called(ctx, registryID, batchSize, artifactID)
+// GetLatestArtifactsByRepo provides a mock function with given fields: ctx, registryID, batchSize, artifactID, batchTimeout
+func (_m *ArtifactRepository) GetLatestArtifactsByRepo(ctx context.Context, registryID int64, batchSize int, artifactID int64, batchTimeout time.Duration) (*[]types.ArtifactMetadata, error) {
+	ret := _m.Called(ctx, registryID, batchSize, artifactID, batchTimeout)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetLatestArtifactsByRepo")
@@ -682,19 +803,19 @@ func (_m *ArtifactRepository) GetLatestArtifactsByRepo(ctx context.Context, regi
 
 	var r0 *[]types.ArtifactMetadata
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int64) (*[]types.ArtifactMetadata, error)); ok {
-		return rf(ctx, registryID, batchSize, artifactID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int64, time.Duration) (*[]types.ArtifactMetadata, error)); ok {
+		return rf(ctx, registryID, batchSize, artifactID, batchTimeout)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int64) *[]types.ArtifactMetadata); ok {
-		r0 = rf(ctx, registryID, batchSize, artifactID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int64, time.Duration) *[]types.ArtifactMetadata); ok {
+		r0 = rf(ctx, registryID, batchSize, artifactID, batchTimeout)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]types.ArtifactMetadata)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int, int64) error); ok {
-		r1 = rf(ctx, registryID, batchSize, artifactID)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int, int64, time.Duration) error); ok {
+		r1 = rf(ctx, registryID, batchSize, artifactID, batchTimeout)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -792,6 +913,36 @@ func (_m *ArtifactRepository) SearchLatestByName(ctx context.Context, regID int6
 	return r0, r1
 }
 
+// SearchPackagesByImageName provides a mock function with given fields: ctx, regID, name, includePrerelease, limit, offset
+func (_m *ArtifactRepository) SearchPackagesByImageName(ctx context.Context, regID int64, name string, includePrerelease bool, limit int, offset int) (*[]types.ArtifactMetadata, error) {
+	ret := _m.Called(ctx, regID, name, includePrerelease, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchPackagesByImageName")
+	}
+
+	var r0 *[]types.ArtifactMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, bool, int, int) (*[]types.ArtifactMetadata, error)); ok {
+		return rf(ctx, regID, name, includePrerelease, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, bool, int, int) *[]types.ArtifactMetadata); ok {
+		r0 = rf(ctx, regID, name, includePrerelease, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]types.ArtifactMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, bool, int, int) error); ok {
+		r1 = rf(ctx, regID, name, includePrerelease, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateArtifactMetadata provides a mock function with given fields: ctx, metadata, artifactID
 func (_m *ArtifactRepository) UpdateArtifactMetadata(ctx context.Context, metadata json.RawMessage, artifactID int64) error {
 	ret := _m.Called(ctx, metadata, artifactID)
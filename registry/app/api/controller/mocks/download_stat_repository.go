@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/types"
@@ -171,6 +172,149 @@ func (_c *MockDownloadStatRepository_CreateByRegistryIDImageAndArtifactName_Call
 	return _c
 }
 
+// CreatePartitions provides a mock function for the type MockDownloadStatRepository
+func (_mock *MockDownloadStatRepository) CreatePartitions(ctx context.Context, now time.Time) error {
+	ret := _mock.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePartitions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = returnFunc(ctx, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDownloadStatRepository_CreatePartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePartitions'
+type MockDownloadStatRepository_CreatePartitions_Call struct {
+	*mock.Call
+}
+
+// CreatePartitions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockDownloadStatRepository_Expecter) CreatePartitions(ctx interface{}, now interface{}) *MockDownloadStatRepository_CreatePartitions_Call {
+	return &MockDownloadStatRepository_CreatePartitions_Call{Call: _e.mock.On("CreatePartitions", ctx, now)}
+}
+
+func (_c *MockDownloadStatRepository_CreatePartitions_Call) Run(run func(ctx context.Context, now time.Time)) *MockDownloadStatRepository_CreatePartitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDownloadStatRepository_CreatePartitions_Call) Return(err error) *MockDownloadStatRepository_CreatePartitions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDownloadStatRepository_CreatePartitions_Call) RunAndReturn(run func(ctx context.Context, now time.Time) error) *MockDownloadStatRepository_CreatePartitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTimeSeries provides a mock function for the type MockDownloadStatRepository
+func (_mock *MockDownloadStatRepository) GetTimeSeries(ctx context.Context, imageID int64, from time.Time, to time.Time, interval types.DownloadStatInterval) ([]types.DownloadStatBucket, error) {
+	ret := _mock.Called(ctx, imageID, from, to, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimeSeries")
+	}
+
+	var r0 []types.DownloadStatBucket
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, types.DownloadStatInterval) ([]types.DownloadStatBucket, error)); ok {
+		return returnFunc(ctx, imageID, from, to, interval)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, types.DownloadStatInterval) []types.DownloadStatBucket); ok {
+		r0 = returnFunc(ctx, imageID, from, to, interval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.DownloadStatBucket)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time, types.DownloadStatInterval) error); ok {
+		r1 = returnFunc(ctx, imageID, from, to, interval)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDownloadStatRepository_GetTimeSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeSeries'
+type MockDownloadStatRepository_GetTimeSeries_Call struct {
+	*mock.Call
+}
+
+// GetTimeSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - imageID int64
+//   - from time.Time
+//   - to time.Time
+//   - interval types.DownloadStatInterval
+func (_e *MockDownloadStatRepository_Expecter) GetTimeSeries(ctx interface{}, imageID interface{}, from interface{}, to interface{}, interval interface{}) *MockDownloadStatRepository_GetTimeSeries_Call {
+	return &MockDownloadStatRepository_GetTimeSeries_Call{Call: _e.mock.On("GetTimeSeries", ctx, imageID, from, to, interval)}
+}
+
+func (_c *MockDownloadStatRepository_GetTimeSeries_Call) Run(run func(ctx context.Context, imageID int64, from time.Time, to time.Time, interval types.DownloadStatInterval)) *MockDownloadStatRepository_GetTimeSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 types.DownloadStatInterval
+		if args[4] != nil {
+			arg4 = args[4].(types.DownloadStatInterval)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDownloadStatRepository_GetTimeSeries_Call) Return(downloadStatBuckets []types.DownloadStatBucket, err error) *MockDownloadStatRepository_GetTimeSeries_Call {
+	_c.Call.Return(downloadStatBuckets, err)
+	return _c
+}
+
+func (_c *MockDownloadStatRepository_GetTimeSeries_Call) RunAndReturn(run func(ctx context.Context, imageID int64, from time.Time, to time.Time, interval types.DownloadStatInterval) ([]types.DownloadStatBucket, error)) *MockDownloadStatRepository_GetTimeSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTotalDownloadsForArtifactID provides a mock function for the type MockDownloadStatRepository
 func (_mock *MockDownloadStatRepository) GetTotalDownloadsForArtifactID(ctx context.Context, artifactID int64) (int64, error) {
 	ret := _mock.Called(ctx, artifactID)
@@ -376,3 +520,69 @@ func (_c *MockDownloadStatRepository_GetTotalDownloadsForManifests_Call) RunAndR
 	_c.Call.Return(run)
 	return _c
 }
+
+// RollUp provides a mock function for the type MockDownloadStatRepository
+func (_mock *MockDownloadStatRepository) RollUp(ctx context.Context, until time.Time) (int64, error) {
+	ret := _mock.Called(ctx, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollUp")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, until)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, until)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDownloadStatRepository_RollUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollUp'
+type MockDownloadStatRepository_RollUp_Call struct {
+	*mock.Call
+}
+
+// RollUp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - until time.Time
+func (_e *MockDownloadStatRepository_Expecter) RollUp(ctx interface{}, until interface{}) *MockDownloadStatRepository_RollUp_Call {
+	return &MockDownloadStatRepository_RollUp_Call{Call: _e.mock.On("RollUp", ctx, until)}
+}
+
+func (_c *MockDownloadStatRepository_RollUp_Call) Run(run func(ctx context.Context, until time.Time)) *MockDownloadStatRepository_RollUp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDownloadStatRepository_RollUp_Call) Return(n int64, err error) *MockDownloadStatRepository_RollUp_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockDownloadStatRepository_RollUp_Call) RunAndReturn(run func(ctx context.Context, until time.Time) (int64, error)) *MockDownloadStatRepository_RollUp_Call {
+	_c.Call.Return(run)
+	return _c
+}
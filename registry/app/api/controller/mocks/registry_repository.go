@@ -846,6 +846,181 @@ func (_c *RegistryRepository_GetIDsByParentSpace_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetStorageSize provides a mock function with given fields: ctx, registryID
+func (_m *RegistryRepository) GetStorageSize(ctx context.Context, registryID int64) (int64, error) {
+	ret := _m.Called(ctx, registryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStorageSize")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, registryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, registryID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, registryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegistryRepository_GetStorageSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStorageSize'
+type RegistryRepository_GetStorageSize_Call struct {
+	*mock.Call
+}
+
+// GetStorageSize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - registryID int64
+func (_e *RegistryRepository_Expecter) GetStorageSize(ctx interface{}, registryID interface{}) *RegistryRepository_GetStorageSize_Call {
+	return &RegistryRepository_GetStorageSize_Call{Call: _e.mock.On("GetStorageSize", ctx, registryID)}
+}
+
+func (_c *RegistryRepository_GetStorageSize_Call) Run(run func(ctx context.Context, registryID int64)) *RegistryRepository_GetStorageSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *RegistryRepository_GetStorageSize_Call) Return(_a0 int64, _a1 error) *RegistryRepository_GetStorageSize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RegistryRepository_GetStorageSize_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *RegistryRepository_GetStorageSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStorageSizes provides a mock function with given fields: ctx, registryIDs
+func (_m *RegistryRepository) GetStorageSizes(ctx context.Context, registryIDs []int64) (map[int64]int64, error) {
+	ret := _m.Called(ctx, registryIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStorageSizes")
+	}
+
+	var r0 map[int64]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (map[int64]int64, error)); ok {
+		return rf(ctx, registryIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64]int64); ok {
+		r0 = rf(ctx, registryIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, registryIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegistryRepository_GetStorageSizes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStorageSizes'
+type RegistryRepository_GetStorageSizes_Call struct {
+	*mock.Call
+}
+
+// GetStorageSizes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - registryIDs []int64
+func (_e *RegistryRepository_Expecter) GetStorageSizes(ctx interface{}, registryIDs interface{}) *RegistryRepository_GetStorageSizes_Call {
+	return &RegistryRepository_GetStorageSizes_Call{Call: _e.mock.On("GetStorageSizes", ctx, registryIDs)}
+}
+
+func (_c *RegistryRepository_GetStorageSizes_Call) Run(run func(ctx context.Context, registryIDs []int64)) *RegistryRepository_GetStorageSizes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *RegistryRepository_GetStorageSizes_Call) Return(_a0 map[int64]int64, _a1 error) *RegistryRepository_GetStorageSizes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RegistryRepository_GetStorageSizes_Call) RunAndReturn(run func(context.Context, []int64) (map[int64]int64, error)) *RegistryRepository_GetStorageSizes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSpaces provides a mock function with given fields: ctx, rootParentID
+func (_m *RegistryRepository) ListSpaces(ctx context.Context, rootParentID int64) ([]types.RegistrySpaces, error) {
+	ret := _m.Called(ctx, rootParentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSpaces")
+	}
+
+	var r0 []types.RegistrySpaces
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]types.RegistrySpaces, error)); ok {
+		return rf(ctx, rootParentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []types.RegistrySpaces); ok {
+		r0 = rf(ctx, rootParentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.RegistrySpaces)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, rootParentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegistryRepository_ListSpaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSpaces'
+type RegistryRepository_ListSpaces_Call struct {
+	*mock.Call
+}
+
+// ListSpaces is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rootParentID int64
+func (_e *RegistryRepository_Expecter) ListSpaces(ctx interface{}, rootParentID interface{}) *RegistryRepository_ListSpaces_Call {
+	return &RegistryRepository_ListSpaces_Call{Call: _e.mock.On("ListSpaces", ctx, rootParentID)}
+}
+
+func (_c *RegistryRepository_ListSpaces_Call) Run(run func(ctx context.Context, rootParentID int64)) *RegistryRepository_ListSpaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *RegistryRepository_ListSpaces_Call) Return(_a0 []types.RegistrySpaces, _a1 error) *RegistryRepository_ListSpaces_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RegistryRepository_ListSpaces_Call) RunAndReturn(run func(context.Context, int64) ([]types.RegistrySpaces, error)) *RegistryRepository_ListSpaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateParentSpace provides a mock function with given fields: ctx, sourceSpaceID, targetSpaceID
 func (_m *RegistryRepository) UpdateParentSpace(ctx context.Context, sourceSpaceID int64, targetSpaceID int64) (int64, error) {
 	ret := _m.Called(ctx, sourceSpaceID, targetSpaceID)
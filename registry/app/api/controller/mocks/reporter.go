@@ -32,3 +32,8 @@ func (m *Reporter) ArtifactCreated(ctx context.Context, payload *registryevents.
 func (m *Reporter) ArtifactDeleted(ctx context.Context, payload *registryevents.ArtifactDeletedPayload) {
 	m.Called(ctx, payload)
 }
+
+// ArtifactScanRequested provides a mock function
+func (m *Reporter) ArtifactScanRequested(ctx context.Context, payload *registryevents.ArtifactScanRequestedPayload) {
+	m.Called(ctx, payload)
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/harness/gitness/registry/types"
 	mock "github.com/stretchr/testify/mock"
@@ -219,8 +220,14 @@ func (_c *MockTagRepository_CountAllArtifactsByRepo_Call) RunAndReturn(run func(
 }
 
 // CountAllTagsByRepoAndImage provides a mock function for the type MockTagRepository
-func (_mock *MockTagRepository) CountAllTagsByRepoAndImage(ctx context.Context, parentID int64, repoKey string, image string, search string) (int64, error) {
-	ret := _mock.Called(ctx, parentID, repoKey, image, search)
+func (_mock *MockTagRepository) CountAllTagsByRepoAndImage(ctx context.Context, parentID int64, repoKey string, image string, search string, opts ...types.QueryOption) (int64, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, parentID, repoKey, image, search, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, parentID, repoKey, image, search)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountAllTagsByRepoAndImage")
@@ -228,16 +235,16 @@ func (_mock *MockTagRepository) CountAllTagsByRepoAndImage(ctx context.Context,
 
 	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string) (int64, error)); ok {
-		return returnFunc(ctx, parentID, repoKey, image, search)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, ...types.QueryOption) (int64, error)); ok {
+		return returnFunc(ctx, parentID, repoKey, image, search, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string) int64); ok {
-		r0 = returnFunc(ctx, parentID, repoKey, image, search)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, ...types.QueryOption) int64); ok {
+		r0 = returnFunc(ctx, parentID, repoKey, image, search, opts...)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, string, string) error); ok {
-		r1 = returnFunc(ctx, parentID, repoKey, image, search)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, string, string, ...types.QueryOption) error); ok {
+		r1 = returnFunc(ctx, parentID, repoKey, image, search, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -255,11 +262,13 @@ type MockTagRepository_CountAllTagsByRepoAndImage_Call struct {
 //   - repoKey string
 //   - image string
 //   - search string
-func (_e *MockTagRepository_Expecter) CountAllTagsByRepoAndImage(ctx interface{}, parentID interface{}, repoKey interface{}, image interface{}, search interface{}) *MockTagRepository_CountAllTagsByRepoAndImage_Call {
-	return &MockTagRepository_CountAllTagsByRepoAndImage_Call{Call: _e.mock.On("CountAllTagsByRepoAndImage", ctx, parentID, repoKey, image, search)}
+//   - opts ...types.QueryOption
+func (_e *MockTagRepository_Expecter) CountAllTagsByRepoAndImage(ctx interface{}, parentID interface{}, repoKey interface{}, image interface{}, search interface{}, opts ...interface{}) *MockTagRepository_CountAllTagsByRepoAndImage_Call {
+	return &MockTagRepository_CountAllTagsByRepoAndImage_Call{Call: _e.mock.On("CountAllTagsByRepoAndImage",
+		append([]interface{}{ctx, parentID, repoKey, image, search}, opts...)...)}
 }
 
-func (_c *MockTagRepository_CountAllTagsByRepoAndImage_Call) Run(run func(ctx context.Context, parentID int64, repoKey string, image string, search string)) *MockTagRepository_CountAllTagsByRepoAndImage_Call {
+func (_c *MockTagRepository_CountAllTagsByRepoAndImage_Call) Run(run func(ctx context.Context, parentID int64, repoKey string, image string, search string, opts ...types.QueryOption)) *MockTagRepository_CountAllTagsByRepoAndImage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -281,12 +290,19 @@ func (_c *MockTagRepository_CountAllTagsByRepoAndImage_Call) Run(run func(ctx co
 		if args[4] != nil {
 			arg4 = args[4].(string)
 		}
+		var arg5 []types.QueryOption
+		var variadicArgs []types.QueryOption
+		if len(args) > 5 {
+			variadicArgs = args[5].([]types.QueryOption)
+		}
+		arg5 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
 			arg4,
+			arg5...,
 		)
 	})
 	return _c
@@ -297,14 +313,20 @@ func (_c *MockTagRepository_CountAllTagsByRepoAndImage_Call) Return(n int64, err
 	return _c
 }
 
-func (_c *MockTagRepository_CountAllTagsByRepoAndImage_Call) RunAndReturn(run func(ctx context.Context, parentID int64, repoKey string, image string, search string) (int64, error)) *MockTagRepository_CountAllTagsByRepoAndImage_Call {
+func (_c *MockTagRepository_CountAllTagsByRepoAndImage_Call) RunAndReturn(run func(ctx context.Context, parentID int64, repoKey string, image string, search string, opts ...types.QueryOption) (int64, error)) *MockTagRepository_CountAllTagsByRepoAndImage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // CountOciVersionByRepoAndImage provides a mock function for the type MockTagRepository
-func (_mock *MockTagRepository) CountOciVersionByRepoAndImage(ctx context.Context, parentID int64, repoKey string, image string, search string) (int64, error) {
-	ret := _mock.Called(ctx, parentID, repoKey, image, search)
+func (_mock *MockTagRepository) CountOciVersionByRepoAndImage(ctx context.Context, parentID int64, repoKey string, image string, search string, opts ...types.QueryOption) (int64, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, parentID, repoKey, image, search, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, parentID, repoKey, image, search)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountOciVersionByRepoAndImage")
@@ -312,16 +334,16 @@ func (_mock *MockTagRepository) CountOciVersionByRepoAndImage(ctx context.Contex
 
 	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string) (int64, error)); ok {
-		return returnFunc(ctx, parentID, repoKey, image, search)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, ...types.QueryOption) (int64, error)); ok {
+		return returnFunc(ctx, parentID, repoKey, image, search, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string) int64); ok {
-		r0 = returnFunc(ctx, parentID, repoKey, image, search)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, ...types.QueryOption) int64); ok {
+		r0 = returnFunc(ctx, parentID, repoKey, image, search, opts...)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, string, string) error); ok {
-		r1 = returnFunc(ctx, parentID, repoKey, image, search)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, string, string, ...types.QueryOption) error); ok {
+		r1 = returnFunc(ctx, parentID, repoKey, image, search, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -339,11 +361,13 @@ type MockTagRepository_CountOciVersionByRepoAndImage_Call struct {
 //   - repoKey string
 //   - image string
 //   - search string
-func (_e *MockTagRepository_Expecter) CountOciVersionByRepoAndImage(ctx interface{}, parentID interface{}, repoKey interface{}, image interface{}, search interface{}) *MockTagRepository_CountOciVersionByRepoAndImage_Call {
-	return &MockTagRepository_CountOciVersionByRepoAndImage_Call{Call: _e.mock.On("CountOciVersionByRepoAndImage", ctx, parentID, repoKey, image, search)}
+//   - opts ...types.QueryOption
+func (_e *MockTagRepository_Expecter) CountOciVersionByRepoAndImage(ctx interface{}, parentID interface{}, repoKey interface{}, image interface{}, search interface{}, opts ...interface{}) *MockTagRepository_CountOciVersionByRepoAndImage_Call {
+	return &MockTagRepository_CountOciVersionByRepoAndImage_Call{Call: _e.mock.On("CountOciVersionByRepoAndImage",
+		append([]interface{}{ctx, parentID, repoKey, image, search}, opts...)...)}
 }
 
-func (_c *MockTagRepository_CountOciVersionByRepoAndImage_Call) Run(run func(ctx context.Context, parentID int64, repoKey string, image string, search string)) *MockTagRepository_CountOciVersionByRepoAndImage_Call {
+func (_c *MockTagRepository_CountOciVersionByRepoAndImage_Call) Run(run func(ctx context.Context, parentID int64, repoKey string, image string, search string, opts ...types.QueryOption)) *MockTagRepository_CountOciVersionByRepoAndImage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -365,12 +389,19 @@ func (_c *MockTagRepository_CountOciVersionByRepoAndImage_Call) Run(run func(ctx
 		if args[4] != nil {
 			arg4 = args[4].(string)
 		}
+		var arg5 []types.QueryOption
+		var variadicArgs []types.QueryOption
+		if len(args) > 5 {
+			variadicArgs = args[5].([]types.QueryOption)
+		}
+		arg5 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
 			arg4,
+			arg5...,
 		)
 	})
 	return _c
@@ -381,7 +412,7 @@ func (_c *MockTagRepository_CountOciVersionByRepoAndImage_Call) Return(n int64,
 	return _c
 }
 
-func (_c *MockTagRepository_CountOciVersionByRepoAndImage_Call) RunAndReturn(run func(ctx context.Context, parentID int64, repoKey string, image string, search string) (int64, error)) *MockTagRepository_CountOciVersionByRepoAndImage_Call {
+func (_c *MockTagRepository_CountOciVersionByRepoAndImage_Call) RunAndReturn(run func(ctx context.Context, parentID int64, repoKey string, image string, search string, opts ...types.QueryOption) (int64, error)) *MockTagRepository_CountOciVersionByRepoAndImage_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1026,8 +1057,8 @@ func (_c *MockTagRepository_GetAllArtifactsByParentIDUntagged_Call) RunAndReturn
 }
 
 // GetAllArtifactsByRepo provides a mock function for the type MockTagRepository
-func (_mock *MockTagRepository) GetAllArtifactsByRepo(ctx context.Context, parentID int64, repoKey string, sortByField string, sortByOrder string, limit int, offset int, search string, labels []string) (*[]types.ArtifactMetadata, error) {
-	ret := _mock.Called(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels)
+func (_mock *MockTagRepository) GetAllArtifactsByRepo(ctx context.Context, parentID int64, repoKey string, sortByField string, sortByOrder string, limit int, offset int, search string, labels []string, includeDownloadCount bool) (*[]types.ArtifactMetadata, error) {
+	ret := _mock.Called(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, includeDownloadCount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllArtifactsByRepo")
@@ -1035,18 +1066,18 @@ func (_mock *MockTagRepository) GetAllArtifactsByRepo(ctx context.Context, paren
 
 	var r0 *[]types.ArtifactMetadata
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, int, int, string, []string) (*[]types.ArtifactMetadata, error)); ok {
-		return returnFunc(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, int, int, string, []string, bool) (*[]types.ArtifactMetadata, error)); ok {
+		return returnFunc(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, includeDownloadCount)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, int, int, string, []string) *[]types.ArtifactMetadata); ok {
-		r0 = returnFunc(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, int, int, string, []string, bool) *[]types.ArtifactMetadata); ok {
+		r0 = returnFunc(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, includeDownloadCount)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]types.ArtifactMetadata)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, string, string, int, int, string, []string) error); ok {
-		r1 = returnFunc(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, string, string, int, int, string, []string, bool) error); ok {
+		r1 = returnFunc(ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, includeDownloadCount)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1068,11 +1099,12 @@ type MockTagRepository_GetAllArtifactsByRepo_Call struct {
 //   - offset int
 //   - search string
 //   - labels []string
-func (_e *MockTagRepository_Expecter) GetAllArtifactsByRepo(ctx interface{}, parentID interface{}, repoKey interface{}, sortByField interface{}, sortByOrder interface{}, limit interface{}, offset interface{}, search interface{}, labels interface{}) *MockTagRepository_GetAllArtifactsByRepo_Call {
-	return &MockTagRepository_GetAllArtifactsByRepo_Call{Call: _e.mock.On("GetAllArtifactsByRepo", ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels)}
+//   - includeDownloadCount bool
+func (_e *MockTagRepository_Expecter) GetAllArtifactsByRepo(ctx interface{}, parentID interface{}, repoKey interface{}, sortByField interface{}, sortByOrder interface{}, limit interface{}, offset interface{}, search interface{}, labels interface{}, includeDownloadCount interface{}) *MockTagRepository_GetAllArtifactsByRepo_Call {
+	return &MockTagRepository_GetAllArtifactsByRepo_Call{Call: _e.mock.On("GetAllArtifactsByRepo", ctx, parentID, repoKey, sortByField, sortByOrder, limit, offset, search, labels, includeDownloadCount)}
 }
 
-func (_c *MockTagRepository_GetAllArtifactsByRepo_Call) Run(run func(ctx context.Context, parentID int64, repoKey string, sortByField string, sortByOrder string, limit int, offset int, search string, labels []string)) *MockTagRepository_GetAllArtifactsByRepo_Call {
+func (_c *MockTagRepository_GetAllArtifactsByRepo_Call) Run(run func(ctx context.Context, parentID int64, repoKey string, sortByField string, sortByOrder string, limit int, offset int, search string, labels []string, includeDownloadCount bool)) *MockTagRepository_GetAllArtifactsByRepo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1110,6 +1142,10 @@ func (_c *MockTagRepository_GetAllArtifactsByRepo_Call) Run(run func(ctx context
 		if args[8] != nil {
 			arg8 = args[8].([]string)
 		}
+		var arg9 bool
+		if args[9] != nil {
+			arg9 = args[9].(bool)
+		}
 		run(
 			arg0,
 			arg1,
@@ -1120,6 +1156,7 @@ func (_c *MockTagRepository_GetAllArtifactsByRepo_Call) Run(run func(ctx context
 			arg6,
 			arg7,
 			arg8,
+			arg9,
 		)
 	})
 	return _c
@@ -1130,14 +1167,20 @@ func (_c *MockTagRepository_GetAllArtifactsByRepo_Call) Return(artifactMetadatas
 	return _c
 }
 
-func (_c *MockTagRepository_GetAllArtifactsByRepo_Call) RunAndReturn(run func(ctx context.Context, parentID int64, repoKey string, sortByField string, sortByOrder string, limit int, offset int, search string, labels []string) (*[]types.ArtifactMetadata, error)) *MockTagRepository_GetAllArtifactsByRepo_Call {
+func (_c *MockTagRepository_GetAllArtifactsByRepo_Call) RunAndReturn(run func(ctx context.Context, parentID int64, repoKey string, sortByField string, sortByOrder string, limit int, offset int, search string, labels []string, includeDownloadCount bool) (*[]types.ArtifactMetadata, error)) *MockTagRepository_GetAllArtifactsByRepo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetAllOciVersionsByRepoAndImage provides a mock function for the type MockTagRepository
-func (_mock *MockTagRepository) GetAllOciVersionsByRepoAndImage(ctx context.Context, parentID int64, repoKey string, image string, sortByField string, sortByOrder string, limit int, offset int, search string) (*[]types.OciVersionMetadata, error) {
-	ret := _mock.Called(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search)
+func (_mock *MockTagRepository) GetAllOciVersionsByRepoAndImage(ctx context.Context, parentID int64, repoKey string, image string, sortByField string, sortByOrder string, limit int, offset int, search string, opts ...types.QueryOption) (*[]types.OciVersionMetadata, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllOciVersionsByRepoAndImage")
@@ -1145,18 +1188,18 @@ func (_mock *MockTagRepository) GetAllOciVersionsByRepoAndImage(ctx context.Cont
 
 	var r0 *[]types.OciVersionMetadata
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, string, int, int, string) (*[]types.OciVersionMetadata, error)); ok {
-		return returnFunc(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, string, int, int, string, ...types.QueryOption) (*[]types.OciVersionMetadata, error)); ok {
+		return returnFunc(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, string, int, int, string) *[]types.OciVersionMetadata); ok {
-		r0 = returnFunc(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, string, int, int, string, ...types.QueryOption) *[]types.OciVersionMetadata); ok {
+		r0 = returnFunc(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]types.OciVersionMetadata)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, string, string, string, int, int, string) error); ok {
-		r1 = returnFunc(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, string, string, string, int, int, string, ...types.QueryOption) error); ok {
+		r1 = returnFunc(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1178,11 +1221,13 @@ type MockTagRepository_GetAllOciVersionsByRepoAndImage_Call struct {
 //   - limit int
 //   - offset int
 //   - search string
-func (_e *MockTagRepository_Expecter) GetAllOciVersionsByRepoAndImage(ctx interface{}, parentID interface{}, repoKey interface{}, image interface{}, sortByField interface{}, sortByOrder interface{}, limit interface{}, offset interface{}, search interface{}) *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call {
-	return &MockTagRepository_GetAllOciVersionsByRepoAndImage_Call{Call: _e.mock.On("GetAllOciVersionsByRepoAndImage", ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search)}
+//   - opts ...types.QueryOption
+func (_e *MockTagRepository_Expecter) GetAllOciVersionsByRepoAndImage(ctx interface{}, parentID interface{}, repoKey interface{}, image interface{}, sortByField interface{}, sortByOrder interface{}, limit interface{}, offset interface{}, search interface{}, opts ...interface{}) *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call {
+	return &MockTagRepository_GetAllOciVersionsByRepoAndImage_Call{Call: _e.mock.On("GetAllOciVersionsByRepoAndImage",
+		append([]interface{}{ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search}, opts...)...)}
 }
 
-func (_c *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call) Run(run func(ctx context.Context, parentID int64, repoKey string, image string, sortByField string, sortByOrder string, limit int, offset int, search string)) *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call {
+func (_c *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call) Run(run func(ctx context.Context, parentID int64, repoKey string, image string, sortByField string, sortByOrder string, limit int, offset int, search string, opts ...types.QueryOption)) *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1220,6 +1265,12 @@ func (_c *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call) Run(run func(c
 		if args[8] != nil {
 			arg8 = args[8].(string)
 		}
+		var arg9 []types.QueryOption
+		var variadicArgs []types.QueryOption
+		if len(args) > 9 {
+			variadicArgs = args[9].([]types.QueryOption)
+		}
+		arg9 = variadicArgs
 		run(
 			arg0,
 			arg1,
@@ -1230,6 +1281,7 @@ func (_c *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call) Run(run func(c
 			arg6,
 			arg7,
 			arg8,
+			arg9...,
 		)
 	})
 	return _c
@@ -1240,14 +1292,20 @@ func (_c *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call) Return(ociVers
 	return _c
 }
 
-func (_c *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call) RunAndReturn(run func(ctx context.Context, parentID int64, repoKey string, image string, sortByField string, sortByOrder string, limit int, offset int, search string) (*[]types.OciVersionMetadata, error)) *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call {
+func (_c *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call) RunAndReturn(run func(ctx context.Context, parentID int64, repoKey string, image string, sortByField string, sortByOrder string, limit int, offset int, search string, opts ...types.QueryOption) (*[]types.OciVersionMetadata, error)) *MockTagRepository_GetAllOciVersionsByRepoAndImage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetAllTagsByRepoAndImage provides a mock function for the type MockTagRepository
-func (_mock *MockTagRepository) GetAllTagsByRepoAndImage(ctx context.Context, parentID int64, repoKey string, image string, sortByField string, sortByOrder string, limit int, offset int, search string) (*[]types.OciVersionMetadata, error) {
-	ret := _mock.Called(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search)
+func (_mock *MockTagRepository) GetAllTagsByRepoAndImage(ctx context.Context, parentID int64, repoKey string, image string, sortByField string, sortByOrder string, limit int, offset int, search string, opts ...types.QueryOption) (*[]types.OciVersionMetadata, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllTagsByRepoAndImage")
@@ -1255,18 +1313,18 @@ func (_mock *MockTagRepository) GetAllTagsByRepoAndImage(ctx context.Context, pa
 
 	var r0 *[]types.OciVersionMetadata
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, string, int, int, string) (*[]types.OciVersionMetadata, error)); ok {
-		return returnFunc(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, string, int, int, string, ...types.QueryOption) (*[]types.OciVersionMetadata, error)); ok {
+		return returnFunc(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, string, int, int, string) *[]types.OciVersionMetadata); ok {
-		r0 = returnFunc(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string, string, string, int, int, string, ...types.QueryOption) *[]types.OciVersionMetadata); ok {
+		r0 = returnFunc(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]types.OciVersionMetadata)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, string, string, string, int, int, string) error); ok {
-		r1 = returnFunc(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, string, string, string, int, int, string, ...types.QueryOption) error); ok {
+		r1 = returnFunc(ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1288,11 +1346,13 @@ type MockTagRepository_GetAllTagsByRepoAndImage_Call struct {
 //   - limit int
 //   - offset int
 //   - search string
-func (_e *MockTagRepository_Expecter) GetAllTagsByRepoAndImage(ctx interface{}, parentID interface{}, repoKey interface{}, image interface{}, sortByField interface{}, sortByOrder interface{}, limit interface{}, offset interface{}, search interface{}) *MockTagRepository_GetAllTagsByRepoAndImage_Call {
-	return &MockTagRepository_GetAllTagsByRepoAndImage_Call{Call: _e.mock.On("GetAllTagsByRepoAndImage", ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search)}
+//   - opts ...types.QueryOption
+func (_e *MockTagRepository_Expecter) GetAllTagsByRepoAndImage(ctx interface{}, parentID interface{}, repoKey interface{}, image interface{}, sortByField interface{}, sortByOrder interface{}, limit interface{}, offset interface{}, search interface{}, opts ...interface{}) *MockTagRepository_GetAllTagsByRepoAndImage_Call {
+	return &MockTagRepository_GetAllTagsByRepoAndImage_Call{Call: _e.mock.On("GetAllTagsByRepoAndImage",
+		append([]interface{}{ctx, parentID, repoKey, image, sortByField, sortByOrder, limit, offset, search}, opts...)...)}
 }
 
-func (_c *MockTagRepository_GetAllTagsByRepoAndImage_Call) Run(run func(ctx context.Context, parentID int64, repoKey string, image string, sortByField string, sortByOrder string, limit int, offset int, search string)) *MockTagRepository_GetAllTagsByRepoAndImage_Call {
+func (_c *MockTagRepository_GetAllTagsByRepoAndImage_Call) Run(run func(ctx context.Context, parentID int64, repoKey string, image string, sortByField string, sortByOrder string, limit int, offset int, search string, opts ...types.QueryOption)) *MockTagRepository_GetAllTagsByRepoAndImage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1330,6 +1390,12 @@ func (_c *MockTagRepository_GetAllTagsByRepoAndImage_Call) Run(run func(ctx cont
 		if args[8] != nil {
 			arg8 = args[8].(string)
 		}
+		var arg9 []types.QueryOption
+		var variadicArgs []types.QueryOption
+		if len(args) > 9 {
+			variadicArgs = args[9].([]types.QueryOption)
+		}
+		arg9 = variadicArgs
 		run(
 			arg0,
 			arg1,
@@ -1340,6 +1406,7 @@ func (_c *MockTagRepository_GetAllTagsByRepoAndImage_Call) Run(run func(ctx cont
 			arg6,
 			arg7,
 			arg8,
+			arg9...,
 		)
 	})
 	return _c
@@ -1350,7 +1417,7 @@ func (_c *MockTagRepository_GetAllTagsByRepoAndImage_Call) Return(ociVersionMeta
 	return _c
 }
 
-func (_c *MockTagRepository_GetAllTagsByRepoAndImage_Call) RunAndReturn(run func(ctx context.Context, parentID int64, repoKey string, image string, sortByField string, sortByOrder string, limit int, offset int, search string) (*[]types.OciVersionMetadata, error)) *MockTagRepository_GetAllTagsByRepoAndImage_Call {
+func (_c *MockTagRepository_GetAllTagsByRepoAndImage_Call) RunAndReturn(run func(ctx context.Context, parentID int64, repoKey string, image string, sortByField string, sortByOrder string, limit int, offset int, search string, opts ...types.QueryOption) (*[]types.OciVersionMetadata, error)) *MockTagRepository_GetAllTagsByRepoAndImage_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2219,6 +2286,74 @@ func (_c *MockTagRepository_HasTagsAfterName_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// ListDeletedTags provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) ListDeletedTags(ctx context.Context, registryID int64) (*[]types.TrashedOCIVersion, error) {
+	ret := _mock.Called(ctx, registryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeletedTags")
+	}
+
+	var r0 *[]types.TrashedOCIVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*[]types.TrashedOCIVersion, error)); ok {
+		return returnFunc(ctx, registryID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *[]types.TrashedOCIVersion); ok {
+		r0 = returnFunc(ctx, registryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]types.TrashedOCIVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, registryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_ListDeletedTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeletedTags'
+type MockTagRepository_ListDeletedTags_Call struct {
+	*mock.Call
+}
+
+// ListDeletedTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - registryID int64
+func (_e *MockTagRepository_Expecter) ListDeletedTags(ctx interface{}, registryID interface{}) *MockTagRepository_ListDeletedTags_Call {
+	return &MockTagRepository_ListDeletedTags_Call{Call: _e.mock.On("ListDeletedTags", ctx, registryID)}
+}
+
+func (_c *MockTagRepository_ListDeletedTags_Call) Run(run func(ctx context.Context, registryID int64)) *MockTagRepository_ListDeletedTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_ListDeletedTags_Call) Return(trashedOCIVersions *[]types.TrashedOCIVersion, err error) *MockTagRepository_ListDeletedTags_Call {
+	_c.Call.Return(trashedOCIVersions, err)
+	return _c
+}
+
+func (_c *MockTagRepository_ListDeletedTags_Call) RunAndReturn(run func(ctx context.Context, registryID int64) (*[]types.TrashedOCIVersion, error)) *MockTagRepository_ListDeletedTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LockTagByNameForUpdate provides a mock function for the type MockTagRepository
 func (_mock *MockTagRepository) LockTagByNameForUpdate(ctx context.Context, repoID int64, name string) (bool, error) {
 	ret := _mock.Called(ctx, repoID, name)
@@ -2291,6 +2426,234 @@ func (_c *MockTagRepository_LockTagByNameForUpdate_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// PurgeDeletedBefore provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) PurgeDeletedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	ret := _mock.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) (int64, error)); ok {
+		return returnFunc(ctx, before, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) int64); ok {
+		r0 = returnFunc(ctx, before, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_PurgeDeletedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedBefore'
+type MockTagRepository_PurgeDeletedBefore_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - limit int
+func (_e *MockTagRepository_Expecter) PurgeDeletedBefore(ctx interface{}, before interface{}, limit interface{}) *MockTagRepository_PurgeDeletedBefore_Call {
+	return &MockTagRepository_PurgeDeletedBefore_Call{Call: _e.mock.On("PurgeDeletedBefore", ctx, before, limit)}
+}
+
+func (_c *MockTagRepository_PurgeDeletedBefore_Call) Run(run func(ctx context.Context, before time.Time, limit int)) *MockTagRepository_PurgeDeletedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_PurgeDeletedBefore_Call) Return(n int64, err error) *MockTagRepository_PurgeDeletedBefore_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockTagRepository_PurgeDeletedBefore_Call) RunAndReturn(run func(ctx context.Context, before time.Time, limit int) (int64, error)) *MockTagRepository_PurgeDeletedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeTag provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) PurgeTag(ctx context.Context, registryID int64, imageName string, name string) (bool, error) {
+	ret := _mock.Called(ctx, registryID, imageName, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTag")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string) (bool, error)); ok {
+		return returnFunc(ctx, registryID, imageName, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string) bool); ok {
+		r0 = returnFunc(ctx, registryID, imageName, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = returnFunc(ctx, registryID, imageName, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_PurgeTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTag'
+type MockTagRepository_PurgeTag_Call struct {
+	*mock.Call
+}
+
+// PurgeTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - registryID int64
+//   - imageName string
+//   - name string
+func (_e *MockTagRepository_Expecter) PurgeTag(ctx interface{}, registryID interface{}, imageName interface{}, name interface{}) *MockTagRepository_PurgeTag_Call {
+	return &MockTagRepository_PurgeTag_Call{Call: _e.mock.On("PurgeTag", ctx, registryID, imageName, name)}
+}
+
+func (_c *MockTagRepository_PurgeTag_Call) Run(run func(ctx context.Context, registryID int64, imageName string, name string)) *MockTagRepository_PurgeTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_PurgeTag_Call) Return(b bool, err error) *MockTagRepository_PurgeTag_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockTagRepository_PurgeTag_Call) RunAndReturn(run func(ctx context.Context, registryID int64, imageName string, name string) (bool, error)) *MockTagRepository_PurgeTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreTag provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) RestoreTag(ctx context.Context, registryID int64, imageName string, name string) (int64, error) {
+	ret := _mock.Called(ctx, registryID, imageName, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreTag")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string) (int64, error)); ok {
+		return returnFunc(ctx, registryID, imageName, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string) int64); ok {
+		r0 = returnFunc(ctx, registryID, imageName, name)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = returnFunc(ctx, registryID, imageName, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_RestoreTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreTag'
+type MockTagRepository_RestoreTag_Call struct {
+	*mock.Call
+}
+
+// RestoreTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - registryID int64
+//   - imageName string
+//   - name string
+func (_e *MockTagRepository_Expecter) RestoreTag(ctx interface{}, registryID interface{}, imageName interface{}, name interface{}) *MockTagRepository_RestoreTag_Call {
+	return &MockTagRepository_RestoreTag_Call{Call: _e.mock.On("RestoreTag", ctx, registryID, imageName, name)}
+}
+
+func (_c *MockTagRepository_RestoreTag_Call) Run(run func(ctx context.Context, registryID int64, imageName string, name string)) *MockTagRepository_RestoreTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_RestoreTag_Call) Return(n int64, err error) *MockTagRepository_RestoreTag_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockTagRepository_RestoreTag_Call) RunAndReturn(run func(ctx context.Context, registryID int64, imageName string, name string) (int64, error)) *MockTagRepository_RestoreTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TagsPaginated provides a mock function for the type MockTagRepository
 func (_mock *MockTagRepository) TagsPaginated(ctx context.Context, repoID int64, image string, filters types.FilterParams) ([]*types.Tag, error) {
 	ret := _mock.Called(ctx, repoID, image, filters)
@@ -6,6 +6,9 @@ import (
 	"context"
 
 	gitnesswebhook "github.com/harness/gitness/app/services/webhook"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -37,3 +40,63 @@ func (m *WebhookService) ReTriggerWebhookExecution(ctx context.Context, webhookE
 
 	return r0, r1
 }
+
+// TestWebhook provides a mock function
+func (m *WebhookService) TestWebhook(ctx context.Context, webhook *types.WebhookCore, registry *registrytypes.Registry, principal *types.Principal, triggerType enum.WebhookTrigger) (*gitnesswebhook.TriggerResult, error) {
+	ret := m.Called(ctx, webhook, registry, principal, triggerType)
+
+	var r0 *gitnesswebhook.TriggerResult
+	if rf, ok := ret.Get(0).(func(context.Context, *types.WebhookCore, *registrytypes.Registry, *types.Principal, enum.WebhookTrigger) *gitnesswebhook.TriggerResult); ok {
+		r0 = rf(ctx, webhook, registry, principal, triggerType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gitnesswebhook.TriggerResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.WebhookCore, *registrytypes.Registry, *types.Principal, enum.WebhookTrigger) error); ok {
+		r1 = rf(ctx, webhook, registry, principal, triggerType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplayWebhookExecution provides a mock function
+func (m *WebhookService) ReplayWebhookExecution(ctx context.Context, webhookExecutionID int64, url string) (*gitnesswebhook.TriggerResult, error) {
+	ret := m.Called(ctx, webhookExecutionID, url)
+
+	var r0 *gitnesswebhook.TriggerResult
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *gitnesswebhook.TriggerResult); ok {
+		r0 = rf(ctx, webhookExecutionID, url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gitnesswebhook.TriggerResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, webhookExecutionID, url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OpenPayloads provides a mock function
+func (m *WebhookService) OpenPayloads(executions []*types.WebhookExecutionCore) error {
+	ret := m.Called(executions)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*types.WebhookExecutionCore) error); ok {
+		r0 = rf(executions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
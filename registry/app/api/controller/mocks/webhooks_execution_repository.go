@@ -110,3 +110,24 @@ func (m *WebhooksExecutionRepository) ListForTrigger(ctx context.Context, trigge
 
 	return r0, r1
 }
+
+// PurgePayloads provides a mock function
+func (m *WebhooksExecutionRepository) PurgePayloads(ctx context.Context, createdBefore int64, limit int) (int64, error) {
+	ret := m.Called(ctx, createdBefore, limit)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) int64); ok {
+		r0 = rf(ctx, createdBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, createdBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,205 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package content serves the files and blobs stored in the registries of a root space by their digest, so clients
+// which only know the digest of a content, like build caches and SBOM tools, don't need its package coordinates.
+package content
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth/authz"
+	corerefcache "github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/services/refcache"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/rs/zerolog/log"
+)
+
+// Content is a file or blob found by its digest. The caller has to close the reader.
+type Content struct {
+	Reader *storage.FileReader
+	Digest digest.Digest
+	Size   int64
+}
+
+type Controller interface {
+	// GetContent returns the content with the digest, if one of the registries of the root space which reference
+	// the content grants the caller the download permission.
+	GetContent(ctx context.Context, rootIdentifier string, dgst string) (*Content, error)
+}
+
+type controller struct {
+	spaceFinder     corerefcache.SpaceFinder
+	registryFinder  refcache.RegistryFinder
+	authorizer      authz.Authorizer
+	genericBlobDao  store.GenericBlobRepository
+	nodesDao        store.NodesRepository
+	blobDao         store.BlobRepository
+	registryBlobDao store.RegistryBlobRepository
+	fileManager     filemanager.FileManager
+	storageService  *storage.Service
+}
+
+func NewController(
+	spaceFinder corerefcache.SpaceFinder,
+	registryFinder refcache.RegistryFinder,
+	authorizer authz.Authorizer,
+	genericBlobDao store.GenericBlobRepository,
+	nodesDao store.NodesRepository,
+	blobDao store.BlobRepository,
+	registryBlobDao store.RegistryBlobRepository,
+	fileManager filemanager.FileManager,
+	storageService *storage.Service,
+) Controller {
+	return &controller{
+		spaceFinder:     spaceFinder,
+		registryFinder:  registryFinder,
+		authorizer:      authorizer,
+		genericBlobDao:  genericBlobDao,
+		nodesDao:        nodesDao,
+		blobDao:         blobDao,
+		registryBlobDao: registryBlobDao,
+		fileManager:     fileManager,
+		storageService:  storageService,
+	}
+}
+
+func (c *controller) GetContent(ctx context.Context, rootIdentifier string, dgst string) (*Content, error) {
+	d, err := parseDigest(dgst)
+	if err != nil {
+		return nil, err
+	}
+
+	rootSpace, err := c.spaceFinder.FindByRef(ctx, rootIdentifier)
+	if err != nil || rootSpace.ParentID != 0 {
+		return nil, usererror.NotFoundf("Root not found: %s", rootIdentifier)
+	}
+
+	// the files of generic-engine packages are looked up first, they are the contents most clients ask for.
+	content, err := c.getFile(ctx, rootIdentifier, rootSpace.ID, d)
+	if err == nil || !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return content, err
+	}
+	content, err = c.getOciBlob(ctx, rootIdentifier, rootSpace.ID, d)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, usererror.NotFoundf("Content not found: %s", d)
+	}
+	return content, err
+}
+
+func (c *controller) getFile(
+	ctx context.Context, rootIdentifier string, rootParentID int64, d digest.Digest,
+) (*Content, error) {
+	blob, err := c.genericBlobDao.FindBySha256AndRootParentID(ctx, d.Encoded(), rootParentID)
+	if err != nil {
+		return nil, err
+	}
+	registryIDs, err := c.nodesDao.GetRegistryIDsByBlobID(ctx, blob.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find registries of file %s: %w", d, err)
+	}
+	registry, err := c.findAccessibleRegistry(ctx, registryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := c.fileManager.DownloadFileByDigest(ctx, rootIdentifier, types.FileInfo{
+		Sha256: blob.Sha256,
+		Size:   blob.Size,
+	}, rootParentID, registry.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", d, err)
+	}
+	return &Content{Reader: reader, Digest: d, Size: blob.Size}, nil
+}
+
+func (c *controller) getOciBlob(
+	ctx context.Context, rootIdentifier string, rootParentID int64, d digest.Digest,
+) (*Content, error) {
+	blob, err := c.blobDao.FindByDigestAndRootParentID(ctx, d, rootParentID)
+	if err != nil {
+		return nil, err
+	}
+	registryIDs, err := c.registryBlobDao.GetRegistryIDsByBlobID(ctx, blob.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find registries of blob %s: %w", d, err)
+	}
+	registry, err := c.findAccessibleRegistry(ctx, registryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	blobStore := c.storageService.OciBlobsStore(ctx, registry.Name, rootIdentifier, types.BlobLocator{
+		Digest:       d,
+		BlobID:       blob.ID,
+		RegistryID:   registry.ID,
+		RootParentID: rootParentID,
+	})
+	reader, size, err := blobStore.GetBlobInternal(ctx, rootIdentifier, d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob %s: %w", d, err)
+	}
+	return &Content{Reader: reader, Digest: d, Size: size}, nil
+}
+
+// findAccessibleRegistry returns the first of the registries the caller may download from. A content referenced by
+// registries the caller has no access to is reported as not found, so its existence isn't disclosed.
+func (c *controller) findAccessibleRegistry(ctx context.Context, registryIDs []int64) (*types.Registry, error) {
+	var accessErr error
+	for _, registryID := range registryIDs {
+		registry, err := c.registryFinder.FindByID(ctx, registryID)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to find registry %d of content", registryID)
+			continue
+		}
+		info := pkg.ArtifactInfo{Registry: *registry}
+		err = pkg.GetRegistryCheckAccess(ctx, c.authorizer, c.spaceFinder, registry.ParentID, info,
+			enum.PermissionArtifactsDownload)
+		if err == nil {
+			return registry, nil
+		}
+		if !apiauth.IsNoAccess(err) {
+			return nil, err
+		}
+		accessErr = err
+	}
+
+	if errors.Is(accessErr, apiauth.ErrUnauthorized) {
+		return nil, accessErr
+	}
+	return nil, gitnessstore.ErrResourceNotFound
+}
+
+func parseDigest(dgst string) (digest.Digest, error) {
+	d, err := digest.Parse(dgst)
+	if err != nil {
+		return "", usererror.BadRequestf("Invalid digest: %s", dgst)
+	}
+	if d.Algorithm() != digest.SHA256 {
+		return "", usererror.BadRequestf("Unsupported digest algorithm: %s", d.Algorithm())
+	}
+	return d, nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package content
+
+import (
+	"testing"
+)
+
+func TestParseDigest(t *testing.T) {
+	tests := []struct {
+		digest string
+		valid  bool
+	}{
+		{digest: "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", valid: true},
+		{digest: "sha256:2cf24dba", valid: false},
+		{digest: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", valid: false},
+		{digest: "sha512:" + "cf83e1357eefb8bdf1542850d66d8007d620e4050b5715dc83f4a921d36ce9ce" +
+			"47d0d13c5d85f2b0ff8318d2877eec2f63b931bd47417a81a538327af927da3e", valid: false},
+	}
+	for _, tt := range tests {
+		_, err := parseDigest(tt.digest)
+		if (err == nil) != tt.valid {
+			t.Errorf("parseDigest(%q): expected valid %t, got error %v", tt.digest, tt.valid, err)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package content
+
+import (
+	"github.com/harness/gitness/app/auth/authz"
+	corerefcache "github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/services/refcache"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+
+	"github.com/google/wire"
+)
+
+func ControllerProvider(
+	spaceFinder corerefcache.SpaceFinder,
+	registryFinder refcache.RegistryFinder,
+	authorizer authz.Authorizer,
+	genericBlobDao store.GenericBlobRepository,
+	nodesDao store.NodesRepository,
+	blobDao store.BlobRepository,
+	registryBlobDao store.RegistryBlobRepository,
+	fileManager filemanager.FileManager,
+	storageService *storage.Service,
+) Controller {
+	return NewController(
+		spaceFinder, registryFinder, authorizer, genericBlobDao, nodesDao, blobDao, registryBlobDao,
+		fileManager, storageService,
+	)
+}
+
+var ControllerSet = wire.NewSet(ControllerProvider)
@@ -32,6 +32,8 @@ type Controller interface {
 	ValidateYaml(ctx context.Context, info hftype.ArtifactInfo, body io.ReadCloser) *ValidateYamlResponse
 	PreUpload(ctx context.Context, info hftype.ArtifactInfo, body io.ReadCloser) *PreUploadResponse
 	RevisionInfo(ctx context.Context, info hftype.ArtifactInfo, queryParams map[string][]string) *RevisionInfoResponse
+	ListTree(ctx context.Context, info hftype.ArtifactInfo, dir string, recursive bool) *TreeResponse
+	PathsInfo(ctx context.Context, info hftype.ArtifactInfo, paths []string) *TreeResponse
 	LfsInfo(ctx context.Context, info hftype.ArtifactInfo, body io.ReadCloser, token string) *LfsInfoResponse
 	LfsVerify(ctx context.Context, info hftype.ArtifactInfo, body io.ReadCloser) *LfsVerifyResponse
 	CommitRevision(ctx context.Context, info hftype.ArtifactInfo, body io.ReadCloser) *CommitRevisionResponse
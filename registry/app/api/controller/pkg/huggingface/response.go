@@ -24,6 +24,7 @@ import (
 var _ response.Response = (*ValidateYamlResponse)(nil)
 var _ response.Response = (*PreUploadResponse)(nil)
 var _ response.Response = (*RevisionInfoResponse)(nil)
+var _ response.Response = (*TreeResponse)(nil)
 var _ response.Response = (*LfsInfoResponse)(nil)
 var _ response.Response = (*LfsVerifyResponse)(nil)
 var _ response.Response = (*LfsUploadResponse)(nil)
@@ -56,6 +57,12 @@ type RevisionInfoResponse struct {
 	Response *huggingfacetype.RevisionInfoResponse
 }
 
+// TreeResponse represents a response listing repository tree entries.
+type TreeResponse struct {
+	BaseResponse
+	Response []huggingfacetype.TreeEntry
+}
+
 type LfsInfoResponse struct {
 	BaseResponse
 	Response *huggingfacetype.LfsInfoResponse
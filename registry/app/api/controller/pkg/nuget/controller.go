@@ -62,7 +62,7 @@ type Controller interface {
 
 	SearchPackage(
 		ctx context.Context, info nugettype.ArtifactInfo, searchTerm string,
-		limit, offset int,
+		includePrerelease bool, limit, offset int,
 	) *SearchPackageResponse
 
 	SearchPackageV2(
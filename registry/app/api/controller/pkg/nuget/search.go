@@ -28,7 +28,7 @@ import (
 
 func (c *controller) SearchPackage(
 	ctx context.Context, info nugettype.ArtifactInfo,
-	searchTerm string, limit, offset int,
+	searchTerm string, includePrerelease bool, limit, offset int,
 ) *SearchPackageResponse {
 	f := func(registry registrytypes.Registry, a pkg.Artifact, l, o int) response.Response {
 		info.UpdateRegistryInfo(registry)
@@ -41,7 +41,7 @@ func (c *controller) SearchPackage(
 				}, nil,
 			}
 		}
-		feedResponse, err := nugetRegistry.SearchPackage(ctx, info, searchTerm, l, o)
+		feedResponse, err := nugetRegistry.SearchPackage(ctx, info, searchTerm, includePrerelease, l, o)
 		return &SearchPackageResponse{
 			BaseResponse{
 				err,
@@ -37,6 +37,7 @@ func (c *controller) UploadPackageFile(
 	f := func(registry registrytypes.Registry, a pkg.Artifact) response.Response {
 		info.RegIdentifier = registry.Name
 		info.RegistryID = registry.ID
+		info.Registry = registry
 		rpmRegistry, ok := a.(rpm.Registry)
 		if !ok {
 			return &PutArtifactResponse{
@@ -227,6 +227,10 @@ func (f *fakePackagesHandler) ServeContent(w http.ResponseWriter, r *http.Reques
 	f.Called(w, r, fileReader, filename)
 }
 
+func (f *fakePackagesHandler) ServePlugin(w http.ResponseWriter, r *http.Request) {
+	f.Called(w, r)
+}
+
 // --- Tests ---.
 func TestDownloadPackage_ServeContent(t *testing.T) {
 	// Arrange
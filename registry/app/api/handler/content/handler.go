@@ -0,0 +1,68 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package content
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/harness/gitness/registry/app/api/controller/pkg/content"
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+type Handler interface {
+	GetContent(w http.ResponseWriter, r *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller content.Controller
+}
+
+func NewHandler(
+	controller content.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+// GetContent serves GET and HEAD requests of /{rootIdentifier}/content/{digest}.
+func (h *handler) GetContent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	c, err := h.controller.GetContent(ctx, chi.URLParam(r, "rootIdentifier"), chi.URLParam(r, "digest"))
+	if err != nil {
+		h.HandleError(ctx, w, err)
+		return
+	}
+	defer func() {
+		if err := c.Reader.Close(); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to close content %s", c.Digest)
+		}
+	}()
+
+	// the content of a digest never changes, so it can be cached for good.
+	w.Header().Set("ETag", strconv.Quote(c.Digest.String()))
+	w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+	w.Header().Set("Content-Type", "application/octet-stream")
+	h.ServeContent(w, r, c.Reader, c.Digest.Encoded())
+}
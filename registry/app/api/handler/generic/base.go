@@ -42,6 +42,7 @@ import (
 	generic2 "github.com/harness/gitness/registry/app/pkg/types/generic"
 	refcache2 "github.com/harness/gitness/registry/app/services/refcache"
 	"github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/registry/services/recentactivity"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog/log"
@@ -67,6 +68,7 @@ func NewGenericArtifactHandler(
 	userCtrl *usercontroller.Controller, authenticator authn.Authenticator, urlProvider urlprovider.Provider,
 	authorizer authz.Authorizer, packageHandler packages.Handler, spaceFinder refcache.SpaceFinder,
 	registryFinder refcache2.RegistryFinder, auditService audit.Service,
+	recentActivity *recentactivity.Service,
 ) *Handler {
 	return &Handler{
 		Handler:        packageHandler,
@@ -80,6 +82,7 @@ func NewGenericArtifactHandler(
 		SpaceFinder:    spaceFinder,
 		RegistryFinder: registryFinder,
 		AuditService:   auditService,
+		RecentActivity: recentActivity,
 	}
 }
 
@@ -95,6 +98,7 @@ type Handler struct {
 	SpaceFinder    refcache.SpaceFinder
 	RegistryFinder refcache2.RegistryFinder
 	AuditService   audit.Service
+	RecentActivity *recentactivity.Service
 }
 
 //nolint:staticcheck
@@ -52,6 +52,7 @@ func (h *Handler) serveContent(
 	w http.ResponseWriter, r *http.Request, fileReader *storage.FileReader, info pkg.GenericArtifactInfo,
 ) {
 	if fileReader != nil {
+		w = commons.ThrottleDownload(w, r, info.Registry)
 		http.ServeContent(w, r, info.FileName, time.Time{}, fileReader)
 	}
 }
@@ -17,13 +17,20 @@ package generic
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/registry/app/pkg/types/generic"
 	"github.com/harness/gitness/registry/request"
 
+	"github.com/opencontainers/go-digest"
 	"github.com/rs/zerolog/log"
 )
 
+// HeaderChecksumSha256 announces the digest of the uploaded file, so its content isn't stored again when
+// the blob already exists.
+const HeaderChecksumSha256 = "X-Checksum-Sha256"
+
 // PutFile handles file upload requests.
 func (h *Handler) PutFile(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -41,6 +48,14 @@ func (h *Handler) PutFile(w http.ResponseWriter, r *http.Request) {
 		contentType = "application/octet-stream"
 	}
 
+	info.Sha256 = strings.ToLower(r.Header.Get(HeaderChecksumSha256))
+	if info.Sha256 != "" {
+		if err := digest.SHA256.Validate(info.Sha256); err != nil {
+			h.HandleError(ctx, w, usererror.BadRequestf("invalid %s header: %s", HeaderChecksumSha256, err))
+			return
+		}
+	}
+
 	// Upload file
 	response := h.Controller.PutFile(ctx, info, r.Body, contentType)
 	if response.GetError() != nil {
@@ -46,6 +46,8 @@ type Handler interface {
 	LfsVerify(writer http.ResponseWriter, request *http.Request)
 	PreUpload(writer http.ResponseWriter, request *http.Request)
 	RevisionInfo(w http.ResponseWriter, r *http.Request)
+	ListTree(w http.ResponseWriter, r *http.Request)
+	PathsInfo(w http.ResponseWriter, r *http.Request)
 	CommitRevision(writer http.ResponseWriter, request *http.Request)
 	HeadFile(w http.ResponseWriter, r *http.Request)
 	DownloadFile(w http.ResponseWriter, r *http.Request)
@@ -81,9 +83,12 @@ func (h *handler) GetPackageArtifactInfo(r *http.Request) (pkg.PackageArtifactIn
 	repo := chi.URLParam(r, "repo")
 	rev := chi.URLParam(r, "rev")
 	sha256 := chi.URLParam(r, "sha256")
-	if repoType != "" {
+	switch {
+	case repoType != "":
 		repoType = strings.TrimSuffix(repoType, "s")
-	} else {
+	case info.Registry.Config != nil && info.Registry.Config.DefaultArtifactType != nil:
+		repoType = string(*info.Registry.Config.DefaultArtifactType)
+	default:
 		repoType = "model"
 	}
 
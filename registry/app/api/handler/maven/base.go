@@ -43,6 +43,7 @@ import (
 	mavenutils "github.com/harness/gitness/registry/app/pkg/maven/utils"
 	refcache2 "github.com/harness/gitness/registry/app/services/refcache"
 	"github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/registry/services/recentactivity"
 
 	"github.com/rs/zerolog/log"
 )
@@ -58,6 +59,7 @@ type Handler struct {
 	RegistryFinder      refcache2.RegistryFinder
 	PublicAccessService publicaccess.Service
 	AuditService        audit.Service
+	RecentActivity      *recentactivity.Service
 }
 
 func NewHandler(
@@ -65,6 +67,7 @@ func NewHandler(
 	userCtrl *usercontroller.Controller, authenticator authn.Authenticator, authorizer authz.Authorizer,
 	spaceFinder refcache.SpaceFinder, registryFinder refcache2.RegistryFinder,
 	publicAccessService publicaccess.Service, auditService audit.Service,
+	recentActivity *recentactivity.Service,
 ) *Handler {
 	return &Handler{
 		Controller:          controller,
@@ -77,6 +80,7 @@ func NewHandler(
 		RegistryFinder:      registryFinder,
 		PublicAccessService: publicAccessService,
 		AuditService:        auditService,
+		RecentActivity:      recentActivity,
 	}
 }
 
@@ -0,0 +1,78 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maven
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/pkg"
+	mavenutils "github.com/harness/gitness/registry/app/pkg/maven/utils"
+)
+
+// TestExtractPathVarsGradlePluginMarker resolves the requests of `pluginManagement` for the plugin
+// com.example.greeting, whose marker is com.example.greeting:com.example.greeting.gradle.plugin.
+func TestExtractPathVarsGradlePluginMarker(t *testing.T) {
+	const markerDir = "com/example/greeting/com.example.greeting.gradle.plugin"
+	tests := []struct {
+		name        string
+		path        string
+		wantVersion string
+		wantFile    string
+	}{
+		{
+			name:        "marker pom",
+			path:        "/maven/root/reg/" + markerDir + "/1.0/com.example.greeting.gradle.plugin-1.0.pom",
+			wantVersion: "1.0",
+			wantFile:    "com.example.greeting.gradle.plugin-1.0.pom",
+		},
+		{
+			name:        "marker pom through the package path",
+			path:        "/pkg/root/reg/maven/" + markerDir + "/1.0/com.example.greeting.gradle.plugin-1.0.pom",
+			wantVersion: "1.0",
+			wantFile:    "com.example.greeting.gradle.plugin-1.0.pom",
+		},
+		{
+			name:     "marker metadata",
+			path:     "/maven/root/reg/" + markerDir + "/maven-metadata.xml",
+			wantFile: "maven-metadata.xml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, registry, groupID, artifactID, version, fileName, err := ExtractPathVars(tt.path)
+			if err != nil {
+				t.Fatalf("failed to extract path vars: %v", err)
+			}
+			if root != "root" || registry != "reg" {
+				t.Errorf("unexpected root %q and registry %q", root, registry)
+			}
+			if groupID != "com.example.greeting" || artifactID != "com.example.greeting.gradle.plugin" {
+				t.Errorf("unexpected coordinates %s:%s", groupID, artifactID)
+			}
+			if version != tt.wantVersion || fileName != tt.wantFile {
+				t.Errorf("unexpected version %q and file %q", version, fileName)
+			}
+
+			info := pkg.MavenArtifactInfo{GroupID: groupID, ArtifactID: artifactID, Version: version, FileName: fileName}
+			wantPath := "/" + markerDir + "/" + tt.wantFile
+			if tt.wantVersion != "" {
+				wantPath = "/" + markerDir + "/" + tt.wantVersion + "/" + tt.wantFile
+			}
+			if got := mavenutils.GetFilePath(info); got != wantPath {
+				t.Errorf("marker is stored at %q, want %q", got, wantPath)
+			}
+		})
+	}
+}
@@ -70,6 +70,7 @@ func (h *Handler) GetArtifact(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) serveContent(
 	w http.ResponseWriter, r *http.Request, response *maven.GetArtifactResponse, info pkg.MavenArtifactInfo,
 ) {
+	w = commons.ThrottleDownload(w, r, info.Registry)
 	if response.Body != nil {
 		http.ServeContent(w, r, info.FileName, time.Time{}, response.Body)
 	} else {
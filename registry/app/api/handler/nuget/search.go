@@ -43,15 +43,19 @@ func (h *handler) SearchPackage(w http.ResponseWriter, r *http.Request) {
 	if err2 != nil {
 		limit = 20
 	}
-	response := h.controller.SearchPackage(r.Context(), *info, searchTerm, limit, offset)
+	includePrerelease, err3 := strconv.ParseBool(r.URL.Query().Get("prerelease"))
+	if err3 != nil {
+		includePrerelease = false
+	}
+	response := h.controller.SearchPackage(r.Context(), *info, searchTerm, includePrerelease, limit, offset)
 
 	if response.GetError() != nil {
 		h.HandleError(r.Context(), w, response.GetError())
 		return
 	}
-	err3 := json.NewEncoder(w).Encode(response.SearchResponse)
-	if err3 != nil {
-		h.HandleErrors(r.Context(), []error{err3}, w)
+	err4 := json.NewEncoder(w).Encode(response.SearchResponse)
+	if err4 != nil {
+		h.HandleErrors(r.Context(), []error{err4}, w)
 		return
 	}
 }
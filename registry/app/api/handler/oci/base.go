@@ -38,6 +38,7 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/docker"
 	refcache2 "github.com/harness/gitness/registry/app/services/refcache"
 	"github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/registry/services/recentactivity"
 
 	v2 "github.com/distribution/distribution/v3/registry/api/v2"
 	"github.com/opencontainers/go-digest"
@@ -58,6 +59,7 @@ func NewHandler(
 	publicAccessService publicaccess.Service,
 	anonymousUserSecret string,
 	auditService audit.Service,
+	recentActivity *recentactivity.Service,
 ) *Handler {
 	return &Handler{
 		Controller:          controller,
@@ -73,6 +75,7 @@ func NewHandler(
 		PublicAccessService: publicAccessService,
 		AnonymousUserSecret: anonymousUserSecret,
 		AuditService:        auditService,
+		RecentActivity:      recentActivity,
 	}
 }
 
@@ -90,6 +93,7 @@ type Handler struct {
 	PublicAccessService publicaccess.Service
 	AnonymousUserSecret string
 	AuditService        audit.Service
+	RecentActivity      *recentactivity.Service
 }
 
 type routeType string
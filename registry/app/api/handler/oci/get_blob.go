@@ -83,6 +83,7 @@ func (h *Handler) GetBlob(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) serveContent(
 	w http.ResponseWriter, r *http.Request, response *docker.GetBlobResponse, info pkg.RegistryInfo,
 ) {
+	w = commons.ThrottleDownload(w, r, info.Registry)
 	if response.Body != nil {
 		http.ServeContent(w, r, info.Digest, time.Time{}, response.Body)
 	} else {
@@ -45,6 +45,7 @@ import (
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/registry/services/recentactivity"
 	"github.com/harness/gitness/types/enum"
 
 	"github.com/rs/zerolog/log"
@@ -62,6 +63,7 @@ func NewHandler(
 	packageWrapper interfaces.PackageWrapper,
 	auditService audit.Service,
 	artifactDao store.ArtifactRepository,
+	recentActivity *recentactivity.Service,
 ) Handler {
 	return &handler{
 		RegistryDao:      registryDao,
@@ -80,6 +82,7 @@ func NewHandler(
 		PackageWrapper:   packageWrapper,
 		AuditService:     auditService,
 		ArtifactDao:      artifactDao,
+		RecentActivity:   recentActivity,
 	}
 }
 
@@ -100,6 +103,7 @@ type handler struct {
 	PackageWrapper   interfaces.PackageWrapper
 	AuditService     audit.Service
 	ArtifactDao      store.ArtifactRepository
+	RecentActivity   *recentactivity.Service
 }
 
 type Handler interface {
@@ -127,6 +131,7 @@ type Handler interface {
 	ServeContent(
 		w http.ResponseWriter, r *http.Request, fileReader *storage.FileReader, filename string,
 	)
+	ServePlugin(w http.ResponseWriter, r *http.Request)
 }
 
 type PathPackageType string
@@ -174,6 +179,14 @@ func (h *handler) TrackDownloadStats(
 		return usererror.ErrInternal
 	}
 
+	h.RecentActivity.RecordPull(
+		ctx,
+		info.BaseArtifactInfo().RegistryID,
+		info.BaseArtifactInfo().Image,
+		info.BaseArtifactInfo().ArtifactType,
+		info.GetVersion(),
+	)
+
 	return nil
 }
 
@@ -386,6 +399,7 @@ func (h *handler) ServeContent(
 	w http.ResponseWriter, r *http.Request, fileReader *storage.FileReader, filename string,
 ) {
 	if fileReader != nil {
+		w = commons.ThrottleFromContext(w, r)
 		http.ServeContent(w, r, filename, time.Time{}, fileReader)
 	}
 }
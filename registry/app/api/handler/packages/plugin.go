@@ -0,0 +1,115 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packages
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/harness/gitness/app/api/render"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/registry/app/pkg/plugin"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// ServePlugin serves the requests of package types implemented by plugins. GET on a version lists its files,
+// GET and PUT on a file download and upload it and DELETE on a version deletes it. Errors of the plugins are
+// rendered as user errors, so plugins can return a usererror.Error to answer with a specific status.
+func (h *handler) ServePlugin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.GetArtifactInfo(r)
+	if err != nil {
+		h.HandleError(ctx, w, err)
+		return
+	}
+
+	packageType := string(info.Registry.PackageType)
+	target := plugin.Target{
+		Registry:       &info.Registry,
+		RootIdentifier: info.RootIdentifier,
+		Artifact:       chi.URLParam(r, "package"),
+		Version:        chi.URLParam(r, "version"),
+		Path:           chi.URLParam(r, "*"),
+	}
+
+	switch {
+	case r.Method == http.MethodGet && target.Path == "":
+		entries, err := plugin.List(ctx, packageType, target)
+		if err != nil {
+			h.HandleError(ctx, w, pluginError(err))
+			return
+		}
+		render.JSON(w, http.StatusOK, entries)
+
+	case (r.Method == http.MethodGet || r.Method == http.MethodHead) && target.Path != "":
+		file, err := plugin.Download(ctx, packageType, target)
+		if err != nil {
+			h.HandleError(ctx, w, pluginError(err))
+			return
+		}
+		defer file.Body.Close()
+		if file.ContentType != "" {
+			w.Header().Set("Content-Type", file.ContentType)
+		}
+		if file.Size > 0 {
+			w.Header().Set("Content-Length", strconv.FormatInt(file.Size, 10))
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodHead {
+			return
+		}
+		if _, err := io.Copy(w, file.Body); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to serve file %s of plugin package", target.Path)
+		}
+
+	case r.Method == http.MethodPut && target.Path != "":
+		if err := plugin.Upload(ctx, packageType, target, r.Body); err != nil {
+			h.HandleError(ctx, w, pluginError(err))
+			return
+		}
+		h.reportPluginIndexEvent(r, info.RegistryID, target.Artifact)
+		w.WriteHeader(http.StatusCreated)
+
+	case r.Method == http.MethodDelete && target.Path == "":
+		if err := plugin.Delete(ctx, packageType, target); err != nil {
+			h.HandleError(ctx, w, pluginError(err))
+			return
+		}
+		h.reportPluginIndexEvent(r, info.RegistryID, target.Artifact)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		h.HandleError(ctx, w, usererror.New(http.StatusMethodNotAllowed, "method not allowed"))
+	}
+}
+
+func (h *handler) reportPluginIndexEvent(r *http.Request, registryID int64, artifactName string) {
+	err := h.PackageWrapper.ReportBuildPackageIndexEvent(r.Context(), registryID, artifactName)
+	if err != nil {
+		log.Ctx(r.Context()).Warn().Err(err).Msgf("failed to report build package index event for %s", artifactName)
+	}
+}
+
+// pluginError answers operations plugins don't implement as not allowed.
+func pluginError(err error) error {
+	if errors.Is(err, plugin.ErrNotSupported) {
+		return usererror.New(http.StatusMethodNotAllowed, err.Error())
+	}
+	return err
+}
@@ -36,7 +36,11 @@ const HTMLTemplate = `
 		{{- /* PEP 503 – Simple Repository API: https://peps.python.org/pep-0503/ */ -}}
 		<h1>Links for {{.Name}}</h1>
 			{{range .Files}}
-				<a href="{{.FileURL}}"{{if .RequiresPython}} data-requires-python="{{.RequiresPython}}"{{end}}>{{.Name}}</a><br>
+				<a href="{{.FileURL}}"
+					{{- if .RequiresPython}} data-requires-python="{{.RequiresPython}}"{{end}}
+					{{- /* PEP 658/714 – core metadata: https://peps.python.org/pep-0714/ */ -}}
+					{{- if .MetadataSha256}} data-dist-info-metadata="sha256={{.MetadataSha256}}"{{end}}
+					{{- if .MetadataSha256}} data-core-metadata="sha256={{.MetadataSha256}}"{{end}}>{{.Name}}</a><br>
 			{{end}}
 	</body>
 </html>
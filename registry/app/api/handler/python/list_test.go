@@ -0,0 +1,48 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package python
+
+import (
+	"bytes"
+	"html/template"
+	"testing"
+
+	pythontype "github.com/harness/gitness/registry/app/pkg/types/python"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTMLTemplateCoreMetadata(t *testing.T) {
+	tmpl, err := template.New("simple").Parse(HTMLTemplate)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	err = tmpl.Execute(buf, pythontype.PackageMetadata{
+		Name: "pkg",
+		Files: []pythontype.File{
+			{FileURL: "/files/pkg-1.0-py3-none-any.whl", Name: "pkg-1.0-py3-none-any.whl",
+				RequiresPython: ">=3.8", MetadataSha256: "abc"},
+			{FileURL: "/files/pkg-1.0.tar.gz", Name: "pkg-1.0.tar.gz"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `<a href="/files/pkg-1.0-py3-none-any.whl" `+
+		`data-requires-python="&gt;=3.8" data-dist-info-metadata="sha256=abc" `+
+		`data-core-metadata="sha256=abc">pkg-1.0-py3-none-any.whl</a>`)
+	assert.Contains(t, buf.String(), `<a href="/files/pkg-1.0.tar.gz">pkg-1.0.tar.gz</a>`,
+		"distributions without core metadata don't announce it")
+}
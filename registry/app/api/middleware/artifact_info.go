@@ -33,6 +33,8 @@ func StoreArtifactInfo(
 				render.TranslatedUserError(r.Context(), w, err)
 				return
 			}
+			updateArtifactLogContext(r, packageInfo)
+
 			// Store the base artifact info in the context
 			ctx := request.WithArtifactInfo(r.Context(), packageInfo)
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -0,0 +1,46 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"io"
+	"net/http"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/klauspost/compress/zstd"
+	"github.com/rs/zerolog/log"
+)
+
+// compressionLevel is the gzip level of the compressed responses.
+const compressionLevel = 5
+
+var zstdOptions = []zstd.EOption{zstd.WithEncoderConcurrency(1), zstd.WithEncoderLevel(zstd.SpeedDefault)}
+
+// CompressJSON compresses the JSON responses for the clients which accept it, zstd is preferred over gzip.
+func CompressJSON() func(http.Handler) http.Handler {
+	compressor := chimiddleware.NewCompressor(compressionLevel, "application/json")
+	opts := zstdOptions
+	// zstd.NewWriter only fails on invalid options, the default options are used in that case so creating the
+	// encoder of a response can't fail.
+	if _, err := zstd.NewWriter(nil, opts...); err != nil {
+		log.Error().Err(err).Msg("invalid zstd encoder options, using the default options")
+		opts = nil
+	}
+	compressor.SetEncoder("zstd", func(w io.Writer, _ int) io.Writer {
+		enc, _ := zstd.NewWriter(w, opts...)
+		return enc
+	})
+	return compressor.Handler
+}
@@ -37,6 +37,7 @@ import (
 	mavenutils "github.com/harness/gitness/registry/app/pkg/maven/utils"
 	pythonutils "github.com/harness/gitness/registry/app/pkg/python/utils"
 	"github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/registry/services/recentactivity"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store"
 
@@ -75,7 +76,7 @@ func TrackDownloadStat(h *oci.Handler) func(http.Handler) http.Handler {
 					return
 				}
 
-				err = dbDownloadStat(ctx, h.Controller, info, h.AuditService, h.SpaceFinder)
+				err = dbDownloadStat(ctx, h.Controller, info, h.AuditService, h.SpaceFinder, h.RecentActivity)
 				if err != nil {
 					log.Ctx(ctx).Error().Stack().Str("middleware",
 						"TrackDownloadStat").Err(err).Msgf("error while putting download stat of artifact, %v",
@@ -93,6 +94,7 @@ func dbDownloadStat(
 	info pkg.RegistryInfo,
 	auditService audit.Service,
 	spaceFinder refcache.SpaceFinder,
+	recentActivity *recentactivity.Service,
 ) error {
 	registry := info.Registry
 
@@ -134,11 +136,13 @@ func dbDownloadStat(
 
 	downloadStat := &types.DownloadStat{
 		ArtifactID: artifact.ID,
+		RegistryID: registry.ID,
 	}
 
 	if err := c.DBStore.DownloadStatDao.Create(ctx, downloadStat); err != nil {
 		return err
 	}
+	recentActivity.RecordPull(ctx, registry.ID, image.Name, info.ArtifactType, info.Reference)
 	return nil
 }
 
@@ -169,7 +173,8 @@ func TrackDownloadStatForGenericArtifact(h *generic.Handler) func(http.Handler)
 					return
 				}
 
-				err = dbDownloadStatForGenericArtifact(ctx, h.Controller, info, h.AuditService, h.SpaceFinder)
+				err = dbDownloadStatForGenericArtifact(ctx, h.Controller, info, h.AuditService, h.SpaceFinder,
+					h.RecentActivity)
 				if !commons.IsEmptyError(err) {
 					log.Ctx(ctx).Error().Stack().Str("middleware",
 						"TrackDownloadStat").Err(err).Msgf("error while putting download stat of artifact, %v",
@@ -212,7 +217,8 @@ func TrackDownloadStatForMavenArtifact(h *maven.Handler) func(http.Handler) http
 					return
 				}
 
-				err2 := dbDownloadStatForMavenArtifact(ctx, h.Controller, info, h.AuditService, h.SpaceFinder)
+				err2 := dbDownloadStatForMavenArtifact(ctx, h.Controller, info, h.AuditService, h.SpaceFinder,
+					h.RecentActivity)
 				if !commons.IsEmptyError(err2) {
 					log.Ctx(ctx).Error().Stack().Str("middleware",
 						"TrackDownloadStat").Err(err).Msgf("error while putting download stat of artifact, %v",
@@ -230,6 +236,7 @@ func dbDownloadStatForGenericArtifact(
 	info pkg.GenericArtifactInfo,
 	auditService audit.Service,
 	spaceFinder refcache.SpaceFinder,
+	recentActivity *recentactivity.Service,
 ) errcode.Error {
 	registry, err := c.DBStore.RegistryDao.GetByParentIDAndName(ctx, info.ParentID, info.RegIdentifier)
 	if err != nil {
@@ -266,11 +273,13 @@ func dbDownloadStatForGenericArtifact(
 
 	downloadStat := &types.DownloadStat{
 		ArtifactID: artifact.ID,
+		RegistryID: registry.ID,
 	}
 
 	if err := c.DBStore.DownloadStatDao.Create(ctx, downloadStat); err != nil {
 		return errcode.ErrCodeNameUnknown.WithDetail(err)
 	}
+	recentActivity.RecordPull(ctx, registry.ID, image.Name, info.ArtifactType, info.Version)
 	return errcode.Error{}
 }
 
@@ -280,6 +289,7 @@ func dbDownloadStatForMavenArtifact(
 	info pkg.MavenArtifactInfo,
 	auditService audit.Service,
 	spaceFinder refcache.SpaceFinder,
+	recentActivity *recentactivity.Service,
 ) errcode.Error {
 	imageName := info.GroupID + ":" + info.ArtifactID
 	registry, err := c.DBStore.RegistryDao.GetByParentIDAndName(ctx, info.ParentID, info.RegIdentifier)
@@ -320,11 +330,13 @@ func dbDownloadStatForMavenArtifact(
 
 	downloadStat := &types.DownloadStat{
 		ArtifactID: artifact.ID,
+		RegistryID: registry.ID,
 	}
 
 	if err := c.DBStore.DownloadStatDao.Create(ctx, downloadStat); err != nil {
 		return errcode.ErrCodeNameUnknown.WithDetail(err)
 	}
+	recentActivity.RecordPull(ctx, registry.ID, image.Name, info.ArtifactType, info.Version)
 	return errcode.Error{}
 }
 
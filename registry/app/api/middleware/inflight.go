@@ -0,0 +1,98 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+)
+
+// InFlightOperation is a registry request which is currently being served.
+type InFlightOperation struct {
+	RequestID string        `json:"request_id,omitempty"`
+	Method    string        `json:"method"`
+	Path      string        `json:"path"`
+	Started   time.Time     `json:"started"`
+	Duration  time.Duration `json:"duration"`
+}
+
+// InFlightTracker keeps track of the registry requests served by this instance,
+// e.g. to find the operations that hang when debugging latency issues.
+type InFlightTracker struct {
+	mu     sync.Mutex
+	nextID uint64
+	ops    map[uint64]InFlightOperation
+}
+
+func NewInFlightTracker() *InFlightTracker {
+	return &InFlightTracker{
+		ops: make(map[uint64]InFlightOperation),
+	}
+}
+
+// Track returns a middleware which tracks every request until it's served.
+func (t *InFlightTracker) Track() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID, _ := request.RequestIDFrom(r.Context())
+			id := t.add(InFlightOperation{
+				RequestID: requestID,
+				Method:    r.Method,
+				// the query is omitted as it can contain credentials.
+				Path:    r.URL.Path,
+				Started: time.Now(),
+			})
+			defer t.remove(id)
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Snapshot returns the operations in flight, the longest running first.
+func (t *InFlightTracker) Snapshot() []InFlightOperation {
+	now := time.Now()
+
+	t.mu.Lock()
+	ops := make([]InFlightOperation, 0, len(t.ops))
+	for _, op := range t.ops {
+		op.Duration = now.Sub(op.Started)
+		ops = append(ops, op)
+	}
+	t.mu.Unlock()
+
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].Started.Before(ops[j].Started)
+	})
+	return ops
+}
+
+func (t *InFlightTracker) add(op InFlightOperation) uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.nextID++
+	t.ops[t.nextID] = op
+	return t.nextID
+}
+
+func (t *InFlightTracker) remove(id uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.ops, id)
+}
@@ -0,0 +1,39 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestInFlightTracker(t *testing.T) {
+	tracker := NewInFlightTracker()
+
+	var inFlight []InFlightOperation
+	h := tracker.Track()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		inFlight = tracker.Snapshot()
+	}))
+	h.ServeHTTP(httptest.NewRecorder(),
+		httptest.NewRequest(http.MethodGet, "/pkg/acc/reg/npm/pkg?token=secret", nil))
+
+	if len(inFlight) != 1 || inFlight[0].Method != http.MethodGet || inFlight[0].Path != "/pkg/acc/reg/npm/pkg" {
+		t.Fatalf("unexpected operations in flight %+v", inFlight)
+	}
+	if ops := tracker.Snapshot(); len(ops) != 0 {
+		t.Errorf("expected no operations in flight after the request, got %+v", ops)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/logging"
+	"github.com/harness/gitness/registry/app/pkg"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// LogPackageRequest annotates the logs of a package request with the root, the registry and the package type.
+// The annotations are added to a new logging context, the artifact fields are added once the artifact info is
+// stored (see StoreArtifactInfo) and the principal ones once the request is authenticated.
+func LogPackageRequest(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		opts := []logging.Option{
+			withLogStr("root_identifier", chi.URLParam(r, "rootIdentifier")),
+			withLogStr("registry", chi.URLParam(r, "registryIdentifier")),
+		}
+		// the remaining route path of the package routes starts with the package type, e.g. /maven/...
+		if rctx := chi.RouteContext(r.Context()); rctx != nil {
+			packageType, _, _ := strings.Cut(strings.TrimPrefix(rctx.RoutePath, "/"), "/")
+			opts = append(opts, withLogStr("package_type", packageType))
+		}
+
+		ctx := logging.NewContext(r.Context(), opts...)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// updateArtifactLogContext annotates the logs of the request with the fields of the artifact info.
+func updateArtifactLogContext(r *http.Request, info pkg.PackageArtifactInfo) {
+	opts := []logging.Option{
+		withLogStr("image", info.GetImage()),
+		withLogStr("version", info.GetVersion()),
+	}
+	if registryID := info.GetRegistryID(); registryID > 0 {
+		opts = append(opts, func(c zerolog.Context) zerolog.Context {
+			return c.Int64("registry_id", registryID)
+		})
+	}
+	logging.UpdateContext(r.Context(), opts...)
+}
+
+// withLogStr annotates the logs with the value, it's skipped if the value is empty.
+func withLogStr(key, value string) logging.Option {
+	return func(c zerolog.Context) zerolog.Context {
+		if value == "" {
+			return c
+		}
+		return c.Str(key, value)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+func TestLogPackageRequest(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+
+	r := chi.NewRouter()
+	r.Route("/{rootIdentifier}/{registryIdentifier}", func(r chi.Router) {
+		r.Use(LogPackageRequest)
+		r.Get("/npm/*", func(_ http.ResponseWriter, r *http.Request) {
+			zerolog.Ctx(r.Context()).Info().Msg("served")
+		})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/acc/reg/npm/pkg/-/pkg-1.0.0.tgz", nil)
+	r.ServeHTTP(httptest.NewRecorder(), req.WithContext(logger.WithContext(req.Context())))
+
+	var fields map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &fields); err != nil {
+		t.Fatalf("failed to decode log line %q: %v", buf.String(), err)
+	}
+	for key, expected := range map[string]string{
+		"root_identifier": "acc",
+		"registry":        "reg",
+		"package_type":    "npm",
+	} {
+		if fields[key] != expected {
+			t.Errorf("expected %s to be %q, got %v", key, expected, fields[key])
+		}
+	}
+}
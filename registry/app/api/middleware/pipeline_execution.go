@@ -0,0 +1,50 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/registry/types"
+)
+
+// Headers through which CI pipelines tell which execution uploads a package, the reference is stored with the
+// versions the upload creates.
+const (
+	HeaderPipelineSystem = "X-Registry-Pipeline-System"
+	HeaderPipelineID     = "X-Registry-Pipeline-Id"
+	HeaderPipelineRunURL = "X-Registry-Pipeline-Run-Url"
+	HeaderCommitSHA      = "X-Registry-Commit-Sha"
+)
+
+// StorePipelineExecution stores in the context the pipeline execution the client said the request is made by.
+func StorePipelineExecution(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pipeline := types.PipelineExecution{
+			System:     strings.TrimSpace(r.Header.Get(HeaderPipelineSystem)),
+			PipelineID: strings.TrimSpace(r.Header.Get(HeaderPipelineID)),
+			RunURL:     strings.TrimSpace(r.Header.Get(HeaderPipelineRunURL)),
+			CommitSHA:  strings.TrimSpace(r.Header.Get(HeaderCommitSHA)),
+		}
+		if pipeline.IsEmpty() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := request.WithPipelineExecution(r.Context(), &pipeline)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
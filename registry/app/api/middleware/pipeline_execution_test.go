@@ -0,0 +1,51 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/registry/types"
+)
+
+func TestStorePipelineExecution(t *testing.T) {
+	var pipeline *types.PipelineExecution
+	h := StorePipelineExecution(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		pipeline = request.PipelineExecutionFrom(r.Context())
+	}))
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPut, "/pkg/acc/reg/generic/pkg/1.0", nil))
+	if pipeline != nil {
+		t.Fatalf("expected no pipeline execution without headers, got %+v", pipeline)
+	}
+
+	req := httptest.NewRequest(http.MethodPut, "/pkg/acc/reg/generic/pkg/1.0", nil)
+	req.Header.Set(HeaderPipelineSystem, "github-actions")
+	req.Header.Set(HeaderPipelineRunURL, " https://ci.example.com/runs/42 ")
+	req.Header.Set(HeaderCommitSHA, "0a1b2c")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	want := types.PipelineExecution{
+		System:    "github-actions",
+		RunURL:    "https://ci.example.com/runs/42",
+		CommitSHA: "0a1b2c",
+	}
+	if pipeline == nil || *pipeline != want {
+		t.Errorf("expected pipeline execution %+v, got %+v", want, pipeline)
+	}
+}
@@ -135,6 +135,7 @@ func dbQuarantineStatusOCI(
 		return err
 	}
 	if len(quarantineArtifacts) > 0 {
+		c.QuarantineFinder.RecordBlockedAccess(ctx, registry.ID, info.Image, digestVal)
 		return errcode.ErrCodeManifestQuarantined
 	}
 	return nil
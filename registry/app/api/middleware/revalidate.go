@@ -0,0 +1,34 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/harness/gitness/registry/request"
+)
+
+// HeaderRevalidate forces the upstreams of proxy registries to be asked again for paths they recently didn't find.
+const HeaderRevalidate = "X-Registry-Revalidate"
+
+// StoreRevalidate stores in the context whether the client asked to revalidate cached upstream misses.
+func StoreRevalidate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		revalidate, _ := strconv.ParseBool(r.Header.Get(HeaderRevalidate))
+		ctx := request.WithRevalidate(r.Context(), revalidate)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/app/api/render"
+	"github.com/harness/gitness/registry/app/utils/validation"
+	"github.com/harness/gitness/registry/request"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ValidateUpload enforces the validation rules of the registry which are known before the upload is
+// read: the version taken from the request path and the size of the request body. Rules on the package
+// metadata are enforced when the artifact is created.
+func ValidateUpload() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			info := request.ArtifactInfoFrom(ctx)
+			if info == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			rules := validation.GetRules(info.BaseArtifactInfo().Registry)
+			if rules == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if err := validation.ValidateVersion(rules, info.GetVersion()); err != nil {
+				log.Ctx(ctx).Info().Str("middleware", "ValidateUpload").Err(err).
+					Msgf("rejected upload of %s", info.GetImage())
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+			if err := validation.ValidateFileSize(rules, r.ContentLength); err != nil {
+				log.Ctx(ctx).Info().Str("middleware", "ValidateUpload").Err(err).
+					Msgf("rejected upload of %s", info.GetImage())
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+			if rules.MaxFileSize > 0 && r.ContentLength < 0 {
+				// the size of chunked uploads is only known once the body is read
+				r.Body = http.MaxBytesReader(w, r.Body, rules.MaxFileSize)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -24,9 +24,21 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List Recent Artifacts
+	// (GET /artifacts/recent)
+	ListRecentArtifacts(w http.ResponseWriter, r *http.Request, params ListRecentArtifactsParams)
+	// List Starred Artifacts
+	// (GET /artifacts/starred)
+	ListStarredArtifacts(w http.ResponseWriter, r *http.Request, params ListStarredArtifactsParams)
+	// Get Package Type Capabilities
+	// (GET /package-types/capabilities)
+	GetPackageTypeCapabilities(w http.ResponseWriter, r *http.Request)
 	// Create Registry.
 	// (POST /registry)
 	CreateRegistry(w http.ResponseWriter, r *http.Request, params CreateRegistryParams)
+	// Search artifacts
+	// (GET /registry/search)
+	SearchArtifacts(w http.ResponseWriter, r *http.Request, params SearchArtifactsParams)
 	// Delete a Registry
 	// (DELETE /registry/{registry_ref})
 	DeleteRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
@@ -36,6 +48,9 @@ type ServerInterface interface {
 	// Updates a Registry
 	// (PUT /registry/{registry_ref})
 	ModifyRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Apply registry configuration
+	// (PUT /registry/{registry_ref}/apply)
+	ApplyRegistryConfig(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ApplyRegistryConfigParams)
 	// List Artifact Labels
 	// (GET /registry/{registry_ref}/artifact/labels)
 	ListArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListArtifactLabelsParams)
@@ -45,9 +60,36 @@ type ServerInterface interface {
 	// Delete Artifact
 	// (DELETE /registry/{registry_ref}/artifact/{artifact})
 	DeleteArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params DeleteArtifactParams)
+	// Update Artifact Description
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/description)
+	UpdateArtifactDescription(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UpdateArtifactDescriptionParams)
+	// List Artifact Description History
+	// (GET /registry/{registry_ref}/artifact/{artifact}/description/history)
+	ListArtifactDescriptionHistory(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params ListArtifactDescriptionHistoryParams)
+	// Get Artifact Download Stats
+	// (GET /registry/{registry_ref}/artifact/{artifact}/downloads)
+	GetArtifactDownloadStats(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactDownloadStatsParams)
 	// Update Artifact Labels
 	// (PUT /registry/{registry_ref}/artifact/{artifact}/labels)
 	UpdateArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UpdateArtifactLabelsParams)
+	// Delete Artifact Logo
+	// (DELETE /registry/{registry_ref}/artifact/{artifact}/logo)
+	DeleteArtifactLogo(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params DeleteArtifactLogoParams)
+	// Get Artifact Logo
+	// (GET /registry/{registry_ref}/artifact/{artifact}/logo)
+	GetArtifactLogo(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactLogoParams)
+	// Upload Artifact Logo
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/logo)
+	UploadArtifactLogo(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UploadArtifactLogoParams)
+	// Export OCI archive
+	// (GET /registry/{registry_ref}/artifact/{artifact}/oci-export)
+	ExportOciArchive(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params ExportOciArchiveParams)
+	// Unstar Artifact
+	// (DELETE /registry/{registry_ref}/artifact/{artifact}/star)
+	UnstarArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UnstarArtifactParams)
+	// Star Artifact
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/star)
+	StarArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params StarArtifactParams)
 	// Get Artifact Stats
 	// (GET /registry/{registry_ref}/artifact/{artifact}/stats)
 	GetArtifactStats(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactStatsParams)
@@ -81,30 +123,150 @@ type ServerInterface interface {
 	// Describe Artifact files
 	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/files)
 	GetArtifactFiles(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactFilesParams)
+	// Revoke firewall approval
+	// (DELETE /registry/{registry_ref}/artifact/{artifact}/version/{version}/firewall-approval)
+	RevokeFirewallApproval(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// Approve version held by the firewall
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/version/{version}/firewall-approval)
+	ApproveFirewallVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// Describe Helm Chart Dependencies
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/dependencies)
+	GetHelmArtifactDependencies(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
 	// Describe Helm Artifact Detail
 	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/details)
 	GetHelmArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetHelmArtifactDetailsParams)
 	// Describe Helm Artifact Manifest
 	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/manifest)
 	GetHelmArtifactManifest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// List Artifact Version Metadata History
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/metadata/history)
+	ListArtifactVersionMetadataHistory(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params ListArtifactVersionMetadataHistoryParams)
+	// Get Artifact Version Provenance
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/provenance)
+	GetArtifactVersionProvenance(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactVersionProvenanceParams)
+	// Update Artifact Version Provenance
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/version/{version}/provenance)
+	UpdateArtifactVersionProvenance(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params UpdateArtifactVersionProvenanceParams)
+	// Restore Artifact Version
+	// (POST /registry/{registry_ref}/artifact/{artifact}/version/{version}/restore)
+	RestoreArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// Get Artifact Scan Status
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/scan)
+	GetArtifactScanStatus(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// Request Artifact Scan
+	// (POST /registry/{registry_ref}/artifact/{artifact}/version/{version}/scan)
+	RequestArtifactScan(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// Update Artifact Scan Status
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/version/{version}/scan)
+	UpdateArtifactScanStatus(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
 	// Get Artifact Version Summary
 	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/summary)
 	GetArtifactVersionSummary(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactVersionSummaryParams)
+	// Purge Artifact Version
+	// (DELETE /registry/{registry_ref}/artifact/{artifact}/version/{version}/trash)
+	PurgeArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
 	// List Artifact Versions
 	// (GET /registry/{registry_ref}/artifact/{artifact}/versions)
 	GetAllArtifactVersions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetAllArtifactVersionsParams)
+	// Sync Artifact Versions
+	// (GET /registry/{registry_ref}/artifact/{artifact}/versions/sync)
+	SyncArtifactVersions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params SyncArtifactVersionsParams)
 	// List Artifacts for Registry
 	// (GET /registry/{registry_ref}/artifacts)
 	GetAllArtifactsByRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetAllArtifactsByRegistryParams)
+	// Get Download Counts of Artifacts
+	// (GET /registry/{registry_ref}/artifacts/download-counts)
+	GetArtifactDownloadCounts(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetArtifactDownloadCountsParams)
 	// Returns CLI Client Setup Details
 	// (GET /registry/{registry_ref}/client-setup-details)
 	GetClientSetupDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetClientSetupDetailsParams)
+	// List deletion requests
+	// (GET /registry/{registry_ref}/deletion-requests)
+	ListDeletionRequests(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListDeletionRequestsParams)
+	// Approve deletion request
+	// (POST /registry/{registry_ref}/deletion-requests/{deletion_request_id}/approve)
+	ApproveDeletionRequest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, deletionRequestId DeletionRequestIdPathParam)
+	// Reject deletion request
+	// (POST /registry/{registry_ref}/deletion-requests/{deletion_request_id}/reject)
+	RejectDeletionRequest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, deletionRequestId DeletionRequestIdPathParam)
+	// List failed uploads
+	// (GET /registry/{registry_ref}/failed-uploads)
+	ListFailedUploads(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListFailedUploadsParams)
+	// Download failed upload
+	// (GET /registry/{registry_ref}/failed-uploads/{failed_upload_uuid}/download)
+	DownloadFailedUpload(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, failedUploadUuid FailedUploadUuidPathParam)
+	// List firewall approvals
+	// (GET /registry/{registry_ref}/firewall-approvals)
+	ListFirewallApprovals(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// List registry index builds
+	// (GET /registry/{registry_ref}/index/builds)
+	ListRegistryIndexBuilds(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListRegistryIndexBuildsParams)
+	// Retry registry index build
+	// (POST /registry/{registry_ref}/index/builds/{index_build_id}/retry)
+	RetryRegistryIndexBuild(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, indexBuildId IndexBuildIdPathParam)
+	// Get registry index status
+	// (GET /registry/{registry_ref}/index/status)
+	GetRegistryIndexStatus(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// List registry jobs
+	// (GET /registry/{registry_ref}/jobs)
+	ListRegistryJobs(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListRegistryJobsParams)
+	// Get registry job
+	// (GET /registry/{registry_ref}/jobs/{job_uuid})
+	GetRegistryJob(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, jobUuid JobUuidPathParam)
+	// Cancel registry job
+	// (POST /registry/{registry_ref}/jobs/{job_uuid}/cancel)
+	CancelRegistryJob(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, jobUuid JobUuidPathParam)
+	// ListNotificationChannels
+	// (GET /registry/{registry_ref}/notification-channels)
+	ListNotificationChannels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// CreateNotificationChannel
+	// (POST /registry/{registry_ref}/notification-channels)
+	CreateNotificationChannel(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// DeleteNotificationChannel
+	// (DELETE /registry/{registry_ref}/notification-channels/{channel_identifier})
+	DeleteNotificationChannel(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, channelIdentifier ChannelIdentifierPathParam)
+	// UpdateNotificationChannel
+	// (PUT /registry/{registry_ref}/notification-channels/{channel_identifier})
+	UpdateNotificationChannel(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, channelIdentifier ChannelIdentifierPathParam)
+	// Import OCI archive
+	// (POST /registry/{registry_ref}/oci-import)
+	ImportOciArchive(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ImportOciArchiveParams)
+	// List package denylist overrides
+	// (GET /registry/{registry_ref}/package-denylist/overrides)
+	ListPackageDenylistOverrides(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Remove package denylist override
+	// (DELETE /registry/{registry_ref}/package-denylist/{denylist_entry_id}/override)
+	RemovePackageDenylistOverride(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, denylistEntryId DenylistEntryIdPathParam)
+	// Override package denylist entry
+	// (PUT /registry/{registry_ref}/package-denylist/{denylist_entry_id}/override)
+	OverridePackageDenylistEntry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, denylistEntryId DenylistEntryIdPathParam)
+	// Get effective registry policy
+	// (GET /registry/{registry_ref}/policy)
+	GetEffectiveRegistryPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
 	// deleteQuarantineFilePath
 	// (DELETE /registry/{registry_ref}/quarantine)
 	DeleteQuarantineFilePath(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params DeleteQuarantineFilePathParams)
 	// quarantineFilePath
 	// (PUT /registry/{registry_ref}/quarantine)
 	QuarantineFilePath(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// List scheduled deletions
+	// (GET /registry/{registry_ref}/scheduled-deletions)
+	ListScheduledDeletions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListScheduledDeletionsParams)
+	// Schedule deletion
+	// (POST /registry/{registry_ref}/scheduled-deletions)
+	ScheduleDeletion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Cancel scheduled deletion
+	// (POST /registry/{registry_ref}/scheduled-deletions/{scheduled_deletion_id}/cancel)
+	CancelScheduledDeletion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, scheduledDeletionId ScheduledDeletionIdPathParam)
+	// Get registry settings
+	// (GET /registry/{registry_ref}/settings)
+	GetRegistrySettings(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Update registry settings
+	// (PATCH /registry/{registry_ref}/settings)
+	UpdateRegistrySettings(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// List Registry Trash
+	// (GET /registry/{registry_ref}/trash)
+	ListRegistryTrash(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
 	// ListWebhooks
 	// (GET /registry/{registry_ref}/webhooks)
 	ListWebhooks(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListWebhooksParams)
@@ -126,9 +288,15 @@ type ServerInterface interface {
 	// GetWebhookExecution
 	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id})
 	GetWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam)
+	// ReplayWebhookExecution
+	// (POST /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/replay)
+	ReplayWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam)
 	// ReTriggerWebhookExecution
 	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/retrigger)
 	ReTriggerWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam)
+	// TestWebhook
+	// (POST /registry/{registry_ref}/webhooks/{webhook_identifier}/test)
+	TestWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam)
 	// List replication rules
 	// (GET /replication/rules)
 	ListReplicationRules(w http.ResponseWriter, r *http.Request, params ListReplicationRulesParams)
@@ -162,21 +330,81 @@ type ServerInterface interface {
 	// List artifacts
 	// (GET /spaces/{space_ref}/artifacts)
 	GetAllArtifacts(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllArtifactsParams)
+	// List package denylist
+	// (GET /spaces/{space_ref}/package-denylist)
+	ListPackageDenylistEntries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params ListPackageDenylistEntriesParams)
+	// Add package denylist entry
+	// (POST /spaces/{space_ref}/package-denylist)
+	CreatePackageDenylistEntry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam)
+	// Import advisories into package denylist
+	// (POST /spaces/{space_ref}/package-denylist/import)
+	ImportPackageDenylist(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam)
+	// Delete package denylist entry
+	// (DELETE /spaces/{space_ref}/package-denylist/{denylist_entry_id})
+	DeletePackageDenylistEntry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, denylistEntryId DenylistEntryIdPathParam)
+	// List pipeline artifacts
+	// (GET /spaces/{space_ref}/pipeline-artifacts)
+	ListPipelineArtifacts(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params ListPipelineArtifactsParams)
 	// List registries
 	// (GET /spaces/{space_ref}/registries)
 	GetAllRegistries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllRegistriesParams)
+	// Get registry garbage stats
+	// (GET /spaces/{space_ref}/registries/garbage)
+	GetRegistryGarbageStats(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam)
+	// Get upload failure stats
+	// (GET /spaces/{space_ref}/registries/upload-failures)
+	GetUploadFailureStats(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetUploadFailureStatsParams)
+	// Get space registry usage
+	// (GET /spaces/{space_ref}/registries/usage)
+	GetSpaceRegistryUsage(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam)
+	// Get space registry usage history
+	// (GET /spaces/{space_ref}/registries/usage/history)
+	GetSpaceRegistryUsageHistory(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetSpaceRegistryUsageHistoryParams)
+	// Get space registry policy
+	// (GET /spaces/{space_ref}/registry-policy)
+	GetSpaceRegistryPolicy(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam)
+	// Update space registry policy
+	// (PUT /spaces/{space_ref}/registry-policy)
+	UpdateSpaceRegistryPolicy(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam)
+	// List vulnerable versions
+	// (GET /spaces/{space_ref}/vulnerable-versions)
+	ListVulnerableVersions(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params ListVulnerableVersionsParams)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// List Recent Artifacts
+// (GET /artifacts/recent)
+func (_ Unimplemented) ListRecentArtifacts(w http.ResponseWriter, r *http.Request, params ListRecentArtifactsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List Starred Artifacts
+// (GET /artifacts/starred)
+func (_ Unimplemented) ListStarredArtifacts(w http.ResponseWriter, r *http.Request, params ListStarredArtifactsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Package Type Capabilities
+// (GET /package-types/capabilities)
+func (_ Unimplemented) GetPackageTypeCapabilities(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Create Registry.
 // (POST /registry)
 func (_ Unimplemented) CreateRegistry(w http.ResponseWriter, r *http.Request, params CreateRegistryParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Search artifacts
+// (GET /registry/search)
+func (_ Unimplemented) SearchArtifacts(w http.ResponseWriter, r *http.Request, params SearchArtifactsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete a Registry
 // (DELETE /registry/{registry_ref})
 func (_ Unimplemented) DeleteRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
@@ -195,6 +423,12 @@ func (_ Unimplemented) ModifyRegistry(w http.ResponseWriter, r *http.Request, re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Apply registry configuration
+// (PUT /registry/{registry_ref}/apply)
+func (_ Unimplemented) ApplyRegistryConfig(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ApplyRegistryConfigParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List Artifact Labels
 // (GET /registry/{registry_ref}/artifact/labels)
 func (_ Unimplemented) ListArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListArtifactLabelsParams) {
@@ -213,12 +447,66 @@ func (_ Unimplemented) DeleteArtifact(w http.ResponseWriter, r *http.Request, re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Update Artifact Description
+// (PUT /registry/{registry_ref}/artifact/{artifact}/description)
+func (_ Unimplemented) UpdateArtifactDescription(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UpdateArtifactDescriptionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List Artifact Description History
+// (GET /registry/{registry_ref}/artifact/{artifact}/description/history)
+func (_ Unimplemented) ListArtifactDescriptionHistory(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params ListArtifactDescriptionHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Artifact Download Stats
+// (GET /registry/{registry_ref}/artifact/{artifact}/downloads)
+func (_ Unimplemented) GetArtifactDownloadStats(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactDownloadStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Update Artifact Labels
 // (PUT /registry/{registry_ref}/artifact/{artifact}/labels)
 func (_ Unimplemented) UpdateArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UpdateArtifactLabelsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delete Artifact Logo
+// (DELETE /registry/{registry_ref}/artifact/{artifact}/logo)
+func (_ Unimplemented) DeleteArtifactLogo(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params DeleteArtifactLogoParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Artifact Logo
+// (GET /registry/{registry_ref}/artifact/{artifact}/logo)
+func (_ Unimplemented) GetArtifactLogo(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactLogoParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload Artifact Logo
+// (PUT /registry/{registry_ref}/artifact/{artifact}/logo)
+func (_ Unimplemented) UploadArtifactLogo(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UploadArtifactLogoParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export OCI archive
+// (GET /registry/{registry_ref}/artifact/{artifact}/oci-export)
+func (_ Unimplemented) ExportOciArchive(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params ExportOciArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unstar Artifact
+// (DELETE /registry/{registry_ref}/artifact/{artifact}/star)
+func (_ Unimplemented) UnstarArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UnstarArtifactParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Star Artifact
+// (PUT /registry/{registry_ref}/artifact/{artifact}/star)
+func (_ Unimplemented) StarArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params StarArtifactParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Artifact Stats
 // (GET /registry/{registry_ref}/artifact/{artifact}/stats)
 func (_ Unimplemented) GetArtifactStats(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactStatsParams) {
@@ -285,6 +573,24 @@ func (_ Unimplemented) GetArtifactFiles(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Revoke firewall approval
+// (DELETE /registry/{registry_ref}/artifact/{artifact}/version/{version}/firewall-approval)
+func (_ Unimplemented) RevokeFirewallApproval(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve version held by the firewall
+// (PUT /registry/{registry_ref}/artifact/{artifact}/version/{version}/firewall-approval)
+func (_ Unimplemented) ApproveFirewallVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Describe Helm Chart Dependencies
+// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/dependencies)
+func (_ Unimplemented) GetHelmArtifactDependencies(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Describe Helm Artifact Detail
 // (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/details)
 func (_ Unimplemented) GetHelmArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetHelmArtifactDetailsParams) {
@@ -297,30 +603,216 @@ func (_ Unimplemented) GetHelmArtifactManifest(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List Artifact Version Metadata History
+// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/metadata/history)
+func (_ Unimplemented) ListArtifactVersionMetadataHistory(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params ListArtifactVersionMetadataHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Artifact Version Provenance
+// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/provenance)
+func (_ Unimplemented) GetArtifactVersionProvenance(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactVersionProvenanceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update Artifact Version Provenance
+// (PUT /registry/{registry_ref}/artifact/{artifact}/version/{version}/provenance)
+func (_ Unimplemented) UpdateArtifactVersionProvenance(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params UpdateArtifactVersionProvenanceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore Artifact Version
+// (POST /registry/{registry_ref}/artifact/{artifact}/version/{version}/restore)
+func (_ Unimplemented) RestoreArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Artifact Scan Status
+// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/scan)
+func (_ Unimplemented) GetArtifactScanStatus(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Request Artifact Scan
+// (POST /registry/{registry_ref}/artifact/{artifact}/version/{version}/scan)
+func (_ Unimplemented) RequestArtifactScan(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update Artifact Scan Status
+// (PUT /registry/{registry_ref}/artifact/{artifact}/version/{version}/scan)
+func (_ Unimplemented) UpdateArtifactScanStatus(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Artifact Version Summary
 // (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/summary)
 func (_ Unimplemented) GetArtifactVersionSummary(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactVersionSummaryParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Purge Artifact Version
+// (DELETE /registry/{registry_ref}/artifact/{artifact}/version/{version}/trash)
+func (_ Unimplemented) PurgeArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List Artifact Versions
 // (GET /registry/{registry_ref}/artifact/{artifact}/versions)
 func (_ Unimplemented) GetAllArtifactVersions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetAllArtifactVersionsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Sync Artifact Versions
+// (GET /registry/{registry_ref}/artifact/{artifact}/versions/sync)
+func (_ Unimplemented) SyncArtifactVersions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params SyncArtifactVersionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List Artifacts for Registry
 // (GET /registry/{registry_ref}/artifacts)
 func (_ Unimplemented) GetAllArtifactsByRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetAllArtifactsByRegistryParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get Download Counts of Artifacts
+// (GET /registry/{registry_ref}/artifacts/download-counts)
+func (_ Unimplemented) GetArtifactDownloadCounts(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetArtifactDownloadCountsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Returns CLI Client Setup Details
 // (GET /registry/{registry_ref}/client-setup-details)
 func (_ Unimplemented) GetClientSetupDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetClientSetupDetailsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List deletion requests
+// (GET /registry/{registry_ref}/deletion-requests)
+func (_ Unimplemented) ListDeletionRequests(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListDeletionRequestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve deletion request
+// (POST /registry/{registry_ref}/deletion-requests/{deletion_request_id}/approve)
+func (_ Unimplemented) ApproveDeletionRequest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, deletionRequestId DeletionRequestIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reject deletion request
+// (POST /registry/{registry_ref}/deletion-requests/{deletion_request_id}/reject)
+func (_ Unimplemented) RejectDeletionRequest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, deletionRequestId DeletionRequestIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List failed uploads
+// (GET /registry/{registry_ref}/failed-uploads)
+func (_ Unimplemented) ListFailedUploads(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListFailedUploadsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download failed upload
+// (GET /registry/{registry_ref}/failed-uploads/{failed_upload_uuid}/download)
+func (_ Unimplemented) DownloadFailedUpload(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, failedUploadUuid FailedUploadUuidPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List firewall approvals
+// (GET /registry/{registry_ref}/firewall-approvals)
+func (_ Unimplemented) ListFirewallApprovals(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List registry index builds
+// (GET /registry/{registry_ref}/index/builds)
+func (_ Unimplemented) ListRegistryIndexBuilds(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListRegistryIndexBuildsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Retry registry index build
+// (POST /registry/{registry_ref}/index/builds/{index_build_id}/retry)
+func (_ Unimplemented) RetryRegistryIndexBuild(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, indexBuildId IndexBuildIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get registry index status
+// (GET /registry/{registry_ref}/index/status)
+func (_ Unimplemented) GetRegistryIndexStatus(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List registry jobs
+// (GET /registry/{registry_ref}/jobs)
+func (_ Unimplemented) ListRegistryJobs(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListRegistryJobsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get registry job
+// (GET /registry/{registry_ref}/jobs/{job_uuid})
+func (_ Unimplemented) GetRegistryJob(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, jobUuid JobUuidPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel registry job
+// (POST /registry/{registry_ref}/jobs/{job_uuid}/cancel)
+func (_ Unimplemented) CancelRegistryJob(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, jobUuid JobUuidPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ListNotificationChannels
+// (GET /registry/{registry_ref}/notification-channels)
+func (_ Unimplemented) ListNotificationChannels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// CreateNotificationChannel
+// (POST /registry/{registry_ref}/notification-channels)
+func (_ Unimplemented) CreateNotificationChannel(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// DeleteNotificationChannel
+// (DELETE /registry/{registry_ref}/notification-channels/{channel_identifier})
+func (_ Unimplemented) DeleteNotificationChannel(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, channelIdentifier ChannelIdentifierPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// UpdateNotificationChannel
+// (PUT /registry/{registry_ref}/notification-channels/{channel_identifier})
+func (_ Unimplemented) UpdateNotificationChannel(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, channelIdentifier ChannelIdentifierPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import OCI archive
+// (POST /registry/{registry_ref}/oci-import)
+func (_ Unimplemented) ImportOciArchive(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ImportOciArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List package denylist overrides
+// (GET /registry/{registry_ref}/package-denylist/overrides)
+func (_ Unimplemented) ListPackageDenylistOverrides(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove package denylist override
+// (DELETE /registry/{registry_ref}/package-denylist/{denylist_entry_id}/override)
+func (_ Unimplemented) RemovePackageDenylistOverride(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, denylistEntryId DenylistEntryIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Override package denylist entry
+// (PUT /registry/{registry_ref}/package-denylist/{denylist_entry_id}/override)
+func (_ Unimplemented) OverridePackageDenylistEntry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, denylistEntryId DenylistEntryIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get effective registry policy
+// (GET /registry/{registry_ref}/policy)
+func (_ Unimplemented) GetEffectiveRegistryPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // deleteQuarantineFilePath
 // (DELETE /registry/{registry_ref}/quarantine)
 func (_ Unimplemented) DeleteQuarantineFilePath(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params DeleteQuarantineFilePathParams) {
@@ -333,6 +825,42 @@ func (_ Unimplemented) QuarantineFilePath(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List scheduled deletions
+// (GET /registry/{registry_ref}/scheduled-deletions)
+func (_ Unimplemented) ListScheduledDeletions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListScheduledDeletionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Schedule deletion
+// (POST /registry/{registry_ref}/scheduled-deletions)
+func (_ Unimplemented) ScheduleDeletion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel scheduled deletion
+// (POST /registry/{registry_ref}/scheduled-deletions/{scheduled_deletion_id}/cancel)
+func (_ Unimplemented) CancelScheduledDeletion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, scheduledDeletionId ScheduledDeletionIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get registry settings
+// (GET /registry/{registry_ref}/settings)
+func (_ Unimplemented) GetRegistrySettings(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update registry settings
+// (PATCH /registry/{registry_ref}/settings)
+func (_ Unimplemented) UpdateRegistrySettings(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List Registry Trash
+// (GET /registry/{registry_ref}/trash)
+func (_ Unimplemented) ListRegistryTrash(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ListWebhooks
 // (GET /registry/{registry_ref}/webhooks)
 func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListWebhooksParams) {
@@ -375,12 +903,24 @@ func (_ Unimplemented) GetWebhookExecution(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// ReplayWebhookExecution
+// (POST /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/replay)
+func (_ Unimplemented) ReplayWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ReTriggerWebhookExecution
 // (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/retrigger)
 func (_ Unimplemented) ReTriggerWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// TestWebhook
+// (POST /registry/{registry_ref}/webhooks/{webhook_identifier}/test)
+func (_ Unimplemented) TestWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List replication rules
 // (GET /replication/rules)
 func (_ Unimplemented) ListReplicationRules(w http.ResponseWriter, r *http.Request, params ListReplicationRulesParams) {
@@ -447,12 +987,84 @@ func (_ Unimplemented) GetAllArtifacts(w http.ResponseWriter, r *http.Request, s
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List package denylist
+// (GET /spaces/{space_ref}/package-denylist)
+func (_ Unimplemented) ListPackageDenylistEntries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params ListPackageDenylistEntriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add package denylist entry
+// (POST /spaces/{space_ref}/package-denylist)
+func (_ Unimplemented) CreatePackageDenylistEntry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import advisories into package denylist
+// (POST /spaces/{space_ref}/package-denylist/import)
+func (_ Unimplemented) ImportPackageDenylist(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete package denylist entry
+// (DELETE /spaces/{space_ref}/package-denylist/{denylist_entry_id})
+func (_ Unimplemented) DeletePackageDenylistEntry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, denylistEntryId DenylistEntryIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List pipeline artifacts
+// (GET /spaces/{space_ref}/pipeline-artifacts)
+func (_ Unimplemented) ListPipelineArtifacts(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params ListPipelineArtifactsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List registries
 // (GET /spaces/{space_ref}/registries)
 func (_ Unimplemented) GetAllRegistries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllRegistriesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get registry garbage stats
+// (GET /spaces/{space_ref}/registries/garbage)
+func (_ Unimplemented) GetRegistryGarbageStats(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get upload failure stats
+// (GET /spaces/{space_ref}/registries/upload-failures)
+func (_ Unimplemented) GetUploadFailureStats(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetUploadFailureStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get space registry usage
+// (GET /spaces/{space_ref}/registries/usage)
+func (_ Unimplemented) GetSpaceRegistryUsage(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get space registry usage history
+// (GET /spaces/{space_ref}/registries/usage/history)
+func (_ Unimplemented) GetSpaceRegistryUsageHistory(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetSpaceRegistryUsageHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get space registry policy
+// (GET /spaces/{space_ref}/registry-policy)
+func (_ Unimplemented) GetSpaceRegistryPolicy(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update space registry policy
+// (PUT /spaces/{space_ref}/registry-policy)
+func (_ Unimplemented) UpdateSpaceRegistryPolicy(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List vulnerable versions
+// (GET /spaces/{space_ref}/vulnerable-versions)
+func (_ Unimplemented) ListVulnerableVersions(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params ListVulnerableVersionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -462,31 +1074,24 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// CreateRegistry operation middleware
-func (siw *ServerInterfaceWrapper) CreateRegistry(w http.ResponseWriter, r *http.Request) {
+// ListRecentArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) ListRecentArtifacts(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateRegistryParams
-
-	// ------------- Required query parameter "space_ref" -------------
-
-	if paramValue := r.URL.Query().Get("space_ref"); paramValue != "" {
+	var params ListRecentArtifactsParams
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "space_ref"})
-		return
-	}
+	// ------------- Optional query parameter "activity" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "space_ref", r.URL.Query(), &params.SpaceRef)
+	err = runtime.BindQueryParameter("form", true, false, "activity", r.URL.Query(), &params.Activity)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "activity", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRegistry(w, r, params)
+		siw.Handler.ListRecentArtifacts(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -496,22 +1101,32 @@ func (siw *ServerInterfaceWrapper) CreateRegistry(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteRegistry operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRegistry(w http.ResponseWriter, r *http.Request) {
+// ListStarredArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) ListStarredArtifacts(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListStarredArtifactsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteRegistry(w, r, registryRef)
+		siw.Handler.ListStarredArtifacts(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -521,22 +1136,11 @@ func (siw *ServerInterfaceWrapper) DeleteRegistry(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetRegistry operation middleware
-func (siw *ServerInterfaceWrapper) GetRegistry(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
-		return
-	}
+// GetPackageTypeCapabilities operation middleware
+func (siw *ServerInterfaceWrapper) GetPackageTypeCapabilities(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRegistry(w, r, registryRef)
+		siw.Handler.GetPackageTypeCapabilities(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -546,22 +1150,31 @@ func (siw *ServerInterfaceWrapper) GetRegistry(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// ModifyRegistry operation middleware
-func (siw *ServerInterfaceWrapper) ModifyRegistry(w http.ResponseWriter, r *http.Request) {
+// CreateRegistry operation middleware
+func (siw *ServerInterfaceWrapper) CreateRegistry(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateRegistryParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Required query parameter "space_ref" -------------
+
+	if paramValue := r.URL.Query().Get("space_ref"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "space_ref"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "space_ref", r.URL.Query(), &params.SpaceRef)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ModifyRegistry(w, r, registryRef)
+		siw.Handler.CreateRegistry(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -571,22 +1184,59 @@ func (siw *ServerInterfaceWrapper) ModifyRegistry(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// ListArtifactLabels operation middleware
-func (siw *ServerInterfaceWrapper) ListArtifactLabels(w http.ResponseWriter, r *http.Request) {
+// SearchArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) SearchArtifacts(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchArtifactsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Required query parameter "space_ref" -------------
+
+	if paramValue := r.URL.Query().Get("space_ref"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "space_ref"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "space_ref", r.URL.Query(), &params.SpaceRef)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListArtifactLabelsParams
+	// ------------- Required query parameter "query" -------------
+
+	if paramValue := r.URL.Query().Get("query"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "query"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "field", r.URL.Query(), &params.Field)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "package_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package_type", r.URL.Query(), &params.PackageType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -604,16 +1254,8 @@ func (siw *ServerInterfaceWrapper) ListArtifactLabels(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Optional query parameter "search_term" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListArtifactLabels(w, r, registryRef, params)
+		siw.Handler.SearchArtifacts(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -623,8 +1265,8 @@ func (siw *ServerInterfaceWrapper) ListArtifactLabels(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactStatsForRegistry operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactStatsForRegistry(w http.ResponseWriter, r *http.Request) {
+// DeleteRegistry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRegistry(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -637,27 +1279,33 @@ func (siw *ServerInterfaceWrapper) GetArtifactStatsForRegistry(w http.ResponseWr
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactStatsForRegistryParams
-
-	// ------------- Optional query parameter "from" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRegistry(w, r, registryRef)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "to" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+// GetRegistry operation middleware
+func (siw *ServerInterfaceWrapper) GetRegistry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactStatsForRegistry(w, r, registryRef, params)
+		siw.Handler.GetRegistry(w, r, registryRef)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -667,8 +1315,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactStatsForRegistry(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteArtifact operation middleware
-func (siw *ServerInterfaceWrapper) DeleteArtifact(w http.ResponseWriter, r *http.Request) {
+// ModifyRegistry operation middleware
+func (siw *ServerInterfaceWrapper) ModifyRegistry(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -681,28 +1329,44 @@ func (siw *ServerInterfaceWrapper) DeleteArtifact(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Path parameter "artifact" -------------
-	var artifact ArtifactPathParam
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ModifyRegistry(w, r, registryRef)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApplyRegistryConfig operation middleware
+func (siw *ServerInterfaceWrapper) ApplyRegistryConfig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteArtifactParams
+	var params ApplyRegistryConfigParams
 
-	// ------------- Optional query parameter "artifact_type" -------------
+	// ------------- Optional query parameter "dryRun" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteArtifact(w, r, registryRef, artifact, params)
+		siw.Handler.ApplyRegistryConfig(w, r, registryRef, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -712,8 +1376,8 @@ func (siw *ServerInterfaceWrapper) DeleteArtifact(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateArtifactLabels operation middleware
-func (siw *ServerInterfaceWrapper) UpdateArtifactLabels(w http.ResponseWriter, r *http.Request) {
+// ListArtifactLabels operation middleware
+func (siw *ServerInterfaceWrapper) ListArtifactLabels(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -726,28 +1390,35 @@ func (siw *ServerInterfaceWrapper) UpdateArtifactLabels(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Path parameter "artifact" -------------
-	var artifact ArtifactPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListArtifactLabelsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params UpdateArtifactLabelsParams
+	// ------------- Optional query parameter "size" -------------
 
-	// ------------- Optional query parameter "artifact_type" -------------
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateArtifactLabels(w, r, registryRef, artifact, params)
+		siw.Handler.ListArtifactLabels(w, r, registryRef, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -757,8 +1428,8 @@ func (siw *ServerInterfaceWrapper) UpdateArtifactLabels(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactStats operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactStats(w http.ResponseWriter, r *http.Request) {
+// GetArtifactStatsForRegistry operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactStatsForRegistry(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -771,17 +1442,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactStats(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Path parameter "artifact" -------------
-	var artifact ArtifactPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactStatsParams
+	var params GetArtifactStatsForRegistryParams
 
 	// ------------- Optional query parameter "from" -------------
 
@@ -800,7 +1462,7 @@ func (siw *ServerInterfaceWrapper) GetArtifactStats(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactStats(w, r, registryRef, artifact, params)
+		siw.Handler.GetArtifactStatsForRegistry(w, r, registryRef, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -810,8 +1472,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactStats(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactSummary operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactSummary(w http.ResponseWriter, r *http.Request) {
+// DeleteArtifact operation middleware
+func (siw *ServerInterfaceWrapper) DeleteArtifact(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -834,7 +1496,7 @@ func (siw *ServerInterfaceWrapper) GetArtifactSummary(w http.ResponseWriter, r *
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactSummaryParams
+	var params DeleteArtifactParams
 
 	// ------------- Optional query parameter "artifact_type" -------------
 
@@ -845,7 +1507,7 @@ func (siw *ServerInterfaceWrapper) GetArtifactSummary(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactSummary(w, r, registryRef, artifact, params)
+		siw.Handler.DeleteArtifact(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -855,8 +1517,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactSummary(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetOciArtifactTags operation middleware
-func (siw *ServerInterfaceWrapper) GetOciArtifactTags(w http.ResponseWriter, r *http.Request) {
+// UpdateArtifactDescription operation middleware
+func (siw *ServerInterfaceWrapper) UpdateArtifactDescription(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -879,34 +1541,18 @@ func (siw *ServerInterfaceWrapper) GetOciArtifactTags(w http.ResponseWriter, r *
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetOciArtifactTagsParams
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "size" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
-		return
-	}
+	var params UpdateArtifactDescriptionParams
 
-	// ------------- Optional query parameter "search_term" -------------
+	// ------------- Optional query parameter "artifact_type" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetOciArtifactTags(w, r, registryRef, artifact, params)
+		siw.Handler.UpdateArtifactDescription(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -916,8 +1562,8 @@ func (siw *ServerInterfaceWrapper) GetOciArtifactTags(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteArtifactVersion operation middleware
-func (siw *ServerInterfaceWrapper) DeleteArtifactVersion(w http.ResponseWriter, r *http.Request) {
+// ListArtifactDescriptionHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListArtifactDescriptionHistory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -939,28 +1585,35 @@ func (siw *ServerInterfaceWrapper) DeleteArtifactVersion(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListArtifactDescriptionHistoryParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "artifact_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteArtifactVersionParams
+	// ------------- Optional query parameter "page" -------------
 
-	// ------------- Optional query parameter "artifact_type" -------------
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteArtifactVersion(w, r, registryRef, artifact, version, params)
+		siw.Handler.ListArtifactDescriptionHistory(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -970,8 +1623,8 @@ func (siw *ServerInterfaceWrapper) DeleteArtifactVersion(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactDetails operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactDetails(w http.ResponseWriter, r *http.Request) {
+// GetArtifactDownloadStats operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactDownloadStats(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -993,17 +1646,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactDetails(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactDetailsParams
+	var params GetArtifactDownloadStatsParams
 
 	// ------------- Optional query parameter "artifact_type" -------------
 
@@ -1013,16 +1657,32 @@ func (siw *ServerInterfaceWrapper) GetArtifactDetails(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Optional query parameter "childVersion" -------------
+	// ------------- Optional query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "childVersion", r.URL.Query(), &params.ChildVersion)
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "childVersion", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", r.URL.Query(), &params.Interval)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "interval", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactDetails(w, r, registryRef, artifact, version, params)
+		siw.Handler.GetArtifactDownloadStats(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1032,8 +1692,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactDetails(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetDockerArtifactDetails operation middleware
-func (siw *ServerInterfaceWrapper) GetDockerArtifactDetails(w http.ResponseWriter, r *http.Request) {
+// UpdateArtifactLabels operation middleware
+func (siw *ServerInterfaceWrapper) UpdateArtifactLabels(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1055,43 +1715,19 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactDetails(w http.ResponseWrite
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDockerArtifactDetailsParams
-
-	// ------------- Required query parameter "digest" -------------
-
-	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
-		return
-	}
+	var params UpdateArtifactLabelsParams
 
-	// ------------- Optional query parameter "version_type" -------------
+	// ------------- Optional query parameter "artifact_type" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "version_type", r.URL.Query(), &params.VersionType)
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDockerArtifactDetails(w, r, registryRef, artifact, version, params)
+		siw.Handler.UpdateArtifactLabels(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1101,8 +1737,8 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactDetails(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetDockerArtifactLayers operation middleware
-func (siw *ServerInterfaceWrapper) GetDockerArtifactLayers(w http.ResponseWriter, r *http.Request) {
+// DeleteArtifactLogo operation middleware
+func (siw *ServerInterfaceWrapper) DeleteArtifactLogo(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1124,35 +1760,19 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactLayers(w http.ResponseWriter
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDockerArtifactLayersParams
-
-	// ------------- Required query parameter "digest" -------------
+	var params DeleteArtifactLogoParams
 
-	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
-		return
-	}
+	// ------------- Optional query parameter "artifact_type" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDockerArtifactLayers(w, r, registryRef, artifact, version, params)
+		siw.Handler.DeleteArtifactLogo(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1162,8 +1782,8 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactLayers(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetDockerArtifactManifest operation middleware
-func (siw *ServerInterfaceWrapper) GetDockerArtifactManifest(w http.ResponseWriter, r *http.Request) {
+// GetArtifactLogo operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactLogo(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1185,35 +1805,40 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactManifest(w http.ResponseWrit
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactLogoParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "artifact_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDockerArtifactManifestParams
+	headers := r.Header
 
-	// ------------- Required query parameter "digest" -------------
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchHeaderParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
 
-	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
-		return
-	}
+		params.IfNoneMatch = &IfNoneMatch
 
-	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
-		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDockerArtifactManifest(w, r, registryRef, artifact, version, params)
+		siw.Handler.GetArtifactLogo(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1223,8 +1848,8 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactManifest(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetDockerArtifactManifests operation middleware
-func (siw *ServerInterfaceWrapper) GetDockerArtifactManifests(w http.ResponseWriter, r *http.Request) {
+// UploadArtifactLogo operation middleware
+func (siw *ServerInterfaceWrapper) UploadArtifactLogo(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1246,28 +1871,19 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactManifests(w http.ResponseWri
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDockerArtifactManifestsParams
+	var params UploadArtifactLogoParams
 
-	// ------------- Optional query parameter "version_type" -------------
+	// ------------- Optional query parameter "artifact_type" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "version_type", r.URL.Query(), &params.VersionType)
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDockerArtifactManifests(w, r, registryRef, artifact, version, params)
+		siw.Handler.UploadArtifactLogo(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1277,8 +1893,8 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactManifests(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactFile operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactFile(w http.ResponseWriter, r *http.Request) {
+// ExportOciArchive operation middleware
+func (siw *ServerInterfaceWrapper) ExportOciArchive(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1300,37 +1916,19 @@ func (siw *ServerInterfaceWrapper) GetArtifactFile(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "file_name" -------------
-	var fileName FileNamePathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "file_name", chi.URLParam(r, "file_name"), &fileName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_name", Err: err})
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactFileParams
+	var params ExportOciArchiveParams
 
-	// ------------- Optional query parameter "artifact_type" -------------
+	// ------------- Optional query parameter "tag" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactFile(w, r, registryRef, artifact, version, fileName, params)
+		siw.Handler.ExportOciArchive(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1340,8 +1938,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactFile(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactFiles operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactFiles(w http.ResponseWriter, r *http.Request) {
+// UnstarArtifact operation middleware
+func (siw *ServerInterfaceWrapper) UnstarArtifact(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1363,17 +1961,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactFiles(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactFilesParams
+	var params UnstarArtifactParams
 
 	// ------------- Optional query parameter "artifact_type" -------------
 
@@ -1383,48 +1972,53 @@ func (siw *ServerInterfaceWrapper) GetArtifactFiles(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnstarArtifact(w, r, registryRef, artifact, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
-		return
-	}
+// StarArtifact operation middleware
+func (siw *ServerInterfaceWrapper) StarArtifact(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "sort_order" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_field" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search_term" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StarArtifactParams
 
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	// ------------- Optional query parameter "artifact_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactFiles(w, r, registryRef, artifact, version, params)
+		siw.Handler.StarArtifact(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1434,8 +2028,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactFiles(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetHelmArtifactDetails operation middleware
-func (siw *ServerInterfaceWrapper) GetHelmArtifactDetails(w http.ResponseWriter, r *http.Request) {
+// GetArtifactStats operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactStats(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1457,28 +2051,27 @@ func (siw *ServerInterfaceWrapper) GetHelmArtifactDetails(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactStatsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetHelmArtifactDetailsParams
-
-	// ------------- Optional query parameter "version_type" -------------
+	// ------------- Optional query parameter "to" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "version_type", r.URL.Query(), &params.VersionType)
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHelmArtifactDetails(w, r, registryRef, artifact, version, params)
+		siw.Handler.GetArtifactStats(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1488,8 +2081,8 @@ func (siw *ServerInterfaceWrapper) GetHelmArtifactDetails(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetHelmArtifactManifest operation middleware
-func (siw *ServerInterfaceWrapper) GetHelmArtifactManifest(w http.ResponseWriter, r *http.Request) {
+// GetArtifactSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactSummary(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1511,17 +2104,19 @@ func (siw *ServerInterfaceWrapper) GetHelmArtifactManifest(w http.ResponseWriter
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactSummaryParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "artifact_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHelmArtifactManifest(w, r, registryRef, artifact, version)
+		siw.Handler.GetArtifactSummary(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1531,8 +2126,8 @@ func (siw *ServerInterfaceWrapper) GetHelmArtifactManifest(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactVersionSummary operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactVersionSummary(w http.ResponseWriter, r *http.Request) {
+// GetOciArtifactTags operation middleware
+func (siw *ServerInterfaceWrapper) GetOciArtifactTags(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1554,36 +2149,35 @@ func (siw *ServerInterfaceWrapper) GetArtifactVersionSummary(w http.ResponseWrit
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOciArtifactTagsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactVersionSummaryParams
-
-	// ------------- Optional query parameter "artifact_type" -------------
+	// ------------- Optional query parameter "size" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "digest" -------------
+	// ------------- Optional query parameter "search_term" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "digest", r.URL.Query(), &params.Digest)
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactVersionSummary(w, r, registryRef, artifact, version, params)
+		siw.Handler.GetOciArtifactTags(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1593,8 +2187,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactVersionSummary(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetAllArtifactVersions operation middleware
-func (siw *ServerInterfaceWrapper) GetAllArtifactVersions(w http.ResponseWriter, r *http.Request) {
+// DeleteArtifactVersion operation middleware
+func (siw *ServerInterfaceWrapper) DeleteArtifactVersion(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1616,8 +2210,17 @@ func (siw *ServerInterfaceWrapper) GetAllArtifactVersions(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllArtifactVersionsParams
+	var params DeleteArtifactVersionParams
 
 	// ------------- Optional query parameter "artifact_type" -------------
 
@@ -1627,48 +2230,70 @@ func (siw *ServerInterfaceWrapper) GetAllArtifactVersions(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteArtifactVersion(w, r, registryRef, artifact, version, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetArtifactDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactDetails(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_order" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_field" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactDetailsParams
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	// ------------- Optional query parameter "artifact_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search_term" -------------
+	// ------------- Optional query parameter "childVersion" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	err = runtime.BindQueryParameter("form", true, false, "childVersion", r.URL.Query(), &params.ChildVersion)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "childVersion", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllArtifactVersions(w, r, registryRef, artifact, params)
+		siw.Handler.GetArtifactDetails(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1678,8 +2303,8 @@ func (siw *ServerInterfaceWrapper) GetAllArtifactVersions(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetAllArtifactsByRegistry operation middleware
-func (siw *ServerInterfaceWrapper) GetAllArtifactsByRegistry(w http.ResponseWriter, r *http.Request) {
+// GetDockerArtifactDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetDockerArtifactDetails(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1692,67 +2317,52 @@ func (siw *ServerInterfaceWrapper) GetAllArtifactsByRegistry(w http.ResponseWrit
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllArtifactsByRegistryParams
-
-	// ------------- Optional query parameter "label" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_order" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDockerArtifactDetailsParams
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
-		return
-	}
+	// ------------- Required query parameter "digest" -------------
 
-	// ------------- Optional query parameter "sort_field" -------------
+	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
 		return
 	}
 
-	// ------------- Optional query parameter "search_term" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "artifact_type" -------------
+	// ------------- Optional query parameter "version_type" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
+	err = runtime.BindQueryParameter("form", true, false, "version_type", r.URL.Query(), &params.VersionType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version_type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllArtifactsByRegistry(w, r, registryRef, params)
+		siw.Handler.GetDockerArtifactDetails(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1762,8 +2372,8 @@ func (siw *ServerInterfaceWrapper) GetAllArtifactsByRegistry(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetClientSetupDetails operation middleware
-func (siw *ServerInterfaceWrapper) GetClientSetupDetails(w http.ResponseWriter, r *http.Request) {
+// GetDockerArtifactLayers operation middleware
+func (siw *ServerInterfaceWrapper) GetDockerArtifactLayers(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1776,27 +2386,44 @@ func (siw *ServerInterfaceWrapper) GetClientSetupDetails(w http.ResponseWriter,
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetClientSetupDetailsParams
-
-	// ------------- Optional query parameter "artifact" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "artifact", r.URL.Query(), &params.Artifact)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "version" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDockerArtifactLayersParams
+
+	// ------------- Required query parameter "digest" -------------
+
+	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetClientSetupDetails(w, r, registryRef, params)
+		siw.Handler.GetDockerArtifactLayers(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1806,8 +2433,8 @@ func (siw *ServerInterfaceWrapper) GetClientSetupDetails(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteQuarantineFilePath operation middleware
-func (siw *ServerInterfaceWrapper) DeleteQuarantineFilePath(w http.ResponseWriter, r *http.Request) {
+// GetDockerArtifactManifest operation middleware
+func (siw *ServerInterfaceWrapper) GetDockerArtifactManifest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1820,43 +2447,44 @@ func (siw *ServerInterfaceWrapper) DeleteQuarantineFilePath(w http.ResponseWrite
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteQuarantineFilePathParams
-
-	// ------------- Optional query parameter "artifact" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "artifact", r.URL.Query(), &params.Artifact)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "version" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "file_path" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDockerArtifactManifestParams
 
-	err = runtime.BindQueryParameter("form", true, false, "file_path", r.URL.Query(), &params.FilePath)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_path", Err: err})
+	// ------------- Required query parameter "digest" -------------
+
+	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
 		return
 	}
 
-	// ------------- Optional query parameter "artifact_type" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
+	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteQuarantineFilePath(w, r, registryRef, params)
+		siw.Handler.GetDockerArtifactManifest(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1866,8 +2494,8 @@ func (siw *ServerInterfaceWrapper) DeleteQuarantineFilePath(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// QuarantineFilePath operation middleware
-func (siw *ServerInterfaceWrapper) QuarantineFilePath(w http.ResponseWriter, r *http.Request) {
+// GetDockerArtifactManifests operation middleware
+func (siw *ServerInterfaceWrapper) GetDockerArtifactManifests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1880,76 +2508,100 @@ func (siw *ServerInterfaceWrapper) QuarantineFilePath(w http.ResponseWriter, r *
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QuarantineFilePath(w, r, registryRef)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// ListWebhooks operation middleware
-func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListWebhooksParams
+	var params GetDockerArtifactManifestsParams
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Optional query parameter "version_type" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "version_type", r.URL.Query(), &params.VersionType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version_type", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDockerArtifactManifests(w, r, registryRef, artifact, version, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetArtifactFile operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_order" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_field" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search_term" -------------
+	// ------------- Path parameter "file_name" -------------
+	var fileName FileNamePathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	err = runtime.BindStyledParameterWithOptions("simple", "file_name", chi.URLParam(r, "file_name"), &fileName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactFileParams
+
+	// ------------- Optional query parameter "artifact_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWebhooks(w, r, registryRef, params)
+		siw.Handler.GetArtifactFile(w, r, registryRef, artifact, version, fileName, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1959,8 +2611,8 @@ func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateWebhook operation middleware
-func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+// GetArtifactFiles operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactFiles(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1973,76 +2625,77 @@ func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWebhook(w, r, registryRef)
-	}))
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-// DeleteWebhook operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
 
-	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactFilesParams
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+	// ------------- Optional query parameter "artifact_type" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "webhook_identifier" -------------
-	var webhookIdentifier WebhookIdentifierPathParam
+	// ------------- Optional query parameter "page" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWebhook(w, r, registryRef, webhookIdentifier)
-	}))
+	// ------------- Optional query parameter "size" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// GetWebhook operation middleware
-func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	// ------------- Optional query parameter "sort_order" -------------
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+	// ------------- Optional query parameter "sort_field" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "webhook_identifier" -------------
-	var webhookIdentifier WebhookIdentifierPathParam
+	// ------------- Optional query parameter "search_term" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWebhook(w, r, registryRef, webhookIdentifier)
+		siw.Handler.GetArtifactFiles(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2052,8 +2705,8 @@ func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateWebhook operation middleware
-func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+// RevokeFirewallApproval operation middleware
+func (siw *ServerInterfaceWrapper) RevokeFirewallApproval(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2066,17 +2719,26 @@ func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Path parameter "webhook_identifier" -------------
-	var webhookIdentifier WebhookIdentifierPathParam
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateWebhook(w, r, registryRef, webhookIdentifier)
+		siw.Handler.RevokeFirewallApproval(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2086,8 +2748,8 @@ func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ListWebhookExecutions operation middleware
-func (siw *ServerInterfaceWrapper) ListWebhookExecutions(w http.ResponseWriter, r *http.Request) {
+// ApproveFirewallVersion operation middleware
+func (siw *ServerInterfaceWrapper) ApproveFirewallVersion(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2100,36 +2762,26 @@ func (siw *ServerInterfaceWrapper) ListWebhookExecutions(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "webhook_identifier" -------------
-	var webhookIdentifier WebhookIdentifierPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
-		return
-	}
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListWebhookExecutionsParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWebhookExecutions(w, r, registryRef, webhookIdentifier, params)
+		siw.Handler.ApproveFirewallVersion(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2139,8 +2791,8 @@ func (siw *ServerInterfaceWrapper) ListWebhookExecutions(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetWebhookExecution operation middleware
-func (siw *ServerInterfaceWrapper) GetWebhookExecution(w http.ResponseWriter, r *http.Request) {
+// GetHelmArtifactDependencies operation middleware
+func (siw *ServerInterfaceWrapper) GetHelmArtifactDependencies(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2153,26 +2805,26 @@ func (siw *ServerInterfaceWrapper) GetWebhookExecution(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Path parameter "webhook_identifier" -------------
-	var webhookIdentifier WebhookIdentifierPathParam
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "webhook_execution_id" -------------
-	var webhookExecutionId WebhookExecutionIdPathParam
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_execution_id", chi.URLParam(r, "webhook_execution_id"), &webhookExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_execution_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWebhookExecution(w, r, registryRef, webhookIdentifier, webhookExecutionId)
+		siw.Handler.GetHelmArtifactDependencies(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2182,8 +2834,8 @@ func (siw *ServerInterfaceWrapper) GetWebhookExecution(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ReTriggerWebhookExecution operation middleware
-func (siw *ServerInterfaceWrapper) ReTriggerWebhookExecution(w http.ResponseWriter, r *http.Request) {
+// GetHelmArtifactDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetHelmArtifactDetails(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2196,26 +2848,37 @@ func (siw *ServerInterfaceWrapper) ReTriggerWebhookExecution(w http.ResponseWrit
 		return
 	}
 
-	// ------------- Path parameter "webhook_identifier" -------------
-	var webhookIdentifier WebhookIdentifierPathParam
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "webhook_execution_id" -------------
-	var webhookExecutionId WebhookExecutionIdPathParam
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_execution_id", chi.URLParam(r, "webhook_execution_id"), &webhookExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_execution_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHelmArtifactDetailsParams
+
+	// ------------- Optional query parameter "version_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version_type", r.URL.Query(), &params.VersionType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version_type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReTriggerWebhookExecution(w, r, registryRef, webhookIdentifier, webhookExecutionId)
+		siw.Handler.GetHelmArtifactDetails(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2225,51 +2888,40 @@ func (siw *ServerInterfaceWrapper) ReTriggerWebhookExecution(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// ListReplicationRules operation middleware
-func (siw *ServerInterfaceWrapper) ListReplicationRules(w http.ResponseWriter, r *http.Request) {
+// GetHelmArtifactManifest operation middleware
+func (siw *ServerInterfaceWrapper) GetHelmArtifactManifest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListReplicationRulesParams
-
-	// ------------- Optional query parameter "space_ref" -------------
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "space_ref", r.URL.Query(), &params.SpaceRef)
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListReplicationRules(w, r, params)
-	}))
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// CreateReplicationRule operation middleware
-func (siw *ServerInterfaceWrapper) CreateReplicationRule(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateReplicationRuleParams
-
-	// ------------- Optional query parameter "space_ref" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "space_ref", r.URL.Query(), &params.SpaceRef)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateReplicationRule(w, r, params)
+		siw.Handler.GetHelmArtifactManifest(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2279,72 +2931,67 @@ func (siw *ServerInterfaceWrapper) CreateReplicationRule(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteReplicationRule operation middleware
-func (siw *ServerInterfaceWrapper) DeleteReplicationRule(w http.ResponseWriter, r *http.Request) {
+// ListArtifactVersionMetadataHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListArtifactVersionMetadataHistory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteReplicationRule(w, r, id)
-	}))
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-// GetReplicationRule operation middleware
-func (siw *ServerInterfaceWrapper) GetReplicationRule(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
 
-	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListArtifactVersionMetadataHistoryParams
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Optional query parameter "artifact_type" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetReplicationRule(w, r, id)
-	}))
+	// ------------- Optional query parameter "page" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// UpdateReplicationRule operation middleware
-func (siw *ServerInterfaceWrapper) UpdateReplicationRule(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Optional query parameter "size" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateReplicationRule(w, r, id)
+		siw.Handler.ListArtifactVersionMetadataHistory(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2354,57 +3001,51 @@ func (siw *ServerInterfaceWrapper) UpdateReplicationRule(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ListMigrationImages operation middleware
-func (siw *ServerInterfaceWrapper) ListMigrationImages(w http.ResponseWriter, r *http.Request) {
+// GetArtifactVersionProvenance operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactVersionProvenance(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListMigrationImagesParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_order" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
-		return
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactVersionProvenanceParams
 
-	// ------------- Optional query parameter "sort_field" -------------
+	// ------------- Optional query parameter "artifact_type" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListMigrationImages(w, r, id, params)
+		siw.Handler.GetArtifactVersionProvenance(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2414,56 +3055,51 @@ func (siw *ServerInterfaceWrapper) ListMigrationImages(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetMigrationLogsForImage operation middleware
-func (siw *ServerInterfaceWrapper) GetMigrationLogsForImage(w http.ResponseWriter, r *http.Request) {
+// UpdateArtifactVersionProvenance operation middleware
+func (siw *ServerInterfaceWrapper) UpdateArtifactVersionProvenance(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "image_id" -------------
-	var imageId string
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "image_id", chi.URLParam(r, "image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMigrationLogsForImage(w, r, id, imageId)
-	}))
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// StartMigration operation middleware
-func (siw *ServerInterfaceWrapper) StartMigration(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateArtifactVersionProvenanceParams
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Optional query parameter "artifact_type" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StartMigration(w, r, id)
+		siw.Handler.UpdateArtifactVersionProvenance(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2473,22 +3109,40 @@ func (siw *ServerInterfaceWrapper) StartMigration(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// StopMigration operation middleware
-func (siw *ServerInterfaceWrapper) StopMigration(w http.ResponseWriter, r *http.Request) {
+// RestoreArtifactVersion operation middleware
+func (siw *ServerInterfaceWrapper) RestoreArtifactVersion(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StopMigration(w, r, id)
+		siw.Handler.RestoreArtifactVersion(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2498,41 +3152,40 @@ func (siw *ServerInterfaceWrapper) StopMigration(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactStatsForSpace operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactStatsForSpace(w http.ResponseWriter, r *http.Request) {
+// GetArtifactScanStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactScanStatus(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "space_ref" -------------
-	var spaceRef SpaceRefPathParam
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactStatsForSpaceParams
-
-	// ------------- Optional query parameter "from" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "to" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactStatsForSpace(w, r, spaceRef, params)
+		siw.Handler.GetArtifactScanStatus(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2542,89 +3195,145 @@ func (siw *ServerInterfaceWrapper) GetArtifactStatsForSpace(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetAllArtifacts operation middleware
-func (siw *ServerInterfaceWrapper) GetAllArtifacts(w http.ResponseWriter, r *http.Request) {
+// RequestArtifactScan operation middleware
+func (siw *ServerInterfaceWrapper) RequestArtifactScan(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "space_ref" -------------
-	var spaceRef SpaceRefPathParam
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllArtifactsParams
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	// ------------- Optional query parameter "reg_identifier" -------------
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "reg_identifier", r.URL.Query(), &params.RegIdentifier)
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reg_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestArtifactScan(w, r, registryRef, artifact, version)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateArtifactScanStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateArtifactScanStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_order" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_field" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateArtifactScanStatus(w, r, registryRef, artifact, version)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetArtifactVersionSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactVersionSummary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search_term" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "latest_version" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "latest_version", r.URL.Query(), &params.LatestVersion)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "latest_version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "package_type" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactVersionSummaryParams
 
-	err = runtime.BindQueryParameter("form", true, false, "package_type", r.URL.Query(), &params.PackageType)
+	// ------------- Optional query parameter "artifact_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "digest" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "digest", r.URL.Query(), &params.Digest)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllArtifacts(w, r, spaceRef, params)
+		siw.Handler.GetArtifactVersionSummary(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2634,36 +3343,80 @@ func (siw *ServerInterfaceWrapper) GetAllArtifacts(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetAllRegistries operation middleware
-func (siw *ServerInterfaceWrapper) GetAllRegistries(w http.ResponseWriter, r *http.Request) {
+// PurgeArtifactVersion operation middleware
+func (siw *ServerInterfaceWrapper) PurgeArtifactVersion(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "space_ref" -------------
-	var spaceRef SpaceRefPathParam
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllRegistriesParams
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	// ------------- Optional query parameter "package_type" -------------
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "package_type", r.URL.Query(), &params.PackageType)
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "type" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PurgeArtifactVersion(w, r, registryRef, artifact, version)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAllArtifactVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetAllArtifactVersions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllArtifactVersionsParams
+
+	// ------------- Optional query parameter "artifact_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
 		return
 	}
 
@@ -2707,24 +3460,24 @@ func (siw *ServerInterfaceWrapper) GetAllRegistries(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Optional query parameter "recursive" -------------
+	// ------------- Optional query parameter "include_deleted" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "recursive", r.URL.Query(), &params.Recursive)
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", r.URL.Query(), &params.IncludeDeleted)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recursive", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_deleted", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "scope" -------------
+	// ------------- Optional query parameter "only_deleted" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	err = runtime.BindQueryParameter("form", true, false, "only_deleted", r.URL.Query(), &params.OnlyDeleted)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "only_deleted", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllRegistries(w, r, spaceRef, params)
+		siw.Handler.GetAllArtifactVersions(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2734,3804 +3487,13849 @@ func (siw *ServerInterfaceWrapper) GetAllRegistries(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// SyncArtifactVersions operation middleware
+func (siw *ServerInterfaceWrapper) SyncArtifactVersions(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SyncArtifactVersionsParams
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional query parameter "size" -------------
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SyncArtifactVersions(w, r, registryRef, artifact, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAllArtifactsByRegistry operation middleware
+func (siw *ServerInterfaceWrapper) GetAllArtifactsByRegistry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllArtifactsByRegistryParams
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "artifact_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllArtifactsByRegistry(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetArtifactDownloadCounts operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactDownloadCounts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactDownloadCountsParams
+
+	// ------------- Required query parameter "artifact" -------------
+
+	if paramValue := r.URL.Query().Get("artifact"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "artifact"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "artifact", r.URL.Query(), &params.Artifact)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArtifactDownloadCounts(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetClientSetupDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetClientSetupDetails(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClientSetupDetailsParams
+
+	// ------------- Optional query parameter "artifact" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact", r.URL.Query(), &params.Artifact)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClientSetupDetails(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDeletionRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListDeletionRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDeletionRequestsParams
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDeletionRequests(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveDeletionRequest operation middleware
+func (siw *ServerInterfaceWrapper) ApproveDeletionRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "deletion_request_id" -------------
+	var deletionRequestId DeletionRequestIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deletion_request_id", chi.URLParam(r, "deletion_request_id"), &deletionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deletion_request_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveDeletionRequest(w, r, registryRef, deletionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RejectDeletionRequest operation middleware
+func (siw *ServerInterfaceWrapper) RejectDeletionRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "deletion_request_id" -------------
+	var deletionRequestId DeletionRequestIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deletion_request_id", chi.URLParam(r, "deletion_request_id"), &deletionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deletion_request_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectDeletionRequest(w, r, registryRef, deletionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListFailedUploads operation middleware
+func (siw *ServerInterfaceWrapper) ListFailedUploads(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFailedUploadsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFailedUploads(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DownloadFailedUpload operation middleware
+func (siw *ServerInterfaceWrapper) DownloadFailedUpload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "failed_upload_uuid" -------------
+	var failedUploadUuid FailedUploadUuidPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "failed_upload_uuid", chi.URLParam(r, "failed_upload_uuid"), &failedUploadUuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "failed_upload_uuid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadFailedUpload(w, r, registryRef, failedUploadUuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListFirewallApprovals operation middleware
+func (siw *ServerInterfaceWrapper) ListFirewallApprovals(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFirewallApprovals(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRegistryIndexBuilds operation middleware
+func (siw *ServerInterfaceWrapper) ListRegistryIndexBuilds(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRegistryIndexBuildsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRegistryIndexBuilds(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RetryRegistryIndexBuild operation middleware
+func (siw *ServerInterfaceWrapper) RetryRegistryIndexBuild(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "index_build_id" -------------
+	var indexBuildId IndexBuildIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "index_build_id", chi.URLParam(r, "index_build_id"), &indexBuildId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "index_build_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryRegistryIndexBuild(w, r, registryRef, indexBuildId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRegistryIndexStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetRegistryIndexStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRegistryIndexStatus(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRegistryJobs operation middleware
+func (siw *ServerInterfaceWrapper) ListRegistryJobs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRegistryJobsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRegistryJobs(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRegistryJob operation middleware
+func (siw *ServerInterfaceWrapper) GetRegistryJob(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "job_uuid" -------------
+	var jobUuid JobUuidPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "job_uuid", chi.URLParam(r, "job_uuid"), &jobUuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_uuid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRegistryJob(w, r, registryRef, jobUuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelRegistryJob operation middleware
+func (siw *ServerInterfaceWrapper) CancelRegistryJob(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "job_uuid" -------------
+	var jobUuid JobUuidPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "job_uuid", chi.URLParam(r, "job_uuid"), &jobUuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_uuid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelRegistryJob(w, r, registryRef, jobUuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListNotificationChannels operation middleware
+func (siw *ServerInterfaceWrapper) ListNotificationChannels(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListNotificationChannels(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateNotificationChannel operation middleware
+func (siw *ServerInterfaceWrapper) CreateNotificationChannel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateNotificationChannel(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteNotificationChannel operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNotificationChannel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "channel_identifier" -------------
+	var channelIdentifier ChannelIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "channel_identifier", chi.URLParam(r, "channel_identifier"), &channelIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel_identifier", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteNotificationChannel(w, r, registryRef, channelIdentifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateNotificationChannel operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNotificationChannel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "channel_identifier" -------------
+	var channelIdentifier ChannelIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "channel_identifier", chi.URLParam(r, "channel_identifier"), &channelIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel_identifier", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateNotificationChannel(w, r, registryRef, channelIdentifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportOciArchive operation middleware
+func (siw *ServerInterfaceWrapper) ImportOciArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportOciArchiveParams
+
+	// ------------- Optional query parameter "image" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "image", r.URL.Query(), &params.Image)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportOciArchive(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPackageDenylistOverrides operation middleware
+func (siw *ServerInterfaceWrapper) ListPackageDenylistOverrides(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPackageDenylistOverrides(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemovePackageDenylistOverride operation middleware
+func (siw *ServerInterfaceWrapper) RemovePackageDenylistOverride(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "denylist_entry_id" -------------
+	var denylistEntryId DenylistEntryIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "denylist_entry_id", chi.URLParam(r, "denylist_entry_id"), &denylistEntryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "denylist_entry_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemovePackageDenylistOverride(w, r, registryRef, denylistEntryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OverridePackageDenylistEntry operation middleware
+func (siw *ServerInterfaceWrapper) OverridePackageDenylistEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "denylist_entry_id" -------------
+	var denylistEntryId DenylistEntryIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "denylist_entry_id", chi.URLParam(r, "denylist_entry_id"), &denylistEntryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "denylist_entry_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OverridePackageDenylistEntry(w, r, registryRef, denylistEntryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEffectiveRegistryPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetEffectiveRegistryPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEffectiveRegistryPolicy(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteQuarantineFilePath operation middleware
+func (siw *ServerInterfaceWrapper) DeleteQuarantineFilePath(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteQuarantineFilePathParams
+
+	// ------------- Optional query parameter "artifact" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact", r.URL.Query(), &params.Artifact)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "file_path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "file_path", r.URL.Query(), &params.FilePath)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_path", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "artifact_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact_type", r.URL.Query(), &params.ArtifactType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact_type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteQuarantineFilePath(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QuarantineFilePath operation middleware
+func (siw *ServerInterfaceWrapper) QuarantineFilePath(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QuarantineFilePath(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListScheduledDeletions operation middleware
+func (siw *ServerInterfaceWrapper) ListScheduledDeletions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListScheduledDeletionsParams
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListScheduledDeletions(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ScheduleDeletion operation middleware
+func (siw *ServerInterfaceWrapper) ScheduleDeletion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ScheduleDeletion(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelScheduledDeletion operation middleware
+func (siw *ServerInterfaceWrapper) CancelScheduledDeletion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "scheduled_deletion_id" -------------
+	var scheduledDeletionId ScheduledDeletionIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduled_deletion_id", chi.URLParam(r, "scheduled_deletion_id"), &scheduledDeletionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduled_deletion_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelScheduledDeletion(w, r, registryRef, scheduledDeletionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRegistrySettings operation middleware
+func (siw *ServerInterfaceWrapper) GetRegistrySettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRegistrySettings(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRegistrySettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRegistrySettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRegistrySettings(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRegistryTrash operation middleware
+func (siw *ServerInterfaceWrapper) ListRegistryTrash(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRegistryTrash(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhooksParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, registryRef, webhookIdentifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhook(w, r, registryRef, webhookIdentifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWebhook(w, r, registryRef, webhookIdentifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhookExecutions operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookExecutions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookExecutionsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookExecutions(w, r, registryRef, webhookIdentifier, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhookExecution operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookExecution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_execution_id" -------------
+	var webhookExecutionId WebhookExecutionIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_execution_id", chi.URLParam(r, "webhook_execution_id"), &webhookExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookExecution(w, r, registryRef, webhookIdentifier, webhookExecutionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplayWebhookExecution operation middleware
+func (siw *ServerInterfaceWrapper) ReplayWebhookExecution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_execution_id" -------------
+	var webhookExecutionId WebhookExecutionIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_execution_id", chi.URLParam(r, "webhook_execution_id"), &webhookExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplayWebhookExecution(w, r, registryRef, webhookIdentifier, webhookExecutionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReTriggerWebhookExecution operation middleware
+func (siw *ServerInterfaceWrapper) ReTriggerWebhookExecution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_execution_id" -------------
+	var webhookExecutionId WebhookExecutionIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_execution_id", chi.URLParam(r, "webhook_execution_id"), &webhookExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReTriggerWebhookExecution(w, r, registryRef, webhookIdentifier, webhookExecutionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TestWebhook operation middleware
+func (siw *ServerInterfaceWrapper) TestWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TestWebhook(w, r, registryRef, webhookIdentifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListReplicationRules operation middleware
+func (siw *ServerInterfaceWrapper) ListReplicationRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReplicationRulesParams
+
+	// ------------- Optional query parameter "space_ref" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space_ref", r.URL.Query(), &params.SpaceRef)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListReplicationRules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateReplicationRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateReplicationRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateReplicationRuleParams
+
+	// ------------- Optional query parameter "space_ref" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space_ref", r.URL.Query(), &params.SpaceRef)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateReplicationRule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteReplicationRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteReplicationRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteReplicationRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReplicationRule operation middleware
+func (siw *ServerInterfaceWrapper) GetReplicationRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReplicationRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateReplicationRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateReplicationRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateReplicationRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMigrationImages operation middleware
+func (siw *ServerInterfaceWrapper) ListMigrationImages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMigrationImagesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMigrationImages(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMigrationLogsForImage operation middleware
+func (siw *ServerInterfaceWrapper) GetMigrationLogsForImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", chi.URLParam(r, "image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMigrationLogsForImage(w, r, id, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartMigration operation middleware
+func (siw *ServerInterfaceWrapper) StartMigration(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartMigration(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StopMigration operation middleware
+func (siw *ServerInterfaceWrapper) StopMigration(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopMigration(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetArtifactStatsForSpace operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactStatsForSpace(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactStatsForSpaceParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArtifactStatsForSpace(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAllArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) GetAllArtifacts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllArtifactsParams
+
+	// ------------- Optional query parameter "reg_identifier" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reg_identifier", r.URL.Query(), &params.RegIdentifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reg_identifier", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "latest_version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "latest_version", r.URL.Query(), &params.LatestVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "latest_version", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "package_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package_type", r.URL.Query(), &params.PackageType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllArtifacts(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPackageDenylistEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListPackageDenylistEntries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPackageDenylistEntriesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPackageDenylistEntries(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePackageDenylistEntry operation middleware
+func (siw *ServerInterfaceWrapper) CreatePackageDenylistEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePackageDenylistEntry(w, r, spaceRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportPackageDenylist operation middleware
+func (siw *ServerInterfaceWrapper) ImportPackageDenylist(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportPackageDenylist(w, r, spaceRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePackageDenylistEntry operation middleware
+func (siw *ServerInterfaceWrapper) DeletePackageDenylistEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "denylist_entry_id" -------------
+	var denylistEntryId DenylistEntryIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "denylist_entry_id", chi.URLParam(r, "denylist_entry_id"), &denylistEntryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "denylist_entry_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePackageDenylistEntry(w, r, spaceRef, denylistEntryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPipelineArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) ListPipelineArtifacts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPipelineArtifactsParams
+
+	// ------------- Optional query parameter "pipeline_system" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pipeline_system", r.URL.Query(), &params.PipelineSystem)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pipeline_system", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pipeline_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pipeline_id", r.URL.Query(), &params.PipelineId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pipeline_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "run_url" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "run_url", r.URL.Query(), &params.RunUrl)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_url", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "commit_sha" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "commit_sha", r.URL.Query(), &params.CommitSha)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commit_sha", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPipelineArtifacts(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAllRegistries operation middleware
+func (siw *ServerInterfaceWrapper) GetAllRegistries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllRegistriesParams
+
+	// ------------- Optional query parameter "package_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package_type", r.URL.Query(), &params.PackageType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "recursive" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "recursive", r.URL.Query(), &params.Recursive)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recursive", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllRegistries(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRegistryGarbageStats operation middleware
+func (siw *ServerInterfaceWrapper) GetRegistryGarbageStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRegistryGarbageStats(w, r, spaceRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUploadFailureStats operation middleware
+func (siw *ServerInterfaceWrapper) GetUploadFailureStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUploadFailureStatsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUploadFailureStats(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpaceRegistryUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetSpaceRegistryUsage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpaceRegistryUsage(w, r, spaceRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpaceRegistryUsageHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetSpaceRegistryUsageHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSpaceRegistryUsageHistoryParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpaceRegistryUsageHistory(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpaceRegistryPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetSpaceRegistryPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpaceRegistryPolicy(w, r, spaceRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateSpaceRegistryPolicy operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSpaceRegistryPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSpaceRegistryPolicy(w, r, spaceRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListVulnerableVersions operation middleware
+func (siw *ServerInterfaceWrapper) ListVulnerableVersions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVulnerableVersionsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_severity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_severity", r.URL.Query(), &params.MinSeverity)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_severity", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVulnerableVersions(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
 	Err       error
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/artifacts/recent", wrapper.ListRecentArtifacts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/artifacts/starred", wrapper.ListStarredArtifacts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/package-types/capabilities", wrapper.GetPackageTypeCapabilities)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry", wrapper.CreateRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/search", wrapper.SearchArtifacts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}", wrapper.DeleteRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}", wrapper.GetRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}", wrapper.ModifyRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/apply", wrapper.ApplyRegistryConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/labels", wrapper.ListArtifactLabels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/stats", wrapper.GetArtifactStatsForRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}", wrapper.DeleteArtifact)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/description", wrapper.UpdateArtifactDescription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/description/history", wrapper.ListArtifactDescriptionHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/downloads", wrapper.GetArtifactDownloadStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/labels", wrapper.UpdateArtifactLabels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/logo", wrapper.DeleteArtifactLogo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/logo", wrapper.GetArtifactLogo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/logo", wrapper.UploadArtifactLogo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/oci-export", wrapper.ExportOciArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/star", wrapper.UnstarArtifact)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/star", wrapper.StarArtifact)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/stats", wrapper.GetArtifactStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/summary", wrapper.GetArtifactSummary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/tags", wrapper.GetOciArtifactTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}", wrapper.DeleteArtifactVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/details", wrapper.GetArtifactDetails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/details", wrapper.GetDockerArtifactDetails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/layers", wrapper.GetDockerArtifactLayers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifest", wrapper.GetDockerArtifactManifest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifests", wrapper.GetDockerArtifactManifests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/file/{file_name}", wrapper.GetArtifactFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/files", wrapper.GetArtifactFiles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/firewall-approval", wrapper.RevokeFirewallApproval)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/firewall-approval", wrapper.ApproveFirewallVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/dependencies", wrapper.GetHelmArtifactDependencies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/details", wrapper.GetHelmArtifactDetails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/manifest", wrapper.GetHelmArtifactManifest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/metadata/history", wrapper.ListArtifactVersionMetadataHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/provenance", wrapper.GetArtifactVersionProvenance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/provenance", wrapper.UpdateArtifactVersionProvenance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/restore", wrapper.RestoreArtifactVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/scan", wrapper.GetArtifactScanStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/scan", wrapper.RequestArtifactScan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/scan", wrapper.UpdateArtifactScanStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/summary", wrapper.GetArtifactVersionSummary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/trash", wrapper.PurgeArtifactVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/versions", wrapper.GetAllArtifactVersions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/versions/sync", wrapper.SyncArtifactVersions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifacts", wrapper.GetAllArtifactsByRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifacts/download-counts", wrapper.GetArtifactDownloadCounts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/client-setup-details", wrapper.GetClientSetupDetails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/deletion-requests", wrapper.ListDeletionRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/deletion-requests/{deletion_request_id}/approve", wrapper.ApproveDeletionRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/deletion-requests/{deletion_request_id}/reject", wrapper.RejectDeletionRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/failed-uploads", wrapper.ListFailedUploads)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/failed-uploads/{failed_upload_uuid}/download", wrapper.DownloadFailedUpload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/firewall-approvals", wrapper.ListFirewallApprovals)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/index/builds", wrapper.ListRegistryIndexBuilds)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/index/builds/{index_build_id}/retry", wrapper.RetryRegistryIndexBuild)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/index/status", wrapper.GetRegistryIndexStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/jobs", wrapper.ListRegistryJobs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/jobs/{job_uuid}", wrapper.GetRegistryJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/jobs/{job_uuid}/cancel", wrapper.CancelRegistryJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/notification-channels", wrapper.ListNotificationChannels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/notification-channels", wrapper.CreateNotificationChannel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/notification-channels/{channel_identifier}", wrapper.DeleteNotificationChannel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/notification-channels/{channel_identifier}", wrapper.UpdateNotificationChannel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/oci-import", wrapper.ImportOciArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/package-denylist/overrides", wrapper.ListPackageDenylistOverrides)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/package-denylist/{denylist_entry_id}/override", wrapper.RemovePackageDenylistOverride)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/package-denylist/{denylist_entry_id}/override", wrapper.OverridePackageDenylistEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/policy", wrapper.GetEffectiveRegistryPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/quarantine", wrapper.DeleteQuarantineFilePath)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/quarantine", wrapper.QuarantineFilePath)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/scheduled-deletions", wrapper.ListScheduledDeletions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/scheduled-deletions", wrapper.ScheduleDeletion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/scheduled-deletions/{scheduled_deletion_id}/cancel", wrapper.CancelScheduledDeletion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/settings", wrapper.GetRegistrySettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/registry/{registry_ref}/settings", wrapper.UpdateRegistrySettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/trash", wrapper.ListRegistryTrash)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks", wrapper.ListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/webhooks", wrapper.CreateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}", wrapper.GetWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}", wrapper.UpdateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions", wrapper.ListWebhookExecutions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}", wrapper.GetWebhookExecution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/replay", wrapper.ReplayWebhookExecution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/retrigger", wrapper.ReTriggerWebhookExecution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/test", wrapper.TestWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/replication/rules", wrapper.ListReplicationRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/replication/rules", wrapper.CreateReplicationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/replication/rules/{id}", wrapper.DeleteReplicationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/replication/rules/{id}", wrapper.GetReplicationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/replication/rules/{id}", wrapper.UpdateReplicationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/replication/rules/{id}/migration/images", wrapper.ListMigrationImages)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/replication/rules/{id}/migration/images/{image_id}/logs", wrapper.GetMigrationLogsForImage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/replication/rules/{id}/migration/start", wrapper.StartMigration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/replication/rules/{id}/migration/stop", wrapper.StopMigration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/artifact/stats", wrapper.GetArtifactStatsForSpace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/artifacts", wrapper.GetAllArtifacts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/package-denylist", wrapper.ListPackageDenylistEntries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/spaces/{space_ref}/package-denylist", wrapper.CreatePackageDenylistEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/spaces/{space_ref}/package-denylist/import", wrapper.ImportPackageDenylist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/spaces/{space_ref}/package-denylist/{denylist_entry_id}", wrapper.DeletePackageDenylistEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/pipeline-artifacts", wrapper.ListPipelineArtifacts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/registries", wrapper.GetAllRegistries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/registries/garbage", wrapper.GetRegistryGarbageStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/registries/upload-failures", wrapper.GetUploadFailureStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/registries/usage", wrapper.GetSpaceRegistryUsage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/registries/usage/history", wrapper.GetSpaceRegistryUsageHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/registry-policy", wrapper.GetSpaceRegistryPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/spaces/{space_ref}/registry-policy", wrapper.UpdateSpaceRegistryPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/vulnerable-versions", wrapper.ListVulnerableVersions)
+	})
+
+	return r
+}
+
+type AcceptedJSONResponse struct {
+	// Message Why the request was queued
+	Message string `json:"message"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactDescriptionResponseJSONResponse struct {
+	// Data A revision of the markdown description of an artifact
+	Data ArtifactDescription `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactDetailResponseJSONResponse struct {
+	// Data Artifact Detail
+	Data ArtifactDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactDownloadStatsResponseJSONResponse struct {
+	// Data Downloads of the versions of an artifact within a range of days
+	Data ArtifactDownloadStats `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactFileResponseJSONResponse struct {
+	// DownloadUrl download url of artifact
+	DownloadUrl string `json:"downloadUrl"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactLabelResponseJSONResponse struct {
+	// Data Harness Artifact Summary
+	Data ArtifactSummary `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactProvenanceResponseJSONResponse struct {
+	// Data The pipeline execution which published an artifact version
+	Data ArtifactProvenance `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactScanStatusResponseJSONResponse struct {
+	// Data Vulnerability scan status of an OCI artifact version
+	Data ArtifactScanStatus `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactStarResponseJSONResponse struct {
+	// Data Whether the current user starred an artifact
+	Data ArtifactStar `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactStatsResponseJSONResponse struct {
+	// Data Harness Artifact Stats
+	Data ArtifactStats `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactSummaryResponseJSONResponse struct {
+	// Data Harness Artifact Summary
+	Data ArtifactSummary `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactVersionSummaryResponseJSONResponse struct {
+	// Data Docker Artifact Version Summary
+	Data ArtifactVersionSummary `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactVersionSyncResponseJSONResponse struct {
+	// Data A page of the versions of an artifact, in the order they were pushed
+	Data ArtifactVersionSyncPage `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type BadRequestJSONResponse Error
+
+type ClientSetupDetailsResponseJSONResponse struct {
+	// Data Client Setup Details
+	Data ClientSetupDetails `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ConflictJSONResponse Error
+
+type DeletionRequestResponseJSONResponse struct {
+	// Data A request to delete an artifact or a version, which waits for the approval of a second user
+	Data DeletionRequest `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type DockerArtifactDetailResponseJSONResponse struct {
+	// Data Docker Artifact Detail
+	Data DockerArtifactDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type DockerArtifactManifestResponseJSONResponse struct {
+	// Data Docker Artifact Manifest
+	Data DockerArtifactManifest `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type DockerLayersResponseJSONResponse struct {
+	// Data Harness Layers Summary
+	Data DockerLayersSummary `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type DockerManifestsResponseJSONResponse struct {
+	// Data Harness Manifests
+	Data DockerManifests `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type EffectiveRegistryPolicyResponseJSONResponse struct {
+	// Data Policy which applies to a registry once inheritance is resolved
+	Data EffectiveRegistryPolicy `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type FileDetailResponseJSONResponse struct {
+	// Data A list of Harness Artifact Files
+	Data ListFileDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type FirewallApprovalResponseJSONResponse struct {
+	// Data A version of a package let through the firewall delay of an upstream proxy before the delay has passed
+	Data FirewallApproval `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type HelmArtifactDependenciesResponseJSONResponse struct {
+	// Data Dependencies and provenance of a Helm chart version
+	Data HelmArtifactDependencies `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type HelmArtifactDetailResponseJSONResponse struct {
+	// Data Helm Artifact Detail
+	Data HelmArtifactDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type HelmArtifactManifestResponseJSONResponse struct {
+	// Data Helm Artifact Manifest
+	Data HelmArtifactManifest `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type InternalServerErrorJSONResponse Error
+
+type ListArtifactDescriptionResponseJSONResponse struct {
+	// Data A list of artifact description revisions
+	Data ListArtifactDescription `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListArtifactDownloadCountResponseJSONResponse struct {
+	// Data The download counts of artifacts
+	Data ListArtifactDownloadCount `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListArtifactLabelResponseJSONResponse struct {
+	// Data A list of Harness Artifact Labels
+	Data ListArtifactLabel `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListArtifactMetadataChangeResponseJSONResponse struct {
+	// Data A list of artifact metadata changes
+	Data ListArtifactMetadataChange `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListArtifactResponseJSONResponse struct {
+	// Data A list of Artifacts
+	Data ListArtifact `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListArtifactVersionResponseJSONResponse struct {
+	// Data A list of Artifact versions
+	Data ListArtifactVersion `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListDeletionRequestsResponseJSONResponse struct {
+	// Data A list of deletion requests
+	Data ListDeletionRequests `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListFailedUploadResponseJSONResponse struct {
+	// Data A list of failed uploads
+	Data ListFailedUpload `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListFirewallApprovalsResponseJSONResponse struct {
+	// Data A list of firewall approvals
+	Data ListFirewallApprovals `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListMigrationImageResponseJSONResponse struct {
+	// Data A list of migration images
+	Data ListMigrationImage `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListNotificationChannelsResponseJSONResponse struct {
+	Data ListNotificationChannels `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListOciArtifactTagsResponseJSONResponse struct {
+	// Data A list of Artifact versions
+	Data ListOciArtifactTags `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListPackageDenylistEntriesResponseJSONResponse struct {
+	// Data A list of package denylist entries
+	Data ListPackageDenylistEntries `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListPackageDenylistOverridesResponseJSONResponse struct {
+	// Data A list of package denylist overrides
+	Data ListPackageDenylistOverrides `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListPipelineArtifactsResponseJSONResponse struct {
+	// Data A list of artifact versions published by pipeline executions
+	Data ListPipelineArtifacts `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListRecentArtifactResponseJSONResponse struct {
+	// Data A list of recent artifacts
+	Data ListRecentArtifact `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListRegistryArtifactResponseJSONResponse struct {
+	// Data A list of Artifacts
+	Data ListRegistryArtifact `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListRegistryIndexBuildResponseJSONResponse struct {
+	// Data A list of registry index builds
+	Data ListRegistryIndexBuild `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListRegistryJobResponseJSONResponse struct {
+	// Data A list of registry jobs
+	Data ListRegistryJob `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListRegistryResponseJSONResponse struct {
+	// Data A list of Harness Artifact Registries
+	Data ListRegistry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListReplicationRuleResponseJSONResponse struct {
+	// Data A list of replication rules
+	Data ListReplicationRule `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListScheduledDeletionsResponseJSONResponse struct {
+	// Data A list of scheduled deletions
+	Data ListScheduledDeletions `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListStarredArtifactResponseJSONResponse struct {
+	// Data A list of starred artifacts
+	Data ListStarredArtifact `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListVulnerableVersionsResponseJSONResponse struct {
+	// Data A list of vulnerable versions
+	Data ListVulnerableVersions `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListWebhooksExecutionResponseJSONResponse struct {
+	// Data A list of Harness Registries webhooks executions
+	Data ListWebhooksExecutions `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListWebhooksResponseJSONResponse struct {
+	// Data A list of Harness Registries webhooks
+	Data ListWebhooks `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type NotFoundJSONResponse Error
+
+type NotImplementedJSONResponse Error
+
+type NotificationChannelResponseJSONResponse struct {
+	// Data Channel which high-signal events of a registry are delivered to
+	Data NotificationChannel `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type OciImportResponseJSONResponse struct {
+	// Data The images imported from an OCI archive
+	Data OciImportResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type PackageDenylistEntryResponseJSONResponse struct {
+	// Data A package denied in all registries of a space and of its subspaces, uploads and proxy pulls of the versions in the version range are blocked
+	Data PackageDenylistEntry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type PackageDenylistImportResponseJSONResponse struct {
+	Data PackageDenylistImportResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type PackageDenylistOverrideResponseJSONResponse struct {
+	// Data An exemption of a registry from an entry of the package denylist of one of its spaces
+	Data PackageDenylistOverride `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type PackageTypeCapabilitiesResponseJSONResponse struct {
+	// Data The capabilities of all package types
+	Data ListPackageTypeCapabilities `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type PlainTextResponseTextplainCharsetUtf8Response struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+
+type RegistryApplyResponseJSONResponse struct {
+	// Data The changes made to reconcile the configuration of a registry
+	Data RegistryApplyResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryGarbageStatsResponseJSONResponse struct {
+	// Data Soft-deleted rows of an account which wait to be purged
+	Data RegistryGarbageStats `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryIndexStatusResponseJSONResponse struct {
+	// Data Status of the latest rebuild of a registry index
+	Data RegistryIndexStatus `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryJobResponseJSONResponse struct {
+	// Data A long-running operation of a registry
+	Data RegistryJob `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryPolicyResponseJSONResponse struct {
+	// Data Registry policies, values which aren't set are inherited from the parent spaces
+	Data RegistryPolicy `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryResponseJSONResponse struct {
+	// Data Harness Artifact Registry
+	Data Registry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistrySettingsResponseJSONResponse struct {
+	Data []RegistrySetting `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryTrashResponseJSONResponse struct {
+	Data []TrashedArtifactVersion `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ReplicationRuleResponseJSONResponse struct {
+	Data ReplicationRule `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ScheduledDeletionResponseJSONResponse struct {
+	// Data A deletion of an artifact or a version which is executed later, it can be canceled until then
+	Data ScheduledDeletion `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type SearchArtifactsResponseJSONResponse struct {
+	// Data A page of artifact versions matching a search
+	Data ListArtifactSearchResults `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type SpaceRegistryUsageHistoryResponseJSONResponse struct {
+	// Data Daily registry usage of a space within a range of days
+	Data SpaceRegistryUsageHistory `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type SpaceRegistryUsageResponseJSONResponse struct {
+	// Data Registry usage of a space and of the spaces below it
+	Data SpaceRegistryUsageReport `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type SuccessJSONResponse struct {
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type TooManyRequestsJSONResponse Error
+
+type UnauthenticatedJSONResponse Error
+
+type UnauthorizedJSONResponse Error
+
+type UploadFailureStatsResponseJSONResponse struct {
+	// Data Failed uploads of the registries of an account within a range of days
+	Data UploadFailureStats `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type WebhookExecutionResponseJSONResponse struct {
+	// Data Harness Regstries Webhook Execution
+	Data WebhookExecution `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type WebhookResponseJSONResponse struct {
+	// Data Harness Regstries Webhook
+	Data Webhook `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type QuarantinePathResponseJSONResponse struct {
+	// Data quarantine path
+	Data QuarantinePath `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListRecentArtifactsRequestObject struct {
+	Params ListRecentArtifactsParams
+}
+
+type ListRecentArtifactsResponseObject interface {
+	VisitListRecentArtifactsResponse(w http.ResponseWriter) error
+}
+
+type ListRecentArtifacts200JSONResponse struct {
+	ListRecentArtifactResponseJSONResponse
+}
+
+func (response ListRecentArtifacts200JSONResponse) VisitListRecentArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRecentArtifacts400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListRecentArtifacts400JSONResponse) VisitListRecentArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRecentArtifacts401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListRecentArtifacts401JSONResponse) VisitListRecentArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRecentArtifacts500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListRecentArtifacts500JSONResponse) VisitListRecentArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStarredArtifactsRequestObject struct {
+	Params ListStarredArtifactsParams
+}
+
+type ListStarredArtifactsResponseObject interface {
+	VisitListStarredArtifactsResponse(w http.ResponseWriter) error
+}
+
+type ListStarredArtifacts200JSONResponse struct {
+	ListStarredArtifactResponseJSONResponse
+}
+
+func (response ListStarredArtifacts200JSONResponse) VisitListStarredArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStarredArtifacts400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListStarredArtifacts400JSONResponse) VisitListStarredArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStarredArtifacts401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListStarredArtifacts401JSONResponse) VisitListStarredArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStarredArtifacts500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListStarredArtifacts500JSONResponse) VisitListStarredArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackageTypeCapabilitiesRequestObject struct {
+}
+
+type GetPackageTypeCapabilitiesResponseObject interface {
+	VisitGetPackageTypeCapabilitiesResponse(w http.ResponseWriter) error
+}
+
+type GetPackageTypeCapabilities200JSONResponse struct {
+	PackageTypeCapabilitiesResponseJSONResponse
+}
+
+func (response GetPackageTypeCapabilities200JSONResponse) VisitGetPackageTypeCapabilitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackageTypeCapabilities401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetPackageTypeCapabilities401JSONResponse) VisitGetPackageTypeCapabilitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackageTypeCapabilities500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetPackageTypeCapabilities500JSONResponse) VisitGetPackageTypeCapabilitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistryRequestObject struct {
+	Params CreateRegistryParams
+	Body   *CreateRegistryJSONRequestBody
+}
+
+type CreateRegistryResponseObject interface {
+	VisitCreateRegistryResponse(w http.ResponseWriter) error
+}
+
+type CreateRegistry201JSONResponse struct{ RegistryResponseJSONResponse }
+
+func (response CreateRegistry201JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateRegistry400JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreateRegistry401JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateRegistry403JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateRegistry500JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistry501JSONResponse struct{ NotImplementedJSONResponse }
+
+func (response CreateRegistry501JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactsRequestObject struct {
+	Params SearchArtifactsParams
+}
+
+type SearchArtifactsResponseObject interface {
+	VisitSearchArtifactsResponse(w http.ResponseWriter) error
+}
+
+type SearchArtifacts200JSONResponse struct {
+	SearchArtifactsResponseJSONResponse
+}
+
+func (response SearchArtifacts200JSONResponse) VisitSearchArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifacts400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SearchArtifacts400JSONResponse) VisitSearchArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifacts401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response SearchArtifacts401JSONResponse) VisitSearchArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifacts403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SearchArtifacts403JSONResponse) VisitSearchArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifacts404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SearchArtifacts404JSONResponse) VisitSearchArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifacts500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SearchArtifacts500JSONResponse) VisitSearchArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type DeleteRegistryResponseObject interface {
+	VisitDeleteRegistryResponse(w http.ResponseWriter) error
+}
+
+type DeleteRegistry200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteRegistry200JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteRegistry400JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteRegistry401JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteRegistry403JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteRegistry404JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistry409JSONResponse struct{ ConflictJSONResponse }
+
+func (response DeleteRegistry409JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteRegistry500JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type GetRegistryResponseObject interface {
+	VisitGetRegistryResponse(w http.ResponseWriter) error
+}
+
+type GetRegistry200JSONResponse struct{ RegistryResponseJSONResponse }
+
+func (response GetRegistry200JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRegistry400JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetRegistry401JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetRegistry403JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetRegistry404JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetRegistry500JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModifyRegistryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *ModifyRegistryJSONRequestBody
+}
+
+type ModifyRegistryResponseObject interface {
+	VisitModifyRegistryResponse(w http.ResponseWriter) error
+}
+
+type ModifyRegistry200JSONResponse struct{ RegistryResponseJSONResponse }
+
+func (response ModifyRegistry200JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModifyRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ModifyRegistry400JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModifyRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ModifyRegistry401JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModifyRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ModifyRegistry403JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModifyRegistry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ModifyRegistry404JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModifyRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ModifyRegistry500JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRegistryConfigRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ApplyRegistryConfigParams
+	Body        *ApplyRegistryConfigJSONRequestBody
+}
+
+type ApplyRegistryConfigResponseObject interface {
+	VisitApplyRegistryConfigResponse(w http.ResponseWriter) error
+}
+
+type ApplyRegistryConfig200JSONResponse struct {
+	RegistryApplyResponseJSONResponse
+}
+
+func (response ApplyRegistryConfig200JSONResponse) VisitApplyRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRegistryConfig400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ApplyRegistryConfig400JSONResponse) VisitApplyRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRegistryConfig401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ApplyRegistryConfig401JSONResponse) VisitApplyRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRegistryConfig403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApplyRegistryConfig403JSONResponse) VisitApplyRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRegistryConfig404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ApplyRegistryConfig404JSONResponse) VisitApplyRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRegistryConfig500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ApplyRegistryConfig500JSONResponse) VisitApplyRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRegistryConfig501JSONResponse struct{ NotImplementedJSONResponse }
+
+func (response ApplyRegistryConfig501JSONResponse) VisitApplyRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactLabelsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListArtifactLabelsParams
+}
+
+type ListArtifactLabelsResponseObject interface {
+	VisitListArtifactLabelsResponse(w http.ResponseWriter) error
+}
+
+type ListArtifactLabels200JSONResponse struct {
+	ListArtifactLabelResponseJSONResponse
+}
+
+func (response ListArtifactLabels200JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactLabels400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListArtifactLabels400JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactLabels401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListArtifactLabels401JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactLabels403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListArtifactLabels403JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactLabels404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListArtifactLabels404JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactLabels500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListArtifactLabels500JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForRegistryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      GetArtifactStatsForRegistryParams
+}
+
+type GetArtifactStatsForRegistryResponseObject interface {
+	VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactStatsForRegistry200JSONResponse struct {
+	ArtifactStatsResponseJSONResponse
+}
+
+func (response GetArtifactStatsForRegistry200JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactStatsForRegistry400JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactStatsForRegistry401JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactStatsForRegistry403JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForRegistry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactStatsForRegistry404JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactStatsForRegistry500JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      DeleteArtifactParams
+}
+
+type DeleteArtifactResponseObject interface {
+	VisitDeleteArtifactResponse(w http.ResponseWriter) error
+}
+
+type DeleteArtifact200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteArtifact200JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifact202JSONResponse struct {
+	DeletionRequestResponseJSONResponse
+}
+
+func (response DeleteArtifact202JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifact400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteArtifact400JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifact401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteArtifact401JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifact403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteArtifact403JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifact404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteArtifact404JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifact409JSONResponse struct{ ConflictJSONResponse }
+
+func (response DeleteArtifact409JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifact500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteArtifact500JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactDescriptionRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      UpdateArtifactDescriptionParams
+	Body        *UpdateArtifactDescriptionJSONRequestBody
+}
+
+type UpdateArtifactDescriptionResponseObject interface {
+	VisitUpdateArtifactDescriptionResponse(w http.ResponseWriter) error
+}
+
+type UpdateArtifactDescription200JSONResponse struct {
+	ArtifactDescriptionResponseJSONResponse
+}
+
+func (response UpdateArtifactDescription200JSONResponse) VisitUpdateArtifactDescriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactDescription400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateArtifactDescription400JSONResponse) VisitUpdateArtifactDescriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactDescription401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response UpdateArtifactDescription401JSONResponse) VisitUpdateArtifactDescriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactDescription403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateArtifactDescription403JSONResponse) VisitUpdateArtifactDescriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactDescription404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateArtifactDescription404JSONResponse) VisitUpdateArtifactDescriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactDescription500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateArtifactDescription500JSONResponse) VisitUpdateArtifactDescriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactDescriptionHistoryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      ListArtifactDescriptionHistoryParams
+}
+
+type ListArtifactDescriptionHistoryResponseObject interface {
+	VisitListArtifactDescriptionHistoryResponse(w http.ResponseWriter) error
+}
+
+type ListArtifactDescriptionHistory200JSONResponse struct {
+	ListArtifactDescriptionResponseJSONResponse
+}
+
+func (response ListArtifactDescriptionHistory200JSONResponse) VisitListArtifactDescriptionHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactDescriptionHistory400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListArtifactDescriptionHistory400JSONResponse) VisitListArtifactDescriptionHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactDescriptionHistory401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListArtifactDescriptionHistory401JSONResponse) VisitListArtifactDescriptionHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactDescriptionHistory403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListArtifactDescriptionHistory403JSONResponse) VisitListArtifactDescriptionHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactDescriptionHistory404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListArtifactDescriptionHistory404JSONResponse) VisitListArtifactDescriptionHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactDescriptionHistory500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListArtifactDescriptionHistory500JSONResponse) VisitListArtifactDescriptionHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDownloadStatsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      GetArtifactDownloadStatsParams
+}
+
+type GetArtifactDownloadStatsResponseObject interface {
+	VisitGetArtifactDownloadStatsResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactDownloadStats200JSONResponse struct {
+	ArtifactDownloadStatsResponseJSONResponse
+}
+
+func (response GetArtifactDownloadStats200JSONResponse) VisitGetArtifactDownloadStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDownloadStats400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactDownloadStats400JSONResponse) VisitGetArtifactDownloadStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDownloadStats401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactDownloadStats401JSONResponse) VisitGetArtifactDownloadStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDownloadStats403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactDownloadStats403JSONResponse) VisitGetArtifactDownloadStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDownloadStats404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactDownloadStats404JSONResponse) VisitGetArtifactDownloadStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDownloadStats500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactDownloadStats500JSONResponse) VisitGetArtifactDownloadStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactLabelsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      UpdateArtifactLabelsParams
+	Body        *UpdateArtifactLabelsJSONRequestBody
+}
+
+type UpdateArtifactLabelsResponseObject interface {
+	VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error
+}
+
+type UpdateArtifactLabels200JSONResponse struct {
+	ArtifactLabelResponseJSONResponse
+}
+
+func (response UpdateArtifactLabels200JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactLabels400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateArtifactLabels400JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactLabels401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response UpdateArtifactLabels401JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactLabels403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateArtifactLabels403JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactLabels404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateArtifactLabels404JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactLabels500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateArtifactLabels500JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactLogoRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      DeleteArtifactLogoParams
+}
+
+type DeleteArtifactLogoResponseObject interface {
+	VisitDeleteArtifactLogoResponse(w http.ResponseWriter) error
+}
+
+type DeleteArtifactLogo200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteArtifactLogo200JSONResponse) VisitDeleteArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactLogo400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteArtifactLogo400JSONResponse) VisitDeleteArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactLogo401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteArtifactLogo401JSONResponse) VisitDeleteArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactLogo403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteArtifactLogo403JSONResponse) VisitDeleteArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactLogo404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteArtifactLogo404JSONResponse) VisitDeleteArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactLogo500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteArtifactLogo500JSONResponse) VisitDeleteArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactLogoRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      GetArtifactLogoParams
+}
+
+type GetArtifactLogoResponseObject interface {
+	VisitGetArtifactLogoResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactLogo200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+
+type GetArtifactLogo200ImageResponse struct {
+	Body          io.Reader
+	Headers       GetArtifactLogo200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetArtifactLogo200ImageResponse) VisitGetArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetArtifactLogo304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+
+type GetArtifactLogo304Response struct {
+	Headers GetArtifactLogo304ResponseHeaders
+}
+
+func (response GetArtifactLogo304Response) VisitGetArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetArtifactLogo400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactLogo400JSONResponse) VisitGetArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactLogo401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactLogo401JSONResponse) VisitGetArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactLogo403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactLogo403JSONResponse) VisitGetArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactLogo404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactLogo404JSONResponse) VisitGetArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactLogo500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactLogo500JSONResponse) VisitGetArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadArtifactLogoRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      UploadArtifactLogoParams
+	Body        io.Reader
+}
+
+type UploadArtifactLogoResponseObject interface {
+	VisitUploadArtifactLogoResponse(w http.ResponseWriter) error
+}
+
+type UploadArtifactLogo200JSONResponse struct{ SuccessJSONResponse }
+
+func (response UploadArtifactLogo200JSONResponse) VisitUploadArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadArtifactLogo400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UploadArtifactLogo400JSONResponse) VisitUploadArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadArtifactLogo401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response UploadArtifactLogo401JSONResponse) VisitUploadArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadArtifactLogo403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UploadArtifactLogo403JSONResponse) VisitUploadArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadArtifactLogo404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UploadArtifactLogo404JSONResponse) VisitUploadArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadArtifactLogo500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UploadArtifactLogo500JSONResponse) VisitUploadArtifactLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportOciArchiveRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      ExportOciArchiveParams
+}
+
+type ExportOciArchiveResponseObject interface {
+	VisitExportOciArchiveResponse(w http.ResponseWriter) error
+}
+
+type ExportOciArchive200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type ExportOciArchive200ApplicationxTarResponse struct {
+	Body          io.Reader
+	Headers       ExportOciArchive200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportOciArchive200ApplicationxTarResponse) VisitExportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-tar")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportOciArchive400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExportOciArchive400JSONResponse) VisitExportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportOciArchive401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ExportOciArchive401JSONResponse) VisitExportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportOciArchive403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExportOciArchive403JSONResponse) VisitExportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportOciArchive404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ExportOciArchive404JSONResponse) VisitExportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportOciArchive429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ExportOciArchive429JSONResponse) VisitExportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportOciArchive500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ExportOciArchive500JSONResponse) VisitExportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnstarArtifactRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      UnstarArtifactParams
+}
+
+type UnstarArtifactResponseObject interface {
+	VisitUnstarArtifactResponse(w http.ResponseWriter) error
+}
+
+type UnstarArtifact200JSONResponse struct {
+	ArtifactStarResponseJSONResponse
+}
+
+func (response UnstarArtifact200JSONResponse) VisitUnstarArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnstarArtifact400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UnstarArtifact400JSONResponse) VisitUnstarArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnstarArtifact401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response UnstarArtifact401JSONResponse) VisitUnstarArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnstarArtifact403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UnstarArtifact403JSONResponse) VisitUnstarArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnstarArtifact404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UnstarArtifact404JSONResponse) VisitUnstarArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnstarArtifact500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UnstarArtifact500JSONResponse) VisitUnstarArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StarArtifactRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      StarArtifactParams
+}
+
+type StarArtifactResponseObject interface {
+	VisitStarArtifactResponse(w http.ResponseWriter) error
+}
+
+type StarArtifact200JSONResponse struct {
+	ArtifactStarResponseJSONResponse
+}
+
+func (response StarArtifact200JSONResponse) VisitStarArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StarArtifact400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response StarArtifact400JSONResponse) VisitStarArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StarArtifact401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response StarArtifact401JSONResponse) VisitStarArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StarArtifact403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response StarArtifact403JSONResponse) VisitStarArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StarArtifact404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response StarArtifact404JSONResponse) VisitStarArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StarArtifact500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response StarArtifact500JSONResponse) VisitStarArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      GetArtifactStatsParams
+}
+
+type GetArtifactStatsResponseObject interface {
+	VisitGetArtifactStatsResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactStats200JSONResponse struct {
+	ArtifactStatsResponseJSONResponse
+}
+
+func (response GetArtifactStats200JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStats400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactStats400JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStats401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactStats401JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStats403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactStats403JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStats404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactStats404JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStats500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactStats500JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactSummaryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      GetArtifactSummaryParams
+}
+
+type GetArtifactSummaryResponseObject interface {
+	VisitGetArtifactSummaryResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactSummary200JSONResponse struct {
+	ArtifactSummaryResponseJSONResponse
+}
+
+func (response GetArtifactSummary200JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactSummary400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactSummary400JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactSummary401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactSummary401JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactSummary403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactSummary403JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactSummary404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactSummary404JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactSummary500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactSummary500JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOciArtifactTagsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      GetOciArtifactTagsParams
+}
+
+type GetOciArtifactTagsResponseObject interface {
+	VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error
+}
+
+type GetOciArtifactTags200JSONResponse struct {
+	ListOciArtifactTagsResponseJSONResponse
+}
+
+func (response GetOciArtifactTags200JSONResponse) VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOciArtifactTags400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetOciArtifactTags400JSONResponse) VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOciArtifactTags401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetOciArtifactTags401JSONResponse) VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOciArtifactTags403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetOciArtifactTags403JSONResponse) VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOciArtifactTags404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetOciArtifactTags404JSONResponse) VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOciArtifactTags500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetOciArtifactTags500JSONResponse) VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersionRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      DeleteArtifactVersionParams
+}
+
+type DeleteArtifactVersionResponseObject interface {
+	VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error
+}
+
+type DeleteArtifactVersion200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteArtifactVersion200JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersion202JSONResponse struct {
+	DeletionRequestResponseJSONResponse
+}
+
+func (response DeleteArtifactVersion202JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersion400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteArtifactVersion400JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersion401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteArtifactVersion401JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersion403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteArtifactVersion403JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersion404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteArtifactVersion404JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersion409JSONResponse struct{ ConflictJSONResponse }
+
+func (response DeleteArtifactVersion409JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersion500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteArtifactVersion500JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDetailsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetArtifactDetailsParams
+}
+
+type GetArtifactDetailsResponseObject interface {
+	VisitGetArtifactDetailsResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactDetails200JSONResponse struct {
+	ArtifactDetailResponseJSONResponse
+}
+
+func (response GetArtifactDetails200JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactDetails400JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactDetails401JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactDetails403JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactDetails404JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDetails500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactDetails500JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactDetailsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetDockerArtifactDetailsParams
+}
+
+type GetDockerArtifactDetailsResponseObject interface {
+	VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error
+}
+
+type GetDockerArtifactDetails200JSONResponse struct {
+	DockerArtifactDetailResponseJSONResponse
+}
+
+func (response GetDockerArtifactDetails200JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetDockerArtifactDetails400JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetDockerArtifactDetails401JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetDockerArtifactDetails403JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetDockerArtifactDetails404JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactDetails500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetDockerArtifactDetails500JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactLayersRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetDockerArtifactLayersParams
+}
+
+type GetDockerArtifactLayersResponseObject interface {
+	VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error
+}
+
+type GetDockerArtifactLayers200JSONResponse struct {
+	DockerLayersResponseJSONResponse
+}
+
+func (response GetDockerArtifactLayers200JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactLayers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetDockerArtifactLayers400JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactLayers401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetDockerArtifactLayers401JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactLayers403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetDockerArtifactLayers403JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactLayers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetDockerArtifactLayers404JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactLayers500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetDockerArtifactLayers500JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifestRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetDockerArtifactManifestParams
+}
+
+type GetDockerArtifactManifestResponseObject interface {
+	VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error
+}
+
+type GetDockerArtifactManifest200JSONResponse struct {
+	DockerArtifactManifestResponseJSONResponse
+}
+
+func (response GetDockerArtifactManifest200JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetDockerArtifactManifest400JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifest401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetDockerArtifactManifest401JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifest403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetDockerArtifactManifest403JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetDockerArtifactManifest404JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifest500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetDockerArtifactManifest500JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifestsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetDockerArtifactManifestsParams
+}
+
+type GetDockerArtifactManifestsResponseObject interface {
+	VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error
+}
+
+type GetDockerArtifactManifests200JSONResponse struct {
+	DockerManifestsResponseJSONResponse
+}
+
+func (response GetDockerArtifactManifests200JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifests400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetDockerArtifactManifests400JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifests401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetDockerArtifactManifests401JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifests403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetDockerArtifactManifests403JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifests404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetDockerArtifactManifests404JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifests500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetDockerArtifactManifests500JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFileRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	FileName    FileNamePathParam    `json:"file_name"`
+	Params      GetArtifactFileParams
+}
+
+type GetArtifactFileResponseObject interface {
+	VisitGetArtifactFileResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactFile200JSONResponse struct {
+	ArtifactFileResponseJSONResponse
+}
+
+func (response GetArtifactFile200JSONResponse) VisitGetArtifactFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFile400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactFile400JSONResponse) VisitGetArtifactFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFile401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactFile401JSONResponse) VisitGetArtifactFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFile403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactFile403JSONResponse) VisitGetArtifactFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFile404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactFile404JSONResponse) VisitGetArtifactFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFile500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactFile500JSONResponse) VisitGetArtifactFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFilesRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetArtifactFilesParams
+}
+
+type GetArtifactFilesResponseObject interface {
+	VisitGetArtifactFilesResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactFiles200JSONResponse struct{ FileDetailResponseJSONResponse }
+
+func (response GetArtifactFiles200JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFiles400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactFiles400JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFiles401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactFiles401JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFiles403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactFiles403JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFiles404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactFiles404JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFiles500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactFiles500JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeFirewallApprovalRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type RevokeFirewallApprovalResponseObject interface {
+	VisitRevokeFirewallApprovalResponse(w http.ResponseWriter) error
+}
+
+type RevokeFirewallApproval200JSONResponse struct{ SuccessJSONResponse }
+
+func (response RevokeFirewallApproval200JSONResponse) VisitRevokeFirewallApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeFirewallApproval400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RevokeFirewallApproval400JSONResponse) VisitRevokeFirewallApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeFirewallApproval401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response RevokeFirewallApproval401JSONResponse) VisitRevokeFirewallApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeFirewallApproval403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RevokeFirewallApproval403JSONResponse) VisitRevokeFirewallApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeFirewallApproval404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeFirewallApproval404JSONResponse) VisitRevokeFirewallApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeFirewallApproval500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RevokeFirewallApproval500JSONResponse) VisitRevokeFirewallApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveFirewallVersionRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Body        *ApproveFirewallVersionJSONRequestBody
+}
+
+type ApproveFirewallVersionResponseObject interface {
+	VisitApproveFirewallVersionResponse(w http.ResponseWriter) error
+}
+
+type ApproveFirewallVersion200JSONResponse struct {
+	FirewallApprovalResponseJSONResponse
+}
+
+func (response ApproveFirewallVersion200JSONResponse) VisitApproveFirewallVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveFirewallVersion400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ApproveFirewallVersion400JSONResponse) VisitApproveFirewallVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveFirewallVersion401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ApproveFirewallVersion401JSONResponse) VisitApproveFirewallVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveFirewallVersion403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApproveFirewallVersion403JSONResponse) VisitApproveFirewallVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveFirewallVersion404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ApproveFirewallVersion404JSONResponse) VisitApproveFirewallVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveFirewallVersion409JSONResponse struct{ ConflictJSONResponse }
+
+func (response ApproveFirewallVersion409JSONResponse) VisitApproveFirewallVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveFirewallVersion500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ApproveFirewallVersion500JSONResponse) VisitApproveFirewallVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDependenciesRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type GetHelmArtifactDependenciesResponseObject interface {
+	VisitGetHelmArtifactDependenciesResponse(w http.ResponseWriter) error
+}
+
+type GetHelmArtifactDependencies200JSONResponse struct {
+	HelmArtifactDependenciesResponseJSONResponse
+}
+
+func (response GetHelmArtifactDependencies200JSONResponse) VisitGetHelmArtifactDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDependencies400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetHelmArtifactDependencies400JSONResponse) VisitGetHelmArtifactDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDependencies401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetHelmArtifactDependencies401JSONResponse) VisitGetHelmArtifactDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDependencies403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetHelmArtifactDependencies403JSONResponse) VisitGetHelmArtifactDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDependencies404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetHelmArtifactDependencies404JSONResponse) VisitGetHelmArtifactDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDependencies500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetHelmArtifactDependencies500JSONResponse) VisitGetHelmArtifactDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDetailsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetHelmArtifactDetailsParams
+}
+
+type GetHelmArtifactDetailsResponseObject interface {
+	VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error
+}
+
+type GetHelmArtifactDetails200JSONResponse struct {
+	HelmArtifactDetailResponseJSONResponse
+}
+
+func (response GetHelmArtifactDetails200JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetHelmArtifactDetails400JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetHelmArtifactDetails401JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetHelmArtifactDetails403JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetHelmArtifactDetails404JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDetails500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetHelmArtifactDetails500JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactManifestRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type GetHelmArtifactManifestResponseObject interface {
+	VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error
+}
+
+type GetHelmArtifactManifest200JSONResponse struct {
+	HelmArtifactManifestResponseJSONResponse
+}
+
+func (response GetHelmArtifactManifest200JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactManifest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetHelmArtifactManifest400JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactManifest401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetHelmArtifactManifest401JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactManifest403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetHelmArtifactManifest403JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactManifest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetHelmArtifactManifest404JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactManifest500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetHelmArtifactManifest500JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactVersionMetadataHistoryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      ListArtifactVersionMetadataHistoryParams
+}
+
+type ListArtifactVersionMetadataHistoryResponseObject interface {
+	VisitListArtifactVersionMetadataHistoryResponse(w http.ResponseWriter) error
+}
+
+type ListArtifactVersionMetadataHistory200JSONResponse struct {
+	ListArtifactMetadataChangeResponseJSONResponse
+}
+
+func (response ListArtifactVersionMetadataHistory200JSONResponse) VisitListArtifactVersionMetadataHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactVersionMetadataHistory400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListArtifactVersionMetadataHistory400JSONResponse) VisitListArtifactVersionMetadataHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactVersionMetadataHistory401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListArtifactVersionMetadataHistory401JSONResponse) VisitListArtifactVersionMetadataHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactVersionMetadataHistory403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListArtifactVersionMetadataHistory403JSONResponse) VisitListArtifactVersionMetadataHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactVersionMetadataHistory404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListArtifactVersionMetadataHistory404JSONResponse) VisitListArtifactVersionMetadataHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactVersionMetadataHistory500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListArtifactVersionMetadataHistory500JSONResponse) VisitListArtifactVersionMetadataHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionProvenanceRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetArtifactVersionProvenanceParams
+}
+
+type GetArtifactVersionProvenanceResponseObject interface {
+	VisitGetArtifactVersionProvenanceResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactVersionProvenance200JSONResponse struct {
+	ArtifactProvenanceResponseJSONResponse
+}
+
+func (response GetArtifactVersionProvenance200JSONResponse) VisitGetArtifactVersionProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionProvenance400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactVersionProvenance400JSONResponse) VisitGetArtifactVersionProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionProvenance401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactVersionProvenance401JSONResponse) VisitGetArtifactVersionProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionProvenance403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactVersionProvenance403JSONResponse) VisitGetArtifactVersionProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionProvenance404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactVersionProvenance404JSONResponse) VisitGetArtifactVersionProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionProvenance500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactVersionProvenance500JSONResponse) VisitGetArtifactVersionProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactVersionProvenanceRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      UpdateArtifactVersionProvenanceParams
+	Body        *UpdateArtifactVersionProvenanceJSONRequestBody
+}
+
+type UpdateArtifactVersionProvenanceResponseObject interface {
+	VisitUpdateArtifactVersionProvenanceResponse(w http.ResponseWriter) error
+}
+
+type UpdateArtifactVersionProvenance200JSONResponse struct {
+	ArtifactProvenanceResponseJSONResponse
+}
+
+func (response UpdateArtifactVersionProvenance200JSONResponse) VisitUpdateArtifactVersionProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactVersionProvenance400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateArtifactVersionProvenance400JSONResponse) VisitUpdateArtifactVersionProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactVersionProvenance401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response UpdateArtifactVersionProvenance401JSONResponse) VisitUpdateArtifactVersionProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactVersionProvenance403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateArtifactVersionProvenance403JSONResponse) VisitUpdateArtifactVersionProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactVersionProvenance404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateArtifactVersionProvenance404JSONResponse) VisitUpdateArtifactVersionProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactVersionProvenance500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateArtifactVersionProvenance500JSONResponse) VisitUpdateArtifactVersionProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArtifactVersionRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type RestoreArtifactVersionResponseObject interface {
+	VisitRestoreArtifactVersionResponse(w http.ResponseWriter) error
+}
+
+type RestoreArtifactVersion200JSONResponse struct{ SuccessJSONResponse }
+
+func (response RestoreArtifactVersion200JSONResponse) VisitRestoreArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArtifactVersion400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RestoreArtifactVersion400JSONResponse) VisitRestoreArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArtifactVersion401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response RestoreArtifactVersion401JSONResponse) VisitRestoreArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArtifactVersion403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RestoreArtifactVersion403JSONResponse) VisitRestoreArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArtifactVersion404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RestoreArtifactVersion404JSONResponse) VisitRestoreArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArtifactVersion500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RestoreArtifactVersion500JSONResponse) VisitRestoreArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArtifactVersion501JSONResponse struct{ NotImplementedJSONResponse }
+
+func (response RestoreArtifactVersion501JSONResponse) VisitRestoreArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactScanStatusRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type GetArtifactScanStatusResponseObject interface {
+	VisitGetArtifactScanStatusResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactScanStatus200JSONResponse struct {
+	ArtifactScanStatusResponseJSONResponse
+}
+
+func (response GetArtifactScanStatus200JSONResponse) VisitGetArtifactScanStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactScanStatus400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactScanStatus400JSONResponse) VisitGetArtifactScanStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactScanStatus401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactScanStatus401JSONResponse) VisitGetArtifactScanStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactScanStatus403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactScanStatus403JSONResponse) VisitGetArtifactScanStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactScanStatus404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactScanStatus404JSONResponse) VisitGetArtifactScanStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactScanStatus500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactScanStatus500JSONResponse) VisitGetArtifactScanStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestArtifactScanRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type RequestArtifactScanResponseObject interface {
+	VisitRequestArtifactScanResponse(w http.ResponseWriter) error
+}
+
+type RequestArtifactScan202JSONResponse struct {
+	ArtifactScanStatusResponseJSONResponse
+}
+
+func (response RequestArtifactScan202JSONResponse) VisitRequestArtifactScanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestArtifactScan400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RequestArtifactScan400JSONResponse) VisitRequestArtifactScanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestArtifactScan401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response RequestArtifactScan401JSONResponse) VisitRequestArtifactScanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestArtifactScan403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RequestArtifactScan403JSONResponse) VisitRequestArtifactScanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestArtifactScan404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RequestArtifactScan404JSONResponse) VisitRequestArtifactScanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestArtifactScan429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RequestArtifactScan429JSONResponse) VisitRequestArtifactScanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestArtifactScan500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RequestArtifactScan500JSONResponse) VisitRequestArtifactScanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactScanStatusRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Body        *UpdateArtifactScanStatusJSONRequestBody
+}
+
+type UpdateArtifactScanStatusResponseObject interface {
+	VisitUpdateArtifactScanStatusResponse(w http.ResponseWriter) error
+}
+
+type UpdateArtifactScanStatus200JSONResponse struct {
+	ArtifactScanStatusResponseJSONResponse
+}
+
+func (response UpdateArtifactScanStatus200JSONResponse) VisitUpdateArtifactScanStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactScanStatus400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateArtifactScanStatus400JSONResponse) VisitUpdateArtifactScanStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactScanStatus401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response UpdateArtifactScanStatus401JSONResponse) VisitUpdateArtifactScanStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactScanStatus403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateArtifactScanStatus403JSONResponse) VisitUpdateArtifactScanStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactScanStatus404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateArtifactScanStatus404JSONResponse) VisitUpdateArtifactScanStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactScanStatus500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateArtifactScanStatus500JSONResponse) VisitUpdateArtifactScanStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionSummaryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetArtifactVersionSummaryParams
+}
+
+type GetArtifactVersionSummaryResponseObject interface {
+	VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactVersionSummary200JSONResponse struct {
+	ArtifactVersionSummaryResponseJSONResponse
+}
+
+func (response GetArtifactVersionSummary200JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionSummary400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactVersionSummary400JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionSummary401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactVersionSummary401JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionSummary403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactVersionSummary403JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionSummary404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactVersionSummary404JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionSummary500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactVersionSummary500JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersionRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type PurgeArtifactVersionResponseObject interface {
+	VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error
+}
+
+type PurgeArtifactVersion200JSONResponse struct{ SuccessJSONResponse }
+
+func (response PurgeArtifactVersion200JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PurgeArtifactVersion400JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PurgeArtifactVersion401JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PurgeArtifactVersion403JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PurgeArtifactVersion404JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion409JSONResponse struct{ ConflictJSONResponse }
+
+func (response PurgeArtifactVersion409JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PurgeArtifactVersion429JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response PurgeArtifactVersion500JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion501JSONResponse struct{ NotImplementedJSONResponse }
+
+func (response PurgeArtifactVersion501JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactVersionsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      GetAllArtifactVersionsParams
+}
+
+type GetAllArtifactVersionsResponseObject interface {
+	VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error
+}
+
+type GetAllArtifactVersions200JSONResponse struct {
+	ListArtifactVersionResponseJSONResponse
+}
+
+func (response GetAllArtifactVersions200JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactVersions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetAllArtifactVersions400JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactVersions401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetAllArtifactVersions401JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactVersions403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetAllArtifactVersions403JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactVersions404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetAllArtifactVersions404JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactVersions500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetAllArtifactVersions500JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncArtifactVersionsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      SyncArtifactVersionsParams
+}
+
+type SyncArtifactVersionsResponseObject interface {
+	VisitSyncArtifactVersionsResponse(w http.ResponseWriter) error
+}
+
+type SyncArtifactVersions200JSONResponse struct {
+	ArtifactVersionSyncResponseJSONResponse
+}
+
+func (response SyncArtifactVersions200JSONResponse) VisitSyncArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncArtifactVersions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SyncArtifactVersions400JSONResponse) VisitSyncArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncArtifactVersions401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response SyncArtifactVersions401JSONResponse) VisitSyncArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncArtifactVersions403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SyncArtifactVersions403JSONResponse) VisitSyncArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncArtifactVersions404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SyncArtifactVersions404JSONResponse) VisitSyncArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncArtifactVersions429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SyncArtifactVersions429JSONResponse) VisitSyncArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncArtifactVersions500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SyncArtifactVersions500JSONResponse) VisitSyncArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactsByRegistryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      GetAllArtifactsByRegistryParams
+}
+
+type GetAllArtifactsByRegistryResponseObject interface {
+	VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error
+}
+
+type GetAllArtifactsByRegistry200JSONResponse struct {
+	ListRegistryArtifactResponseJSONResponse
+}
+
+func (response GetAllArtifactsByRegistry200JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactsByRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetAllArtifactsByRegistry400JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactsByRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetAllArtifactsByRegistry401JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactsByRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetAllArtifactsByRegistry403JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactsByRegistry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetAllArtifactsByRegistry404JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactsByRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetAllArtifactsByRegistry500JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDownloadCountsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      GetArtifactDownloadCountsParams
+}
+
+type GetArtifactDownloadCountsResponseObject interface {
+	VisitGetArtifactDownloadCountsResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactDownloadCounts200JSONResponse struct {
+	ListArtifactDownloadCountResponseJSONResponse
+}
+
+func (response GetArtifactDownloadCounts200JSONResponse) VisitGetArtifactDownloadCountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDownloadCounts400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactDownloadCounts400JSONResponse) VisitGetArtifactDownloadCountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDownloadCounts401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactDownloadCounts401JSONResponse) VisitGetArtifactDownloadCountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDownloadCounts403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactDownloadCounts403JSONResponse) VisitGetArtifactDownloadCountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDownloadCounts404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactDownloadCounts404JSONResponse) VisitGetArtifactDownloadCountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDownloadCounts500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactDownloadCounts500JSONResponse) VisitGetArtifactDownloadCountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientSetupDetailsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      GetClientSetupDetailsParams
+}
+
+type GetClientSetupDetailsResponseObject interface {
+	VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error
+}
+
+type GetClientSetupDetails200JSONResponse struct {
+	ClientSetupDetailsResponseJSONResponse
+}
+
+func (response GetClientSetupDetails200JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientSetupDetails400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetClientSetupDetails400JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientSetupDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetClientSetupDetails401JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientSetupDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetClientSetupDetails403JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientSetupDetails404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetClientSetupDetails404JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientSetupDetails500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetClientSetupDetails500JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeletionRequestsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListDeletionRequestsParams
+}
+
+type ListDeletionRequestsResponseObject interface {
+	VisitListDeletionRequestsResponse(w http.ResponseWriter) error
+}
+
+type ListDeletionRequests200JSONResponse struct {
+	ListDeletionRequestsResponseJSONResponse
+}
+
+func (response ListDeletionRequests200JSONResponse) VisitListDeletionRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeletionRequests400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListDeletionRequests400JSONResponse) VisitListDeletionRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeletionRequests401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListDeletionRequests401JSONResponse) VisitListDeletionRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeletionRequests403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListDeletionRequests403JSONResponse) VisitListDeletionRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeletionRequests404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListDeletionRequests404JSONResponse) VisitListDeletionRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeletionRequests500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListDeletionRequests500JSONResponse) VisitListDeletionRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveDeletionRequestRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	DeletionRequestId DeletionRequestIdPathParam `json:"deletion_request_id"`
+}
+
+type ApproveDeletionRequestResponseObject interface {
+	VisitApproveDeletionRequestResponse(w http.ResponseWriter) error
+}
+
+type ApproveDeletionRequest200JSONResponse struct {
+	DeletionRequestResponseJSONResponse
+}
+
+func (response ApproveDeletionRequest200JSONResponse) VisitApproveDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveDeletionRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ApproveDeletionRequest400JSONResponse) VisitApproveDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveDeletionRequest401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ApproveDeletionRequest401JSONResponse) VisitApproveDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveDeletionRequest403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApproveDeletionRequest403JSONResponse) VisitApproveDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveDeletionRequest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ApproveDeletionRequest404JSONResponse) VisitApproveDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveDeletionRequest409JSONResponse struct{ ConflictJSONResponse }
+
+func (response ApproveDeletionRequest409JSONResponse) VisitApproveDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveDeletionRequest500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ApproveDeletionRequest500JSONResponse) VisitApproveDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectDeletionRequestRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	DeletionRequestId DeletionRequestIdPathParam `json:"deletion_request_id"`
+	Body              *RejectDeletionRequestJSONRequestBody
+}
+
+type RejectDeletionRequestResponseObject interface {
+	VisitRejectDeletionRequestResponse(w http.ResponseWriter) error
+}
+
+type RejectDeletionRequest200JSONResponse struct {
+	DeletionRequestResponseJSONResponse
+}
+
+func (response RejectDeletionRequest200JSONResponse) VisitRejectDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectDeletionRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RejectDeletionRequest400JSONResponse) VisitRejectDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectDeletionRequest401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response RejectDeletionRequest401JSONResponse) VisitRejectDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectDeletionRequest403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RejectDeletionRequest403JSONResponse) VisitRejectDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectDeletionRequest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RejectDeletionRequest404JSONResponse) VisitRejectDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectDeletionRequest409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RejectDeletionRequest409JSONResponse) VisitRejectDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectDeletionRequest500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RejectDeletionRequest500JSONResponse) VisitRejectDeletionRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFailedUploadsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListFailedUploadsParams
+}
+
+type ListFailedUploadsResponseObject interface {
+	VisitListFailedUploadsResponse(w http.ResponseWriter) error
+}
+
+type ListFailedUploads200JSONResponse struct {
+	ListFailedUploadResponseJSONResponse
+}
+
+func (response ListFailedUploads200JSONResponse) VisitListFailedUploadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFailedUploads400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListFailedUploads400JSONResponse) VisitListFailedUploadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFailedUploads401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListFailedUploads401JSONResponse) VisitListFailedUploadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFailedUploads403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFailedUploads403JSONResponse) VisitListFailedUploadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFailedUploads404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListFailedUploads404JSONResponse) VisitListFailedUploadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFailedUploads500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListFailedUploads500JSONResponse) VisitListFailedUploadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadFailedUploadRequestObject struct {
+	RegistryRef      RegistryRefPathParam      `json:"registry_ref"`
+	FailedUploadUuid FailedUploadUuidPathParam `json:"failed_upload_uuid"`
+}
+
+type DownloadFailedUploadResponseObject interface {
+	VisitDownloadFailedUploadResponse(w http.ResponseWriter) error
+}
+
+type DownloadFailedUpload200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type DownloadFailedUpload200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       DownloadFailedUpload200ResponseHeaders
+	ContentLength int64
+}
+
+func (response DownloadFailedUpload200ApplicationoctetStreamResponse) VisitDownloadFailedUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadFailedUpload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DownloadFailedUpload400JSONResponse) VisitDownloadFailedUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadFailedUpload401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DownloadFailedUpload401JSONResponse) VisitDownloadFailedUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadFailedUpload403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DownloadFailedUpload403JSONResponse) VisitDownloadFailedUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadFailedUpload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DownloadFailedUpload404JSONResponse) VisitDownloadFailedUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadFailedUpload500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DownloadFailedUpload500JSONResponse) VisitDownloadFailedUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFirewallApprovalsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type ListFirewallApprovalsResponseObject interface {
+	VisitListFirewallApprovalsResponse(w http.ResponseWriter) error
+}
+
+type ListFirewallApprovals200JSONResponse struct {
+	ListFirewallApprovalsResponseJSONResponse
+}
+
+func (response ListFirewallApprovals200JSONResponse) VisitListFirewallApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFirewallApprovals400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListFirewallApprovals400JSONResponse) VisitListFirewallApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFirewallApprovals401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListFirewallApprovals401JSONResponse) VisitListFirewallApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFirewallApprovals403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFirewallApprovals403JSONResponse) VisitListFirewallApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFirewallApprovals404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListFirewallApprovals404JSONResponse) VisitListFirewallApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFirewallApprovals500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListFirewallApprovals500JSONResponse) VisitListFirewallApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryIndexBuildsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListRegistryIndexBuildsParams
+}
+
+type ListRegistryIndexBuildsResponseObject interface {
+	VisitListRegistryIndexBuildsResponse(w http.ResponseWriter) error
+}
+
+type ListRegistryIndexBuilds200JSONResponse struct {
+	ListRegistryIndexBuildResponseJSONResponse
+}
+
+func (response ListRegistryIndexBuilds200JSONResponse) VisitListRegistryIndexBuildsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryIndexBuilds400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListRegistryIndexBuilds400JSONResponse) VisitListRegistryIndexBuildsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryIndexBuilds401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListRegistryIndexBuilds401JSONResponse) VisitListRegistryIndexBuildsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryIndexBuilds403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListRegistryIndexBuilds403JSONResponse) VisitListRegistryIndexBuildsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryIndexBuilds404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListRegistryIndexBuilds404JSONResponse) VisitListRegistryIndexBuildsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryIndexBuilds500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListRegistryIndexBuilds500JSONResponse) VisitListRegistryIndexBuildsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryRegistryIndexBuildRequestObject struct {
+	RegistryRef  RegistryRefPathParam  `json:"registry_ref"`
+	IndexBuildId IndexBuildIdPathParam `json:"index_build_id"`
+}
+
+type RetryRegistryIndexBuildResponseObject interface {
+	VisitRetryRegistryIndexBuildResponse(w http.ResponseWriter) error
+}
+
+type RetryRegistryIndexBuild200JSONResponse struct{ SuccessJSONResponse }
+
+func (response RetryRegistryIndexBuild200JSONResponse) VisitRetryRegistryIndexBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryRegistryIndexBuild202JSONResponse struct{ AcceptedJSONResponse }
+
+func (response RetryRegistryIndexBuild202JSONResponse) VisitRetryRegistryIndexBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryRegistryIndexBuild400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RetryRegistryIndexBuild400JSONResponse) VisitRetryRegistryIndexBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryRegistryIndexBuild401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response RetryRegistryIndexBuild401JSONResponse) VisitRetryRegistryIndexBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryRegistryIndexBuild403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RetryRegistryIndexBuild403JSONResponse) VisitRetryRegistryIndexBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryRegistryIndexBuild404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RetryRegistryIndexBuild404JSONResponse) VisitRetryRegistryIndexBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryRegistryIndexBuild500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RetryRegistryIndexBuild500JSONResponse) VisitRetryRegistryIndexBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryIndexStatusRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type GetRegistryIndexStatusResponseObject interface {
+	VisitGetRegistryIndexStatusResponse(w http.ResponseWriter) error
+}
+
+type GetRegistryIndexStatus200JSONResponse struct {
+	RegistryIndexStatusResponseJSONResponse
+}
+
+func (response GetRegistryIndexStatus200JSONResponse) VisitGetRegistryIndexStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryIndexStatus400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRegistryIndexStatus400JSONResponse) VisitGetRegistryIndexStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryIndexStatus401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetRegistryIndexStatus401JSONResponse) VisitGetRegistryIndexStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryIndexStatus403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetRegistryIndexStatus403JSONResponse) VisitGetRegistryIndexStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryIndexStatus404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetRegistryIndexStatus404JSONResponse) VisitGetRegistryIndexStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryIndexStatus500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetRegistryIndexStatus500JSONResponse) VisitGetRegistryIndexStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryJobsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListRegistryJobsParams
+}
+
+type ListRegistryJobsResponseObject interface {
+	VisitListRegistryJobsResponse(w http.ResponseWriter) error
+}
+
+type ListRegistryJobs200JSONResponse struct {
+	ListRegistryJobResponseJSONResponse
+}
+
+func (response ListRegistryJobs200JSONResponse) VisitListRegistryJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryJobs400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListRegistryJobs400JSONResponse) VisitListRegistryJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryJobs401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListRegistryJobs401JSONResponse) VisitListRegistryJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryJobs403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListRegistryJobs403JSONResponse) VisitListRegistryJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryJobs404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListRegistryJobs404JSONResponse) VisitListRegistryJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryJobs500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListRegistryJobs500JSONResponse) VisitListRegistryJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryJobRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	JobUuid     JobUuidPathParam     `json:"job_uuid"`
+}
+
+type GetRegistryJobResponseObject interface {
+	VisitGetRegistryJobResponse(w http.ResponseWriter) error
+}
+
+type GetRegistryJob200JSONResponse struct {
+	RegistryJobResponseJSONResponse
+}
+
+func (response GetRegistryJob200JSONResponse) VisitGetRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryJob400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRegistryJob400JSONResponse) VisitGetRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryJob401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetRegistryJob401JSONResponse) VisitGetRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryJob403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetRegistryJob403JSONResponse) VisitGetRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryJob404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetRegistryJob404JSONResponse) VisitGetRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryJob500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetRegistryJob500JSONResponse) VisitGetRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRegistryJobRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	JobUuid     JobUuidPathParam     `json:"job_uuid"`
+}
+
+type CancelRegistryJobResponseObject interface {
+	VisitCancelRegistryJobResponse(w http.ResponseWriter) error
+}
+
+type CancelRegistryJob200JSONResponse struct {
+	RegistryJobResponseJSONResponse
+}
+
+func (response CancelRegistryJob200JSONResponse) VisitCancelRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRegistryJob400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CancelRegistryJob400JSONResponse) VisitCancelRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRegistryJob401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CancelRegistryJob401JSONResponse) VisitCancelRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRegistryJob403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CancelRegistryJob403JSONResponse) VisitCancelRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRegistryJob404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CancelRegistryJob404JSONResponse) VisitCancelRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRegistryJob409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CancelRegistryJob409JSONResponse) VisitCancelRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRegistryJob500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CancelRegistryJob500JSONResponse) VisitCancelRegistryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNotificationChannelsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type ListNotificationChannelsResponseObject interface {
+	VisitListNotificationChannelsResponse(w http.ResponseWriter) error
+}
+
+type ListNotificationChannels200JSONResponse struct {
+	ListNotificationChannelsResponseJSONResponse
+}
+
+func (response ListNotificationChannels200JSONResponse) VisitListNotificationChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNotificationChannels400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListNotificationChannels400JSONResponse) VisitListNotificationChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNotificationChannels401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListNotificationChannels401JSONResponse) VisitListNotificationChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNotificationChannels403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListNotificationChannels403JSONResponse) VisitListNotificationChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNotificationChannels404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListNotificationChannels404JSONResponse) VisitListNotificationChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNotificationChannels500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListNotificationChannels500JSONResponse) VisitListNotificationChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNotificationChannelRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *CreateNotificationChannelJSONRequestBody
+}
+
+type CreateNotificationChannelResponseObject interface {
+	VisitCreateNotificationChannelResponse(w http.ResponseWriter) error
+}
+
+type CreateNotificationChannel201JSONResponse struct {
+	NotificationChannelResponseJSONResponse
+}
+
+func (response CreateNotificationChannel201JSONResponse) VisitCreateNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNotificationChannel400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateNotificationChannel400JSONResponse) VisitCreateNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNotificationChannel401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreateNotificationChannel401JSONResponse) VisitCreateNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNotificationChannel403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateNotificationChannel403JSONResponse) VisitCreateNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNotificationChannel404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateNotificationChannel404JSONResponse) VisitCreateNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNotificationChannel500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateNotificationChannel500JSONResponse) VisitCreateNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNotificationChannelRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	ChannelIdentifier ChannelIdentifierPathParam `json:"channel_identifier"`
+}
+
+type DeleteNotificationChannelResponseObject interface {
+	VisitDeleteNotificationChannelResponse(w http.ResponseWriter) error
+}
+
+type DeleteNotificationChannel200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteNotificationChannel200JSONResponse) VisitDeleteNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNotificationChannel400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteNotificationChannel400JSONResponse) VisitDeleteNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNotificationChannel401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteNotificationChannel401JSONResponse) VisitDeleteNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNotificationChannel403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteNotificationChannel403JSONResponse) VisitDeleteNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNotificationChannel404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteNotificationChannel404JSONResponse) VisitDeleteNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNotificationChannel500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteNotificationChannel500JSONResponse) VisitDeleteNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationChannelRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	ChannelIdentifier ChannelIdentifierPathParam `json:"channel_identifier"`
+	Body              *UpdateNotificationChannelJSONRequestBody
+}
+
+type UpdateNotificationChannelResponseObject interface {
+	VisitUpdateNotificationChannelResponse(w http.ResponseWriter) error
+}
+
+type UpdateNotificationChannel200JSONResponse struct {
+	NotificationChannelResponseJSONResponse
+}
+
+func (response UpdateNotificationChannel200JSONResponse) VisitUpdateNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationChannel400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateNotificationChannel400JSONResponse) VisitUpdateNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationChannel401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response UpdateNotificationChannel401JSONResponse) VisitUpdateNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationChannel403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateNotificationChannel403JSONResponse) VisitUpdateNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationChannel404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateNotificationChannel404JSONResponse) VisitUpdateNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationChannel500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateNotificationChannel500JSONResponse) VisitUpdateNotificationChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportOciArchiveRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ImportOciArchiveParams
+	Body        io.Reader
+}
+
+type ImportOciArchiveResponseObject interface {
+	VisitImportOciArchiveResponse(w http.ResponseWriter) error
+}
+
+type ImportOciArchive200JSONResponse struct{ OciImportResponseJSONResponse }
+
+func (response ImportOciArchive200JSONResponse) VisitImportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportOciArchive400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ImportOciArchive400JSONResponse) VisitImportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportOciArchive401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ImportOciArchive401JSONResponse) VisitImportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportOciArchive403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ImportOciArchive403JSONResponse) VisitImportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportOciArchive404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ImportOciArchive404JSONResponse) VisitImportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportOciArchive429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ImportOciArchive429JSONResponse) VisitImportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportOciArchive500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ImportOciArchive500JSONResponse) VisitImportOciArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageDenylistOverridesRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type ListPackageDenylistOverridesResponseObject interface {
+	VisitListPackageDenylistOverridesResponse(w http.ResponseWriter) error
+}
+
+type ListPackageDenylistOverrides200JSONResponse struct {
+	ListPackageDenylistOverridesResponseJSONResponse
+}
+
+func (response ListPackageDenylistOverrides200JSONResponse) VisitListPackageDenylistOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageDenylistOverrides400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListPackageDenylistOverrides400JSONResponse) VisitListPackageDenylistOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageDenylistOverrides401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListPackageDenylistOverrides401JSONResponse) VisitListPackageDenylistOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageDenylistOverrides403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListPackageDenylistOverrides403JSONResponse) VisitListPackageDenylistOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageDenylistOverrides404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListPackageDenylistOverrides404JSONResponse) VisitListPackageDenylistOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageDenylistOverrides500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListPackageDenylistOverrides500JSONResponse) VisitListPackageDenylistOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemovePackageDenylistOverrideRequestObject struct {
+	RegistryRef     RegistryRefPathParam     `json:"registry_ref"`
+	DenylistEntryId DenylistEntryIdPathParam `json:"denylist_entry_id"`
+}
+
+type RemovePackageDenylistOverrideResponseObject interface {
+	VisitRemovePackageDenylistOverrideResponse(w http.ResponseWriter) error
+}
+
+type RemovePackageDenylistOverride200JSONResponse struct{ SuccessJSONResponse }
+
+func (response RemovePackageDenylistOverride200JSONResponse) VisitRemovePackageDenylistOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemovePackageDenylistOverride400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RemovePackageDenylistOverride400JSONResponse) VisitRemovePackageDenylistOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemovePackageDenylistOverride401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response RemovePackageDenylistOverride401JSONResponse) VisitRemovePackageDenylistOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemovePackageDenylistOverride403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemovePackageDenylistOverride403JSONResponse) VisitRemovePackageDenylistOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemovePackageDenylistOverride404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemovePackageDenylistOverride404JSONResponse) VisitRemovePackageDenylistOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemovePackageDenylistOverride500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RemovePackageDenylistOverride500JSONResponse) VisitRemovePackageDenylistOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OverridePackageDenylistEntryRequestObject struct {
+	RegistryRef     RegistryRefPathParam     `json:"registry_ref"`
+	DenylistEntryId DenylistEntryIdPathParam `json:"denylist_entry_id"`
+	Body            *OverridePackageDenylistEntryJSONRequestBody
+}
+
+type OverridePackageDenylistEntryResponseObject interface {
+	VisitOverridePackageDenylistEntryResponse(w http.ResponseWriter) error
+}
+
+type OverridePackageDenylistEntry200JSONResponse struct {
+	PackageDenylistOverrideResponseJSONResponse
+}
+
+func (response OverridePackageDenylistEntry200JSONResponse) VisitOverridePackageDenylistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OverridePackageDenylistEntry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response OverridePackageDenylistEntry400JSONResponse) VisitOverridePackageDenylistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OverridePackageDenylistEntry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response OverridePackageDenylistEntry401JSONResponse) VisitOverridePackageDenylistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OverridePackageDenylistEntry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response OverridePackageDenylistEntry403JSONResponse) VisitOverridePackageDenylistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OverridePackageDenylistEntry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response OverridePackageDenylistEntry404JSONResponse) VisitOverridePackageDenylistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OverridePackageDenylistEntry409JSONResponse struct{ ConflictJSONResponse }
+
+func (response OverridePackageDenylistEntry409JSONResponse) VisitOverridePackageDenylistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OverridePackageDenylistEntry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response OverridePackageDenylistEntry500JSONResponse) VisitOverridePackageDenylistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEffectiveRegistryPolicyRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type GetEffectiveRegistryPolicyResponseObject interface {
+	VisitGetEffectiveRegistryPolicyResponse(w http.ResponseWriter) error
+}
+
+type GetEffectiveRegistryPolicy200JSONResponse struct {
+	EffectiveRegistryPolicyResponseJSONResponse
+}
+
+func (response GetEffectiveRegistryPolicy200JSONResponse) VisitGetEffectiveRegistryPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEffectiveRegistryPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetEffectiveRegistryPolicy400JSONResponse) VisitGetEffectiveRegistryPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEffectiveRegistryPolicy401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetEffectiveRegistryPolicy401JSONResponse) VisitGetEffectiveRegistryPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEffectiveRegistryPolicy403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEffectiveRegistryPolicy403JSONResponse) VisitGetEffectiveRegistryPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEffectiveRegistryPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetEffectiveRegistryPolicy404JSONResponse) VisitGetEffectiveRegistryPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEffectiveRegistryPolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetEffectiveRegistryPolicy500JSONResponse) VisitGetEffectiveRegistryPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteQuarantineFilePathRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      DeleteQuarantineFilePathParams
+}
+
+type DeleteQuarantineFilePathResponseObject interface {
+	VisitDeleteQuarantineFilePathResponse(w http.ResponseWriter) error
+}
+
+type DeleteQuarantineFilePath200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteQuarantineFilePath200JSONResponse) VisitDeleteQuarantineFilePathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteQuarantineFilePath400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteQuarantineFilePath400JSONResponse) VisitDeleteQuarantineFilePathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteQuarantineFilePath401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteQuarantineFilePath401JSONResponse) VisitDeleteQuarantineFilePathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteQuarantineFilePath403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteQuarantineFilePath403JSONResponse) VisitDeleteQuarantineFilePathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteQuarantineFilePath500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteQuarantineFilePath500JSONResponse) VisitDeleteQuarantineFilePathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuarantineFilePathRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *QuarantineFilePathJSONRequestBody
+}
+
+type QuarantineFilePathResponseObject interface {
+	VisitQuarantineFilePathResponse(w http.ResponseWriter) error
+}
+
+type QuarantineFilePath200JSONResponse struct {
+	QuarantinePathResponseJSONResponse
+}
+
+func (response QuarantineFilePath200JSONResponse) VisitQuarantineFilePathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuarantineFilePath400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response QuarantineFilePath400JSONResponse) VisitQuarantineFilePathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuarantineFilePath401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response QuarantineFilePath401JSONResponse) VisitQuarantineFilePathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuarantineFilePath403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response QuarantineFilePath403JSONResponse) VisitQuarantineFilePathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuarantineFilePath500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response QuarantineFilePath500JSONResponse) VisitQuarantineFilePathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScheduledDeletionsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListScheduledDeletionsParams
+}
+
+type ListScheduledDeletionsResponseObject interface {
+	VisitListScheduledDeletionsResponse(w http.ResponseWriter) error
+}
+
+type ListScheduledDeletions200JSONResponse struct {
+	ListScheduledDeletionsResponseJSONResponse
+}
+
+func (response ListScheduledDeletions200JSONResponse) VisitListScheduledDeletionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScheduledDeletions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListScheduledDeletions400JSONResponse) VisitListScheduledDeletionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScheduledDeletions401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListScheduledDeletions401JSONResponse) VisitListScheduledDeletionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScheduledDeletions403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListScheduledDeletions403JSONResponse) VisitListScheduledDeletionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScheduledDeletions404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListScheduledDeletions404JSONResponse) VisitListScheduledDeletionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScheduledDeletions500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListScheduledDeletions500JSONResponse) VisitListScheduledDeletionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleDeletionRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *ScheduleDeletionJSONRequestBody
+}
+
+type ScheduleDeletionResponseObject interface {
+	VisitScheduleDeletionResponse(w http.ResponseWriter) error
+}
+
+type ScheduleDeletion200JSONResponse struct {
+	ScheduledDeletionResponseJSONResponse
+}
+
+func (response ScheduleDeletion200JSONResponse) VisitScheduleDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleDeletion400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ScheduleDeletion400JSONResponse) VisitScheduleDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleDeletion401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ScheduleDeletion401JSONResponse) VisitScheduleDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleDeletion403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ScheduleDeletion403JSONResponse) VisitScheduleDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleDeletion404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ScheduleDeletion404JSONResponse) VisitScheduleDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleDeletion409JSONResponse struct{ ConflictJSONResponse }
+
+func (response ScheduleDeletion409JSONResponse) VisitScheduleDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleDeletion500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+func (response ScheduleDeletion500JSONResponse) VisitScheduleDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+type CancelScheduledDeletionRequestObject struct {
+	RegistryRef         RegistryRefPathParam         `json:"registry_ref"`
+	ScheduledDeletionId ScheduledDeletionIdPathParam `json:"scheduled_deletion_id"`
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+type CancelScheduledDeletionResponseObject interface {
+	VisitCancelScheduledDeletionResponse(w http.ResponseWriter) error
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+type CancelScheduledDeletion200JSONResponse struct {
+	ScheduledDeletionResponseJSONResponse
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+func (response CancelScheduledDeletion200JSONResponse) VisitCancelScheduledDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+type CancelScheduledDeletion400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CancelScheduledDeletion400JSONResponse) VisitCancelScheduledDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type CancelScheduledDeletion401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CancelScheduledDeletion401JSONResponse) VisitCancelScheduledDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type CancelScheduledDeletion403JSONResponse struct{ UnauthorizedJSONResponse }
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+func (response CancelScheduledDeletion403JSONResponse) VisitCancelScheduledDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/registry", wrapper.CreateRegistry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/registry/{registry_ref}", wrapper.DeleteRegistry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}", wrapper.GetRegistry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/registry/{registry_ref}", wrapper.ModifyRegistry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/labels", wrapper.ListArtifactLabels)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/stats", wrapper.GetArtifactStatsForRegistry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}", wrapper.DeleteArtifact)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/labels", wrapper.UpdateArtifactLabels)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/stats", wrapper.GetArtifactStats)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/summary", wrapper.GetArtifactSummary)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/tags", wrapper.GetOciArtifactTags)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}", wrapper.DeleteArtifactVersion)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/details", wrapper.GetArtifactDetails)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/details", wrapper.GetDockerArtifactDetails)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/layers", wrapper.GetDockerArtifactLayers)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifest", wrapper.GetDockerArtifactManifest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifests", wrapper.GetDockerArtifactManifests)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/file/{file_name}", wrapper.GetArtifactFile)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/files", wrapper.GetArtifactFiles)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/details", wrapper.GetHelmArtifactDetails)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/manifest", wrapper.GetHelmArtifactManifest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/summary", wrapper.GetArtifactVersionSummary)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/versions", wrapper.GetAllArtifactVersions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifacts", wrapper.GetAllArtifactsByRegistry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/client-setup-details", wrapper.GetClientSetupDetails)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/registry/{registry_ref}/quarantine", wrapper.DeleteQuarantineFilePath)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/registry/{registry_ref}/quarantine", wrapper.QuarantineFilePath)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks", wrapper.ListWebhooks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/registry/{registry_ref}/webhooks", wrapper.CreateWebhook)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}", wrapper.DeleteWebhook)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}", wrapper.GetWebhook)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}", wrapper.UpdateWebhook)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions", wrapper.ListWebhookExecutions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}", wrapper.GetWebhookExecution)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/retrigger", wrapper.ReTriggerWebhookExecution)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/replication/rules", wrapper.ListReplicationRules)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/replication/rules", wrapper.CreateReplicationRule)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/replication/rules/{id}", wrapper.DeleteReplicationRule)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/replication/rules/{id}", wrapper.GetReplicationRule)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/replication/rules/{id}", wrapper.UpdateReplicationRule)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/replication/rules/{id}/migration/images", wrapper.ListMigrationImages)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/replication/rules/{id}/migration/images/{image_id}/logs", wrapper.GetMigrationLogsForImage)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/replication/rules/{id}/migration/start", wrapper.StartMigration)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/replication/rules/{id}/migration/stop", wrapper.StopMigration)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/spaces/{space_ref}/artifact/stats", wrapper.GetArtifactStatsForSpace)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/spaces/{space_ref}/artifacts", wrapper.GetAllArtifacts)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/spaces/{space_ref}/registries", wrapper.GetAllRegistries)
-	})
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelScheduledDeletion404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CancelScheduledDeletion404JSONResponse) VisitCancelScheduledDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelScheduledDeletion409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CancelScheduledDeletion409JSONResponse) VisitCancelScheduledDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelScheduledDeletion500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CancelScheduledDeletion500JSONResponse) VisitCancelScheduledDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistrySettingsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type GetRegistrySettingsResponseObject interface {
+	VisitGetRegistrySettingsResponse(w http.ResponseWriter) error
+}
+
+type GetRegistrySettings200JSONResponse struct {
+	RegistrySettingsResponseJSONResponse
+}
+
+func (response GetRegistrySettings200JSONResponse) VisitGetRegistrySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistrySettings400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRegistrySettings400JSONResponse) VisitGetRegistrySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistrySettings401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetRegistrySettings401JSONResponse) VisitGetRegistrySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	return r
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ArtifactDetailResponseJSONResponse struct {
-	// Data Artifact Detail
-	Data ArtifactDetail `json:"data"`
+type GetRegistrySettings403JSONResponse struct{ UnauthorizedJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response GetRegistrySettings403JSONResponse) VisitGetRegistrySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ArtifactFileResponseJSONResponse struct {
-	// DownloadUrl download url of artifact
-	DownloadUrl string `json:"downloadUrl"`
+type GetRegistrySettings404JSONResponse struct{ NotFoundJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response GetRegistrySettings404JSONResponse) VisitGetRegistrySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ArtifactLabelResponseJSONResponse struct {
-	// Data Harness Artifact Summary
-	Data ArtifactSummary `json:"data"`
+type GetRegistrySettings500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response GetRegistrySettings500JSONResponse) VisitGetRegistrySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ArtifactStatsResponseJSONResponse struct {
-	// Data Harness Artifact Stats
-	Data ArtifactStats `json:"data"`
+type UpdateRegistrySettingsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *UpdateRegistrySettingsJSONRequestBody
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type UpdateRegistrySettingsResponseObject interface {
+	VisitUpdateRegistrySettingsResponse(w http.ResponseWriter) error
 }
 
-type ArtifactSummaryResponseJSONResponse struct {
-	// Data Harness Artifact Summary
-	Data ArtifactSummary `json:"data"`
+type UpdateRegistrySettings200JSONResponse struct {
+	RegistrySettingsResponseJSONResponse
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response UpdateRegistrySettings200JSONResponse) VisitUpdateRegistrySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ArtifactVersionSummaryResponseJSONResponse struct {
-	// Data Docker Artifact Version Summary
-	Data ArtifactVersionSummary `json:"data"`
+type UpdateRegistrySettings400JSONResponse struct{ BadRequestJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response UpdateRegistrySettings400JSONResponse) VisitUpdateRegistrySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type BadRequestJSONResponse Error
+type UpdateRegistrySettings401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-type ClientSetupDetailsResponseJSONResponse struct {
-	// Data Client Setup Details
-	Data ClientSetupDetails `json:"data"`
+func (response UpdateRegistrySettings401JSONResponse) VisitUpdateRegistrySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DockerArtifactDetailResponseJSONResponse struct {
-	// Data Docker Artifact Detail
-	Data DockerArtifactDetail `json:"data"`
+type UpdateRegistrySettings403JSONResponse struct{ UnauthorizedJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response UpdateRegistrySettings403JSONResponse) VisitUpdateRegistrySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DockerArtifactManifestResponseJSONResponse struct {
-	// Data Docker Artifact Manifest
-	Data DockerArtifactManifest `json:"data"`
+type UpdateRegistrySettings404JSONResponse struct{ NotFoundJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response UpdateRegistrySettings404JSONResponse) VisitUpdateRegistrySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DockerLayersResponseJSONResponse struct {
-	// Data Harness Layers Summary
-	Data DockerLayersSummary `json:"data"`
+type UpdateRegistrySettings500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response UpdateRegistrySettings500JSONResponse) VisitUpdateRegistrySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DockerManifestsResponseJSONResponse struct {
-	// Data Harness Manifests
-	Data DockerManifests `json:"data"`
+type ListRegistryTrashRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type ListRegistryTrashResponseObject interface {
+	VisitListRegistryTrashResponse(w http.ResponseWriter) error
 }
 
-type FileDetailResponseJSONResponse struct {
-	// Data A list of Harness Artifact Files
-	Data ListFileDetail `json:"data"`
+type ListRegistryTrash200JSONResponse struct {
+	RegistryTrashResponseJSONResponse
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response ListRegistryTrash200JSONResponse) VisitListRegistryTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type HelmArtifactDetailResponseJSONResponse struct {
-	// Data Helm Artifact Detail
-	Data HelmArtifactDetail `json:"data"`
+type ListRegistryTrash400JSONResponse struct{ BadRequestJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response ListRegistryTrash400JSONResponse) VisitListRegistryTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type HelmArtifactManifestResponseJSONResponse struct {
-	// Data Helm Artifact Manifest
-	Data HelmArtifactManifest `json:"data"`
+type ListRegistryTrash401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response ListRegistryTrash401JSONResponse) VisitListRegistryTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type InternalServerErrorJSONResponse Error
+type ListRegistryTrash403JSONResponse struct{ UnauthorizedJSONResponse }
 
-type ListArtifactLabelResponseJSONResponse struct {
-	// Data A list of Harness Artifact Labels
-	Data ListArtifactLabel `json:"data"`
+func (response ListRegistryTrash403JSONResponse) VisitListRegistryTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactResponseJSONResponse struct {
-	// Data A list of Artifacts
-	Data ListArtifact `json:"data"`
+type ListRegistryTrash404JSONResponse struct{ NotFoundJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response ListRegistryTrash404JSONResponse) VisitListRegistryTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactVersionResponseJSONResponse struct {
-	// Data A list of Artifact versions
-	Data ListArtifactVersion `json:"data"`
+type ListRegistryTrash500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response ListRegistryTrash500JSONResponse) VisitListRegistryTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListMigrationImageResponseJSONResponse struct {
-	// Data A list of migration images
-	Data ListMigrationImage `json:"data"`
+type ListRegistryTrash501JSONResponse struct{ NotImplementedJSONResponse }
+
+func (response ListRegistryTrash501JSONResponse) VisitListRegistryTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooksRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListWebhooksParams
+}
+
+type ListWebhooksResponseObject interface {
+	VisitListWebhooksResponse(w http.ResponseWriter) error
+}
+
+type ListWebhooks200JSONResponse struct {
+	ListWebhooksResponseJSONResponse
+}
+
+func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListWebhooks400JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListWebhooks401JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListWebhooks403JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListWebhooks500JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook201JSONResponse struct{ WebhookResponseJSONResponse }
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateWebhook400JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreateWebhook401JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListOciArtifactTagsResponseJSONResponse struct {
-	// Data A list of Artifact versions
-	Data ListOciArtifactTags `json:"data"`
+type CreateWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
-}
+func (response CreateWebhook403JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-type ListRegistryArtifactResponseJSONResponse struct {
-	// Data A list of Artifacts
-	Data ListRegistryArtifact `json:"data"`
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type CreateWebhook500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-type ListRegistryResponseJSONResponse struct {
-	// Data A list of Harness Artifact Registries
-	Data ListRegistry `json:"data"`
+func (response CreateWebhook500JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListReplicationRuleResponseJSONResponse struct {
-	// Data A list of replication rules
-	Data ListReplicationRule `json:"data"`
+type CreateWebhook501JSONResponse struct{ NotImplementedJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response CreateWebhook501JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWebhooksExecutionResponseJSONResponse struct {
-	// Data A list of Harness Registries webhooks executions
-	Data ListWebhooksExecutions `json:"data"`
+type DeleteWebhookRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
 }
 
-type ListWebhooksResponseJSONResponse struct {
-	// Data A list of Harness Registries webhooks
-	Data ListWebhooks `json:"data"`
+type DeleteWebhook200JSONResponse struct{ SuccessJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response DeleteWebhook200JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type NotFoundJSONResponse Error
+type DeleteWebhook400JSONResponse struct{ BadRequestJSONResponse }
 
-type PlainTextResponseTextplainCharsetUtf8Response struct {
-	Body io.Reader
+func (response DeleteWebhook400JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	ContentLength int64
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RegistryResponseJSONResponse struct {
-	// Data Harness Artifact Registry
-	Data Registry `json:"data"`
+type DeleteWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response DeleteWebhook401JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplicationRuleResponseJSONResponse struct {
-	Data ReplicationRule `json:"data"`
+type DeleteWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
-}
+func (response DeleteWebhook403JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-type SuccessJSONResponse struct {
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UnauthenticatedJSONResponse Error
-
-type UnauthorizedJSONResponse Error
+type DeleteWebhook404JSONResponse struct{ NotFoundJSONResponse }
 
-type WebhookExecutionResponseJSONResponse struct {
-	// Data Harness Regstries Webhook Execution
-	Data WebhookExecution `json:"data"`
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookResponseJSONResponse struct {
-	// Data Harness Regstries Webhook
-	Data Webhook `json:"data"`
-
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type DeleteWebhook500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-type QuarantinePathResponseJSONResponse struct {
-	// Data quarantine path
-	Data QuarantinePath `json:"data"`
+func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRegistryRequestObject struct {
-	Params CreateRegistryParams
-	Body   *CreateRegistryJSONRequestBody
+type GetWebhookRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
 }
 
-type CreateRegistryResponseObject interface {
-	VisitCreateRegistryResponse(w http.ResponseWriter) error
+type GetWebhookResponseObject interface {
+	VisitGetWebhookResponse(w http.ResponseWriter) error
 }
 
-type CreateRegistry201JSONResponse struct{ RegistryResponseJSONResponse }
+type GetWebhook200JSONResponse struct{ WebhookResponseJSONResponse }
 
-func (response CreateRegistry201JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+func (response GetWebhook200JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRegistry400JSONResponse struct{ BadRequestJSONResponse }
+type GetWebhook400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response CreateRegistry400JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+func (response GetWebhook400JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response CreateRegistry401JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+func (response GetWebhook401JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response CreateRegistry403JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+func (response GetWebhook403JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRegistry500JSONResponse struct {
+type GetWebhook404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetWebhook404JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response CreateRegistry500JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+func (response GetWebhook500JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRegistryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+type UpdateWebhookRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
+	Body              *UpdateWebhookJSONRequestBody
 }
 
-type DeleteRegistryResponseObject interface {
-	VisitDeleteRegistryResponse(w http.ResponseWriter) error
+type UpdateWebhookResponseObject interface {
+	VisitUpdateWebhookResponse(w http.ResponseWriter) error
 }
 
-type DeleteRegistry200JSONResponse struct{ SuccessJSONResponse }
+type UpdateWebhook201JSONResponse struct{ WebhookResponseJSONResponse }
 
-func (response DeleteRegistry200JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+func (response UpdateWebhook201JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRegistry400JSONResponse struct{ BadRequestJSONResponse }
+type UpdateWebhook400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response DeleteRegistry400JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+func (response UpdateWebhook400JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+type UpdateWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response DeleteRegistry401JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+func (response UpdateWebhook401JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+type UpdateWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteRegistry403JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+func (response UpdateWebhook403JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRegistry404JSONResponse struct{ NotFoundJSONResponse }
+type UpdateWebhook404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteRegistry404JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+func (response UpdateWebhook404JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRegistry500JSONResponse struct {
+type UpdateWebhook500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response DeleteRegistry500JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+func (response UpdateWebhook500JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRegistryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+type ListWebhookExecutionsRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
+	Params            ListWebhookExecutionsParams
 }
 
-type GetRegistryResponseObject interface {
-	VisitGetRegistryResponse(w http.ResponseWriter) error
+type ListWebhookExecutionsResponseObject interface {
+	VisitListWebhookExecutionsResponse(w http.ResponseWriter) error
 }
 
-type GetRegistry200JSONResponse struct{ RegistryResponseJSONResponse }
+type ListWebhookExecutions200JSONResponse struct {
+	ListWebhooksExecutionResponseJSONResponse
+}
 
-func (response GetRegistry200JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+func (response ListWebhookExecutions200JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRegistry400JSONResponse struct{ BadRequestJSONResponse }
+type ListWebhookExecutions400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetRegistry400JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+func (response ListWebhookExecutions400JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ListWebhookExecutions401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetRegistry401JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+func (response ListWebhookExecutions401JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+type ListWebhookExecutions403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetRegistry403JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+func (response ListWebhookExecutions403JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRegistry404JSONResponse struct{ NotFoundJSONResponse }
+type ListWebhookExecutions404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetRegistry404JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+func (response ListWebhookExecutions404JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRegistry500JSONResponse struct {
+type ListWebhookExecutions500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetRegistry500JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+func (response ListWebhookExecutions500JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModifyRegistryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Body        *ModifyRegistryJSONRequestBody
+type GetWebhookExecutionRequestObject struct {
+	RegistryRef        RegistryRefPathParam        `json:"registry_ref"`
+	WebhookIdentifier  WebhookIdentifierPathParam  `json:"webhook_identifier"`
+	WebhookExecutionId WebhookExecutionIdPathParam `json:"webhook_execution_id"`
 }
 
-type ModifyRegistryResponseObject interface {
-	VisitModifyRegistryResponse(w http.ResponseWriter) error
+type GetWebhookExecutionResponseObject interface {
+	VisitGetWebhookExecutionResponse(w http.ResponseWriter) error
 }
 
-type ModifyRegistry200JSONResponse struct{ RegistryResponseJSONResponse }
+type GetWebhookExecution200JSONResponse struct {
+	WebhookExecutionResponseJSONResponse
+}
 
-func (response ModifyRegistry200JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+func (response GetWebhookExecution200JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModifyRegistry400JSONResponse struct{ BadRequestJSONResponse }
+type GetWebhookExecution400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ModifyRegistry400JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+func (response GetWebhookExecution400JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModifyRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetWebhookExecution401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response ModifyRegistry401JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+func (response GetWebhookExecution401JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModifyRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetWebhookExecution403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ModifyRegistry403JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+func (response GetWebhookExecution403JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModifyRegistry404JSONResponse struct{ NotFoundJSONResponse }
+type GetWebhookExecution404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response ModifyRegistry404JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+func (response GetWebhookExecution404JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModifyRegistry500JSONResponse struct {
+type GetWebhookExecution500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response ModifyRegistry500JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+func (response GetWebhookExecution500JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactLabelsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Params      ListArtifactLabelsParams
+type ReplayWebhookExecutionRequestObject struct {
+	RegistryRef        RegistryRefPathParam        `json:"registry_ref"`
+	WebhookIdentifier  WebhookIdentifierPathParam  `json:"webhook_identifier"`
+	WebhookExecutionId WebhookExecutionIdPathParam `json:"webhook_execution_id"`
+	Body               *ReplayWebhookExecutionJSONRequestBody
 }
 
-type ListArtifactLabelsResponseObject interface {
-	VisitListArtifactLabelsResponse(w http.ResponseWriter) error
+type ReplayWebhookExecutionResponseObject interface {
+	VisitReplayWebhookExecutionResponse(w http.ResponseWriter) error
 }
 
-type ListArtifactLabels200JSONResponse struct {
-	ListArtifactLabelResponseJSONResponse
+type ReplayWebhookExecution200JSONResponse struct {
+	WebhookExecutionResponseJSONResponse
 }
 
-func (response ListArtifactLabels200JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response ReplayWebhookExecution200JSONResponse) VisitReplayWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactLabels400JSONResponse struct{ BadRequestJSONResponse }
+type ReplayWebhookExecution400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListArtifactLabels400JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response ReplayWebhookExecution400JSONResponse) VisitReplayWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactLabels401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ReplayWebhookExecution401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response ListArtifactLabels401JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response ReplayWebhookExecution401JSONResponse) VisitReplayWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactLabels403JSONResponse struct{ UnauthorizedJSONResponse }
+type ReplayWebhookExecution403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListArtifactLabels403JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response ReplayWebhookExecution403JSONResponse) VisitReplayWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactLabels404JSONResponse struct{ NotFoundJSONResponse }
+type ReplayWebhookExecution404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response ListArtifactLabels404JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response ReplayWebhookExecution404JSONResponse) VisitReplayWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactLabels500JSONResponse struct {
+type ReplayWebhookExecution500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response ListArtifactLabels500JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response ReplayWebhookExecution500JSONResponse) VisitReplayWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsForRegistryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Params      GetArtifactStatsForRegistryParams
+type ReTriggerWebhookExecutionRequestObject struct {
+	RegistryRef        RegistryRefPathParam        `json:"registry_ref"`
+	WebhookIdentifier  WebhookIdentifierPathParam  `json:"webhook_identifier"`
+	WebhookExecutionId WebhookExecutionIdPathParam `json:"webhook_execution_id"`
 }
 
-type GetArtifactStatsForRegistryResponseObject interface {
-	VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error
+type ReTriggerWebhookExecutionResponseObject interface {
+	VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error
 }
 
-type GetArtifactStatsForRegistry200JSONResponse struct {
-	ArtifactStatsResponseJSONResponse
+type ReTriggerWebhookExecution200JSONResponse struct {
+	WebhookExecutionResponseJSONResponse
 }
 
-func (response GetArtifactStatsForRegistry200JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+func (response ReTriggerWebhookExecution200JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsForRegistry400JSONResponse struct{ BadRequestJSONResponse }
+type ReTriggerWebhookExecution400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetArtifactStatsForRegistry400JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+func (response ReTriggerWebhookExecution400JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsForRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ReTriggerWebhookExecution401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetArtifactStatsForRegistry401JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+func (response ReTriggerWebhookExecution401JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsForRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+type ReTriggerWebhookExecution403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetArtifactStatsForRegistry403JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+func (response ReTriggerWebhookExecution403JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsForRegistry404JSONResponse struct{ NotFoundJSONResponse }
+type ReTriggerWebhookExecution404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetArtifactStatsForRegistry404JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+func (response ReTriggerWebhookExecution404JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsForRegistry500JSONResponse struct {
+type ReTriggerWebhookExecution500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetArtifactStatsForRegistry500JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+func (response ReTriggerWebhookExecution500JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Params      DeleteArtifactParams
+type TestWebhookRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
+	Body              *TestWebhookJSONRequestBody
 }
 
-type DeleteArtifactResponseObject interface {
-	VisitDeleteArtifactResponse(w http.ResponseWriter) error
+type TestWebhookResponseObject interface {
+	VisitTestWebhookResponse(w http.ResponseWriter) error
 }
 
-type DeleteArtifact200JSONResponse struct{ SuccessJSONResponse }
+type TestWebhook200JSONResponse struct {
+	WebhookExecutionResponseJSONResponse
+}
 
-func (response DeleteArtifact200JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+func (response TestWebhook200JSONResponse) VisitTestWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifact400JSONResponse struct{ BadRequestJSONResponse }
+type TestWebhook400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response DeleteArtifact400JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+func (response TestWebhook400JSONResponse) VisitTestWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifact401JSONResponse struct{ UnauthenticatedJSONResponse }
+type TestWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response DeleteArtifact401JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+func (response TestWebhook401JSONResponse) VisitTestWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifact403JSONResponse struct{ UnauthorizedJSONResponse }
+type TestWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteArtifact403JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+func (response TestWebhook403JSONResponse) VisitTestWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifact404JSONResponse struct{ NotFoundJSONResponse }
+type TestWebhook404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteArtifact404JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+func (response TestWebhook404JSONResponse) VisitTestWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifact500JSONResponse struct {
+type TestWebhook500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response DeleteArtifact500JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+func (response TestWebhook500JSONResponse) VisitTestWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateArtifactLabelsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Params      UpdateArtifactLabelsParams
-	Body        *UpdateArtifactLabelsJSONRequestBody
+type ListReplicationRulesRequestObject struct {
+	Params ListReplicationRulesParams
 }
 
-type UpdateArtifactLabelsResponseObject interface {
-	VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error
+type ListReplicationRulesResponseObject interface {
+	VisitListReplicationRulesResponse(w http.ResponseWriter) error
 }
 
-type UpdateArtifactLabels200JSONResponse struct {
-	ArtifactLabelResponseJSONResponse
+type ListReplicationRules200JSONResponse struct {
+	ListReplicationRuleResponseJSONResponse
 }
 
-func (response UpdateArtifactLabels200JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response ListReplicationRules200JSONResponse) VisitListReplicationRulesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateArtifactLabels400JSONResponse struct{ BadRequestJSONResponse }
+type ListReplicationRules400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpdateArtifactLabels400JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response ListReplicationRules400JSONResponse) VisitListReplicationRulesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateArtifactLabels401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ListReplicationRules401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response UpdateArtifactLabels401JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response ListReplicationRules401JSONResponse) VisitListReplicationRulesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateArtifactLabels403JSONResponse struct{ UnauthorizedJSONResponse }
+type ListReplicationRules403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpdateArtifactLabels403JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response ListReplicationRules403JSONResponse) VisitListReplicationRulesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateArtifactLabels404JSONResponse struct{ NotFoundJSONResponse }
+type ListReplicationRules404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response UpdateArtifactLabels404JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response ListReplicationRules404JSONResponse) VisitListReplicationRulesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateArtifactLabels500JSONResponse struct {
+type ListReplicationRules500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response UpdateArtifactLabels500JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response ListReplicationRules500JSONResponse) VisitListReplicationRulesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Params      GetArtifactStatsParams
+type CreateReplicationRuleRequestObject struct {
+	Params CreateReplicationRuleParams
+	Body   *CreateReplicationRuleJSONRequestBody
 }
 
-type GetArtifactStatsResponseObject interface {
-	VisitGetArtifactStatsResponse(w http.ResponseWriter) error
+type CreateReplicationRuleResponseObject interface {
+	VisitCreateReplicationRuleResponse(w http.ResponseWriter) error
 }
 
-type GetArtifactStats200JSONResponse struct {
-	ArtifactStatsResponseJSONResponse
+type CreateReplicationRule200JSONResponse struct {
+	ReplicationRuleResponseJSONResponse
 }
 
-func (response GetArtifactStats200JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+func (response CreateReplicationRule200JSONResponse) VisitCreateReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStats400JSONResponse struct{ BadRequestJSONResponse }
+type CreateReplicationRule400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetArtifactStats400JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+func (response CreateReplicationRule400JSONResponse) VisitCreateReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStats401JSONResponse struct{ UnauthenticatedJSONResponse }
+type CreateReplicationRule401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetArtifactStats401JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+func (response CreateReplicationRule401JSONResponse) VisitCreateReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStats403JSONResponse struct{ UnauthorizedJSONResponse }
+type CreateReplicationRule403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetArtifactStats403JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+func (response CreateReplicationRule403JSONResponse) VisitCreateReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStats404JSONResponse struct{ NotFoundJSONResponse }
+type CreateReplicationRule404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetArtifactStats404JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+func (response CreateReplicationRule404JSONResponse) VisitCreateReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStats500JSONResponse struct {
+type CreateReplicationRule500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetArtifactStats500JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+func (response CreateReplicationRule500JSONResponse) VisitCreateReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactSummaryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Params      GetArtifactSummaryParams
+type DeleteReplicationRuleRequestObject struct {
+	Id string `json:"id"`
 }
 
-type GetArtifactSummaryResponseObject interface {
-	VisitGetArtifactSummaryResponse(w http.ResponseWriter) error
+type DeleteReplicationRuleResponseObject interface {
+	VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error
 }
 
-type GetArtifactSummary200JSONResponse struct {
-	ArtifactSummaryResponseJSONResponse
-}
+type DeleteReplicationRule200JSONResponse struct{ SuccessJSONResponse }
 
-func (response GetArtifactSummary200JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+func (response DeleteReplicationRule200JSONResponse) VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactSummary400JSONResponse struct{ BadRequestJSONResponse }
+type DeleteReplicationRule400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetArtifactSummary400JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+func (response DeleteReplicationRule400JSONResponse) VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactSummary401JSONResponse struct{ UnauthenticatedJSONResponse }
+type DeleteReplicationRule401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetArtifactSummary401JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+func (response DeleteReplicationRule401JSONResponse) VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactSummary403JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteReplicationRule403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetArtifactSummary403JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+func (response DeleteReplicationRule403JSONResponse) VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactSummary404JSONResponse struct{ NotFoundJSONResponse }
+type DeleteReplicationRule404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetArtifactSummary404JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+func (response DeleteReplicationRule404JSONResponse) VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactSummary500JSONResponse struct {
+type DeleteReplicationRule500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetArtifactSummary500JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+func (response DeleteReplicationRule500JSONResponse) VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOciArtifactTagsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Params      GetOciArtifactTagsParams
+type GetReplicationRuleRequestObject struct {
+	Id string `json:"id"`
 }
 
-type GetOciArtifactTagsResponseObject interface {
-	VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error
+type GetReplicationRuleResponseObject interface {
+	VisitGetReplicationRuleResponse(w http.ResponseWriter) error
 }
 
-type GetOciArtifactTags200JSONResponse struct {
-	ListOciArtifactTagsResponseJSONResponse
+type GetReplicationRule200JSONResponse struct {
+	ReplicationRuleResponseJSONResponse
 }
 
-func (response GetOciArtifactTags200JSONResponse) VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error {
+func (response GetReplicationRule200JSONResponse) VisitGetReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOciArtifactTags400JSONResponse struct{ BadRequestJSONResponse }
+type GetReplicationRule400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetOciArtifactTags400JSONResponse) VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error {
+func (response GetReplicationRule400JSONResponse) VisitGetReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOciArtifactTags401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetReplicationRule401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetOciArtifactTags401JSONResponse) VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error {
+func (response GetReplicationRule401JSONResponse) VisitGetReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOciArtifactTags403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetReplicationRule403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetOciArtifactTags403JSONResponse) VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error {
+func (response GetReplicationRule403JSONResponse) VisitGetReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOciArtifactTags404JSONResponse struct{ NotFoundJSONResponse }
+type GetReplicationRule404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetOciArtifactTags404JSONResponse) VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error {
+func (response GetReplicationRule404JSONResponse) VisitGetReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOciArtifactTags500JSONResponse struct {
+type GetReplicationRule500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetOciArtifactTags500JSONResponse) VisitGetOciArtifactTagsResponse(w http.ResponseWriter) error {
+func (response GetReplicationRule500JSONResponse) VisitGetReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactVersionRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      DeleteArtifactVersionParams
+type UpdateReplicationRuleRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateReplicationRuleJSONRequestBody
 }
 
-type DeleteArtifactVersionResponseObject interface {
-	VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error
+type UpdateReplicationRuleResponseObject interface {
+	VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error
 }
 
-type DeleteArtifactVersion200JSONResponse struct{ SuccessJSONResponse }
+type UpdateReplicationRule200JSONResponse struct {
+	ReplicationRuleResponseJSONResponse
+}
 
-func (response DeleteArtifactVersion200JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+func (response UpdateReplicationRule200JSONResponse) VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactVersion400JSONResponse struct{ BadRequestJSONResponse }
+type UpdateReplicationRule400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response DeleteArtifactVersion400JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+func (response UpdateReplicationRule400JSONResponse) VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactVersion401JSONResponse struct{ UnauthenticatedJSONResponse }
+type UpdateReplicationRule401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response DeleteArtifactVersion401JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+func (response UpdateReplicationRule401JSONResponse) VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactVersion403JSONResponse struct{ UnauthorizedJSONResponse }
+type UpdateReplicationRule403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteArtifactVersion403JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+func (response UpdateReplicationRule403JSONResponse) VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactVersion404JSONResponse struct{ NotFoundJSONResponse }
+type UpdateReplicationRule404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteArtifactVersion404JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+func (response UpdateReplicationRule404JSONResponse) VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactVersion500JSONResponse struct {
+type UpdateReplicationRule500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response DeleteArtifactVersion500JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+func (response UpdateReplicationRule500JSONResponse) VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactDetailsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetArtifactDetailsParams
+type ListMigrationImagesRequestObject struct {
+	Id     string `json:"id"`
+	Params ListMigrationImagesParams
 }
 
-type GetArtifactDetailsResponseObject interface {
-	VisitGetArtifactDetailsResponse(w http.ResponseWriter) error
+type ListMigrationImagesResponseObject interface {
+	VisitListMigrationImagesResponse(w http.ResponseWriter) error
 }
 
-type GetArtifactDetails200JSONResponse struct {
-	ArtifactDetailResponseJSONResponse
+type ListMigrationImages200JSONResponse struct {
+	ListMigrationImageResponseJSONResponse
 }
 
-func (response GetArtifactDetails200JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response ListMigrationImages200JSONResponse) VisitListMigrationImagesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
+type ListMigrationImages400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetArtifactDetails400JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response ListMigrationImages400JSONResponse) VisitListMigrationImagesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ListMigrationImages401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetArtifactDetails401JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response ListMigrationImages401JSONResponse) VisitListMigrationImagesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+type ListMigrationImages403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetArtifactDetails403JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response ListMigrationImages403JSONResponse) VisitListMigrationImagesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+type ListMigrationImages404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetArtifactDetails404JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response ListMigrationImages404JSONResponse) VisitListMigrationImagesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactDetails500JSONResponse struct {
+type ListMigrationImages500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetArtifactDetails500JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response ListMigrationImages500JSONResponse) VisitListMigrationImagesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactDetailsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetDockerArtifactDetailsParams
+type GetMigrationLogsForImageRequestObject struct {
+	Id      string `json:"id"`
+	ImageId string `json:"image_id"`
 }
 
-type GetDockerArtifactDetailsResponseObject interface {
-	VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error
+type GetMigrationLogsForImageResponseObject interface {
+	VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error
 }
 
-type GetDockerArtifactDetails200JSONResponse struct {
-	DockerArtifactDetailResponseJSONResponse
+type GetMigrationLogsForImage200TextplainCharsetUtf8Response struct {
+	PlainTextResponseTextplainCharsetUtf8Response
 }
 
-func (response GetDockerArtifactDetails200JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetMigrationLogsForImage200TextplainCharsetUtf8Response) VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type GetDockerArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
+type GetMigrationLogsForImage400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetDockerArtifactDetails400JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response GetMigrationLogsForImage400JSONResponse) VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetMigrationLogsForImage401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetDockerArtifactDetails401JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response GetMigrationLogsForImage401JSONResponse) VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetMigrationLogsForImage403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetDockerArtifactDetails403JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response GetMigrationLogsForImage403JSONResponse) VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+type GetMigrationLogsForImage404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetDockerArtifactDetails404JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response GetMigrationLogsForImage404JSONResponse) VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactDetails500JSONResponse struct {
+type GetMigrationLogsForImage500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetDockerArtifactDetails500JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response GetMigrationLogsForImage500JSONResponse) VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactLayersRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetDockerArtifactLayersParams
+type StartMigrationRequestObject struct {
+	Id string `json:"id"`
 }
 
-type GetDockerArtifactLayersResponseObject interface {
-	VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error
+type StartMigrationResponseObject interface {
+	VisitStartMigrationResponse(w http.ResponseWriter) error
 }
 
-type GetDockerArtifactLayers200JSONResponse struct {
-	DockerLayersResponseJSONResponse
-}
+type StartMigration200JSONResponse struct{ SuccessJSONResponse }
 
-func (response GetDockerArtifactLayers200JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+func (response StartMigration200JSONResponse) VisitStartMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactLayers400JSONResponse struct{ BadRequestJSONResponse }
+type StartMigration400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetDockerArtifactLayers400JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+func (response StartMigration400JSONResponse) VisitStartMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactLayers401JSONResponse struct{ UnauthenticatedJSONResponse }
+type StartMigration401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetDockerArtifactLayers401JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+func (response StartMigration401JSONResponse) VisitStartMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactLayers403JSONResponse struct{ UnauthorizedJSONResponse }
+type StartMigration403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetDockerArtifactLayers403JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+func (response StartMigration403JSONResponse) VisitStartMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactLayers404JSONResponse struct{ NotFoundJSONResponse }
+type StartMigration404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetDockerArtifactLayers404JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+func (response StartMigration404JSONResponse) VisitStartMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactLayers500JSONResponse struct {
+type StartMigration500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetDockerArtifactLayers500JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+func (response StartMigration500JSONResponse) VisitStartMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactManifestRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetDockerArtifactManifestParams
+type StopMigrationRequestObject struct {
+	Id string `json:"id"`
 }
 
-type GetDockerArtifactManifestResponseObject interface {
-	VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error
+type StopMigrationResponseObject interface {
+	VisitStopMigrationResponse(w http.ResponseWriter) error
 }
 
-type GetDockerArtifactManifest200JSONResponse struct {
-	DockerArtifactManifestResponseJSONResponse
-}
+type StopMigration200JSONResponse struct{ SuccessJSONResponse }
 
-func (response GetDockerArtifactManifest200JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+func (response StopMigration200JSONResponse) VisitStopMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactManifest400JSONResponse struct{ BadRequestJSONResponse }
+type StopMigration400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetDockerArtifactManifest400JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+func (response StopMigration400JSONResponse) VisitStopMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactManifest401JSONResponse struct{ UnauthenticatedJSONResponse }
+type StopMigration401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetDockerArtifactManifest401JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+func (response StopMigration401JSONResponse) VisitStopMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactManifest403JSONResponse struct{ UnauthorizedJSONResponse }
+type StopMigration403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetDockerArtifactManifest403JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+func (response StopMigration403JSONResponse) VisitStopMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactManifest404JSONResponse struct{ NotFoundJSONResponse }
+type StopMigration404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetDockerArtifactManifest404JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+func (response StopMigration404JSONResponse) VisitStopMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactManifest500JSONResponse struct {
+type StopMigration500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetDockerArtifactManifest500JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+func (response StopMigration500JSONResponse) VisitStopMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactManifestsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetDockerArtifactManifestsParams
+type GetArtifactStatsForSpaceRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   GetArtifactStatsForSpaceParams
 }
 
-type GetDockerArtifactManifestsResponseObject interface {
-	VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error
+type GetArtifactStatsForSpaceResponseObject interface {
+	VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error
 }
 
-type GetDockerArtifactManifests200JSONResponse struct {
-	DockerManifestsResponseJSONResponse
+type GetArtifactStatsForSpace200JSONResponse struct {
+	ArtifactStatsResponseJSONResponse
 }
 
-func (response GetDockerArtifactManifests200JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+func (response GetArtifactStatsForSpace200JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactManifests400JSONResponse struct{ BadRequestJSONResponse }
+type GetArtifactStatsForSpace400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetDockerArtifactManifests400JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+func (response GetArtifactStatsForSpace400JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactManifests401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetArtifactStatsForSpace401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetDockerArtifactManifests401JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+func (response GetArtifactStatsForSpace401JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactManifests403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetArtifactStatsForSpace403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetDockerArtifactManifests403JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+func (response GetArtifactStatsForSpace403JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactManifests404JSONResponse struct{ NotFoundJSONResponse }
+type GetArtifactStatsForSpace404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetDockerArtifactManifests404JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+func (response GetArtifactStatsForSpace404JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactManifests500JSONResponse struct {
+type GetArtifactStatsForSpace500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetDockerArtifactManifests500JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+func (response GetArtifactStatsForSpace500JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactFileRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	FileName    FileNamePathParam    `json:"file_name"`
-	Params      GetArtifactFileParams
+type GetAllArtifactsRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   GetAllArtifactsParams
 }
 
-type GetArtifactFileResponseObject interface {
-	VisitGetArtifactFileResponse(w http.ResponseWriter) error
+type GetAllArtifactsResponseObject interface {
+	VisitGetAllArtifactsResponse(w http.ResponseWriter) error
 }
 
-type GetArtifactFile200JSONResponse struct {
-	ArtifactFileResponseJSONResponse
+type GetAllArtifacts200JSONResponse struct {
+	ListArtifactResponseJSONResponse
 }
 
-func (response GetArtifactFile200JSONResponse) VisitGetArtifactFileResponse(w http.ResponseWriter) error {
+func (response GetAllArtifacts200JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactFile400JSONResponse struct{ BadRequestJSONResponse }
+type GetAllArtifacts400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetArtifactFile400JSONResponse) VisitGetArtifactFileResponse(w http.ResponseWriter) error {
+func (response GetAllArtifacts400JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactFile401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetAllArtifacts401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetArtifactFile401JSONResponse) VisitGetArtifactFileResponse(w http.ResponseWriter) error {
+func (response GetAllArtifacts401JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactFile403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAllArtifacts403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetArtifactFile403JSONResponse) VisitGetArtifactFileResponse(w http.ResponseWriter) error {
+func (response GetAllArtifacts403JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactFile404JSONResponse struct{ NotFoundJSONResponse }
+type GetAllArtifacts404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetArtifactFile404JSONResponse) VisitGetArtifactFileResponse(w http.ResponseWriter) error {
+func (response GetAllArtifacts404JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactFile500JSONResponse struct {
+type GetAllArtifacts500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetArtifactFile500JSONResponse) VisitGetArtifactFileResponse(w http.ResponseWriter) error {
+func (response GetAllArtifacts500JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactFilesRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetArtifactFilesParams
+type ListPackageDenylistEntriesRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   ListPackageDenylistEntriesParams
 }
 
-type GetArtifactFilesResponseObject interface {
-	VisitGetArtifactFilesResponse(w http.ResponseWriter) error
+type ListPackageDenylistEntriesResponseObject interface {
+	VisitListPackageDenylistEntriesResponse(w http.ResponseWriter) error
 }
 
-type GetArtifactFiles200JSONResponse struct{ FileDetailResponseJSONResponse }
+type ListPackageDenylistEntries200JSONResponse struct {
+	ListPackageDenylistEntriesResponseJSONResponse
+}
 
-func (response GetArtifactFiles200JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+func (response ListPackageDenylistEntries200JSONResponse) VisitListPackageDenylistEntriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactFiles400JSONResponse struct{ BadRequestJSONResponse }
+type ListPackageDenylistEntries400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetArtifactFiles400JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+func (response ListPackageDenylistEntries400JSONResponse) VisitListPackageDenylistEntriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactFiles401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ListPackageDenylistEntries401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetArtifactFiles401JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+func (response ListPackageDenylistEntries401JSONResponse) VisitListPackageDenylistEntriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactFiles403JSONResponse struct{ UnauthorizedJSONResponse }
+type ListPackageDenylistEntries403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetArtifactFiles403JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+func (response ListPackageDenylistEntries403JSONResponse) VisitListPackageDenylistEntriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactFiles404JSONResponse struct{ NotFoundJSONResponse }
+type ListPackageDenylistEntries404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetArtifactFiles404JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+func (response ListPackageDenylistEntries404JSONResponse) VisitListPackageDenylistEntriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactFiles500JSONResponse struct {
+type ListPackageDenylistEntries500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetArtifactFiles500JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+func (response ListPackageDenylistEntries500JSONResponse) VisitListPackageDenylistEntriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHelmArtifactDetailsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetHelmArtifactDetailsParams
+type CreatePackageDenylistEntryRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Body     *CreatePackageDenylistEntryJSONRequestBody
 }
 
-type GetHelmArtifactDetailsResponseObject interface {
-	VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error
+type CreatePackageDenylistEntryResponseObject interface {
+	VisitCreatePackageDenylistEntryResponse(w http.ResponseWriter) error
 }
 
-type GetHelmArtifactDetails200JSONResponse struct {
-	HelmArtifactDetailResponseJSONResponse
+type CreatePackageDenylistEntry201JSONResponse struct {
+	PackageDenylistEntryResponseJSONResponse
 }
 
-func (response GetHelmArtifactDetails200JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response CreatePackageDenylistEntry201JSONResponse) VisitCreatePackageDenylistEntryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHelmArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
+type CreatePackageDenylistEntry400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetHelmArtifactDetails400JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response CreatePackageDenylistEntry400JSONResponse) VisitCreatePackageDenylistEntryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHelmArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+type CreatePackageDenylistEntry401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetHelmArtifactDetails401JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response CreatePackageDenylistEntry401JSONResponse) VisitCreatePackageDenylistEntryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHelmArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+type CreatePackageDenylistEntry403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetHelmArtifactDetails403JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response CreatePackageDenylistEntry403JSONResponse) VisitCreatePackageDenylistEntryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHelmArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+type CreatePackageDenylistEntry404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetHelmArtifactDetails404JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response CreatePackageDenylistEntry404JSONResponse) VisitCreatePackageDenylistEntryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHelmArtifactDetails500JSONResponse struct {
+type CreatePackageDenylistEntry409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CreatePackageDenylistEntry409JSONResponse) VisitCreatePackageDenylistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackageDenylistEntry500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetHelmArtifactDetails500JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response CreatePackageDenylistEntry500JSONResponse) VisitCreatePackageDenylistEntryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHelmArtifactManifestRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
+type ImportPackageDenylistRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Body     *ImportPackageDenylistJSONRequestBody
 }
 
-type GetHelmArtifactManifestResponseObject interface {
-	VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error
+type ImportPackageDenylistResponseObject interface {
+	VisitImportPackageDenylistResponse(w http.ResponseWriter) error
 }
 
-type GetHelmArtifactManifest200JSONResponse struct {
-	HelmArtifactManifestResponseJSONResponse
+type ImportPackageDenylist200JSONResponse struct {
+	PackageDenylistImportResponseJSONResponse
 }
 
-func (response GetHelmArtifactManifest200JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+func (response ImportPackageDenylist200JSONResponse) VisitImportPackageDenylistResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHelmArtifactManifest400JSONResponse struct{ BadRequestJSONResponse }
+type ImportPackageDenylist400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetHelmArtifactManifest400JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+func (response ImportPackageDenylist400JSONResponse) VisitImportPackageDenylistResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHelmArtifactManifest401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ImportPackageDenylist401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetHelmArtifactManifest401JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+func (response ImportPackageDenylist401JSONResponse) VisitImportPackageDenylistResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHelmArtifactManifest403JSONResponse struct{ UnauthorizedJSONResponse }
+type ImportPackageDenylist403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetHelmArtifactManifest403JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+func (response ImportPackageDenylist403JSONResponse) VisitImportPackageDenylistResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHelmArtifactManifest404JSONResponse struct{ NotFoundJSONResponse }
+type ImportPackageDenylist404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetHelmArtifactManifest404JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+func (response ImportPackageDenylist404JSONResponse) VisitImportPackageDenylistResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHelmArtifactManifest500JSONResponse struct {
+type ImportPackageDenylist500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetHelmArtifactManifest500JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+func (response ImportPackageDenylist500JSONResponse) VisitImportPackageDenylistResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactVersionSummaryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetArtifactVersionSummaryParams
+type DeletePackageDenylistEntryRequestObject struct {
+	SpaceRef        SpaceRefPathParam        `json:"space_ref"`
+	DenylistEntryId DenylistEntryIdPathParam `json:"denylist_entry_id"`
 }
 
-type GetArtifactVersionSummaryResponseObject interface {
-	VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error
+type DeletePackageDenylistEntryResponseObject interface {
+	VisitDeletePackageDenylistEntryResponse(w http.ResponseWriter) error
 }
 
-type GetArtifactVersionSummary200JSONResponse struct {
-	ArtifactVersionSummaryResponseJSONResponse
-}
+type DeletePackageDenylistEntry200JSONResponse struct{ SuccessJSONResponse }
 
-func (response GetArtifactVersionSummary200JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+func (response DeletePackageDenylistEntry200JSONResponse) VisitDeletePackageDenylistEntryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactVersionSummary400JSONResponse struct{ BadRequestJSONResponse }
+type DeletePackageDenylistEntry400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetArtifactVersionSummary400JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+func (response DeletePackageDenylistEntry400JSONResponse) VisitDeletePackageDenylistEntryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactVersionSummary401JSONResponse struct{ UnauthenticatedJSONResponse }
+type DeletePackageDenylistEntry401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetArtifactVersionSummary401JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+func (response DeletePackageDenylistEntry401JSONResponse) VisitDeletePackageDenylistEntryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactVersionSummary403JSONResponse struct{ UnauthorizedJSONResponse }
+type DeletePackageDenylistEntry403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetArtifactVersionSummary403JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+func (response DeletePackageDenylistEntry403JSONResponse) VisitDeletePackageDenylistEntryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactVersionSummary404JSONResponse struct{ NotFoundJSONResponse }
+type DeletePackageDenylistEntry404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetArtifactVersionSummary404JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+func (response DeletePackageDenylistEntry404JSONResponse) VisitDeletePackageDenylistEntryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactVersionSummary500JSONResponse struct {
+type DeletePackageDenylistEntry500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetArtifactVersionSummary500JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+func (response DeletePackageDenylistEntry500JSONResponse) VisitDeletePackageDenylistEntryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllArtifactVersionsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Params      GetAllArtifactVersionsParams
+type ListPipelineArtifactsRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   ListPipelineArtifactsParams
 }
 
-type GetAllArtifactVersionsResponseObject interface {
-	VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error
+type ListPipelineArtifactsResponseObject interface {
+	VisitListPipelineArtifactsResponse(w http.ResponseWriter) error
 }
 
-type GetAllArtifactVersions200JSONResponse struct {
-	ListArtifactVersionResponseJSONResponse
+type ListPipelineArtifacts200JSONResponse struct {
+	ListPipelineArtifactsResponseJSONResponse
 }
 
-func (response GetAllArtifactVersions200JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+func (response ListPipelineArtifacts200JSONResponse) VisitListPipelineArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllArtifactVersions400JSONResponse struct{ BadRequestJSONResponse }
+type ListPipelineArtifacts400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetAllArtifactVersions400JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+func (response ListPipelineArtifacts400JSONResponse) VisitListPipelineArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllArtifactVersions401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ListPipelineArtifacts401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetAllArtifactVersions401JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+func (response ListPipelineArtifacts401JSONResponse) VisitListPipelineArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllArtifactVersions403JSONResponse struct{ UnauthorizedJSONResponse }
+type ListPipelineArtifacts403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetAllArtifactVersions403JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+func (response ListPipelineArtifacts403JSONResponse) VisitListPipelineArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllArtifactVersions404JSONResponse struct{ NotFoundJSONResponse }
+type ListPipelineArtifacts404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetAllArtifactVersions404JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+func (response ListPipelineArtifacts404JSONResponse) VisitListPipelineArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllArtifactVersions500JSONResponse struct {
+type ListPipelineArtifacts500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetAllArtifactVersions500JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+func (response ListPipelineArtifacts500JSONResponse) VisitListPipelineArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllArtifactsByRegistryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Params      GetAllArtifactsByRegistryParams
+type GetAllRegistriesRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   GetAllRegistriesParams
 }
 
-type GetAllArtifactsByRegistryResponseObject interface {
-	VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error
+type GetAllRegistriesResponseObject interface {
+	VisitGetAllRegistriesResponse(w http.ResponseWriter) error
 }
 
-type GetAllArtifactsByRegistry200JSONResponse struct {
-	ListRegistryArtifactResponseJSONResponse
+type GetAllRegistries200JSONResponse struct {
+	ListRegistryResponseJSONResponse
 }
 
-func (response GetAllArtifactsByRegistry200JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+func (response GetAllRegistries200JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllArtifactsByRegistry400JSONResponse struct{ BadRequestJSONResponse }
+type GetAllRegistries400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetAllArtifactsByRegistry400JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+func (response GetAllRegistries400JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllArtifactsByRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetAllRegistries401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetAllArtifactsByRegistry401JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+func (response GetAllRegistries401JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllArtifactsByRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAllRegistries403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetAllArtifactsByRegistry403JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+func (response GetAllRegistries403JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllArtifactsByRegistry404JSONResponse struct{ NotFoundJSONResponse }
+type GetAllRegistries404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetAllArtifactsByRegistry404JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+func (response GetAllRegistries404JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllArtifactsByRegistry500JSONResponse struct {
+type GetAllRegistries500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetAllArtifactsByRegistry500JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+func (response GetAllRegistries500JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClientSetupDetailsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Params      GetClientSetupDetailsParams
+type GetRegistryGarbageStatsRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
 }
 
-type GetClientSetupDetailsResponseObject interface {
-	VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error
+type GetRegistryGarbageStatsResponseObject interface {
+	VisitGetRegistryGarbageStatsResponse(w http.ResponseWriter) error
 }
 
-type GetClientSetupDetails200JSONResponse struct {
-	ClientSetupDetailsResponseJSONResponse
+type GetRegistryGarbageStats200JSONResponse struct {
+	RegistryGarbageStatsResponseJSONResponse
 }
 
-func (response GetClientSetupDetails200JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+func (response GetRegistryGarbageStats200JSONResponse) VisitGetRegistryGarbageStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClientSetupDetails400JSONResponse struct{ BadRequestJSONResponse }
+type GetRegistryGarbageStats400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetClientSetupDetails400JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+func (response GetRegistryGarbageStats400JSONResponse) VisitGetRegistryGarbageStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClientSetupDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetRegistryGarbageStats401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetClientSetupDetails401JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+func (response GetRegistryGarbageStats401JSONResponse) VisitGetRegistryGarbageStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClientSetupDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetRegistryGarbageStats403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetClientSetupDetails403JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+func (response GetRegistryGarbageStats403JSONResponse) VisitGetRegistryGarbageStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClientSetupDetails404JSONResponse struct{ NotFoundJSONResponse }
+type GetRegistryGarbageStats404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetClientSetupDetails404JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+func (response GetRegistryGarbageStats404JSONResponse) VisitGetRegistryGarbageStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClientSetupDetails500JSONResponse struct {
+type GetRegistryGarbageStats500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetClientSetupDetails500JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+func (response GetRegistryGarbageStats500JSONResponse) VisitGetRegistryGarbageStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteQuarantineFilePathRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Params      DeleteQuarantineFilePathParams
+type GetUploadFailureStatsRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   GetUploadFailureStatsParams
 }
 
-type DeleteQuarantineFilePathResponseObject interface {
-	VisitDeleteQuarantineFilePathResponse(w http.ResponseWriter) error
+type GetUploadFailureStatsResponseObject interface {
+	VisitGetUploadFailureStatsResponse(w http.ResponseWriter) error
 }
 
-type DeleteQuarantineFilePath200JSONResponse struct{ SuccessJSONResponse }
+type GetUploadFailureStats200JSONResponse struct {
+	UploadFailureStatsResponseJSONResponse
+}
 
-func (response DeleteQuarantineFilePath200JSONResponse) VisitDeleteQuarantineFilePathResponse(w http.ResponseWriter) error {
+func (response GetUploadFailureStats200JSONResponse) VisitGetUploadFailureStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteQuarantineFilePath400JSONResponse struct{ BadRequestJSONResponse }
+type GetUploadFailureStats400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response DeleteQuarantineFilePath400JSONResponse) VisitDeleteQuarantineFilePathResponse(w http.ResponseWriter) error {
+func (response GetUploadFailureStats400JSONResponse) VisitGetUploadFailureStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteQuarantineFilePath401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetUploadFailureStats401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response DeleteQuarantineFilePath401JSONResponse) VisitDeleteQuarantineFilePathResponse(w http.ResponseWriter) error {
+func (response GetUploadFailureStats401JSONResponse) VisitGetUploadFailureStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteQuarantineFilePath403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetUploadFailureStats403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteQuarantineFilePath403JSONResponse) VisitDeleteQuarantineFilePathResponse(w http.ResponseWriter) error {
+func (response GetUploadFailureStats403JSONResponse) VisitGetUploadFailureStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteQuarantineFilePath500JSONResponse struct {
+type GetUploadFailureStats404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetUploadFailureStats404JSONResponse) VisitGetUploadFailureStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUploadFailureStats500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response DeleteQuarantineFilePath500JSONResponse) VisitDeleteQuarantineFilePathResponse(w http.ResponseWriter) error {
+func (response GetUploadFailureStats500JSONResponse) VisitGetUploadFailureStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuarantineFilePathRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Body        *QuarantineFilePathJSONRequestBody
+type GetSpaceRegistryUsageRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
 }
 
-type QuarantineFilePathResponseObject interface {
-	VisitQuarantineFilePathResponse(w http.ResponseWriter) error
+type GetSpaceRegistryUsageResponseObject interface {
+	VisitGetSpaceRegistryUsageResponse(w http.ResponseWriter) error
 }
 
-type QuarantineFilePath200JSONResponse struct {
-	QuarantinePathResponseJSONResponse
+type GetSpaceRegistryUsage200JSONResponse struct {
+	SpaceRegistryUsageResponseJSONResponse
 }
 
-func (response QuarantineFilePath200JSONResponse) VisitQuarantineFilePathResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryUsage200JSONResponse) VisitGetSpaceRegistryUsageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuarantineFilePath400JSONResponse struct{ BadRequestJSONResponse }
+type GetSpaceRegistryUsage400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response QuarantineFilePath400JSONResponse) VisitQuarantineFilePathResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryUsage400JSONResponse) VisitGetSpaceRegistryUsageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuarantineFilePath401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetSpaceRegistryUsage401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response QuarantineFilePath401JSONResponse) VisitQuarantineFilePathResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryUsage401JSONResponse) VisitGetSpaceRegistryUsageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuarantineFilePath403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetSpaceRegistryUsage403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response QuarantineFilePath403JSONResponse) VisitQuarantineFilePathResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryUsage403JSONResponse) VisitGetSpaceRegistryUsageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuarantineFilePath500JSONResponse struct {
+type GetSpaceRegistryUsage404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSpaceRegistryUsage404JSONResponse) VisitGetSpaceRegistryUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceRegistryUsage500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response QuarantineFilePath500JSONResponse) VisitQuarantineFilePathResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryUsage500JSONResponse) VisitGetSpaceRegistryUsageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWebhooksRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Params      ListWebhooksParams
+type GetSpaceRegistryUsageHistoryRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   GetSpaceRegistryUsageHistoryParams
 }
 
-type ListWebhooksResponseObject interface {
-	VisitListWebhooksResponse(w http.ResponseWriter) error
+type GetSpaceRegistryUsageHistoryResponseObject interface {
+	VisitGetSpaceRegistryUsageHistoryResponse(w http.ResponseWriter) error
 }
 
-type ListWebhooks200JSONResponse struct {
-	ListWebhooksResponseJSONResponse
+type GetSpaceRegistryUsageHistory200JSONResponse struct {
+	SpaceRegistryUsageHistoryResponseJSONResponse
 }
 
-func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryUsageHistory200JSONResponse) VisitGetSpaceRegistryUsageHistoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWebhooks400JSONResponse struct{ BadRequestJSONResponse }
+type GetSpaceRegistryUsageHistory400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListWebhooks400JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryUsageHistory400JSONResponse) VisitGetSpaceRegistryUsageHistoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWebhooks401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetSpaceRegistryUsageHistory401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response ListWebhooks401JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryUsageHistory401JSONResponse) VisitGetSpaceRegistryUsageHistoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWebhooks403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetSpaceRegistryUsageHistory403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListWebhooks403JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryUsageHistory403JSONResponse) VisitGetSpaceRegistryUsageHistoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWebhooks500JSONResponse struct {
+type GetSpaceRegistryUsageHistory404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSpaceRegistryUsageHistory404JSONResponse) VisitGetSpaceRegistryUsageHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceRegistryUsageHistory500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response ListWebhooks500JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryUsageHistory500JSONResponse) VisitGetSpaceRegistryUsageHistoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWebhookRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Body        *CreateWebhookJSONRequestBody
+type GetSpaceRegistryPolicyRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
 }
 
-type CreateWebhookResponseObject interface {
-	VisitCreateWebhookResponse(w http.ResponseWriter) error
+type GetSpaceRegistryPolicyResponseObject interface {
+	VisitGetSpaceRegistryPolicyResponse(w http.ResponseWriter) error
 }
 
-type CreateWebhook201JSONResponse struct{ WebhookResponseJSONResponse }
+type GetSpaceRegistryPolicy200JSONResponse struct {
+	RegistryPolicyResponseJSONResponse
+}
 
-func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryPolicy200JSONResponse) VisitGetSpaceRegistryPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWebhook400JSONResponse struct{ BadRequestJSONResponse }
+type GetSpaceRegistryPolicy400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response CreateWebhook400JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryPolicy400JSONResponse) VisitGetSpaceRegistryPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetSpaceRegistryPolicy401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response CreateWebhook401JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryPolicy401JSONResponse) VisitGetSpaceRegistryPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetSpaceRegistryPolicy403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response CreateWebhook403JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryPolicy403JSONResponse) VisitGetSpaceRegistryPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWebhook500JSONResponse struct {
+type GetSpaceRegistryPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSpaceRegistryPolicy404JSONResponse) VisitGetSpaceRegistryPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceRegistryPolicy500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response CreateWebhook500JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+func (response GetSpaceRegistryPolicy500JSONResponse) VisitGetSpaceRegistryPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWebhookRequestObject struct {
-	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
-	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
+type UpdateSpaceRegistryPolicyRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Body     *UpdateSpaceRegistryPolicyJSONRequestBody
 }
 
-type DeleteWebhookResponseObject interface {
-	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+type UpdateSpaceRegistryPolicyResponseObject interface {
+	VisitUpdateSpaceRegistryPolicyResponse(w http.ResponseWriter) error
 }
 
-type DeleteWebhook200JSONResponse struct{ SuccessJSONResponse }
+type UpdateSpaceRegistryPolicy200JSONResponse struct {
+	RegistryPolicyResponseJSONResponse
+}
 
-func (response DeleteWebhook200JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+func (response UpdateSpaceRegistryPolicy200JSONResponse) VisitUpdateSpaceRegistryPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWebhook400JSONResponse struct{ BadRequestJSONResponse }
+type UpdateSpaceRegistryPolicy400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response DeleteWebhook400JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+func (response UpdateSpaceRegistryPolicy400JSONResponse) VisitUpdateSpaceRegistryPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
+type UpdateSpaceRegistryPolicy401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response DeleteWebhook401JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+func (response UpdateSpaceRegistryPolicy401JSONResponse) VisitUpdateSpaceRegistryPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
+type UpdateSpaceRegistryPolicy403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteWebhook403JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+func (response UpdateSpaceRegistryPolicy403JSONResponse) VisitUpdateSpaceRegistryPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWebhook404JSONResponse struct{ NotFoundJSONResponse }
+type UpdateSpaceRegistryPolicy404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+func (response UpdateSpaceRegistryPolicy404JSONResponse) VisitUpdateSpaceRegistryPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWebhook500JSONResponse struct {
+type UpdateSpaceRegistryPolicy500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+func (response UpdateSpaceRegistryPolicy500JSONResponse) VisitUpdateSpaceRegistryPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWebhookRequestObject struct {
-	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
-	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
+type ListVulnerableVersionsRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   ListVulnerableVersionsParams
 }
 
-type GetWebhookResponseObject interface {
-	VisitGetWebhookResponse(w http.ResponseWriter) error
+type ListVulnerableVersionsResponseObject interface {
+	VisitListVulnerableVersionsResponse(w http.ResponseWriter) error
 }
 
-type GetWebhook200JSONResponse struct{ WebhookResponseJSONResponse }
+type ListVulnerableVersions200JSONResponse struct {
+	ListVulnerableVersionsResponseJSONResponse
+}
 
-func (response GetWebhook200JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+func (response ListVulnerableVersions200JSONResponse) VisitListVulnerableVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWebhook400JSONResponse struct{ BadRequestJSONResponse }
+type ListVulnerableVersions400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetWebhook400JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+func (response ListVulnerableVersions400JSONResponse) VisitListVulnerableVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ListVulnerableVersions401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetWebhook401JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+func (response ListVulnerableVersions401JSONResponse) VisitListVulnerableVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
+type ListVulnerableVersions403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetWebhook403JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+func (response ListVulnerableVersions403JSONResponse) VisitListVulnerableVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWebhook404JSONResponse struct{ NotFoundJSONResponse }
+type ListVulnerableVersions404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetWebhook404JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+func (response ListVulnerableVersions404JSONResponse) VisitListVulnerableVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWebhook500JSONResponse struct {
+type ListVulnerableVersions500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetWebhook500JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+func (response ListVulnerableVersions500JSONResponse) VisitListVulnerableVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List Recent Artifacts
+	// (GET /artifacts/recent)
+	ListRecentArtifacts(ctx context.Context, request ListRecentArtifactsRequestObject) (ListRecentArtifactsResponseObject, error)
+	// List Starred Artifacts
+	// (GET /artifacts/starred)
+	ListStarredArtifacts(ctx context.Context, request ListStarredArtifactsRequestObject) (ListStarredArtifactsResponseObject, error)
+	// Get Package Type Capabilities
+	// (GET /package-types/capabilities)
+	GetPackageTypeCapabilities(ctx context.Context, request GetPackageTypeCapabilitiesRequestObject) (GetPackageTypeCapabilitiesResponseObject, error)
+	// Create Registry.
+	// (POST /registry)
+	CreateRegistry(ctx context.Context, request CreateRegistryRequestObject) (CreateRegistryResponseObject, error)
+	// Search artifacts
+	// (GET /registry/search)
+	SearchArtifacts(ctx context.Context, request SearchArtifactsRequestObject) (SearchArtifactsResponseObject, error)
+	// Delete a Registry
+	// (DELETE /registry/{registry_ref})
+	DeleteRegistry(ctx context.Context, request DeleteRegistryRequestObject) (DeleteRegistryResponseObject, error)
+	// Returns Registry Details
+	// (GET /registry/{registry_ref})
+	GetRegistry(ctx context.Context, request GetRegistryRequestObject) (GetRegistryResponseObject, error)
+	// Updates a Registry
+	// (PUT /registry/{registry_ref})
+	ModifyRegistry(ctx context.Context, request ModifyRegistryRequestObject) (ModifyRegistryResponseObject, error)
+	// Apply registry configuration
+	// (PUT /registry/{registry_ref}/apply)
+	ApplyRegistryConfig(ctx context.Context, request ApplyRegistryConfigRequestObject) (ApplyRegistryConfigResponseObject, error)
+	// List Artifact Labels
+	// (GET /registry/{registry_ref}/artifact/labels)
+	ListArtifactLabels(ctx context.Context, request ListArtifactLabelsRequestObject) (ListArtifactLabelsResponseObject, error)
+	// Get Artifact Stats
+	// (GET /registry/{registry_ref}/artifact/stats)
+	GetArtifactStatsForRegistry(ctx context.Context, request GetArtifactStatsForRegistryRequestObject) (GetArtifactStatsForRegistryResponseObject, error)
+	// Delete Artifact
+	// (DELETE /registry/{registry_ref}/artifact/{artifact})
+	DeleteArtifact(ctx context.Context, request DeleteArtifactRequestObject) (DeleteArtifactResponseObject, error)
+	// Update Artifact Description
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/description)
+	UpdateArtifactDescription(ctx context.Context, request UpdateArtifactDescriptionRequestObject) (UpdateArtifactDescriptionResponseObject, error)
+	// List Artifact Description History
+	// (GET /registry/{registry_ref}/artifact/{artifact}/description/history)
+	ListArtifactDescriptionHistory(ctx context.Context, request ListArtifactDescriptionHistoryRequestObject) (ListArtifactDescriptionHistoryResponseObject, error)
+	// Get Artifact Download Stats
+	// (GET /registry/{registry_ref}/artifact/{artifact}/downloads)
+	GetArtifactDownloadStats(ctx context.Context, request GetArtifactDownloadStatsRequestObject) (GetArtifactDownloadStatsResponseObject, error)
+	// Update Artifact Labels
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/labels)
+	UpdateArtifactLabels(ctx context.Context, request UpdateArtifactLabelsRequestObject) (UpdateArtifactLabelsResponseObject, error)
+	// Delete Artifact Logo
+	// (DELETE /registry/{registry_ref}/artifact/{artifact}/logo)
+	DeleteArtifactLogo(ctx context.Context, request DeleteArtifactLogoRequestObject) (DeleteArtifactLogoResponseObject, error)
+	// Get Artifact Logo
+	// (GET /registry/{registry_ref}/artifact/{artifact}/logo)
+	GetArtifactLogo(ctx context.Context, request GetArtifactLogoRequestObject) (GetArtifactLogoResponseObject, error)
+	// Upload Artifact Logo
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/logo)
+	UploadArtifactLogo(ctx context.Context, request UploadArtifactLogoRequestObject) (UploadArtifactLogoResponseObject, error)
+	// Export OCI archive
+	// (GET /registry/{registry_ref}/artifact/{artifact}/oci-export)
+	ExportOciArchive(ctx context.Context, request ExportOciArchiveRequestObject) (ExportOciArchiveResponseObject, error)
+	// Unstar Artifact
+	// (DELETE /registry/{registry_ref}/artifact/{artifact}/star)
+	UnstarArtifact(ctx context.Context, request UnstarArtifactRequestObject) (UnstarArtifactResponseObject, error)
+	// Star Artifact
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/star)
+	StarArtifact(ctx context.Context, request StarArtifactRequestObject) (StarArtifactResponseObject, error)
+	// Get Artifact Stats
+	// (GET /registry/{registry_ref}/artifact/{artifact}/stats)
+	GetArtifactStats(ctx context.Context, request GetArtifactStatsRequestObject) (GetArtifactStatsResponseObject, error)
+	// Get Artifact Summary
+	// (GET /registry/{registry_ref}/artifact/{artifact}/summary)
+	GetArtifactSummary(ctx context.Context, request GetArtifactSummaryRequestObject) (GetArtifactSummaryResponseObject, error)
+	// List OCI Artifact tags
+	// (GET /registry/{registry_ref}/artifact/{artifact}/tags)
+	GetOciArtifactTags(ctx context.Context, request GetOciArtifactTagsRequestObject) (GetOciArtifactTagsResponseObject, error)
+	// Delete an Artifact Version
+	// (DELETE /registry/{registry_ref}/artifact/{artifact}/version/{version})
+	DeleteArtifactVersion(ctx context.Context, request DeleteArtifactVersionRequestObject) (DeleteArtifactVersionResponseObject, error)
+	// Describe Artifact Details
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/details)
+	GetArtifactDetails(ctx context.Context, request GetArtifactDetailsRequestObject) (GetArtifactDetailsResponseObject, error)
+	// Describe Docker Artifact Detail
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/details)
+	GetDockerArtifactDetails(ctx context.Context, request GetDockerArtifactDetailsRequestObject) (GetDockerArtifactDetailsResponseObject, error)
+	// Describe Docker Artifact Layers
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/layers)
+	GetDockerArtifactLayers(ctx context.Context, request GetDockerArtifactLayersRequestObject) (GetDockerArtifactLayersResponseObject, error)
+	// Describe Docker Artifact Manifest
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifest)
+	GetDockerArtifactManifest(ctx context.Context, request GetDockerArtifactManifestRequestObject) (GetDockerArtifactManifestResponseObject, error)
+	// Describe Docker Artifact Manifests
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifests)
+	GetDockerArtifactManifests(ctx context.Context, request GetDockerArtifactManifestsRequestObject) (GetDockerArtifactManifestsResponseObject, error)
+	// Get Artifact file
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/file/{file_name})
+	GetArtifactFile(ctx context.Context, request GetArtifactFileRequestObject) (GetArtifactFileResponseObject, error)
+	// Describe Artifact files
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/files)
+	GetArtifactFiles(ctx context.Context, request GetArtifactFilesRequestObject) (GetArtifactFilesResponseObject, error)
+	// Revoke firewall approval
+	// (DELETE /registry/{registry_ref}/artifact/{artifact}/version/{version}/firewall-approval)
+	RevokeFirewallApproval(ctx context.Context, request RevokeFirewallApprovalRequestObject) (RevokeFirewallApprovalResponseObject, error)
+	// Approve version held by the firewall
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/version/{version}/firewall-approval)
+	ApproveFirewallVersion(ctx context.Context, request ApproveFirewallVersionRequestObject) (ApproveFirewallVersionResponseObject, error)
+	// Describe Helm Chart Dependencies
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/dependencies)
+	GetHelmArtifactDependencies(ctx context.Context, request GetHelmArtifactDependenciesRequestObject) (GetHelmArtifactDependenciesResponseObject, error)
+	// Describe Helm Artifact Detail
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/details)
+	GetHelmArtifactDetails(ctx context.Context, request GetHelmArtifactDetailsRequestObject) (GetHelmArtifactDetailsResponseObject, error)
+	// Describe Helm Artifact Manifest
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/manifest)
+	GetHelmArtifactManifest(ctx context.Context, request GetHelmArtifactManifestRequestObject) (GetHelmArtifactManifestResponseObject, error)
+	// List Artifact Version Metadata History
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/metadata/history)
+	ListArtifactVersionMetadataHistory(ctx context.Context, request ListArtifactVersionMetadataHistoryRequestObject) (ListArtifactVersionMetadataHistoryResponseObject, error)
+	// Get Artifact Version Provenance
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/provenance)
+	GetArtifactVersionProvenance(ctx context.Context, request GetArtifactVersionProvenanceRequestObject) (GetArtifactVersionProvenanceResponseObject, error)
+	// Update Artifact Version Provenance
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/version/{version}/provenance)
+	UpdateArtifactVersionProvenance(ctx context.Context, request UpdateArtifactVersionProvenanceRequestObject) (UpdateArtifactVersionProvenanceResponseObject, error)
+	// Restore Artifact Version
+	// (POST /registry/{registry_ref}/artifact/{artifact}/version/{version}/restore)
+	RestoreArtifactVersion(ctx context.Context, request RestoreArtifactVersionRequestObject) (RestoreArtifactVersionResponseObject, error)
+	// Get Artifact Scan Status
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/scan)
+	GetArtifactScanStatus(ctx context.Context, request GetArtifactScanStatusRequestObject) (GetArtifactScanStatusResponseObject, error)
+	// Request Artifact Scan
+	// (POST /registry/{registry_ref}/artifact/{artifact}/version/{version}/scan)
+	RequestArtifactScan(ctx context.Context, request RequestArtifactScanRequestObject) (RequestArtifactScanResponseObject, error)
+	// Update Artifact Scan Status
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/version/{version}/scan)
+	UpdateArtifactScanStatus(ctx context.Context, request UpdateArtifactScanStatusRequestObject) (UpdateArtifactScanStatusResponseObject, error)
+	// Get Artifact Version Summary
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/summary)
+	GetArtifactVersionSummary(ctx context.Context, request GetArtifactVersionSummaryRequestObject) (GetArtifactVersionSummaryResponseObject, error)
+	// Purge Artifact Version
+	// (DELETE /registry/{registry_ref}/artifact/{artifact}/version/{version}/trash)
+	PurgeArtifactVersion(ctx context.Context, request PurgeArtifactVersionRequestObject) (PurgeArtifactVersionResponseObject, error)
+	// List Artifact Versions
+	// (GET /registry/{registry_ref}/artifact/{artifact}/versions)
+	GetAllArtifactVersions(ctx context.Context, request GetAllArtifactVersionsRequestObject) (GetAllArtifactVersionsResponseObject, error)
+	// Sync Artifact Versions
+	// (GET /registry/{registry_ref}/artifact/{artifact}/versions/sync)
+	SyncArtifactVersions(ctx context.Context, request SyncArtifactVersionsRequestObject) (SyncArtifactVersionsResponseObject, error)
+	// List Artifacts for Registry
+	// (GET /registry/{registry_ref}/artifacts)
+	GetAllArtifactsByRegistry(ctx context.Context, request GetAllArtifactsByRegistryRequestObject) (GetAllArtifactsByRegistryResponseObject, error)
+	// Get Download Counts of Artifacts
+	// (GET /registry/{registry_ref}/artifacts/download-counts)
+	GetArtifactDownloadCounts(ctx context.Context, request GetArtifactDownloadCountsRequestObject) (GetArtifactDownloadCountsResponseObject, error)
+	// Returns CLI Client Setup Details
+	// (GET /registry/{registry_ref}/client-setup-details)
+	GetClientSetupDetails(ctx context.Context, request GetClientSetupDetailsRequestObject) (GetClientSetupDetailsResponseObject, error)
+	// List deletion requests
+	// (GET /registry/{registry_ref}/deletion-requests)
+	ListDeletionRequests(ctx context.Context, request ListDeletionRequestsRequestObject) (ListDeletionRequestsResponseObject, error)
+	// Approve deletion request
+	// (POST /registry/{registry_ref}/deletion-requests/{deletion_request_id}/approve)
+	ApproveDeletionRequest(ctx context.Context, request ApproveDeletionRequestRequestObject) (ApproveDeletionRequestResponseObject, error)
+	// Reject deletion request
+	// (POST /registry/{registry_ref}/deletion-requests/{deletion_request_id}/reject)
+	RejectDeletionRequest(ctx context.Context, request RejectDeletionRequestRequestObject) (RejectDeletionRequestResponseObject, error)
+	// List failed uploads
+	// (GET /registry/{registry_ref}/failed-uploads)
+	ListFailedUploads(ctx context.Context, request ListFailedUploadsRequestObject) (ListFailedUploadsResponseObject, error)
+	// Download failed upload
+	// (GET /registry/{registry_ref}/failed-uploads/{failed_upload_uuid}/download)
+	DownloadFailedUpload(ctx context.Context, request DownloadFailedUploadRequestObject) (DownloadFailedUploadResponseObject, error)
+	// List firewall approvals
+	// (GET /registry/{registry_ref}/firewall-approvals)
+	ListFirewallApprovals(ctx context.Context, request ListFirewallApprovalsRequestObject) (ListFirewallApprovalsResponseObject, error)
+	// List registry index builds
+	// (GET /registry/{registry_ref}/index/builds)
+	ListRegistryIndexBuilds(ctx context.Context, request ListRegistryIndexBuildsRequestObject) (ListRegistryIndexBuildsResponseObject, error)
+	// Retry registry index build
+	// (POST /registry/{registry_ref}/index/builds/{index_build_id}/retry)
+	RetryRegistryIndexBuild(ctx context.Context, request RetryRegistryIndexBuildRequestObject) (RetryRegistryIndexBuildResponseObject, error)
+	// Get registry index status
+	// (GET /registry/{registry_ref}/index/status)
+	GetRegistryIndexStatus(ctx context.Context, request GetRegistryIndexStatusRequestObject) (GetRegistryIndexStatusResponseObject, error)
+	// List registry jobs
+	// (GET /registry/{registry_ref}/jobs)
+	ListRegistryJobs(ctx context.Context, request ListRegistryJobsRequestObject) (ListRegistryJobsResponseObject, error)
+	// Get registry job
+	// (GET /registry/{registry_ref}/jobs/{job_uuid})
+	GetRegistryJob(ctx context.Context, request GetRegistryJobRequestObject) (GetRegistryJobResponseObject, error)
+	// Cancel registry job
+	// (POST /registry/{registry_ref}/jobs/{job_uuid}/cancel)
+	CancelRegistryJob(ctx context.Context, request CancelRegistryJobRequestObject) (CancelRegistryJobResponseObject, error)
+	// ListNotificationChannels
+	// (GET /registry/{registry_ref}/notification-channels)
+	ListNotificationChannels(ctx context.Context, request ListNotificationChannelsRequestObject) (ListNotificationChannelsResponseObject, error)
+	// CreateNotificationChannel
+	// (POST /registry/{registry_ref}/notification-channels)
+	CreateNotificationChannel(ctx context.Context, request CreateNotificationChannelRequestObject) (CreateNotificationChannelResponseObject, error)
+	// DeleteNotificationChannel
+	// (DELETE /registry/{registry_ref}/notification-channels/{channel_identifier})
+	DeleteNotificationChannel(ctx context.Context, request DeleteNotificationChannelRequestObject) (DeleteNotificationChannelResponseObject, error)
+	// UpdateNotificationChannel
+	// (PUT /registry/{registry_ref}/notification-channels/{channel_identifier})
+	UpdateNotificationChannel(ctx context.Context, request UpdateNotificationChannelRequestObject) (UpdateNotificationChannelResponseObject, error)
+	// Import OCI archive
+	// (POST /registry/{registry_ref}/oci-import)
+	ImportOciArchive(ctx context.Context, request ImportOciArchiveRequestObject) (ImportOciArchiveResponseObject, error)
+	// List package denylist overrides
+	// (GET /registry/{registry_ref}/package-denylist/overrides)
+	ListPackageDenylistOverrides(ctx context.Context, request ListPackageDenylistOverridesRequestObject) (ListPackageDenylistOverridesResponseObject, error)
+	// Remove package denylist override
+	// (DELETE /registry/{registry_ref}/package-denylist/{denylist_entry_id}/override)
+	RemovePackageDenylistOverride(ctx context.Context, request RemovePackageDenylistOverrideRequestObject) (RemovePackageDenylistOverrideResponseObject, error)
+	// Override package denylist entry
+	// (PUT /registry/{registry_ref}/package-denylist/{denylist_entry_id}/override)
+	OverridePackageDenylistEntry(ctx context.Context, request OverridePackageDenylistEntryRequestObject) (OverridePackageDenylistEntryResponseObject, error)
+	// Get effective registry policy
+	// (GET /registry/{registry_ref}/policy)
+	GetEffectiveRegistryPolicy(ctx context.Context, request GetEffectiveRegistryPolicyRequestObject) (GetEffectiveRegistryPolicyResponseObject, error)
+	// deleteQuarantineFilePath
+	// (DELETE /registry/{registry_ref}/quarantine)
+	DeleteQuarantineFilePath(ctx context.Context, request DeleteQuarantineFilePathRequestObject) (DeleteQuarantineFilePathResponseObject, error)
+	// quarantineFilePath
+	// (PUT /registry/{registry_ref}/quarantine)
+	QuarantineFilePath(ctx context.Context, request QuarantineFilePathRequestObject) (QuarantineFilePathResponseObject, error)
+	// List scheduled deletions
+	// (GET /registry/{registry_ref}/scheduled-deletions)
+	ListScheduledDeletions(ctx context.Context, request ListScheduledDeletionsRequestObject) (ListScheduledDeletionsResponseObject, error)
+	// Schedule deletion
+	// (POST /registry/{registry_ref}/scheduled-deletions)
+	ScheduleDeletion(ctx context.Context, request ScheduleDeletionRequestObject) (ScheduleDeletionResponseObject, error)
+	// Cancel scheduled deletion
+	// (POST /registry/{registry_ref}/scheduled-deletions/{scheduled_deletion_id}/cancel)
+	CancelScheduledDeletion(ctx context.Context, request CancelScheduledDeletionRequestObject) (CancelScheduledDeletionResponseObject, error)
+	// Get registry settings
+	// (GET /registry/{registry_ref}/settings)
+	GetRegistrySettings(ctx context.Context, request GetRegistrySettingsRequestObject) (GetRegistrySettingsResponseObject, error)
+	// Update registry settings
+	// (PATCH /registry/{registry_ref}/settings)
+	UpdateRegistrySettings(ctx context.Context, request UpdateRegistrySettingsRequestObject) (UpdateRegistrySettingsResponseObject, error)
+	// List Registry Trash
+	// (GET /registry/{registry_ref}/trash)
+	ListRegistryTrash(ctx context.Context, request ListRegistryTrashRequestObject) (ListRegistryTrashResponseObject, error)
+	// ListWebhooks
+	// (GET /registry/{registry_ref}/webhooks)
+	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
+	// CreateWebhook
+	// (POST /registry/{registry_ref}/webhooks)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	// DeleteWebhook
+	// (DELETE /registry/{registry_ref}/webhooks/{webhook_identifier})
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// GetWebhook
+	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier})
+	GetWebhook(ctx context.Context, request GetWebhookRequestObject) (GetWebhookResponseObject, error)
+	// UpdateWebhook
+	// (PUT /registry/{registry_ref}/webhooks/{webhook_identifier})
+	UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error)
+	// ListWebhookExecutions
+	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions)
+	ListWebhookExecutions(ctx context.Context, request ListWebhookExecutionsRequestObject) (ListWebhookExecutionsResponseObject, error)
+	// GetWebhookExecution
+	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id})
+	GetWebhookExecution(ctx context.Context, request GetWebhookExecutionRequestObject) (GetWebhookExecutionResponseObject, error)
+	// ReplayWebhookExecution
+	// (POST /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/replay)
+	ReplayWebhookExecution(ctx context.Context, request ReplayWebhookExecutionRequestObject) (ReplayWebhookExecutionResponseObject, error)
+	// ReTriggerWebhookExecution
+	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/retrigger)
+	ReTriggerWebhookExecution(ctx context.Context, request ReTriggerWebhookExecutionRequestObject) (ReTriggerWebhookExecutionResponseObject, error)
+	// TestWebhook
+	// (POST /registry/{registry_ref}/webhooks/{webhook_identifier}/test)
+	TestWebhook(ctx context.Context, request TestWebhookRequestObject) (TestWebhookResponseObject, error)
+	// List replication rules
+	// (GET /replication/rules)
+	ListReplicationRules(ctx context.Context, request ListReplicationRulesRequestObject) (ListReplicationRulesResponseObject, error)
+	// Create a replication rule
+	// (POST /replication/rules)
+	CreateReplicationRule(ctx context.Context, request CreateReplicationRuleRequestObject) (CreateReplicationRuleResponseObject, error)
+	// Delete a replication rule
+	// (DELETE /replication/rules/{id})
+	DeleteReplicationRule(ctx context.Context, request DeleteReplicationRuleRequestObject) (DeleteReplicationRuleResponseObject, error)
+	// Get a replication rule
+	// (GET /replication/rules/{id})
+	GetReplicationRule(ctx context.Context, request GetReplicationRuleRequestObject) (GetReplicationRuleResponseObject, error)
+	// Update a replication rule
+	// (PUT /replication/rules/{id})
+	UpdateReplicationRule(ctx context.Context, request UpdateReplicationRuleRequestObject) (UpdateReplicationRuleResponseObject, error)
+	// List migration images
+	// (GET /replication/rules/{id}/migration/images)
+	ListMigrationImages(ctx context.Context, request ListMigrationImagesRequestObject) (ListMigrationImagesResponseObject, error)
+	// Get migration logs for an image
+	// (GET /replication/rules/{id}/migration/images/{image_id}/logs)
+	GetMigrationLogsForImage(ctx context.Context, request GetMigrationLogsForImageRequestObject) (GetMigrationLogsForImageResponseObject, error)
+	// Start migration
+	// (POST /replication/rules/{id}/migration/start)
+	StartMigration(ctx context.Context, request StartMigrationRequestObject) (StartMigrationResponseObject, error)
+	// Stop migration
+	// (POST /replication/rules/{id}/migration/stop)
+	StopMigration(ctx context.Context, request StopMigrationRequestObject) (StopMigrationResponseObject, error)
+	// Get artifact stats
+	// (GET /spaces/{space_ref}/artifact/stats)
+	GetArtifactStatsForSpace(ctx context.Context, request GetArtifactStatsForSpaceRequestObject) (GetArtifactStatsForSpaceResponseObject, error)
+	// List artifacts
+	// (GET /spaces/{space_ref}/artifacts)
+	GetAllArtifacts(ctx context.Context, request GetAllArtifactsRequestObject) (GetAllArtifactsResponseObject, error)
+	// List package denylist
+	// (GET /spaces/{space_ref}/package-denylist)
+	ListPackageDenylistEntries(ctx context.Context, request ListPackageDenylistEntriesRequestObject) (ListPackageDenylistEntriesResponseObject, error)
+	// Add package denylist entry
+	// (POST /spaces/{space_ref}/package-denylist)
+	CreatePackageDenylistEntry(ctx context.Context, request CreatePackageDenylistEntryRequestObject) (CreatePackageDenylistEntryResponseObject, error)
+	// Import advisories into package denylist
+	// (POST /spaces/{space_ref}/package-denylist/import)
+	ImportPackageDenylist(ctx context.Context, request ImportPackageDenylistRequestObject) (ImportPackageDenylistResponseObject, error)
+	// Delete package denylist entry
+	// (DELETE /spaces/{space_ref}/package-denylist/{denylist_entry_id})
+	DeletePackageDenylistEntry(ctx context.Context, request DeletePackageDenylistEntryRequestObject) (DeletePackageDenylistEntryResponseObject, error)
+	// List pipeline artifacts
+	// (GET /spaces/{space_ref}/pipeline-artifacts)
+	ListPipelineArtifacts(ctx context.Context, request ListPipelineArtifactsRequestObject) (ListPipelineArtifactsResponseObject, error)
+	// List registries
+	// (GET /spaces/{space_ref}/registries)
+	GetAllRegistries(ctx context.Context, request GetAllRegistriesRequestObject) (GetAllRegistriesResponseObject, error)
+	// Get registry garbage stats
+	// (GET /spaces/{space_ref}/registries/garbage)
+	GetRegistryGarbageStats(ctx context.Context, request GetRegistryGarbageStatsRequestObject) (GetRegistryGarbageStatsResponseObject, error)
+	// Get upload failure stats
+	// (GET /spaces/{space_ref}/registries/upload-failures)
+	GetUploadFailureStats(ctx context.Context, request GetUploadFailureStatsRequestObject) (GetUploadFailureStatsResponseObject, error)
+	// Get space registry usage
+	// (GET /spaces/{space_ref}/registries/usage)
+	GetSpaceRegistryUsage(ctx context.Context, request GetSpaceRegistryUsageRequestObject) (GetSpaceRegistryUsageResponseObject, error)
+	// Get space registry usage history
+	// (GET /spaces/{space_ref}/registries/usage/history)
+	GetSpaceRegistryUsageHistory(ctx context.Context, request GetSpaceRegistryUsageHistoryRequestObject) (GetSpaceRegistryUsageHistoryResponseObject, error)
+	// Get space registry policy
+	// (GET /spaces/{space_ref}/registry-policy)
+	GetSpaceRegistryPolicy(ctx context.Context, request GetSpaceRegistryPolicyRequestObject) (GetSpaceRegistryPolicyResponseObject, error)
+	// Update space registry policy
+	// (PUT /spaces/{space_ref}/registry-policy)
+	UpdateSpaceRegistryPolicy(ctx context.Context, request UpdateSpaceRegistryPolicyRequestObject) (UpdateSpaceRegistryPolicyResponseObject, error)
+	// List vulnerable versions
+	// (GET /spaces/{space_ref}/vulnerable-versions)
+	ListVulnerableVersions(ctx context.Context, request ListVulnerableVersionsRequestObject) (ListVulnerableVersionsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListRecentArtifacts operation middleware
+func (sh *strictHandler) ListRecentArtifacts(w http.ResponseWriter, r *http.Request, params ListRecentArtifactsParams) {
+	var request ListRecentArtifactsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRecentArtifacts(ctx, request.(ListRecentArtifactsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRecentArtifacts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRecentArtifactsResponseObject); ok {
+		if err := validResponse.VisitListRecentArtifactsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListStarredArtifacts operation middleware
+func (sh *strictHandler) ListStarredArtifacts(w http.ResponseWriter, r *http.Request, params ListStarredArtifactsParams) {
+	var request ListStarredArtifactsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type UpdateWebhookRequestObject struct {
-	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
-	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
-	Body              *UpdateWebhookJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListStarredArtifacts(ctx, request.(ListStarredArtifactsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListStarredArtifacts")
+	}
 
-type UpdateWebhookResponseObject interface {
-	VisitUpdateWebhookResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListStarredArtifactsResponseObject); ok {
+		if err := validResponse.VisitListStarredArtifactsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateWebhook201JSONResponse struct{ WebhookResponseJSONResponse }
+// GetPackageTypeCapabilities operation middleware
+func (sh *strictHandler) GetPackageTypeCapabilities(w http.ResponseWriter, r *http.Request) {
+	var request GetPackageTypeCapabilitiesRequestObject
 
-func (response UpdateWebhook201JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPackageTypeCapabilities(ctx, request.(GetPackageTypeCapabilitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPackageTypeCapabilities")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPackageTypeCapabilitiesResponseObject); ok {
+		if err := validResponse.VisitGetPackageTypeCapabilitiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateWebhook400JSONResponse struct{ BadRequestJSONResponse }
+// CreateRegistry operation middleware
+func (sh *strictHandler) CreateRegistry(w http.ResponseWriter, r *http.Request, params CreateRegistryParams) {
+	var request CreateRegistryRequestObject
 
-func (response UpdateWebhook400JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateRegistryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdateWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateRegistry(ctx, request.(CreateRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateRegistry")
+	}
 
-func (response UpdateWebhook401JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateRegistryResponseObject); ok {
+		if err := validResponse.VisitCreateRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
+// SearchArtifacts operation middleware
+func (sh *strictHandler) SearchArtifacts(w http.ResponseWriter, r *http.Request, params SearchArtifactsParams) {
+	var request SearchArtifactsRequestObject
 
-func (response UpdateWebhook403JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchArtifacts(ctx, request.(SearchArtifactsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchArtifacts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchArtifactsResponseObject); ok {
+		if err := validResponse.VisitSearchArtifactsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateWebhook404JSONResponse struct{ NotFoundJSONResponse }
+// DeleteRegistry operation middleware
+func (sh *strictHandler) DeleteRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request DeleteRegistryRequestObject
 
-func (response UpdateWebhook404JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRegistry(ctx, request.(DeleteRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRegistry")
+	}
 
-type UpdateWebhook500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteRegistryResponseObject); ok {
+		if err := validResponse.VisitDeleteRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response UpdateWebhook500JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetRegistry operation middleware
+func (sh *strictHandler) GetRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request GetRegistryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
 
-type ListWebhookExecutionsRequestObject struct {
-	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
-	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
-	Params            ListWebhookExecutionsParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRegistry(ctx, request.(GetRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRegistry")
+	}
 
-type ListWebhookExecutionsResponseObject interface {
-	VisitListWebhookExecutionsResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListWebhookExecutions200JSONResponse struct {
-	ListWebhooksExecutionResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRegistryResponseObject); ok {
+		if err := validResponse.VisitGetRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ListWebhookExecutions200JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ModifyRegistry operation middleware
+func (sh *strictHandler) ModifyRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ModifyRegistryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
 
-type ListWebhookExecutions400JSONResponse struct{ BadRequestJSONResponse }
+	var body ModifyRegistryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response ListWebhookExecutions400JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ModifyRegistry(ctx, request.(ModifyRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ModifyRegistry")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ModifyRegistryResponseObject); ok {
+		if err := validResponse.VisitModifyRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListWebhookExecutions401JSONResponse struct{ UnauthenticatedJSONResponse }
+// ApplyRegistryConfig operation middleware
+func (sh *strictHandler) ApplyRegistryConfig(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ApplyRegistryConfigParams) {
+	var request ApplyRegistryConfigRequestObject
 
-func (response ListWebhookExecutions401JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.RegistryRef = registryRef
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ApplyRegistryConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ListWebhookExecutions403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApplyRegistryConfig(ctx, request.(ApplyRegistryConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApplyRegistryConfig")
+	}
 
-func (response ListWebhookExecutions403JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApplyRegistryConfigResponseObject); ok {
+		if err := validResponse.VisitApplyRegistryConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListWebhookExecutions404JSONResponse struct{ NotFoundJSONResponse }
+// ListArtifactLabels operation middleware
+func (sh *strictHandler) ListArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListArtifactLabelsParams) {
+	var request ListArtifactLabelsRequestObject
 
-func (response ListWebhookExecutions404JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListArtifactLabels(ctx, request.(ListArtifactLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListArtifactLabels")
+	}
 
-type ListWebhookExecutions500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListArtifactLabelsResponseObject); ok {
+		if err := validResponse.VisitListArtifactLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ListWebhookExecutions500JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetArtifactStatsForRegistry operation middleware
+func (sh *strictHandler) GetArtifactStatsForRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetArtifactStatsForRegistryParams) {
+	var request GetArtifactStatsForRegistryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type GetWebhookExecutionRequestObject struct {
-	RegistryRef        RegistryRefPathParam        `json:"registry_ref"`
-	WebhookIdentifier  WebhookIdentifierPathParam  `json:"webhook_identifier"`
-	WebhookExecutionId WebhookExecutionIdPathParam `json:"webhook_execution_id"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactStatsForRegistry(ctx, request.(GetArtifactStatsForRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactStatsForRegistry")
+	}
 
-type GetWebhookExecutionResponseObject interface {
-	VisitGetWebhookExecutionResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetWebhookExecution200JSONResponse struct {
-	WebhookExecutionResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactStatsForRegistryResponseObject); ok {
+		if err := validResponse.VisitGetArtifactStatsForRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetWebhookExecution200JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DeleteArtifact operation middleware
+func (sh *strictHandler) DeleteArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params DeleteArtifactParams) {
+	var request DeleteArtifactRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type GetWebhookExecution400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteArtifact(ctx, request.(DeleteArtifactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteArtifact")
+	}
 
-func (response GetWebhookExecution400JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteArtifactResponseObject); ok {
+		if err := validResponse.VisitDeleteArtifactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetWebhookExecution401JSONResponse struct{ UnauthenticatedJSONResponse }
+// UpdateArtifactDescription operation middleware
+func (sh *strictHandler) UpdateArtifactDescription(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UpdateArtifactDescriptionParams) {
+	var request UpdateArtifactDescriptionRequestObject
 
-func (response GetWebhookExecution401JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateArtifactDescriptionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetWebhookExecution403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateArtifactDescription(ctx, request.(UpdateArtifactDescriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateArtifactDescription")
+	}
 
-func (response GetWebhookExecution403JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateArtifactDescriptionResponseObject); ok {
+		if err := validResponse.VisitUpdateArtifactDescriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetWebhookExecution404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response GetWebhookExecution404JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// ListArtifactDescriptionHistory operation middleware
+func (sh *strictHandler) ListArtifactDescriptionHistory(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params ListArtifactDescriptionHistoryParams) {
+	var request ListArtifactDescriptionHistoryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type GetWebhookExecution500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListArtifactDescriptionHistory(ctx, request.(ListArtifactDescriptionHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListArtifactDescriptionHistory")
+	}
 
-func (response GetWebhookExecution500JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListArtifactDescriptionHistoryResponseObject); ok {
+		if err := validResponse.VisitListArtifactDescriptionHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ReTriggerWebhookExecutionRequestObject struct {
-	RegistryRef        RegistryRefPathParam        `json:"registry_ref"`
-	WebhookIdentifier  WebhookIdentifierPathParam  `json:"webhook_identifier"`
-	WebhookExecutionId WebhookExecutionIdPathParam `json:"webhook_execution_id"`
-}
+// GetArtifactDownloadStats operation middleware
+func (sh *strictHandler) GetArtifactDownloadStats(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactDownloadStatsParams) {
+	var request GetArtifactDownloadStatsRequestObject
 
-type ReTriggerWebhookExecutionResponseObject interface {
-	VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type ReTriggerWebhookExecution200JSONResponse struct {
-	WebhookExecutionResponseJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactDownloadStats(ctx, request.(GetArtifactDownloadStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactDownloadStats")
+	}
 
-func (response ReTriggerWebhookExecution200JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactDownloadStatsResponseObject); ok {
+		if err := validResponse.VisitGetArtifactDownloadStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ReTriggerWebhookExecution400JSONResponse struct{ BadRequestJSONResponse }
+// UpdateArtifactLabels operation middleware
+func (sh *strictHandler) UpdateArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UpdateArtifactLabelsParams) {
+	var request UpdateArtifactLabelsRequestObject
 
-func (response ReTriggerWebhookExecution400JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateArtifactLabelsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ReTriggerWebhookExecution401JSONResponse struct{ UnauthenticatedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateArtifactLabels(ctx, request.(UpdateArtifactLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateArtifactLabels")
+	}
 
-func (response ReTriggerWebhookExecution401JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateArtifactLabelsResponseObject); ok {
+		if err := validResponse.VisitUpdateArtifactLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ReTriggerWebhookExecution403JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response ReTriggerWebhookExecution403JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// DeleteArtifactLogo operation middleware
+func (sh *strictHandler) DeleteArtifactLogo(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params DeleteArtifactLogoParams) {
+	var request DeleteArtifactLogoRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type ReTriggerWebhookExecution404JSONResponse struct{ NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteArtifactLogo(ctx, request.(DeleteArtifactLogoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteArtifactLogo")
+	}
 
-func (response ReTriggerWebhookExecution404JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteArtifactLogoResponseObject); ok {
+		if err := validResponse.VisitDeleteArtifactLogoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ReTriggerWebhookExecution500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// GetArtifactLogo operation middleware
+func (sh *strictHandler) GetArtifactLogo(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactLogoParams) {
+	var request GetArtifactLogoRequestObject
 
-func (response ReTriggerWebhookExecution500JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactLogo(ctx, request.(GetArtifactLogoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactLogo")
+	}
 
-type ListReplicationRulesRequestObject struct {
-	Params ListReplicationRulesParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListReplicationRulesResponseObject interface {
-	VisitListReplicationRulesResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactLogoResponseObject); ok {
+		if err := validResponse.VisitGetArtifactLogoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListReplicationRules200JSONResponse struct {
-	ListReplicationRuleResponseJSONResponse
-}
+// UploadArtifactLogo operation middleware
+func (sh *strictHandler) UploadArtifactLogo(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UploadArtifactLogoParams) {
+	var request UploadArtifactLogoRequestObject
 
-func (response ListReplicationRules200JSONResponse) VisitListReplicationRulesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Body = r.Body
 
-type ListReplicationRules400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadArtifactLogo(ctx, request.(UploadArtifactLogoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadArtifactLogo")
+	}
 
-func (response ListReplicationRules400JSONResponse) VisitListReplicationRulesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadArtifactLogoResponseObject); ok {
+		if err := validResponse.VisitUploadArtifactLogoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListReplicationRules401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response ListReplicationRules401JSONResponse) VisitListReplicationRulesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// ExportOciArchive operation middleware
+func (sh *strictHandler) ExportOciArchive(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params ExportOciArchiveParams) {
+	var request ExportOciArchiveRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type ListReplicationRules403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportOciArchive(ctx, request.(ExportOciArchiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportOciArchive")
+	}
 
-func (response ListReplicationRules403JSONResponse) VisitListReplicationRulesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportOciArchiveResponseObject); ok {
+		if err := validResponse.VisitExportOciArchiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListReplicationRules404JSONResponse struct{ NotFoundJSONResponse }
+// UnstarArtifact operation middleware
+func (sh *strictHandler) UnstarArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UnstarArtifactParams) {
+	var request UnstarArtifactRequestObject
 
-func (response ListReplicationRules404JSONResponse) VisitListReplicationRulesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UnstarArtifact(ctx, request.(UnstarArtifactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnstarArtifact")
+	}
 
-type ListReplicationRules500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UnstarArtifactResponseObject); ok {
+		if err := validResponse.VisitUnstarArtifactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ListReplicationRules500JSONResponse) VisitListReplicationRulesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// StarArtifact operation middleware
+func (sh *strictHandler) StarArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params StarArtifactParams) {
+	var request StarArtifactRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type CreateReplicationRuleRequestObject struct {
-	Params CreateReplicationRuleParams
-	Body   *CreateReplicationRuleJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StarArtifact(ctx, request.(StarArtifactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StarArtifact")
+	}
 
-type CreateReplicationRuleResponseObject interface {
-	VisitCreateReplicationRuleResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateReplicationRule200JSONResponse struct {
-	ReplicationRuleResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StarArtifactResponseObject); ok {
+		if err := validResponse.VisitStarArtifactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response CreateReplicationRule200JSONResponse) VisitCreateReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetArtifactStats operation middleware
+func (sh *strictHandler) GetArtifactStats(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactStatsParams) {
+	var request GetArtifactStatsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type CreateReplicationRule400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactStats(ctx, request.(GetArtifactStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactStats")
+	}
 
-func (response CreateReplicationRule400JSONResponse) VisitCreateReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactStatsResponseObject); ok {
+		if err := validResponse.VisitGetArtifactStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateReplicationRule401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response CreateReplicationRule401JSONResponse) VisitCreateReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetArtifactSummary operation middleware
+func (sh *strictHandler) GetArtifactSummary(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactSummaryParams) {
+	var request GetArtifactSummaryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type CreateReplicationRule403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactSummary(ctx, request.(GetArtifactSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactSummary")
+	}
 
-func (response CreateReplicationRule403JSONResponse) VisitCreateReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactSummaryResponseObject); ok {
+		if err := validResponse.VisitGetArtifactSummaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateReplicationRule404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response CreateReplicationRule404JSONResponse) VisitCreateReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetOciArtifactTags operation middleware
+func (sh *strictHandler) GetOciArtifactTags(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetOciArtifactTagsParams) {
+	var request GetOciArtifactTagsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type CreateReplicationRule500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOciArtifactTags(ctx, request.(GetOciArtifactTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOciArtifactTags")
+	}
 
-func (response CreateReplicationRule500JSONResponse) VisitCreateReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOciArtifactTagsResponseObject); ok {
+		if err := validResponse.VisitGetOciArtifactTagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteReplicationRuleRequestObject struct {
-	Id string `json:"id"`
-}
+// DeleteArtifactVersion operation middleware
+func (sh *strictHandler) DeleteArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params DeleteArtifactVersionParams) {
+	var request DeleteArtifactVersionRequestObject
 
-type DeleteReplicationRuleResponseObject interface {
-	VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-type DeleteReplicationRule200JSONResponse struct{ SuccessJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteArtifactVersion(ctx, request.(DeleteArtifactVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteArtifactVersion")
+	}
 
-func (response DeleteReplicationRule200JSONResponse) VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteArtifactVersionResponseObject); ok {
+		if err := validResponse.VisitDeleteArtifactVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteReplicationRule400JSONResponse struct{ BadRequestJSONResponse }
+// GetArtifactDetails operation middleware
+func (sh *strictHandler) GetArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactDetailsParams) {
+	var request GetArtifactDetailsRequestObject
+
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactDetails(ctx, request.(GetArtifactDetailsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactDetails")
+	}
 
-func (response DeleteReplicationRule400JSONResponse) VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactDetailsResponseObject); ok {
+		if err := validResponse.VisitGetArtifactDetailsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteReplicationRule401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response DeleteReplicationRule401JSONResponse) VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetDockerArtifactDetails operation middleware
+func (sh *strictHandler) GetDockerArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactDetailsParams) {
+	var request GetDockerArtifactDetailsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-type DeleteReplicationRule403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDockerArtifactDetails(ctx, request.(GetDockerArtifactDetailsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDockerArtifactDetails")
+	}
 
-func (response DeleteReplicationRule403JSONResponse) VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDockerArtifactDetailsResponseObject); ok {
+		if err := validResponse.VisitGetDockerArtifactDetailsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteReplicationRule404JSONResponse struct{ NotFoundJSONResponse }
+// GetDockerArtifactLayers operation middleware
+func (sh *strictHandler) GetDockerArtifactLayers(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactLayersParams) {
+	var request GetDockerArtifactLayersRequestObject
 
-func (response DeleteReplicationRule404JSONResponse) VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDockerArtifactLayers(ctx, request.(GetDockerArtifactLayersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDockerArtifactLayers")
+	}
 
-type DeleteReplicationRule500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDockerArtifactLayersResponseObject); ok {
+		if err := validResponse.VisitGetDockerArtifactLayersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response DeleteReplicationRule500JSONResponse) VisitDeleteReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetDockerArtifactManifest operation middleware
+func (sh *strictHandler) GetDockerArtifactManifest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactManifestParams) {
+	var request GetDockerArtifactManifestRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-type GetReplicationRuleRequestObject struct {
-	Id string `json:"id"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDockerArtifactManifest(ctx, request.(GetDockerArtifactManifestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDockerArtifactManifest")
+	}
 
-type GetReplicationRuleResponseObject interface {
-	VisitGetReplicationRuleResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetReplicationRule200JSONResponse struct {
-	ReplicationRuleResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDockerArtifactManifestResponseObject); ok {
+		if err := validResponse.VisitGetDockerArtifactManifestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetReplicationRule200JSONResponse) VisitGetReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetDockerArtifactManifests operation middleware
+func (sh *strictHandler) GetDockerArtifactManifests(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactManifestsParams) {
+	var request GetDockerArtifactManifestsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-type GetReplicationRule400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDockerArtifactManifests(ctx, request.(GetDockerArtifactManifestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDockerArtifactManifests")
+	}
 
-func (response GetReplicationRule400JSONResponse) VisitGetReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDockerArtifactManifestsResponseObject); ok {
+		if err := validResponse.VisitGetDockerArtifactManifestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetReplicationRule401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response GetReplicationRule401JSONResponse) VisitGetReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetArtifactFile operation middleware
+func (sh *strictHandler) GetArtifactFile(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, fileName FileNamePathParam, params GetArtifactFileParams) {
+	var request GetArtifactFileRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.FileName = fileName
+	request.Params = params
 
-type GetReplicationRule403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactFile(ctx, request.(GetArtifactFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactFile")
+	}
 
-func (response GetReplicationRule403JSONResponse) VisitGetReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactFileResponseObject); ok {
+		if err := validResponse.VisitGetArtifactFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetReplicationRule404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response GetReplicationRule404JSONResponse) VisitGetReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetArtifactFiles operation middleware
+func (sh *strictHandler) GetArtifactFiles(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactFilesParams) {
+	var request GetArtifactFilesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-type GetReplicationRule500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactFiles(ctx, request.(GetArtifactFilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactFiles")
+	}
 
-func (response GetReplicationRule500JSONResponse) VisitGetReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactFilesResponseObject); ok {
+		if err := validResponse.VisitGetArtifactFilesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateReplicationRuleRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateReplicationRuleJSONRequestBody
-}
+// RevokeFirewallApproval operation middleware
+func (sh *strictHandler) RevokeFirewallApproval(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request RevokeFirewallApprovalRequestObject
 
-type UpdateReplicationRuleResponseObject interface {
-	VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-type UpdateReplicationRule200JSONResponse struct {
-	ReplicationRuleResponseJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeFirewallApproval(ctx, request.(RevokeFirewallApprovalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeFirewallApproval")
+	}
 
-func (response UpdateReplicationRule200JSONResponse) VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeFirewallApprovalResponseObject); ok {
+		if err := validResponse.VisitRevokeFirewallApprovalResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateReplicationRule400JSONResponse struct{ BadRequestJSONResponse }
+// ApproveFirewallVersion operation middleware
+func (sh *strictHandler) ApproveFirewallVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request ApproveFirewallVersionRequestObject
 
-func (response UpdateReplicationRule400JSONResponse) VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ApproveFirewallVersionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdateReplicationRule401JSONResponse struct{ UnauthenticatedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveFirewallVersion(ctx, request.(ApproveFirewallVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveFirewallVersion")
+	}
 
-func (response UpdateReplicationRule401JSONResponse) VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApproveFirewallVersionResponseObject); ok {
+		if err := validResponse.VisitApproveFirewallVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateReplicationRule403JSONResponse struct{ UnauthorizedJSONResponse }
+// GetHelmArtifactDependencies operation middleware
+func (sh *strictHandler) GetHelmArtifactDependencies(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request GetHelmArtifactDependenciesRequestObject
 
-func (response UpdateReplicationRule403JSONResponse) VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHelmArtifactDependencies(ctx, request.(GetHelmArtifactDependenciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHelmArtifactDependencies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHelmArtifactDependenciesResponseObject); ok {
+		if err := validResponse.VisitGetHelmArtifactDependenciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateReplicationRule404JSONResponse struct{ NotFoundJSONResponse }
+// GetHelmArtifactDetails operation middleware
+func (sh *strictHandler) GetHelmArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetHelmArtifactDetailsParams) {
+	var request GetHelmArtifactDetailsRequestObject
 
-func (response UpdateReplicationRule404JSONResponse) VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHelmArtifactDetails(ctx, request.(GetHelmArtifactDetailsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHelmArtifactDetails")
+	}
 
-type UpdateReplicationRule500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHelmArtifactDetailsResponseObject); ok {
+		if err := validResponse.VisitGetHelmArtifactDetailsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response UpdateReplicationRule500JSONResponse) VisitUpdateReplicationRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetHelmArtifactManifest operation middleware
+func (sh *strictHandler) GetHelmArtifactManifest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request GetHelmArtifactManifestRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-type ListMigrationImagesRequestObject struct {
-	Id     string `json:"id"`
-	Params ListMigrationImagesParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHelmArtifactManifest(ctx, request.(GetHelmArtifactManifestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHelmArtifactManifest")
+	}
 
-type ListMigrationImagesResponseObject interface {
-	VisitListMigrationImagesResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListMigrationImages200JSONResponse struct {
-	ListMigrationImageResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHelmArtifactManifestResponseObject); ok {
+		if err := validResponse.VisitGetHelmArtifactManifestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ListMigrationImages200JSONResponse) VisitListMigrationImagesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListArtifactVersionMetadataHistory operation middleware
+func (sh *strictHandler) ListArtifactVersionMetadataHistory(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params ListArtifactVersionMetadataHistoryParams) {
+	var request ListArtifactVersionMetadataHistoryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-type ListMigrationImages400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListArtifactVersionMetadataHistory(ctx, request.(ListArtifactVersionMetadataHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListArtifactVersionMetadataHistory")
+	}
 
-func (response ListMigrationImages400JSONResponse) VisitListMigrationImagesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListArtifactVersionMetadataHistoryResponseObject); ok {
+		if err := validResponse.VisitListArtifactVersionMetadataHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListMigrationImages401JSONResponse struct{ UnauthenticatedJSONResponse }
+// GetArtifactVersionProvenance operation middleware
+func (sh *strictHandler) GetArtifactVersionProvenance(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactVersionProvenanceParams) {
+	var request GetArtifactVersionProvenanceRequestObject
 
-func (response ListMigrationImages401JSONResponse) VisitListMigrationImagesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactVersionProvenance(ctx, request.(GetArtifactVersionProvenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactVersionProvenance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactVersionProvenanceResponseObject); ok {
+		if err := validResponse.VisitGetArtifactVersionProvenanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListMigrationImages403JSONResponse struct{ UnauthorizedJSONResponse }
+// UpdateArtifactVersionProvenance operation middleware
+func (sh *strictHandler) UpdateArtifactVersionProvenance(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params UpdateArtifactVersionProvenanceParams) {
+	var request UpdateArtifactVersionProvenanceRequestObject
 
-func (response ListMigrationImages403JSONResponse) VisitListMigrationImagesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateArtifactVersionProvenanceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ListMigrationImages404JSONResponse struct{ NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateArtifactVersionProvenance(ctx, request.(UpdateArtifactVersionProvenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateArtifactVersionProvenance")
+	}
 
-func (response ListMigrationImages404JSONResponse) VisitListMigrationImagesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateArtifactVersionProvenanceResponseObject); ok {
+		if err := validResponse.VisitUpdateArtifactVersionProvenanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListMigrationImages500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// RestoreArtifactVersion operation middleware
+func (sh *strictHandler) RestoreArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request RestoreArtifactVersionRequestObject
 
-func (response ListMigrationImages500JSONResponse) VisitListMigrationImagesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreArtifactVersion(ctx, request.(RestoreArtifactVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreArtifactVersion")
+	}
 
-type GetMigrationLogsForImageRequestObject struct {
-	Id      string `json:"id"`
-	ImageId string `json:"image_id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetMigrationLogsForImageResponseObject interface {
-	VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreArtifactVersionResponseObject); ok {
+		if err := validResponse.VisitRestoreArtifactVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetMigrationLogsForImage200TextplainCharsetUtf8Response struct {
-	PlainTextResponseTextplainCharsetUtf8Response
-}
+// GetArtifactScanStatus operation middleware
+func (sh *strictHandler) GetArtifactScanStatus(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request GetArtifactScanStatusRequestObject
 
-func (response GetMigrationLogsForImage200TextplainCharsetUtf8Response) VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactScanStatus(ctx, request.(GetArtifactScanStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactScanStatus")
 	}
-	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactScanStatusResponseObject); ok {
+		if err := validResponse.VisitGetArtifactScanStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
 }
 
-type GetMigrationLogsForImage400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response GetMigrationLogsForImage400JSONResponse) VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// RequestArtifactScan operation middleware
+func (sh *strictHandler) RequestArtifactScan(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request RequestArtifactScanRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-type GetMigrationLogsForImage401JSONResponse struct{ UnauthenticatedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestArtifactScan(ctx, request.(RequestArtifactScanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestArtifactScan")
+	}
 
-func (response GetMigrationLogsForImage401JSONResponse) VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequestArtifactScanResponseObject); ok {
+		if err := validResponse.VisitRequestArtifactScanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetMigrationLogsForImage403JSONResponse struct{ UnauthorizedJSONResponse }
+// UpdateArtifactScanStatus operation middleware
+func (sh *strictHandler) UpdateArtifactScanStatus(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request UpdateArtifactScanStatusRequestObject
 
-func (response GetMigrationLogsForImage403JSONResponse) VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateArtifactScanStatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetMigrationLogsForImage404JSONResponse struct{ NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateArtifactScanStatus(ctx, request.(UpdateArtifactScanStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateArtifactScanStatus")
+	}
 
-func (response GetMigrationLogsForImage404JSONResponse) VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateArtifactScanStatusResponseObject); ok {
+		if err := validResponse.VisitUpdateArtifactScanStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetMigrationLogsForImage500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// GetArtifactVersionSummary operation middleware
+func (sh *strictHandler) GetArtifactVersionSummary(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactVersionSummaryParams) {
+	var request GetArtifactVersionSummaryRequestObject
 
-func (response GetMigrationLogsForImage500JSONResponse) VisitGetMigrationLogsForImageResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactVersionSummary(ctx, request.(GetArtifactVersionSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactVersionSummary")
+	}
 
-type StartMigrationRequestObject struct {
-	Id string `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type StartMigrationResponseObject interface {
-	VisitStartMigrationResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactVersionSummaryResponseObject); ok {
+		if err := validResponse.VisitGetArtifactVersionSummaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StartMigration200JSONResponse struct{ SuccessJSONResponse }
-
-func (response StartMigration200JSONResponse) VisitStartMigrationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PurgeArtifactVersion operation middleware
+func (sh *strictHandler) PurgeArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request PurgeArtifactVersionRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-type StartMigration400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PurgeArtifactVersion(ctx, request.(PurgeArtifactVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PurgeArtifactVersion")
+	}
 
-func (response StartMigration400JSONResponse) VisitStartMigrationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PurgeArtifactVersionResponseObject); ok {
+		if err := validResponse.VisitPurgeArtifactVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StartMigration401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response StartMigration401JSONResponse) VisitStartMigrationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetAllArtifactVersions operation middleware
+func (sh *strictHandler) GetAllArtifactVersions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetAllArtifactVersionsParams) {
+	var request GetAllArtifactVersionsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type StartMigration403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAllArtifactVersions(ctx, request.(GetAllArtifactVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAllArtifactVersions")
+	}
 
-func (response StartMigration403JSONResponse) VisitStartMigrationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAllArtifactVersionsResponseObject); ok {
+		if err := validResponse.VisitGetAllArtifactVersionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StartMigration404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response StartMigration404JSONResponse) VisitStartMigrationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// SyncArtifactVersions operation middleware
+func (sh *strictHandler) SyncArtifactVersions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params SyncArtifactVersionsParams) {
+	var request SyncArtifactVersionsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type StartMigration500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SyncArtifactVersions(ctx, request.(SyncArtifactVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SyncArtifactVersions")
+	}
 
-func (response StartMigration500JSONResponse) VisitStartMigrationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SyncArtifactVersionsResponseObject); ok {
+		if err := validResponse.VisitSyncArtifactVersionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StopMigrationRequestObject struct {
-	Id string `json:"id"`
-}
+// GetAllArtifactsByRegistry operation middleware
+func (sh *strictHandler) GetAllArtifactsByRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetAllArtifactsByRegistryParams) {
+	var request GetAllArtifactsByRegistryRequestObject
 
-type StopMigrationResponseObject interface {
-	VisitStopMigrationResponse(w http.ResponseWriter) error
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type StopMigration200JSONResponse struct{ SuccessJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAllArtifactsByRegistry(ctx, request.(GetAllArtifactsByRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAllArtifactsByRegistry")
+	}
 
-func (response StopMigration200JSONResponse) VisitStopMigrationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAllArtifactsByRegistryResponseObject); ok {
+		if err := validResponse.VisitGetAllArtifactsByRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StopMigration400JSONResponse struct{ BadRequestJSONResponse }
+// GetArtifactDownloadCounts operation middleware
+func (sh *strictHandler) GetArtifactDownloadCounts(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetArtifactDownloadCountsParams) {
+	var request GetArtifactDownloadCountsRequestObject
 
-func (response StopMigration400JSONResponse) VisitStopMigrationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.RegistryRef = registryRef
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactDownloadCounts(ctx, request.(GetArtifactDownloadCountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactDownloadCounts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactDownloadCountsResponseObject); ok {
+		if err := validResponse.VisitGetArtifactDownloadCountsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StopMigration401JSONResponse struct{ UnauthenticatedJSONResponse }
+// GetClientSetupDetails operation middleware
+func (sh *strictHandler) GetClientSetupDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetClientSetupDetailsParams) {
+	var request GetClientSetupDetailsRequestObject
 
-func (response StopMigration401JSONResponse) VisitStopMigrationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.RegistryRef = registryRef
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientSetupDetails(ctx, request.(GetClientSetupDetailsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientSetupDetails")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type StopMigration403JSONResponse struct{ UnauthorizedJSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientSetupDetailsResponseObject); ok {
+		if err := validResponse.VisitGetClientSetupDetailsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response StopMigration403JSONResponse) VisitStopMigrationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// ListDeletionRequests operation middleware
+func (sh *strictHandler) ListDeletionRequests(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListDeletionRequestsParams) {
+	var request ListDeletionRequestsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type StopMigration404JSONResponse struct{ NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDeletionRequests(ctx, request.(ListDeletionRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDeletionRequests")
+	}
 
-func (response StopMigration404JSONResponse) VisitStopMigrationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDeletionRequestsResponseObject); ok {
+		if err := validResponse.VisitListDeletionRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StopMigration500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// ApproveDeletionRequest operation middleware
+func (sh *strictHandler) ApproveDeletionRequest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, deletionRequestId DeletionRequestIdPathParam) {
+	var request ApproveDeletionRequestRequestObject
 
-func (response StopMigration500JSONResponse) VisitStopMigrationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.RegistryRef = registryRef
+	request.DeletionRequestId = deletionRequestId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveDeletionRequest(ctx, request.(ApproveDeletionRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveDeletionRequest")
+	}
 
-type GetArtifactStatsForSpaceRequestObject struct {
-	SpaceRef SpaceRefPathParam `json:"space_ref"`
-	Params   GetArtifactStatsForSpaceParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetArtifactStatsForSpaceResponseObject interface {
-	VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApproveDeletionRequestResponseObject); ok {
+		if err := validResponse.VisitApproveDeletionRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetArtifactStatsForSpace200JSONResponse struct {
-	ArtifactStatsResponseJSONResponse
-}
+// RejectDeletionRequest operation middleware
+func (sh *strictHandler) RejectDeletionRequest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, deletionRequestId DeletionRequestIdPathParam) {
+	var request RejectDeletionRequestRequestObject
 
-func (response GetArtifactStatsForSpace200JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.RegistryRef = registryRef
+	request.DeletionRequestId = deletionRequestId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body RejectDeletionRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetArtifactStatsForSpace400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RejectDeletionRequest(ctx, request.(RejectDeletionRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RejectDeletionRequest")
+	}
 
-func (response GetArtifactStatsForSpace400JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RejectDeletionRequestResponseObject); ok {
+		if err := validResponse.VisitRejectDeletionRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetArtifactStatsForSpace401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response GetArtifactStatsForSpace401JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// ListFailedUploads operation middleware
+func (sh *strictHandler) ListFailedUploads(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListFailedUploadsParams) {
+	var request ListFailedUploadsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type GetArtifactStatsForSpace403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFailedUploads(ctx, request.(ListFailedUploadsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFailedUploads")
+	}
 
-func (response GetArtifactStatsForSpace403JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListFailedUploadsResponseObject); ok {
+		if err := validResponse.VisitListFailedUploadsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetArtifactStatsForSpace404JSONResponse struct{ NotFoundJSONResponse }
+// DownloadFailedUpload operation middleware
+func (sh *strictHandler) DownloadFailedUpload(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, failedUploadUuid FailedUploadUuidPathParam) {
+	var request DownloadFailedUploadRequestObject
 
-func (response GetArtifactStatsForSpace404JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
+	request.FailedUploadUuid = failedUploadUuid
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DownloadFailedUpload(ctx, request.(DownloadFailedUploadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DownloadFailedUpload")
+	}
 
-type GetArtifactStatsForSpace500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DownloadFailedUploadResponseObject); ok {
+		if err := validResponse.VisitDownloadFailedUploadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetArtifactStatsForSpace500JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// ListFirewallApprovals operation middleware
+func (sh *strictHandler) ListFirewallApprovals(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ListFirewallApprovalsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
 
-type GetAllArtifactsRequestObject struct {
-	SpaceRef SpaceRefPathParam `json:"space_ref"`
-	Params   GetAllArtifactsParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFirewallApprovals(ctx, request.(ListFirewallApprovalsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFirewallApprovals")
+	}
 
-type GetAllArtifactsResponseObject interface {
-	VisitGetAllArtifactsResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetAllArtifacts200JSONResponse struct {
-	ListArtifactResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListFirewallApprovalsResponseObject); ok {
+		if err := validResponse.VisitListFirewallApprovalsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAllArtifacts200JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListRegistryIndexBuilds operation middleware
+func (sh *strictHandler) ListRegistryIndexBuilds(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListRegistryIndexBuildsParams) {
+	var request ListRegistryIndexBuildsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type GetAllArtifacts400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRegistryIndexBuilds(ctx, request.(ListRegistryIndexBuildsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRegistryIndexBuilds")
+	}
 
-func (response GetAllArtifacts400JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRegistryIndexBuildsResponseObject); ok {
+		if err := validResponse.VisitListRegistryIndexBuildsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllArtifacts401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response GetAllArtifacts401JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// RetryRegistryIndexBuild operation middleware
+func (sh *strictHandler) RetryRegistryIndexBuild(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, indexBuildId IndexBuildIdPathParam) {
+	var request RetryRegistryIndexBuildRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.IndexBuildId = indexBuildId
 
-type GetAllArtifacts403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RetryRegistryIndexBuild(ctx, request.(RetryRegistryIndexBuildRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RetryRegistryIndexBuild")
+	}
 
-func (response GetAllArtifacts403JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RetryRegistryIndexBuildResponseObject); ok {
+		if err := validResponse.VisitRetryRegistryIndexBuildResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllArtifacts404JSONResponse struct{ NotFoundJSONResponse }
+// GetRegistryIndexStatus operation middleware
+func (sh *strictHandler) GetRegistryIndexStatus(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request GetRegistryIndexStatusRequestObject
 
-func (response GetAllArtifacts404JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRegistryIndexStatus(ctx, request.(GetRegistryIndexStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRegistryIndexStatus")
+	}
 
-type GetAllArtifacts500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRegistryIndexStatusResponseObject); ok {
+		if err := validResponse.VisitGetRegistryIndexStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAllArtifacts500JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// ListRegistryJobs operation middleware
+func (sh *strictHandler) ListRegistryJobs(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListRegistryJobsParams) {
+	var request ListRegistryJobsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type GetAllRegistriesRequestObject struct {
-	SpaceRef SpaceRefPathParam `json:"space_ref"`
-	Params   GetAllRegistriesParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRegistryJobs(ctx, request.(ListRegistryJobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRegistryJobs")
+	}
 
-type GetAllRegistriesResponseObject interface {
-	VisitGetAllRegistriesResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetAllRegistries200JSONResponse struct {
-	ListRegistryResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRegistryJobsResponseObject); ok {
+		if err := validResponse.VisitListRegistryJobsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAllRegistries200JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetRegistryJob operation middleware
+func (sh *strictHandler) GetRegistryJob(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, jobUuid JobUuidPathParam) {
+	var request GetRegistryJobRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.JobUuid = jobUuid
 
-type GetAllRegistries400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRegistryJob(ctx, request.(GetRegistryJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRegistryJob")
+	}
 
-func (response GetAllRegistries400JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRegistryJobResponseObject); ok {
+		if err := validResponse.VisitGetRegistryJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllRegistries401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response GetAllRegistries401JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// CancelRegistryJob operation middleware
+func (sh *strictHandler) CancelRegistryJob(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, jobUuid JobUuidPathParam) {
+	var request CancelRegistryJobRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.JobUuid = jobUuid
 
-type GetAllRegistries403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelRegistryJob(ctx, request.(CancelRegistryJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelRegistryJob")
+	}
 
-func (response GetAllRegistries403JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelRegistryJobResponseObject); ok {
+		if err := validResponse.VisitCancelRegistryJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllRegistries404JSONResponse struct{ NotFoundJSONResponse }
+// ListNotificationChannels operation middleware
+func (sh *strictHandler) ListNotificationChannels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ListNotificationChannelsRequestObject
 
-func (response GetAllRegistries404JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListNotificationChannels(ctx, request.(ListNotificationChannelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListNotificationChannels")
+	}
 
-type GetAllRegistries500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListNotificationChannelsResponseObject); ok {
+		if err := validResponse.VisitListNotificationChannelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAllRegistries500JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// CreateNotificationChannel operation middleware
+func (sh *strictHandler) CreateNotificationChannel(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request CreateNotificationChannelRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Create Registry.
-	// (POST /registry)
-	CreateRegistry(ctx context.Context, request CreateRegistryRequestObject) (CreateRegistryResponseObject, error)
-	// Delete a Registry
-	// (DELETE /registry/{registry_ref})
-	DeleteRegistry(ctx context.Context, request DeleteRegistryRequestObject) (DeleteRegistryResponseObject, error)
-	// Returns Registry Details
-	// (GET /registry/{registry_ref})
-	GetRegistry(ctx context.Context, request GetRegistryRequestObject) (GetRegistryResponseObject, error)
-	// Updates a Registry
-	// (PUT /registry/{registry_ref})
-	ModifyRegistry(ctx context.Context, request ModifyRegistryRequestObject) (ModifyRegistryResponseObject, error)
-	// List Artifact Labels
-	// (GET /registry/{registry_ref}/artifact/labels)
-	ListArtifactLabels(ctx context.Context, request ListArtifactLabelsRequestObject) (ListArtifactLabelsResponseObject, error)
-	// Get Artifact Stats
-	// (GET /registry/{registry_ref}/artifact/stats)
-	GetArtifactStatsForRegistry(ctx context.Context, request GetArtifactStatsForRegistryRequestObject) (GetArtifactStatsForRegistryResponseObject, error)
-	// Delete Artifact
-	// (DELETE /registry/{registry_ref}/artifact/{artifact})
-	DeleteArtifact(ctx context.Context, request DeleteArtifactRequestObject) (DeleteArtifactResponseObject, error)
-	// Update Artifact Labels
-	// (PUT /registry/{registry_ref}/artifact/{artifact}/labels)
-	UpdateArtifactLabels(ctx context.Context, request UpdateArtifactLabelsRequestObject) (UpdateArtifactLabelsResponseObject, error)
-	// Get Artifact Stats
-	// (GET /registry/{registry_ref}/artifact/{artifact}/stats)
-	GetArtifactStats(ctx context.Context, request GetArtifactStatsRequestObject) (GetArtifactStatsResponseObject, error)
-	// Get Artifact Summary
-	// (GET /registry/{registry_ref}/artifact/{artifact}/summary)
-	GetArtifactSummary(ctx context.Context, request GetArtifactSummaryRequestObject) (GetArtifactSummaryResponseObject, error)
-	// List OCI Artifact tags
-	// (GET /registry/{registry_ref}/artifact/{artifact}/tags)
-	GetOciArtifactTags(ctx context.Context, request GetOciArtifactTagsRequestObject) (GetOciArtifactTagsResponseObject, error)
-	// Delete an Artifact Version
-	// (DELETE /registry/{registry_ref}/artifact/{artifact}/version/{version})
-	DeleteArtifactVersion(ctx context.Context, request DeleteArtifactVersionRequestObject) (DeleteArtifactVersionResponseObject, error)
-	// Describe Artifact Details
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/details)
-	GetArtifactDetails(ctx context.Context, request GetArtifactDetailsRequestObject) (GetArtifactDetailsResponseObject, error)
-	// Describe Docker Artifact Detail
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/details)
-	GetDockerArtifactDetails(ctx context.Context, request GetDockerArtifactDetailsRequestObject) (GetDockerArtifactDetailsResponseObject, error)
-	// Describe Docker Artifact Layers
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/layers)
-	GetDockerArtifactLayers(ctx context.Context, request GetDockerArtifactLayersRequestObject) (GetDockerArtifactLayersResponseObject, error)
-	// Describe Docker Artifact Manifest
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifest)
-	GetDockerArtifactManifest(ctx context.Context, request GetDockerArtifactManifestRequestObject) (GetDockerArtifactManifestResponseObject, error)
-	// Describe Docker Artifact Manifests
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifests)
-	GetDockerArtifactManifests(ctx context.Context, request GetDockerArtifactManifestsRequestObject) (GetDockerArtifactManifestsResponseObject, error)
-	// Get Artifact file
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/file/{file_name})
-	GetArtifactFile(ctx context.Context, request GetArtifactFileRequestObject) (GetArtifactFileResponseObject, error)
-	// Describe Artifact files
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/files)
-	GetArtifactFiles(ctx context.Context, request GetArtifactFilesRequestObject) (GetArtifactFilesResponseObject, error)
-	// Describe Helm Artifact Detail
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/details)
-	GetHelmArtifactDetails(ctx context.Context, request GetHelmArtifactDetailsRequestObject) (GetHelmArtifactDetailsResponseObject, error)
-	// Describe Helm Artifact Manifest
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/manifest)
-	GetHelmArtifactManifest(ctx context.Context, request GetHelmArtifactManifestRequestObject) (GetHelmArtifactManifestResponseObject, error)
-	// Get Artifact Version Summary
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/summary)
-	GetArtifactVersionSummary(ctx context.Context, request GetArtifactVersionSummaryRequestObject) (GetArtifactVersionSummaryResponseObject, error)
-	// List Artifact Versions
-	// (GET /registry/{registry_ref}/artifact/{artifact}/versions)
-	GetAllArtifactVersions(ctx context.Context, request GetAllArtifactVersionsRequestObject) (GetAllArtifactVersionsResponseObject, error)
-	// List Artifacts for Registry
-	// (GET /registry/{registry_ref}/artifacts)
-	GetAllArtifactsByRegistry(ctx context.Context, request GetAllArtifactsByRegistryRequestObject) (GetAllArtifactsByRegistryResponseObject, error)
-	// Returns CLI Client Setup Details
-	// (GET /registry/{registry_ref}/client-setup-details)
-	GetClientSetupDetails(ctx context.Context, request GetClientSetupDetailsRequestObject) (GetClientSetupDetailsResponseObject, error)
-	// deleteQuarantineFilePath
-	// (DELETE /registry/{registry_ref}/quarantine)
-	DeleteQuarantineFilePath(ctx context.Context, request DeleteQuarantineFilePathRequestObject) (DeleteQuarantineFilePathResponseObject, error)
-	// quarantineFilePath
-	// (PUT /registry/{registry_ref}/quarantine)
-	QuarantineFilePath(ctx context.Context, request QuarantineFilePathRequestObject) (QuarantineFilePathResponseObject, error)
-	// ListWebhooks
-	// (GET /registry/{registry_ref}/webhooks)
-	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
-	// CreateWebhook
-	// (POST /registry/{registry_ref}/webhooks)
-	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
-	// DeleteWebhook
-	// (DELETE /registry/{registry_ref}/webhooks/{webhook_identifier})
-	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
-	// GetWebhook
-	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier})
-	GetWebhook(ctx context.Context, request GetWebhookRequestObject) (GetWebhookResponseObject, error)
-	// UpdateWebhook
-	// (PUT /registry/{registry_ref}/webhooks/{webhook_identifier})
-	UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error)
-	// ListWebhookExecutions
-	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions)
-	ListWebhookExecutions(ctx context.Context, request ListWebhookExecutionsRequestObject) (ListWebhookExecutionsResponseObject, error)
-	// GetWebhookExecution
-	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id})
-	GetWebhookExecution(ctx context.Context, request GetWebhookExecutionRequestObject) (GetWebhookExecutionResponseObject, error)
-	// ReTriggerWebhookExecution
-	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/retrigger)
-	ReTriggerWebhookExecution(ctx context.Context, request ReTriggerWebhookExecutionRequestObject) (ReTriggerWebhookExecutionResponseObject, error)
-	// List replication rules
-	// (GET /replication/rules)
-	ListReplicationRules(ctx context.Context, request ListReplicationRulesRequestObject) (ListReplicationRulesResponseObject, error)
-	// Create a replication rule
-	// (POST /replication/rules)
-	CreateReplicationRule(ctx context.Context, request CreateReplicationRuleRequestObject) (CreateReplicationRuleResponseObject, error)
-	// Delete a replication rule
-	// (DELETE /replication/rules/{id})
-	DeleteReplicationRule(ctx context.Context, request DeleteReplicationRuleRequestObject) (DeleteReplicationRuleResponseObject, error)
-	// Get a replication rule
-	// (GET /replication/rules/{id})
-	GetReplicationRule(ctx context.Context, request GetReplicationRuleRequestObject) (GetReplicationRuleResponseObject, error)
-	// Update a replication rule
-	// (PUT /replication/rules/{id})
-	UpdateReplicationRule(ctx context.Context, request UpdateReplicationRuleRequestObject) (UpdateReplicationRuleResponseObject, error)
-	// List migration images
-	// (GET /replication/rules/{id}/migration/images)
-	ListMigrationImages(ctx context.Context, request ListMigrationImagesRequestObject) (ListMigrationImagesResponseObject, error)
-	// Get migration logs for an image
-	// (GET /replication/rules/{id}/migration/images/{image_id}/logs)
-	GetMigrationLogsForImage(ctx context.Context, request GetMigrationLogsForImageRequestObject) (GetMigrationLogsForImageResponseObject, error)
-	// Start migration
-	// (POST /replication/rules/{id}/migration/start)
-	StartMigration(ctx context.Context, request StartMigrationRequestObject) (StartMigrationResponseObject, error)
-	// Stop migration
-	// (POST /replication/rules/{id}/migration/stop)
-	StopMigration(ctx context.Context, request StopMigrationRequestObject) (StopMigrationResponseObject, error)
-	// Get artifact stats
-	// (GET /spaces/{space_ref}/artifact/stats)
-	GetArtifactStatsForSpace(ctx context.Context, request GetArtifactStatsForSpaceRequestObject) (GetArtifactStatsForSpaceResponseObject, error)
-	// List artifacts
-	// (GET /spaces/{space_ref}/artifacts)
-	GetAllArtifacts(ctx context.Context, request GetAllArtifactsRequestObject) (GetAllArtifactsResponseObject, error)
-	// List registries
-	// (GET /spaces/{space_ref}/registries)
-	GetAllRegistries(ctx context.Context, request GetAllRegistriesRequestObject) (GetAllRegistriesResponseObject, error)
-}
+	var body CreateNotificationChannelJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateNotificationChannel(ctx, request.(CreateNotificationChannelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateNotificationChannel")
+	}
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateNotificationChannelResponseObject); ok {
+		if err := validResponse.VisitCreateNotificationChannelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+// DeleteNotificationChannel operation middleware
+func (sh *strictHandler) DeleteNotificationChannel(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, channelIdentifier ChannelIdentifierPathParam) {
+	var request DeleteNotificationChannelRequestObject
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	request.RegistryRef = registryRef
+	request.ChannelIdentifier = channelIdentifier
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteNotificationChannel(ctx, request.(DeleteNotificationChannelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteNotificationChannel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteNotificationChannelResponseObject); ok {
+		if err := validResponse.VisitDeleteNotificationChannelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// CreateRegistry operation middleware
-func (sh *strictHandler) CreateRegistry(w http.ResponseWriter, r *http.Request, params CreateRegistryParams) {
-	var request CreateRegistryRequestObject
+// UpdateNotificationChannel operation middleware
+func (sh *strictHandler) UpdateNotificationChannel(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, channelIdentifier ChannelIdentifierPathParam) {
+	var request UpdateNotificationChannelRequestObject
 
-	request.Params = params
+	request.RegistryRef = registryRef
+	request.ChannelIdentifier = channelIdentifier
 
-	var body CreateRegistryJSONRequestBody
+	var body UpdateNotificationChannelJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6539,18 +17337,18 @@ func (sh *strictHandler) CreateRegistry(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateRegistry(ctx, request.(CreateRegistryRequestObject))
+		return sh.ssi.UpdateNotificationChannel(ctx, request.(UpdateNotificationChannelRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateRegistry")
+		handler = middleware(handler, "UpdateNotificationChannel")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateRegistryResponseObject); ok {
-		if err := validResponse.VisitCreateRegistryResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateNotificationChannelResponseObject); ok {
+		if err := validResponse.VisitUpdateNotificationChannelResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6558,25 +17356,28 @@ func (sh *strictHandler) CreateRegistry(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// DeleteRegistry operation middleware
-func (sh *strictHandler) DeleteRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
-	var request DeleteRegistryRequestObject
+// ImportOciArchive operation middleware
+func (sh *strictHandler) ImportOciArchive(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ImportOciArchiveParams) {
+	var request ImportOciArchiveRequestObject
 
 	request.RegistryRef = registryRef
+	request.Params = params
+
+	request.Body = r.Body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteRegistry(ctx, request.(DeleteRegistryRequestObject))
+		return sh.ssi.ImportOciArchive(ctx, request.(ImportOciArchiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteRegistry")
+		handler = middleware(handler, "ImportOciArchive")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteRegistryResponseObject); ok {
-		if err := validResponse.VisitDeleteRegistryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportOciArchiveResponseObject); ok {
+		if err := validResponse.VisitImportOciArchiveResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6584,25 +17385,25 @@ func (sh *strictHandler) DeleteRegistry(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetRegistry operation middleware
-func (sh *strictHandler) GetRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
-	var request GetRegistryRequestObject
+// ListPackageDenylistOverrides operation middleware
+func (sh *strictHandler) ListPackageDenylistOverrides(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ListPackageDenylistOverridesRequestObject
 
 	request.RegistryRef = registryRef
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRegistry(ctx, request.(GetRegistryRequestObject))
+		return sh.ssi.ListPackageDenylistOverrides(ctx, request.(ListPackageDenylistOverridesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRegistry")
+		handler = middleware(handler, "ListPackageDenylistOverrides")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRegistryResponseObject); ok {
-		if err := validResponse.VisitGetRegistryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPackageDenylistOverridesResponseObject); ok {
+		if err := validResponse.VisitListPackageDenylistOverridesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6610,32 +17411,26 @@ func (sh *strictHandler) GetRegistry(w http.ResponseWriter, r *http.Request, reg
 	}
 }
 
-// ModifyRegistry operation middleware
-func (sh *strictHandler) ModifyRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
-	var request ModifyRegistryRequestObject
+// RemovePackageDenylistOverride operation middleware
+func (sh *strictHandler) RemovePackageDenylistOverride(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, denylistEntryId DenylistEntryIdPathParam) {
+	var request RemovePackageDenylistOverrideRequestObject
 
 	request.RegistryRef = registryRef
-
-	var body ModifyRegistryJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.DenylistEntryId = denylistEntryId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ModifyRegistry(ctx, request.(ModifyRegistryRequestObject))
+		return sh.ssi.RemovePackageDenylistOverride(ctx, request.(RemovePackageDenylistOverrideRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ModifyRegistry")
+		handler = middleware(handler, "RemovePackageDenylistOverride")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ModifyRegistryResponseObject); ok {
-		if err := validResponse.VisitModifyRegistryResponse(w); err != nil {
+	} else if validResponse, ok := response.(RemovePackageDenylistOverrideResponseObject); ok {
+		if err := validResponse.VisitRemovePackageDenylistOverrideResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6643,26 +17438,33 @@ func (sh *strictHandler) ModifyRegistry(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ListArtifactLabels operation middleware
-func (sh *strictHandler) ListArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListArtifactLabelsParams) {
-	var request ListArtifactLabelsRequestObject
+// OverridePackageDenylistEntry operation middleware
+func (sh *strictHandler) OverridePackageDenylistEntry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, denylistEntryId DenylistEntryIdPathParam) {
+	var request OverridePackageDenylistEntryRequestObject
 
 	request.RegistryRef = registryRef
-	request.Params = params
+	request.DenylistEntryId = denylistEntryId
+
+	var body OverridePackageDenylistEntryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListArtifactLabels(ctx, request.(ListArtifactLabelsRequestObject))
+		return sh.ssi.OverridePackageDenylistEntry(ctx, request.(OverridePackageDenylistEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListArtifactLabels")
+		handler = middleware(handler, "OverridePackageDenylistEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListArtifactLabelsResponseObject); ok {
-		if err := validResponse.VisitListArtifactLabelsResponse(w); err != nil {
+	} else if validResponse, ok := response.(OverridePackageDenylistEntryResponseObject); ok {
+		if err := validResponse.VisitOverridePackageDenylistEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6670,26 +17472,25 @@ func (sh *strictHandler) ListArtifactLabels(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetArtifactStatsForRegistry operation middleware
-func (sh *strictHandler) GetArtifactStatsForRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetArtifactStatsForRegistryParams) {
-	var request GetArtifactStatsForRegistryRequestObject
+// GetEffectiveRegistryPolicy operation middleware
+func (sh *strictHandler) GetEffectiveRegistryPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request GetEffectiveRegistryPolicyRequestObject
 
 	request.RegistryRef = registryRef
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactStatsForRegistry(ctx, request.(GetArtifactStatsForRegistryRequestObject))
+		return sh.ssi.GetEffectiveRegistryPolicy(ctx, request.(GetEffectiveRegistryPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactStatsForRegistry")
+		handler = middleware(handler, "GetEffectiveRegistryPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactStatsForRegistryResponseObject); ok {
-		if err := validResponse.VisitGetArtifactStatsForRegistryResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetEffectiveRegistryPolicyResponseObject); ok {
+		if err := validResponse.VisitGetEffectiveRegistryPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6697,27 +17498,26 @@ func (sh *strictHandler) GetArtifactStatsForRegistry(w http.ResponseWriter, r *h
 	}
 }
 
-// DeleteArtifact operation middleware
-func (sh *strictHandler) DeleteArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params DeleteArtifactParams) {
-	var request DeleteArtifactRequestObject
+// DeleteQuarantineFilePath operation middleware
+func (sh *strictHandler) DeleteQuarantineFilePath(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params DeleteQuarantineFilePathParams) {
+	var request DeleteQuarantineFilePathRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteArtifact(ctx, request.(DeleteArtifactRequestObject))
+		return sh.ssi.DeleteQuarantineFilePath(ctx, request.(DeleteQuarantineFilePathRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteArtifact")
+		handler = middleware(handler, "DeleteQuarantineFilePath")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteArtifactResponseObject); ok {
-		if err := validResponse.VisitDeleteArtifactResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteQuarantineFilePathResponseObject); ok {
+		if err := validResponse.VisitDeleteQuarantineFilePathResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6725,15 +17525,13 @@ func (sh *strictHandler) DeleteArtifact(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// UpdateArtifactLabels operation middleware
-func (sh *strictHandler) UpdateArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params UpdateArtifactLabelsParams) {
-	var request UpdateArtifactLabelsRequestObject
+// QuarantineFilePath operation middleware
+func (sh *strictHandler) QuarantineFilePath(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request QuarantineFilePathRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Params = params
 
-	var body UpdateArtifactLabelsJSONRequestBody
+	var body QuarantineFilePathJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6741,18 +17539,18 @@ func (sh *strictHandler) UpdateArtifactLabels(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateArtifactLabels(ctx, request.(UpdateArtifactLabelsRequestObject))
+		return sh.ssi.QuarantineFilePath(ctx, request.(QuarantineFilePathRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateArtifactLabels")
+		handler = middleware(handler, "QuarantineFilePath")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateArtifactLabelsResponseObject); ok {
-		if err := validResponse.VisitUpdateArtifactLabelsResponse(w); err != nil {
+	} else if validResponse, ok := response.(QuarantineFilePathResponseObject); ok {
+		if err := validResponse.VisitQuarantineFilePathResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6760,27 +17558,26 @@ func (sh *strictHandler) UpdateArtifactLabels(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetArtifactStats operation middleware
-func (sh *strictHandler) GetArtifactStats(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactStatsParams) {
-	var request GetArtifactStatsRequestObject
+// ListScheduledDeletions operation middleware
+func (sh *strictHandler) ListScheduledDeletions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListScheduledDeletionsParams) {
+	var request ListScheduledDeletionsRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactStats(ctx, request.(GetArtifactStatsRequestObject))
+		return sh.ssi.ListScheduledDeletions(ctx, request.(ListScheduledDeletionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactStats")
+		handler = middleware(handler, "ListScheduledDeletions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactStatsResponseObject); ok {
-		if err := validResponse.VisitGetArtifactStatsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListScheduledDeletionsResponseObject); ok {
+		if err := validResponse.VisitListScheduledDeletionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6788,27 +17585,32 @@ func (sh *strictHandler) GetArtifactStats(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetArtifactSummary operation middleware
-func (sh *strictHandler) GetArtifactSummary(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactSummaryParams) {
-	var request GetArtifactSummaryRequestObject
+// ScheduleDeletion operation middleware
+func (sh *strictHandler) ScheduleDeletion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ScheduleDeletionRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Params = params
+
+	var body ScheduleDeletionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactSummary(ctx, request.(GetArtifactSummaryRequestObject))
+		return sh.ssi.ScheduleDeletion(ctx, request.(ScheduleDeletionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactSummary")
+		handler = middleware(handler, "ScheduleDeletion")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactSummaryResponseObject); ok {
-		if err := validResponse.VisitGetArtifactSummaryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ScheduleDeletionResponseObject); ok {
+		if err := validResponse.VisitScheduleDeletionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6816,27 +17618,26 @@ func (sh *strictHandler) GetArtifactSummary(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetOciArtifactTags operation middleware
-func (sh *strictHandler) GetOciArtifactTags(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetOciArtifactTagsParams) {
-	var request GetOciArtifactTagsRequestObject
+// CancelScheduledDeletion operation middleware
+func (sh *strictHandler) CancelScheduledDeletion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, scheduledDeletionId ScheduledDeletionIdPathParam) {
+	var request CancelScheduledDeletionRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Params = params
+	request.ScheduledDeletionId = scheduledDeletionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetOciArtifactTags(ctx, request.(GetOciArtifactTagsRequestObject))
+		return sh.ssi.CancelScheduledDeletion(ctx, request.(CancelScheduledDeletionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetOciArtifactTags")
+		handler = middleware(handler, "CancelScheduledDeletion")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetOciArtifactTagsResponseObject); ok {
-		if err := validResponse.VisitGetOciArtifactTagsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CancelScheduledDeletionResponseObject); ok {
+		if err := validResponse.VisitCancelScheduledDeletionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6844,28 +17645,25 @@ func (sh *strictHandler) GetOciArtifactTags(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// DeleteArtifactVersion operation middleware
-func (sh *strictHandler) DeleteArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params DeleteArtifactVersionParams) {
-	var request DeleteArtifactVersionRequestObject
+// GetRegistrySettings operation middleware
+func (sh *strictHandler) GetRegistrySettings(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request GetRegistrySettingsRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteArtifactVersion(ctx, request.(DeleteArtifactVersionRequestObject))
+		return sh.ssi.GetRegistrySettings(ctx, request.(GetRegistrySettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteArtifactVersion")
+		handler = middleware(handler, "GetRegistrySettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteArtifactVersionResponseObject); ok {
-		if err := validResponse.VisitDeleteArtifactVersionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRegistrySettingsResponseObject); ok {
+		if err := validResponse.VisitGetRegistrySettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6873,28 +17671,32 @@ func (sh *strictHandler) DeleteArtifactVersion(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// GetArtifactDetails operation middleware
-func (sh *strictHandler) GetArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactDetailsParams) {
-	var request GetArtifactDetailsRequestObject
+// UpdateRegistrySettings operation middleware
+func (sh *strictHandler) UpdateRegistrySettings(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request UpdateRegistrySettingsRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
-	request.Params = params
+
+	var body UpdateRegistrySettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactDetails(ctx, request.(GetArtifactDetailsRequestObject))
+		return sh.ssi.UpdateRegistrySettings(ctx, request.(UpdateRegistrySettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactDetails")
+		handler = middleware(handler, "UpdateRegistrySettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactDetailsResponseObject); ok {
-		if err := validResponse.VisitGetArtifactDetailsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateRegistrySettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateRegistrySettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6902,28 +17704,25 @@ func (sh *strictHandler) GetArtifactDetails(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetDockerArtifactDetails operation middleware
-func (sh *strictHandler) GetDockerArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactDetailsParams) {
-	var request GetDockerArtifactDetailsRequestObject
+// ListRegistryTrash operation middleware
+func (sh *strictHandler) ListRegistryTrash(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ListRegistryTrashRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDockerArtifactDetails(ctx, request.(GetDockerArtifactDetailsRequestObject))
+		return sh.ssi.ListRegistryTrash(ctx, request.(ListRegistryTrashRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDockerArtifactDetails")
+		handler = middleware(handler, "ListRegistryTrash")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDockerArtifactDetailsResponseObject); ok {
-		if err := validResponse.VisitGetDockerArtifactDetailsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListRegistryTrashResponseObject); ok {
+		if err := validResponse.VisitListRegistryTrashResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6931,28 +17730,26 @@ func (sh *strictHandler) GetDockerArtifactDetails(w http.ResponseWriter, r *http
 	}
 }
 
-// GetDockerArtifactLayers operation middleware
-func (sh *strictHandler) GetDockerArtifactLayers(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactLayersParams) {
-	var request GetDockerArtifactLayersRequestObject
+// ListWebhooks operation middleware
+func (sh *strictHandler) ListWebhooks(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListWebhooksParams) {
+	var request ListWebhooksRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDockerArtifactLayers(ctx, request.(GetDockerArtifactLayersRequestObject))
+		return sh.ssi.ListWebhooks(ctx, request.(ListWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDockerArtifactLayers")
+		handler = middleware(handler, "ListWebhooks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDockerArtifactLayersResponseObject); ok {
-		if err := validResponse.VisitGetDockerArtifactLayersResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWebhooksResponseObject); ok {
+		if err := validResponse.VisitListWebhooksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6960,28 +17757,59 @@ func (sh *strictHandler) GetDockerArtifactLayers(w http.ResponseWriter, r *http.
 	}
 }
 
-// GetDockerArtifactManifest operation middleware
-func (sh *strictHandler) GetDockerArtifactManifest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactManifestParams) {
-	var request GetDockerArtifactManifestRequestObject
+// CreateWebhook operation middleware
+func (sh *strictHandler) CreateWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request CreateWebhookRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
-	request.Params = params
+
+	var body CreateWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
+	var request DeleteWebhookRequestObject
+
+	request.RegistryRef = registryRef
+	request.WebhookIdentifier = webhookIdentifier
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDockerArtifactManifest(ctx, request.(GetDockerArtifactManifestRequestObject))
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDockerArtifactManifest")
+		handler = middleware(handler, "DeleteWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDockerArtifactManifestResponseObject); ok {
-		if err := validResponse.VisitGetDockerArtifactManifestResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6989,28 +17817,26 @@ func (sh *strictHandler) GetDockerArtifactManifest(w http.ResponseWriter, r *htt
 	}
 }
 
-// GetDockerArtifactManifests operation middleware
-func (sh *strictHandler) GetDockerArtifactManifests(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactManifestsParams) {
-	var request GetDockerArtifactManifestsRequestObject
+// GetWebhook operation middleware
+func (sh *strictHandler) GetWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
+	var request GetWebhookRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
-	request.Params = params
+	request.WebhookIdentifier = webhookIdentifier
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDockerArtifactManifests(ctx, request.(GetDockerArtifactManifestsRequestObject))
+		return sh.ssi.GetWebhook(ctx, request.(GetWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDockerArtifactManifests")
+		handler = middleware(handler, "GetWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDockerArtifactManifestsResponseObject); ok {
-		if err := validResponse.VisitGetDockerArtifactManifestsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhookResponseObject); ok {
+		if err := validResponse.VisitGetWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7018,29 +17844,33 @@ func (sh *strictHandler) GetDockerArtifactManifests(w http.ResponseWriter, r *ht
 	}
 }
 
-// GetArtifactFile operation middleware
-func (sh *strictHandler) GetArtifactFile(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, fileName FileNamePathParam, params GetArtifactFileParams) {
-	var request GetArtifactFileRequestObject
+// UpdateWebhook operation middleware
+func (sh *strictHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
+	var request UpdateWebhookRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
-	request.FileName = fileName
-	request.Params = params
+	request.WebhookIdentifier = webhookIdentifier
+
+	var body UpdateWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactFile(ctx, request.(GetArtifactFileRequestObject))
+		return sh.ssi.UpdateWebhook(ctx, request.(UpdateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactFile")
+		handler = middleware(handler, "UpdateWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactFileResponseObject); ok {
-		if err := validResponse.VisitGetArtifactFileResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateWebhookResponseObject); ok {
+		if err := validResponse.VisitUpdateWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7048,28 +17878,27 @@ func (sh *strictHandler) GetArtifactFile(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetArtifactFiles operation middleware
-func (sh *strictHandler) GetArtifactFiles(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactFilesParams) {
-	var request GetArtifactFilesRequestObject
+// ListWebhookExecutions operation middleware
+func (sh *strictHandler) ListWebhookExecutions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, params ListWebhookExecutionsParams) {
+	var request ListWebhookExecutionsRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
+	request.WebhookIdentifier = webhookIdentifier
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactFiles(ctx, request.(GetArtifactFilesRequestObject))
+		return sh.ssi.ListWebhookExecutions(ctx, request.(ListWebhookExecutionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactFiles")
+		handler = middleware(handler, "ListWebhookExecutions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactFilesResponseObject); ok {
-		if err := validResponse.VisitGetArtifactFilesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWebhookExecutionsResponseObject); ok {
+		if err := validResponse.VisitListWebhookExecutionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7077,28 +17906,27 @@ func (sh *strictHandler) GetArtifactFiles(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetHelmArtifactDetails operation middleware
-func (sh *strictHandler) GetHelmArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetHelmArtifactDetailsParams) {
-	var request GetHelmArtifactDetailsRequestObject
+// GetWebhookExecution operation middleware
+func (sh *strictHandler) GetWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam) {
+	var request GetWebhookExecutionRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
-	request.Params = params
+	request.WebhookIdentifier = webhookIdentifier
+	request.WebhookExecutionId = webhookExecutionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHelmArtifactDetails(ctx, request.(GetHelmArtifactDetailsRequestObject))
+		return sh.ssi.GetWebhookExecution(ctx, request.(GetWebhookExecutionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHelmArtifactDetails")
+		handler = middleware(handler, "GetWebhookExecution")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHelmArtifactDetailsResponseObject); ok {
-		if err := validResponse.VisitGetHelmArtifactDetailsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhookExecutionResponseObject); ok {
+		if err := validResponse.VisitGetWebhookExecutionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7106,27 +17934,34 @@ func (sh *strictHandler) GetHelmArtifactDetails(w http.ResponseWriter, r *http.R
 	}
 }
 
-// GetHelmArtifactManifest operation middleware
-func (sh *strictHandler) GetHelmArtifactManifest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
-	var request GetHelmArtifactManifestRequestObject
+// ReplayWebhookExecution operation middleware
+func (sh *strictHandler) ReplayWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam) {
+	var request ReplayWebhookExecutionRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
+	request.WebhookIdentifier = webhookIdentifier
+	request.WebhookExecutionId = webhookExecutionId
+
+	var body ReplayWebhookExecutionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHelmArtifactManifest(ctx, request.(GetHelmArtifactManifestRequestObject))
+		return sh.ssi.ReplayWebhookExecution(ctx, request.(ReplayWebhookExecutionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHelmArtifactManifest")
+		handler = middleware(handler, "ReplayWebhookExecution")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHelmArtifactManifestResponseObject); ok {
-		if err := validResponse.VisitGetHelmArtifactManifestResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReplayWebhookExecutionResponseObject); ok {
+		if err := validResponse.VisitReplayWebhookExecutionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7134,28 +17969,27 @@ func (sh *strictHandler) GetHelmArtifactManifest(w http.ResponseWriter, r *http.
 	}
 }
 
-// GetArtifactVersionSummary operation middleware
-func (sh *strictHandler) GetArtifactVersionSummary(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactVersionSummaryParams) {
-	var request GetArtifactVersionSummaryRequestObject
+// ReTriggerWebhookExecution operation middleware
+func (sh *strictHandler) ReTriggerWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam) {
+	var request ReTriggerWebhookExecutionRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
-	request.Params = params
+	request.WebhookIdentifier = webhookIdentifier
+	request.WebhookExecutionId = webhookExecutionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactVersionSummary(ctx, request.(GetArtifactVersionSummaryRequestObject))
+		return sh.ssi.ReTriggerWebhookExecution(ctx, request.(ReTriggerWebhookExecutionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactVersionSummary")
+		handler = middleware(handler, "ReTriggerWebhookExecution")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactVersionSummaryResponseObject); ok {
-		if err := validResponse.VisitGetArtifactVersionSummaryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReTriggerWebhookExecutionResponseObject); ok {
+		if err := validResponse.VisitReTriggerWebhookExecutionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7163,27 +17997,33 @@ func (sh *strictHandler) GetArtifactVersionSummary(w http.ResponseWriter, r *htt
 	}
 }
 
-// GetAllArtifactVersions operation middleware
-func (sh *strictHandler) GetAllArtifactVersions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetAllArtifactVersionsParams) {
-	var request GetAllArtifactVersionsRequestObject
+// TestWebhook operation middleware
+func (sh *strictHandler) TestWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
+	var request TestWebhookRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Params = params
+	request.WebhookIdentifier = webhookIdentifier
+
+	var body TestWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAllArtifactVersions(ctx, request.(GetAllArtifactVersionsRequestObject))
+		return sh.ssi.TestWebhook(ctx, request.(TestWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAllArtifactVersions")
+		handler = middleware(handler, "TestWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAllArtifactVersionsResponseObject); ok {
-		if err := validResponse.VisitGetAllArtifactVersionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(TestWebhookResponseObject); ok {
+		if err := validResponse.VisitTestWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7191,26 +18031,25 @@ func (sh *strictHandler) GetAllArtifactVersions(w http.ResponseWriter, r *http.R
 	}
 }
 
-// GetAllArtifactsByRegistry operation middleware
-func (sh *strictHandler) GetAllArtifactsByRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetAllArtifactsByRegistryParams) {
-	var request GetAllArtifactsByRegistryRequestObject
+// ListReplicationRules operation middleware
+func (sh *strictHandler) ListReplicationRules(w http.ResponseWriter, r *http.Request, params ListReplicationRulesParams) {
+	var request ListReplicationRulesRequestObject
 
-	request.RegistryRef = registryRef
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAllArtifactsByRegistry(ctx, request.(GetAllArtifactsByRegistryRequestObject))
+		return sh.ssi.ListReplicationRules(ctx, request.(ListReplicationRulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAllArtifactsByRegistry")
+		handler = middleware(handler, "ListReplicationRules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAllArtifactsByRegistryResponseObject); ok {
-		if err := validResponse.VisitGetAllArtifactsByRegistryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListReplicationRulesResponseObject); ok {
+		if err := validResponse.VisitListReplicationRulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7218,26 +18057,32 @@ func (sh *strictHandler) GetAllArtifactsByRegistry(w http.ResponseWriter, r *htt
 	}
 }
 
-// GetClientSetupDetails operation middleware
-func (sh *strictHandler) GetClientSetupDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetClientSetupDetailsParams) {
-	var request GetClientSetupDetailsRequestObject
+// CreateReplicationRule operation middleware
+func (sh *strictHandler) CreateReplicationRule(w http.ResponseWriter, r *http.Request, params CreateReplicationRuleParams) {
+	var request CreateReplicationRuleRequestObject
 
-	request.RegistryRef = registryRef
 	request.Params = params
 
+	var body CreateReplicationRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetClientSetupDetails(ctx, request.(GetClientSetupDetailsRequestObject))
+		return sh.ssi.CreateReplicationRule(ctx, request.(CreateReplicationRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetClientSetupDetails")
+		handler = middleware(handler, "CreateReplicationRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetClientSetupDetailsResponseObject); ok {
-		if err := validResponse.VisitGetClientSetupDetailsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateReplicationRuleResponseObject); ok {
+		if err := validResponse.VisitCreateReplicationRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7245,26 +18090,25 @@ func (sh *strictHandler) GetClientSetupDetails(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// DeleteQuarantineFilePath operation middleware
-func (sh *strictHandler) DeleteQuarantineFilePath(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params DeleteQuarantineFilePathParams) {
-	var request DeleteQuarantineFilePathRequestObject
+// DeleteReplicationRule operation middleware
+func (sh *strictHandler) DeleteReplicationRule(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteReplicationRuleRequestObject
 
-	request.RegistryRef = registryRef
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteQuarantineFilePath(ctx, request.(DeleteQuarantineFilePathRequestObject))
+		return sh.ssi.DeleteReplicationRule(ctx, request.(DeleteReplicationRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteQuarantineFilePath")
+		handler = middleware(handler, "DeleteReplicationRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteQuarantineFilePathResponseObject); ok {
-		if err := validResponse.VisitDeleteQuarantineFilePathResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteReplicationRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteReplicationRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7272,32 +18116,25 @@ func (sh *strictHandler) DeleteQuarantineFilePath(w http.ResponseWriter, r *http
 	}
 }
 
-// QuarantineFilePath operation middleware
-func (sh *strictHandler) QuarantineFilePath(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
-	var request QuarantineFilePathRequestObject
-
-	request.RegistryRef = registryRef
+// GetReplicationRule operation middleware
+func (sh *strictHandler) GetReplicationRule(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetReplicationRuleRequestObject
 
-	var body QuarantineFilePathJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QuarantineFilePath(ctx, request.(QuarantineFilePathRequestObject))
+		return sh.ssi.GetReplicationRule(ctx, request.(GetReplicationRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QuarantineFilePath")
+		handler = middleware(handler, "GetReplicationRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QuarantineFilePathResponseObject); ok {
-		if err := validResponse.VisitQuarantineFilePathResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetReplicationRuleResponseObject); ok {
+		if err := validResponse.VisitGetReplicationRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7305,26 +18142,32 @@ func (sh *strictHandler) QuarantineFilePath(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// ListWebhooks operation middleware
-func (sh *strictHandler) ListWebhooks(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListWebhooksParams) {
-	var request ListWebhooksRequestObject
+// UpdateReplicationRule operation middleware
+func (sh *strictHandler) UpdateReplicationRule(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateReplicationRuleRequestObject
 
-	request.RegistryRef = registryRef
-	request.Params = params
+	request.Id = id
+
+	var body UpdateReplicationRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListWebhooks(ctx, request.(ListWebhooksRequestObject))
+		return sh.ssi.UpdateReplicationRule(ctx, request.(UpdateReplicationRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListWebhooks")
+		handler = middleware(handler, "UpdateReplicationRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListWebhooksResponseObject); ok {
-		if err := validResponse.VisitListWebhooksResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateReplicationRuleResponseObject); ok {
+		if err := validResponse.VisitUpdateReplicationRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7332,32 +18175,26 @@ func (sh *strictHandler) ListWebhooks(w http.ResponseWriter, r *http.Request, re
 	}
 }
 
-// CreateWebhook operation middleware
-func (sh *strictHandler) CreateWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
-	var request CreateWebhookRequestObject
-
-	request.RegistryRef = registryRef
-
-	var body CreateWebhookJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// ListMigrationImages operation middleware
+func (sh *strictHandler) ListMigrationImages(w http.ResponseWriter, r *http.Request, id string, params ListMigrationImagesParams) {
+	var request ListMigrationImagesRequestObject
+
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
+		return sh.ssi.ListMigrationImages(ctx, request.(ListMigrationImagesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateWebhook")
+		handler = middleware(handler, "ListMigrationImages")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
-		if err := validResponse.VisitCreateWebhookResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListMigrationImagesResponseObject); ok {
+		if err := validResponse.VisitListMigrationImagesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7365,26 +18202,26 @@ func (sh *strictHandler) CreateWebhook(w http.ResponseWriter, r *http.Request, r
 	}
 }
 
-// DeleteWebhook operation middleware
-func (sh *strictHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
-	var request DeleteWebhookRequestObject
+// GetMigrationLogsForImage operation middleware
+func (sh *strictHandler) GetMigrationLogsForImage(w http.ResponseWriter, r *http.Request, id string, imageId string) {
+	var request GetMigrationLogsForImageRequestObject
 
-	request.RegistryRef = registryRef
-	request.WebhookIdentifier = webhookIdentifier
+	request.Id = id
+	request.ImageId = imageId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
+		return sh.ssi.GetMigrationLogsForImage(ctx, request.(GetMigrationLogsForImageRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteWebhook")
+		handler = middleware(handler, "GetMigrationLogsForImage")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
-		if err := validResponse.VisitDeleteWebhookResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetMigrationLogsForImageResponseObject); ok {
+		if err := validResponse.VisitGetMigrationLogsForImageResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7392,26 +18229,25 @@ func (sh *strictHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, r
 	}
 }
 
-// GetWebhook operation middleware
-func (sh *strictHandler) GetWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
-	var request GetWebhookRequestObject
+// StartMigration operation middleware
+func (sh *strictHandler) StartMigration(w http.ResponseWriter, r *http.Request, id string) {
+	var request StartMigrationRequestObject
 
-	request.RegistryRef = registryRef
-	request.WebhookIdentifier = webhookIdentifier
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetWebhook(ctx, request.(GetWebhookRequestObject))
+		return sh.ssi.StartMigration(ctx, request.(StartMigrationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetWebhook")
+		handler = middleware(handler, "StartMigration")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetWebhookResponseObject); ok {
-		if err := validResponse.VisitGetWebhookResponse(w); err != nil {
+	} else if validResponse, ok := response.(StartMigrationResponseObject); ok {
+		if err := validResponse.VisitStartMigrationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7419,33 +18255,25 @@ func (sh *strictHandler) GetWebhook(w http.ResponseWriter, r *http.Request, regi
 	}
 }
 
-// UpdateWebhook operation middleware
-func (sh *strictHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
-	var request UpdateWebhookRequestObject
-
-	request.RegistryRef = registryRef
-	request.WebhookIdentifier = webhookIdentifier
+// StopMigration operation middleware
+func (sh *strictHandler) StopMigration(w http.ResponseWriter, r *http.Request, id string) {
+	var request StopMigrationRequestObject
 
-	var body UpdateWebhookJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateWebhook(ctx, request.(UpdateWebhookRequestObject))
+		return sh.ssi.StopMigration(ctx, request.(StopMigrationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateWebhook")
+		handler = middleware(handler, "StopMigration")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateWebhookResponseObject); ok {
-		if err := validResponse.VisitUpdateWebhookResponse(w); err != nil {
+	} else if validResponse, ok := response.(StopMigrationResponseObject); ok {
+		if err := validResponse.VisitStopMigrationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7453,27 +18281,26 @@ func (sh *strictHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request, r
 	}
 }
 
-// ListWebhookExecutions operation middleware
-func (sh *strictHandler) ListWebhookExecutions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, params ListWebhookExecutionsParams) {
-	var request ListWebhookExecutionsRequestObject
+// GetArtifactStatsForSpace operation middleware
+func (sh *strictHandler) GetArtifactStatsForSpace(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetArtifactStatsForSpaceParams) {
+	var request GetArtifactStatsForSpaceRequestObject
 
-	request.RegistryRef = registryRef
-	request.WebhookIdentifier = webhookIdentifier
+	request.SpaceRef = spaceRef
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListWebhookExecutions(ctx, request.(ListWebhookExecutionsRequestObject))
+		return sh.ssi.GetArtifactStatsForSpace(ctx, request.(GetArtifactStatsForSpaceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListWebhookExecutions")
+		handler = middleware(handler, "GetArtifactStatsForSpace")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListWebhookExecutionsResponseObject); ok {
-		if err := validResponse.VisitListWebhookExecutionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetArtifactStatsForSpaceResponseObject); ok {
+		if err := validResponse.VisitGetArtifactStatsForSpaceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7481,27 +18308,26 @@ func (sh *strictHandler) ListWebhookExecutions(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// GetWebhookExecution operation middleware
-func (sh *strictHandler) GetWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam) {
-	var request GetWebhookExecutionRequestObject
+// GetAllArtifacts operation middleware
+func (sh *strictHandler) GetAllArtifacts(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllArtifactsParams) {
+	var request GetAllArtifactsRequestObject
 
-	request.RegistryRef = registryRef
-	request.WebhookIdentifier = webhookIdentifier
-	request.WebhookExecutionId = webhookExecutionId
+	request.SpaceRef = spaceRef
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetWebhookExecution(ctx, request.(GetWebhookExecutionRequestObject))
+		return sh.ssi.GetAllArtifacts(ctx, request.(GetAllArtifactsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetWebhookExecution")
+		handler = middleware(handler, "GetAllArtifacts")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetWebhookExecutionResponseObject); ok {
-		if err := validResponse.VisitGetWebhookExecutionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetAllArtifactsResponseObject); ok {
+		if err := validResponse.VisitGetAllArtifactsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7509,27 +18335,26 @@ func (sh *strictHandler) GetWebhookExecution(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// ReTriggerWebhookExecution operation middleware
-func (sh *strictHandler) ReTriggerWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam) {
-	var request ReTriggerWebhookExecutionRequestObject
+// ListPackageDenylistEntries operation middleware
+func (sh *strictHandler) ListPackageDenylistEntries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params ListPackageDenylistEntriesParams) {
+	var request ListPackageDenylistEntriesRequestObject
 
-	request.RegistryRef = registryRef
-	request.WebhookIdentifier = webhookIdentifier
-	request.WebhookExecutionId = webhookExecutionId
+	request.SpaceRef = spaceRef
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ReTriggerWebhookExecution(ctx, request.(ReTriggerWebhookExecutionRequestObject))
+		return sh.ssi.ListPackageDenylistEntries(ctx, request.(ListPackageDenylistEntriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReTriggerWebhookExecution")
+		handler = middleware(handler, "ListPackageDenylistEntries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ReTriggerWebhookExecutionResponseObject); ok {
-		if err := validResponse.VisitReTriggerWebhookExecutionResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPackageDenylistEntriesResponseObject); ok {
+		if err := validResponse.VisitListPackageDenylistEntriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7537,25 +18362,32 @@ func (sh *strictHandler) ReTriggerWebhookExecution(w http.ResponseWriter, r *htt
 	}
 }
 
-// ListReplicationRules operation middleware
-func (sh *strictHandler) ListReplicationRules(w http.ResponseWriter, r *http.Request, params ListReplicationRulesParams) {
-	var request ListReplicationRulesRequestObject
+// CreatePackageDenylistEntry operation middleware
+func (sh *strictHandler) CreatePackageDenylistEntry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	var request CreatePackageDenylistEntryRequestObject
 
-	request.Params = params
+	request.SpaceRef = spaceRef
+
+	var body CreatePackageDenylistEntryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListReplicationRules(ctx, request.(ListReplicationRulesRequestObject))
+		return sh.ssi.CreatePackageDenylistEntry(ctx, request.(CreatePackageDenylistEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListReplicationRules")
+		handler = middleware(handler, "CreatePackageDenylistEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListReplicationRulesResponseObject); ok {
-		if err := validResponse.VisitListReplicationRulesResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreatePackageDenylistEntryResponseObject); ok {
+		if err := validResponse.VisitCreatePackageDenylistEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7563,13 +18395,13 @@ func (sh *strictHandler) ListReplicationRules(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// CreateReplicationRule operation middleware
-func (sh *strictHandler) CreateReplicationRule(w http.ResponseWriter, r *http.Request, params CreateReplicationRuleParams) {
-	var request CreateReplicationRuleRequestObject
+// ImportPackageDenylist operation middleware
+func (sh *strictHandler) ImportPackageDenylist(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	var request ImportPackageDenylistRequestObject
 
-	request.Params = params
+	request.SpaceRef = spaceRef
 
-	var body CreateReplicationRuleJSONRequestBody
+	var body ImportPackageDenylistJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -7577,18 +18409,18 @@ func (sh *strictHandler) CreateReplicationRule(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateReplicationRule(ctx, request.(CreateReplicationRuleRequestObject))
+		return sh.ssi.ImportPackageDenylist(ctx, request.(ImportPackageDenylistRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateReplicationRule")
+		handler = middleware(handler, "ImportPackageDenylist")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateReplicationRuleResponseObject); ok {
-		if err := validResponse.VisitCreateReplicationRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportPackageDenylistResponseObject); ok {
+		if err := validResponse.VisitImportPackageDenylistResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7596,25 +18428,26 @@ func (sh *strictHandler) CreateReplicationRule(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// DeleteReplicationRule operation middleware
-func (sh *strictHandler) DeleteReplicationRule(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteReplicationRuleRequestObject
+// DeletePackageDenylistEntry operation middleware
+func (sh *strictHandler) DeletePackageDenylistEntry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, denylistEntryId DenylistEntryIdPathParam) {
+	var request DeletePackageDenylistEntryRequestObject
 
-	request.Id = id
+	request.SpaceRef = spaceRef
+	request.DenylistEntryId = denylistEntryId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteReplicationRule(ctx, request.(DeleteReplicationRuleRequestObject))
+		return sh.ssi.DeletePackageDenylistEntry(ctx, request.(DeletePackageDenylistEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteReplicationRule")
+		handler = middleware(handler, "DeletePackageDenylistEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteReplicationRuleResponseObject); ok {
-		if err := validResponse.VisitDeleteReplicationRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePackageDenylistEntryResponseObject); ok {
+		if err := validResponse.VisitDeletePackageDenylistEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7622,25 +18455,26 @@ func (sh *strictHandler) DeleteReplicationRule(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// GetReplicationRule operation middleware
-func (sh *strictHandler) GetReplicationRule(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetReplicationRuleRequestObject
+// ListPipelineArtifacts operation middleware
+func (sh *strictHandler) ListPipelineArtifacts(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params ListPipelineArtifactsParams) {
+	var request ListPipelineArtifactsRequestObject
 
-	request.Id = id
+	request.SpaceRef = spaceRef
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetReplicationRule(ctx, request.(GetReplicationRuleRequestObject))
+		return sh.ssi.ListPipelineArtifacts(ctx, request.(ListPipelineArtifactsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetReplicationRule")
+		handler = middleware(handler, "ListPipelineArtifacts")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetReplicationRuleResponseObject); ok {
-		if err := validResponse.VisitGetReplicationRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPipelineArtifactsResponseObject); ok {
+		if err := validResponse.VisitListPipelineArtifactsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7648,32 +18482,26 @@ func (sh *strictHandler) GetReplicationRule(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// UpdateReplicationRule operation middleware
-func (sh *strictHandler) UpdateReplicationRule(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateReplicationRuleRequestObject
-
-	request.Id = id
+// GetAllRegistries operation middleware
+func (sh *strictHandler) GetAllRegistries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllRegistriesParams) {
+	var request GetAllRegistriesRequestObject
 
-	var body UpdateReplicationRuleJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.SpaceRef = spaceRef
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateReplicationRule(ctx, request.(UpdateReplicationRuleRequestObject))
+		return sh.ssi.GetAllRegistries(ctx, request.(GetAllRegistriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateReplicationRule")
+		handler = middleware(handler, "GetAllRegistries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateReplicationRuleResponseObject); ok {
-		if err := validResponse.VisitUpdateReplicationRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetAllRegistriesResponseObject); ok {
+		if err := validResponse.VisitGetAllRegistriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7681,26 +18509,25 @@ func (sh *strictHandler) UpdateReplicationRule(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// ListMigrationImages operation middleware
-func (sh *strictHandler) ListMigrationImages(w http.ResponseWriter, r *http.Request, id string, params ListMigrationImagesParams) {
-	var request ListMigrationImagesRequestObject
+// GetRegistryGarbageStats operation middleware
+func (sh *strictHandler) GetRegistryGarbageStats(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	var request GetRegistryGarbageStatsRequestObject
 
-	request.Id = id
-	request.Params = params
+	request.SpaceRef = spaceRef
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListMigrationImages(ctx, request.(ListMigrationImagesRequestObject))
+		return sh.ssi.GetRegistryGarbageStats(ctx, request.(GetRegistryGarbageStatsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListMigrationImages")
+		handler = middleware(handler, "GetRegistryGarbageStats")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListMigrationImagesResponseObject); ok {
-		if err := validResponse.VisitListMigrationImagesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRegistryGarbageStatsResponseObject); ok {
+		if err := validResponse.VisitGetRegistryGarbageStatsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7708,26 +18535,26 @@ func (sh *strictHandler) ListMigrationImages(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetMigrationLogsForImage operation middleware
-func (sh *strictHandler) GetMigrationLogsForImage(w http.ResponseWriter, r *http.Request, id string, imageId string) {
-	var request GetMigrationLogsForImageRequestObject
+// GetUploadFailureStats operation middleware
+func (sh *strictHandler) GetUploadFailureStats(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetUploadFailureStatsParams) {
+	var request GetUploadFailureStatsRequestObject
 
-	request.Id = id
-	request.ImageId = imageId
+	request.SpaceRef = spaceRef
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMigrationLogsForImage(ctx, request.(GetMigrationLogsForImageRequestObject))
+		return sh.ssi.GetUploadFailureStats(ctx, request.(GetUploadFailureStatsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMigrationLogsForImage")
+		handler = middleware(handler, "GetUploadFailureStats")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetMigrationLogsForImageResponseObject); ok {
-		if err := validResponse.VisitGetMigrationLogsForImageResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUploadFailureStatsResponseObject); ok {
+		if err := validResponse.VisitGetUploadFailureStatsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7735,25 +18562,25 @@ func (sh *strictHandler) GetMigrationLogsForImage(w http.ResponseWriter, r *http
 	}
 }
 
-// StartMigration operation middleware
-func (sh *strictHandler) StartMigration(w http.ResponseWriter, r *http.Request, id string) {
-	var request StartMigrationRequestObject
+// GetSpaceRegistryUsage operation middleware
+func (sh *strictHandler) GetSpaceRegistryUsage(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	var request GetSpaceRegistryUsageRequestObject
 
-	request.Id = id
+	request.SpaceRef = spaceRef
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.StartMigration(ctx, request.(StartMigrationRequestObject))
+		return sh.ssi.GetSpaceRegistryUsage(ctx, request.(GetSpaceRegistryUsageRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StartMigration")
+		handler = middleware(handler, "GetSpaceRegistryUsage")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(StartMigrationResponseObject); ok {
-		if err := validResponse.VisitStartMigrationResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpaceRegistryUsageResponseObject); ok {
+		if err := validResponse.VisitGetSpaceRegistryUsageResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7761,25 +18588,26 @@ func (sh *strictHandler) StartMigration(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// StopMigration operation middleware
-func (sh *strictHandler) StopMigration(w http.ResponseWriter, r *http.Request, id string) {
-	var request StopMigrationRequestObject
+// GetSpaceRegistryUsageHistory operation middleware
+func (sh *strictHandler) GetSpaceRegistryUsageHistory(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetSpaceRegistryUsageHistoryParams) {
+	var request GetSpaceRegistryUsageHistoryRequestObject
 
-	request.Id = id
+	request.SpaceRef = spaceRef
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.StopMigration(ctx, request.(StopMigrationRequestObject))
+		return sh.ssi.GetSpaceRegistryUsageHistory(ctx, request.(GetSpaceRegistryUsageHistoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StopMigration")
+		handler = middleware(handler, "GetSpaceRegistryUsageHistory")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(StopMigrationResponseObject); ok {
-		if err := validResponse.VisitStopMigrationResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpaceRegistryUsageHistoryResponseObject); ok {
+		if err := validResponse.VisitGetSpaceRegistryUsageHistoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7787,26 +18615,25 @@ func (sh *strictHandler) StopMigration(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetArtifactStatsForSpace operation middleware
-func (sh *strictHandler) GetArtifactStatsForSpace(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetArtifactStatsForSpaceParams) {
-	var request GetArtifactStatsForSpaceRequestObject
+// GetSpaceRegistryPolicy operation middleware
+func (sh *strictHandler) GetSpaceRegistryPolicy(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	var request GetSpaceRegistryPolicyRequestObject
 
 	request.SpaceRef = spaceRef
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactStatsForSpace(ctx, request.(GetArtifactStatsForSpaceRequestObject))
+		return sh.ssi.GetSpaceRegistryPolicy(ctx, request.(GetSpaceRegistryPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactStatsForSpace")
+		handler = middleware(handler, "GetSpaceRegistryPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactStatsForSpaceResponseObject); ok {
-		if err := validResponse.VisitGetArtifactStatsForSpaceResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpaceRegistryPolicyResponseObject); ok {
+		if err := validResponse.VisitGetSpaceRegistryPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7814,26 +18641,32 @@ func (sh *strictHandler) GetArtifactStatsForSpace(w http.ResponseWriter, r *http
 	}
 }
 
-// GetAllArtifacts operation middleware
-func (sh *strictHandler) GetAllArtifacts(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllArtifactsParams) {
-	var request GetAllArtifactsRequestObject
+// UpdateSpaceRegistryPolicy operation middleware
+func (sh *strictHandler) UpdateSpaceRegistryPolicy(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	var request UpdateSpaceRegistryPolicyRequestObject
 
 	request.SpaceRef = spaceRef
-	request.Params = params
+
+	var body UpdateSpaceRegistryPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAllArtifacts(ctx, request.(GetAllArtifactsRequestObject))
+		return sh.ssi.UpdateSpaceRegistryPolicy(ctx, request.(UpdateSpaceRegistryPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAllArtifacts")
+		handler = middleware(handler, "UpdateSpaceRegistryPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAllArtifactsResponseObject); ok {
-		if err := validResponse.VisitGetAllArtifactsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSpaceRegistryPolicyResponseObject); ok {
+		if err := validResponse.VisitUpdateSpaceRegistryPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7841,26 +18674,26 @@ func (sh *strictHandler) GetAllArtifacts(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetAllRegistries operation middleware
-func (sh *strictHandler) GetAllRegistries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllRegistriesParams) {
-	var request GetAllRegistriesRequestObject
+// ListVulnerableVersions operation middleware
+func (sh *strictHandler) ListVulnerableVersions(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params ListVulnerableVersionsParams) {
+	var request ListVulnerableVersionsRequestObject
 
 	request.SpaceRef = spaceRef
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAllRegistries(ctx, request.(GetAllRegistriesRequestObject))
+		return sh.ssi.ListVulnerableVersions(ctx, request.(ListVulnerableVersionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAllRegistries")
+		handler = middleware(handler, "ListVulnerableVersions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAllRegistriesResponseObject); ok {
-		if err := validResponse.VisitGetAllRegistriesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListVulnerableVersionsResponseObject); ok {
+		if err := validResponse.VisitListVulnerableVersionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7870,140 +18703,427 @@ func (sh *strictHandler) GetAllRegistries(w http.ResponseWriter, r *http.Request
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/+x963LcOJLuq+DwnIno1pRV7p4+GxvamB+ybta0LNWUSu7omHZIEImq4phFsgFQstqh",
-	"iP21D7D7hvMkG7iRIAmQYN1UtvmnWy7ikkh8mUgkkInPnp8s0iRGMSXewWcvhRguEEWY/+sC3qOIjNhv",
-	"7J8BIj4OUxomsXcgPu57Ay9k//o9Q/jJG3gxXCDvwIvYR2/gEX+OFpBVDila8EbpU8pKEIrDeOY9D9QP",
-	"EGP45D0/D7wxmoWE4qfzAMU0nIYIW0hQBUFR0kIPRrPbUC+0EmGTpxS1kcTKWIih4lNBAoqzhXfwD+/9",
-	"+Xhyc3jhDbyb0fVkfHL4zvswqNL1PPAgpuEU+tRCwyH/TC29q8olCpr6oHNLP5dwgUAyBapoDoYU0rmx",
-	"Q4x+z0KMAu+A4gy5EdDAbFUEsNr7LeO9tbJ9kQQcrAGkkCBq5rk/D6PgPcIkTGILOUesCHgQZUAY+5Bw",
-	"/hwn/keEczYRG6V6Fy2zE4QzROhVaoPAMf9u60jUdupitfa7zPc0jBBDlAPgDtW8n4YRsqCONXfL/+5O",
-	"RgMJ6rNl5LxXSUhjLzhZHENqAzb7tA9OE7yAFLwC794Nj4+Hv/7666+2bnGyaOkxghQRqtBl0ObsM5Df",
-	"GWMpwnbtzgrfPtihep8kEYIx7zmF/kc4Qy5KcySKNilP2Vpdmjvo8RTO0GW2uEfYIMQZxiimgJUBsShk",
-	"o2RWpiBAU5hF1Dv4YeBN+dx5B14Y03/7ycuJCGOKZgjnZFyHfyAD0Hm/DOp8VCBFGMjuTJQQ1oiRkh9f",
-	"u5GCkZ9hEj7YZuiXOaJzhAFNQBQSCrCYsRARkFeNnvZ/i3+L9/aOUYqRDykK9vf2wA1BgM4RiNEjuCN+",
-	"kqI7kJsZoga4yxv5K5PQOwD+9V//LUv/FcY+IjTB5K5SdAojgu70onESo7vfYqsRIGuaecWbG5gQLEf7",
-	"NEbTBtVwE4e/Zwgw6QeFrQGmCebjn4YxjBTjnkAY81/vMYz9+T6YzBF4gFGGgA9jcI9AipOHMEABQCHn",
-	"PCQAgmkWRU/gZnzxCsV+wr7y3r5D+7P9AbhL8AzG4R+QEfSnH09TnPwT+fRPP56qXu++B4lsKo1gGIvq",
-	"KA7CeAYeQzoHEFAMw4j9O40yAkg4i8F3d3+++55VI4jNHE2wscuh7HCouhv++e77/WI6ygpaFbrFaNpR",
-	"R6uy1yn00RhN/87meZVZIayh8pSA71QvvGw+bz5GfLDfb3TOtjRR5fmpahXGlCVmh4uiZTb29q7ZV6bZ",
-	"NBUitcreHhPwvT0mxXt74F//+T/Al9pYTFASR0/gOymw3wMAWOlcPRir7O0x7uztARhFTO3kX4iszuhD",
-	"cQBj6tAAt9Dy+r/F51OQLEJKUTAAd1z5gJAASEi2QEEDZxkPjKZoPhhmjhaUsapJjMyWKUEQ+/MJwgZ+",
-	"i2+AfbQt5qLILWX1WyY2wfQ0RFFg6Cf/ZOkkwfR2Kgu09XGFA9PKXHxq6CORBRr7kGpjVV1u0Bpfn1Io",
-	"K+2ldcIGNfU3pYibmEyTNW4paNLS20PjXrjYxpoaf3Da5OY9uG8IZbeWPWHRbRfsyloN+xe1a5o0uCFk",
-	"K3YvxPH52cn1xBt4k8Mzs6J/RPfzJPl48gn5Gev5PGjXYLIOQKqSJloWLskqt3mV2zDoyDLZhO7AcyXU",
-	"mbySO8+dOGk+IkLfJEGI+I5RwYe7NMfiK/vdT2KKYv4nTNMo9IXQ/pOIHXTRyf9jwnng/d9h4U0diq9k",
-	"aGyc01Hmg6SKGUNZGkCKcocR4N5U4mkeyHUTWW23gT6mj7kRjACMA0Wrso8FkTkZ4yxC66fV2PwSJOft",
-	"AJxFiJH+iwDXukmuNNuZVIl5RuHvGcQwpmG8dr7WW25GaVEekBT54TT0wTSMxAou92kkTWJSFrJjRGEY",
-	"jeWnTtSnOEkRplJqA0idhU90yvhHKKQZaat3LUrpm02mo2Vl4SrWlHRyz1ZxM7/EOBnDZogWMh1wirhQ",
-	"KyJPQ4bm1fmSPMZRAoMbHNW1rfoIMhzpvntvUPebrYlVGjldOTZHMChYxsCl80tq1K0C6TpbLKBQc7uC",
-	"JL46APVZZxDrm2ybQazPXWIPa4qY2SPmskcQKUhSVEqT9mVYVO58BzgVlE/w8jM+jXFvYLDuBfkE4wSb",
-	"yHsDA4DVGj3wjqIQxfQa0SwV69y2ZL7e8UvOFTedOEWAMJL0JVYcwb6ICWLqegchHeSElQl+B+Nwigh9",
-	"EW6pzneQXwuNNEH0BXxCmGyVT6LLnbRJGGEFb9REbpc9ea+7yRpm729VFV2EhBad7hJTmGnPefIWRYsX",
-	"UdP1jneAP3MULUwqWid2ywra1PXOcUpXzucxRTiG0TXCDwgLm2rjFprqFBDeK0Ci4MBjIvgS+9davy9t",
-	"qfFrJAYPp07oC/Bmp9hS5YfcF70AW9Rxzi5wR26+SMl9JTn1LpxhzoHzBZyhLTKq3PEL8Glc49NCkQRC",
-	"RlMuXVd+qKZ1Amdki0yq9LwTaKJwRkAYTxMOpxhcHZ3XUKVOR15AL1W73kn9VJwebZ0vO8EP/fBLEFc5",
-	"odoiW0o974Qeqp6z5YpInoqR/EB7i4yq9f0S2oizR57tkeKIvuyt1ql9AQbthIA9asRcJvQ0yeJg80b8",
-	"ZJ6fbKKAzUmSYR+BR0hAnFAw5VQ8D7xRBMN4gj7Z1gWKPtEhvz30H8CfQ0wQ/WtGp6/+vUwj+gQXacRY",
-	"8xZFUTIAjwmOgv9TP5mrU3ooLyexnkrg2bJm3hWtLE7QB8LH4HhJYUsM2in9XFXNklGMrOvM9xEhK/Bj",
-	"HQNzGZGkFIw13N/EMKNzFNOQxyFsXldUO8xpSHD4x/YIkL0VN1m2vbZWu30BhNevvOkaMb+Ks0127Kg+",
-	"NF4rGkE63xJ3yp2+AJO0K0z8Xm8BlGd1h1DcXeIa5mf0dI18jOjP6Kk+YKjKGGPQYLkFLWDZoTSPcznn",
-	"SqQ1mMtcmfPX1BNRA2qhKC/XjZZyNQsV1Wk0kPTheeAdxkn8tEg4PLSbCtJZb4mC9imQBQZeELLvizCG",
-	"VPiAFzBNGQUHn72jw/HZlfWcG+JZUu7vKImn4cwbeMdXRz+fjLsc/uZVz04uT8bnR7a6ZyhGOPRtla3U",
-	"ntlIfXty8c79+KOodnN2dn55dnp4dGKtnc1mYTw7hT6yNPLu8P3Jpa36O/iAYkvFy5GV5svURvLlzdnJ",
-	"xFotmyFqqTj6dfL2ykrn6InOExuhYzuhYwuhzwOlQ54uS9GtPP71eeAlMbqaegf/6H7DIO+h66mXY8Um",
-	"cLbVtU93W82GCWirepkuN9DxkvXsKGuradc2rZOyXLU26X3+MKiudVpuBtdLXQrTwhYJDqlxnZFf35hX",
-	"UXWX9CjJhEXisASF5O/5Kh+YQtQH3iIJ+B7fQpMIPDB80KW1hQujsmDrd62htKTqa66MDa99eCii+JuX",
-	"Uu74vxRJEPTgF7ENlnkMMswWR30sddOpWG5PYhrSp3eIQmXhwSAI2ZoLo5EGEhGHYVmSRSMgb6Whv2pM",
-	"RhmI8tywW/i/ziHZQNOI9bFaxqMNZH2CEqAI5YJS8UyFC0QoXKQgjMEijKKQID+JAwIe50jExuUBy4+Q",
-	"AJJM6SvZnummt5Ir0k2wjmWLdfpwhkA4LRMS2ujQJNFBWLtPOatD6Dsp5MYKC22OXeaoIgWbURJpFkVH",
-	"yWIBYzPRTkoE15IpNRazbhSwlvvI1Q2oBqLq3tycHxsbz7IwWE3T5ZleaqOtpj6p6T99giQpFZJ1rDdp",
-	"CnH3vSYNbyGOESFFlKIoN7DEbXSRQVVHpRFxqEITCqNrmmAt+4hDtSzt1M9zE5vkfUUHRsmS27M/dl7p",
-	"5iu6ifpNqORl9G2LQbW8SlxWkzTYResTfzWeqiNuijDPcVFKV+YNOuQfq8VlONgkKih5M7YJz/CVA9eu",
-	"mDqBexpGaM2mfb/yr23lt8qcdZ/iJowbXbqb5LUSZlRPmSCu/NckahOr0hbXne0vIQ5yuuGt9BYsUPNe",
-	"ew2rS0bn5pXlsDhxZLCsrCo3BOERJOQxwaxPwxGG7lI3rTl2R1Q9ax7/nZ818Vr1OOeqsCwcPQjVMxwD",
-	"h44YiLJ0lEShbxBj+RmI75zGmqE5zpNR1QhFn9IQo2P4RMwrXZv6H2E0DT91s59U5pPOVc3sqUXpGXjE",
-	"4+Z4IXBsmzIYxm8RDOxHRM1fxTUrfTSOwYXXom6rM0cjUCdH6/xDM39UR838UaWaT5TOLy/OL09cRkdR",
-	"mp8iTA7fXNvqTOB9tUL9BIF2Ojowk9HmMDYRUvMRz5dFCnVYA+QUiDWgggJqc2FWBts2y6xIdVC+MMqW",
-	"QzHnljDqDDI/X40jlY5yzrRxQTMzW5gBVNGByQlrXjxhlCFLsqpWuiwrTescEYrSpSeos0rNmW2htFSo",
-	"ukZfU0hD3xt4/DgNUjRJPqLYuBgbw4hbTdb87LsC42YfyCYcE+2HMCtvxDbmdWjbjmnf3zyJ1Nirb9vs",
-	"Vzas2y0c7cxxUcOZdJPxaI5Kr5sizTPy3EpQHrnYKkF5ybo1VDTRzNa8pJ1RPJD7JKZOXlER9W1bm7pg",
-	"pkKoaqGFTtLqwBXFrBvlwC4iMjraVXvXuGdYWBNyiH2He0eSKvvgFRSsVrTzTDWrXzt3ljyna9W9Vhat",
-	"dlJtZnA+yXm/7SxvYHZRpMrm5iVpoTfdAWxVFNi3b8spXBMz8qjlqsQHBrtjMkdgTmkqgo4BLzTQogt+",
-	"ev2T8TjChurDfHeu1DGA90lGuR9IBDYbSF4gQuDMQh7mUJKOJJn4DoaRyV9VU1F8NKp1I7M+UQyL/UUl",
-	"kb28HMwLgXyDWObrR8slzgUkH5VjrDlpfKPpq4/nI/fDiMKmwWjpGmpjYd+sFt4c+R9Jtuh4cONmGDbZ",
-	"Qg3ukG72jNlLLJ86KIZXp0ofhezWxNmmG2xNJspM1Gu3UUotONkoZ929bWfbdbUZsmTUFTOKFq07kDyE",
-	"tOES2Dq3J9/A7uLr2DhYb6Q2SYEpT8o6Ng3GZCctgN/0hqHttmYjn0TdKfTRVtXG36Y4memxbyoQr2bS",
-	"MDXP851bhEycZ6ggCodCWryCDfe5+shwaLKMMoKwZVmrTJyAeDEG0/yVso3UjS0R8KrlNCdW3UlcqnsD",
-	"N+O2dtvRYB2whnKlW7fq+M0i+WZS/naRbnq6vIsknkXq0EvKc1vovbx+7dzPeRygT+Z+fO0hKL1598bN",
-	"bzuxtmP7+046s4wPNRVoK3DQhrML5aG1ocWwc+W3fmpbqq0gYJkrRz1qHFHTcOPZlPHHQcXkGXmsmuq9",
-	"KtCxtU6aq3o1qldgX74Ca9r+NmivU55OsIpGkWRwmXaccFjOrNhDb6ehJ7Bgg10lm1cDZGpJtoxOyM0o",
-	"vnrOsR50Ow26glH61Gh962McKOjYQFrNprbSSr0dpCTuJF8dnRdkU1bYUSrKbOlNxRXwWp0uGxL17bzz",
-	"2tpw5a5XWy8Lg9x1F3a0l0pz6iStCjp2q72CSY2yNjjuoJelSlq/WfmKNivV5F4NuKnnROx14EvO/muj",
-	"Esxa9ovahINxFnXRerU0cI1Kr6PhKAi3wTTP7Oig2QuFXuRg7JG6a6v1o8OMmmfSCa1aErNGlObttiFP",
-	"S7q6HAa1XKmGeA2Xxlsb7cKZUra7fhnf6WVcm2QjTBMfRk4nlU7hlWbjtZTZzECEPUFS0+HugtVqP9ZV",
-	"BSxnojOcZOm56wF53VFm8H5ZeuLf2BbdeIiLkxmW+UcNaeXyNIEONNoyPzXxMk4XWz0gt2eLaqQyMz4p",
-	"ukE6K86VGnElr80Eznhm/S73f93O2eVlsIYbu6PydRxbSL68jaKFZMh8fipjXZ4SrkjXJxPpqSxzIkWd",
-	"yP82kHkEeXq+cuY8U0xHQzqzpmlPebWtzrvdw2PzARjCo6MoeUTBCFKKcNztdPc+SvyPS9b1q+GhjnFB",
-	"ei1Ts/lEuWz4i3i93ck/0ng/ceCFzXkDNhMoPsruo9BfX+anjd3as92Z65TzoPSGvYwNq1+T0/iSh5A3",
-	"x4xb3Ux9CrMtpDBrwvBLJTgrJ9LYUpbDrWUg2XKikbo4dsroUFkTatC7zu7lal+8q59g8D7ENIMRSDC4",
-	"SQnFCC70xbYpFv1mdD0ZnxxaE9Sq9vIw9Pfn48nN4YWtvCRlTUHo1daaS1dorQee084Z6lwDyGuHFe7W",
-	"ULt+7aS4dshMWE7xfg22RavSXSEmoE0VXtvu8tMl8jOuZstIu6WiInXlKkyaZY0Ya/LXfkOxHjt+3XKx",
-	"CuwxiumYlW8NOTGYztZVumi3CWhtzgJWURzeKbWzj8BDsSpncmUyLcaWBVJ5HdR6OyiWapPDwOKYdFtm",
-	"ra7NthXXGr3x/KH+kFKbmJJV5HS90YyIUDZBUm5cz+u0N6S0FhR21IRyZnsy8sUbeGdHI+OMtghn6/bV",
-	"Li8DT7xXtuTYROXlhtUkqpKoMvtL3dX5OqhhqA4MnRklvukwMEt/5SEwp9VmmzDeCaDuCpg2hR8jNJY4",
-	"ORiP3m3VM6tnwmpYu3yRNonwtEkyHZrKRtR1sZKZzWSyMhNYrvPzmeqz6AF/H40UFryI/ucbEfGO2zTj",
-	"i2mcUD1R0s3R0cn1tTfwTg/PL27GrPeT8fhqbOxez09m2EHAe5k+ipjSR823n8OuNqmGBGstwwC+2rpX",
-	"NsPw3p3cEt/cCMXhbGbKs6BZTbJIMZmH48n56eHR5PZofHI4OeeHK/lvxycXJ/w308RW9v4WOczkjXxj",
-	"pkfVxAgnn0x3T2Emdl5uNlUpyWabHVVk22wtWU/WyW0tqOUCbayvynHluUgousHRdTaV2SQrZ3apzO7B",
-	"n0AjvBSAaYriAAV8BrmgslbAzfiCs5XOQ5LbwfvgNMFAHGblRjAZiEJc8xKQPCCMwyCMZ7w5mT8D3A1J",
-	"uEgjdCc6zwgKePujp9H5KzYwSMP7CIEwDtAnRPbBBYK8ERgHgGIYRuwfJIJkjgiAGPGXWdV6wUs9hlEE",
-	"7tkHvIBR+AcK9n+LvcYVLj8L5Alf5tm9N/COMkITZtofPpITn8GZn9IfoZhivoqNnkahx0+b/8ZQxU90",
-	"rzCTyCPMVB5b4BKGuievFMRtBHqmoVRu9U5DjB5hFL2TSV9cfGS26tY9f/XOo8JRbXUceJ9eldaNVzLh",
-	"SbGB0eS1YRjVlCbiK1gkAQL8vUGBQVggC6SstX1doVxcXP3iDbxfDsdMl7y5uDr62aw/dHGtP1jq8jQf",
-	"WeJFPuLwEF9GEL50CzJXJZlGKPtOOyhE5U12uY+fVXTmiglp1X0yq/d0jGbybpYq2i2DVukRKSdvarMH",
-	"BcXwPrId16Ai3ZD7SqvnKDLdGWtx2cQE+RlGliOmmFm0MLI5dCgiVH8Wlucxcr7nJius8GrWi4qZtEY6",
-	"2ETSfDHMkkPaFJd3Uqxb5SKbhFdgUJv9D3bZEjOV72TbxOztZDJSsgZUvarM3SeBOS3WvAC/Y2qZ5zbK",
-	"i+dnO5IuK66FdustM/XpSK5hLo/f1EWowWauPWFs3AqNTybj88M3Fye3YivENkeTw4tb+8aodl/VXQWD",
-	"E40WozJ2VbZyNXIsjlTqO4PrzLEJXAiCs5ITNXjlAovuKjJ/bhovr18xksrqauo8UFmDqQqz+pcFXDYR",
-	"muaTeHTUxA3wt3rXvq4l+Ftd+6qrmWJSafmyLHGm1azyQHhNW1Xe72656+z4ApGVf5YXQrDLEzyO/UvT",
-	"wV3QysZD6XaLN9DHn9PZzGe7+7spYV/1jlitQCNfGxjonIEOFoGY1nE+c7mdJupVezkaIawNVzdegQA9",
-	"oIhxg0jMHnhzSlNyMBw+Pj7uz0XV/TDhohLSqLnBw9G5lijvwPth//X+a35fJkUxTEPvwPsL/0lcBOD8",
-	"H2I9EiEx2XVHfB0GMO+I7ZEZ1eKGfpAX0a/oQgwXiHKtYPF1FUWGiuNcz4zR9O8Zwk8j9p3fw5EL7Rtp",
-	"bJkaK4qEqDjMNqy3fNA/vv7B3pAspzVSLLs/vX7dXvENDLSOf3Lp6yaGxaM7KBD1/uJaL8HhH6LS/3eh",
-	"71xu5K4RfkBY5ABmGCYq/baacX2+eaqEg3942nb+A6uU42f4Wf11i9H0WcAoQtRgbYvbGRqgQCguEEHf",
-	"T7KYSlcgArPwAcVA5LItA040sQLg1NxOmfrQoVaCiQM3r8XxwpeAjp9e/9Re6TKhp0kWrxNOtfm24Wng",
-	"zZBBAY0RzXBMCrjIHNndYXOG6C5g5ktULS8FHtvk2zGUZgYM3aQBPyBcRenwS3lPmwDQ2te3HoRrBWEd",
-	"PUssiUNlTA6L229GfXcRElpNmVm3uWqJOMmaEDlorZfCGbrkYbOupfm9UoeyBEHszycIL6taa1zp4d0O",
-	"bxPgNIAXyWwc8U3Ug+VGeJ8hWnmzfN+0UJdePz9N8Jr1bjsWpzhZHEOKnCvQRCu+FHpLY+6R247cOpZW",
-	"we1n9ZfL9kW1vm/ZnGg5nLaDV0X8UpUmTynqt0Hb2AZpuFgDUDVbosHubbcmRLkXsifWityOtnTFWFjB",
-	"oO7NjqWs6nUaHppcrN8G2W1x6K2Vb9daGZLi5UQHuIvCzYAvnlj8+m2XyqB7JHdFcg6WdWCZygzOVtcI",
-	"AdV0QGblXU1kvdNY3nGXSoWXvYg4OlVM6cbXISTykHf4Wf7RZcMKZDqKto1rkbVih+VGjr/f8+720V9c",
-	"Q9+mBGGoPTjbbgsVh0lWU6go8mWZQpuRHX8eRsF7VXF1m0twt19PXESJofgemcC7IUnicUpOAlV93r5B",
-	"rkxP+JOvb5ERSSS7drHqkmRibi9cHYTLDGRNxCoF1ipp8gH7LoJ2kb953yxnebmvWcxWEBnBn15UVhCV",
-	"HGLbEBX91WNnYdHeUG4RF/215V5gmtYYxaledFYQHQ1u2xQespT0EHfx+QoXnLUaajmfeulZg/RsfO2Z",
-	"hhEafmb/vY3hAj1bxeefGaHgAUYhP+BEn0JCUeyj0puJrJkmv8Op+N47HQjn+yVcoFXvXeus7SWu4ymP",
-	"xOtmXA35G8rtLrupfDy5UXB6d93Gj5USTK9w4NYwK3waoijYyoFV8WJ2L+TL+BWVhG1G1OcoWjj5FN+i",
-	"aOHkUWQFv3p/4prszjqvehnpICMmTGqSUvq8RnFx8naUaWvydegg+FI9HSujv3dcrIx/g9tiAxLQ6XKb",
-	"PJp0uuQmy36pd9026R+8Suk6NlplDveS1nHLVQHzek2ytkt2MIp4PGyVGstN6SiqTPrXuAX7JrdTekil",
-	"nNtekrsGVWpCsawMdxVYIhKHF2GTTUJL3jxtPcBSxHt8M8K3vYuJDA/Vt/p6ke0osjXx6RzvL5Ljv+LJ",
-	"8V+1eTxUnouji3Mg8rvLNOwq2ck9JCgASawel1Vp9mtSrWWHfzlvSFcLdXmw14fbQ909rYoNbsvgvUj3",
-	"1nQbXfwOivcqub9RJdozXUgvip6qfG9fCKDdjrb6y+tbyWoW2MGkoK4h2JpJ6HcX4G4MssuEONfTMC4V",
-	"31zOmvkNpsX73Rk6TVpSJr1ttwS4NZJM80zJthMQVu4X1ehXkAJot3fCitPfIP4rQFPIz3/iKtOYQlRB",
-	"ug3KIvGk9jrDC2nMSlrppfKH5m18o+lDi1k0AMVFQQ4/y79ui9zObnlFi65N5uR64dWudvIs52oQfcrR",
-	"LcUdNkKwJdlom6o6Q/SLB9IXqKJe8FymBU3GzYIrmkQqnJ0DVL9s7n7+pM2ss8P8jZj2jUrtKZfcZ8ks",
-	"xqb9yknRyS5gfnPbnpW3G/rLWr1guG9USgjbkIAU3/PfbsPgeXm5aTA2Ss8lfQEC81gh+zxYk9HSC8RS",
-	"1ouOn+2KwzB/RqpJMEQJ4+tgZZEYI/mMUC8YvWCscAxmR5FVPPK36Ic4awpb4Q5cGEVAqwJEFZNNpD9y",
-	"ny0TwkJsz+csd3+gRE0PJsfrA6a5Lk5S828NztL8vaVqU9Znl0oztT7YdH6VooKYlR6n6NG33KNNRtiY",
-	"AWjUZsPP0nRt9bK2wlM90tQCz5C1Kk9R5aNp8sk59Q4bxRkaeOL5QNObbb0XdcMPNzlDamC/ou8AGP48",
-	"026ipVdIS11l7wSdpgT2DugRJbcFoH5x/AJTza9lcRwuwpmA3TBcwFnbBiAvDURp+cAYjAGHXX0f8E5V",
-	"OBetbwDBX+L9jqV3MmV+9tLiuJGp4nYdkjL8zP/P3UFRUsrzXbME8mm7SGbkNMF89jYkDKZGJKGbNy1G",
-	"EQzjCfrUX9F3NCoKZDIM8Wv6UKJ0NZASCjG1P4R8zT5rvTcpcl42h3C/6flyEFaZ5VURlaRNgEpSZzwl",
-	"aQ+nLxJO+hw3ook74sjwM/9/5ycVVVFA5MMyrS8q8ufWl3YX9q8TfY379SqIFFo5Vkg7UF2DVIvyLXGp",
-	"28GnCvPTD/P6sFRZOoIUkfw5EKdB8gjFdYSx9uGrHbdtumC5Ci8uIvzcpLeoYEsGoQUNbkWA65BzF/pv",
-	"KgXEwOE+hJ9hEj6484T4yfoC1ntJdz5p1kSsLuqsAm9ACF31pk0e1p7hyDvwhjANhw8/8PmTbVXrHI7O",
-	"CaAJ8PlB4wBk3Kc6AFGNGLkD0XQAA5G5tRmisgldc8kWCiugsQEgg+tBMgUixbWpsVpKYOc25yhamFqs",
-	"JLuyt2dk2WMRSiXby2+aPH94/t8AAAD//ymGEYJDRQEA",
+	"H4sIAAAAAAAC/+2923LjxrIo+CuYPjOx9vZQUtvL5+aJ/cCWqG4tqyWZlNrLse2QIbIowg0CNC5Sczkc",
+	"MU/zATN/eL5kMrMuKABVQIGkSHabO85ZVhN1ycrKzMrKyssfr8bxfBFHLMrSV9/98WrhJ/6cZSyhf136",
+	"DyxMb/A3/OeEpeMkWGRBHL36jn88ftV7FeC/fs9ZsoR/RNAd/hniR/hnOp6xuY+dg4zNadBsucAWaZYE",
+	"0eOrP3vyBz9J/OWrP+GHIXsM4PPyYgJgBdOAJRYQZEOvaGmBJ2GP94HeaC3AbuFDG0jYxgJMxj8VILAo",
+	"h6H+89WHi+HtXf8Svt3djG6Hg/77V7/0qnABHH4C6/DHWX+cBU9BtrTAch2FSy9hWZ5EnuySes9BNvOy",
+	"WZB6H4No4sVTzxfD2DZTfi/B/L8nbArf/stJQUAn/Gt60q/AVwL6Cga10RR9Q5CyGStAtsIlGsAvCfs9",
+	"DxI2efVdluRsxe2V41mA46vK2oEpJrdv3Y2fzRqQQNsimh571wuW+PgVdm8WjGfePJ4E02UJS54fpjFs",
+	"5ZgtMi+Afb67uzhTmFvAdB0RZ4e9gfwVNNi7bd/urYwA6yPxMfEzP2WZmQvGMz+KWKhLCStO76IAgPCi",
+	"GFuOCZee6O8VcsGCLtGwLEC6IG48C8LJBxCqMK0FwFNs4j3xNl4QjWHdSARn8fgjS9p5QZ+ihQSBYedB",
+	"Npr5FlBG7/qSBXlT+nMRLFgYRMxjn9g4JwQ+5EGYWQGirvfpzG8BB3aa4XBDQChLs4tJ+zbKLl7C+7Rv",
+	"oexxL3rAXjbu4TRO5sDq38FY2X/79pUiP/gne4T9NwA+yvyMOUjiKvAp7DaXxymOYMMnfXSWwGcG2ATQ",
+	"0TKEE2oQ0QHbjuuFP/7oPyLOeUePYU8XjPP299R+I/gOHmEp1wubfD6j7zb88d5ttEiN1hu/i2CYxM9R",
+	"GPsT3J/0AtaaPPmhTd9i0SOc3IIz4UQI4knqyRFSEBHIrzkMMvEelj3vrP+TF0zhKPhbinLPAzlqgz0Q",
+	"M7uTlwluvqJkOcyjJjbA4fKMcfkCkhXQRjpJnMMZtliES0AOfpxbMU1TlGCdsKmfh0A/UzgFmaKehzgO",
+	"mR8RYOzTIk6yW//RAht8Sb0s9ni7HhynoZfhbwLhwRy5AJHMWwCWn2csAtSCTATmfQyeWGQDGQZaVeec",
+	"+kHIJncLRPddHjhwLO/h5dSlnVF583ve/D7PWzi1TsRT6I86i4NKIxVD7xz62OCBT/f0d3cwGkCQny07",
+	"RLMKQBpnSeL5mV3Q46dj75ykmXfkvX9/cnZ28hP8n21aGK5lxmB6BST23s/Gs3fMn1gvQwOgYHVu+zDg",
+	"BE6YFDg3LTA9owGK6S+mRzj4EY3eBsccyf4C2cACAX3zIrHXimlSoaeCivIE50jM0uhvGTXredenF4Kz",
+	"Qn8JEgDZLQOuilFSANdg1yAR41jFF35tgz4ah/mE0bnIJrYF8EYEbwqAHE14cwKThIEPN6a5HwVTPLjt",
+	"4pSGuRe9u0oq0d0mQ+kPP/SApcMJiSzRgesRTO16T1wWhMxiUYr4h/ZCAreAbxZYUkOX5849HTmpQUM3",
+	"yDK4b7JPb0BrnLhoHom8SlM30jYdxBk1vqfG951F2W/xg5uMVbBBj3aYoNEqgjUEYZJmUqk3GGDwsye+",
+	"o0jNNAhqBhlsfP9kvyHoJDgPohGDti22BdTvOHeLcYFBplM25iqI95SHEVxcH4IwyAJ5r0c9VwztxXCx",
+	"eYifrJQIYNzLxs66yQdt1qVcBa0KhUqzAFCrchUBPfjtIyxtkbAxAzIYg+iDGb2KCLAtECFaVUwIxdzF",
+	"FnUjdPgGm5QYrX4l76CqLGCAq3z+ACdM/X6bJwlsk7egI4I3skFSEeYKF1/3nO4JOMAo+BczqCE0L9Ih",
+	"rQqVaE9MZ7xy4SBGSL557QiKuDRfWE+cM6XQi6Y2UpHfuVBrEhuy5WiZwipt9oYLL6Xv4pBI/KgbGLx3",
+	"CyjAFXmCx44Fih9nDCZN8FAirhNiFYWF6houj3+Ofo6++uqMIZf5yE5ffeXdpfycjtiz92s6jhfsV09Z",
+	"r3kP71c1yH+gtP3V8/7X//P/itb/4QOzplmcpL9WmhLL/ao3RR0fWllty6JnVw6Wh8iQTV3u4XD5K04a",
+	"D8iP1j8NUBnQzkr69QH2czw79m5RNvthjiph5D3AMEn8BKNMPBYQ5n0QaN40D0Hu3Q0vj0CCxfiVZvs3",
+	"dvx43PN+jZNHkHf/IqvZ//HNOQzxG8h4+EvO+uu/kyjHoRahDyBQdxZN8CpH9mbfyxK4Z+C/F2EOR0Dw",
+	"GHn/9uv/CT19PBBw52AvjFOeiAlP5HQn0O242I7yWSsb3eMR0e28lW1HIAwZbMoPuM/r7EqKA5W3xPs3",
+	"OQu1Vfs2Thgt9t9fdM+2tFHl/alKVUTKKruTR3eJzTgCeKgK0sJQaZNlMOJ9noQtMgy/TXK4Ikubmovu",
+	"qjoV5r5WLVH1uVfWyg1YzWrgu9op60t4EUvlyAifAD226jZffTXCr7jp2qEhzpGvvkKR/tVXKLfhqPhf",
+	"//f/542F/sFZkq6X/yZE9L97noet1YFg7PLVV8gP8AkNQ8AF6ksquiN8wEk+LK59ADLXq/4/RxdTL54H",
+	"GZxtwFN03KBNyU/TfA7HnZ2XEAfGFxS1GHxFKSDDrjC6+UElZXhJP8d7ZRN98GZ0rQcY6RoqeQ8OXx/f",
+	"bLj5TNxQ8f4p+kxcLZLUtfN746hYgLagJjGOsvY5Tvg9mjfvFZeZOZpFUBTiavgS57QcziJ2vV7+s4t8",
+	"47PfssQAJv/m4Ucr11GT+wz7t0wUJxnHUX0e9ckyCXy/r++NeY7rZGK6CRSfGuaIRYPGOcQxva7uZDil",
+	"v7xDuHLUrHgGpy+oGf2lFJ9GJC+jMVyXgQ8ahNaYGihBJL06EGfsKYhhAXix7QmUJ6m4eQdpuQs+ugT2",
+	"N1yapAXcLN6gPTyLW2Z7anxFLx7ATYM/OT2PqxncXzPEtM2OGvJtv4OfRgFwFyYVvRoMQ9JkeNvgpCFG",
+	"sftonF28HYxu4dNt/61Zn3hmD7M4/jiQeriL4iz6aF4GrXqz6HKvunS3+4ohuriSSECdwVvRe0TcS0GZ",
+	"ewO0xMgUJwnvrABMPPTj13EM6n9Ef+J7qnB3Ofkt5UbkbiqVYYo/uR+BjhPphwFKVL4ABZCbZrQ25M9U",
+	"eKGh+VDOQH6ELwV+aXAnwJUHE7kwpjqkIziWhiyFK8NLgVufoRnmhI1BUyJkgySHgVkF0XCLQ2NT71XF",
+	"IWQIhwR73hj85tGNsOMXArLmCINgngNTPIO23V/g2e9vnC5s4zej2afWQBtKiM/w0vOwlMcrjVljaxjy",
+	"SnM4O+VuZJteUsMUDatCdYxsToweNATxm/zjcFvEA8KZ7jy06XU0zdGyPZMJ0jx3TMJ/15yWTFtTme+C",
+	"nrdfeFHlSZpXxd/bvevRB1jgUwCKGFo4gmjVBV4D7SZwCL3wEqvTNC8yFq2L/SPR4LQ8YedT6sXGF1ad",
+	"wF0YG5wluT64yB9gPWgI0YW01PM0F/PTOJoGj2fxOJ+LhWxkTZbhGxamLhbwmczWY+qacz2Xb5e0YusL",
+	"uIkBxI0LifLo7qqIsrMvqCMHmy5kOswvBe3K8tiE2BHLMlAN05cCtjq+M45T0dFEE3DpXv5YuQ5sfgFN",
+	"s7TxLvYFsGv3Dwm/gGmYh+wlADcMvwK1qHG8BAZC0KV5vaKhbQx22/jN6JZvC0KwCD2wckcwyPxb6C82",
+	"eNMLMQzdsgYGmPc98sGBJQQgxIX2IWgI0f9CwLoDaqYUDcLfc7jmwsU02jhZ10duRmjRHiQzG6Mi6qFb",
+	"JtnqxAMt92zjl2AyobBJJ3hBj1+wJBP36DlLU3T/MPglLMWxIQ5BPwXwWE5eOjVHGHzwytNWTuGt9Idm",
+	"NKOIzj0FTGFKiR/QqGjC2m0FNl/ggvaYA+o9sBnGd9HdsDBL+SEQw2QJ8iGKBPjGqz5H9Bq4xWegFYwM",
+	"m8MnAeCCTKWG6fYKSWplBGV+EG4dNzjpDtAiMYCs+cgyT0MTQlSyjJTiAbaNIH3uXeKp0JRVTIZ4FlUP",
+	"ihXjDLkKZsG8RGXoGr8JFAog7pKwLt/kRy9PQj3U8OWkmw5OV+pDr/UCaXgkGOyHW6W5UT6f+1y33Reu",
+	"JHOlUWzdoO0qQoeALWOpmHiXUn2hoDBiB02ufNJt05CaeJ/ICO3FXqrAUsBmfrJt/MCUu6Qb6JqYKWYH",
+	"h9zOD7canSBAZvRw2XiQyGkBkoRSPLzuBkXlyfcAU5NybLt6XGlA3DIa7whrMPMNXs92izb0DakhjATD",
+	"G3+y6Qv8IEnixAQRzKU/2p2GAfQbsSxf8NvKtqRjfeJdbg+ZWggiNIXmC/2ihPZ2WPoW9kY3DYzFrGlx",
+	"N1E+qehlK+8nsIQ4T7iaVnvK3cpO1syHW9/GWk4J/WzjWTh2YgcwTb2HsnuiACsD/F5Eyu0EW3LyPcTX",
+	"XAONA33pL0GcbxVPfMq9vMwiYAVu5EZuFz1q1v1EzYBCbYMnVn1y3QqKLLPvAarwRGMSutKDr/4miWa2",
+	"rQryS5i6mHSfSAotaqnZ+2ormKlOuwPcSB8u4erlly1p71g4L07gBYsw0hsWuCX82KbfAxqaAWjoKJag",
+	"BlCGrAz1FhmtPvG+IMqgLenAbllXMk29d5jS9SRKNBX54YglcPPlN6AXv0/JSeFOh7N6jDfsvUJ5vrun",
+	"U8vsO9g/Cty3vKE+BfzdSxemJcjFq9ApJpHZBeb0+XdtO9CfDnkmt1R/m0uryNvmw1dt3l0jq0x1ha++",
+	"Duh7EY16SlnmdoCpMgA7500ZnavS7tnYcgeo2it6quJD2IB3gJYPhWfwzrFT8mKoYKpiv0u3iKrq1Lti",
+	"s3pe1yp7nWupHLd56dSm3RVySjkpDZip3ADTrd7JK3PvDEfVO2gdT++DR+7IR6kWt4ik8sQ7wNCwJo7m",
+	"EiSRHVLiyBAPtU1yMk2/F+LbFNulkHY9DuSRg4lwt4ivysx7gSrK8xdE01g47GHuv+qJZwhX255FyA7A",
+	"roSXMU14YNAxLeFiO8ScAmFvcCej4gzYE4FlkmW2irbq3DvDl4yuK4qaVPE0ZGMAYAf3mfLEu8JQQlA0",
+	"4oe/hOwEQ+Wp9/Lmp0oQqYzFO8BQMfnu6KiegtlOTP+IH3aAJZh15+j5LX6wo2UHONkLntJfWzlwlTDH",
+	"LaKlNPNeXF+qwZpKFa8lRNzmGV+ffFe8ZUo/WeUwdBGHWXZwiFVm3hmSOBgNB73Mhh4yYUrcJjXVJ98V",
+	"op4UJIUZs4oqEVSbaqHjW8NUbe6dYUqEBqdFBLwdUztA0F6cbM8aMFdxdh7n0WQ7nr0iMJrXdiGfXYr/",
+	"xWyqU4KCQ3QxX4QMM3uwLcAF82GyGjmhesuU11pEcOFpXOgExsRFWyEow8w7dxx3zsV0PQ5kGqGtIEuf",
+	"DxPabx9R3PzGyweJrEi6RDInjtoKbkxT7wBBlhp9DUjaKgXZ5t4NNdWQ1U5SRU6rXeBLzr4PuFL5ugzY",
+	"wmyip/5C1fvZvkW3CsE+ePSMNXjwFNRPRQLwBvMR37JPNm7M4NMJJS3+v8jTMmXZf+TZ9Oh/lBHHPvl4",
+	"BgMI71gYxj1Max5O/rd6RH8d5r7IiYwzlTZWmeqwLOOWtrM6526ERD2pg/SZmfsTJrO9ReNA5DByTIr2",
+	"1k8esDrRFiOITVPvBUJL1bWS+FllxxiPpTdeyRC61SB9w8x7EtnALbF8LDuhbc8Uu1szbKkOn0l0bTUm",
+	"Zi9DYVxTH24VQ/tx3erxOBjXfIsbw5CqqdchH2O94t6eBVo1pX/kf98mfjrbNhpp0sJSqzlU7hE2VcnJ",
+	"DKE1J9Dc/tPJnj2bVF9MBNPqWS4nhTPoVjBUm3cHODKUGtMPQl4TaRf+IuWqTFyV34UWJYonmd9IRK0/",
+	"zmp3mIDxXYB1s7Z1IFrn349Mdn5ABeGEBpEjfBUFor6AnWEO5FWc7Mdt0Yoy0jaEMVyUf3tgYfzsBQT4",
+	"KB/DT+kaqNvE0l3WLCD1hhoz3cbxez9aKk/8l38SiWOMEFwqn3uE4i7yc0BvlAVULPbloahOqGCIk+Bf",
+	"2wNAzIazk5s9+v3nyVaNDfWJ94IbK9EHJTsDVvGgmE5vHPppqiVt3vY7cHXaHaCuXn1JPytV1ultomNP",
+	"b4rGDNpYNWpL2ClPugMkadm6qZZeQSh/ynJWKk13mn7P4B4LqMzgj/qCfdnGWGfeL49QFOlyaU1awsXE",
+	"qVquuTPh1zRTKhfUApFq1w2WcjcLFNVtNID0C6bRi+JoOY+JPLSsen28uQfZ0lxi8GPAVRWEKYHWwrKt",
+	"ZxHLU5aI8omlxP2yRtyHi8GPgzP44ebu8hL++MWQX9gUxV6Dp6+CyVWpWT/5iNHSTVXGehU64zb8ST8z",
+	"LDiYg/7gzxdYlHIehHAjRwP/BAv5sahWzgydLsRoppzJ4tMbA2ZvoM04WPihqAgjmlZngFEdaGRSxlkN",
+	"Dom0OhjDCjq1rz1yK8uo7m3mfe0CSYUM1bRlCHW89LTNqIubXkuJu4q8bKKc9xY6wUVLQulR+aH5IluW",
+	"Wo1DuDmmqJn3WvhOn7J5NZT3o07eMkRbNAC8Bfh9HkRYdJRqFcAJglPDn6f94dtra05EP3mMy/PxYj8w",
+	"6Nn16feDYZdMc6rr28HVYHhxauv7lkUsCca2zlZo39pAfTe4fO+e4KXodvf27cXV2/P+6cDaO398BESe",
+	"g1C1DPK+/2FwZev+3n9ikaXj1Y0V5quFDeSru7eDW2u3HPQOS8ebn27fXVvhvFnCjcAG6NAO6NAC6J9K",
+	"mC6veGHLRfHojl9hnGtQO/6zezpDNUPXvD6OHZuIs62vfbvbejZsQFvXq8VqCx2u2M9OZW097dKmdVNW",
+	"69bGvX/+Uj30pZAnOnVM+itpmiv/QmGon/L86xuz2jop5ZZx0/mC9AelVk+0UR/iGM4iuhFSReHAChMv",
+	"Omv4oHOrm/+RREKh6b8JkXsnN3kY1g/eV6DzPYA6iD4u2EDoN88sYd4D74g/CYcJlZSEl9YpFu2k9xQd",
+	"Lv0008AyqXZYYEOe+HDFzwg8CR3MLoDr1TS/NMCiAWwRj2cmJU+vb+SnFg0sDf5l3g9Z869Vp6fodhK5",
+	"vVJBaPHUzGsN5wkqDfoeN6ohVdKsK//lRERtirWqeNKF2C2kWll+xFdemcFlddz+VC9J7lqdBe81QBK+",
+	"l6Ciil8n/jKtrX2axIYq0edUcx06KM9nHMRWCr1GHXTnwlSIrYlKtbVeyE5Ulb0OE/LK6iBlceaHXfZX",
+	"ZSe31R9PFZWJavRBVMDV8+Yxglu0oDL2WGPb5dm38t5bKxJUfvyt0BxtaY8Xo1c7UcKAtromUhyAeMiW",
+	"Mg0UnUaTSYBY8MMbjYp4EXDLnYAP4qlRGuar1tIuU6rIkqW/nNd3uQkvYoCmFetrtaxHW8jmTmrhSLbi",
+	"1V491+B5oDummRhhJWEXpGdixDp8sPleUI6P8AIbHJoq4KAtdN9y7JNm74WWYeww1/bYZY8qXPAyWgoe",
+	"6qfxfO5HZqCdTmuJ/haLXunwbWrgso6h3lbre3d3cWYcPM+DyXoqhThTDavF3ceKlh9sioa+QQKUCsg6",
+	"rbtICpEcz2Dy4xYiZfCT2esqx3ShE23S2KdmexlL37yQgS5mvmA6tR8eLRdYMdN5wMJJkYiw+o668EL2",
+	"xMJi3VNsn5ZB76kntQD0/JCXm4zYswfnIxw5ppPJ3QIpZ96o+dFscBQYbaLO6ySAe6ZhU+/eXF6M3g3O",
+	"pMSWRWuQTorq3llcEuc972Z4/c8L3ivgl56xj447Huoa1DZfQEvmzwV9q38CVX9aajZ1BQGagPiojXZ1",
+	"rQqbUdNfsVZ5jd30q7Iru8FSFcdpddoQPbyauoXp9It3JV3vmaSnRYX95ICVydw4UCJplZrxZeJUI+nW",
+	"hSZKxDpx13kGk9Hskgyurm/vR6f9qytOCIOrs4urt/hXfzSin877F5f0x2A4vB42kghOwf2zNN2xEoPH",
+	"IeCeLDKaGsNallQyrkYOcQGxazE8ucgqxuRQbUgaqQfQym2jBq3uPl/O7mUl70nwKPBSwyLqSwJvdvov",
+	"GSDiCPQ61FQ4NLKUUs88Nq4tahhZDUuDTYOImNY0WkRRRjRZPwzjZ/OgAz8JA6rJjKP7UQwTJHxw/P8P",
+	"qr6QeZIN7rxAes+NBMjNj445kzJB55lRl6hW0xLyAjVwGpKWKdlOmiNKz1of2fIZZAsegNwRh1qIdPjL",
+	"ZtbTnCMNYNcFrweiajyj01HAV6fVlldBocNtWAEH7fpjfQXv4mfvmYVh6aykJbCUfgNKwvNxFjwilQXo",
+	"DpdlpIkqsTyJ4SRiBa1xQ2MHfd1ZMTZqxGWVVyKvZIzDpTfSJtayNFQZZ8RatRd1ldajweSGbSz2uytl",
+	"iMXRJD3LQXXrq9vhJ3qabph1NxJq2dPAa8GLyUT3zk8i9C1UJgPezmZ17HIPl31Gwi7r0IXMPqMsTjB8",
+	"zr0b9zZz7vBnE5pEhSYHRImW23sE2abhZd13/s1Zc5RN3oSSl7D1rGLIaXkqWkPUt5kodiOc7Kg2Slcz",
+	"ZWg4txhaGt5nNmcdkbtS9UycIs7gdqnUgozPJfUT2HMWCj++lGWNuofRNt78XuKrM3y1x5KHfPyRNc/y",
+	"sPSgQxBPemhiYDwxdpodezf0K69lCQqhehpIAQqYcR5knHmc3gdKi35DUJlYaNVHj3ayKbSH0rOCRFAT",
+	"bYh9czC1i5b7aHLX7Satgp/uAWob7Kd6JyGOZc9ewIQvF7YxC/7BGr8ha7z9uLI90rudAC9qTncQBFb1",
+	"kDtbeTVx8BJa4hb1wO1rXw58GiuDsQvWhHl5Q345Djxkf00Sduaymbg5yKfWYyOvUmZHl82pVFold56o",
+	"y2Alsmg3CctyuGlNUDmhyD0sw46u8VlaFGHfiEXceD4ucptFb+7oWlBDSvlusBZ0ZHuU45mAXJ80xLbL",
+	"9o67fOOb3xUXfvGqaHH+IWcw/I5PBmShWXJPNrUV5b2e+en7OGHNIofmBXEzhcOxB0IHkJZoEMz9pTeN",
+	"QxEMahJDaL89zZM0TsxPOWP6hteDKcvGs/IC/WlGKwEACBBUo4+9i+xvaUHeDBhabXLCSLGOOJw/R3Ik",
+	"Aj3IpDEYo5X5i5dhUo4uD0/B5PjnyEQdupvQKq49BT+3ua9onKphsqc2z0hWeTYz38X6RcwnckLlHnYH",
+	"N80bP03RLgw/G6Kg9Kgc0y1NJTIzCiqVVoy8uYQ8xBRjyngdR+HS85/8IKS0txjLluILTjkBWQExHoL3",
+	"/BB8pR8ur4oz4l6+RaoEqKg7PUYIsXkJNu/g2or47wQl9arWSq2L1xVFn8nOdooMli9E2p46bPyzx78T",
+	"jDXD21DtQA1Q9mkBFHiG12Hj5aVN/b4BXgg+dTP9CFLv3tWMngAIfQRCYnEmNsOAI2zjUSPvzLZlfhC9",
+	"Y/7EHijX/DXrJCc0sEe8b6uE0ADUwdEm/6UZP3KiZvzIVs1hPhdXlxdXA5fVZWyhQjtu+29G1lw4/kO1",
+	"Qz2sI+sUz2EGo82L3wRIzXF/tiqlZA66tNgCrktXqCCz+U9XFtu2y9ikphTyS/FqVEzY4pdqA8/P1sNI",
+	"ZSKFmTYsaNf8FmR4smnP5Jhq1hDRscisH7bDZTlpWvcohR9X3qDOIlUh2wJpqVFVzUDzZTDGEDuMcQIt",
+	"6zb+yCLjYVypk2mOsaVPqMtxRaB0CYJjT5l/eyp+Aq9AMtepLFwo8p3QpYFs7lZLgzmuBsiEmd4OTvmH",
+	"Ipn4U8CeaXSbi1BndyQ+rtUfg5/labdhuebNEeZ76BaAz/cS2aBG/y1DRZpjj559l6hxG00frtHjykXD",
+	"3UkqLvw6REiwyGnVc5uQNmNVjGNn04LlsF2WoRBJScOQ5cSKio11e1jKWoVmhalGmUhIpt1s69c0eXlW",
+	"bAa3TbyGYRb/lGXyughLCbVAKVDprTaxqiUFm2hPW3wpZaLQWUSna9O5V1nlkO9WLb5A41sHcW1EXQ1f",
+	"9DOXJ9UCu7pPpHJ/69/cDK8/kN/bcPCPwektd4H7583F0OInaYpIbTelqkDtBpvPdt6a2yMG134geLGH",
+	"5LZnAu37m+WZ3QWvkynUntDD+gyQhHsTw9cQQN10qZ5w+m29VTfvyJ+tAL33o2BqVC+qHKRa1m+JxRDN",
+	"aFUt7Yi69JcssZh7a5d4apzadPYuNFMBVI7QAmfa6ujDm1kfcBq9VGltrlptDXuGC0ec9tH9sHX1Air7",
+	"4iUpWK0LzjvVLH7t2Fkxpqv9VciGokNY9UuEVdv8h0NJLmI/2kmxgQiLJlXyaz6q5/rQHZiwyh12c99q",
+	"B5EZGZwfhsBOl8E8yGxHzBsQa8/BJJuhRRqLZnsPy4yl6MIjL4BAI8wHolAh5RTy4mvBMa+9OfBL6uVR",
+	"iHMZ3ld8LYFV9ZDzF8rPT7YqfINc8yhNfaMDtjb4RPeDUhcO5Mc4FUWuZlSKCzHheMVgyVMwZn1R8cB5",
+	"dtFPpjB0XCRdxJ3nIO88x+ArK/no3lUNLl/KeUw6flHSRoqgxyCJQsLQZ/6ywfMS8CS1y7QU0m44wN2F",
+	"OgWfOcTzc1gco+cNbtIolsZWB2lzXH89op9Fj9msDFJacYyjSejpugenBvuY8vg6D93CgD19PbbsrI/A",
+	"/zgYfI9pkK6vbt8Zr0wDmfS9UvCgHi3HX0yE6QNTIDLuvVi4X8R4GATRDGDPKPgroATNcfhkEAMLNU/H",
+	"MgxUKi/tnHufjzCi3q2PBwK4YjbTxvIcroar88QSnjfLsoVMUIqNelqln29ff2v2k7aoUX31TCb1f89/",
+	"QFdKyuRPkJlcBeDQM76k35JJBc9o3boisq22MoFYjRzdiKxPWeIXhv5K9ITIVUqNPPVSU8brR0tOSdjk",
+	"j9JDSIj+KQhzZnp1b7BB6+v5SG+6vLFpMeeEFp4h1xjpwwMGxHkiX2mBe8OJMBAusPgTKlRZ6onUosgt",
+	"H9kig6MzC0J8lReWnI3FZ/LQVALaZA+V5FwJISN6pWpXoGNEj3o9yI2ZVZUzSim9b6OdjDt9ygfXimO6",
+	"X+izfCjKORKyHj9w0DKnphzzR4SUtsBoKV7dKJLO/G/+639rVHtdTnsn10XhWFN2sqJZFBxyk7sYDM8B",
+	"bTZLGn6zms9mbPwxzecdAx3crG5NhqaGN/huxiKza6jAaLG8OlRl9NK0Zswm7NmHG5l4amlzYlOCJKSC",
+	"LUmcP86kekUDIbNwraYWdA4yZ4oeSuIxAFqhgrvw09RwNK8jX+S70UZivTX7v+aK5Bjm3RAZmdhvo87X",
+	"L2PwIB9XX2lbaHiVCKwZfwqYq5GHy5qvOqiYqCUWW16hkNLet4VR0rQm0JtyMTbZLx95v3YDZnPwq+my",
+	"8ra7i9Lb7fonvU38Scg++Engm66J4gNAMg599MUDNuNd0C0TK+XMrfEfGSDmIc/Evyz5RWwCuIBQRTsH",
+	"rKPsxhO2Y5cOieNMJFjOI1qGu3JD1b7STVTLUUHCFYeiopyNaWgSyzM3fvlgFR0NSG3LknqKI58VAegG",
+	"HLJPcKeEjW5GQBGsp8Oi7mfckgMXiBRLwpbqe7uJW2efc7Uq3em8eqGYiVu1jtfSJBWUWrDQTjNmxYaI",
+	"oe2BsNnxYfOvh3+Bx78v413Pmt246RyaIcm9wJueDoz9Ra9M8C/9nmcSbHaJvSydhtTveOnPw563CCIR",
+	"ycF/xWeKOpuGgW8+jKTIaE7nUGT/0O1ZjfLS4O5vu5QkbBGnAVUJM3/m032wOaHIODCBCoUggYomfjAP",
+	"BNo8tPaDihJSoL1VZRQXJYXdRgpoSmnFU6kUFwN1YMMiQI6NtQrNxeltshtd2B58HyObV6pTBRbDKrpW",
+	"4zKiokh/pKpvDIYX5xfkAHN3pf3j/cVohN4yJtMuDlyMaRNBNxa0lstgk6M84jixO8eDDowOSd+zpcle",
+	"mcwptoQykY092JQU3zzYAu3ZVJyHVC9tk3F3AA3cALaOz3tbnvFGqcz7Tqnc2xavCf8A8fKoF+2UwqV2",
+	"L0Ru4xX8zEc6j1uR9XYcGmmlbWynrFJW8iQwRomlyPkumj0/UIs1mBhEL0BpspFQxVWQAqowplVTS126",
+	"d01LrCtYVdUcB2rIzE3pArzCK4DPqz0LfO1o6nhkHWbB5uVZXr92noeqqFtD1igzx4KbRdTw7oPLDDv1",
+	"sSs4ojfp6jxft2aTLOigjc5aKhhJmtFEQpGpRuasbDRodI+R00E6kNq+k1ppq1uprWsS/1QnvpZsWitQ",
+	"WgmctrfSymRta72UASI2njI4iFG+pJqHzlYIfpVkTQcmcWSShiT0Osm0p5euyWOVFZJnCk4tKaW780YF",
+	"loMg3ncakxvdRmTl0uYNqQbqSUbb03geaGK3NEGGFLmxnfi9lNS17SiUk7TRmtWaU7+N2HOS+OXB0q6j",
+	"rZKg4XDX+YLuOpWorUYCqgZs1ckx0UZx84iuBLG2M5eYwLaeFq8stZZyCfGDrN47WS13psseOpFciULa",
+	"6E2ObSW3BseohtvMOT2S1yqPyafzzuO4LbyA9SC5911yc1qwk13ZYaiZTaTfj69a19QIfRxHYqr4rbVx",
+	"UjGFbVHvg0f+0HAx95tveHPZ0qMHW0vgzssoQxUoD5y075xUIErfGm1ufY09STo2Ir2KM/U8htf/SBiG",
+	"6oaFiHVgJ8OwrRylJrHBej0OVFZP/zFd76axHaqO3UHGMi8K7AwbO3JwGS0H290avFXdLhslCk+hMxYt",
+	"cfswAjlols/SzXkiungssmRjK8Zy2n0DKMuDFN97SpPb7Ehh1yDHkmDSkcZi1atW/kofbxU6kwC1SvVi",
+	"ppaloueZyilp5CbaWa0FGS5BD6xmjKx4lxXDd15tDabWGDt9MuuCRYm1vssLft0yW9S9w1IEtep4LZ4C",
+	"bsuvQHgQKF+AaeyGqnOwaMxSm5PQGQ82VIEvSISirkYRDc/jZCc82MxXYZWTmKUYCJgyHq+ZxgnlZKMn",
+	"BhFgVPUcoNlGMXdAbyJIhJ/aNWLzyoLJHhbtemYA7tfkJg9E4RgojvMO2RhgcXHWSahlwxt2d06sTN56",
+	"K22lAt3pytku05A99iAGdv8KpDZn5T3tFHVufzAxl6tr0nPkmHvoC1cF7fBO9AUdhnJzaZlv8iCcNAt2",
+	"WYwDm3sP2N5UPIp+XmGcTvSogXygxH2nRLHFbWT4j/jBiW5+ix92dQTT1B1g7ETTuP6D4Wp1MiOc24ms",
+	"cLnPQ9a8iaqpl+ThQd/b8ca/Nip8ecu7qrbh3jAPu2h4ZUppN3d0eovggNvIdISlrKHFRDpRNK4xla2V",
+	"G4fJNV0byAkBNRjaPYXVHNZ1xaaEXeKqLQr4tFX7GQ3efxgMvUWe8arUVI46LYpuF8nIhoPTwdXpT7yM",
+	"eJxm4lIaLlUJJC+OSinaaWjKR0w9jWFXtA5el9RFU1cFojd4E65Of9B9Pn8t/EMeYlmAh5C5OPw9qda7",
+	"ftY7kIDdHNG5XlaNCNwLZdno6kdZ/8nBIDLUalMVZaMOVLVfVPXssKPmnXSiQUEwrZSnxm2jvEHxHLMa",
+	"DTY96DCnwVsH7YIZtZ7Dubv/b8vFJhvJNB5jSi6HMGynAsdmm6/exwTEe/+JRZ0j1+fYqz1mXTawBHw/",
+	"JnG+sHx74rmqUmsWq7SUQQK1bHMqq4pK78pu5VRaTqkApF36PGDhxBZOdh1O6HoQsWePcoDyVz0F7RQ7",
+	"94AAKUe6TFyJP1KydH8ykfVq5rEp821EBVfQ44ksqTUvgHBCVS2fzcRQ85Q0uD9aNoy+od+TMQlAEj8m",
+	"IGDNhQ2LfBgOKWdMHm11UuUfREJhvKQdUcaHkCqXVp9SKf0x3B8DoBGqUNqxXgCLUGmyZPbnE67ksDfA",
+	"rkY531zovCUTUyJztZt3A+6pwSKoAd0amAoNF6GozbNSYTnDzhrL7gV63XRZ54xjWV9csS/llJkadn5x",
+	"oy9r2sR92/jSzpY54r3/KZjnc+1ki7QJU4388aybxXnS8ybSCyGLva9fW6p56cRSSe47h0MBJRZyPkt7",
+	"ntxEOkIG7/sXl57yNe2tSGnlKd/GXsY+ZSeyhRAAyvdJ5FziFh+RUVoU1eKHNVlleJpr2oPehklZZTmp",
+	"ZCiNYBAM8hQKonc3vKzga3TZP/2ejo7bQf/9SGFOlGam5M50YMjqYDGmaZ3wgl5tZcAaGErSuCOvyPxu",
+	"0qpF2wzDEPhYdhSBN5q26gxQTySmCXIlvOVGyRl/uOsP+1e3WBK19+pmeH1Lxb3uzwaXg9uL6yv48Ye7",
+	"69v+/ZvhoH9qzlt/teieYi1azLeaxOcqf2RZdyix11bhvB596E+egjQ2urqAEik+Si0O2ntcfxf5tCnx",
+	"LxUSQOHE/fp4xYIA+C4xVgERzZ2FLwIp+xjFrjmPV1GFqbXO3i8W1GiQ1mPCuTulWvzDkozRAmE98rnE",
+	"HO9F6fZEax2AiGcBZZpTDSLUHClkndqCmLC5aTpgTPhm8oOnU74D6Dy0ZTgwmq3aE3OZsidbcH5TrLBy",
+	"nI/jdJnCnPxuL6snAGebzgG3HFTFmD17plmFjzpI3ZQIOZBVgciMBWSpYqxeGUWwnnjtwENncHo9+ml0",
+	"O3iv04/GgM1YsFZKLgNcW/40+MQs140oS+CiN7Z8xjIw97oYcLhZVOI36lc3PTAEWoDEmsZdSqt1yEfc",
+	"ayqGBoBeEOZF5gCjCYPHG6ktEsWUIgpvwawDoO3VHWfC+CG9DKKPJoFUtotQU04wMSocmGDNm/lwuQ1B",
+	"154sqTYPv1mFNCBgC9jApzJPsmyCUaWkke9kCycwVBkGUFTJ5TWLk1IFiWqZzA4cJXHNJpbAPFOqVzxR",
+	"y+volZDbuKtypho3NJBWMLeno+dJN3PzMaMnI5VpVbnlEs0NCUO/mYwTD+XJBK0aT+dUVJEjOoLrQjgB",
+	"/R4OF9VQkJ/eCmOpgDyCjyBe0phOLr4i+A9wapIWvWETpDziRXKMeU8zE5eSCRKYU3QnOKSKP19kS1KD",
+	"8giaPCJRyt1yyN9bMGUVq6btNMYDNZzyoIMH3JaFx3rhsSlqZBN/+bxKGmIuzR/oN0C7iCWXicc/LUUB",
+	"wOpbtlCw5FM1yXFiUFGBb6OlGqgvt7dtpFQD2b3oXhY5pw2XSWIvJk0TKQVUjU6kXxKca5XYbqgZUkqJ",
+	"XadjPaBG4YX/1tP/wWvZSPqp0A5QEwXkoEKol482V9G2V1LkfNgtOqmoiCWobmi2ilISOr4ASa69oog8",
+	"ryifsjn8W08hjKLE+/nVz/nr139n/+F9ffzN8eueR/8cw7++PX7986tjrw8IKGnIElNldBy7VcsWp7Mq",
+	"xqHEk3tVDpNksJqYXImnQz719gIln/MulTfIAf9SlbJsgBAPQbfrjbrytgaKFMN3gFWqfVXzvFDHW5Qm",
+	"EfMohWosrGJ8CnOxw4/BYtE+cP2arhWhFYqh3FigFl7BTN2QQIpR3FK+qN4qbA9s2v1DQuiARBUyaTJH",
+	"sE+oJajU38rKJNVnfkCUZbEW6DmVqgud0XRAb/RYlZbMDR2rNFwscsx3OVmlNrNKRLRV/hhCc5erFT6y",
+	"7PjK9Y+KeIFUUIi8UemIW7nYkfnUrEHDfy+X6yrFseslOt/3r+76aHkFaWQ0ct40aR8UokiG/6IUlSr+",
+	"eX36PTkqvu9/GKA99ean23dkWH07uBoML07hr3eDy/fwn6u7t4Nb/O8N/mtI/3vaH769xsb4P+/u3r69",
+	"uHp73j8dtAG5QkxySYGq82FlwJUDkpdml/nVzmd7IDNSvQ5yAyVVwLPe+vwCZ0TbSvbSLckVf62SoIyp",
+	"QsAb3s2quUiKnnpH49KrsdJGa3MlirscxO0bwribDKSbLHLjXOJIrk+vcNRzcxHpULHGMF61/KOpSJ22",
+	"jKY9KryYDHU5RHC0VExOLwy7IhSLYvf8+t4ayjvP50E2mvkG0fqur26u1IoflvV5yR5irOMp2lrcI5I8",
+	"ujO9/N0NL9XRbKK9+j1MWagrjzwXnlCjOGrgel8atVQQeMb93YxnVn3Tltks7u4jtKBuW31r+iGPM98G",
+	"2l1K5S3xebwWSj9O4jTlSXazWcJSNGgBBkH74ias4eDtxeh2+NM9fzq8fTccjN5dX57J99q6JVWWu3d+",
+	"jn9YZqwoya2rF0r5wJf5sR+yaOIn3jyOslnPe+3NQW6mXh7RykgXdqpeThbSFugwWwBqohw2Qabc5IoD",
+	"FK5XBSZXhEdhPbVtHCwe4yfoVkG7R+N7fiarhoeAfX51pI2blP0X/sdrso/9z9cGTwMdjlYvr9YsBBrJ",
+	"Z5qbIpYtKWJAngL2zC9BaKszPWFi3XSHo1UC0pftAUQ5v8sx1NfbYt9VbiWIwAQhNousF7FhOB13rSXf",
+	"rBWrbjVLqbjEYl1T2qxeySRBwg73M3W3TRjO1koxuPJRq6iBNugXI1na0knYEg4sDcXMwhioEiBAu2k3",
+	"7y9hQ16p7xhVv3xxE4cBr9XmpImflnqZhlUHgUvkqzg22qsr8prhK5YgV7IcqSmNp9lRQw1yGdIGZ2HG",
+	"xmZ1ieLjCuFcuDSCsAtIHorYH8pqi6Qc4oVVOsbVH1Wa6+K2uqAF6ZlYUB09cIyjPa96oTajQQMpSG+o",
+	"sJfZz26VIhovVqvSVinSrcq6kA8mf6x6cUgNLz1Zn73AfpOM6C8W4bLp7ViUNfDm/oTUcHrtGiPlcAVZ",
+	"L95W0qTWroRR5kZ7HYxJshzmUfNzplwFWR/JnwEnJB8/8sAG8S1SKBmorhpdyufrNVZ8sCYqMRSsk8Uw",
+	"rQWX1zjDtymiVqk1u30ZEaQ/5D7ciDK4CE02J0bQy+S9ECUWNxSQzY21m/dYN5IN7u4uzmx1LBNLIFCR",
+	"AQz1XvkOIMORuVeAygLkQDKd5KdRvypvRYu+VZesJWS4ClrbVXSUP4iLcrpgY/R6JSXyQ5BkuR/ireBu",
+	"Af2ZP9eVtUmAY8yDyIe7Fy+Yu1ggGuDPuxu4ng76720kIscTEPVefbgY3qJ92BYLykEplCIhnZZUxPg7",
+	"vmSMMonYNdDlf7ZEllZGa25dgfXPX2q1QB14QuLNaFS1uqOVN65v0bnexc9kG02ywmZkPRJJjPJTY6IZ",
+	"0U9ht255ydWbM/EXuSjDXyZDuPFgNLiT8Jk2cHL7avHu57VAWE1LrGsYcG/K0N7ykS3Jb4hukaqPQitX",
+	"8b0F6fjo6SK4gru54BOisr8Yqxu7uCyUlzCUvQzld+RTf0k/E3hqp6azeJzPjW7tZywlN3/D9jwJkSC3",
+	"6djrA+7GmgkUD0e4hAbcUPU8gwMNC0bSgBhYJRxH/Ez269ErfXkImS8SRgnZNPPyaO5HIA4nx3WN7mUu",
+	"a4IgnBXEkWivFXiR1AHXpU/GN6LiPFD3pRJFBfV7VE9auHh6EOXTKEtnd4pb0WOnu8T8NhWxaSG6ocYE",
+	"Jv8iRxGmDCB1QTYa3N5ikejeq9PLQf/q7ub+5vry4vQnkmz8ULq/GV7/E3/4cfDm3fX1940C7q2fPGBs",
+	"bmayRI00NdBL4mdhCvwYRGTu478/B9kM3ejg/4EkfMjHHw1u7sZKEKgde2lAjw/k2fosbg+F5imXfXl7",
+	"/zXKbPjvfxf//ftr/OPt7YD+Mq1x3EFJxjXpgTS3/bf05Hp1cT4Y3RqHT43xzCPdiNvjXpiTGDmerNzC",
+	"EizIIADZ+xy56GQV8Ujg9l7x96CxSD9EADVJRm2zU9fdjrC0N06gyBJfa2LvAeg5Tx4NttRUDt/pCqoT",
+	"osmTHsPcu9x6qMOofYsKx1dt9dI1FzZQ2N9nFPqMtO7FdOVVTbhrfjQO84mb+b2qHRUr06HuCTw27Wdz",
+	"FsckrwoWLf1i3ZFeyCLDealJKXqRwP7VC60IYeCRcMDQQCTTIKLHQkcflySJDdrLAH8uzazNhFq8qB6l",
+	"ckqu7jhK2DH7Z9z0T7/vvx2IWYSf9kQY4xGn3GsYZgyZwYVDvmeh8wYfyShQtKfuyvTCL4B7cPEZ8Xig",
+	"19EKPsqgGp804Vq4ur2Cr1yMYRlehJfL5cN5dDoYjfixNbo7xX/AX+f9i8u7oQkVJk/QYnfUFPpSWtlk",
+	"pMCqCAP6XWX+pmur2mAD+xiK5VHbH3KWN5lY/IjLDTk09/cnTYOSiqtLg3jAQsc20A+BiSPEickIk1qW",
+	"dHV9NZBWnYJYIvakptcDOLE1Eubg6ozvUOftQl1wsrKPHVp1PL4Uoe+0Puyo/S/jvokGbJlG4+jxSODY",
+	"w111srKu4lGoGOi3+IH243cOdJtn4YZEJ8xqFpwibWkNCCm911glnvZ0nhoOB/XNNLdUxuoqdLFFeLo9",
+	"LOVcplHC2BKvIpgc3TTgAhZTZErzUHpCjYpoFl80PCNSxPO5xRlgHfmLE4gRDGhtkculWPEf7gZ3ZAgZ",
+	"3l1dadw+OKNfkd/pj9P+1eng0mIocbMUCque0Fo5JBpWu/ia1rKxu7/Atpv/O9nVt/o02fxKuNq7wJfw",
+	"tNj6JrCGj2CbpV7eLlbKWVG2mK77lJmVfASlWV03nPEXzVXfMAv7U9V9UDpLYYMAb7yUZkk+QcDdSBq7",
+	"+DUJVKCg5M298EndsTjv+3kWF29JI1RhjB62RZu0HIU3BYaYlGL6ZTpNzXM5m5FZjw9OgTUP8RPreaRI",
+	"ZXmC0XxouZnW1aa7q++vrn9Eb+zL6x/RYjA4u7hDv+t3F2/fofAcXtxenPYvjcJTehyo6ptN/gaFawFd",
+	"wafCjCsLcoorCIkZegUyuxsIQUEmgJskePJNu3qNx8pHxhZwuX0EEf2I8tibgMKwVC5zHhkFAM09uhXH",
+	"OaXcjZMJ5VWZxZpvnVkUzOd5hn4RpjNVpI1in4C4cLxiO5FsHhhpa/DjM2xYxiLjBL+jd2IbF+oujAVL",
+	"jTDnCey7yao5ZMgbqQoDLQqQVWkeBrGsPYE9jXC8M3+ZNr3mTeB7OU6LEs/A3qPrH98hIII5/oLk62h+",
+	"aGFzW0DELcM4VzjBEhHBQlyFscZaajX+eCByqAHGWSpDOqu5BlhYsriVkaITiGlf5P5aSNrAWz2bMDHa",
+	"9lAcyafa6mUcbUDCaERxwiRwCANBWhFxFZ17BLfzgYixTpuyHRksB9SXnq3O+3eXt+3XZo7hXvvzmz0Y",
+	"7+Ch9vk6hm1a01pHkcIjfojt/1ghN5bVV6AYt4m6xdOV4X5sEl/ypbTZAiBcnU3ZMnEkcQ6Qt1OqZwYq",
+	"kQZmByriFosCcgJevAezpVFC3k+sIvI+bZKR92RTvl/UpOS9XxaT978rOXmfNgrKTo++pfOF0o4Cutyw",
+	"KMJT1JbUXkQYrocP2FMbpAB0oJBUk4NNkREGWItXBDEUvrRzdbP09O7zTKOc3mCLGEoGteMNILaFDcqU",
+	"BcVt7Jip12zMwCEeXk1+LRZfE0l10nWleGC0PCguQn9ZTZtsPVpyW3QOvxMsScUkrTyi5yYtnQ220OJ4",
+	"rD4JZkXHmP/Xza/GmkG4zcXmH6AKPBo7/lKBSdSpaTqG03XO4Y6d23yzUTv33RxXTFjTR6hmMiRkw6YS",
+	"7vCh9/TGSHfrJWdtOprchZtxbbzzastqOhWVX4yO/tJ0dbz2ajRUJwwdGSW8tVvkSvTrqE1uk4z3glD3",
+	"hZhein6MpLFCZs/hzfutRjECjHivBFTZgHt785Zu83iMl/12JLyJvVCs6Pg9AzUD+Ci7aHDV4y3IUQTn",
+	"4n67c4r8I5Utw/v/Eq07lEcEh8bSZfF8cvxpHhqN/pXZR/q9ts43SY6vkdDaVGFBQkLqNwKSomsIW8j4",
+	"dRm9XtJA3ZkUlczpUlwd2i0wzQYYHi1GFhgeaS6W5nE10RB+UKMLWRNLlsSyyzUtONFk4vOXDT4V/hS4",
+	"R8DN88Lw2UTqC8qR3ONJfb75dnbs3WoZlWnsIigQdHt8BdKuHS2pfBzj8YDSuE2/p/k8Kg6lXFjca6Y1",
+	"45WlUJJRdNRLkhmebxW2yoXDeG6kcjyhhk96AEx69AwJvR4wlQVgDl9Dc+DNEJcZWd+IjNssB+iSDUZN",
+	"qu+7m7PMOjltRAW50oVjraw2RUk6fSG9UtiBTiY843eQAOJnfjjdjHuQL9+zNUTWKwdwAuiGNhcOXcvv",
+	"SD3MdqrQN6Jem/C9ED7skjwyYw7z7rKiFLQrHgutssMtG5wWxCLBLLbU9oisY8hJ0IwyY7L7kfTu9g1F",
+	"GHUP3dN3g7O7y8rLunpE770a/HNwenerv7Gb1MURtzS2XfzHYUDPZyzLF8rPXBjOut70L64ueRr32/4b",
+	"c9J4Uh+kXkqJAmz5A8p20HJizZ5wl5Q6TrmRsqunICBA6fSCzJ7v4Q26cDa9oLTmeaCjkj/Jl5M9uMml",
+	"RFmL3R/e00YlTHgIW1Y26pgjgqukXX1SCwirK6zA16tuhZHDalTzLsBRTHoRvTEqK1YuaUmmZJXO5iKd",
+	"qngiqz8u4YtTbfBzqhY6KXQmGuTYOyfseEfe+/cnZ2cnP8H/GXXpyF+ksziz5szwM5GfjMxUzIcDAybr",
+	"eaDOo78RFSs99vB5Sz2ZyjFJZ43n+Jo5ca2ZVEfrSIxmjPho1vzj+qIu/dWx1UBPMsEr5vIuUOpGN3A7",
+	"NlaVHVoJRuTwdREq8GcQ4zOiaYaCdojhJNHjEcYfwrlkcScm7u/QLD1LSyB64r/IJQitBI50uCVHqcbz",
+	"PR5Bw28/C474FYmqPf+/hje1MLf9VATbYUcXqGrQXa7Mbz7uziZPCuOpANdOlcLTd0zfODHeAAvGklzg",
+	"TDwrHTqVY6XrkcBXu4HDoLWcsnaPkxHMwjtTT+6z0RD+PQ4L32LUt+i5okNjKfOSBYgXytdT4EhfhIX6",
+	"jC7zF9EEra7cbMLVJ7L2cO/MfAwiLZ3m9JQGVxpd26/5x4OCPxxeD436863/MEJNfZSxhQHJ/oM34oo8",
+	"fq8S+IyBWDJTkVD80w6+Enht4LCwsbXiew1/+gJs5tLyMoTFtLaazH9wB7eENzdAmSpKazXcAfoeHzlG",
+	"GycXzWo+l+J3E53dJj45zwvS/2C7O/eVVQQLhWT+I4Vnq3oJMsisJ096bq5KGNf1DX4J67gkK4OZ33Av",
+	"7zXVxWhK1mm3H/iPHrK+ikxXhTFEvYqpCSXt6b9MCTNVRYkCU+btU5RhfXEXTQpR0B/eXpz3T2/vKdkA",
+	"r32mftPqodmyGxolBq9ocg7XojyxROmec8OXZg/XY4kxYpGUDMBwucIB6pVkVvPGoZ8aEmd30C5onFMa",
+	"Rnufurj60L+8OLvvD0/fXXxA0Sh/eT+47Z/1b/vaTx8GwxFHkPxldPH2qn/LZar0szXhCK1YHAmrG8Gm",
+	"OhJf9bauCXTPB6uhvAgBLqHCRNk1ekpdCMpgytHignd5JzevgJSBFNXGIrNAE+33QLlHIqBTPxJXddcr",
+	"U51FjfHLm71gd46IrjqHarfwUgSyPeq4kijG8oarP43WInHuKjkjDO7+M3d/nDvQNG9g957hrtvqg9OP",
+	"4mg5j0H5a21J2p56MoU/uJ8OAud0uZDtCOXzOGN3STjKp9PAUFr9esGfrumSDpomtsInPLhyFsUi+Cjk",
+	"9MTd/oNUS1FyjlG/PPuvdPPCxAPYiF7tU1nGQNpb5fvhrydpgFF4v/LJ6VmZQoiXNxdHuDDYyYdQxJCy",
+	"9Ni7BA2U0vYC92C5EXxE8tIQVZ1UvbqqMqrY6hnkLWosEZJnGPyLTY5/NudZVt4RKu89uhcksxwD8k5z",
+	"UHiQXvvP6WCMspAKqZ9iFn7ygACQg1dUSfQfSFVUrfM6Qa3zFKv04m9vY6Q6vMS+yx8fYdpzv+QXqEez",
+	"FlQq3IBBTLFnwOYZPrm+A1hTU54i+Fl7d4zYc7jUcmar5CewMTP0jn8AiQTIQd99/loC9PyEz71pjKni",
+	"YQOASTG3LuA0ZH5K/uX4FBwuZVwL/P+lT/Xe9HF43tRj74OMHBB6I3fy5HT1wMR0MBsQcCIK25ZT0lOV",
+	"VZijTl6vee+UV1aX84BONMs8/9lf8m2e88zGr77779+8hn8FEf/Xa2POMTvW38cT1i6Fm7tbQ6yqj9KS",
+	"e2sisPfq01HpSeVIuK8WjpGalDyHM/SmlGW+ljoRq7TAUROijWk8UxVHK2mXUBufsowaGCMp4Mv4Y5rP",
+	"U7szzB8tIYWv3rFPQttW5z0B5k+ecIy0sMFI6HqFi6sfPsYJKAFzkwcrDnNlM6/QOTtiLFrxxkIwEoJI",
+	"jeBotIuXuyanUzVW5bHoVXuchVihPk1P25byOpuOVheCkeKlC830PJ9C2PB3Qp74u4K2aoRO2CGVqIXu",
+	"DarQ+pue0GtKyCo193jKJAsNGDYtbdsLmxyqqbD0FXTIiXS/IRFb25dj/bZ4yQMGf+wP8c7z5vL61Jyp",
+	"qaTl1GwYqcGpzGQekr5fF85eCQ7+Ymjqu3KqsqpaoiL1ATSBifIXTW36ZNHMS7CdxyKAe8w9SOTlBNFs",
+	"j9OA8wfp0ZwGyJp2X2VyUYVOkVpc34P5omWo+TkGYRmUBfmdB8pJ2+0c9Bx+MmfFIrUCXz04bunpQ/Ti",
+	"VaUXfuKL4NZJnHX2ukPWORcrq9E2v4soQNRJT5BOY9QvdaK+okg1qmPMLR2DfxqJWoyjRYfVnn/y0E88",
+	"9mmBGQICHRllGAA8zEpVtWHxrUKFiQPhFB1QTpzZ4YIjU4lWUw6Y7jhC++6vk3FYnFBD2HZVU6JpgLNq",
+	"hyK4DTTRyrHTNNC7UutiFKyJdZPIWjKth8VlubmW91SF/LmHE60c7Kscf1vnq7oIm9MfduC6sgRsm98s",
+	"MM0krEW3Gwvg6uHvSy9dRmPpFIOVy+o1CgK4d5geTM6KirelEUGQ8kRHKN0esLNwX8VQt1MyArrjKXAr",
+	"JQtzcuBbwj9WMQzyEcalDGhyYqOmqeUoaNxTHWsqFpnqdc3nPveNcXCJk621iXtq10rr/6WNWvTsClKg",
+	"r5XeQI4esob3DymwC++lWiZMNGTYEzhsqWKY43vu2tv/1JC9vLpyV928LBTa/DlWrEWm0V8VThPpicc5",
+	"e/oeEPLC3PyjpepTc3Ra1yxabQHXMljbGFDNPmWJ/44eaN23ZVB0Mgu/5gjvCMRVnjBLCv6IF+O2xX9j",
+	"BiwtSlMWp3BIj4u9RIf2+DqrY8VO7y3i7a7D+7N8Aq7vki1vj8bHXW3zhrw9wjWiSBigdr+Bt/hOqcfv",
+	"NjZ7d3t7I3nNk/1q/lTxZGlc76wg/vo90WZ4a4Y8hW1I2Qqgi44bgb1Ismj5dCqMAi55Vuos1PDCLAKb",
+	"S/UJ624nw8Ht8KL/5nJwz91O0BHltn95b3dCqcZodxDB3sBap1OIW1dhq2Wb7RL9sXqURVIwgrOQU3nA",
+	"E40W3UUk78K7rypfQZZx2XM9dV6o6CGTK9XFv2jgogVpkk/Qo6MkbiB/q0POl3UE/1XPvuppJpFUOr4s",
+	"R5zpNCtSkZgTMhXfpaez2THUGY1o7bPiL7AUnLVVWddvDo7zC9XBndFqt0Jtyp6+/l5TWfQCj6uFulYd",
+	"cA2vPQ14bUCgcxVlzdPUus4/iW+nMfdwijKxGs6sDblDj7wJXHBCxEYqaPa7V7MsW6TfnZw8Pz8fiyK/",
+	"x0FMrBJkYfOA/ZsL7f703auvj18fv6YiPwvgk0UAP/2dfuKZKAn/JyqN1AmvbYo/PjKj/zzPW6g74KYd",
+	"KqR6PhXpLYUUkG9eTOmxx8pLBt80VFZeJNlXaNwrl20VySUAxRlJHotfR9FErVPWWb3BT1SbSB7FhI9v",
+	"Xr+2iS/V7qQOj342f+syxBt/omkD377+ur3LXYQvySjlxqQXQb//6jLVhbi4jfB5PaH4VqJzZRYi/Hp8",
+	"QZ6OYSw1QUZ49dsv2FGjGeEb3ZFoGpzwHagEs9+JARropRIV0J1gFv4j4/7wVv+eSmt6FFqdoioQfwEk",
+	"JVbkRFPCAHSEwjU9GfuLklGqkbikJ3PRhT9jlRxPKNhJ99irk81blmkmulMdhFX21DJWeV93ukmwYE9W",
+	"gkAwvcqa5V5pb098sxIt09UiNhkDTuny5vnqdKqjmzfRixl34k95TIsYsOkPOUtKUp1Y4Y24oZtRJZvA",
+	"yk6quSv/rO25w14Vg+yEeb99/XfXfnGCvnPrERP2dQD0Ks4u0CsQi6fhlCUaFISik0kr2Z2kzE/GM6tg",
+	"GNFnkbhZJvZRTy1Sb1KvvrYw51LQpHhz4nkmtOlScltCVwr4iyM2JQ+3CSOXywhzWPMsK6W0u4lwslt6",
+	"s/jZe2ZhyIuT8ddnnPZ3JGh++j0w9S5tOfL4klc/7Zq4qf304/uxSh/yZnDuo70ZdOizxXO8sg2fkxj4",
+	"9vW3Tqx8jn6iGzyEOMoK7bBBR1D8/4f86x4m/7OIU7Jl0dXOIcnmMtZAZj1/DJ5YJJI5lVmLD7HGOSWP",
+	"hCleVde5d4x42OAXSU3fvv6f7R3QTyEMuHFhQ+RXIxDbAdRrVkIVffG0dGl3OgNtbB+I7HNUYXYlu2yb",
+	"b6ehRW6goTtKJ5SuJaWoZMnyJQho43r0gQg3SoR16nHSoctn6Al6GfP7XG6UcqIMdWqrNVstdFzEiXKa",
+	"LZc0RgU50WxDPGMYeZRj5etjbwCLXar0U0IRn4gKzOXKyQnmiR4zvX6yqpQsw9jNxWOoVLKfqqrAx14f",
+	"sSCjmijAVc2ZPQdjjCT+iCEqsYS4ronTEJWU/5vhxnbtdQJd8mgDzFupeL0WDwuEHBj5hW7QhN+C70q8",
+	"uZIkEFr3SVHTwaj5kIlPvUJcUmOzLVY24m22xg2r3vxc7663LFnnBaGElQN/ONqUKwTX/bZY0LcK9TaS",
+	"NxpH1WQU1260GMsm1OI8TjasgbXTInpan8F+OnfIYq35StRbWvOBct0M7WVaWodu/5B/uVg+5OjH3sVU",
+	"5GCp1xSKGEVXq9JvWFcErZeoCanstDKBEYWKo+pGNlNeRIPit63ZeTHlnF5IToYNo753bLG39IuX9+3w",
+	"kQR9pU6aRXJdy843r79pb19JIf5F8+COLUMaIW6AY08qHmmW25Z2owFoPmJ4lv7oUMlO3hM5tNlTEOdp",
+	"qWGQ8gJ/fkpREE+B8K0vsxy/QhaFFQpgPkvu63jpMax7LeOFcbzDIelmxyjOyTIZbpj3TmZFwuBWzxXJ",
+	"N+rgdOJJURRcZv6xX4u0hco0xp8b2/X2z53mwIUbuGRpyPMK2twIL8oC404cqFqrvMUyyq/6qm/OHdZT",
+	"OZUxpB1TaD8z9hGdEykHb+PN7kwvB/sFcuZ6F0gHo6SOPyJAuGFs4vpZ2pgDb3e8hkrsbfI+qpkMGx66",
+	"2o2GZX10y2bDfVBFhU1wA0rowbq4ovq5vn1R54v4MW4y1gzZnOwhlCQA2laUyRYbySWO/lezkxzo2Mls",
+	"4QniMFFxz+Y+mTTQYk9UCEIroMg6otLNYXNeisS7mB5dAfRH79FrsUm9+iyJt71TMMXl0+p5NGAz2WPg",
+	"kIi+CeZw+Tn5inJxUcRcKWjrIYh8U56QP2sZ+G61/atkzdfCk09x545OYfokDstz1kOjBrf+Y3MbbPV3",
+	"Tvl1aDQqocf7LOClqynC7GVhOkgLB5WwUVRYFDp+K/O9m6u3Pe8fN4O3eKl6e3FuFh3cV0M6WLBPQUql",
+	"zAFwgw6IQ3/+R1xJA9TYnNL68SxAJ/E4Y9mRKKjene+LiEWsUfvn4WB9IQWRrksu3NJVPYzHwRH7JCtR",
+	"mQ9lnieX+AanFKxFhwVZ9yMq+MD/HfpLrAWW+cmDH4Y9jx0/HmMCCFQyeRP0T8VMh0Fy9IjJoSeq3Gta",
+	"eG4FcwQJZ8Kh0eUheMKAvPQjsGqMjB4nfnrs9TF4AWFCpyq+Dqp8GmKcahrPGX0gP7D6XW9A7a/HQZ+P",
+	"v99czlcHR47zca7z+acj2JKNHOy2va4cpByMo7MAQEwD+ej0Fzsqv/3G4QHxNo7f+5H0Wk03KDc4gess",
+	"tCGpkQpicrlUYlupCJbibSlRX9NV8w7lQvLXeo7XHFySg+3E4WgkGml7KrfokIjktFRsW3q764Ta40Hf",
+	"vFhA0ZR75c588slllNOvHht3oN8D/TbHoDlQ7wrSecPehftNuwc/xL+uH+KJlmLWgdx542aCV1lo/0ri",
+	"mi/6QMldKVkRyyZomY/REPSQki6vZofLoFl4051WUAiOude0vOfBEhVcHljE0ZOnRKkZp8JNMIlwvzn5",
+	"Q/zRxRVdVpHajku6dBTamEf6B5Wgeo/ZuSiHcXBmPzizF2kOohoXvpRAOJmIWHgnnbAInLf74akmX9pb",
+	"8SrMOp4F4eSD7LgBZzrC7uFcdWElpOIHZiLeF+IkKlzpxFC8xqUTX/GmnxV3rcIovAhh1ynWPQNNyD0w",
+	"VwfmMhOyxmKVBhvltNBfihc0Z0a75F1a+Uy1+5LZbA2W4fg5sMoarKJIbBusMvejYCpSezszy3vZqZVd",
+	"tJYHhmk8YySmDqyzButo5LZN5klX4p7UnX2+wANno4qawtOBezbAPS9+9mDdh5M/8H/vsczCn1b2+Q0L",
+	"uiovdfI3xfqgaG1UUItSvFa7wzn/fjA6pCeyMPm6OSZ11B44ruNrl6DXlzE1qBLt7SY73rSFcQ7muhd/",
+	"XkPv2WTiNjA2ppTbW3m4QwI4mD5WtytKDnspVk/Ysx+GR/KJzdWXVLZX5Zr5iD0vyP6WejOgLu/BH3/0",
+	"/Ec/iLwcNoRy23tyQnyH85fktLfw05TKd5WFyJA9xR/ZuWjfl/B9ZjrsIcLxpbIuI3UU5OQX9NEl6/Il",
+	"yzBwSUsk4RcFYmZJnD/OTGTL4y5ksXYPpv609B7YNE4YT1VRIe5e9RU6xYVNvCR4nGWe/+wveXUIXl9M",
+	"JWySCaDzSZB5WQIy1JhqFh+tJZ98pg/Tq8TJV0XDWqHy9cEOD9Abf4AWtKoYgR8TyxKHrZWttvGsg9nm",
+	"J3pFlkYVl7Nx0Zh8SQj8yBe3R997B0NiFuhEVZIx6cLYqngF0ub/K5xltsUftMEO2iDR2SnRWYWAJK9Q",
+	"i83qhoJf2t+bS3M3vTaXaeELfWvekE2yjqsDx3TlGPvD8Uuxi9NLWBm2pncwnQg+11ewtan/8Ki1Nv0b",
+	"nrRegANklb1OeTVl1Q+ZVVOr1KfH+Cn7gnNGTXETei8G/Cyyam7IY3ePM3HK7TilbT+wdNdknIKqPYnH",
+	"DWfkrDN1ceVxYudFsGBhEOE7Gxvn5KDPy+4s8ocwSGfCob/C1k0vCNK/tYDj4HTf8qRW4OrAYB0f1iR/",
+	"lcitQyA7FslKJuvwQq8IfRc51mRt6yiKsW6ViGlRo4tcH16MFklMT9OSSvMvzFAdLYw3AsUDuX8bycZ5",
+	"4M51UnI6M+j6Rx+AiVWh7QXOh7yB56uoMgy1A2AoqbTQuXl2FUrZlPjpzPDORYN85pFlh3euvaoHJylz",
+	"a4FeKRxPrfb0pzyMgPQfgjDIlh52oVKQuUxkxtMjuauGIxhhRAP8JdilvuzDAdI1SwDSnCIZi15nkfU8",
+	"zBj6x9HRhM3xPahM0AAVDt+BlsWg+sZ+/pT8zYGS9yv5nCTdEhescq0hIR7n2RjTOfKKv3WJ3oH8y/eS",
+	"z1yar5jlH1cNxJ+H2UYuF4ezYZ3LRfvxsAFNqUuaJHnbcUmXJNp+rlmTXjLC6nqRbULxKmP4wGAr2tY2",
+	"m6qpzmH8nt3gyHrDElDfYDHhUtzc5ZHV8e5+kyePh5v7X907bvvq3SZMBES7L2wgaMuhhh61yF1VKCyJ",
+	"MMOwwmvpoUji3kWJOJRFicZhPmE8HdHEGTFxFC7LfdZ+jRZkdDjJV3yG3rCWDIS0jMYtMkPzpE+rXiLC",
+	"YT5GIse/lt4zS5i3yPGxrechs6C/Mf6XO9yP8wQIvUgch2UPfo583nLKsvGMVWbkY3n+FBDmBVnPS2OP",
+	"feLYg/kn7BO+xXHTJlpgg4w8h4HmE5LDIPLgogzL/DkyjZsG6FwMX4LEC31AeZJHx548NaiGAghFdhQG",
+	"8wAfHEBGeosEOgULPzz+uX7HHsFUn5fUROSc0r50kplrOKhU9XsA4GCPejl7FOJ3o6Kkq5qR0hO7CC9Y",
+	"tqga6Zul1nI7fMPrDh5UhjXLr3E9Y11lQe6+JIiDttBRW6ix28oBPkUh4SOq5dytcDSv/6xcTakWkdId",
+	"atlnnepBn3Iotn2eYv6FdFNKcGktB+J2M2qpysmniqaKU2sFCue+XkcpkO3iqC3oRhL36eWFd0odvRF2",
+	"lLE33oOfUorjIpYVazwZ6Jn3ps67C8jparpanezryz3Qu8v7YTO5rULvMn33USL1SxdJLhtjHTtpuNXl",
+	"t6+kd8+L2HNzoEAl5fT2KH9Snhjfm9ZWUqqLOdC1o5JSzSO/yj2kRswnf8if7sVP98EE1Bge/2x3KOzL",
+	"DPQNee7RmiAT5uslffHd4kkm1VcGA3qRj0LMTiDz26uQazjCWFKtDIzDoEllMg+iqkrU855nwHjBJPpb",
+	"5s39j0xnSmtqggpp7orNLibrPnocctRvL0VAlexfkCsT9htWjGvw8sXvTTzZK3OQSN9R4cIH5BQcqWBA",
+	"rDqREk+NVZUL4qk5cTkaIyeJ/xzp7an53J/wdscGlzKcY295rqOXTI3lngL2vJqHzIF7X557OfFtgnmn",
+	"oFiyyREPaHFTDkVbZJCUqZsP3PlDOq8e8LcE70V+GAMTqwrH9KvHcDnl8NJj75ygUCOj9Z0S86A9w/ek",
+	"DT4L5uzYqGLy/qJA+taYcOvBnfoyD4qno+I5LdHWKneoMo+c/MH/fc//fZ/neLhJ25eVg6QhQ0Rj82LR",
+	"KsEVmTjaGKqHtceDzHuG//AuhnRuch6dVrbGEVNt0jvAS7smaCneHcPxnR3xtF8bqeGtIZyyIOlEcajj",
+	"/XJ5EqT5rorw7kxYzaDodlipd9+QYmOaMs3xs01PNedg3KjmM9vU6bP6GVEF6HBQuB4U1RyHKx0W5Jpw",
+	"8pAHoaM6JZJ4SAqk/h7vX7cLtGblkK8/FzjMGw7FF6sP1Rd7IHZHYle1H3V6W5feT/6gf93Tv8SdP+Mu",
+	"+OYr/w85y8kKB3IWPXC4bVmcFRpkhts30Wd1+7dG6oGacn1zV9cSjn1ovlCEd6Bxy1NKsjQS+eo0zkNo",
+	"nWR6EW2L/xJCO2EEQK36Ko1uejQs0fdGI7ZWolMDOAdx6/aKXSHEtBr55EyJv8UPbhSIppcjkKgR2lEV",
+	"ZVUe7yoWmiAhyJhMwfoIy0wrpppGpeMfCN0Xr23AKg9031XN+I2TxkoEf/IH/C+3s7TSvm+hfDvhB1nK",
+	"yb6naJ4YQJB9GD+mTcIZqGFrJA9ocLOquAnyAyF3F+C/0XavS8YnY0yoE9oV41P6juT8O6rIE3wtbqHp",
+	"nofr4+lxcDp86+LWQz6ZwVbIZzlQ8uGdyUL6nEDWpv4ozoKpMO0eYSLSiDma7/SenuxZpnujRnKl9TuV",
+	"E+7YMmeC6SB/3RQJy35KStQ/NyWXOU0Ynur45sLmfhD2vFGI1XNAur4febfMn6dGkqOHyFnwODtKg0cM",
+	"QFIcwZ5YZCgOyScyQL1JIuz4xm+Axp4Kwy3qtT7egZxbZWoDadjoubNwPflD/HUfTBBV04AlfzaF6p8J",
+	"TzffTP/NEpd3fjlqb9cnBJwXarGHwPst5EC373qDYDalPeL5YVakPt55r6nvJSX164OkftGsRZuT1PE4",
+	"OAoAkqTBCfKCvnPlN5j7ImO/yJpCP3ihv4zzzMv85MEPQYUJA+EgDEtMveckyDJGroxx4qeo2qQfgWHi",
+	"Hv5JnMQLYnup/4TOlONZ8ITPjllceWtkx4/HGACAxQglLNTMD5KjR3+xoDeaNMM7QsrDvAVM0g/z8V/B",
+	"gm6laFRhk2PvTYgXU5omxhzLC38MIIRwIk54GTf0/AqD6KMYGn5HkKWzC69y2CPzjEodEwYqYJsM7Foy",
+	"mUXoZ+gvIh24+VJncTgxJL7gmL8eB33ebntvSTTxBSLYKjMsbjKfjgDjK/jH4OhBAtz1XZbkbCWZAoji",
+	"GDtEcL9cBDfHsEjyJ6my8/VbeJQdwfG0RG45AYZOElAM3e7gADVOIt+tpH+aHE19IG6WWdRLL1xqPgeH",
+	"mhs+/JkY/VqBuuPbuw2uw0Hq+BRQpZuCKjZC03/Iv+6RXpfkfSBncC22yz6x+UJaVksUrI4UGrxX/InL",
+	"CUR3uUI6pQwuCziRhYy2GDjAJx4g8Bv1XTgQv80VgRQoK/l3rKQ7IBpNDeSJRi0iSYugxt8BfvwPvXmR",
+	"uO6Vmgq9rdC38CE4D0Opeq1cPVfSeYX8iQr3hfa7Fq0wc/JaFzXrmIe3jY2/bUjk1vmERSumz1jEAKNb",
+	"OTbeVChLpNIzirYusTUFp80wsxbzodmTH+YM2S6I4EcKiUHGN71OD6ZTNs5AX5QvZDcctB0qURaQDvqT",
+	"2ws0k+gryGMh97Qzof6ew0YCOqJG1UhE/v+gGoPaHiK/ZDOLCbhoeg4tb3jDzyLNhUNsi1jR1quNfUY6",
+	"1obofWInJknqGgVbVaXfXQj3xUh2FZ2igHgtNaIYBuH5nCTshgjod2fSaZKSaFSb5Bj6JwNtHb1/ZT8V",
+	"oMuNuCtnUBnJAc8UHNsSq2l16o1kUakv6KAGOJpRDMTVscKORL5I2iZjyCspXinyWpyRIgurz2PlehiD",
+	"Km6K0rXMywGrIXz4WyqqMKK5/7aSrUFWb3sA1dZ7ALX7MUEUoVsR3Ex5PlYRj7fw6cWgnmZVAC8JZ4fC",
+	"ugrKWiK7xhGHO98L3PkklhXVr5AxwXAqnPyhfrxXqU+6uXnW2VrcDZ/9FN04JVN5S2ZLg2Jx76xR1u7O",
+	"jg3YGw9ssj23zzpNrsIuLANV69HxqUldcsnWwTWlECARg9Ts8kYLCZbNSq2mEWl+GEnA9iCkSsJy0II6",
+	"uuOnxSbajOh+Np4ZlCAmbOg8O62NwHrokpQDBXLKAjgZZagS7dFih7b0wiBH7SwuSi9JeR11lzrhraG7",
+	"HKh45YpozoTcJGJVHaaWag6V4slpyY+mGhqoiqHzhGpUZGHSGP13K0o37VyaEiAHInyxikZ0D5XI9uS2",
+	"dybbZ/Ywi+OP7ZrBpXi8/JF30NLh1onxRznovoeh7ktNgJVtOBLTf0HzYoXQJOWrn5qqG3OSbiNlHiQg",
+	"Wu1QTxAQrBUnosb4K9DJJuRrdfMN9OUiV0/+EH91iwEBJaCY2vTIt1mqbJdWYhWH2I6tx3Y0kmCv+dBu",
+	"k3BwjfvsCekzlGw7vLW3UJOlHrobNfHr1N4R1OG03f87+MucsyfcYO/0ZiyJeyC7qPoyqGg2XXMGxST7",
+	"QPN7mLRH7qXC1IExOt1vShT2QgxSfFe/3bvk+rHyTYOyodp+JgzzXAF7/Se0KiIODNFFe9HpZ7vsAOMu",
+	"Qr8hmeaIRROZfhCNtd7CX1LuZTLsLrDIrRjYUwPLiMqYBqGauRjgGcUwTOLdDS+PvRs+Cg+xpBI3KjU/",
+	"fynBwER6rw6iSfxc5LHlkaGmyhm4ji+WHzu/xJiwsdZ7zIHDV4nSsRDl1pk8S4LHR5Y0nX68Rf38M7Da",
+	"LW97OP0OvLEGb9ipaKPsgQkX2843H4u6AzYBmaUDTnNdlPwhwmnkoYevnYnubPIJ83Y81l/rb1mafe6m",
+	"BG0Nh7Nky/xSph8rhxRpHBL0xm18wCcvKK2Lx7uYn+NVq6Fo1I2EKTAU6PeHnCXL9Yt6l6A5kI9z4tz6",
+	"Xhcv7Opba647cukoD2V5bKzs1ObIZgWFuEQxa3kmHahvpfR0ZrIxE6BRmp38ISw4rY+NreTJW7aSZ4Cj",
+	"ihCvCD7AvwJ0WCrnuuk1FHI6PCa+5GNiF5KyvC2i66cDwZCT735Sy0EgreTv24l0GjIMulCP9NXdDgEd",
+	"DsfP0Gt3I4fjyTx45GR3wpPrNV8AVGuZio87rsO9NzC75b6XHS746C9AwZ+jd+TKN5kyPg/c4niRqdLt",
+	"JjgFfsX/ksGU6nMUnFPTBNS2XULD8zih3XshZjANIgB9edXiJvSD6JZ9yg6k6aZUFJSJNMRrTQsqXY9I",
+	"08xvSvE6ws/a7E2CnNoqEj5cej4fCqvs8roUFS+aCCpeONNTvDiQ02dJTvoeN1ITT+x38gf9lz+7yKcR",
+	"qmFoVzTpqiVfUXhTw91aRv5iJhA8UUc4z8rmwm71xZN4flbkH2nvkMVna6YrKa32cLQ63terRCSplWgl",
+	"bSfUtCWcEZ9D9CQIZkINw772fQv0qUpkas94f5E4svbWvKDeB55Sxm2RlJhx3fxpSDGSDg4M7Hht0xnL",
+	"lXmrOZk3kV28lFy8RxUbqfhBuSfG4APhGEsxGZJ3D3jP7ciEF2TsTYRxmlFz4JMVs5qb2MX2QnvGU4f7",
+	"apAgEu/8MoK6RP3kwCKTN+cPnAGPvbuFcM/kRXs/LSljs+oqUnilMk2zTOmVoOsL+XQ+hFgPZNLz8iik",
+	"4qeGxP1FwvNjy/PxRjI7GxhsA6mZCZa1gmrMAx4yD20881B/MmnPx9z1HDppK7oDs5Yy+AMPUCIinqru",
+	"evTB8ydPAahGASZMV/V2ih+R2eY+4CKI81SN0pMeaI2HGnpYi1k1Vhc1cUR1AeJt2QoL4kRpvsA1AYRs",
+	"HKfLNGNz7qGdfgywMo+tvk2FkveEQ2Ulmc2lTv8L1KbZbI0ZjZipyJPLwebKf4baHM4lOaJS+Y0W9bBc",
+	"z147vqBZcapaPExe6gQ7FOXYW5eU9Y+aYMHCIGJH7YYL/dKjlDIRqSN0M5viJzJBYpq7Rf4AgM74yUQc",
+	"ISAoYnpSOIqy8YzSk0GD39EfryfL2VOS4WOvn3khw4ggURBEjgKs2cNC4/d5EtKpA/idB9l9OvO9eZ5S",
+	"OfGUGXJN0lVCDLJlo4uEfUSnYOduyG+OXQAxd0noXhaScDea+fsbHlvbssOJ6Xrxk3y3iqVEO4vc7JxF",
+	"h2OLpXOoH29bMWtUjXPu5tFOnb58w2gCghvOgyfWIbNuvL5NdKhCVQ4s7+iTr7FYd1Y/ecTqqI/MrYZA",
+	"PM2OZMJGc7JGuoKOx7DSzKgu+FiOK8YTe5Enj2jgwXTrC646zOJnj5Rl/5HuqEuuXogZm/LmvuWrGImX",
+	"nc1cH1dN9agDc6DjjslzBT12fqTTSJpXijua+kGYJ471RCMS5kVdXywZGqdaEbo4DyeY8xwp10/SJv3Y",
+	"RP8lOi8S+crh0WOBmIkhfrxx6KeiejE3iU7Y1M/DTFXjClFN/vtrb+IvzYcvN8CecxRsjC328jG8vtQD",
+	"07kxHSd1TzDKWiyXOp8hcLmkeqRA7PjvB/jjOZhkMy9Piwtky2sD5VXnvzywEE6NQNQfIDh4bgn+OYjG",
+	"YS7fCoqvmL6dVwaW/Tm3FdAEqUdcLOrZCdjRfUoABOoRvu15Yz9k0cRPvHkcZTMjM444J3Gmv0uNvp5b",
+	"OqPqoByYxY1ZOD2pcypPyy6ZXZnlZBYgK7gVZpwAhy69NPIX6SzO6hUHFGFr5w2nfGlwMVL7imdLnYbe",
+	"ibV8qUeMdcUH5lmdebyZopqOTLQ86lDUVJC3LG5anCXwIYgY97HG5+uCQwuXjkoNhbSVHVYsabrpK8ih",
+	"jOka1FkrYao7TZgTgi5CEq8r0psl3O8lCWvF0hySrjZQmONAoh0D/Jyp1CI8n/IwAiJ7CNmRfOp5uXch",
+	"fPXX/RXk5EEYZNjlYxQ/R6hxXI8+VJ9Ig6Ta3Piy80Gt54NczhfkO9feeh5EI/aEx9PaCVHqqDzwpaP9",
+	"teAqxShGnsSeNBInzCq7caaGnnkSwg8n/iI4efqatlSMVfMPurkglX1Mnm49uMxP6L9hzSosgma0xxik",
+	"LvNoj1jJL6w624oRijfUxgHgpOOZgkEuTNCNLzENdsa/rDDmjIVz04jv8HeX8Ywoey5qZ4jxVHKkP3/5",
+	"8/8Hn2U7QD85AwA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
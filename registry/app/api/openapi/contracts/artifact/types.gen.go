@@ -11,6 +11,36 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for ArtifactActivity.
+const (
+	ArtifactActivityPULLED ArtifactActivity = "PULLED"
+	ArtifactActivityVIEWED ArtifactActivity = "VIEWED"
+)
+
+// Defines values for ArtifactOrigin.
+const (
+	ArtifactOriginPROXIED   ArtifactOrigin = "PROXIED"
+	ArtifactOriginPUBLISHED ArtifactOrigin = "PUBLISHED"
+)
+
+// Defines values for ArtifactScanOutcome.
+const (
+	ArtifactScanOutcomeERROR      ArtifactScanOutcome = "ERROR"
+	ArtifactScanOutcomeFAILED     ArtifactScanOutcome = "FAILED"
+	ArtifactScanOutcomeNOTSCANNED ArtifactScanOutcome = "NOT_SCANNED"
+	ArtifactScanOutcomePASSED     ArtifactScanOutcome = "PASSED"
+	ArtifactScanOutcomePENDING    ArtifactScanOutcome = "PENDING"
+)
+
+// Defines values for ArtifactSearchField.
+const (
+	ArtifactSearchFieldAuthor      ArtifactSearchField = "author"
+	ArtifactSearchFieldDependency  ArtifactSearchField = "dependency"
+	ArtifactSearchFieldDescription ArtifactSearchField = "description"
+	ArtifactSearchFieldKeywords    ArtifactSearchField = "keywords"
+	ArtifactSearchFieldName        ArtifactSearchField = "name"
+)
+
 // Defines values for ArtifactType.
 const (
 	ArtifactTypeDataset ArtifactType = "dataset"
@@ -24,12 +54,69 @@ const (
 	AuthTypeUserPassword       AuthType = "UserPassword"
 )
 
+// Defines values for Capability.
+const (
+	CapabilityQuarantine    Capability = "quarantine"
+	CapabilitySigning       Capability = "signing"
+	CapabilitySoftDelete    Capability = "soft_delete"
+	CapabilityUpstreamProxy Capability = "upstream_proxy"
+	CapabilityWebhooks      Capability = "webhooks"
+)
+
 // Defines values for ClientSetupStepType.
 const (
 	ClientSetupStepTypeGenerateToken ClientSetupStepType = "GenerateToken"
 	ClientSetupStepTypeStatic        ClientSetupStepType = "Static"
 )
 
+// Defines values for DeletionRequestState.
+const (
+	DeletionRequestStateAPPROVED DeletionRequestState = "APPROVED"
+	DeletionRequestStateEXPIRED  DeletionRequestState = "EXPIRED"
+	DeletionRequestStatePENDING  DeletionRequestState = "PENDING"
+	DeletionRequestStateREJECTED DeletionRequestState = "REJECTED"
+)
+
+// Defines values for DownloadStatsInterval.
+const (
+	DownloadStatsIntervalDAY   DownloadStatsInterval = "DAY"
+	DownloadStatsIntervalMONTH DownloadStatsInterval = "MONTH"
+	DownloadStatsIntervalWEEK  DownloadStatsInterval = "WEEK"
+)
+
+// Defines values for HelmChartProvenanceStatus.
+const (
+	HelmChartProvenanceStatusMISSING    HelmChartProvenanceStatus = "MISSING"
+	HelmChartProvenanceStatusUNVERIFIED HelmChartProvenanceStatus = "UNVERIFIED"
+	HelmChartProvenanceStatusVERIFIED   HelmChartProvenanceStatus = "VERIFIED"
+)
+
+// Defines values for ListSort.
+const (
+	ListSortRECENCY ListSort = "RECENCY"
+	ListSortSEMVER  ListSort = "SEMVER"
+)
+
+// Defines values for NotificationChannelType.
+const (
+	NotificationChannelTypeEMAIL NotificationChannelType = "EMAIL"
+	NotificationChannelTypeSLACK NotificationChannelType = "SLACK"
+	NotificationChannelTypeTEAMS NotificationChannelType = "TEAMS"
+)
+
+// Defines values for NotificationEvent.
+const (
+	NotificationEventPROTECTEDDELETION NotificationEvent = "PROTECTED_DELETION"
+	NotificationEventQUARANTINE        NotificationEvent = "QUARANTINE"
+	NotificationEventQUOTABREACH       NotificationEvent = "QUOTA_BREACH"
+)
+
+// Defines values for PackageDenylistSource.
+const (
+	PackageDenylistSourceMANUAL PackageDenylistSource = "MANUAL"
+	PackageDenylistSourceOSV    PackageDenylistSource = "OSV"
+)
+
 // Defines values for PackageType.
 const (
 	PackageTypeCARGO       PackageType = "CARGO"
@@ -45,6 +132,105 @@ const (
 	PackageTypeRPM         PackageType = "RPM"
 )
 
+// Defines values for RegistryConfigAction.
+const (
+	RegistryConfigActionCREATED RegistryConfigAction = "CREATED"
+	RegistryConfigActionDELETED RegistryConfigAction = "DELETED"
+	RegistryConfigActionUPDATED RegistryConfigAction = "UPDATED"
+)
+
+// Defines values for RegistryConfigResource.
+const (
+	RegistryConfigResourceCLEANUPPOLICY RegistryConfigResource = "CLEANUP_POLICY"
+	RegistryConfigResourceSETTING       RegistryConfigResource = "SETTING"
+	RegistryConfigResourceUPSTREAMPROXY RegistryConfigResource = "UPSTREAM_PROXY"
+	RegistryConfigResourceWEBHOOK       RegistryConfigResource = "WEBHOOK"
+)
+
+// Defines values for RegistryGarbageStatAge.
+const (
+	RegistryGarbageStatAgeGTE30D RegistryGarbageStatAge = "GTE_30D"
+	RegistryGarbageStatAgeLT1D   RegistryGarbageStatAge = "LT_1D"
+	RegistryGarbageStatAgeLT30D  RegistryGarbageStatAge = "LT_30D"
+	RegistryGarbageStatAgeLT7D   RegistryGarbageStatAge = "LT_7D"
+)
+
+// Defines values for RegistryGarbageStatKind.
+const (
+	RegistryGarbageStatKindMANIFEST RegistryGarbageStatKind = "MANIFEST"
+	RegistryGarbageStatKindTAG      RegistryGarbageStatKind = "TAG"
+)
+
+// Defines values for RegistryIndexBuildIndexType.
+const (
+	RegistryIndexBuildIndexTypePACKAGE  RegistryIndexBuildIndexType = "PACKAGE"
+	RegistryIndexBuildIndexTypeREGISTRY RegistryIndexBuildIndexType = "REGISTRY"
+)
+
+// Defines values for RegistryIndexBuildStatus.
+const (
+	RegistryIndexBuildStatusFAILURE    RegistryIndexBuildStatus = "FAILURE"
+	RegistryIndexBuildStatusPROCESSING RegistryIndexBuildStatus = "PROCESSING"
+	RegistryIndexBuildStatusSUCCESS    RegistryIndexBuildStatus = "SUCCESS"
+)
+
+// Defines values for RegistryIndexStatusStatus.
+const (
+	RegistryIndexStatusStatusFAILURE    RegistryIndexStatusStatus = "FAILURE"
+	RegistryIndexStatusStatusNONE       RegistryIndexStatusStatus = "NONE"
+	RegistryIndexStatusStatusPENDING    RegistryIndexStatusStatus = "PENDING"
+	RegistryIndexStatusStatusPROCESSING RegistryIndexStatusStatus = "PROCESSING"
+	RegistryIndexStatusStatusSUCCESS    RegistryIndexStatusStatus = "SUCCESS"
+)
+
+// Defines values for RegistryJobState.
+const (
+	RegistryJobStateCANCELED  RegistryJobState = "CANCELED"
+	RegistryJobStateFAILED    RegistryJobState = "FAILED"
+	RegistryJobStateQUEUED    RegistryJobState = "QUEUED"
+	RegistryJobStateRUNNING   RegistryJobState = "RUNNING"
+	RegistryJobStateSUCCEEDED RegistryJobState = "SUCCEEDED"
+)
+
+// Defines values for RegistryPolicyAutoQuarantineSeverity.
+const (
+	RegistryPolicyAutoQuarantineSeverityCRITICAL RegistryPolicyAutoQuarantineSeverity = "CRITICAL"
+	RegistryPolicyAutoQuarantineSeverityHIGH     RegistryPolicyAutoQuarantineSeverity = "HIGH"
+	RegistryPolicyAutoQuarantineSeverityLOW      RegistryPolicyAutoQuarantineSeverity = "LOW"
+	RegistryPolicyAutoQuarantineSeverityMEDIUM   RegistryPolicyAutoQuarantineSeverity = "MEDIUM"
+	RegistryPolicyAutoQuarantineSeverityNONE     RegistryPolicyAutoQuarantineSeverity = "NONE"
+	RegistryPolicyAutoQuarantineSeverityUNKNOWN  RegistryPolicyAutoQuarantineSeverity = "UNKNOWN"
+)
+
+// Defines values for RegistryPolicySourceField.
+const (
+	RegistryPolicySourceFieldAutoQuarantineSeverity RegistryPolicySourceField = "autoQuarantineSeverity"
+	RegistryPolicySourceFieldDeletionApproval       RegistryPolicySourceField = "deletionApproval"
+	RegistryPolicySourceFieldDownloadStatsPrivacy   RegistryPolicySourceField = "downloadStatsPrivacy"
+	RegistryPolicySourceFieldImmutable              RegistryPolicySourceField = "immutable"
+	RegistryPolicySourceFieldQuota                  RegistryPolicySourceField = "quota"
+	RegistryPolicySourceFieldRequireSignatures      RegistryPolicySourceField = "requireSignatures"
+	RegistryPolicySourceFieldRetentionDays          RegistryPolicySourceField = "retentionDays"
+)
+
+// Defines values for RegistryPolicySourceType.
+const (
+	RegistryPolicySourceTypeDEFAULT  RegistryPolicySourceType = "DEFAULT"
+	RegistryPolicySourceTypeREGISTRY RegistryPolicySourceType = "REGISTRY"
+	RegistryPolicySourceTypeSPACE    RegistryPolicySourceType = "SPACE"
+)
+
+// Defines values for RegistrySettingKey.
+const (
+	RegistrySettingKeyAutoQuarantineSeverity RegistrySettingKey = "auto_quarantine_severity"
+	RegistrySettingKeyDeletionApproval       RegistrySettingKey = "deletion_approval"
+	RegistrySettingKeyDownloadStatsPrivacy   RegistrySettingKey = "download_stats_privacy"
+	RegistrySettingKeyImmutable              RegistrySettingKey = "immutable"
+	RegistrySettingKeyQuota                  RegistrySettingKey = "quota"
+	RegistrySettingKeyRequireSignatures      RegistrySettingKey = "require_signatures"
+	RegistrySettingKeyRetentionDays          RegistrySettingKey = "retention_days"
+)
+
 // Defines values for RegistryType.
 const (
 	RegistryTypeUPSTREAM RegistryType = "UPSTREAM"
@@ -79,6 +265,15 @@ const (
 	ReplicationRuleRequestSourceTypeLocal ReplicationRuleRequestSourceType = "Local"
 )
 
+// Defines values for ScheduledDeletionState.
+const (
+	ScheduledDeletionStateCANCELED  ScheduledDeletionState = "CANCELED"
+	ScheduledDeletionStateEXECUTED  ScheduledDeletionState = "EXECUTED"
+	ScheduledDeletionStateFAILED    ScheduledDeletionState = "FAILED"
+	ScheduledDeletionStateRUNNING   ScheduledDeletionState = "RUNNING"
+	ScheduledDeletionStateSCHEDULED ScheduledDeletionState = "SCHEDULED"
+)
+
 // Defines values for SectionType.
 const (
 	SectionTypeINLINE SectionType = "INLINE"
@@ -94,8 +289,18 @@ const (
 
 // Defines values for Trigger.
 const (
-	TriggerARTIFACTCREATION Trigger = "ARTIFACT_CREATION"
-	TriggerARTIFACTDELETION Trigger = "ARTIFACT_DELETION"
+	TriggerARTIFACTCREATION       Trigger = "ARTIFACT_CREATION"
+	TriggerARTIFACTDELETION       Trigger = "ARTIFACT_DELETION"
+	TriggerREGISTRYQUOTATHRESHOLD Trigger = "REGISTRY_QUOTA_THRESHOLD"
+)
+
+// Defines values for UploadFailureStatErrorClass.
+const (
+	UploadFailureStatErrorClassINVALIDARCHIVE   UploadFailureStatErrorClass = "INVALID_ARCHIVE"
+	UploadFailureStatErrorClassINVALIDMETADATA  UploadFailureStatErrorClass = "INVALID_METADATA"
+	UploadFailureStatErrorClassINVALIDSIGNATURE UploadFailureStatErrorClass = "INVALID_SIGNATURE"
+	UploadFailureStatErrorClassINVALIDVERSION   UploadFailureStatErrorClass = "INVALID_VERSION"
+	UploadFailureStatErrorClassUNKNOWN          UploadFailureStatErrorClass = "UNKNOWN"
 )
 
 // Defines values for UpstreamConfigSource.
@@ -119,6 +324,22 @@ const (
 	UpstreamProxyConfigFirewallModeWARN  UpstreamProxyConfigFirewallMode = "WARN"
 )
 
+// Defines values for ValidationRulesConfigVersionFormat.
+const (
+	ANY    ValidationRulesConfigVersionFormat = "ANY"
+	REGEX  ValidationRulesConfigVersionFormat = "REGEX"
+	SEMVER ValidationRulesConfigVersionFormat = "SEMVER"
+)
+
+// Defines values for VulnerabilitySeverity.
+const (
+	VulnerabilitySeverityCRITICAL VulnerabilitySeverity = "CRITICAL"
+	VulnerabilitySeverityHIGH     VulnerabilitySeverity = "HIGH"
+	VulnerabilitySeverityLOW      VulnerabilitySeverity = "LOW"
+	VulnerabilitySeverityMEDIUM   VulnerabilitySeverity = "MEDIUM"
+	VulnerabilitySeverityUNKNOWN  VulnerabilitySeverity = "UNKNOWN"
+)
+
 // Defines values for WebhookExecResult.
 const (
 	WebhookExecResultFATALERROR     WebhookExecResult = "FATAL_ERROR"
@@ -157,12 +378,60 @@ const (
 	DeleteArtifactParamsArtifactTypeModel   DeleteArtifactParamsArtifactType = "model"
 )
 
+// Defines values for UpdateArtifactDescriptionParamsArtifactType.
+const (
+	UpdateArtifactDescriptionParamsArtifactTypeDataset UpdateArtifactDescriptionParamsArtifactType = "dataset"
+	UpdateArtifactDescriptionParamsArtifactTypeModel   UpdateArtifactDescriptionParamsArtifactType = "model"
+)
+
+// Defines values for ListArtifactDescriptionHistoryParamsArtifactType.
+const (
+	ListArtifactDescriptionHistoryParamsArtifactTypeDataset ListArtifactDescriptionHistoryParamsArtifactType = "dataset"
+	ListArtifactDescriptionHistoryParamsArtifactTypeModel   ListArtifactDescriptionHistoryParamsArtifactType = "model"
+)
+
+// Defines values for GetArtifactDownloadStatsParamsArtifactType.
+const (
+	GetArtifactDownloadStatsParamsArtifactTypeDataset GetArtifactDownloadStatsParamsArtifactType = "dataset"
+	GetArtifactDownloadStatsParamsArtifactTypeModel   GetArtifactDownloadStatsParamsArtifactType = "model"
+)
+
 // Defines values for UpdateArtifactLabelsParamsArtifactType.
 const (
 	UpdateArtifactLabelsParamsArtifactTypeDataset UpdateArtifactLabelsParamsArtifactType = "dataset"
 	UpdateArtifactLabelsParamsArtifactTypeModel   UpdateArtifactLabelsParamsArtifactType = "model"
 )
 
+// Defines values for DeleteArtifactLogoParamsArtifactType.
+const (
+	DeleteArtifactLogoParamsArtifactTypeDataset DeleteArtifactLogoParamsArtifactType = "dataset"
+	DeleteArtifactLogoParamsArtifactTypeModel   DeleteArtifactLogoParamsArtifactType = "model"
+)
+
+// Defines values for GetArtifactLogoParamsArtifactType.
+const (
+	GetArtifactLogoParamsArtifactTypeDataset GetArtifactLogoParamsArtifactType = "dataset"
+	GetArtifactLogoParamsArtifactTypeModel   GetArtifactLogoParamsArtifactType = "model"
+)
+
+// Defines values for UploadArtifactLogoParamsArtifactType.
+const (
+	UploadArtifactLogoParamsArtifactTypeDataset UploadArtifactLogoParamsArtifactType = "dataset"
+	UploadArtifactLogoParamsArtifactTypeModel   UploadArtifactLogoParamsArtifactType = "model"
+)
+
+// Defines values for UnstarArtifactParamsArtifactType.
+const (
+	UnstarArtifactParamsArtifactTypeDataset UnstarArtifactParamsArtifactType = "dataset"
+	UnstarArtifactParamsArtifactTypeModel   UnstarArtifactParamsArtifactType = "model"
+)
+
+// Defines values for StarArtifactParamsArtifactType.
+const (
+	StarArtifactParamsArtifactTypeDataset StarArtifactParamsArtifactType = "dataset"
+	StarArtifactParamsArtifactTypeModel   StarArtifactParamsArtifactType = "model"
+)
+
 // Defines values for GetArtifactSummaryParamsArtifactType.
 const (
 	GetArtifactSummaryParamsArtifactTypeDataset GetArtifactSummaryParamsArtifactType = "dataset"
@@ -211,12 +480,30 @@ const (
 	GetHelmArtifactDetailsParamsVersionTypeTAG    GetHelmArtifactDetailsParamsVersionType = "TAG"
 )
 
+// Defines values for GetArtifactVersionProvenanceParamsArtifactType.
+const (
+	GetArtifactVersionProvenanceParamsArtifactTypeDataset GetArtifactVersionProvenanceParamsArtifactType = "dataset"
+	GetArtifactVersionProvenanceParamsArtifactTypeModel   GetArtifactVersionProvenanceParamsArtifactType = "model"
+)
+
+// Defines values for UpdateArtifactVersionProvenanceParamsArtifactType.
+const (
+	UpdateArtifactVersionProvenanceParamsArtifactTypeDataset UpdateArtifactVersionProvenanceParamsArtifactType = "dataset"
+	UpdateArtifactVersionProvenanceParamsArtifactTypeModel   UpdateArtifactVersionProvenanceParamsArtifactType = "model"
+)
+
 // Defines values for GetArtifactVersionSummaryParamsArtifactType.
 const (
 	GetArtifactVersionSummaryParamsArtifactTypeDataset GetArtifactVersionSummaryParamsArtifactType = "dataset"
 	GetArtifactVersionSummaryParamsArtifactTypeModel   GetArtifactVersionSummaryParamsArtifactType = "model"
 )
 
+// Defines values for ListArtifactVersionMetadataHistoryParamsArtifactType.
+const (
+	ListArtifactVersionMetadataHistoryParamsArtifactTypeDataset ListArtifactVersionMetadataHistoryParamsArtifactType = "dataset"
+	ListArtifactVersionMetadataHistoryParamsArtifactTypeModel   ListArtifactVersionMetadataHistoryParamsArtifactType = "model"
+)
+
 // Defines values for GetAllArtifactVersionsParamsArtifactType.
 const (
 	GetAllArtifactVersionsParamsArtifactTypeDataset GetAllArtifactVersionsParamsArtifactType = "dataset"
@@ -229,6 +516,11 @@ const (
 	GetAllArtifactsByRegistryParamsArtifactTypeModel   GetAllArtifactsByRegistryParamsArtifactType = "model"
 )
 
+// Defines values for GetAllArtifactsByRegistryParamsInclude.
+const (
+	GetAllArtifactsByRegistryParamsIncludeDownloadCounts GetAllArtifactsByRegistryParamsInclude = "download_counts"
+)
+
 // Defines values for DeleteQuarantineFilePathParamsArtifactType.
 const (
 	DeleteQuarantineFilePathParamsArtifactTypeDataset DeleteQuarantineFilePathParamsArtifactType = "dataset"
@@ -274,11 +566,61 @@ type ArtifactDetail struct {
 	Name          *string       `json:"name,omitempty"`
 
 	// PackageType refers to package
-	PackageType      PackageType `json:"packageType"`
-	QuarantineReason *string     `json:"quarantineReason,omitempty"`
-	Size             *string     `json:"size,omitempty"`
-	Version          string      `json:"version"`
-	union            json.RawMessage
+	PackageType PackageType `json:"packageType"`
+
+	// QuarantineBlockedPulls number of pulls which were blocked while the artifact was quarantined
+	QuarantineBlockedPulls *int64 `json:"quarantineBlockedPulls,omitempty"`
+
+	// QuarantineLastBlockedPullAt time of the last pull which was blocked, in milliseconds since epoch
+	QuarantineLastBlockedPullAt *string `json:"quarantineLastBlockedPullAt,omitempty"`
+	QuarantineReason            *string `json:"quarantineReason,omitempty"`
+	Size                        *string `json:"size,omitempty"`
+	Version                     string  `json:"version"`
+	union                       json.RawMessage
+}
+
+// ArtifactActivity The kind of interaction of the current user with an artifact
+type ArtifactActivity string
+
+// ArtifactDescription A revision of the markdown description of an artifact
+type ArtifactDescription struct {
+	// ChangedAt Timestamp in milliseconds when the description was changed
+	ChangedAt string `json:"changedAt"`
+
+	// ChangedBy Principal which changed the description
+	ChangedBy   int64  `json:"changedBy"`
+	Description string `json:"description"`
+
+	// Revision Revision of the description, starting at 1
+	Revision int64 `json:"revision"`
+}
+
+// ArtifactDescriptionRequest defines model for ArtifactDescriptionRequest.
+type ArtifactDescriptionRequest struct {
+	// Description Markdown description of the artifact, an empty description clears it
+	Description string `json:"description"`
+}
+
+// ArtifactDownloadCount The download count of an artifact
+type ArtifactDownloadCount struct {
+	DownloadsCount int64  `json:"downloadsCount"`
+	Name           string `json:"name"`
+}
+
+// ArtifactDownloadStats Downloads of the versions of an artifact within a range of days
+type ArtifactDownloadStats struct {
+	// From First day of the range. Format - MM/DD/YYYY
+	From string `json:"from"`
+
+	// Interval Length of the periods downloads are counted by, weeks start on Monday
+	Interval DownloadStatsInterval `json:"interval"`
+
+	// To Last day of the range. Format - MM/DD/YYYY
+	To         string `json:"to"`
+	TotalCount int64  `json:"totalCount"`
+
+	// Versions Versions downloaded within the range, most downloaded first
+	Versions []ArtifactVersionDownloadStats `json:"versions"`
 }
 
 // ArtifactEntityMetadata Artifact Entity Metadata
@@ -322,6 +664,83 @@ type ArtifactMetadata struct {
 	Version      *string       `json:"version,omitempty"`
 }
 
+// ArtifactMetadataChange A change of the metadata of an artifact version
+type ArtifactMetadataChange struct {
+	// ChangedAt Timestamp in milliseconds when the metadata was changed
+	ChangedAt string `json:"changedAt"`
+
+	// ChangedBy Principal which changed the metadata
+	ChangedBy int64 `json:"changedBy"`
+
+	// Diff Top level metadata fields which changed, with their old and new values
+	Diff map[string]MetadataFieldChange `json:"diff"`
+
+	// Revision Revision of the metadata, starting at 1
+	Revision int64 `json:"revision"`
+}
+
+// ArtifactProvenance The pipeline execution which published an artifact version
+type ArtifactProvenance struct {
+	// CreatedAt Timestamp in milliseconds of when the provenance was recorded
+	CreatedAt string `json:"createdAt"`
+
+	// CreatedBy ID of the principal which recorded the provenance
+	CreatedBy *int64 `json:"createdBy,omitempty"`
+
+	// Pipeline Reference to the CI pipeline execution which published an artifact version
+	Pipeline PipelineExecution `json:"pipeline"`
+}
+
+// ArtifactOrigin PUBLISHED if the version was published to the registry, PROXIED if it was cached from the upstream of an upstream proxy
+type ArtifactOrigin string
+
+// ArtifactScanOutcome defines model for ArtifactScanOutcome.
+type ArtifactScanOutcome string
+
+// ArtifactScanResultRequest Outcome of a vulnerability scan
+type ArtifactScanResultRequest struct {
+	Outcome ArtifactScanOutcome `json:"outcome"`
+}
+
+// ArtifactScanStatus Vulnerability scan status of an OCI artifact version
+type ArtifactScanStatus struct {
+	Digest string `json:"digest"`
+
+	// LastRequestedAt Time of the last on-demand scan request
+	LastRequestedAt *string `json:"lastRequestedAt,omitempty"`
+
+	// LastScannedAt Time the last scan finished
+	LastScannedAt *string `json:"lastScannedAt,omitempty"`
+
+	// NextRequestAllowedAt Earliest time another scan can be requested
+	NextRequestAllowedAt *string             `json:"nextRequestAllowedAt,omitempty"`
+	Outcome              ArtifactScanOutcome `json:"outcome"`
+}
+
+// ArtifactSearchField A field of the metadata of artifact versions which is searched
+type ArtifactSearchField string
+
+// ArtifactSearchResult An artifact version matching a search
+type ArtifactSearchResult struct {
+	Description *string `json:"description,omitempty"`
+	Package     string  `json:"package"`
+
+	// PackageType refers to package
+	PackageType PackageType `json:"packageType"`
+
+	// Rank How well the version matches the query, higher is better
+	Rank               float64 `json:"rank"`
+	RegistryIdentifier string  `json:"registryIdentifier"`
+	Version            string  `json:"version"`
+}
+
+// ArtifactStar Whether the current user starred an artifact
+type ArtifactStar struct {
+	// StarCount Number of users which starred the artifact
+	StarCount int64 `json:"starCount"`
+	Starred   bool  `json:"starred"`
+}
+
 // ArtifactStats Harness Artifact Stats
 type ArtifactStats struct {
 	DownloadCount    *int64 `json:"downloadCount,omitempty"`
@@ -337,7 +756,10 @@ type ArtifactSummary struct {
 	CreatedAt    *string       `json:"createdAt,omitempty"`
 
 	// DeletedAt Timestamp in milliseconds when the registry was soft-deleted
-	DeletedAt      *string `json:"deletedAt,omitempty"`
+	DeletedAt *string `json:"deletedAt,omitempty"`
+
+	// Description Markdown description of the artifact
+	Description    *string `json:"description,omitempty"`
 	DownloadsCount *int64  `json:"downloadsCount,omitempty"`
 	ImageName      string  `json:"imageName"`
 
@@ -349,21 +771,41 @@ type ArtifactSummary struct {
 	// PackageType refers to package
 	PackageType  PackageType `json:"packageType"`
 	RegistryUUID string      `json:"registryUUID"`
-	Uuid         string      `json:"uuid"`
+
+	// StarCount Number of users which starred the artifact
+	StarCount *int64 `json:"starCount,omitempty"`
+
+	// Starred True if the current user starred the artifact
+	Starred *bool  `json:"starred,omitempty"`
+	Uuid    string `json:"uuid"`
 }
 
 // ArtifactType refers to artifact type
 type ArtifactType string
 
+// ArtifactVersionDownloadStats Downloads of a version of an artifact within a range of days
+type ArtifactVersionDownloadStats struct {
+	// Buckets Downloads by period, oldest first. Periods without downloads are omitted
+	Buckets    []DownloadStatsBucket `json:"buckets"`
+	TotalCount int64                 `json:"totalCount"`
+	Version    string                `json:"version"`
+}
+
 // ArtifactVersionMetadata Artifact Version Metadata
 type ArtifactVersionMetadata struct {
 	// ArtifactType refers to artifact type
-	ArtifactType   *ArtifactType `json:"artifactType,omitempty"`
-	DigestCount    *int          `json:"digestCount,omitempty"`
-	DownloadsCount *int64        `json:"downloadsCount,omitempty"`
-	FileCount      *int64        `json:"fileCount,omitempty"`
-	IsQuarantined  *bool         `json:"isQuarantined,omitempty"`
-	LastModified   *string       `json:"lastModified,omitempty"`
+	ArtifactType *ArtifactType `json:"artifactType,omitempty"`
+
+	// DeletedAt Timestamp in milliseconds when the version was soft-deleted
+	DeletedAt      *string `json:"deletedAt,omitempty"`
+	DigestCount    *int    `json:"digestCount,omitempty"`
+	DownloadsCount *int64  `json:"downloadsCount,omitempty"`
+	FileCount      *int64  `json:"fileCount,omitempty"`
+
+	// IsDeleted True if the version is soft-deleted
+	IsDeleted     *bool   `json:"isDeleted,omitempty"`
+	IsQuarantined *bool   `json:"isQuarantined,omitempty"`
+	LastModified  *string `json:"lastModified,omitempty"`
 
 	// Metadata Artifact Entity Metadata
 	Metadata *ArtifactEntityMetadata `json:"metadata,omitempty"`
@@ -393,17 +835,49 @@ type ArtifactVersionSummary struct {
 	IsDeleted     bool  `json:"isDeleted"`
 	IsQuarantined *bool `json:"isQuarantined,omitempty"`
 
+	// Origin PUBLISHED if the version was published to the registry, PROXIED if it was cached from the upstream of an upstream proxy
+	Origin *ArtifactOrigin `json:"origin,omitempty"`
+
 	// PackageType refers to package
 	PackageType      PackageType `json:"packageType"`
 	QuarantineReason *string     `json:"quarantineReason,omitempty"`
 	RegistryUUID     string      `json:"registryUUID"`
-	Uuid             string      `json:"uuid"`
-	Version          string      `json:"version"`
+
+	// UpstreamProvenance Where a version cached by an upstream proxy was fetched from, as it was when it was first cached
+	UpstreamProvenance *UpstreamProvenance `json:"upstreamProvenance,omitempty"`
+	Uuid               string              `json:"uuid"`
+	Version            string              `json:"version"`
+}
+
+// ArtifactVersionSyncEntry A version of an artifact returned by the sync of its versions
+type ArtifactVersionSyncEntry struct {
+	// CreatedAt Timestamp in milliseconds when the version was pushed
+	CreatedAt *string                 `json:"createdAt,omitempty"`
+	Metadata  *map[string]interface{} `json:"metadata,omitempty"`
+
+	// ModifiedAt Timestamp in milliseconds when the version was last modified
+	ModifiedAt *string `json:"modifiedAt,omitempty"`
+	Uuid       string  `json:"uuid"`
+	Version    string  `json:"version"`
+}
+
+// ArtifactVersionSyncPage A page of the versions of an artifact, in the order they were pushed
+type ArtifactVersionSyncPage struct {
+	// HasMore True if the page is full, so more versions may follow it
+	HasMore bool `json:"hasMore"`
+
+	// NextCursor The cursor to fetch the versions after this page with. It's returned even when there are no more
+	// versions, so it can be stored to fetch the versions pushed later.
+	NextCursor string                     `json:"nextCursor"`
+	Versions   []ArtifactVersionSyncEntry `json:"versions"`
 }
 
 // AuthType Authentication type
 type AuthType string
 
+// Capability A capability of registries which is only available for some package types
+type Capability string
+
 // CargoArtifactDetailConfig Config for Cargo artifact details
 type CargoArtifactDetailConfig struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
@@ -457,6 +931,44 @@ type ClientSetupStepConfig struct {
 // ClientSetupStepType ClientSetupStepType type
 type ClientSetupStepType string
 
+// DeletionRequest A request to delete an artifact or a version, which waits for the approval of a second user
+type DeletionRequest struct {
+	Artifact string `json:"artifact"`
+
+	// Comment Comment of the reviewer
+	Comment *string `json:"comment,omitempty"`
+
+	// CreatedAt Timestamp in milliseconds of the request
+	CreatedAt string `json:"createdAt"`
+
+	// ExpiresAt Timestamp in milliseconds after which a pending request can't be approved anymore
+	ExpiresAt string `json:"expiresAt"`
+	Id        int64  `json:"id"`
+
+	// RequestedBy ID of the principal who requested the deletion
+	RequestedBy int64 `json:"requestedBy"`
+
+	// ReviewedAt Timestamp in milliseconds of the review
+	ReviewedAt *string `json:"reviewedAt,omitempty"`
+
+	// ReviewedBy ID of the principal who approved or rejected the request
+	ReviewedBy *int64 `json:"reviewedBy,omitempty"`
+
+	// State State of a deletion request
+	State DeletionRequestState `json:"state"`
+
+	// Version The version to delete, it's not set if the whole artifact is deleted
+	Version *string `json:"version,omitempty"`
+}
+
+// DeletionRequestReview defines model for DeletionRequestReview.
+type DeletionRequestReview struct {
+	Comment *string `json:"comment,omitempty"`
+}
+
+// DeletionRequestState State of a deletion request
+type DeletionRequestState string
+
 // DockerArtifactDetail Docker Artifact Detail
 type DockerArtifactDetail struct {
 	CreatedAt      *string `json:"createdAt,omitempty"`
@@ -504,13 +1016,19 @@ type DockerLayersSummary struct {
 
 // DockerManifestDetails Harness Artifact Layers
 type DockerManifestDetails struct {
-	CreatedAt        *string `json:"createdAt,omitempty"`
-	Digest           string  `json:"digest"`
-	DownloadsCount   *int64  `json:"downloadsCount,omitempty"`
-	IsQuarantined    *bool   `json:"isQuarantined,omitempty"`
-	OsArch           string  `json:"osArch"`
-	QuarantineReason *string `json:"quarantineReason,omitempty"`
-	Size             *string `json:"size,omitempty"`
+	CreatedAt      *string `json:"createdAt,omitempty"`
+	Digest         string  `json:"digest"`
+	DownloadsCount *int64  `json:"downloadsCount,omitempty"`
+	IsQuarantined  *bool   `json:"isQuarantined,omitempty"`
+	OsArch         string  `json:"osArch"`
+
+	// QuarantineBlockedPulls number of pulls which were blocked while the artifact was quarantined
+	QuarantineBlockedPulls *int64 `json:"quarantineBlockedPulls,omitempty"`
+
+	// QuarantineLastBlockedPullAt time of the last pull which was blocked, in milliseconds since epoch
+	QuarantineLastBlockedPullAt *string `json:"quarantineLastBlockedPullAt,omitempty"`
+	QuarantineReason            *string `json:"quarantineReason,omitempty"`
+	Size                        *string `json:"size,omitempty"`
 }
 
 // DockerManifests Harness Manifests
@@ -520,6 +1038,39 @@ type DockerManifests struct {
 	Version   string                   `json:"version"`
 }
 
+// DownloadRateLimitConfig Bandwidth caps in bytes per second of each download from a registry, 0 means unlimited
+type DownloadRateLimitConfig struct {
+	// Anonymous Cap of the anonymous downloads
+	Anonymous *int64 `json:"anonymous,omitempty"`
+
+	// Default Cap of the downloads of principals whose type has no cap
+	Default *int64 `json:"default,omitempty"`
+
+	// ServiceAccount Cap of the downloads of service accounts
+	ServiceAccount *int64 `json:"serviceAccount,omitempty"`
+
+	// User Cap of the downloads of users
+	User *int64 `json:"user,omitempty"`
+}
+
+// DownloadStatsBucket Downloads within a period, the first and the last period only count the days of the range
+type DownloadStatsBucket struct {
+	Count int64 `json:"count"`
+
+	// Start First day of the period. Format - MM/DD/YYYY
+	Start string `json:"start"`
+}
+
+// DownloadStatsInterval Length of the periods downloads are counted by, weeks start on Monday
+type DownloadStatsInterval string
+
+// EffectiveRegistryPolicy Policy which applies to a registry once inheritance is resolved
+type EffectiveRegistryPolicy struct {
+	// Policy Registry policies, values which aren't set are inherited from the parent spaces
+	Policy  RegistryPolicy         `json:"policy"`
+	Sources []RegistryPolicySource `json:"sources"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code The http error code
@@ -539,6 +1090,25 @@ type ExtraHeader struct {
 	Value  string `json:"value"`
 }
 
+// FailedUpload An upload whose package couldn't be parsed, its content is kept until it expires
+type FailedUpload struct {
+	// CreatedAt Timestamp in milliseconds of the upload
+	CreatedAt string `json:"createdAt"`
+
+	// Error Error of parsing the package
+	Error string `json:"error"`
+
+	// ExpiresAt Timestamp in milliseconds when the failed upload is deleted
+	ExpiresAt string `json:"expiresAt"`
+
+	// Filename Name of the uploaded file, only set when the client sent it
+	Filename    *string     `json:"filename,omitempty"`
+	PackageType PackageType `json:"packageType"`
+	Sha256      string      `json:"sha256"`
+	Size        int64       `json:"size"`
+	Uuid        string      `json:"uuid"`
+}
+
 // FileDetail File Detail
 type FileDetail struct {
 	Checksums       []string `json:"checksums"`
@@ -549,6 +1119,24 @@ type FileDetail struct {
 	Size            string   `json:"size"`
 }
 
+// FirewallApproval A version of a package let through the firewall delay of an upstream proxy before the delay has passed
+type FirewallApproval struct {
+	// CreatedAt Timestamp in milliseconds of the approval
+	CreatedAt string `json:"createdAt"`
+
+	// CreatedBy ID of the principal who approved the version
+	CreatedBy int64  `json:"createdBy"`
+	Package   string `json:"package"`
+	Reason    string `json:"reason"`
+	Version   string `json:"version"`
+}
+
+// FirewallApprovalRequest defines model for FirewallApprovalRequest.
+type FirewallApprovalRequest struct {
+	// Reason Why the version is served before the firewall delay has passed
+	Reason string `json:"reason"`
+}
+
 // GenericArtifactDetailConfig Config for generic artifact details
 type GenericArtifactDetailConfig struct {
 	Description *string `json:"description,omitempty"`
@@ -559,6 +1147,14 @@ type GoArtifactDetailConfig struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// GradleVariant Variant declared in Gradle module metadata
+type GradleVariant struct {
+	Attributes   *map[string]string `json:"attributes,omitempty"`
+	Dependencies *[]string          `json:"dependencies,omitempty"`
+	Files        *[]string          `json:"files,omitempty"`
+	Name         string             `json:"name"`
+}
+
 // HelmArtifactDetail Helm Artifact Detail
 type HelmArtifactDetail struct {
 	Artifact       *string `json:"artifact,omitempty"`
@@ -584,11 +1180,56 @@ type HelmArtifactDetailConfig struct {
 	PullCommand *string `json:"pullCommand,omitempty"`
 }
 
+// HelmArtifactDependencies Dependencies and provenance of a Helm chart version
+type HelmArtifactDependencies struct {
+	Chart        string                `json:"chart"`
+	ChartVersion string                `json:"chartVersion"`
+	Dependencies []HelmChartDependency `json:"dependencies"`
+
+	// ExternalDependencies Number of dependencies resolved from outside the registry
+	ExternalDependencies int64 `json:"externalDependencies"`
+
+	// Provenance Result of the provenance verification of a Helm chart
+	Provenance HelmChartProvenance `json:"provenance"`
+}
+
 // HelmArtifactManifest Helm Artifact Manifest
 type HelmArtifactManifest struct {
 	Manifest string `json:"manifest"`
 }
 
+// HelmChartDependency Dependency declared in Chart.yaml, pinned by Chart.lock
+type HelmChartDependency struct {
+	Alias *string `json:"alias,omitempty"`
+
+	// External Whether the dependency is resolved from outside the registry
+	External   bool    `json:"external"`
+	Name       string  `json:"name"`
+	Repository *string `json:"repository,omitempty"`
+
+	// ResolvedVersion Version pinned in Chart.lock
+	ResolvedVersion *string `json:"resolvedVersion,omitempty"`
+
+	// Version Version constraint declared in Chart.yaml
+	Version *string `json:"version,omitempty"`
+}
+
+// HelmChartProvenance Result of the provenance verification of a Helm chart
+type HelmChartProvenance struct {
+	KeyId  *string                   `json:"keyId,omitempty"`
+	Signer *string                   `json:"signer,omitempty"`
+	Status HelmChartProvenanceStatus `json:"status"`
+}
+
+// HelmChartProvenanceStatus defines model for HelmChartProvenanceStatus.
+type HelmChartProvenanceStatus string
+
+// HelmProvenanceConfig Provenance verification configuration for Helm registries
+type HelmProvenanceConfig struct {
+	// TrustedKeys Armored public keys accepted for chart provenance signatures
+	TrustedKeys *[]string `json:"trustedKeys,omitempty"`
+}
+
 // HuggingFaceArtifactDetailConfig Config for huggingface artifact details
 type HuggingFaceArtifactDetailConfig struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
@@ -621,6 +1262,11 @@ type ListArtifact struct {
 	PageSize *int `json:"pageSize,omitempty"`
 }
 
+// ListArtifactDownloadCount The download counts of artifacts
+type ListArtifactDownloadCount struct {
+	DownloadCounts []ArtifactDownloadCount `json:"downloadCounts"`
+}
+
 // ListArtifactLabel A list of Harness Artifact Labels
 type ListArtifactLabel struct {
 	// ItemCount The total number of items
@@ -637,10 +1283,10 @@ type ListArtifactLabel struct {
 	PageSize *int `json:"pageSize,omitempty"`
 }
 
-// ListArtifactVersion A list of Artifact versions
-type ListArtifactVersion struct {
-	// ArtifactVersions A list of Artifact versions
-	ArtifactVersions *[]ArtifactVersionMetadata `json:"artifactVersions,omitempty"`
+// ListArtifactDescription A list of artifact description revisions
+type ListArtifactDescription struct {
+	// Descriptions A list of artifact description revisions
+	Descriptions []ArtifactDescription `json:"descriptions"`
 
 	// ItemCount The total number of items
 	ItemCount *int64 `json:"itemCount,omitempty"`
@@ -655,9 +1301,84 @@ type ListArtifactVersion struct {
 	PageSize *int `json:"pageSize,omitempty"`
 }
 
-// ListFileDetail A list of Harness Artifact Files
-type ListFileDetail struct {
-	// Files A list of Harness Artifact Files
+// ListArtifactMetadataChange A list of artifact metadata changes
+type ListArtifactMetadataChange struct {
+	// Changes A list of artifact metadata changes
+	Changes []ArtifactMetadataChange `json:"changes"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// ListArtifactSearchResults A page of artifact versions matching a search
+type ListArtifactSearchResults struct {
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int                   `json:"pageSize,omitempty"`
+	Results  []ArtifactSearchResult `json:"results"`
+}
+
+// ListArtifactVersion A list of Artifact versions
+type ListArtifactVersion struct {
+	// ArtifactVersions A list of Artifact versions
+	ArtifactVersions *[]ArtifactVersionMetadata `json:"artifactVersions,omitempty"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// ListDeletionRequests A list of deletion requests
+type ListDeletionRequests struct {
+	Requests []DeletionRequest `json:"requests"`
+}
+
+// ListFailedUpload A list of failed uploads
+type ListFailedUpload struct {
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// Uploads A list of failed uploads
+	Uploads []FailedUpload `json:"uploads"`
+}
+
+// ListFileDetail A list of Harness Artifact Files
+type ListFileDetail struct {
+	// Files A list of Harness Artifact Files
 	Files []FileDetail `json:"files"`
 
 	// ItemCount The total number of items
@@ -673,6 +1394,11 @@ type ListFileDetail struct {
 	PageSize *int `json:"pageSize,omitempty"`
 }
 
+// ListFirewallApprovals A list of firewall approvals
+type ListFirewallApprovals struct {
+	Approvals []FirewallApproval `json:"approvals"`
+}
+
 // ListMigrationImage A list of migration images
 type ListMigrationImage struct {
 	// Images A list of Artifact versions
@@ -709,6 +1435,69 @@ type ListOciArtifactTags struct {
 	PageSize *int `json:"pageSize,omitempty"`
 }
 
+// ListNotificationChannels defines model for ListNotificationChannels.
+type ListNotificationChannels struct {
+	Channels []NotificationChannel `json:"channels"`
+}
+
+// ListPackageDenylistEntries A list of package denylist entries
+type ListPackageDenylistEntries struct {
+	Entries []PackageDenylistEntry `json:"entries"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// ListPackageDenylistOverrides A list of package denylist overrides
+type ListPackageDenylistOverrides struct {
+	Overrides []PackageDenylistOverride `json:"overrides"`
+}
+
+// ListPackageTypeCapabilities The capabilities of all package types
+type ListPackageTypeCapabilities struct {
+	PackageTypes []PackageTypeCapabilities `json:"packageTypes"`
+}
+
+// ListPipelineArtifacts A list of artifact versions published by pipeline executions
+type ListPipelineArtifacts struct {
+	Artifacts []PipelineArtifact `json:"artifacts"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// ListPreferencesConfig Defaults of the lists of a registry, applied when a request doesn't set the sort or page size
+type ListPreferencesConfig struct {
+	// DefaultSort Default order of the versions of an artifact, SEMVER puts the highest version first and RECENCY the most recently modified one
+	DefaultSort *ListSort `json:"defaultSort,omitempty"`
+
+	// PageSize Number of items per page, between 1 and 100
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// ListRecentArtifact A list of recent artifacts
+type ListRecentArtifact struct {
+	Artifacts []RecentArtifact `json:"artifacts"`
+}
+
 // ListRegistry A list of Harness Artifact Registries
 type ListRegistry struct {
 	// ItemCount The total number of items
@@ -727,6 +1516,42 @@ type ListRegistry struct {
 	Registries []RegistryMetadata `json:"registries"`
 }
 
+// ListRegistryIndexBuild A list of registry index builds
+type ListRegistryIndexBuild struct {
+	// Builds A list of registry index builds
+	Builds []RegistryIndexBuild `json:"builds"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// ListRegistryJob A list of registry jobs
+type ListRegistryJob struct {
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// Jobs A list of registry jobs
+	Jobs []RegistryJob `json:"jobs"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
 // ListRegistryArtifact A list of Artifacts
 type ListRegistryArtifact struct {
 	// Artifacts A list of Artifact
@@ -763,6 +1588,47 @@ type ListReplicationRule struct {
 	Rules []ReplicationRule `json:"rules"`
 }
 
+// ListScheduledDeletions A list of scheduled deletions
+type ListScheduledDeletions struct {
+	Deletions []ScheduledDeletion `json:"deletions"`
+}
+
+// ListSort Default order of the versions of an artifact, SEMVER puts the highest version first and RECENCY the most recently modified one
+type ListSort string
+
+// ListStarredArtifact A list of starred artifacts
+type ListStarredArtifact struct {
+	Artifacts []StarredArtifact `json:"artifacts"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// ListVulnerableVersions A list of vulnerable versions
+type ListVulnerableVersions struct {
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int                `json:"pageSize,omitempty"`
+	Versions []VulnerableVersion `json:"versions"`
+}
+
 // ListWebhooks A list of Harness Registries webhooks
 type ListWebhooks struct {
 	// ItemCount The total number of items
@@ -808,6 +1674,15 @@ type LocalReplicationRegistry struct {
 type MavenArtifactDetailConfig struct {
 	ArtifactId *string `json:"artifactId,omitempty"`
 	GroupId    *string `json:"groupId,omitempty"`
+
+	// Variants Variants declared in the Gradle module metadata of the version
+	Variants *[]GradleVariant `json:"variants,omitempty"`
+}
+
+// MetadataFieldChange Old and new value of a metadata field, null when the field was added or removed
+type MetadataFieldChange struct {
+	New interface{} `json:"new"`
+	Old interface{} `json:"old"`
 }
 
 // MigrationImage defines model for MigrationImage.
@@ -818,6 +1693,45 @@ type MigrationImage struct {
 	Status   *string `json:"status,omitempty"`
 }
 
+// NotificationChannel Channel which high-signal events of a registry are delivered to
+type NotificationChannel struct {
+	CreatedAt  string                  `json:"createdAt"`
+	Enabled    bool                    `json:"enabled"`
+	Events     []NotificationEvent     `json:"events"`
+	Identifier string                  `json:"identifier"`
+	ModifiedAt string                  `json:"modifiedAt"`
+	RateLimit  int                     `json:"rateLimit"`
+	Recipients *[]string               `json:"recipients,omitempty"`
+	Template   *string                 `json:"template,omitempty"`
+	Type       NotificationChannelType `json:"type"`
+}
+
+// NotificationChannelRequest defines model for NotificationChannelRequest.
+type NotificationChannelRequest struct {
+	Enabled    bool                `json:"enabled"`
+	Events     []NotificationEvent `json:"events"`
+	Identifier string              `json:"identifier"`
+
+	// RateLimit Maximum number of notifications delivered per hour, defaults to 10
+	RateLimit *int `json:"rateLimit,omitempty"`
+
+	// Recipients Email addresses, required for EMAIL channels
+	Recipients *[]string `json:"recipients,omitempty"`
+
+	// Template Go text/template which overrides the default message, it's executed on the event
+	Template *string                 `json:"template,omitempty"`
+	Type     NotificationChannelType `json:"type"`
+
+	// Url Incoming webhook URL, required for SLACK and TEAMS channels. It's kept when not set on update
+	Url *string `json:"url,omitempty"`
+}
+
+// NotificationChannelType defines model for NotificationChannelType.
+type NotificationChannelType string
+
+// NotificationEvent High-signal registry event
+type NotificationEvent string
+
 // NpmArtifactDetailConfig Config for npm artifact details
 type NpmArtifactDetailConfig struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
@@ -828,20 +1742,223 @@ type NugetArtifactDetailConfig struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// OSVAdvisory An advisory in the OSV format, only the affected packages are imported
+type OSVAdvisory struct {
+	Affected *[]OSVAffected `json:"affected,omitempty"`
+	Id       string         `json:"id"`
+	Summary  *string        `json:"summary,omitempty"`
+}
+
+// OSVAffected A package affected by an advisory, all its versions are affected if neither versions nor ranges are set
+type OSVAffected struct {
+	Package  OSVPackage  `json:"package"`
+	Ranges   *[]OSVRange `json:"ranges,omitempty"`
+	Versions *[]string   `json:"versions,omitempty"`
+}
+
+// OSVPackage defines model for OSVPackage.
+type OSVPackage struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+}
+
+// OSVRange defines model for OSVRange.
+type OSVRange struct {
+	Events *[]OSVRangeEvent `json:"events,omitempty"`
+
+	// Type Type of the range, only SEMVER and ECOSYSTEM ranges are imported
+	Type string `json:"type"`
+}
+
+// OSVRangeEvent defines model for OSVRangeEvent.
+type OSVRangeEvent struct {
+	Fixed        *string `json:"fixed,omitempty"`
+	Introduced   *string `json:"introduced,omitempty"`
+	LastAffected *string `json:"last_affected,omitempty"`
+}
+
 // OciArtifactTag OCI Artifact Tag info
 type OciArtifactTag struct {
 	Digest string `json:"digest"`
 	Name   string `json:"name"`
 }
 
+// OciImportResult The images imported from an OCI archive
+type OciImportResult struct {
+	// BlobsLinked The number of blobs the root space had already, they are linked instead of uploaded
+	BlobsLinked int `json:"blobsLinked"`
+
+	// BlobsUploaded The number of blobs uploaded to the storage
+	BlobsUploaded int                `json:"blobsUploaded"`
+	Images        []OciImportedImage `json:"images"`
+}
+
+// OciImportedImage defines model for OciImportedImage.
+type OciImportedImage struct {
+	Digest string `json:"digest"`
+	Image  string `json:"image"`
+
+	// Reconstructed Whether the manifest list was rebuilt from the platforms the archive holds, or from the images the archive tags alike, so its digest differs from the one of the source
+	Reconstructed bool `json:"reconstructed"`
+
+	// Tag The tag of the image, it's empty for untagged images
+	Tag *string `json:"tag,omitempty"`
+}
+
+// PackageDenylistEntry A package denied in all registries of a space and of its subspaces, uploads and proxy pulls of the versions in the version range are blocked
+type PackageDenylistEntry struct {
+	// CreatedAt Timestamp in milliseconds of the creation
+	CreatedAt string `json:"createdAt"`
+
+	// CreatedBy ID of the principal who added the entry
+	CreatedBy int64 `json:"createdBy"`
+
+	// ExternalId ID of the advisory the entry was imported from
+	ExternalId *string `json:"externalId,omitempty"`
+	Id         int64   `json:"id"`
+	Name       string  `json:"name"`
+
+	// PackageType The package type of the package, the package is denied in registries of all types if it's not set
+	PackageType *string `json:"packageType,omitempty"`
+	Reason      string  `json:"reason"`
+
+	// Source Source of a package denylist entry
+	Source PackageDenylistSource `json:"source"`
+
+	// VersionRange The denied versions, a version or a semver constraint like ">= 1.2.0, < 1.4.0". All versions are denied if it's not set.
+	VersionRange *string `json:"versionRange,omitempty"`
+}
+
+// PackageDenylistEntryRequest defines model for PackageDenylistEntryRequest.
+type PackageDenylistEntryRequest struct {
+	Name string `json:"name"`
+
+	// PackageType refers to package
+	PackageType *PackageType `json:"packageType,omitempty"`
+	Reason      string       `json:"reason"`
+
+	// VersionRange The denied versions, a version or a semver constraint like ">= 1.2.0, < 1.4.0". All versions are denied if it's not set.
+	VersionRange *string `json:"versionRange,omitempty"`
+}
+
+// PackageDenylistImportRequest defines model for PackageDenylistImportRequest.
+type PackageDenylistImportRequest struct {
+	Advisories []OSVAdvisory `json:"advisories"`
+}
+
+// PackageDenylistImportResult defines model for PackageDenylistImportResult.
+type PackageDenylistImportResult struct {
+	// Imported The number of entries added to the denylist
+	Imported int `json:"imported"`
+
+	// Skipped The number of affected packages which were already denied or whose ecosystem isn't supported
+	Skipped int `json:"skipped"`
+}
+
+// PackageDenylistOverride An exemption of a registry from an entry of the package denylist of one of its spaces
+type PackageDenylistOverride struct {
+	// CreatedAt Timestamp in milliseconds of the override
+	CreatedAt string `json:"createdAt"`
+
+	// CreatedBy ID of the principal who overrode the entry
+	CreatedBy int64 `json:"createdBy"`
+
+	// Entry A package denied in all registries of a space and of its subspaces, uploads and proxy pulls of the versions in the version range are blocked
+	Entry  PackageDenylistEntry `json:"entry"`
+	Reason string               `json:"reason"`
+}
+
+// PackageDenylistOverrideRequest defines model for PackageDenylistOverrideRequest.
+type PackageDenylistOverrideRequest struct {
+	// Reason Why the registry is exempted from the entry
+	Reason string `json:"reason"`
+}
+
+// PackageDenylistSource Source of a package denylist entry
+type PackageDenylistSource string
+
 // PackageType refers to package
 type PackageType string
 
+// PackageTypeCapabilities The capabilities of a package type
+type PackageTypeCapabilities struct {
+	Capabilities []PackageTypeCapability `json:"capabilities"`
+
+	// PackageType refers to package
+	PackageType PackageType `json:"packageType"`
+}
+
+// PackageTypeCapability Whether a capability is supported for a package type
+type PackageTypeCapability struct {
+	// Capability A capability of registries which is only available for some package types
+	Capability Capability `json:"capability"`
+	Supported  bool       `json:"supported"`
+}
+
+// PipelineArtifact An artifact version published by a pipeline execution
+type PipelineArtifact struct {
+	Package string `json:"package"`
+
+	// PackageType refers to package
+	PackageType PackageType `json:"packageType"`
+
+	// Provenance The pipeline execution which published an artifact version
+	Provenance         ArtifactProvenance `json:"provenance"`
+	RegistryIdentifier string             `json:"registryIdentifier"`
+	Version            string             `json:"version"`
+}
+
+// PipelineExecution Reference to the CI pipeline execution which published an artifact version
+type PipelineExecution struct {
+	// CommitSha SHA of the commit the pipeline execution built
+	CommitSha  *string `json:"commitSha,omitempty"`
+	PipelineId *string `json:"pipelineId,omitempty"`
+
+	// RunUrl URL of the pipeline execution
+	RunUrl *string `json:"runUrl,omitempty"`
+
+	// System CI system which ran the pipeline
+	System *string `json:"system,omitempty"`
+}
+
 // PythonArtifactDetailConfig Config for python artifact details
 type PythonArtifactDetailConfig struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// QuotaConfig Usage limits of a registry, crossing a threshold raises the REGISTRY_QUOTA_THRESHOLD webhook
+type QuotaConfig struct {
+	// BandwidthLimit Maximum number of bytes downloaded from the registry per calendar month, 0 means unlimited
+	BandwidthLimit *int64 `json:"bandwidthLimit,omitempty"`
+
+	// StorageLimit Maximum size in bytes of the blobs stored in the registry, 0 means unlimited
+	StorageLimit *int64 `json:"storageLimit,omitempty"`
+
+	// Thresholds Usage percentages of a limit at which alerts are raised, defaults to 80 and 90
+	Thresholds *[]int `json:"thresholds,omitempty"`
+}
+
+// RecentArtifact An artifact the current user recently viewed or pulled
+type RecentArtifact struct {
+	// Activity The kind of interaction of the current user with an artifact
+	Activity ArtifactActivity `json:"activity"`
+
+	// ArtifactType refers to artifact type
+	ArtifactType *ArtifactType `json:"artifactType,omitempty"`
+
+	// At Timestamp in milliseconds of the interaction
+	At   string `json:"at"`
+	Name string `json:"name"`
+
+	// PackageType refers to package
+	PackageType        PackageType `json:"packageType"`
+	RegistryIdentifier string      `json:"registryIdentifier"`
+	RegistryPath       string      `json:"registryPath"`
+
+	// Version The version which was pulled, it's not set for views
+	Version *string `json:"version,omitempty"`
+}
+
 // Registry Harness Artifact Registry
 type Registry struct {
 	AllowedPattern *[]string        `json:"allowedPattern,omitempty"`
@@ -853,9 +1970,12 @@ type Registry struct {
 	CreatedAt *string         `json:"createdAt,omitempty"`
 
 	// DeletedAt Timestamp in milliseconds when the registry was soft-deleted
-	DeletedAt   *string `json:"deletedAt,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Identifier  string  `json:"identifier"`
+	DeletedAt *string `json:"deletedAt,omitempty"`
+
+	// DeletionProtection Deletes of the registry and its artifacts fail while enabled
+	DeletionProtection *bool   `json:"deletionProtection,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	Identifier         string  `json:"identifier"`
 
 	// IsDeleted True if the registry is soft-deleted
 	IsDeleted  bool      `json:"isDeleted"`
@@ -869,6 +1989,14 @@ type Registry struct {
 	Uuid        string      `json:"uuid"`
 }
 
+// RegistryApplyResult The changes made to reconcile the configuration of a registry
+type RegistryApplyResult struct {
+	Changes []RegistryConfigChange `json:"changes"`
+
+	// DryRun Whether the changes were only computed and not applied
+	DryRun bool `json:"dryRun"`
+}
+
 // RegistryArtifactMetadata Artifact Metadata
 type RegistryArtifactMetadata struct {
 	// ArtifactType refers to artifact type
@@ -892,7 +2020,10 @@ type RegistryArtifactMetadata struct {
 	RegistryIdentifier string      `json:"registryIdentifier"`
 	RegistryPath       string      `json:"registryPath"`
 	RegistryUUID       string      `json:"registryUUID"`
-	Uuid               string      `json:"uuid"`
+
+	// StarCount Number of users which starred the artifact
+	StarCount *int64 `json:"starCount,omitempty"`
+	Uuid      string `json:"uuid"`
 }
 
 // RegistryConfig SubConfig specific for Virtual or Upstream Registry
@@ -902,6 +2033,133 @@ type RegistryConfig struct {
 	union json.RawMessage
 }
 
+// RegistryConfigAction How a part of the configuration of a registry was changed
+type RegistryConfigAction string
+
+// RegistryConfigChange A change made to reconcile the configuration of a registry
+type RegistryConfigChange struct {
+	// Action How a part of the configuration of a registry was changed
+	Action RegistryConfigAction `json:"action"`
+
+	// Identifier The setting key, or the identifier of the cleanup policy, upstream proxy or webhook
+	Identifier string `json:"identifier"`
+
+	// Resource The part of the configuration of a registry which was changed
+	Resource RegistryConfigResource `json:"resource"`
+}
+
+// RegistryConfigDocument Desired configuration of a virtual registry. A section which is set is the whole desired state of that section, a section which isn't set is left unmanaged.
+type RegistryConfigDocument struct {
+	CleanupPolicy *[]CleanupPolicy `json:"cleanupPolicy,omitempty"`
+
+	// Settings Values of the settings keyed by setting key, a null value resets a setting
+	Settings *RegistrySettingsRequest `json:"settings,omitempty"`
+
+	// UpstreamProxies Identifiers of the upstream proxies of the registry, in the order they are resolved
+	UpstreamProxies *[]string         `json:"upstreamProxies,omitempty"`
+	Webhooks        *[]WebhookRequest `json:"webhooks,omitempty"`
+}
+
+// RegistryConfigResource The part of the configuration of a registry which was changed
+type RegistryConfigResource string
+
+// RegistryGarbageStat Soft-deleted rows of a kind, deleted within an age bucket
+type RegistryGarbageStat struct {
+	// Age Time since the rows were deleted
+	Age   RegistryGarbageStatAge  `json:"age"`
+	Count int64                   `json:"count"`
+	Kind  RegistryGarbageStatKind `json:"kind"`
+
+	// Size Size in bytes, tags don't hold storage of their own
+	Size int64 `json:"size"`
+}
+
+// RegistryGarbageStatAge Time since the rows were deleted
+type RegistryGarbageStatAge string
+
+// RegistryGarbageStatKind defines model for RegistryGarbageStat.Kind.
+type RegistryGarbageStatKind string
+
+// RegistryGarbageStats Soft-deleted rows of an account which wait to be purged
+type RegistryGarbageStats struct {
+	Stats      []RegistryGarbageStat `json:"stats"`
+	TotalCount int64                 `json:"totalCount"`
+
+	// TotalSize Size in bytes of the soft-deleted manifests, blobs shared with other manifests are included
+	TotalSize int64 `json:"totalSize"`
+}
+
+// RegistryIndexBuild A run of a registry index build
+type RegistryIndexBuild struct {
+	// Duration Duration of the build in milliseconds, only set once it finished
+	Duration *int64 `json:"duration,omitempty"`
+
+	// Error Error of the build, only set for failed builds
+	Error *string `json:"error,omitempty"`
+	Id    int64   `json:"id"`
+
+	// IndexType PACKAGE builds rebuild the index of a single package
+	IndexType RegistryIndexBuildIndexType `json:"indexType"`
+
+	// Package Package whose index was built, only set for PACKAGE builds
+	Package *string `json:"package,omitempty"`
+
+	// StartedAt Timestamp in milliseconds when the build started
+	StartedAt string                   `json:"startedAt"`
+	Status    RegistryIndexBuildStatus `json:"status"`
+}
+
+// RegistryIndexBuildIndexType PACKAGE builds rebuild the index of a single package
+type RegistryIndexBuildIndexType string
+
+// RegistryIndexBuildStatus defines model for RegistryIndexBuild.Status.
+type RegistryIndexBuildStatus string
+
+// RegistryIndexStatus Status of the latest rebuild of a registry index
+type RegistryIndexStatus struct {
+	// RebuildQueued True if another rebuild was requested while the current one is running
+	RebuildQueued bool `json:"rebuildQueued"`
+
+	// Status NONE if the index was never rebuilt
+	Status RegistryIndexStatusStatus `json:"status"`
+
+	// UpdatedAt Timestamp in milliseconds of the last status change
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// RegistryIndexStatusStatus NONE if the index was never rebuilt
+type RegistryIndexStatusStatus string
+
+// RegistryJob A long-running operation of a registry
+type RegistryJob struct {
+	// CreatedAt Timestamp in milliseconds when the job was queued
+	CreatedAt string `json:"createdAt"`
+	CreatedBy int64  `json:"createdBy"`
+
+	// Error Error of the job, only set for failed jobs
+	Error *string `json:"error,omitempty"`
+
+	// FinishedAt Timestamp in milliseconds when the job completed, only set once it completed
+	FinishedAt *string `json:"finishedAt,omitempty"`
+
+	// Kind The operation run by the job
+	Kind string `json:"kind"`
+
+	// Log The latest lines logged by the job
+	Log *string `json:"log,omitempty"`
+
+	// Progress Progress of the job in percent
+	Progress int `json:"progress"`
+
+	// StartedAt Timestamp in milliseconds when the job started, only set once it started
+	StartedAt *string          `json:"startedAt,omitempty"`
+	State     RegistryJobState `json:"state"`
+	Uuid      string           `json:"uuid"`
+}
+
+// RegistryJobState defines model for RegistryJob.State.
+type RegistryJobState string
+
 // RegistryMetadata Harness Artifact Registry Metadata
 type RegistryMetadata struct {
 	ArtifactsCount *int64 `json:"artifactsCount,omitempty"`
@@ -929,6 +2187,48 @@ type RegistryMetadata struct {
 	Uuid string       `json:"uuid"`
 }
 
+// RegistryPolicy Registry policies, values which aren't set are inherited from the parent spaces
+type RegistryPolicy struct {
+	// AutoQuarantineSeverity Quarantines the versions found affected by vulnerabilities of this severity or above, NONE turns it off
+	AutoQuarantineSeverity *RegistryPolicyAutoQuarantineSeverity `json:"autoQuarantineSeverity,omitempty"`
+
+	// DeletionApproval Deletes of artifacts wait for the approval of a second user
+	DeletionApproval *bool `json:"deletionApproval,omitempty"`
+
+	// DownloadStatsPrivacy Only keeps aggregated daily download counters, without recording who downloaded
+	DownloadStatsPrivacy *bool `json:"downloadStatsPrivacy,omitempty"`
+
+	// Immutable Prevents existing versions from being overwritten
+	Immutable *bool `json:"immutable,omitempty"`
+
+	// Quota Usage limits of a registry, crossing a threshold raises the REGISTRY_QUOTA_THRESHOLD webhook
+	Quota *QuotaConfig `json:"quota,omitempty"`
+
+	// RequireSignatures Rejects uploads of packages which aren't signed
+	RequireSignatures *bool `json:"requireSignatures,omitempty"`
+
+	// RetentionDays Number of days versions are kept for, 0 keeps them forever
+	RetentionDays *int64 `json:"retentionDays,omitempty"`
+}
+
+// RegistryPolicyAutoQuarantineSeverity Quarantines the versions found affected by vulnerabilities of this severity or above, NONE turns it off
+type RegistryPolicyAutoQuarantineSeverity string
+
+// RegistryPolicySource Tells where the effective value of a policy field comes from
+type RegistryPolicySource struct {
+	Field RegistryPolicySourceField `json:"field"`
+
+	// SpacePath Path of the space the value is inherited from, only set for SPACE sources
+	SpacePath *string                  `json:"spacePath,omitempty"`
+	Type      RegistryPolicySourceType `json:"type"`
+}
+
+// RegistryPolicySourceField defines model for RegistryPolicySource.Field.
+type RegistryPolicySourceField string
+
+// RegistryPolicySourceType defines model for RegistryPolicySource.Type.
+type RegistryPolicySourceType string
+
 // RegistryRequest defines model for RegistryRequest.
 type RegistryRequest struct {
 	AllowedPattern *[]string        `json:"allowedPattern,omitempty"`
@@ -936,20 +2236,48 @@ type RegistryRequest struct {
 	CleanupPolicy  *[]CleanupPolicy `json:"cleanupPolicy,omitempty"`
 
 	// Config SubConfig specific for Virtual or Upstream Registry
-	Config      *RegistryConfig `json:"config,omitempty"`
-	Description *string         `json:"description,omitempty"`
-	Identifier  string          `json:"identifier"`
-	IsPublic    bool            `json:"isPublic"`
-	Labels      *[]string       `json:"labels,omitempty"`
+	Config *RegistryConfig `json:"config,omitempty"`
+
+	// DeletionProtection Deletes of the registry and its artifacts fail while enabled
+	DeletionProtection *bool     `json:"deletionProtection,omitempty"`
+	Description        *string   `json:"description,omitempty"`
+	Identifier         string    `json:"identifier"`
+	IsPublic           bool      `json:"isPublic"`
+	Labels             *[]string `json:"labels,omitempty"`
 
 	// PackageType refers to package
 	PackageType PackageType `json:"packageType"`
 	ParentRef   string      `json:"parentRef"`
 }
 
+// RegistrySetting Effective value of a setting of a registry
+type RegistrySetting struct {
+	// Default Value which applies if neither the registry nor its spaces set the setting
+	Default interface{}        `json:"default"`
+	Key     RegistrySettingKey `json:"key"`
+
+	// Source Tells where the effective value of a policy field comes from
+	Source RegistryPolicySource `json:"source"`
+
+	// Value Value which applies to the registry
+	Value interface{} `json:"value"`
+}
+
+// RegistrySettingKey defines model for RegistrySetting.Key.
+type RegistrySettingKey string
+
+// RegistrySettingsRequest Values of the settings keyed by setting key, a null value resets a setting
+type RegistrySettingsRequest map[string]interface{}
+
 // RegistryType refers to type of registry i.e virtual or upstream
 type RegistryType string
 
+// ReplayWebhookExecutionRequest defines model for ReplayWebhookExecutionRequest.
+type ReplayWebhookExecutionRequest struct {
+	// Url URL the payload is sent to instead of the URL of the webhook
+	Url *string `json:"url,omitempty"`
+}
+
 // ReplicationRegistry defines model for ReplicationRegistry.
 type ReplicationRegistry struct {
 	union json.RawMessage
@@ -996,9 +2324,128 @@ type RpmArtifactDetailConfig struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// RpmSigningConfig GPG signing configuration for RPM registries
+type RpmSigningConfig struct {
+	// SigningKeySecretIdentifier Secret holding the armored private key used to sign repomd.xml
+	SigningKeySecretIdentifier *string `json:"signingKeySecretIdentifier,omitempty"`
+	SigningKeySecretSpacePath  *string `json:"signingKeySecretSpacePath,omitempty"`
+
+	// TrustedKeys Armored public keys accepted for package signatures
+	TrustedKeys *[]string `json:"trustedKeys,omitempty"`
+
+	// VerifyPackageSignatures Reject uploads of packages which are not signed by a trusted key
+	VerifyPackageSignatures *bool `json:"verifyPackageSignatures,omitempty"`
+}
+
+// ScheduleDeletionRequest defines model for ScheduleDeletionRequest.
+type ScheduleDeletionRequest struct {
+	Artifact string `json:"artifact"`
+
+	// Delay Duration after which the deletion is executed, like 24h. The default delay of the instance applies if it's not set.
+	Delay *string `json:"delay,omitempty"`
+
+	// Version The version to delete, the whole artifact is deleted if it's not set
+	Version *string `json:"version,omitempty"`
+}
+
+// ScheduledDeletion A deletion of an artifact or a version which is executed later, it can be canceled until then
+type ScheduledDeletion struct {
+	Artifact string `json:"artifact"`
+
+	// CanceledBy ID of the principal who canceled the deletion
+	CanceledBy *int64 `json:"canceledBy,omitempty"`
+
+	// CreatedAt Timestamp in milliseconds of the scheduling
+	CreatedAt string `json:"createdAt"`
+
+	// CreatedBy ID of the principal who scheduled the deletion, the artifact is deleted on their behalf
+	CreatedBy int64 `json:"createdBy"`
+
+	// Error Error of a failed deletion
+	Error *string `json:"error,omitempty"`
+
+	// ExecuteAt Timestamp in milliseconds after which the deletion is executed
+	ExecuteAt string `json:"executeAt"`
+	Id        int64  `json:"id"`
+
+	// State State of a scheduled deletion
+	State ScheduledDeletionState `json:"state"`
+
+	// UpdatedAt Timestamp in milliseconds of the last change of the state
+	UpdatedAt string `json:"updatedAt"`
+
+	// Version The version to delete, it's not set if the whole artifact is deleted
+	Version *string `json:"version,omitempty"`
+}
+
+// ScheduledDeletionState State of a scheduled deletion
+type ScheduledDeletionState string
+
 // SectionType refers to client setup section type
 type SectionType string
 
+// SpaceRegistryUsage Usage of the registries of a space, including the registries of the spaces below it
+type SpaceRegistryUsage struct {
+	// BandwidthBytes Number of bytes downloaded from the registries in the calendar month
+	BandwidthBytes int64  `json:"bandwidthBytes"`
+	RegistryCount  int64  `json:"registryCount"`
+	SpacePath      string `json:"spacePath"`
+
+	// StorageBytes Size in bytes of the blobs stored in the registries
+	StorageBytes int64 `json:"storageBytes"`
+}
+
+// SpaceRegistryUsageHistory Daily registry usage of a space within a range of days
+type SpaceRegistryUsageHistory struct {
+	// From First day of the range. Format - MM/DD/YYYY
+	From string `json:"from"`
+
+	// Snapshots Usage at the end of each day, oldest first. Days without snapshot are omitted
+	Snapshots []SpaceRegistryUsageSnapshot `json:"snapshots"`
+	SpacePath string                       `json:"spacePath"`
+
+	// To Last day of the range. Format - MM/DD/YYYY
+	To string `json:"to"`
+}
+
+// SpaceRegistryUsageReport Registry usage of a space and of the spaces below it
+type SpaceRegistryUsageReport struct {
+	// PeriodStart First day the bandwidth is counted from. Format - MM/DD/YYYY
+	PeriodStart string `json:"periodStart"`
+
+	// Spaces Usage of the space and of each space below it which contains registries, ordered by path
+	Spaces []SpaceRegistryUsage `json:"spaces"`
+}
+
+// SpaceRegistryUsageSnapshot Registry usage of a space persisted at the end of a day
+type SpaceRegistryUsageSnapshot struct {
+	// BandwidthBytes Number of bytes downloaded in the calendar month up to the day
+	BandwidthBytes int64 `json:"bandwidthBytes"`
+
+	// Day Day of the snapshot. Format - MM/DD/YYYY
+	Day           string `json:"day"`
+	RegistryCount int64  `json:"registryCount"`
+	StorageBytes  int64  `json:"storageBytes"`
+}
+
+// StarredArtifact An artifact starred by the current user
+type StarredArtifact struct {
+	// ArtifactType refers to artifact type
+	ArtifactType *ArtifactType `json:"artifactType,omitempty"`
+	Name         string        `json:"name"`
+
+	// PackageType refers to package
+	PackageType        PackageType `json:"packageType"`
+	RegistryIdentifier string      `json:"registryIdentifier"`
+	RegistryPath       string      `json:"registryPath"`
+
+	// StarCount Number of users which starred the artifact
+	StarCount int64 `json:"starCount"`
+
+	// StarredAt Timestamp in milliseconds when the current user starred the artifact
+	StarredAt string `json:"starredAt"`
+}
+
 // Status Indicates if the request was successful or not
 type Status string
 
@@ -1013,9 +2460,53 @@ type TabSetupStepConfig struct {
 	Tabs *[]TabSetupStep `json:"tabs,omitempty"`
 }
 
+// TestWebhookRequest defines model for TestWebhookRequest.
+type TestWebhookRequest struct {
+	// Trigger refers to trigger
+	Trigger Trigger `json:"trigger"`
+}
+
+// TrashedArtifactVersion A deleted OCI tag, or untagged manifest, which can be restored
+type TrashedArtifactVersion struct {
+	// DeletedAt Timestamp in milliseconds when the version was deleted
+	DeletedAt string `json:"deletedAt"`
+	Digest    string `json:"digest"`
+	Package   string `json:"package"`
+
+	// Version Tag name, or the manifest digest for untagged manifests
+	Version string `json:"version"`
+}
+
 // Trigger refers to trigger
 type Trigger string
 
+// UploadFailureStat Failed uploads of a registry with the same package type and error class
+type UploadFailureStat struct {
+	Count      int64                       `json:"count"`
+	ErrorClass UploadFailureStatErrorClass `json:"errorClass"`
+
+	// LastFailedAt Timestamp in milliseconds of the last failed upload
+	LastFailedAt       string      `json:"lastFailedAt"`
+	PackageType        PackageType `json:"packageType"`
+	RegistryIdentifier string      `json:"registryIdentifier"`
+}
+
+// UploadFailureStatErrorClass defines model for UploadFailureStat.ErrorClass.
+type UploadFailureStatErrorClass string
+
+// UploadFailureStats Failed uploads of the registries of an account within a range of days
+type UploadFailureStats struct {
+	// From First day of the range. Format - MM/DD/YYYY
+	From string `json:"from"`
+
+	// Stats Counts by registry, package type and error class, most frequent first
+	Stats []UploadFailureStat `json:"stats"`
+
+	// To Last day of the range. Format - MM/DD/YYYY
+	To         string `json:"to"`
+	TotalCount int64  `json:"totalCount"`
+}
+
 // UpstreamConfig Configuration for Harness Artifact UpstreamProxies
 type UpstreamConfig struct {
 	Auth *UpstreamConfig_Auth `json:"auth,omitempty"`
@@ -1027,6 +2518,9 @@ type UpstreamConfig struct {
 	RemoteUrlSuffix *string               `json:"remoteUrlSuffix,omitempty"`
 	Source          *UpstreamConfigSource `json:"source,omitempty"`
 
+	// UpstreamProxyConfigFirewallDelayHours Hours a version newly published upstream is held back before it's served, so a compromised release is likely found and yanked before it's pulled. Versions can be approved to be served sooner. It's supported for npm and Python upstreams, 0 serves new versions right away.
+	UpstreamProxyConfigFirewallDelayHours *int `json:"upstreamProxyConfigFirewallDelayHours,omitempty"`
+
 	// UpstreamProxyConfigFirewallMode Firewall mode applied to an upstream proxy.
 	UpstreamProxyConfigFirewallMode *UpstreamProxyConfigFirewallMode `json:"upstreamProxyConfigFirewallMode,omitempty"`
 	Url                             *string                          `json:"url,omitempty"`
@@ -1040,6 +2534,27 @@ type UpstreamConfig_Auth struct {
 // UpstreamConfigSource defines model for UpstreamConfig.Source.
 type UpstreamConfigSource string
 
+// UpstreamFileProvenance Where a file cached by an upstream proxy was fetched from
+type UpstreamFileProvenance struct {
+	// Checksums Hex digests of the file advertised by the upstream, keyed by algorithm
+	Checksums map[string]string `json:"checksums"`
+	FileName  string            `json:"fileName"`
+
+	// FirstSeenAt Timestamp in milliseconds when the file was first cached
+	FirstSeenAt string `json:"firstSeenAt"`
+
+	// SourceUrl URL the file was downloaded from
+	SourceUrl string `json:"sourceUrl"`
+}
+
+// UpstreamProvenance Where a version cached by an upstream proxy was fetched from, as it was when it was first cached
+type UpstreamProvenance struct {
+	Files []UpstreamFileProvenance `json:"files"`
+
+	// FirstSeenAt Timestamp in milliseconds when the first file of the version was cached
+	FirstSeenAt *string `json:"firstSeenAt,omitempty"`
+}
+
 // UpstreamProxyConfigFirewallMode Firewall mode applied to an upstream proxy.
 type UpstreamProxyConfigFirewallMode string
 
@@ -1053,7 +2568,77 @@ type UserPassword struct {
 
 // VirtualConfig Configuration for Harness Virtual Artifact Registries
 type VirtualConfig struct {
-	UpstreamProxies *[]string `json:"upstreamProxies,omitempty"`
+	// DefaultArtifactType refers to artifact type
+	DefaultArtifactType *ArtifactType `json:"defaultArtifactType,omitempty"`
+
+	// DownloadRateLimit Bandwidth caps in bytes per second of each download from a registry, 0 means unlimited
+	DownloadRateLimit *DownloadRateLimitConfig `json:"downloadRateLimit,omitempty"`
+
+	// HelmProvenance Provenance verification configuration for Helm registries
+	HelmProvenance *HelmProvenanceConfig `json:"helmProvenance,omitempty"`
+
+	// ListPreferences Defaults of the lists of a registry, applied when a request doesn't set the sort or page size
+	ListPreferences *ListPreferencesConfig `json:"listPreferences,omitempty"`
+
+	// Policy Registry policies, values which aren't set are inherited from the parent spaces
+	Policy *RegistryPolicy `json:"policy,omitempty"`
+
+	// Quota Usage limits of a registry, crossing a threshold raises the REGISTRY_QUOTA_THRESHOLD webhook
+	Quota *QuotaConfig `json:"quota,omitempty"`
+
+	// RpmSigning GPG signing configuration for RPM registries
+	RpmSigning      *RpmSigningConfig `json:"rpmSigning,omitempty"`
+	UpstreamProxies *[]string         `json:"upstreamProxies,omitempty"`
+
+	// ValidationRules Validation rules enforced on uploads to a registry
+	ValidationRules *ValidationRulesConfig `json:"validationRules,omitempty"`
+}
+
+// ValidationRulesConfig Validation rules enforced on uploads to a registry
+type ValidationRulesConfig struct {
+	// MaxFileSize Maximum size in bytes of a single uploaded file
+	MaxFileSize *int64 `json:"maxFileSize,omitempty"`
+
+	// RequiredMetadataFields Metadata fields which must be set on uploaded packages, nested fields are separated by dots
+	RequiredMetadataFields *[]string `json:"requiredMetadataFields,omitempty"`
+
+	// VersionFormat Format uploaded versions must follow
+	VersionFormat *ValidationRulesConfigVersionFormat `json:"versionFormat,omitempty"`
+
+	// VersionPattern Regular expression uploaded versions must match when the version format is REGEX
+	VersionPattern *string `json:"versionPattern,omitempty"`
+}
+
+// ValidationRulesConfigVersionFormat Format uploaded versions must follow
+type ValidationRulesConfigVersionFormat string
+
+// Vulnerability A vulnerability synced from OSV
+type Vulnerability struct {
+	// Aliases IDs of the vulnerability in other databases, like its CVE
+	Aliases []string `json:"aliases"`
+
+	// Id ID of the advisory in OSV
+	Id string `json:"id"`
+
+	// ModifiedAt Timestamp in milliseconds of the last modification of the advisory
+	ModifiedAt string                `json:"modifiedAt"`
+	Severity   VulnerabilitySeverity `json:"severity"`
+	Summary    string                `json:"summary"`
+}
+
+// VulnerabilitySeverity defines model for VulnerabilitySeverity.
+type VulnerabilitySeverity string
+
+// VulnerableVersion A version stored in a registry which is affected by vulnerabilities
+type VulnerableVersion struct {
+	Package string `json:"package"`
+
+	// PackageType refers to package
+	PackageType        PackageType           `json:"packageType"`
+	RegistryIdentifier string                `json:"registryIdentifier"`
+	Severity           VulnerabilitySeverity `json:"severity"`
+	Version            string                `json:"version"`
+	Vulnerabilities    []Vulnerability       `json:"vulnerabilities"`
 }
 
 // Webhook Harness Regstries Webhook
@@ -1163,6 +2748,12 @@ type RegistryIdentifierParam []string
 // RegistryTypeParam defines model for RegistryTypeParam.
 type RegistryTypeParam string
 
+// ArtifactActivityParam The kind of interaction of the current user with an artifact
+type ArtifactActivityParam ArtifactActivity
+
+// ArtifactNamesParam defines model for artifactNamesParam.
+type ArtifactNamesParam []string
+
 // ArtifactParam defines model for artifactParam.
 type ArtifactParam string
 
@@ -1172,15 +2763,42 @@ type ArtifactPathParam string
 // ArtifactTypeParam defines model for artifactTypeParam.
 type ArtifactTypeParam string
 
+// ChannelIdentifierPathParam defines model for channelIdentifierPathParam.
+type ChannelIdentifierPathParam string
+
 // ChildVersionParam defines model for childVersionParam.
 type ChildVersionParam string
 
+// CommitShaParam defines model for commitShaParam.
+type CommitShaParam string
+
+// DeletionRequestIdPathParam defines model for deletionRequestIdPathParam.
+type DeletionRequestIdPathParam int64
+
+// DeletionRequestStateParam State of a deletion request
+type DeletionRequestStateParam DeletionRequestState
+
+// DenylistEntryIdPathParam defines model for denylistEntryIdPathParam.
+type DenylistEntryIdPathParam int64
+
 // DigestOptParam defines model for digestOptParam.
 type DigestOptParam string
 
 // DigestParam defines model for digestParam.
 type DigestParam string
 
+// DownloadStatsIntervalParam Length of the periods downloads are counted by, weeks start on Monday
+type DownloadStatsIntervalParam DownloadStatsInterval
+
+// DryRunParam defines model for dryRunParam.
+type DryRunParam bool
+
+// ExportTagParam defines model for exportTagParam.
+type ExportTagParam []string
+
+// FailedUploadUuidPathParam defines model for failedUploadUuidPathParam.
+type FailedUploadUuidPathParam string
+
 // FileNamePathParam defines model for fileNamePathParam.
 type FileNamePathParam string
 
@@ -1190,9 +2808,33 @@ type FilePathParam string
 // FromDateParam defines model for fromDateParam.
 type FromDateParam string
 
+// IfNoneMatchHeaderParam defines model for ifNoneMatchHeaderParam.
+type IfNoneMatchHeaderParam string
+
+// ImportImageParam defines model for importImageParam.
+type ImportImageParam string
+
+// IncludeDeletedParam defines model for includeDeletedParam.
+type IncludeDeletedParam bool
+
+// IncludeParam defines model for includeParam.
+type IncludeParam []string
+
+// IndexBuildIdPathParam defines model for indexBuildIdPathParam.
+type IndexBuildIdPathParam string
+
+// JobUuidPathParam defines model for jobUuidPathParam.
+type JobUuidPathParam string
+
 // LatestVersion defines model for latestVersion.
 type LatestVersion bool
 
+// MinSeverityParam defines model for minSeverityParam.
+type MinSeverityParam VulnerabilitySeverity
+
+// OnlyDeletedParam defines model for onlyDeletedParam.
+type OnlyDeletedParam bool
+
 // PackageTypeParam defines model for packageTypeParam.
 type PackageTypeParam []string
 
@@ -1202,6 +2844,12 @@ type PageNumber int64
 // PageSize defines model for pageSize.
 type PageSize int64
 
+// PipelineIdParam defines model for pipelineIdParam.
+type PipelineIdParam string
+
+// PipelineSystemParam defines model for pipelineSystemParam.
+type PipelineSystemParam string
+
 // RecursiveParam defines model for recursiveParam.
 type RecursiveParam bool
 
@@ -1211,9 +2859,24 @@ type RegistryRefPathParam string
 // RequiredSpaceRefQueryParam defines model for requiredSpaceRefQueryParam.
 type RequiredSpaceRefQueryParam string
 
+// RunUrlParam defines model for runUrlParam.
+type RunUrlParam string
+
+// ScheduledDeletionIdPathParam defines model for scheduledDeletionIdPathParam.
+type ScheduledDeletionIdPathParam int64
+
+// ScheduledDeletionStateParam State of a scheduled deletion
+type ScheduledDeletionStateParam ScheduledDeletionState
+
 // ScopeParam defines model for scopeParam.
 type ScopeParam string
 
+// SearchFieldParam A field of the metadata of artifact versions which is searched
+type SearchFieldParam ArtifactSearchField
+
+// SearchQueryParam defines model for searchQueryParam.
+type SearchQueryParam string
+
 // SearchTerm defines model for searchTerm.
 type SearchTerm string
 
@@ -1229,6 +2892,9 @@ type SpaceRefPathParam string
 // SpaceRefQueryParam defines model for spaceRefQueryParam.
 type SpaceRefQueryParam string
 
+// SyncCursorParam defines model for syncCursorParam.
+type SyncCursorParam string
+
 // ToDateParam defines model for toDateParam.
 type ToDateParam string
 
@@ -1247,6 +2913,15 @@ type WebhookExecutionIdPathParam string
 // WebhookIdentifierPathParam defines model for webhookIdentifierPathParam.
 type WebhookIdentifierPathParam string
 
+// Accepted defines model for Accepted.
+type Accepted struct {
+	// Message Why the request was queued
+	Message string `json:"message"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
 // ArtifactDetailResponse defines model for ArtifactDetailResponse.
 type ArtifactDetailResponse struct {
 	// Data Artifact Detail
@@ -1256,6 +2931,24 @@ type ArtifactDetailResponse struct {
 	Status Status `json:"status"`
 }
 
+// ArtifactDescriptionResponse defines model for ArtifactDescriptionResponse.
+type ArtifactDescriptionResponse struct {
+	// Data A revision of the markdown description of an artifact
+	Data ArtifactDescription `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ArtifactDownloadStatsResponse defines model for ArtifactDownloadStatsResponse.
+type ArtifactDownloadStatsResponse struct {
+	// Data Downloads of the versions of an artifact within a range of days
+	Data ArtifactDownloadStats `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
 // ArtifactFileResponse defines model for ArtifactFileResponse.
 type ArtifactFileResponse struct {
 	// DownloadUrl download url of artifact
@@ -1274,6 +2967,33 @@ type ArtifactLabelResponse struct {
 	Status Status `json:"status"`
 }
 
+// ArtifactProvenanceResponse defines model for ArtifactProvenanceResponse.
+type ArtifactProvenanceResponse struct {
+	// Data The pipeline execution which published an artifact version
+	Data ArtifactProvenance `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ArtifactScanStatusResponse defines model for ArtifactScanStatusResponse.
+type ArtifactScanStatusResponse struct {
+	// Data Vulnerability scan status of an OCI artifact version
+	Data ArtifactScanStatus `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ArtifactStarResponse defines model for ArtifactStarResponse.
+type ArtifactStarResponse struct {
+	// Data Whether the current user starred an artifact
+	Data ArtifactStar `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
 // ArtifactStatsResponse defines model for ArtifactStatsResponse.
 type ArtifactStatsResponse struct {
 	// Data Harness Artifact Stats
@@ -1301,6 +3021,15 @@ type ArtifactVersionSummaryResponse struct {
 	Status Status `json:"status"`
 }
 
+// ArtifactVersionSyncResponse defines model for ArtifactVersionSyncResponse.
+type ArtifactVersionSyncResponse struct {
+	// Data A page of the versions of an artifact, in the order they were pushed
+	Data ArtifactVersionSyncPage `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
 // BadRequest defines model for BadRequest.
 type BadRequest Error
 
@@ -1313,6 +3042,18 @@ type ClientSetupDetailsResponse struct {
 	Status Status `json:"status"`
 }
 
+// Conflict defines model for Conflict.
+type Conflict Error
+
+// DeletionRequestResponse defines model for DeletionRequestResponse.
+type DeletionRequestResponse struct {
+	// Data A request to delete an artifact or a version, which waits for the approval of a second user
+	Data DeletionRequest `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
 // DockerArtifactDetailResponse defines model for DockerArtifactDetailResponse.
 type DockerArtifactDetailResponse struct {
 	// Data Docker Artifact Detail
@@ -1349,6 +3090,15 @@ type DockerManifestsResponse struct {
 	Status Status `json:"status"`
 }
 
+// EffectiveRegistryPolicyResponse defines model for EffectiveRegistryPolicyResponse.
+type EffectiveRegistryPolicyResponse struct {
+	// Data Policy which applies to a registry once inheritance is resolved
+	Data EffectiveRegistryPolicy `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
 // FileDetailResponse defines model for FileDetailResponse.
 type FileDetailResponse struct {
 	// Data A list of Harness Artifact Files
@@ -1358,6 +3108,24 @@ type FileDetailResponse struct {
 	Status Status `json:"status"`
 }
 
+// FirewallApprovalResponse defines model for FirewallApprovalResponse.
+type FirewallApprovalResponse struct {
+	// Data A version of a package let through the firewall delay of an upstream proxy before the delay has passed
+	Data FirewallApproval `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// HelmArtifactDependenciesResponse defines model for HelmArtifactDependenciesResponse.
+type HelmArtifactDependenciesResponse struct {
+	// Data Dependencies and provenance of a Helm chart version
+	Data HelmArtifactDependencies `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
 // HelmArtifactDetailResponse defines model for HelmArtifactDetailResponse.
 type HelmArtifactDetailResponse struct {
 	// Data Helm Artifact Detail
@@ -1379,6 +3147,15 @@ type HelmArtifactManifestResponse struct {
 // InternalServerError defines model for InternalServerError.
 type InternalServerError Error
 
+// ListArtifactDownloadCountResponse defines model for ListArtifactDownloadCountResponse.
+type ListArtifactDownloadCountResponse struct {
+	// Data The download counts of artifacts
+	Data ListArtifactDownloadCount `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
 // ListArtifactLabelResponse defines model for ListArtifactLabelResponse.
 type ListArtifactLabelResponse struct {
 	// Data A list of Harness Artifact Labels
@@ -1388,6 +3165,24 @@ type ListArtifactLabelResponse struct {
 	Status Status `json:"status"`
 }
 
+// ListArtifactDescriptionResponse defines model for ListArtifactDescriptionResponse.
+type ListArtifactDescriptionResponse struct {
+	// Data A list of artifact description revisions
+	Data ListArtifactDescription `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListArtifactMetadataChangeResponse defines model for ListArtifactMetadataChangeResponse.
+type ListArtifactMetadataChangeResponse struct {
+	// Data A list of artifact metadata changes
+	Data ListArtifactMetadataChange `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
 // ListArtifactResponse defines model for ListArtifactResponse.
 type ListArtifactResponse struct {
 	// Data A list of Artifacts
@@ -1406,6 +3201,33 @@ type ListArtifactVersionResponse struct {
 	Status Status `json:"status"`
 }
 
+// ListDeletionRequestsResponse defines model for ListDeletionRequestsResponse.
+type ListDeletionRequestsResponse struct {
+	// Data A list of deletion requests
+	Data ListDeletionRequests `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListFailedUploadResponse defines model for ListFailedUploadResponse.
+type ListFailedUploadResponse struct {
+	// Data A list of failed uploads
+	Data ListFailedUpload `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListFirewallApprovalsResponse defines model for ListFirewallApprovalsResponse.
+type ListFirewallApprovalsResponse struct {
+	// Data A list of firewall approvals
+	Data ListFirewallApprovals `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
 // ListMigrationImageResponse defines model for ListMigrationImageResponse.
 type ListMigrationImageResponse struct {
 	// Data A list of migration images
@@ -1415,75 +3237,317 @@ type ListMigrationImageResponse struct {
 	Status Status `json:"status"`
 }
 
-// ListOciArtifactTagsResponse defines model for ListOciArtifactTagsResponse.
-type ListOciArtifactTagsResponse struct {
-	// Data A list of Artifact versions
-	Data ListOciArtifactTags `json:"data"`
+// ListOciArtifactTagsResponse defines model for ListOciArtifactTagsResponse.
+type ListOciArtifactTagsResponse struct {
+	// Data A list of Artifact versions
+	Data ListOciArtifactTags `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListNotificationChannelsResponse defines model for ListNotificationChannelsResponse.
+type ListNotificationChannelsResponse struct {
+	Data ListNotificationChannels `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListPackageDenylistEntriesResponse defines model for ListPackageDenylistEntriesResponse.
+type ListPackageDenylistEntriesResponse struct {
+	// Data A list of package denylist entries
+	Data ListPackageDenylistEntries `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListPackageDenylistOverridesResponse defines model for ListPackageDenylistOverridesResponse.
+type ListPackageDenylistOverridesResponse struct {
+	// Data A list of package denylist overrides
+	Data ListPackageDenylistOverrides `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListPipelineArtifactsResponse defines model for ListPipelineArtifactsResponse.
+type ListPipelineArtifactsResponse struct {
+	// Data A list of artifact versions published by pipeline executions
+	Data ListPipelineArtifacts `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListRecentArtifactResponse defines model for ListRecentArtifactResponse.
+type ListRecentArtifactResponse struct {
+	// Data A list of recent artifacts
+	Data ListRecentArtifact `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListRegistryArtifactResponse defines model for ListRegistryArtifactResponse.
+type ListRegistryArtifactResponse struct {
+	// Data A list of Artifacts
+	Data ListRegistryArtifact `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListRegistryIndexBuildResponse defines model for ListRegistryIndexBuildResponse.
+type ListRegistryIndexBuildResponse struct {
+	// Data A list of registry index builds
+	Data ListRegistryIndexBuild `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListRegistryJobResponse defines model for ListRegistryJobResponse.
+type ListRegistryJobResponse struct {
+	// Data A list of registry jobs
+	Data ListRegistryJob `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListRegistryResponse defines model for ListRegistryResponse.
+type ListRegistryResponse struct {
+	// Data A list of Harness Artifact Registries
+	Data ListRegistry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListReplicationRuleResponse defines model for ListReplicationRuleResponse.
+type ListReplicationRuleResponse struct {
+	// Data A list of replication rules
+	Data ListReplicationRule `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListScheduledDeletionsResponse defines model for ListScheduledDeletionsResponse.
+type ListScheduledDeletionsResponse struct {
+	// Data A list of scheduled deletions
+	Data ListScheduledDeletions `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListStarredArtifactResponse defines model for ListStarredArtifactResponse.
+type ListStarredArtifactResponse struct {
+	// Data A list of starred artifacts
+	Data ListStarredArtifact `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListVulnerableVersionsResponse defines model for ListVulnerableVersionsResponse.
+type ListVulnerableVersionsResponse struct {
+	// Data A list of vulnerable versions
+	Data ListVulnerableVersions `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListWebhooksExecutionResponse defines model for ListWebhooksExecutionResponse.
+type ListWebhooksExecutionResponse struct {
+	// Data A list of Harness Registries webhooks executions
+	Data ListWebhooksExecutions `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListWebhooksResponse defines model for ListWebhooksResponse.
+type ListWebhooksResponse struct {
+	// Data A list of Harness Registries webhooks
+	Data ListWebhooks `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// NotFound defines model for NotFound.
+type NotFound Error
+
+// NotImplemented defines model for NotImplemented.
+type NotImplemented Error
+
+// NotificationChannelResponse defines model for NotificationChannelResponse.
+type NotificationChannelResponse struct {
+	// Data Channel which high-signal events of a registry are delivered to
+	Data NotificationChannel `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// OciImportResponse defines model for OciImportResponse.
+type OciImportResponse struct {
+	// Data The images imported from an OCI archive
+	Data OciImportResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// PackageDenylistEntryResponse defines model for PackageDenylistEntryResponse.
+type PackageDenylistEntryResponse struct {
+	// Data A package denied in all registries of a space and of its subspaces, uploads and proxy pulls of the versions in the version range are blocked
+	Data PackageDenylistEntry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// PackageDenylistImportResponse defines model for PackageDenylistImportResponse.
+type PackageDenylistImportResponse struct {
+	Data PackageDenylistImportResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// PackageDenylistOverrideResponse defines model for PackageDenylistOverrideResponse.
+type PackageDenylistOverrideResponse struct {
+	// Data An exemption of a registry from an entry of the package denylist of one of its spaces
+	Data PackageDenylistOverride `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// PackageTypeCapabilitiesResponse defines model for PackageTypeCapabilitiesResponse.
+type PackageTypeCapabilitiesResponse struct {
+	// Data The capabilities of all package types
+	Data ListPackageTypeCapabilities `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryApplyResponse defines model for RegistryApplyResponse.
+type RegistryApplyResponse struct {
+	// Data The changes made to reconcile the configuration of a registry
+	Data RegistryApplyResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryGarbageStatsResponse defines model for RegistryGarbageStatsResponse.
+type RegistryGarbageStatsResponse struct {
+	// Data Soft-deleted rows of an account which wait to be purged
+	Data RegistryGarbageStats `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryIndexStatusResponse defines model for RegistryIndexStatusResponse.
+type RegistryIndexStatusResponse struct {
+	// Data Status of the latest rebuild of a registry index
+	Data RegistryIndexStatus `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryJobResponse defines model for RegistryJobResponse.
+type RegistryJobResponse struct {
+	// Data A long-running operation of a registry
+	Data RegistryJob `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryPolicyResponse defines model for RegistryPolicyResponse.
+type RegistryPolicyResponse struct {
+	// Data Registry policies, values which aren't set are inherited from the parent spaces
+	Data RegistryPolicy `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryResponse defines model for RegistryResponse.
+type RegistryResponse struct {
+	// Data Harness Artifact Registry
+	Data Registry `json:"data"`
 
 	// Status Indicates if the request was successful or not
 	Status Status `json:"status"`
 }
 
-// ListRegistryArtifactResponse defines model for ListRegistryArtifactResponse.
-type ListRegistryArtifactResponse struct {
-	// Data A list of Artifacts
-	Data ListRegistryArtifact `json:"data"`
+// RegistrySettingsResponse defines model for RegistrySettingsResponse.
+type RegistrySettingsResponse struct {
+	Data []RegistrySetting `json:"data"`
 
 	// Status Indicates if the request was successful or not
 	Status Status `json:"status"`
 }
 
-// ListRegistryResponse defines model for ListRegistryResponse.
-type ListRegistryResponse struct {
-	// Data A list of Harness Artifact Registries
-	Data ListRegistry `json:"data"`
+// RegistryTrashResponse defines model for RegistryTrashResponse.
+type RegistryTrashResponse struct {
+	Data []TrashedArtifactVersion `json:"data"`
 
 	// Status Indicates if the request was successful or not
 	Status Status `json:"status"`
 }
 
-// ListReplicationRuleResponse defines model for ListReplicationRuleResponse.
-type ListReplicationRuleResponse struct {
-	// Data A list of replication rules
-	Data ListReplicationRule `json:"data"`
+// ReplicationRuleResponse defines model for ReplicationRuleResponse.
+type ReplicationRuleResponse struct {
+	Data ReplicationRule `json:"data"`
 
 	// Status Indicates if the request was successful or not
 	Status Status `json:"status"`
 }
 
-// ListWebhooksExecutionResponse defines model for ListWebhooksExecutionResponse.
-type ListWebhooksExecutionResponse struct {
-	// Data A list of Harness Registries webhooks executions
-	Data ListWebhooksExecutions `json:"data"`
+// ScheduledDeletionResponse defines model for ScheduledDeletionResponse.
+type ScheduledDeletionResponse struct {
+	// Data A deletion of an artifact or a version which is executed later, it can be canceled until then
+	Data ScheduledDeletion `json:"data"`
 
 	// Status Indicates if the request was successful or not
 	Status Status `json:"status"`
 }
 
-// ListWebhooksResponse defines model for ListWebhooksResponse.
-type ListWebhooksResponse struct {
-	// Data A list of Harness Registries webhooks
-	Data ListWebhooks `json:"data"`
+// SearchArtifactsResponse defines model for SearchArtifactsResponse.
+type SearchArtifactsResponse struct {
+	// Data A page of artifact versions matching a search
+	Data ListArtifactSearchResults `json:"data"`
 
 	// Status Indicates if the request was successful or not
 	Status Status `json:"status"`
 }
 
-// NotFound defines model for NotFound.
-type NotFound Error
-
-// RegistryResponse defines model for RegistryResponse.
-type RegistryResponse struct {
-	// Data Harness Artifact Registry
-	Data Registry `json:"data"`
+// SpaceRegistryUsageHistoryResponse defines model for SpaceRegistryUsageHistoryResponse.
+type SpaceRegistryUsageHistoryResponse struct {
+	// Data Daily registry usage of a space within a range of days
+	Data SpaceRegistryUsageHistory `json:"data"`
 
 	// Status Indicates if the request was successful or not
 	Status Status `json:"status"`
 }
 
-// ReplicationRuleResponse defines model for ReplicationRuleResponse.
-type ReplicationRuleResponse struct {
-	Data ReplicationRule `json:"data"`
+// SpaceRegistryUsageResponse defines model for SpaceRegistryUsageResponse.
+type SpaceRegistryUsageResponse struct {
+	// Data Registry usage of a space and of the spaces below it
+	Data SpaceRegistryUsageReport `json:"data"`
 
 	// Status Indicates if the request was successful or not
 	Status Status `json:"status"`
@@ -1495,12 +3559,24 @@ type Success struct {
 	Status Status `json:"status"`
 }
 
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests Error
+
 // Unauthenticated defines model for Unauthenticated.
 type Unauthenticated Error
 
 // Unauthorized defines model for Unauthorized.
 type Unauthorized Error
 
+// UploadFailureStatsResponse defines model for UploadFailureStatsResponse.
+type UploadFailureStatsResponse struct {
+	// Data Failed uploads of the registries of an account within a range of days
+	Data UploadFailureStats `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
 // WebhookExecutionResponse defines model for WebhookExecutionResponse.
 type WebhookExecutionResponse struct {
 	// Data Harness Regstries Webhook Execution
@@ -1528,12 +3604,57 @@ type QuarantinePathResponse struct {
 	Status Status `json:"status"`
 }
 
+// PipelineExecutionRequest Reference to the CI pipeline execution which published an artifact version
+type PipelineExecutionRequest PipelineExecution
+
+// ListRecentArtifactsParams defines parameters for ListRecentArtifacts.
+type ListRecentArtifactsParams struct {
+	// Activity Only return artifacts with this kind of activity.
+	Activity *ArtifactActivityParam `form:"activity,omitempty" json:"activity,omitempty"`
+}
+
+// ListStarredArtifactsParams defines parameters for ListStarredArtifacts.
+type ListStarredArtifactsParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
 // CreateRegistryParams defines parameters for CreateRegistry.
 type CreateRegistryParams struct {
 	// SpaceRef Unique path identifier for the final space in the branch (required for registry creation). The value can be provided either as a fully URL-encoded path (e.g., `organization%2Fproject`) or as a plain path ending with a trailing plus sign (`+`) as separator (e.g., `organization/project/+`).
 	SpaceRef RequiredSpaceRefQueryParam `form:"space_ref" json:"space_ref"`
 }
 
+// SearchArtifactsParams defines parameters for SearchArtifacts.
+type SearchArtifactsParams struct {
+	// SpaceRef Unique path identifier for the final space in the branch (required for registry creation). The value can be provided either as a fully URL-encoded path (e.g., `organization%2Fproject`) or as a plain path ending with a trailing plus sign (`+`) as separator (e.g., `organization/project/+`).
+	SpaceRef RequiredSpaceRefQueryParam `form:"space_ref" json:"space_ref"`
+
+	// Query The words to search, versions matching all of them are returned.
+	Query SearchQueryParam `form:"query" json:"query"`
+
+	// Field Only searches this field of the metadata, all fields are searched if it's not set.
+	Field *SearchFieldParam `form:"field,omitempty" json:"field,omitempty"`
+
+	// PackageType Registry Package Type
+	PackageType *PackageTypeParam `form:"package_type,omitempty" json:"package_type,omitempty"`
+
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ApplyRegistryConfigParams defines parameters for ApplyRegistryConfig.
+type ApplyRegistryConfigParams struct {
+	// DryRun Only compute the changes without applying them.
+	DryRun *DryRunParam `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
 // ListArtifactLabelsParams defines parameters for ListArtifactLabels.
 type ListArtifactLabelsParams struct {
 	// Page Current page number
@@ -1564,6 +3685,48 @@ type DeleteArtifactParams struct {
 // DeleteArtifactParamsArtifactType defines parameters for DeleteArtifact.
 type DeleteArtifactParamsArtifactType string
 
+// UpdateArtifactDescriptionParams defines parameters for UpdateArtifactDescription.
+type UpdateArtifactDescriptionParams struct {
+	// ArtifactType artifact type.
+	ArtifactType *UpdateArtifactDescriptionParamsArtifactType `form:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+}
+
+// UpdateArtifactDescriptionParamsArtifactType defines parameters for UpdateArtifactDescription.
+type UpdateArtifactDescriptionParamsArtifactType string
+
+// ListArtifactDescriptionHistoryParams defines parameters for ListArtifactDescriptionHistory.
+type ListArtifactDescriptionHistoryParams struct {
+	// ArtifactType artifact type.
+	ArtifactType *ListArtifactDescriptionHistoryParamsArtifactType `form:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListArtifactDescriptionHistoryParamsArtifactType defines parameters for ListArtifactDescriptionHistory.
+type ListArtifactDescriptionHistoryParamsArtifactType string
+
+// GetArtifactDownloadStatsParams defines parameters for GetArtifactDownloadStats.
+type GetArtifactDownloadStatsParams struct {
+	// ArtifactType artifact type.
+	ArtifactType *GetArtifactDownloadStatsParamsArtifactType `form:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+
+	// From Date. Format - MM/DD/YYYY
+	From *FromDateParam `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Date. Format - MM/DD/YYYY
+	To *ToDateParam `form:"to,omitempty" json:"to,omitempty"`
+
+	// Interval Length of the periods downloads are counted by, DAY if it's not set.
+	Interval *DownloadStatsIntervalParam `form:"interval,omitempty" json:"interval,omitempty"`
+}
+
+// GetArtifactDownloadStatsParamsArtifactType defines parameters for GetArtifactDownloadStats.
+type GetArtifactDownloadStatsParamsArtifactType string
+
 // UpdateArtifactLabelsParams defines parameters for UpdateArtifactLabels.
 type UpdateArtifactLabelsParams struct {
 	// ArtifactType artifact type.
@@ -1573,6 +3736,60 @@ type UpdateArtifactLabelsParams struct {
 // UpdateArtifactLabelsParamsArtifactType defines parameters for UpdateArtifactLabels.
 type UpdateArtifactLabelsParamsArtifactType string
 
+// DeleteArtifactLogoParams defines parameters for DeleteArtifactLogo.
+type DeleteArtifactLogoParams struct {
+	// ArtifactType artifact type.
+	ArtifactType *DeleteArtifactLogoParamsArtifactType `form:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+}
+
+// DeleteArtifactLogoParamsArtifactType defines parameters for DeleteArtifactLogo.
+type DeleteArtifactLogoParamsArtifactType string
+
+// GetArtifactLogoParams defines parameters for GetArtifactLogo.
+type GetArtifactLogoParams struct {
+	// ArtifactType artifact type.
+	ArtifactType *GetArtifactLogoParamsArtifactType `form:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+
+	// IfNoneMatch ETag of the cached response.
+	IfNoneMatch *IfNoneMatchHeaderParam `json:"If-None-Match,omitempty"`
+}
+
+// GetArtifactLogoParamsArtifactType defines parameters for GetArtifactLogo.
+type GetArtifactLogoParamsArtifactType string
+
+// UploadArtifactLogoParams defines parameters for UploadArtifactLogo.
+type UploadArtifactLogoParams struct {
+	// ArtifactType artifact type.
+	ArtifactType *UploadArtifactLogoParamsArtifactType `form:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+}
+
+// UploadArtifactLogoParamsArtifactType defines parameters for UploadArtifactLogo.
+type UploadArtifactLogoParamsArtifactType string
+
+// ExportOciArchiveParams defines parameters for ExportOciArchive.
+type ExportOciArchiveParams struct {
+	// Tag Tags to export, all tags of the image are exported when none is given.
+	Tag *ExportTagParam `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// UnstarArtifactParams defines parameters for UnstarArtifact.
+type UnstarArtifactParams struct {
+	// ArtifactType artifact type.
+	ArtifactType *UnstarArtifactParamsArtifactType `form:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+}
+
+// UnstarArtifactParamsArtifactType defines parameters for UnstarArtifact.
+type UnstarArtifactParamsArtifactType string
+
+// StarArtifactParams defines parameters for StarArtifact.
+type StarArtifactParams struct {
+	// ArtifactType artifact type.
+	ArtifactType *StarArtifactParamsArtifactType `form:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+}
+
+// StarArtifactParamsArtifactType defines parameters for StarArtifact.
+type StarArtifactParamsArtifactType string
+
 // GetArtifactStatsParams defines parameters for GetArtifactStats.
 type GetArtifactStatsParams struct {
 	// From Date. Format - MM/DD/YYYY
@@ -1699,6 +3916,24 @@ type GetHelmArtifactDetailsParams struct {
 // GetHelmArtifactDetailsParamsVersionType defines parameters for GetHelmArtifactDetails.
 type GetHelmArtifactDetailsParamsVersionType string
 
+// GetArtifactVersionProvenanceParams defines parameters for GetArtifactVersionProvenance.
+type GetArtifactVersionProvenanceParams struct {
+	// ArtifactType artifact type.
+	ArtifactType *GetArtifactVersionProvenanceParamsArtifactType `form:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+}
+
+// GetArtifactVersionProvenanceParamsArtifactType defines parameters for GetArtifactVersionProvenance.
+type GetArtifactVersionProvenanceParamsArtifactType string
+
+// UpdateArtifactVersionProvenanceParams defines parameters for UpdateArtifactVersionProvenance.
+type UpdateArtifactVersionProvenanceParams struct {
+	// ArtifactType artifact type.
+	ArtifactType *UpdateArtifactVersionProvenanceParamsArtifactType `form:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+}
+
+// UpdateArtifactVersionProvenanceParamsArtifactType defines parameters for UpdateArtifactVersionProvenance.
+type UpdateArtifactVersionProvenanceParamsArtifactType string
+
 // GetArtifactVersionSummaryParams defines parameters for GetArtifactVersionSummary.
 type GetArtifactVersionSummaryParams struct {
 	// ArtifactType artifact type.
@@ -1711,6 +3946,21 @@ type GetArtifactVersionSummaryParams struct {
 // GetArtifactVersionSummaryParamsArtifactType defines parameters for GetArtifactVersionSummary.
 type GetArtifactVersionSummaryParamsArtifactType string
 
+// ListArtifactVersionMetadataHistoryParams defines parameters for ListArtifactVersionMetadataHistory.
+type ListArtifactVersionMetadataHistoryParams struct {
+	// ArtifactType artifact type.
+	ArtifactType *ListArtifactVersionMetadataHistoryParamsArtifactType `form:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListArtifactVersionMetadataHistoryParamsArtifactType defines parameters for ListArtifactVersionMetadataHistory.
+type ListArtifactVersionMetadataHistoryParamsArtifactType string
+
 // GetAllArtifactVersionsParams defines parameters for GetAllArtifactVersions.
 type GetAllArtifactVersionsParams struct {
 	// ArtifactType artifact type.
@@ -1730,11 +3980,26 @@ type GetAllArtifactVersionsParams struct {
 
 	// SearchTerm search Term.
 	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+
+	// IncludeDeleted Include the soft-deleted OCI tags and manifests.
+	IncludeDeleted *IncludeDeletedParam `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// OnlyDeleted Only list the soft-deleted OCI tags and manifests, takes precedence over include_deleted.
+	OnlyDeleted *OnlyDeletedParam `form:"only_deleted,omitempty" json:"only_deleted,omitempty"`
 }
 
 // GetAllArtifactVersionsParamsArtifactType defines parameters for GetAllArtifactVersions.
 type GetAllArtifactVersionsParamsArtifactType string
 
+// SyncArtifactVersionsParams defines parameters for SyncArtifactVersions.
+type SyncArtifactVersionsParams struct {
+	// Cursor The cursor returned with the previous page, the first page is returned without it.
+	Cursor *SyncCursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
 // GetAllArtifactsByRegistryParams defines parameters for GetAllArtifactsByRegistry.
 type GetAllArtifactsByRegistryParams struct {
 	// Label Label.
@@ -1757,11 +4022,23 @@ type GetAllArtifactsByRegistryParams struct {
 
 	// ArtifactType artifact type.
 	ArtifactType *GetAllArtifactsByRegistryParamsArtifactType `form:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+
+	// Include Optional fields to include in the response, which are expensive to compute.
+	Include *IncludeParam `form:"include,omitempty" json:"include,omitempty"`
 }
 
 // GetAllArtifactsByRegistryParamsArtifactType defines parameters for GetAllArtifactsByRegistry.
 type GetAllArtifactsByRegistryParamsArtifactType string
 
+// GetAllArtifactsByRegistryParamsInclude defines parameters for GetAllArtifactsByRegistry.
+type GetAllArtifactsByRegistryParamsInclude string
+
+// GetArtifactDownloadCountsParams defines parameters for GetArtifactDownloadCounts.
+type GetArtifactDownloadCountsParams struct {
+	// Artifact Names of the artifacts.
+	Artifact ArtifactNamesParam `form:"artifact" json:"artifact"`
+}
+
 // GetClientSetupDetailsParams defines parameters for GetClientSetupDetails.
 type GetClientSetupDetailsParams struct {
 	// Artifact Artifat
@@ -1771,6 +4048,45 @@ type GetClientSetupDetailsParams struct {
 	Version *VersionParam `form:"version,omitempty" json:"version,omitempty"`
 }
 
+// ListDeletionRequestsParams defines parameters for ListDeletionRequests.
+type ListDeletionRequestsParams struct {
+	// State Only return deletion requests in this state.
+	State *DeletionRequestStateParam `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// ListFailedUploadsParams defines parameters for ListFailedUploads.
+type ListFailedUploadsParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListRegistryIndexBuildsParams defines parameters for ListRegistryIndexBuilds.
+type ListRegistryIndexBuildsParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListRegistryJobsParams defines parameters for ListRegistryJobs.
+type ListRegistryJobsParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ImportOciArchiveParams defines parameters for ImportOciArchive.
+type ImportOciArchiveParams struct {
+	// Image Image name of the images the archive doesn't name, OCI image layouts often only tag their images.
+	Image *ImportImageParam `form:"image,omitempty" json:"image,omitempty"`
+}
+
 // DeleteQuarantineFilePathParams defines parameters for DeleteQuarantineFilePath.
 type DeleteQuarantineFilePathParams struct {
 	// Artifact Artifat
@@ -1789,6 +4105,12 @@ type DeleteQuarantineFilePathParams struct {
 // DeleteQuarantineFilePathParamsArtifactType defines parameters for DeleteQuarantineFilePath.
 type DeleteQuarantineFilePathParamsArtifactType string
 
+// ListScheduledDeletionsParams defines parameters for ListScheduledDeletions.
+type ListScheduledDeletionsParams struct {
+	// State Only return scheduled deletions in this state.
+	State *ScheduledDeletionStateParam `form:"state,omitempty" json:"state,omitempty"`
+}
+
 // ListWebhooksParams defines parameters for ListWebhooks.
 type ListWebhooksParams struct {
 	// Page Current page number
@@ -1879,6 +4201,39 @@ type GetAllArtifactsParams struct {
 	PackageType *PackageTypeParam `form:"package_type,omitempty" json:"package_type,omitempty"`
 }
 
+// ListPackageDenylistEntriesParams defines parameters for ListPackageDenylistEntries.
+type ListPackageDenylistEntriesParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// SearchTerm search Term.
+	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+}
+
+// ListPipelineArtifactsParams defines parameters for ListPipelineArtifacts.
+type ListPipelineArtifactsParams struct {
+	// PipelineSystem CI system which ran the pipeline.
+	PipelineSystem *PipelineSystemParam `form:"pipeline_system,omitempty" json:"pipeline_system,omitempty"`
+
+	// PipelineId ID of the pipeline.
+	PipelineId *PipelineIdParam `form:"pipeline_id,omitempty" json:"pipeline_id,omitempty"`
+
+	// RunUrl URL of the pipeline execution.
+	RunUrl *RunUrlParam `form:"run_url,omitempty" json:"run_url,omitempty"`
+
+	// CommitSha SHA of the commit the pipeline execution built.
+	CommitSha *CommitShaParam `form:"commit_sha,omitempty" json:"commit_sha,omitempty"`
+
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
 // GetAllRegistriesParams defines parameters for GetAllRegistries.
 type GetAllRegistriesParams struct {
 	// PackageType Registry Package Type
@@ -1916,30 +4271,111 @@ type GetAllRegistriesParams struct {
 	Scope *GetAllRegistriesParamsScope `form:"scope,omitempty" json:"scope,omitempty"`
 }
 
+// GetUploadFailureStatsParams defines parameters for GetUploadFailureStats.
+type GetUploadFailureStatsParams struct {
+	// From Date. Format - MM/DD/YYYY
+	From *FromDateParam `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Date. Format - MM/DD/YYYY
+	To *ToDateParam `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // GetAllRegistriesParamsType defines parameters for GetAllRegistries.
 type GetAllRegistriesParamsType string
 
 // GetAllRegistriesParamsScope defines parameters for GetAllRegistries.
 type GetAllRegistriesParamsScope string
 
+// CreatePackageDenylistEntryJSONRequestBody defines body for CreatePackageDenylistEntry for application/json ContentType.
+type CreatePackageDenylistEntryJSONRequestBody PackageDenylistEntryRequest
+
+// ImportPackageDenylistJSONRequestBody defines body for ImportPackageDenylist for application/json ContentType.
+type ImportPackageDenylistJSONRequestBody PackageDenylistImportRequest
+
+// UpdateSpaceRegistryPolicyJSONRequestBody defines body for UpdateSpaceRegistryPolicy for application/json ContentType.
+type UpdateSpaceRegistryPolicyJSONRequestBody RegistryPolicy
+
+// GetSpaceRegistryUsageHistoryParams defines parameters for GetSpaceRegistryUsageHistory.
+type GetSpaceRegistryUsageHistoryParams struct {
+	// From Date. Format - MM/DD/YYYY
+	From *FromDateParam `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Date. Format - MM/DD/YYYY
+	To *ToDateParam `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListVulnerableVersionsParams defines parameters for ListVulnerableVersions.
+type ListVulnerableVersionsParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// SearchTerm search Term.
+	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+
+	// MinSeverity Only lists the versions affected by vulnerabilities of this severity or above.
+	MinSeverity *MinSeverityParam `form:"min_severity,omitempty" json:"min_severity,omitempty"`
+}
+
 // CreateRegistryJSONRequestBody defines body for CreateRegistry for application/json ContentType.
 type CreateRegistryJSONRequestBody RegistryRequest
 
 // ModifyRegistryJSONRequestBody defines body for ModifyRegistry for application/json ContentType.
 type ModifyRegistryJSONRequestBody RegistryRequest
 
+// ApplyRegistryConfigJSONRequestBody defines body for ApplyRegistryConfig for application/json ContentType.
+type ApplyRegistryConfigJSONRequestBody RegistryConfigDocument
+
+// UpdateArtifactDescriptionJSONRequestBody defines body for UpdateArtifactDescription for application/json ContentType.
+type UpdateArtifactDescriptionJSONRequestBody ArtifactDescriptionRequest
+
 // UpdateArtifactLabelsJSONRequestBody defines body for UpdateArtifactLabels for application/json ContentType.
 type UpdateArtifactLabelsJSONRequestBody ArtifactLabelRequest
 
+// ApproveFirewallVersionJSONRequestBody defines body for ApproveFirewallVersion for application/json ContentType.
+type ApproveFirewallVersionJSONRequestBody FirewallApprovalRequest
+
+// UpdateArtifactVersionProvenanceJSONRequestBody defines body for UpdateArtifactVersionProvenance for application/json ContentType.
+type UpdateArtifactVersionProvenanceJSONRequestBody PipelineExecution
+
+// UpdateArtifactScanStatusJSONRequestBody defines body for UpdateArtifactScanStatus for application/json ContentType.
+type UpdateArtifactScanStatusJSONRequestBody ArtifactScanResultRequest
+
+// RejectDeletionRequestJSONRequestBody defines body for RejectDeletionRequest for application/json ContentType.
+type RejectDeletionRequestJSONRequestBody DeletionRequestReview
+
+// CreateNotificationChannelJSONRequestBody defines body for CreateNotificationChannel for application/json ContentType.
+type CreateNotificationChannelJSONRequestBody NotificationChannelRequest
+
+// UpdateNotificationChannelJSONRequestBody defines body for UpdateNotificationChannel for application/json ContentType.
+type UpdateNotificationChannelJSONRequestBody NotificationChannelRequest
+
+// OverridePackageDenylistEntryJSONRequestBody defines body for OverridePackageDenylistEntry for application/json ContentType.
+type OverridePackageDenylistEntryJSONRequestBody PackageDenylistOverrideRequest
+
 // QuarantineFilePathJSONRequestBody defines body for QuarantineFilePath for application/json ContentType.
 type QuarantineFilePathJSONRequestBody QuarantineRequest
 
+// ScheduleDeletionJSONRequestBody defines body for ScheduleDeletion for application/json ContentType.
+type ScheduleDeletionJSONRequestBody ScheduleDeletionRequest
+
+// UpdateRegistrySettingsJSONRequestBody defines body for UpdateRegistrySettings for application/json ContentType.
+type UpdateRegistrySettingsJSONRequestBody RegistrySettingsRequest
+
 // CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
 type CreateWebhookJSONRequestBody WebhookRequest
 
 // UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
 type UpdateWebhookJSONRequestBody WebhookRequest
 
+// ReplayWebhookExecutionJSONRequestBody defines body for ReplayWebhookExecution for application/json ContentType.
+type ReplayWebhookExecutionJSONRequestBody ReplayWebhookExecutionRequest
+
+// TestWebhookJSONRequestBody defines body for TestWebhook for application/json ContentType.
+type TestWebhookJSONRequestBody TestWebhookRequest
+
 // CreateReplicationRuleJSONRequestBody defines body for CreateReplicationRule for application/json ContentType.
 type CreateReplicationRuleJSONRequestBody ReplicationRuleRequest
 
@@ -2384,6 +4820,20 @@ func (t ArtifactDetail) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'packageType': %w", err)
 	}
 
+	if t.QuarantineBlockedPulls != nil {
+		object["quarantineBlockedPulls"], err = json.Marshal(t.QuarantineBlockedPulls)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'quarantineBlockedPulls': %w", err)
+		}
+	}
+
+	if t.QuarantineLastBlockedPullAt != nil {
+		object["quarantineLastBlockedPullAt"], err = json.Marshal(t.QuarantineLastBlockedPullAt)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'quarantineLastBlockedPullAt': %w", err)
+		}
+	}
+
 	if t.QuarantineReason != nil {
 		object["quarantineReason"], err = json.Marshal(t.QuarantineReason)
 		if err != nil {
@@ -2474,6 +4924,20 @@ func (t *ArtifactDetail) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["quarantineBlockedPulls"]; found {
+		err = json.Unmarshal(raw, &t.QuarantineBlockedPulls)
+		if err != nil {
+			return fmt.Errorf("error reading 'quarantineBlockedPulls': %w", err)
+		}
+	}
+
+	if raw, found := object["quarantineLastBlockedPullAt"]; found {
+		err = json.Unmarshal(raw, &t.QuarantineLastBlockedPullAt)
+		if err != nil {
+			return fmt.Errorf("error reading 'quarantineLastBlockedPullAt': %w", err)
+		}
+	}
+
 	if raw, found := object["quarantineReason"]; found {
 		err = json.Unmarshal(raw, &t.QuarantineReason)
 		if err != nil {
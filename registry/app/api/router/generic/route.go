@@ -41,6 +41,8 @@ func NewGenericArtifactHandler(handler *generic.Handler) Handler {
 	}
 	r.Route("/generic", func(r chi.Router) {
 		r.Use(middleware.StoreOriginalPath)
+		r.Use(middleware.StoreRevalidate)
+		r.Use(middleware.StorePipelineExecution)
 		r.Use(middlewareauthn.Attempt(handler.Authenticator))
 		r.Use(middleware.TrackDownloadStatForGenericArtifact(handler))
 		r.Use(middleware.TrackBandwidthStatForGenericArtifacts(handler))
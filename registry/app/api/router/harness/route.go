@@ -29,6 +29,7 @@ import (
 	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/registry/app/api/controller/metadata"
 	"github.com/harness/gitness/registry/app/api/interfaces"
+	"github.com/harness/gitness/registry/app/api/middleware"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	registryevents "github.com/harness/gitness/registry/app/events/artifact"
 	registrypostprocessingevents "github.com/harness/gitness/registry/app/events/asyncprocessing"
@@ -36,9 +37,23 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	"github.com/harness/gitness/registry/app/pkg/quarantine"
 	"github.com/harness/gitness/registry/app/services/refcache"
+	"github.com/harness/gitness/registry/app/services/registrypolicy"
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/app/utils/cargo"
+	"github.com/harness/gitness/registry/services/concurrency"
+	"github.com/harness/gitness/registry/services/deletion"
+	"github.com/harness/gitness/registry/services/deletionapproval"
+	"github.com/harness/gitness/registry/services/denylist"
+	"github.com/harness/gitness/registry/services/firewalldelay"
+	"github.com/harness/gitness/registry/services/notification"
+	"github.com/harness/gitness/registry/services/outbox"
+	"github.com/harness/gitness/registry/services/recentactivity"
+	"github.com/harness/gitness/registry/services/registryjob"
+	"github.com/harness/gitness/registry/services/registryusage"
+	"github.com/harness/gitness/registry/services/trash"
+	"github.com/harness/gitness/registry/services/upstreamprovenance"
+	"github.com/harness/gitness/registry/services/vulnerability"
 	registrywebhook "github.com/harness/gitness/registry/services/webhook"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/types"
@@ -96,11 +111,40 @@ func NewAPIHandler(
 	publicAccess publicaccess.Service,
 	quarantineFinder quarantine.Finder,
 	storageService *storage.Service,
+	registryPolicyService *registrypolicy.Service,
+	indexBuildRepository store.IndexBuildRepository,
 	app *docker.App,
+	trashService *trash.Service,
+	metadataHistoryRepository store.ArtifactMetadataHistoryRepository,
+	garbageRepository store.GarbageRepository,
+	notificationChannelRepository store.NotificationChannelRepository,
+	notificationDispatcher *notification.Dispatcher,
+	imageDescriptionRepository store.ImageDescriptionRepository,
+	eventOutbox *outbox.Outbox,
+	failedUploadDao store.FailedUploadRepository,
+	uploadFailureStatsDao store.UploadFailureStatsRepository,
+	concurrencyLimiter *concurrency.Limiter,
+	registryJobDao store.RegistryJobRepository,
+	registryJobService *registryjob.Service,
+	registryUsageService *registryusage.Service,
+	imageStarRepository store.ImageStarRepository,
+	recentActivityService *recentactivity.Service,
+	deletionApprovalService *deletionapproval.Service,
+	deletionService *deletion.Service,
+	quarantineAccessAttemptStore store.QuarantineAccessAttemptRepository,
+	packageDenylistService *denylist.Service,
+	vulnerabilityService *vulnerability.Service,
+	provenanceRepository store.ArtifactProvenanceRepository,
+	ociImporter *docker.Importer,
+	ociExporter *docker.Exporter,
+	searchRepository store.ArtifactSearchRepository,
+	firewallDelayService *firewalldelay.Service,
+	upstreamProvenanceService *upstreamprovenance.Service,
 ) APIHandler {
 	r := chi.NewRouter()
 	r.Use(audit.Middleware())
 	r.Use(middlewareauthn.Attempt(authenticator))
+	r.Use(middleware.CompressJSON())
 	registryMetadataHelper := metadata.NewRegistryMetadataHelper(spacePathStore, spaceFinder, repoDao)
 
 	apiController := metadata.NewAPIController(
@@ -138,10 +182,40 @@ func NewAPIHandler(
 		packageWrapper,
 		publicAccess,
 		storageService,
+		registryPolicyService,
+		indexBuildRepository,
 		app,
+		trashService,
+		metadataHistoryRepository,
+		garbageRepository,
+		notificationChannelRepository,
+		notificationDispatcher,
+		imageDescriptionRepository,
+		eventOutbox,
+		failedUploadDao,
+		uploadFailureStatsDao,
+		concurrencyLimiter,
+		registryJobDao,
+		registryJobService,
+		registryUsageService,
+		imageStarRepository,
+		recentActivityService,
+		deletionApprovalService,
+		deletionService,
+		quarantineAccessAttemptStore,
+		packageDenylistService,
+		vulnerabilityService,
+		provenanceRepository,
+		ociImporter,
+		ociExporter,
+		searchRepository,
+		firewallDelayService,
+		upstreamProvenanceService,
 	)
+	// the due scheduled deletions are executed by the controller, they go through the same path as the deletes.
+	deletionService.Register(apiController)
 
-	handler := artifact.NewStrictHandler(apiController, []artifact.StrictMiddlewareFunc{})
+	handler := artifact.NewStrictHandler(streamingServer{apiController}, []artifact.StrictMiddlewareFunc{})
 	muxHandler := artifact.HandlerFromMuxWithBaseURL(handler, r, baseURL)
 	return encode.TerminatedPathBefore(
 		terminatedPathPrefixesAPI,
@@ -0,0 +1,135 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package harness
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"slices"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/api/utils"
+)
+
+// streamingServer streams the artifact listings instead of buffering them in full before writing them.
+type streamingServer struct {
+	artifact.StrictServerInterface
+}
+
+func (s streamingServer) GetAllArtifacts(
+	ctx context.Context,
+	r artifact.GetAllArtifactsRequestObject,
+) (artifact.GetAllArtifactsResponseObject, error) {
+	resp, err := s.StrictServerInterface.GetAllArtifacts(ctx, r)
+	if list, ok := resp.(artifact.GetAllArtifacts200JSONResponse); ok {
+		return streamedArtifacts{list}, err
+	}
+	return resp, err
+}
+
+func (s streamingServer) GetAllArtifactsByRegistry(
+	ctx context.Context,
+	r artifact.GetAllArtifactsByRegistryRequestObject,
+) (artifact.GetAllArtifactsByRegistryResponseObject, error) {
+	resp, err := s.StrictServerInterface.GetAllArtifactsByRegistry(ctx, r)
+	if list, ok := resp.(artifact.GetAllArtifactsByRegistry200JSONResponse); ok {
+		return streamedRegistryArtifacts{list}, err
+	}
+	return resp, err
+}
+
+func (s streamingServer) GetAllArtifactVersions(
+	ctx context.Context,
+	r artifact.GetAllArtifactVersionsRequestObject,
+) (artifact.GetAllArtifactVersionsResponseObject, error) {
+	resp, err := s.StrictServerInterface.GetAllArtifactVersions(ctx, r)
+	if list, ok := resp.(artifact.GetAllArtifactVersions200JSONResponse); ok {
+		return streamedArtifactVersions{list}, err
+	}
+	return resp, err
+}
+
+type streamedArtifacts struct {
+	artifact.GetAllArtifacts200JSONResponse
+}
+
+func (r streamedArtifacts) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
+	resp := r.GetAllArtifacts200JSONResponse
+	if resp.Data.Artifacts == nil {
+		return resp.VisitGetAllArtifactsResponse(w)
+	}
+	data := resp.Data
+	return writeStreamedJSON(w, "artifacts", slices.Values(data.Artifacts),
+		pageFields(data.ItemCount, data.PageCount, data.PageIndex, data.PageSize), resp.Status)
+}
+
+type streamedRegistryArtifacts struct {
+	artifact.GetAllArtifactsByRegistry200JSONResponse
+}
+
+func (r streamedRegistryArtifacts) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	resp := r.GetAllArtifactsByRegistry200JSONResponse
+	if resp.Data.Artifacts == nil {
+		return resp.VisitGetAllArtifactsByRegistryResponse(w)
+	}
+	data := resp.Data
+	return writeStreamedJSON(w, "artifacts", slices.Values(data.Artifacts),
+		pageFields(data.ItemCount, data.PageCount, data.PageIndex, data.PageSize), resp.Status)
+}
+
+type streamedArtifactVersions struct {
+	artifact.GetAllArtifactVersions200JSONResponse
+}
+
+func (r streamedArtifactVersions) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	resp := r.GetAllArtifactVersions200JSONResponse
+	if resp.Data.ArtifactVersions == nil {
+		return resp.VisitGetAllArtifactVersionsResponse(w)
+	}
+	data := resp.Data
+	return writeStreamedJSON(w, "artifactVersions", slices.Values(*data.ArtifactVersions),
+		pageFields(data.ItemCount, data.PageCount, data.PageIndex, data.PageSize), resp.Status)
+}
+
+// pageFields returns the paging fields of a listing which are set, in the order of the generated types.
+func pageFields(itemCount, pageCount, pageIndex *int64, pageSize *int) []utils.JSONField {
+	var fields []utils.JSONField
+	if itemCount != nil {
+		fields = append(fields, utils.JSONField{Key: "itemCount", Value: *itemCount})
+	}
+	if pageCount != nil {
+		fields = append(fields, utils.JSONField{Key: "pageCount", Value: *pageCount})
+	}
+	if pageIndex != nil {
+		fields = append(fields, utils.JSONField{Key: "pageIndex", Value: *pageIndex})
+	}
+	if pageSize != nil {
+		fields = append(fields, utils.JSONField{Key: "pageSize", Value: *pageSize})
+	}
+	return fields
+}
+
+func writeStreamedJSON[T any](
+	w http.ResponseWriter,
+	key string,
+	items iter.Seq[T],
+	fields []utils.JSONField,
+	status artifact.Status,
+) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	return utils.StreamJSONList(w, key, items, fields, status)
+}
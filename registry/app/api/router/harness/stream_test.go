@@ -0,0 +1,133 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package harness
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+func TestStreamedResponses(t *testing.T) {
+	count := int64(2)
+	size := 50
+	pull := "docker pull <image>"
+	artifacts := artifact.ListArtifactResponseJSONResponse{
+		Data: artifact.ListArtifact{
+			Artifacts: []artifact.ArtifactMetadata{{Name: "a"}, {Name: "b", DownloadsCount: &count}},
+			ItemCount: &count,
+		},
+		Status: artifact.StatusSUCCESS,
+	}
+	registryArtifacts := artifact.ListRegistryArtifactResponseJSONResponse{
+		Data: artifact.ListRegistryArtifact{
+			Artifacts: []artifact.RegistryArtifactMetadata{{Name: "a", RegistryIdentifier: "reg"}},
+			PageCount: &count,
+		},
+		Status: artifact.StatusSUCCESS,
+	}
+	versions := artifact.ListArtifactVersionResponseJSONResponse{
+		Data: artifact.ListArtifactVersion{
+			ArtifactVersions: &[]artifact.ArtifactVersionMetadata{{Name: "1.0.0", PullCommand: &pull}, {Name: "2.0.0"}},
+			ItemCount:        &count,
+			PageCount:        &count,
+			PageIndex:        &count,
+			PageSize:         &size,
+		},
+		Status: artifact.StatusSUCCESS,
+	}
+
+	tests := []struct {
+		name     string
+		visit    func(w *httptest.ResponseRecorder) error
+		streamed func(w *httptest.ResponseRecorder) error
+	}{
+		{
+			name: "artifacts",
+			visit: func(w *httptest.ResponseRecorder) error {
+				return artifact.GetAllArtifacts200JSONResponse{ListArtifactResponseJSONResponse: artifacts}.
+					VisitGetAllArtifactsResponse(w)
+			},
+			streamed: func(w *httptest.ResponseRecorder) error {
+				return streamedArtifacts{artifact.GetAllArtifacts200JSONResponse{
+					ListArtifactResponseJSONResponse: artifacts,
+				}}.VisitGetAllArtifactsResponse(w)
+			},
+		},
+		{
+			name: "registry artifacts",
+			visit: func(w *httptest.ResponseRecorder) error {
+				return artifact.GetAllArtifactsByRegistry200JSONResponse{
+					ListRegistryArtifactResponseJSONResponse: registryArtifacts,
+				}.VisitGetAllArtifactsByRegistryResponse(w)
+			},
+			streamed: func(w *httptest.ResponseRecorder) error {
+				return streamedRegistryArtifacts{artifact.GetAllArtifactsByRegistry200JSONResponse{
+					ListRegistryArtifactResponseJSONResponse: registryArtifacts,
+				}}.VisitGetAllArtifactsByRegistryResponse(w)
+			},
+		},
+		{
+			name: "artifact versions",
+			visit: func(w *httptest.ResponseRecorder) error {
+				return artifact.GetAllArtifactVersions200JSONResponse{
+					ListArtifactVersionResponseJSONResponse: versions,
+				}.VisitGetAllArtifactVersionsResponse(w)
+			},
+			streamed: func(w *httptest.ResponseRecorder) error {
+				return streamedArtifactVersions{artifact.GetAllArtifactVersions200JSONResponse{
+					ListArtifactVersionResponseJSONResponse: versions,
+				}}.VisitGetAllArtifactVersionsResponse(w)
+			},
+		},
+		{
+			name: "no artifacts",
+			visit: func(w *httptest.ResponseRecorder) error {
+				return artifact.GetAllArtifacts200JSONResponse{}.VisitGetAllArtifactsResponse(w)
+			},
+			streamed: func(w *httptest.ResponseRecorder) error {
+				return streamedArtifacts{}.VisitGetAllArtifactsResponse(w)
+			},
+		},
+		{
+			name: "no artifact versions",
+			visit: func(w *httptest.ResponseRecorder) error {
+				return artifact.GetAllArtifactVersions200JSONResponse{}.VisitGetAllArtifactVersionsResponse(w)
+			},
+			streamed: func(w *httptest.ResponseRecorder) error {
+				return streamedArtifactVersions{}.VisitGetAllArtifactVersionsResponse(w)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := httptest.NewRecorder()
+			if err := tt.visit(want); err != nil {
+				t.Fatalf("failed to write the response: %v", err)
+			}
+			got := httptest.NewRecorder()
+			if err := tt.streamed(got); err != nil {
+				t.Fatalf("failed to stream the response: %v", err)
+			}
+			if got.Code != want.Code || got.Header().Get("Content-Type") != want.Header().Get("Content-Type") {
+				t.Errorf("unexpected response %d %q", got.Code, got.Header().Get("Content-Type"))
+			}
+			if got.Body.String() != want.Body.String() {
+				t.Errorf("streamed response differs\n got: %s\nwant: %s", got.Body, want.Body)
+			}
+		})
+	}
+}
@@ -40,6 +40,8 @@ func NewMavenHandler(handler *maven.Handler) Handler {
 
 	r.Route("/maven", func(r chi.Router) {
 		r.Use(middleware.StoreOriginalPath)
+		r.Use(middleware.StoreRevalidate)
+		r.Use(middleware.StorePipelineExecution)
 		r.Use(middleware.CheckAuthHeader())
 		r.Use(middlewareauthn.Attempt(handler.Authenticator))
 		r.Use(middleware.CheckAuthWithChallenge(handler, handler.SpaceFinder, handler.PublicAccessService))
@@ -22,6 +22,7 @@ import (
 	"github.com/harness/gitness/app/services/publicaccess"
 	"github.com/harness/gitness/app/services/refcache"
 	"github.com/harness/gitness/registry/app/api/handler/cargo"
+	"github.com/harness/gitness/registry/app/api/handler/content"
 	"github.com/harness/gitness/registry/app/api/handler/generic"
 	"github.com/harness/gitness/registry/app/api/handler/gopackage"
 	"github.com/harness/gitness/registry/app/api/handler/huggingface"
@@ -32,6 +33,7 @@ import (
 	"github.com/harness/gitness/registry/app/api/handler/python"
 	"github.com/harness/gitness/registry/app/api/handler/rpm"
 	"github.com/harness/gitness/registry/app/api/middleware"
+	"github.com/harness/gitness/registry/app/pkg/plugin"
 	"github.com/harness/gitness/types/enum"
 
 	"github.com/go-chi/chi/v5"
@@ -58,13 +60,26 @@ func NewRouter(
 	cargoHandler cargo.Handler,
 	gopackageHandler gopackage.Handler,
 	huggingfaceHandler huggingface.Handler,
+	contentHandler content.Handler,
 	spaceFinder refcache.SpaceFinder,
 	publicAccessService publicaccess.Service,
 ) Handler {
 	r := chi.NewRouter()
 
+	// Contents are addressed by digest within the root space, the access is checked against the registries
+	// referencing them.
+	r.Route("/{rootIdentifier}/content/{digest}", func(r chi.Router) {
+		r.Use(middleware.LogPackageRequest)
+		r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+		r.Get("/", contentHandler.GetContent)
+		r.Head("/", contentHandler.GetContent)
+	})
+
 	r.Route("/{rootIdentifier}/{registryIdentifier}", func(r chi.Router) {
+		r.Use(middleware.LogPackageRequest)
 		r.Use(middleware.StoreOriginalPath)
+		r.Use(middleware.StoreRevalidate)
+		r.Use(middleware.StorePipelineExecution)
 
 		r.Route("/maven", func(r chi.Router) {
 			r.Use(middleware.CheckAuthHeader())
@@ -75,7 +90,7 @@ func NewRouter(
 			r.Use(middleware.StoreArtifactInfo(mavenHandler))
 			r.Get("/*", mavenHandler.GetArtifact)
 			r.Head("/*", mavenHandler.HeadArtifact)
-			r.Put("/*", mavenHandler.PutArtifact)
+			r.With(middleware.ValidateUpload()).Put("/*", mavenHandler.PutArtifact)
 		})
 
 		r.Route("/generic", func(r chi.Router) {
@@ -89,6 +104,7 @@ func NewRouter(
 					Get("/", genericHandler.PullArtifact)
 
 				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					With(middleware.ValidateUpload()).
 					Put("/", genericHandler.PushArtifact)
 			})
 		})
@@ -113,6 +129,7 @@ func NewRouter(
 							With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
 							Head("/*", genericHandler.HeadFile)
 						r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+							With(middleware.ValidateUpload()).
 							Put("/*", genericHandler.PutFile)
 					})
 				})
@@ -125,6 +142,7 @@ func NewRouter(
 			// TODO (Arvind): Move this to top layer with total abstraction
 			r.With(middleware.StoreArtifactInfo(pythonHandler)).
 				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				With(middleware.ValidateUpload()).
 				Post("/*", pythonHandler.UploadPackageFile)
 			r.With(middleware.StoreArtifactInfo(pythonHandler)).
 				With(middleware.TrackDownloadStatsForPythonPackage(packageHandler)).
@@ -138,11 +156,23 @@ func NewRouter(
 			})
 		})
 
+		// The package types implemented by plugins are served by the package handler, any other package type
+		// isn't supported.
 		r.Route("/{packageType}", func(r chi.Router) {
 			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
-			r.HandleFunc("/*", func(w http.ResponseWriter, r *http.Request) {
-				packageType := chi.URLParam(r, "packageType")
-				http.Error(w, fmt.Sprintf("Package type '%s' is not supported", packageType), http.StatusNotFound)
+			r.Use(requirePlugin)
+			r.Route("/{package}/{version}", func(r chi.Router) {
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/", packageHandler.ServePlugin)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/*", packageHandler.ServePlugin)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Head("/*", packageHandler.ServePlugin)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					With(middleware.ValidateUpload()).
+					Put("/*", packageHandler.ServePlugin)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDelete)).
+					Delete("/", packageHandler.ServePlugin)
 			})
 		})
 
@@ -159,9 +189,11 @@ func NewRouter(
 
 			r.With(middleware.StoreArtifactInfo(nugetHandler)).
 				With(middleware.RequestNugetPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				With(middleware.ValidateUpload()).
 				Put("/*", nugetHandler.UploadPackage)
 			r.With(middleware.StoreArtifactInfo(nugetHandler)).
 				With(middleware.RequestNugetPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				With(middleware.ValidateUpload()).
 				Put("/symbolpackage/*", nugetHandler.UploadSymbolPackage)
 			r.With(middleware.StoreArtifactInfo(nugetHandler)).
 				With(middleware.TrackDownloadStats(packageHandler)).
@@ -222,6 +254,7 @@ func NewRouter(
 			r.Route("/@{scope}/{id}", func(r chi.Router) {
 				r.With(middleware.StoreArtifactInfo(npmHandler)).
 					With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					With(middleware.ValidateUpload()).
 					Put("/", npmHandler.UploadPackage)
 
 				r.With(middleware.StoreArtifactInfo(npmHandler)).
@@ -250,6 +283,7 @@ func NewRouter(
 			r.Route("/{id}", func(r chi.Router) {
 				r.With(middleware.StoreArtifactInfo(npmHandler)).
 					With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					With(middleware.ValidateUpload()).
 					Put("/", npmHandler.UploadPackage)
 
 				r.With(middleware.StoreArtifactInfo(npmHandler)).
@@ -299,6 +333,7 @@ func NewRouter(
 			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
 			r.With(middleware.StoreArtifactInfo(rpmHandler)).
 				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				With(middleware.ValidateUpload()).
 				Put("/*", rpmHandler.UploadPackageFile)
 			r.With(middleware.StoreArtifactInfo(rpmHandler)).
 				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
@@ -328,6 +363,7 @@ func NewRouter(
 				Get("/api/v1/crates", cargoHandler.SearchPackage)
 			r.With(middleware.StoreArtifactInfo(cargoHandler)).
 				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				With(middleware.ValidateUpload()).
 				Put("/api/v1/crates/new", cargoHandler.UploadPackage)
 			r.With(middleware.StoreArtifactInfo(cargoHandler)).
 				With(middleware.TrackDownloadStats(packageHandler)).
@@ -346,6 +382,7 @@ func NewRouter(
 			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
 			r.With(middleware.StoreArtifactInfo(gopackageHandler)).
 				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				With(middleware.ValidateUpload()).
 				Put("/upload", gopackageHandler.UploadPackage)
 			r.With(middleware.StoreArtifactInfo(gopackageHandler)).
 				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
@@ -377,6 +414,22 @@ func NewRouter(
 				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
 				Get("/api/{repoType}/{repo}/revision/{rev}", huggingfaceHandler.RevisionInfo)
 
+			r.With(middleware.StoreArtifactInfo(huggingfaceHandler)).
+				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/api/{repoType}/{repo}", huggingfaceHandler.RevisionInfo)
+
+			r.With(middleware.StoreArtifactInfo(huggingfaceHandler)).
+				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/api/{repoType}/{repo}/tree/{rev}", huggingfaceHandler.ListTree)
+
+			r.With(middleware.StoreArtifactInfo(huggingfaceHandler)).
+				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/api/{repoType}/{repo}/tree/{rev}/*", huggingfaceHandler.ListTree)
+
+			r.With(middleware.StoreArtifactInfo(huggingfaceHandler)).
+				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Post("/api/{repoType}/{repo}/paths-info/{rev}", huggingfaceHandler.PathsInfo)
+
 			r.With(middleware.StoreArtifactInfo(huggingfaceHandler)).
 				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
 				Post("/{repo}.git/info/lfs/objects/batch", huggingfaceHandler.LfsInfo)
@@ -387,6 +440,7 @@ func NewRouter(
 
 			r.With(middleware.StoreArtifactInfo(huggingfaceHandler)).
 				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				With(middleware.ValidateUpload()).
 				Put("/api/{repoType}/{repo}/{rev}/multipart/upload/{sha256}", huggingfaceHandler.LfsUpload)
 
 			r.With(middleware.StoreArtifactInfo(huggingfaceHandler)).
@@ -395,6 +449,7 @@ func NewRouter(
 
 			r.With(middleware.StoreArtifactInfo(huggingfaceHandler)).
 				With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				With(middleware.ValidateUpload()).
 				Post("/api/{repoType}/{repo}/commit/{rev}", huggingfaceHandler.CommitRevision)
 
 			r.With(middleware.StoreArtifactInfo(huggingfaceHandler)).
@@ -420,6 +475,18 @@ func NewRouter(
 	return r
 }
 
+// requirePlugin answers the requests of package types no plugin is registered for as not supported.
+func requirePlugin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		packageType := chi.URLParam(r, "packageType")
+		if _, ok := plugin.GetByPathPackageType(packageType); !ok {
+			http.Error(w, fmt.Sprintf("Package type '%s' is not supported", packageType), http.StatusNotFound)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 func registerDistTagRoutes(r chi.Router, npmHandler npm.Handler, packageHandler packages.Handler) {
 	r.With(middleware.StoreArtifactInfo(npmHandler)).
 		With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
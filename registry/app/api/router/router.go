@@ -22,6 +22,7 @@ import (
 	"github.com/harness/gitness/app/api/middleware/logging"
 	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/registry/app/api/handler/swagger"
+	"github.com/harness/gitness/registry/app/api/middleware"
 	generic2 "github.com/harness/gitness/registry/app/api/router/generic"
 	"github.com/harness/gitness/registry/app/api/router/harness"
 	"github.com/harness/gitness/registry/app/api/router/maven"
@@ -43,6 +44,7 @@ func GetAppRouter(
 	mavenHandler maven.Handler,
 	genericHandler generic2.Handler,
 	packageHandler packages.Handler,
+	inFlightTracker *middleware.InFlightTracker,
 ) AppRouter {
 	r := chi.NewRouter()
 
@@ -51,6 +53,7 @@ func GetAppRouter(
 	r.Use(hlog.MethodHandler("http.method"))
 	r.Use(logging.HLogRequestIDHandler())
 	r.Use(logging.HLogAccessLogHandler())
+	r.Use(inFlightTracker.Track())
 	r.Use(address.Handler("", ""))
 
 	r.Use(audit.Middleware())
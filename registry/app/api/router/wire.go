@@ -24,6 +24,7 @@ import (
 	urlprovider "github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/registry/app/api/handler/cargo"
+	"github.com/harness/gitness/registry/app/api/handler/content"
 	"github.com/harness/gitness/registry/app/api/handler/generic"
 	"github.com/harness/gitness/registry/app/api/handler/gopackage"
 	"github.com/harness/gitness/registry/app/api/handler/huggingface"
@@ -35,6 +36,7 @@ import (
 	"github.com/harness/gitness/registry/app/api/handler/python"
 	"github.com/harness/gitness/registry/app/api/handler/rpm"
 	"github.com/harness/gitness/registry/app/api/interfaces"
+	"github.com/harness/gitness/registry/app/api/middleware"
 	generic2 "github.com/harness/gitness/registry/app/api/router/generic"
 	"github.com/harness/gitness/registry/app/api/router/harness"
 	mavenRouter "github.com/harness/gitness/registry/app/api/router/maven"
@@ -47,9 +49,23 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/quarantine"
 	"github.com/harness/gitness/registry/app/services/publicaccess"
 	refcache2 "github.com/harness/gitness/registry/app/services/refcache"
+	"github.com/harness/gitness/registry/app/services/registrypolicy"
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	cargoutils "github.com/harness/gitness/registry/app/utils/cargo"
+	"github.com/harness/gitness/registry/services/concurrency"
+	"github.com/harness/gitness/registry/services/deletion"
+	"github.com/harness/gitness/registry/services/deletionapproval"
+	"github.com/harness/gitness/registry/services/denylist"
+	"github.com/harness/gitness/registry/services/firewalldelay"
+	"github.com/harness/gitness/registry/services/notification"
+	"github.com/harness/gitness/registry/services/outbox"
+	"github.com/harness/gitness/registry/services/recentactivity"
+	"github.com/harness/gitness/registry/services/registryjob"
+	"github.com/harness/gitness/registry/services/registryusage"
+	"github.com/harness/gitness/registry/services/trash"
+	"github.com/harness/gitness/registry/services/upstreamprovenance"
+	"github.com/harness/gitness/registry/services/vulnerability"
 	registrywebhook "github.com/harness/gitness/registry/services/webhook"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/types"
@@ -63,8 +79,13 @@ func AppRouterProvider(
 	mavenHandler mavenRouter.Handler,
 	genericHandler generic2.Handler,
 	handler packagerrouter.Handler,
+	inFlightTracker *middleware.InFlightTracker,
 ) AppRouter {
-	return GetAppRouter(ocir, appHandler, config.APIURL, mavenHandler, genericHandler, handler)
+	return GetAppRouter(ocir, appHandler, config.APIURL, mavenHandler, genericHandler, handler, inFlightTracker)
+}
+
+func InFlightTrackerProvider() *middleware.InFlightTracker {
+	return middleware.NewInFlightTracker()
 }
 
 func APIHandlerProvider(
@@ -101,7 +122,35 @@ func APIHandlerProvider(
 	publicAccess publicaccess.CacheService,
 	quarantineFinder quarantine.Finder,
 	storageService *storage.Service,
+	registryPolicyService *registrypolicy.Service,
+	indexBuildRepository store.IndexBuildRepository,
 	app *docker.App,
+	trashService *trash.Service,
+	metadataHistoryRepository store.ArtifactMetadataHistoryRepository,
+	garbageRepository store.GarbageRepository,
+	notificationChannelRepository store.NotificationChannelRepository,
+	notificationDispatcher *notification.Dispatcher,
+	imageDescriptionRepository store.ImageDescriptionRepository,
+	eventOutbox *outbox.Outbox,
+	failedUploadDao store.FailedUploadRepository,
+	uploadFailureStatsDao store.UploadFailureStatsRepository,
+	concurrencyLimiter *concurrency.Limiter,
+	registryJobDao store.RegistryJobRepository,
+	registryJobService *registryjob.Service,
+	registryUsageService *registryusage.Service,
+	imageStarRepository store.ImageStarRepository,
+	recentActivityService *recentactivity.Service,
+	deletionApprovalService *deletionapproval.Service,
+	deletionService *deletion.Service,
+	quarantineAccessAttemptStore store.QuarantineAccessAttemptRepository,
+	packageDenylistService *denylist.Service,
+	vulnerabilityService *vulnerability.Service,
+	provenanceRepository store.ArtifactProvenanceRepository,
+	ociImporter *docker.Importer,
+	ociExporter *docker.Exporter,
+	searchRepository store.ArtifactSearchRepository,
+	firewallDelayService *firewalldelay.Service,
+	upstreamProvenanceService *upstreamprovenance.Service,
 ) harness.APIHandler {
 	return harness.NewAPIHandler(
 		repoDao,
@@ -137,7 +186,35 @@ func APIHandlerProvider(
 		publicAccess,
 		quarantineFinder,
 		storageService,
+		registryPolicyService,
+		indexBuildRepository,
 		app,
+		trashService,
+		metadataHistoryRepository,
+		garbageRepository,
+		notificationChannelRepository,
+		notificationDispatcher,
+		imageDescriptionRepository,
+		eventOutbox,
+		failedUploadDao,
+		uploadFailureStatsDao,
+		concurrencyLimiter,
+		registryJobDao,
+		registryJobService,
+		registryUsageService,
+		imageStarRepository,
+		recentActivityService,
+		deletionApprovalService,
+		deletionService,
+		quarantineAccessAttemptStore,
+		packageDenylistService,
+		vulnerabilityService,
+		provenanceRepository,
+		ociImporter,
+		ociExporter,
+		searchRepository,
+		firewallDelayService,
+		upstreamProvenanceService,
 	)
 }
 
@@ -164,6 +241,7 @@ func PackageHandlerProvider(
 	cargoHandler cargo.Handler,
 	gopackageHandler gopackage.Handler,
 	huggingfaceHandler huggingface.Handler,
+	contentHandler content.Handler,
 	spaceFinder refcache.SpaceFinder,
 	publicAccessService publicaccess.CacheService,
 ) packagerrouter.Handler {
@@ -178,10 +256,11 @@ func PackageHandlerProvider(
 		cargoHandler,
 		gopackageHandler,
 		huggingfaceHandler,
+		contentHandler,
 		spaceFinder,
 		publicAccessService,
 	)
 }
 
 var WireSet = wire.NewSet(APIHandlerProvider, OCIHandlerProvider, AppRouterProvider,
-	MavenHandlerProvider, GenericHandlerProvider, PackageHandlerProvider)
+	MavenHandlerProvider, GenericHandlerProvider, PackageHandlerProvider, InFlightTrackerProvider)
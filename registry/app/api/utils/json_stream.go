@@ -0,0 +1,94 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"iter"
+)
+
+const streamBufferSize = 32 * 1024
+
+// JSONField is a field of the data object written by StreamJSONList.
+type JSONField struct {
+	Key   string
+	Value any
+}
+
+// StreamJSONList writes the response envelope {"data":{<key>:[<items>],<fields>},"status":<status>} to w the
+// way json.Encoder does. The items are encoded one at a time as they're read from the iterator, so the memory
+// used doesn't grow with the length of the list.
+func StreamJSONList[T any](w io.Writer, key string, items iter.Seq[T], fields []JSONField, status any) error {
+	s := &jsonStream{w: bufio.NewWriterSize(w, streamBufferSize)}
+	s.raw(`{"data":{`)
+	s.key(key)
+	s.raw("[")
+	first := true
+	for item := range items {
+		if !first {
+			s.raw(",")
+		}
+		first = false
+		s.value(item)
+		if s.err != nil {
+			return s.err
+		}
+	}
+	s.raw("]")
+	for _, field := range fields {
+		s.raw(",")
+		s.key(field.Key)
+		s.value(field.Value)
+	}
+	s.raw("},")
+	s.key("status")
+	s.value(status)
+	s.raw("}\n")
+	if s.err != nil {
+		return s.err
+	}
+	return s.w.Flush()
+}
+
+// jsonStream keeps the first error of the writes, the following writes are skipped.
+type jsonStream struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (s *jsonStream) raw(data string) {
+	if s.err == nil {
+		_, s.err = s.w.WriteString(data)
+	}
+}
+
+func (s *jsonStream) key(key string) {
+	s.value(key)
+	s.raw(":")
+}
+
+func (s *jsonStream) value(v any) {
+	if s.err != nil {
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		s.err = err
+		return
+	}
+	_, s.err = s.w.Write(data)
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/api/utils"
+)
+
+type embedded struct {
+	Inner string `json:"inner"`
+}
+
+type item struct {
+	*embedded
+	Name  string `json:"name"`
+	Size  int64  `json:"size,string"`
+	Inner string `json:"-"`
+}
+
+type list struct {
+	Data struct {
+		Items []item `json:"items"`
+		Count int    `json:"count"`
+	} `json:"data"`
+	Status string `json:"status"`
+}
+
+func TestStreamJSONList(t *testing.T) {
+	items := []item{
+		{embedded: &embedded{Inner: "<promoted>"}, Name: "a", Size: 1, Inner: "shadowed"},
+		{Name: "b&c", Size: 2},
+	}
+	v := list{Status: "SUCCESS"}
+	v.Data.Items = items
+	v.Data.Count = len(items)
+
+	want := &bytes.Buffer{}
+	if err := json.NewEncoder(want).Encode(v); err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+	got := &bytes.Buffer{}
+	fields := []utils.JSONField{{Key: "count", Value: len(items)}}
+	if err := utils.StreamJSONList(got, "items", slices.Values(items), fields, "SUCCESS"); err != nil {
+		t.Fatalf("failed to stream: %v", err)
+	}
+	if got.String() != want.String() {
+		t.Errorf("streamed list differs\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestStreamJSONListEmpty(t *testing.T) {
+	got := &bytes.Buffer{}
+	if err := utils.StreamJSONList(got, "items", slices.Values([]item(nil)), nil, "SUCCESS"); err != nil {
+		t.Fatalf("failed to stream: %v", err)
+	}
+	if want := `{"data":{"items":[]},"status":"SUCCESS"}` + "\n"; got.String() != want {
+		t.Errorf("streamed list differs\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestStreamJSONListStopsOnError(t *testing.T) {
+	read := 0
+	items := iter.Seq[any](func(yield func(any) bool) {
+		for _, v := range []any{"a", make(chan int), "c"} {
+			read++
+			if !yield(v) {
+				return
+			}
+		}
+	})
+	var typeErr *json.UnsupportedTypeError
+	if err := utils.StreamJSONList(&bytes.Buffer{}, "items", items, nil, "SUCCESS"); !errors.As(err, &typeErr) {
+		t.Fatalf("expected an unsupported type error, got %v", err)
+	}
+	if read != 2 {
+		t.Errorf("expected the listing to stop after the failed item, read %d items", read)
+	}
+}
+
+func TestStreamJSONListGeneratedTypes(t *testing.T) {
+	count := int64(2)
+	url := "pkg/test?a=<b>"
+	artifacts := []artifact.ArtifactMetadata{
+		{Name: "a", PullCommand: &url, Labels: &[]string{"x"}},
+		{Name: "b", DownloadsCount: &count},
+	}
+	resp := artifact.GetAllArtifacts200JSONResponse{
+		ListArtifactResponseJSONResponse: artifact.ListArtifactResponseJSONResponse{
+			Data:   artifact.ListArtifact{Artifacts: artifacts, ItemCount: &count},
+			Status: artifact.StatusSUCCESS,
+		},
+	}
+
+	want, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	got := &bytes.Buffer{}
+	fields := []utils.JSONField{{Key: "itemCount", Value: count}}
+	if err := utils.StreamJSONList(got, "artifacts", slices.Values(artifacts), fields, resp.Status); err != nil {
+		t.Fatalf("failed to stream: %v", err)
+	}
+	if got.String() != string(want)+"\n" {
+		t.Errorf("streamed artifacts differ\n got: %s\nwant: %s", got, want)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	urlprovider "github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/audit"
 	cargo2 "github.com/harness/gitness/registry/app/api/controller/pkg/cargo"
+	contentcontroller "github.com/harness/gitness/registry/app/api/controller/pkg/content"
 	generic3 "github.com/harness/gitness/registry/app/api/controller/pkg/generic"
 	gopackage2 "github.com/harness/gitness/registry/app/api/controller/pkg/gopackage"
 	"github.com/harness/gitness/registry/app/api/controller/pkg/huggingface"
@@ -33,6 +34,7 @@ import (
 	python2 "github.com/harness/gitness/registry/app/api/controller/pkg/python"
 	rpm2 "github.com/harness/gitness/registry/app/api/controller/pkg/rpm"
 	"github.com/harness/gitness/registry/app/api/handler/cargo"
+	"github.com/harness/gitness/registry/app/api/handler/content"
 	"github.com/harness/gitness/registry/app/api/handler/generic"
 	"github.com/harness/gitness/registry/app/api/handler/gopackage"
 	hf2 "github.com/harness/gitness/registry/app/api/handler/huggingface"
@@ -45,7 +47,12 @@ import (
 	"github.com/harness/gitness/registry/app/api/handler/rpm"
 	"github.com/harness/gitness/registry/app/api/interfaces"
 	"github.com/harness/gitness/registry/app/api/router"
+	"github.com/harness/gitness/registry/app/common/faultinject"
+	"github.com/harness/gitness/registry/app/common/hedging"
+	commonhttp "github.com/harness/gitness/registry/app/common/http"
+	"github.com/harness/gitness/registry/app/common/upstreampolicy"
 	storagedriver "github.com/harness/gitness/registry/app/driver"
+	driverbase "github.com/harness/gitness/registry/app/driver/base"
 	"github.com/harness/gitness/registry/app/driver/factory"
 	"github.com/harness/gitness/registry/app/driver/filesystem"
 	"github.com/harness/gitness/registry/app/driver/s3-aws"
@@ -53,6 +60,7 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/base"
 	cargoregistry "github.com/harness/gitness/registry/app/pkg/cargo"
 	"github.com/harness/gitness/registry/app/pkg/docker"
+	"github.com/harness/gitness/registry/app/pkg/failedupload"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	generic2 "github.com/harness/gitness/registry/app/pkg/generic"
 	gopackageregistry "github.com/harness/gitness/registry/app/pkg/gopackage"
@@ -65,11 +73,13 @@ import (
 	rpmregistry "github.com/harness/gitness/registry/app/pkg/rpm"
 	publicaccess2 "github.com/harness/gitness/registry/app/services/publicaccess"
 	refcache2 "github.com/harness/gitness/registry/app/services/refcache"
+	"github.com/harness/gitness/registry/app/services/registrypolicy"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/app/store/cache"
 	"github.com/harness/gitness/registry/app/store/database"
 	"github.com/harness/gitness/registry/config"
 	"github.com/harness/gitness/registry/gc"
+	"github.com/harness/gitness/registry/services/recentactivity"
 	"github.com/harness/gitness/types"
 
 	"github.com/google/wire"
@@ -100,10 +110,74 @@ func DefaultStorageProvider(ctx context.Context, c *types.Config) (storagedriver
 			log.Error().Stack().Err(err).Msg("failed to init s3 Blob storage ")
 			panic(err)
 		}
+		d = withMirrors(ctx, c, d)
 	}
+	d = withFaultInjection(c, d)
+	enableUpstreamHedging(c)
+	configureUpstreamPolicy(c)
 	return d, err
 }
 
+// configureUpstreamPolicy sets the timeout, retry and concurrency policy shared by the upstream clients.
+// The policy wraps the hedging, so the response timeout bounds a hedged attempt as a whole.
+func configureUpstreamPolicy(c *types.Config) {
+	commonhttp.SetUpstreamPolicy(upstreampolicy.New(upstreampolicy.Config{
+		ResponseTimeout:      c.Registry.UpstreamClient.ResponseTimeout,
+		MaxRetries:           c.Registry.UpstreamClient.MaxRetries,
+		RetryBaseDelay:       c.Registry.UpstreamClient.RetryBaseDelay,
+		RetryMaxDelay:        c.Registry.UpstreamClient.RetryMaxDelay,
+		MaxConcurrentPerHost: c.Registry.UpstreamClient.MaxConcurrentPerHost,
+	}))
+}
+
+// enableUpstreamHedging wraps the transports of upstream clients to hedge metadata requests, if enabled.
+// It's applied after the fault injection so every attempt is subject to the injected faults.
+func enableUpstreamHedging(c *types.Config) {
+	if !c.Registry.UpstreamHedging.Enabled {
+		return
+	}
+	hedger, err := hedging.Parse(c.Registry.UpstreamHedging.Delay, c.Registry.UpstreamHedging.Mirrors)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("failed to init upstream hedging")
+		panic(err)
+	}
+	commonhttp.EnableHedging(hedger)
+}
+
+// withFaultInjection wraps the driver and the transports of upstream clients to inject faults, if enabled.
+func withFaultInjection(c *types.Config, d storagedriver.StorageDriver) storagedriver.StorageDriver {
+	if !c.Registry.FaultInjection.Enabled {
+		return d
+	}
+	injector, err := faultinject.Parse(c.Registry.FaultInjection.Rules)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("failed to init fault injection")
+		panic(err)
+	}
+	log.Warn().Msg("fault injection is enabled for registry storage and upstream calls")
+	commonhttp.EnableFaultInjection(injector)
+	return driverbase.NewFaultInjector(d, injector)
+}
+
+// withMirrors wraps the driver to redirect downloads to the S3 mirror in the region of the client.
+func withMirrors(ctx context.Context, c *types.Config, d storagedriver.StorageDriver) storagedriver.StorageDriver {
+	params, err := config.GetS3MirrorParameters(c)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("failed to parse s3 mirrors")
+		panic(err)
+	}
+	mirrors := make(map[string]storagedriver.StorageDriver, len(params))
+	for region, p := range params {
+		mirror, err := factory.Create(ctx, "s3aws", p)
+		if err != nil {
+			log.Error().Stack().Err(err).Msgf("failed to init s3 Blob storage of mirror in region %s", region)
+			panic(err)
+		}
+		mirrors[region] = mirror
+	}
+	return driverbase.NewRegionalRedirector(d, mirrors)
+}
+
 func NewHandlerProvider(
 	controller *docker.Controller,
 	spaceFinder refcache.SpaceFinder,
@@ -117,6 +191,7 @@ func NewHandlerProvider(
 	registryFinder refcache2.RegistryFinder,
 	publicAccessService publicaccess2.CacheService,
 	auditService audit.Service,
+	recentActivity *recentactivity.Service,
 ) *ocihandler.Handler {
 	return ocihandler.NewHandler(
 		controller,
@@ -132,6 +207,7 @@ func NewHandlerProvider(
 		publicAccessService,
 		config.Auth.AnonymousUserSecret,
 		auditService,
+		recentActivity,
 	)
 }
 
@@ -140,6 +216,7 @@ func NewMavenHandlerProvider(
 	tokenStore corestore.TokenStore, userCtrl *usercontroller.Controller, authenticator authn.Authenticator,
 	authorizer authz.Authorizer, spaceFinder refcache.SpaceFinder, registryFinder refcache2.RegistryFinder,
 	publicAccessService publicaccess2.CacheService, auditService audit.Service,
+	recentActivity *recentactivity.Service,
 ) *mavenhandler.Handler {
 	return mavenhandler.NewHandler(
 		controller,
@@ -152,6 +229,7 @@ func NewMavenHandlerProvider(
 		registryFinder,
 		publicAccessService,
 		auditService,
+		recentActivity,
 	)
 }
 
@@ -166,6 +244,7 @@ func NewPackageHandlerProvider(
 	packageWrapper interfaces.PackageWrapper,
 	auditService audit.Service,
 	artifactDao store.ArtifactRepository,
+	recentActivity *recentactivity.Service,
 ) packages.Handler {
 	return packages.NewHandler(
 		registryDao,
@@ -184,6 +263,7 @@ func NewPackageHandlerProvider(
 		packageWrapper,
 		auditService,
 		artifactDao,
+		recentActivity,
 	)
 }
 
@@ -220,6 +300,7 @@ func NewGenericHandlerProvider(
 	userCtrl *usercontroller.Controller, authenticator authn.Authenticator, urlProvider urlprovider.Provider,
 	authorizer authz.Authorizer, packageHandler packages.Handler, spaceFinder refcache.SpaceFinder,
 	registryFinder refcache2.RegistryFinder, auditService audit.Service,
+	recentActivity *recentactivity.Service,
 ) *generic.Handler {
 	return generic.NewGenericArtifactHandler(
 		spaceStore,
@@ -233,6 +314,7 @@ func NewGenericHandlerProvider(
 		spaceFinder,
 		registryFinder,
 		auditService,
+		recentActivity,
 	)
 }
 
@@ -250,6 +332,13 @@ func NewGoPackageHandlerProvider(
 	return gopackage.NewHandler(controller, packageHandler)
 }
 
+func NewContentHandlerProvider(
+	controller contentcontroller.Controller,
+	packageHandler packages.Handler,
+) content.Handler {
+	return content.NewHandler(controller, packageHandler)
+}
+
 var WireSet = wire.NewSet(
 	DefaultStorageProvider,
 	NewHandlerProvider,
@@ -262,13 +351,16 @@ var WireSet = wire.NewSet(
 	NewRpmHandlerProvider,
 	NewCargoHandlerProvider,
 	NewGoPackageHandlerProvider,
+	NewContentHandlerProvider,
 	database.WireSet,
 	cache.WireSet,
 	refcache2.WireSet,
+	registrypolicy.WireSet,
 	pkg.WireSet,
 	docker.OpenSourceWireSet,
 	filemanager.WireSet,
 	quarantine.WireSet,
+	failedupload.WireSet,
 	maven.WireSet,
 	nuget.WireSet,
 	python.WireSet,
@@ -286,6 +378,7 @@ var WireSet = wire.NewSet(
 	cargo2.ControllerSet,
 	cargoregistry.WireSet,
 	gopackage2.ControllerSet,
+	contentcontroller.ControllerSet,
 	gopackageregistry.WireSet,
 	huggingface.WireSet,
 	hf2.WireSet,
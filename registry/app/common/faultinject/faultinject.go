@@ -0,0 +1,150 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package faultinject delays and fails storage and upstream calls according to configured rules, so retries,
+// timeouts and cache fallbacks can be exercised in staging. It must never be enabled in production.
+package faultinject
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"regexp"
+	"time"
+)
+
+// Target is the kind of calls a rule applies to.
+type Target string
+
+const (
+	TargetStorage  Target = "storage"
+	TargetUpstream Target = "upstream"
+)
+
+// ErrInjected is wrapped by all injected errors.
+var ErrInjected = errors.New("injected fault")
+
+// Rule injects faults in the calls of a target whose path matches a regular expression. Storage calls are
+// matched by the path in the storage, upstream calls by the URL path of the request.
+type Rule struct {
+	Target Target `json:"target"`
+	Path   string `json:"path"`
+	// Latency is added to every matching call, e.g. "500ms".
+	Latency string `json:"latency"`
+	// ErrorRate is the probability in [0, 1] of failing a matching call.
+	ErrorRate float64 `json:"errorRate"`
+	// Status is the status of the response returned instead of an error when an upstream call is failed.
+	Status int `json:"status"`
+}
+
+type rule struct {
+	target    Target
+	path      *regexp.Regexp
+	latency   time.Duration
+	errorRate float64
+	status    int
+}
+
+// Injector applies the first rule matching a call.
+type Injector struct {
+	rules []rule
+	rand  func() float64
+}
+
+// Parse creates an injector from a JSON array of rules.
+func Parse(spec string) (*Injector, error) {
+	var rules []Rule
+	if err := json.Unmarshal([]byte(spec), &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse fault injection rules: %w", err)
+	}
+	return New(rules)
+}
+
+func New(rules []Rule) (*Injector, error) {
+	injector := &Injector{
+		rules: make([]rule, len(rules)),
+		rand:  rand.Float64, //nolint:gosec // faults don't need a secure source of randomness
+	}
+	for i, r := range rules {
+		if r.Target != TargetStorage && r.Target != TargetUpstream {
+			return nil, fmt.Errorf("rule %d: unknown target %q", i, r.Target)
+		}
+		path, err := regexp.Compile(r.Path)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: invalid path: %w", i, err)
+		}
+		var latency time.Duration
+		if r.Latency != "" {
+			if latency, err = time.ParseDuration(r.Latency); err != nil {
+				return nil, fmt.Errorf("rule %d: invalid latency: %w", i, err)
+			}
+		}
+		if r.ErrorRate < 0 || r.ErrorRate > 1 {
+			return nil, fmt.Errorf("rule %d: error rate must be within [0, 1]", i)
+		}
+		injector.rules[i] = rule{
+			target:    r.Target,
+			path:      path,
+			latency:   latency,
+			errorRate: r.ErrorRate,
+			status:    r.Status,
+		}
+	}
+	return injector, nil
+}
+
+// FaultError is the error a call is failed with.
+type FaultError struct {
+	Target Target
+	Path   string
+	// Status is the status of the response to return instead of the error, 0 if the error should be returned.
+	Status int
+}
+
+func (e *FaultError) Error() string {
+	return fmt.Sprintf("%s: %s call for %s", ErrInjected, e.Target, e.Path)
+}
+
+func (e *FaultError) Unwrap() error {
+	return ErrInjected
+}
+
+// Inject delays a call by the latency of the first rule matching it, and returns a FaultError if the call
+// should fail. The error of the context is returned if it's done while waiting.
+func (i *Injector) Inject(ctx context.Context, target Target, path string) error {
+	if i == nil {
+		return nil
+	}
+	for _, r := range i.rules {
+		if r.target != target || !r.path.MatchString(path) {
+			continue
+		}
+		if r.latency > 0 {
+			timer := time.NewTimer(r.latency)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+		if r.errorRate > 0 && i.rand() < r.errorRate {
+			return &FaultError{Target: target, Path: path, Status: r.status}
+		}
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faultinject
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{name: "valid", spec: `[{"target":"storage","path":"^/docker/","latency":"10ms","errorRate":0.5}]`},
+		{name: "empty", spec: `[]`},
+		{name: "invalid json", spec: `{`, wantErr: true},
+		{name: "unknown target", spec: `[{"target":"database","path":".*"}]`, wantErr: true},
+		{name: "invalid path", spec: `[{"target":"storage","path":"("}]`, wantErr: true},
+		{name: "invalid latency", spec: `[{"target":"storage","path":".*","latency":"soon"}]`, wantErr: true},
+		{name: "invalid error rate", spec: `[{"target":"upstream","path":".*","errorRate":2}]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInjector_Inject(t *testing.T) {
+	injector, err := New([]Rule{
+		{Target: TargetStorage, Path: "^/docker/", ErrorRate: 1},
+		{Target: TargetStorage, Path: ".*", Latency: "20ms"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.Background()
+
+	err = injector.Inject(ctx, TargetStorage, "/docker/blobs/sha256")
+	if !errors.Is(err, ErrInjected) {
+		t.Fatalf("expected an injected error, got %v", err)
+	}
+	if err = injector.Inject(ctx, TargetUpstream, "/docker/blobs/sha256"); err != nil {
+		t.Fatalf("expected no fault for another target, got %v", err)
+	}
+
+	start := time.Now()
+	if err = injector.Inject(ctx, TargetStorage, "/maven/file"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Fatalf("expected the call to be delayed, it took %s", elapsed)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err = injector.Inject(canceled, TargetStorage, "/maven/file"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the context error, got %v", err)
+	}
+
+	var nilInjector *Injector
+	if err = nilInjector.Inject(ctx, TargetStorage, "/docker/blobs/sha256"); err != nil {
+		t.Fatalf("expected no error of a nil injector, got %v", err)
+	}
+}
+
+func TestInjector_RoundTripper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	injector, err := New([]Rule{
+		{Target: TargetUpstream, Path: "^/unavailable", ErrorRate: 1, Status: http.StatusServiceUnavailable},
+		{Target: TargetUpstream, Path: "^/broken", ErrorRate: 1},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := &http.Client{Transport: injector.RoundTripper(http.DefaultTransport)}
+
+	for path, want := range map[string]int{"/unavailable": http.StatusServiceUnavailable, "/ok": http.StatusOK} {
+		resp, err := client.Get(server.URL + path) //nolint:noctx
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", path, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != want {
+			t.Fatalf("expected status %d for %s, got %d", want, path, resp.StatusCode)
+		}
+	}
+
+	resp, err := client.Get(server.URL + "/broken") //nolint:noctx
+	if err == nil {
+		resp.Body.Close()
+	}
+	if !errors.Is(err, ErrInjected) {
+		t.Fatalf("expected an injected error, got %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faultinject
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+)
+
+type roundTripper struct {
+	next     http.RoundTripper
+	injector *Injector
+}
+
+// RoundTripper wraps a transport to inject the faults of the upstream rules in its requests. Failed requests
+// get a response with the status of the rule, or an error if the rule has no status.
+func (i *Injector) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if i == nil {
+		return next
+	}
+	return &roundTripper{next: next, injector: i}
+}
+
+func (t *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	err := t.injector.Inject(req.Context(), TargetUpstream, req.URL.Path)
+	var fault *FaultError
+	if errors.As(err, &fault) && fault.Status != 0 {
+		return &http.Response{
+			Status:     http.StatusText(fault.Status),
+			StatusCode: fault.Status,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{"Content-Type": []string{"text/plain"}},
+			Body:       io.NopCloser(strings.NewReader(fault.Error())),
+			Request:    req,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
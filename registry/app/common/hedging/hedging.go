@@ -0,0 +1,134 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hedging reduces the tail latency of small upstream metadata requests of proxy registries: if the
+// upstream doesn't respond within a delay, the request is sent to an equivalent mirror as well and the first
+// usable response is used.
+package hedging
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultDelay is the time to wait for the upstream before a mirror is asked if no delay is configured.
+const DefaultDelay = 300 * time.Millisecond
+
+// contentPath matches the paths of package contents, which are too large to be fetched twice.
+var contentPath = regexp.MustCompile(
+	`(?i)(/blobs/|\.(tgz|tar|gz|bz2|xz|zst|zip|jar|war|ear|aar|whl|egg|nupkg|snupkg|crate|rpm|deb|apk|bin|safetensors)$)`)
+
+// Config configures the hedging of upstream requests.
+type Config struct {
+	// Delay is the time to wait for a response of the upstream before the next mirror is asked.
+	Delay time.Duration
+	// Mirrors maps the URL of an upstream to the URLs of its equivalent mirrors, in the order they are asked.
+	Mirrors map[string][]string
+}
+
+type upstream struct {
+	prefix  string
+	mirrors []string
+}
+
+// Hedger sends the metadata requests of upstreams with mirrors to the mirrors as well, if the upstream is slow.
+type Hedger struct {
+	delay time.Duration
+	// upstreams are sorted by descending length of the prefix, so the most specific upstream matches first.
+	upstreams []upstream
+}
+
+// Parse creates a hedger from a JSON object mapping upstream URLs to lists of mirror URLs, e.g.
+// {"https://registry.npmjs.org": ["https://registry.npmmirror.com"]}.
+func Parse(delay time.Duration, spec string) (*Hedger, error) {
+	var mirrors map[string][]string
+	if err := json.Unmarshal([]byte(spec), &mirrors); err != nil {
+		return nil, fmt.Errorf("failed to parse upstream mirrors: %w", err)
+	}
+	return New(Config{Delay: delay, Mirrors: mirrors})
+}
+
+func New(config Config) (*Hedger, error) {
+	h := &Hedger{
+		delay: config.Delay,
+	}
+	if h.delay <= 0 {
+		h.delay = DefaultDelay
+	}
+
+	for upstreamURL, mirrorURLs := range config.Mirrors {
+		prefix, err := normalizeURL(upstreamURL)
+		if err != nil {
+			return nil, err
+		}
+		u := upstream{prefix: prefix}
+		for _, mirrorURL := range mirrorURLs {
+			mirror, err := normalizeURL(mirrorURL)
+			if err != nil {
+				return nil, err
+			}
+			u.mirrors = append(u.mirrors, mirror)
+		}
+		if len(u.mirrors) > 0 {
+			h.upstreams = append(h.upstreams, u)
+		}
+	}
+	sort.Slice(h.upstreams, func(i, j int) bool {
+		return len(h.upstreams[i].prefix) > len(h.upstreams[j].prefix)
+	})
+
+	return h, nil
+}
+
+// mirrorURLs returns the upstream of a request and the URLs of the request on the mirrors of the upstream,
+// no URLs if the request has to go to the upstream only.
+func (h *Hedger) mirrorURLs(method string, reqURL *url.URL) (string, []string) {
+	if method != http.MethodGet && method != http.MethodHead {
+		return "", nil
+	}
+	if contentPath.MatchString(reqURL.Path) {
+		return "", nil
+	}
+
+	target := reqURL.String()
+	for _, u := range h.upstreams {
+		rest, ok := strings.CutPrefix(target, u.prefix)
+		if !ok || (rest != "" && rest[0] != '/' && rest[0] != '?') {
+			continue
+		}
+		urls := make([]string, len(u.mirrors))
+		for i, mirror := range u.mirrors {
+			urls[i] = mirror + rest
+		}
+		return u.prefix, urls
+	}
+	return "", nil
+}
+
+func normalizeURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", fmt.Errorf("invalid upstream mirror URL %q: %w", raw, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid upstream mirror URL %q: an absolute http(s) URL is required", raw)
+	}
+	return strings.TrimSuffix(u.String(), "/"), nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hedging
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHedging(t *testing.T) {
+	upstreamDelay := atomic.Int64{}
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Duration(upstreamDelay.Load())):
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = io.WriteString(w, "upstream")
+	}))
+	defer upstream.Close()
+
+	var mirrorAuth atomic.Value
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mirrorAuth.Store(r.Header.Get("Authorization"))
+		_, _ = io.WriteString(w, "mirror")
+	}))
+	defer mirror.Close()
+
+	hedger, err := New(Config{
+		Delay:   20 * time.Millisecond,
+		Mirrors: map[string][]string{upstream.URL + "/npm/": {mirror.URL}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: hedger.RoundTripper(http.DefaultTransport)}
+
+	get := func(path string) string {
+		req, err := http.NewRequest(http.MethodGet, upstream.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer secret")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	if got := get("/npm/lodash"); got != "upstream" {
+		t.Errorf("expected a fast upstream to answer, got %q", got)
+	}
+
+	upstreamDelay.Store(int64(time.Second))
+	if got := get("/npm/lodash"); got != "mirror" {
+		t.Errorf("expected the mirror to answer for a slow upstream, got %q", got)
+	}
+	if auth, _ := mirrorAuth.Load().(string); auth != "" {
+		t.Errorf("expected no credentials to be sent to the mirror, got %q", auth)
+	}
+
+	upstreamDelay.Store(int64(50 * time.Millisecond))
+	if got := get("/npm/lodash/-/lodash-4.17.21.tgz"); got != "upstream" {
+		t.Errorf("expected package contents not to be hedged, got %q", got)
+	}
+	if got := get("/pypi/simple/requests/"); got != "upstream" {
+		t.Errorf("expected upstreams without mirrors not to be hedged, got %q", got)
+	}
+}
+
+func TestNewInvalidMirror(t *testing.T) {
+	if _, err := New(Config{Mirrors: map[string][]string{"https://registry.npmjs.org": {"mirror"}}}); err == nil {
+		t.Error("expected a relative mirror URL to be rejected")
+	}
+	if _, err := Parse(0, `["https://registry.npmjs.org"]`); err == nil {
+		t.Error("expected a list of mirrors to be rejected")
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hedging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	hedgedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "registry",
+		Subsystem: "upstream",
+		Name:      "hedged_requests_total",
+		Help:      "Number of upstream requests which were sent to a mirror because the upstream was slow or failed.",
+	}, []string{"upstream"})
+
+	hedgeWins = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "registry",
+		Subsystem: "upstream",
+		Name:      "hedge_wins_total",
+		Help:      "Number of hedged upstream requests which were answered by a mirror.",
+	}, []string{"upstream"})
+)
+
+// credentialHeaders hold the credentials of the upstream, they are never sent to a mirror.
+var credentialHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie"}
+
+type roundTripper struct {
+	next   http.RoundTripper
+	hedger *Hedger
+}
+
+type attempt struct {
+	index int
+	resp  *http.Response
+	err   error
+}
+
+// RoundTripper wraps the transport of upstream clients to hedge the metadata requests of upstreams with mirrors.
+func (h *Hedger) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if h == nil || len(h.upstreams) == 0 {
+		return next
+	}
+	return &roundTripper{next: next, hedger: h}
+}
+
+//nolint:gocognit // the attempts are coordinated in a single loop on purpose.
+func (t *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	upstreamURL, mirrors := t.hedger.mirrorURLs(req.Method, req.URL)
+	if len(mirrors) == 0 || (req.Body != nil && req.Body != http.NoBody) {
+		return t.next.RoundTrip(req)
+	}
+
+	attempts := make(chan attempt, len(mirrors)+1)
+	cancels := make([]context.CancelFunc, 0, len(mirrors)+1)
+	send := func(r *http.Request) {
+		ctx, cancel := context.WithCancel(req.Context())
+		index := len(cancels)
+		cancels = append(cancels, cancel)
+		go func() {
+			resp, err := t.next.RoundTrip(r.WithContext(ctx))
+			attempts <- attempt{index: index, resp: resp, err: err}
+		}()
+	}
+
+	send(req)
+	timer := time.NewTimer(t.hedger.delay)
+	defer timer.Stop()
+
+	var failed []attempt
+	for received := 0; ; {
+		select {
+		case <-timer.C:
+			// the mirrors are asked one after the other, every delay, until one of the attempts succeeds.
+			next := len(cancels) - 1
+			if next >= len(mirrors) {
+				continue
+			}
+			if next == 0 {
+				hedgedRequests.WithLabelValues(upstreamURL).Inc()
+			}
+			mirrorReq, err := mirrorRequest(req, mirrors[next])
+			if err != nil {
+				// record the failure as an attempt, so the next mirror is asked right away.
+				cancels = append(cancels, func() {})
+				attempts <- attempt{index: len(cancels) - 1, err: err}
+				continue
+			}
+			send(mirrorReq)
+			timer.Reset(t.hedger.delay)
+
+		case a := <-attempts:
+			received++
+			if a.usable() {
+				if a.index > 0 {
+					hedgeWins.WithLabelValues(upstreamURL).Inc()
+				}
+				for i, cancel := range cancels {
+					if i != a.index {
+						cancel()
+					}
+				}
+				go discard(attempts, len(cancels)-received)
+				for _, f := range failed {
+					f.closeBody()
+				}
+				a.resp.Body = &cancelBody{ReadCloser: a.resp.Body, cancel: cancels[a.index]}
+				return a.resp, nil
+			}
+
+			failed = append(failed, a)
+			if received < len(cancels) {
+				continue
+			}
+			if len(cancels) <= len(mirrors) && req.Context().Err() == nil {
+				// nothing is in flight, so there is no need to wait for the delay.
+				timer.Reset(0)
+				continue
+			}
+
+			// all attempts failed, the result of the upstream is returned.
+			result := failed[0]
+			for _, f := range failed {
+				if f.index == 0 {
+					result = f
+				}
+			}
+			for _, f := range failed {
+				if f.index != result.index {
+					f.closeBody()
+					cancels[f.index]()
+				}
+			}
+			if result.err != nil {
+				cancels[result.index]()
+				return nil, result.err
+			}
+			result.resp.Body = &cancelBody{ReadCloser: result.resp.Body, cancel: cancels[result.index]}
+			return result.resp, nil
+		}
+	}
+}
+
+// usable returns whether the response of an attempt can be returned. Mirrors don't get the credentials of the
+// upstream, so only their successful and not found responses are used.
+func (a attempt) usable() bool {
+	if a.err != nil {
+		return false
+	}
+	status := a.resp.StatusCode
+	if a.index == 0 {
+		return status < http.StatusInternalServerError && status != http.StatusTooManyRequests
+	}
+	return status < http.StatusBadRequest || status == http.StatusNotFound
+}
+
+func mirrorRequest(req *http.Request, mirrorURL string) (*http.Request, error) {
+	u, err := url.Parse(mirrorURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mirror URL: %w", err)
+	}
+	r := req.Clone(req.Context())
+	r.URL = u
+	r.Host = ""
+	for _, h := range credentialHeaders {
+		r.Header.Del(h)
+	}
+	return r, nil
+}
+
+// discard closes the bodies of the attempts still in flight once they complete.
+func discard(attempts <-chan attempt, inFlight int) {
+	for range inFlight {
+		a := <-attempts
+		a.closeBody()
+	}
+}
+
+func (a attempt) closeBody() {
+	if a.resp != nil {
+		_ = a.resp.Body.Close()
+	}
+}
+
+// cancelBody cancels the context of the attempt which returned the response once the body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -21,6 +21,10 @@ import (
 	"net"
 	"net/http"
 	"time"
+
+	"github.com/harness/gitness/registry/app/common/faultinject"
+	"github.com/harness/gitness/registry/app/common/hedging"
+	"github.com/harness/gitness/registry/app/common/upstreampolicy"
 )
 
 const (
@@ -33,6 +37,7 @@ const (
 var (
 	secureHTTPTransport   http.RoundTripper
 	insecureHTTPTransport http.RoundTripper
+	upstreamPolicy        = upstreampolicy.New(upstreampolicy.DefaultConfig())
 )
 
 func init() {
@@ -135,3 +140,32 @@ func GetHTTPTransport(opts ...TransportOption) http.RoundTripper {
 	}
 	return secureHTTPTransport
 }
+
+// NewClient returns a client for the calls to upstreams and remote integrations. Its calls are subject to the
+// shared upstream policy: attempts time out if the upstream doesn't respond, idempotent requests are retried with
+// a jittered backoff and the concurrent calls to a host are limited.
+func NewClient(opts ...TransportOption) *http.Client {
+	return &http.Client{
+		Transport: upstreamPolicy.RoundTripper(GetHTTPTransport(opts...)),
+	}
+}
+
+// SetUpstreamPolicy replaces the policy of the clients returned by NewClient. It has to be called at startup,
+// before any client got created.
+func SetUpstreamPolicy(policy *upstreampolicy.Policy) {
+	upstreamPolicy = policy
+}
+
+// EnableFaultInjection wraps the transports of upstream clients to inject the faults of the upstream rules of
+// the injector. It has to be called at startup, before any client got its transport.
+func EnableFaultInjection(injector *faultinject.Injector) {
+	secureHTTPTransport = injector.RoundTripper(secureHTTPTransport)
+	insecureHTTPTransport = injector.RoundTripper(insecureHTTPTransport)
+}
+
+// EnableHedging wraps the transports of upstream clients to hedge the metadata requests of upstreams with
+// mirrors. It has to be called at startup, before any client got its transport.
+func EnableHedging(hedger *hedging.Hedger) {
+	secureHTTPTransport = hedger.RoundTripper(secureHTTPTransport)
+	insecureHTTPTransport = hedger.RoundTripper(insecureHTTPTransport)
+}
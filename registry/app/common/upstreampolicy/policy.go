@@ -0,0 +1,138 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package upstreampolicy enforces the shared error budget of the calls to the upstreams of proxy registries and
+// remote integrations: every attempt has to respond within a timeout, idempotent requests are retried with a
+// jittered exponential backoff and the concurrent calls to a single host are limited.
+package upstreampolicy
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Config configures the policy of upstream calls.
+type Config struct {
+	// ResponseTimeout is the time an attempt waits for the response headers of the upstream, 0 disables it.
+	// Reading the body isn't bounded, so large packages can be streamed from slow upstreams.
+	ResponseTimeout time.Duration
+	// MaxRetries is the number of times a failed idempotent request is retried.
+	MaxRetries int
+	// RetryBaseDelay is the backoff before the first retry, it's doubled for every further retry.
+	RetryBaseDelay time.Duration
+	// RetryMaxDelay caps the backoff between retries, a Retry-After of the upstream beyond it isn't waited for.
+	RetryMaxDelay time.Duration
+	// MaxConcurrentPerHost is the number of calls in flight to a single host, 0 disables the limit.
+	MaxConcurrentPerHost int
+}
+
+// DefaultConfig is the policy of upstream calls if none is configured.
+func DefaultConfig() Config {
+	return Config{
+		ResponseTimeout:      30 * time.Second,
+		MaxRetries:           2,
+		RetryBaseDelay:       200 * time.Millisecond,
+		RetryMaxDelay:        5 * time.Second,
+		MaxConcurrentPerHost: 64,
+	}
+}
+
+// Policy applies the timeout, retry and concurrency policy to the calls of upstream clients.
+type Policy struct {
+	config Config
+
+	mu    sync.Mutex
+	hosts map[string]chan struct{}
+}
+
+func New(config Config) *Policy {
+	if config.MaxRetries < 0 {
+		config.MaxRetries = 0
+	}
+	if config.RetryBaseDelay <= 0 {
+		config.RetryBaseDelay = DefaultConfig().RetryBaseDelay
+	}
+	if config.RetryMaxDelay < config.RetryBaseDelay {
+		config.RetryMaxDelay = config.RetryBaseDelay
+	}
+	return &Policy{
+		config: config,
+		hosts:  map[string]chan struct{}{},
+	}
+}
+
+// acquire waits for a slot of the host, the returned function releases it.
+func (p *Policy) acquire(ctx context.Context, host string) (func(), error) {
+	if p.config.MaxConcurrentPerHost <= 0 {
+		return func() {}, nil
+	}
+
+	p.mu.Lock()
+	slots, ok := p.hosts[host]
+	if !ok {
+		slots = make(chan struct{}, p.config.MaxConcurrentPerHost)
+		p.hosts[host] = slots
+	}
+	p.mu.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+	default:
+		throttledRequests.WithLabelValues(host).Inc()
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { <-slots })
+	}, nil
+}
+
+// backoff returns the delay before the retry following the given attempt and whether the request should be
+// retried at all. A Retry-After of the upstream is honored as long as it doesn't exceed the max delay.
+func (p *Policy) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return retryAfter, retryAfter <= p.config.RetryMaxDelay
+		}
+	}
+
+	ceiling := p.config.RetryMaxDelay
+	if attempt < 32 {
+		ceiling = min(p.config.RetryBaseDelay<<attempt, p.config.RetryMaxDelay)
+	}
+	// full jitter spreads the retries of concurrent requests, so a recovering upstream isn't hit all at once.
+	return time.Duration(rand.Int64N(int64(ceiling)) + 1), true //nolint:gosec // the jitter needs no crypto rand.
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,170 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upstreampolicy
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	var calls atomic.Int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer upstream.Close()
+
+	policy := New(Config{MaxRetries: 2, RetryBaseDelay: time.Millisecond, RetryMaxDelay: 5 * time.Millisecond})
+	client := &http.Client{Transport: policy.RoundTripper(http.DefaultTransport)}
+
+	resp, err := client.Get(upstream.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls.Load() != 3 {
+		t.Errorf("expected a success after 2 retries, got %d after %d calls", resp.StatusCode, calls.Load())
+	}
+
+	calls.Store(0)
+	resp, err = client.Post(upstream.URL, "text/plain", strings.NewReader("body"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || calls.Load() != 1 {
+		t.Errorf("expected a non-idempotent request not to be retried, got %d after %d calls",
+			resp.StatusCode, calls.Load())
+	}
+}
+
+func TestRetryAfterBeyondMaxDelay(t *testing.T) {
+	var calls atomic.Int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer upstream.Close()
+
+	policy := New(Config{MaxRetries: 3, RetryBaseDelay: time.Millisecond, RetryMaxDelay: time.Second})
+	client := &http.Client{Transport: policy.RoundTripper(http.DefaultTransport)}
+
+	resp, err := client.Get(upstream.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests || calls.Load() != 1 {
+		t.Errorf("expected the throttled response to be returned right away, got %d after %d calls",
+			resp.StatusCode, calls.Load())
+	}
+}
+
+func TestResponseTimeout(t *testing.T) {
+	var calls atomic.Int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		// the body isn't bounded by the response timeout.
+		time.Sleep(50 * time.Millisecond)
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer upstream.Close()
+
+	policy := New(Config{
+		ResponseTimeout: 20 * time.Millisecond,
+		MaxRetries:      1,
+		RetryBaseDelay:  time.Millisecond,
+	})
+	client := &http.Client{Transport: policy.RoundTripper(http.DefaultTransport)}
+
+	resp, err := client.Get(upstream.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != "ok" || calls.Load() != 2 {
+		t.Errorf("expected the retry to answer, got %q (%v) after %d calls", body, err, calls.Load())
+	}
+
+	policy = New(Config{ResponseTimeout: 20 * time.Millisecond})
+	client = &http.Client{Transport: policy.RoundTripper(http.DefaultTransport)}
+	calls.Store(0)
+	_, err = client.Get(upstream.URL)
+	if !errors.Is(err, ErrResponseTimeout) {
+		t.Errorf("expected a response timeout, got %v", err)
+	}
+}
+
+func TestConcurrencyLimit(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer upstream.Close()
+
+	policy := New(Config{MaxConcurrentPerHost: 2})
+	client := &http.Client{Transport: policy.RoundTripper(http.DefaultTransport)}
+
+	errs := make(chan error, 6)
+	for range 6 {
+		go func() {
+			resp, err := client.Get(upstream.URL)
+			if err == nil {
+				_, err = io.ReadAll(resp.Body)
+				resp.Body.Close()
+			}
+			errs <- err
+		}()
+	}
+	for range 6 {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if peak.Load() > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", peak.Load())
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upstreampolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// ErrResponseTimeout is returned if the upstream didn't respond within the response timeout.
+var ErrResponseTimeout = errors.New("upstream didn't respond in time")
+
+var (
+	retriedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "registry",
+		Subsystem: "upstream",
+		Name:      "retried_requests_total",
+		Help:      "Number of upstream requests which were retried because the upstream failed or was unavailable.",
+	}, []string{"host"})
+
+	timedOutRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "registry",
+		Subsystem: "upstream",
+		Name:      "timed_out_requests_total",
+		Help:      "Number of upstream request attempts which didn't get a response within the response timeout.",
+	}, []string{"host"})
+
+	throttledRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "registry",
+		Subsystem: "upstream",
+		Name:      "throttled_requests_total",
+		Help:      "Number of upstream requests which had to wait for the concurrency limit of the host.",
+	}, []string{"host"})
+)
+
+type roundTripper struct {
+	next   http.RoundTripper
+	policy *Policy
+}
+
+// RoundTripper wraps the transport of upstream clients to apply the policy to their calls.
+func (p *Policy) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if p == nil {
+		return next
+	}
+	return &roundTripper{next: next, policy: p}
+}
+
+func (t *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	host := req.URL.Host
+	retryable := idempotent(req.Method) && (req.Body == nil || req.Body == http.NoBody)
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.attempt(req, host)
+		if !retryable || attempt >= t.policy.config.MaxRetries || ctx.Err() != nil || !shouldRetry(resp, err) {
+			return resp, err
+		}
+		delay, ok := t.policy.backoff(attempt, resp)
+		if !ok {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			_ = resp.Body.Close()
+		}
+
+		retriedRequests.WithLabelValues(host).Inc()
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// attempt sends the request once. The slot of the host and the context of the attempt are held until the
+// body of the response is closed or read to the end.
+func (t *roundTripper) attempt(req *http.Request, host string) (*http.Response, error) {
+	release, err := t.policy.acquire(req.Context(), host)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	var timer *time.Timer
+	if timeout := t.policy.config.ResponseTimeout; timeout > 0 {
+		timer = time.AfterFunc(timeout, cancel)
+	}
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	timedOut := timer != nil && !timer.Stop()
+	if timedOut && req.Context().Err() == nil {
+		timedOutRequests.WithLabelValues(host).Inc()
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		cancel()
+		release()
+		return nil, fmt.Errorf("%w: no response from %s within %s", ErrResponseTimeout, host,
+			t.policy.config.ResponseTimeout)
+	}
+	if err != nil {
+		cancel()
+		release()
+		return nil, err
+	}
+
+	resp.Body = &releaseBody{ReadCloser: resp.Body, release: func() {
+		cancel()
+		release()
+	}}
+	return resp, nil
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// shouldRetry returns whether the result of an attempt is a transient failure of the upstream.
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// releaseBody releases the resources of the attempt once the body is closed or read to the end.
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if errors.Is(err, io.EOF) {
+		b.release()
+	}
+	return n, err
+}
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base
+
+import (
+	"context"
+	"io"
+
+	"github.com/harness/gitness/registry/app/common/faultinject"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+)
+
+type faultInjector struct {
+	storagedriver.StorageDriver
+
+	injector *faultinject.Injector
+}
+
+// NewFaultInjector wraps the given driver to delay and fail its calls according to the storage rules of the
+// injector. Injected errors are returned as driver errors, like failures of the storage would be.
+func NewFaultInjector(
+	driver storagedriver.StorageDriver,
+	injector *faultinject.Injector,
+) storagedriver.StorageDriver {
+	if injector == nil {
+		return driver
+	}
+	return &faultInjector{
+		StorageDriver: driver,
+		injector:      injector,
+	}
+}
+
+func (d *faultInjector) inject(ctx context.Context, path string) error {
+	if err := d.injector.Inject(ctx, faultinject.TargetStorage, path); err != nil {
+		return storagedriver.Error{DriverName: d.Name(), Detail: err}
+	}
+	return nil
+}
+
+func (d *faultInjector) GetContent(ctx context.Context, path string) ([]byte, error) {
+	if err := d.inject(ctx, path); err != nil {
+		return nil, err
+	}
+	return d.StorageDriver.GetContent(ctx, path)
+}
+
+func (d *faultInjector) PutContent(ctx context.Context, path string, content []byte) error {
+	if err := d.inject(ctx, path); err != nil {
+		return err
+	}
+	return d.StorageDriver.PutContent(ctx, path, content)
+}
+
+func (d *faultInjector) Reader(ctx context.Context, path string, offset int64) (io.ReadCloser, error) {
+	if err := d.inject(ctx, path); err != nil {
+		return nil, err
+	}
+	return d.StorageDriver.Reader(ctx, path, offset)
+}
+
+func (d *faultInjector) Writer(ctx context.Context, path string, a bool) (storagedriver.FileWriter, error) {
+	if err := d.inject(ctx, path); err != nil {
+		return nil, err
+	}
+	return d.StorageDriver.Writer(ctx, path, a)
+}
+
+func (d *faultInjector) Stat(ctx context.Context, path string) (storagedriver.FileInfo, error) {
+	if err := d.inject(ctx, path); err != nil {
+		return nil, err
+	}
+	return d.StorageDriver.Stat(ctx, path)
+}
+
+func (d *faultInjector) List(ctx context.Context, path string) ([]string, error) {
+	if err := d.inject(ctx, path); err != nil {
+		return nil, err
+	}
+	return d.StorageDriver.List(ctx, path)
+}
+
+func (d *faultInjector) Move(ctx context.Context, sourcePath string, destPath string) error {
+	if err := d.inject(ctx, sourcePath); err != nil {
+		return err
+	}
+	return d.StorageDriver.Move(ctx, sourcePath, destPath)
+}
+
+func (d *faultInjector) Delete(ctx context.Context, path string) error {
+	if err := d.inject(ctx, path); err != nil {
+		return err
+	}
+	return d.StorageDriver.Delete(ctx, path)
+}
+
+func (d *faultInjector) RedirectURL(ctx context.Context, method string, path string, filename string) (string, error) {
+	if err := d.inject(ctx, path); err != nil {
+		return "", err
+	}
+	return d.StorageDriver.RedirectURL(ctx, method, path, filename)
+}
+
+func (d *faultInjector) Walk(
+	ctx context.Context,
+	path string,
+	f storagedriver.WalkFn,
+	options ...func(*storagedriver.WalkOptions),
+) error {
+	if err := d.inject(ctx, path); err != nil {
+		return err
+	}
+	return d.StorageDriver.Walk(ctx, path, f, options...)
+}
+
+func (d *faultInjector) CopyObject(ctx context.Context, srcKey, destBucket, destKey string) error {
+	if err := d.inject(ctx, srcKey); err != nil {
+		return err
+	}
+	return d.StorageDriver.CopyObject(ctx, srcKey, destBucket, destKey)
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/harness/gitness/audit"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+
+	"github.com/rs/zerolog/log"
+)
+
+type regionalRedirector struct {
+	storagedriver.StorageDriver
+
+	mirrors map[string]storagedriver.StorageDriver
+}
+
+// NewRegionalRedirector wraps the given driver so that downloads are redirected to the mirror in the region
+// of the client, the mirrors are keyed by region and must hold replicas of the content of the driver.
+// Downloads fall back to the driver when the client is in no mirrored region or the mirror can't serve them.
+func NewRegionalRedirector(
+	driver storagedriver.StorageDriver,
+	mirrors map[string]storagedriver.StorageDriver,
+) storagedriver.StorageDriver {
+	if len(mirrors) == 0 {
+		return driver
+	}
+	return &regionalRedirector{
+		StorageDriver: driver,
+		mirrors:       mirrors,
+	}
+}
+
+// RedirectURL returns a URL of the mirror in the region of the client which may be used to retrieve the
+// content stored at the given path.
+func (r *regionalRedirector) RedirectURL(
+	ctx context.Context,
+	method string,
+	path string,
+	filename string,
+) (string, error) {
+	region := audit.GetRegion(ctx)
+	mirror, ok := r.mirrors[region]
+	if !ok || (method != http.MethodGet && method != http.MethodHead) {
+		return r.StorageDriver.RedirectURL(ctx, method, path, filename)
+	}
+
+	// Content is replicated asynchronously, the mirror may not have it yet.
+	if _, err := mirror.Stat(ctx, path); err != nil {
+		log.Ctx(ctx).Debug().Err(err).Str("region", region).Str("path", path).
+			Msg("content not found in mirror, falling back to the main storage")
+		return r.StorageDriver.RedirectURL(ctx, method, path, filename)
+	}
+	url, err := mirror.RedirectURL(ctx, method, path, filename)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("region", region).Str("path", path).
+			Msg("failed to get redirect URL of mirror, falling back to the main storage")
+		return r.StorageDriver.RedirectURL(ctx, method, path, filename)
+	}
+	if url == "" {
+		return r.StorageDriver.RedirectURL(ctx, method, path, filename)
+	}
+	return url, nil
+}
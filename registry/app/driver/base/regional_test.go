@@ -0,0 +1,83 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/harness/gitness/audit"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+)
+
+type redirectDriver struct {
+	storagedriver.StorageDriver
+
+	url     string
+	missing bool
+}
+
+func (d *redirectDriver) Stat(_ context.Context, path string) (storagedriver.FileInfo, error) {
+	if d.missing {
+		return nil, storagedriver.PathNotFoundError{Path: path}
+	}
+	return nil, nil
+}
+
+func (d *redirectDriver) RedirectURL(_ context.Context, _ string, _ string, _ string) (string, error) {
+	return d.url, nil
+}
+
+// regionContext returns the context of a request sent from the given region.
+func regionContext(region string) context.Context {
+	var ctx context.Context
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Client-Region", region)
+	audit.Middleware()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	})).ServeHTTP(httptest.NewRecorder(), r)
+	return ctx
+}
+
+func TestRegionalRedirector(t *testing.T) {
+	main := &redirectDriver{url: "main"}
+	d := NewRegionalRedirector(main, map[string]storagedriver.StorageDriver{
+		"eu-west-1": &redirectDriver{url: "eu"},
+		"us-east-1": &redirectDriver{url: "us", missing: true},
+	})
+
+	tests := []struct {
+		name   string
+		region string
+		method string
+		want   string
+	}{
+		{name: "redirects to the mirror of the region", region: "EU-West-1", method: http.MethodGet, want: "eu"},
+		{name: "falls back without a mirror", region: "ap-south-1", method: http.MethodGet, want: "main"},
+		{name: "falls back when the mirror misses the content", region: "us-east-1", method: http.MethodGet,
+			want: "main"},
+		{name: "falls back for uploads", region: "eu-west-1", method: http.MethodPut, want: "main"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.RedirectURL(regionContext(tt.region), tt.method, "/files/a", "")
+			if err != nil || got != tt.want {
+				t.Errorf("expected %q, got %q (err: %v)", tt.want, got, err)
+			}
+		})
+	}
+}
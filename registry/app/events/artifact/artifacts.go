@@ -18,6 +18,7 @@ package artifact
 import (
 	"context"
 
+	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/events"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 
@@ -29,6 +30,14 @@ const ArtifactsCategory = "artifacts"
 const ArtifactCreatedEvent events.EventType = "artifact-created"
 const ArtifactDeletedEvent events.EventType = "artifact-deleted"
 
+// ResourceScope holds the identifiers of the account, organization and project the registry of an event belongs to,
+// consumers route the events with them. The organization and project are empty for registries of upper levels.
+type ResourceScope struct {
+	AccountIdentifier string `json:"account_identifier"`
+	OrgIdentifier     string `json:"org_identifier,omitempty"`
+	ProjectIdentifier string `json:"project_identifier,omitempty"`
+}
+
 //nolint:revive
 type ArtifactCreatedPayload struct {
 	RegistryID   int64                `json:"registry_id"`
@@ -87,7 +96,7 @@ type ArtifactInfo struct {
 }
 
 func (r *Reporter) ArtifactCreated(ctx context.Context, payload *ArtifactCreatedPayload) {
-	eventID, err := events.ReporterSendEvent(r.innerReporter, ctx, ArtifactCreatedEvent, payload)
+	eventID, err := r.SendArtifactCreated(ctx, payload)
 	if err != nil {
 		log.Ctx(ctx).Err(err).Msgf("failed to send artifact-created created event")
 		return
@@ -96,6 +105,12 @@ func (r *Reporter) ArtifactCreated(ctx context.Context, payload *ArtifactCreated
 	log.Ctx(ctx).Debug().Msgf("reported artifact-created event with id '%s'", eventID)
 }
 
+// SendArtifactCreated sends the artifact-created event and returns the error to the caller,
+// it's used by callers which retry failed sends.
+func (r *Reporter) SendArtifactCreated(ctx context.Context, payload *ArtifactCreatedPayload) (string, error) {
+	return events.ReporterSendEvent(r.innerReporter, ctx, ArtifactCreatedEvent, payload)
+}
+
 func (r *Reader) RegisterArtifactCreated(
 	fn events.HandlerFunc[*ArtifactCreatedPayload],
 	opts ...events.HandlerOption,
@@ -106,13 +121,17 @@ func (r *Reader) RegisterArtifactCreated(
 //nolint:revive
 type ArtifactDeletedPayload struct {
 	RegistryID   int64                `json:"registry_id"`
+	RegistryUUID string               `json:"registry_uuid,omitempty"`
+	Scope        *ResourceScope       `json:"scope,omitempty"`
 	PrincipalID  int64                `json:"principal_id"`
 	ArtifactType artifact.PackageType `json:"artifact_type"`
 	Artifact     Artifact             `json:"artifact"`
+	// ActorChain holds the real actors in case the principal acted on behalf of another one.
+	ActorChain []audit.Actor `json:"actor_chain,omitempty"`
 }
 
 func (r *Reporter) ArtifactDeleted(ctx context.Context, payload *ArtifactDeletedPayload) {
-	eventID, err := events.ReporterSendEvent(r.innerReporter, ctx, ArtifactDeletedEvent, payload)
+	eventID, err := r.SendArtifactDeleted(ctx, payload)
 	if err != nil {
 		log.Ctx(ctx).Err(err).Msgf("failed to send artifact deleted event")
 		return
@@ -121,6 +140,15 @@ func (r *Reporter) ArtifactDeleted(ctx context.Context, payload *ArtifactDeleted
 	log.Ctx(ctx).Debug().Msgf("reported artifact deleted event with id '%s'", eventID)
 }
 
+// SendArtifactDeleted sends the artifact-deleted event and returns the error to the caller,
+// it's used by callers which retry failed sends.
+func (r *Reporter) SendArtifactDeleted(ctx context.Context, payload *ArtifactDeletedPayload) (string, error) {
+	if payload.ActorChain == nil {
+		payload.ActorChain = audit.GetActorChain(ctx)
+	}
+	return events.ReporterSendEvent(r.innerReporter, ctx, ArtifactDeletedEvent, payload)
+}
+
 func (r *Reader) RegisterArtifactDeleted(
 	fn events.HandlerFunc[*ArtifactDeletedPayload],
 	opts ...events.HandlerOption,
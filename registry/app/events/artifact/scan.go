@@ -0,0 +1,61 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+
+	"github.com/rs/zerolog/log"
+)
+
+const ArtifactScanRequestedEvent events.EventType = "artifact-scan-requested"
+
+// ScanPriority indicates how urgently a scanner should pick up a scan request.
+type ScanPriority string
+
+const (
+	ScanPriorityNormal ScanPriority = "normal"
+	ScanPriorityHigh   ScanPriority = "high"
+)
+
+//nolint:revive
+type ArtifactScanRequestedPayload struct {
+	RegistryID   int64                `json:"registry_id"`
+	PrincipalID  int64                `json:"principal_id"`
+	ArtifactType artifact.PackageType `json:"artifact_type"`
+	Image        string               `json:"image"`
+	Digest       string               `json:"digest"`
+	Priority     ScanPriority         `json:"priority"`
+}
+
+func (r *Reporter) ArtifactScanRequested(ctx context.Context, payload *ArtifactScanRequestedPayload) {
+	eventID, err := events.ReporterSendEvent(r.innerReporter, ctx, ArtifactScanRequestedEvent, payload)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msgf("failed to send artifact scan requested event")
+		return
+	}
+
+	log.Ctx(ctx).Debug().Msgf("reported artifact scan requested event with id '%s'", eventID)
+}
+
+func (r *Reader) RegisterArtifactScanRequested(
+	fn events.HandlerFunc[*ArtifactScanRequestedPayload],
+	opts ...events.HandlerOption,
+) error {
+	return events.ReaderRegisterEvent(r.innerReader, ArtifactScanRequestedEvent, fn, opts...)
+}
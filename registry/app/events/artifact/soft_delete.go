@@ -0,0 +1,86 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+// ArtifactSoftDeletedEvent and ArtifactRestoredEvent report versions which are moved to the trash of their
+// registry and restored from it. Purging a version from the trash isn't reported again.
+const (
+	ArtifactSoftDeletedEvent events.EventType = "artifact-soft-deleted"
+	ArtifactRestoredEvent    events.EventType = "artifact-restored"
+)
+
+//nolint:revive
+type ArtifactSoftDeletedPayload struct {
+	RegistryID   int64                `json:"registry_id"`
+	RegistryUUID string               `json:"registry_uuid,omitempty"`
+	PrincipalID  int64                `json:"principal_id"`
+	ArtifactType artifact.PackageType `json:"artifact_type"`
+	Image        string               `json:"image"`
+	// Version is the tag or the manifest digest, depending on how the version was addressed.
+	Version    string        `json:"version"`
+	ActorChain []audit.Actor `json:"actor_chain,omitempty"`
+}
+
+// SendArtifactSoftDeleted sends the artifact-soft-deleted event and returns the error to the caller,
+// it's used by callers which retry failed sends.
+func (r *Reporter) SendArtifactSoftDeleted(ctx context.Context, payload *ArtifactSoftDeletedPayload) (string, error) {
+	if payload.ActorChain == nil {
+		payload.ActorChain = audit.GetActorChain(ctx)
+	}
+	return events.ReporterSendEvent(r.innerReporter, ctx, ArtifactSoftDeletedEvent, payload)
+}
+
+func (r *Reader) RegisterArtifactSoftDeleted(
+	fn events.HandlerFunc[*ArtifactSoftDeletedPayload],
+	opts ...events.HandlerOption,
+) error {
+	return events.ReaderRegisterEvent(r.innerReader, ArtifactSoftDeletedEvent, fn, opts...)
+}
+
+//nolint:revive
+type ArtifactRestoredPayload struct {
+	RegistryID   int64                `json:"registry_id"`
+	RegistryUUID string               `json:"registry_uuid,omitempty"`
+	PrincipalID  int64                `json:"principal_id"`
+	ArtifactType artifact.PackageType `json:"artifact_type"`
+	Image        string               `json:"image"`
+	// Version is the tag or the manifest digest, depending on how the version was addressed.
+	Version    string        `json:"version"`
+	ActorChain []audit.Actor `json:"actor_chain,omitempty"`
+}
+
+// SendArtifactRestored sends the artifact-restored event and returns the error to the caller,
+// it's used by callers which retry failed sends.
+func (r *Reporter) SendArtifactRestored(ctx context.Context, payload *ArtifactRestoredPayload) (string, error) {
+	if payload.ActorChain == nil {
+		payload.ActorChain = audit.GetActorChain(ctx)
+	}
+	return events.ReporterSendEvent(r.innerReporter, ctx, ArtifactRestoredEvent, payload)
+}
+
+func (r *Reader) RegisterArtifactRestored(
+	fn events.HandlerFunc[*ArtifactRestoredPayload],
+	opts ...events.HandlerOption,
+) error {
+	return events.ReaderRegisterEvent(r.innerReader, ArtifactRestoredEvent, fn, opts...)
+}
@@ -16,7 +16,9 @@ package asyncprocessing
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/harness/gitness/app/api/request"
@@ -32,6 +34,11 @@ type ExecuteAsyncTaskPayload struct {
 	TaskKey string `json:"task_key"` //nolint:tagliatelle
 }
 
+// RegistryIndexTaskKey returns the key of the task which rebuilds the index of the registry.
+func RegistryIndexTaskKey(registryID int64) string {
+	return fmt.Sprintf("registry_%d", registryID)
+}
+
 func (r *Reporter) BuildRegistryIndex(ctx context.Context, registryID int64, sources []types.SourceRef) {
 	session, _ := request.AuthSessionFrom(ctx)
 	principalID := session.Principal.ID
@@ -44,7 +51,7 @@ func (r *Reporter) BuildRegistryIndexWithPrincipal(
 	sources []types.SourceRef,
 	principalID int64,
 ) {
-	key := fmt.Sprintf("registry_%d", registryID)
+	key := RegistryIndexTaskKey(registryID)
 	payload, err := json.Marshal(&types.BuildRegistryIndexTaskPayload{
 		Key:         key,
 		RegistryID:  registryID,
@@ -65,14 +72,16 @@ func (r *Reporter) BuildRegistryIndexWithPrincipal(
 	}
 }
 
-func (r *Reporter) BuildPackageIndex(ctx context.Context, registryID int64, image string) {
+func (r *Reporter) BuildPackageIndex(
+	ctx context.Context, registryID int64, image string, sources []types.SourceRef,
+) {
 	session, _ := request.AuthSessionFrom(ctx)
 	principalID := session.Principal.ID
-	r.BuildPackageIndexWithPrincipal(ctx, registryID, image, principalID)
+	r.BuildPackageIndexWithPrincipal(ctx, registryID, image, sources, principalID)
 }
 
 func (r *Reporter) BuildPackageIndexWithPrincipal(
-	ctx context.Context, registryID int64, image string, principalID int64,
+	ctx context.Context, registryID int64, image string, sources []types.SourceRef, principalID int64,
 ) {
 	key := fmt.Sprintf("package_%d_%s", registryID, image)
 	payload, err := json.Marshal(&types.BuildPackageIndexTaskPayload{
@@ -89,8 +98,6 @@ func (r *Reporter) BuildPackageIndexWithPrincipal(
 		Kind:    types.TaskKindBuildPackageIndex,
 		Payload: payload,
 	}
-
-	sources := make([]types.SourceRef, 0)
 	sources = append(sources, types.SourceRef{Type: types.SourceTypeRegistry, ID: registryID})
 	err = r.UpsertAndSendEvent(ctx, task, sources)
 	if err != nil {
@@ -161,7 +168,13 @@ func (r *Reporter) upsertTask(ctx context.Context, task *types.Task, sources []t
 	shouldEnqueue := false
 	err := r.tx.WithTx(
 		ctx, func(ctx context.Context) error {
-			err := r.TaskRepository.UpsertTask(ctx, task)
+			// new tasks are created as pending, but don't have a queued run yet
+			_, err := r.TaskRepository.Find(ctx, task.Key)
+			created := errors.Is(err, sql.ErrNoRows)
+			if err != nil && !created {
+				return fmt.Errorf("failed to find task %s: %w", task.Key, err)
+			}
+			err = r.TaskRepository.UpsertTask(ctx, task)
 			if err != nil {
 				return fmt.Errorf("failed to upsert task: %w", err)
 			}
@@ -177,7 +190,8 @@ func (r *Reporter) upsertTask(ctx context.Context, task *types.Task, sources []t
 				}
 			}
 
-			if status == types.TaskStatusProcessing {
+			switch {
+			case status == types.TaskStatusProcessing:
 				err = r.TaskRepository.SetRunAgain(ctx, task.Key, true)
 				if err != nil {
 					return fmt.Errorf("failed to set task %s to run again: %w", task.Key, err)
@@ -186,7 +200,15 @@ func (r *Reporter) upsertTask(ctx context.Context, task *types.Task, sources []t
 				if err != nil {
 					log.Ctx(ctx).Error().Msgf("failed to log task event for task %s: %v", task.Key, err)
 				}
-			} else {
+			case status == types.TaskStatusPending && !created:
+				// the queued run hasn't started yet and claims the new sources when it does. The task is
+				// enqueued again in case its event got lost, a duplicate event skips the task once it's done.
+				err = r.TaskEventRepository.LogTaskEvent(ctx, task.Key, "coalesced", task.Payload)
+				if err != nil {
+					log.Ctx(ctx).Error().Msgf("failed to log task event for task %s: %v", task.Key, err)
+				}
+				shouldEnqueue = true
+			default:
 				err = r.TaskRepository.UpdateStatus(ctx, task.Key, types.TaskStatusPending)
 				if err != nil {
 					return fmt.Errorf("failed to update task %s status to pending: %w", task.Key, err)
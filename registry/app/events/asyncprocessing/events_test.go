@@ -0,0 +1,178 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asyncprocessing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTx struct{}
+
+func (fakeTx) WithTx(ctx context.Context, txFn func(ctx context.Context) error, _ ...any) error {
+	return txFn(ctx)
+}
+
+type fakeTaskRepository struct {
+	store.TaskRepository
+	tasks    map[string]*types.Task
+	runAgain map[string]bool
+}
+
+func (r *fakeTaskRepository) Find(_ context.Context, key string) (*types.Task, error) {
+	task, ok := r.tasks[key]
+	if !ok {
+		return nil, fmt.Errorf("failed to find task with key %s: %w", key, sql.ErrNoRows)
+	}
+	return task, nil
+}
+
+func (r *fakeTaskRepository) UpsertTask(_ context.Context, task *types.Task) error {
+	if _, ok := r.tasks[task.Key]; !ok {
+		r.tasks[task.Key] = &types.Task{Key: task.Key, Kind: task.Kind, Payload: task.Payload,
+			Status: types.TaskStatusPending}
+	}
+	return nil
+}
+
+func (r *fakeTaskRepository) LockForUpdate(_ context.Context, task *types.Task) (types.TaskStatus, error) {
+	return r.tasks[task.Key].Status, nil
+}
+
+func (r *fakeTaskRepository) SetRunAgain(_ context.Context, taskKey string, runAgain bool) error {
+	r.runAgain[taskKey] = runAgain
+	return nil
+}
+
+func (r *fakeTaskRepository) UpdateStatus(_ context.Context, taskKey string, status types.TaskStatus) error {
+	r.tasks[taskKey].Status = status
+	return nil
+}
+
+type fakeTaskSourceRepository struct {
+	store.TaskSourceRepository
+	sources []types.SourceRef
+}
+
+func (r *fakeTaskSourceRepository) InsertSource(_ context.Context, _ string, source types.SourceRef) error {
+	r.sources = append(r.sources, source)
+	return nil
+}
+
+type fakeTaskEventRepository struct {
+	events []string
+}
+
+func (r *fakeTaskEventRepository) LogTaskEvent(_ context.Context, _ string, event string, _ []byte) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+type fakeProducer struct {
+	sent int
+}
+
+func (p *fakeProducer) Send(context.Context, string, map[string]any) (string, error) {
+	p.sent++
+	return fmt.Sprintf("event-%d", p.sent), nil
+}
+
+func newTestReporter(t *testing.T) (
+	*Reporter, *fakeTaskRepository, *fakeTaskSourceRepository, *fakeTaskEventRepository, *fakeProducer,
+) {
+	producer := &fakeProducer{}
+	system, err := events.NewSystem(func(string, string) (events.StreamConsumer, error) {
+		return nil, fmt.Errorf("no consumers in tests")
+	}, producer)
+	require.NoError(t, err)
+	innerReporter, err := events.NewReporter(system, RegistryAsyncProcessing)
+	require.NoError(t, err)
+
+	taskRepository := &fakeTaskRepository{tasks: map[string]*types.Task{}, runAgain: map[string]bool{}}
+	taskSourceRepository := &fakeTaskSourceRepository{}
+	taskEventRepository := &fakeTaskEventRepository{}
+	reporter := &Reporter{
+		tx:                   fakeTx{},
+		innerReporter:        innerReporter,
+		TaskRepository:       taskRepository,
+		TaskSourceRepository: taskSourceRepository,
+		TaskEventRepository:  taskEventRepository,
+	}
+	return reporter, taskRepository, taskSourceRepository, taskEventRepository, producer
+}
+
+func TestUpsertAndSendEvent(t *testing.T) {
+	ctx := context.Background()
+	source := types.SourceRef{Type: types.SourceTypeRegistry, ID: 1}
+
+	t.Run("enqueues a new task", func(t *testing.T) {
+		reporter, tasks, sources, taskEvents, producer := newTestReporter(t)
+
+		require.NoError(t, reporter.UpsertAndSendEvent(ctx, &types.Task{Key: "registry_1"},
+			[]types.SourceRef{source}))
+
+		assert.Equal(t, types.TaskStatusPending, tasks.tasks["registry_1"].Status)
+		assert.Equal(t, []types.SourceRef{source}, sources.sources)
+		assert.Equal(t, []string{"enqueued"}, taskEvents.events)
+		assert.Equal(t, 1, producer.sent)
+	})
+
+	t.Run("re-enqueues a pending task it merged into", func(t *testing.T) {
+		reporter, tasks, sources, taskEvents, producer := newTestReporter(t)
+		tasks.tasks["registry_1"] = &types.Task{Key: "registry_1", Status: types.TaskStatusPending}
+
+		require.NoError(t, reporter.UpsertAndSendEvent(ctx, &types.Task{Key: "registry_1"},
+			[]types.SourceRef{source}))
+
+		assert.Equal(t, types.TaskStatusPending, tasks.tasks["registry_1"].Status)
+		assert.Equal(t, []types.SourceRef{source}, sources.sources)
+		assert.Equal(t, []string{"coalesced"}, taskEvents.events)
+		assert.Equal(t, 1, producer.sent, "the event of the pending task may be lost")
+	})
+
+	t.Run("asks a processing task to run again", func(t *testing.T) {
+		reporter, tasks, _, taskEvents, producer := newTestReporter(t)
+		tasks.tasks["registry_1"] = &types.Task{Key: "registry_1", Status: types.TaskStatusProcessing}
+
+		require.NoError(t, reporter.UpsertAndSendEvent(ctx, &types.Task{Key: "registry_1"},
+			[]types.SourceRef{source}))
+
+		assert.True(t, tasks.runAgain["registry_1"])
+		assert.Equal(t, types.TaskStatusProcessing, tasks.tasks["registry_1"].Status)
+		assert.Equal(t, []string{"merged"}, taskEvents.events)
+		assert.Zero(t, producer.sent, "the running task enqueues itself once it's done")
+	})
+
+	t.Run("enqueues a completed task", func(t *testing.T) {
+		reporter, tasks, _, taskEvents, producer := newTestReporter(t)
+		tasks.tasks["registry_1"] = &types.Task{Key: "registry_1", Status: types.TaskStatusSuccess}
+
+		require.NoError(t, reporter.UpsertAndSendEvent(ctx, &types.Task{Key: "registry_1"},
+			[]types.SourceRef{source}))
+
+		assert.Equal(t, types.TaskStatusPending, tasks.tasks["registry_1"].Status)
+		assert.Equal(t, []string{"enqueued"}, taskEvents.events)
+		assert.Equal(t, 1, producer.sent)
+	})
+}
@@ -22,22 +22,28 @@ import (
 	"github.com/harness/gitness/registry/app/api/interfaces"
 	artifactapi "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/factory"
+	"github.com/harness/gitness/registry/app/pkg/plugin"
 	"github.com/harness/gitness/registry/app/services/refcache"
 	"github.com/harness/gitness/registry/types"
 )
 
+// packageWrapper dispatches to the helper of a built-in package type, and to the registered plugin of the
+// package type for the operations plugins support.
 type packageWrapper struct {
 	packageFactory factory.PackageFactory
 	regFinder      refcache.RegistryFinder
+	registryHelper interfaces.RegistryHelper
 }
 
 func NewPackageWrapper(
 	packageFactory factory.PackageFactory,
 	regFinder refcache.RegistryFinder,
+	registryHelper interfaces.RegistryHelper,
 ) interfaces.PackageWrapper {
 	return &packageWrapper{
 		packageFactory: packageFactory,
 		regFinder:      regFinder,
+		registryHelper: registryHelper,
 	}
 }
 
@@ -49,7 +55,20 @@ func (p *packageWrapper) IsValidPackageType(packageType string) bool {
 	if packageType == "" {
 		return true
 	}
-	return p.packageFactory.IsValidPackageType(packageType)
+	if p.packageFactory.IsValidPackageType(packageType) {
+		return true
+	}
+	_, ok := plugin.Get(packageType)
+	return ok
+}
+
+// isPlugin returns whether the package type is served by a plugin rather than a built-in helper.
+func (p *packageWrapper) isPlugin(packageType string) bool {
+	if p.GetPackage(packageType) != nil {
+		return false
+	}
+	_, ok := plugin.Get(packageType)
+	return ok
 }
 
 func (p *packageWrapper) IsValidPackageTypes(packageTypes []string) bool {
@@ -86,7 +105,8 @@ func (p *packageWrapper) IsValidRepoTypes(repoTypes []string) bool {
 func (p *packageWrapper) ValidateRepoType(packageType string, repoType string) bool {
 	pkg := p.GetPackage(packageType)
 	if pkg == nil {
-		return false
+		// plugins have no upstream adapters, so their registries can only be local.
+		return p.isPlugin(packageType) && repoType == string(artifactapi.RegistryTypeVIRTUAL)
 	}
 	return pkg.IsValidRepoType(repoType)
 }
@@ -136,6 +156,9 @@ func (p *packageWrapper) GetPackageTypeFromPathPackageType(pathPackageType strin
 			return pkgType, nil
 		}
 	}
+	if pl, ok := plugin.GetByPathPackageType(pathPackageType); ok && p.isPlugin(pl.PackageType()) {
+		return pl.PackageType(), nil
+	}
 	return "", fmt.Errorf("unsupported path package type: %s", pathPackageType)
 }
 
@@ -146,12 +169,18 @@ func (p *packageWrapper) DeleteArtifactVersion(
 	artifactName string,
 	versionName string,
 ) error {
-	pkg := p.GetPackage(string(regInfo.PackageType))
-	if pkg == nil {
-		return fmt.Errorf("unsupported package type: %s", regInfo.PackageType)
-	}
-	if err := pkg.DeleteVersion(ctx, regInfo, imageInfo, artifactName, versionName); err != nil {
-		return fmt.Errorf("failed to delete version: %w", err)
+	if p.isPlugin(string(regInfo.PackageType)) {
+		if err := p.deleteWithPlugin(ctx, regInfo, artifactName, versionName); err != nil {
+			return fmt.Errorf("failed to delete version: %w", err)
+		}
+	} else {
+		pkg := p.GetPackage(string(regInfo.PackageType))
+		if pkg == nil {
+			return fmt.Errorf("unsupported package type: %s", regInfo.PackageType)
+		}
+		if err := pkg.DeleteVersion(ctx, regInfo, imageInfo, artifactName, versionName); err != nil {
+			return fmt.Errorf("failed to delete version: %w", err)
+		}
 	}
 	if err := p.ReportDeleteVersionEvent(ctx, regInfo.RegistryID, artifactName, versionName); err != nil {
 		return fmt.Errorf("failed to report delete version event: %w", err)
@@ -179,6 +208,10 @@ func (p *packageWrapper) ReportDeleteVersionEvent(
 	if err != nil {
 		return fmt.Errorf("failed to find registry: %w", err)
 	}
+	if p.isPlugin(string(registry.PackageType)) {
+		// the artifact events are only reported for built-in package types.
+		return nil
+	}
 	pkg := p.GetPackage(string(registry.PackageType))
 	if pkg == nil {
 		return fmt.Errorf("unsupported package type: %s", registry.PackageType)
@@ -196,6 +229,11 @@ func (p *packageWrapper) ReportBuildPackageIndexEvent(
 	if err != nil {
 		return fmt.Errorf("failed to find registry: %w", err)
 	}
+	if p.isPlugin(string(registry.PackageType)) {
+		// the index is rebuilt by the plugin once the task is processed, see BuildPackageIndexAsync.
+		p.registryHelper.ReportBuildPackageIndexEvent(ctx, registry.ID, artifactName)
+		return nil
+	}
 	pkg := p.GetPackage(string(registry.PackageType))
 	if pkg == nil {
 		return fmt.Errorf("unsupported package type: %s", registry.PackageType)
@@ -213,6 +251,10 @@ func (p *packageWrapper) ReportBuildRegistryIndexEvent(
 	if err != nil {
 		return fmt.Errorf("failed to find registry: %w", err)
 	}
+	if p.isPlugin(string(registry.PackageType)) {
+		p.registryHelper.ReportBuildRegistryIndexEvent(ctx, registry.ID, sourceRefs)
+		return nil
+	}
 	pkg := p.GetPackage(string(registry.PackageType))
 	if pkg == nil {
 		return fmt.Errorf("unsupported package type: %s", registry.PackageType)
@@ -226,12 +268,18 @@ func (p *packageWrapper) DeleteArtifact(
 	regInfo *types.RegistryRequestBaseInfo,
 	artifactName string,
 ) error {
-	pkg := p.GetPackage(string(regInfo.PackageType))
-	if pkg == nil {
-		return fmt.Errorf("unsupported package type: %s", regInfo.PackageType)
-	}
-	if err := pkg.DeleteArtifact(ctx, regInfo, artifactName); err != nil {
-		return fmt.Errorf("failed to delete artifact: %w", err)
+	if p.isPlugin(string(regInfo.PackageType)) {
+		if err := p.deleteWithPlugin(ctx, regInfo, artifactName, ""); err != nil {
+			return fmt.Errorf("failed to delete artifact: %w", err)
+		}
+	} else {
+		pkg := p.GetPackage(string(regInfo.PackageType))
+		if pkg == nil {
+			return fmt.Errorf("unsupported package type: %s", regInfo.PackageType)
+		}
+		if err := pkg.DeleteArtifact(ctx, regInfo, artifactName); err != nil {
+			return fmt.Errorf("failed to delete artifact: %w", err)
+		}
 	}
 	if err := p.ReportBuildRegistryIndexEvent(ctx, regInfo.RegistryID, make([]types.SourceRef, 0)); err != nil {
 		return fmt.Errorf("failed to report build registry index event: %w", err)
@@ -239,6 +287,24 @@ func (p *packageWrapper) DeleteArtifact(
 	return nil
 }
 
+func (p *packageWrapper) deleteWithPlugin(
+	ctx context.Context,
+	regInfo *types.RegistryRequestBaseInfo,
+	artifactName string,
+	versionName string,
+) error {
+	registry, err := p.regFinder.FindByID(ctx, regInfo.RegistryID)
+	if err != nil {
+		return fmt.Errorf("failed to find registry: %w", err)
+	}
+	return plugin.Delete(ctx, string(regInfo.PackageType), plugin.Target{
+		Registry:       registry,
+		RootIdentifier: regInfo.RootIdentifier,
+		Artifact:       artifactName,
+		Version:        versionName,
+	})
+}
+
 func (p *packageWrapper) GetFilePath(
 	packageType string,
 	artifactName string,
@@ -345,6 +411,9 @@ func (p *packageWrapper) BuildRegistryIndexAsync(
 	if err != nil {
 		return fmt.Errorf("failed to find registry: %w", err)
 	}
+	if p.isPlugin(string(registry.PackageType)) {
+		return plugin.Reindex(ctx, string(registry.PackageType), registry, "")
+	}
 	pkg := p.GetPackage(string(registry.PackageType))
 	if pkg == nil {
 		return fmt.Errorf("unsupported package type: %s", registry.PackageType)
@@ -360,6 +429,9 @@ func (p *packageWrapper) BuildPackageIndexAsync(
 	if err != nil {
 		return fmt.Errorf("failed to find registry: %w", err)
 	}
+	if p.isPlugin(string(registry.PackageType)) {
+		return plugin.Reindex(ctx, string(registry.PackageType), registry, payload.Image)
+	}
 	pkg := p.GetPackage(string(registry.PackageType))
 	if pkg == nil {
 		return fmt.Errorf("unsupported package type: %s", registry.PackageType)
@@ -375,6 +447,10 @@ func (p *packageWrapper) BuildPackageMetadataAsync(
 	if err != nil {
 		return fmt.Errorf("failed to find registry: %w", err)
 	}
+	if p.isPlugin(string(registry.PackageType)) {
+		// plugins keep the metadata of their packages up to date when they are uploaded.
+		return nil
+	}
 	pkg := p.GetPackage(string(registry.PackageType))
 	if pkg == nil {
 		return fmt.Errorf("unsupported package type: %s", registry.PackageType)
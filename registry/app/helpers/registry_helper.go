@@ -143,7 +143,7 @@ func (r *registryHelper) ReportBuildPackageIndexEvent(
 	ctx context.Context, registryID int64, artifactName string,
 ) {
 	if r.PostProcessingReporter != nil {
-		r.PostProcessingReporter.BuildPackageIndex(ctx, registryID, artifactName)
+		r.PostProcessingReporter.BuildPackageIndex(ctx, registryID, artifactName, make([]types.SourceRef, 0))
 	}
 }
 
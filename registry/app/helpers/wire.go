@@ -50,7 +50,7 @@ func ProvidePackageWrapperProvider(
 	packageFactory.Register(pkg.NewGoPackageType(registryHelper))
 	packageFactory.Register(pkg.NewHuggingFacePackageType(registryHelper))
 
-	return NewPackageWrapper(packageFactory, regFinder)
+	return NewPackageWrapper(packageFactory, regFinder, registryHelper)
 }
 
 func ProvideRegistryHelper(
@@ -78,5 +78,4 @@ func ProvideRegistryHelper(
 var WireSet = wire.NewSet(
 	ProvidePackageWrapperProvider,
 	ProvideRegistryHelper,
-	NewNoopDependencyFirewallChecker,
 )
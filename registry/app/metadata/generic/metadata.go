@@ -25,6 +25,15 @@ type GenericMetadata struct {
 	Files     []metadata.File `json:"files"`
 	FileCount int64           `json:"file_count"`
 	Size      int64           `json:"size"`
+	// Source is set for artifacts published from a repository.
+	Source *Source `json:"source,omitempty"`
+}
+
+// Source links an artifact to the commit of the repository it was published from.
+type Source struct {
+	RepoPath  string `json:"repo_path"`
+	Ref       string `json:"ref"`
+	CommitSHA string `json:"commit_sha"`
 }
 
 func (p *GenericMetadata) GetFiles() []metadata.File {
@@ -45,6 +45,9 @@ type VersionMetadataDB struct {
 	Files     []metadata.File `json:"files"`
 	FileCount int64           `json:"file_count"`
 	Size      int64           `json:"size"`
+	// ZipHash and ModHash are the h1 dirhashes of the module zip and go.mod files, as listed in go.sum.
+	ZipHash string `json:"zip_hash,omitempty"`
+	ModHash string `json:"mod_hash,omitempty"`
 }
 
 func (p *VersionMetadataDB) GetFiles() []metadata.File {
@@ -0,0 +1,48 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+type ProvenanceStatus string
+
+const (
+	ProvenanceStatusMissing    ProvenanceStatus = "missing"
+	ProvenanceStatusUnverified ProvenanceStatus = "unverified"
+	ProvenanceStatusVerified   ProvenanceStatus = "verified"
+)
+
+// Metadata is stored with the artifact of a Helm chart pushed as an OCI artifact.
+type Metadata struct {
+	Name         string       `json:"name"`
+	Version      string       `json:"version"`
+	APIVersion   string       `json:"api_version,omitempty"`
+	AppVersion   string       `json:"app_version,omitempty"`
+	Dependencies []Dependency `json:"dependencies,omitempty"`
+	Provenance   Provenance   `json:"provenance"`
+}
+
+// Dependency is a chart dependency declared in Chart.yaml, with the version pinned by Chart.lock if any.
+type Dependency struct {
+	Name            string `json:"name"`
+	Alias           string `json:"alias,omitempty"`
+	Version         string `json:"version,omitempty"`
+	Repository      string `json:"repository,omitempty"`
+	ResolvedVersion string `json:"resolved_version,omitempty"`
+}
+
+type Provenance struct {
+	Status ProvenanceStatus `json:"status"`
+	KeyID  string           `json:"key_id,omitempty"`
+	Signer string           `json:"signer,omitempty"`
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// FieldChange is the change of a top level field of the artifact metadata, Old or New is null when the
+// field was added or removed.
+type FieldChange struct {
+	Old json.RawMessage `json:"old"`
+	New json.RawMessage `json:"new"`
+}
+
+// Diff returns the top level fields which differ between two revisions of the raw artifact metadata,
+// keyed by field. It returns nil when nothing changed.
+func Diff(oldRaw json.RawMessage, newRaw json.RawMessage) (json.RawMessage, error) {
+	oldFields, err := metadataFields(oldRaw)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := metadataFields(newRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(oldFields)+len(newFields))
+	for k := range oldFields {
+		keys = append(keys, k)
+	}
+	for k := range newFields {
+		if _, ok := oldFields[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	changes := map[string]FieldChange{}
+	for _, k := range keys {
+		oldValue, err := canonical(oldFields[k])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read old value of %q: %w", k, err)
+		}
+		newValue, err := canonical(newFields[k])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read new value of %q: %w", k, err)
+		}
+		if !bytes.Equal(oldValue, newValue) {
+			changes[k] = FieldChange{Old: oldValue, New: newValue}
+		}
+	}
+	if len(changes) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	return json.Marshal(changes)
+}
+
+// canonical re-encodes a json value with sorted object keys, so values which only differ in formatting
+// or key order compare equal. A missing value is returned as null.
+func canonical(raw json.RawMessage) (json.RawMessage, error) {
+	if len(raw) == 0 {
+		return json.RawMessage("null"), nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
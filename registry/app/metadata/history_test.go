@@ -0,0 +1,46 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	diff, err := Diff(nil, json.RawMessage(`{"size":10,"files":[]}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"files":{"old":null,"new":[]},"size":{"old":null,"new":10}}`, string(diff))
+
+	diff, err = Diff(
+		json.RawMessage(`{"size":10,"scan_status":{"outcome":"pending","requested_by":1},"name":"a"}`),
+		json.RawMessage(`{"name":"a","scan_status":{"requested_by":1,"outcome":"passed"}}`),
+	)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"scan_status":{"old":{"outcome":"pending","requested_by":1},"new":{"outcome":"passed","requested_by":1}},
+		"size":{"old":10,"new":null}
+	}`, string(diff))
+
+	diff, err = Diff(json.RawMessage(`{"a": {"x":1, "y":2}}`), json.RawMessage(`{"a":{"y":2,"x":1}}`))
+	require.NoError(t, err)
+	assert.Nil(t, diff)
+
+	_, err = Diff(json.RawMessage(`[]`), nil)
+	assert.Error(t, err)
+}
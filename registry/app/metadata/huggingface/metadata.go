@@ -16,11 +16,22 @@ package huggingface
 
 import "github.com/harness/gitness/registry/app/metadata"
 
-// CardData represents the card data for a HuggingFace model.
+// CardData represents the card data for a HuggingFace model or dataset.
 type CardData struct {
-	Language []string `json:"language,omitempty"`
-	Tags     []string `json:"tags,omitempty"`
-	License  string   `json:"license,omitempty"`
+	Language       []string        `json:"language,omitempty"`
+	Tags           []string        `json:"tags,omitempty"`
+	License        string          `json:"license,omitempty"`
+	PrettyName     string          `json:"pretty_name,omitempty"`
+	TaskCategories []string        `json:"task_categories,omitempty"`
+	SizeCategories []string        `json:"size_categories,omitempty"`
+	Configs        []DatasetConfig `json:"configs,omitempty"`
+}
+
+// DatasetConfig represents a dataset configuration declared in the dataset card.
+type DatasetConfig struct {
+	ConfigName string `json:"config_name"`
+	DataFiles  any    `json:"data_files,omitempty"`
+	Default    bool   `json:"default,omitempty"`
 }
 
 // Sibling represents a file in a HuggingFace model.
@@ -22,9 +22,18 @@ type GenericMetadata struct {
 }
 
 type MavenMetadata struct {
-	Files     []File `json:"files"`
-	FileCount int64  `json:"file_count"`
-	Size      int64  `json:"size"`
+	Files     []File          `json:"files"`
+	FileCount int64           `json:"file_count"`
+	Size      int64           `json:"size"`
+	Variants  []GradleVariant `json:"variants,omitempty"`
+}
+
+// GradleVariant is the summary of a variant declared in the Gradle module metadata (.module) of a version.
+type GradleVariant struct {
+	Name         string            `json:"name"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+	Files        []string          `json:"files,omitempty"`
+	Dependencies []string          `json:"dependencies,omitempty"`
 }
 
 func (m *MavenMetadata) GetFiles() []File {
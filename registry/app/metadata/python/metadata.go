@@ -86,6 +86,8 @@ type PythonMetadata struct {
 	Files     []metadata.File `json:"files"`
 	FileCount int64           `json:"file_count"`
 	Size      int64           `json:"size"`
+	// CoreMetadata maps distribution filenames to the sha256 of their PEP 658 core metadata file.
+	CoreMetadata map[string]string `json:"core_metadata,omitempty"`
 }
 
 func (p *PythonMetadata) GetFiles() []metadata.File {
@@ -0,0 +1,81 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const scanStatusKey = "scan_status"
+
+// ScanOutcome is the result of the latest vulnerability scan of an artifact.
+type ScanOutcome string
+
+const (
+	ScanOutcomePending ScanOutcome = "pending"
+	ScanOutcomePassed  ScanOutcome = "passed"
+	ScanOutcomeFailed  ScanOutcome = "failed"
+	ScanOutcomeError   ScanOutcome = "error"
+)
+
+// ScanStatus tracks the vulnerability scans of an artifact. It is stored next to the
+// package specific fields of the artifact metadata.
+type ScanStatus struct {
+	LastRequestedAt int64       `json:"last_requested_at,omitempty"`
+	RequestedBy     int64       `json:"requested_by,omitempty"`
+	LastScannedAt   int64       `json:"last_scanned_at,omitempty"`
+	Outcome         ScanOutcome `json:"outcome,omitempty"`
+}
+
+// GetScanStatus reads the scan status from the raw artifact metadata.
+func GetScanStatus(raw json.RawMessage) (ScanStatus, error) {
+	var status ScanStatus
+	fields, err := metadataFields(raw)
+	if err != nil {
+		return status, err
+	}
+	if value, ok := fields[scanStatusKey]; ok {
+		if err = json.Unmarshal(value, &status); err != nil {
+			return status, fmt.Errorf("failed to unmarshal scan status: %w", err)
+		}
+	}
+	return status, nil
+}
+
+// SetScanStatus stores the scan status in the raw artifact metadata, keeping all other fields.
+func SetScanStatus(raw json.RawMessage, status ScanStatus) (json.RawMessage, error) {
+	fields, err := metadataFields(raw)
+	if err != nil {
+		return nil, err
+	}
+	value, err := json.Marshal(status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal scan status: %w", err)
+	}
+	fields[scanStatusKey] = value
+	return json.Marshal(fields)
+}
+
+func metadataFields(raw json.RawMessage) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if len(raw) == 0 || string(raw) == "null" {
+		return fields, nil
+	}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal artifact metadata: %w", err)
+	}
+	return fields, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanStatus(t *testing.T) {
+	status, err := GetScanStatus(nil)
+	require.NoError(t, err)
+	assert.Equal(t, ScanStatus{}, status)
+
+	raw := json.RawMessage(`{"name":"chart","dependencies":[]}`)
+	raw, err = SetScanStatus(raw, ScanStatus{LastRequestedAt: 10, Outcome: ScanOutcomePending})
+	require.NoError(t, err)
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(raw, &fields))
+	assert.Equal(t, "chart", fields["name"])
+
+	status, err = GetScanStatus(raw)
+	require.NoError(t, err)
+	assert.Equal(t, ScanStatus{LastRequestedAt: 10, Outcome: ScanOutcomePending}, status)
+
+	_, err = GetScanStatus(json.RawMessage(`[]`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,216 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxSearchFieldLength caps the text indexed per field, long descriptions like the readme of a python package
+// are cut.
+const maxSearchFieldLength = 32 * 1024
+
+// SearchFields are the parts of the artifact metadata which are indexed for search.
+type SearchFields struct {
+	Description  string
+	Keywords     string
+	Authors      string
+	Dependencies string
+}
+
+// The package types don't share a metadata layout, the fields are found by the keys the formats use for them at
+// any depth of the metadata.
+var (
+	descriptionKeys = map[string]bool{"description": true, "summary": true, "desc": true}
+	keywordKeys     = map[string]bool{"keywords": true, "tags": true}
+	authorKeys      = map[string]bool{"author": true, "authors": true, "maintainer": true, "maintainers": true}
+	dependencyKeys  = map[string]bool{"dependencies": true, "deps": true, "requires_dist": true}
+	// skippedKeys hold lists of files or data which don't describe the package.
+	skippedKeys = map[string]bool{"files": true}
+)
+
+type searchCollector struct {
+	descriptions []string
+	keywords     []string
+	authors      []string
+	dependencies []string
+}
+
+// ExtractSearchFields returns the description, keywords, authors and dependencies found in the raw artifact
+// metadata. Values found more than once, like the dependencies shared by the versions of an npm package, are kept
+// once.
+func ExtractSearchFields(raw json.RawMessage) (SearchFields, error) {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return SearchFields{}, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return SearchFields{}, fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	c := &searchCollector{}
+	c.visit(v)
+	return SearchFields{
+		Description:  joinSearchValues(c.descriptions, "\n"),
+		Keywords:     joinSearchValues(c.keywords, " "),
+		Authors:      joinSearchValues(c.authors, " "),
+		Dependencies: joinSearchValues(c.dependencies, " "),
+	}, nil
+}
+
+func (c *searchCollector) visit(v any) {
+	switch value := v.(type) {
+	case map[string]any:
+		for _, k := range sortedKeys(value) {
+			key := strings.ToLower(k)
+			switch {
+			case skippedKeys[key]:
+			case descriptionKeys[key]:
+				c.descriptions = appendStrings(c.descriptions, value[k])
+			case keywordKeys[key]:
+				c.keywords = appendStrings(c.keywords, value[k])
+			case authorKeys[key]:
+				c.authors = appendNames(c.authors, value[k])
+			case dependencyKeys[key]:
+				c.dependencies = appendNames(c.dependencies, value[k])
+			default:
+				c.visit(value[k])
+			}
+		}
+	case []any:
+		for _, item := range value {
+			c.visit(item)
+		}
+	}
+}
+
+// appendStrings appends the string or the strings of the list v.
+func appendStrings(dst []string, v any) []string {
+	switch value := v.(type) {
+	case string:
+		return append(dst, value)
+	case []any:
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				dst = append(dst, s)
+			}
+		}
+	}
+	return dst
+}
+
+// appendNames appends the names of the people or packages listed by v. They're strings, objects with a name or
+// id, like npm authors and nuget dependencies, or maps keyed by name like npm dependencies.
+func appendNames(dst []string, v any) []string {
+	switch value := v.(type) {
+	case string:
+		return append(dst, value)
+	case []any:
+		for _, item := range value {
+			dst = appendNames(dst, item)
+		}
+	case map[string]any:
+		for _, key := range []string{"name", "id", "username"} {
+			if name, ok := value[key].(string); ok && name != "" {
+				return append(dst, name)
+			}
+		}
+		if allStrings(value) {
+			return append(dst, sortedKeys(value)...)
+		}
+		// groups of dependencies, like the dependencies of a nuget package per target framework.
+		for _, k := range sortedKeys(value) {
+			switch value[k].(type) {
+			case []any, map[string]any:
+				dst = appendNames(dst, value[k])
+			}
+		}
+	}
+	return dst
+}
+
+func allStrings(m map[string]any) bool {
+	for _, v := range m {
+		if _, ok := v.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// joinSearchValues joins the distinct non-empty values, cut to maxSearchFieldLength.
+func joinSearchValues(values []string, sep string) string {
+	seen := make(map[string]bool, len(values))
+	var b strings.Builder
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		if b.Len() > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(v)
+		if b.Len() >= maxSearchFieldLength {
+			break
+		}
+	}
+	return truncateUTF8(b.String(), maxSearchFieldLength)
+}
+
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// SearchTerms splits a search query into lowercase words the way the documents are split when they're indexed,
+// punctuation separates words so "left-pad" searches "left" and "pad".
+func SearchTerms(query string) []string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := make([]string, 0, len(words))
+	seen := make(map[string]bool, len(words))
+	for _, w := range words {
+		if !seen[w] {
+			seen[w] = true
+			terms = append(terms, w)
+		}
+	}
+	return terms
+}
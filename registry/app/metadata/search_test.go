@@ -0,0 +1,91 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractSearchFields(t *testing.T) {
+	// npm
+	fields, err := ExtractSearchFields(json.RawMessage(`{
+		"name": "left-pad",
+		"description": "String left pad",
+		"keywords": ["pad", "string"],
+		"author": {"name": "azer", "email": "azer@example.com"},
+		"versions": {
+			"1.0.0": {"description": "String left pad", "dependencies": {"lodash": "^4.0.0", "chalk": "^2.0.0"}}
+		},
+		"files": [{"name": "left-pad-1.0.0.tgz", "description": "ignored"}]
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, SearchFields{
+		Description:  "String left pad",
+		Keywords:     "pad string",
+		Authors:      "azer",
+		Dependencies: "chalk lodash",
+	}, fields)
+
+	// python
+	fields, err = ExtractSearchFields(json.RawMessage(`{
+		"metadata": {
+			"summary": "HTTP for humans",
+			"author": "Kenneth Reitz",
+			"maintainer": "PSF",
+			"requires_dist": ["charset-normalizer (<4,>=2)", "idna"]
+		}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, SearchFields{
+		Description:  "HTTP for humans",
+		Authors:      "Kenneth Reitz PSF",
+		Dependencies: "charset-normalizer (<4,>=2) idna",
+	}, fields)
+
+	// nuget
+	fields, err = ExtractSearchFields(json.RawMessage(`{"package_metadata": {"dependencies": {
+		"groups": [{"targetFramework": "net8.0", "dependencies": [{"id": "Newtonsoft.Json", "version": "13.0.1"}]}]
+	}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "Newtonsoft.Json", fields.Dependencies)
+
+	fields, err = ExtractSearchFields(nil)
+	require.NoError(t, err)
+	assert.Equal(t, SearchFields{}, fields)
+
+	_, err = ExtractSearchFields(json.RawMessage(`{`))
+	assert.Error(t, err)
+}
+
+func TestExtractSearchFieldsTruncates(t *testing.T) {
+	long := strings.Repeat("é", maxSearchFieldLength)
+	raw, err := json.Marshal(map[string]string{"description": long})
+	require.NoError(t, err)
+
+	fields, err := ExtractSearchFields(raw)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(fields.Description), maxSearchFieldLength)
+	assert.True(t, strings.HasPrefix(long, fields.Description))
+}
+
+func TestSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"left", "pad", "v2"}, SearchTerms("Left-Pad  left v2!"))
+	assert.Empty(t, SearchTerms(" -- "))
+}
@@ -77,6 +77,7 @@ func LogArtifactUpload(
 		audit.WithData(
 			AuditKeyImageUUID, imageUUID,
 		),
+		audit.WithActorChain(audit.ActorChain(ctx, session.Principal)),
 	}
 
 	err = auditService.Log(
@@ -150,6 +151,7 @@ func LogArtifactDownload(
 		),
 		audit.ActionDownloaded,
 		parentSpace.Path,
+		audit.WithActorChain(audit.ActorChain(ctx, session.Principal)),
 	)
 	if err != nil {
 		log.Ctx(ctx).Warn().Err(err).Msgf(
@@ -41,6 +41,8 @@ import (
 	registryrefcache "github.com/harness/gitness/registry/app/services/refcache"
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/utils/validation"
+	"github.com/harness/gitness/registry/services/denylist"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/types/enum"
@@ -72,6 +74,17 @@ type LocalBase interface {
 		file io.ReadCloser,
 		metadata metadata.Metadata,
 	) (*commons.ResponseHeaders, string, error)
+	// UploadByDigest creates the file from the blob with the sha256 already stored in the registry, without
+	// the content being uploaded again. It returns false when the blob isn't stored, the file must be uploaded then.
+	UploadByDigest(
+		ctx context.Context,
+		info pkg.ArtifactInfo,
+		fileName,
+		version,
+		path string,
+		sha256 string,
+		metadata metadata.Metadata,
+	) (*commons.ResponseHeaders, string, bool, error)
 	UpdateFileManagerAndCreateArtifact(
 		ctx context.Context,
 		info pkg.ArtifactInfo,
@@ -133,6 +146,7 @@ type localBase struct {
 	authorizer     authz.Authorizer
 	spaceFinder    refcache.SpaceFinder
 	auditService   audit.Service
+	denylist       *denylist.Service
 }
 
 func NewLocalBase(
@@ -147,6 +161,7 @@ func NewLocalBase(
 	authorizer authz.Authorizer,
 	spaceFinder refcache.SpaceFinder,
 	auditService audit.Service,
+	denylist *denylist.Service,
 ) LocalBase {
 	return &localBase{
 		registryDao:    registryDao,
@@ -160,6 +175,7 @@ func NewLocalBase(
 		authorizer:     authorizer,
 		spaceFinder:    spaceFinder,
 		auditService:   auditService,
+		denylist:       denylist,
 	}
 }
 
@@ -224,6 +240,9 @@ func (l *localBase) UpdateFileManagerAndCreateArtifact(
 	if err != nil {
 		return responseHeaders, "", 0, false, errcode.ErrCodeUnknown.WithDetail(err)
 	}
+	if err = l.validateUpload(ctx, *registry, info.Image, version, metadata); err != nil {
+		return responseHeaders, "", 0, false, err
+	}
 	session, _ := request.AuthSessionFrom(ctx)
 	err = l.fileManager.PostFileUpload(ctx, path, registry.ID, info.RootParentID,
 		info.RootIdentifier, fileInfo, session.Principal.ID)
@@ -247,6 +266,9 @@ func (l *localBase) MoveMultipleTempFilesAndCreateArtifact(
 	filesInfo *[]types.FileInfo,
 	version string,
 ) error {
+	if err := l.validateUpload(ctx, info.Registry, info.Image, version, metadata); err != nil {
+		return err
+	}
 	session, _ := request.AuthSessionFrom(ctx)
 	for _, fileInfo := range *filesInfo {
 		filePath := path.Join(pathPrefix, fileInfo.Filename)
@@ -397,16 +419,80 @@ func (l *localBase) uploadInternal(
 		Code:    0,
 	}
 
+	registry, err := l.checkUpload(ctx, info, fileName, version, path, metadata)
+	if err != nil {
+		return nil, "", err
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	fileInfo, err := l.fileManager.UploadFile(ctx, path, registry.ID, info.RootParentID, info.RootIdentifier, file,
+		fileReadCloser, session.Principal.ID)
+	if err != nil {
+		return responseHeaders, "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	fileInfo.Filename = fileName
+	_, err = l.postUploadArtifact(ctx, info, registry, version, metadata, fileInfo)
+	if err != nil {
+		return responseHeaders, "", err
+	}
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, fileInfo.Sha256, nil
+}
+
+func (l *localBase) UploadByDigest(
+	ctx context.Context,
+	info pkg.ArtifactInfo,
+	fileName,
+	version,
+	path string,
+	sha256 string,
+	metadata metadata.Metadata,
+) (*commons.ResponseHeaders, string, bool, error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	registry, err := l.checkUpload(ctx, info, fileName, version, path, metadata)
+	if err != nil {
+		return nil, "", false, err
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	fileInfo, found, err := l.fileManager.LinkFile(ctx, path, registry.ID, info.RootParentID, sha256,
+		session.Principal.ID)
+	if err != nil {
+		return responseHeaders, "", false, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if !found {
+		return responseHeaders, "", false, nil
+	}
+	fileInfo.Filename = fileName
+	_, err = l.postUploadArtifact(ctx, info, registry, version, metadata, fileInfo)
+	if err != nil {
+		return responseHeaders, "", false, err
+	}
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, fileInfo.Sha256, true, nil
+}
+
+// checkUpload checks that the file can be uploaded to the registry and returns the registry.
+func (l *localBase) checkUpload(
+	ctx context.Context,
+	info pkg.ArtifactInfo,
+	fileName string,
+	version string,
+	path string,
+	metadata metadata.Metadata,
+) (*types.Registry, error) {
 	err := l.CheckIfFileAlreadyExist(ctx, info, version, metadata, fileName, path)
 
 	if err != nil {
 		if !errors.IsConflict(err) {
-			return nil, "", err
+			return nil, err
 		}
 		err = pkg.GetRegistryCheckAccess(ctx, l.authorizer, l.spaceFinder,
 			info.ParentID, info, enum.PermissionArtifactsDelete)
 		if err != nil {
-			return nil, "", usererror.Forbidden(fmt.Sprintf("Not enough permissions to overwrite file %s "+
+			return nil, usererror.Forbidden(fmt.Sprintf("Not enough permissions to overwrite file %s "+
 				"(needs DELETE permission).",
 				fileName))
 		}
@@ -414,21 +500,31 @@ func (l *localBase) uploadInternal(
 
 	registry, err := l.registryFinder.FindByRootParentID(ctx, info.RootParentID, info.RegIdentifier)
 	if err != nil {
-		return responseHeaders, "", errcode.ErrCodeUnknown.WithDetail(err)
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
 	}
-	session, _ := request.AuthSessionFrom(ctx)
-	fileInfo, err := l.fileManager.UploadFile(ctx, path, registry.ID, info.RootParentID, info.RootIdentifier, file,
-		fileReadCloser, session.Principal.ID)
-	if err != nil {
-		return responseHeaders, "", errcode.ErrCodeUnknown.WithDetail(err)
+	if err = l.validateUpload(ctx, *registry, info.Image, version, metadata); err != nil {
+		return nil, err
 	}
-	fileInfo.Filename = fileName
-	_, err = l.postUploadArtifact(ctx, info, registry, version, metadata, fileInfo)
-	if err != nil {
-		return responseHeaders, "", err
+	return registry, nil
+}
+
+// validateUpload enforces the validation rules of the registry on the version and metadata of an upload, and
+// rejects packages denied by the denylists of its spaces.
+func (l *localBase) validateUpload(
+	ctx context.Context,
+	registry types.Registry,
+	image string,
+	version string,
+	metadata metadata.Metadata,
+) error {
+	rules := validation.GetRules(registry)
+	if err := validation.ValidateVersion(rules, version); err != nil {
+		return err
 	}
-	responseHeaders.Code = http.StatusCreated
-	return responseHeaders, fileInfo.Sha256, nil
+	if err := validation.ValidateMetadata(rules, metadata); err != nil {
+		return err
+	}
+	return l.denylist.CheckUpload(ctx, &registry, image, version)
 }
 
 func (l *localBase) postUploadArtifact(
@@ -708,7 +804,7 @@ func (l *localBase) DeletePackage(ctx context.Context, info pkg.PackageArtifactI
 	err := l.tx.WithTx(
 		ctx, func(ctx context.Context) error {
 			path := "/" + info.BaseArtifactInfo().Image
-			err := l.nodesDao.DeleteByNodePathAndRegistryID(ctx, path, info.BaseArtifactInfo().RegistryID)
+			err := l.fileManager.DeleteFile(ctx, info.BaseArtifactInfo().RegistryID, path)
 
 			if err != nil {
 				return err
@@ -741,8 +837,7 @@ func (l *localBase) DeleteVersion(ctx context.Context, info pkg.PackageArtifactI
 	err := l.tx.WithTx(
 		ctx, func(ctx context.Context) error {
 			path := "/" + info.BaseArtifactInfo().Image + "/" + info.GetVersion()
-			err := l.nodesDao.DeleteByNodePathAndRegistryID(ctx,
-				path, info.BaseArtifactInfo().RegistryID)
+			err := l.fileManager.DeleteFile(ctx, info.BaseArtifactInfo().RegistryID, path)
 
 			if err != nil {
 				return err
@@ -0,0 +1,138 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	gitnesserrors "github.com/harness/gitness/errors"
+	liberrors "github.com/harness/gitness/registry/app/common/lib/errors"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/request"
+)
+
+// maxNotFoundEntries bounds the memory used by the cache of upstream misses.
+const maxNotFoundEntries = 100_000
+
+// upstreamNotFound remembers the paths the upstreams of proxy registries recently didn't find, so that clients
+// probing for versions which don't exist don't reach the upstreams and the database on every request.
+var upstreamNotFound = newNotFoundCache()
+
+// SetUpstreamNotFoundTTL sets how long upstream misses are cached for, 0 disables the cache.
+func SetUpstreamNotFoundTTL(ttl time.Duration) {
+	upstreamNotFound.setTTL(ttl)
+}
+
+type notFoundKey struct {
+	registryID int64
+	path       string
+}
+
+type notFoundCache struct {
+	mx      sync.Mutex
+	ttl     time.Duration
+	entries map[notFoundKey]time.Time
+}
+
+func newNotFoundCache() *notFoundCache {
+	return &notFoundCache{entries: make(map[notFoundKey]time.Time)}
+}
+
+func (c *notFoundCache) setTTL(ttl time.Duration) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.ttl = ttl
+	if ttl <= 0 {
+		c.entries = make(map[notFoundKey]time.Time)
+	}
+}
+
+// has tells whether the path was recently not found in the registry.
+func (c *notFoundCache) has(key notFoundKey, now time.Time) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	expiry, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	if !now.Before(expiry) {
+		delete(c.entries, key)
+		return false
+	}
+	return true
+}
+
+func (c *notFoundCache) add(key notFoundKey, now time.Time) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.ttl <= 0 {
+		return
+	}
+	if len(c.entries) >= maxNotFoundEntries {
+		for k, expiry := range c.entries {
+			if !now.Before(expiry) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxNotFoundEntries {
+			return
+		}
+	}
+	c.entries[key] = now.Add(c.ttl)
+}
+
+func (c *notFoundCache) remove(key notFoundKey) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	delete(c.entries, key)
+}
+
+// upstreamNotFoundKey returns the key of the request in the cache of upstream misses, it returns false
+// when the request path isn't known.
+func upstreamNotFoundKey(ctx context.Context, registryID int64) (notFoundKey, bool) {
+	path, ok := ctx.Value(request.OriginalPathKey).(string)
+	if !ok || path == "" {
+		return notFoundKey{}, false
+	}
+	return notFoundKey{registryID: registryID, path: path}, true
+}
+
+// isNotFound tells whether the error returned by an upstream means the artifact doesn't exist.
+func isNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	if gitnesserrors.IsNotFound(err) || liberrors.IsNotFoundErr(err) {
+		return true
+	}
+	var userErr *usererror.Error
+	if errors.As(err, &userErr) {
+		return userErr.Status == http.StatusNotFound
+	}
+	var commonsErr *commons.Error
+	if errors.As(err, &commonsErr) {
+		return commonsErr.Status == http.StatusNotFound
+	}
+	return false
+}
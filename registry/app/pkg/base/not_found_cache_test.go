@@ -0,0 +1,96 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	gitnesserrors "github.com/harness/gitness/errors"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/request"
+)
+
+func TestNotFoundCache(t *testing.T) {
+	now := time.Now()
+	key := notFoundKey{registryID: 1, path: "/pkg/root/reg/npm/missing"}
+
+	t.Run("disabled cache does not store", func(t *testing.T) {
+		c := newNotFoundCache()
+		c.add(key, now)
+		if c.has(key, now) {
+			t.Error("expected the disabled cache to be empty")
+		}
+	})
+
+	t.Run("entries expire", func(t *testing.T) {
+		c := newNotFoundCache()
+		c.setTTL(time.Minute)
+		c.add(key, now)
+		if !c.has(key, now.Add(30*time.Second)) {
+			t.Error("expected the entry to be cached")
+		}
+		if c.has(notFoundKey{registryID: 2, path: key.path}, now) {
+			t.Error("expected entries to be per registry")
+		}
+		if c.has(key, now.Add(time.Minute)) {
+			t.Error("expected the entry to be expired")
+		}
+	})
+
+	t.Run("entries are removed", func(t *testing.T) {
+		c := newNotFoundCache()
+		c.setTTL(time.Minute)
+		c.add(key, now)
+		c.remove(key)
+		if c.has(key, now) {
+			t.Error("expected the entry to be removed")
+		}
+	})
+}
+
+func TestUpstreamNotFoundKey(t *testing.T) {
+	if _, ok := upstreamNotFoundKey(context.Background(), 1); ok {
+		t.Error("expected no key without the request path")
+	}
+	ctx := request.WithOriginalPath(context.Background(), "/pkg/root/reg/npm/missing")
+	key, ok := upstreamNotFoundKey(ctx, 1)
+	if !ok || key.registryID != 1 || key.path != "/pkg/root/reg/npm/missing" {
+		t.Errorf("unexpected key %v", key)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: nil, want: false},
+		{err: gitnesserrors.NotFoundf("missing"), want: true},
+		{err: usererror.NotFoundf("missing"), want: true},
+		{err: fmt.Errorf("wrapped: %w", usererror.NotFoundf("missing")), want: true},
+		{err: commons.NotFoundError("missing", nil), want: true},
+		{err: usererror.ErrInternal, want: false},
+		{err: fmt.Errorf("connection refused"), want: false},
+	}
+	for _, tt := range tests {
+		if got := isNotFound(tt.err); got != tt.want {
+			t.Errorf("isNotFound(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
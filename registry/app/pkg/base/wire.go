@@ -21,7 +21,9 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	registryrefcache "github.com/harness/gitness/registry/app/services/refcache"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/denylist"
 	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
 
 	"github.com/google/wire"
 )
@@ -38,10 +40,13 @@ func LocalBaseProvider(
 	authorizer authz.Authorizer,
 	spaceFinder refcache.SpaceFinder,
 	auditService audit.Service,
+	denylist *denylist.Service,
+	config *types.Config,
 ) LocalBase {
+	SetUpstreamNotFoundTTL(config.Registry.UpstreamNotFoundCacheTTL)
 	return NewLocalBase(
 		registryDao, registryFinder, fileManager, tx, imageDao, artifactDao, nodesDao,
-		tagsDao, authorizer, spaceFinder, auditService,
+		tagsDao, authorizer, spaceFinder, auditService, denylist,
 	)
 }
 
@@ -16,6 +16,7 @@ package base
 
 import (
 	"context"
+	"time"
 
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/errors"
@@ -27,6 +28,7 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/response"
 	huggingfacetypes "github.com/harness/gitness/registry/app/pkg/types/huggingface"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/request"
 	registrytypes "github.com/harness/gitness/registry/types"
 
 	"github.com/rs/zerolog/log"
@@ -134,6 +136,15 @@ func proxyInternalWithChecks(
 		log.Ctx(ctx).Info().Msgf("Using Registry: %s, Type: %s", registry.Name, registry.Type)
 		art := GetArtifactRegistry(registry)
 		if art != nil { //nolint:nestif
+			notFoundKey, cacheable := upstreamNotFoundKey(ctx, registry.ID)
+			cacheable = cacheable && registry.Type == artifact.RegistryTypeUPSTREAM
+			if cacheable && !request.RevalidateFrom(ctx) && upstreamNotFound.has(notFoundKey, time.Now()) {
+				log.Ctx(ctx).Debug().Msgf("Repository: %s recently didn't find %s, skipping", registry.Name,
+					notFoundKey.path)
+				lastError = errors.NotFoundf("no matching artifacts found in registry %s", registry.Name)
+				continue
+			}
+
 			version := info.GetVersion()
 			image := info.BaseArtifactInfo().Image
 			var artifactType *artifact.ArtifactType
@@ -171,6 +182,13 @@ func proxyInternalWithChecks(
 			}
 
 			r = f(registry, art)
+			if cacheable {
+				if isNotFound(r.GetError()) {
+					upstreamNotFound.add(notFoundKey, time.Now())
+				} else {
+					upstreamNotFound.remove(notFoundKey)
+				}
+			}
 			if r.GetError() == nil {
 				return r, nil
 			}
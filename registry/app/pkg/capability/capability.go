@@ -0,0 +1,110 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capability
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+// Capability is a feature of registries which is only available for some package types.
+type Capability string
+
+const (
+	// SoftDelete keeps deleted versions in the trash of the registry until they are restored or purged.
+	SoftDelete Capability = "soft_delete"
+	// Quarantine blocks the download of quarantined versions and files.
+	Quarantine Capability = "quarantine"
+	// UpstreamProxy proxies and caches the packages of a remote registry.
+	UpstreamProxy Capability = "upstream_proxy"
+	// Webhooks notifies the webhooks of a registry when artifacts are created or deleted.
+	Webhooks Capability = "webhooks"
+	// Signing verifies the signatures of the packages pushed to a registry.
+	Signing Capability = "signing"
+)
+
+// Capabilities lists all capabilities in the order they are reported.
+var Capabilities = []Capability{SoftDelete, Quarantine, UpstreamProxy, Webhooks, Signing}
+
+// matrix lists the capabilities supported by each package type.
+var matrix = map[artifact.PackageType][]Capability{
+	artifact.PackageTypeDOCKER:      {SoftDelete, Quarantine, UpstreamProxy, Webhooks},
+	artifact.PackageTypeHELM:        {SoftDelete, Quarantine, UpstreamProxy, Webhooks, Signing},
+	artifact.PackageTypeGENERIC:     {Quarantine, UpstreamProxy},
+	artifact.PackageTypeMAVEN:       {Quarantine, UpstreamProxy},
+	artifact.PackageTypePYTHON:      {Quarantine, UpstreamProxy},
+	artifact.PackageTypeNPM:         {Quarantine, UpstreamProxy},
+	artifact.PackageTypeRPM:         {Quarantine, UpstreamProxy, Signing},
+	artifact.PackageTypeNUGET:       {Quarantine, UpstreamProxy},
+	artifact.PackageTypeCARGO:       {Quarantine, UpstreamProxy, Webhooks},
+	artifact.PackageTypeGO:          {Quarantine, UpstreamProxy, Webhooks},
+	artifact.PackageTypeHUGGINGFACE: {Quarantine},
+}
+
+// UnsupportedError is returned for a capability which isn't supported for a package type.
+type UnsupportedError struct {
+	PackageType artifact.PackageType
+	Capability  Capability
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("%s is not supported for %s registries", e.Capability, e.PackageType)
+}
+
+// Details returns the package type and the capability, so clients can tell which combination is unsupported.
+func (e *UnsupportedError) Details() map[string]any {
+	return map[string]any{
+		"packageType": e.PackageType,
+		"capability":  e.Capability,
+	}
+}
+
+// IsSupported returns whether the capability is supported for the package type.
+func IsSupported(packageType artifact.PackageType, capability Capability) bool {
+	return slices.Contains(matrix[packageType], capability)
+}
+
+// Check returns an UnsupportedError if the capability isn't supported for the package type.
+func Check(packageType artifact.PackageType, capability Capability) error {
+	if !IsSupported(packageType, capability) {
+		return &UnsupportedError{PackageType: packageType, Capability: capability}
+	}
+	return nil
+}
+
+// PackageCapabilities reports which capabilities are supported for a package type.
+type PackageCapabilities struct {
+	PackageType artifact.PackageType
+	Supported   map[Capability]bool
+}
+
+// Matrix returns the capabilities of all package types, ordered by package type.
+func Matrix() []PackageCapabilities {
+	result := make([]PackageCapabilities, 0, len(matrix))
+	for packageType := range matrix {
+		supported := make(map[Capability]bool, len(Capabilities))
+		for _, capability := range Capabilities {
+			supported[capability] = IsSupported(packageType, capability)
+		}
+		result = append(result, PackageCapabilities{PackageType: packageType, Supported: supported})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].PackageType < result[j].PackageType
+	})
+	return result
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capability_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/interfaces"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/helpers/pkg"
+	"github.com/harness/gitness/registry/app/pkg/capability"
+
+	"github.com/stretchr/testify/require"
+)
+
+func packageHelpers() []interfaces.PackageHelper {
+	return []interfaces.PackageHelper{
+		pkg.NewDockerPackageType(nil),
+		pkg.NewHelmPackageType(nil),
+		pkg.NewGenericPackageType(nil),
+		pkg.NewMavenPackageType(nil),
+		pkg.NewPythonPackageType(nil),
+		pkg.NewNPMPackageType(nil),
+		pkg.NewRPMPackageType(nil),
+		pkg.NewNugetPackageType(nil),
+		pkg.NewCargoPackageType(nil, nil),
+		pkg.NewGoPackageType(nil),
+		pkg.NewHuggingFacePackageType(nil),
+	}
+}
+
+func TestMatrixCoversAllPackageTypes(t *testing.T) {
+	matrix := capability.Matrix()
+	helpers := packageHelpers()
+	require.Len(t, matrix, len(helpers))
+
+	for i := 1; i < len(matrix); i++ {
+		require.Less(t, matrix[i-1].PackageType, matrix[i].PackageType, "matrix should be ordered by package type")
+	}
+	for _, m := range matrix {
+		require.Len(t, m.Supported, len(capability.Capabilities))
+	}
+}
+
+func TestUpstreamProxyMatchesRepoTypes(t *testing.T) {
+	for _, helper := range packageHelpers() {
+		packageType := artifact.PackageType(helper.GetPackageType())
+		require.Equal(t,
+			helper.IsValidRepoType(string(artifact.RegistryTypeUPSTREAM)),
+			capability.IsSupported(packageType, capability.UpstreamProxy),
+			"upstream proxy support of %s", packageType)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	require.NoError(t, capability.Check(artifact.PackageTypeDOCKER, capability.SoftDelete))
+
+	err := capability.Check(artifact.PackageTypeNPM, capability.SoftDelete)
+	var unsupported *capability.UnsupportedError
+	require.True(t, errors.As(err, &unsupported))
+	require.Equal(t, "soft_delete is not supported for NPM registries", err.Error())
+	require.Equal(t, map[string]any{
+		"packageType": artifact.PackageTypeNPM,
+		"capability":  capability.SoftDelete,
+	}, unsupported.Details())
+
+	require.Error(t, capability.Check("UNKNOWN", capability.Quarantine))
+}
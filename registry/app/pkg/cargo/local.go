@@ -35,6 +35,7 @@ import (
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/services/webhook"
+	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store/database/dbtx"
 )
 
@@ -146,7 +147,7 @@ func (c *localRegistry) publishArtifactCreatedEvent(
 func (c *localRegistry) regeneratePackageIndex(
 	ctx context.Context, info cargotype.ArtifactInfo,
 ) {
-	c.postProcessingReporter.BuildPackageIndex(ctx, info.RegistryID, info.Image)
+	c.postProcessingReporter.BuildPackageIndex(ctx, info.RegistryID, info.Image, make([]types.SourceRef, 0))
 }
 
 func (c *localRegistry) DownloadPackageIndex(
@@ -74,7 +74,7 @@ func (h *localRegistryHelper) DownloadFile(ctx context.Context, info cargotype.A
 func (h *localRegistryHelper) UpdatePackageIndex(
 	ctx context.Context, info cargotype.ArtifactInfo,
 ) {
-	h.postProcessingReporter.BuildPackageIndex(ctx, info.RegistryID, info.Image)
+	h.postProcessingReporter.BuildPackageIndex(ctx, info.RegistryID, info.Image, make([]types.SourceRef, 0))
 }
 
 func (h *localRegistryHelper) MoveTempFile(
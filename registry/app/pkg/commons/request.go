@@ -96,12 +96,13 @@ func ServeContent(
 	fileName string,
 	readCloser io.ReadCloser,
 ) error {
+	w = ThrottleFromContext(w, r)
 	if body != nil {
 		http.ServeContent(w, r, fileName, time.Time{}, body)
 		return nil
 	}
 	if readCloser != nil {
-		_, err := io.Copy(w, readCloser)
+		_, err := storage.Copy(w, readCloser)
 		if err != nil {
 			return fmt.Errorf("failed to copy content: %w", err)
 		}
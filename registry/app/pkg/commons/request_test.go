@@ -0,0 +1,113 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commons
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/driver/filesystem"
+	"github.com/harness/gitness/registry/app/storage"
+
+	"github.com/rs/zerolog"
+)
+
+// discardResponseWriter discards the response, it reads the content with io.Discard like the response writer of
+// net/http reads it with its own pooled buffers, so only the allocations of the download path are measured.
+type discardResponseWriter struct {
+	header http.Header
+}
+
+func (w *discardResponseWriter) Header() http.Header         { return w.header }
+func (w *discardResponseWriter) WriteHeader(int)             {}
+func (w *discardResponseWriter) Write(p []byte) (int, error) { return len(p), nil }
+func (w *discardResponseWriter) ReadFrom(r io.Reader) (int64, error) {
+	return io.Copy(io.Discard, r)
+}
+
+// BenchmarkServeContent measures the download path from the storage driver to the response. Compare the results
+// of two revisions with benchstat; the 1GB file is skipped in short mode.
+func BenchmarkServeContent(b *testing.B) {
+	// the storage driver logs every read.
+	level := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+	defer zerolog.SetGlobalLevel(level)
+
+	for _, size := range []int64{10 << 20, 1 << 30} {
+		b.Run(fmt.Sprintf("%dMB", size>>20), func(b *testing.B) {
+			if testing.Short() && size > 10<<20 {
+				b.Skip("skipping large file in short mode")
+			}
+			ctx := context.Background()
+			d := filesystem.New(filesystem.DriverParameters{RootDirectory: b.TempDir(), MaxThreads: 100})
+			writeRandomFile(ctx, b, d, "/file", size)
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			durations := make([]time.Duration, 0, b.N)
+			b.SetBytes(size)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				started := time.Now()
+				fr, err := storage.NewFileReader(ctx, d, "/file", size)
+				if err != nil {
+					b.Fatal(err)
+				}
+				w := &discardResponseWriter{header: http.Header{}}
+				if err = ServeContent(w, r, fr, "file", nil); err != nil {
+					b.Fatal(err)
+				}
+				fr.Close()
+				durations = append(durations, time.Since(started))
+			}
+			b.StopTimer()
+
+			slices.Sort(durations)
+			b.ReportMetric(float64(percentile(durations, 50).Nanoseconds()), "p50-ns")
+			b.ReportMetric(float64(percentile(durations, 99).Nanoseconds()), "p99-ns")
+		})
+	}
+}
+
+func writeRandomFile(ctx context.Context, b *testing.B, d *filesystem.Driver, path string, size int64) {
+	b.Helper()
+	fw, err := d.Writer(ctx, path, false)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if _, err = io.CopyN(fw, rand.Reader, size); err != nil {
+		b.Fatal(err)
+	}
+	if err = fw.Commit(ctx); err != nil {
+		b.Fatal(err)
+	}
+	if err = fw.Close(); err != nil {
+		b.Fatal(err)
+	}
+}
+
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	return sorted[(len(sorted)-1)*p/100]
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commons
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/storage"
+	registryrequest "github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+
+	"golang.org/x/time/rate"
+)
+
+// minThrottleBurst is the largest number of bytes a throttled download may write at once when its rate limit
+// is lower.
+const minThrottleBurst = 32 * 1024
+
+// ThrottleDownload returns the writer the content of a download from the registry is written to, it caps the
+// bandwidth of the download to the rate limit the registry sets for the principal of the request.
+func ThrottleDownload(w http.ResponseWriter, r *http.Request, registry types.Registry) http.ResponseWriter {
+	if registry.Config == nil || registry.Config.DownloadRateLimit == nil {
+		return w
+	}
+	principalType, anonymous := principalClass(r.Context())
+	limit := registry.Config.DownloadRateLimit.Limit(principalType, anonymous)
+	if limit <= 0 {
+		return w
+	}
+	return &throttledWriter{
+		ResponseWriter: w,
+		ctx:            r.Context(),
+		limiter:        rate.NewLimiter(rate.Limit(limit), int(max(limit, minThrottleBurst))),
+	}
+}
+
+// ThrottleFromContext throttles the download with the registry of the artifact info stored in the request context.
+func ThrottleFromContext(w http.ResponseWriter, r *http.Request) http.ResponseWriter {
+	info, ok := r.Context().Value(registryrequest.ArtifactInfoKey).(pkg.PackageArtifactInfo)
+	if !ok || info == nil {
+		return w
+	}
+	return ThrottleDownload(w, r, info.BaseArtifactInfo().Registry)
+}
+
+func principalClass(ctx context.Context) (enum.PrincipalType, bool) {
+	session, ok := request.AuthSessionFrom(ctx)
+	if !ok || auth.IsAnonymousSession(session) {
+		return "", true
+	}
+	return session.Principal.Type, false
+}
+
+type throttledWriter struct {
+	http.ResponseWriter
+	ctx     context.Context
+	limiter *rate.Limiter
+}
+
+func (w *throttledWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), w.limiter.Burst())
+		if err := w.limiter.WaitN(w.ctx, n); err != nil {
+			return written, err
+		}
+		m, err := w.ResponseWriter.Write(p[:n])
+		written += m
+		if err != nil {
+			return written, err
+		}
+		p = p[n:]
+	}
+	return written, nil
+}
+
+// ReadFrom copies the content with a pooled buffer, a throttled writer hides the ReadFrom of the response writer
+// and io.Copy would allocate a buffer for every download otherwise.
+func (w *throttledWriter) ReadFrom(r io.Reader) (int64, error) {
+	return storage.Copy(writerOnly{w}, r)
+}
+
+// writerOnly hides the ReadFrom of a writer, so io.CopyBuffer uses the given buffer.
+type writerOnly struct {
+	io.Writer
+}
+
+func (w *throttledWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commons
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+)
+
+func TestThrottleDownload(t *testing.T) {
+	t.Run("returns the writer when no limit is set", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if got := ThrottleDownload(w, r, types.Registry{Config: &types.RegistryConfig{}}); got != w {
+			t.Errorf("expected the writer to be returned unchanged")
+		}
+	})
+
+	t.Run("writes everything in chunks of the burst size", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		registry := types.Registry{Config: &types.RegistryConfig{
+			DownloadRateLimit: &types.DownloadRateLimitConfig{Anonymous: 1 << 30},
+		}}
+		throttled := ThrottleDownload(w, r, registry)
+		if _, ok := throttled.(*throttledWriter); !ok {
+			t.Fatalf("expected a throttled writer, got %T", throttled)
+		}
+		body := strings.Repeat("x", 3*minThrottleBurst+1)
+		n, err := throttled.Write([]byte(body))
+		if err != nil || n != len(body) || w.Body.String() != body {
+			t.Errorf("expected %d bytes written, got %d (err: %v)", len(body), n, err)
+		}
+	})
+
+	t.Run("copies everything with ReadFrom", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		registry := types.Registry{Config: &types.RegistryConfig{
+			DownloadRateLimit: &types.DownloadRateLimitConfig{Anonymous: 1 << 30},
+		}}
+		throttled, ok := ThrottleDownload(w, r, registry).(io.ReaderFrom)
+		if !ok {
+			t.Fatal("expected the throttled writer to implement io.ReaderFrom")
+		}
+		body := strings.Repeat("x", 3*minThrottleBurst+1)
+		n, err := throttled.ReadFrom(strings.NewReader(body))
+		if err != nil || n != int64(len(body)) || w.Body.String() != body {
+			t.Errorf("expected %d bytes copied, got %d (err: %v)", len(body), n, err)
+		}
+	})
+}
@@ -17,9 +17,11 @@ package pkg
 import (
 	"context"
 
+	"github.com/harness/gitness/registry/app/api/interfaces"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/pkg/quarantine"
 	store2 "github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/denylist"
 	"github.com/harness/gitness/registry/types"
 
 	"github.com/rs/zerolog/log"
@@ -35,14 +37,23 @@ const (
 var TypeRegistry = map[ArtifactType]Artifact{}
 
 type CoreController struct {
-	RegistryDao      store2.RegistryRepository
-	QuarantineFinder quarantine.Finder
+	RegistryDao               store2.RegistryRepository
+	QuarantineFinder          quarantine.Finder
+	DependencyFirewallChecker interfaces.DependencyFirewallChecker
+	Denylist                  *denylist.Service
 }
 
-func NewCoreController(registryDao store2.RegistryRepository, quarantineFinder quarantine.Finder) *CoreController {
+func NewCoreController(
+	registryDao store2.RegistryRepository,
+	quarantineFinder quarantine.Finder,
+	dependencyFirewallChecker interfaces.DependencyFirewallChecker,
+	denylist *denylist.Service,
+) *CoreController {
 	return &CoreController{
-		RegistryDao:      registryDao,
-		QuarantineFinder: quarantineFinder,
+		RegistryDao:               registryDao,
+		QuarantineFinder:          quarantineFinder,
+		DependencyFirewallChecker: dependencyFirewallChecker,
+		Denylist:                  denylist,
 	}
 }
 
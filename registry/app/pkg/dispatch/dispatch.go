@@ -0,0 +1,93 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dispatch is the single place which decides how the artifacts of a package type are deleted and
+// indexed. The deletion controllers and the index builds look up the handlers of a package type here instead of
+// switching over the package types themselves, so a new package type only has to be registered once.
+package dispatch
+
+import (
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+// Deletion is how the artifacts or versions of a package type are deleted.
+type Deletion int
+
+const (
+	// DeletePackageHelper delegates the deletion to the package helper of the package type.
+	DeletePackageHelper Deletion = iota + 1
+	// DeleteOCI deletes the manifests and unlinks the blobs of the image.
+	DeleteOCI
+	// DeleteFiles deletes the file nodes of the artifact or version.
+	DeleteFiles
+	// DeleteIndexed deletes the file nodes of the version and rebuilds the index which listed it.
+	DeleteIndexed
+	// DeleteUnsupported rejects the deletion.
+	DeleteUnsupported
+)
+
+// Indexing is how the registry and package indexes of a package type are built.
+type Indexing int
+
+const (
+	// IndexPackageHelper delegates the index builds to the package helper of the package type.
+	IndexPackageHelper Indexing = iota + 1
+	// IndexRPM builds the repodata of the whole registry.
+	IndexRPM
+	// IndexGo builds the version list and the metadata of a module.
+	IndexGo
+)
+
+// Handlers are the handlers of a package type.
+type Handlers struct {
+	ArtifactDeletion Deletion
+	VersionDeletion  Deletion
+	Indexing         Indexing
+}
+
+// defaultHandlers are used for the package types without registered handlers, e.g. the ones of plugins.
+var defaultHandlers = Handlers{
+	ArtifactDeletion: DeletePackageHelper,
+	VersionDeletion:  DeletePackageHelper,
+	Indexing:         IndexPackageHelper,
+}
+
+// registry holds the handlers of the built-in package types.
+var registry = map[artifact.PackageType]Handlers{
+	artifact.PackageTypeDOCKER:      {DeleteOCI, DeleteOCI, IndexPackageHelper},
+	artifact.PackageTypeHELM:        {DeleteOCI, DeleteOCI, IndexPackageHelper},
+	artifact.PackageTypeGENERIC:     {DeleteFiles, DeleteFiles, IndexPackageHelper},
+	artifact.PackageTypeMAVEN:       {DeleteFiles, DeleteFiles, IndexPackageHelper},
+	artifact.PackageTypePYTHON:      {DeleteFiles, DeleteFiles, IndexPackageHelper},
+	artifact.PackageTypeNPM:         {DeleteFiles, DeleteFiles, IndexPackageHelper},
+	artifact.PackageTypeNUGET:       {DeleteFiles, DeleteFiles, IndexPackageHelper},
+	artifact.PackageTypeRPM:         {DeleteUnsupported, DeleteIndexed, IndexRPM},
+	artifact.PackageTypeGO:          {DeleteFiles, DeleteIndexed, IndexGo},
+	artifact.PackageTypeCARGO:       {DeletePackageHelper, DeletePackageHelper, IndexPackageHelper},
+	artifact.PackageTypeHUGGINGFACE: {DeleteUnsupported, DeletePackageHelper, IndexPackageHelper},
+}
+
+// For returns the handlers of a package type, the package helper handles the package types without handlers.
+func For(packageType artifact.PackageType) Handlers {
+	if h, ok := registry[packageType]; ok {
+		return h
+	}
+	return defaultHandlers
+}
+
+// IsRegistered returns whether handlers are registered for the package type.
+func IsRegistered(packageType artifact.PackageType) bool {
+	_, ok := registry[packageType]
+	return ok
+}
@@ -0,0 +1,51 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch_test
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/dispatch"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestAllPackageTypesHaveHandlers fails once a package type is added to the API without registering its
+// handlers.
+func TestAllPackageTypesHaveHandlers(t *testing.T) {
+	swagger, err := artifact.GetSwagger()
+	require.NoError(t, err)
+	schema, ok := swagger.Components.Schemas["PackageType"]
+	require.True(t, ok)
+	require.NotEmpty(t, schema.Value.Enum)
+
+	for _, value := range schema.Value.Enum {
+		packageType := artifact.PackageType(value.(string)) //nolint:errcheck,forcetypeassert
+		require.True(t, dispatch.IsRegistered(packageType), "no handlers registered for %s", packageType)
+
+		h := dispatch.For(packageType)
+		require.NotZero(t, h.ArtifactDeletion, "no artifact deletion for %s", packageType)
+		require.NotZero(t, h.VersionDeletion, "no version deletion for %s", packageType)
+		require.NotZero(t, h.Indexing, "no indexing for %s", packageType)
+	}
+}
+
+func TestUnknownPackageTypeUsesPackageHelper(t *testing.T) {
+	h := dispatch.For("CUSTOM")
+	require.Equal(t, dispatch.DeletePackageHelper, h.ArtifactDeletion)
+	require.Equal(t, dispatch.DeletePackageHelper, h.VersionDeletion)
+	require.Equal(t, dispatch.IndexPackageHelper, h.Indexing)
+}
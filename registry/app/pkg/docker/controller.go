@@ -31,6 +31,7 @@ import (
 	"github.com/harness/gitness/app/auth/authz"
 	"github.com/harness/gitness/app/services/refcache"
 	corestore "github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/dist_temp/errcode"
 	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/pkg/commons"
@@ -229,6 +230,19 @@ func (c *Controller) PullManifest(
 			return handleQuarantineError(ctx, err, art.Image, art.Tag, art.Digest)
 		}
 
+		// Check the denylists of the spaces if upstream proxy
+		if registry.Type == artifact.RegistryTypeUPSTREAM {
+			err = c.DependencyFirewallChecker.CheckPolicyViolation(ctx, registry.ID, art.Image,
+				manifestVersion(art), nil)
+			if err != nil {
+				log.Ctx(ctx).Warn().Err(err).Msgf("pull of artifact: [%s], tag: [%s], digest: [%s] is blocked",
+					art.Image, art.Tag, art.Digest)
+				return &GetManifestResponse{
+					Errors: []error{err},
+				}
+			}
+		}
+
 		headers, desc, man, e := a.(Registry).PullManifest(ctx, art, acceptHeaders, ifNoneMatchHeader) //nolint:errcheck
 		response := &GetManifestResponse{e, headers, desc, man}
 		return response
@@ -255,9 +269,20 @@ func (c *Controller) PutManifest(
 	if err != nil {
 		return nil, []error{errcode.ErrCodeDenied}
 	}
+	if err = c.Denylist.CheckUpload(ctx, &artInfo.Registry, artInfo.Image, manifestVersion(artInfo)); err != nil {
+		return nil, []error{err}
+	}
 	return c.local.PutManifest(ctx, artInfo, mediaType, body, length)
 }
 
+// manifestVersion returns the version of the manifest checked against the denylists, its tag if it has one.
+func manifestVersion(info pkg.RegistryInfo) string {
+	if info.Tag != "" {
+		return info.Tag
+	}
+	return info.Digest
+}
+
 func (c *Controller) DeleteManifest(
 	ctx context.Context,
 	artInfo pkg.RegistryInfo,
@@ -0,0 +1,244 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/harness/gitness/registry/app/manifest"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/ocilayout"
+	"github.com/harness/gitness/registry/types"
+	store2 "github.com/harness/gitness/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+// exportTagsPageSize is the number of tags read at once when all tags of an image are exported.
+const exportTagsPageSize = 100
+
+// ErrExportTagNotFound is returned when a tag to export doesn't exist.
+var ErrExportTagNotFound = errors.New("tag not found")
+
+// Exporter writes the tags of an image as OCI image layout tarballs, e.g. to move images into air-gapped
+// instances. The tarballs can be imported back with the Importer, skopeo or oras.
+type Exporter struct {
+	local *LocalRegistry
+}
+
+func NewExporter(local *LocalRegistry) *Exporter {
+	return &Exporter{
+		local: local,
+	}
+}
+
+// ExportTag is a tag of an image to export along with the manifest it points to.
+type ExportTag struct {
+	Name     string
+	Manifest *types.Manifest
+}
+
+// Resolve finds the manifests of the tags of the image in the registry of info, all tags are resolved when none
+// is given. Resolving the tags before writing the tarball reports unknown tags before anything is streamed.
+func (e *Exporter) Resolve(
+	ctx context.Context,
+	info pkg.RegistryInfo,
+	image string,
+	tags []string,
+) ([]ExportTag, error) {
+	if len(tags) == 0 {
+		var err error
+		if tags, err = e.listTags(ctx, info.Registry.ID, image); err != nil {
+			return nil, err
+		}
+		if len(tags) == 0 {
+			return nil, fmt.Errorf("%w: image %s has no tags", ErrExportTagNotFound, image)
+		}
+	}
+
+	resolved := make([]ExportTag, 0, len(tags))
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		m, err := e.local.manifestDao.FindManifestByTagName(ctx, info.Registry.ID, image, tag)
+		if errors.Is(err, store2.ErrResourceNotFound) {
+			return nil, fmt.Errorf("%w: %s:%s", ErrExportTagNotFound, image, tag)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find manifest of %s:%s: %w", image, tag, err)
+		}
+		resolved = append(resolved, ExportTag{Name: tag, Manifest: m})
+	}
+	return resolved, nil
+}
+
+func (e *Exporter) listTags(ctx context.Context, registryID int64, image string) ([]string, error) {
+	var names []string
+	filters := types.FilterParams{MaxEntries: exportTagsPageSize}
+	for {
+		tags, err := e.local.tagDao.TagsPaginated(ctx, registryID, image, filters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tags of %s: %w", image, err)
+		}
+		for _, tag := range tags {
+			names = append(names, tag.Name)
+		}
+		if len(tags) < exportTagsPageSize {
+			return names, nil
+		}
+		filters.LastEntry = tags[len(tags)-1].Name
+	}
+}
+
+// Write streams the resolved tags of the image as an OCI image layout tarball to w. The manifests and blobs the
+// tags share are written once. The manifests a list references but the registry doesn't hold, like the platforms
+// an upstream proxy didn't cache, are left out of the tarball like the foreign layers are.
+func (e *Exporter) Write(
+	ctx context.Context,
+	info pkg.RegistryInfo,
+	image string,
+	tags []ExportTag,
+	w io.Writer,
+) error {
+	lw, err := ocilayout.NewWriter(w)
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		desc, err := e.writeManifest(ctx, lw, info, image, tag.Manifest)
+		if err != nil {
+			return fmt.Errorf("failed to export %s:%s: %w", image, tag.Name, err)
+		}
+		lw.AddImage(desc, image, tag.Name)
+	}
+	return lw.Close()
+}
+
+// writeManifest writes a manifest along with its blobs or the manifests it lists and returns its descriptor.
+func (e *Exporter) writeManifest(
+	ctx context.Context,
+	lw *ocilayout.Writer,
+	info pkg.RegistryInfo,
+	image string,
+	dbManifest *types.Manifest,
+) (manifest.Descriptor, error) {
+	payload := []byte(dbManifest.Payload)
+	desc := manifest.Descriptor{
+		MediaType: manifestMediaType(manifest.Descriptor{MediaType: dbManifest.MediaType}, payload),
+		Digest:    dbManifest.Digest,
+		Size:      int64(len(payload)),
+	}
+	if lw.Has(desc.Digest) {
+		return desc, nil
+	}
+
+	m, err := DBManifestToManifest(dbManifest)
+	if err != nil {
+		return manifest.Descriptor{}, fmt.Errorf("failed to parse manifest %s: %w", dbManifest.Digest, err)
+	}
+	if isManifestList(desc.MediaType) {
+		for _, child := range m.References() {
+			if err = e.writeChildManifest(ctx, lw, info, image, child); err != nil {
+				return manifest.Descriptor{}, err
+			}
+		}
+	} else {
+		for _, ref := range m.References() {
+			if err = e.writeBlob(ctx, lw, info, image, ref); err != nil {
+				return manifest.Descriptor{}, err
+			}
+		}
+	}
+
+	if err = lw.WriteBlob(desc.Digest, desc.Size, bytes.NewReader(payload)); err != nil {
+		return manifest.Descriptor{}, err
+	}
+	return desc, nil
+}
+
+func (e *Exporter) writeChildManifest(
+	ctx context.Context,
+	lw *ocilayout.Writer,
+	info pkg.RegistryInfo,
+	image string,
+	child manifest.Descriptor,
+) error {
+	if lw.Has(child.Digest) {
+		return nil
+	}
+	d, err := types.NewDigest(child.Digest)
+	if err != nil {
+		return fmt.Errorf("invalid digest of manifest %s: %w", child.Digest, err)
+	}
+	dbChild, err := e.local.manifestDao.FindManifestByDigest(ctx, info.Registry.ID, image, d)
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		log.Ctx(ctx).Debug().Msgf("manifest %s of image %s isn't stored, it's left out of the export",
+			child.Digest, image)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find manifest %s: %w", child.Digest, err)
+	}
+	_, err = e.writeManifest(ctx, lw, info, image, dbChild)
+	return err
+}
+
+func (e *Exporter) writeBlob(
+	ctx context.Context,
+	lw *ocilayout.Writer,
+	info pkg.RegistryInfo,
+	image string,
+	desc manifest.Descriptor,
+) error {
+	if lw.Has(desc.Digest) {
+		return nil
+	}
+	if len(desc.URLs) > 0 {
+		// foreign layers are pulled from their URLs, they aren't stored.
+		return nil
+	}
+
+	blobInfo := info
+	blobInfo.ArtifactInfo = &pkg.ArtifactInfo{}
+	*blobInfo.ArtifactInfo = *info.ArtifactInfo
+	blobInfo.Image = image
+	blobInfo.Digest = desc.Digest.String()
+
+	blobID, err := e.local.dbBlobLinkExists(ctx, desc.Digest, blobInfo)
+	if err != nil {
+		return fmt.Errorf("failed to find blob %s: %w", desc.Digest, err)
+	}
+	blobCtx := e.local.App.GetBlobsContext(ctx, blobInfo, types.BlobLocator{
+		Digest:       desc.Digest,
+		BlobID:       blobID,
+		RegistryID:   blobInfo.RegistryID,
+		RootParentID: blobInfo.RootParentID,
+	})
+	//nolint:contextcheck
+	content, size, err := blobCtx.OciBlobStore.GetBlobInternal(blobCtx.Context, blobInfo.RootIdentifier, desc.Digest)
+	if err != nil {
+		return fmt.Errorf("failed to open blob %s: %w", desc.Digest, err)
+	}
+	defer content.Close()
+
+	return lw.WriteBlob(desc.Digest, size, content)
+}
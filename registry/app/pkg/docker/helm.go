@@ -0,0 +1,160 @@
+// Source: https://gitlab.com/gitlab-org/container-registry
+
+// Copyright 2019 Gitlab Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/manifest"
+	helmmetadata "github.com/harness/gitness/registry/app/metadata/helm"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/storage"
+	helmutils "github.com/harness/gitness/registry/app/utils/helm"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/rs/zerolog/log"
+)
+
+// getHelmChartMetadata reads the dependencies of a Helm chart from its config blob and Chart.lock, and
+// verifies its provenance file when one was pushed. It returns nil for manifests which are not Helm charts.
+func (r *LocalRegistry) getHelmChartMetadata(
+	ctx context.Context,
+	info pkg.RegistryInfo,
+	mfst manifest.Manifest,
+) (*helmmetadata.Metadata, error) {
+	if info.Registry.PackageType != artifact.PackageTypeHELM {
+		return nil, nil
+	}
+	chartManifest, ok := mfst.(manifest.ManifestV2)
+	if !ok || chartManifest.Config().MediaType != helmutils.ConfigMediaType {
+		return nil, nil
+	}
+
+	blobs := r.App.GetBlobsContext(ctx, info, types.BlobLocator{
+		RegistryID:   info.RegistryID,
+		RootParentID: info.RootParentID,
+	}).OciBlobStore
+
+	content, err := blobs.Get(ctx, info.RootIdentifier, chartManifest.Config().Digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get helm chart config: %w", err)
+	}
+	config, err := helmutils.ParseChartConfig(content)
+	if err != nil {
+		return nil, errcode.ErrCodeManifestInvalid.WithDetail(err.Error())
+	}
+
+	var chartDigest, provDigest digest.Digest
+	for _, layer := range chartManifest.Layers() {
+		switch layer.MediaType {
+		case helmutils.ChartLayerMediaType:
+			chartDigest = layer.Digest
+		case helmutils.ProvenanceLayerMediaType:
+			provDigest = layer.Digest
+		}
+	}
+
+	var lock *helmutils.ChartLock
+	if chartDigest != "" {
+		lock, err = r.getHelmChartLock(ctx, info, blobs, chartDigest, config.Name)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to read lock file of helm chart %s:%s", config.Name,
+				config.Version)
+		}
+	}
+	metadata := helmutils.GetChartMetadata(config, lock)
+
+	if provDigest != "" {
+		if chartDigest == "" {
+			return nil, errcode.ErrCodeManifestInvalid.WithDetail("helm chart provenance pushed without a chart")
+		}
+		metadata.Provenance, err = r.verifyHelmProvenance(ctx, info, blobs, provDigest, chartDigest)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &metadata, nil
+}
+
+func (r *LocalRegistry) getHelmChartLock(
+	ctx context.Context,
+	info pkg.RegistryInfo,
+	blobs storage.OciBlobStore,
+	chartDigest digest.Digest,
+	chartName string,
+) (*helmutils.ChartLock, error) {
+	reader, err := blobs.Open(ctx, info.RootIdentifier, chartDigest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open helm chart: %w", err)
+	}
+	defer reader.Close()
+	return helmutils.ReadChartLock(reader, chartName)
+}
+
+// verifyHelmProvenance rejects charts whose provenance does not match the chart archive, or which is not
+// signed by one of the trusted keys of the registry when any are configured.
+func (r *LocalRegistry) verifyHelmProvenance(
+	ctx context.Context,
+	info pkg.RegistryInfo,
+	blobs storage.OciBlobStore,
+	provDigest digest.Digest,
+	chartDigest digest.Digest,
+) (helmmetadata.Provenance, error) {
+	prov, err := blobs.Get(ctx, info.RootIdentifier, provDigest)
+	if err != nil {
+		return helmmetadata.Provenance{}, fmt.Errorf("failed to get helm chart provenance: %w", err)
+	}
+	var trustedKeys []string
+	if info.Registry.Config != nil && info.Registry.Config.HelmProvenance != nil {
+		trustedKeys = info.Registry.Config.HelmProvenance.TrustedKeys
+	}
+	keyring, err := helmutils.ReadTrustedKeys(trustedKeys)
+	if err != nil {
+		return helmmetadata.Provenance{}, fmt.Errorf("failed to read trusted keys of registry %s: %w",
+			info.RegIdentifier, err)
+	}
+	provenance, err := helmutils.VerifyProvenance(prov, chartDigest, keyring)
+	if err != nil {
+		return helmmetadata.Provenance{}, errcode.ErrCodeManifestInvalid.WithDetail(err.Error())
+	}
+	return provenance, nil
+}
+
+// storeHelmChartMetadata stores the chart metadata with the artifact created for the manifest.
+func (r *LocalRegistry) storeHelmChartMetadata(
+	ctx context.Context,
+	info pkg.RegistryInfo,
+	d digest.Digest,
+	metadata *helmmetadata.Metadata,
+) error {
+	dgst, err := types.NewDigest(d)
+	if err != nil {
+		return err
+	}
+	dbArtifact, err := r.artifactDao.GetByRegistryImageAndVersion(ctx, info.RegistryID, info.Image, dgst.String())
+	if err != nil {
+		return fmt.Errorf("failed to get artifact for helm chart: %w", err)
+	}
+	rawMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal helm chart metadata: %w", err)
+	}
+	return r.artifactDao.UpdateArtifactMetadata(ctx, rawMetadata, dbArtifact.ID)
+}
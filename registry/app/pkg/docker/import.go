@@ -0,0 +1,478 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/handler/utils"
+	"github.com/harness/gitness/registry/app/manifest"
+	"github.com/harness/gitness/registry/app/manifest/manifestlist"
+	"github.com/harness/gitness/registry/app/manifest/ocischema"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/ocilayout"
+	"github.com/harness/gitness/registry/app/services/hook"
+	"github.com/harness/gitness/registry/types"
+	store2 "github.com/harness/gitness/store"
+
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rs/zerolog/log"
+)
+
+// ErrImportDenied is returned when the registry doesn't allow an image of the imported archive.
+var ErrImportDenied = errors.New("image is not allowed by the registry")
+
+// Importer imports the images of OCI image layouts and docker save archives into registries, e.g. to move
+// images into air-gapped instances. The blobs the root space already has are linked instead of uploaded again.
+type Importer struct {
+	local          *LocalRegistry
+	maxArchiveSize int64
+}
+
+func NewImporter(local *LocalRegistry, maxArchiveSize int64) *Importer {
+	return &Importer{
+		local:          local,
+		maxArchiveSize: maxArchiveSize,
+	}
+}
+
+// ImportedImage is a tag, or an untagged image, created by an import.
+type ImportedImage struct {
+	Image  string
+	Tag    string
+	Digest digest.Digest
+	// Reconstructed tells the manifest list was rebuilt, either from the platforms the archive holds or from
+	// the images the archive tags alike, so its digest differs from the one of the source.
+	Reconstructed bool
+}
+
+// ImportResult summarizes an import.
+type ImportResult struct {
+	Images        []ImportedImage
+	BlobsUploaded int
+	BlobsLinked   int
+}
+
+// importImage is a tag of the archive, a tag with several images has them combined into an image index.
+type importImage struct {
+	name        string
+	tag         string
+	descriptors []manifest.Descriptor
+}
+
+// importState tracks what an import stored already, so the blobs and manifests shared by images are only
+// stored once.
+type importState struct {
+	info    pkg.RegistryInfo
+	archive *ocilayout.Archive
+	// built holds the manifest lists reconstructed by the import.
+	built map[digest.Digest][]byte
+	// manifests maps the manifests of the archive to the descriptors they were stored with, by image.
+	manifests map[string]manifest.Descriptor
+	blobs     map[string]bool
+	result    ImportResult
+}
+
+// Import imports the images of the archive read from body into the registry of info. Images the archive doesn't
+// name are imported as defaultImage. The import isn't atomic, the images imported before a failure are kept,
+// importing the archive again completes it.
+func (i *Importer) Import(
+	ctx context.Context,
+	info pkg.RegistryInfo,
+	body io.Reader,
+	defaultImage string,
+) (*ImportResult, error) {
+	dir, err := os.MkdirTemp("", "registry-import-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create import directory: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to remove import directory %s", dir)
+		}
+	}()
+
+	if err = ocilayout.Extract(body, dir, i.maxArchiveSize); err != nil {
+		return nil, err
+	}
+	archive, err := ocilayout.Open(dir)
+	if err != nil {
+		return nil, err
+	}
+	images, err := groupImportImages(archive.Refs, defaultImage)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &importState{
+		info:      info,
+		archive:   archive,
+		built:     map[digest.Digest][]byte{},
+		manifests: map[string]manifest.Descriptor{},
+		blobs:     map[string]bool{},
+	}
+	for _, image := range images {
+		if err = i.importImage(ctx, s, image); err != nil {
+			return &s.result, err
+		}
+	}
+	return &s.result, nil
+}
+
+// groupImportImages groups the images of the archive by tag, the untagged ones are imported by digest.
+func groupImportImages(refs []ocilayout.Ref, defaultImage string) ([]*importImage, error) {
+	var images []*importImage
+	byTag := map[string]*importImage{}
+	for _, ref := range refs {
+		name := ref.Name
+		if name == "" {
+			name = defaultImage
+		}
+		if name == "" {
+			return nil, fmt.Errorf("%w: image %s has no name, the image to import it as is required",
+				ocilayout.ErrInvalidArchive, ref.Descriptor.Digest)
+		}
+		if ref.Tag == "" {
+			images = append(images, &importImage{name: name, descriptors: []manifest.Descriptor{ref.Descriptor}})
+			continue
+		}
+		key := name + ":" + ref.Tag
+		if image, ok := byTag[key]; ok {
+			image.descriptors = append(image.descriptors, ref.Descriptor)
+			continue
+		}
+		image := &importImage{name: name, tag: ref.Tag, descriptors: []manifest.Descriptor{ref.Descriptor}}
+		byTag[key] = image
+		images = append(images, image)
+	}
+	return images, nil
+}
+
+func (i *Importer) importImage(ctx context.Context, s *importState, image *importImage) error {
+	ref := image.name
+	if image.tag != "" {
+		ref += ":" + image.tag
+	}
+	if err := utils.PatternAllowed(s.info.Registry.AllowedPattern, s.info.Registry.BlockedPattern,
+		ref); err != nil {
+		return fmt.Errorf("%w: %s", ErrImportDenied, ref)
+	}
+
+	desc := image.descriptors[0]
+	reconstructed := false
+	if len(image.descriptors) > 1 {
+		var err error
+		if desc, err = s.buildIndex(image.descriptors); err != nil {
+			return err
+		}
+		reconstructed = true
+	}
+
+	stored, err := i.importManifest(ctx, s, image.name, desc, image.tag)
+	if err != nil {
+		return fmt.Errorf("failed to import %s: %w", ref, err)
+	}
+	s.result.Images = append(s.result.Images, ImportedImage{
+		Image:         image.name,
+		Tag:           image.tag,
+		Digest:        stored.Digest,
+		Reconstructed: reconstructed || stored.Digest != desc.Digest,
+	})
+	return nil
+}
+
+// buildIndex combines the image manifests the archive tags alike, e.g. the platforms of an image pulled one by
+// one, into an image index.
+func (s *importState) buildIndex(descriptors []manifest.Descriptor) (manifest.Descriptor, error) {
+	children := make([]manifest.Descriptor, 0, len(descriptors))
+	for _, desc := range descriptors {
+		if isManifestList(desc.MediaType) {
+			return manifest.Descriptor{}, fmt.Errorf("%w: manifest list %s shares its tag with other images",
+				ocilayout.ErrInvalidArchive, desc.Digest)
+		}
+		platform, err := s.archive.Platform(desc)
+		if err != nil {
+			return manifest.Descriptor{}, err
+		}
+		children = append(children, manifest.Descriptor{
+			MediaType: desc.MediaType,
+			Digest:    desc.Digest,
+			Size:      desc.Size,
+			Platform:  platform,
+		})
+	}
+	index, err := ocischema.FromDescriptors(children, nil)
+	if err != nil {
+		return manifest.Descriptor{}, fmt.Errorf("failed to build image index: %w", err)
+	}
+	return s.store(v1.MediaTypeImageIndex, index)
+}
+
+// importManifest imports a manifest along with its blobs or the manifests it lists, and returns the descriptor
+// it was stored with. The manifests a list references but the archive doesn't hold, like the other platforms of
+// a multi-platform image saved by docker, are left out of the list.
+func (i *Importer) importManifest(
+	ctx context.Context,
+	s *importState,
+	image string,
+	desc manifest.Descriptor,
+	tag string,
+) (manifest.Descriptor, error) {
+	key := image + "@" + desc.Digest.String()
+	if stored, ok := s.manifests[key]; ok && tag == "" {
+		return stored, nil
+	}
+
+	payload, err := s.readManifest(desc.Digest)
+	if err != nil {
+		return manifest.Descriptor{}, err
+	}
+	mediaType := manifestMediaType(desc, payload)
+	m, _, err := manifest.UnmarshalManifest(mediaType, payload)
+	if err != nil {
+		return manifest.Descriptor{}, fmt.Errorf("%w: invalid manifest %s: %w", ocilayout.ErrInvalidArchive,
+			desc.Digest, err)
+	}
+
+	stored := manifest.Descriptor{MediaType: mediaType, Digest: desc.Digest, Size: int64(len(payload))}
+	if isManifestList(mediaType) {
+		children := make([]manifest.Descriptor, 0, len(m.References()))
+		changed := false
+		for _, child := range m.References() {
+			if !s.hasManifest(child.Digest) {
+				changed = true
+				continue
+			}
+			storedChild, err := i.importManifest(ctx, s, image, child, "")
+			if err != nil {
+				return manifest.Descriptor{}, err
+			}
+			changed = changed || storedChild.Digest != child.Digest
+			child.Digest, child.Size = storedChild.Digest, storedChild.Size
+			children = append(children, child)
+		}
+		if len(children) == 0 {
+			return manifest.Descriptor{}, fmt.Errorf("%w: the archive has none of the manifests of list %s",
+				ocilayout.ErrInvalidArchive, desc.Digest)
+		}
+		if changed {
+			if stored, err = s.rebuildList(m, children); err != nil {
+				return manifest.Descriptor{}, err
+			}
+			payload = s.built[stored.Digest]
+		}
+	} else {
+		for _, ref := range m.References() {
+			if err = i.importBlob(ctx, s, image, ref); err != nil {
+				return manifest.Descriptor{}, err
+			}
+		}
+	}
+
+	artInfo := s.info
+	artInfo.ArtifactInfo = &pkg.ArtifactInfo{}
+	*artInfo.ArtifactInfo = *s.info.ArtifactInfo
+	artInfo.Image = image
+	artInfo.Digest = stored.Digest.String()
+	artInfo.Tag = tag
+	artInfo.Reference = tag
+	if tag == "" {
+		artInfo.Reference = stored.Digest.String()
+	}
+	_, errs := i.local.PutManifest(ctx, artInfo, stored.MediaType, io.NopCloser(bytes.NewReader(payload)),
+		int64(len(payload)))
+	if len(errs) > 0 {
+		return manifest.Descriptor{}, fmt.Errorf("failed to store manifest %s: %w", stored.Digest, errs[0])
+	}
+	s.manifests[key] = stored
+	return stored, nil
+}
+
+// rebuildList rebuilds a manifest list with the given manifests, keeping its media type and annotations.
+func (s *importState) rebuildList(m manifest.Manifest, children []manifest.Descriptor) (manifest.Descriptor, error) {
+	if _, ok := m.(*manifestlist.DeserializedManifestList); ok {
+		descriptors := make([]manifestlist.ManifestDescriptor, 0, len(children))
+		for _, child := range children {
+			d := manifestlist.ManifestDescriptor{Descriptor: child}
+			if child.Platform != nil {
+				d.Platform = manifestlist.PlatformSpec{
+					Architecture: child.Platform.Architecture,
+					OS:           child.Platform.OS,
+					OSVersion:    child.Platform.OSVersion,
+					OSFeatures:   child.Platform.OSFeatures,
+					Variant:      child.Platform.Variant,
+				}
+			}
+			d.Descriptor.Platform = nil
+			descriptors = append(descriptors, d)
+		}
+		list, err := manifestlist.FromDescriptors(descriptors)
+		if err != nil {
+			return manifest.Descriptor{}, fmt.Errorf("failed to rebuild manifest list: %w", err)
+		}
+		return s.store(manifestlist.MediaTypeManifestList, list)
+	}
+
+	var annotations map[string]string
+	if index, ok := m.(*ocischema.DeserializedImageIndex); ok {
+		annotations = index.ImageIndex.Annotations
+	}
+	index, err := ocischema.FromDescriptors(children, annotations)
+	if err != nil {
+		return manifest.Descriptor{}, fmt.Errorf("failed to rebuild image index: %w", err)
+	}
+	return s.store(v1.MediaTypeImageIndex, index)
+}
+
+// store keeps a manifest built by the import, so it's read like the manifests of the archive.
+func (s *importState) store(mediaType string, m manifest.Manifest) (manifest.Descriptor, error) {
+	_, payload, err := m.Payload()
+	if err != nil {
+		return manifest.Descriptor{}, fmt.Errorf("failed to get payload of manifest: %w", err)
+	}
+	d := digest.FromBytes(payload)
+	s.built[d] = payload
+	return manifest.Descriptor{MediaType: mediaType, Digest: d, Size: int64(len(payload))}, nil
+}
+
+func (s *importState) hasManifest(d digest.Digest) bool {
+	_, ok := s.built[d]
+	return ok || s.archive.Has(d)
+}
+
+func (s *importState) readManifest(d digest.Digest) ([]byte, error) {
+	if payload, ok := s.built[d]; ok {
+		return payload, nil
+	}
+	return s.archive.ReadManifest(d)
+}
+
+// importBlob links the blob to the image when the root space has it already and uploads it otherwise.
+func (i *Importer) importBlob(ctx context.Context, s *importState, image string, desc manifest.Descriptor) error {
+	key := image + "@" + desc.Digest.String()
+	if s.blobs[key] {
+		return nil
+	}
+	if !s.archive.Has(desc.Digest) {
+		if len(desc.URLs) > 0 {
+			// foreign layers are pulled from their URLs, they aren't part of archives.
+			return nil
+		}
+		return fmt.Errorf("%w: blob %s not found", ocilayout.ErrInvalidArchive, desc.Digest)
+	}
+
+	artInfo := s.info
+	artInfo.ArtifactInfo = &pkg.ArtifactInfo{}
+	*artInfo.ArtifactInfo = *s.info.ArtifactInfo
+	artInfo.Image = image
+	artInfo.Digest = desc.Digest.String()
+
+	blob, err := i.local.blobRepo.FindByDigestAndRootParentID(ctx, desc.Digest, artInfo.RootParentID)
+	switch {
+	case err == nil:
+		err = i.local.dbPutBlobUploadComplete(ctx, "application/octet-stream", desc.Digest.String(),
+			int(blob.Size), "", artInfo, nil)
+		if err != nil {
+			return fmt.Errorf("failed to link blob %s: %w", desc.Digest, err)
+		}
+		s.result.BlobsLinked++
+	case errors.Is(err, store2.ErrResourceNotFound):
+		if err = i.uploadBlob(ctx, s, artInfo, desc.Digest); err != nil {
+			return err
+		}
+		s.result.BlobsUploaded++
+	default:
+		return fmt.Errorf("failed to find blob %s: %w", desc.Digest, err)
+	}
+	s.blobs[key] = true
+	return nil
+}
+
+func (i *Importer) uploadBlob(ctx context.Context, s *importState, artInfo pkg.RegistryInfo, d digest.Digest) error {
+	content, _, err := s.archive.Open(d)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	blobCtx := i.local.App.GetBlobsContext(ctx, artInfo, types.BlobLocator{
+		RegistryID:   artInfo.RegistryID,
+		RootParentID: artInfo.RootParentID,
+	})
+	upload, err := blobCtx.OciBlobStore.Create(blobCtx.Context) //nolint:contextcheck
+	if err != nil {
+		return fmt.Errorf("failed to create upload of blob %s: %w", d, err)
+	}
+	defer upload.Close()
+
+	if _, err = io.Copy(upload, content); err != nil {
+		return fmt.Errorf("failed to upload blob %s: %w", d, err)
+	}
+	//nolint:contextcheck
+	desc, err := upload.Commit(blobCtx, artInfo.RootIdentifier, manifest.Descriptor{Digest: d})
+	if err != nil {
+		//nolint:contextcheck
+		if cErr := upload.Cancel(blobCtx); cErr != nil {
+			log.Ctx(ctx).Warn().Err(cErr).Msgf("failed to cancel upload of blob %s", d)
+		}
+		return fmt.Errorf("failed to commit blob %s: %w", d, err)
+	}
+
+	commitCallback := hook.EmitCommitEventCallback(ctx, i.local.blobActionHook, hook.BlobCommitEvent{
+		BlobEventBase: hook.BlobEventBase{
+			BlobLocator: types.BlobLocator{
+				Digest:       desc.Digest,
+				RegistryID:   artInfo.RegistryID,
+				RootParentID: artInfo.RootParentID,
+			},
+			ClientIP:  audit.GetRealIP(ctx),
+			BucketKey: blobCtx.OciBlobStore.BucketKey(),
+		},
+		Digests: types.BlobDigests{
+			SHA256: desc.Digest,
+		},
+		Size: desc.Size,
+	})
+	err = i.local.dbPutBlobUploadComplete(ctx, "application/octet-stream", d.String(), int(desc.Size),
+		blobCtx.OciBlobStore.Path(), artInfo, commitCallback)
+	if err != nil {
+		return fmt.Errorf("failed to store blob %s: %w", d, err)
+	}
+	return nil
+}
+
+// manifestMediaType returns the media type of a manifest, from its payload when the descriptor has none.
+func manifestMediaType(desc manifest.Descriptor, payload []byte) string {
+	if desc.MediaType != "" {
+		return desc.MediaType
+	}
+	var versioned manifest.Versioned
+	if err := json.Unmarshal(payload, &versioned); err == nil && versioned.MediaType != "" {
+		return versioned.MediaType
+	}
+	return v1.MediaTypeImageManifest
+}
+
+func isManifestList(mediaType string) bool {
+	return mediaType == v1.MediaTypeImageIndex || mediaType == manifestlist.MediaTypeManifestList
+}
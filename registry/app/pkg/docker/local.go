@@ -811,11 +811,24 @@ func (r *LocalRegistry) PutManifest(
 	// We don't need to store manifest file in S3 storage
 	// manifestServicePut(ctx, _manifest, options...)
 
+	helmMetadata, err := r.getHelmChartMetadata(ctx, artInfo, unmarshalManifest)
+	if err != nil {
+		errs = r.appendPutError(err, errs)
+		return responseHeaders, errs
+	}
+
 	if err = r.ms.DBPut(ctx, unmarshalManifest, d, responseHeaders, artInfo); err != nil {
 		errs = r.appendPutError(err, errs)
 		return responseHeaders, errs
 	}
 
+	if helmMetadata != nil {
+		if err = r.storeHelmChartMetadata(ctx, artInfo, d, helmMetadata); err != nil {
+			errs = append(errs, errcode.ErrCodeUnknown.WithDetail(err))
+			return responseHeaders, errs
+		}
+	}
+
 	// Tag this manifest
 	if tag != "" {
 		if err = r.ms.DBTag(ctx, unmarshalManifest, d, tag, responseHeaders, artInfo); err != nil {
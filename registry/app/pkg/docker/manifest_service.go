@@ -25,7 +25,6 @@ import (
 	"time"
 
 	"github.com/harness/gitness/app/api/request"
-	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/app/services/refcache"
 	urlprovider "github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/audit"
@@ -42,6 +41,7 @@ import (
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/registry/gc"
+	"github.com/harness/gitness/registry/services/outbox"
 	"github.com/harness/gitness/registry/services/webhook"
 	"github.com/harness/gitness/registry/types"
 	gitnessstore "github.com/harness/gitness/store"
@@ -70,7 +70,7 @@ type manifestService struct {
 	gcService               gc.Service
 	tx                      dbtx.Transactor
 	reporter                event.Reporter
-	artifactEventReporter   registryevents.Reporter
+	outbox                  *outbox.Outbox
 	urlProvider             urlprovider.Provider
 	untaggedImagesEnabled   func(ctx context.Context) bool
 	auditService            audit.Service
@@ -82,9 +82,10 @@ func NewManifestService(
 	imageDao store.ImageRepository, artifactDao store.ArtifactRepository,
 	layerDao store.LayerRepository, manifestRefDao store.ManifestReferenceRepository,
 	tx dbtx.Transactor, gcService gc.Service, reporter event.Reporter, spaceFinder refcache.SpaceFinder,
-	ociImageIndexMappingDao store.OCIImageIndexMappingRepository, artifactEventReporter registryevents.Reporter,
+	ociImageIndexMappingDao store.OCIImageIndexMappingRepository,
 	urlProvider urlprovider.Provider, untaggedImagesEnabled func(ctx context.Context) bool,
 	auditService audit.Service,
+	outbox *outbox.Outbox,
 ) ManifestService {
 	return &manifestService{
 		registryDao:             registryDao,
@@ -101,10 +102,10 @@ func NewManifestService(
 		reporter:                reporter,
 		spaceFinder:             spaceFinder,
 		ociImageIndexMappingDao: ociImageIndexMappingDao,
-		artifactEventReporter:   artifactEventReporter,
 		urlProvider:             urlProvider,
 		untaggedImagesEnabled:   untaggedImagesEnabled,
 		auditService:            auditService,
+		outbox:                  outbox,
 	}
 }
 
@@ -211,14 +212,14 @@ func (l *manifestService) dbTagManifest(
 	if err != nil {
 		return formatFailedToTagErr(err)
 	}
-	err = l.addTagsWithTx(ctx, dbRegistry, dbManifest, []string{tagName}, imageName)
+	events, err := l.addTagsWithTx(ctx, info, dbManifest, dgst, []string{tagName}, imageName)
 	if err != nil {
 		return formatFailedToTagErr(err)
 	}
+	l.outbox.Publish(ctx, events...)
 	spacePath, packageType, err := l.getSpacePathAndPackageType(ctx, &dbRegistry)
 	if err == nil {
-		session, _ := request.AuthSessionFrom(ctx)
-		l.reportEvents(ctx, dgst, info, imageName, tagName, packageType, spacePath, dbManifest, session)
+		l.reportEvents(ctx, info, imageName, tagName, packageType, spacePath, dbManifest)
 	} else {
 		log.Ctx(ctx).Err(err).Msg("Failed to find spacePath, not publishing event")
 	}
@@ -257,31 +258,38 @@ func (l *manifestService) AddTagsToManifest(
 		}
 	}
 
-	err = l.addTagsWithTx(ctx, dbRegistry, dbManifest, newTags, imageName)
+	events, err := l.addTagsWithTx(ctx, info, dbManifest, dgst, newTags, imageName)
 	if err != nil {
 		return formatFailedToTagErr(err)
 	}
+	l.outbox.Publish(ctx, events...)
 	spacePath, packageType, err := l.getSpacePathAndPackageType(ctx, &dbRegistry)
 	if err != nil {
 		log.Ctx(ctx).Err(err).Msg("Failed to find spacePath, not publishing event")
 	}
 
-	session, _ := request.AuthSessionFrom(ctx)
 	for _, tag := range newTags {
-		l.reportEvents(ctx, dgst, info, imageName, tag, packageType, spacePath, dbManifest, session)
+		l.reportEvents(ctx, info, imageName, tag, packageType, spacePath, dbManifest)
 	}
 
 	return nil
 }
 
+// addTagsWithTx tags the manifest and stores the artifact-created events of the tags in the same transaction,
+// the returned events are to be published once it committed.
 func (l *manifestService) addTagsWithTx(
 	ctx context.Context,
-	dbRegistry types.Registry,
+	info pkg.RegistryInfo,
 	dbManifest *types.Manifest,
+	dgst digest.Digest,
 	tags []string,
 	imageName string,
-) error {
+) ([]*types.OutboxEvent, error) {
+	dbRegistry := info.Registry
+	session, _ := request.AuthSessionFrom(ctx)
+	var events []*types.OutboxEvent
 	err := l.tx.WithTx(ctx, func(ctx context.Context) error {
+		events = nil
 		// Prevent long running transactions by setting an upper limit of manifestTagGCLockTimeout. If the GC is holding
 		// the lock of a related review record, the processing there should be fast enough to avoid this. Regardless, we
 		// should not let transactions open (and clients waiting) for too long. If this sensible timeout is exceeded, abort
@@ -299,22 +307,32 @@ func (l *manifestService) addTagsWithTx(
 			if err := l.upsertTag(ctx, dbRegistry.ID, dbManifest.ID, imageName, tag); err != nil {
 				return formatFailedToTagErr(err)
 			}
+
+			createPayload := webhook.GetArtifactCreatedPayload(ctx, info, session.Principal.ID,
+				dbRegistry.ID, dbRegistry.Name, tag, dgst.String(), l.urlProvider)
+			e, err := l.outbox.ArtifactCreated(ctx, &createPayload)
+			if err != nil {
+				return err
+			}
+			events = append(events, e)
 		}
 		return nil
 	})
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
 }
 
+// reportEvents reports the tag to the scanners, the artifact-created webhook event goes through the outbox.
 func (l *manifestService) reportEvents(
 	ctx context.Context,
-	dgst digest.Digest,
 	info pkg.RegistryInfo,
 	imageName string,
 	tag string,
 	packageType event.PackageType,
 	spacePath string,
 	dbManifest *types.Manifest,
-	session *auth.Session,
 ) {
 	reg := info.Registry
 	if !l.untaggedImagesEnabled(ctx) {
@@ -323,10 +341,6 @@ func (l *manifestService) reportEvents(
 			spacePath, dbManifest.ID,
 		)
 	}
-
-	createPayload := webhook.GetArtifactCreatedPayload(ctx, info, session.Principal.ID,
-		reg.ID, reg.Name, tag, dgst.String(), l.urlProvider)
-	l.artifactEventReporter.ArtifactCreated(ctx, &createPayload)
 }
 
 func formatFailedToTagErr(err error) error {
@@ -1178,23 +1192,53 @@ func (l *manifestService) DeleteTag(
 	if err != nil {
 		return false, err
 	}
-
-	existingDigest := l.getTagDigest(ctx, registry.ID, info.Image, tag)
-	deleted, err := l.tagDao.DeleteTagByName(ctx, registry.ID, tag)
+	space, err := l.spaceFinder.FindByID(ctx, registry.ParentID)
 	if err != nil {
-		return false, fmt.Errorf("failed to delete tag in database: %w", err)
-	}
-	if !deleted {
-		return false, distribution.ErrTagUnknown{Tag: tag}
+		return false, fmt.Errorf("failed to find space of registry: %w", err)
 	}
 
-	if existingDigest != "" {
+	// the artifact-deleted and artifact-soft-deleted events are stored along with the deletion, so they aren't lost
+	// if we crash after the commit.
+	var events []*types.OutboxEvent
+	err = l.tx.WithTx(ctx, func(ctx context.Context) error {
+		existingDigest := l.getTagDigest(ctx, registry.ID, info.Image, tag)
+		deleted, err := l.tagDao.DeleteTagByName(ctx, registry.ID, tag)
+		if err != nil {
+			return fmt.Errorf("failed to delete tag in database: %w", err)
+		}
+		if !deleted {
+			return distribution.ErrTagUnknown{Tag: tag}
+		}
+
 		session, _ := request.AuthSessionFrom(ctx)
-		payload := webhook.GetArtifactDeletedPayload(ctx, session.Principal.ID, registry.ID,
-			registry.Name, tag, existingDigest.String(), info.RootIdentifier, info.PackageType, info.Image,
-			l.urlProvider, l.untaggedImagesEnabled(ctx))
-		l.artifactEventReporter.ArtifactDeleted(ctx, &payload)
+		if existingDigest != "" {
+			payload := webhook.GetArtifactDeletedPayload(ctx, session.Principal.ID, registry.ID,
+				registry.UUID, space.Path, registry.Name, tag, existingDigest.String(), info.RootIdentifier,
+				info.PackageType, info.Image, l.urlProvider, l.untaggedImagesEnabled(ctx))
+			deletedEvent, err := l.outbox.ArtifactDeleted(ctx, &payload)
+			if err != nil {
+				return err
+			}
+			events = append(events, deletedEvent)
+		}
+		softDeletedEvent, err := l.outbox.ArtifactSoftDeleted(ctx, &registryevents.ArtifactSoftDeletedPayload{
+			RegistryID:   registry.ID,
+			RegistryUUID: registry.UUID,
+			PrincipalID:  session.Principal.ID,
+			ArtifactType: info.PackageType,
+			Image:        info.Image,
+			Version:      tag,
+		})
+		if err != nil {
+			return err
+		}
+		events = append(events, softDeletedEvent)
+		return nil
+	})
+	if err != nil {
+		return false, err
 	}
+	l.outbox.Publish(ctx, events...)
 
 	return true, nil
 }
@@ -1317,37 +1361,21 @@ func (l *manifestService) DeleteManifest(
 				}
 			}
 
-			found, err := l.manifestDao.DeleteManifest(ctx, registry.ID, imageName, d)
+			// The manifest, its tags and its artifact are only soft-deleted so that they can be restored from the
+			// trash. Its blobs stay referenced, and are only reviewed by the online GC, once the manifest is purged.
+			err := l.manifestDao.SoftDelete(ctx, registry.ID, m.ID)
 			if err != nil {
+				if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+					return util.ErrManifestNotFound
+				}
 				return err
 			}
-			if !found {
-				return util.ErrManifestNotFound
-			}
-
-			_, err = l.tagDao.DeleteTagByManifestID(ctx, registry.ID, m.ID)
-			if err != nil {
-				return fmt.Errorf("failed to delete tags for: %s, err: %w", d.String(), err)
-			}
 
-			err = l.artifactDao.DeleteByVersionAndImageName(ctx, imageName, newDigest.String(), registry.ID)
+			err = l.artifactDao.SoftDeleteByVersionAndImageName(ctx, imageName, newDigest.String(), registry.ID)
 			if err != nil {
 				return fmt.Errorf("failed to delete artifact for: %s, err: %w", d.String(), err)
 			}
 
-			count, err := l.manifestDao.CountByImageName(ctx, registry.ID, imageName)
-			if err != nil {
-				return fmt.Errorf("failed to count manifests for image: %s, err: %w", imageName, err)
-			}
-			if count < 1 {
-				err = l.imageDao.DeleteByImageNameAndRegID(
-					ctx, registry.ID, imageName,
-				)
-				if err != nil {
-					return fmt.Errorf("failed to delete image: %s, err: %w", imageName, err)
-				}
-			}
-
 			return nil
 		},
 	)
@@ -23,7 +23,6 @@ import (
 	"github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/registry/app/event"
-	registryevents "github.com/harness/gitness/registry/app/events/artifact"
 	"github.com/harness/gitness/registry/app/events/replication"
 	"github.com/harness/gitness/registry/app/manifest/manifestlist"
 	"github.com/harness/gitness/registry/app/manifest/schema2"
@@ -34,6 +33,7 @@ import (
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/gc"
+	"github.com/harness/gitness/registry/services/outbox"
 	types2 "github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/secret"
 	"github.com/harness/gitness/store/database/dbtx"
@@ -65,6 +65,14 @@ func LocalRegistryProvider(
 	return registry
 }
 
+func ImporterProvider(local *LocalRegistry, config *types.Config) *Importer {
+	return NewImporter(local, config.Registry.Import.MaxArchiveSize)
+}
+
+func ExporterProvider(local *LocalRegistry) *Exporter {
+	return NewExporter(local)
+}
+
 func ManifestServiceProvider(
 	registryDao store.RegistryRepository,
 	manifestDao store.ManifestRepository, blobRepo store.BlobRepository, mtRepository store.MediaTypesRepository,
@@ -72,16 +80,16 @@ func ManifestServiceProvider(
 	artifactDao store.ArtifactRepository, layerDao store.LayerRepository,
 	gcService gc.Service, tx dbtx.Transactor, reporter event.Reporter, spaceFinder refcache.SpaceFinder,
 	ociImageIndexMappingDao store.OCIImageIndexMappingRepository,
-	artifactEventReporter *registryevents.Reporter,
 	urlProvider url.Provider,
 	auditService audit.Service,
+	outbox *outbox.Outbox,
 ) ManifestService {
 	return NewManifestService(
 		registryDao, manifestDao, blobRepo, mtRepository, tagDao, imageDao,
 		artifactDao, layerDao, manifestRefDao, tx, gcService, reporter, spaceFinder,
-		ociImageIndexMappingDao, *artifactEventReporter, urlProvider, func(_ context.Context) bool {
+		ociImageIndexMappingDao, urlProvider, func(_ context.Context) bool {
 			return true
-		}, auditService)
+		}, auditService, outbox)
 }
 
 func RemoteRegistryProvider(
@@ -155,7 +163,9 @@ func getManifestCacheHandler(
 
 var ControllerSet = wire.NewSet(ControllerProvider)
 var DBStoreSet = wire.NewSet(DBStoreProvider)
-var RegistrySet = wire.NewSet(LocalRegistryProvider, ManifestServiceProvider, RemoteRegistryProvider)
+var RegistrySet = wire.NewSet(
+	LocalRegistryProvider, ManifestServiceProvider, RemoteRegistryProvider, ImporterProvider, ExporterProvider,
+)
 var ProxySet = wire.NewSet(ProvideProxyController)
 var StorageServiceSet = wire.NewSet(StorageServiceProvider)
 var AppSet = wire.NewSet(NewApp)
@@ -205,6 +215,7 @@ var OpenSourceWireSet = wire.NewSet(
 	WireSet,
 	OciBlobStoreSet,
 	BucketServiceSet,
+	hook.ProvideBlobActionHookRegistry,
 	hook.ProvideBlobCommitHook,
 	storage.NewStaticStorageResolver,
 )
@@ -0,0 +1,72 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package failedupload
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+
+	"github.com/harness/gitness/registry/types"
+)
+
+// classError tags an upload error with its class when the class can't be told from the error itself.
+type classError struct {
+	class types.UploadErrorClass
+	err   error
+}
+
+func (e *classError) Error() string {
+	return e.err.Error()
+}
+
+func (e *classError) Unwrap() error {
+	return e.err
+}
+
+// WithClass tags err with the class it's counted in by the upload failure stats.
+func WithClass(class types.UploadErrorClass, err error) error {
+	return &classError{class: class, err: err}
+}
+
+// ClassifyError returns the class of an upload error. Errors which weren't tagged with WithClass are classified
+// by the errors of the archive and metadata decoders they wrap.
+func ClassifyError(err error) types.UploadErrorClass {
+	var ce *classError
+	if errors.As(err, &ce) {
+		return ce.class
+	}
+
+	if errors.Is(err, zip.ErrFormat) || errors.Is(err, zip.ErrAlgorithm) || errors.Is(err, zip.ErrChecksum) ||
+		errors.Is(err, gzip.ErrHeader) || errors.Is(err, gzip.ErrChecksum) || errors.Is(err, tar.ErrHeader) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		return types.UploadErrorClassInvalidArchive
+	}
+
+	var xmlSyntaxErr *xml.SyntaxError
+	var xmlUnmarshalErr xml.UnmarshalError
+	var jsonSyntaxErr *json.SyntaxError
+	var jsonTypeErr *json.UnmarshalTypeError
+	if errors.As(err, &xmlSyntaxErr) || errors.As(err, &xmlUnmarshalErr) || errors.As(err, &jsonSyntaxErr) ||
+		errors.As(err, &jsonTypeErr) {
+		return types.UploadErrorClassInvalidMetadata
+	}
+
+	return types.UploadErrorClassUnknown
+}
@@ -0,0 +1,162 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package failedupload
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// maxErrorLength bounds the parse error stored with a failed upload, parsers can quote large parts of the input.
+const maxErrorLength = 4096
+
+// statsRetention is how long the daily counts of failed uploads are kept.
+const statsRetention = 90 * 24 * time.Hour
+
+// Recorder keeps the content of uploads whose package couldn't be parsed for a while, so publishers can
+// download what their build actually pushed along with the parse error. It also counts the failed uploads by
+// error class, so systemic problems like a broken publisher plugin show up in the upload failure stats.
+type Recorder struct {
+	fileManager filemanager.FileManager
+	store       store.FailedUploadRepository
+	statsStore  store.UploadFailureStatsRepository
+	ttl         time.Duration
+}
+
+// NewRecorder returns a recorder which keeps failed uploads for ttl, nothing is kept when ttl isn't positive.
+// Failed uploads are counted either way.
+func NewRecorder(
+	fileManager filemanager.FileManager,
+	store store.FailedUploadRepository,
+	statsStore store.UploadFailureStatsRepository,
+	ttl time.Duration,
+) *Recorder {
+	return &Recorder{
+		fileManager: fileManager,
+		store:       store,
+		statsStore:  statsStore,
+		ttl:         ttl,
+	}
+}
+
+// GetPath returns the path of the content of a failed upload. It's kept out of the file tree of the packages
+// and keyed by the UUID of the upload.
+func GetPath(upload *types.FailedUpload) string {
+	return "/.failed-uploads/" + upload.UUID
+}
+
+// Record counts the upload described by fileInfo, which failed to parse with parseErr, and keeps its blob.
+// It's best effort: failures are only logged as the upload fails with parseErr anyway.
+func (r *Recorder) Record(
+	ctx context.Context,
+	info pkg.ArtifactInfo,
+	packageType artifact.PackageType,
+	filename string,
+	fileInfo types.FileInfo,
+	parseErr error,
+) {
+	if r == nil || parseErr == nil {
+		return
+	}
+
+	now := time.Now()
+	err := r.statsStore.Increment(ctx, info.RegistryID, packageType, ClassifyError(parseErr), now)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to count failed upload to registry: %d", info.RegistryID)
+	}
+
+	if r.ttl <= 0 {
+		return
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	upload := &types.FailedUpload{
+		UUID:        uuid.NewString(),
+		RegistryID:  info.RegistryID,
+		PackageType: packageType,
+		Filename:    filename,
+		Sha256:      fileInfo.Sha256,
+		Size:        fileInfo.Size,
+		Error:       truncateError(parseErr.Error()),
+		CreatedBy:   session.Principal.ID,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(r.ttl),
+	}
+
+	err = r.fileManager.PostFileUpload(ctx, GetPath(upload), info.RegistryID, info.RootParentID,
+		info.RootIdentifier, fileInfo, upload.CreatedBy)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to keep content of failed upload to registry: %d",
+			info.RegistryID)
+		return
+	}
+	if err = r.store.Create(ctx, upload); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to store failed upload to registry: %d", info.RegistryID)
+		// the content would be kept forever without the row which expires it.
+		if err = r.fileManager.DeleteFile(ctx, info.RegistryID, GetPath(upload)); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to delete content of failed upload %s", upload.UUID)
+		}
+	}
+}
+
+// PurgeExpired deletes up to limit failed uploads which expired before now along with their content, it
+// returns the number of deleted uploads.
+func (r *Recorder) PurgeExpired(ctx context.Context, now time.Time, limit int) (int, error) {
+	uploads, err := r.store.ListExpired(ctx, now, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired failed uploads: %w", err)
+	}
+
+	for i, upload := range uploads {
+		if err = ctx.Err(); err != nil {
+			return i, err
+		}
+		// the blob itself is garbage collected once no file references it.
+		if err = r.fileManager.DeleteFile(ctx, upload.RegistryID, GetPath(upload)); err != nil {
+			return i, fmt.Errorf("failed to delete content of failed upload %s: %w", upload.UUID, err)
+		}
+		if err = r.store.Delete(ctx, upload.ID); err != nil {
+			return i, fmt.Errorf("failed to delete failed upload %s: %w", upload.UUID, err)
+		}
+	}
+	return len(uploads), nil
+}
+
+// PurgeStats deletes the daily counts of failed uploads which are older than the retention of the stats.
+func (r *Recorder) PurgeStats(ctx context.Context, now time.Time) (int64, error) {
+	deleted, err := r.statsStore.DeleteBefore(ctx, now.Add(-statsRetention))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete upload failure stats: %w", err)
+	}
+	return deleted, nil
+}
+
+func truncateError(msg string) string {
+	if len(msg) <= maxErrorLength {
+		return msg
+	}
+	return msg[:maxErrorLength] + "..."
+}
@@ -0,0 +1,196 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package failedupload
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/types"
+	gitnesstypes "github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeFileManager struct {
+	filemanager.FileManager
+	files map[string]string
+}
+
+func (f *fakeFileManager) PostFileUpload(
+	_ context.Context, filePath string, _ int64, _ int64, _ string, fileInfo types.FileInfo, _ int64,
+) error {
+	f.files[filePath] = fileInfo.Sha256
+	return nil
+}
+
+func (f *fakeFileManager) DeleteFile(_ context.Context, _ int64, filePath string) error {
+	delete(f.files, filePath)
+	return nil
+}
+
+func newTestRecorder(
+	t *testing.T, ttl time.Duration,
+) (*Recorder, *fakeFileManager, *mocks.MockFailedUploadRepository, *mocks.MockUploadFailureStatsRepository) {
+	fileManager := &fakeFileManager{files: map[string]string{}}
+	store := mocks.NewMockFailedUploadRepository(t)
+	statsStore := mocks.NewMockUploadFailureStatsRepository(t)
+	return NewRecorder(fileManager, store, statsStore, ttl), fileManager, store, statsStore
+}
+
+func testContext() context.Context {
+	return request.WithAuthSession(context.Background(), &auth.Session{
+		Principal: gitnesstypes.Principal{ID: 7},
+	})
+}
+
+func TestRecord(t *testing.T) {
+	recorder, fileManager, store, statsStore := newTestRecorder(t, time.Hour)
+	info := pkg.ArtifactInfo{BaseInfo: &pkg.BaseInfo{}, RegistryID: 3}
+	fileInfo := types.FileInfo{Sha256: "abc", Size: 42}
+
+	var upload *types.FailedUpload
+	statsStore.EXPECT().Increment(mock.Anything, int64(3), artifact.PackageTypeNUGET,
+		types.UploadErrorClassUnknown, mock.Anything).Return(nil).Once()
+	store.EXPECT().Create(mock.Anything, mock.Anything).Run(func(_ context.Context, u *types.FailedUpload) {
+		u.ID = 1
+		upload = u
+	}).Return(nil).Once()
+
+	recorder.Record(testContext(), info, artifact.PackageTypeNUGET, "pkg.nupkg", fileInfo,
+		errors.New(strings.Repeat("x", maxErrorLength+10)))
+
+	if upload == nil {
+		t.Fatal("expected a failed upload to be created")
+	}
+	if upload.RegistryID != 3 || upload.CreatedBy != 7 || upload.Sha256 != "abc" || upload.Size != 42 {
+		t.Errorf("unexpected failed upload: %+v", upload)
+	}
+	if len(upload.Error) != maxErrorLength+len("...") {
+		t.Errorf("expected the error to be truncated, got length %d", len(upload.Error))
+	}
+	if upload.ExpiresAt.Sub(upload.CreatedAt) != time.Hour {
+		t.Errorf("expected the upload to expire after the ttl, got %s", upload.ExpiresAt.Sub(upload.CreatedAt))
+	}
+	if fileManager.files[GetPath(upload)] != "abc" {
+		t.Errorf("expected the content to be kept at %s", GetPath(upload))
+	}
+}
+
+func TestRecordDisabled(t *testing.T) {
+	recorder, fileManager, _, statsStore := newTestRecorder(t, 0)
+	info := pkg.ArtifactInfo{BaseInfo: &pkg.BaseInfo{}, RegistryID: 3}
+
+	// the failed upload is counted once, nothing is kept as the ttl is zero.
+	statsStore.EXPECT().Increment(mock.Anything, int64(3), artifact.PackageTypeRPM,
+		types.UploadErrorClassUnknown, mock.Anything).Return(nil).Once()
+
+	recorder.Record(testContext(), info, artifact.PackageTypeRPM, "", types.FileInfo{}, errors.New("bad"))
+	(*Recorder)(nil).Record(testContext(), info, artifact.PackageTypeRPM, "", types.FileInfo{}, errors.New("bad"))
+
+	if len(fileManager.files) != 0 {
+		t.Errorf("expected nothing to be kept, got %d files", len(fileManager.files))
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want types.UploadErrorClass
+	}{
+		{"archive", fmt.Errorf("failed to read nupkg: %w", zip.ErrFormat), types.UploadErrorClassInvalidArchive},
+		{"xml", fmt.Errorf("failed to decode nuspec: %w", &xml.SyntaxError{Msg: "eof"}),
+			types.UploadErrorClassInvalidMetadata},
+		{"json", fmt.Errorf("bad package.json: %w", &json.SyntaxError{}), types.UploadErrorClassInvalidMetadata},
+		{"tagged", WithClass(types.UploadErrorClassInvalidVersion, zip.ErrFormat),
+			types.UploadErrorClassInvalidVersion},
+		{"unknown", errors.New("something else"), types.UploadErrorClassUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyError(tt.err); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRecordDeletesContentWhenStoreFails(t *testing.T) {
+	recorder, fileManager, store, statsStore := newTestRecorder(t, time.Hour)
+	info := pkg.ArtifactInfo{BaseInfo: &pkg.BaseInfo{}, RegistryID: 3}
+
+	statsStore.EXPECT().Increment(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Once()
+	store.EXPECT().Create(mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+
+	recorder.Record(testContext(), info, artifact.PackageTypeRPM, "", types.FileInfo{Sha256: "abc"},
+		errors.New("bad"))
+
+	if len(fileManager.files) != 0 {
+		t.Errorf("expected the content to be deleted, got %v", fileManager.files)
+	}
+}
+
+func TestPurgeExpired(t *testing.T) {
+	recorder, fileManager, store, _ := newTestRecorder(t, time.Hour)
+	expired := &types.FailedUpload{ID: 1, UUID: "old", RegistryID: 3}
+	unexpired := &types.FailedUpload{ID: 2, UUID: "new", RegistryID: 3}
+	fileManager.files[GetPath(expired)] = "old"
+	fileManager.files[GetPath(unexpired)] = "new"
+	now := time.Now()
+
+	store.EXPECT().ListExpired(mock.Anything, now, 10).Return([]*types.FailedUpload{expired}, nil).Once()
+	store.EXPECT().Delete(mock.Anything, int64(1)).Return(nil).Once()
+
+	purged, err := recorder.PurgeExpired(context.Background(), now, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("expected 1 purged upload, got %d", purged)
+	}
+	if len(fileManager.files) != 1 || fileManager.files[GetPath(unexpired)] != "new" {
+		t.Errorf("expected only the content of the unexpired upload to be left, got %v", fileManager.files)
+	}
+}
+
+func TestPurgeStats(t *testing.T) {
+	recorder, _, _, statsStore := newTestRecorder(t, time.Hour)
+	now := time.Now()
+
+	statsStore.EXPECT().DeleteBefore(mock.Anything, now.Add(-statsRetention)).Return(4, nil).Once()
+
+	deleted, err := recorder.PurgeStats(context.Background(), now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deleted != 4 {
+		t.Errorf("expected 4 deleted stats, got %d", deleted)
+	}
+}
@@ -16,6 +16,7 @@ package filemanager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -30,6 +31,7 @@ import (
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
 	"github.com/harness/gitness/store/database/dbtx"
 	gitnesstypes "github.com/harness/gitness/types"
 
@@ -116,6 +118,52 @@ func (f *fileManager) UploadFile(
 	return fileInfo, nil
 }
 
+func (f *fileManager) LinkFile(
+	ctx context.Context,
+	filePath string,
+	regID int64,
+	rootParentID int64,
+	sha256 string,
+	principalID int64,
+) (types.FileInfo, bool, error) {
+	var fileInfo types.FileInfo
+	found := false
+	err := f.tx.WithTx(ctx, func(ctx context.Context) error {
+		gb, err := f.genericBlobDao.FindBySha256AndRootParentID(ctx, sha256, rootParentID)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find generic blob with sha256 : %s, err: %w", sha256, err)
+		}
+		// the blob is only linked when the registry already has a file with it, as the caller may not have
+		// access to the other registries of the root parent.
+		_, err = f.nodesDao.GetByBlobIDAndRegistryID(ctx, gb.ID, regID)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find file of generic blob %s in registry %d: %w", gb.ID, regID, err)
+		}
+		if err = f.createNodes(ctx, filePath, gb.ID, regID, principalID); err != nil {
+			return err
+		}
+		found = true
+		fileInfo = types.FileInfo{
+			Sha1:   gb.Sha1,
+			Sha256: gb.Sha256,
+			Sha512: gb.Sha512,
+			MD5:    gb.MD5,
+			Size:   gb.Size,
+		}
+		return nil
+	})
+	if err != nil {
+		return types.FileInfo{}, false, fmt.Errorf("failed to link file with path : %s, err: %w", filePath, err)
+	}
+	return fileInfo, found, nil
+}
+
 // GetBlobsContext context constructs the context object for the application. This only be
 // called once per request.
 func (f *fileManager) getBlobsContext(
@@ -408,11 +456,15 @@ func (f *fileManager) DeleteFile(
 	regID int64,
 	filePath string,
 ) error {
-	err := f.nodesDao.DeleteByNodePathAndRegistryID(ctx, filePath, regID)
+	nodes, err := f.nodesDao.GetAllFileNodesByPathPrefixAndRegistryID(ctx, regID, filePath)
+	if err != nil {
+		return fmt.Errorf("failed to get files for path: %s, with error: %w", filePath, err)
+	}
+	err = f.nodesDao.DeleteByNodePathAndRegistryID(ctx, filePath, regID)
 	if err != nil {
 		return fmt.Errorf("failed to delete file for path: %s, with error: %w", filePath, err)
 	}
-	return nil
+	return f.releaseBlobs(ctx, *nodes...)
 }
 
 func (f *fileManager) DeleteLeafNode(
@@ -423,10 +475,33 @@ func (f *fileManager) DeleteLeafNode(
 	if len(filePath) > 0 && !strings.HasPrefix(filePath, rootPathString) {
 		filePath = rootPathString + filePath
 	}
-	err := f.nodesDao.DeleteByLeafNodePathAndRegistryID(ctx, filePath, regID)
+	node, err := f.nodesDao.GetByPathAndRegistryID(ctx, regID, filePath)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get file for path: %s, with error: %w", filePath, err)
+	}
+	err = f.nodesDao.DeleteByLeafNodePathAndRegistryID(ctx, filePath, regID)
 	if err != nil {
 		return fmt.Errorf("failed to delete file for path: %s, with error: %w", filePath, err)
 	}
+	return f.releaseBlobs(ctx, *node)
+}
+
+// releaseBlobs deletes the generic blobs of deleted files. Uploads of the same content link to the same blob,
+// so a blob is only deleted once no file references it anymore.
+func (f *fileManager) releaseBlobs(ctx context.Context, nodes ...types.Node) error {
+	released := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		if !node.IsFile || node.BlobID == "" || released[node.BlobID] {
+			continue
+		}
+		released[node.BlobID] = true
+		if err := f.genericBlobDao.DeleteByID(ctx, node.BlobID); err != nil {
+			return fmt.Errorf("failed to release generic blob %s: %w", node.BlobID, err)
+		}
+	}
 	return nil
 }
 
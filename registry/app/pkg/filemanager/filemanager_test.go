@@ -0,0 +1,139 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filemanager_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/services/hook"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRegistryID   = int64(3)
+	testRootParentID = int64(1)
+	testSha256       = "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c"
+)
+
+// fakeTx runs the transaction function with the given context.
+type fakeTx struct {
+	dbtx.Transactor
+}
+
+func (fakeTx) WithTx(ctx context.Context, txFn func(context.Context) error, _ ...any) error {
+	return txFn(ctx)
+}
+
+func newTestFileManager(
+	t *testing.T,
+) (filemanager.FileManager, *mocks.GenericBlobRepository, *mocks.MockNodesRepository) {
+	genericBlobDao := mocks.NewGenericBlobRepository(t)
+	nodesDao := mocks.NewMockNodesRepository(t)
+	fileManager := filemanager.NewFileManager(nil, genericBlobDao, nodesDao, fakeTx{}, nil, nil, nil, nil,
+		hook.NewNoOpBlobActionHook())
+	return fileManager, genericBlobDao, nodesDao
+}
+
+func TestLinkFile(t *testing.T) {
+	fileManager, genericBlobDao, nodesDao := newTestFileManager(t)
+	blob := &types.GenericBlob{ID: "blob", Sha256: testSha256, Sha1: "sha1", MD5: "md5", Size: 42}
+
+	genericBlobDao.On("FindBySha256AndRootParentID", mock.Anything, testSha256, testRootParentID).
+		Return(blob, nil).Once()
+	nodesDao.EXPECT().GetByBlobIDAndRegistryID(mock.Anything, "blob", testRegistryID).
+		Return(&types.Node{ID: "other", BlobID: "blob"}, nil).Once()
+	var created []*types.Node
+	nodesDao.EXPECT().Create(mock.Anything, mock.Anything).Run(func(_ context.Context, node *types.Node) {
+		created = append(created, node)
+	}).Return(nil).Times(3)
+
+	fileInfo, found, err := fileManager.LinkFile(context.Background(), "/pkg/1.0/file.bin", testRegistryID,
+		testRootParentID, testSha256, 7)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, types.FileInfo{Sha256: testSha256, Sha1: "sha1", MD5: "md5", Size: 42}, fileInfo)
+	require.Len(t, created, 3)
+	file := created[2]
+	assert.True(t, file.IsFile)
+	assert.Equal(t, "blob", file.BlobID)
+	assert.Equal(t, "/pkg/1.0/file.bin", file.NodePath)
+	assert.Equal(t, testRegistryID, file.RegistryID)
+}
+
+func TestLinkFileNotFound(t *testing.T) {
+	fileManager, genericBlobDao, _ := newTestFileManager(t)
+
+	genericBlobDao.On("FindBySha256AndRootParentID", mock.Anything, testSha256, testRootParentID).
+		Return(nil, gitnessstore.ErrResourceNotFound).Once()
+
+	_, found, err := fileManager.LinkFile(context.Background(), "/pkg/1.0/file.bin", testRegistryID,
+		testRootParentID, testSha256, 7)
+	require.NoError(t, err)
+	assert.False(t, found, "the file must be uploaded when there is no blob")
+}
+
+func TestLinkFileOfOtherRegistry(t *testing.T) {
+	fileManager, genericBlobDao, nodesDao := newTestFileManager(t)
+
+	// the blob is stored under the root parent, but only for a file of another registry.
+	genericBlobDao.On("FindBySha256AndRootParentID", mock.Anything, testSha256, testRootParentID).
+		Return(&types.GenericBlob{ID: "blob", Sha256: testSha256}, nil).Once()
+	nodesDao.EXPECT().GetByBlobIDAndRegistryID(mock.Anything, "blob", testRegistryID).
+		Return(nil, gitnessstore.ErrResourceNotFound).Once()
+
+	_, found, err := fileManager.LinkFile(context.Background(), "/pkg/1.0/file.bin", testRegistryID,
+		testRootParentID, testSha256, 7)
+	require.NoError(t, err)
+	assert.False(t, found, "the blob of another registry must not be linked")
+	nodesDao.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestDeleteFileReleasesBlobs(t *testing.T) {
+	fileManager, genericBlobDao, nodesDao := newTestFileManager(t)
+
+	nodesDao.EXPECT().GetAllFileNodesByPathPrefixAndRegistryID(mock.Anything, testRegistryID, "/pkg/1.0").
+		Return(&[]types.Node{
+			{ID: "1", IsFile: true, BlobID: "shared"},
+			{ID: "2", IsFile: true, BlobID: "shared"},
+			{ID: "3", IsFile: true, BlobID: "other"},
+		}, nil).Once()
+	nodesDao.EXPECT().DeleteByNodePathAndRegistryID(mock.Anything, "/pkg/1.0", testRegistryID).Return(nil).Once()
+	// the dao only deletes the blobs no other file references, each blob is released once.
+	genericBlobDao.On("DeleteByID", mock.Anything, "shared").Return(nil).Once()
+	genericBlobDao.On("DeleteByID", mock.Anything, "other").Return(nil).Once()
+
+	require.NoError(t, fileManager.DeleteFile(context.Background(), testRegistryID, "/pkg/1.0"))
+}
+
+func TestDeleteLeafNodeReleasesBlob(t *testing.T) {
+	fileManager, genericBlobDao, nodesDao := newTestFileManager(t)
+
+	nodesDao.EXPECT().GetByPathAndRegistryID(mock.Anything, testRegistryID, "/pkg/1.0/file.bin").
+		Return(&types.Node{ID: "1", IsFile: true, BlobID: "blob"}, nil).Once()
+	nodesDao.EXPECT().DeleteByLeafNodePathAndRegistryID(mock.Anything, "/pkg/1.0/file.bin", testRegistryID).
+		Return(nil).Once()
+	genericBlobDao.On("DeleteByID", mock.Anything, "blob").Return(nil).Once()
+
+	require.NoError(t, fileManager.DeleteLeafNode(context.Background(), testRegistryID, "pkg/1.0/file.bin"))
+}
@@ -35,6 +35,17 @@ type FileManager interface {
 		principalID int64,
 	) (types.FileInfo, error)
 
+	// LinkFile creates the file at filePath from the blob with the sha256 a file of the registry already has,
+	// without writing its content again. It returns false when there is no such blob.
+	LinkFile(
+		ctx context.Context,
+		filePath string,
+		regID int64,
+		rootParentID int64,
+		sha256 string,
+		principalID int64,
+	) (types.FileInfo, bool, error)
+
 	DownloadFileByPath(
 		ctx context.Context,
 		filePath string,
@@ -89,8 +89,19 @@ func (c *localRegistry) PutFile(
 	contentType string,
 ) (*commons.ResponseHeaders, string, error) {
 	completePath := pkg.JoinWithSeparator("/", info.Image, info.Version, info.FilePath)
+	if info.Sha256 != "" {
+		headers, sha256, linked, err := c.localBase.UploadByDigest(ctx, info.ArtifactInfo, info.FileName,
+			info.Version, completePath, info.Sha256, &generic2.GenericMetadata{Source: info.Source})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to upload file by digest: %w", err)
+		}
+		if linked {
+			log.Ctx(ctx).Info().Str("sha256", sha256).Msg("Linked file to the existing blob, skipped the upload")
+			return headers, sha256, nil
+		}
+	}
 	headers, sha256, err := c.localBase.Upload(ctx, info.ArtifactInfo, info.FileName, info.Version, completePath,
-		reader, &generic2.GenericMetadata{})
+		reader, &generic2.GenericMetadata{Source: info.Source})
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msgf("Failed to upload file: %q, %q, %q", info.FileName, info.Version,
 			completePath)
@@ -0,0 +1,94 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/metadata"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/base"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/types/generic"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLocalBase links files to the blobs in linked and records the uploads.
+type fakeLocalBase struct {
+	base.LocalBase
+	linked   map[string]bool
+	uploaded []string
+}
+
+func (f *fakeLocalBase) UploadByDigest(
+	_ context.Context, _ pkg.ArtifactInfo, _, _, _ string, sha256 string, _ metadata.Metadata,
+) (*commons.ResponseHeaders, string, bool, error) {
+	if !f.linked[sha256] {
+		return &commons.ResponseHeaders{}, "", false, nil
+	}
+	return &commons.ResponseHeaders{Code: 201}, sha256, true, nil
+}
+
+func (f *fakeLocalBase) Upload(
+	_ context.Context, _ pkg.ArtifactInfo, _, _, path string, file io.ReadCloser, _ metadata.Metadata,
+) (*commons.ResponseHeaders, string, error) {
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, "", err
+	}
+	f.uploaded = append(f.uploaded, path+":"+string(content))
+	return &commons.ResponseHeaders{Code: 201}, "uploaded", nil
+}
+
+func TestPutFileByDigest(t *testing.T) {
+	tests := []struct {
+		name         string
+		sha256       string
+		wantSha256   string
+		wantUploaded []string
+	}{
+		{name: "linked", sha256: "existing", wantSha256: "existing"},
+		{
+			name: "not found", sha256: "missing", wantSha256: "uploaded",
+			wantUploaded: []string{"pkg/1.0/file.bin:content"},
+		},
+		{name: "no digest", wantSha256: "uploaded", wantUploaded: []string{"pkg/1.0/file.bin:content"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localBase := &fakeLocalBase{linked: map[string]bool{"existing": true}}
+			registry := &localRegistry{localBase: localBase}
+			info := generic.ArtifactInfo{
+				ArtifactInfo: pkg.ArtifactInfo{BaseInfo: &pkg.BaseInfo{}, Image: "pkg"},
+				FileName:     "file.bin",
+				FilePath:     "file.bin",
+				Version:      "1.0",
+				Sha256:       tt.sha256,
+			}
+
+			headers, sha256, err := registry.PutFile(context.Background(), info,
+				io.NopCloser(strings.NewReader("content")), "application/octet-stream")
+			require.NoError(t, err)
+			assert.Equal(t, 201, headers.Code)
+			assert.Equal(t, tt.wantSha256, sha256)
+			assert.Equal(t, tt.wantUploaded, localBase.uploaded)
+		})
+	}
+}
@@ -22,9 +22,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"path/filepath"
 
 	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/api/usererror"
 	urlprovider "github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/api/utils"
@@ -40,8 +42,11 @@ import (
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/services/webhook"
+	"github.com/harness/gitness/registry/types"
 	gitnessstore "github.com/harness/gitness/store"
 	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/rs/zerolog/log"
 )
 
 var _ pkg.Artifact = (*localRegistry)(nil)
@@ -116,18 +121,47 @@ func (c *localRegistry) UploadPackage(
 	if err != nil {
 		return nil, fmt.Errorf("failed to get file path: %w", err)
 	}
+
+	modBytes, err := io.ReadAll(modfile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mod file: %w", err)
+	}
+	// spool the zip file to disk, it has to be validated before anything is stored
+	zipTmpFile, err := os.CreateTemp("", "gopackage-*.zip")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file for zip file: %w", err)
+	}
+	defer func() {
+		zipTmpFile.Close()
+		if err := os.Remove(zipTmpFile.Name()); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to remove temp file %s", zipTmpFile.Name())
+		}
+	}()
+	if _, err = io.Copy(zipTmpFile, zipfile); err != nil {
+		return nil, fmt.Errorf("failed to read zip file: %w", err)
+	}
+	metadata, err := verifyModule(info, modBytes, zipTmpFile.Name())
+	if err != nil {
+		return nil, err
+	}
+	if _, err = zipTmpFile.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to rewind zip file: %w", err)
+	}
+
 	// upload .zip
 	zipFileName := info.Version + ".zip"
 	zipFilePath := filepath.Join(filePath, zipFileName)
 
-	response, err := c.uploadFile(ctx, info, &info.Metadata, zipfile, zipFileName, zipFilePath)
+	response, err := c.uploadFile(ctx, info, metadata, io.NopCloser(zipTmpFile), zipFileName, zipFilePath)
 	if err != nil {
 		return response, fmt.Errorf("failed to upload zip file: %w", err)
 	}
 	// upload .mod
 	modFileName := info.Version + ".mod"
 	modFilePath := filepath.Join(filePath, modFileName)
-	response, err = c.uploadFile(ctx, info, &info.Metadata, modfile, modFileName, modFilePath)
+	response, err = c.uploadFile(
+		ctx, info, metadata, io.NopCloser(bytes.NewReader(modBytes)), modFileName, modFilePath,
+	)
 	if err != nil {
 		return response, fmt.Errorf("failed to upload mod file: %w", err)
 	}
@@ -138,7 +172,7 @@ func (c *localRegistry) UploadPackage(
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert metadata to io.ReadCloser: %w", err)
 	}
-	response, err = c.uploadFile(ctx, info, &info.Metadata, infoFile, infoFileName, infoFilePath)
+	response, err = c.uploadFile(ctx, info, metadata, infoFile, infoFileName, infoFilePath)
 	if err != nil {
 		return response, fmt.Errorf("failed to upload info file: %w", err)
 	}
@@ -162,16 +196,39 @@ func metadataToReadCloser(meta gopackagemetadata.VersionMetadata) (io.ReadCloser
 	return io.NopCloser(bytes.NewReader(b)), nil
 }
 
+// verifyModule rejects uploads which would fail `go mod verify` for consumers and returns the
+// version metadata along with the h1 hashes of the zip and go.mod files.
+func verifyModule(
+	info gopackagetype.ArtifactInfo, modBytes []byte, zipPath string,
+) (gopackagemetadata.VersionMetadataDB, error) {
+	if err := gopackageutils.ValidateModFile(info.Image, info.Version, modBytes); err != nil {
+		return gopackagemetadata.VersionMetadataDB{}, usererror.BadRequestf("invalid go module: %s", err.Error())
+	}
+	if err := gopackageutils.ValidateZipFile(zipPath, info.Image, info.Version, modBytes); err != nil {
+		return gopackagemetadata.VersionMetadataDB{}, usererror.BadRequestf("invalid go module: %s", err.Error())
+	}
+	zipHash, err := gopackageutils.HashZipFile(zipPath)
+	if err != nil {
+		return gopackagemetadata.VersionMetadataDB{}, err
+	}
+	modHash, err := gopackageutils.HashModFile(modBytes)
+	if err != nil {
+		return gopackagemetadata.VersionMetadataDB{}, err
+	}
+	return gopackagemetadata.VersionMetadataDB{
+		VersionMetadata: info.Metadata,
+		ZipHash:         zipHash,
+		ModHash:         modHash,
+	}, nil
+}
+
 func (c *localRegistry) uploadFile(
 	ctx context.Context, info gopackagetype.ArtifactInfo,
-	metadata *gopackagemetadata.VersionMetadata, fileReader io.ReadCloser,
+	metadata gopackagemetadata.VersionMetadataDB, fileReader io.ReadCloser,
 	filename string, path string,
 ) (responseHeaders *commons.ResponseHeaders, err error) {
 	response, _, err := c.localBase.Upload(
-		ctx, info.ArtifactInfo, filename, info.Version, path, fileReader,
-		&gopackagemetadata.VersionMetadataDB{
-			VersionMetadata: *metadata,
-		})
+		ctx, info.ArtifactInfo, filename, info.Version, path, fileReader, &metadata)
 	if err != nil {
 		return response, fmt.Errorf("failed to upload file %s: %w", filename, err)
 	}
@@ -196,7 +253,7 @@ func (c *localRegistry) publishArtifactCreatedEvent(
 func (c *localRegistry) regeneratePackageIndex(
 	ctx context.Context, info gopackagetype.ArtifactInfo,
 ) {
-	c.postProcessingReporter.BuildPackageIndex(ctx, info.RegistryID, info.Image)
+	c.postProcessingReporter.BuildPackageIndex(ctx, info.RegistryID, info.Image, make([]types.SourceRef, 0))
 }
 
 func (c *localRegistry) regeneratePackageMetadata(
@@ -24,6 +24,7 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/pkg/types/gopackage"
 	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/types"
 )
 
 type LocalRegistryHelper interface {
@@ -61,7 +62,7 @@ func (h *localRegistryHelper) FileExists(
 func (h *localRegistryHelper) RegeneratePackageIndex(
 	ctx context.Context, info gopackage.ArtifactInfo,
 ) {
-	h.postProcessingReporter.BuildPackageIndex(ctx, info.RegistryID, info.Image)
+	h.postProcessingReporter.BuildPackageIndex(ctx, info.RegistryID, info.Image, make([]types.SourceRef, 0))
 }
 
 func (h *localRegistryHelper) RegeneratePackageMetadata(
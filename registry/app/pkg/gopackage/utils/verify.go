@@ -0,0 +1,111 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/dirhash"
+	modzip "golang.org/x/mod/zip"
+)
+
+const goModFileName = "go.mod"
+
+// ValidateModFile checks that the module path and version are valid for the module proxy protocol and
+// that the module path matches the path declared in the go.mod file.
+func ValidateModFile(modulePath string, version string, modBytes []byte) error {
+	if err := module.Check(modulePath, version); err != nil {
+		return err
+	}
+	if module.CanonicalVersion(version) != version {
+		return fmt.Errorf("version %s is not canonical, expected %s", version, module.CanonicalVersion(version))
+	}
+	declaredPath := modfile.ModulePath(modBytes)
+	if declaredPath == "" {
+		return fmt.Errorf("module directive not found in %s", goModFileName)
+	}
+	if declaredPath != modulePath {
+		return fmt.Errorf("module path %s does not match path %s declared in %s", modulePath, declaredPath,
+			goModFileName)
+	}
+	return nil
+}
+
+// ValidateZipFile checks that the module zip file at zipPath follows the module zip format for the given
+// module version and that its go.mod file, if any, is identical to the uploaded go.mod file.
+// Either mismatch would make `go mod verify` fail for consumers of the module.
+func ValidateZipFile(zipPath string, modulePath string, version string, modBytes []byte) error {
+	mod := module.Version{Path: modulePath, Version: version}
+	checkedFiles, err := modzip.CheckZip(mod, zipPath)
+	if err != nil {
+		return fmt.Errorf("invalid module zip file: %w", err)
+	}
+	if err = checkedFiles.Err(); err != nil {
+		return fmt.Errorf("invalid module zip file: %w", err)
+	}
+
+	zipReader, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return fmt.Errorf("failed to open module zip file: %w", err)
+	}
+	defer zipReader.Close()
+
+	goModPath := mod.String() + "/" + goModFileName
+	for _, file := range zipReader.File {
+		if file.Name != goModPath {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return fmt.Errorf("failed to open %s in module zip file: %w", goModFileName, err)
+		}
+		zipModBytes, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read %s in module zip file: %w", goModFileName, err)
+		}
+		if !bytes.Equal(zipModBytes, modBytes) {
+			return fmt.Errorf("%s in module zip file does not match the uploaded %s", goModFileName,
+				goModFileName)
+		}
+		return nil
+	}
+	return nil
+}
+
+// HashZipFile returns the h1 dirhash of the module zip file, as recorded in go.sum.
+func HashZipFile(zipPath string) (string, error) {
+	hash, err := dirhash.HashZip(zipPath, dirhash.Hash1)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash module zip file: %w", err)
+	}
+	return hash, nil
+}
+
+// HashModFile returns the h1 dirhash of the go.mod file, as recorded in go.sum for the /go.mod entry.
+func HashModFile(modBytes []byte) (string, error) {
+	hash, err := dirhash.Hash1([]string{goModFileName}, func(string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(modBytes)), nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to hash %s file: %w", goModFileName, err)
+	}
+	return hash, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+)
+
+func TestValidateModFile(t *testing.T) {
+	modBytes := []byte("module example.com/hello/v2\n\ngo 1.22\n")
+
+	tests := []struct {
+		name       string
+		modulePath string
+		version    string
+		wantErr    bool
+	}{
+		{name: "valid", modulePath: "example.com/hello/v2", version: "v2.1.0"},
+		{name: "path mismatch", modulePath: "example.com/other/v2", version: "v2.1.0", wantErr: true},
+		{name: "major version mismatch", modulePath: "example.com/hello/v2", version: "v1.0.0", wantErr: true},
+		{name: "non canonical version", modulePath: "example.com/hello/v2", version: "v2.1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateModFile(tt.modulePath, tt.version, modBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateModFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHashModFile(t *testing.T) {
+	first, err := HashModFile([]byte("module example.com/hello\n"))
+	if err != nil {
+		t.Fatalf("HashModFile() error = %v", err)
+	}
+	second, err := HashModFile([]byte("module example.com/hello/v2\n"))
+	if err != nil {
+		t.Fatalf("HashModFile() error = %v", err)
+	}
+	if first == second {
+		t.Errorf("HashModFile() returned the same hash for different go.mod files")
+	}
+	if first[:3] != "h1:" {
+		t.Errorf("HashModFile() = %s, want h1: prefix", first)
+	}
+}
@@ -181,19 +181,7 @@ func (c *localRegistry) RevisionInfo(
 		}, nil
 	}
 
-	//todo: add logs
-	image, err := c.imageDao.GetByNameAndType(ctx, info.RegistryID, info.Repo, &info.RepoType)
-	if err != nil {
-		return headers, nil, err
-	}
-
-	artifact, err := c.artifactDao.GetByName(ctx, image.ID, info.Revision)
-	if err != nil {
-		return headers, nil, err
-	}
-
-	metadata := &huggingfacemetadata.HuggingFaceMetadata{}
-	err = json.Unmarshal(artifact.Metadata, metadata)
+	metadata, err := c.revisionMetadata(ctx, info)
 	if err != nil {
 		return headers, nil, err
 	}
@@ -212,6 +200,44 @@ func (c *localRegistry) RevisionInfo(
 	}, nil
 }
 
+func (c *localRegistry) ListTree(
+	ctx context.Context, info huggingfacetype.ArtifactInfo, dir string, recursive bool,
+) (
+	headers *commons.ResponseHeaders, response []huggingfacetype.TreeEntry, err error,
+) {
+	headers = &commons.ResponseHeaders{
+		Headers: map[string]string{"Content-Type": contentTypeJSON},
+	}
+	metadata, err := c.revisionMetadata(ctx, info)
+	if err != nil {
+		headers.Code = http.StatusNotFound
+		return headers, nil, err
+	}
+
+	entries, found := treeEntries(metadata.Files, dir, recursive)
+	if !found {
+		headers.Code = http.StatusNotFound
+		return headers, nil, usererror.NotFoundf("Path %s not found in revision %s", dir, info.Revision)
+	}
+	headers.Code = http.StatusOK
+	return headers, entries, nil
+}
+
+func (c *localRegistry) PathsInfo(ctx context.Context, info huggingfacetype.ArtifactInfo, paths []string) (
+	headers *commons.ResponseHeaders, response []huggingfacetype.TreeEntry, err error,
+) {
+	headers = &commons.ResponseHeaders{
+		Headers: map[string]string{"Content-Type": contentTypeJSON},
+	}
+	metadata, err := c.revisionMetadata(ctx, info)
+	if err != nil {
+		headers.Code = http.StatusNotFound
+		return headers, nil, err
+	}
+	headers.Code = http.StatusOK
+	return headers, pathsInfo(metadata.Files, paths), nil
+}
+
 func (c *localRegistry) LfsInfo(
 	ctx context.Context, info huggingfacetype.ArtifactInfo, body io.ReadCloser,
 	token string,
@@ -398,12 +424,14 @@ func (c *localRegistry) CommitRevision(ctx context.Context, info huggingfacetype
 
 	modelMetadata := huggingfacemetadata.Metadata{
 		ID:           info.Repo,
-		ModelID:      info.Repo,
 		Siblings:     *siblings,
 		LastModified: time.Now().UTC().Format(time.RFC3339),
 		Private:      true,
 		Readme:       readme,
-		CardData:     &huggingfacemetadata.CardData{},
+		CardData:     parseCardData(ctx, readme),
+	}
+	if info.RepoType == apicontract.ArtifactTypeModel {
+		modelMetadata.ModelID = info.Repo
 	}
 	if headerInfo.Summary != "" {
 		modelMetadata.CardData.Tags = append(modelMetadata.CardData.Tags, "summary:"+headerInfo.Summary)
@@ -527,6 +555,91 @@ func (c *localRegistry) readme(
 	return ""
 }
 
+// revisionMetadata returns the stored metadata of the requested repo revision.
+func (c *localRegistry) revisionMetadata(ctx context.Context, info huggingfacetype.ArtifactInfo) (
+	*huggingfacemetadata.HuggingFaceMetadata, error,
+) {
+	image, err := c.imageDao.GetByNameAndType(ctx, info.RegistryID, info.Repo, &info.RepoType)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msgf("Failed to get image: %s", string(info.RepoType)+"/"+info.Repo)
+		return nil, err
+	}
+
+	artifact, err := c.artifactDao.GetByName(ctx, image.ID, info.Revision)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msgf("Failed to get artifact: %s", info.Revision)
+		return nil, err
+	}
+
+	metadata := &huggingfacemetadata.HuggingFaceMetadata{}
+	if err = json.Unmarshal(artifact.Metadata, metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// parseCardData extracts the card data from the YAML front matter of the repo readme.
+func parseCardData(ctx context.Context, readme string) *huggingfacemetadata.CardData {
+	cardData := &huggingfacemetadata.CardData{}
+	match := frontMatterRE.FindStringSubmatch(readme)
+	if match == nil {
+		return cardData
+	}
+
+	var card struct {
+		Language       any    `yaml:"language"`
+		Tags           any    `yaml:"tags"`
+		License        any    `yaml:"license"`
+		PrettyName     string `yaml:"pretty_name"`
+		TaskCategories any    `yaml:"task_categories"`
+		SizeCategories any    `yaml:"size_categories"`
+		Configs        []struct {
+			ConfigName string `yaml:"config_name"`
+			DataFiles  any    `yaml:"data_files"`
+			Default    bool   `yaml:"default"`
+		} `yaml:"configs"`
+	}
+	if err := yaml.Unmarshal([]byte(match[1]), &card); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("Failed to parse readme front matter")
+		return cardData
+	}
+
+	cardData.Language = toStringSlice(card.Language)
+	cardData.Tags = toStringSlice(card.Tags)
+	if license := toStringSlice(card.License); len(license) > 0 {
+		cardData.License = license[0]
+	}
+	cardData.PrettyName = card.PrettyName
+	cardData.TaskCategories = toStringSlice(card.TaskCategories)
+	cardData.SizeCategories = toStringSlice(card.SizeCategories)
+	for _, config := range card.Configs {
+		cardData.Configs = append(cardData.Configs, huggingfacemetadata.DatasetConfig{
+			ConfigName: config.ConfigName,
+			DataFiles:  config.DataFiles,
+			Default:    config.Default,
+		})
+	}
+	return cardData
+}
+
+// toStringSlice converts card fields which may be declared as a single value or a list.
+func toStringSlice(v any) []string {
+	switch val := v.(type) {
+	case string:
+		return []string{val}
+	case []any:
+		values := make([]string, 0, len(val))
+		for _, item := range val {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
 func lfsAction(blobURL, oid, token string) huggingfacetype.LfsAction {
 	return huggingfacetype.LfsAction{
 		Href: blobURL,
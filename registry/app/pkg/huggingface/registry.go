@@ -39,6 +39,12 @@ type Registry interface {
 	RevisionInfo(ctx context.Context, info huggingfacetype.ArtifactInfo, queryParams map[string][]string) (
 		headers *commons.ResponseHeaders, response *huggingfacetype.RevisionInfoResponse, err error)
 
+	ListTree(ctx context.Context, info huggingfacetype.ArtifactInfo, dir string, recursive bool) (
+		headers *commons.ResponseHeaders, response []huggingfacetype.TreeEntry, err error)
+
+	PathsInfo(ctx context.Context, info huggingfacetype.ArtifactInfo, paths []string) (
+		headers *commons.ResponseHeaders, response []huggingfacetype.TreeEntry, err error)
+
 	LfsInfo(ctx context.Context, info huggingfacetype.ArtifactInfo, body io.ReadCloser, token string) (
 		headers *commons.ResponseHeaders, response *huggingfacetype.LfsInfoResponse, err error)
 
@@ -0,0 +1,119 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package huggingface
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/metadata"
+	huggingfacetype "github.com/harness/gitness/registry/app/pkg/types/huggingface"
+)
+
+const lfsPointerFormat = "version https://git-lfs.github.com/spec/v1\noid sha256:%s\nsize %d\n"
+
+// treeEntries lists the files and directories stored under dir. Only direct children are
+// returned unless recursive is set. found is false when dir does not exist in the revision.
+func treeEntries(files []metadata.File, dir string, recursive bool) (
+	entries []huggingfacetype.TreeEntry, found bool,
+) {
+	dir = strings.Trim(dir, "/")
+	entries = []huggingfacetype.TreeEntry{}
+	seenDirs := map[string]bool{}
+
+	for _, file := range files {
+		rel := file.Filename
+		if dir != "" {
+			if !strings.HasPrefix(file.Filename, dir+"/") {
+				continue
+			}
+			rel = strings.TrimPrefix(file.Filename, dir+"/")
+		}
+		found = true
+
+		parts := strings.Split(rel, "/")
+		if !recursive && len(parts) > 1 {
+			dirPath := path.Join(dir, parts[0])
+			if !seenDirs[dirPath] {
+				seenDirs[dirPath] = true
+				entries = append(entries, directoryEntry(dirPath))
+			}
+			continue
+		}
+		for i := 1; i < len(parts); i++ {
+			dirPath := path.Join(dir, strings.Join(parts[:i], "/"))
+			if !seenDirs[dirPath] {
+				seenDirs[dirPath] = true
+				entries = append(entries, directoryEntry(dirPath))
+			}
+		}
+		entries = append(entries, fileEntry(file))
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Path < entries[j].Path
+	})
+	return entries, found || dir == ""
+}
+
+// pathsInfo returns the entries for the requested paths. Paths which are not part of the
+// revision are skipped.
+func pathsInfo(files []metadata.File, paths []string) []huggingfacetype.TreeEntry {
+	entries := []huggingfacetype.TreeEntry{}
+	for _, p := range paths {
+		p = strings.Trim(p, "/")
+		if p == "" {
+			continue
+		}
+		isDir := false
+		for _, file := range files {
+			if file.Filename == p {
+				entries = append(entries, fileEntry(file))
+				isDir = false
+				break
+			}
+			if strings.HasPrefix(file.Filename, p+"/") {
+				isDir = true
+			}
+		}
+		if isDir {
+			entries = append(entries, directoryEntry(p))
+		}
+	}
+	return entries
+}
+
+func fileEntry(file metadata.File) huggingfacetype.TreeEntry {
+	return huggingfacetype.TreeEntry{
+		Type: huggingfacetype.TreeEntryFile,
+		Oid:  file.Sha256,
+		Size: file.Size,
+		Path: file.Filename,
+		Lfs: &huggingfacetype.TreeEntryLfs{
+			Oid:         file.Sha256,
+			Size:        file.Size,
+			PointerSize: int64(len(fmt.Sprintf(lfsPointerFormat, file.Sha256, file.Size))),
+		},
+	}
+}
+
+func directoryEntry(dirPath string) huggingfacetype.TreeEntry {
+	return huggingfacetype.TreeEntry{
+		Type: huggingfacetype.TreeEntryDirectory,
+		Path: dirPath,
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package huggingface
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/metadata"
+	huggingfacetype "github.com/harness/gitness/registry/app/pkg/types/huggingface"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var datasetFiles = []metadata.File{
+	{Filename: "README.md", Size: 10, Sha256: "readme"},
+	{Filename: "data/train/part-0.parquet", Size: 100, Sha256: "train0"},
+	{Filename: "data/test.parquet", Size: 50, Sha256: "test"},
+}
+
+func entryPaths(entries []huggingfacetype.TreeEntry) []string {
+	paths := make([]string, 0, len(entries))
+	for _, e := range entries {
+		paths = append(paths, string(e.Type)+":"+e.Path)
+	}
+	return paths
+}
+
+func TestTreeEntries(t *testing.T) {
+	entries, found := treeEntries(datasetFiles, "", false)
+	assert.True(t, found)
+	assert.Equal(t, []string{"file:README.md", "directory:data"}, entryPaths(entries))
+
+	entries, found = treeEntries(datasetFiles, "data", false)
+	assert.True(t, found)
+	assert.Equal(t, []string{"file:data/test.parquet", "directory:data/train"}, entryPaths(entries))
+
+	entries, found = treeEntries(datasetFiles, "/", true)
+	assert.True(t, found)
+	assert.Equal(t, []string{
+		"file:README.md",
+		"directory:data",
+		"file:data/test.parquet",
+		"directory:data/train",
+		"file:data/train/part-0.parquet",
+	}, entryPaths(entries))
+	assert.Equal(t, int64(100), entries[4].Lfs.Size)
+
+	_, found = treeEntries(datasetFiles, "missing", false)
+	assert.False(t, found)
+}
+
+func TestPathsInfo(t *testing.T) {
+	entries := pathsInfo(datasetFiles, []string{"README.md", "data/train", "missing"})
+	assert.Equal(t, []string{"file:README.md", "directory:data/train"}, entryPaths(entries))
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/pkg/quarantine"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/denylist"
 	registrytypes "github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/types/enum"
@@ -61,6 +62,7 @@ type Controller struct {
 	SpaceFinder               refcache.SpaceFinder
 	quarantineFinder          quarantine.Finder
 	dependencyFirewallChecker interfaces.DependencyFirewallChecker
+	denylist                  *denylist.Service
 }
 
 type DBStore struct {
@@ -82,6 +84,7 @@ func NewController(
 	spaceFinder refcache.SpaceFinder,
 	quarantineFinder quarantine.Finder,
 	dependencyFirewallChecker interfaces.DependencyFirewallChecker,
+	denylist *denylist.Service,
 ) *Controller {
 	c := &Controller{
 		local:                     local,
@@ -91,6 +94,7 @@ func NewController(
 		SpaceFinder:               spaceFinder,
 		quarantineFinder:          quarantineFinder,
 		dependencyFirewallChecker: dependencyFirewallChecker,
+		denylist:                  denylist,
 	}
 
 	TypeRegistry[LocalRegistryType] = local
@@ -262,6 +266,12 @@ func (c *Controller) PutArtifact(
 		}
 	}
 
+	if err = c.denylist.CheckUpload(ctx, &info.Registry, info.Image, info.Version); err != nil {
+		return &PutArtifactResponse{
+			Errors: []error{err},
+		}
+	}
+
 	responseHeaders, errs := c.local.PutArtifact(ctx, info, fileReader)
 	return &PutArtifactResponse{
 		ResponseHeaders: responseHeaders,
@@ -15,6 +15,7 @@
 package maven
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -43,6 +44,8 @@ import (
 
 const (
 	ArtifactTypeLocalRegistry = "Local Registry"
+	maxChecksumFileSize       = 1024
+	maxGradleModuleFileSize   = 10 << 20
 )
 
 func NewLocalRegistry(
@@ -109,6 +112,9 @@ func (r *LocalRegistry) FetchArtifact(ctx context.Context, info pkg.MavenArtifac
 
 	fileInfo, err := r.fileManager.GetFileMetadata(ctx, info.RegistryID, filePath)
 	if err != nil {
+		if utils.IsChecksumFile(info.FileName) {
+			return r.fetchGeneratedChecksum(ctx, info, serveFile, err)
+		}
 		return processError(err)
 	}
 	var fileReader *storage.FileReader
@@ -123,11 +129,103 @@ func (r *LocalRegistry) FetchArtifact(ctx context.Context, info pkg.MavenArtifac
 	return responseHeaders, fileReader, nil, redirectURL, nil
 }
 
+// fetchGeneratedChecksum serves a checksum sidecar which was never uploaded by computing it from
+// the stored checksums of the file it belongs to.
+func (r *LocalRegistry) fetchGeneratedChecksum(
+	ctx context.Context, info pkg.MavenArtifactInfo, serveFile bool, lookupErr error,
+) (
+	responseHeaders *commons.ResponseHeaders,
+	body *storage.FileReader,
+	readCloser io.ReadCloser,
+	redirectURL string,
+	errs []error,
+) {
+	targetInfo := info
+	targetInfo.FileName = utils.GetChecksumTargetFileName(info.FileName)
+	targetFileInfo, err := r.fileManager.GetFileMetadata(ctx, info.RegistryID, utils.GetFilePath(targetInfo))
+	if err != nil {
+		return processError(lookupErr)
+	}
+	checksum := utils.GetChecksum(info.FileName, targetFileInfo)
+	if checksum == "" {
+		return processError(lookupErr)
+	}
+	log.Ctx(ctx).Debug().Msgf("serving generated checksum for file: %s", utils.GetFilePath(targetInfo))
+
+	responseHeaders = utils.SetHeaders(info, types.FileInfo{
+		Size:      int64(len(checksum)),
+		Filename:  info.FileName,
+		CreatedAt: targetFileInfo.CreatedAt,
+	})
+	if serveFile {
+		readCloser = io.NopCloser(strings.NewReader(checksum))
+	}
+	return responseHeaders, nil, readCloser, "", nil
+}
+
+// validateChecksum verifies a client uploaded checksum sidecar against the checksum of the stored file
+// it belongs to. If the target file has not been uploaded yet, the checksum is accepted as is.
+func (r *LocalRegistry) validateChecksum(
+	ctx context.Context, info pkg.MavenArtifactInfo, content []byte,
+) error {
+	targetInfo := info
+	targetInfo.FileName = utils.GetChecksumTargetFileName(info.FileName)
+	targetFileInfo, err := r.fileManager.GetFileMetadata(ctx, info.RegistryID, utils.GetFilePath(targetInfo))
+	if err != nil {
+		log.Ctx(ctx).Debug().Msgf("skipping checksum validation for file: %s, target file not found: %v",
+			info.FileName, err)
+		return nil
+	}
+	expected := strings.ToLower(utils.GetChecksum(info.FileName, targetFileInfo))
+	actual := utils.ParseChecksum(string(content))
+	if expected != "" && expected != actual {
+		return commons.New(http.StatusBadRequest,
+			fmt.Sprintf("checksum mismatch for file: %s, expected: %s, actual: %s",
+				targetInfo.FileName, expected, actual), nil)
+	}
+	return nil
+}
+
 func (r *LocalRegistry) PutArtifact(ctx context.Context, info pkg.MavenArtifactInfo, fileReader io.Reader) (
 	responseHeaders *commons.ResponseHeaders, errs []error,
 ) {
 	filePath := utils.GetFilePath(info)
 
+	if utils.IsChecksumFile(info.FileName) {
+		content, err := io.ReadAll(io.LimitReader(fileReader, maxChecksumFileSize+1))
+		if err != nil {
+			return responseHeaders, []error{fmt.Errorf("failed to read checksum file: %s: %w", info.FileName, err)}
+		}
+		if len(content) > maxChecksumFileSize {
+			return responseHeaders, []error{commons.New(http.StatusBadRequest,
+				fmt.Sprintf("checksum file: %s exceeds the maximum size of %d bytes",
+					info.FileName, maxChecksumFileSize), nil)}
+		}
+		if err = r.validateChecksum(ctx, info, content); err != nil {
+			return responseHeaders, []error{err}
+		}
+		fileReader = bytes.NewReader(content)
+	}
+
+	var gradleVariants []metadata.GradleVariant
+	if utils.IsGradleModuleFile(info.FileName) {
+		content, err := io.ReadAll(io.LimitReader(fileReader, maxGradleModuleFileSize+1))
+		if err != nil {
+			return responseHeaders, []error{fmt.Errorf("failed to read gradle module file: %s: %w", info.FileName, err)}
+		}
+		if len(content) > maxGradleModuleFileSize {
+			return responseHeaders, []error{commons.New(http.StatusBadRequest,
+				fmt.Sprintf("gradle module file: %s exceeds the maximum size of %d bytes",
+					info.FileName, maxGradleModuleFileSize), nil)}
+		}
+		module, err := utils.ParseGradleModule(content, info)
+		if err != nil {
+			return responseHeaders, []error{commons.New(http.StatusBadRequest, err.Error(), nil)}
+		}
+		gradleVariants = utils.GetGradleVariants(module)
+		fileReader = bytes.NewReader(content)
+	}
+
 	// if package file belongs to maven-metadata file, then file override is expected.
 	if !utils.IsMetadataFile(info.FileName) {
 		artifactExists, err := r.localBase.CheckIfVersionExists(ctx, info)
@@ -191,6 +289,9 @@ func (r *LocalRegistry) PutArtifact(ctx context.Context, info pkg.MavenArtifactI
 			if err3 != nil {
 				return err3
 			}
+			if gradleVariants != nil {
+				metadata.Variants = gradleVariants
+			}
 
 			metadataJSON, err3 := json.Marshal(metadata)
 
@@ -0,0 +1,131 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maven
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFileManager struct {
+	filemanager.FileManager
+	files map[string]types.FileInfo
+}
+
+func (f *fakeFileManager) GetFileMetadata(_ context.Context, _ int64, filePath string) (types.FileInfo, error) {
+	fileInfo, ok := f.files[filePath]
+	if !ok {
+		return types.FileInfo{}, gitnessstore.ErrResourceNotFound
+	}
+	return fileInfo, nil
+}
+
+func newTestLocalRegistry() *LocalRegistry {
+	return &LocalRegistry{fileManager: &fakeFileManager{files: map[string]types.FileInfo{
+		"/com/example/app/1.0/app-1.0.jar": {MD5: "d41d8cd98f00b204e9800998ecf8427e", Sha1: "da39a3ee"},
+	}}}
+}
+
+func newTestArtifactInfo(fileName string) pkg.MavenArtifactInfo {
+	return pkg.MavenArtifactInfo{
+		ArtifactInfo: &pkg.ArtifactInfo{BaseInfo: &pkg.BaseInfo{}},
+		GroupID:      "com.example",
+		ArtifactID:   "app",
+		Version:      "1.0",
+		FileName:     fileName,
+	}
+}
+
+func requireStatus(t *testing.T, err error, status int) {
+	t.Helper()
+	var userErr *commons.Error
+	require.ErrorAs(t, err, &userErr)
+	require.Equal(t, status, userErr.Status)
+}
+
+func TestValidateChecksum(t *testing.T) {
+	ctx := context.Background()
+	r := newTestLocalRegistry()
+
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+		status   int
+	}{
+		{name: "matching", fileName: "app-1.0.jar.md5", content: "d41d8cd98f00b204e9800998ecf8427e"},
+		{name: "with filename", fileName: "app-1.0.jar.sha1", content: "DA39A3EE  app-1.0.jar\n"},
+		{name: "target not uploaded", fileName: "app-1.0.pom.sha1", content: "anything"},
+		{name: "unknown checksum of target", fileName: "app-1.0.jar.sha512", content: "anything"},
+		{name: "mismatch", fileName: "app-1.0.jar.md5", content: "0000", status: http.StatusBadRequest},
+		{name: "malformed", fileName: "app-1.0.jar.sha1", content: " \n", status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := r.validateChecksum(ctx, newTestArtifactInfo(tt.fileName), []byte(tt.content))
+			if tt.status == 0 {
+				require.NoError(t, err)
+				return
+			}
+			requireStatus(t, err, tt.status)
+		})
+	}
+}
+
+func TestFetchGeneratedChecksum(t *testing.T) {
+	ctx := context.Background()
+	r := newTestLocalRegistry()
+	lookupErr := errors.New("resource not found")
+
+	headers, _, readCloser, _, errs := r.fetchGeneratedChecksum(ctx, newTestArtifactInfo("app-1.0.jar.md5"), true,
+		lookupErr)
+	require.Empty(t, errs)
+	require.Equal(t, http.StatusOK, headers.Code)
+	content, err := io.ReadAll(readCloser)
+	require.NoError(t, err)
+	require.Equal(t, "d41d8cd98f00b204e9800998ecf8427e", string(content))
+
+	_, _, readCloser, _, errs = r.fetchGeneratedChecksum(ctx, newTestArtifactInfo("app-1.0.jar.sha1"), false,
+		lookupErr)
+	require.Empty(t, errs)
+	require.Nil(t, readCloser, "a head request has no body")
+
+	for _, fileName := range []string{"app-1.0.pom.md5", "app-1.0.jar.sha256"} {
+		_, _, _, _, errs = r.fetchGeneratedChecksum(ctx, newTestArtifactInfo(fileName), true, lookupErr)
+		require.Len(t, errs, 1, fileName)
+		requireStatus(t, errs[0], http.StatusNotFound)
+	}
+}
+
+func TestPutArtifactRejectsOversizedChecksum(t *testing.T) {
+	r := newTestLocalRegistry()
+	content := strings.Repeat("a", maxChecksumFileSize+1)
+
+	_, errs := r.PutArtifact(context.Background(), newTestArtifactInfo("app-1.0.jar.md5"), strings.NewReader(content))
+	require.Len(t, errs, 1)
+	requireStatus(t, errs[0], http.StatusBadRequest)
+}
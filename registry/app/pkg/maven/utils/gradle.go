@@ -0,0 +1,144 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/metadata"
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+const (
+	extensionModule         = ".module"
+	contentTypeGradleModule = "application/vnd.org.gradle.module+json"
+)
+
+// supportedGradleModuleFormatVersions lists the Gradle module metadata format versions which can be parsed.
+var supportedGradleModuleFormatVersions = []string{"1.0", "1.1"}
+
+// GradleModule is the subset of the Gradle module metadata specification
+// (https://github.com/gradle/gradle/blob/master/platforms/documentation/docs/src/docs/design/gradle-module-metadata-latest-specification.md)
+// required to validate uploads and to browse variants.
+type GradleModule struct {
+	FormatVersion string                `json:"formatVersion"`
+	Component     GradleModuleComponent `json:"component"`
+	Variants      []GradleModuleVariant `json:"variants"`
+}
+
+type GradleModuleComponent struct {
+	Group   string `json:"group"`
+	Module  string `json:"module"`
+	Version string `json:"version"`
+	URL     string `json:"url,omitempty"`
+}
+
+type GradleModuleVariant struct {
+	Name                  string                   `json:"name"`
+	Attributes            map[string]any           `json:"attributes,omitempty"`
+	AvailableAt           *GradleModuleComponent   `json:"available-at,omitempty"`
+	Dependencies          []GradleModuleDependency `json:"dependencies,omitempty"`
+	DependencyConstraints []GradleModuleDependency `json:"dependencyConstraints,omitempty"`
+	Files                 []GradleModuleFile       `json:"files,omitempty"`
+}
+
+type GradleModuleDependency struct {
+	Group   string         `json:"group"`
+	Module  string         `json:"module"`
+	Version map[string]any `json:"version,omitempty"`
+}
+
+type GradleModuleFile struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Size   int64  `json:"size"`
+	Sha1   string `json:"sha1,omitempty"`
+	Sha256 string `json:"sha256,omitempty"`
+	Sha512 string `json:"sha512,omitempty"`
+	MD5    string `json:"md5,omitempty"`
+}
+
+// IsGradleModuleFile reports whether the filename is a Gradle module metadata file.
+func IsGradleModuleFile(filename string) bool {
+	return strings.ToLower(filepath.Ext(filename)) == extensionModule
+}
+
+// ParseGradleModule parses and validates the content of a Gradle module metadata file against
+// the coordinates it is being uploaded to.
+func ParseGradleModule(content []byte, info pkg.MavenArtifactInfo) (*GradleModule, error) {
+	module := &GradleModule{}
+	if err := json.Unmarshal(content, module); err != nil {
+		return nil, fmt.Errorf("invalid gradle module metadata: %w", err)
+	}
+	if !slices.Contains(supportedGradleModuleFormatVersions, module.FormatVersion) {
+		return nil, fmt.Errorf("unsupported gradle module metadata format version: %q", module.FormatVersion)
+	}
+	if module.Component.Group != info.GroupID || module.Component.Module != info.ArtifactID ||
+		module.Component.Version != info.Version {
+		return nil, fmt.Errorf("gradle module component %s:%s:%s does not match upload coordinates %s:%s:%s",
+			module.Component.Group, module.Component.Module, module.Component.Version,
+			info.GroupID, info.ArtifactID, info.Version)
+	}
+	names := make(map[string]struct{}, len(module.Variants))
+	for _, variant := range module.Variants {
+		if variant.Name == "" {
+			return nil, fmt.Errorf("gradle module variant name is required")
+		}
+		if _, ok := names[variant.Name]; ok {
+			return nil, fmt.Errorf("duplicate gradle module variant: %s", variant.Name)
+		}
+		names[variant.Name] = struct{}{}
+		for _, file := range variant.Files {
+			if file.Name == "" || file.URL == "" {
+				return nil, fmt.Errorf("gradle module variant %s has a file without name or url", variant.Name)
+			}
+		}
+	}
+	return module, nil
+}
+
+// GetGradleVariants summarizes the variants of a Gradle module to be stored with the artifact metadata.
+func GetGradleVariants(module *GradleModule) []metadata.GradleVariant {
+	variants := make([]metadata.GradleVariant, 0, len(module.Variants))
+	for _, v := range module.Variants {
+		attributes := make(map[string]string, len(v.Attributes))
+		for key, value := range v.Attributes {
+			attributes[key] = fmt.Sprint(value)
+		}
+		files := make([]string, 0, len(v.Files))
+		for _, file := range v.Files {
+			files = append(files, file.Name)
+		}
+		dependencies := make([]string, 0, len(v.Dependencies))
+		for _, dep := range v.Dependencies {
+			coordinate := dep.Group + ":" + dep.Module
+			if requires, ok := dep.Version["requires"]; ok {
+				coordinate += ":" + fmt.Sprint(requires)
+			}
+			dependencies = append(dependencies, coordinate)
+		}
+		variants = append(variants, metadata.GradleVariant{
+			Name:         v.Name,
+			Attributes:   attributes,
+			Files:        files,
+			Dependencies: dependencies,
+		})
+	}
+	return variants
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/metadata"
+	"github.com/harness/gitness/registry/app/pkg"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testGradleModule = `{
+  "formatVersion": "1.1",
+  "component": {"group": "com.example", "module": "lib", "version": "1.0"},
+  "variants": [
+    {
+      "name": "apiElements",
+      "attributes": {"org.gradle.usage": "java-api", "org.gradle.jvm.version": 17},
+      "dependencies": [
+        {"group": "org.slf4j", "module": "slf4j-api", "version": {"requires": "2.0.9"}},
+        {"group": "com.example", "module": "platform"}
+      ],
+      "files": [{"name": "lib-1.0.jar", "url": "lib-1.0.jar", "size": 42, "sha256": "abc"}]
+    },
+    {"name": "runtimeElements"}
+  ]
+}`
+
+func newTestGradleInfo() pkg.MavenArtifactInfo {
+	return pkg.MavenArtifactInfo{GroupID: "com.example", ArtifactID: "lib", Version: "1.0", FileName: "lib-1.0.module"}
+}
+
+func TestParseGradleModule(t *testing.T) {
+	module, err := ParseGradleModule([]byte(testGradleModule), newTestGradleInfo())
+	require.NoError(t, err)
+	require.Equal(t, "1.1", module.FormatVersion)
+	require.Len(t, module.Variants, 2)
+	require.Equal(t, "lib-1.0.jar", module.Variants[0].Files[0].URL)
+}
+
+func TestParseGradleModuleInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		info    func(*pkg.MavenArtifactInfo)
+	}{
+		{name: "malformed", content: `{"formatVersion": `},
+		{name: "unsupported format version",
+			content: `{"formatVersion": "2.0", "component": {"group": "com.example", "module": "lib", "version": "1.0"}}`},
+		{name: "other coordinates", content: testGradleModule,
+			info: func(info *pkg.MavenArtifactInfo) { info.Version = "1.1" }},
+		{name: "unnamed variant",
+			content: `{"formatVersion": "1.1", "component": {"group": "com.example", "module": "lib", ` +
+				`"version": "1.0"}, "variants": [{"name": ""}]}`},
+		{name: "duplicate variant",
+			content: `{"formatVersion": "1.1", "component": {"group": "com.example", "module": "lib", ` +
+				`"version": "1.0"}, "variants": [{"name": "api"}, {"name": "api"}]}`},
+		{name: "file without url",
+			content: `{"formatVersion": "1.1", "component": {"group": "com.example", "module": "lib", ` +
+				`"version": "1.0"}, "variants": [{"name": "api", "files": [{"name": "lib-1.0.jar"}]}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := newTestGradleInfo()
+			if tt.info != nil {
+				tt.info(&info)
+			}
+			_, err := ParseGradleModule([]byte(tt.content), info)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestGetGradleVariants(t *testing.T) {
+	module, err := ParseGradleModule([]byte(testGradleModule), newTestGradleInfo())
+	require.NoError(t, err)
+
+	require.Equal(t, []metadata.GradleVariant{
+		{
+			Name: "apiElements",
+			Attributes: map[string]string{
+				"org.gradle.usage":       "java-api",
+				"org.gradle.jvm.version": "17",
+			},
+			Files:        []string{"lib-1.0.jar"},
+			Dependencies: []string{"org.slf4j:slf4j-api:2.0.9", "com.example:platform"},
+		},
+		{
+			Name:         "runtimeElements",
+			Attributes:   map[string]string{},
+			Files:        []string{},
+			Dependencies: []string{},
+		},
+	}, GetGradleVariants(module))
+}
@@ -91,6 +91,43 @@ func IsMetadataFile(filename string) bool {
 		filename == mavenMetadataFile+extensionSHA512
 }
 
+// IsChecksumFile reports whether the filename is a checksum sidecar (.md5, .sha1, .sha256 or .sha512).
+func IsChecksumFile(filename string) bool {
+	ext := strings.ToLower(filepath.Ext(filename))
+	return ext == extensionMD5 || ext == extensionSHA1 || ext == extensionSHA256 || ext == extensionSHA512
+}
+
+// GetChecksumTargetFileName returns the name of the file the checksum sidecar belongs to.
+func GetChecksumTargetFileName(filename string) string {
+	return strings.TrimSuffix(filename, filepath.Ext(filename))
+}
+
+// GetChecksum returns the checksum of the file matching the extension of the checksum sidecar filename.
+func GetChecksum(filename string, fileInfo types.FileInfo) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case extensionMD5:
+		return fileInfo.MD5
+	case extensionSHA1:
+		return fileInfo.Sha1
+	case extensionSHA256:
+		return fileInfo.Sha256
+	case extensionSHA512:
+		return fileInfo.Sha512
+	default:
+		return ""
+	}
+}
+
+// ParseChecksum extracts the checksum value from the content of a checksum sidecar file. Some clients
+// write the file in the "<checksum>  <filename>" format, so only the first field is considered.
+func ParseChecksum(content string) string {
+	fields := strings.Fields(content)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToLower(fields[0])
+}
+
 func SetHeaders(
 	info pkg.MavenArtifactInfo,
 	fileInfo types.FileInfo,
@@ -112,6 +149,8 @@ func SetHeaders(
 		responseHeaders.Headers["Content-Type"] = contentTypeXML
 	case extensionMD5, extensionSHA1, extensionSHA256, extensionSHA512:
 		responseHeaders.Headers["Content-Type"] = contentTypePlainText
+	case extensionModule:
+		responseHeaders.Headers["Content-Type"] = contentTypeGradleModule
 	}
 	return responseHeaders
 }
@@ -0,0 +1,38 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import "testing"
+
+func TestParseChecksum(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "plain", content: "d41d8cd98f00b204e9800998ecf8427e", want: "d41d8cd98f00b204e9800998ecf8427e"},
+		{name: "upper case", content: "DA39A3EE\n", want: "da39a3ee"},
+		{name: "with filename", content: "da39a3ee  app-1.0.jar\n", want: "da39a3ee"},
+		{name: "empty", content: "", want: ""},
+		{name: "whitespace only", content: " \t\n", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseChecksum(tt.content); got != tt.want {
+				t.Errorf("ParseChecksum(%q) = %q, want %q", tt.content, got, tt.want)
+			}
+		})
+	}
+}
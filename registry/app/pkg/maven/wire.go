@@ -24,6 +24,7 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/quarantine"
 	"github.com/harness/gitness/registry/app/remote/controller/proxy/maven"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/denylist"
 	"github.com/harness/gitness/secret"
 	"github.com/harness/gitness/store/database/dbtx"
 
@@ -62,8 +63,10 @@ func ControllerProvider(
 	spaceFinder refcache.SpaceFinder,
 	quarantineFinder quarantine.Finder,
 	dependencyFirewallChecker interfaces.DependencyFirewallChecker,
+	denylist *denylist.Service,
 ) *Controller {
-	return NewController(local, remote, authorizer, dBStore, spaceFinder, quarantineFinder, dependencyFirewallChecker)
+	return NewController(local, remote, authorizer, dBStore, spaceFinder, quarantineFinder, dependencyFirewallChecker,
+		denylist)
 }
 
 func DBStoreProvider(
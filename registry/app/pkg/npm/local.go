@@ -18,6 +18,7 @@ import (
 	"bufio"
 	"context"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -38,6 +39,7 @@ import (
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
 	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/google/uuid"
@@ -135,10 +137,23 @@ func (c *localRegistry) UploadPackageFile(
 	for _, meta := range packageMetadata.Versions {
 		info.Version = meta.Version
 	}
+	if versionMetadata, ok := packageMetadata.Versions[info.Version]; ok && versionMetadata != nil {
+		if err = verifyTarballIntegrity(versionMetadata.Dist, fileInfo); err != nil {
+			log.Ctx(ctx).Error().Msgf("integrity check failed for npm package: %s@%s: %v",
+				info.Image, info.Version, err)
+			return nil, "", err
+		}
+		versionMetadata.Dist.Shasum = fileInfo.Sha1
+		versionMetadata.Dist.Integrity = getTarballIntegrity(fileInfo)
+	}
 	info.Filename = info.Image + "-" + info.Version + ".tgz"
 	fileInfo.Filename = info.Filename
 	filePath := path.Join(info.Image, info.Version, fileInfo.Filename)
 
+	if err = c.checkExistingTarball(ctx, info, filePath, fileInfo); err != nil {
+		return nil, "", err
+	}
+
 	_, sha256, _, _, err = c.localBase.UpdateFileManagerAndCreateArtifact(ctx, info.ArtifactInfo, info.Version,
 		filePath,
 		&npm2.NpmMetadata{
@@ -158,6 +173,78 @@ func (c *localRegistry) UploadPackageFile(
 	return nil, sha256, nil
 }
 
+// checkExistingTarball fails with a conflict if the version was already published with a different tarball.
+func (c *localRegistry) checkExistingTarball(
+	ctx context.Context, info npm.ArtifactInfo, filePath string, fileInfo types.FileInfo,
+) error {
+	existingSha256, _, err := c.fileManager.HeadFile(ctx, "/"+filePath, info.RegistryID)
+	switch {
+	case errors.Is(err, gitnessstore.ErrResourceNotFound):
+		log.Ctx(ctx).Debug().Msgf("no existing tarball found for npm package: %s@%s", info.Image, info.Version)
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to check for an existing tarball of npm package: %s@%s: %w",
+			info.Image, info.Version, err)
+	case existingSha256 != fileInfo.Sha256:
+		return usererror.Conflict(fmt.Sprintf("cannot modify pre-existing version: %s@%s, "+
+			"the tarball content differs from the published one", info.Image, info.Version))
+	default:
+		return nil
+	}
+}
+
+// verifyTarballIntegrity checks the integrity and shasum declared in the publish payload against the
+// checksums computed while storing the tarball.
+func verifyTarballIntegrity(dist npm2.PackageDistribution, fileInfo types.FileInfo) error {
+	if dist.Shasum != "" && !strings.EqualFold(dist.Shasum, fileInfo.Sha1) {
+		return usererror.BadRequest(fmt.Sprintf("shasum mismatch: declared %s, computed %s",
+			dist.Shasum, fileInfo.Sha1))
+	}
+	if dist.Integrity == "" {
+		return nil
+	}
+	checksums := map[string]string{
+		"sha1":   fileInfo.Sha1,
+		"sha256": fileInfo.Sha256,
+		"sha512": fileInfo.Sha512,
+	}
+	supported := false
+	// integrity is a Subresource Integrity string: one or more space separated "<algorithm>-<base64>" values.
+	for _, value := range strings.Fields(dist.Integrity) {
+		algorithm, encoded, found := strings.Cut(value, "-")
+		if !found {
+			continue
+		}
+		checksum, ok := checksums[algorithm]
+		if !ok {
+			continue
+		}
+		supported = true
+		encoded, _, _ = strings.Cut(encoded, "?")
+		digest, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return usererror.BadRequest(fmt.Sprintf("invalid integrity value: %s", value))
+		}
+		if hex.EncodeToString(digest) == strings.ToLower(checksum) {
+			return nil
+		}
+	}
+	if !supported {
+		return usererror.BadRequest(fmt.Sprintf("unsupported integrity algorithm: %s", dist.Integrity))
+	}
+	return usererror.BadRequest(fmt.Sprintf("integrity mismatch: declared %s, computed %s",
+		dist.Integrity, getTarballIntegrity(fileInfo)))
+}
+
+// getTarballIntegrity returns the sha512 Subresource Integrity value of the stored tarball.
+func getTarballIntegrity(fileInfo types.FileInfo) string {
+	digest, err := hex.DecodeString(fileInfo.Sha512)
+	if err != nil || len(digest) == 0 {
+		return ""
+	}
+	return "sha512-" + base64.StdEncoding.EncodeToString(digest)
+}
+
 func (c *localRegistry) GetPackageMetadata(ctx context.Context, info npm.ArtifactInfo) (npm2.PackageMetadata, error) {
 	packageMetadata := npm2.PackageMetadata{}
 	versions := make(map[string]*npm2.PackageMetadataVersion)
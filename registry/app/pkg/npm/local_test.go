@@ -18,12 +18,19 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha512"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"mime/multipart"
 	"testing"
+	"time"
 
+	"github.com/harness/gitness/app/api/usererror"
 	urlprovider "github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/driver/filesystem"
@@ -38,6 +45,7 @@ import (
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -148,6 +156,14 @@ func (m *mockLocalBase) Upload(
 	panic("not implemented in tests")
 }
 
+func (m *mockLocalBase) UploadByDigest(
+	context.Context,
+	pkg.ArtifactInfo, string, string,
+	string, string, metadata.Metadata,
+) (*commons.ResponseHeaders, string, bool, error) {
+	panic("not implemented in tests")
+}
+
 func (m *mockLocalBase) UpdateFileManagerAndCreateArtifact(
 	ctx context.Context,
 	info pkg.ArtifactInfo,
@@ -289,7 +305,7 @@ func (m *mockArtifactDAO) DuplicateArtifact(
 
 func (m *mockArtifactDAO) GetLatestArtifactsByRepo(
 	_ context.Context,
-	_ int64, _ int, _ int64,
+	_ int64, _ int, _ int64, _ time.Duration,
 ) (*[]types.ArtifactMetadata, error) {
 	// TODO implement me
 	panic("implement me")
@@ -298,6 +314,7 @@ func (m *mockArtifactDAO) GetLatestArtifactsByRepo(
 func (m *mockArtifactDAO) GetByUUID(
 	ctx context.Context,
 	uuid string,
+	_ ...types.QueryOption,
 ) (*types.Artifact, error) {
 	return m.getByUUID(ctx, uuid)
 }
@@ -305,17 +322,20 @@ func (m *mockArtifactDAO) GetByUUID(
 func (m *mockArtifactDAO) GetByName(
 	ctx context.Context,
 	imageID int64, version string,
+	_ ...types.QueryOption,
 ) (*types.Artifact, error) {
 	return m.getByName(ctx, imageID, version)
 }
 func (m *mockArtifactDAO) GetByRegistryImageAndVersion(
 	context.Context,
 	int64, string, string,
+	...types.QueryOption,
 ) (*types.Artifact, error) {
 	return nil, nil //nolint:nilnil
 }
 func (m *mockArtifactDAO) GetByRegistryImageVersionAndArtifactType(
 	ctx context.Context, registryID int64, image string, version string, artifactType string,
+	_ ...types.QueryOption,
 ) (*types.Artifact, error) {
 	return nil, nil //nolint:nilnil
 }
@@ -339,10 +359,15 @@ func (m *mockArtifactDAO) CountAllArtifactsByParentID(
 func (m *mockArtifactDAO) GetArtifactsByRepo(
 	context.Context,
 	int64, string, string, string,
-	int, int, string, []string, *artifact.ArtifactType,
+	int, int, string, []string, *artifact.ArtifactType, bool,
 ) (*[]types.ArtifactMetadata, error) {
 	return &[]types.ArtifactMetadata{}, nil
 }
+func (m *mockArtifactDAO) GetDownloadCountsByImageNames(
+	context.Context, int64, []string,
+) (map[string]int64, error) {
+	return map[string]int64{}, nil
+}
 func (m *mockArtifactDAO) CountArtifactsByRepo(
 	context.Context,
 	int64, string, string, []string, *artifact.ArtifactType,
@@ -380,12 +405,14 @@ func (m *mockArtifactDAO) UpdateArtifactMetadata(context.Context, json.RawMessag
 func (m *mockArtifactDAO) GetByRegistryIDAndImage(
 	ctx context.Context,
 	registryID int64, image string,
+	_ ...types.QueryOption,
 ) (*[]types.Artifact, error) {
 	return m.getByRegistryIDAndImage(ctx, registryID, image)
 }
 func (m *mockArtifactDAO) Get(
 	ctx context.Context,
 	id int64,
+	_ ...types.QueryOption,
 ) (*types.Artifact, error) {
 	return m.get(ctx, id)
 }
@@ -395,12 +422,15 @@ func (m *mockArtifactDAO) DeleteByImageNameAndRegistryID(context.Context, int64,
 func (m *mockArtifactDAO) DeleteByVersionAndImageName(context.Context, string, string, int64) error {
 	return nil //nolint:nilnil
 }
+func (m *mockArtifactDAO) SoftDeleteByVersionAndImageName(context.Context, string, string, int64) error {
+	return nil
+}
 func (m *mockArtifactDAO) GetLatestByImageID(context.Context, int64) (*types.Artifact, error) {
 	return nil, nil //nolint:nilnil
 }
 func (m *mockArtifactDAO) GetAllArtifactsByRepo(
 	context.Context,
-	int64, int, int64,
+	int64, int, int64, time.Duration,
 ) (*[]types.ArtifactMetadata, error) {
 	return nil, nil //nolint:nilnil
 }
@@ -431,6 +461,15 @@ func (m *mockArtifactDAO) SearchByImageName(
 func (m *mockArtifactDAO) CountByImageName(context.Context, int64, string) (int64, error) {
 	return 0, nil
 }
+func (m *mockArtifactDAO) SearchPackagesByImageName(
+	context.Context,
+	int64, string, bool, int, int,
+) (*[]types.ArtifactMetadata, error) {
+	return &[]types.ArtifactMetadata{}, nil
+}
+func (m *mockArtifactDAO) CountPackagesByImageName(context.Context, int64, string, bool) (int64, error) {
+	return 0, nil
+}
 
 type mockURLProvider struct {
 	pkgURL func(ctx context.Context, regRef string, pkgType string, params ...string) string
@@ -472,6 +511,15 @@ func (m *mockURLProvider) GenerateUIRegistryURL(_ context.Context, _ string, _ s
 	return ""
 }
 
+type mockFileManager struct {
+	filemanager.FileManager
+	headFile func(ctx context.Context, filePath string, regID int64) (string, int64, error)
+}
+
+func (m *mockFileManager) HeadFile(ctx context.Context, filePath string, regID int64) (string, int64, error) {
+	return m.headFile(ctx, filePath, regID)
+}
+
 // -----------------------
 // Helpers
 // -----------------------
@@ -781,3 +829,76 @@ func TestProcessAttachmentsOptimized_NoData(t *testing.T) {
 	_, err := lr.processAttachmentsOptimized(ctx, info, dec, bufReader)
 	assert.Error(t, err)
 }
+
+func TestVerifyTarballIntegrity(t *testing.T) {
+	content := []byte("test tarball bytes")
+	sha1Sum := sha1.Sum(content) //nolint:gosec
+	sha512Sum := sha512.Sum512(content)
+	fi := types.FileInfo{
+		Sha1:   hex.EncodeToString(sha1Sum[:]),
+		Sha512: hex.EncodeToString(sha512Sum[:]),
+	}
+	integrity := "sha512-" + base64.StdEncoding.EncodeToString(sha512Sum[:])
+
+	assert.Equal(t, integrity, getTarballIntegrity(fi))
+
+	// Matching shasum and integrity
+	assert.NoError(t, verifyTarballIntegrity(npmmeta.PackageDistribution{
+		Shasum: fi.Sha1, Integrity: integrity,
+	}, fi))
+	// Nothing declared
+	assert.NoError(t, verifyTarballIntegrity(npmmeta.PackageDistribution{}, fi))
+	// Multiple values, one matching
+	assert.NoError(t, verifyTarballIntegrity(npmmeta.PackageDistribution{
+		Integrity: "sha1-" + base64.StdEncoding.EncodeToString(sha1Sum[:]) + " sha384-abc",
+	}, fi))
+
+	// Mismatching shasum
+	assert.Error(t, verifyTarballIntegrity(npmmeta.PackageDistribution{Shasum: "abc"}, fi))
+	// Mismatching integrity
+	assert.Error(t, verifyTarballIntegrity(npmmeta.PackageDistribution{
+		Integrity: "sha512-" + base64.StdEncoding.EncodeToString([]byte("other")),
+	}, fi))
+	// Unsupported algorithm
+	assert.Error(t, verifyTarballIntegrity(npmmeta.PackageDistribution{Integrity: "md5-abc"}, fi))
+}
+
+func TestCheckExistingTarball(t *testing.T) {
+	ctx := context.Background()
+	info := sampleArtifactInfo()
+	fi := types.FileInfo{Sha256: "abc"}
+
+	tests := []struct {
+		name     string
+		sha256   string
+		headErr  error
+		wantErr  bool
+		conflict bool
+	}{
+		{name: "not published yet", headErr: fmt.Errorf("failed to get node: %w", gitnessstore.ErrResourceNotFound)},
+		{name: "same tarball", sha256: "abc"},
+		{name: "different tarball", sha256: "def", wantErr: true, conflict: true},
+		{name: "lookup fails", headErr: errors.New("db down"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := newLocalForTests(&mockLocalBase{}, nil, nil, nil, nil)
+			lr.fileManager = &mockFileManager{
+				headFile: func(_ context.Context, filePath string, regID int64) (string, int64, error) {
+					assert.Equal(t, "/pkg/1.0.0/pkg-1.0.0.tgz", filePath)
+					assert.Equal(t, int64(10), regID)
+					return tt.sha256, 0, tt.headErr
+				},
+			}
+
+			err := lr.checkExistingTarball(ctx, info, "pkg/1.0.0/pkg-1.0.0.tgz", fi)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			var userErr *usererror.Error
+			assert.Equal(t, tt.conflict, errors.As(err, &userErr))
+		})
+	}
+}
@@ -18,6 +18,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/harness/gitness/app/services/refcache"
 	urlprovider "github.com/harness/gitness/app/url"
@@ -31,6 +33,9 @@ import (
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	cfg "github.com/harness/gitness/registry/config"
+	"github.com/harness/gitness/registry/services/firewalldelay"
+	"github.com/harness/gitness/registry/services/upstreamprovenance"
+	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/secret"
 	"github.com/harness/gitness/store/database/dbtx"
 
@@ -51,6 +56,8 @@ type proxy struct {
 	spaceFinder         refcache.SpaceFinder
 	service             secret.Service
 	localRegistryHelper LocalRegistryHelper
+	firewallDelay       *firewalldelay.Service
+	upstreamProvenance  *upstreamprovenance.Service
 }
 
 func (r *proxy) SearchPackage(_ context.Context, _ npm2.ArtifactInfo, _ int, _ int) (*npm.PackageSearch, error) {
@@ -99,6 +106,9 @@ func (r *proxy) GetPackageMetadata(ctx context.Context, info npm2.ArtifactInfo)
 	if err != nil {
 		return npm.PackageMetadata{}, err
 	}
+	if err = r.holdBackVersions(ctx, upstreamProxy, info.Image, result); err != nil {
+		return npm.PackageMetadata{}, err
+	}
 	regURL := r.urlProvider.PackageURL(ctx, info.RootIdentifier+"/"+info.ParentRegIdentifier, "npm")
 
 	versions := make(map[string]*npm.PackageMetadataVersion)
@@ -110,6 +120,70 @@ func (r *proxy) GetPackageMetadata(ctx context.Context, info npm2.ArtifactInfo)
 	return *result, nil
 }
 
+// holdBackVersions removes the versions held back by the firewall delay of the upstream proxy from the metadata of
+// the package. The dist-tags pointing to them fall back to the newest version served, a prerelease for a
+// prerelease, so clients resolve the versions they can pull.
+func (r *proxy) holdBackVersions(
+	ctx context.Context,
+	upstreamProxy *types.UpstreamProxy,
+	pkg string,
+	metadata *npm.PackageMetadata,
+) error {
+	if firewalldelay.Delay(upstreamProxy) <= 0 {
+		return nil
+	}
+	published := make(map[string]time.Time, len(metadata.Versions))
+	for version := range metadata.Versions {
+		published[version] = metadata.Time[version]
+	}
+	held, err := r.firewallDelay.HeldVersions(ctx, upstreamProxy, pkg, published)
+	if err != nil {
+		return err
+	}
+	if len(held) == 0 {
+		return nil
+	}
+
+	for version := range held {
+		delete(metadata.Versions, version)
+		delete(metadata.Time, version)
+	}
+	for tag, version := range metadata.DistTags {
+		if _, ok := held[version]; !ok {
+			continue
+		}
+		if fallback := newestVersion(metadata, isPrerelease(version)); fallback != "" {
+			metadata.DistTags[tag] = fallback
+		} else {
+			delete(metadata.DistTags, tag)
+		}
+	}
+	log.Ctx(ctx).Info().Msgf("registry [%s] holds back %d versions of package [%s] published upstream within its "+
+		"firewall delay", upstreamProxy.RepoKey, len(held), pkg)
+	return nil
+}
+
+// newestVersion returns the last published version of the metadata which is a prerelease or not, empty if there's
+// none.
+func newestVersion(metadata *npm.PackageMetadata, prerelease bool) string {
+	newest := ""
+	var newestTime time.Time
+	for version := range metadata.Versions {
+		if isPrerelease(version) != prerelease {
+			continue
+		}
+		if publishedAt := metadata.Time[version]; newest == "" || publishedAt.After(newestTime) {
+			newest = version
+			newestTime = publishedAt
+		}
+	}
+	return newest
+}
+
+func isPrerelease(version string) bool {
+	return strings.Contains(version, "-")
+}
+
 type Proxy interface {
 	Registry
 }
@@ -125,6 +199,8 @@ func NewProxy(
 	spaceFinder refcache.SpaceFinder,
 	service secret.Service,
 	localRegistryHelper LocalRegistryHelper,
+	firewallDelay *firewalldelay.Service,
+	upstreamProvenance *upstreamprovenance.Service,
 ) Proxy {
 	return &proxy{
 		proxyStore:          proxyStore,
@@ -137,6 +213,8 @@ func NewProxy(
 		spaceFinder:         spaceFinder,
 		service:             service,
 		localRegistryHelper: localRegistryHelper,
+		firewallDelay:       firewallDelay,
+		upstreamProvenance:  upstreamProvenance,
 	}
 }
 
@@ -175,6 +253,17 @@ func (r *proxy) DownloadPackageFile(ctx context.Context, info npm2.ArtifactInfo)
 		return nil, nil, nil, "", err
 	}
 
+	if firewalldelay.Delay(upstreamProxy) > 0 {
+		metadata, err := remote.GetPackageMetadata(ctx, info.Image)
+		if err != nil {
+			return nil, nil, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		err = r.firewallDelay.CheckPull(ctx, upstreamProxy, info.Image, info.Version, metadata.Time[info.Version])
+		if err != nil {
+			return nil, nil, nil, "", err
+		}
+	}
+
 	file, err := remote.GetPackage(ctx, info.Image, info.Version)
 	if err != nil {
 		return nil, nil, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
@@ -227,9 +316,29 @@ func (r *proxy) putFileToLocal(ctx context.Context, info npm2.ArtifactInfo, remo
 		return err2
 	}
 	log.Ctx(ctx).Info().Msgf("Successfully uploaded %s with SHA256: %s", info.Filename, sha256)
+
+	var dist npm.PackageDistribution
+	if v, ok := versionMetadata.Versions[info.Version]; ok && v != nil {
+		dist = v.Dist
+	}
+	err = r.upstreamProvenance.Record(ctx, info.RegistryID, info.Image, info.Version, info.Filename, dist.Tarball,
+		distChecksums(dist))
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to record the upstream provenance of %s", info.Filename)
+	}
 	return nil
 }
 
+// distChecksums returns the checksums the upstream advertises for the tarball of a version, the shasum is kept
+// along the digests of the integrity.
+func distChecksums(dist npm.PackageDistribution) map[string]string {
+	checksums := upstreamprovenance.IntegrityChecksums(dist.Integrity)
+	if _, ok := checksums["sha1"]; !ok && dist.Shasum != "" {
+		checksums["sha1"] = strings.ToLower(dist.Shasum)
+	}
+	return checksums
+}
+
 func (r *proxy) UploadPackageFileWithoutParsing(
 	ctx context.Context,
 	_ npm2.ArtifactInfo,
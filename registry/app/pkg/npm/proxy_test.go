@@ -0,0 +1,100 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	npmmeta "github.com/harness/gitness/registry/app/metadata/npm"
+	"github.com/harness/gitness/registry/services/firewalldelay"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type noApprovalsDao struct{}
+
+func (noApprovalsDao) Create(context.Context, *types.FirewallApproval) error { return nil }
+
+func (noApprovalsDao) List(context.Context, int64) ([]*types.FirewallApproval, error) {
+	return nil, nil
+}
+
+func (noApprovalsDao) ListByPackage(context.Context, int64, string) ([]*types.FirewallApproval, error) {
+	return nil, nil
+}
+
+func (noApprovalsDao) Delete(context.Context, int64, string, string) error { return nil }
+
+func TestHoldBackVersions(t *testing.T) {
+	now := time.Now()
+	metadata := &npmmeta.PackageMetadata{
+		DistTags: map[string]string{"latest": "1.2.0", "next": "2.0.0-rc.1", "beta": "1.3.0-beta.1"},
+		Versions: map[string]*npmmeta.PackageMetadataVersion{
+			"1.0.0": {}, "1.1.0": {}, "1.2.0": {}, "1.3.0-beta.1": {}, "2.0.0-rc.1": {},
+		},
+		Time: map[string]time.Time{
+			"1.0.0":        now.Add(-30 * 24 * time.Hour),
+			"1.1.0":        now.Add(-10 * 24 * time.Hour),
+			"1.2.0":        now.Add(-time.Hour),
+			"1.3.0-beta.1": now.Add(-5 * 24 * time.Hour),
+			"2.0.0-rc.1":   now.Add(-2 * time.Hour),
+		},
+	}
+	r := &proxy{firewallDelay: firewalldelay.NewService(noApprovalsDao{})}
+	upstream := &types.UpstreamProxy{RegistryID: 1, PackageType: artifact.PackageTypeNPM, FirewallDelayHours: 48}
+
+	err := r.holdBackVersions(context.Background(), upstream, "left-pad", metadata)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, metadata.Versions, "1.2.0")
+	assert.NotContains(t, metadata.Time, "2.0.0-rc.1")
+	assert.Contains(t, metadata.Versions, "1.1.0")
+	// the tags of held versions fall back to the newest version served, prereleases to prereleases.
+	assert.Equal(t, "1.1.0", metadata.DistTags["latest"])
+	assert.Equal(t, "1.3.0-beta.1", metadata.DistTags["next"])
+	assert.Equal(t, "1.3.0-beta.1", metadata.DistTags["beta"])
+}
+
+func TestHoldBackVersionsWithoutDelay(t *testing.T) {
+	metadata := &npmmeta.PackageMetadata{
+		DistTags: map[string]string{"latest": "1.0.0"},
+		Versions: map[string]*npmmeta.PackageMetadataVersion{"1.0.0": {}},
+		Time:     map[string]time.Time{"1.0.0": time.Now()},
+	}
+	r := &proxy{firewallDelay: firewalldelay.NewService(noApprovalsDao{})}
+	upstream := &types.UpstreamProxy{RegistryID: 1, PackageType: artifact.PackageTypeNPM}
+
+	assert.NoError(t, r.holdBackVersions(context.Background(), upstream, "left-pad", metadata))
+	assert.Contains(t, metadata.Versions, "1.0.0")
+	assert.Equal(t, "1.0.0", metadata.DistTags["latest"])
+}
+
+func TestDistChecksums(t *testing.T) {
+	dist := npmmeta.PackageDistribution{
+		Integrity: "sha512-XRcglhh3p2lHAu4gFg75i5owZ3/uttIZh11iKj+W2fqc4Iu8OvwIHkDSftaw4gURo0WA2fT2oguwTa4HChLwJw==",
+		Shasum:    "16C385A6CBD7C6AD06CD6A7195AAFAE4932FCF3D",
+	}
+
+	assert.Equal(t, map[string]string{
+		"sha512": "5d1720961877a7694702ee20160ef98b9a30677feeb6d219875d622a3f96d9fa9ce08bbc3afc081e40d27ed6b0e20511a345" +
+			"80d9f4f6a20bb04dae070a12f027",
+		"sha1": "16c385a6cbd7c6ad06cd6a7195aafae4932fcf3d",
+	}, distChecksums(dist))
+	assert.Empty(t, distChecksums(npmmeta.PackageDistribution{}))
+}
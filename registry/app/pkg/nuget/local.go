@@ -32,10 +32,12 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/base"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	zs "github.com/harness/gitness/registry/app/pkg/commons/zipreader"
+	"github.com/harness/gitness/registry/app/pkg/failedupload"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	nugettype "github.com/harness/gitness/registry/app/pkg/types/nuget"
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/google/uuid"
@@ -68,6 +70,8 @@ type localRegistry struct {
 	imageDao    store.ImageRepository
 	artifactDao store.ArtifactRepository
 	urlProvider urlprovider.Provider
+	// failedUploads keeps the packages whose metadata couldn't be read for the publisher to debug them.
+	failedUploads *failedupload.Recorder
 }
 
 func (c *localRegistry) GetServiceEndpoint(
@@ -172,15 +176,16 @@ func (c *localRegistry) SearchPackageV2(
 func (c *localRegistry) SearchPackage(
 	ctx context.Context,
 	info nugettype.ArtifactInfo,
-	searchTerm string, limit int, offset int,
+	searchTerm string, includePrerelease bool, limit int, offset int,
 ) (*nugettype.SearchResultResponse, error) {
 	packageURL := c.urlProvider.PackageURL(ctx, info.RootIdentifier+"/"+info.RegIdentifier, "nuget")
-	artifacts, err := c.artifactDao.SearchByImageName(ctx, info.RegistryID, strings.ToLower(searchTerm), limit, offset)
+	artifacts, err := c.artifactDao.SearchPackagesByImageName(ctx, info.RegistryID, searchTerm, includePrerelease,
+		limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"failed to get artifacts for registry: %d and image: %s: %w", info.RegistryID, searchTerm, err)
 	}
-	count, err2 := c.artifactDao.CountByImageName(ctx, info.RegistryID, strings.ToLower(searchTerm))
+	count, err2 := c.artifactDao.CountPackagesByImageName(ctx, info.RegistryID, searchTerm, includePrerelease)
 	if err2 != nil {
 		return nil, fmt.Errorf(
 			"failed to get artifacts count for registry: %d and image: %s: %w",
@@ -266,6 +271,7 @@ func (c *localRegistry) UploadPackage(
 
 	metadata, err = c.buildMetadata(r)
 	if err != nil {
+		c.failedUploads.Record(ctx, info.ArtifactInfo, apicontract.PackageTypeNUGET, info.Filename, fileInfo, err)
 		return headers, "", fmt.Errorf(
 			"failed to build metadata for registry: %d with error: %w",
 			info.RegistryID, err)
@@ -274,8 +280,11 @@ func (c *localRegistry) UploadPackage(
 	info.Version = metadata.PackageMetadata.Version
 	normalisedVersion, err2 := validateAndNormaliseVersion(info.Version)
 	if err2 != nil {
-		return headers, "", fmt.Errorf("nuspec file contains an invalid version: %s with "+
+		err = fmt.Errorf("nuspec file contains an invalid version: %s with "+
 			"package name: %s, registry name: %s", info.Version, info.Image, info.RegIdentifier)
+		c.failedUploads.Record(ctx, info.ArtifactInfo, apicontract.PackageTypeNUGET, info.Filename, fileInfo,
+			failedupload.WithClass(types.UploadErrorClassInvalidVersion, err))
+		return headers, "", err
 	}
 	info.Version = normalisedVersion
 	info.Metadata = metadata
@@ -427,16 +436,18 @@ func NewLocalRegistry(
 	imageDao store.ImageRepository,
 	artifactDao store.ArtifactRepository,
 	urlProvider urlprovider.Provider,
+	failedUploads *failedupload.Recorder,
 ) LocalRegistry {
 	return &localRegistry{
-		localBase:   localBase,
-		fileManager: fileManager,
-		proxyStore:  proxyStore,
-		tx:          tx,
-		registryDao: registryDao,
-		imageDao:    imageDao,
-		artifactDao: artifactDao,
-		urlProvider: urlProvider,
+		localBase:     localBase,
+		fileManager:   fileManager,
+		proxyStore:    proxyStore,
+		tx:            tx,
+		registryDao:   registryDao,
+		imageDao:      imageDao,
+		artifactDao:   artifactDao,
+		urlProvider:   urlProvider,
+		failedUploads: failedUploads,
 	}
 }
 
@@ -222,7 +222,7 @@ func (r *proxy) SearchPackageV2(
 
 func (r *proxy) SearchPackage(
 	ctx context.Context, info nugettype.ArtifactInfo,
-	searchTerm string, limit int, offset int,
+	searchTerm string, includePrerelease bool, limit int, offset int,
 ) (*nugettype.SearchResultResponse, error) {
 	upstreamProxy, err := r.proxyStore.GetByRegistryIdentifier(ctx, info.ParentID, info.RegIdentifier)
 	if err != nil {
@@ -235,7 +235,7 @@ func (r *proxy) SearchPackage(
 	}
 
 	// Use the v3 search API directly
-	fileReader, err := helper.SearchPackage(ctx, searchTerm, limit, offset)
+	fileReader, err := helper.SearchPackage(ctx, searchTerm, includePrerelease, limit, offset)
 	if err != nil {
 		return nil, err
 	}
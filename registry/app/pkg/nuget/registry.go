@@ -45,7 +45,7 @@ type Registry interface {
 		searchTerm string, limit int, offset int) (*nuget.FeedResponse, error)
 
 	SearchPackage(ctx context.Context, info nuget.ArtifactInfo,
-		searchTerm string, limit int, offset int) (*nuget.SearchResultResponse, error)
+		searchTerm string, includePrerelease bool, limit int, offset int) (*nuget.SearchResultResponse, error)
 
 	CountPackageV2(ctx context.Context, info nuget.ArtifactInfo, searchTerm string) (int64, error)
 
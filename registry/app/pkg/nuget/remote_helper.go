@@ -42,7 +42,7 @@ type RemoteRegistryHelper interface {
 
 	SearchPackageV2(ctx context.Context, searchTerm string, limit, offset int) (io.ReadCloser, error)
 
-	SearchPackage(ctx context.Context, searchTerm string, limit, offset int) (io.ReadCloser, error)
+	SearchPackage(ctx context.Context, searchTerm string, includePrerelease bool, limit, offset int) (io.ReadCloser, error)
 
 	CountPackageV2(ctx context.Context, searchTerm string) (int64, error)
 
@@ -161,8 +161,8 @@ func (r *remoteRegistryHelper) SearchPackageV2(ctx context.Context,
 }
 
 func (r *remoteRegistryHelper) SearchPackage(ctx context.Context,
-	searchTerm string, limit, offset int) (io.ReadCloser, error) {
-	searchResults, err := r.adapter.SearchPackage(ctx, searchTerm, limit, offset)
+	searchTerm string, includePrerelease bool, limit, offset int) (io.ReadCloser, error) {
+	searchResults, err := r.adapter.SearchPackage(ctx, searchTerm, includePrerelease, limit, offset)
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msgf("failed to search packages (v3) with term: %s", searchTerm)
 		return nil, err
@@ -18,6 +18,7 @@ import (
 	"github.com/harness/gitness/app/services/refcache"
 	urlprovider "github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/registry/app/pkg/base"
+	"github.com/harness/gitness/registry/app/pkg/failedupload"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/secret"
@@ -35,9 +36,10 @@ func LocalRegistryProvider(
 	imageDao store.ImageRepository,
 	artifactDao store.ArtifactRepository,
 	urlProvider urlprovider.Provider,
+	failedUploads *failedupload.Recorder,
 ) LocalRegistry {
 	registry := NewLocalRegistry(localBase, fileManager, proxyStore, tx, registryDao, imageDao, artifactDao,
-		urlProvider)
+		urlProvider, failedUploads)
 	base.Register(registry)
 	return registry
 }
@@ -0,0 +1,136 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ocilayout
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/harness/gitness/registry/app/manifest"
+
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// dockerImage is an image of the manifest of a docker save archive, the paths are relative to the archive.
+type dockerImage struct {
+	Config   string   `json:"Config"`   //nolint:tagliatelle
+	RepoTags []string `json:"RepoTags"` //nolint:tagliatelle
+	Layers   []string `json:"Layers"`   //nolint:tagliatelle
+}
+
+// loadDockerManifest reads the images of a docker save archive. The archive only has the configs and layers of
+// the images, so an OCI image manifest is built for each of them.
+func (a *Archive) loadDockerManifest() error {
+	content, err := os.ReadFile(filepath.Join(a.dir, dockerManifestFile))
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", dockerManifestFile, err)
+	}
+	var images []dockerImage
+	if err = json.Unmarshal(content, &images); err != nil {
+		return fmt.Errorf("%w: failed to parse %s: %w", ErrInvalidArchive, dockerManifestFile, err)
+	}
+
+	for _, image := range images {
+		desc, err := a.buildDockerManifest(image)
+		if err != nil {
+			return err
+		}
+		if len(image.RepoTags) == 0 {
+			a.Refs = append(a.Refs, Ref{Descriptor: desc})
+			continue
+		}
+		for _, repoTag := range image.RepoTags {
+			name, tag, err := ParseReference(repoTag)
+			if err != nil {
+				return err
+			}
+			a.Refs = append(a.Refs, Ref{Name: name, Tag: tag, Descriptor: desc})
+		}
+	}
+	return nil
+}
+
+func (a *Archive) buildDockerManifest(image dockerImage) (manifest.Descriptor, error) {
+	config, err := a.addFile(image.Config)
+	if err != nil {
+		return manifest.Descriptor{}, err
+	}
+	config.MediaType = v1.MediaTypeImageConfig
+
+	m := v1.Manifest{
+		MediaType: v1.MediaTypeImageManifest,
+		Config: v1.Descriptor{
+			MediaType: config.MediaType,
+			Digest:    config.Digest,
+			Size:      config.Size,
+		},
+		Layers: make([]v1.Descriptor, 0, len(image.Layers)),
+	}
+	m.SchemaVersion = 2
+	for _, layerPath := range image.Layers {
+		layer, err := a.addFile(layerPath)
+		if err != nil {
+			return manifest.Descriptor{}, err
+		}
+		m.Layers = append(m.Layers, v1.Descriptor{
+			MediaType: layer.MediaType,
+			Digest:    layer.Digest,
+			Size:      layer.Size,
+		})
+	}
+
+	payload, err := json.Marshal(m)
+	if err != nil {
+		return manifest.Descriptor{}, fmt.Errorf("failed to marshal manifest of %s: %w", image.Config, err)
+	}
+	d := digest.FromBytes(payload)
+	a.synthetic[d] = payload
+	return manifest.Descriptor{MediaType: v1.MediaTypeImageManifest, Digest: d, Size: int64(len(payload))}, nil
+}
+
+// addFile digests a file of a docker save archive so it can be opened by its digest. The layers are tarballs,
+// the gzip compressed ones are told apart by their magic number.
+func (a *Archive) addFile(name string) (manifest.Descriptor, error) {
+	if !filepath.IsLocal(name) {
+		return manifest.Descriptor{}, fmt.Errorf("%w: invalid path %q in %s", ErrInvalidArchive, name,
+			dockerManifestFile)
+	}
+	p := filepath.Join(a.dir, name)
+	f, err := os.Open(p)
+	if err != nil {
+		return manifest.Descriptor{}, fmt.Errorf("%w: %s not found: %w", ErrInvalidArchive, name, err)
+	}
+	defer f.Close()
+
+	br := bufio.NewReader(f)
+	mediaType := v1.MediaTypeImageLayer
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		mediaType = v1.MediaTypeImageLayerGzip
+	}
+
+	digester := digest.Canonical.Digester()
+	size, err := io.Copy(digester.Hash(), br)
+	if err != nil {
+		return manifest.Descriptor{}, fmt.Errorf("failed to digest %s: %w", name, err)
+	}
+	d := digester.Digest()
+	a.blobs[d] = p
+	return manifest.Descriptor{MediaType: mediaType, Digest: d, Size: size}, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ocilayout
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Extract unpacks a tarball, optionally gzip compressed, into dir. Only regular files and the links between them
+// are extracted, docker save links the layers shared by several images. It fails when an entry escapes dir or
+// when the files add up to more than maxSize bytes.
+func Extract(r io.Reader, dir string, maxSize int64) error {
+	br := bufio.NewReader(r)
+	var src io.Reader = br
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+		}
+		defer gz.Close()
+		src = gz
+	}
+
+	links := map[string]string{}
+	var total int64
+	tr := tar.NewReader(src)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+		}
+		if !filepath.IsLocal(hdr.Name) {
+			return fmt.Errorf("%w: entry %q is outside of the archive", ErrInvalidArchive, hdr.Name)
+		}
+		name := filepath.Join(dir, hdr.Name)
+
+		//nolint:exhaustive
+		switch hdr.Typeflag {
+		case tar.TypeReg:
+			total += hdr.Size
+			if total > maxSize {
+				return fmt.Errorf("%w: archive is larger than %d bytes", ErrInvalidArchive, maxSize)
+			}
+			if err = extractFile(tr, name, hdr.Size); err != nil {
+				return err
+			}
+		case tar.TypeSymlink, tar.TypeLink:
+			target := hdr.Linkname
+			if hdr.Typeflag == tar.TypeSymlink {
+				target = filepath.Join(filepath.Dir(hdr.Name), target)
+			}
+			if !filepath.IsLocal(target) {
+				return fmt.Errorf("%w: link %q points outside of the archive", ErrInvalidArchive, hdr.Name)
+			}
+			links[name] = filepath.Join(dir, target)
+		default:
+			// directories are created along with their files, other entries aren't needed.
+		}
+	}
+
+	// the links are resolved once all files are extracted, as they can precede their targets.
+	for name, target := range links {
+		if err := os.MkdirAll(filepath.Dir(name), 0o700); err != nil {
+			return fmt.Errorf("failed to create directory of %s: %w", name, err)
+		}
+		if err := os.Link(target, name); err != nil {
+			return fmt.Errorf("%w: failed to link %s: %w", ErrInvalidArchive, name, err)
+		}
+	}
+	return nil
+}
+
+func extractFile(r io.Reader, name string, size int64) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0o700); err != nil {
+		return fmt.Errorf("failed to create directory of %s: %w", name, err)
+	}
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", name, err)
+	}
+	if _, err = io.CopyN(f, r, size); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("%w: failed to extract %s: %w", ErrInvalidArchive, name, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,251 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ocilayout reads the images of OCI image layouts, like the ones written by oras or skopeo, and of the
+// archives written by docker save, so they can be imported into a registry, and writes OCI image layouts to export
+// images.
+package ocilayout
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/harness/gitness/registry/app/manifest"
+
+	"github.com/distribution/reference"
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// LayoutFile and IndexFile are the files at the root of an OCI image layout.
+	LayoutFile = "oci-layout"
+	IndexFile  = "index.json"
+	// BlobsDir holds the blobs of an OCI image layout, by algorithm and encoded digest.
+	BlobsDir = "blobs"
+
+	// dockerManifestFile lists the images of a docker save archive.
+	dockerManifestFile = "manifest.json"
+
+	// annotationImageName is the full reference containerd and docker write next to the tag of an image.
+	annotationImageName = "io.containerd.image.name"
+
+	// maxManifestSize bounds the manifests read into memory.
+	maxManifestSize = 4 << 20
+)
+
+// ErrInvalidArchive is returned for archives which aren't valid OCI image layouts or docker save archives.
+var ErrInvalidArchive = errors.New("invalid OCI archive")
+
+var tagPattern = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+
+// Ref is an image of an archive. The name is empty when the archive doesn't name the image, e.g. an OCI
+// image layout which only tags its images, and the tag is empty for untagged images.
+type Ref struct {
+	Name       string
+	Tag        string
+	Descriptor manifest.Descriptor
+}
+
+// Archive is an extracted OCI image layout or docker save archive.
+type Archive struct {
+	dir  string
+	Refs []Ref
+	// blobs maps the digests of the blobs of docker save archives to their files, the blobs of OCI image
+	// layouts are found by their digest.
+	blobs map[digest.Digest]string
+	// synthetic holds the manifests built for the images of docker save archives, which don't have any.
+	synthetic map[digest.Digest][]byte
+}
+
+// Open reads the images of the archive extracted into dir.
+func Open(dir string) (*Archive, error) {
+	a := &Archive{
+		dir:       dir,
+		blobs:     map[digest.Digest]string{},
+		synthetic: map[digest.Digest][]byte{},
+	}
+
+	var err error
+	switch {
+	case fileExists(filepath.Join(dir, IndexFile)):
+		// docker save writes an OCI image layout along with its manifest since docker 25, the layout is preferred.
+		err = a.loadIndex()
+	case fileExists(filepath.Join(dir, dockerManifestFile)):
+		err = a.loadDockerManifest()
+	default:
+		return nil, fmt.Errorf("%w: neither an OCI image layout nor a docker save archive", ErrInvalidArchive)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *Archive) loadIndex() error {
+	content, err := os.ReadFile(filepath.Join(a.dir, IndexFile))
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", IndexFile, err)
+	}
+	var index struct {
+		Manifests []manifest.Descriptor `json:"manifests"`
+	}
+	if err = json.Unmarshal(content, &index); err != nil {
+		return fmt.Errorf("%w: failed to parse %s: %w", ErrInvalidArchive, IndexFile, err)
+	}
+
+	for _, desc := range index.Manifests {
+		if err = desc.Digest.Validate(); err != nil {
+			return fmt.Errorf("%w: invalid digest %q in %s: %w", ErrInvalidArchive, desc.Digest, IndexFile, err)
+		}
+		name, tag, err := refFromAnnotations(desc.Annotations)
+		if err != nil {
+			return err
+		}
+		a.Refs = append(a.Refs, Ref{Name: name, Tag: tag, Descriptor: desc})
+	}
+	return nil
+}
+
+// refFromAnnotations returns the name and tag of an image of an OCI image layout. The reference name annotation
+// usually only holds the tag, but some tools store the full reference in it.
+func refFromAnnotations(annotations map[string]string) (string, string, error) {
+	if ref := annotations[annotationImageName]; ref != "" {
+		return ParseReference(ref)
+	}
+	ref := annotations[v1.AnnotationRefName]
+	if ref == "" || tagPattern.MatchString(ref) {
+		return "", ref, nil
+	}
+	return ParseReference(ref)
+}
+
+// ParseReference returns the image name and tag of a reference like "alpine:3.20" or "registry.io/team/app:1.0".
+// The registry host isn't part of the name, except for the images of docker hub which keep their familiar name.
+func ParseReference(ref string) (string, string, error) {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: invalid image reference %q: %w", ErrInvalidArchive, ref, err)
+	}
+	name := reference.Path(named)
+	if reference.Domain(named) == "docker.io" {
+		name = reference.FamiliarName(named)
+	}
+	tag := ""
+	if tagged, ok := named.(reference.Tagged); ok {
+		tag = tagged.Tag()
+	}
+	return name, tag, nil
+}
+
+// Has returns whether the archive holds the blob.
+func (a *Archive) Has(d digest.Digest) bool {
+	if _, ok := a.synthetic[d]; ok {
+		return true
+	}
+	p, err := a.blobPath(d)
+	return err == nil && fileExists(p)
+}
+
+// Open opens a blob of the archive and returns its size.
+func (a *Archive) Open(d digest.Digest) (io.ReadCloser, int64, error) {
+	if content, ok := a.synthetic[d]; ok {
+		return io.NopCloser(bytes.NewReader(content)), int64(len(content)), nil
+	}
+	p, err := a.blobPath(d)
+	if err != nil {
+		return nil, 0, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: blob %s not found: %w", ErrInvalidArchive, d, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, 0, fmt.Errorf("failed to stat blob %s: %w", d, err)
+	}
+	return f, info.Size(), nil
+}
+
+// ReadManifest reads a manifest of the archive and verifies its digest.
+func (a *Archive) ReadManifest(d digest.Digest) ([]byte, error) {
+	rc, _, err := a.Open(d)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, maxManifestSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest %s: %w", d, err)
+	}
+	if len(content) > maxManifestSize {
+		return nil, fmt.Errorf("%w: manifest %s is larger than %d bytes", ErrInvalidArchive, d, maxManifestSize)
+	}
+	if d.Algorithm().FromBytes(content) != d {
+		return nil, fmt.Errorf("%w: manifest %s doesn't match its digest", ErrInvalidArchive, d)
+	}
+	return content, nil
+}
+
+// Platform returns the platform of an image manifest from its config.
+func (a *Archive) Platform(desc manifest.Descriptor) (*v1.Platform, error) {
+	if desc.Platform != nil {
+		return desc.Platform, nil
+	}
+	content, err := a.ReadManifest(desc.Digest)
+	if err != nil {
+		return nil, err
+	}
+	var m struct {
+		Config manifest.Descriptor `json:"config"`
+	}
+	if err = json.Unmarshal(content, &m); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse manifest %s: %w", ErrInvalidArchive, desc.Digest, err)
+	}
+	config, err := a.ReadManifest(m.Config.Digest)
+	if err != nil {
+		return nil, err
+	}
+	platform := &v1.Platform{}
+	if err = json.Unmarshal(config, platform); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse config %s: %w", ErrInvalidArchive, m.Config.Digest, err)
+	}
+	if platform.OS == "" || platform.Architecture == "" {
+		return nil, fmt.Errorf("%w: config %s has no platform", ErrInvalidArchive, m.Config.Digest)
+	}
+	return platform, nil
+}
+
+func (a *Archive) blobPath(d digest.Digest) (string, error) {
+	if p, ok := a.blobs[d]; ok {
+		return p, nil
+	}
+	if err := d.Validate(); err != nil {
+		return "", fmt.Errorf("%w: invalid digest %q: %w", ErrInvalidArchive, d, err)
+	}
+	return filepath.Join(a.dir, BlobsDir, d.Algorithm().String(), d.Encoded()), nil
+}
+
+func fileExists(p string) bool {
+	info, err := os.Stat(p)
+	return err == nil && info.Mode().IsRegular()
+}
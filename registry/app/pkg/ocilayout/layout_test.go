@@ -0,0 +1,324 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ocilayout
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/manifest"
+
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+type tarEntry struct {
+	name     string
+	content  []byte
+	typeflag byte
+	linkname string
+}
+
+func buildTar(t *testing.T, entries []tarEntry) *bytes.Buffer {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Mode: 0o600, Typeflag: e.typeflag, Linkname: e.linkname}
+		if hdr.Typeflag == 0 {
+			hdr.Typeflag = tar.TypeReg
+			hdr.Size = int64(len(e.content))
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatalf("failed to write header of %s: %v", e.name, err)
+		}
+		if _, err := tw.Write(e.content); err != nil {
+			t.Fatalf("failed to write %s: %v", e.name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("failed to close tar: %v", err)
+	}
+	return buf
+}
+
+func blobEntry(content []byte) (tarEntry, v1.Descriptor) {
+	d := digest.FromBytes(content)
+	return tarEntry{name: BlobsDir + "/sha256/" + d.Encoded(), content: content},
+		v1.Descriptor{Digest: d, Size: int64(len(content))}
+}
+
+func mustJSON(t *testing.T, v any) []byte {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	return b
+}
+
+// ociLayout builds an OCI image layout with a single arm64 image, tagged with the given reference name.
+func ociLayout(t *testing.T, refName string) (*bytes.Buffer, digest.Digest) {
+	t.Helper()
+	configEntry, config := blobEntry(mustJSON(t, v1.Image{Platform: v1.Platform{OS: "linux", Architecture: "arm64"}}))
+	config.MediaType = v1.MediaTypeImageConfig
+	layerEntry, layer := blobEntry([]byte("layer"))
+	layer.MediaType = v1.MediaTypeImageLayer
+
+	m := v1.Manifest{MediaType: v1.MediaTypeImageManifest, Config: config, Layers: []v1.Descriptor{layer}}
+	m.SchemaVersion = 2
+	manifestEntry, desc := blobEntry(mustJSON(t, m))
+	desc.MediaType = v1.MediaTypeImageManifest
+	desc.Annotations = map[string]string{v1.AnnotationRefName: refName}
+
+	index := v1.Index{MediaType: v1.MediaTypeImageIndex, Manifests: []v1.Descriptor{desc}}
+	index.SchemaVersion = 2
+	return buildTar(t, []tarEntry{
+		{name: LayoutFile, content: []byte(`{"imageLayoutVersion":"1.0.0"}`)},
+		{name: IndexFile, content: mustJSON(t, index)},
+		configEntry,
+		layerEntry,
+		manifestEntry,
+	}), desc.Digest
+}
+
+func extractAndOpen(t *testing.T, r io.Reader) (*Archive, error) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := Extract(r, dir, 1<<20); err != nil {
+		return nil, err
+	}
+	return Open(dir)
+}
+
+func TestOpenOCILayout(t *testing.T) {
+	tests := []struct {
+		refName  string
+		wantName string
+		wantTag  string
+	}{
+		{refName: "1.0", wantTag: "1.0"},
+		{refName: "alpine:3.20", wantName: "alpine", wantTag: "3.20"},
+		{refName: "registry.io/team/app:2.1", wantName: "team/app", wantTag: "2.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.refName, func(t *testing.T) {
+			tarball, manifestDigest := ociLayout(t, tt.refName)
+			archive, err := extractAndOpen(t, tarball)
+			if err != nil {
+				t.Fatalf("failed to open archive: %v", err)
+			}
+			if len(archive.Refs) != 1 {
+				t.Fatalf("expected 1 ref, got %d", len(archive.Refs))
+			}
+			ref := archive.Refs[0]
+			if ref.Name != tt.wantName || ref.Tag != tt.wantTag {
+				t.Errorf("expected %q:%q, got %q:%q", tt.wantName, tt.wantTag, ref.Name, ref.Tag)
+			}
+			if ref.Descriptor.Digest != manifestDigest {
+				t.Errorf("expected manifest %s, got %s", manifestDigest, ref.Descriptor.Digest)
+			}
+
+			platform, err := archive.Platform(ref.Descriptor)
+			if err != nil {
+				t.Fatalf("failed to read platform: %v", err)
+			}
+			if platform.OS != "linux" || platform.Architecture != "arm64" {
+				t.Errorf("expected linux/arm64, got %s/%s", platform.OS, platform.Architecture)
+			}
+		})
+	}
+}
+
+func TestOpenDockerSave(t *testing.T) {
+	config := mustJSON(t, v1.Image{Platform: v1.Platform{OS: "linux", Architecture: "amd64"}})
+	layer := []byte("layer")
+	images := []dockerImage{
+		{Config: "config.json", RepoTags: []string{"app:1.0", "app:latest"}, Layers: []string{"layer/layer.tar"}},
+		// docker links the layers images share.
+		{Config: "config.json", Layers: []string{"shared/layer.tar"}},
+	}
+	tarball := buildTar(t, []tarEntry{
+		{name: dockerManifestFile, content: mustJSON(t, images)},
+		{name: "config.json", content: config},
+		{name: "shared/layer.tar", typeflag: tar.TypeSymlink, linkname: "../layer/layer.tar"},
+		{name: "layer/layer.tar", content: layer},
+	})
+
+	archive, err := extractAndOpen(t, tarball)
+	if err != nil {
+		t.Fatalf("failed to open archive: %v", err)
+	}
+	if len(archive.Refs) != 3 {
+		t.Fatalf("expected 3 refs, got %d", len(archive.Refs))
+	}
+	if archive.Refs[0].Name != "app" || archive.Refs[0].Tag != "1.0" || archive.Refs[1].Tag != "latest" {
+		t.Errorf("unexpected refs %+v", archive.Refs)
+	}
+	if archive.Refs[2].Name != "" || archive.Refs[2].Tag != "" {
+		t.Errorf("expected an untagged image, got %q:%q", archive.Refs[2].Name, archive.Refs[2].Tag)
+	}
+
+	payload, err := archive.ReadManifest(archive.Refs[0].Descriptor.Digest)
+	if err != nil {
+		t.Fatalf("failed to read manifest: %v", err)
+	}
+	var m v1.Manifest
+	if err = json.Unmarshal(payload, &m); err != nil {
+		t.Fatalf("failed to parse manifest: %v", err)
+	}
+	if m.Config.Digest != digest.FromBytes(config) || len(m.Layers) != 1 ||
+		m.Layers[0].Digest != digest.FromBytes(layer) {
+		t.Errorf("unexpected manifest %s", payload)
+	}
+	for _, d := range []digest.Digest{m.Config.Digest, m.Layers[0].Digest} {
+		if !archive.Has(d) {
+			t.Errorf("expected blob %s", d)
+		}
+	}
+
+	platform, err := archive.Platform(manifest.Descriptor{Digest: archive.Refs[0].Descriptor.Digest})
+	if err != nil {
+		t.Fatalf("failed to read platform: %v", err)
+	}
+	if platform.Architecture != "amd64" {
+		t.Errorf("expected amd64, got %s", platform.Architecture)
+	}
+}
+
+func TestExtractRejectsInvalidArchives(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []tarEntry
+		maxSize int64
+	}{
+		{
+			name:    "path escape",
+			entries: []tarEntry{{name: "../escape", content: []byte("x")}},
+			maxSize: 1 << 20,
+		},
+		{
+			name:    "link escape",
+			entries: []tarEntry{{name: "link", typeflag: tar.TypeSymlink, linkname: "../../etc/passwd"}},
+			maxSize: 1 << 20,
+		},
+		{
+			name:    "too large",
+			entries: []tarEntry{{name: "a", content: []byte("12345")}, {name: "b", content: []byte("12345")}},
+			maxSize: 8,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Extract(buildTar(t, tt.entries), t.TempDir(), tt.maxSize)
+			if !errors.Is(err, ErrInvalidArchive) {
+				t.Errorf("expected ErrInvalidArchive, got %v", err)
+			}
+		})
+	}
+}
+
+func TestReadManifestVerifiesDigest(t *testing.T) {
+	d := digest.FromBytes([]byte("{}"))
+	index := v1.Index{Manifests: []v1.Descriptor{{MediaType: v1.MediaTypeImageManifest, Digest: d, Size: 2}}}
+	tarball := buildTar(t, []tarEntry{
+		{name: IndexFile, content: mustJSON(t, index)},
+		{name: BlobsDir + "/sha256/" + d.Encoded(), content: []byte("[]")},
+	})
+
+	archive, err := extractAndOpen(t, tarball)
+	if err != nil {
+		t.Fatalf("failed to open archive: %v", err)
+	}
+	if _, err = archive.ReadManifest(d); !errors.Is(err, ErrInvalidArchive) {
+		t.Errorf("expected ErrInvalidArchive, got %v", err)
+	}
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	config := mustJSON(t, v1.Image{Platform: v1.Platform{OS: "linux", Architecture: "amd64"}})
+	layer := []byte("layer")
+	configDesc := v1.Descriptor{MediaType: v1.MediaTypeImageConfig, Digest: digest.FromBytes(config),
+		Size: int64(len(config))}
+	layerDesc := v1.Descriptor{MediaType: v1.MediaTypeImageLayer, Digest: digest.FromBytes(layer),
+		Size: int64(len(layer))}
+	m := v1.Manifest{MediaType: v1.MediaTypeImageManifest, Config: configDesc, Layers: []v1.Descriptor{layerDesc}}
+	m.SchemaVersion = 2
+	payload := mustJSON(t, m)
+	desc := manifest.Descriptor{
+		MediaType: v1.MediaTypeImageManifest,
+		Digest:    digest.FromBytes(payload),
+		Size:      int64(len(payload)),
+	}
+
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf)
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	for _, content := range [][]byte{config, layer, layer, payload} {
+		if err = w.WriteBlob(digest.FromBytes(content), int64(len(content)), bytes.NewReader(content)); err != nil {
+			t.Fatalf("failed to write blob: %v", err)
+		}
+	}
+	w.AddImage(desc, "team/app", "1.0")
+	w.AddImage(desc, "team/app", "latest")
+	if err = w.Close(); err != nil {
+		t.Fatalf("failed to close writer: %v", err)
+	}
+
+	archive, err := extractAndOpen(t, buf)
+	if err != nil {
+		t.Fatalf("failed to open archive: %v", err)
+	}
+	if len(archive.Refs) != 2 {
+		t.Fatalf("expected 2 refs, got %d", len(archive.Refs))
+	}
+	for i, tag := range []string{"1.0", "latest"} {
+		ref := archive.Refs[i]
+		if ref.Name != "team/app" || ref.Tag != tag || ref.Descriptor.Digest != desc.Digest {
+			t.Errorf("expected team/app:%s@%s, got %s:%s@%s", tag, desc.Digest, ref.Name, ref.Tag,
+				ref.Descriptor.Digest)
+		}
+	}
+	if _, err = archive.ReadManifest(desc.Digest); err != nil {
+		t.Errorf("failed to read manifest: %v", err)
+	}
+	platform, err := archive.Platform(desc)
+	if err != nil {
+		t.Fatalf("failed to read platform: %v", err)
+	}
+	if platform.Architecture != "amd64" {
+		t.Errorf("expected amd64, got %s", platform.Architecture)
+	}
+}
+
+func TestWriterVerifiesDigest(t *testing.T) {
+	w, err := NewWriter(io.Discard)
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	d := digest.FromBytes([]byte("layer"))
+	if err = w.WriteBlob(d, 5, bytes.NewReader([]byte("other"))); err == nil {
+		t.Error("expected an error for a blob which doesn't match its digest")
+	}
+	if w.Has(d) {
+		t.Error("expected the blob not to be recorded as written")
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ocilayout
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/harness/gitness/registry/app/manifest"
+
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// Writer streams an OCI image layout as a tarball. The blobs are written as they're added and the index once the
+// writer is closed, so the layout doesn't have to be staged on disk.
+type Writer struct {
+	tw      *tar.Writer
+	written map[digest.Digest]bool
+	images  []v1.Descriptor
+	modTime time.Time
+}
+
+// NewWriter starts an OCI image layout tarball written to w.
+func NewWriter(w io.Writer) (*Writer, error) {
+	lw := &Writer{
+		tw:      tar.NewWriter(w),
+		written: map[digest.Digest]bool{},
+		modTime: time.Now(),
+	}
+	layout, err := json.Marshal(v1.ImageLayout{Version: v1.ImageLayoutVersion})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", LayoutFile, err)
+	}
+	if err = lw.writeFile(LayoutFile, int64(len(layout)), bytes.NewReader(layout)); err != nil {
+		return nil, err
+	}
+	return lw, nil
+}
+
+// Has returns whether the blob was written already.
+func (w *Writer) Has(d digest.Digest) bool {
+	return w.written[d]
+}
+
+// WriteBlob writes a blob of size bytes read from r, blobs written already are skipped. The content is verified
+// against its digest.
+func (w *Writer) WriteBlob(d digest.Digest, size int64, r io.Reader) error {
+	if w.written[d] {
+		return nil
+	}
+	if err := d.Validate(); err != nil {
+		return fmt.Errorf("invalid digest %q: %w", d, err)
+	}
+	verifier := d.Verifier()
+	name := path.Join(BlobsDir, d.Algorithm().String(), d.Encoded())
+	if err := w.writeFile(name, size, io.TeeReader(r, verifier)); err != nil {
+		return err
+	}
+	if !verifier.Verified() {
+		return fmt.Errorf("blob %s doesn't match its digest", d)
+	}
+	w.written[d] = true
+	return nil
+}
+
+// AddImage lists a manifest written with WriteBlob in the index of the layout. The tag is kept as the reference
+// name, like skopeo and oras do, and the name as the full reference containerd and docker read.
+func (w *Writer) AddImage(desc manifest.Descriptor, name string, tag string) {
+	image := v1.Descriptor{
+		MediaType: desc.MediaType,
+		Digest:    desc.Digest,
+		Size:      desc.Size,
+		Platform:  desc.Platform,
+	}
+	if tag != "" {
+		image.Annotations = map[string]string{v1.AnnotationRefName: tag}
+		if name != "" {
+			image.Annotations[annotationImageName] = name + ":" + tag
+		}
+	}
+	w.images = append(w.images, image)
+}
+
+// Close writes the index of the layout and finishes the tarball, it doesn't close the underlying writer.
+func (w *Writer) Close() error {
+	index := v1.Index{MediaType: v1.MediaTypeImageIndex, Manifests: w.images}
+	index.SchemaVersion = 2
+	if index.Manifests == nil {
+		index.Manifests = []v1.Descriptor{}
+	}
+	content, err := json.Marshal(index)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", IndexFile, err)
+	}
+	if err = w.writeFile(IndexFile, int64(len(content)), bytes.NewReader(content)); err != nil {
+		return err
+	}
+	if err = w.tw.Close(); err != nil {
+		return fmt.Errorf("failed to close archive: %w", err)
+	}
+	return nil
+}
+
+func (w *Writer) writeFile(name string, size int64, r io.Reader) error {
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0o644,
+		ModTime:  w.modTime,
+	}
+	if err := w.tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("failed to write header of %s: %w", name, err)
+	}
+	if _, err := io.CopyN(w.tw, r, size); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plugin lets builds add custom package types without changing the controllers of the registry. A plugin
+// is registered by its package type and implements the operations its package type supports, the registry
+// answers the other operations as not supported.
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/harness/gitness/registry/types"
+)
+
+// ErrNotSupported is returned for an operation which isn't implemented by the plugin of a package type.
+var ErrNotSupported = errors.New("operation not supported")
+
+// Target addresses the registry, artifact, version and file an operation applies to. Operations on a whole
+// artifact leave the version empty, operations on a whole version leave the path empty.
+type Target struct {
+	Registry       *types.Registry
+	RootIdentifier string
+	Artifact       string
+	Version        string
+	Path           string
+}
+
+// File is the content of a downloaded file, the body has to be closed by the caller.
+type File struct {
+	Body        io.ReadCloser
+	Size        int64
+	ContentType string
+}
+
+// Entry is a file of a version or a version of an artifact returned by List.
+type Entry struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+}
+
+// Plugin is the minimal implementation of a custom package type.
+type Plugin interface {
+	// PackageType is the package type of the registries served by the plugin, e.g. "CONAN".
+	PackageType() string
+	// PathPackageType is the segment of the package type in the package URLs, e.g. "conan".
+	PathPackageType() string
+}
+
+// Uploader stores a file of a version.
+type Uploader interface {
+	Upload(ctx context.Context, target Target, content io.Reader) error
+}
+
+// Downloader serves a file of a version.
+type Downloader interface {
+	Download(ctx context.Context, target Target) (*File, error)
+}
+
+// Lister lists the files of a version, or the versions of an artifact if the target has no version.
+type Lister interface {
+	List(ctx context.Context, target Target) ([]Entry, error)
+}
+
+// Deleter deletes a version, or a whole artifact if the target has no version.
+type Deleter interface {
+	Delete(ctx context.Context, target Target) error
+}
+
+// Reindexer rebuilds the index of an artifact, or of the whole registry if the artifact is empty.
+type Reindexer interface {
+	Reindex(ctx context.Context, registry *types.Registry, artifact string) error
+}
+
+// Validator validates the target of an upload before the content is read.
+type Validator interface {
+	Validate(target Target) error
+}
+
+var (
+	mu      sync.RWMutex
+	plugins = map[string]Plugin{}
+)
+
+// Register registers the plugin of a package type. It's meant to be called from the init function of the package
+// implementing the plugin and panics if the package type or its path segment are taken already. The built-in
+// package types take precedence over plugins.
+func Register(p Plugin) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if p == nil || p.PackageType() == "" || p.PathPackageType() == "" {
+		panic("plugin: a package type and a path package type are required")
+	}
+	for _, registered := range plugins {
+		if registered.PackageType() == p.PackageType() || registered.PathPackageType() == p.PathPackageType() {
+			panic(fmt.Sprintf("plugin: package type %s is registered twice", p.PackageType()))
+		}
+	}
+	plugins[p.PackageType()] = p
+}
+
+// Get returns the plugin of a package type.
+func Get(packageType string) (Plugin, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	p, ok := plugins[packageType]
+	return p, ok
+}
+
+// GetByPathPackageType returns the plugin of the path segment of a package type.
+func GetByPathPackageType(pathPackageType string) (Plugin, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, p := range plugins {
+		if p.PathPackageType() == pathPackageType {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// PackageTypes returns the sorted package types of the registered plugins.
+func PackageTypes() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	packageTypes := make([]string, 0, len(plugins))
+	for packageType := range plugins {
+		packageTypes = append(packageTypes, packageType)
+	}
+	sort.Strings(packageTypes)
+	return packageTypes
+}
+
+// Upload validates the target and uploads the content with the plugin of the package type.
+func Upload(ctx context.Context, packageType string, target Target, content io.Reader) error {
+	uploader, err := lookup[Uploader](packageType, "upload")
+	if err != nil {
+		return err
+	}
+	if err := Validate(packageType, target); err != nil {
+		return err
+	}
+	return uploader.Upload(ctx, target, content)
+}
+
+// Download downloads a file with the plugin of the package type.
+func Download(ctx context.Context, packageType string, target Target) (*File, error) {
+	downloader, err := lookup[Downloader](packageType, "download")
+	if err != nil {
+		return nil, err
+	}
+	return downloader.Download(ctx, target)
+}
+
+// List lists the entries of the target with the plugin of the package type.
+func List(ctx context.Context, packageType string, target Target) ([]Entry, error) {
+	lister, err := lookup[Lister](packageType, "list")
+	if err != nil {
+		return nil, err
+	}
+	return lister.List(ctx, target)
+}
+
+// Delete deletes the target with the plugin of the package type.
+func Delete(ctx context.Context, packageType string, target Target) error {
+	deleter, err := lookup[Deleter](packageType, "delete")
+	if err != nil {
+		return err
+	}
+	return deleter.Delete(ctx, target)
+}
+
+// Reindex rebuilds an index with the plugin of the package type. Package types without indexes don't implement
+// it, so it's a no-op for them.
+func Reindex(ctx context.Context, packageType string, registry *types.Registry, artifact string) error {
+	reindexer, err := lookup[Reindexer](packageType, "reindex")
+	if errors.Is(err, ErrNotSupported) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return reindexer.Reindex(ctx, registry, artifact)
+}
+
+// Validate validates the target with the plugin of the package type, if it validates targets at all.
+func Validate(packageType string, target Target) error {
+	validator, err := lookup[Validator](packageType, "validate")
+	if errors.Is(err, ErrNotSupported) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return validator.Validate(target)
+}
+
+func lookup[T any](packageType string, operation string) (T, error) {
+	var impl T
+	p, ok := Get(packageType)
+	if !ok {
+		return impl, fmt.Errorf("unsupported package type: %s", packageType)
+	}
+	impl, ok = p.(T)
+	if !ok {
+		return impl, fmt.Errorf("%w: %s of %s packages", ErrNotSupported, operation, packageType)
+	}
+	return impl, nil
+}
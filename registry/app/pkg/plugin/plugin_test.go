@@ -0,0 +1,101 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+type fakePlugin struct {
+	uploaded map[string]string
+	deleted  []string
+}
+
+func (p *fakePlugin) PackageType() string     { return "FAKE" }
+func (p *fakePlugin) PathPackageType() string { return "fake" }
+
+func (p *fakePlugin) Upload(_ context.Context, target Target, content io.Reader) error {
+	body, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	p.uploaded[target.Artifact+"/"+target.Version+"/"+target.Path] = string(body)
+	return nil
+}
+
+func (p *fakePlugin) Delete(_ context.Context, target Target) error {
+	p.deleted = append(p.deleted, target.Artifact+"/"+target.Version)
+	return nil
+}
+
+func (p *fakePlugin) Validate(target Target) error {
+	if target.Version == "" {
+		return errors.New("a version is required")
+	}
+	return nil
+}
+
+func TestPlugin(t *testing.T) {
+	p := &fakePlugin{uploaded: map[string]string{}}
+	Register(p)
+	defer delete(plugins, p.PackageType())
+
+	if got, ok := GetByPathPackageType("fake"); !ok || got != p {
+		t.Fatal("expected the plugin to be found by its path package type")
+	}
+
+	ctx := context.Background()
+	target := Target{Artifact: "pkg", Version: "1.0.0", Path: "pkg.bin"}
+	if err := Upload(ctx, "FAKE", target, strings.NewReader("content")); err != nil {
+		t.Fatal(err)
+	}
+	if p.uploaded["pkg/1.0.0/pkg.bin"] != "content" {
+		t.Errorf("expected the content to be uploaded, got %v", p.uploaded)
+	}
+	if err := Upload(ctx, "FAKE", Target{Artifact: "pkg", Path: "pkg.bin"}, strings.NewReader("")); err == nil {
+		t.Error("expected the upload of an invalid target to fail")
+	}
+
+	if err := Delete(ctx, "FAKE", target); err != nil || len(p.deleted) != 1 {
+		t.Errorf("expected the version to be deleted, got %v (%v)", p.deleted, err)
+	}
+
+	if _, err := Download(ctx, "FAKE", target); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("expected the download to be unsupported, got %v", err)
+	}
+	if err := Reindex(ctx, "FAKE", nil, "pkg"); err != nil {
+		t.Errorf("expected the reindex of a package type without indexes to be a no-op, got %v", err)
+	}
+	if _, err := List(ctx, "UNKNOWN", target); err == nil || errors.Is(err, ErrNotSupported) {
+		t.Errorf("expected an unknown package type to fail, got %v", err)
+	}
+}
+
+func TestRegisterTwice(t *testing.T) {
+	p := &fakePlugin{}
+	Register(p)
+	defer delete(plugins, p.PackageType())
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected registering a package type twice to panic")
+		}
+	}()
+	Register(&fakePlugin{})
+}
@@ -15,6 +15,7 @@
 package python
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -23,6 +24,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/harness/gitness/app/api/request"
 	urlprovider "github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/errors"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
@@ -31,6 +33,7 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/base"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	pythonutils "github.com/harness/gitness/registry/app/pkg/python/utils"
 	pythontype "github.com/harness/gitness/registry/app/pkg/types/python"
 	"github.com/harness/gitness/registry/app/remote/adapter/commons/pypi"
 	registryrefcache "github.com/harness/gitness/registry/app/services/refcache"
@@ -151,6 +154,7 @@ func (c *localRegistry) GetPackageMetadata(
 					file.Filename,
 				),
 				RequiresPython: metadata.RequiresPython,
+				MetadataSha256: metadata.CoreMetadata[file.Filename],
 			}
 			packageMetadata.Files = append(packageMetadata.Files, fileInfo)
 		}
@@ -202,10 +206,47 @@ func (c *localRegistry) UploadPackageFile(
 	filename string,
 ) (headers *commons.ResponseHeaders, sha256 string, err error) {
 	path := pkg.JoinWithSeparator("/", info.Image, info.Metadata.Version, filename)
+	pythonMetadata := &pythonmetadata.PythonMetadata{
+		Metadata: info.Metadata,
+	}
+	if pythonutils.IsWheelFile(filename) {
+		metadataSha256, err := c.uploadCoreMetadata(ctx, info, file, path)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to extract core metadata from %s", filename)
+		} else {
+			pythonMetadata.CoreMetadata = map[string]string{filename: metadataSha256}
+		}
+	}
 	return c.localBase.UploadFile(ctx, info.ArtifactInfo, filename, info.Metadata.Version, path, file,
-		&pythonmetadata.PythonMetadata{
-			Metadata: info.Metadata,
-		})
+		pythonMetadata)
+}
+
+// uploadCoreMetadata extracts the METADATA file of a wheel and stores it next to the wheel, so it can
+// be served as the PEP 658 core metadata file. The wheel reader is rewound before returning.
+func (c *localRegistry) uploadCoreMetadata(
+	ctx context.Context,
+	info pythontype.ArtifactInfo,
+	file multipart.File,
+	path string,
+) (string, error) {
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", fmt.Errorf("failed to determine wheel size: %w", err)
+	}
+	content, err := pythonutils.ExtractWheelMetadata(file, size)
+	if _, seekErr := file.Seek(0, io.SeekStart); seekErr != nil {
+		return "", fmt.Errorf("failed to rewind wheel: %w", seekErr)
+	}
+	if err != nil {
+		return "", err
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	fileInfo, err := c.fileManager.UploadFile(ctx, pythonutils.GetCoreMetadataFilename(path), info.RegistryID,
+		info.RootParentID, info.RootIdentifier, nil, bytes.NewReader(content), session.Principal.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload core metadata file: %w", err)
+	}
+	return fileInfo.Sha256, nil
 }
 
 func (c *localRegistry) UploadPackageFileReader(
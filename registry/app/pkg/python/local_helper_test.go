@@ -162,6 +162,14 @@ func (m *MockLocalBase) Upload(
 	return args.Get(0).(*commons.ResponseHeaders), args.String(1), args.Error(2) //nolint:errcheck
 }
 
+func (m *MockLocalBase) UploadByDigest(
+	ctx context.Context, info pkg.ArtifactInfo, filename, version, path string,
+	sha256 string, metadata metadata.Metadata,
+) (*commons.ResponseHeaders, string, bool, error) {
+	args := m.Called(ctx, info, filename, version, path, sha256, metadata)
+	return args.Get(0).(*commons.ResponseHeaders), args.String(1), args.Bool(2), args.Error(3) //nolint:errcheck
+}
+
 func (m *MockLocalBase) UploadFile(
 	ctx context.Context, info pkg.ArtifactInfo, filename, version, path string,
 	file multipart.File,
@@ -0,0 +1,88 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package python
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"testing"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	pythontype "github.com/harness/gitness/registry/app/pkg/types/python"
+	"github.com/harness/gitness/registry/types"
+	gitnesstypes "github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFileManager struct {
+	filemanager.FileManager
+	files map[string][]byte
+}
+
+func (f *fakeFileManager) UploadFile(
+	_ context.Context, filePath string, _ int64, _ int64, _ string, _ multipart.File, fileReader io.Reader, _ int64,
+) (types.FileInfo, error) {
+	content, err := io.ReadAll(fileReader)
+	if err != nil {
+		return types.FileInfo{}, err
+	}
+	f.files[filePath] = content
+	return types.FileInfo{Sha256: "sha-of-" + filePath}, nil
+}
+
+// wheelFile is a multipart.File backed by memory.
+type wheelFile struct {
+	*bytes.Reader
+}
+
+func (wheelFile) Close() error {
+	return nil
+}
+
+func TestUploadCoreMetadata(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	f, err := w.Create("pkg-1.0.dist-info/METADATA")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("Name: pkg\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	wheel := wheelFile{Reader: bytes.NewReader(buf.Bytes())}
+
+	fileManager := &fakeFileManager{files: map[string][]byte{}}
+	c := &localRegistry{fileManager: fileManager}
+	ctx := request.WithAuthSession(context.Background(), &auth.Session{Principal: gitnesstypes.Principal{ID: 7}})
+	info := pythontype.ArtifactInfo{ArtifactInfo: pkg.ArtifactInfo{BaseInfo: &pkg.BaseInfo{}, RegistryID: 3}}
+
+	sha256, err := c.uploadCoreMetadata(ctx, info, wheel, "pkg/1.0/pkg-1.0-py3-none-any.whl")
+	require.NoError(t, err)
+	assert.Equal(t, "sha-of-pkg/1.0/pkg-1.0-py3-none-any.whl.metadata", sha256)
+	assert.Equal(t, "Name: pkg\n", string(fileManager.files["pkg/1.0/pkg-1.0-py3-none-any.whl.metadata"]))
+
+	offset, err := wheel.Seek(0, io.SeekCurrent)
+	require.NoError(t, err)
+	assert.Zero(t, offset, "the wheel is rewound to be uploaded itself")
+
+	_, err = c.uploadCoreMetadata(ctx, info, wheelFile{Reader: bytes.NewReader([]byte("not a wheel"))}, "pkg.whl")
+	assert.Error(t, err)
+}
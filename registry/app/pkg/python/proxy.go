@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
+	"time"
 
 	"github.com/harness/gitness/app/services/refcache"
 	urlprovider "github.com/harness/gitness/app/url"
@@ -34,6 +35,9 @@ import (
 	"github.com/harness/gitness/registry/app/store"
 	cfg "github.com/harness/gitness/registry/config"
 	request2 "github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/registry/services/firewalldelay"
+	"github.com/harness/gitness/registry/services/upstreamprovenance"
+	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/secret"
 	"github.com/harness/gitness/store/database/dbtx"
 
@@ -56,6 +60,8 @@ type proxy struct {
 	spaceFinder         refcache.SpaceFinder
 	service             secret.Service
 	localRegistryHelper LocalRegistryHelper
+	firewallDelay       *firewalldelay.Service
+	upstreamProvenance  *upstreamprovenance.Service
 }
 
 type Proxy interface {
@@ -73,6 +79,8 @@ func NewProxy(
 	spaceFinder refcache.SpaceFinder,
 	service secret.Service,
 	localRegistryHelper LocalRegistryHelper,
+	firewallDelay *firewalldelay.Service,
+	upstreamProvenance *upstreamprovenance.Service,
 ) Proxy {
 	return &proxy{
 		fileManager:         fileManager,
@@ -85,6 +93,8 @@ func NewProxy(
 		spaceFinder:         spaceFinder,
 		service:             service,
 		localRegistryHelper: localRegistryHelper,
+		firewallDelay:       firewallDelay,
+		upstreamProvenance:  upstreamProvenance,
 	}
 }
 
@@ -124,6 +134,17 @@ func (r *proxy) DownloadPackageFile(ctx context.Context, info pythontype.Artifac
 		return nil, nil, nil, "", err
 	}
 
+	if firewalldelay.Delay(upstreamProxy) > 0 {
+		times, err := r.getReleaseTimes(ctx, remote, info.Image)
+		if err != nil {
+			return nil, nil, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		version := pypi.GetPyPIVersion(info.Filename)
+		if err = r.firewallDelay.CheckPull(ctx, upstreamProxy, info.Image, version, times[version]); err != nil {
+			return nil, nil, nil, "", err
+		}
+	}
+
 	file, err := remote.GetFile(ctx, info.Image, info.Filename)
 	if err != nil {
 		return nil, nil, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
@@ -158,9 +179,16 @@ func (r *proxy) GetPackageMetadata(
 	if err != nil {
 		return pythontype.PackageMetadata{}, err
 	}
+	held, err := r.heldVersions(ctx, upstreamProxy, helper, info.Image)
+	if err != nil {
+		return pythontype.PackageMetadata{}, err
+	}
 
 	var files []pythontype.File
 	for _, file := range result.Packages {
+		if _, ok := held[file.Version()]; ok {
+			continue
+		}
 		pkgURL := r.urlProvider.PackageURL(
 			ctx,
 			info.RootIdentifier+"/"+info.RegIdentifier,
@@ -186,6 +214,46 @@ func (r *proxy) GetPackageMetadata(
 	return metadata, nil
 }
 
+// heldVersions returns the versions of the package held back by the firewall delay of the upstream proxy, their
+// files are left out of the index of the package.
+func (r *proxy) heldVersions(
+	ctx context.Context,
+	upstreamProxy *types.UpstreamProxy,
+	remote RemoteRegistryHelper,
+	pkg string,
+) (map[string]time.Time, error) {
+	if firewalldelay.Delay(upstreamProxy) <= 0 {
+		return nil, nil
+	}
+	times, err := r.getReleaseTimes(ctx, remote, pkg)
+	if err != nil {
+		return nil, err
+	}
+	held, err := r.firewallDelay.HeldVersions(ctx, upstreamProxy, pkg, times)
+	if err != nil {
+		return nil, err
+	}
+	if len(held) > 0 {
+		log.Ctx(ctx).Info().Msgf("registry [%s] holds back %d versions of package [%s] published upstream within "+
+			"its firewall delay", upstreamProxy.RepoKey, len(held), pkg)
+	}
+	return held, nil
+}
+
+// getReleaseTimes returns the publish times of the versions of the package. The firewall delay can't be applied
+// without them, so the pulls fail rather than serve versions which may be new.
+func (r *proxy) getReleaseTimes(
+	ctx context.Context,
+	remote RemoteRegistryHelper,
+	pkg string,
+) (map[string]time.Time, error) {
+	times, err := remote.GetReleaseTimes(ctx, pkg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the publish times of package %s for the firewall delay: %w", pkg, err)
+	}
+	return times, nil
+}
+
 func (r *proxy) putFileToLocal(ctx context.Context, pkg string, filename string, remote RemoteRegistryHelper) error {
 	version := pypi.GetPyPIVersion(filename)
 	metadata, err := remote.GetJSON(ctx, pkg, version)
@@ -217,9 +285,34 @@ func (r *proxy) putFileToLocal(ctx context.Context, pkg string, filename string,
 		return err2
 	}
 	log.Ctx(ctx).Info().Msgf("Successfully uploaded %s with SHA256: %s", filename, sha256)
+	r.recordProvenance(ctx, *info, remote)
 	return nil
 }
 
+// recordProvenance records the link of the upstream index the file was cached from. The file stays cached when it
+// fails, the provenance is missing then.
+func (r *proxy) recordProvenance(ctx context.Context, info pythontype.ArtifactInfo, remote RemoteRegistryHelper) {
+	metadata, err := remote.GetMetadata(ctx, info.Image)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to get the index of %s to record the upstream provenance of %s",
+			info.Image, info.Filename)
+		return
+	}
+	for _, p := range metadata.Packages {
+		if p.Name != info.Filename {
+			continue
+		}
+		err = r.upstreamProvenance.Record(ctx, info.RegistryID, info.Image, info.Metadata.Version, info.Filename,
+			p.SourceURL(), p.Checksums())
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to record the upstream provenance of %s", info.Filename)
+		}
+		return
+	}
+	log.Ctx(ctx).Warn().Msgf("file %s isn't listed in the upstream index of %s, its provenance isn't recorded",
+		info.Filename, info.Image)
+}
+
 // UploadPackageFile TODO: Extract this upload function for all types of packageTypes
 // uploads the package file to the storage.
 func (r *proxy) UploadPackageFile(
@@ -17,6 +17,7 @@ package python
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/harness/gitness/app/services/refcache"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
@@ -40,6 +41,9 @@ type RemoteRegistryHelper interface {
 
 	// GetJSON Fetches the metadata for the given package and specific version
 	GetJSON(ctx context.Context, pkg string, version string) (*python.Metadata, error)
+
+	// GetReleaseTimes Fetches the time each version of the given package was published at
+	GetReleaseTimes(ctx context.Context, pkg string) (map[string]time.Time, error)
 }
 
 type remoteRegistryHelper struct {
@@ -119,3 +123,12 @@ func (r *remoteRegistryHelper) GetJSON(ctx context.Context, pkg string, version
 	}
 	return metadata, nil
 }
+
+func (r *remoteRegistryHelper) GetReleaseTimes(ctx context.Context, pkg string) (map[string]time.Time, error) {
+	times, err := r.adapter.GetReleaseTimes(ctx, pkg)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msgf("failed to get release times for pkg: %s", pkg)
+		return nil, err
+	}
+	return times, nil
+}
@@ -15,12 +15,20 @@
 package utils
 
 import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"path"
 	"strings"
 )
 
 const (
-	ExtWhl   = ".whl"
-	ExtTarGz = ".tar.gz"
+	ExtWhl      = ".whl"
+	ExtTarGz    = ".tar.gz"
+	ExtMetadata = ".metadata"
+
+	// maxWheelMetadataSize is the upper bound of the core metadata file read from a wheel.
+	maxWheelMetadataSize = 10 << 20
 )
 
 var MainArtifactFileExtensions = []string{
@@ -37,3 +45,40 @@ func IsMainArtifactFile(filename string) bool {
 	}
 	return false
 }
+
+func IsWheelFile(filename string) bool {
+	return strings.HasSuffix(strings.ToLower(filename), ExtWhl)
+}
+
+// GetCoreMetadataFilename returns the name of the PEP 658 core metadata file served alongside a distribution.
+func GetCoreMetadataFilename(filename string) string {
+	return filename + ExtMetadata
+}
+
+// ExtractWheelMetadata returns the content of the {name}-{version}.dist-info/METADATA file of a wheel.
+func ExtractWheelMetadata(reader io.ReaderAt, size int64) ([]byte, error) {
+	zipReader, err := zip.NewReader(reader, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open wheel: %w", err)
+	}
+	for _, file := range zipReader.File {
+		dir, name := path.Split(file.Name)
+		if name != "METADATA" || strings.Count(dir, "/") != 1 || !strings.HasSuffix(dir, ".dist-info/") {
+			continue
+		}
+		if file.UncompressedSize64 > maxWheelMetadataSize {
+			return nil, fmt.Errorf("wheel metadata file %s exceeds the maximum size", file.Name)
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open wheel metadata file %s: %w", file.Name, err)
+		}
+		content, err := io.ReadAll(io.LimitReader(rc, maxWheelMetadataSize))
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read wheel metadata file %s: %w", file.Name, err)
+		}
+		return content, nil
+	}
+	return nil, fmt.Errorf("no dist-info METADATA file found in wheel")
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWheel(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestExtractWheelMetadata(t *testing.T) {
+	wheel := newTestWheel(t, map[string]string{
+		"pkg/__init__.py":                       "",
+		"pkg/vendored-1.0.dist-info/METADATA":   "Name: vendored",
+		"pkg-1.0.dist-info/METADATA":            "Metadata-Version: 2.1\nName: pkg\n",
+		"pkg-1.0.dist-info/RECORD":              "",
+		"pkg-1.0.data/scripts/METADATA":         "not metadata",
+		"pkg-1.0.dist-info/entry_points.txt":    "",
+		"other-1.0.dist-info/nested/METADATA":   "nested",
+		"pkg-1.0.dist-info.backup/METADATA.txt": "",
+	})
+
+	content, err := ExtractWheelMetadata(wheel, wheel.Size())
+	require.NoError(t, err)
+	assert.Equal(t, "Metadata-Version: 2.1\nName: pkg\n", string(content))
+}
+
+func TestExtractWheelMetadataInvalid(t *testing.T) {
+	wheel := newTestWheel(t, map[string]string{"pkg/__init__.py": ""})
+	_, err := ExtractWheelMetadata(wheel, wheel.Size())
+	assert.Error(t, err, "a wheel without dist-info METADATA has no core metadata")
+
+	notZip := bytes.NewReader([]byte("not a wheel"))
+	_, err = ExtractWheelMetadata(notZip, notZip.Size())
+	assert.Error(t, err)
+}
+
+func TestIsWheelFile(t *testing.T) {
+	assert.True(t, IsWheelFile("pkg-1.0-py3-none-any.whl"))
+	assert.True(t, IsWheelFile("PKG-1.0-py3-none-any.WHL"))
+	assert.False(t, IsWheelFile("pkg-1.0.tar.gz"))
+	assert.Equal(t, "pkg/1.0/pkg-1.0-py3-none-any.whl.metadata",
+		GetCoreMetadataFilename("pkg/1.0/pkg-1.0-py3-none-any.whl"))
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	registryrefcache "github.com/harness/gitness/registry/app/services/refcache"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/firewalldelay"
+	"github.com/harness/gitness/registry/services/upstreamprovenance"
 	"github.com/harness/gitness/secret"
 	"github.com/harness/gitness/store/database/dbtx"
 
@@ -55,9 +57,12 @@ func ProxyProvider(
 	spaceFinder refcache.SpaceFinder,
 	service secret.Service,
 	localRegistryHelper LocalRegistryHelper,
+	firewallDelay *firewalldelay.Service,
+	upstreamProvenance *upstreamprovenance.Service,
 ) Proxy {
 	proxy := NewProxy(fileManager, proxyStore, tx, registryDao, imageDao, artifactDao, urlProvider,
-		spaceFinder, service, localRegistryHelper)
+		spaceFinder, service, localRegistryHelper, firewallDelay,
+		upstreamProvenance)
 	base.Register(proxy)
 	return proxy
 }
@@ -27,12 +27,26 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-// CacheKey represents the cache key for quarantine status.
+// CacheKey represents the cache key for quarantine status. The artifact type is held by value, so keys are equal
+// regardless of where the type is stored and it survives the encoding of evictions sent to other instances.
+// Evictions without a version evict all versions of the image.
 type CacheKey struct {
 	RegistryID   int64
 	Image        string
 	Version      string
-	artifactType *artifact.ArtifactType
+	ArtifactType artifact.ArtifactType
+}
+
+func newCacheKey(registryID int64, image string, version string, artifactType *artifact.ArtifactType) CacheKey {
+	key := CacheKey{
+		RegistryID: registryID,
+		Image:      image,
+		Version:    version,
+	}
+	if artifactType != nil {
+		key.ArtifactType = *artifactType
+	}
+	return key
 }
 
 // Finder provides cached access to quarantine status checks.
@@ -55,6 +69,10 @@ type Finder interface {
 		digestStr string,
 	) error
 
+	// RecordBlockedAccess records a pull of a quarantined artifact which was blocked. The check methods record
+	// the pulls they block themselves, it's only needed where pulls are blocked without them.
+	RecordBlockedAccess(ctx context.Context, registryID int64, image string, version string)
+
 	// EvictCache evicts the cache entry for a specific artifact.
 	EvictCache(
 		ctx context.Context,
@@ -63,6 +81,9 @@ type Finder interface {
 		version string,
 		artifactType *artifact.ArtifactType,
 	)
+
+	// EvictImage evicts the cache entries of all versions of an image.
+	EvictImage(ctx context.Context, registryID int64, image string)
 }
 
 // finder implements the Finder interface with caching.
@@ -95,12 +116,7 @@ func (f *finder) CheckArtifactQuarantineStatus(
 	version string,
 	artifactType *artifact.ArtifactType,
 ) error {
-	cacheKey := CacheKey{
-		RegistryID:   registryID,
-		Image:        image,
-		Version:      version,
-		artifactType: artifactType,
-	}
+	cacheKey := newCacheKey(registryID, image, version, artifactType)
 
 	// Check cache first
 	isQuarantined, err := f.quarantineCache.Get(ctx, cacheKey)
@@ -109,6 +125,7 @@ func (f *finder) CheckArtifactQuarantineStatus(
 	}
 
 	if isQuarantined {
+		f.service.RecordBlockedAccess(ctx, registryID, image, version)
 		return usererror.ErrQuarantinedArtifact
 	}
 	return nil
@@ -143,6 +160,11 @@ func (f *finder) CheckOCIManifestQuarantineStatus(
 	return f.CheckArtifactQuarantineStatus(ctx, registryID, image, typesDigest.String(), nil)
 }
 
+// RecordBlockedAccess records a pull of a quarantined artifact which was blocked.
+func (f *finder) RecordBlockedAccess(ctx context.Context, registryID int64, image string, version string) {
+	f.service.RecordBlockedAccess(ctx, registryID, image, version)
+}
+
 // EvictCache evicts the cache entry for a specific artifact.
 // This should be called when quarantine status changes.
 // It uses the evictor to both evict the cache and publish events.
@@ -153,21 +175,26 @@ func (f *finder) EvictCache(
 	version string,
 	artifactType *artifact.ArtifactType,
 ) {
-	cacheKey := CacheKey{
-		RegistryID:   registryID,
-		Image:        image,
-		Version:      version,
-		artifactType: artifactType,
-	}
+	cacheKey := newCacheKey(registryID, image, version, artifactType)
 	// Use evictor to evict cache and publish event
 	f.evictor.Evict(ctx, &cacheKey)
 }
 
+// EvictImage evicts the cache entries of all versions of an image.
+// This should be called when the image is deleted, as its quarantine entries are deleted along with it.
+func (f *finder) EvictImage(ctx context.Context, registryID int64, image string) {
+	f.evictor.Evict(ctx, &CacheKey{RegistryID: registryID, Image: image})
+}
+
 // quarantineCacheGetter implements the cache getter interface.
 type quarantineCacheGetter struct {
 	service *Service
 }
 
 func (g quarantineCacheGetter) Find(ctx context.Context, key CacheKey) (bool, error) {
-	return g.service.CheckArtifactQuarantineStatus(ctx, key.RegistryID, key.Image, key.Version, key.artifactType)
+	var artifactType *artifact.ArtifactType
+	if key.ArtifactType != "" {
+		artifactType = &key.ArtifactType
+	}
+	return g.service.CheckArtifactQuarantineStatus(ctx, key.RegistryID, key.Image, key.Version, artifactType)
 }
@@ -0,0 +1,103 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quarantine_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	cache2 "github.com/harness/gitness/app/store/cache"
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/app/pkg/quarantine"
+	"github.com/harness/gitness/registry/types"
+	coretypes "github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeQuarantineCache map[quarantine.CacheKey]bool
+
+func (f fakeQuarantineCache) Stats() (int64, int64) {
+	return 0, 0
+}
+
+func (f fakeQuarantineCache) Get(_ context.Context, key quarantine.CacheKey) (bool, error) {
+	return f[key], nil
+}
+
+func (f fakeQuarantineCache) Evict(context.Context, quarantine.CacheKey) {}
+
+func TestCheckArtifactQuarantineStatus_RecordsBlockedPulls(t *testing.T) {
+	attempts := mocks.NewMockQuarantineAccessAttemptRepository(t)
+	quarantineCache := fakeQuarantineCache{
+		{RegistryID: 1, Image: "app", Version: "1.0.0"}: true,
+	}
+	f := quarantine.NewFinder(quarantine.NewService(nil, nil, attempts), quarantineCache,
+		cache2.Evictor[*quarantine.CacheKey]{})
+
+	ctx := request.WithAuthSession(context.Background(), &auth.Session{
+		Principal: coretypes.Principal{ID: 7},
+	})
+
+	var attempt *types.QuarantineAccessAttempt
+	attempts.EXPECT().Create(mock.Anything, mock.Anything).Run(
+		func(_ context.Context, a *types.QuarantineAccessAttempt) {
+			attempt = a
+		}).Return(nil).Once()
+
+	err := f.CheckArtifactQuarantineStatus(ctx, 1, "app", "1.0.0", nil)
+	require.ErrorIs(t, err, usererror.ErrQuarantinedArtifact)
+
+	err = f.CheckArtifactQuarantineStatus(ctx, 1, "app", "2.0.0", nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, attempt)
+	require.Equal(t, int64(1), attempt.RegistryID)
+	require.Equal(t, "app", attempt.ImageName)
+	require.Equal(t, "1.0.0", attempt.Version)
+	require.NotNil(t, attempt.PrincipalID)
+	require.Equal(t, int64(7), *attempt.PrincipalID)
+}
+
+func TestRecordBlockedAccess_Anonymous(t *testing.T) {
+	attempts := mocks.NewMockQuarantineAccessAttemptRepository(t)
+	s := quarantine.NewService(nil, nil, attempts)
+
+	attempts.EXPECT().Create(mock.Anything, mock.MatchedBy(func(a *types.QuarantineAccessAttempt) bool {
+		return a.PrincipalID == nil
+	})).Return(nil).Twice()
+
+	ctx := request.WithAuthSession(context.Background(), &auth.Session{Principal: auth.AnonymousPrincipal})
+	s.RecordBlockedAccess(ctx, 1, "app", "1.0.0")
+	s.RecordBlockedAccess(context.Background(), 1, "app", "1.0.0")
+}
+
+func TestCheckArtifactQuarantineStatus_RecordingFailureStillBlocks(t *testing.T) {
+	attempts := mocks.NewMockQuarantineAccessAttemptRepository(t)
+	attempts.EXPECT().Create(mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+	quarantineCache := fakeQuarantineCache{
+		{RegistryID: 1, Image: "app", Version: "1.0.0"}: true,
+	}
+	f := quarantine.NewFinder(quarantine.NewService(nil, nil, attempts), quarantineCache,
+		cache2.Evictor[*quarantine.CacheKey]{})
+
+	err := f.CheckArtifactQuarantineStatus(context.Background(), 1, "app", "1.0.0", nil)
+	require.ErrorIs(t, err, usererror.ErrQuarantinedArtifact)
+}
@@ -18,9 +18,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
 	store2 "github.com/harness/gitness/store"
 
 	"github.com/opencontainers/go-digest"
@@ -31,16 +35,19 @@ import (
 type Service struct {
 	quarantineRepo store.QuarantineArtifactRepository
 	manifestRepo   store.ManifestRepository
+	attemptRepo    store.QuarantineAccessAttemptRepository
 }
 
 // NewService creates a new quarantine service.
 func NewService(
 	quarantineRepo store.QuarantineArtifactRepository,
 	manifestRepo store.ManifestRepository,
+	attemptRepo store.QuarantineAccessAttemptRepository,
 ) *Service {
 	return &Service{
 		quarantineRepo: quarantineRepo,
 		manifestRepo:   manifestRepo,
+		attemptRepo:    attemptRepo,
 	}
 }
 
@@ -98,3 +105,28 @@ func (s *Service) ResolveDigest(
 
 	return "", nil
 }
+
+// RecordBlockedAccess records a pull of a quarantined artifact which was blocked, along with the principal of the
+// session. Recording is best effort, failures are only logged so they never change the outcome of the pull.
+func (s *Service) RecordBlockedAccess(
+	ctx context.Context,
+	registryID int64,
+	image string,
+	version string,
+) {
+	attempt := &types.QuarantineAccessAttempt{
+		RegistryID: registryID,
+		ImageName:  image,
+		Version:    version,
+		CreatedAt:  time.Now(),
+	}
+	if session, ok := request.AuthSessionFrom(ctx); ok && session != nil && !auth.IsAnonymousSession(session) {
+		principalID := session.Principal.ID
+		attempt.PrincipalID = &principalID
+	}
+
+	if err := s.attemptRepo.Create(ctx, attempt); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to record blocked pull of quarantined artifact: [%s], "+
+			"version: [%s]", image, version)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/harness/gitness/cache"
 	"github.com/harness/gitness/pubsub"
 	"github.com/harness/gitness/registry/app/store"
+	registrycache "github.com/harness/gitness/registry/app/store/cache"
 
 	"github.com/google/wire"
 )
@@ -48,8 +49,9 @@ func ProvideEvictorQuarantine(pubsub pubsub.PubSub) cache2.Evictor[*CacheKey] {
 func ProvideService(
 	quarantineRepo store.QuarantineArtifactRepository,
 	manifestRepo store.ManifestRepository,
+	attemptRepo store.QuarantineAccessAttemptRepository,
 ) *Service {
-	return NewService(quarantineRepo, manifestRepo)
+	return NewService(quarantineRepo, manifestRepo, attemptRepo)
 }
 
 // ProvideQuarantineCache provides the quarantine cache.
@@ -59,10 +61,16 @@ func ProvideQuarantineCache(
 	evictor cache2.Evictor[*CacheKey],
 ) cache.Cache[CacheKey, bool] {
 	getter := quarantineCacheGetter{service: service}
-	c := cache.New[CacheKey, bool](getter, quarantineCacheDuration)
+	c := registrycache.NewInvalidatedCache[CacheKey, bool](appCtx, "quarantine", getter, quarantineCacheDuration)
 
-	evictor.Subscribe(appCtx, func(key *CacheKey) error {
-		c.Evict(appCtx, *key)
+	evictor.SubscribeChanges(appCtx, func(key *CacheKey, changed time.Time) error {
+		if key.Version != "" {
+			c.Invalidate(appCtx, *key, changed)
+			return nil
+		}
+		c.InvalidateWhere(appCtx, func(k CacheKey) bool {
+			return k.RegistryID == key.RegistryID && k.Image == key.Image
+		}, changed)
 		return nil
 	})
 	return c
@@ -19,10 +19,13 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/events/asyncprocessing"
 	rpmmetadata "github.com/harness/gitness/registry/app/metadata/rpm"
 	"github.com/harness/gitness/registry/app/pkg/base"
 	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/failedupload"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	"github.com/harness/gitness/registry/app/pkg/types/rpm"
 	rpmutil "github.com/harness/gitness/registry/app/utils/rpm"
@@ -39,17 +42,21 @@ type registryHelper struct {
 	localBase              base.LocalBase
 	fileManager            filemanager.FileManager
 	postProcessingReporter *asyncprocessing.Reporter
+	// failedUploads keeps the packages which couldn't be parsed for the publisher to debug them.
+	failedUploads *failedupload.Recorder
 }
 
 func NewRegistryHelper(
 	localBase base.LocalBase,
 	fileManager filemanager.FileManager,
 	postProcessingReporter *asyncprocessing.Reporter,
+	failedUploads *failedupload.Recorder,
 ) RegistryHelper {
 	return &registryHelper{
 		localBase:              localBase,
 		fileManager:            fileManager,
 		postProcessingReporter: postProcessingReporter,
+		failedUploads:          failedUploads,
 	}
 }
 
@@ -69,9 +76,16 @@ func (c *registryHelper) UploadPackage(
 	}
 	defer r.Close()
 
+	if cfg := info.Registry.Config; cfg != nil && cfg.RpmSigning != nil && cfg.RpmSigning.VerifyPackageSignatures {
+		if err = c.verifyPackageSignature(ctx, info, fileInfo, cfg.RpmSigning); err != nil {
+			return nil, "", err
+		}
+	}
+
 	p, err := rpmutil.ParsePackage(r)
 	if err != nil {
 		log.Printf("failed to parse rpm package: %v", err)
+		c.recordFailedUpload(ctx, info, fileInfo, err)
 		return nil, "", err
 	}
 
@@ -110,3 +124,40 @@ func (c *registryHelper) UploadPackage(
 	}
 	return rs, sha256, err
 }
+
+func (c *registryHelper) verifyPackageSignature(
+	ctx context.Context,
+	info rpm.ArtifactInfo,
+	fileInfo types.FileInfo,
+	signingConfig *types.RpmSigningConfig,
+) error {
+	keyring, err := rpmutil.ReadTrustedKeys(signingConfig.TrustedKeys)
+	if err != nil {
+		return err
+	}
+	r, err := c.fileManager.DownloadFileByDigest(ctx, info.RootIdentifier, fileInfo, info.RootParentID, info.RegistryID)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if err = rpmutil.VerifyPackageSignature(r, keyring); err != nil {
+		c.recordFailedUpload(ctx, info, fileInfo, failedupload.WithClass(types.UploadErrorClassInvalidSignature, err))
+		return usererror.BadRequest(err.Error())
+	}
+	return nil
+}
+
+// recordFailedUpload keeps an upload which failed with err for the publisher to debug it.
+func (c *registryHelper) recordFailedUpload(
+	ctx context.Context,
+	info rpm.ArtifactInfo,
+	fileInfo types.FileInfo,
+	err error,
+) {
+	// packages cached from an upstream weren't published by the user, there is nothing for them to debug.
+	if info.Registry.Type == artifact.RegistryTypeUPSTREAM {
+		return
+	}
+	c.failedUploads.Record(ctx, info.ArtifactInfo, artifact.PackageTypeRPM, info.FileName, fileInfo, err)
+}
@@ -19,6 +19,7 @@ import (
 	urlprovider "github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/registry/app/events/asyncprocessing"
 	"github.com/harness/gitness/registry/app/pkg/base"
+	"github.com/harness/gitness/registry/app/pkg/failedupload"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/secret"
@@ -48,11 +49,13 @@ func RegistryHelperProvider(
 	localBase base.LocalBase,
 	fileManager filemanager.FileManager,
 	postProcessingReporter *asyncprocessing.Reporter,
+	failedUploads *failedupload.Recorder,
 ) RegistryHelper {
 	return NewRegistryHelper(
 		localBase,
 		fileManager,
 		postProcessingReporter,
+		failedUploads,
 	)
 }
 
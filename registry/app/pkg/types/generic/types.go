@@ -15,6 +15,7 @@
 package generic
 
 import (
+	"github.com/harness/gitness/registry/app/metadata/generic"
 	"github.com/harness/gitness/registry/app/pkg"
 )
 
@@ -25,6 +26,11 @@ type ArtifactInfo struct {
 	Version  string
 
 	Description string
+	// Sha256 is the digest of the file announced by the client, the upload is skipped when a blob with it
+	// is already stored.
+	Sha256 string
+	// Source (optional) is stored in the metadata of the artifact, unless the artifact already has one.
+	Source *generic.Source
 }
 
 // BaseArtifactInfo implements pkg.PackageArtifactInfo interface.
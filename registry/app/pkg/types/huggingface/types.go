@@ -106,6 +106,30 @@ type RevisionInfoResponse struct {
 	XetEnabled bool `json:"xetEnabled"` //nolint:tagliatelle
 }
 
+// TreeEntryType represents the type of repository tree entry.
+type TreeEntryType string
+
+const (
+	TreeEntryFile      TreeEntryType = "file"
+	TreeEntryDirectory TreeEntryType = "directory"
+)
+
+// TreeEntry represents a file or directory in a repository tree listing.
+type TreeEntry struct {
+	Type TreeEntryType `json:"type"`
+	Oid  string        `json:"oid"`
+	Size int64         `json:"size"`
+	Path string        `json:"path"`
+	Lfs  *TreeEntryLfs `json:"lfs,omitempty"`
+}
+
+// TreeEntryLfs represents the LFS pointer details of a file tree entry.
+type TreeEntryLfs struct {
+	Oid         string `json:"oid"`
+	Size        int64  `json:"size"`
+	PointerSize int64  `json:"pointerSize"` //nolint:tagliatelle
+}
+
 type LfsInfoRequest struct {
 	Operation string              `json:"operation"` // "upload", "download"
 	Transfers []string            `json:"transfers"` // "basic", "multipart"
@@ -52,6 +52,8 @@ type File struct {
 	FileURL        string
 	Name           string
 	RequiresPython string
+	// MetadataSha256 is the sha256 of the PEP 658 core metadata file, empty when it is not available.
+	MetadataSha256 string
 }
 
 type PackageMetadata struct {
@@ -15,8 +15,10 @@
 package pkg
 
 import (
+	"github.com/harness/gitness/registry/app/api/interfaces"
 	"github.com/harness/gitness/registry/app/pkg/quarantine"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/denylist"
 
 	"github.com/google/wire"
 )
@@ -24,8 +26,10 @@ import (
 func CoreControllerProvider(
 	registryDao store.RegistryRepository,
 	quarantineFinder quarantine.Finder,
+	dependencyFirewallChecker interfaces.DependencyFirewallChecker,
+	denylist *denylist.Service,
 ) *CoreController {
-	return NewCoreController(registryDao, quarantineFinder)
+	return NewCoreController(registryDao, quarantineFinder, dependencyFirewallChecker, denylist)
 }
 
 var WireSet = wire.NewSet(CoreControllerProvider)
@@ -124,9 +124,7 @@ func getAwsSvc(ctx context.Context, accessKey, secretKey string, reg types.Upstr
 	config := &aws.Config{
 		Credentials: cred,
 		Region:      &region,
-		HTTPClient: &http.Client{
-			Transport: commonhttp.GetHTTPTransport(commonhttp.WithInsecure(false)),
-		},
+		HTTPClient:  commonhttp.NewClient(commonhttp.WithInsecure(false)),
 	}
 
 	svc := awsecrapi.New(sess, config)
@@ -260,9 +258,7 @@ func NewAuth(accessKey string, awssvc *awsecrapi.ECR, isPublic bool) Credential
 }
 
 func (a *awsAuthCredential) getPublicECRToken(ctx context.Context, host string) (string, error) {
-	c := &http.Client{
-		Transport: commonhttp.GetHTTPTransport(commonhttp.WithInsecure(true)),
-	}
+	c := commonhttp.NewClient(commonhttp.WithInsecure(true))
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, buildTokenURL(host, host), nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
@@ -77,6 +77,33 @@ func (p Package) URL() string {
 	return baseURL.ResolveReference(parsedURL).String()
 }
 
+// SourceURL returns the URL of the file without the fragment carrying its digest.
+func (p Package) SourceURL() string {
+	fileURL := p.URL()
+	parsedURL, err := url.Parse(fileURL)
+	if err != nil {
+		return fileURL
+	}
+	parsedURL.Fragment = ""
+	parsedURL.RawFragment = ""
+	return parsedURL.String()
+}
+
+// Checksums returns the digest the index advertises for the file in the fragment of its URL, like
+// "#sha256=<hex>", keyed by algorithm. It's empty if the link has no digest.
+func (p Package) Checksums() map[string]string {
+	checksums := map[string]string{}
+	parsedURL, err := url.Parse(p.ATags["href"])
+	if err != nil {
+		return checksums
+	}
+	algorithm, digest, ok := strings.Cut(parsedURL.Fragment, "=")
+	if ok && algorithm != "" && digest != "" {
+		checksums[strings.ToLower(algorithm)] = strings.ToLower(digest)
+	}
+	return checksums
+}
+
 func (p Package) Valid() bool {
 	return p.URL() != "" && p.Name != ""
 }
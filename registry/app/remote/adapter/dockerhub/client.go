@@ -39,10 +39,8 @@ type Client struct {
 // NewClient creates a new DockerHub client.
 func NewClient(_ types.UpstreamProxy) (*Client, error) {
 	client := &Client{
-		host: registryURL,
-		client: &http.Client{
-			Transport: commonhttp.GetHTTPTransport(commonhttp.WithInsecure(true)),
-		},
+		host:   registryURL,
+		client: commonhttp.NewClient(commonhttp.WithInsecure(true)),
 	}
 
 	return client, nil
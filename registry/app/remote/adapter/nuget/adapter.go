@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -211,7 +212,9 @@ func (a adapter) SearchPackageV2(ctx context.Context, searchTerm string, limit,
 	return closer, nil
 }
 
-func (a adapter) SearchPackage(ctx context.Context, searchTerm string, limit, offset int) (io.ReadCloser, error) {
+func (a adapter) SearchPackage(
+	ctx context.Context, searchTerm string, includePrerelease bool, limit, offset int,
+) (io.ReadCloser, error) {
 	// For v3 API, we need to use the search service endpoint
 	endpoint, err := a.GetServiceEndpoint(ctx)
 	if err != nil {
@@ -223,8 +226,8 @@ func (a adapter) SearchPackage(ctx context.Context, searchTerm string, limit, of
 		return nil, fmt.Errorf("failed to get search service URL: %w", err)
 	}
 
-	searchEndpoint := fmt.Sprintf("%s?q=%s&skip=%d&take=%d",
-		strings.TrimRight(searchURL, "/"), searchTerm, offset, limit)
+	searchEndpoint := fmt.Sprintf("%s?q=%s&skip=%d&take=%d&prerelease=%t&semVerLevel=2.0.0",
+		strings.TrimRight(searchURL, "/"), url.QueryEscape(searchTerm), offset, limit, includePrerelease)
 	log.Ctx(ctx).Info().Msgf("Search Package V3 URL: %s", searchEndpoint)
 	_, closer, err := a.GetFileFromURL(ctx, searchEndpoint)
 	if err != nil {
@@ -48,10 +48,8 @@ func newClient(
 	}
 
 	c := &client{
-		url: registry.RepoURL,
-		client: &http.Client{
-			Transport: commonhttp.GetHTTPTransport(commonhttp.WithInsecure(true)),
-		},
+		url:      registry.RepoURL,
+		client:   commonhttp.NewClient(commonhttp.WithInsecure(true)),
 		username: username,
 		password: password,
 	}
@@ -21,6 +21,7 @@ import (
 	"io"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/harness/gitness/app/services/refcache"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
@@ -164,6 +165,43 @@ func (a *adapter) GetJSON(ctx context.Context, pkg string, version string) (*pyt
 	return &response, nil
 }
 
+// GetReleaseTimes returns the time each version of the package was published at, the upload time of its first file.
+// It's read from the JSON API of the upstream.
+func (a *adapter) GetReleaseTimes(ctx context.Context, pkg string) (map[string]time.Time, error) {
+	_, readCloser, err := a.GetFile(ctx, fmt.Sprintf("pypi/%s/json", pkg))
+	if err != nil {
+		return nil, err
+	}
+	defer readCloser.Close()
+
+	var response ProjectResponse
+	if err = json.NewDecoder(readCloser).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to parse the releases of package %s: %w", pkg, err)
+	}
+	return ReleaseTimes(response), nil
+}
+
+// ReleaseTimes returns the upload time of the first file of each release, releases without files are skipped. The
+// versions are read from the file names like the proxy does, they may be normalized differently than the release.
+func ReleaseTimes(response ProjectResponse) map[string]time.Time {
+	times := make(map[string]time.Time, len(response.Releases))
+	for release, files := range response.Releases {
+		for _, file := range files {
+			if file.UploadTime.IsZero() {
+				continue
+			}
+			version := pypi.GetPyPIVersion(file.Filename)
+			if version == "" {
+				version = release
+			}
+			if first, ok := times[version]; !ok || file.UploadTime.Before(first) {
+				times[version] = file.UploadTime
+			}
+		}
+	}
+	return times
+}
+
 func ParseMetadata(ctx context.Context, body io.ReadCloser) (python.Metadata, error) {
 	bytes, err := io.ReadAll(body)
 	if err != nil {
@@ -14,8 +14,22 @@
 
 package pypi
 
-import "github.com/harness/gitness/registry/app/metadata/python"
+import (
+	"time"
+
+	"github.com/harness/gitness/registry/app/metadata/python"
+)
 
 type Response struct {
 	Info python.Metadata `json:"info"`
 }
+
+// ProjectResponse is the part of the JSON API response of a project listing the files of its releases.
+type ProjectResponse struct {
+	Releases map[string][]ReleaseFile `json:"releases"`
+}
+
+type ReleaseFile struct {
+	Filename   string    `json:"filename"`
+	UploadTime time.Time `json:"upload_time_iso_8601"`
+}
@@ -39,9 +39,7 @@ func NewAuthorizer(username, password string, insecure, isOCI bool) lib.Authoriz
 		username: username,
 		password: password,
 		isOCI:    isOCI,
-		client: &http.Client{
-			Transport: commonhttp.GetHTTPTransport(commonhttp.WithInsecure(insecure)),
-		},
+		client:   commonhttp.NewClient(commonhttp.WithInsecure(insecure)),
 	}
 }
 
@@ -185,14 +185,13 @@ func NewClientWithAuthorizer(
 	insecure bool,
 	interceptors ...interceptor.Interceptor,
 ) Client {
+	httpClient := commonhttp.NewClient(commonhttp.WithInsecure(insecure))
+	httpClient.Timeout = registryHTTPClientTimeout
 	return &client{
 		url:          url,
 		authorizer:   authorizer,
 		interceptors: interceptors,
-		client: &http.Client{
-			Transport: commonhttp.GetHTTPTransport(commonhttp.WithInsecure(insecure)),
-			Timeout:   registryHTTPClientTimeout,
-		},
+		client:       httpClient,
 	}
 }
 
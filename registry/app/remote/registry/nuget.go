@@ -29,7 +29,7 @@ type NugetRegistry interface {
 	ListPackageVersion(ctx context.Context, pkg string) (io.ReadCloser, error)
 	ListPackageVersionV2(ctx context.Context, pkg string) (io.ReadCloser, error)
 	SearchPackageV2(ctx context.Context, searchTerm string, limit, offset int) (io.ReadCloser, error)
-	SearchPackage(ctx context.Context, searchTerm string, limit, offset int) (io.ReadCloser, error)
+	SearchPackage(ctx context.Context, searchTerm string, includePrerelease bool, limit, offset int) (io.ReadCloser, error)
 	CountPackageV2(ctx context.Context, searchTerm string) (int64, error)
 	CountPackageVersionV2(ctx context.Context, pkg string) (int64, error)
 }
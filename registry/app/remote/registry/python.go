@@ -17,6 +17,7 @@ package registry
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/harness/gitness/registry/app/metadata/python"
 	"github.com/harness/gitness/registry/app/remote/adapter/commons/pypi"
@@ -26,4 +27,5 @@ type PythonRegistry interface {
 	GetMetadata(ctx context.Context, pkg string) (*pypi.SimpleMetadata, error)
 	GetPackage(ctx context.Context, pkg string, filename string) (io.ReadCloser, error)
 	GetJSON(ctx context.Context, pkg string, version string) (*python.Metadata, error)
+	GetReleaseTimes(ctx context.Context, pkg string) (map[string]time.Time, error)
 }
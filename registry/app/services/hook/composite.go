@@ -0,0 +1,134 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// maxInFlightEvents is the number of events a hook may process concurrently, events are dropped while a hook
+// is at the limit so that a slow hook doesn't pile up goroutines.
+const maxInFlightEvents = 64
+
+type registeredHook struct {
+	name string
+	hook BlobActionHook
+	// blockingCommit runs OnCommit inline, its error fails the commit.
+	blockingCommit bool
+	inFlight       chan struct{}
+}
+
+// CompositeBlobActionHook is a BlobActionHook which fans out the events to the hooks registered on it. Each hook
+// processes the events on its own goroutines, a hook which fails or panics doesn't affect the others.
+type CompositeBlobActionHook struct {
+	mu    sync.RWMutex
+	hooks []*registeredHook
+}
+
+var _ BlobActionHook = (*CompositeBlobActionHook)(nil)
+
+func NewCompositeBlobActionHook() *CompositeBlobActionHook {
+	return &CompositeBlobActionHook{}
+}
+
+// Register adds a hook which processes all events asynchronously.
+func (c *CompositeBlobActionHook) Register(name string, hook BlobActionHook) {
+	c.register(name, hook, false)
+}
+
+// RegisterBlocking adds a hook whose OnCommit runs inline in the commit, an error it returns fails the commit.
+// Read events are still processed asynchronously.
+func (c *CompositeBlobActionHook) RegisterBlocking(name string, hook BlobActionHook) {
+	c.register(name, hook, true)
+}
+
+func (c *CompositeBlobActionHook) register(name string, hook BlobActionHook, blockingCommit bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hooks = append(c.hooks, &registeredHook{
+		name:           name,
+		hook:           hook,
+		blockingCommit: blockingCommit,
+		inFlight:       make(chan struct{}, maxInFlightEvents),
+	})
+}
+
+func (c *CompositeBlobActionHook) registered() []*registeredHook {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.hooks
+}
+
+func (c *CompositeBlobActionHook) OnRead(ctx context.Context, event BlobReadEvent) error {
+	for _, h := range c.registered() {
+		h.async(ctx, "OnRead", func(ctx context.Context) error {
+			return h.hook.OnRead(ctx, event)
+		})
+	}
+	return nil
+}
+
+func (c *CompositeBlobActionHook) OnCommit(ctx context.Context, event BlobCommitEvent) error {
+	var errs []error
+	for _, h := range c.registered() {
+		if !h.blockingCommit {
+			h.async(ctx, "OnCommit", func(ctx context.Context) error {
+				return h.hook.OnCommit(ctx, event)
+			})
+			continue
+		}
+		if err := h.call(ctx, func(ctx context.Context) error {
+			return h.hook.OnCommit(ctx, event)
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("blob action hook %s: %w", h.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// async runs fn on its own goroutine with a context which isn't canceled with the request.
+func (h *registeredHook) async(ctx context.Context, method string, fn func(ctx context.Context) error) {
+	select {
+	case h.inFlight <- struct{}{}:
+	default:
+		log.Ctx(ctx).Warn().Str("hook", h.name).Str("method", method).
+			Msg("blob action hook is busy, dropping event")
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() { <-h.inFlight }()
+		if err := h.call(ctx, fn); err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("hook", h.name).Str("method", method).
+				Msg("blob action hook failed")
+		}
+	}()
+}
+
+// call runs fn and turns a panic into an error.
+func (h *registeredHook) call(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn(ctx)
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type recordingHook struct {
+	reads   chan BlobReadEvent
+	commits chan BlobCommitEvent
+	err     error
+	panics  bool
+}
+
+func newRecordingHook() *recordingHook {
+	return &recordingHook{
+		reads:   make(chan BlobReadEvent, 1),
+		commits: make(chan BlobCommitEvent, 1),
+	}
+}
+
+func (h *recordingHook) OnRead(_ context.Context, event BlobReadEvent) error {
+	if h.panics {
+		panic("read failed")
+	}
+	h.reads <- event
+	return h.err
+}
+
+func (h *recordingHook) OnCommit(_ context.Context, event BlobCommitEvent) error {
+	if h.panics {
+		panic("commit failed")
+	}
+	h.commits <- event
+	return h.err
+}
+
+func TestCompositeBlobActionHook(t *testing.T) {
+	t.Run("fans out events to the hooks in isolation", func(t *testing.T) {
+		composite := NewCompositeBlobActionHook()
+		failing := &recordingHook{panics: true}
+		healthy := newRecordingHook()
+		composite.Register("failing", failing)
+		composite.Register("healthy", healthy)
+
+		if err := composite.OnRead(context.Background(), BlobReadEvent{}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if err := composite.OnCommit(context.Background(), BlobCommitEvent{Size: 1}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		select {
+		case <-healthy.reads:
+		case <-time.After(time.Second):
+			t.Error("expected the read event to reach the healthy hook")
+		}
+		select {
+		case event := <-healthy.commits:
+			if event.Size != 1 {
+				t.Errorf("expected the commit event, got %v", event)
+			}
+		case <-time.After(time.Second):
+			t.Error("expected the commit event to reach the healthy hook")
+		}
+	})
+
+	t.Run("fails the commit when a blocking hook fails", func(t *testing.T) {
+		composite := NewCompositeBlobActionHook()
+		errCommit := errors.New("commit rejected")
+		blocking := newRecordingHook()
+		blocking.err = errCommit
+		composite.RegisterBlocking("blocking", blocking)
+
+		if err := composite.OnCommit(context.Background(), BlobCommitEvent{}); !errors.Is(err, errCommit) {
+			t.Errorf("expected %v, got %v", errCommit, err)
+		}
+	})
+
+	t.Run("turns a panic of a blocking hook into an error", func(t *testing.T) {
+		composite := NewCompositeBlobActionHook()
+		composite.RegisterBlocking("panics", &recordingHook{panics: true})
+
+		if err := composite.OnCommit(context.Background(), BlobCommitEvent{}); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
@@ -22,7 +22,7 @@ import (
 
 // EmitReadEvent emits a read event.
 // Important: The implementer should trigger this in async as this is on active read path and it is responsibility
-// of the implementer of this hook.
+// of the implementer of this hook. Hooks registered on CompositeBlobActionHook are always run asynchronously.
 func EmitReadEvent(
 	ctx context.Context,
 	hook BlobActionHook,
@@ -14,6 +14,13 @@
 
 package hook
 
-func ProvideBlobCommitHook() BlobActionHook {
-	return NewNoOpBlobActionHook()
+// ProvideBlobActionHookRegistry provides the hook the blob action hooks are registered on.
+func ProvideBlobActionHookRegistry() *CompositeBlobActionHook {
+	composite := NewCompositeBlobActionHook()
+	composite.Register("trace", NewNoOpBlobActionHook())
+	return composite
+}
+
+func ProvideBlobCommitHook(registry *CompositeBlobActionHook) BlobActionHook {
+	return registry
 }
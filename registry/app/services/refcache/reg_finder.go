@@ -106,9 +106,17 @@ func (r registryFinder) FindByRootParentID(ctx context.Context, rootParentID int
 }
 
 func (r registryFinder) Update(ctx context.Context, registry *types.Registry) (err error) {
+	previous, err := r.inner.Get(ctx, registry.ID)
+	if err != nil {
+		return fmt.Errorf("error finding registry by id: %w", err)
+	}
 	err = r.inner.Update(ctx, registry)
 	if err == nil {
 		r.MarkChanged(ctx, registry)
+		// the root-ref cache is keyed by name, the entry of the previous name has to be evicted as well.
+		if previous.Name != registry.Name || previous.RootParentID != registry.RootParentID {
+			r.evictor.Evict(ctx, previous)
+		}
 	}
 	return err
 }
@@ -0,0 +1,72 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrypolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/cache"
+	"github.com/harness/gitness/registry/app/services/refcache"
+	"github.com/harness/gitness/registry/types"
+)
+
+// downloadStatModeCacheDuration is how long the download stat mode of a registry is cached for, a change of the
+// privacy setting of a space applies to downloads once it expires.
+const downloadStatModeCacheDuration = time.Minute
+
+// DownloadStatModes resolves how the downloads of registries are recorded from their download stats privacy
+// policy. It's consulted on every download, so the modes are cached.
+type DownloadStatModes struct {
+	cache *cache.TTLCache[int64, types.DownloadStatMode]
+}
+
+func NewDownloadStatModes(policies *Service, registryFinder refcache.RegistryFinder) *DownloadStatModes {
+	return &DownloadStatModes{
+		cache: cache.New[int64, types.DownloadStatMode](downloadStatModeGetter{
+			policies:       policies,
+			registryFinder: registryFinder,
+		}, downloadStatModeCacheDuration),
+	}
+}
+
+func (m *DownloadStatModes) DownloadStatMode(ctx context.Context, registryID int64) (types.DownloadStatMode, error) {
+	return m.cache.Get(ctx, registryID)
+}
+
+type downloadStatModeGetter struct {
+	policies       *Service
+	registryFinder refcache.RegistryFinder
+}
+
+func (g downloadStatModeGetter) Find(ctx context.Context, registryID int64) (types.DownloadStatMode, error) {
+	registry, err := g.registryFinder.FindByID(ctx, registryID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find registry %d: %w", registryID, err)
+	}
+	effective, err := g.policies.Resolve(ctx, registry)
+	if err != nil {
+		return "", err
+	}
+	return downloadStatMode(&effective.Policy), nil
+}
+
+func downloadStatMode(policy *types.RegistryPolicy) types.DownloadStatMode {
+	if policy.DownloadStatsPrivacy != nil && *policy.DownloadStatsPrivacy {
+		return types.DownloadStatModeAggregated
+	}
+	return types.DownloadStatModeDetailed
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrypolicy
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadStatMode(t *testing.T) {
+	enabled := true
+	disabled := false
+
+	assert.Equal(t, types.DownloadStatModeDetailed, downloadStatMode(&types.RegistryPolicy{}))
+	assert.Equal(t, types.DownloadStatModeDetailed,
+		downloadStatMode(&types.RegistryPolicy{DownloadStatsPrivacy: &disabled}))
+	assert.Equal(t, types.DownloadStatModeAggregated,
+		downloadStatMode(&types.RegistryPolicy{DownloadStatsPrivacy: &enabled}))
+}
+
+func TestInheritDownloadStatsPrivacy(t *testing.T) {
+	enabled := true
+
+	dst := &types.RegistryPolicy{}
+	inherited := inherit(dst, &types.RegistryPolicy{DownloadStatsPrivacy: &enabled})
+
+	assert.Equal(t, []types.RegistryPolicyField{types.RegistryPolicyFieldDownloadStatsPrivacy}, inherited)
+	assert.Equal(t, types.DownloadStatModeAggregated, downloadStatMode(dst))
+}
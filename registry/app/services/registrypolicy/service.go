@@ -0,0 +1,181 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrypolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/app/services/settings"
+	"github.com/harness/gitness/registry/types"
+)
+
+// fields lists the policy fields in the order their sources are reported.
+var fields = []types.RegistryPolicyField{
+	types.RegistryPolicyFieldRetentionDays,
+	types.RegistryPolicyFieldImmutable,
+	types.RegistryPolicyFieldRequireSignatures,
+	types.RegistryPolicyFieldQuota,
+	types.RegistryPolicyFieldDownloadStatsPrivacy,
+	types.RegistryPolicyFieldDeletionApproval,
+	types.RegistryPolicyFieldAutoQuarantineSeverity,
+}
+
+// Service resolves the policies of registries, which are inherited from the settings of their spaces.
+type Service struct {
+	settings    *settings.Service
+	spaceFinder refcache.SpaceFinder
+}
+
+func NewService(settingsService *settings.Service, spaceFinder refcache.SpaceFinder) *Service {
+	return &Service{
+		settings:    settingsService,
+		spaceFinder: spaceFinder,
+	}
+}
+
+// GetSpacePolicy returns the registry policy defined on the space itself, without inherited values.
+func (s *Service) GetSpacePolicy(ctx context.Context, spaceID int64) (*types.RegistryPolicy, error) {
+	policy := &types.RegistryPolicy{}
+	err := s.settings.SpaceMap(ctx, spaceID, settings.Mapping(settings.KeyRegistryPolicy, policy))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registry policy of space %d: %w", spaceID, err)
+	}
+	return policy, nil
+}
+
+// SetSpacePolicy replaces the registry policy defined on the space.
+func (s *Service) SetSpacePolicy(ctx context.Context, spaceID int64, policy *types.RegistryPolicy) error {
+	err := s.settings.SpaceSet(ctx, spaceID, settings.KeyRegistryPolicy, policy)
+	if err != nil {
+		return fmt.Errorf("failed to set registry policy of space %d: %w", spaceID, err)
+	}
+	return nil
+}
+
+// Resolve returns the policy of the registry: values set on the registry (through its settings or its config) win,
+// the others are inherited from the closest space up the hierarchy which sets them, and fall back to the defaults.
+func (s *Service) Resolve(ctx context.Context, registry *types.Registry) (*types.EffectiveRegistryPolicy, error) {
+	sources := make(map[types.RegistryPolicyField]types.RegistryPolicySource, len(fields))
+	effective := &types.EffectiveRegistryPolicy{}
+
+	registrySettings, err := s.GetRegistrySettings(ctx, registry.ID)
+	if err != nil {
+		return nil, err
+	}
+	// the settings of the registry win over the policy stored in its config.
+	for _, policy := range []*types.RegistryPolicy{registrySettings, registryPolicy(registry)} {
+		for _, f := range inherit(&effective.Policy, policy) {
+			sources[f] = types.RegistryPolicySource{Field: f, Type: types.RegistryPolicySourceRegistry}
+		}
+	}
+
+	for spaceID := registry.ParentID; spaceID > 0 && len(sources) < len(fields); {
+		space, err := s.spaceFinder.FindByID(ctx, spaceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find space %d: %w", spaceID, err)
+		}
+		policy, err := s.GetSpacePolicy(ctx, space.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range inherit(&effective.Policy, policy) {
+			sources[f] = types.RegistryPolicySource{
+				Field:     f,
+				Type:      types.RegistryPolicySourceSpace,
+				SpacePath: space.Path,
+			}
+		}
+		spaceID = space.ParentID
+	}
+
+	inherit(&effective.Policy, defaultPolicy())
+	for _, f := range fields {
+		source, ok := sources[f]
+		if !ok {
+			source = types.RegistryPolicySource{Field: f, Type: types.RegistryPolicySourceDefault}
+		}
+		effective.Sources = append(effective.Sources, source)
+	}
+	return effective, nil
+}
+
+// registryPolicy returns the policy values set on the registry. The quota configured before policies
+// existed is used when the policy doesn't set one.
+func registryPolicy(registry *types.Registry) *types.RegistryPolicy {
+	policy := &types.RegistryPolicy{}
+	if registry.Config == nil {
+		return policy
+	}
+	if registry.Config.Policy != nil {
+		*policy = *registry.Config.Policy
+	}
+	if policy.Quota == nil {
+		policy.Quota = registry.Config.Quota
+	}
+	return policy
+}
+
+func defaultPolicy() *types.RegistryPolicy {
+	retentionDays := int64(0)
+	immutable := false
+	requireSignatures := false
+	downloadStatsPrivacy := false
+	deletionApproval := false
+	autoQuarantineSeverity := types.VulnerabilitySeverityNone
+	return &types.RegistryPolicy{
+		RetentionDays:          &retentionDays,
+		Immutable:              &immutable,
+		RequireSignatures:      &requireSignatures,
+		DownloadStatsPrivacy:   &downloadStatsPrivacy,
+		DeletionApproval:       &deletionApproval,
+		AutoQuarantineSeverity: &autoQuarantineSeverity,
+	}
+}
+
+// inherit copies the values which are set in src but not in dst, and returns the fields it copied.
+func inherit(dst *types.RegistryPolicy, src *types.RegistryPolicy) []types.RegistryPolicyField {
+	var inherited []types.RegistryPolicyField
+	if dst.RetentionDays == nil && src.RetentionDays != nil {
+		dst.RetentionDays = src.RetentionDays
+		inherited = append(inherited, types.RegistryPolicyFieldRetentionDays)
+	}
+	if dst.Immutable == nil && src.Immutable != nil {
+		dst.Immutable = src.Immutable
+		inherited = append(inherited, types.RegistryPolicyFieldImmutable)
+	}
+	if dst.RequireSignatures == nil && src.RequireSignatures != nil {
+		dst.RequireSignatures = src.RequireSignatures
+		inherited = append(inherited, types.RegistryPolicyFieldRequireSignatures)
+	}
+	if dst.Quota == nil && src.Quota != nil {
+		dst.Quota = src.Quota
+		inherited = append(inherited, types.RegistryPolicyFieldQuota)
+	}
+	if dst.DownloadStatsPrivacy == nil && src.DownloadStatsPrivacy != nil {
+		dst.DownloadStatsPrivacy = src.DownloadStatsPrivacy
+		inherited = append(inherited, types.RegistryPolicyFieldDownloadStatsPrivacy)
+	}
+	if dst.DeletionApproval == nil && src.DeletionApproval != nil {
+		dst.DeletionApproval = src.DeletionApproval
+		inherited = append(inherited, types.RegistryPolicyFieldDeletionApproval)
+	}
+	if dst.AutoQuarantineSeverity == nil && src.AutoQuarantineSeverity != nil {
+		dst.AutoQuarantineSeverity = src.AutoQuarantineSeverity
+		inherited = append(inherited, types.RegistryPolicyFieldAutoQuarantineSeverity)
+	}
+	return inherited
+}
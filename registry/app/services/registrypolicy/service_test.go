@@ -0,0 +1,68 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrypolicy
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInherit(t *testing.T) {
+	registryDays := int64(7)
+	spaceDays := int64(30)
+	immutable := true
+	quota := &types.QuotaConfig{StorageLimit: 1024}
+
+	dst := &types.RegistryPolicy{RetentionDays: &registryDays}
+	inherited := inherit(dst, &types.RegistryPolicy{
+		RetentionDays: &spaceDays,
+		Immutable:     &immutable,
+		Quota:         quota,
+	})
+
+	assert.Equal(t, []types.RegistryPolicyField{
+		types.RegistryPolicyFieldImmutable,
+		types.RegistryPolicyFieldQuota,
+	}, inherited)
+	assert.Equal(t, registryDays, *dst.RetentionDays)
+	assert.True(t, *dst.Immutable)
+	assert.Nil(t, dst.RequireSignatures)
+	assert.Equal(t, quota, dst.Quota)
+}
+
+func TestRegistryPolicy(t *testing.T) {
+	legacyQuota := &types.QuotaConfig{StorageLimit: 1024}
+	policyQuota := &types.QuotaConfig{StorageLimit: 2048}
+
+	t.Run("registry without config", func(t *testing.T) {
+		assert.Equal(t, &types.RegistryPolicy{}, registryPolicy(&types.Registry{}))
+	})
+
+	t.Run("quota falls back to the registry quota", func(t *testing.T) {
+		policy := registryPolicy(&types.Registry{Config: &types.RegistryConfig{Quota: legacyQuota}})
+		assert.Equal(t, legacyQuota, policy.Quota)
+	})
+
+	t.Run("policy quota wins over the registry quota", func(t *testing.T) {
+		policy := registryPolicy(&types.Registry{Config: &types.RegistryConfig{
+			Quota:  legacyQuota,
+			Policy: &types.RegistryPolicy{Quota: policyQuota},
+		}})
+		assert.Equal(t, policyQuota, policy.Quota)
+	})
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrypolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/harness/gitness/app/services/settings"
+	"github.com/harness/gitness/registry/types"
+)
+
+var (
+	SettingRetentionDays          = settings.Define(settings.KeyRegistryRetentionDays, int64(0), validateRetentionDays)
+	SettingImmutable              = settings.Define(settings.KeyRegistryImmutable, false, nil)
+	SettingRequireSignatures      = settings.Define(settings.KeyRegistryRequireSignatures, false, nil)
+	SettingQuota                  = settings.Define[*types.QuotaConfig](settings.KeyRegistryQuota, nil, validateQuota)
+	SettingDownloadStatsPrivacy   = settings.Define(settings.KeyRegistryDownloadStatsPrivacy, false, nil)
+	SettingDeletionApproval       = settings.Define(settings.KeyRegistryDeletionApproval, false, nil)
+	SettingAutoQuarantineSeverity = settings.Define(
+		settings.KeyRegistryAutoQuarantineSeverity, types.VulnerabilitySeverityNone, validateAutoQuarantineSeverity)
+)
+
+// Schema is the schema of the settings of registries.
+var Schema = settings.Schema{
+	SettingRetentionDays,
+	SettingImmutable,
+	SettingRequireSignatures,
+	SettingQuota,
+	SettingDownloadStatsPrivacy,
+	SettingDeletionApproval,
+	SettingAutoQuarantineSeverity,
+}
+
+// settingFields maps the policy fields to the keys of the registry settings which set them.
+var settingFields = map[types.RegistryPolicyField]settings.Key{
+	types.RegistryPolicyFieldRetentionDays:          settings.KeyRegistryRetentionDays,
+	types.RegistryPolicyFieldImmutable:              settings.KeyRegistryImmutable,
+	types.RegistryPolicyFieldRequireSignatures:      settings.KeyRegistryRequireSignatures,
+	types.RegistryPolicyFieldQuota:                  settings.KeyRegistryQuota,
+	types.RegistryPolicyFieldDownloadStatsPrivacy:   settings.KeyRegistryDownloadStatsPrivacy,
+	types.RegistryPolicyFieldDeletionApproval:       settings.KeyRegistryDeletionApproval,
+	types.RegistryPolicyFieldAutoQuarantineSeverity: settings.KeyRegistryAutoQuarantineSeverity,
+}
+
+func validateRetentionDays(days int64) error {
+	if days < 0 {
+		return errors.New("retention days must not be negative")
+	}
+	return nil
+}
+
+func validateAutoQuarantineSeverity(severity types.VulnerabilitySeverity) error {
+	if !severity.IsValid() {
+		return fmt.Errorf("invalid auto quarantine severity %q", severity)
+	}
+	return nil
+}
+
+func validateQuota(quota *types.QuotaConfig) error {
+	if quota == nil {
+		return nil
+	}
+	if quota.StorageLimit < 0 || quota.BandwidthLimit < 0 {
+		return errors.New("quota limits must not be negative")
+	}
+	for _, t := range quota.Thresholds {
+		if t < 1 || t > 100 {
+			return fmt.Errorf("invalid quota threshold %d, thresholds must be between 1 and 100", t)
+		}
+	}
+	return nil
+}
+
+// GetRegistrySettings returns the policy values set on the registry through its settings.
+func (s *Service) GetRegistrySettings(ctx context.Context, registryID int64) (*types.RegistryPolicy, error) {
+	policy := &types.RegistryPolicy{}
+	err := s.settings.RegistryMap(ctx, registryID,
+		settings.Mapping(settings.KeyRegistryRetentionDays, &policy.RetentionDays),
+		settings.Mapping(settings.KeyRegistryImmutable, &policy.Immutable),
+		settings.Mapping(settings.KeyRegistryRequireSignatures, &policy.RequireSignatures),
+		settings.Mapping(settings.KeyRegistryQuota, &policy.Quota),
+		settings.Mapping(settings.KeyRegistryDownloadStatsPrivacy, &policy.DownloadStatsPrivacy),
+		settings.Mapping(settings.KeyRegistryDeletionApproval, &policy.DeletionApproval),
+		settings.Mapping(settings.KeyRegistryAutoQuarantineSeverity, &policy.AutoQuarantineSeverity),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings of registry %d: %w", registryID, err)
+	}
+	return policy, nil
+}
+
+// RegistrySettingValues returns the values of the settings set on the registry keyed by setting key, settings
+// which aren't set on the registry are left out.
+func (s *Service) RegistrySettingValues(ctx context.Context, registryID int64) (map[settings.Key]any, error) {
+	policy, err := s.GetRegistrySettings(ctx, registryID)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[settings.Key]any, len(Schema))
+	if policy.RetentionDays != nil {
+		values[settings.KeyRegistryRetentionDays] = *policy.RetentionDays
+	}
+	if policy.Immutable != nil {
+		values[settings.KeyRegistryImmutable] = *policy.Immutable
+	}
+	if policy.RequireSignatures != nil {
+		values[settings.KeyRegistryRequireSignatures] = *policy.RequireSignatures
+	}
+	if policy.Quota != nil {
+		values[settings.KeyRegistryQuota] = policy.Quota
+	}
+	if policy.DownloadStatsPrivacy != nil {
+		values[settings.KeyRegistryDownloadStatsPrivacy] = *policy.DownloadStatsPrivacy
+	}
+	if policy.DeletionApproval != nil {
+		values[settings.KeyRegistryDeletionApproval] = *policy.DeletionApproval
+	}
+	if policy.AutoQuarantineSeverity != nil {
+		values[settings.KeyRegistryAutoQuarantineSeverity] = *policy.AutoQuarantineSeverity
+	}
+	return values, nil
+}
+
+// UpdateRegistrySettings sets the settings of the registry, settings without a value are reset to their default.
+// The values must have been decoded and validated with the Schema.
+func (s *Service) UpdateRegistrySettings(ctx context.Context, registryID int64, values []settings.KeyValue) error {
+	for _, kv := range values {
+		var err error
+		if kv.Value == nil {
+			err = s.settings.RegistryDelete(ctx, registryID, kv.Key)
+		} else {
+			err = s.settings.RegistrySet(ctx, registryID, kv.Key, kv.Value)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update setting %q of registry %d: %w", kv.Key, registryID, err)
+		}
+	}
+	return nil
+}
+
+// EffectiveSettings returns the values of all settings of the registry which apply once inheritance is resolved.
+func (s *Service) EffectiveSettings(ctx context.Context, registry *types.Registry) ([]types.RegistrySetting, error) {
+	effective, err := s.Resolve(ctx, registry)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[settings.Key]any{
+		settings.KeyRegistryRetentionDays:          *effective.Policy.RetentionDays,
+		settings.KeyRegistryImmutable:              *effective.Policy.Immutable,
+		settings.KeyRegistryRequireSignatures:      *effective.Policy.RequireSignatures,
+		settings.KeyRegistryQuota:                  effective.Policy.Quota,
+		settings.KeyRegistryDownloadStatsPrivacy:   *effective.Policy.DownloadStatsPrivacy,
+		settings.KeyRegistryDeletionApproval:       *effective.Policy.DeletionApproval,
+		settings.KeyRegistryAutoQuarantineSeverity: *effective.Policy.AutoQuarantineSeverity,
+	}
+
+	result := make([]types.RegistrySetting, 0, len(effective.Sources))
+	for _, source := range effective.Sources {
+		key := settingFields[source.Field]
+		definition, ok := Schema.Find(key)
+		if !ok {
+			return nil, fmt.Errorf("setting %q isn't defined", key)
+		}
+		result = append(result, types.RegistrySetting{
+			Key:     string(key),
+			Value:   values[key],
+			Default: definition.Default(),
+			Source:  source,
+		})
+	}
+	return result, nil
+}
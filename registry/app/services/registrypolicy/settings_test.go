@@ -0,0 +1,63 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrypolicy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/harness/gitness/app/services/settings"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaDecode(t *testing.T) {
+	t.Run("valid values", func(t *testing.T) {
+		values, err := Schema.Decode(map[settings.Key]json.RawMessage{
+			settings.KeyRegistryRetentionDays: json.RawMessage(`30`),
+			settings.KeyRegistryImmutable:     json.RawMessage(`true`),
+			settings.KeyRegistryQuota:         json.RawMessage(`{"storageLimit":1024,"thresholds":[80]}`),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []settings.KeyValue{
+			{Key: settings.KeyRegistryRetentionDays, Value: int64(30)},
+			{Key: settings.KeyRegistryImmutable, Value: true},
+			{Key: settings.KeyRegistryQuota, Value: &types.QuotaConfig{StorageLimit: 1024, Thresholds: []int{80}}},
+		}, values)
+	})
+
+	t.Run("null resets the setting", func(t *testing.T) {
+		values, err := Schema.Decode(map[settings.Key]json.RawMessage{
+			settings.KeyRegistryRequireSignatures: json.RawMessage(`null`),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []settings.KeyValue{{Key: settings.KeyRegistryRequireSignatures}}, values)
+	})
+
+	invalid := map[string]map[settings.Key]json.RawMessage{
+		"unknown key":        {"unknown": json.RawMessage(`1`)},
+		"wrong type":         {settings.KeyRegistryImmutable: json.RawMessage(`"yes"`)},
+		"negative retention": {settings.KeyRegistryRetentionDays: json.RawMessage(`-1`)},
+		"invalid threshold":  {settings.KeyRegistryQuota: json.RawMessage(`{"thresholds":[120]}`)},
+	}
+	for name, raw := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := Schema.Decode(raw)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrypolicy
+
+import (
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/app/services/settings"
+	registryrefcache "github.com/harness/gitness/registry/app/services/refcache"
+	"github.com/harness/gitness/registry/app/store"
+
+	"github.com/google/wire"
+)
+
+func ProvideService(settingsService *settings.Service, spaceFinder refcache.SpaceFinder) *Service {
+	return NewService(settingsService, spaceFinder)
+}
+
+func ProvideDownloadStatModes(
+	policies *Service,
+	registryFinder registryrefcache.RegistryFinder,
+) store.DownloadStatModeResolver {
+	return NewDownloadStatModes(policies, registryFinder)
+}
+
+var WireSet = wire.NewSet(
+	ProvideService,
+	ProvideDownloadStatModes,
+)
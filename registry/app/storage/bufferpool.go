@@ -0,0 +1,62 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bufio"
+	"io"
+	"sync"
+)
+
+// copyBufferSize is the size of the buffers used to copy contents to their destination.
+const copyBufferSize = 256 * 1024
+
+// The buffers of the download path are pooled: every download would otherwise allocate the read buffer of its
+// file reader and a copy buffer, which adds up to a lot of garbage for registries serving many downloads.
+var (
+	readerPool = sync.Pool{
+		New: func() any {
+			return bufio.NewReaderSize(nil, fileReaderBufferSize)
+		},
+	}
+
+	copyBufferPool = sync.Pool{
+		New: func() any {
+			buf := make([]byte, copyBufferSize)
+			return &buf
+		},
+	}
+)
+
+func getReader(r io.Reader) *bufio.Reader {
+	//nolint:errcheck // the pool only holds buffered readers.
+	brd := readerPool.Get().(*bufio.Reader)
+	brd.Reset(r)
+	return brd
+}
+
+func putReader(brd *bufio.Reader) {
+	// the reader must not keep the remote reader it wrapped alive.
+	brd.Reset(nil)
+	readerPool.Put(brd)
+}
+
+// Copy copies from src to dst like io.Copy, with a pooled buffer instead of allocating one per copy.
+func Copy(dst io.Writer, src io.Reader) (int64, error) {
+	//nolint:errcheck // the pool only holds byte slices.
+	buf := copyBufferPool.Get().(*[]byte)
+	defer copyBufferPool.Put(buf)
+	return io.CopyBuffer(dst, src, *buf)
+}
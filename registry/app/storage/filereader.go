@@ -140,7 +140,7 @@ func (fr *FileReader) reader() (io.Reader, error) {
 	fr.rc = rc
 
 	if fr.brd == nil {
-		fr.brd = bufio.NewReaderSize(fr.rc, fileReaderBufferSize)
+		fr.brd = getReader(fr.rc)
 	} else {
 		fr.brd.Reset(fr.rc)
 	}
@@ -175,7 +175,10 @@ func (fr *FileReader) closeWithErr(err error) error {
 	}
 
 	fr.rc = nil
-	fr.brd = nil
+	if fr.brd != nil {
+		putReader(fr.brd)
+		fr.brd = nil
+	}
 
 	return fr.err
 }
@@ -0,0 +1,155 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/cache"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	cacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "registry",
+		Subsystem: "cache",
+		Name:      "lookups_total",
+		Help:      "Number of lookups of registry caches.",
+	}, []string{"cache"})
+
+	cacheInvalidations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "registry",
+		Subsystem: "cache",
+		Name:      "invalidations_total",
+		Help:      "Number of invalidations received by registry caches.",
+	}, []string{"cache"})
+
+	cacheStaleHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "registry",
+		Subsystem: "cache",
+		Name:      "stale_hits_total",
+		Help:      "Number of invalidated entries of registry caches which were served after they changed.",
+	}, []string{"cache"})
+)
+
+// InvalidatedCache is a cache whose entries are invalidated through the cache evictor bus. It keeps track of
+// when its entries were last served, to report the entries served between a change and the arrival of the
+// invalidation as stale hits.
+type InvalidatedCache[K comparable, V any] struct {
+	name   string
+	inner  cache.Cache[K, V]
+	maxAge time.Duration
+
+	mx     sync.Mutex
+	served map[K]time.Time
+}
+
+func NewInvalidatedCache[K comparable, V any](
+	appCtx context.Context,
+	name string,
+	getter cache.Getter[K, V],
+	maxAge time.Duration,
+) *InvalidatedCache[K, V] {
+	c := &InvalidatedCache[K, V]{
+		name:   name,
+		inner:  cache.New[K, V](getter, maxAge),
+		maxAge: maxAge,
+		served: make(map[K]time.Time),
+	}
+
+	go c.purger(appCtx)
+
+	return c
+}
+
+// purger forgets about entries which expired from the inner cache.
+func (c *InvalidatedCache[K, V]) purger(ctx context.Context) {
+	purgeTick := time.NewTicker(time.Minute)
+	defer purgeTick.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-purgeTick.C:
+			c.mx.Lock()
+			for key, served := range c.served {
+				if now.Sub(served) >= c.maxAge {
+					delete(c.served, key)
+				}
+			}
+			c.mx.Unlock()
+		}
+	}
+}
+
+func (c *InvalidatedCache[K, V]) Stats() (int64, int64) {
+	return c.inner.Stats()
+}
+
+func (c *InvalidatedCache[K, V]) Get(ctx context.Context, key K) (V, error) {
+	v, err := c.inner.Get(ctx, key)
+	if err != nil {
+		return v, err
+	}
+
+	cacheLookups.WithLabelValues(c.name).Inc()
+
+	c.mx.Lock()
+	c.served[key] = time.Now()
+	c.mx.Unlock()
+
+	return v, nil
+}
+
+func (c *InvalidatedCache[K, V]) Evict(ctx context.Context, key K) {
+	c.Invalidate(ctx, key, time.Time{})
+}
+
+// Invalidate evicts the entry of the key, which changed at the provided time.
+func (c *InvalidatedCache[K, V]) Invalidate(ctx context.Context, key K, changed time.Time) {
+	cacheInvalidations.WithLabelValues(c.name).Inc()
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.evict(ctx, key, changed)
+}
+
+// InvalidateWhere evicts the entries whose keys match, which all changed at the provided time.
+func (c *InvalidatedCache[K, V]) InvalidateWhere(ctx context.Context, match func(key K) bool, changed time.Time) {
+	cacheInvalidations.WithLabelValues(c.name).Inc()
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	for key := range c.served {
+		if match(key) {
+			c.evict(ctx, key, changed)
+		}
+	}
+}
+
+func (c *InvalidatedCache[K, V]) evict(ctx context.Context, key K, changed time.Time) {
+	if served, ok := c.served[key]; ok && !changed.IsZero() && served.After(changed) {
+		cacheStaleHits.WithLabelValues(c.name).Inc()
+	}
+	c.inner.Evict(ctx, key)
+	delete(c.served, key)
+}
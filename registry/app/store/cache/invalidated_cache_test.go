@@ -0,0 +1,80 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type countingGetter struct {
+	calls int
+}
+
+func (g *countingGetter) Find(_ context.Context, key int64) (int64, error) {
+	g.calls++
+	return key * 10, nil
+}
+
+func TestInvalidatedCache(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("counts entries served after they changed as stale hits", func(t *testing.T) {
+		c := NewInvalidatedCache[int64, int64](ctx, "test_stale", &countingGetter{}, time.Minute)
+
+		changed := time.Now().Add(-time.Second)
+		if _, err := c.Get(ctx, 1); err != nil {
+			t.Fatalf("failed to get: %v", err)
+		}
+		c.Invalidate(ctx, 1, changed)
+		// the entry isn't cached anymore, so the invalidation finds nothing stale.
+		c.Invalidate(ctx, 1, changed)
+
+		if stale := testutil.ToFloat64(cacheStaleHits.WithLabelValues("test_stale")); stale != 1 {
+			t.Errorf("expected 1 stale hit, got %v", stale)
+		}
+		if invalidations := testutil.ToFloat64(cacheInvalidations.WithLabelValues("test_stale")); invalidations != 2 {
+			t.Errorf("expected 2 invalidations, got %v", invalidations)
+		}
+	})
+
+	t.Run("evicts the entries which match", func(t *testing.T) {
+		getter := &countingGetter{}
+		c := NewInvalidatedCache[int64, int64](ctx, "test_where", getter, time.Minute)
+
+		for _, key := range []int64{1, 2, 3} {
+			if _, err := c.Get(ctx, key); err != nil {
+				t.Fatalf("failed to get: %v", err)
+			}
+		}
+		c.InvalidateWhere(ctx, func(key int64) bool { return key >= 2 }, time.Now())
+		for _, key := range []int64{1, 2, 3} {
+			if _, err := c.Get(ctx, key); err != nil {
+				t.Fatalf("failed to get: %v", err)
+			}
+		}
+
+		if getter.calls != 5 {
+			t.Errorf("expected 5 lookups of the source, got %d", getter.calls)
+		}
+		if stale := testutil.ToFloat64(cacheStaleHits.WithLabelValues("test_where")); stale != 0 {
+			t.Errorf("expected no stale hits, got %v", stale)
+		}
+	})
+}
@@ -20,7 +20,6 @@ import (
 	"time"
 
 	cache2 "github.com/harness/gitness/app/store/cache"
-	"github.com/harness/gitness/cache"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/types"
 )
@@ -31,10 +30,11 @@ func NewRegistryIDCache(
 	evictorRepo cache2.Evictor[*types.Registry],
 	dur time.Duration,
 ) store.RegistryIDCache {
-	c := cache.New[int64, *types.Registry](registryIDCacheGetter{regSource: regSource}, dur)
+	c := NewInvalidatedCache[int64, *types.Registry](appCtx, "registry_id",
+		registryIDCacheGetter{regSource: regSource}, dur)
 
-	evictorRepo.Subscribe(appCtx, func(repoCore *types.Registry) error {
-		c.Evict(appCtx, repoCore.ID)
+	evictorRepo.SubscribeChanges(appCtx, func(repoCore *types.Registry, changed time.Time) error {
+		c.Invalidate(appCtx, repoCore.ID, changed)
 		return nil
 	})
 
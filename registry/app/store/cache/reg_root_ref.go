@@ -20,7 +20,6 @@ import (
 	"time"
 
 	cache2 "github.com/harness/gitness/app/store/cache"
-	"github.com/harness/gitness/cache"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/types"
 )
@@ -31,14 +30,13 @@ func NewRegistryRootRefCache(
 	evictorReg cache2.Evictor[*types.Registry],
 	dur time.Duration,
 ) store.RegistryRootRefCache {
-	c := cache.New[types.RegistryRootRefCacheKey, int64](registryRootRefCacheGetter{
-		regSource: regSource,
-	}, dur)
-	evictorReg.Subscribe(appCtx, func(key *types.Registry) error {
-		c.Evict(appCtx, types.RegistryRootRefCacheKey{
+	c := NewInvalidatedCache[types.RegistryRootRefCacheKey, int64](appCtx, "registry_root_ref",
+		registryRootRefCacheGetter{regSource: regSource}, dur)
+	evictorReg.SubscribeChanges(appCtx, func(key *types.Registry, changed time.Time) error {
+		c.Invalidate(appCtx, types.RegistryRootRefCacheKey{
 			RootParentID:       key.RootParentID,
 			RegistryIdentifier: key.Name,
-		})
+		}, changed)
 		return nil
 	})
 
@@ -147,7 +147,21 @@ type ManifestRepository interface {
 		digest types.Digest,
 	) (types.Manifests, error)
 	GetLatestManifest(ctx context.Context, repoID int64, imageName string) (*types.Manifest, error)
-	CountByImageName(ctx context.Context, repoID int64, imageName string) (int64, error)
+	// CountByImageName counts the manifests of the image, the soft-deleted ones only if the options ask for them.
+	CountByImageName(ctx context.Context, repoID int64, imageName string, opts ...types.QueryOption) (int64, error)
+	// SoftDelete marks a manifest and the tags pointing to it as deleted.
+	SoftDelete(ctx context.Context, registryID, id int64) error
+	// Restore restores a soft-deleted manifest and the tags deleted along with it.
+	Restore(ctx context.Context, registryID, id int64) (bool, error)
+	FindDeletedManifestByDigest(
+		ctx context.Context, repoID int64, imageName string,
+		digest types.Digest,
+	) (*types.Manifest, error)
+	ListDeletedManifests(ctx context.Context, registryID int64) (*[]types.TrashedOCIVersion, error)
+	// ListDeletedBefore lists up to limit manifests of all registries soft-deleted before the given time.
+	ListDeletedBefore(ctx context.Context, before time.Time, limit int) (types.Manifests, error)
+	// GetTopImagesBySize returns the images of the registry with the largest manifests.
+	GetTopImagesBySize(ctx context.Context, registryID int64, limit int) ([]types.ImageUsage, error)
 }
 
 type ManifestReferenceRepository interface {
@@ -224,7 +238,7 @@ type TagRepository interface {
 	GetAllArtifactsByRepo(
 		ctx context.Context, parentID int64, repoKey string,
 		sortByField string, sortByOrder string,
-		limit int, offset int, search string, labels []string,
+		limit int, offset int, search string, labels []string, includeDownloadCount bool,
 	) (*[]types.ArtifactMetadata, error)
 
 	GetLatestTagMetadata(
@@ -277,6 +291,7 @@ type TagRepository interface {
 		limit int,
 		offset int,
 		search string,
+		opts ...types.QueryOption,
 	) (*[]types.OciVersionMetadata, error)
 
 	GetAllOciVersionsByRepoAndImage(
@@ -289,6 +304,7 @@ type TagRepository interface {
 		limit int,
 		offset int,
 		search string,
+		opts ...types.QueryOption,
 	) (*[]types.OciVersionMetadata, error)
 
 	GetOciTagsInfo(
@@ -298,14 +314,19 @@ type TagRepository interface {
 	) (*[]types.TagInfo, error)
 
 	DeleteTag(ctx context.Context, registryID int64, imageName string, name string) (err error)
+	RestoreTag(ctx context.Context, registryID int64, imageName string, name string) (int64, error)
+	PurgeTag(ctx context.Context, registryID int64, imageName string, name string) (bool, error)
+	ListDeletedTags(ctx context.Context, registryID int64) (*[]types.TrashedOCIVersion, error)
+	// PurgeDeletedBefore permanently deletes up to limit tags of all registries soft-deleted before the given time.
+	PurgeDeletedBefore(ctx context.Context, before time.Time, limit int) (int64, error)
 
 	CountAllTagsByRepoAndImage(
 		ctx context.Context, parentID int64, repoKey string,
-		image string, search string,
+		image string, search string, opts ...types.QueryOption,
 	) (int64, error)
 	CountOciVersionByRepoAndImage(
 		ctx context.Context, parentID int64, repoKey string,
-		image string, search string,
+		image string, search string, opts ...types.QueryOption,
 	) (int64, error)
 	FindTag(
 		ctx context.Context, repoID int64, imageName string,
@@ -462,6 +483,16 @@ type RegistryRepository interface {
 	FetchUpstreamProxyKeys(ctx context.Context, ids []int64) (repokeys []string, err error)
 	Count(ctx context.Context) (int64, error)
 
+	// GetStorageSize returns the size in bytes of the blobs stored in the registry.
+	GetStorageSize(ctx context.Context, registryID int64) (int64, error)
+
+	// GetStorageSizes returns the size in bytes of the blobs stored in each of the registries.
+	GetStorageSizes(ctx context.Context, registryIDs []int64) (map[int64]int64, error)
+
+	// ListSpaces returns the parent and root spaces of all registries of the root space,
+	// or of all registries if rootParentID is 0.
+	ListSpaces(ctx context.Context, rootParentID int64) ([]types.RegistrySpaces, error)
+
 	// GetIDsByParentSpace returns all registry IDs under a given parent space
 	GetIDsByParentSpace(ctx context.Context, parentSpaceID int64) ([]int64, error)
 
@@ -483,6 +514,9 @@ type RegistryBlobRepository interface {
 		ctx context.Context, registryID int64,
 		imageName string,
 	) (bool, error)
+
+	// GetRegistryIDsByBlobID returns the IDs of the registries the blob is linked to.
+	GetRegistryIDsByBlobID(ctx context.Context, blobID int64) ([]int64, error)
 }
 
 type ImageRepository interface {
@@ -530,16 +564,19 @@ type ImageRepository interface {
 }
 
 type ArtifactRepository interface {
-	GetByUUID(ctx context.Context, uuid string) (*types.Artifact, error)
-	Get(ctx context.Context, id int64) (*types.Artifact, error)
+	// The getters accept query options to skip loading the metadata, to cap the rows of listings and to lock the
+	// selected rows.
+	GetByUUID(ctx context.Context, uuid string, opts ...types.QueryOption) (*types.Artifact, error)
+	Get(ctx context.Context, id int64, opts ...types.QueryOption) (*types.Artifact, error)
 	// Get an Artifact specified by ID
-	GetByName(ctx context.Context, imageID int64, version string) (*types.Artifact, error)
+	GetByName(ctx context.Context, imageID int64, version string, opts ...types.QueryOption) (*types.Artifact, error)
 	// Get an Artifact specified by RegistryID, image name and version
 	GetByRegistryImageAndVersion(
-		ctx context.Context, registryID int64, image string, version string,
+		ctx context.Context, registryID int64, image string, version string, opts ...types.QueryOption,
 	) (*types.Artifact, error)
 	GetByRegistryImageVersionAndArtifactType(
 		ctx context.Context, registryID int64, image string, version string, artifactType string,
+		opts ...types.QueryOption,
 	) (*types.Artifact, error)
 	// Create an Artifact
 	CreateOrUpdate(ctx context.Context, artifact *types.Artifact) (int64, error)
@@ -554,11 +591,17 @@ type ArtifactRepository interface {
 		ctx context.Context, parentID int64,
 		registryIDs *[]string, search string, latestVersion bool, packageTypes []string,
 	) (int64, error)
+	// GetArtifactsByRepo lists the latest artifacts of the registry, the download counts are only aggregated if
+	// includeDownloadCount is set or the artifacts are sorted by them.
 	GetArtifactsByRepo(
 		ctx context.Context, parentID int64, repoKey string, sortByField string, sortByOrder string,
 		limit int, offset int, search string, labels []string,
-		artifactType *artifact.ArtifactType,
+		artifactType *artifact.ArtifactType, includeDownloadCount bool,
 	) (*[]types.ArtifactMetadata, error)
+	// GetDownloadCountsByImageNames returns the download counts of the images of the registry by image name.
+	GetDownloadCountsByImageNames(
+		ctx context.Context, registryID int64, imageNames []string,
+	) (map[string]int64, error)
 	CountArtifactsByRepo(
 		ctx context.Context, parentID int64, repoKey, search string, labels []string,
 		artifactType *artifact.ArtifactType,
@@ -579,29 +622,33 @@ type ArtifactRepository interface {
 		ctx context.Context, id int64, identifier string, image string, version string,
 		artifactType *artifact.ArtifactType,
 	) (*types.ArtifactMetadata, error)
+	// UpdateArtifactMetadata replaces the metadata of an artifact and records the change in its history.
 	UpdateArtifactMetadata(
 		ctx context.Context, metadata json.RawMessage,
 		artifactID int64,
 	) (err error)
 
-	GetByRegistryIDAndImage(ctx context.Context, registryID int64, image string) (
-		*[]types.Artifact,
-		error,
-	)
+	GetByRegistryIDAndImage(
+		ctx context.Context, registryID int64, image string, opts ...types.QueryOption,
+	) (*[]types.Artifact, error)
 
 	DeleteByImageNameAndRegistryID(ctx context.Context, regID int64, image string) (err error)
 
 	DeleteByVersionAndImageName(ctx context.Context, image string, version string, regID int64) (err error)
+	// SoftDeleteByVersionAndImageName marks the version as deleted, CreateOrUpdate restores it.
+	SoftDeleteByVersionAndImageName(ctx context.Context, image string, version string, regID int64) error
 	GetLatestByImageID(ctx context.Context, imageID int64) (*types.Artifact, error)
 
-	// get latest artifacts from all images under repo
+	// get latest artifacts from all images under repo, the batch is cancelled once batchTimeout passes
+	// (0 disables it).
 	GetLatestArtifactsByRepo(
-		ctx context.Context, registryID int64, batchSize int, artifactID int64,
+		ctx context.Context, registryID int64, batchSize int, artifactID int64, batchTimeout time.Duration,
 	) (*[]types.ArtifactMetadata, error)
 
-	// get all artifacts from all images under repo
+	// get all artifacts from all images under repo, the batch is cancelled once batchTimeout passes
+	// (0 disables it).
 	GetAllArtifactsByRepo(
-		ctx context.Context, registryID int64, batchSize int, artifactID int64,
+		ctx context.Context, registryID int64, batchSize int, artifactID int64, batchTimeout time.Duration,
 	) (*[]types.ArtifactMetadata, error)
 
 	GetArtifactsByRepoAndImageBatch(
@@ -625,12 +672,24 @@ type ArtifactRepository interface {
 		ctx context.Context, regID int64, name string,
 	) (int64, error)
 
+	SearchPackagesByImageName(
+		ctx context.Context, regID int64, name string, includePrerelease bool,
+		limit int, offset int,
+	) (*[]types.ArtifactMetadata, error)
+
+	CountPackagesByImageName(
+		ctx context.Context, regID int64, name string, includePrerelease bool,
+	) (int64, error)
+
 	// DuplicateArtifact creates a copy of an artifact with a different image ID and created by user
 	DuplicateArtifact(
 		ctx context.Context, sourceArtifact *types.Artifact, targetImageID int64,
 	) (*types.Artifact, error)
 }
 
+// DownloadStatRepository records the downloads of artifacts. Depending on the mode of the registry a download is
+// stored as a record of its own or only increments the counter of the version for the day, the counts returned
+// cover both.
 type DownloadStatRepository interface {
 	Create(ctx context.Context, downloadStat *types.DownloadStat) error
 	GetTotalDownloadsForImage(ctx context.Context, imageID int64) (int64, error)
@@ -647,10 +706,44 @@ type DownloadStatRepository interface {
 		artifactType *artifact.ArtifactType,
 	) error
 	GetTotalDownloadsForArtifactID(ctx context.Context, artifactID int64) (int64, error)
+	// GetTimeSeries returns the downloads of each version of the image from from until to, which is excluded,
+	// counted by the periods of interval and ordered by version and start.
+	GetTimeSeries(
+		ctx context.Context,
+		imageID int64,
+		from time.Time,
+		to time.Time,
+		interval types.DownloadStatInterval,
+	) ([]types.DownloadStatBucket, error)
+	// RollUp adds the downloads recorded since the previous roll up and before the day of until to the daily
+	// counters, it returns the number of counters written. It must be called in a transaction.
+	RollUp(ctx context.Context, until time.Time) (int64, error)
+	// CreatePartitions creates the download stats partitions of the month of now and of the following months.
+	// It must be called in a transaction.
+	CreatePartitions(ctx context.Context, now time.Time) error
+}
+
+// DownloadStatModeResolver returns how the downloads of a registry are recorded.
+type DownloadStatModeResolver interface {
+	DownloadStatMode(ctx context.Context, registryID int64) (types.DownloadStatMode, error)
 }
 
 type BandwidthStatRepository interface {
 	Create(ctx context.Context, bandwidthStat *types.BandwidthStat) error
+	// GetTotalBytesByRegistry returns the bytes of the given type transferred from the registry since the given time.
+	GetTotalBytesByRegistry(
+		ctx context.Context, registryID int64, bandwidthType types.BandwidthType, since time.Time,
+	) (int64, error)
+	// GetTotalBytesByRegistries returns the bytes of the given type transferred from each of the registries
+	// since the given time.
+	GetTotalBytesByRegistries(
+		ctx context.Context, registryIDs []int64, bandwidthType types.BandwidthType, since time.Time,
+	) (map[int64]int64, error)
+	// GetTopImagesByRegistry returns the images with the most bytes of the given type transferred since the
+	// given time.
+	GetTopImagesByRegistry(
+		ctx context.Context, registryID int64, bandwidthType types.BandwidthType, since time.Time, limit int,
+	) ([]types.ImageUsage, error)
 }
 
 type GCBlobTaskRepository interface {
@@ -705,6 +798,8 @@ type NodesRepository interface {
 	CountByPathAndRegistryID(
 		ctx context.Context, registryID int64, path string,
 	) (int64, error)
+	// GetRegistryIDsByBlobID returns the IDs of the registries with a file of the generic blob.
+	GetRegistryIDsByBlobID(ctx context.Context, blobID string) ([]int64, error)
 	// Create a node
 	Create(ctx context.Context, node *types.Node) error
 	// delete a node
@@ -748,6 +843,7 @@ type GenericBlobRepository interface {
 		rootParentID int64,
 	) (*types.GenericBlob, error)
 	Create(ctx context.Context, gb *types.GenericBlob) (string, bool, error)
+	// DeleteByID deletes the blob if no file references it anymore.
 	DeleteByID(ctx context.Context, id string) error
 	TotalSizeByRootParentID(ctx context.Context, id int64) (int64, error)
 }
@@ -812,6 +908,10 @@ type WebhooksExecutionRepository interface {
 
 	// ListForTrigger lists the webhook executions for a given trigger id.
 	ListForTrigger(ctx context.Context, triggerID string) ([]*gitnesstypes.WebhookExecutionCore, error)
+
+	// PurgePayloads drops the request bodies of at most limit executions created before the given time (in
+	// milliseconds), the executions can't be retriggered afterward. It returns the number of executions purged.
+	PurgePayloads(ctx context.Context, createdBefore int64, limit int) (int64, error)
 }
 
 type PackageTagRepository interface {
@@ -847,8 +947,393 @@ type TaskSourceRepository interface {
 
 	ClaimSources(ctx context.Context, key string, runID string) error
 
+	// FindByRunID returns the sources claimed by a run.
+	FindByRunID(ctx context.Context, runID string) ([]types.TaskSource, error)
+
 	UpdateSourceStatus(ctx context.Context, runID string, status types.TaskStatus, errMsg string) error
 }
+type IndexBuildRepository interface {
+	Find(ctx context.Context, id int64) (*types.IndexBuild, error)
+
+	// Create creates a new index build entry.
+	Create(ctx context.Context, build *types.IndexBuild) error
+
+	// Complete sets the final status of an index build.
+	Complete(
+		ctx context.Context, id int64, status types.TaskStatus, errMsg string, duration time.Duration,
+	) error
+
+	// ListForRegistry lists the index builds of a registry, latest first.
+	ListForRegistry(
+		ctx context.Context,
+		registryID int64,
+		limit int,
+		page int,
+		size int,
+	) ([]*types.IndexBuild, error)
+
+	CountForRegistry(ctx context.Context, registryID int64) (int64, error)
+}
+
+type ArtifactMetadataHistoryRepository interface {
+	// Create records a change of the metadata of an artifact, its revision is set on the change.
+	Create(ctx context.Context, change *types.ArtifactMetadataChange) error
+
+	// ListForArtifact lists the metadata changes of an artifact, latest first.
+	ListForArtifact(
+		ctx context.Context,
+		artifactID int64,
+		limit int,
+		offset int,
+	) ([]*types.ArtifactMetadataChange, error)
+
+	CountForArtifact(ctx context.Context, artifactID int64) (int64, error)
+}
+
+// ArtifactProvenanceRepository keeps the pipeline executions which published the artifacts.
+type ArtifactProvenanceRepository interface {
+	// Upsert stores the provenance of an artifact, it replaces the stored one of the artifact.
+	Upsert(ctx context.Context, provenance *types.ArtifactProvenance) error
+
+	Find(ctx context.Context, artifactID int64) (*types.ArtifactProvenance, error)
+
+	// ListArtifacts lists the artifacts of the registries of the space whose provenance matches the fields of the
+	// pipeline execution which are set, latest first.
+	ListArtifacts(
+		ctx context.Context, spaceID int64, pipeline types.PipelineExecution, limit int, offset int,
+	) ([]*types.ProvenanceArtifact, error)
+
+	CountArtifacts(ctx context.Context, spaceID int64, pipeline types.PipelineExecution) (int64, error)
+}
+
+// UpstreamProvenanceRepository keeps where the files cached by the upstream proxies were fetched from.
+type UpstreamProvenanceRepository interface {
+	// Record stores the provenance of a file of an artifact, it's ignored if the provenance of the file is already
+	// stored so the first one is kept.
+	Record(ctx context.Context, provenance *types.UpstreamProvenance) error
+
+	// ListByArtifact lists the provenance of the files of an artifact, the first cached first.
+	ListByArtifact(ctx context.Context, artifactID int64) ([]*types.UpstreamProvenance, error)
+}
+
+// ArtifactSearchRepository keeps the full-text search documents of the artifacts, built from their metadata.
+type ArtifactSearchRepository interface {
+	// Index builds the search document of an artifact from its metadata, it replaces the stored one.
+	Index(ctx context.Context, artifactID int64, metadata json.RawMessage) error
+
+	// IndexMissing indexes up to limit artifacts which have no search document yet, like the ones stored before
+	// search was added, and returns how many were indexed.
+	IndexMissing(ctx context.Context, limit int) (int, error)
+
+	// Search lists the versions whose documents match the query, the best match first.
+	Search(ctx context.Context, query types.ArtifactSearchQuery) ([]*types.ArtifactSearchResult, error)
+
+	Count(ctx context.Context, query types.ArtifactSearchQuery) (int64, error)
+}
+
+type ImageDescriptionRepository interface {
+	// Create records an edit of the description of an image, its revision is set on the description.
+	Create(ctx context.Context, description *types.ImageDescription) error
+
+	// GetLatest returns the current description of an image.
+	GetLatest(ctx context.Context, imageID int64) (*types.ImageDescription, error)
+
+	// ListForImage lists the revisions of the description of an image, latest first.
+	ListForImage(ctx context.Context, imageID int64, limit int, offset int) ([]*types.ImageDescription, error)
+
+	CountForImage(ctx context.Context, imageID int64) (int64, error)
+}
+
+// ImageStarRepository keeps the stars principals give to images. The number of stars of an image is maintained
+// on the image itself, Star and Unstar must run in a transaction to keep it in sync.
+type ImageStarRepository interface {
+	// Star stars the image for the principal, it returns false if the principal had already starred it.
+	Star(ctx context.Context, imageID int64, principalID int64) (bool, error)
+
+	// Unstar removes the star of the principal from the image, it returns false if there was none.
+	Unstar(ctx context.Context, imageID int64, principalID int64) (bool, error)
+
+	IsStarred(ctx context.Context, imageID int64, principalID int64) (bool, error)
+
+	// GetStarCount returns the number of principals which starred the image.
+	GetStarCount(ctx context.Context, imageID int64) (int64, error)
+
+	// GetStarCountsByImageNames returns the number of stars of the images of the registry by image name.
+	GetStarCountsByImageNames(ctx context.Context, registryID int64, imageNames []string) (map[string]int64, error)
+
+	// ListByPrincipal lists the images starred by the principal, the most recently starred first.
+	ListByPrincipal(ctx context.Context, principalID int64, limit int, offset int) ([]*types.StarredImage, error)
+
+	CountByPrincipal(ctx context.Context, principalID int64) (int64, error)
+}
+
+// RecentActivityRepository keeps the recent interactions of principals with images, the latest one of each kind
+// per image.
+type RecentActivityRepository interface {
+	// Upsert records the interaction, the image is found by its registry, name and artifact type. An interaction
+	// of the same kind with the same image replaces the previous one. Nothing is recorded if the image doesn't exist.
+	Upsert(ctx context.Context, activity *types.RecentActivity) error
+
+	// Trim deletes all but the keep latest interactions of the kind of the principal.
+	Trim(ctx context.Context, principalID int64, kind types.RecentActivityKind, keep int) error
+
+	// ListForPrincipal lists the interactions of the principal, the latest first. All kinds are listed if kind is nil.
+	ListForPrincipal(
+		ctx context.Context, principalID int64, kind *types.RecentActivityKind, limit int,
+	) ([]*types.RecentActivity, error)
+}
+
+// DeletionRequestRepository keeps the requests to delete artifacts which wait for the approval of a second user.
+type DeletionRequestRepository interface {
+	// Create stores a pending request, it fails with store.ErrDuplicate if the artifact or version already has one.
+	Create(ctx context.Context, request *types.DeletionRequest) error
+
+	Find(ctx context.Context, id int64) (*types.DeletionRequest, error)
+
+	// FindPending returns the pending request to delete the version of the image, the version is empty for
+	// requests to delete the whole image.
+	FindPending(ctx context.Context, registryID int64, imageName string, version string) (*types.DeletionRequest, error)
+
+	// List lists the requests of the registry, newest first. All states are listed if state is nil.
+	List(
+		ctx context.Context, registryID int64, state *types.DeletionRequestState, limit int,
+	) ([]*types.DeletionRequest, error)
+
+	// UpdateState moves the request from the state to another one. It fails with store.ErrResourceNotFound if the
+	// request isn't in the from state anymore, so concurrent reviews can't both succeed.
+	UpdateState(
+		ctx context.Context, request *types.DeletionRequest, from types.DeletionRequestState,
+	) error
+
+	// ExpireStale marks the pending requests of the registry which expired before now as expired.
+	ExpireStale(ctx context.Context, registryID int64, now int64) (int64, error)
+}
+
+// ScheduledDeletionRepository keeps the deletions of artifacts which are executed later.
+type ScheduledDeletionRepository interface {
+	// Create stores a scheduled deletion, it fails with store.ErrDuplicate if the artifact or version already has
+	// one.
+	Create(ctx context.Context, deletion *types.ScheduledDeletion) error
+
+	Find(ctx context.Context, id int64) (*types.ScheduledDeletion, error)
+
+	// FindScheduled returns the deletion of the version of the image which waits for its execution, the version is
+	// empty for deletions of the whole image.
+	FindScheduled(
+		ctx context.Context, registryID int64, imageName string, version string,
+	) (*types.ScheduledDeletion, error)
+
+	// List lists the deletions of the registry, newest first. All states are listed if state is nil.
+	List(
+		ctx context.Context, registryID int64, state *types.ScheduledDeletionState, limit int,
+	) ([]*types.ScheduledDeletion, error)
+
+	// ListDue lists the scheduled deletions of all registries whose execution is due at now, oldest first.
+	ListDue(ctx context.Context, now int64, limit int) ([]*types.ScheduledDeletion, error)
+
+	// UpdateState moves the deletion from the state to another one. It fails with store.ErrResourceNotFound if the
+	// deletion isn't in the from state anymore, so a deletion can't be canceled once its execution started.
+	UpdateState(ctx context.Context, deletion *types.ScheduledDeletion, from types.ScheduledDeletionState) error
+}
+
+// PackageDenylistEntryRepository keeps the packages denied in the registries of spaces.
+type PackageDenylistEntryRepository interface {
+	// Create stores an entry, it fails with store.ErrDuplicate if the space already denies the version range of
+	// the package.
+	Create(ctx context.Context, entry *types.PackageDenylistEntry) error
+
+	Find(ctx context.Context, id int64) (*types.PackageDenylistEntry, error)
+
+	// List lists the entries of the space, ordered by name. Only the entries whose name contains search are
+	// listed if it's set.
+	List(
+		ctx context.Context, spaceID int64, search string, limit int, offset int,
+	) ([]*types.PackageDenylistEntry, error)
+
+	Count(ctx context.Context, spaceID int64, search string) (int64, error)
+
+	// ListForPackage lists the entries of the spaces for the package of the package type which aren't
+	// overridden for the registry.
+	ListForPackage(
+		ctx context.Context, spaceIDs []int64, registryID int64, packageType string, name string,
+	) ([]*types.PackageDenylistEntry, error)
+
+	Delete(ctx context.Context, id int64) error
+}
+
+// PackageDenylistOverrideRepository keeps the registries exempted from denylist entries.
+type PackageDenylistOverrideRepository interface {
+	// Create stores an override, it fails with store.ErrDuplicate if the registry is already exempted from the
+	// entry.
+	Create(ctx context.Context, override *types.PackageDenylistOverride) error
+
+	Find(ctx context.Context, entryID int64, registryID int64) (*types.PackageDenylistOverride, error)
+
+	// List lists the overrides of the registry, newest first.
+	List(ctx context.Context, registryID int64) ([]*types.PackageDenylistOverride, error)
+
+	Delete(ctx context.Context, entryID int64, registryID int64) error
+}
+
+// FirewallApprovalRepository keeps the versions let through the firewall delay of upstream proxies.
+type FirewallApprovalRepository interface {
+	// Create stores an approval, it fails with store.ErrDuplicate if the version is already approved.
+	Create(ctx context.Context, approval *types.FirewallApproval) error
+
+	// List lists the approvals of the registry, newest first.
+	List(ctx context.Context, registryID int64) ([]*types.FirewallApproval, error)
+
+	ListByPackage(ctx context.Context, registryID int64, pkg string) ([]*types.FirewallApproval, error)
+
+	Delete(ctx context.Context, registryID int64, pkg string, version string) error
+}
+
+// VulnerabilityRepository keeps the vulnerabilities synced from OSV and the versions of packages they affect.
+type VulnerabilityRepository interface {
+	// Upsert stores a vulnerability, it replaces the stored one with the same ID.
+	Upsert(ctx context.Context, vulnerability *types.Vulnerability) error
+
+	Find(ctx context.Context, id string) (*types.Vulnerability, error)
+
+	// ListCandidates lists the versions of the packages of the package types with an artifact ID above
+	// afterArtifactID, ordered by artifact ID.
+	ListCandidates(
+		ctx context.Context, packageTypes []string, afterArtifactID int64, limit int,
+	) ([]*types.VulnerabilityCandidate, error)
+
+	// ReplaceMatches sets the vulnerabilities which affect the artifact, and returns the IDs of the ones which
+	// didn't affect it before.
+	ReplaceMatches(ctx context.Context, artifactID int64, vulnerabilityIDs []string) ([]string, error)
+
+	// ListVulnerableVersions lists the versions stored in the registries of the space which are affected by
+	// vulnerabilities of the severities, ordered by package name and version. Only the versions whose package
+	// name contains search are listed if it's set.
+	ListVulnerableVersions(
+		ctx context.Context,
+		spaceID int64,
+		severities []types.VulnerabilitySeverity,
+		search string,
+		limit int,
+		offset int,
+	) ([]*types.VulnerableVersion, error)
+
+	CountVulnerableVersions(
+		ctx context.Context, spaceID int64, severities []types.VulnerabilitySeverity, search string,
+	) (int64, error)
+
+	// ListForArtifacts lists the vulnerabilities of the severities which affect the artifacts, keyed by
+	// artifact ID.
+	ListForArtifacts(
+		ctx context.Context, artifactIDs []int64, severities []types.VulnerabilitySeverity,
+	) (map[int64][]*types.Vulnerability, error)
+}
+
+type EventOutboxRepository interface {
+	// Create stores an event, it's written within the transaction of the context if there is one.
+	Create(ctx context.Context, event *types.OutboxEvent) error
+
+	// ListDue lists the events whose next attempt is due, oldest first.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*types.OutboxEvent, error)
+
+	// Claim postpones the next attempt of an event to leaseUntil and counts the attempt. It returns false when
+	// the event was claimed or deleted by somebody else since it was read.
+	Claim(ctx context.Context, event *types.OutboxEvent, leaseUntil time.Time) (bool, error)
+
+	// Fail records the error of the last attempt of an event and when to retry it.
+	Fail(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error
+
+	Delete(ctx context.Context, id int64) error
+}
+
+type FailedUploadRepository interface {
+	Create(ctx context.Context, upload *types.FailedUpload) error
+
+	// GetByUUID returns the failed upload of a registry, expired uploads are found until they are purged.
+	GetByUUID(ctx context.Context, registryID int64, uuid string) (*types.FailedUpload, error)
+
+	// ListForRegistry lists the failed uploads of a registry, latest first.
+	ListForRegistry(ctx context.Context, registryID int64, limit int, offset int) ([]*types.FailedUpload, error)
+
+	CountForRegistry(ctx context.Context, registryID int64) (int64, error)
+
+	// ListExpired lists the failed uploads of all registries which expired before the given time.
+	ListExpired(ctx context.Context, before time.Time, limit int) ([]*types.FailedUpload, error)
+
+	Delete(ctx context.Context, id int64) error
+}
+
+// RegistryJobRepository stores the long-running operations of registries.
+type RegistryJobRepository interface {
+	Create(ctx context.Context, job *types.RegistryJob) error
+
+	GetByUUID(ctx context.Context, registryID int64, uuid string) (*types.RegistryJob, error)
+
+	// ListForRegistry lists the jobs of a registry, latest first.
+	ListForRegistry(ctx context.Context, registryID int64, limit int, offset int) ([]*types.RegistryJob, error)
+
+	CountForRegistry(ctx context.Context, registryID int64) (int64, error)
+
+	// Update stores the state, progress, log and timestamps of a job unless it was completed meanwhile,
+	// false is returned then.
+	Update(ctx context.Context, job *types.RegistryJob) (bool, error)
+}
+
+// RegistryUsageSnapshotRepository persists the daily registry usage of spaces.
+type RegistryUsageSnapshotRepository interface {
+	// Upsert stores the snapshots, a snapshot replaces the one of the same space and day.
+	Upsert(ctx context.Context, snapshots []types.RegistryUsageSnapshot) error
+
+	// List lists the snapshots of the days in [from, to) of a space, oldest first.
+	List(ctx context.Context, spaceID int64, from time.Time, to time.Time) ([]types.RegistryUsageSnapshot, error)
+}
+
+// RegistryStatsRepository maintains the precomputed statistics of registries and of their images
+// the dashboards read instead of aggregating the artifacts and download stats on each request.
+type RegistryStatsRepository interface {
+	// Refresh recomputes the statistics of the registries and of all their images.
+	Refresh(ctx context.Context, registryIDs []int64) error
+
+	// ListRegistryIDs lists up to limit IDs of registries greater than afterID, ordered by ID.
+	ListRegistryIDs(ctx context.Context, afterID int64, limit int) ([]int64, error)
+}
+
+// UploadFailureStatsRepository counts the failed uploads per registry, package type and error class by day.
+type UploadFailureStatsRepository interface {
+	// Increment counts a failed upload in the day of failedAt.
+	Increment(
+		ctx context.Context,
+		registryID int64,
+		packageType artifact.PackageType,
+		errorClass types.UploadErrorClass,
+		failedAt time.Time,
+	) error
+
+	// GetStats sums the counts of the days in [from, to) of the registries of an account.
+	GetStats(ctx context.Context, rootParentID int64, from time.Time, to time.Time) ([]types.UploadFailureStat, error)
+
+	// DeleteBefore deletes the counts of the days before the given time.
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// GarbageRepository reports the soft-deleted rows which wait to be purged.
+type GarbageRepository interface {
+	// GetStats groups the soft-deleted tags and manifests by account and age, all accounts are reported when
+	// rootParentID is 0.
+	GetStats(ctx context.Context, rootParentID int64, now time.Time) ([]types.GarbageStat, error)
+}
+
+type NotificationChannelRepository interface {
+	Create(ctx context.Context, channel *types.NotificationChannel) error
+	Update(ctx context.Context, channel *types.NotificationChannel) error
+	GetByRegistryAndIdentifier(
+		ctx context.Context,
+		registryID int64,
+		identifier string,
+	) (*types.NotificationChannel, error)
+	ListByRegistry(ctx context.Context, registryID int64) ([]*types.NotificationChannel, error)
+	DeleteByRegistryAndIdentifier(ctx context.Context, registryID int64, identifier string) error
+}
+
 type TaskEventRepository interface {
 	LogTaskEvent(ctx context.Context, key string, event string, payload []byte) error
 }
@@ -867,3 +1352,13 @@ type QuarantineArtifactRepository interface {
 		artifactID *int64, imageID int64, nodeID *string,
 	) error
 }
+
+// QuarantineAccessAttemptRepository keeps the pulls of quarantined artifacts which were blocked.
+type QuarantineAccessAttemptRepository interface {
+	Create(ctx context.Context, attempt *types.QuarantineAccessAttempt) error
+
+	// GetSummary sums up the blocked pulls of the version of the image.
+	GetSummary(
+		ctx context.Context, registryID int64, imageName string, version string,
+	) (*types.QuarantineAccessSummary, error)
+}
@@ -20,13 +20,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	metadatapkg "github.com/harness/gitness/registry/app/metadata"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/app/store/database/util"
+	registryrequest "github.com/harness/gitness/registry/request"
 	"github.com/harness/gitness/registry/types"
 	gitness_store "github.com/harness/gitness/store"
 	databaseg "github.com/harness/gitness/store/database"
@@ -40,12 +43,22 @@ import (
 )
 
 type ArtifactDao struct {
-	db *sqlx.DB
+	db         *sqlx.DB
+	tx         dbtx.Transactor
+	history    ArtifactMetadataHistoryDao
+	provenance ArtifactProvenanceDao
+	search     ArtifactSearchDao
+	limits     util.Limits
 }
 
-func NewArtifactDao(db *sqlx.DB) store.ArtifactRepository {
+func NewArtifactDao(db *sqlx.DB, tx dbtx.Transactor, limits util.Limits) store.ArtifactRepository {
 	return &ArtifactDao{
-		db: db,
+		db:         db,
+		tx:         tx,
+		history:    ArtifactMetadataHistoryDao{db: db, limits: limits},
+		provenance: ArtifactProvenanceDao{db: db, limits: limits},
+		search:     ArtifactSearchDao{db: db, limits: limits},
+		limits:     limits,
 	}
 }
 
@@ -61,13 +74,17 @@ type artifactDB struct {
 	UpdatedBy int64            `db:"artifact_updated_by"`
 }
 
-func (a ArtifactDao) GetByUUID(ctx context.Context, uuid string) (*types.Artifact, error) {
+func (a ArtifactDao) GetByUUID(
+	ctx context.Context, uuid string, opts ...types.QueryOption,
+) (*types.Artifact, error) {
+	options := types.MakeQueryOptions(opts...)
 	stmt := databaseg.Builder.
-		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(artifactDB{}), ",")).
+		Select(util.SelectColumns(artifactDB{}, "artifact_metadata", options)).
 		From("artifacts").
 		Where("artifact_uuid = ?", uuid)
+	stmt = util.ApplyQueryOptions(stmt, a.db.DriverName(), options)
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	dst := new(artifactDB)
 	sql, args, err := stmt.ToSql()
@@ -82,17 +99,19 @@ func (a ArtifactDao) GetByUUID(ctx context.Context, uuid string) (*types.Artifac
 	return a.mapToArtifact(ctx, dst)
 }
 
-func (a ArtifactDao) Get(ctx context.Context, id int64) (*types.Artifact, error) {
-	q := databaseg.Builder.Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(artifactDB{}), ",")).
+func (a ArtifactDao) Get(ctx context.Context, id int64, opts ...types.QueryOption) (*types.Artifact, error) {
+	options := types.MakeQueryOptions(opts...)
+	q := databaseg.Builder.Select(util.SelectColumns(artifactDB{}, "artifact_metadata", options)).
 		From("artifacts").
 		Where("artifact_id = ?", id)
+	q = util.ApplyQueryOptions(q, a.db.DriverName(), options)
 
 	sql, args, err := q.ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	dst := new(artifactDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -101,17 +120,21 @@ func (a ArtifactDao) Get(ctx context.Context, id int64) (*types.Artifact, error)
 	return a.mapToArtifact(ctx, dst)
 }
 
-func (a ArtifactDao) GetByName(ctx context.Context, imageID int64, version string) (*types.Artifact, error) {
-	q := databaseg.Builder.Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(artifactDB{}), ",")).
+func (a ArtifactDao) GetByName(
+	ctx context.Context, imageID int64, version string, opts ...types.QueryOption,
+) (*types.Artifact, error) {
+	options := types.MakeQueryOptions(opts...)
+	q := databaseg.Builder.Select(util.SelectColumns(artifactDB{}, "artifact_metadata", options)).
 		From("artifacts").
 		Where("artifact_image_id = ? AND artifact_version = ?", imageID, version)
+	q = util.ApplyQueryOptions(q, a.db.DriverName(), options)
 
 	sql, args, err := q.ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	dst := new(artifactDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -121,21 +144,23 @@ func (a ArtifactDao) GetByName(ctx context.Context, imageID int64, version strin
 }
 
 func (a ArtifactDao) GetByRegistryImageAndVersion(
-	ctx context.Context, registryID int64, image string, version string,
+	ctx context.Context, registryID int64, image string, version string, opts ...types.QueryOption,
 ) (*types.Artifact, error) {
-	q := databaseg.Builder.Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(artifactDB{}), ",")).
+	options := types.MakeQueryOptions(opts...)
+	q := databaseg.Builder.Select(util.SelectColumns(artifactDB{}, "artifact_metadata", options)).
 		From("artifacts a").
 		Join("images i ON a.artifact_image_id = i.image_id").
 		Where("i.image_registry_id = ?", registryID).
 		Where("i.image_name = ?", image).
 		Where("a.artifact_version = ?", version)
+	q = util.ApplyQueryOptions(q, a.db.DriverName(), options)
 
 	sql, args, err := q.ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	dst := new(artifactDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -146,21 +171,24 @@ func (a ArtifactDao) GetByRegistryImageAndVersion(
 
 func (a ArtifactDao) GetByRegistryImageVersionAndArtifactType(
 	ctx context.Context, registryID int64, image string, version string, artifactType string,
+	opts ...types.QueryOption,
 ) (*types.Artifact, error) {
-	q := databaseg.Builder.Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(artifactDB{}), ",")).
+	options := types.MakeQueryOptions(opts...)
+	q := databaseg.Builder.Select(util.SelectColumns(artifactDB{}, "artifact_metadata", options)).
 		From("artifacts a").
 		Join("images i ON a.artifact_image_id = i.image_id").
 		Where("i.image_registry_id = ?", registryID).
 		Where("i.image_name = ?", image).
 		Where("i.image_type = ?", artifactType).
 		Where("a.artifact_version = ?", version)
+	q = util.ApplyQueryOptions(q, a.db.DriverName(), options)
 
 	sql, args, err := q.ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	dst := new(artifactDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -169,22 +197,23 @@ func (a ArtifactDao) GetByRegistryImageVersionAndArtifactType(
 	return a.mapToArtifact(ctx, dst)
 }
 
-func (a ArtifactDao) GetByRegistryIDAndImage(ctx context.Context, registryID int64, image string) (
-	*[]types.Artifact,
-	error,
-) {
-	q := databaseg.Builder.Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(artifactDB{}), ",")).
+func (a ArtifactDao) GetByRegistryIDAndImage(
+	ctx context.Context, registryID int64, image string, opts ...types.QueryOption,
+) (*[]types.Artifact, error) {
+	options := types.MakeQueryOptions(opts...)
+	q := databaseg.Builder.Select(util.SelectColumns(artifactDB{}, "artifact_metadata", options)).
 		From("artifacts a").
 		Join("images i ON a.artifact_image_id = i.image_id").
 		Where("i.image_registry_id = ? AND i.image_name = ? AND i.image_type IS NULL", registryID, image).
 		OrderBy("a.artifact_created_at DESC")
+	q = util.ApplyQueryOptions(q, a.db.DriverName(), options)
 
 	sql, args, err := q.ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	dst := []artifactDB{}
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -214,7 +243,7 @@ func (a ArtifactDao) GetLatestByImageID(ctx context.Context, imageID int64) (*ty
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	dst := new(artifactDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -249,18 +278,37 @@ func (a ArtifactDao) CreateOrUpdate(ctx context.Context, artifact *types.Artifac
 						,:artifact_uuid
 		    ) 
             ON CONFLICT (artifact_image_id, artifact_version)
-		    DO UPDATE SET artifact_metadata = :artifact_metadata
+		    DO UPDATE SET artifact_metadata = :artifact_metadata, artifact_deleted_at = NULL
             RETURNING artifact_id`
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 	query, arg, err := db.BindNamed(sqlQuery, a.mapToInternalArtifact(ctx, artifact))
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind artifact object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&artifact.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	if err = db.GetContext(ctx, &artifact.ID, query, arg...); err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
 	}
+
+	// uploads made by a CI pipeline link the version to the execution which published it.
+	if pipeline := registryrequest.PipelineExecutionFrom(ctx); pipeline != nil && artifact.ID != 0 {
+		err = a.provenance.Upsert(ctx, &types.ArtifactProvenance{
+			ArtifactID: artifact.ID,
+			Pipeline:   *pipeline,
+			CreatedBy:  artifact.UpdatedBy,
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// the search document follows the metadata of the version.
+	if artifact.ID != 0 {
+		if err = a.search.Index(ctx, artifact.ID, artifact.Metadata); err != nil {
+			return 0, err
+		}
+	}
 	return artifact.ID, nil
 }
 
@@ -273,10 +321,10 @@ func (a ArtifactDao) Count(ctx context.Context) (int64, error) {
 		return 0, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -313,7 +361,7 @@ func (a ArtifactDao) DeleteByImageNameAndRegistryID(ctx context.Context, regID i
 			Where("i.image_name = ? AND i.image_registry_id = ?", image, regID)
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	delQuery, delArgs, err := delStmt.ToSql()
 	if err != nil {
@@ -351,7 +399,7 @@ func (a ArtifactDao) DeleteByVersionAndImageName(
 		return errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	_, err = db.ExecContext(ctx, sql, args...)
 	if err != nil {
@@ -361,6 +409,33 @@ func (a ArtifactDao) DeleteByVersionAndImageName(
 	return nil
 }
 
+// SoftDeleteByVersionAndImageName marks the version of the image as deleted along with its soft-deleted manifest, it
+// is deleted once the manifest is purged and restored when the manifest is pushed again or restored.
+func (a ArtifactDao) SoftDeleteByVersionAndImageName(
+	ctx context.Context, image string,
+	version string, regID int64,
+) error {
+	stmt := databaseg.Builder.Update("artifacts").
+		Set("artifact_deleted_at", time.Now().UnixMilli()).
+		Where("artifact_version = ? AND artifact_deleted_at IS NULL", version).
+		Where("artifact_image_id IN (SELECT image_id FROM images WHERE image_name = ? AND image_registry_id = ?)",
+			image, regID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := util.GetAccessor(ctx, a.db, a.limits)
+
+	_, err = db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the soft delete query failed")
+	}
+
+	return nil
+}
+
 func (a ArtifactDao) mapToInternalArtifact(ctx context.Context, in *types.Artifact) *artifactDB {
 	session, _ := request.AuthSessionFrom(ctx)
 
@@ -440,7 +515,7 @@ func (a ArtifactDao) SearchLatestByName(
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to build SQL for latest artifact metadata with pagination")
 	}
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	var metadataList []*artifactDB
 	if err := db.SelectContext(ctx, &metadataList, sql, args...); err != nil {
@@ -479,7 +554,7 @@ func (a ArtifactDao) CountLatestByName(
 		return 0, errors.Wrap(err, "Failed to build count SQL")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	var count int64
 	if err := db.GetContext(ctx, &count, sql, args...); err != nil {
@@ -512,7 +587,7 @@ func (a ArtifactDao) SearchByImageName(
 		return nil, errors.Wrap(err, "Failed to build SQL for"+
 			" artifact metadata with pagination")
 	}
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	var dst []*artifactMetadataDB
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -538,7 +613,7 @@ func (a ArtifactDao) CountByImageName(
 		return 0, errors.Wrap(err, "Failed to build count SQL")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	var count int64
 	if err := db.GetContext(ctx, &count, sql, args...); err != nil {
@@ -548,6 +623,89 @@ func (a ArtifactDao) CountByImageName(
 	return count, nil
 }
 
+// SearchPackagesByImageName returns all versions of the images matching the name, paginating over
+// images rather than versions. Pre-release versions are skipped unless includePrerelease is set.
+func (a ArtifactDao) SearchPackagesByImageName(
+	ctx context.Context, regID int64, name string, includePrerelease bool,
+	limit int, offset int,
+) (*[]types.ArtifactMetadata, error) {
+	// the subquery keeps ? placeholders, they're numbered along with the ones of the outer query.
+	imageQuery := sq.Select("i.image_id").
+		From("images i").
+		Join("artifacts a ON a.artifact_image_id = i.image_id").
+		Where("i.image_registry_id = ?", regID)
+	imageQuery = withPackageSearchFilters(imageQuery, name, includePrerelease).
+		GroupBy("i.image_id", "i.image_name").
+		OrderBy("i.image_name ASC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+	imageSQL, imageArgs, err := imageQuery.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to build SQL for package search")
+	}
+
+	q := databaseg.Builder.Select(
+		`i.image_name as name,
+        a.artifact_id as artifact_id, a.artifact_version as version, a.artifact_metadata as metadata`,
+	).
+		From("artifacts a").
+		Join("images i ON a.artifact_image_id = i.image_id").
+		Join(fmt.Sprintf("(%s) p ON p.image_id = i.image_id", imageSQL), imageArgs...)
+	q = withPackageSearchFilters(q, "", includePrerelease).
+		OrderBy("i.image_name ASC, a.artifact_version ASC")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to build SQL for package search")
+	}
+	db := util.GetAccessor(ctx, a.db, a.limits)
+
+	var dst []*artifactMetadataDB
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to search packages")
+	}
+	return a.mapToArtifactMetadataList(dst)
+}
+
+// CountPackagesByImageName counts the images matching the name which have at least one version
+// visible with the given pre-release filter.
+func (a ArtifactDao) CountPackagesByImageName(
+	ctx context.Context, regID int64, name string, includePrerelease bool,
+) (int64, error) {
+	q := databaseg.Builder.
+		Select("COUNT(DISTINCT i.image_id)").
+		From("images i").
+		Join("artifacts a ON a.artifact_image_id = i.image_id").
+		Where("i.image_registry_id = ?", regID)
+	q = withPackageSearchFilters(q, name, includePrerelease)
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "Failed to build count SQL")
+	}
+
+	db := util.GetAccessor(ctx, a.db, a.limits)
+
+	var count int64
+	if err := db.GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count packages")
+	}
+
+	return count, nil
+}
+
+// withPackageSearchFilters restricts q to images matching the name and, unless includePrerelease is set,
+// to versions without a SemVer pre-release label.
+func withPackageSearchFilters(q sq.SelectBuilder, name string, includePrerelease bool) sq.SelectBuilder {
+	if name != "" {
+		q = q.Where("LOWER(i.image_name) LIKE ?", sqlPartialMatch(strings.ToLower(name)))
+	}
+	if !includePrerelease {
+		q = q.Where("a.artifact_version NOT LIKE ?", "%-%")
+	}
+	return q
+}
+
 func (a ArtifactDao) GetAllArtifactsByParentID(
 	ctx context.Context,
 	parentID int64,
@@ -567,34 +725,17 @@ func (a ArtifactDao) GetAllArtifactsByParentID(
 		a.artifact_version as version, 
 		a.artifact_updated_at as modified_at, 
 		i.image_labels as labels, 
-		a.artifact_metadata as metadata,
-		COALESCE(t2.download_count,0) as download_count `,
+		a.artifact_metadata as metadata`,
 	).
+		Column(imageDownloadCountFromStats+" as download_count").
 		From("artifacts a").
 		Join("images i ON a.artifact_image_id = i.image_id").
 		Join("registries r ON r.registry_id = i.image_registry_id").
-		Where("r.registry_parent_id = ?", parentID).
-		LeftJoin(
-			`( SELECT i.image_id, SUM(COALESCE(t1.download_count, 0)) as download_count FROM 
-			( SELECT a.artifact_image_id, COUNT(d.download_stat_id) as download_count 
-			FROM artifacts a JOIN download_stats d ON d.download_stat_artifact_id = a.artifact_id 
-			GROUP BY a.artifact_image_id ) as t1 
-			JOIN images i ON i.image_id = t1.artifact_image_id 
-			JOIN registries r ON r.registry_id = i.image_registry_id 
-			WHERE r.registry_parent_id = ? GROUP BY i.image_id) as t2 
-			ON i.image_id = t2.image_id`, parentID,
-		)
+		LeftJoin("image_stats s ON s.image_stats_image_id = i.image_id").
+		Where("r.registry_parent_id = ?", parentID)
 
 	if latestVersion {
-		q = q.Join(
-			`(SELECT t.artifact_id as id, ROW_NUMBER() OVER (PARTITION BY t.artifact_image_id
-			ORDER BY t.artifact_updated_at DESC) AS rank FROM artifacts t 
-			JOIN images i ON t.artifact_image_id = i.image_id
-            JOIN registries r ON i.image_registry_id = r.registry_id
-			WHERE r.registry_parent_id = ? ) AS a1 
-			ON a.artifact_id = a1.id`, parentID, // nolint:goconst
-		).
-			Where("a1.rank = 1")
+		q = q.Where(isLatestArtifactFromStats)
 	}
 
 	if len(*registryIDs) > 0 {
@@ -619,7 +760,7 @@ func (a ArtifactDao) GetAllArtifactsByParentID(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	dst := []*artifactMetadataDB{}
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -640,15 +781,8 @@ func (a ArtifactDao) CountAllArtifactsByParentID(
 		Where("r.registry_parent_id = ?", parentID)
 
 	if latestVersion {
-		q = q.Join(
-			`(SELECT t.artifact_id as id, ROW_NUMBER() OVER (PARTITION BY t.artifact_image_id
-			ORDER BY t.artifact_updated_at DESC) AS rank FROM artifacts t 
-			JOIN images i ON t.artifact_image_id = i.image_id
-            JOIN registries r ON i.image_registry_id = r.registry_id
-			WHERE r.registry_parent_id = ? ) AS a1 
-			ON a.artifact_id = a1.id`, parentID, // nolint:goconst
-		).
-			Where("a1.rank = 1")
+		q = q.LeftJoin("image_stats s ON s.image_stats_image_id = i.image_id").
+			Where(isLatestArtifactFromStats)
 	}
 	if len(*registryIDs) > 0 {
 		q = q.Where(sq.Eq{"r.registry_name": registryIDs})
@@ -666,10 +800,10 @@ func (a ArtifactDao) CountAllArtifactsByParentID(
 	if err != nil {
 		return -1, errors.Wrap(err, "Failed to convert query to sql")
 	}
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -679,15 +813,21 @@ func (a ArtifactDao) CountAllArtifactsByParentID(
 func (a ArtifactDao) GetArtifactsByRepo(
 	ctx context.Context, parentID int64, repoKey string, sortByField string,
 	sortByOrder string, limit int, offset int, search string, labels []string,
-	artifactType *artifact.ArtifactType,
+	artifactType *artifact.ArtifactType, includeDownloadCount bool,
 ) (*[]types.ArtifactMetadata, error) {
+	// the download counts are aggregated over all download stats of the registry, which is expensive.
+	includeDownloadCount = includeDownloadCount || sortByField == downloadCount
+	downloadCountColumn := "0 as download_count"
+	if includeDownloadCount {
+		downloadCountColumn = "COALESCE(t2.download_count, 0) as download_count"
+	}
 	q := databaseg.Builder.Select(
 		`r.registry_name as repo_name, i.image_name as name, i.image_uuid as uuid,
 		r.registry_uuid as registry_uuid,
 		r.registry_package_type as package_type, a.artifact_version as latest_version, 
-		a.artifact_updated_at as modified_at, i.image_labels as labels, i.image_type as artifact_type,
-		COALESCE(t2.download_count, 0) as download_count`,
+		a.artifact_updated_at as modified_at, i.image_labels as labels, i.image_type as artifact_type`,
 	).
+		Column(downloadCountColumn).
 		From("artifacts a").
 		Join(
 			`(SELECT a.artifact_id as id, ROW_NUMBER() OVER (PARTITION BY a.artifact_image_id
@@ -698,19 +838,21 @@ func (a ArtifactDao) GetArtifactsByRepo(
 			ON a.artifact_id = a1.id`, parentID, repoKey, // nolint:goconst
 		).
 		Join("images i ON i.image_id = a.artifact_image_id").
-		Join("registries r ON i.image_registry_id = r.registry_id").
-		LeftJoin(
+		Join("registries r ON i.image_registry_id = r.registry_id")
+	if includeDownloadCount {
+		q = q.LeftJoin(
 			`( SELECT i.image_id, SUM(COALESCE(t1.download_count, 0)) as download_count FROM 
-			( SELECT a.artifact_image_id, COUNT(d.download_stat_id) as download_count 
+			( SELECT a.artifact_image_id, SUM(d.download_stat_count) as download_count 
 			FROM artifacts a 
-			JOIN download_stats d ON d.download_stat_artifact_id = a.artifact_id GROUP BY 
+			JOIN download_stat_counts d ON d.download_stat_artifact_id = a.artifact_id GROUP BY 
 			a.artifact_image_id ) as t1 
 			JOIN images i ON i.image_id = t1.artifact_image_id 
 			JOIN registries r ON r.registry_id = i.image_registry_id 
 			WHERE r.registry_parent_id = ? AND r.registry_name = ? GROUP BY i.image_id) as t2 
 			ON i.image_id = t2.image_id`, parentID, repoKey,
-		).
-		Where("a1.rank = 1 ")
+		)
+	}
+	q = q.Where("a1.rank = 1 ")
 
 	if search != "" {
 		q = q.Where("i.image_name LIKE ?", sqlPartialMatch(search))
@@ -741,7 +883,7 @@ func (a ArtifactDao) GetArtifactsByRepo(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	dst := []*artifactMetadataDB{}
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -750,6 +892,39 @@ func (a ArtifactDao) GetArtifactsByRepo(
 	return a.mapToArtifactMetadataList(dst)
 }
 
+func (a ArtifactDao) GetDownloadCountsByImageNames(
+	ctx context.Context, registryID int64, imageNames []string,
+) (map[string]int64, error) {
+	counts := make(map[string]int64, len(imageNames))
+	if len(imageNames) == 0 {
+		return counts, nil
+	}
+
+	q := databaseg.Builder.Select("i.image_name, SUM(d.download_stat_count) AS total").
+		From("images i").
+		Join("artifacts a ON a.artifact_image_id = i.image_id").
+		Join("download_stat_counts d ON d.download_stat_artifact_id = a.artifact_id").
+		Where("i.image_registry_id = ?", registryID).
+		Where(sq.Eq{"i.image_name": imageNames}).
+		GroupBy("i.image_name")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := util.GetAccessor(ctx, a.db, a.limits)
+
+	var dst []imageNameTotal
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing download counts query")
+	}
+	for _, total := range dst {
+		counts[total.ImageName] = total.Total
+	}
+	return counts, nil
+}
+
 // nolint:goconst
 func (a ArtifactDao) CountArtifactsByRepo(
 	ctx context.Context, parentID int64, repoKey, search string, labels []string,
@@ -779,10 +954,10 @@ func (a ArtifactDao) CountArtifactsByRepo(
 	if err != nil {
 		return -1, errors.Wrap(err, "Failed to convert query to sql")
 	}
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -806,11 +981,11 @@ func (a ArtifactDao) GetLatestArtifactMetadata(
 		LEFT JOIN (
 			SELECT 
 				a.artifact_image_id, 
-				COUNT(d.download_stat_id) AS download_count
+				SUM(d.download_stat_count) AS download_count
 			FROM 
 				artifacts a
 			JOIN 
-				download_stats d ON d.download_stat_artifact_id = a.artifact_id
+				download_stat_counts d ON d.download_stat_artifact_id = a.artifact_id
 			GROUP BY 
 				a.artifact_image_id
 		) AS dc ON i.image_id = dc.artifact_image_id
@@ -865,7 +1040,7 @@ func (a ArtifactDao) GetLatestArtifactMetadata(
 	finalQuery := util.FormatQuery(sql, args)
 	log.Ctx(ctx).Debug().Str("sql", finalQuery).Msg("Executing GetLatestTagMetadata query")
 	// Execute query
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	dst := new(artifactMetadataDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -964,7 +1139,7 @@ func (a ArtifactDao) GetAllVersionsByRepoAndImage(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	dst := []*nonOCIArtifactMetadataDB{}
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -991,8 +1166,8 @@ func (a ArtifactDao) fetchDownloadStatsForArtifacts(
 	}
 
 	query, args, err := databaseg.Builder.
-		Select("download_stat_artifact_id", "COUNT(*) AS download_count").
-		From("download_stats").
+		Select("download_stat_artifact_id", "SUM(download_stat_count) AS download_count").
+		From("download_stat_counts").
 		Where(sq.Eq{"download_stat_artifact_id": artifactIDs}).
 		GroupBy("download_stat_artifact_id").
 		ToSql()
@@ -1058,10 +1233,10 @@ func (a ArtifactDao) CountAllVersionsByRepoAndImage(
 		return -1, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -1075,11 +1250,11 @@ func (a ArtifactDao) GetArtifactMetadata(
 	q := databaseg.Builder.Select(
 		"r.registry_package_type as package_type, a.artifact_version as name, a.artifact_uuid as uuid,"+
 			"a.artifact_updated_at as modified_at, r.registry_uuid as registry_uuid, "+
-			"COALESCE(COUNT(dc.download_stat_id), 0) as download_count").
+			"COALESCE(SUM(dc.download_stat_count), 0) as download_count").
 		From("artifacts a").
 		Join("images i ON i.image_id = a.artifact_image_id").
 		Join("registries r ON i.image_registry_id = registry_id").
-		LeftJoin("download_stats dc ON dc.download_stat_artifact_id = a.artifact_id").
+		LeftJoin("download_stat_counts dc ON dc.download_stat_artifact_id = a.artifact_id").
 		Where(
 			"r.registry_parent_id = ? AND r.registry_name = ?"+
 				" AND i.image_name = ? AND a.artifact_version = ?", id, identifier, image, version,
@@ -1096,7 +1271,7 @@ func (a ArtifactDao) GetArtifactMetadata(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	dst := new(artifactMetadataDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -1116,38 +1291,76 @@ func (a ArtifactDao) UpdateArtifactMetadata(
 		principalID = session.Principal.ID
 	}
 
-	q := databaseg.Builder.Update("artifacts").
-		Set("artifact_metadata", metadata).
-		Set("artifact_updated_at", time.Now().UnixMilli()).
-		Set("artifact_updated_by", principalID).
-		Where("artifact_id = ?", artifactID)
+	now := time.Now()
 
-	sql, args, err := q.ToSql()
+	update := func(ctx context.Context) error {
+		current, err := a.Get(ctx, artifactID)
+		if err != nil {
+			return err
+		}
 
-	if err != nil {
-		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind artifacts object")
-	}
+		q := databaseg.Builder.Update("artifacts").
+			Set("artifact_metadata", metadata).
+			Set("artifact_updated_at", now.UnixMilli()).
+			Set("artifact_updated_by", principalID).
+			Where("artifact_id = ?", artifactID)
 
-	result, err := a.db.ExecContext(ctx, sql, args...)
-	if err != nil {
-		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to update artifact")
-	}
+		sql, args, err := q.ToSql()
 
-	count, err := result.RowsAffected()
-	if err != nil {
-		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of updated rows")
-	}
+		if err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind artifacts object")
+		}
+
+		result, err := util.GetAccessor(ctx, a.db, a.limits).ExecContext(ctx, sql, args...)
+		if err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to update artifact")
+		}
+
+		count, err := result.RowsAffected()
+		if err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of updated rows")
+		}
 
-	if count == 0 {
-		return gitness_store.ErrResourceNotFound
+		if count == 0 {
+			return gitness_store.ErrResourceNotFound
+		}
+
+		if err = a.search.Index(ctx, artifactID, metadata); err != nil {
+			return err
+		}
+
+		diff, err := metadatapkg.Diff(current.Metadata, metadata)
+		if err != nil {
+			return fmt.Errorf("failed to diff metadata of artifact %d: %w", artifactID, err)
+		}
+		if diff == nil {
+			return nil
+		}
+
+		return a.history.Create(ctx, &types.ArtifactMetadataChange{
+			ArtifactID: artifactID,
+			Diff:       diff,
+			CreatedBy:  principalID,
+			CreatedAt:  now,
+		})
 	}
 
-	return nil
+	// the update and its history entry are written together, callers may already run in a transaction.
+	if dbtx.GetTransaction(ctx) != nil {
+		return update(ctx)
+	}
+	return a.tx.WithTx(ctx, update)
 }
 
 func (a ArtifactDao) GetLatestArtifactsByRepo(
-	ctx context.Context, registryID int64, batchSize int, artifactID int64,
+	ctx context.Context, registryID int64, batchSize int, artifactID int64, batchTimeout time.Duration,
 ) (*[]types.ArtifactMetadata, error) {
+	ctx, cancel, err := util.WithBatchTimeout(ctx, batchTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("artifact scan of registry %d stopped after artifact %d: %w", registryID, artifactID, err)
+	}
+	defer cancel()
+
 	q := databaseg.Builder.Select(
 		`r.registry_name as repo_name, i.image_name as name,
 		a.artifact_id as artifact_id, a.artifact_version as version, a.artifact_metadata as metadata`,
@@ -1160,7 +1373,7 @@ func (a ArtifactDao) GetLatestArtifactsByRepo(
 			ORDER BY t.artifact_updated_at DESC) AS rank FROM artifacts t 
 			JOIN images i ON t.artifact_image_id = i.image_id
 			JOIN registries r ON i.image_registry_id = r.registry_id
-			WHERE r.registry_id = ? ) AS a1 
+			WHERE r.registry_id = ? AND t.artifact_deleted_at IS NULL ) AS a1 
 			ON a.artifact_id = a1.id`, registryID,
 		).
 		Where("a.artifact_id > ? AND r.registry_id = ?", artifactID, registryID).
@@ -1173,7 +1386,7 @@ func (a ArtifactDao) GetLatestArtifactsByRepo(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	var dst []*artifactMetadataDB
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -1184,8 +1397,14 @@ func (a ArtifactDao) GetLatestArtifactsByRepo(
 }
 
 func (a ArtifactDao) GetAllArtifactsByRepo(
-	ctx context.Context, registryID int64, batchSize int, artifactID int64,
+	ctx context.Context, registryID int64, batchSize int, artifactID int64, batchTimeout time.Duration,
 ) (*[]types.ArtifactMetadata, error) {
+	ctx, cancel, err := util.WithBatchTimeout(ctx, batchTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("artifact scan of registry %d stopped after artifact %d: %w", registryID, artifactID, err)
+	}
+	defer cancel()
+
 	q := databaseg.Builder.Select(
 		`r.registry_name as repo_name, i.image_name as name,
         a.artifact_id as artifact_id, a.artifact_version as version, a.artifact_metadata as metadata`,
@@ -1193,7 +1412,7 @@ func (a ArtifactDao) GetAllArtifactsByRepo(
 		From("artifacts a").
 		Join("images i ON i.image_id = a.artifact_image_id").
 		Join("registries r ON i.image_registry_id = r.registry_id").
-		Where("artifact_id > ? AND r.registry_id = ?", artifactID, registryID).
+		Where("artifact_id > ? AND r.registry_id = ? AND a.artifact_deleted_at IS NULL", artifactID, registryID).
 		OrderBy("artifact_id ASC").
 		Limit(util.SafeIntToUInt64(batchSize))
 
@@ -1202,7 +1421,7 @@ func (a ArtifactDao) GetAllArtifactsByRepo(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	var dst []*artifactMetadataDB
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -1221,13 +1440,14 @@ func (a ArtifactDao) GetArtifactsByRepoAndImageBatch(
 ) (*[]types.ArtifactMetadata, error) {
 	q := databaseg.Builder.Select(
 		`r.registry_name as repo_name, i.image_name as name,
-        a.artifact_id as artifact_id, a.artifact_version as version, a.artifact_metadata as metadata`,
+        a.artifact_id as artifact_id, a.artifact_uuid as uuid, a.artifact_version as version,
+        a.artifact_metadata as metadata, a.artifact_created_at as created_at, a.artifact_updated_at as modified_at`,
 	).
 		From("artifacts a").
 		Join("images i ON i.image_id = a.artifact_image_id").
 		Join("registries r ON i.image_registry_id = r.registry_id").
 		Where("artifact_id > ? AND r.registry_id = ?", lastArtifactID, registryID).
-		Where("i.image_name = ?", imageName).
+		Where("i.image_name = ? AND a.artifact_deleted_at IS NULL", imageName).
 		OrderBy("artifact_id ASC").
 		Limit(util.SafeIntToUInt64(util.MinInt(batchSize, 100)))
 
@@ -1236,7 +1456,7 @@ func (a ArtifactDao) GetArtifactsByRepoAndImageBatch(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	db := util.GetAccessor(ctx, a.db, a.limits)
 
 	var dst []*artifactMetadataDB
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
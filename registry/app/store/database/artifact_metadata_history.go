@@ -0,0 +1,172 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ArtifactMetadataHistoryDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+const (
+	artifactMetadataHistoryColumns = `
+		 artifact_metadata_history_id
+		,artifact_metadata_history_artifact_id
+		,artifact_metadata_history_revision
+		,artifact_metadata_history_diff
+		,artifact_metadata_history_created_by
+		,artifact_metadata_history_created_at`
+)
+
+// Create records a change of the metadata of an artifact as the next revision of the artifact.
+func (h ArtifactMetadataHistoryDao) Create(ctx context.Context, change *types.ArtifactMetadataChange) error {
+	const sqlQuery = `
+		INSERT INTO artifact_metadata_history (
+			 artifact_metadata_history_artifact_id
+			,artifact_metadata_history_revision
+			,artifact_metadata_history_diff
+			,artifact_metadata_history_created_by
+			,artifact_metadata_history_created_at
+		) values (
+			 :artifact_metadata_history_artifact_id
+			,(SELECT COALESCE(MAX(artifact_metadata_history_revision), 0) + 1
+				FROM artifact_metadata_history
+				WHERE artifact_metadata_history_artifact_id = :artifact_metadata_history_artifact_id)
+			,:artifact_metadata_history_diff
+			,:artifact_metadata_history_created_by
+			,:artifact_metadata_history_created_at
+		) RETURNING artifact_metadata_history_id, artifact_metadata_history_revision`
+
+	db := util.GetAccessor(ctx, h.db, h.limits)
+
+	created := mapToArtifactMetadataHistoryDB(change)
+	query, arg, err := db.BindNamed(sqlQuery, created)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind artifact metadata history object")
+	}
+
+	if err = db.GetContext(ctx, created, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	change.ID = created.ID
+	change.Revision = created.Revision
+
+	return nil
+}
+
+func (h ArtifactMetadataHistoryDao) ListForArtifact(
+	ctx context.Context,
+	artifactID int64,
+	limit int,
+	offset int,
+) ([]*types.ArtifactMetadataChange, error) {
+	stmt := database.Builder.
+		Select(artifactMetadataHistoryColumns).
+		From("artifact_metadata_history").
+		Where("artifact_metadata_history_artifact_id = ?", artifactID).
+		OrderBy("artifact_metadata_history_revision DESC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, h.db, h.limits)
+
+	dst := []*artifactMetadataHistoryDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select query failed")
+	}
+
+	changes := make([]*types.ArtifactMetadataChange, len(dst))
+	for i, change := range dst {
+		changes[i] = mapToArtifactMetadataChange(change)
+	}
+	return changes, nil
+}
+
+func (h ArtifactMetadataHistoryDao) CountForArtifact(ctx context.Context, artifactID int64) (int64, error) {
+	stmt := database.Builder.
+		Select("COUNT(*)").
+		From("artifact_metadata_history").
+		Where("artifact_metadata_history_artifact_id = ?", artifactID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, h.db, h.limits)
+
+	var count int64
+	if err = db.GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Count query failed")
+	}
+
+	return count, nil
+}
+
+func NewArtifactMetadataHistoryDao(db *sqlx.DB, limits util.Limits) store.ArtifactMetadataHistoryRepository {
+	return &ArtifactMetadataHistoryDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type artifactMetadataHistoryDB struct {
+	ID         int64           `db:"artifact_metadata_history_id"`
+	ArtifactID int64           `db:"artifact_metadata_history_artifact_id"`
+	Revision   int64           `db:"artifact_metadata_history_revision"`
+	Diff       json.RawMessage `db:"artifact_metadata_history_diff"`
+	CreatedBy  int64           `db:"artifact_metadata_history_created_by"`
+	CreatedAt  int64           `db:"artifact_metadata_history_created_at"`
+}
+
+func mapToArtifactMetadataChange(dst *artifactMetadataHistoryDB) *types.ArtifactMetadataChange {
+	return &types.ArtifactMetadataChange{
+		ID:         dst.ID,
+		ArtifactID: dst.ArtifactID,
+		Revision:   dst.Revision,
+		Diff:       dst.Diff,
+		CreatedBy:  dst.CreatedBy,
+		CreatedAt:  time.UnixMilli(dst.CreatedAt),
+	}
+}
+
+func mapToArtifactMetadataHistoryDB(change *types.ArtifactMetadataChange) *artifactMetadataHistoryDB {
+	return &artifactMetadataHistoryDB{
+		ID:         change.ID,
+		ArtifactID: change.ArtifactID,
+		Revision:   change.Revision,
+		Diff:       change.Diff,
+		CreatedBy:  change.CreatedBy,
+		CreatedAt:  change.CreatedAt.UnixMilli(),
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type ArtifactProvenanceDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewArtifactProvenanceDao(db *sqlx.DB, limits util.Limits) store.ArtifactProvenanceRepository {
+	return &ArtifactProvenanceDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+const (
+	artifactProvenanceColumns = `
+		 artifact_provenance_artifact_id
+		,artifact_provenance_pipeline_system
+		,artifact_provenance_pipeline_id
+		,artifact_provenance_run_url
+		,artifact_provenance_commit_sha
+		,artifact_provenance_created_by
+		,artifact_provenance_created`
+
+	provenanceArtifactColumns = `
+		 r.registry_name
+		,r.registry_package_type
+		,i.image_name
+		,a.artifact_version
+		,` + artifactProvenanceColumns
+)
+
+type artifactProvenanceDB struct {
+	ArtifactID     int64  `db:"artifact_provenance_artifact_id"`
+	PipelineSystem string `db:"artifact_provenance_pipeline_system"`
+	PipelineID     string `db:"artifact_provenance_pipeline_id"`
+	RunURL         string `db:"artifact_provenance_run_url"`
+	CommitSHA      string `db:"artifact_provenance_commit_sha"`
+	CreatedBy      int64  `db:"artifact_provenance_created_by"`
+	Created        int64  `db:"artifact_provenance_created"`
+}
+
+type provenanceArtifactDB struct {
+	RegistryName string `db:"registry_name"`
+	PackageType  string `db:"registry_package_type"`
+	Name         string `db:"image_name"`
+	Version      string `db:"artifact_version"`
+	artifactProvenanceDB
+}
+
+func (d ArtifactProvenanceDao) Upsert(ctx context.Context, provenance *types.ArtifactProvenance) error {
+	const sqlQuery = `
+		INSERT INTO artifact_provenances (
+			 artifact_provenance_artifact_id
+			,artifact_provenance_pipeline_system
+			,artifact_provenance_pipeline_id
+			,artifact_provenance_run_url
+			,artifact_provenance_commit_sha
+			,artifact_provenance_created_by
+			,artifact_provenance_created
+		) values (
+			 :artifact_provenance_artifact_id
+			,:artifact_provenance_pipeline_system
+			,:artifact_provenance_pipeline_id
+			,:artifact_provenance_run_url
+			,:artifact_provenance_commit_sha
+			,:artifact_provenance_created_by
+			,:artifact_provenance_created
+		) ON CONFLICT (artifact_provenance_artifact_id) DO UPDATE SET
+			 artifact_provenance_pipeline_system = EXCLUDED.artifact_provenance_pipeline_system
+			,artifact_provenance_pipeline_id = EXCLUDED.artifact_provenance_pipeline_id
+			,artifact_provenance_run_url = EXCLUDED.artifact_provenance_run_url
+			,artifact_provenance_commit_sha = EXCLUDED.artifact_provenance_commit_sha
+			,artifact_provenance_created_by = EXCLUDED.artifact_provenance_created_by
+			,artifact_provenance_created = EXCLUDED.artifact_provenance_created`
+
+	if provenance.CreatedBy == 0 {
+		if session, ok := request.AuthSessionFrom(ctx); ok {
+			provenance.CreatedBy = session.Principal.ID
+		}
+	}
+	if provenance.CreatedAt.IsZero() {
+		provenance.CreatedAt = time.Now()
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToArtifactProvenanceDB(provenance))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind artifact provenance object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Upsert query failed")
+	}
+	return nil
+}
+
+func (d ArtifactProvenanceDao) Find(ctx context.Context, artifactID int64) (*types.ArtifactProvenance, error) {
+	stmt := database.Builder.
+		Select(artifactProvenanceColumns).
+		From("artifact_provenances").
+		Where("artifact_provenance_artifact_id = ?", artifactID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := new(artifactProvenanceDB)
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to get artifact provenance")
+	}
+	return mapToArtifactProvenance(dst), nil
+}
+
+func (d ArtifactProvenanceDao) ListArtifacts(
+	ctx context.Context,
+	spaceID int64,
+	pipeline types.PipelineExecution,
+	limit int,
+	offset int,
+) ([]*types.ProvenanceArtifact, error) {
+	stmt := d.artifactsQuery(provenanceArtifactColumns, spaceID, pipeline).
+		OrderBy("artifact_provenance_created DESC", "artifact_provenance_artifact_id DESC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*provenanceArtifactDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list artifacts of pipeline")
+	}
+
+	artifacts := make([]*types.ProvenanceArtifact, len(dst))
+	for i, a := range dst {
+		artifacts[i] = &types.ProvenanceArtifact{
+			ArtifactID:   a.ArtifactID,
+			RegistryName: a.RegistryName,
+			PackageType:  a.PackageType,
+			Name:         a.Name,
+			Version:      a.Version,
+			Provenance:   *mapToArtifactProvenance(&a.artifactProvenanceDB),
+		}
+	}
+	return artifacts, nil
+}
+
+func (d ArtifactProvenanceDao) CountArtifacts(
+	ctx context.Context,
+	spaceID int64,
+	pipeline types.PipelineExecution,
+) (int64, error) {
+	sql, args, err := d.artifactsQuery("COUNT(*)", spaceID, pipeline).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var count int64
+	if err = db.GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Failed to count artifacts of pipeline")
+	}
+	return count, nil
+}
+
+// artifactsQuery selects the artifacts of the registries of the space whose provenance matches the fields of the
+// pipeline execution which are set.
+func (d ArtifactProvenanceDao) artifactsQuery(
+	columns string,
+	spaceID int64,
+	pipeline types.PipelineExecution,
+) sq.SelectBuilder {
+	stmt := database.Builder.
+		Select(columns).
+		From("artifact_provenances").
+		Join("artifacts a ON a.artifact_id = artifact_provenance_artifact_id").
+		Join("images i ON a.artifact_image_id = i.image_id").
+		Join("registries r ON r.registry_id = i.image_registry_id").
+		Where("r.registry_parent_id = ?", spaceID)
+	if pipeline.System != "" {
+		stmt = stmt.Where("artifact_provenance_pipeline_system = ?", pipeline.System)
+	}
+	if pipeline.PipelineID != "" {
+		stmt = stmt.Where("artifact_provenance_pipeline_id = ?", pipeline.PipelineID)
+	}
+	if pipeline.RunURL != "" {
+		stmt = stmt.Where("artifact_provenance_run_url = ?", pipeline.RunURL)
+	}
+	if pipeline.CommitSHA != "" {
+		stmt = stmt.Where("artifact_provenance_commit_sha = ?", pipeline.CommitSHA)
+	}
+	return stmt
+}
+
+func mapToArtifactProvenanceDB(provenance *types.ArtifactProvenance) *artifactProvenanceDB {
+	return &artifactProvenanceDB{
+		ArtifactID:     provenance.ArtifactID,
+		PipelineSystem: provenance.Pipeline.System,
+		PipelineID:     provenance.Pipeline.PipelineID,
+		RunURL:         provenance.Pipeline.RunURL,
+		CommitSHA:      provenance.Pipeline.CommitSHA,
+		CreatedBy:      provenance.CreatedBy,
+		Created:        provenance.CreatedAt.UnixMilli(),
+	}
+}
+
+func mapToArtifactProvenance(dst *artifactProvenanceDB) *types.ArtifactProvenance {
+	return &types.ArtifactProvenance{
+		ArtifactID: dst.ArtifactID,
+		Pipeline: types.PipelineExecution{
+			System:     dst.PipelineSystem,
+			PipelineID: dst.PipelineID,
+			RunURL:     dst.RunURL,
+			CommitSHA:  dst.CommitSHA,
+		},
+		CreatedBy: dst.CreatedBy,
+		CreatedAt: time.UnixMilli(dst.Created),
+	}
+}
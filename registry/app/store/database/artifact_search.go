@@ -0,0 +1,413 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	metadatapkg "github.com/harness/gitness/registry/app/metadata"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// searchDescriptionLength is the length of the description returned with the search results.
+const searchDescriptionLength = 1024
+
+// searchVectorExpr is the text search vector of a column of a search document in postgres, the same expression
+// builds the indexed vector in the migration.
+const searchVectorExpr = `to_tsvector('simple', regexp_replace(%s, '[^[:alnum:]]+', ' ', 'g'))`
+
+var searchFieldColumns = map[types.ArtifactSearchField]string{
+	types.ArtifactSearchFieldName:         "artifact_search_document_name",
+	types.ArtifactSearchFieldKeywords:     "artifact_search_document_keywords",
+	types.ArtifactSearchFieldDescription:  "artifact_search_document_description",
+	types.ArtifactSearchFieldAuthors:      "artifact_search_document_authors",
+	types.ArtifactSearchFieldDependencies: "artifact_search_document_dependencies",
+}
+
+// sqliteSearchWeights weigh the hits in the columns of artifact_search_fts, in their order, like the weights of the
+// fields rank them in postgres.
+var sqliteSearchWeights = []float64{1.0, 0.4, 0.2, 0.1, 0.1}
+
+type ArtifactSearchDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewArtifactSearchDao(db *sqlx.DB, limits util.Limits) store.ArtifactSearchRepository {
+	return &ArtifactSearchDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type artifactSearchDocumentDB struct {
+	ArtifactID   int64  `db:"artifact_search_document_artifact_id"`
+	Description  string `db:"artifact_search_document_description"`
+	Keywords     string `db:"artifact_search_document_keywords"`
+	Authors      string `db:"artifact_search_document_authors"`
+	Dependencies string `db:"artifact_search_document_dependencies"`
+	Updated      int64  `db:"artifact_search_document_updated"`
+}
+
+type artifactSearchResultDB struct {
+	ArtifactID   int64   `db:"artifact_search_document_artifact_id"`
+	RegistryName string  `db:"registry_name"`
+	PackageType  string  `db:"registry_package_type"`
+	Name         string  `db:"image_name"`
+	Version      string  `db:"artifact_version"`
+	Description  string  `db:"description"`
+	Rank         float64 `db:"rank"`
+}
+
+type artifactSearchMatchDB struct {
+	ArtifactID int64  `db:"docid"`
+	MatchInfo  []byte `db:"match_info"`
+}
+
+func (d ArtifactSearchDao) Index(ctx context.Context, artifactID int64, metadata json.RawMessage) error {
+	const sqlQuery = `
+		INSERT INTO artifact_search_documents (
+			 artifact_search_document_artifact_id
+			,artifact_search_document_name
+			,artifact_search_document_description
+			,artifact_search_document_keywords
+			,artifact_search_document_authors
+			,artifact_search_document_dependencies
+			,artifact_search_document_updated
+		) SELECT
+			 a.artifact_id
+			,i.image_name
+			,:artifact_search_document_description
+			,:artifact_search_document_keywords
+			,:artifact_search_document_authors
+			,:artifact_search_document_dependencies
+			,:artifact_search_document_updated
+		FROM artifacts a
+		JOIN images i ON i.image_id = a.artifact_image_id
+		WHERE a.artifact_id = :artifact_search_document_artifact_id
+		ON CONFLICT (artifact_search_document_artifact_id) DO UPDATE SET
+			 artifact_search_document_name = EXCLUDED.artifact_search_document_name
+			,artifact_search_document_description = EXCLUDED.artifact_search_document_description
+			,artifact_search_document_keywords = EXCLUDED.artifact_search_document_keywords
+			,artifact_search_document_authors = EXCLUDED.artifact_search_document_authors
+			,artifact_search_document_dependencies = EXCLUDED.artifact_search_document_dependencies
+			,artifact_search_document_updated = EXCLUDED.artifact_search_document_updated`
+
+	fields, err := metadatapkg.ExtractSearchFields(metadata)
+	if err != nil {
+		// the artifact stays searchable by its name.
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to read search fields of artifact %d", artifactID)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, &artifactSearchDocumentDB{
+		ArtifactID:   artifactID,
+		Description:  fields.Description,
+		Keywords:     fields.Keywords,
+		Authors:      fields.Authors,
+		Dependencies: fields.Dependencies,
+		Updated:      time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind artifact search document object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Upsert query failed")
+	}
+	return nil
+}
+
+func (d ArtifactSearchDao) IndexMissing(ctx context.Context, limit int) (int, error) {
+	stmt := database.Builder.
+		Select("a.artifact_id", "a.artifact_metadata").
+		From("artifacts a").
+		LeftJoin("artifact_search_documents ON artifact_search_document_artifact_id = a.artifact_id").
+		Where("artifact_search_document_artifact_id IS NULL").
+		OrderBy("a.artifact_id").
+		Limit(util.SafeIntToUInt64(limit))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var dst []struct {
+		ArtifactID int64           `db:"artifact_id"`
+		Metadata   json.RawMessage `db:"artifact_metadata"`
+	}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Failed to list artifacts without search document")
+	}
+
+	for i, a := range dst {
+		if err = d.Index(ctx, a.ArtifactID, a.Metadata); err != nil {
+			return i, err
+		}
+	}
+	return len(dst), nil
+}
+
+func (d ArtifactSearchDao) Search(
+	ctx context.Context,
+	query types.ArtifactSearchQuery,
+) ([]*types.ArtifactSearchResult, error) {
+	if len(query.Terms) == 0 {
+		return []*types.ArtifactSearchResult{}, nil
+	}
+	if d.db.DriverName() == SQLITE3 {
+		return d.searchSqlite(ctx, query)
+	}
+
+	tsQuery := postgresSearchQuery(query.Terms)
+	columns := fmt.Sprintf(`
+		 artifact_search_document_artifact_id
+		,r.registry_name
+		,r.registry_package_type
+		,i.image_name
+		,a.artifact_version
+		,substr(artifact_search_document_description, 1, %d) AS description`, searchDescriptionLength)
+	stmt := d.searchQuery(columns, query).
+		Column("ts_rank_cd(artifact_search_document_vector, to_tsquery('simple', ?)) AS rank", tsQuery).
+		Where("artifact_search_document_vector @@ to_tsquery('simple', ?)", tsQuery).
+		OrderBy("rank DESC", "artifact_search_document_artifact_id DESC").
+		Limit(util.SafeIntToUInt64(query.Limit)).
+		Offset(util.SafeIntToUInt64(query.Offset))
+	if column, ok := searchFieldColumns[query.Field]; ok {
+		stmt = stmt.Where(fmt.Sprintf(searchVectorExpr, column)+" @@ to_tsquery('simple', ?)", tsQuery)
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*artifactSearchResultDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to search artifacts")
+	}
+	return mapToArtifactSearchResults(dst), nil
+}
+
+// searchSqlite ranks all the matches of the query and reads the page of results. SQLite has no ranking function,
+// the hits of the terms are read with matchinfo and weighed here.
+func (d ArtifactSearchDao) searchSqlite(
+	ctx context.Context,
+	query types.ArtifactSearchQuery,
+) ([]*types.ArtifactSearchResult, error) {
+	stmt := d.sqliteMatchQuery("artifact_search_fts.docid, matchinfo(artifact_search_fts, 'pcnx') AS match_info",
+		query)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var matches []*artifactSearchMatchDB
+	if err = db.SelectContext(ctx, &matches, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to search artifacts")
+	}
+
+	ranks := make(map[int64]float64, len(matches))
+	for _, m := range matches {
+		ranks[m.ArtifactID] = sqliteSearchRank(m.MatchInfo)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		ri, rj := ranks[matches[i].ArtifactID], ranks[matches[j].ArtifactID]
+		if ri != rj {
+			return ri > rj
+		}
+		return matches[i].ArtifactID > matches[j].ArtifactID
+	})
+
+	if query.Offset >= len(matches) {
+		return []*types.ArtifactSearchResult{}, nil
+	}
+	matches = matches[query.Offset:]
+	if query.Limit > 0 && len(matches) > query.Limit {
+		matches = matches[:query.Limit]
+	}
+	ids := make([]int64, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ArtifactID
+	}
+
+	columns := fmt.Sprintf(`
+		 artifact_search_document_artifact_id
+		,r.registry_name
+		,r.registry_package_type
+		,i.image_name
+		,a.artifact_version
+		,substr(artifact_search_document_description, 1, %d) AS description
+		,0 AS rank`, searchDescriptionLength)
+	sql, args, err = d.searchQuery(columns, types.ArtifactSearchQuery{SpaceID: query.SpaceID}).
+		Where(sq.Eq{"artifact_search_document_artifact_id": ids}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	dst := []*artifactSearchResultDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to read search results")
+	}
+	for _, r := range dst {
+		r.Rank = ranks[r.ArtifactID]
+	}
+	sort.Slice(dst, func(i, j int) bool {
+		if dst[i].Rank != dst[j].Rank {
+			return dst[i].Rank > dst[j].Rank
+		}
+		return dst[i].ArtifactID > dst[j].ArtifactID
+	})
+	return mapToArtifactSearchResults(dst), nil
+}
+
+func (d ArtifactSearchDao) Count(ctx context.Context, query types.ArtifactSearchQuery) (int64, error) {
+	if len(query.Terms) == 0 {
+		return 0, nil
+	}
+
+	var stmt sq.SelectBuilder
+	if d.db.DriverName() == SQLITE3 {
+		stmt = d.sqliteMatchQuery("COUNT(*)", query)
+	} else {
+		tsQuery := postgresSearchQuery(query.Terms)
+		stmt = d.searchQuery("COUNT(*)", query).
+			Where("artifact_search_document_vector @@ to_tsquery('simple', ?)", tsQuery)
+		if column, ok := searchFieldColumns[query.Field]; ok {
+			stmt = stmt.Where(fmt.Sprintf(searchVectorExpr, column)+" @@ to_tsquery('simple', ?)", tsQuery)
+		}
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var count int64
+	if err = db.GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Failed to count search results")
+	}
+	return count, nil
+}
+
+// searchQuery selects the search documents of the versions stored in the registries of the space, of the package
+// types of the query if any.
+func (d ArtifactSearchDao) searchQuery(columns string, query types.ArtifactSearchQuery) sq.SelectBuilder {
+	stmt := database.Builder.
+		Select(columns).
+		From("artifact_search_documents").
+		Join("artifacts a ON a.artifact_id = artifact_search_document_artifact_id").
+		Join("images i ON i.image_id = a.artifact_image_id").
+		Join("registries r ON r.registry_id = i.image_registry_id").
+		Where("r.registry_parent_id = ?", query.SpaceID)
+	if len(query.PackageTypes) > 0 {
+		stmt = stmt.Where(sq.Eq{"r.registry_package_type": query.PackageTypes})
+	}
+	return stmt
+}
+
+func (d ArtifactSearchDao) sqliteMatchQuery(columns string, query types.ArtifactSearchQuery) sq.SelectBuilder {
+	prefix := ""
+	if column, ok := searchFieldColumns[query.Field]; ok {
+		prefix = column + ":"
+	}
+	words := make([]string, len(query.Terms))
+	for i, term := range query.Terms {
+		words[i] = prefix + term + "*"
+	}
+
+	return d.searchQuery(columns, query).
+		Join("artifact_search_fts ON artifact_search_fts.docid = artifact_search_document_artifact_id").
+		Where("artifact_search_fts MATCH ?", strings.Join(words, " "))
+}
+
+// postgresSearchQuery matches the documents holding words starting with each of the terms.
+func postgresSearchQuery(terms []string) string {
+	words := make([]string, len(terms))
+	for i, term := range terms {
+		words[i] = term + ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+// sqliteSearchRank weighs the hits of the phrases of a match in the columns they're found in by how rare the
+// phrases are, from the 'pcnx' matchinfo of the match.
+func sqliteSearchRank(matchInfo []byte) float64 {
+	values := make([]uint32, len(matchInfo)/4)
+	for i := range values {
+		values[i] = binary.NativeEndian.Uint32(matchInfo[i*4:])
+	}
+	if len(values) < 3 {
+		return 0
+	}
+	phrases, columns, rows := int(values[0]), int(values[1]), float64(values[2])
+	if len(values) < 3+3*phrases*columns {
+		return 0
+	}
+
+	var rank float64
+	for p := range phrases {
+		for c := 0; c < columns && c < len(sqliteSearchWeights); c++ {
+			hits := values[3+3*(p*columns+c)]
+			docs := values[3+3*(p*columns+c)+2]
+			if hits == 0 || docs == 0 {
+				continue
+			}
+			rank += sqliteSearchWeights[c] * float64(hits) * math.Log(1+rows/float64(docs))
+		}
+	}
+	return rank
+}
+
+func mapToArtifactSearchResults(dst []*artifactSearchResultDB) []*types.ArtifactSearchResult {
+	results := make([]*types.ArtifactSearchResult, len(dst))
+	for i, r := range dst {
+		results[i] = &types.ArtifactSearchResult{
+			ArtifactID:   r.ArtifactID,
+			RegistryName: r.RegistryName,
+			PackageType:  r.PackageType,
+			Name:         r.Name,
+			Version:      r.Version,
+			Description:  r.Description,
+			Rank:         r.Rank,
+		}
+	}
+	return results
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchPackagesByImageName(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	dao := database.NewArtifactDao(db, nil, util.Limits{})
+
+	registryID := createRegistry(t, db, "nuget")
+	otherRegistryID := createRegistry(t, db, "other")
+	for name, versions := range map[string][]string{
+		"Newtonsoft.Json":      {"12.0.0", "13.0.1", "14.0.0-beta.1"},
+		"Newtonsoft.Json.Bson": {"1.0.0"},
+		"NewPreview":           {"0.1.0-alpha"},
+		"Serilog":              {"3.0.0"},
+	} {
+		imageID := createImage(t, db, registryID, name)
+		for _, version := range versions {
+			createArtifact(t, db, imageID, version)
+		}
+	}
+	createArtifact(t, db, createImage(t, db, otherRegistryID, "Newtonsoft.Json"), "13.0.1")
+
+	versionsOf := func(artifacts *[]types.ArtifactMetadata) map[string][]string {
+		versions := make(map[string][]string)
+		for _, a := range *artifacts {
+			versions[a.Name] = append(versions[a.Name], a.Version)
+		}
+		return versions
+	}
+
+	artifacts, err := dao.SearchPackagesByImageName(ctx, registryID, "newtonsoft", false, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"Newtonsoft.Json":      {"12.0.0", "13.0.1"},
+		"Newtonsoft.Json.Bson": {"1.0.0"},
+	}, versionsOf(artifacts), "pre-release versions are skipped and other registries ignored")
+
+	count, err := dao.CountPackagesByImageName(ctx, registryID, "NEWTONSOFT", false)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	// pages are made of packages, not of versions.
+	artifacts, err = dao.SearchPackagesByImageName(ctx, registryID, "new", true, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"Newtonsoft.Json": {"12.0.0", "13.0.1", "14.0.0-beta.1"},
+	}, versionsOf(artifacts))
+
+	count, err = dao.CountPackagesByImageName(ctx, registryID, "new", true)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count, "packages with only pre-release versions are found with pre-releases")
+
+	count, err = dao.CountPackagesByImageName(ctx, registryID, "new", false)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+}
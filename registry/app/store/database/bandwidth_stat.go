@@ -22,20 +22,24 @@ import (
 
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/registry/types"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	errors2 "github.com/pkg/errors"
 )
 
 type BandwidthStatDao struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	limits util.Limits
 }
 
-func NewBandwidthStatDao(db *sqlx.DB) store.BandwidthStatRepository {
+func NewBandwidthStatDao(db *sqlx.DB, limits util.Limits) store.BandwidthStatRepository {
 	return &BandwidthStatDao{
-		db: db,
+		db:     db,
+		limits: limits,
 	}
 }
 
@@ -74,19 +78,116 @@ func (b BandwidthStatDao) Create(ctx context.Context, bandwidthStat *types.Bandw
 		    ) 		   
         RETURNING bandwidth_stat_id`
 
-	db := dbtx.GetAccessor(ctx, b.db)
+	db := util.GetAccessor(ctx, b.db, b.limits)
 	query, arg, err := db.BindNamed(sqlQuery, b.mapToInternalBandwidthStat(ctx, bandwidthStat))
 	if err != nil {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind bandwidth stat object")
 	}
 
-	if err = db.QueryRowContext(ctx, query,
-		arg...).Scan(&bandwidthStat.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	if err = db.GetContext(ctx, &bandwidthStat.ID, query,
+		arg...); err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
 	}
 	return nil
 }
 
+func (b BandwidthStatDao) GetTotalBytesByRegistry(
+	ctx context.Context,
+	registryID int64,
+	bandwidthType types.BandwidthType,
+	since time.Time,
+) (int64, error) {
+	stmt := databaseg.Builder.Select("COALESCE(SUM(b.bandwidth_stat_bytes), 0)").
+		From("bandwidth_stats b").
+		Join("images i ON i.image_id = b.bandwidth_stat_image_id").
+		Where("i.image_registry_id = ? AND b.bandwidth_stat_type = ? AND b.bandwidth_stat_timestamp >= ?",
+			registryID, bandwidthType, since.UnixMilli())
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, errors2.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := util.GetAccessor(ctx, b.db, b.limits)
+
+	var total int64
+	if err = db.GetContext(ctx, &total, sql, args...); err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing bandwidth sum query")
+	}
+	return total, nil
+}
+
+func (b BandwidthStatDao) GetTotalBytesByRegistries(
+	ctx context.Context,
+	registryIDs []int64,
+	bandwidthType types.BandwidthType,
+	since time.Time,
+) (map[int64]int64, error) {
+	if len(registryIDs) == 0 {
+		return make(map[int64]int64), nil
+	}
+
+	stmt := databaseg.Builder.
+		Select("i.image_registry_id AS registry_id, COALESCE(SUM(b.bandwidth_stat_bytes), 0) AS bytes").
+		From("bandwidth_stats b").
+		Join("images i ON i.image_id = b.bandwidth_stat_image_id").
+		Where(sq.Eq{"i.image_registry_id": registryIDs}).
+		Where("b.bandwidth_stat_type = ? AND b.bandwidth_stat_timestamp >= ?", bandwidthType, since.UnixMilli()).
+		GroupBy("i.image_registry_id")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors2.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := util.GetAccessor(ctx, b.db, b.limits)
+
+	type registryBytesDB struct {
+		RegistryID int64 `db:"registry_id"`
+		Bytes      int64 `db:"bytes"`
+	}
+	dst := []registryBytesDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing bandwidth sum by registry query")
+	}
+
+	totals := make(map[int64]int64, len(dst))
+	for _, d := range dst {
+		totals[d.RegistryID] = d.Bytes
+	}
+	return totals, nil
+}
+
+func (b BandwidthStatDao) GetTopImagesByRegistry(
+	ctx context.Context,
+	registryID int64,
+	bandwidthType types.BandwidthType,
+	since time.Time,
+	limit int,
+) ([]types.ImageUsage, error) {
+	stmt := databaseg.Builder.Select("i.image_name, SUM(b.bandwidth_stat_bytes) AS bytes").
+		From("bandwidth_stats b").
+		Join("images i ON i.image_id = b.bandwidth_stat_image_id").
+		Where("i.image_registry_id = ? AND b.bandwidth_stat_type = ? AND b.bandwidth_stat_timestamp >= ?",
+			registryID, bandwidthType, since.UnixMilli()).
+		GroupBy("i.image_name").
+		OrderBy("bytes DESC").
+		Limit(uint64(limit)) //nolint:gosec
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors2.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := util.GetAccessor(ctx, b.db, b.limits)
+
+	dst := []*imageUsageDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing top images query")
+	}
+	return mapToImageUsages(dst), nil
+}
+
 func (b BandwidthStatDao) mapToInternalBandwidthStat(ctx context.Context,
 	in *types.BandwidthStat) *bandwidthStatDB {
 	session, _ := request.AuthSessionFrom(ctx)
@@ -112,3 +213,16 @@ func (b BandwidthStatDao) mapToInternalBandwidthStat(ctx context.Context,
 		UpdatedBy: session.Principal.ID,
 	}
 }
+
+type imageUsageDB struct {
+	ImageName string `db:"image_name"`
+	Bytes     int64  `db:"bytes"`
+}
+
+func mapToImageUsages(dst []*imageUsageDB) []types.ImageUsage {
+	usages := make([]types.ImageUsage, 0, len(dst))
+	for _, d := range dst {
+		usages = append(usages, types.ImageUsage{ImageName: d.ImageName, Bytes: d.Bytes})
+	}
+	return usages
+}
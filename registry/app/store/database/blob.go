@@ -25,7 +25,6 @@ import (
 	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/opencontainers/go-digest"
@@ -37,12 +36,14 @@ type blobDao struct {
 
 	//FIXME: Arvind: Move this to controller layer later
 	mtRepository store.MediaTypesRepository
+	limits       util.Limits
 }
 
-func NewBlobDao(db *sqlx.DB, mtRepository store.MediaTypesRepository) store.BlobRepository {
+func NewBlobDao(db *sqlx.DB, mtRepository store.MediaTypesRepository, limits util.Limits) store.BlobRepository {
 	return &blobDao{
 		db:           db,
 		mtRepository: mtRepository,
+		limits:       limits,
 	}
 }
 
@@ -84,7 +85,7 @@ func (bd blobDao) FindByDigestAndRootParentID(ctx context.Context, d digest.Dige
 		Where("blob_root_parent_id = ?", rootParentID).
 		Where("blob_digest = ?", digestBytes)
 
-	db := dbtx.GetAccessor(ctx, bd.db)
+	db := util.GetAccessor(ctx, bd.db, bd.limits)
 
 	dst := new(blobMetadataDB)
 	sql, args, err := stmt.ToSql()
@@ -104,7 +105,7 @@ func (bd blobDao) TotalSizeByRootParentID(ctx context.Context, rootID int64) (in
 		From("blobs").
 		Where("blob_root_parent_id = ?", rootID)
 
-	db := dbtx.GetAccessor(ctx, bd.db)
+	db := util.GetAccessor(ctx, bd.db, bd.limits)
 
 	var size int64
 	sqlQuery, args, err := q.ToSql()
@@ -112,7 +113,7 @@ func (bd blobDao) TotalSizeByRootParentID(ctx context.Context, rootID int64) (in
 		return 0, errors2.Wrap(err, "Failed to convert query to sql")
 	}
 
-	if err = db.QueryRowContext(ctx, sqlQuery, args...).Scan(&size); err != nil &&
+	if err = db.GetContext(ctx, &size, sqlQuery, args...); err != nil &&
 		!errors2.Is(err, sql.ErrNoRows) {
 		return 0,
 			database.ProcessSQLErrorf(ctx, err, "Failed to find total blob size for root parent with id %d", rootID)
@@ -124,7 +125,7 @@ func (bd blobDao) FindByID(ctx context.Context, id int64) (*types.Blob, error) {
 	stmt := PrimaryQuery.
 		Where("blob_id = ?", id)
 
-	db := dbtx.GetAccessor(ctx, bd.db)
+	db := util.GetAccessor(ctx, bd.db, bd.limits)
 
 	dst := new(blobMetadataDB)
 	sql, args, err := stmt.ToSql()
@@ -157,7 +158,7 @@ func (bd blobDao) FindByDigestAndRepoID(ctx context.Context, d digest.Digest, re
 		Where("rblob_image_name = ?", imageName).
 		Where("blob_digest = ?", digestBytes)
 
-	db := dbtx.GetAccessor(ctx, bd.db)
+	db := util.GetAccessor(ctx, bd.db, bd.limits)
 
 	dst := new(blobMetadataDB)
 	sql, args, err := stmt.ToSql()
@@ -198,7 +199,7 @@ func (bd blobDao) CreateOrFind(ctx context.Context, b *types.Blob) (*types.Blob,
 	}
 	b.MediaTypeID = mediaTypeID
 
-	db := dbtx.GetAccessor(ctx, bd.db)
+	db := util.GetAccessor(ctx, bd.db, bd.limits)
 	blob, err := mapToInternalBlob(ctx, b)
 	if err != nil {
 		return nil, false, err
@@ -209,7 +210,7 @@ func (bd blobDao) CreateOrFind(ctx context.Context, b *types.Blob) (*types.Blob,
 	}
 
 	var created bool
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&b.ID); err != nil {
+	if err = db.GetContext(ctx, &b.ID, query, arg...); err != nil {
 		if errors2.Is(err, sql.ErrNoRows) {
 			created = false
 		} else {
@@ -232,7 +233,7 @@ func (bd blobDao) DeleteByID(ctx context.Context, id int64) error {
 		return fmt.Errorf("failed to convert purge blob query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, bd.db)
+	db := util.GetAccessor(ctx, bd.db, bd.limits)
 
 	_, err = db.ExecContext(ctx, sql, args...)
 	if err != nil {
@@ -256,7 +257,7 @@ func (bd blobDao) ExistsBlob(ctx context.Context, repoID int64,
 	}
 
 	var exists bool
-	db := dbtx.GetAccessor(ctx, bd.db)
+	db := util.GetAccessor(ctx, bd.db, bd.limits)
 	newDigest, err := types.NewDigest(d)
 	if err != nil {
 		return false, err
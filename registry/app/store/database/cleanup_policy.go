@@ -23,18 +23,19 @@ import (
 	"github.com/harness/gitness/registry/app/common/lib/errors"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/registry/types/enum"
 	databaseg "github.com/harness/gitness/store/database"
 	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/jmoiron/sqlx"
-	"github.com/rs/zerolog/log"
 )
 
 type CleanupPolicyDao struct {
-	db *sqlx.DB
-	tx dbtx.Transactor
+	db     *sqlx.DB
+	tx     dbtx.Transactor
+	limits util.Limits
 }
 
 type CleanupPolicyDB struct {
@@ -60,17 +61,18 @@ type CleanupPolicyJoinMapping struct {
 	CleanupPolicyPrefixMappingDB
 }
 
-func NewCleanupPolicyDao(db *sqlx.DB, tx dbtx.Transactor) store.CleanupPolicyRepository {
+func NewCleanupPolicyDao(db *sqlx.DB, tx dbtx.Transactor, limits util.Limits) store.CleanupPolicyRepository {
 	return &CleanupPolicyDao{
-		db: db,
-		tx: tx,
+		db:     db,
+		tx:     tx,
+		limits: limits,
 	}
 }
 
 func (c CleanupPolicyDao) GetIDsByRegistryID(ctx context.Context, id int64) (ids []int64, err error) {
 	stmt := databaseg.Builder.Select("cp_id").From("cleanup_policies").
 		Where("cp_registry_id = ?", id)
-	db := dbtx.GetAccessor(ctx, c.db)
+	db := util.GetAccessor(ctx, c.db, c.limits)
 	var res []int64
 	query, args, err := stmt.ToSql()
 	if err != nil {
@@ -110,30 +112,21 @@ func (c CleanupPolicyDao) GetByRegistryID(
 		Join("cleanup_policy_prefix_mappings ON cp_id = cpp_cleanup_policy_id").
 		Where("cp_registry_id = ?", id)
 
-	db := dbtx.GetAccessor(ctx, c.db)
+	db := util.GetAccessor(ctx, c.db, c.limits)
 	query, args, err := stmt.ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.QueryxContext(ctx, query, args...)
-	if err != nil {
-		if !errors.Is(err, sql.ErrNoRows) {
-			return nil, databaseg.ProcessSQLErrorf(
-				ctx, err,
-				"failed to get cleanup policy ids by registry id %d", id,
-			)
-		}
+	var dst []CleanupPolicyJoinMapping
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(
+			ctx, err,
+			"failed to get cleanup policy ids by registry id %d", id,
+		)
 	}
 
-	defer func(rows *sqlx.Rows) {
-		err := rows.Close()
-		if err != nil {
-			log.Ctx(ctx).Error().Msgf("failed to close rows: %v", err)
-		}
-	}(rows)
-
-	return c.mapToCleanupPolicies(ctx, rows)
+	return c.mapToCleanupPolicies(ctx, dst), nil
 }
 
 func (c CleanupPolicyDao) Create(ctx context.Context, cleanupPolicy *types.CleanupPolicy) (id int64, err error) {
@@ -156,7 +149,7 @@ func (c CleanupPolicyDao) Create(ctx context.Context, cleanupPolicy *types.Clean
 			,:cp_updated_by
 		) RETURNING cp_id`
 
-	db := dbtx.GetAccessor(ctx, c.db)
+	db := util.GetAccessor(ctx, c.db, c.limits)
 
 	// insert repo first so we get id
 	query, arg, err := db.BindNamed(sqlQuery, c.mapToInternalCleanupPolicy(ctx, cleanupPolicy))
@@ -167,7 +160,7 @@ func (c CleanupPolicyDao) Create(ctx context.Context, cleanupPolicy *types.Clean
 		)
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&cleanupPolicy.ID); err != nil {
+	if err = db.GetContext(ctx, &cleanupPolicy.ID, query, arg...); err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
 	}
 
@@ -189,7 +182,7 @@ func (c CleanupPolicyDao) createPrefixMapping(
 			,:cpp_prefix_type
 		) RETURNING cpp_id`
 
-	db := dbtx.GetAccessor(ctx, c.db)
+	db := util.GetAccessor(ctx, c.db, c.limits)
 
 	// insert repo first so we get id
 	query, arg, err := db.BindNamed(sqlQuery, mapping)
@@ -200,7 +193,7 @@ func (c CleanupPolicyDao) createPrefixMapping(
 		)
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&mapping.PrefixID); err != nil {
+	if err = db.GetContext(ctx, &mapping.PrefixID, query, arg...); err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
 	}
 
@@ -216,7 +209,7 @@ func (c CleanupPolicyDao) Delete(ctx context.Context, id int64) (err error) {
 		return err
 	}
 
-	db := dbtx.GetAccessor(ctx, c.db)
+	db := util.GetAccessor(ctx, c.db, c.limits)
 	_, err = db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return databaseg.ProcessSQLErrorf(
@@ -234,7 +227,7 @@ func (c CleanupPolicyDao) deleteCleanupPolicies(ctx context.Context, ids []int64
 	}
 
 	query = c.db.Rebind(query)
-	db := dbtx.GetAccessor(ctx, c.db)
+	db := util.GetAccessor(ctx, c.db, c.limits)
 	_, err = db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return databaseg.ProcessSQLErrorf(
@@ -353,17 +346,11 @@ func (c CleanupPolicyDao) mapToInternalCleanupPolicy(
 
 func (c CleanupPolicyDao) mapToCleanupPolicies(
 	_ context.Context,
-	rows *sqlx.Rows,
-) (*[]types.CleanupPolicy, error) {
+	rows []CleanupPolicyJoinMapping,
+) *[]types.CleanupPolicy {
 	cleanupPolicies := make(map[int64]*types.CleanupPolicy)
 
-	for rows.Next() {
-		var cp CleanupPolicyJoinMapping
-		if err := rows.StructScan(&cp); err != nil {
-			return nil,
-				errors.Wrap(err, "failed to scan cleanup policy")
-		}
-
+	for _, cp := range rows {
 		if _, exists := cleanupPolicies[cp.ID]; !exists {
 			cleanupPolicies[cp.ID] = &types.CleanupPolicy{
 				ID:            cp.ID,
@@ -389,5 +376,5 @@ func (c CleanupPolicyDao) mapToCleanupPolicies(
 	for _, cp := range cleanupPolicies {
 		result = append(result, *cp)
 	}
-	return &result, nil
+	return &result
 }
@@ -0,0 +1,293 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type DeletionRequestDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewDeletionRequestDao(db *sqlx.DB, limits util.Limits) store.DeletionRequestRepository {
+	return &DeletionRequestDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+const (
+	deletionRequestColumns = `
+		 deletion_request_id
+		,deletion_request_registry_id
+		,deletion_request_image_name
+		,deletion_request_version
+		,deletion_request_state
+		,deletion_request_requested_by
+		,deletion_request_reviewed_by
+		,deletion_request_comment
+		,deletion_request_created
+		,deletion_request_updated
+		,deletion_request_expires`
+)
+
+type deletionRequestDB struct {
+	ID          int64         `db:"deletion_request_id"`
+	RegistryID  int64         `db:"deletion_request_registry_id"`
+	ImageName   string        `db:"deletion_request_image_name"`
+	Version     string        `db:"deletion_request_version"`
+	State       string        `db:"deletion_request_state"`
+	RequestedBy int64         `db:"deletion_request_requested_by"`
+	ReviewedBy  sql.NullInt64 `db:"deletion_request_reviewed_by"`
+	Comment     string        `db:"deletion_request_comment"`
+	Created     int64         `db:"deletion_request_created"`
+	Updated     int64         `db:"deletion_request_updated"`
+	Expires     int64         `db:"deletion_request_expires"`
+}
+
+func (d DeletionRequestDao) Create(ctx context.Context, request *types.DeletionRequest) error {
+	const sqlQuery = `
+		INSERT INTO deletion_requests (
+			 deletion_request_registry_id
+			,deletion_request_image_name
+			,deletion_request_version
+			,deletion_request_state
+			,deletion_request_requested_by
+			,deletion_request_reviewed_by
+			,deletion_request_comment
+			,deletion_request_created
+			,deletion_request_updated
+			,deletion_request_expires
+		) values (
+			 :deletion_request_registry_id
+			,:deletion_request_image_name
+			,:deletion_request_version
+			,:deletion_request_state
+			,:deletion_request_requested_by
+			,:deletion_request_reviewed_by
+			,:deletion_request_comment
+			,:deletion_request_created
+			,:deletion_request_updated
+			,:deletion_request_expires
+		) RETURNING deletion_request_id`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToDeletionRequestDB(request))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind deletion request object")
+	}
+
+	if err = db.GetContext(ctx, &request.ID, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (d DeletionRequestDao) Find(ctx context.Context, id int64) (*types.DeletionRequest, error) {
+	stmt := database.Builder.
+		Select(deletionRequestColumns).
+		From("deletion_requests").
+		Where("deletion_request_id = ?", id)
+
+	return d.get(ctx, stmt.ToSql)
+}
+
+func (d DeletionRequestDao) FindPending(
+	ctx context.Context,
+	registryID int64,
+	imageName string,
+	version string,
+) (*types.DeletionRequest, error) {
+	stmt := database.Builder.
+		Select(deletionRequestColumns).
+		From("deletion_requests").
+		Where("deletion_request_registry_id = ?", registryID).
+		Where("deletion_request_image_name = ?", imageName).
+		Where("deletion_request_version = ?", version).
+		Where("deletion_request_state = ?", string(types.DeletionRequestStatePending))
+
+	return d.get(ctx, stmt.ToSql)
+}
+
+func (d DeletionRequestDao) get(
+	ctx context.Context,
+	toSQL func() (string, []any, error),
+) (*types.DeletionRequest, error) {
+	sql, args, err := toSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := new(deletionRequestDB)
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to get deletion request")
+	}
+	return mapToDeletionRequest(dst), nil
+}
+
+func (d DeletionRequestDao) List(
+	ctx context.Context,
+	registryID int64,
+	state *types.DeletionRequestState,
+	limit int,
+) ([]*types.DeletionRequest, error) {
+	stmt := database.Builder.
+		Select(deletionRequestColumns).
+		From("deletion_requests").
+		Where("deletion_request_registry_id = ?", registryID)
+	if state != nil {
+		stmt = stmt.Where("deletion_request_state = ?", string(*state))
+	}
+	stmt = stmt.
+		OrderBy("deletion_request_created DESC", "deletion_request_id DESC").
+		Limit(util.SafeIntToUInt64(limit))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*deletionRequestDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list deletion requests")
+	}
+
+	requests := make([]*types.DeletionRequest, len(dst))
+	for i, request := range dst {
+		requests[i] = mapToDeletionRequest(request)
+	}
+	return requests, nil
+}
+
+func (d DeletionRequestDao) UpdateState(
+	ctx context.Context,
+	request *types.DeletionRequest,
+	from types.DeletionRequestState,
+) error {
+	stmt := database.Builder.
+		Update("deletion_requests").
+		Set("deletion_request_state", string(request.State)).
+		Set("deletion_request_reviewed_by", request.ReviewedBy).
+		Set("deletion_request_comment", request.Comment).
+		Set("deletion_request_updated", request.UpdatedAt.UnixMilli()).
+		Where("deletion_request_id = ?", request.ID).
+		Where("deletion_request_state = ?", string(from))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Update query failed")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to get number of updated rows")
+	}
+	if count == 0 {
+		return gitnessstore.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (d DeletionRequestDao) ExpireStale(ctx context.Context, registryID int64, now int64) (int64, error) {
+	stmt := database.Builder.
+		Update("deletion_requests").
+		Set("deletion_request_state", string(types.DeletionRequestStateExpired)).
+		Set("deletion_request_updated", now).
+		Where("deletion_request_registry_id = ?", registryID).
+		Where("deletion_request_state = ?", string(types.DeletionRequestStatePending)).
+		Where("deletion_request_expires <= ?", now)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Failed to expire deletion requests")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Failed to get number of expired rows")
+	}
+	return count, nil
+}
+
+func mapToDeletionRequestDB(request *types.DeletionRequest) *deletionRequestDB {
+	dst := &deletionRequestDB{
+		ID:          request.ID,
+		RegistryID:  request.RegistryID,
+		ImageName:   request.ImageName,
+		Version:     request.Version,
+		State:       string(request.State),
+		RequestedBy: request.RequestedBy,
+		Comment:     request.Comment,
+		Created:     request.CreatedAt.UnixMilli(),
+		Updated:     request.UpdatedAt.UnixMilli(),
+		Expires:     request.ExpiresAt.UnixMilli(),
+	}
+	if request.ReviewedBy != nil {
+		dst.ReviewedBy = sql.NullInt64{Int64: *request.ReviewedBy, Valid: true}
+	}
+	return dst
+}
+
+func mapToDeletionRequest(dst *deletionRequestDB) *types.DeletionRequest {
+	request := &types.DeletionRequest{
+		ID:          dst.ID,
+		RegistryID:  dst.RegistryID,
+		ImageName:   dst.ImageName,
+		Version:     dst.Version,
+		State:       types.DeletionRequestState(dst.State),
+		RequestedBy: dst.RequestedBy,
+		Comment:     dst.Comment,
+		CreatedAt:   time.UnixMilli(dst.Created),
+		UpdatedAt:   time.UnixMilli(dst.Updated),
+		ExpiresAt:   time.UnixMilli(dst.Expires),
+	}
+	if dst.ReviewedBy.Valid {
+		reviewedBy := dst.ReviewedBy.Int64
+		request.ReviewedBy = &reviewedBy
+	}
+	return request
+}
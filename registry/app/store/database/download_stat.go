@@ -26,7 +26,6 @@ import (
 	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/registry/types"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
@@ -35,15 +34,57 @@ import (
 )
 
 type DownloadStatDao struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	modes  store.DownloadStatModeResolver
+	limits util.Limits
 }
 
-func NewDownloadStatDao(db *sqlx.DB) store.DownloadStatRepository {
+func NewDownloadStatDao(
+	db *sqlx.DB, modes store.DownloadStatModeResolver, limits util.Limits,
+) store.DownloadStatRepository {
 	return &DownloadStatDao{
-		db: db,
+		db:     db,
+		modes:  modes,
+		limits: limits,
 	}
 }
 
+// downloadStatDailyConflict adds the downloads to the counter of the day when the version was already downloaded
+// that day.
+const downloadStatDailyConflict = `ON CONFLICT (download_stat_daily_artifact_id, download_stat_daily_day)
+	DO UPDATE SET download_stat_daily_count = download_stats_daily.download_stat_daily_count +
+		EXCLUDED.download_stat_daily_count`
+
+// downloadStatPartitionsAhead is the number of months after the current one whose download_stats partitions are
+// created in advance. Downloads of months without partition go to the default partition.
+const downloadStatPartitionsAhead = 3
+
+// downloadStatPartitionPrefix prefixes the names of the monthly download_stats partitions, which end with the
+// year and month of their downloads.
+const downloadStatPartitionPrefix = "download_stats_"
+
+// downloadStatDefaultPartition is the partition of the downloads of the months without partition.
+const downloadStatDefaultPartition = "download_stats_default"
+
+// mode returns how the downloads of the registry are recorded. Registries whose mode can't be resolved only get
+// aggregated counters, so that no per-request record is stored for a space which asked for privacy.
+func (d DownloadStatDao) mode(ctx context.Context, registryID int64) types.DownloadStatMode {
+	if d.modes == nil || registryID == 0 {
+		return types.DownloadStatModeDetailed
+	}
+	mode, err := d.modes.DownloadStatMode(ctx, registryID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to resolve download stat mode of registry %d", registryID)
+		return types.DownloadStatModeAggregated
+	}
+	return mode
+}
+
+// downloadStatDay returns the start of the UTC day of t in milliseconds.
+func downloadStatDay(t time.Time) int64 {
+	return t.UTC().Truncate(24 * time.Hour).UnixMilli()
+}
+
 type downloadStatDB struct {
 	ID         int64 `db:"download_stat_id"`
 	ArtifactID int64 `db:"download_stat_artifact_id"`
@@ -55,6 +96,10 @@ type downloadStatDB struct {
 }
 
 func (d DownloadStatDao) Create(ctx context.Context, downloadStat *types.DownloadStat) error {
+	if d.mode(ctx, downloadStat.RegistryID) == types.DownloadStatModeAggregated {
+		return d.createAggregated(ctx, downloadStat.ArtifactID)
+	}
+
 	const sqlQuery = `
 		INSERT INTO download_stats ( 
 		         download_stat_artifact_id
@@ -72,32 +117,65 @@ func (d DownloadStatDao) Create(ctx context.Context, downloadStat *types.Downloa
 				        ,:download_stat_updated_by							
 		    ) 		   
         RETURNING download_stat_id`
-	db := dbtx.GetAccessor(ctx, d.db)
+	db := util.GetAccessor(ctx, d.db, d.limits)
 	query, arg, err := db.BindNamed(sqlQuery, d.mapToInternalDownloadStat(ctx, downloadStat))
 	if err != nil {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind download stat object")
 	}
 
-	if err = db.QueryRowContext(ctx, query,
-		arg...).Scan(&downloadStat.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	if err = db.GetContext(ctx, &downloadStat.ID, query,
+		arg...); err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
 	}
 	return nil
 }
 
+// createAggregated counts a download of the artifact in the counter of the day, without recording who downloaded.
+func (d DownloadStatDao) createAggregated(ctx context.Context, artifactID int64) error {
+	stmt := databaseg.Builder.
+		Insert("download_stats_daily").
+		Columns(
+			"download_stat_daily_artifact_id",
+			"download_stat_daily_day",
+			"download_stat_daily_count",
+		).
+		Values(artifactID, downloadStatDay(time.Now()), 1).
+		Suffix(downloadStatDailyConflict)
+
+	sqlStr, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to generate SQL: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+	if _, err = db.ExecContext(ctx, sqlStr, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Upsert of aggregated download stat failed")
+	}
+	return nil
+}
+
 func (d DownloadStatDao) CreateByRegistryIDImageAndArtifactName(
 	ctx context.Context,
 	regID int64, image string, version string, artifactType *artifact.ArtifactType,
 ) error {
+	aggregated := d.mode(ctx, regID) == types.DownloadStatModeAggregated
+	columns := []string{
+		"download_stat_artifact_id",
+		"download_stat_timestamp",
+		"download_stat_created_at",
+		"download_stat_updated_at",
+		"download_stat_created_by",
+		"download_stat_updated_by",
+	}
+	values := []string{"a.artifact_id", "?", "?", "?", "?", "?"}
+	table := "download_stats"
+	if aggregated {
+		columns = []string{"download_stat_daily_artifact_id", "download_stat_daily_day", "download_stat_daily_count"}
+		values = []string{"a.artifact_id", "?", "1"}
+		table = "download_stats_daily"
+	}
 	selectQuery := databaseg.Builder.
-		Select(
-			"a.artifact_id",
-			"?",
-			"?",
-			"?",
-			"?",
-			"?",
-		).
+		Select(values...).
 		From("artifacts a").
 		Join("images i ON a.artifact_image_id = i.image_id").
 		Where("a.artifact_version = ? AND i.image_registry_id = ? AND i.image_name = ? ")
@@ -108,16 +186,12 @@ func (d DownloadStatDao) CreateByRegistryIDImageAndArtifactName(
 	}
 	selectQuery = selectQuery.Limit(1)
 	insertQuery := databaseg.Builder.
-		Insert("download_stats").
-		Columns(
-			"download_stat_artifact_id",
-			"download_stat_timestamp",
-			"download_stat_created_at",
-			"download_stat_updated_at",
-			"download_stat_created_by",
-			"download_stat_updated_by",
-		).
+		Insert(table).
+		Columns(columns...).
 		Select(selectQuery)
+	if aggregated {
+		insertQuery = insertQuery.Suffix(downloadStatDailyConflict)
+	}
 
 	// Convert query to SQL string and args
 	sqlStr, _, err := insertQuery.ToSql()
@@ -127,11 +201,15 @@ func (d DownloadStatDao) CreateByRegistryIDImageAndArtifactName(
 
 	session, _ := request.AuthSessionFrom(ctx)
 	user := session.Principal.ID
-	db := dbtx.GetAccessor(ctx, d.db)
+	db := util.GetAccessor(ctx, d.db, d.limits)
 
 	// Execute the query with parameters
-	now := time.Now().UnixMilli()
-	args := []interface{}{now, now, now, user, user, version, regID, image}
+	now := time.Now()
+	args := []interface{}{now.UnixMilli(), now.UnixMilli(), now.UnixMilli(), user, user}
+	if aggregated {
+		args = []interface{}{downloadStatDay(now)}
+	}
+	args = append(args, version, regID, image)
 
 	// Only add artifactType parameter if the WHERE clause includes it
 	if artifactType != nil && *artifactType != "" {
@@ -145,10 +223,11 @@ func (d DownloadStatDao) CreateByRegistryIDImageAndArtifactName(
 
 	return nil
 }
+
 func (d DownloadStatDao) GetTotalDownloadsForImage(ctx context.Context, imageID int64) (int64, error) {
-	q := databaseg.Builder.Select(`count(*)`).
+	q := databaseg.Builder.Select(`COALESCE(SUM(ds.download_stat_count), 0)`).
 		From("artifacts art").Where("art.artifact_image_id = ?", imageID).
-		Join("download_stats ds ON ds.download_stat_artifact_id = art.artifact_id")
+		Join("download_stat_counts ds ON ds.download_stat_artifact_id = art.artifact_id")
 
 	sql, args, err := q.ToSql()
 	if err != nil {
@@ -158,10 +237,10 @@ func (d DownloadStatDao) GetTotalDownloadsForImage(ctx context.Context, imageID
 	finalQuery := util.FormatQuery(sql, args)
 	log.Ctx(ctx).Debug().Str("sql", finalQuery).Msg("Executing GetTotalDownloadsForImage query")
 	// Execute query
-	db := dbtx.GetAccessor(ctx, d.db)
+	db := util.GetAccessor(ctx, d.db, d.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -169,8 +248,8 @@ func (d DownloadStatDao) GetTotalDownloadsForImage(ctx context.Context, imageID
 }
 
 func (d DownloadStatDao) GetTotalDownloadsForArtifactID(ctx context.Context, artifactID int64) (int64, error) {
-	q := databaseg.Builder.Select(`count(*)`).
-		From("download_stats ds").Where("ds.download_stat_artifact_id = ?", artifactID)
+	q := databaseg.Builder.Select(`COALESCE(SUM(ds.download_stat_count), 0)`).
+		From("download_stat_counts ds").Where("ds.download_stat_artifact_id = ?", artifactID)
 
 	sql, args, err := q.ToSql()
 	if err != nil {
@@ -180,10 +259,10 @@ func (d DownloadStatDao) GetTotalDownloadsForArtifactID(ctx context.Context, art
 	finalQuery := util.FormatQuery(sql, args)
 	log.Ctx(ctx).Debug().Str("sql", finalQuery).Msg("Executing GetTotalDownloadsForArtifact query")
 	// Execute query
-	db := dbtx.GetAccessor(ctx, d.db)
+	db := util.GetAccessor(ctx, d.db, d.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -195,9 +274,9 @@ func (d DownloadStatDao) GetTotalDownloadsForManifests(
 	artifactVersions []string,
 	imageID int64,
 ) (map[string]int64, error) {
-	q := databaseg.Builder.Select(`art.artifact_version, count(*) as count`).
+	q := databaseg.Builder.Select(`art.artifact_version, SUM(ds.download_stat_count) as count`).
 		From("artifacts art").
-		Join("download_stats ds ON ds.download_stat_artifact_id = art.artifact_id").Where(sq.And{
+		Join("download_stat_counts ds ON ds.download_stat_artifact_id = art.artifact_id").Where(sq.And{
 		sq.Eq{"artifact_image_id": imageID},
 		sq.Eq{"artifact_version": artifactVersions},
 	}, "art").GroupBy("art.artifact_version")
@@ -210,7 +289,7 @@ func (d DownloadStatDao) GetTotalDownloadsForManifests(
 	finalQuery := util.FormatQuery(sql, args)
 	log.Ctx(ctx).Debug().Str("sql", finalQuery).Msg("Executing GetTotalDownloadsForManifests query")
 	// Execute query
-	db := dbtx.GetAccessor(ctx, d.db)
+	db := util.GetAccessor(ctx, d.db, d.limits)
 
 	dst := []*versionsCountDB{}
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -226,6 +305,173 @@ func (d DownloadStatDao) GetTotalDownloadsForManifests(
 	return result, nil
 }
 
+// GetTimeSeries returns the downloads of each version of the image from from until to, which is excluded, counted
+// by the periods of interval. The buckets are ordered by version and start, periods without downloads are left out.
+func (d DownloadStatDao) GetTimeSeries(
+	ctx context.Context,
+	imageID int64,
+	from time.Time,
+	to time.Time,
+	interval types.DownloadStatInterval,
+) ([]types.DownloadStatBucket, error) {
+	q := databaseg.Builder.Select(`art.artifact_version, ds.download_stat_day, SUM(ds.download_stat_count) as count`).
+		From("artifacts art").
+		Join("download_stat_counts ds ON ds.download_stat_artifact_id = art.artifact_id").
+		Where("art.artifact_image_id = ?", imageID).
+		Where("ds.download_stat_day >= ?", downloadStatDay(from)).
+		Where("ds.download_stat_day < ?", downloadStatDay(to)).
+		GroupBy("art.artifact_version", "ds.download_stat_day").
+		OrderBy("art.artifact_version", "ds.download_stat_day")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+	finalQuery := util.FormatQuery(sql, args)
+	log.Ctx(ctx).Debug().Str("sql", finalQuery).Msg("Executing GetTimeSeries query")
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*versionDayCountDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing query")
+	}
+
+	// The days are folded into the periods of the interval, the rows of a period are consecutive as they're sorted.
+	buckets := []types.DownloadStatBucket{}
+	for _, v := range dst {
+		start := interval.BucketStart(time.UnixMilli(v.Day))
+		last := len(buckets) - 1
+		if last >= 0 && buckets[last].Version == v.Version && buckets[last].Start.Equal(start) {
+			buckets[last].Count += v.Count
+			continue
+		}
+		buckets = append(buckets, types.DownloadStatBucket{Version: v.Version, Start: start, Count: v.Count})
+	}
+	return buckets, nil
+}
+
+type versionDayCountDB struct {
+	Version string `db:"artifact_version"`
+	Day     int64  `db:"download_stat_day"`
+	Count   int64  `db:"count"`
+}
+
+// RollUp adds the downloads recorded from the end of the previous roll up until the start of the day of until to
+// the daily counters. The counters and the end of the roll up are written in the transaction of the context, so the
+// download_stat_counts view counts each download once.
+func (d DownloadStatDao) RollUp(ctx context.Context, until time.Time) (int64, error) {
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var from int64
+	if err := db.GetContext(ctx, &from, `SELECT download_stat_rollup_until FROM download_stat_rollups
+		WHERE download_stat_rollup_id = 1`); err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get the end of the previous roll up")
+	}
+	to := downloadStatDay(until)
+	if to <= from {
+		return 0, nil
+	}
+
+	stmt := databaseg.Builder.
+		Insert("download_stats_daily").
+		Columns(
+			"download_stat_daily_artifact_id",
+			"download_stat_daily_day",
+			"download_stat_daily_count",
+		).
+		Select(databaseg.Builder.
+			Select(
+				"download_stat_artifact_id",
+				"download_stat_timestamp - download_stat_timestamp % 86400000",
+				"COUNT(*)",
+			).
+			From("download_stats").
+			Where(sq.GtOrEq{"download_stat_timestamp": from}).
+			Where(sq.Lt{"download_stat_timestamp": to}).
+			GroupBy("1", "2")).
+		Suffix(downloadStatDailyConflict)
+
+	sqlStr, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate SQL: %w", err)
+	}
+	result, err := db.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to roll up download stats")
+	}
+	counters, err := result.RowsAffected()
+	if err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of rolled up download stats")
+	}
+
+	// the end of the roll up only moves if no other roll up moved it meanwhile.
+	result, err = db.ExecContext(ctx, `UPDATE download_stat_rollups SET download_stat_rollup_until = $1
+		WHERE download_stat_rollup_id = 1 AND download_stat_rollup_until = $2`, to, from)
+	if err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to update the end of the roll up")
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of updated roll ups")
+	}
+	if updated == 0 {
+		return 0, fmt.Errorf("download stats were rolled up concurrently")
+	}
+	return counters, nil
+}
+
+// CreatePartitions creates the download_stats partitions of the month of now and of the following months which
+// don't exist yet. The downloads the default partition holds for the month of a new partition are moved to it, so
+// partitions created late don't conflict with them. It must be called in a transaction, SQLite tables aren't
+// partitioned.
+func (d DownloadStatDao) CreatePartitions(ctx context.Context, now time.Time) error {
+	if d.db.DriverName() == SQLITE3 {
+		return nil
+	}
+
+	now = now.UTC()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i <= downloadStatPartitionsAhead; i++ {
+		if err := d.createPartition(ctx, month.AddDate(0, i, 0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d DownloadStatDao) createPartition(ctx context.Context, start time.Time) error {
+	db := util.GetAccessor(ctx, d.db, d.limits)
+	name := downloadStatPartitionPrefix + start.Format("200601")
+
+	var exists bool
+	if err := db.GetContext(ctx, &exists, `SELECT to_regclass($1) IS NOT NULL`, name); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to check download stats partition of %s",
+			start.Format("2006-01"))
+	}
+	if exists {
+		return nil
+	}
+
+	from, to := start.UnixMilli(), start.AddDate(0, 1, 0).UnixMilli()
+	// the lock keeps downloads of the month from being inserted into the default partition until the partition
+	// is attached.
+	stmts := []string{
+		fmt.Sprintf(`LOCK TABLE %s IN SHARE ROW EXCLUSIVE MODE`, downloadStatDefaultPartition),
+		fmt.Sprintf(`CREATE TABLE %s (LIKE download_stats INCLUDING DEFAULTS)`, name),
+		fmt.Sprintf(`WITH moved AS (DELETE FROM %s WHERE download_stat_timestamp >= %d
+			AND download_stat_timestamp < %d RETURNING *) INSERT INTO %s SELECT * FROM moved`,
+			downloadStatDefaultPartition, from, to, name),
+		fmt.Sprintf(`ALTER TABLE download_stats ATTACH PARTITION %s FOR VALUES FROM (%d) TO (%d)`, name, from, to),
+	}
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to create download stats partition of %s",
+				start.Format("2006-01"))
+		}
+	}
+	return nil
+}
+
 func (d DownloadStatDao) mapToInternalDownloadStat(
 	ctx context.Context,
 	in *types.DownloadStat,
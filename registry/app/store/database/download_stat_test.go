@@ -0,0 +1,96 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/app/store/database/util"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+const day = 24 * time.Hour
+
+func createDownload(t *testing.T, db *sqlx.DB, artifactID int64, at time.Time) {
+	t.Helper()
+	_, err := db.Exec(`INSERT INTO download_stats (download_stat_artifact_id, download_stat_timestamp,
+		download_stat_created_at, download_stat_updated_at, download_stat_created_by, download_stat_updated_by)
+		VALUES (?, ?, ?, ?, 1, 1)`, artifactID, at.UnixMilli(), at.UnixMilli(), at.UnixMilli())
+	require.NoError(t, err)
+}
+
+func setRolledUpUntil(t *testing.T, db *sqlx.DB, until time.Time) {
+	t.Helper()
+	_, err := db.Exec(`UPDATE download_stat_rollups SET download_stat_rollup_until = ?`, until.UnixMilli())
+	require.NoError(t, err)
+}
+
+func rolledUpUntil(t *testing.T, db *sqlx.DB) time.Time {
+	t.Helper()
+	var until int64
+	require.NoError(t, db.Get(&until, `SELECT download_stat_rollup_until FROM download_stat_rollups`))
+	return time.UnixMilli(until).UTC()
+}
+
+func TestDownloadStatRollUp(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	dao := database.NewDownloadStatDao(db, nil, util.Limits{})
+
+	today := time.Now().UTC().Truncate(day)
+	registryID := createRegistry(t, db, "generic")
+	artifactID := createArtifact(t, db, createImage(t, db, registryID, "app"), "1.0.0")
+	setRolledUpUntil(t, db, today.Add(-3*day))
+	createDownload(t, db, artifactID, today.Add(-2*day+time.Hour))
+	createDownload(t, db, artifactID, today.Add(-day+time.Hour))
+	createDownload(t, db, artifactID, today.Add(-day+2*time.Hour))
+	createDownload(t, db, artifactID, today.Add(time.Minute))
+
+	total, err := dao.GetTotalDownloadsForArtifactID(ctx, artifactID)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), total)
+
+	counters, err := dao.RollUp(ctx, today.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), counters, "the downloads of the past two days are rolled up")
+	require.Equal(t, today, rolledUpUntil(t, db))
+
+	var counts []int64
+	require.NoError(t, db.Select(&counts, `SELECT download_stat_daily_count FROM download_stats_daily
+		ORDER BY download_stat_daily_day`))
+	require.Equal(t, []int64{1, 2}, counts)
+
+	total, err = dao.GetTotalDownloadsForArtifactID(ctx, artifactID)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), total, "the rolled up downloads are counted once")
+
+	counters, err = dao.RollUp(ctx, today.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, counters, "the day is only rolled up once it ended")
+	require.Equal(t, today, rolledUpUntil(t, db))
+}
+
+func TestDownloadStatCreatePartitions(t *testing.T) {
+	db := setupDB(t)
+	dao := database.NewDownloadStatDao(db, nil, util.Limits{})
+
+	// SQLite tables aren't partitioned.
+	require.NoError(t, dao.CreatePartitions(context.Background(), time.Now()))
+}
@@ -0,0 +1,215 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type EventOutboxDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+const (
+	eventOutboxColumns = `
+		 registry_event_outbox_id
+		,registry_event_outbox_kind
+		,registry_event_outbox_payload
+		,registry_event_outbox_attempts
+		,registry_event_outbox_next_attempt_at
+		,registry_event_outbox_last_error
+		,registry_event_outbox_created_at`
+)
+
+func (d EventOutboxDao) Create(ctx context.Context, event *types.OutboxEvent) error {
+	const sqlQuery = `
+		INSERT INTO registry_event_outbox (
+			 registry_event_outbox_kind
+			,registry_event_outbox_payload
+			,registry_event_outbox_attempts
+			,registry_event_outbox_next_attempt_at
+			,registry_event_outbox_last_error
+			,registry_event_outbox_created_at
+		) values (
+			 :registry_event_outbox_kind
+			,:registry_event_outbox_payload
+			,:registry_event_outbox_attempts
+			,:registry_event_outbox_next_attempt_at
+			,:registry_event_outbox_last_error
+			,:registry_event_outbox_created_at
+		) RETURNING registry_event_outbox_id`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToEventOutboxDB(event))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind outbox event object")
+	}
+
+	if err = db.GetContext(ctx, &event.ID, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+
+	return nil
+}
+
+func (d EventOutboxDao) ListDue(ctx context.Context, now time.Time, limit int) ([]*types.OutboxEvent, error) {
+	stmt := database.Builder.
+		Select(eventOutboxColumns).
+		From("registry_event_outbox").
+		Where("registry_event_outbox_next_attempt_at <= ?", now.UnixMilli()).
+		OrderBy("registry_event_outbox_id ASC").
+		Limit(util.SafeIntToUInt64(limit))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*eventOutboxDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select query failed")
+	}
+
+	events := make([]*types.OutboxEvent, len(dst))
+	for i, event := range dst {
+		events[i] = mapToOutboxEvent(event)
+	}
+	return events, nil
+}
+
+func (d EventOutboxDao) Claim(ctx context.Context, event *types.OutboxEvent, leaseUntil time.Time) (bool, error) {
+	stmt := database.Builder.
+		Update("registry_event_outbox").
+		Set("registry_event_outbox_next_attempt_at", leaseUntil.UnixMilli()).
+		Set("registry_event_outbox_attempts", event.Attempts+1).
+		Where("registry_event_outbox_id = ?", event.ID).
+		Where("registry_event_outbox_attempts = ?", event.Attempts)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return false, database.ProcessSQLErrorf(ctx, err, "Failed to claim outbox event")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, database.ProcessSQLErrorf(ctx, err, "Failed to get number of updated rows")
+	}
+	if count == 0 {
+		return false, nil
+	}
+
+	event.Attempts++
+	event.NextAttemptAt = leaseUntil
+	return true, nil
+}
+
+func (d EventOutboxDao) Fail(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	stmt := database.Builder.
+		Update("registry_event_outbox").
+		Set("registry_event_outbox_next_attempt_at", nextAttemptAt.UnixMilli()).
+		Set("registry_event_outbox_last_error", lastError).
+		Where("registry_event_outbox_id = ?", id)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to update outbox event")
+	}
+	return nil
+}
+
+func (d EventOutboxDao) Delete(ctx context.Context, id int64) error {
+	stmt := database.Builder.
+		Delete("registry_event_outbox").
+		Where("registry_event_outbox_id = ?", id)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to delete outbox event")
+	}
+	return nil
+}
+
+func NewEventOutboxDao(db *sqlx.DB, limits util.Limits) store.EventOutboxRepository {
+	return &EventOutboxDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type eventOutboxDB struct {
+	ID            int64  `db:"registry_event_outbox_id"`
+	Kind          string `db:"registry_event_outbox_kind"`
+	Payload       []byte `db:"registry_event_outbox_payload"`
+	Attempts      int    `db:"registry_event_outbox_attempts"`
+	NextAttemptAt int64  `db:"registry_event_outbox_next_attempt_at"`
+	LastError     string `db:"registry_event_outbox_last_error"`
+	CreatedAt     int64  `db:"registry_event_outbox_created_at"`
+}
+
+func mapToOutboxEvent(dst *eventOutboxDB) *types.OutboxEvent {
+	return &types.OutboxEvent{
+		ID:            dst.ID,
+		Kind:          types.OutboxEventKind(dst.Kind),
+		Payload:       dst.Payload,
+		Attempts:      dst.Attempts,
+		NextAttemptAt: time.UnixMilli(dst.NextAttemptAt),
+		LastError:     dst.LastError,
+		CreatedAt:     time.UnixMilli(dst.CreatedAt),
+	}
+}
+
+func mapToEventOutboxDB(event *types.OutboxEvent) *eventOutboxDB {
+	return &eventOutboxDB{
+		ID:            event.ID,
+		Kind:          string(event.Kind),
+		Payload:       event.Payload,
+		Attempts:      event.Attempts,
+		NextAttemptAt: event.NextAttemptAt.UnixMilli(),
+		LastError:     event.LastError,
+		CreatedAt:     event.CreatedAt.UnixMilli(),
+	}
+}
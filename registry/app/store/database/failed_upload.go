@@ -0,0 +1,254 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type FailedUploadDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+const (
+	failedUploadColumns = `
+		 registry_failed_upload_id
+		,registry_failed_upload_uuid
+		,registry_failed_upload_registry_id
+		,registry_failed_upload_package_type
+		,registry_failed_upload_filename
+		,registry_failed_upload_sha256
+		,registry_failed_upload_size
+		,registry_failed_upload_error
+		,registry_failed_upload_created_by
+		,registry_failed_upload_created_at
+		,registry_failed_upload_expires_at`
+)
+
+func (d FailedUploadDao) Create(ctx context.Context, upload *types.FailedUpload) error {
+	const sqlQuery = `
+		INSERT INTO registry_failed_uploads (
+			 registry_failed_upload_uuid
+			,registry_failed_upload_registry_id
+			,registry_failed_upload_package_type
+			,registry_failed_upload_filename
+			,registry_failed_upload_sha256
+			,registry_failed_upload_size
+			,registry_failed_upload_error
+			,registry_failed_upload_created_by
+			,registry_failed_upload_created_at
+			,registry_failed_upload_expires_at
+		) values (
+			 :registry_failed_upload_uuid
+			,:registry_failed_upload_registry_id
+			,:registry_failed_upload_package_type
+			,:registry_failed_upload_filename
+			,:registry_failed_upload_sha256
+			,:registry_failed_upload_size
+			,:registry_failed_upload_error
+			,:registry_failed_upload_created_by
+			,:registry_failed_upload_created_at
+			,:registry_failed_upload_expires_at
+		) RETURNING registry_failed_upload_id`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToFailedUploadDB(upload))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind failed upload object")
+	}
+
+	if err = db.GetContext(ctx, &upload.ID, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+
+	return nil
+}
+
+func (d FailedUploadDao) GetByUUID(ctx context.Context, registryID int64, uuid string) (*types.FailedUpload, error) {
+	stmt := database.Builder.
+		Select(failedUploadColumns).
+		From("registry_failed_uploads").
+		Where("registry_failed_upload_registry_id = ?", registryID).
+		Where("registry_failed_upload_uuid = ?", uuid)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := new(failedUploadDB)
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find failed upload")
+	}
+
+	return mapToFailedUpload(dst), nil
+}
+
+func (d FailedUploadDao) ListForRegistry(
+	ctx context.Context,
+	registryID int64,
+	limit int,
+	offset int,
+) ([]*types.FailedUpload, error) {
+	stmt := database.Builder.
+		Select(failedUploadColumns).
+		From("registry_failed_uploads").
+		Where("registry_failed_upload_registry_id = ?", registryID).
+		OrderBy("registry_failed_upload_created_at DESC", "registry_failed_upload_id DESC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+
+	return d.list(ctx, stmt.ToSql)
+}
+
+func (d FailedUploadDao) CountForRegistry(ctx context.Context, registryID int64) (int64, error) {
+	stmt := database.Builder.
+		Select("COUNT(*)").
+		From("registry_failed_uploads").
+		Where("registry_failed_upload_registry_id = ?", registryID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var count int64
+	if err = db.GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Count query failed")
+	}
+
+	return count, nil
+}
+
+func (d FailedUploadDao) ListExpired(ctx context.Context, before time.Time, limit int) ([]*types.FailedUpload, error) {
+	stmt := database.Builder.
+		Select(failedUploadColumns).
+		From("registry_failed_uploads").
+		Where("registry_failed_upload_expires_at < ?", before.UnixMilli()).
+		OrderBy("registry_failed_upload_expires_at ASC").
+		Limit(util.SafeIntToUInt64(limit))
+
+	return d.list(ctx, stmt.ToSql)
+}
+
+func (d FailedUploadDao) Delete(ctx context.Context, id int64) error {
+	stmt := database.Builder.
+		Delete("registry_failed_uploads").
+		Where("registry_failed_upload_id = ?", id)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to delete failed upload")
+	}
+	return nil
+}
+
+func (d FailedUploadDao) list(
+	ctx context.Context,
+	toSQL func() (string, []any, error),
+) ([]*types.FailedUpload, error) {
+	sql, args, err := toSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*failedUploadDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select query failed")
+	}
+
+	uploads := make([]*types.FailedUpload, len(dst))
+	for i, upload := range dst {
+		uploads[i] = mapToFailedUpload(upload)
+	}
+	return uploads, nil
+}
+
+func NewFailedUploadDao(db *sqlx.DB, limits util.Limits) store.FailedUploadRepository {
+	return &FailedUploadDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type failedUploadDB struct {
+	ID          int64  `db:"registry_failed_upload_id"`
+	UUID        string `db:"registry_failed_upload_uuid"`
+	RegistryID  int64  `db:"registry_failed_upload_registry_id"`
+	PackageType string `db:"registry_failed_upload_package_type"`
+	Filename    string `db:"registry_failed_upload_filename"`
+	Sha256      string `db:"registry_failed_upload_sha256"`
+	Size        int64  `db:"registry_failed_upload_size"`
+	Error       string `db:"registry_failed_upload_error"`
+	CreatedBy   int64  `db:"registry_failed_upload_created_by"`
+	CreatedAt   int64  `db:"registry_failed_upload_created_at"`
+	ExpiresAt   int64  `db:"registry_failed_upload_expires_at"`
+}
+
+func mapToFailedUpload(dst *failedUploadDB) *types.FailedUpload {
+	return &types.FailedUpload{
+		ID:          dst.ID,
+		UUID:        dst.UUID,
+		RegistryID:  dst.RegistryID,
+		PackageType: artifact.PackageType(dst.PackageType),
+		Filename:    dst.Filename,
+		Sha256:      dst.Sha256,
+		Size:        dst.Size,
+		Error:       dst.Error,
+		CreatedBy:   dst.CreatedBy,
+		CreatedAt:   time.UnixMilli(dst.CreatedAt),
+		ExpiresAt:   time.UnixMilli(dst.ExpiresAt),
+	}
+}
+
+func mapToFailedUploadDB(upload *types.FailedUpload) *failedUploadDB {
+	return &failedUploadDB{
+		ID:          upload.ID,
+		UUID:        upload.UUID,
+		RegistryID:  upload.RegistryID,
+		PackageType: string(upload.PackageType),
+		Filename:    upload.Filename,
+		Sha256:      upload.Sha256,
+		Size:        upload.Size,
+		Error:       upload.Error,
+		CreatedBy:   upload.CreatedBy,
+		CreatedAt:   upload.CreatedAt.UnixMilli(),
+		ExpiresAt:   upload.ExpiresAt.UnixMilli(),
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type FirewallApprovalDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewFirewallApprovalDao(db *sqlx.DB, limits util.Limits) store.FirewallApprovalRepository {
+	return &FirewallApprovalDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+const (
+	firewallApprovalColumns = `
+		 firewall_approval_id
+		,firewall_approval_registry_id
+		,firewall_approval_package
+		,firewall_approval_version
+		,firewall_approval_reason
+		,firewall_approval_created_by
+		,firewall_approval_created`
+)
+
+type firewallApprovalDB struct {
+	ID         int64  `db:"firewall_approval_id"`
+	RegistryID int64  `db:"firewall_approval_registry_id"`
+	Package    string `db:"firewall_approval_package"`
+	Version    string `db:"firewall_approval_version"`
+	Reason     string `db:"firewall_approval_reason"`
+	CreatedBy  int64  `db:"firewall_approval_created_by"`
+	Created    int64  `db:"firewall_approval_created"`
+}
+
+func (d FirewallApprovalDao) Create(ctx context.Context, approval *types.FirewallApproval) error {
+	const sqlQuery = `
+		INSERT INTO firewall_approvals (
+			 firewall_approval_registry_id
+			,firewall_approval_package
+			,firewall_approval_version
+			,firewall_approval_reason
+			,firewall_approval_created_by
+			,firewall_approval_created
+		) values (
+			 :firewall_approval_registry_id
+			,:firewall_approval_package
+			,:firewall_approval_version
+			,:firewall_approval_reason
+			,:firewall_approval_created_by
+			,:firewall_approval_created
+		) RETURNING firewall_approval_id`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToFirewallApprovalDB(approval))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind firewall approval object")
+	}
+
+	if err = db.GetContext(ctx, &approval.ID, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (d FirewallApprovalDao) List(ctx context.Context, registryID int64) ([]*types.FirewallApproval, error) {
+	stmt := database.Builder.
+		Select(firewallApprovalColumns).
+		From("firewall_approvals").
+		Where("firewall_approval_registry_id = ?", registryID).
+		OrderBy("firewall_approval_created DESC", "firewall_approval_id DESC")
+
+	return d.list(ctx, stmt)
+}
+
+func (d FirewallApprovalDao) ListByPackage(
+	ctx context.Context,
+	registryID int64,
+	pkg string,
+) ([]*types.FirewallApproval, error) {
+	stmt := database.Builder.
+		Select(firewallApprovalColumns).
+		From("firewall_approvals").
+		Where("firewall_approval_registry_id = ?", registryID).
+		Where("firewall_approval_package = ?", pkg)
+
+	return d.list(ctx, stmt)
+}
+
+func (d FirewallApprovalDao) list(
+	ctx context.Context,
+	stmt squirrel.SelectBuilder,
+) ([]*types.FirewallApproval, error) {
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*firewallApprovalDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list firewall approvals")
+	}
+
+	approvals := make([]*types.FirewallApproval, len(dst))
+	for i, approval := range dst {
+		approvals[i] = mapToFirewallApproval(approval)
+	}
+	return approvals, nil
+}
+
+func (d FirewallApprovalDao) Delete(ctx context.Context, registryID int64, pkg string, version string) error {
+	stmt := database.Builder.
+		Delete("firewall_approvals").
+		Where("firewall_approval_registry_id = ?", registryID).
+		Where("firewall_approval_package = ?", pkg).
+		Where("firewall_approval_version = ?", version)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to delete firewall approval")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitnessstore.ErrResourceNotFound
+	}
+	return nil
+}
+
+func mapToFirewallApprovalDB(approval *types.FirewallApproval) *firewallApprovalDB {
+	return &firewallApprovalDB{
+		ID:         approval.ID,
+		RegistryID: approval.RegistryID,
+		Package:    approval.Package,
+		Version:    approval.Version,
+		Reason:     approval.Reason,
+		CreatedBy:  approval.CreatedBy,
+		Created:    approval.CreatedAt.UnixMilli(),
+	}
+}
+
+func mapToFirewallApproval(dst *firewallApprovalDB) *types.FirewallApproval {
+	return &types.FirewallApproval{
+		ID:         dst.ID,
+		RegistryID: dst.RegistryID,
+		Package:    dst.Package,
+		Version:    dst.Version,
+		Reason:     dst.Reason,
+		CreatedBy:  dst.CreatedBy,
+		CreatedAt:  time.UnixMilli(dst.Created),
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type GarbageDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewGarbageDao(db *sqlx.DB, limits util.Limits) store.GarbageRepository {
+	return &GarbageDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type garbageStatDB struct {
+	RootParentID int64            `db:"root_parent_id"`
+	Age          types.GarbageAge `db:"age"`
+	Count        int64            `db:"count"`
+	Size         int64            `db:"size"`
+}
+
+func (g GarbageDao) GetStats(ctx context.Context, rootParentID int64, now time.Time) ([]types.GarbageStat, error) {
+	tags, err := g.getStats(ctx, types.GarbageKindTag, "tags", "tag_registry_id", "tag_deleted_at", "",
+		rootParentID, now)
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := g.getStats(ctx, types.GarbageKindManifest, "manifests", "manifest_registry_id",
+		"manifest_deleted_at", "manifest_total_size", rootParentID, now)
+	if err != nil {
+		return nil, err
+	}
+	return append(tags, manifests...), nil
+}
+
+// getStats groups the soft-deleted rows of a table by account and age. An empty sizeColumn reports
+// no size for the rows.
+func (g GarbageDao) getStats(
+	ctx context.Context,
+	kind types.GarbageKind,
+	table string,
+	registryColumn string,
+	deletedAtColumn string,
+	sizeColumn string,
+	rootParentID int64,
+	now time.Time,
+) ([]types.GarbageStat, error) {
+	var ageCase strings.Builder
+	ageArgs := make([]any, 0, len(types.GarbageAgeBounds))
+	ageCase.WriteString("CASE")
+	for _, bound := range types.GarbageAgeBounds {
+		fmt.Fprintf(&ageCase, " WHEN g.%s > ? THEN '%s'", deletedAtColumn, bound.Age)
+		ageArgs = append(ageArgs, now.Add(-bound.MaxAge).UnixMilli())
+	}
+	fmt.Fprintf(&ageCase, " ELSE '%s' END AS age", types.GarbageAgeOlder)
+
+	size := "0 AS size"
+	if sizeColumn != "" {
+		size = fmt.Sprintf("COALESCE(SUM(g.%s), 0) AS size", sizeColumn)
+	}
+
+	q := database.Builder.
+		Select("r.registry_root_parent_id AS root_parent_id").
+		Column(sq.Expr(ageCase.String(), ageArgs...)).
+		Column("COUNT(*) AS count").
+		Column(size).
+		From(table+" g").
+		Join("registries r ON r.registry_id = g."+registryColumn).
+		Where("g."+deletedAtColumn+" IS NOT NULL").
+		GroupBy("r.registry_root_parent_id", "age")
+
+	if rootParentID > 0 {
+		q = q.Where("r.registry_root_parent_id = ?", rootParentID)
+	}
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, g.db, g.limits)
+
+	dst := []*garbageStatDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to get garbage stats of %s", table)
+	}
+
+	stats := make([]types.GarbageStat, len(dst))
+	for i, s := range dst {
+		stats[i] = types.GarbageStat{
+			RootParentID: s.RootParentID,
+			Kind:         kind,
+			Age:          s.Age,
+			Count:        s.Count,
+			Size:         s.Size,
+		}
+	}
+	return stats, nil
+}
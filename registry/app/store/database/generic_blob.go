@@ -24,7 +24,6 @@ import (
 	"github.com/harness/gitness/registry/types"
 	store2 "github.com/harness/gitness/store"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -32,7 +31,8 @@ import (
 )
 
 type GenericBlobDao struct {
-	sqlDB *sqlx.DB
+	sqlDB  *sqlx.DB
+	limits util.Limits
 }
 
 func (g GenericBlobDao) FindByID(ctx context.Context, id string) (*types.GenericBlob, error) {
@@ -41,7 +41,7 @@ func (g GenericBlobDao) FindByID(ctx context.Context, id string) (*types.Generic
 		From("generic_blobs").
 		Where("generic_blob_id = ?", id)
 
-	db := dbtx.GetAccessor(ctx, g.sqlDB)
+	db := util.GetAccessor(ctx, g.sqlDB, g.limits)
 
 	dst := new(GenericBlob)
 	sql, args, err := q.ToSql()
@@ -62,7 +62,7 @@ func (g GenericBlobDao) TotalSizeByRootParentID(ctx context.Context, rootID int6
 		From("generic_blobs").
 		Where("generic_blob_root_parent_id = ?", rootID)
 
-	db := dbtx.GetAccessor(ctx, g.sqlDB)
+	db := util.GetAccessor(ctx, g.sqlDB, g.limits)
 
 	var size int64
 	sqlQuery, args, err := q.ToSql()
@@ -70,7 +70,7 @@ func (g GenericBlobDao) TotalSizeByRootParentID(ctx context.Context, rootID int6
 		return 0, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	if err = db.QueryRowContext(ctx, sqlQuery, args...).Scan(&size); err != nil &&
+	if err = db.GetContext(ctx, &size, sqlQuery, args...); err != nil &&
 		!errors.Is(err, sql.ErrNoRows) {
 		return 0,
 			databaseg.ProcessSQLErrorf(ctx, err, "Failed to find total blob size for root parent with id %d", rootID)
@@ -88,7 +88,7 @@ func (g GenericBlobDao) FindBySha256AndRootParentID(
 		From("generic_blobs").
 		Where("generic_blob_root_parent_id = ? AND generic_blob_sha_256 = ?", rootParentID, sha256)
 
-	db := dbtx.GetAccessor(ctx, g.sqlDB)
+	db := util.GetAccessor(ctx, g.sqlDB, g.limits)
 
 	dst := new(GenericBlob)
 	sql, args, err := q.ToSql()
@@ -129,13 +129,13 @@ func (g GenericBlobDao) Create(ctx context.Context, gb *types.GenericBlob) (stri
         DO UPDATE SET generic_blob_id = generic_blobs.generic_blob_id
         RETURNING generic_blob_id`
 
-	db := dbtx.GetAccessor(ctx, g.sqlDB)
+	db := util.GetAccessor(ctx, g.sqlDB, g.limits)
 	query, arg, err := db.BindNamed(sqlQuery, g.mapToInternalGenericBlob(gb))
 	if err != nil {
 		return "", false, databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind generic blob object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&gb.ID); err != nil {
+	if err = db.GetContext(ctx, &gb.ID, query, arg...); err != nil {
 		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, store2.ErrDuplicate) {
 			return "", false, nil
 		}
@@ -144,9 +144,18 @@ func (g GenericBlobDao) Create(ctx context.Context, gb *types.GenericBlob) (stri
 	return gb.ID, true, nil
 }
 
-func (g GenericBlobDao) DeleteByID(_ context.Context, _ string) error {
-	// TODO implement me
-	panic("implement me")
+// DeleteByID deletes the blob unless files still reference it, as uploads of the same content share the blob.
+func (g GenericBlobDao) DeleteByID(ctx context.Context, id string) error {
+	const sqlQuery = `
+		DELETE FROM generic_blobs
+		WHERE generic_blob_id = $1
+		AND NOT EXISTS (SELECT 1 FROM nodes WHERE node_generic_blob_id = $1)`
+
+	db := util.GetAccessor(ctx, g.sqlDB, g.limits)
+	if _, err := db.ExecContext(ctx, sqlQuery, id); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to delete generic blob %s", id)
+	}
+	return nil
 }
 
 func (g GenericBlobDao) mapToGenericBlob(_ context.Context, dst *GenericBlob) (*types.GenericBlob, error) {
@@ -184,9 +193,10 @@ func (g GenericBlobDao) mapToInternalGenericBlob(gb *types.GenericBlob) any {
 	}
 }
 
-func NewGenericBlobDao(sqlDB *sqlx.DB) store.GenericBlobRepository {
+func NewGenericBlobDao(sqlDB *sqlx.DB, limits util.Limits) store.GenericBlobRepository {
 	return &GenericBlobDao{
-		sqlDB: sqlDB,
+		sqlDB:  sqlDB,
+		limits: limits,
 	}
 }
 
@@ -28,7 +28,6 @@ import (
 	"github.com/harness/gitness/registry/types"
 	gitness_store "github.com/harness/gitness/store"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -36,12 +35,14 @@ import (
 )
 
 type ImageDao struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	limits util.Limits
 }
 
-func NewImageDao(db *sqlx.DB) store.ImageRepository {
+func NewImageDao(db *sqlx.DB, limits util.Limits) store.ImageRepository {
 	return &ImageDao{
-		db: db,
+		db:     db,
+		limits: limits,
 	}
 }
 
@@ -69,7 +70,7 @@ func (i ImageDao) GetByUUID(ctx context.Context, uuid string) (*types.Image, err
 		From("images").
 		Where("image_uuid = ?", uuid)
 
-	db := dbtx.GetAccessor(ctx, i.db)
+	db := util.GetAccessor(ctx, i.db, i.limits)
 
 	dst := new(imageDB)
 	sql, args, err := stmt.ToSql()
@@ -94,7 +95,7 @@ func (i ImageDao) Get(ctx context.Context, id int64) (*types.Image, error) {
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, i.db)
+	db := util.GetAccessor(ctx, i.db, i.limits)
 
 	dst := new(imageDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -112,7 +113,7 @@ func (i ImageDao) DeleteByImageNameAndRegID(ctx context.Context, regID int64, im
 		return errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, i.db)
+	db := util.GetAccessor(ctx, i.db, i.limits)
 
 	_, err = db.ExecContext(ctx, sql, args...)
 	if err != nil {
@@ -134,7 +135,7 @@ func (i ImageDao) DeleteByImageNameIfNoLinkedArtifacts(
 		return errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, i.db)
+	db := util.GetAccessor(ctx, i.db, i.limits)
 
 	_, err = db.ExecContext(ctx, sql, args...)
 	if err != nil {
@@ -154,7 +155,7 @@ func (i ImageDao) GetByName(ctx context.Context, registryID int64, name string)
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, i.db)
+	db := util.GetAccessor(ctx, i.db, i.limits)
 
 	dst := new(imageDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -181,7 +182,7 @@ func (i ImageDao) GetByNameAndType(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, i.db)
+	db := util.GetAccessor(ctx, i.db, i.limits)
 
 	dst := new(imageDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -227,13 +228,13 @@ func (i ImageDao) CreateOrUpdate(ctx context.Context, image *types.Image) error
 			   image_enabled = :image_enabled
             RETURNING image_id`
 
-	db := dbtx.GetAccessor(ctx, i.db)
+	db := util.GetAccessor(ctx, i.db, i.limits)
 	query, arg, err := db.BindNamed(sqlQuery, i.mapToInternalImage(ctx, image))
 	if err != nil {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind image object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&image.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	if err = db.GetContext(ctx, &image.ID, query, arg...); err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
 	}
 	return nil
@@ -262,7 +263,7 @@ func (i ImageDao) GetLabelsByParentIDAndRepo(
 
 	dst := []*imageLabelDB{}
 
-	db := dbtx.GetAccessor(ctx, i.db)
+	db := util.GetAccessor(ctx, i.db, i.limits)
 
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
 		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get artifact labels")
@@ -289,7 +290,7 @@ func (i ImageDao) CountLabelsByParentIDAndRepo(
 		return -1, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, i.db)
+	db := util.GetAccessor(ctx, i.db, i.limits)
 
 	dst := []*imageLabelDB{}
 
@@ -317,7 +318,7 @@ func (i ImageDao) GetByRepoAndName(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, i.db)
+	db := util.GetAccessor(ctx, i.db, i.limits)
 
 	dst := new(imageDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -335,7 +336,7 @@ func (i ImageDao) Update(ctx context.Context, image *types.Image) (err error) {
 	// update Version (used for optimistic locking) and Updated time
 	dbImage.UpdatedAt = time.Now().UnixMilli()
 
-	db := dbtx.GetAccessor(ctx, i.db)
+	db := util.GetAccessor(ctx, i.db, i.limits)
 
 	query, arg, err := db.BindNamed(sqlQuery, dbImage)
 	if err != nil {
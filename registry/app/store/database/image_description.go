@@ -0,0 +1,193 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ImageDescriptionDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+const (
+	imageDescriptionColumns = `
+		 image_description_id
+		,image_description_image_id
+		,image_description_revision
+		,image_description_content
+		,image_description_created_by
+		,image_description_created_at`
+)
+
+// Create records an edit of the description of an image as the next revision of the image.
+func (d ImageDescriptionDao) Create(ctx context.Context, description *types.ImageDescription) error {
+	const sqlQuery = `
+		INSERT INTO image_descriptions (
+			 image_description_image_id
+			,image_description_revision
+			,image_description_content
+			,image_description_created_by
+			,image_description_created_at
+		) values (
+			 :image_description_image_id
+			,(SELECT COALESCE(MAX(image_description_revision), 0) + 1
+				FROM image_descriptions
+				WHERE image_description_image_id = :image_description_image_id)
+			,:image_description_content
+			,:image_description_created_by
+			,:image_description_created_at
+		) RETURNING image_description_id, image_description_revision`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	created := mapToImageDescriptionDB(description)
+	query, arg, err := db.BindNamed(sqlQuery, created)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind image description object")
+	}
+
+	if err = db.GetContext(ctx, created, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	description.ID = created.ID
+	description.Revision = created.Revision
+
+	return nil
+}
+
+func (d ImageDescriptionDao) GetLatest(ctx context.Context, imageID int64) (*types.ImageDescription, error) {
+	stmt := database.Builder.
+		Select(imageDescriptionColumns).
+		From("image_descriptions").
+		Where("image_description_image_id = ?", imageID).
+		OrderBy("image_description_revision DESC").
+		Limit(1)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := new(imageDescriptionDB)
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find image description")
+	}
+	return mapToImageDescription(dst), nil
+}
+
+func (d ImageDescriptionDao) ListForImage(
+	ctx context.Context,
+	imageID int64,
+	limit int,
+	offset int,
+) ([]*types.ImageDescription, error) {
+	stmt := database.Builder.
+		Select(imageDescriptionColumns).
+		From("image_descriptions").
+		Where("image_description_image_id = ?", imageID).
+		OrderBy("image_description_revision DESC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*imageDescriptionDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select query failed")
+	}
+
+	descriptions := make([]*types.ImageDescription, len(dst))
+	for i, description := range dst {
+		descriptions[i] = mapToImageDescription(description)
+	}
+	return descriptions, nil
+}
+
+func (d ImageDescriptionDao) CountForImage(ctx context.Context, imageID int64) (int64, error) {
+	stmt := database.Builder.
+		Select("COUNT(*)").
+		From("image_descriptions").
+		Where("image_description_image_id = ?", imageID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var count int64
+	if err = db.GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Count query failed")
+	}
+
+	return count, nil
+}
+
+func NewImageDescriptionDao(db *sqlx.DB, limits util.Limits) store.ImageDescriptionRepository {
+	return &ImageDescriptionDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type imageDescriptionDB struct {
+	ID        int64  `db:"image_description_id"`
+	ImageID   int64  `db:"image_description_image_id"`
+	Revision  int64  `db:"image_description_revision"`
+	Content   string `db:"image_description_content"`
+	CreatedBy int64  `db:"image_description_created_by"`
+	CreatedAt int64  `db:"image_description_created_at"`
+}
+
+func mapToImageDescription(dst *imageDescriptionDB) *types.ImageDescription {
+	return &types.ImageDescription{
+		ID:        dst.ID,
+		ImageID:   dst.ImageID,
+		Revision:  dst.Revision,
+		Content:   dst.Content,
+		CreatedBy: dst.CreatedBy,
+		CreatedAt: time.UnixMilli(dst.CreatedAt),
+	}
+}
+
+func mapToImageDescriptionDB(description *types.ImageDescription) *imageDescriptionDB {
+	return &imageDescriptionDB{
+		ID:        description.ID,
+		ImageID:   description.ImageID,
+		Revision:  description.Revision,
+		Content:   description.Content,
+		CreatedBy: description.CreatedBy,
+		CreatedAt: description.CreatedAt.UnixMilli(),
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type ImageStarDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewImageStarDao(db *sqlx.DB, limits util.Limits) store.ImageStarRepository {
+	return &ImageStarDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type starredImageDB struct {
+	ImageID          int64                  `db:"image_id"`
+	ImageName        string                 `db:"image_name"`
+	ArtifactType     *artifact.ArtifactType `db:"image_type"`
+	RegistryID       int64                  `db:"registry_id"`
+	RegistryName     string                 `db:"registry_name"`
+	RegistryParentID int64                  `db:"registry_parent_id"`
+	PackageType      artifact.PackageType   `db:"registry_package_type"`
+	StarCount        int64                  `db:"image_star_count"`
+	StarredAt        int64                  `db:"image_star_created_at"`
+}
+
+// imageNameTotal is a total per image name, of the stars or the downloads of the images.
+type imageNameTotal struct {
+	ImageName string `db:"image_name"`
+	Total     int64  `db:"total"`
+}
+
+func (d ImageStarDao) Star(ctx context.Context, imageID int64, principalID int64) (bool, error) {
+	stmt := database.Builder.
+		Insert("image_stars").
+		Columns("image_star_image_id", "image_star_principal_id", "image_star_created_at").
+		Values(imageID, principalID, time.Now().UnixMilli()).
+		Suffix("ON CONFLICT (image_star_image_id, image_star_principal_id) DO NOTHING")
+
+	starred, err := d.exec(ctx, stmt)
+	if err != nil || !starred {
+		return false, err
+	}
+	return true, d.addToStarCount(ctx, imageID, 1)
+}
+
+func (d ImageStarDao) Unstar(ctx context.Context, imageID int64, principalID int64) (bool, error) {
+	stmt := database.Builder.
+		Delete("image_stars").
+		Where("image_star_image_id = ? AND image_star_principal_id = ?", imageID, principalID)
+
+	unstarred, err := d.exec(ctx, stmt)
+	if err != nil || !unstarred {
+		return false, err
+	}
+	return true, d.addToStarCount(ctx, imageID, -1)
+}
+
+// exec runs the statement and returns whether it changed a row.
+func (d ImageStarDao) exec(ctx context.Context, stmt sq.Sqlizer) (bool, error) {
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return false, database.ProcessSQLErrorf(ctx, err, "Failed to update image star")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, database.ProcessSQLErrorf(ctx, err, "Failed to get number of updated rows")
+	}
+	return count > 0, nil
+}
+
+func (d ImageStarDao) addToStarCount(ctx context.Context, imageID int64, delta int64) error {
+	stmt := database.Builder.
+		Update("images").
+		Set("image_star_count", sq.Expr("image_star_count + ?", delta)).
+		Where("image_id = ?", imageID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to update star count of image")
+	}
+	return nil
+}
+
+func (d ImageStarDao) IsStarred(ctx context.Context, imageID int64, principalID int64) (bool, error) {
+	stmt := database.Builder.
+		Select("COUNT(*)").
+		From("image_stars").
+		Where("image_star_image_id = ? AND image_star_principal_id = ?", imageID, principalID)
+
+	count, err := d.count(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (d ImageStarDao) GetStarCount(ctx context.Context, imageID int64) (int64, error) {
+	stmt := database.Builder.
+		Select("image_star_count").
+		From("images").
+		Where("image_id = ?", imageID)
+
+	return d.count(ctx, stmt)
+}
+
+func (d ImageStarDao) GetStarCountsByImageNames(
+	ctx context.Context, registryID int64, imageNames []string,
+) (map[string]int64, error) {
+	counts := make(map[string]int64, len(imageNames))
+	if len(imageNames) == 0 {
+		return counts, nil
+	}
+
+	// images of different artifact types may share a name, their stars are added up.
+	stmt := database.Builder.
+		Select("image_name, SUM(image_star_count) AS total").
+		From("images").
+		Where("image_registry_id = ?", registryID).
+		Where(sq.Eq{"image_name": imageNames}).
+		GroupBy("image_name")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var dst []imageNameTotal
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed executing star counts query")
+	}
+	for _, total := range dst {
+		counts[total.ImageName] = total.Total
+	}
+	return counts, nil
+}
+
+func (d ImageStarDao) ListByPrincipal(
+	ctx context.Context,
+	principalID int64,
+	limit int,
+	offset int,
+) ([]*types.StarredImage, error) {
+	stmt := database.Builder.
+		Select(`i.image_id, i.image_name, i.image_type, i.image_star_count, s.image_star_created_at,
+			r.registry_id, r.registry_name, r.registry_parent_id, r.registry_package_type`).
+		From("image_stars s").
+		Join("images i ON i.image_id = s.image_star_image_id").
+		Join("registries r ON r.registry_id = i.image_registry_id").
+		Where("s.image_star_principal_id = ?", principalID).
+		OrderBy("s.image_star_created_at DESC", "i.image_id DESC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*starredImageDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select query failed")
+	}
+
+	images := make([]*types.StarredImage, len(dst))
+	for i, image := range dst {
+		images[i] = mapToStarredImage(image)
+	}
+	return images, nil
+}
+
+func (d ImageStarDao) CountByPrincipal(ctx context.Context, principalID int64) (int64, error) {
+	stmt := database.Builder.
+		Select("COUNT(*)").
+		From("image_stars s").
+		Join("images i ON i.image_id = s.image_star_image_id").
+		Join("registries r ON r.registry_id = i.image_registry_id").
+		Where("s.image_star_principal_id = ?", principalID)
+
+	return d.count(ctx, stmt)
+}
+
+func (d ImageStarDao) count(ctx context.Context, stmt sq.SelectBuilder) (int64, error) {
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var count int64
+	if err = db.GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Count query failed")
+	}
+	return count, nil
+}
+
+func mapToStarredImage(dst *starredImageDB) *types.StarredImage {
+	return &types.StarredImage{
+		ImageID:          dst.ImageID,
+		ImageName:        dst.ImageName,
+		ArtifactType:     dst.ArtifactType,
+		RegistryID:       dst.RegistryID,
+		RegistryName:     dst.RegistryName,
+		RegistryParentID: dst.RegistryParentID,
+		PackageType:      dst.PackageType,
+		StarCount:        dst.StarCount,
+		StarredAt:        time.UnixMilli(dst.StarredAt),
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type IndexBuildDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+const (
+	indexBuildColumns = `
+		 registry_index_build_id
+		,registry_index_build_registry_id
+		,registry_index_build_task_key
+		,registry_index_build_kind
+		,registry_index_build_image
+		,registry_index_build_run_id
+		,registry_index_build_status
+		,registry_index_build_error
+		,registry_index_build_principal_id
+		,registry_index_build_started_at
+		,registry_index_build_duration`
+
+	indexBuildSelectBase = `
+	SELECT` + indexBuildColumns + `
+	FROM registry_index_builds`
+)
+
+func (i IndexBuildDao) Find(ctx context.Context, id int64) (*types.IndexBuild, error) {
+	const sqlQuery = indexBuildSelectBase + `
+	WHERE registry_index_build_id = $1`
+
+	db := util.GetAccessor(ctx, i.db, i.limits)
+
+	dst := &indexBuildDB{}
+	if err := db.GetContext(ctx, dst, sqlQuery, id); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select query failed")
+	}
+
+	return mapToIndexBuild(dst), nil
+}
+
+func (i IndexBuildDao) Create(ctx context.Context, build *types.IndexBuild) error {
+	const sqlQuery = `
+		INSERT INTO registry_index_builds (
+			 registry_index_build_registry_id
+			,registry_index_build_task_key
+			,registry_index_build_kind
+			,registry_index_build_image
+			,registry_index_build_run_id
+			,registry_index_build_status
+			,registry_index_build_error
+			,registry_index_build_principal_id
+			,registry_index_build_started_at
+			,registry_index_build_duration
+		) values (
+			 :registry_index_build_registry_id
+			,:registry_index_build_task_key
+			,:registry_index_build_kind
+			,:registry_index_build_image
+			,:registry_index_build_run_id
+			,:registry_index_build_status
+			,:registry_index_build_error
+			,:registry_index_build_principal_id
+			,:registry_index_build_started_at
+			,:registry_index_build_duration
+		) RETURNING registry_index_build_id`
+
+	db := util.GetAccessor(ctx, i.db, i.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToIndexBuildDB(build))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind index build object")
+	}
+
+	if err = db.GetContext(ctx, &build.ID, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+
+	return nil
+}
+
+func (i IndexBuildDao) Complete(
+	ctx context.Context, id int64, status types.TaskStatus, errMsg string, duration time.Duration,
+) error {
+	stmt := database.Builder.
+		Update("registry_index_builds").
+		Set("registry_index_build_status", status).
+		Set("registry_index_build_error", errMsg).
+		Set("registry_index_build_duration", duration.Milliseconds()).
+		Where("registry_index_build_id = ?", id)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, i.db, i.limits)
+
+	if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Update query failed")
+	}
+
+	return nil
+}
+
+func (i IndexBuildDao) ListForRegistry(
+	ctx context.Context,
+	registryID int64,
+	limit int,
+	page int,
+	size int,
+) ([]*types.IndexBuild, error) {
+	stmt := database.Builder.
+		Select(indexBuildColumns).
+		From("registry_index_builds").
+		Where("registry_index_build_registry_id = ?", registryID)
+
+	stmt = stmt.Limit(database.Limit(limit))
+	stmt = stmt.Offset(database.Offset(page, size))
+
+	// latest builds first.
+	stmt = stmt.OrderBy("registry_index_build_id DESC")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, i.db, i.limits)
+
+	dst := []*indexBuildDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select query failed")
+	}
+
+	builds := make([]*types.IndexBuild, len(dst))
+	for j, build := range dst {
+		builds[j] = mapToIndexBuild(build)
+	}
+	return builds, nil
+}
+
+func (i IndexBuildDao) CountForRegistry(ctx context.Context, registryID int64) (int64, error) {
+	stmt := database.Builder.
+		Select("COUNT(*)").
+		From("registry_index_builds").
+		Where("registry_index_build_registry_id = ?", registryID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, i.db, i.limits)
+
+	var count int64
+	if err = db.GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Count query failed")
+	}
+
+	return count, nil
+}
+
+func NewIndexBuildDao(db *sqlx.DB, limits util.Limits) store.IndexBuildRepository {
+	return &IndexBuildDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type indexBuildDB struct {
+	ID          int64            `db:"registry_index_build_id"`
+	RegistryID  int64            `db:"registry_index_build_registry_id"`
+	TaskKey     string           `db:"registry_index_build_task_key"`
+	Kind        types.TaskKind   `db:"registry_index_build_kind"`
+	Image       string           `db:"registry_index_build_image"`
+	RunID       string           `db:"registry_index_build_run_id"`
+	Status      types.TaskStatus `db:"registry_index_build_status"`
+	Error       string           `db:"registry_index_build_error"`
+	PrincipalID int64            `db:"registry_index_build_principal_id"`
+	StartedAt   int64            `db:"registry_index_build_started_at"`
+	Duration    int64            `db:"registry_index_build_duration"`
+}
+
+func mapToIndexBuild(dst *indexBuildDB) *types.IndexBuild {
+	return &types.IndexBuild{
+		ID:          dst.ID,
+		RegistryID:  dst.RegistryID,
+		TaskKey:     dst.TaskKey,
+		Kind:        dst.Kind,
+		Image:       dst.Image,
+		RunID:       dst.RunID,
+		Status:      dst.Status,
+		Error:       dst.Error,
+		PrincipalID: dst.PrincipalID,
+		StartedAt:   time.UnixMilli(dst.StartedAt),
+		Duration:    time.Duration(dst.Duration) * time.Millisecond,
+	}
+}
+
+func mapToIndexBuildDB(build *types.IndexBuild) *indexBuildDB {
+	return &indexBuildDB{
+		ID:          build.ID,
+		RegistryID:  build.RegistryID,
+		TaskKey:     build.TaskKey,
+		Kind:        build.Kind,
+		Image:       build.Image,
+		RunID:       build.RunID,
+		Status:      build.Status,
+		Error:       build.Error,
+		PrincipalID: build.PrincipalID,
+		StartedAt:   build.StartedAt.UnixMilli(),
+		Duration:    build.Duration.Milliseconds(),
+	}
+}
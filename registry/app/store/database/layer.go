@@ -26,7 +26,6 @@ import (
 	"github.com/harness/gitness/registry/types"
 	store2 "github.com/harness/gitness/store"
 	"github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -34,12 +33,14 @@ import (
 type layersDao struct {
 	db           *sqlx.DB
 	mtRepository store.MediaTypesRepository
+	limits       util.Limits
 }
 
-func NewLayersDao(db *sqlx.DB, mtRepository store.MediaTypesRepository) store.LayerRepository {
+func NewLayersDao(db *sqlx.DB, mtRepository store.MediaTypesRepository, limits util.Limits) store.LayerRepository {
 	return &layersDao{
 		db:           db,
 		mtRepository: mtRepository,
+		limits:       limits,
 	}
 }
 
@@ -98,14 +99,14 @@ func (l layersDao) AssociateLayerBlob(
 		Size:        b.Size,
 	}
 
-	db := dbtx.GetAccessor(ctx, l.db)
+	db := util.GetAccessor(ctx, l.db, l.limits)
 	query, arg, err := db.BindNamed(sqlQuery, l.mapToInternalLayer(ctx, layer))
 	if err != nil {
 		return database.ProcessSQLErrorf(ctx, err, "Bind query failed")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&layer.ID); err != nil {
-		err = database.ProcessSQLErrorf(ctx, err, "QueryRowContext failed")
+	if err = db.GetContext(ctx, &layer.ID, query, arg...); err != nil {
+		err = database.ProcessSQLErrorf(ctx, err, "GetContext failed")
 		if errors.Is(err, store2.ErrDuplicate) {
 			return nil
 		}
@@ -131,7 +132,7 @@ func (l layersDao) GetAllLayersByManifestID(
 	}
 
 	dst := []layersDB{}
-	db := dbtx.GetAccessor(ctx, l.db)
+	db := util.GetAccessor(ctx, l.db, l.limits)
 
 	if err = db.SelectContext(ctx, &dst, toSQL, args...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Failed to find layers")
@@ -28,7 +28,6 @@ import (
 	"github.com/harness/gitness/registry/types"
 	store2 "github.com/harness/gitness/store"
 	"github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/opencontainers/go-digest"
@@ -38,12 +37,16 @@ import (
 type manifestDao struct {
 	sqlDB        *sqlx.DB
 	mtRepository store.MediaTypesRepository
+	limits       util.Limits
 }
 
-func NewManifestDao(sqlDB *sqlx.DB, mtRepository store.MediaTypesRepository) store.ManifestRepository {
+func NewManifestDao(
+	sqlDB *sqlx.DB, mtRepository store.MediaTypesRepository, limits util.Limits,
+) store.ManifestRepository {
 	return &manifestDao{
 		sqlDB:        sqlDB,
 		mtRepository: mtRepository,
+		limits:       limits,
 	}
 }
 
@@ -140,7 +143,9 @@ var (
 			:manifest_created_by,
 			:manifest_updated_at,
 			:manifest_updated_by
-		) ON CONFLICT (manifest_registry_id, manifest_image_name, manifest_digest) DO NOTHING
+		) ON CONFLICT (manifest_registry_id, manifest_image_name, manifest_digest)
+			DO UPDATE SET manifest_deleted_at = NULL
+			WHERE manifests.manifest_deleted_at IS NOT NULL
 			RETURNING manifest_id`
 
 	ReadQuery = database.Builder.Select(
@@ -225,7 +230,7 @@ func (dao manifestDao) References(
 		LeftJoin("blobs ON manifest_configuration_blob_id = blob_id").
 		Where("manifest_ref_registry_id = ?", m.RegistryID).Where("manifest_ref_parent_id = ?", m.ID)
 
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 	dst := []*manifestMetadataDB{}
 
 	toSQL, args, err := stmt.ToSql()
@@ -251,9 +256,10 @@ func (dao manifestDao) ReferencedBy(
 	m *types.Manifest,
 ) (types.Manifests, error) {
 	stmt := ReadQuery.Join("manifest_references ON manifest_ref_parent_id = manifest_id").
-		Where("manifest_ref_registry_id = ?", m.RegistryID).Where("manifest_ref_child_id = ?", m.ID)
+		Where("manifest_ref_registry_id = ?", m.RegistryID).Where("manifest_ref_child_id = ?", m.ID).
+		Where("manifest_deleted_at IS NULL")
 
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 	dst := []*manifestMetadataDB{}
 
 	toSQL, args, err := stmt.ToSql()
@@ -280,7 +286,7 @@ func (dao manifestDao) Create(ctx context.Context, m *types.Manifest) error {
 	}
 	m.MediaTypeID = mediaTypeID
 
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 	manifest, err := mapToInternalManifest(ctx, m)
 	if err != nil {
 		return err
@@ -291,7 +297,7 @@ func (dao manifestDao) Create(ctx context.Context, m *types.Manifest) error {
 		return database.ProcessSQLErrorf(ctx, err, "Failed to bind manifest object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&manifest.ID); err != nil {
+	if err = db.GetContext(ctx, &manifest.ID, query, arg...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Insert query failed")
 		if !errors.Is(err, store2.ErrResourceNotFound) {
 			return err
@@ -313,7 +319,7 @@ func (dao manifestDao) CreateOrFind(ctx context.Context, m *types.Manifest) erro
 	}
 	m.MediaTypeID = mediaTypeID
 
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 	manifest, err := mapToInternalManifest(ctx, m)
 	if err != nil {
 		return err
@@ -324,7 +330,7 @@ func (dao manifestDao) CreateOrFind(ctx context.Context, m *types.Manifest) erro
 		return database.ProcessSQLErrorf(ctx, err, "Failed to bind manifest object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&manifest.ID); err != nil {
+	if err = db.GetContext(ctx, &manifest.ID, query, arg...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Insert query failed")
 		if !errors.Is(err, store2.ErrResourceNotFound) {
 			return err
@@ -376,7 +382,7 @@ func (dao manifestDao) Delete(ctx context.Context, registryID, id int64) error {
 		return fmt.Errorf("failed to convert manifest query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	_, err = db.ExecContext(ctx, toSQL, args...)
 	if err != nil {
@@ -405,7 +411,7 @@ func (dao manifestDao) DeleteManifest(
 		return false, fmt.Errorf("failed to convert manifest query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	r, err := db.ExecContext(ctx, toSQL, args...)
 	if err != nil {
@@ -431,7 +437,7 @@ func (dao manifestDao) DeleteManifestByImageName(
 		return false, fmt.Errorf("failed to convert manifest query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	r, err := db.ExecContext(ctx, toSQL, args...)
 	if err != nil {
@@ -454,7 +460,7 @@ func (dao manifestDao) FindManifestByID(
 	}
 
 	dst := new(manifestMetadataDB)
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	if err = db.GetContext(ctx, dst, toSQL, args...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Failed to find manifest")
@@ -478,7 +484,8 @@ func (dao manifestDao) FindManifestByDigest(
 		Where(
 			"manifest_registry_id = ? AND manifest_image_name = ? AND manifest_digest = ?",
 			repoID, imageName, digestBytes,
-		)
+		).
+		Where("manifest_deleted_at IS NULL")
 
 	toSQL, args, err := stmt.ToSql()
 	if err != nil {
@@ -486,7 +493,7 @@ func (dao manifestDao) FindManifestByDigest(
 	}
 
 	dst := new(manifestMetadataDB)
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	if err = db.GetContext(ctx, dst, toSQL, args...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Failed to find manifest")
@@ -508,7 +515,7 @@ func (dao manifestDao) ListManifestsBySubjectDigest(
 	stmt := ReadQuery.
 		LeftJoin("blobs ON manifest_configuration_blob_id = blob_id").
 		Where(
-			"manifest_registry_id = ? AND manifest_subject_digest = ?",
+			"manifest_registry_id = ? AND manifest_subject_digest = ? AND manifest_deleted_at IS NULL",
 			repoID, digestBytes,
 		)
 
@@ -518,7 +525,7 @@ func (dao manifestDao) ListManifestsBySubjectDigest(
 	}
 
 	dst := []*manifestMetadataDB{}
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	if err = db.SelectContext(ctx, &dst, toSQL, args...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Failed to list manifests")
@@ -541,7 +548,8 @@ func (dao manifestDao) FindManifestByTagName(
 		Join("tags t ON t.tag_registry_id = manifest_registry_id AND t.tag_manifest_id = manifest_id").
 		LeftJoin("blobs ON manifest_configuration_blob_id = blob_id").
 		Where(
-			"manifest_registry_id = ? AND manifest_image_name = ? AND t.tag_name = ?",
+			"manifest_registry_id = ? AND manifest_image_name = ? AND t.tag_name = ?"+
+				" AND t.tag_deleted_at IS NULL AND manifest_deleted_at IS NULL",
 			repoID, imageName, tag,
 		)
 
@@ -551,7 +559,7 @@ func (dao manifestDao) FindManifestByTagName(
 	}
 
 	dst := new(manifestMetadataDB)
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	if err = db.GetContext(ctx, dst, toSQL, args...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Failed to find manifest")
@@ -572,7 +580,8 @@ func (dao manifestDao) FindManifestDigestByTagName(
 			"t.tag_registry_id = m.manifest_registry_id AND "+
 			"t.tag_image_name = m.manifest_image_name").
 		Where(
-			"manifest_registry_id = ? AND manifest_image_name = ? AND t.tag_name = ?",
+			"manifest_registry_id = ? AND manifest_image_name = ? AND t.tag_name = ?"+
+				" AND t.tag_deleted_at IS NULL AND manifest_deleted_at IS NULL",
 			regID, imageName, tag,
 		)
 
@@ -582,7 +591,7 @@ func (dao manifestDao) FindManifestDigestByTagName(
 	}
 
 	var manifestDigestBytes []byte
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	if err = db.GetContext(ctx, &manifestDigestBytes, toSQL, args...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Failed to find manifest digest")
@@ -609,7 +618,7 @@ func (dao manifestDao) GetManifestPayload(
 	stmt := ReadQuery.Join("registries r ON r.registry_id = manifest_registry_id").
 		Where(
 			"r.registry_parent_id = ? AND r.registry_name = ? AND "+
-				"manifest_image_name = ? AND manifest_digest = ?",
+				"manifest_image_name = ? AND manifest_digest = ? AND manifest_deleted_at IS NULL",
 			parentID, repoKey, imageName, digestBytes,
 		)
 
@@ -619,7 +628,7 @@ func (dao manifestDao) GetManifestPayload(
 	}
 
 	dst := new(manifestMetadataDB)
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	if err = db.GetContext(ctx, dst, toSQL, args...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Failed to find manifest payload")
@@ -644,7 +653,8 @@ func (dao manifestDao) FindManifestPayloadByTagName(
 		Join("tags t ON t.tag_manifest_id = manifest_id").
 		Where(
 			"r.registry_parent_id = ? AND r.registry_name = ?"+
-				" AND manifest_image_name = ? AND t.tag_name = ?",
+				" AND manifest_image_name = ? AND t.tag_name = ?"+
+				" AND t.tag_deleted_at IS NULL AND manifest_deleted_at IS NULL",
 			parentID, repoKey, imageName, version,
 		)
 
@@ -654,7 +664,7 @@ func (dao manifestDao) FindManifestPayloadByTagName(
 	}
 
 	dst := new(manifestMetadataDB)
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	if err = db.GetContext(ctx, dst, toSQL, args...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Failed to find manifest")
@@ -679,7 +689,7 @@ func (dao manifestDao) Get(ctx context.Context, manifestID int64) (*types.Manife
 	}
 
 	dst := new(manifestMetadataDB)
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	if err = db.GetContext(ctx, dst, toSQL, args...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Failed to find manifest")
@@ -695,7 +705,7 @@ func (dao manifestDao) ListManifestsBySubject(
 ) (types.Manifests, error) {
 	stmt := ReadQuery.
 		LeftJoin("blobs ON manifest_configuration_blob_id = blob_id").
-		Where("manifest_registry_id = ? AND manifest_subject_id = ?", repoID, id)
+		Where("manifest_registry_id = ? AND manifest_subject_id = ? AND manifest_deleted_at IS NULL", repoID, id)
 
 	toSQL, args, err := stmt.ToSql()
 	if err != nil {
@@ -703,7 +713,7 @@ func (dao manifestDao) ListManifestsBySubject(
 	}
 
 	dst := []*manifestMetadataDB{}
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	if err = db.SelectContext(ctx, &dst, toSQL, args...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Failed to find manifest")
@@ -722,10 +732,11 @@ func (dao manifestDao) GetLatestManifest(ctx context.Context, repoID int64, imag
 	stmt := database.Builder.
 		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(manifestDB{}), ",")).
 		From("manifests").
-		Where("manifest_registry_id = ? AND manifest_image_name = ?", repoID, imageName).
+		Where("manifest_registry_id = ? AND manifest_image_name = ? AND manifest_deleted_at IS NULL",
+			repoID, imageName).
 		OrderBy("manifest_created_at DESC").Limit(1)
 
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	dst := new(manifestMetadataDB)
 	sql, args, err := stmt.ToSql()
@@ -740,25 +751,225 @@ func (dao manifestDao) GetLatestManifest(ctx context.Context, repoID int64, imag
 	return dao.mapToManifest(dst)
 }
 
-func (dao manifestDao) CountByImageName(ctx context.Context, repoID int64, imageName string) (int64, error) {
+func (dao manifestDao) CountByImageName(
+	ctx context.Context, repoID int64, imageName string, opts ...types.QueryOption,
+) (int64, error) {
+	options := types.MakeQueryOptions(opts...)
 	q := database.Builder.Select("COUNT(*)").
 		From("manifests").
-		Where("manifest_registry_id = ? AND manifest_image_name = ?", repoID, imageName)
+		Where("manifest_registry_id = ? AND manifest_image_name = ?", repoID, imageName).
+		Where(options.DeleteFilter.Condition("manifest_deleted_at"))
 	sql, args, err := q.ToSql()
 	if err != nil {
 		return -1, errors2.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, database.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
 	return count, nil
 }
 
+// SoftDelete marks a manifest and the tags pointing to it as deleted. Soft-deleted manifests keep their blobs
+// referenced until they are purged.
+func (dao manifestDao) SoftDelete(ctx context.Context, registryID, id int64) error {
+	deletedAt := time.Now().UnixMilli()
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
+
+	stmt := database.Builder.Update("manifests").
+		Set("manifest_deleted_at", deletedAt).
+		Where("manifest_registry_id = ? AND manifest_id = ? AND manifest_deleted_at IS NULL", registryID, id)
+	toSQL, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert manifest query to sql: %w", err)
+	}
+	r, err := db.ExecContext(ctx, toSQL, args...)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "the soft delete query failed")
+	}
+	if count, _ := r.RowsAffected(); count == 0 {
+		return store2.ErrResourceNotFound
+	}
+
+	stmt = database.Builder.Update("tags").
+		Set("tag_deleted_at", deletedAt).
+		Where("tag_registry_id = ? AND tag_manifest_id = ? AND tag_deleted_at IS NULL", registryID, id)
+	toSQL, args, err = stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert tag query to sql: %w", err)
+	}
+	if _, err = db.ExecContext(ctx, toSQL, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "the soft delete query failed")
+	}
+	return nil
+}
+
+// Restore restores a soft-deleted manifest together with the tags which were deleted along with it.
+// A boolean is returned to denote whether the manifest was restored.
+func (dao manifestDao) Restore(ctx context.Context, registryID, id int64) (bool, error) {
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
+
+	q := database.Builder.Select("manifest_deleted_at").
+		From("manifests").
+		Where("manifest_registry_id = ? AND manifest_id = ? AND manifest_deleted_at IS NOT NULL", registryID, id)
+	toSQL, args, err := q.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to convert manifest query to sql: %w", err)
+	}
+	var deletedAt int64
+	if err = db.GetContext(ctx, &deletedAt, toSQL, args...); err != nil {
+		err = database.ProcessSQLErrorf(ctx, err, "Failed to find deleted manifest")
+		if errors.Is(err, store2.ErrResourceNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	stmt := database.Builder.Update("manifests").
+		Set("manifest_deleted_at", nil).
+		Where("manifest_registry_id = ? AND manifest_id = ?", registryID, id)
+	toSQL, args, err = stmt.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to convert manifest query to sql: %w", err)
+	}
+	if _, err = db.ExecContext(ctx, toSQL, args...); err != nil {
+		return false, database.ProcessSQLErrorf(ctx, err, "the restore query failed")
+	}
+
+	stmt = database.Builder.Update("tags").
+		Set("tag_deleted_at", nil).
+		Where("tag_registry_id = ? AND tag_manifest_id = ? AND tag_deleted_at = ?", registryID, id, deletedAt)
+	toSQL, args, err = stmt.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to convert tag query to sql: %w", err)
+	}
+	if _, err = db.ExecContext(ctx, toSQL, args...); err != nil {
+		return false, database.ProcessSQLErrorf(ctx, err, "the restore query failed")
+	}
+	return true, nil
+}
+
+// FindDeletedManifestByDigest finds a soft-deleted manifest by digest within a repository.
+func (dao manifestDao) FindDeletedManifestByDigest(
+	ctx context.Context, repoID int64,
+	imageName string, digest types.Digest,
+) (*types.Manifest, error) {
+	digestBytes, err := util.GetHexDecodedBytes(string(digest))
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := ReadQuery.
+		Where(
+			"manifest_registry_id = ? AND manifest_image_name = ? AND manifest_digest = ?",
+			repoID, imageName, digestBytes,
+		).
+		Where("manifest_deleted_at IS NOT NULL")
+
+	toSQL, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert manifest query to sql: %w", err)
+	}
+
+	dst := new(manifestMetadataDB)
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
+
+	if err = db.GetContext(ctx, dst, toSQL, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find deleted manifest")
+	}
+
+	return dao.mapToManifest(dst)
+}
+
+// ListDeletedManifests lists the soft-deleted manifests of a registry, most recently deleted first.
+func (dao manifestDao) ListDeletedManifests(
+	ctx context.Context,
+	registryID int64,
+) (*[]types.TrashedOCIVersion, error) {
+	stmt := database.Builder.
+		Select("manifest_image_name AS image_name, manifest_digest, manifest_deleted_at AS deleted_at").
+		From("manifests").
+		Where("manifest_registry_id = ? AND manifest_deleted_at IS NOT NULL", registryID).
+		OrderBy("manifest_deleted_at DESC")
+
+	toSQL, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors2.Wrap(err, "Failed to convert query to sql")
+	}
+
+	dst := []*trashedOCIVersionDB{}
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
+
+	if err = db.SelectContext(ctx, &dst, toSQL, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list deleted manifests")
+	}
+	return mapToTrashedOCIVersions(dst)
+}
+
+// ListDeletedBefore lists up to limit manifests of all registries which were soft-deleted before the given time,
+// the oldest first.
+func (dao manifestDao) ListDeletedBefore(
+	ctx context.Context,
+	before time.Time,
+	limit int,
+) (types.Manifests, error) {
+	stmt := ReadQuery.
+		Where("manifest_deleted_at IS NOT NULL AND manifest_deleted_at < ?", before.UnixMilli()).
+		OrderBy("manifest_deleted_at ASC").
+		Limit(util.SafeIntToUInt64(limit))
+
+	toSQL, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert manifest query to sql: %w", err)
+	}
+
+	dst := []*manifestMetadataDB{}
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
+
+	if err = db.SelectContext(ctx, &dst, toSQL, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list deleted manifests")
+	}
+
+	result, err := dao.mapToManifests(dst)
+	if err != nil {
+		return nil, err
+	}
+	return *result, nil
+}
+
+// GetTopImagesBySize returns the images of the registry with the largest manifests. Layers shared between
+// the manifests of an image are counted for every manifest.
+func (dao manifestDao) GetTopImagesBySize(
+	ctx context.Context,
+	registryID int64,
+	limit int,
+) ([]types.ImageUsage, error) {
+	stmt := database.Builder.
+		Select("manifest_image_name AS image_name, COALESCE(SUM(manifest_total_size), 0) AS bytes").
+		From("manifests").
+		Where("manifest_registry_id = ? AND manifest_deleted_at IS NULL", registryID).
+		GroupBy("manifest_image_name").
+		OrderBy("bytes DESC").
+		Limit(uint64(limit)) //nolint:gosec
+
+	toSQL, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors2.Wrap(err, "Failed to convert query to sql")
+	}
+
+	dst := []*imageUsageDB{}
+	db := util.GetAccessor(ctx, dao.sqlDB, dao.limits)
+
+	if err = db.SelectContext(ctx, &dst, toSQL, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list image sizes")
+	}
+	return mapToImageUsages(dst), nil
+}
+
 func mapToInternalManifest(ctx context.Context, in *types.Manifest) (*manifestDB, error) {
 	if in.CreatedAt.IsZero() {
 		in.CreatedAt = time.Now()
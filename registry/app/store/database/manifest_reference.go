@@ -26,18 +26,19 @@ import (
 	"github.com/harness/gitness/registry/types"
 	store2 "github.com/harness/gitness/store"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/jmoiron/sqlx"
 )
 
 type manifestReferenceDao struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	limits util.Limits
 }
 
-func NewManifestReferenceDao(db *sqlx.DB) store.ManifestReferenceRepository {
+func NewManifestReferenceDao(db *sqlx.DB, limits util.Limits) store.ManifestReferenceRepository {
 	return &manifestReferenceDao{
-		db: db,
+		db:     db,
+		limits: limits,
 	}
 }
 
@@ -86,14 +87,14 @@ func (dao manifestReferenceDao) AssociateManifest(
 		ChildID:    m.ID,
 	}
 
-	db := dbtx.GetAccessor(ctx, dao.db)
+	db := util.GetAccessor(ctx, dao.db, dao.limits)
 	query, arg, err := db.BindNamed(sqlQuery, mapToInternalManifestReference(ctx, manifestRef))
 	if err != nil {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Bind query failed")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&manifestRef.ID); err != nil {
-		err = databaseg.ProcessSQLErrorf(ctx, err, "QueryRowContext failed")
+	if err = db.GetContext(ctx, &manifestRef.ID, query, arg...); err != nil {
+		err = databaseg.ProcessSQLErrorf(ctx, err, "GetContext failed")
 		if errors.Is(err, store2.ErrResourceNotFound) {
 			return nil
 		}
@@ -22,14 +22,14 @@ import (
 	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/jmoiron/sqlx"
 	errors2 "github.com/pkg/errors"
 )
 
 type mediaTypesDao struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	limits util.Limits
 }
 
 type mediaTypeDB struct {
@@ -39,9 +39,10 @@ type mediaTypeDB struct {
 	IsRunnable bool   `db:"is_runnable"`
 }
 
-func NewMediaTypesDao(db *sqlx.DB) store.MediaTypesRepository {
+func NewMediaTypesDao(db *sqlx.DB, limits util.Limits) store.MediaTypesRepository {
 	return &mediaTypesDao{
-		db: db,
+		db:     db,
+		limits: limits,
 	}
 }
 
@@ -54,7 +55,7 @@ func (mt mediaTypesDao) MediaTypeExists(ctx context.Context, mediaType string) (
 	args = append(args, mediaType)
 
 	var exists bool
-	db := dbtx.GetAccessor(ctx, mt.db)
+	db := util.GetAccessor(ctx, mt.db, mt.limits)
 
 	if err = db.GetContext(ctx, &exists, sql, args...); err != nil {
 		return false, database.ProcessSQLErrorf(ctx, err, "Failed to check if media type exists")
@@ -76,7 +77,7 @@ func (mt mediaTypesDao) GetMediaTypeByID(
 	}
 
 	dst := new(mediaTypeDB)
-	db := dbtx.GetAccessor(ctx, mt.db)
+	db := util.GetAccessor(ctx, mt.db, mt.limits)
 
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
 		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find media type")
@@ -99,7 +100,7 @@ func (mt mediaTypesDao) MapMediaType(ctx context.Context, mediaType string) (int
 		From("media_types").
 		Where("mt_media_type = ?", mediaType)
 
-	db := dbtx.GetAccessor(ctx, mt.db)
+	db := util.GetAccessor(ctx, mt.db, mt.limits)
 	var id int64
 	sql, args, err := stmt.ToSql()
 	if err != nil {
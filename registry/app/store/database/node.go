@@ -25,7 +25,6 @@ import (
 	"github.com/harness/gitness/registry/types"
 	store2 "github.com/harness/gitness/store"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
@@ -34,7 +33,8 @@ import (
 )
 
 type NodeDao struct {
-	sqlDB *sqlx.DB
+	sqlDB  *sqlx.DB
+	limits util.Limits
 }
 
 func (n NodeDao) GetByPathAndRegistryID(ctx context.Context, registryID int64, path string) (*types.Node, error) {
@@ -43,7 +43,7 @@ func (n NodeDao) GetByPathAndRegistryID(ctx context.Context, registryID int64, p
 		From("nodes").
 		Where("node_path = ? AND node_registry_id = ?", path, registryID)
 
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 
 	dst := new(Nodes)
 	sql, args, err := q.ToSql()
@@ -70,7 +70,7 @@ func (n NodeDao) FindByPathsAndRegistryID(ctx context.Context, paths []string, r
 		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 	var nodes []string
 	if err = db.SelectContext(ctx, &nodes, sql, args...); err != nil {
 		return nil, fmt.Errorf("failed to query nodes: %w", err)
@@ -84,7 +84,7 @@ func (n NodeDao) Get(ctx context.Context, id string) (*types.Node, error) {
 		From("nodes").
 		Where("node_id = ?", id)
 
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 
 	dst := new(Nodes)
 	sql, args, err := q.ToSql()
@@ -105,7 +105,7 @@ func (n NodeDao) GetByNameAndRegistryID(ctx context.Context, registryID int64, n
 		From("nodes").
 		Where("node_name = ? AND node_registry_id = ?", name, registryID)
 
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 
 	dst := new(Nodes)
 	sql, args, err := q.ToSql()
@@ -126,7 +126,7 @@ func (n NodeDao) GetByBlobIDAndRegistryID(ctx context.Context, blobID string, re
 		From("nodes").
 		Where("node_generic_blob_id = ? AND node_registry_id = ?", blobID, registryID).Limit(1)
 
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 
 	dst := new(Nodes)
 	_sql, args, err := q.ToSql()
@@ -141,6 +141,27 @@ func (n NodeDao) GetByBlobIDAndRegistryID(ctx context.Context, blobID string, re
 	return n.mapToNode(ctx, dst)
 }
 
+func (n NodeDao) GetRegistryIDsByBlobID(ctx context.Context, blobID string) ([]int64, error) {
+	q := databaseg.Builder.
+		Select("DISTINCT node_registry_id").
+		From("nodes").
+		Where("node_generic_blob_id = ? AND node_is_file = true", blobID).
+		OrderBy("node_registry_id")
+
+	_sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
+
+	var registryIDs []int64
+	if err = db.SelectContext(ctx, &registryIDs, _sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find registries of blob %s", blobID)
+	}
+	return registryIDs, nil
+}
+
 func (n NodeDao) FindByPathAndRegistryID(
 	ctx context.Context, registryID int64, pathPrefix string, filename string,
 ) (*types.Node, error) {
@@ -151,7 +172,7 @@ func (n NodeDao) FindByPathAndRegistryID(
 			registryID, pathPrefix+"/%", filename).
 		OrderBy("node_created_at DESC").Limit(1)
 
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 
 	dst := new(Nodes)
 	sql, args, err := q.ToSql()
@@ -174,7 +195,7 @@ func (n NodeDao) CountByPathAndRegistryID(
 		From("nodes").
 		Where("node_is_file = true AND node_path LIKE ? AND node_registry_id = ?", path, registryID)
 
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 
 	sql, args, err := q.ToSql()
 	if err != nil {
@@ -182,7 +203,7 @@ func (n NodeDao) CountByPathAndRegistryID(
 	}
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing node count query")
 	}
@@ -216,13 +237,13 @@ func (n NodeDao) Create(ctx context.Context, node *types.Node) error {
 			node_generic_blob_id = :node_generic_blob_id
 		    RETURNING node_id`
 
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 	query, arg, err := db.BindNamed(sqlQuery, n.mapToInternalNode(node))
 	if err != nil {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind node object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&node.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	if err = db.GetContext(ctx, &node.ID, query, arg...); err != nil && !errors.Is(err, sql.ErrNoRows) {
 		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, store2.ErrDuplicate) {
 			return nil
 		}
@@ -237,7 +258,7 @@ func (n NodeDao) DeleteByID(_ context.Context, _ int64) (err error) {
 }
 
 func (n NodeDao) DeleteByNodePathAndRegistryID(ctx context.Context, nodePath string, regID int64) (err error) {
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 	delStmt := databaseg.Builder.Delete("nodes").
 		Where("(node_path = ? OR node_path LIKE ?)", nodePath, nodePath+"/%").
 		Where("node_registry_id = ?", regID)
@@ -256,7 +277,7 @@ func (n NodeDao) DeleteByNodePathAndRegistryID(ctx context.Context, nodePath str
 }
 
 func (n NodeDao) DeleteByLeafNodePathAndRegistryID(ctx context.Context, nodePath string, regID int64) (err error) {
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 	delStmt := databaseg.Builder.Delete("nodes").
 		Where("node_path = ?", nodePath).
 		Where("node_registry_id = ?", regID).
@@ -287,7 +308,7 @@ func (n NodeDao) GetAllFileNodesByPathPrefixAndRegistryID(
 		Where("node_registry_id = ? AND node_is_file AND (node_path = ? OR node_path LIKE ?)",
 			registryID, pathPrefix, pathPrefix+"/%")
 
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 
 	sql, args, err := q.ToSql()
 	if err != nil {
@@ -386,7 +407,7 @@ func (n NodeDao) GetFilesMetadataByPathAndRegistryID(
 		Join("generic_blobs gb ON gb.generic_blob_id = n.node_generic_blob_id").
 		Where("n.node_is_file = true AND n.node_path LIKE ? AND n.node_registry_id = ?", path, registryID)
 
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 
 	q = q.OrderBy(sortByField + " " + sortByOrder).Limit(uint64(limit)).Offset(uint64(offset)) //nolint:gosec
 
@@ -425,7 +446,7 @@ func (n NodeDao) GetFileMetadataByPathAndRegistryID(
 		Join("generic_blobs gb ON gb.generic_blob_id = n.node_generic_blob_id").
 		Where("n.node_is_file = true AND n.node_path LIKE ? AND n.node_registry_id = ?", path, registryID)
 
-	db := dbtx.GetAccessor(ctx, n.sqlDB)
+	db := util.GetAccessor(ctx, n.sqlDB, n.limits)
 
 	dst := FileNodeMetadataDB{}
 	sql, args, err := q.ToSql()
@@ -462,8 +483,8 @@ func (n NodeDao) mapToNodeMetadata(d *FileNodeMetadataDB) *types.FileNodeMetadat
 	}
 }
 
-func NewNodeDao(sqlDB *sqlx.DB) store.NodesRepository {
-	return &NodeDao{sqlDB: sqlDB}
+func NewNodeDao(sqlDB *sqlx.DB, limits util.Limits) store.NodesRepository {
+	return &NodeDao{sqlDB: sqlDB, limits: limits}
 }
 
 type Nodes struct {
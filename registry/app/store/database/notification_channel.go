@@ -0,0 +1,305 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const notificationChannelListSeparator = ","
+
+type NotificationChannelDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+const (
+	notificationChannelColumns = `
+		 registry_notification_channel_id
+		,registry_notification_channel_registry_id
+		,registry_notification_channel_identifier
+		,registry_notification_channel_type
+		,registry_notification_channel_recipients
+		,registry_notification_channel_url
+		,registry_notification_channel_events
+		,registry_notification_channel_template
+		,registry_notification_channel_rate_limit
+		,registry_notification_channel_enabled
+		,registry_notification_channel_created_at
+		,registry_notification_channel_updated_at
+		,registry_notification_channel_created_by
+		,registry_notification_channel_updated_by`
+)
+
+func (n NotificationChannelDao) Create(ctx context.Context, channel *types.NotificationChannel) error {
+	const sqlQuery = `
+		INSERT INTO registry_notification_channels (
+			 registry_notification_channel_registry_id
+			,registry_notification_channel_identifier
+			,registry_notification_channel_type
+			,registry_notification_channel_recipients
+			,registry_notification_channel_url
+			,registry_notification_channel_events
+			,registry_notification_channel_template
+			,registry_notification_channel_rate_limit
+			,registry_notification_channel_enabled
+			,registry_notification_channel_created_at
+			,registry_notification_channel_updated_at
+			,registry_notification_channel_created_by
+			,registry_notification_channel_updated_by
+		) values (
+			 :registry_notification_channel_registry_id
+			,:registry_notification_channel_identifier
+			,:registry_notification_channel_type
+			,:registry_notification_channel_recipients
+			,:registry_notification_channel_url
+			,:registry_notification_channel_events
+			,:registry_notification_channel_template
+			,:registry_notification_channel_rate_limit
+			,:registry_notification_channel_enabled
+			,:registry_notification_channel_created_at
+			,:registry_notification_channel_updated_at
+			,:registry_notification_channel_created_by
+			,:registry_notification_channel_updated_by
+		) RETURNING registry_notification_channel_id`
+
+	db := util.GetAccessor(ctx, n.db, n.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToNotificationChannelDB(channel))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind notification channel object")
+	}
+
+	if err = db.GetContext(ctx, &channel.ID, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+
+	return nil
+}
+
+func (n NotificationChannelDao) Update(ctx context.Context, channel *types.NotificationChannel) error {
+	const sqlQuery = `
+		UPDATE registry_notification_channels
+		SET
+			 registry_notification_channel_type = :registry_notification_channel_type
+			,registry_notification_channel_recipients = :registry_notification_channel_recipients
+			,registry_notification_channel_url = :registry_notification_channel_url
+			,registry_notification_channel_events = :registry_notification_channel_events
+			,registry_notification_channel_template = :registry_notification_channel_template
+			,registry_notification_channel_rate_limit = :registry_notification_channel_rate_limit
+			,registry_notification_channel_enabled = :registry_notification_channel_enabled
+			,registry_notification_channel_updated_at = :registry_notification_channel_updated_at
+			,registry_notification_channel_updated_by = :registry_notification_channel_updated_by
+		WHERE registry_notification_channel_id = :registry_notification_channel_id`
+
+	db := util.GetAccessor(ctx, n.db, n.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToNotificationChannelDB(channel))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind notification channel object")
+	}
+
+	result, err := db.ExecContext(ctx, query, arg...)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Update query failed")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to get number of updated rows")
+	}
+	if count == 0 {
+		return gitnessstore.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (n NotificationChannelDao) GetByRegistryAndIdentifier(
+	ctx context.Context,
+	registryID int64,
+	identifier string,
+) (*types.NotificationChannel, error) {
+	stmt := database.Builder.
+		Select(notificationChannelColumns).
+		From("registry_notification_channels").
+		Where("registry_notification_channel_registry_id = ?", registryID).
+		Where("registry_notification_channel_identifier = ?", identifier)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, n.db, n.limits)
+
+	dst := new(notificationChannelDB)
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to get notification channel")
+	}
+
+	return mapToNotificationChannel(dst), nil
+}
+
+func (n NotificationChannelDao) ListByRegistry(
+	ctx context.Context,
+	registryID int64,
+) ([]*types.NotificationChannel, error) {
+	stmt := database.Builder.
+		Select(notificationChannelColumns).
+		From("registry_notification_channels").
+		Where("registry_notification_channel_registry_id = ?", registryID).
+		OrderBy("registry_notification_channel_identifier")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, n.db, n.limits)
+
+	dst := []*notificationChannelDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list notification channels")
+	}
+
+	channels := make([]*types.NotificationChannel, len(dst))
+	for i, channel := range dst {
+		channels[i] = mapToNotificationChannel(channel)
+	}
+	return channels, nil
+}
+
+func (n NotificationChannelDao) DeleteByRegistryAndIdentifier(
+	ctx context.Context,
+	registryID int64,
+	identifier string,
+) error {
+	stmt := database.Builder.
+		Delete("registry_notification_channels").
+		Where("registry_notification_channel_registry_id = ?", registryID).
+		Where("registry_notification_channel_identifier = ?", identifier)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, n.db, n.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Delete query failed")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitnessstore.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func NewNotificationChannelDao(db *sqlx.DB, limits util.Limits) store.NotificationChannelRepository {
+	return &NotificationChannelDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type notificationChannelDB struct {
+	ID         int64  `db:"registry_notification_channel_id"`
+	RegistryID int64  `db:"registry_notification_channel_registry_id"`
+	Identifier string `db:"registry_notification_channel_identifier"`
+	Type       string `db:"registry_notification_channel_type"`
+	Recipients string `db:"registry_notification_channel_recipients"`
+	URL        string `db:"registry_notification_channel_url"`
+	Events     string `db:"registry_notification_channel_events"`
+	Template   string `db:"registry_notification_channel_template"`
+	RateLimit  int    `db:"registry_notification_channel_rate_limit"`
+	Enabled    bool   `db:"registry_notification_channel_enabled"`
+	CreatedAt  int64  `db:"registry_notification_channel_created_at"`
+	UpdatedAt  int64  `db:"registry_notification_channel_updated_at"`
+	CreatedBy  int64  `db:"registry_notification_channel_created_by"`
+	UpdatedBy  int64  `db:"registry_notification_channel_updated_by"`
+}
+
+func mapToNotificationChannel(dst *notificationChannelDB) *types.NotificationChannel {
+	events := splitNotificationChannelList(dst.Events)
+	channel := &types.NotificationChannel{
+		ID:         dst.ID,
+		RegistryID: dst.RegistryID,
+		Identifier: dst.Identifier,
+		Type:       types.NotificationChannelType(dst.Type),
+		Recipients: splitNotificationChannelList(dst.Recipients),
+		URL:        dst.URL,
+		Events:     make([]types.NotificationEventType, len(events)),
+		Template:   dst.Template,
+		RateLimit:  dst.RateLimit,
+		Enabled:    dst.Enabled,
+		CreatedAt:  time.UnixMilli(dst.CreatedAt),
+		UpdatedAt:  time.UnixMilli(dst.UpdatedAt),
+		CreatedBy:  dst.CreatedBy,
+		UpdatedBy:  dst.UpdatedBy,
+	}
+	for i, event := range events {
+		channel.Events[i] = types.NotificationEventType(event)
+	}
+	return channel
+}
+
+func mapToNotificationChannelDB(channel *types.NotificationChannel) *notificationChannelDB {
+	events := make([]string, len(channel.Events))
+	for i, event := range channel.Events {
+		events[i] = string(event)
+	}
+	return &notificationChannelDB{
+		ID:         channel.ID,
+		RegistryID: channel.RegistryID,
+		Identifier: channel.Identifier,
+		Type:       string(channel.Type),
+		Recipients: strings.Join(channel.Recipients, notificationChannelListSeparator),
+		URL:        channel.URL,
+		Events:     strings.Join(events, notificationChannelListSeparator),
+		Template:   channel.Template,
+		RateLimit:  channel.RateLimit,
+		Enabled:    channel.Enabled,
+		CreatedAt:  channel.CreatedAt.UnixMilli(),
+		UpdatedAt:  channel.UpdatedAt.UnixMilli(),
+		CreatedBy:  channel.CreatedBy,
+		UpdatedBy:  channel.UpdatedBy,
+	}
+}
+
+func splitNotificationChannelList(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, notificationChannelListSeparator)
+}
@@ -26,19 +26,20 @@ import (
 	"github.com/harness/gitness/registry/types"
 	store2 "github.com/harness/gitness/store"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
 )
 
 type ociImageIndexMappingDao struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	limits util.Limits
 }
 
-func NewOCIImageIndexMappingDao(db *sqlx.DB) store.OCIImageIndexMappingRepository {
+func NewOCIImageIndexMappingDao(db *sqlx.DB, limits util.Limits) store.OCIImageIndexMappingRepository {
 	return &ociImageIndexMappingDao{
-		db: db,
+		db:     db,
+		limits: limits,
 	}
 }
 
@@ -75,15 +76,15 @@ func (dao *ociImageIndexMappingDao) Create(
             DO NOTHING
             RETURNING oci_mapping_id`
 
-	db := dbtx.GetAccessor(ctx, dao.db)
+	db := util.GetAccessor(ctx, dao.db, dao.limits)
 	internalManifest := mapToInternalOCIMapping(ctx, ociManifest)
 	query, args, err := db.BindNamed(sqlQuery, internalManifest)
 	if err != nil {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Bind query failed")
 	}
 
-	if err = db.QueryRowContext(ctx, query, args...).Scan(&ociManifest.ID); err != nil {
-		err = databaseg.ProcessSQLErrorf(ctx, err, "QueryRowContext failed")
+	if err = db.GetContext(ctx, &ociManifest.ID, query, args...); err != nil {
+		err = databaseg.ProcessSQLErrorf(ctx, err, "GetContext failed")
 		if errors.Is(err, store2.ErrDuplicate) {
 			return nil
 		}
@@ -116,20 +117,15 @@ func (dao *ociImageIndexMappingDao) GetAllByChildDigest(
             manifest_image_name = $2 AND
             oci_mapping_child_digest = $3`
 
-	db := dbtx.GetAccessor(ctx, dao.db)
-	rows, err := db.QueryxContext(ctx, sqlQuery, registryID, imageName, digestBytes)
-	if err != nil || rows.Err() != nil {
-		return nil, databaseg.ProcessSQLErrorf(ctx, err, "QueryxContext failed")
+	db := util.GetAccessor(ctx, dao.db, dao.limits)
+	var dst []ociImageIndexMappingDB
+	if err := db.SelectContext(ctx, &dst, sqlQuery, registryID, imageName, digestBytes); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "SelectContext failed")
 	}
-	defer rows.Close()
 
 	var manifests []*types.OCIImageIndexMapping
-	for rows.Next() {
-		var dbManifest ociImageIndexMappingDB
-		if err := rows.StructScan(&dbManifest); err != nil {
-			return nil, databaseg.ProcessSQLErrorf(ctx, err, "StructScan failed")
-		}
-		manifests = append(manifests, mapToExternalOCIManifest(&dbManifest))
+	for i := range dst {
+		manifests = append(manifests, mapToExternalOCIManifest(&dst[i]))
 	}
 	return manifests, nil
 }
@@ -0,0 +1,273 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type PackageDenylistEntryDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewPackageDenylistEntryDao(db *sqlx.DB, limits util.Limits) store.PackageDenylistEntryRepository {
+	return &PackageDenylistEntryDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+const (
+	packageDenylistEntryColumns = `
+		 package_denylist_entry_id
+		,package_denylist_entry_space_id
+		,package_denylist_entry_package_type
+		,package_denylist_entry_name
+		,package_denylist_entry_version_range
+		,package_denylist_entry_reason
+		,package_denylist_entry_source
+		,package_denylist_entry_external_id
+		,package_denylist_entry_created_by
+		,package_denylist_entry_created`
+)
+
+type packageDenylistEntryDB struct {
+	ID           int64  `db:"package_denylist_entry_id"`
+	SpaceID      int64  `db:"package_denylist_entry_space_id"`
+	PackageType  string `db:"package_denylist_entry_package_type"`
+	Name         string `db:"package_denylist_entry_name"`
+	VersionRange string `db:"package_denylist_entry_version_range"`
+	Reason       string `db:"package_denylist_entry_reason"`
+	Source       string `db:"package_denylist_entry_source"`
+	ExternalID   string `db:"package_denylist_entry_external_id"`
+	CreatedBy    int64  `db:"package_denylist_entry_created_by"`
+	Created      int64  `db:"package_denylist_entry_created"`
+}
+
+func (d PackageDenylistEntryDao) Create(ctx context.Context, entry *types.PackageDenylistEntry) error {
+	const sqlQuery = `
+		INSERT INTO package_denylist_entries (
+			 package_denylist_entry_space_id
+			,package_denylist_entry_package_type
+			,package_denylist_entry_name
+			,package_denylist_entry_version_range
+			,package_denylist_entry_reason
+			,package_denylist_entry_source
+			,package_denylist_entry_external_id
+			,package_denylist_entry_created_by
+			,package_denylist_entry_created
+		) values (
+			 :package_denylist_entry_space_id
+			,:package_denylist_entry_package_type
+			,:package_denylist_entry_name
+			,:package_denylist_entry_version_range
+			,:package_denylist_entry_reason
+			,:package_denylist_entry_source
+			,:package_denylist_entry_external_id
+			,:package_denylist_entry_created_by
+			,:package_denylist_entry_created
+		) RETURNING package_denylist_entry_id`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToPackageDenylistEntryDB(entry))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind package denylist entry object")
+	}
+
+	if err = db.GetContext(ctx, &entry.ID, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (d PackageDenylistEntryDao) Find(ctx context.Context, id int64) (*types.PackageDenylistEntry, error) {
+	stmt := database.Builder.
+		Select(packageDenylistEntryColumns).
+		From("package_denylist_entries").
+		Where("package_denylist_entry_id = ?", id)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := new(packageDenylistEntryDB)
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to get package denylist entry")
+	}
+	return mapToPackageDenylistEntry(dst), nil
+}
+
+func (d PackageDenylistEntryDao) List(
+	ctx context.Context,
+	spaceID int64,
+	search string,
+	limit int,
+	offset int,
+) ([]*types.PackageDenylistEntry, error) {
+	stmt := database.Builder.
+		Select(packageDenylistEntryColumns).
+		From("package_denylist_entries").
+		Where("package_denylist_entry_space_id = ?", spaceID)
+	if search != "" {
+		stmt = stmt.Where("package_denylist_entry_name LIKE ?", sqlPartialMatch(strings.ToLower(search)))
+	}
+	stmt = stmt.
+		OrderBy("package_denylist_entry_name ASC", "package_denylist_entry_id ASC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+
+	return d.list(ctx, stmt.ToSql)
+}
+
+func (d PackageDenylistEntryDao) Count(ctx context.Context, spaceID int64, search string) (int64, error) {
+	stmt := database.Builder.
+		Select("COUNT(*)").
+		From("package_denylist_entries").
+		Where("package_denylist_entry_space_id = ?", spaceID)
+	if search != "" {
+		stmt = stmt.Where("package_denylist_entry_name LIKE ?", sqlPartialMatch(strings.ToLower(search)))
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var count int64
+	if err = db.GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Failed to count package denylist entries")
+	}
+	return count, nil
+}
+
+func (d PackageDenylistEntryDao) ListForPackage(
+	ctx context.Context,
+	spaceIDs []int64,
+	registryID int64,
+	packageType string,
+	name string,
+) ([]*types.PackageDenylistEntry, error) {
+	stmt := database.Builder.
+		Select(packageDenylistEntryColumns).
+		From("package_denylist_entries").
+		Where(sq.Eq{"package_denylist_entry_space_id": spaceIDs}).
+		Where(sq.Eq{"package_denylist_entry_package_type": []string{"", packageType}}).
+		Where("package_denylist_entry_name = ?", strings.ToLower(name)).
+		Where(`NOT EXISTS (SELECT 1 FROM package_denylist_overrides
+			WHERE package_denylist_override_entry_id = package_denylist_entry_id
+			AND package_denylist_override_registry_id = ?)`, registryID).
+		OrderBy("package_denylist_entry_id ASC")
+
+	return d.list(ctx, stmt.ToSql)
+}
+
+func (d PackageDenylistEntryDao) Delete(ctx context.Context, id int64) error {
+	stmt := database.Builder.
+		Delete("package_denylist_entries").
+		Where("package_denylist_entry_id = ?", id)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to delete package denylist entry")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitnessstore.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (d PackageDenylistEntryDao) list(
+	ctx context.Context,
+	toSQL func() (string, []any, error),
+) ([]*types.PackageDenylistEntry, error) {
+	sql, args, err := toSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*packageDenylistEntryDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list package denylist entries")
+	}
+
+	entries := make([]*types.PackageDenylistEntry, len(dst))
+	for i, entry := range dst {
+		entries[i] = mapToPackageDenylistEntry(entry)
+	}
+	return entries, nil
+}
+
+func mapToPackageDenylistEntryDB(entry *types.PackageDenylistEntry) *packageDenylistEntryDB {
+	return &packageDenylistEntryDB{
+		ID:           entry.ID,
+		SpaceID:      entry.SpaceID,
+		PackageType:  entry.PackageType,
+		Name:         entry.Name,
+		VersionRange: entry.VersionRange,
+		Reason:       entry.Reason,
+		Source:       string(entry.Source),
+		ExternalID:   entry.ExternalID,
+		CreatedBy:    entry.CreatedBy,
+		Created:      entry.CreatedAt.UnixMilli(),
+	}
+}
+
+func mapToPackageDenylistEntry(dst *packageDenylistEntryDB) *types.PackageDenylistEntry {
+	return &types.PackageDenylistEntry{
+		ID:           dst.ID,
+		SpaceID:      dst.SpaceID,
+		PackageType:  dst.PackageType,
+		Name:         dst.Name,
+		VersionRange: dst.VersionRange,
+		Reason:       dst.Reason,
+		Source:       types.PackageDenylistSource(dst.Source),
+		ExternalID:   dst.ExternalID,
+		CreatedBy:    dst.CreatedBy,
+		CreatedAt:    time.UnixMilli(dst.Created),
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type PackageDenylistOverrideDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewPackageDenylistOverrideDao(db *sqlx.DB, limits util.Limits) store.PackageDenylistOverrideRepository {
+	return &PackageDenylistOverrideDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+const (
+	packageDenylistOverrideColumns = `
+		 package_denylist_override_id
+		,package_denylist_override_entry_id
+		,package_denylist_override_registry_id
+		,package_denylist_override_reason
+		,package_denylist_override_created_by
+		,package_denylist_override_created`
+)
+
+type packageDenylistOverrideDB struct {
+	ID         int64  `db:"package_denylist_override_id"`
+	EntryID    int64  `db:"package_denylist_override_entry_id"`
+	RegistryID int64  `db:"package_denylist_override_registry_id"`
+	Reason     string `db:"package_denylist_override_reason"`
+	CreatedBy  int64  `db:"package_denylist_override_created_by"`
+	Created    int64  `db:"package_denylist_override_created"`
+}
+
+func (d PackageDenylistOverrideDao) Create(ctx context.Context, override *types.PackageDenylistOverride) error {
+	const sqlQuery = `
+		INSERT INTO package_denylist_overrides (
+			 package_denylist_override_entry_id
+			,package_denylist_override_registry_id
+			,package_denylist_override_reason
+			,package_denylist_override_created_by
+			,package_denylist_override_created
+		) values (
+			 :package_denylist_override_entry_id
+			,:package_denylist_override_registry_id
+			,:package_denylist_override_reason
+			,:package_denylist_override_created_by
+			,:package_denylist_override_created
+		) RETURNING package_denylist_override_id`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToPackageDenylistOverrideDB(override))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind package denylist override object")
+	}
+
+	if err = db.GetContext(ctx, &override.ID, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (d PackageDenylistOverrideDao) Find(
+	ctx context.Context,
+	entryID int64,
+	registryID int64,
+) (*types.PackageDenylistOverride, error) {
+	stmt := database.Builder.
+		Select(packageDenylistOverrideColumns).
+		From("package_denylist_overrides").
+		Where("package_denylist_override_entry_id = ?", entryID).
+		Where("package_denylist_override_registry_id = ?", registryID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := new(packageDenylistOverrideDB)
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to get package denylist override")
+	}
+	return mapToPackageDenylistOverride(dst), nil
+}
+
+func (d PackageDenylistOverrideDao) List(
+	ctx context.Context,
+	registryID int64,
+) ([]*types.PackageDenylistOverride, error) {
+	stmt := database.Builder.
+		Select(packageDenylistOverrideColumns).
+		From("package_denylist_overrides").
+		Where("package_denylist_override_registry_id = ?", registryID).
+		OrderBy("package_denylist_override_created DESC", "package_denylist_override_id DESC")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*packageDenylistOverrideDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list package denylist overrides")
+	}
+
+	overrides := make([]*types.PackageDenylistOverride, len(dst))
+	for i, override := range dst {
+		overrides[i] = mapToPackageDenylistOverride(override)
+	}
+	return overrides, nil
+}
+
+func (d PackageDenylistOverrideDao) Delete(ctx context.Context, entryID int64, registryID int64) error {
+	stmt := database.Builder.
+		Delete("package_denylist_overrides").
+		Where("package_denylist_override_entry_id = ?", entryID).
+		Where("package_denylist_override_registry_id = ?", registryID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to delete package denylist override")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitnessstore.ErrResourceNotFound
+	}
+	return nil
+}
+
+func mapToPackageDenylistOverrideDB(override *types.PackageDenylistOverride) *packageDenylistOverrideDB {
+	return &packageDenylistOverrideDB{
+		ID:         override.ID,
+		EntryID:    override.EntryID,
+		RegistryID: override.RegistryID,
+		Reason:     override.Reason,
+		CreatedBy:  override.CreatedBy,
+		Created:    override.CreatedAt.UnixMilli(),
+	}
+}
+
+func mapToPackageDenylistOverride(dst *packageDenylistOverrideDB) *types.PackageDenylistOverride {
+	return &types.PackageDenylistOverride{
+		ID:         dst.ID,
+		EntryID:    dst.EntryID,
+		RegistryID: dst.RegistryID,
+		Reason:     dst.Reason,
+		CreatedBy:  dst.CreatedBy,
+		CreatedAt:  time.UnixMilli(dst.Created),
+	}
+}
@@ -20,21 +20,23 @@ import (
 	"time"
 
 	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/registry/types"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 )
 
 type PackageTagDao struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	limits util.Limits
 }
 
-func NewPackageTagDao(db *sqlx.DB) *PackageTagDao {
+func NewPackageTagDao(db *sqlx.DB, limits util.Limits) *PackageTagDao {
 	return &PackageTagDao{
-		db: db,
+		db:     db,
+		limits: limits,
 	}
 }
 
@@ -73,7 +75,7 @@ func (r PackageTagDao) FindByImageNameAndRegID(ctx context.Context,
 		stmt = stmt.Where("i.image_type IS NULL")
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	dst := []PackageTagMetadataDB{}
 
@@ -105,7 +107,7 @@ func (r PackageTagDao) DeleteByTagAndImageName(ctx context.Context,
 		return fmt.Errorf("failed to convert purge package_tag query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	_, err = db.ExecContext(ctx, sql, args...)
 	if err != nil {
@@ -128,7 +130,7 @@ func (r PackageTagDao) DeleteByImageNameAndRegID(ctx context.Context, image stri
 		return fmt.Errorf("failed to convert purge package_tag query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	_, err = db.ExecContext(ctx, sql, args...)
 	if err != nil {
@@ -163,14 +165,14 @@ func (r PackageTagDao) Create(ctx context.Context, tag *types.PackageTag) (strin
 	    package_tag_artifact_id = :package_tag_artifact_id 
 		RETURNING package_tag_id`
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	query, arg, err := db.BindNamed(sqlQuery, mapToInternalPackageTag(ctx, tag))
 	if err != nil {
 		return "",
 			databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind repo object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&tag.ID); err != nil {
+	if err = db.GetContext(ctx, &tag.ID, query, arg...); err != nil {
 		return "", databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
 	}
 
@@ -0,0 +1,133 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type QuarantineAccessAttemptDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewQuarantineAccessAttemptDao(db *sqlx.DB, limits util.Limits) store.QuarantineAccessAttemptRepository {
+	return &QuarantineAccessAttemptDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type quarantineAccessAttemptDB struct {
+	ID          int64         `db:"quarantine_access_attempt_id"`
+	RegistryID  int64         `db:"quarantine_access_attempt_registry_id"`
+	ImageName   string        `db:"quarantine_access_attempt_image_name"`
+	Version     string        `db:"quarantine_access_attempt_version"`
+	PrincipalID sql.NullInt64 `db:"quarantine_access_attempt_principal_id"`
+	Created     int64         `db:"quarantine_access_attempt_created"`
+}
+
+type quarantineAccessSummaryDB struct {
+	Count           int64         `db:"attempt_count"`
+	LastAttemptedAt sql.NullInt64 `db:"last_attempted_at"`
+}
+
+func (d QuarantineAccessAttemptDao) Create(ctx context.Context, attempt *types.QuarantineAccessAttempt) error {
+	const sqlQuery = `
+		INSERT INTO quarantine_access_attempts (
+			 quarantine_access_attempt_registry_id
+			,quarantine_access_attempt_image_name
+			,quarantine_access_attempt_version
+			,quarantine_access_attempt_principal_id
+			,quarantine_access_attempt_created
+		) values (
+			 :quarantine_access_attempt_registry_id
+			,:quarantine_access_attempt_image_name
+			,:quarantine_access_attempt_version
+			,:quarantine_access_attempt_principal_id
+			,:quarantine_access_attempt_created
+		) RETURNING quarantine_access_attempt_id`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToQuarantineAccessAttemptDB(attempt))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind quarantine access attempt object")
+	}
+
+	if err = db.GetContext(ctx, &attempt.ID, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (d QuarantineAccessAttemptDao) GetSummary(
+	ctx context.Context,
+	registryID int64,
+	imageName string,
+	version string,
+) (*types.QuarantineAccessSummary, error) {
+	stmt := database.Builder.
+		Select("COUNT(*) AS attempt_count", "MAX(quarantine_access_attempt_created) AS last_attempted_at").
+		From("quarantine_access_attempts").
+		Where("quarantine_access_attempt_registry_id = ?", registryID).
+		Where("quarantine_access_attempt_image_name = ?", imageName).
+		Where("quarantine_access_attempt_version = ?", version)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := new(quarantineAccessSummaryDB)
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to get quarantine access summary")
+	}
+
+	summary := &types.QuarantineAccessSummary{
+		Count: dst.Count,
+	}
+	if dst.LastAttemptedAt.Valid {
+		lastAttemptedAt := time.UnixMilli(dst.LastAttemptedAt.Int64)
+		summary.LastAttemptedAt = &lastAttemptedAt
+	}
+	return summary, nil
+}
+
+func mapToQuarantineAccessAttemptDB(attempt *types.QuarantineAccessAttempt) *quarantineAccessAttemptDB {
+	dst := &quarantineAccessAttemptDB{
+		ID:         attempt.ID,
+		RegistryID: attempt.RegistryID,
+		ImageName:  attempt.ImageName,
+		Version:    attempt.Version,
+		Created:    attempt.CreatedAt.UnixMilli(),
+	}
+	if attempt.PrincipalID != nil {
+		dst.PrincipalID = sql.NullInt64{Int64: *attempt.PrincipalID, Valid: true}
+	}
+	return dst
+}
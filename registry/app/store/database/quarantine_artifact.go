@@ -24,7 +24,6 @@ import (
 	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/registry/types"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -32,12 +31,14 @@ import (
 )
 
 type QuarantineArtifactDao struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	limits util.Limits
 }
 
-func NewQuarantineArtifactDao(db *sqlx.DB) *QuarantineArtifactDao {
+func NewQuarantineArtifactDao(db *sqlx.DB, limits util.Limits) *QuarantineArtifactDao {
 	return &QuarantineArtifactDao{
-		db: db,
+		db:     db,
+		limits: limits,
 	}
 }
 
@@ -80,7 +81,7 @@ func (q QuarantineArtifactDao) GetByFilePath(ctx context.Context,
 			"nd.node_path = ?)",
 		filePath)
 
-	db := dbtx.GetAccessor(ctx, q.db)
+	db := util.GetAccessor(ctx, q.db, q.limits)
 
 	dst := []*QuarantineArtifactDB{}
 	sqlQuery, args, err := stmtBuilder.ToSql()
@@ -121,13 +122,13 @@ func (q QuarantineArtifactDao) Create(ctx context.Context, artifact *types.Quara
 		DO NOTHING
 		RETURNING quarantined_path_id`
 
-	db := dbtx.GetAccessor(ctx, q.db)
+	db := util.GetAccessor(ctx, q.db, q.limits)
 	query, arg, err := db.BindNamed(sqlQuery, q.mapToInternalQuarantineArtifact(ctx, artifact))
 	if err != nil {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind quarantine artifact object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&artifact.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	if err = db.GetContext(ctx, &artifact.ID, query, arg...); err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
 	}
 
@@ -230,7 +231,7 @@ func (q QuarantineArtifactDao) DeleteByRegistryIDArtifactAndFilePath(ctx context
 	}
 
 	// Execute the query
-	db := dbtx.GetAccessor(ctx, q.db)
+	db := util.GetAccessor(ctx, q.db, q.limits)
 	sql, args, err := stmtBuilder.ToSql()
 	if err != nil {
 		return errors.Wrap(err, "Failed to convert delete query to SQL")
@@ -0,0 +1,193 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type RecentActivityDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewRecentActivityDao(db *sqlx.DB, limits util.Limits) store.RecentActivityRepository {
+	return &RecentActivityDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type recentActivityDB struct {
+	ID               int64                  `db:"recent_activity_id"`
+	PrincipalID      int64                  `db:"recent_activity_principal_id"`
+	Kind             string                 `db:"recent_activity_kind"`
+	Version          string                 `db:"recent_activity_version"`
+	At               int64                  `db:"recent_activity_at"`
+	ImageName        string                 `db:"image_name"`
+	ArtifactType     *artifact.ArtifactType `db:"image_type"`
+	RegistryID       int64                  `db:"registry_id"`
+	RegistryName     string                 `db:"registry_name"`
+	RegistryParentID int64                  `db:"registry_parent_id"`
+	PackageType      artifact.PackageType   `db:"registry_package_type"`
+}
+
+func (d RecentActivityDao) Upsert(ctx context.Context, activity *types.RecentActivity) error {
+	selectQuery := database.Builder.
+		Select().
+		Column(sq.Expr("?", activity.PrincipalID)).
+		Column(sq.Expr("?", string(activity.Kind))).
+		Column("i.image_id").
+		Column(sq.Expr("?", activity.Version)).
+		Column(sq.Expr("?", activity.At.UnixMilli())).
+		From("images i").
+		Where("i.image_registry_id = ? AND i.image_name = ?", activity.RegistryID, activity.ImageName)
+	if activity.ArtifactType != nil && *activity.ArtifactType != "" {
+		selectQuery = selectQuery.Where("i.image_type = ?", *activity.ArtifactType)
+	} else {
+		selectQuery = selectQuery.Where("i.image_type IS NULL")
+	}
+	selectQuery = selectQuery.Limit(1)
+
+	stmt := database.Builder.
+		Insert("recent_activities").
+		Columns(
+			"recent_activity_principal_id",
+			"recent_activity_kind",
+			"recent_activity_image_id",
+			"recent_activity_version",
+			"recent_activity_at",
+		).
+		Select(selectQuery).
+		Suffix("ON CONFLICT (recent_activity_principal_id, recent_activity_kind, recent_activity_image_id) " +
+			"DO UPDATE SET recent_activity_version = EXCLUDED.recent_activity_version, " +
+			"recent_activity_at = EXCLUDED.recent_activity_at")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to upsert recent activity")
+	}
+	return nil
+}
+
+func (d RecentActivityDao) Trim(
+	ctx context.Context,
+	principalID int64,
+	kind types.RecentActivityKind,
+	keep int,
+) error {
+	latest := sq.
+		Select("recent_activity_id").
+		From("recent_activities").
+		Where("recent_activity_principal_id = ? AND recent_activity_kind = ?", principalID, string(kind)).
+		OrderBy("recent_activity_at DESC", "recent_activity_id DESC").
+		Limit(util.SafeIntToUInt64(keep))
+
+	latestSQL, latestArgs, err := latest.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	stmt := database.Builder.
+		Delete("recent_activities").
+		Where("recent_activity_principal_id = ? AND recent_activity_kind = ?", principalID, string(kind)).
+		Where(sq.Expr("recent_activity_id NOT IN (SELECT recent_activity_id FROM ("+latestSQL+") AS latest)",
+			latestArgs...))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to trim recent activities")
+	}
+	return nil
+}
+
+func (d RecentActivityDao) ListForPrincipal(
+	ctx context.Context,
+	principalID int64,
+	kind *types.RecentActivityKind,
+	limit int,
+) ([]*types.RecentActivity, error) {
+	stmt := database.Builder.
+		Select(`a.recent_activity_id, a.recent_activity_principal_id, a.recent_activity_kind,
+			a.recent_activity_version, a.recent_activity_at, i.image_name, i.image_type,
+			r.registry_id, r.registry_name, r.registry_parent_id, r.registry_package_type`).
+		From("recent_activities a").
+		Join("images i ON i.image_id = a.recent_activity_image_id").
+		Join("registries r ON r.registry_id = i.image_registry_id").
+		Where("a.recent_activity_principal_id = ?", principalID)
+	if kind != nil {
+		stmt = stmt.Where("a.recent_activity_kind = ?", string(*kind))
+	}
+	stmt = stmt.
+		OrderBy("a.recent_activity_at DESC", "a.recent_activity_id DESC").
+		Limit(util.SafeIntToUInt64(limit))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*recentActivityDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select query failed")
+	}
+
+	activities := make([]*types.RecentActivity, len(dst))
+	for i, activity := range dst {
+		activities[i] = mapToRecentActivity(activity)
+	}
+	return activities, nil
+}
+
+func mapToRecentActivity(dst *recentActivityDB) *types.RecentActivity {
+	return &types.RecentActivity{
+		ID:               dst.ID,
+		PrincipalID:      dst.PrincipalID,
+		Kind:             types.RecentActivityKind(dst.Kind),
+		RegistryID:       dst.RegistryID,
+		ImageName:        dst.ImageName,
+		ArtifactType:     dst.ArtifactType,
+		Version:          dst.Version,
+		At:               time.UnixMilli(dst.At),
+		RegistryName:     dst.RegistryName,
+		RegistryParentID: dst.RegistryParentID,
+		PackageType:      dst.PackageType,
+	}
+}
@@ -31,7 +31,6 @@ import (
 	"github.com/harness/gitness/registry/utils"
 	gitnessstore "github.com/harness/gitness/store"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
@@ -47,13 +46,15 @@ type registryDao struct {
 
 	//FIXME: Arvind: Move this to controller layer later
 	mtRepository store.MediaTypesRepository
+	limits       util.Limits
 }
 
-func NewRegistryDao(db *sqlx.DB, mtRepository store.MediaTypesRepository) store.RegistryRepository {
+func NewRegistryDao(db *sqlx.DB, mtRepository store.MediaTypesRepository, limits util.Limits) store.RegistryRepository {
 	return &registryDao{
 		db: db,
 		//FIXME: Arvind: Move this to controller layer later
 		mtRepository: mtRepository,
+		limits:       limits,
 	}
 }
 
@@ -89,7 +90,7 @@ func (r registryDao) GetByUUID(ctx context.Context, uuid string) (*types.Registr
 		From("registries").
 		Where("registry_uuid = ?", uuid)
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	dst := new(registryDB)
 	sql, args, err := stmt.ToSql()
@@ -110,7 +111,7 @@ func (r registryDao) Get(ctx context.Context, id int64) (*types.Registry, error)
 		From("registries").
 		Where("registry_id = ?", id)
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	dst := new(registryDB)
 	sql, args, err := stmt.ToSql()
@@ -135,7 +136,7 @@ func (r registryDao) GetByParentIDAndName(
 		From("registries").
 		Where("registry_parent_id = ? AND registry_name = ?", parentID, name)
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	dst := new(registryDB)
 	sql, args, err := stmt.ToSql()
@@ -159,7 +160,7 @@ func (r registryDao) GetByRootParentIDAndName(
 		From("registries").
 		Where("registry_root_parent_id = ? AND registry_name = ?", parentID, name)
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	dst := new(registryDB)
 	sql, args, err := stmt.ToSql()
@@ -183,16 +184,85 @@ func (r registryDao) Count(ctx context.Context) (int64, error) {
 		return 0, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
 	return count, nil
 }
 
+func (r registryDao) GetStorageSize(ctx context.Context, registryID int64) (int64, error) {
+	ociSizes, err := r.fetchOCIBlobSizes(ctx, []int64{registryID})
+	if err != nil {
+		return 0, err
+	}
+	if size := ociSizes[registryID]; size > 0 {
+		return size, nil
+	}
+	genericSizes, err := r.fetchGenericBlobSizes(ctx, []int64{registryID})
+	if err != nil {
+		return 0, err
+	}
+	return genericSizes[registryID], nil
+}
+
+func (r registryDao) GetStorageSizes(ctx context.Context, registryIDs []int64) (map[int64]int64, error) {
+	sizes, err := r.fetchOCIBlobSizes(ctx, registryIDs)
+	if err != nil {
+		return nil, err
+	}
+	genericSizes, err := r.fetchGenericBlobSizes(ctx, registryIDs)
+	if err != nil {
+		return nil, err
+	}
+	for registryID, size := range genericSizes {
+		if sizes[registryID] <= 0 {
+			sizes[registryID] = size
+		}
+	}
+	return sizes, nil
+}
+
+func (r registryDao) ListSpaces(ctx context.Context, rootParentID int64) ([]types.RegistrySpaces, error) {
+	stmt := databaseg.Builder.
+		Select("registry_id", "registry_parent_id", "registry_root_parent_id").
+		From("registries").
+		OrderBy("registry_id")
+	if rootParentID > 0 {
+		stmt = stmt.Where("registry_root_parent_id = ?", rootParentID)
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert select query to sql: %w", err)
+	}
+
+	type registrySpacesDB struct {
+		RegistryID   int64 `db:"registry_id"`
+		ParentID     int64 `db:"registry_parent_id"`
+		RootParentID int64 `db:"registry_root_parent_id"`
+	}
+
+	db := util.GetAccessor(ctx, r.db, r.limits)
+	var dst []registrySpacesDB
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "failed to list registry spaces")
+	}
+
+	spaces := make([]types.RegistrySpaces, len(dst))
+	for i, d := range dst {
+		spaces[i] = types.RegistrySpaces{
+			RegistryID:   d.RegistryID,
+			ParentID:     d.ParentID,
+			RootParentID: d.RootParentID,
+		}
+	}
+	return spaces, nil
+}
+
 func (r registryDao) FetchUpstreamProxyKeys(
 	ctx context.Context,
 	ids []int64,
@@ -208,7 +278,7 @@ func (r registryDao) FetchUpstreamProxyKeys(
 		From("registries").
 		Where(sq.Eq{"registry_id": ids})
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	dst := []registryNameID{}
 	sql, args, err := stmt.ToSql()
@@ -245,7 +315,7 @@ func (r registryDao) GetByIDIn(ctx context.Context, ids []int64) (*[]types.Regis
 		From("registries").
 		Where(sq.Eq{"registry_id": ids})
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	dst := []*registryDB{}
 	sql, args, err := stmt.ToSql()
@@ -275,6 +345,7 @@ type RegistryMetadataDB struct {
 	Size          int64                 `db:"size"`
 	Labels        sql.NullString        `db:"registry_labels"`
 	Config        sql.NullString        `db:"registry_config"`
+	HasStats      bool                  `db:"has_stats"`
 }
 
 func (r registryDao) GetAll(
@@ -305,9 +376,10 @@ func (r registryDao) GetAll(
 		COALESCE(u.upstream_proxy_config_url, '') AS url,
 		r.registry_config,
 		r.registry_labels,
-		0 AS artifact_count,
-		0 AS size,
-		0 AS download_count
+		COALESCE(s.registry_stats_artifact_count, 0) AS artifact_count,
+		COALESCE(s.registry_stats_size, 0) AS size,
+		COALESCE(s.registry_stats_download_count, 0) AS download_count,
+		s.registry_stats_registry_id IS NOT NULL AS has_stats
 	`
 
 	var query sq.SelectBuilder
@@ -315,6 +387,7 @@ func (r registryDao) GetAll(
 		Select(selectFields).
 		From("registries r").
 		LeftJoin("upstream_proxy_configs u ON r.registry_id = u.upstream_proxy_config_registry_id").
+		LeftJoin("registry_stats s ON r.registry_id = s.registry_stats_registry_id").
 		Where(sq.Eq{"r.registry_parent_id": parentIDs})
 
 	// Apply search filter
@@ -352,53 +425,66 @@ func (r registryDao) GetAll(
 	}
 
 	// Execute main query
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	dst := []*RegistryMetadataDB{}
 	if err := db.SelectContext(ctx, &dst, sql, args...); err != nil {
 		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing main registry query")
 	}
 
-	// If no registries found, return empty list
-	if len(dst) == 0 {
-		return r.mapToRegistryMetadataList(ctx, dst)
+	if err := r.fillMissingStats(ctx, dst); err != nil {
+		return nil, err
+	}
+
+	// Map results to response type
+	return r.mapToRegistryMetadataList(ctx, dst)
+}
+
+// fillMissingStats computes the aggregates of the registries whose statistics haven't been refreshed yet.
+func (r registryDao) fillMissingStats(ctx context.Context, dst []*RegistryMetadataDB) error {
+	var missing []*RegistryMetadataDB
+	for _, reg := range dst {
+		if !reg.HasStats {
+			missing = append(missing, reg)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
 	}
 
 	// Extract registry IDs for subsequent queries
-	registryIDs := make([]int64, len(dst))
-	registryIDStrings := make([]string, len(dst))
-	for i, reg := range dst {
+	registryIDs := make([]int64, len(missing))
+	for i, reg := range missing {
 		regID, err := strconv.ParseInt(reg.RegID, 10, 64)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse registry ID %s: %w", reg.RegID, err)
+			return fmt.Errorf("failed to parse registry ID %s: %w", reg.RegID, err)
 		}
 		registryIDs[i] = regID
-		registryIDStrings[i] = reg.RegID
 	}
 
 	// Fetch aggregate data sequentially
 	artifactCounts, err := r.fetchArtifactCounts(ctx, registryIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch artifact counts: %w", err)
+		return fmt.Errorf("failed to fetch artifact counts: %w", err)
 	}
 
 	ociSizes, err := r.fetchOCIBlobSizes(ctx, registryIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch OCI blob sizes: %w", err)
+		return fmt.Errorf("failed to fetch OCI blob sizes: %w", err)
 	}
 
 	genericSizes, err := r.fetchGenericBlobSizes(ctx, registryIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch generic blob sizes: %w", err)
+		return fmt.Errorf("failed to fetch generic blob sizes: %w", err)
 	}
 
 	downloadCounts, err := r.fetchDownloadCounts(ctx, registryIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch download counts: %w", err)
+		return fmt.Errorf("failed to fetch download counts: %w", err)
 	}
 
 	// Merge aggregate data into registry results
-	for _, reg := range dst {
-		regID, _ := strconv.ParseInt(reg.RegID, 10, 64)
+	for i, reg := range missing {
+		regID := registryIDs[i]
 
 		// Set artifact count
 		if count, ok := artifactCounts[regID]; ok {
@@ -417,9 +503,7 @@ func (r registryDao) GetAll(
 			reg.DownloadCount = count
 		}
 	}
-
-	// Map results to response type
-	return r.mapToRegistryMetadataList(ctx, dst)
+	return nil
 }
 
 // fetchArtifactCounts fetches artifact counts for given registry IDs.
@@ -435,7 +519,7 @@ func (r registryDao) fetchArtifactCounts(ctx context.Context, registryIDs []int6
 		GROUP BY image_registry_id
 	`
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	sql, args, err := sqlx.In(query, registryIDs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build artifact counts query: %w", err)
@@ -473,7 +557,7 @@ func (r registryDao) fetchOCIBlobSizes(ctx context.Context, registryIDs []int64)
 		GROUP BY rb.rblob_registry_id
 	`
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	sql, args, err := sqlx.In(query, registryIDs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build OCI blob sizes query: %w", err)
@@ -512,7 +596,7 @@ func (r registryDao) fetchGenericBlobSizes(ctx context.Context, registryIDs []in
 		GROUP BY nodes.node_registry_id
 	`
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	sql, args, err := sqlx.In(query, registryIDs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build generic blob sizes query: %w", err)
@@ -543,15 +627,15 @@ func (r registryDao) fetchDownloadCounts(ctx context.Context, registryIDs []int6
 	}
 
 	query := `
-		SELECT i.image_registry_id, COUNT(d.download_stat_id) AS download_count
-		FROM download_stats d
+		SELECT i.image_registry_id, SUM(d.download_stat_count) AS download_count
+		FROM download_stat_counts d
 		LEFT JOIN artifacts a ON d.download_stat_artifact_id = a.artifact_id
 		LEFT JOIN images i ON a.artifact_image_id = i.image_id
 		WHERE i.image_registry_id IN (?) AND i.image_enabled = TRUE
 		GROUP BY i.image_registry_id
 	`
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	sql, args, err := sqlx.In(query, registryIDs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build download counts query: %w", err)
@@ -600,10 +684,10 @@ func (r registryDao) CountAll(
 		return -1, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -648,13 +732,13 @@ func (r registryDao) Create(ctx context.Context, registry *types.Registry) (id i
 			,:registry_uuid
 		) RETURNING registry_id`
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	query, arg, err := db.BindNamed(sqlQuery, mapToInternalRegistry(ctx, registry))
 	if err != nil {
 		return -1, databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind repo object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&registry.ID); err != nil {
+	if err = db.GetContext(ctx, &registry.ID, query, arg...); err != nil {
 		return -1, databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
 	}
 
@@ -717,7 +801,7 @@ func (r registryDao) Delete(ctx context.Context, parentID int64, name string) (e
 		return fmt.Errorf("failed to convert purge registry query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	_, err = db.ExecContext(ctx, sql, args...)
 	if err != nil {
@@ -736,7 +820,7 @@ func (r registryDao) Update(ctx context.Context, registry *types.Registry) (err
 	// update Version (used for optimistic locking) and Updated time
 	dbRepo.UpdatedAt = time.Now().UnixMilli()
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	query, arg, err := db.BindNamed(sqlQuery, dbRepo)
 	if err != nil {
@@ -772,7 +856,7 @@ func (r registryDao) FetchUpstreamProxyIDs(
 		Where(sq.Eq{"registry_name": repokeys}).
 		Where("registry_type = ?", artifact.RegistryTypeUPSTREAM)
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	query, args, err := stmt.ToSql()
 	if err != nil {
@@ -807,7 +891,7 @@ func (r registryDao) FetchRegistriesIDByUpstreamProxyID(
 		).
 		Where("registry_type = ?", artifact.RegistryTypeVIRTUAL)
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	query, args, err := stmt.ToSql()
 	if err != nil {
@@ -918,7 +1002,7 @@ func (r registryDao) GetIDsByParentSpace(ctx context.Context, parentSpaceID int6
 		return nil, fmt.Errorf("failed to convert select query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	var registryIDs []int64
 	err = db.SelectContext(ctx, &registryIDs, sql, args...)
 	if err != nil {
@@ -942,7 +1026,7 @@ func (r registryDao) UpdateParentSpace(ctx context.Context, srcSpaceID int64, ta
 		return 0, fmt.Errorf("failed to convert update parent space query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	result, err := db.ExecContext(ctx, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "failed to update registry parent space")
@@ -23,21 +23,23 @@ import (
 
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/registry/types"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
 )
 
 type registryBlobDao struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	limits util.Limits
 }
 
-func NewRegistryBlobDao(db *sqlx.DB) store.RegistryBlobRepository {
+func NewRegistryBlobDao(db *sqlx.DB, limits util.Limits) store.RegistryBlobRepository {
 	return &registryBlobDao{
-		db: db,
+		db:     db,
+		limits: limits,
 	}
 }
 
@@ -80,7 +82,7 @@ func (r registryBlobDao) LinkBlob(
           RETURNING rblob_registry_id`
 
 	rblob := mapToInternalRegistryBlob(ctx, registry.ID, blobID, imageName)
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	query, arg, err := db.BindNamed(sqlQuery, rblob)
 	if err != nil {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind repo object")
@@ -88,7 +90,7 @@ func (r registryBlobDao) LinkBlob(
 
 	var registryBlobID int64
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&registryBlobID); err != nil {
+	if err = db.GetContext(ctx, &registryBlobID, query, arg...); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil
 		}
@@ -116,7 +118,7 @@ func (r registryBlobDao) UnlinkBlob(
 		return false, fmt.Errorf("failed to convert purge registry query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	result, err := db.ExecContext(ctx, sql, args...)
 	if err != nil {
@@ -140,7 +142,7 @@ func (r registryBlobDao) UnlinkBlobByImageName(
 		return false, fmt.Errorf("failed to convert purge registry query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	result, err := db.ExecContext(ctx, sql, args...)
 	if err != nil {
@@ -151,6 +153,26 @@ func (r registryBlobDao) UnlinkBlobByImageName(
 	return affected > 0, err
 }
 
+func (r registryBlobDao) GetRegistryIDsByBlobID(ctx context.Context, blobID int64) ([]int64, error) {
+	stmt := databaseg.Builder.Select("DISTINCT rblob_registry_id").
+		From("registry_blobs").
+		Where("rblob_blob_id = ?", blobID).
+		OrderBy("rblob_registry_id")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert registry blobs query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, r.db, r.limits)
+
+	var registryIDs []int64
+	if err = db.SelectContext(ctx, &registryIDs, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "error finding registries of blob")
+	}
+	return registryIDs, nil
+}
+
 func mapToInternalRegistryBlob(
 	ctx context.Context, registryID int64, blobID int64,
 	imageName string,
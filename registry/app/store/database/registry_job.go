@@ -0,0 +1,282 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type RegistryJobDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+const (
+	registryJobColumns = `
+		 registry_job_id
+		,registry_job_uuid
+		,registry_job_registry_id
+		,registry_job_kind
+		,registry_job_state
+		,registry_job_progress
+		,registry_job_log
+		,registry_job_error
+		,registry_job_created_by
+		,registry_job_created_at
+		,registry_job_updated_at
+		,registry_job_started_at
+		,registry_job_finished_at`
+)
+
+func (d RegistryJobDao) Create(ctx context.Context, job *types.RegistryJob) error {
+	const sqlQuery = `
+		INSERT INTO registry_jobs (
+			 registry_job_uuid
+			,registry_job_registry_id
+			,registry_job_kind
+			,registry_job_state
+			,registry_job_progress
+			,registry_job_log
+			,registry_job_error
+			,registry_job_created_by
+			,registry_job_created_at
+			,registry_job_updated_at
+			,registry_job_started_at
+			,registry_job_finished_at
+		) values (
+			 :registry_job_uuid
+			,:registry_job_registry_id
+			,:registry_job_kind
+			,:registry_job_state
+			,:registry_job_progress
+			,:registry_job_log
+			,:registry_job_error
+			,:registry_job_created_by
+			,:registry_job_created_at
+			,:registry_job_updated_at
+			,:registry_job_started_at
+			,:registry_job_finished_at
+		) RETURNING registry_job_id`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToRegistryJobDB(job))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind registry job object")
+	}
+
+	if err = db.GetContext(ctx, &job.ID, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+
+	return nil
+}
+
+func (d RegistryJobDao) GetByUUID(ctx context.Context, registryID int64, uuid string) (*types.RegistryJob, error) {
+	stmt := database.Builder.
+		Select(registryJobColumns).
+		From("registry_jobs").
+		Where("registry_job_registry_id = ?", registryID).
+		Where("registry_job_uuid = ?", uuid)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := new(registryJobDB)
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find registry job")
+	}
+
+	return mapToRegistryJob(dst), nil
+}
+
+func (d RegistryJobDao) ListForRegistry(
+	ctx context.Context,
+	registryID int64,
+	limit int,
+	offset int,
+) ([]*types.RegistryJob, error) {
+	stmt := database.Builder.
+		Select(registryJobColumns).
+		From("registry_jobs").
+		Where("registry_job_registry_id = ?", registryID).
+		OrderBy("registry_job_created_at DESC", "registry_job_id DESC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*registryJobDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select query failed")
+	}
+
+	jobs := make([]*types.RegistryJob, len(dst))
+	for i, job := range dst {
+		jobs[i] = mapToRegistryJob(job)
+	}
+	return jobs, nil
+}
+
+func (d RegistryJobDao) CountForRegistry(ctx context.Context, registryID int64) (int64, error) {
+	stmt := database.Builder.
+		Select("COUNT(*)").
+		From("registry_jobs").
+		Where("registry_job_registry_id = ?", registryID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var count int64
+	if err = db.GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Count query failed")
+	}
+
+	return count, nil
+}
+
+func (d RegistryJobDao) Update(ctx context.Context, job *types.RegistryJob) (bool, error) {
+	dbJob := mapToRegistryJobDB(job)
+	stmt := database.Builder.
+		Update("registry_jobs").
+		Set("registry_job_state", dbJob.State).
+		Set("registry_job_progress", dbJob.Progress).
+		Set("registry_job_log", dbJob.Log).
+		Set("registry_job_error", dbJob.Error).
+		Set("registry_job_updated_at", dbJob.UpdatedAt).
+		Set("registry_job_started_at", dbJob.StartedAt).
+		Set("registry_job_finished_at", dbJob.FinishedAt).
+		Where("registry_job_id = ?", job.ID).
+		Where(sq.NotEq{"registry_job_state": []string{
+			string(types.RegistryJobStateSucceeded),
+			string(types.RegistryJobStateFailed),
+			string(types.RegistryJobStateCanceled),
+		}})
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return false, database.ProcessSQLErrorf(ctx, err, "Failed to update registry job")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, database.ProcessSQLErrorf(ctx, err, "Failed to get number of updated rows")
+	}
+	return count > 0, nil
+}
+
+func NewRegistryJobDao(db *sqlx.DB, limits util.Limits) store.RegistryJobRepository {
+	return &RegistryJobDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type registryJobDB struct {
+	ID         int64  `db:"registry_job_id"`
+	UUID       string `db:"registry_job_uuid"`
+	RegistryID int64  `db:"registry_job_registry_id"`
+	Kind       string `db:"registry_job_kind"`
+	State      string `db:"registry_job_state"`
+	Progress   int    `db:"registry_job_progress"`
+	Log        string `db:"registry_job_log"`
+	Error      string `db:"registry_job_error"`
+	CreatedBy  int64  `db:"registry_job_created_by"`
+	CreatedAt  int64  `db:"registry_job_created_at"`
+	UpdatedAt  int64  `db:"registry_job_updated_at"`
+	StartedAt  int64  `db:"registry_job_started_at"`
+	FinishedAt int64  `db:"registry_job_finished_at"`
+}
+
+func mapToRegistryJob(dst *registryJobDB) *types.RegistryJob {
+	return &types.RegistryJob{
+		ID:         dst.ID,
+		UUID:       dst.UUID,
+		RegistryID: dst.RegistryID,
+		Kind:       types.RegistryJobKind(dst.Kind),
+		State:      types.RegistryJobState(dst.State),
+		Progress:   dst.Progress,
+		Log:        dst.Log,
+		Error:      dst.Error,
+		CreatedBy:  dst.CreatedBy,
+		CreatedAt:  time.UnixMilli(dst.CreatedAt),
+		UpdatedAt:  time.UnixMilli(dst.UpdatedAt),
+		StartedAt:  unixMilliOrZero(dst.StartedAt),
+		FinishedAt: unixMilliOrZero(dst.FinishedAt),
+	}
+}
+
+func mapToRegistryJobDB(job *types.RegistryJob) *registryJobDB {
+	return &registryJobDB{
+		ID:         job.ID,
+		UUID:       job.UUID,
+		RegistryID: job.RegistryID,
+		Kind:       string(job.Kind),
+		State:      string(job.State),
+		Progress:   job.Progress,
+		Log:        job.Log,
+		Error:      job.Error,
+		CreatedBy:  job.CreatedBy,
+		CreatedAt:  job.CreatedAt.UnixMilli(),
+		UpdatedAt:  job.UpdatedAt.UnixMilli(),
+		StartedAt:  zeroOrUnixMilli(job.StartedAt),
+		FinishedAt: zeroOrUnixMilli(job.FinishedAt),
+	}
+}
+
+// unixMilliOrZero maps the 0 stored for timestamps which weren't set yet back to the zero time.
+func unixMilliOrZero(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+func zeroOrUnixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
@@ -0,0 +1,156 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type RegistryStatsDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewRegistryStatsDao(db *sqlx.DB, limits util.Limits) store.RegistryStatsRepository {
+	return &RegistryStatsDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+// refreshRegistryStatsQuery recomputes the artifact count, size and download count of registries.
+// The size of a registry is the size of its OCI blobs, or of its generic files if it has no OCI blobs.
+const refreshRegistryStatsQuery = `
+	INSERT INTO registry_stats (
+		 registry_stats_registry_id
+		,registry_stats_artifact_count
+		,registry_stats_size
+		,registry_stats_download_count
+		,registry_stats_refreshed_at
+	)
+	SELECT
+		 r.registry_id
+		,(SELECT COUNT(i.image_id) FROM images i
+			WHERE i.image_registry_id = r.registry_id AND i.image_enabled = TRUE)
+		,COALESCE(NULLIF(
+			(SELECT COALESCE(SUM(b.blob_size), 0) FROM registry_blobs rb
+				JOIN blobs b ON rb.rblob_blob_id = b.blob_id
+				WHERE rb.rblob_registry_id = r.registry_id), 0),
+			(SELECT COALESCE(SUM(g.generic_blob_size), 0) FROM nodes n
+				JOIN generic_blobs g ON g.generic_blob_id = n.node_generic_blob_id
+				WHERE n.node_is_file AND n.node_registry_id = r.registry_id))
+		,(SELECT COALESCE(SUM(d.download_stat_count), 0) FROM download_stat_counts d
+			JOIN artifacts a ON d.download_stat_artifact_id = a.artifact_id
+			JOIN images i ON a.artifact_image_id = i.image_id
+			WHERE i.image_registry_id = r.registry_id AND i.image_enabled = TRUE)
+		,?
+	FROM registries r
+	WHERE r.registry_id IN (?)
+	ON CONFLICT (registry_stats_registry_id)
+	DO UPDATE SET
+		 registry_stats_artifact_count = EXCLUDED.registry_stats_artifact_count
+		,registry_stats_size = EXCLUDED.registry_stats_size
+		,registry_stats_download_count = EXCLUDED.registry_stats_download_count
+		,registry_stats_refreshed_at = EXCLUDED.registry_stats_refreshed_at`
+
+// refreshImageStatsQuery recomputes the latest artifact and the download count of the images of registries.
+const refreshImageStatsQuery = `
+	INSERT INTO image_stats (
+		 image_stats_image_id
+		,image_stats_registry_id
+		,image_stats_latest_artifact_id
+		,image_stats_download_count
+		,image_stats_refreshed_at
+	)
+	SELECT
+		 i.image_id
+		,i.image_registry_id
+		,(SELECT a.artifact_id FROM artifacts a
+			WHERE a.artifact_image_id = i.image_id
+			ORDER BY a.artifact_updated_at DESC, a.artifact_id DESC LIMIT 1)
+		,(SELECT COALESCE(SUM(d.download_stat_count), 0) FROM download_stat_counts d
+			JOIN artifacts a ON d.download_stat_artifact_id = a.artifact_id
+			WHERE a.artifact_image_id = i.image_id)
+		,?
+	FROM images i
+	WHERE i.image_registry_id IN (?)
+	ON CONFLICT (image_stats_image_id)
+	DO UPDATE SET
+		 image_stats_latest_artifact_id = EXCLUDED.image_stats_latest_artifact_id
+		,image_stats_download_count = EXCLUDED.image_stats_download_count
+		,image_stats_refreshed_at = EXCLUDED.image_stats_refreshed_at`
+
+// isLatestArtifactFromStats matches the latest artifact of the image i according to the image stats s,
+// falling back to the most recently updated artifact of images whose stats haven't been refreshed yet.
+const isLatestArtifactFromStats = `a.artifact_id = COALESCE(s.image_stats_latest_artifact_id,
+	(SELECT t.artifact_id FROM artifacts t WHERE t.artifact_image_id = i.image_id
+	ORDER BY t.artifact_updated_at DESC, t.artifact_id DESC LIMIT 1))`
+
+// imageDownloadCountFromStats selects the download count of the image i according to the image stats s,
+// falling back to counting the downloads of images whose stats haven't been refreshed yet.
+const imageDownloadCountFromStats = `COALESCE(s.image_stats_download_count,
+	(SELECT COALESCE(SUM(d.download_stat_count), 0) FROM download_stat_counts d
+	JOIN artifacts t ON d.download_stat_artifact_id = t.artifact_id
+	WHERE t.artifact_image_id = i.image_id))`
+
+func (d RegistryStatsDao) Refresh(ctx context.Context, registryIDs []int64) error {
+	if len(registryIDs) == 0 {
+		return nil
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+	now := time.Now().UnixMilli()
+
+	for _, query := range []string{refreshRegistryStatsQuery, refreshImageStatsQuery} {
+		sql, args, err := sqlx.In(query, now, registryIDs)
+		if err != nil {
+			return fmt.Errorf("failed to build registry stats query: %w", err)
+		}
+		if _, err = db.ExecContext(ctx, db.Rebind(sql), args...); err != nil {
+			return database.ProcessSQLErrorf(ctx, err, "Failed to refresh registry stats")
+		}
+	}
+	return nil
+}
+
+func (d RegistryStatsDao) ListRegistryIDs(ctx context.Context, afterID int64, limit int) ([]int64, error) {
+	stmt := database.Builder.
+		Select("registry_id").
+		From("registries").
+		Where("registry_id > ?", afterID).
+		OrderBy("registry_id ASC").
+		Limit(util.SafeIntToUInt64(limit))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var ids []int64
+	if err = db.SelectContext(ctx, &ids, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list registry IDs")
+	}
+	return ids, nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type RegistryUsageSnapshotDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewRegistryUsageSnapshotDao(db *sqlx.DB, limits util.Limits) store.RegistryUsageSnapshotRepository {
+	return &RegistryUsageSnapshotDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type registryUsageSnapshotDB struct {
+	SpaceID        int64 `db:"registry_usage_snapshot_space_id"`
+	Day            int64 `db:"registry_usage_snapshot_day"`
+	RegistryCount  int64 `db:"registry_usage_snapshot_registry_count"`
+	StorageBytes   int64 `db:"registry_usage_snapshot_storage_bytes"`
+	BandwidthBytes int64 `db:"registry_usage_snapshot_bandwidth_bytes"`
+	Created        int64 `db:"registry_usage_snapshot_created"`
+}
+
+const registryUsageSnapshotColumns = `
+	 registry_usage_snapshot_space_id
+	,registry_usage_snapshot_day
+	,registry_usage_snapshot_registry_count
+	,registry_usage_snapshot_storage_bytes
+	,registry_usage_snapshot_bandwidth_bytes
+	,registry_usage_snapshot_created`
+
+func (d RegistryUsageSnapshotDao) Upsert(ctx context.Context, snapshots []types.RegistryUsageSnapshot) error {
+	const sqlQuery = `
+		INSERT INTO registry_usage_snapshots (` + registryUsageSnapshotColumns + `
+		) VALUES (
+			 :registry_usage_snapshot_space_id
+			,:registry_usage_snapshot_day
+			,:registry_usage_snapshot_registry_count
+			,:registry_usage_snapshot_storage_bytes
+			,:registry_usage_snapshot_bandwidth_bytes
+			,:registry_usage_snapshot_created
+		)
+		ON CONFLICT (registry_usage_snapshot_space_id, registry_usage_snapshot_day)
+		DO UPDATE SET
+			 registry_usage_snapshot_registry_count = EXCLUDED.registry_usage_snapshot_registry_count
+			,registry_usage_snapshot_storage_bytes = EXCLUDED.registry_usage_snapshot_storage_bytes
+			,registry_usage_snapshot_bandwidth_bytes = EXCLUDED.registry_usage_snapshot_bandwidth_bytes
+			,registry_usage_snapshot_created = EXCLUDED.registry_usage_snapshot_created`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	for _, snapshot := range snapshots {
+		query, args, err := db.BindNamed(sqlQuery, mapToInternalRegistryUsageSnapshot(snapshot))
+		if err != nil {
+			return database.ProcessSQLErrorf(ctx, err, "Failed to bind registry usage snapshot")
+		}
+		if _, err = db.ExecContext(ctx, query, args...); err != nil {
+			return database.ProcessSQLErrorf(ctx, err, "Failed to upsert registry usage snapshot")
+		}
+	}
+	return nil
+}
+
+func (d RegistryUsageSnapshotDao) List(
+	ctx context.Context,
+	spaceID int64,
+	from time.Time,
+	to time.Time,
+) ([]types.RegistryUsageSnapshot, error) {
+	stmt := database.Builder.
+		Select(registryUsageSnapshotColumns).
+		From("registry_usage_snapshots").
+		Where("registry_usage_snapshot_space_id = ?", spaceID).
+		Where("registry_usage_snapshot_day >= ?", registryUsageDay(from)).
+		Where("registry_usage_snapshot_day < ?", to.UnixMilli()).
+		OrderBy("registry_usage_snapshot_day ASC")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*registryUsageSnapshotDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list registry usage snapshots")
+	}
+
+	snapshots := make([]types.RegistryUsageSnapshot, len(dst))
+	for i, s := range dst {
+		snapshots[i] = types.RegistryUsageSnapshot{
+			RegistryUsage: types.RegistryUsage{
+				SpaceID:        s.SpaceID,
+				RegistryCount:  s.RegistryCount,
+				StorageBytes:   s.StorageBytes,
+				BandwidthBytes: s.BandwidthBytes,
+			},
+			Day:     time.UnixMilli(s.Day).UTC(),
+			Created: time.UnixMilli(s.Created),
+		}
+	}
+	return snapshots, nil
+}
+
+func mapToInternalRegistryUsageSnapshot(in types.RegistryUsageSnapshot) *registryUsageSnapshotDB {
+	return &registryUsageSnapshotDB{
+		SpaceID:        in.SpaceID,
+		Day:            registryUsageDay(in.Day),
+		RegistryCount:  in.RegistryCount,
+		StorageBytes:   in.StorageBytes,
+		BandwidthBytes: in.BandwidthBytes,
+		Created:        in.Created.UnixMilli(),
+	}
+}
+
+// registryUsageDay returns the start of the UTC day of t in milliseconds, usage is persisted by day.
+func registryUsageDay(t time.Time) int64 {
+	return t.UTC().Truncate(24 * time.Hour).UnixMilli()
+}
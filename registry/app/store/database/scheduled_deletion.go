@@ -0,0 +1,284 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ScheduledDeletionDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewScheduledDeletionDao(db *sqlx.DB, limits util.Limits) store.ScheduledDeletionRepository {
+	return &ScheduledDeletionDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+const (
+	scheduledDeletionColumns = `
+		 scheduled_deletion_id
+		,scheduled_deletion_registry_id
+		,scheduled_deletion_image_name
+		,scheduled_deletion_version
+		,scheduled_deletion_state
+		,scheduled_deletion_execute_at
+		,scheduled_deletion_created_by
+		,scheduled_deletion_canceled_by
+		,scheduled_deletion_error
+		,scheduled_deletion_created
+		,scheduled_deletion_updated`
+)
+
+type scheduledDeletionDB struct {
+	ID         int64         `db:"scheduled_deletion_id"`
+	RegistryID int64         `db:"scheduled_deletion_registry_id"`
+	ImageName  string        `db:"scheduled_deletion_image_name"`
+	Version    string        `db:"scheduled_deletion_version"`
+	State      string        `db:"scheduled_deletion_state"`
+	ExecuteAt  int64         `db:"scheduled_deletion_execute_at"`
+	CreatedBy  int64         `db:"scheduled_deletion_created_by"`
+	CanceledBy sql.NullInt64 `db:"scheduled_deletion_canceled_by"`
+	Error      string        `db:"scheduled_deletion_error"`
+	Created    int64         `db:"scheduled_deletion_created"`
+	Updated    int64         `db:"scheduled_deletion_updated"`
+}
+
+func (d ScheduledDeletionDao) Create(ctx context.Context, deletion *types.ScheduledDeletion) error {
+	const sqlQuery = `
+		INSERT INTO scheduled_deletions (
+			 scheduled_deletion_registry_id
+			,scheduled_deletion_image_name
+			,scheduled_deletion_version
+			,scheduled_deletion_state
+			,scheduled_deletion_execute_at
+			,scheduled_deletion_created_by
+			,scheduled_deletion_canceled_by
+			,scheduled_deletion_error
+			,scheduled_deletion_created
+			,scheduled_deletion_updated
+		) values (
+			 :scheduled_deletion_registry_id
+			,:scheduled_deletion_image_name
+			,:scheduled_deletion_version
+			,:scheduled_deletion_state
+			,:scheduled_deletion_execute_at
+			,:scheduled_deletion_created_by
+			,:scheduled_deletion_canceled_by
+			,:scheduled_deletion_error
+			,:scheduled_deletion_created
+			,:scheduled_deletion_updated
+		) RETURNING scheduled_deletion_id`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToScheduledDeletionDB(deletion))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind scheduled deletion object")
+	}
+
+	if err = db.GetContext(ctx, &deletion.ID, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (d ScheduledDeletionDao) Find(ctx context.Context, id int64) (*types.ScheduledDeletion, error) {
+	stmt := database.Builder.
+		Select(scheduledDeletionColumns).
+		From("scheduled_deletions").
+		Where("scheduled_deletion_id = ?", id)
+
+	return d.get(ctx, stmt.ToSql)
+}
+
+func (d ScheduledDeletionDao) FindScheduled(
+	ctx context.Context,
+	registryID int64,
+	imageName string,
+	version string,
+) (*types.ScheduledDeletion, error) {
+	stmt := database.Builder.
+		Select(scheduledDeletionColumns).
+		From("scheduled_deletions").
+		Where("scheduled_deletion_registry_id = ?", registryID).
+		Where("scheduled_deletion_image_name = ?", imageName).
+		Where("scheduled_deletion_version = ?", version).
+		Where("scheduled_deletion_state = ?", string(types.ScheduledDeletionStateScheduled))
+
+	return d.get(ctx, stmt.ToSql)
+}
+
+func (d ScheduledDeletionDao) get(
+	ctx context.Context,
+	toSQL func() (string, []any, error),
+) (*types.ScheduledDeletion, error) {
+	sql, args, err := toSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := new(scheduledDeletionDB)
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to get scheduled deletion")
+	}
+	return mapToScheduledDeletion(dst), nil
+}
+
+func (d ScheduledDeletionDao) List(
+	ctx context.Context,
+	registryID int64,
+	state *types.ScheduledDeletionState,
+	limit int,
+) ([]*types.ScheduledDeletion, error) {
+	stmt := database.Builder.
+		Select(scheduledDeletionColumns).
+		From("scheduled_deletions").
+		Where("scheduled_deletion_registry_id = ?", registryID)
+	if state != nil {
+		stmt = stmt.Where("scheduled_deletion_state = ?", string(*state))
+	}
+	stmt = stmt.
+		OrderBy("scheduled_deletion_created DESC", "scheduled_deletion_id DESC").
+		Limit(util.SafeIntToUInt64(limit))
+
+	return d.list(ctx, stmt.ToSql)
+}
+
+func (d ScheduledDeletionDao) ListDue(ctx context.Context, now int64, limit int) ([]*types.ScheduledDeletion, error) {
+	stmt := database.Builder.
+		Select(scheduledDeletionColumns).
+		From("scheduled_deletions").
+		Where("scheduled_deletion_state = ?", string(types.ScheduledDeletionStateScheduled)).
+		Where("scheduled_deletion_execute_at <= ?", now).
+		OrderBy("scheduled_deletion_execute_at", "scheduled_deletion_id").
+		Limit(util.SafeIntToUInt64(limit))
+
+	return d.list(ctx, stmt.ToSql)
+}
+
+func (d ScheduledDeletionDao) list(
+	ctx context.Context,
+	toSQL func() (string, []any, error),
+) ([]*types.ScheduledDeletion, error) {
+	sql, args, err := toSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*scheduledDeletionDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list scheduled deletions")
+	}
+
+	deletions := make([]*types.ScheduledDeletion, len(dst))
+	for i, deletion := range dst {
+		deletions[i] = mapToScheduledDeletion(deletion)
+	}
+	return deletions, nil
+}
+
+func (d ScheduledDeletionDao) UpdateState(
+	ctx context.Context,
+	deletion *types.ScheduledDeletion,
+	from types.ScheduledDeletionState,
+) error {
+	stmt := database.Builder.
+		Update("scheduled_deletions").
+		Set("scheduled_deletion_state", string(deletion.State)).
+		Set("scheduled_deletion_canceled_by", deletion.CanceledBy).
+		Set("scheduled_deletion_error", deletion.Error).
+		Set("scheduled_deletion_updated", deletion.UpdatedAt.UnixMilli()).
+		Where("scheduled_deletion_id = ?", deletion.ID).
+		Where("scheduled_deletion_state = ?", string(from))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Update query failed")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to get number of updated rows")
+	}
+	if count == 0 {
+		return gitnessstore.ErrResourceNotFound
+	}
+	return nil
+}
+
+func mapToScheduledDeletionDB(deletion *types.ScheduledDeletion) *scheduledDeletionDB {
+	dst := &scheduledDeletionDB{
+		ID:         deletion.ID,
+		RegistryID: deletion.RegistryID,
+		ImageName:  deletion.ImageName,
+		Version:    deletion.Version,
+		State:      string(deletion.State),
+		ExecuteAt:  deletion.ExecuteAt.UnixMilli(),
+		CreatedBy:  deletion.CreatedBy,
+		Error:      deletion.Error,
+		Created:    deletion.CreatedAt.UnixMilli(),
+		Updated:    deletion.UpdatedAt.UnixMilli(),
+	}
+	if deletion.CanceledBy != nil {
+		dst.CanceledBy = sql.NullInt64{Int64: *deletion.CanceledBy, Valid: true}
+	}
+	return dst
+}
+
+func mapToScheduledDeletion(dst *scheduledDeletionDB) *types.ScheduledDeletion {
+	deletion := &types.ScheduledDeletion{
+		ID:         dst.ID,
+		RegistryID: dst.RegistryID,
+		ImageName:  dst.ImageName,
+		Version:    dst.Version,
+		State:      types.ScheduledDeletionState(dst.State),
+		ExecuteAt:  time.UnixMilli(dst.ExecuteAt),
+		CreatedBy:  dst.CreatedBy,
+		Error:      dst.Error,
+		CreatedAt:  time.UnixMilli(dst.Created),
+		UpdatedAt:  time.UnixMilli(dst.Updated),
+	}
+	if dst.CanceledBy.Valid {
+		canceledBy := dst.CanceledBy.Int64
+		deletion.CanceledBy = &canceledBy
+	}
+	return deletion
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/app/store/database/migrate"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func setupDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	// must use file as db because in memory have only basic features.
+	db, err := sqlx.Connect("sqlite3", filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Error opening db, err: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+	_, _ = db.Exec("PRAGMA busy_timeout = 5000;")
+	if err = migrate.Migrate(context.Background(), db); err != nil {
+		t.Fatalf("Error migrating db, err: %v", err)
+	}
+	return db
+}
+
+func createRegistry(t *testing.T, db *sqlx.DB, name string) int64 {
+	t.Helper()
+	now := time.Now().UnixMilli()
+	res, err := db.Exec(`INSERT INTO registries (registry_name, registry_root_parent_id, registry_parent_id,
+		registry_type, registry_package_type, registry_created_at, registry_updated_at, registry_created_by,
+		registry_updated_by, registry_uuid) VALUES (?, 1, 1, 'VIRTUAL', 'NUGET', ?, ?, 1, 1, ?)`,
+		name, now, now, uuid.NewString())
+	if err != nil {
+		t.Fatalf("failed to create registry %v", err)
+	}
+	id, _ := res.LastInsertId()
+	return id
+}
+
+func createImage(t *testing.T, db *sqlx.DB, registryID int64, name string) int64 {
+	t.Helper()
+	now := time.Now().UnixMilli()
+	res, err := db.Exec(`INSERT INTO images (image_name, image_registry_id, image_enabled, image_created_at,
+		image_updated_at, image_created_by, image_updated_by, image_uuid) VALUES (?, ?, TRUE, ?, ?, 1, 1, ?)`,
+		name, registryID, now, now, uuid.NewString())
+	if err != nil {
+		t.Fatalf("failed to create image %v", err)
+	}
+	id, _ := res.LastInsertId()
+	return id
+}
+
+func createArtifact(t *testing.T, db *sqlx.DB, imageID int64, version string) int64 {
+	t.Helper()
+	now := time.Now().UnixMilli()
+	res, err := db.Exec(`INSERT INTO artifacts (artifact_version, artifact_image_id, artifact_created_at,
+		artifact_updated_at, artifact_created_by, artifact_updated_by, artifact_metadata, artifact_uuid)
+		VALUES (?, ?, ?, ?, 1, 1, ?, ?)`, version, imageID, now, now, []byte("{}"), uuid.NewString())
+	if err != nil {
+		t.Fatalf("failed to create artifact %v", err)
+	}
+	id, _ := res.LastInsertId()
+	return id
+}
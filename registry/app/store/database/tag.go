@@ -30,7 +30,6 @@ import (
 	"github.com/harness/gitness/registry/types"
 	store2 "github.com/harness/gitness/store"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
@@ -55,12 +54,14 @@ const (
 )
 
 type tagDao struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	limits util.Limits
 }
 
-func NewTagDao(db *sqlx.DB) store.TagRepository {
+func NewTagDao(db *sqlx.DB, limits util.Limits) store.TagRepository {
 	return &tagDao{
-		db: db,
+		db:     db,
+		limits: limits,
 	}
 }
 
@@ -112,6 +113,7 @@ type tagMetadataDB struct {
 	Digest        []byte               `db:"manifest_digest"`
 	DownloadCount int64                `db:"download_count"`
 	ArtifactUUID  sql.NullString       `db:"artifact_uuid"`
+	DeletedAt     sql.NullInt64        `db:"deleted_at"`
 }
 
 type ociVersionMetadataDB struct {
@@ -127,6 +129,7 @@ type ociVersionMetadataDB struct {
 	DownloadCount int64                `db:"download_count"`
 	Tags          *string              `db:"tags"`
 	ArtifactUUID  sql.NullString       `db:"artifact_uuid"`
+	DeletedAt     sql.NullInt64        `db:"deleted_at"`
 }
 
 type tagDetailDB struct {
@@ -142,6 +145,13 @@ type tagInfoDB struct {
 	Digest []byte `db:"manifest_digest"`
 }
 
+type trashedOCIVersionDB struct {
+	ImageName string         `db:"image_name"`
+	Name      sql.NullString `db:"name"`
+	Digest    []byte         `db:"manifest_digest"`
+	DeletedAt int64          `db:"deleted_at"`
+}
+
 func (t tagDao) CreateOrUpdate(ctx context.Context, tag *types.Tag) error {
 	const sqlQuery = `
 		INSERT INTO tags ( 
@@ -166,23 +176,21 @@ func (t tagDao) CreateOrUpdate(ctx context.Context, tag *types.Tag) error {
 			ON CONFLICT (tag_registry_id, tag_name, tag_image_name)
 		    DO UPDATE SET
 			   tag_manifest_id = :tag_manifest_id,
-		       tag_updated_at = :tag_updated_at
+		       tag_updated_at = :tag_updated_at,
+		       tag_deleted_at = NULL
 			WHERE
-			   tags.tag_manifest_id <> :tag_manifest_id
+			   tags.tag_manifest_id <> :tag_manifest_id OR tags.tag_deleted_at IS NOT NULL
 	   RETURNING
 		   tag_id, tag_created_at, tag_updated_at`
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 	tagDB := t.mapToInternalTag(ctx, tag)
 	query, arg, err := db.BindNamed(sqlQuery, tagDB)
 	if err != nil {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind repo object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(
-		&tagDB.ID,
-		&tagDB.CreatedAt, &tagDB.UpdatedAt,
-	); err != nil {
+	if err = db.GetContext(ctx, tagDB, query, arg...); err != nil {
 		err := databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
 		if !errors.Is(err, store2.ErrResourceNotFound) {
 			return err
@@ -201,7 +209,7 @@ func (t tagDao) LockTagByNameForUpdate(
 	// only one record is locked and processed.
 	stmt := databaseg.Builder.Select("1").
 		From("tags").
-		Where("tag_registry_id = ? AND tag_name = ?", repoID, name).
+		Where("tag_registry_id = ? AND tag_name = ? AND tag_deleted_at IS NULL", repoID, name).
 		Limit(1).
 		Suffix("FOR UPDATE")
 
@@ -210,10 +218,10 @@ func (t tagDao) LockTagByNameForUpdate(
 		return false, fmt.Errorf("failed to convert select for update query to SQL: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	var exists int
-	err = db.QueryRowContext(ctx, sqlQuery, args...).Scan(&exists)
+	err = db.GetContext(ctx, &exists, sqlQuery, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return false, nil // Tag does not exist
@@ -223,21 +231,22 @@ func (t tagDao) LockTagByNameForUpdate(
 	return true, nil
 }
 
-// DeleteTagByName deletes a tag by name within a repository. A boolean is returned to denote whether the tag was
-// deleted or not. This avoids the need for a separate preceding `SELECT` to find if it exists.
+// DeleteTagByName soft-deletes a tag by name within a repository. A boolean is returned to denote whether the tag
+// was deleted or not. This avoids the need for a separate preceding `SELECT` to find if it exists.
 func (t tagDao) DeleteTagByName(
 	ctx context.Context, repoID int64,
 	name string,
 ) (bool, error) {
-	stmt := databaseg.Builder.Delete("tags").
-		Where("tag_registry_id = ? AND tag_name = ?", repoID, name)
+	stmt := databaseg.Builder.Update("tags").
+		Set("tag_deleted_at", time.Now().UnixMilli()).
+		Where("tag_registry_id = ? AND tag_name = ? AND tag_deleted_at IS NULL", repoID, name)
 
 	sql, args, err := stmt.ToSql()
 	if err != nil {
 		return false, fmt.Errorf("failed to convert purge tag query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	result, err := db.ExecContext(ctx, sql, args...)
 	if err != nil {
@@ -268,7 +277,7 @@ func (t tagDao) DeleteTagByManifestID(
 		return false, fmt.Errorf("failed to convert purge tag query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	result, err := db.ExecContext(ctx, sql, args...)
 	if err != nil {
@@ -296,12 +305,12 @@ func (t tagDao) TagsPaginated(
 		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(tagDB{}), ",")).
 		From("tags").
 		Where(
-			"tag_registry_id = ? AND tag_image_name = ? AND tag_name > ?",
+			"tag_registry_id = ? AND tag_image_name = ? AND tag_name > ? AND tag_deleted_at IS NULL",
 			repoID, image, filters.LastEntry,
 		).
 		OrderBy("tag_name").Limit(uint64(filters.MaxEntries)) //nolint:gosec
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	dst := []*tagDB{}
 	sql, args, err := stmt.ToSql()
@@ -323,7 +332,7 @@ func (t tagDao) HasTagsAfterName(
 		Select("COUNT(*)").
 		From("tags").
 		Where(
-			"tag_registry_id = ? AND tag_name LIKE ? ",
+			"tag_registry_id = ? AND tag_name LIKE ? AND tag_deleted_at IS NULL",
 			repoID, sqlPartialMatch(filters.Name),
 		)
 	comparison := greaterThan
@@ -341,7 +350,7 @@ func (t tagDao) HasTagsAfterName(
 	}
 	stmt = stmt.OrderBy("tag_name").GroupBy("tag_name").Limit(uint64(filters.MaxEntries)) //nolint:gosec
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	var count int64
 	sqlQuery, args, err := stmt.ToSql()
@@ -349,7 +358,7 @@ func (t tagDao) HasTagsAfterName(
 		return false, errors.Wrap(err, "Failed to convert query to sqlQuery")
 	}
 
-	if err = db.QueryRowContext(ctx, sqlQuery, args...).Scan(&count); err != nil &&
+	if err = db.GetContext(ctx, &count, sqlQuery, args...); err != nil &&
 		!errors.Is(err, sql.ErrNoRows) {
 		return false,
 			databaseg.ProcessSQLErrorf(ctx, err, "Failed to find tag")
@@ -426,7 +435,7 @@ func (t tagDao) GetAllArtifactsByParentID(
 	// Add pagination (LIMIT and OFFSET) **after** the WHERE and ORDER BY clauses
 	finalQuery = fmt.Sprintf("%s LIMIT %d OFFSET %d", finalQuery, limit, offset)
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	dst := []*artifactMetadataDB{}
 	if err = db.SelectContext(ctx, &dst, finalQuery, finalArgs...); err != nil {
@@ -457,15 +466,15 @@ func (t tagDao) GetAllArtifactsQueryByParentIDForOCI(
 	).
 		From("tags t").
 		Join("registries r ON t.tag_registry_id = r.registry_id").
-		Where("r.registry_parent_id = ?", parentID).
+		Where("r.registry_parent_id = ? AND t.tag_deleted_at IS NULL", parentID).
 		Join(
 			"images i ON i.image_registry_id = t.tag_registry_id AND"+
 				" i.image_name = t.tag_image_name",
 		).
 		LeftJoin(
 			`( SELECT i.image_id, SUM(COALESCE(t1.download_count, 0)) as download_count FROM 
-			( SELECT a.artifact_image_id, COUNT(d.download_stat_id) as download_count 
-			FROM artifacts a JOIN download_stats d ON d.download_stat_artifact_id = a.artifact_id 
+			( SELECT a.artifact_image_id, SUM(d.download_stat_count) as download_count 
+			FROM artifacts a JOIN download_stat_counts d ON d.download_stat_artifact_id = a.artifact_id 
 			GROUP BY a.artifact_image_id ) as t1 
 			JOIN images i ON i.image_id = t1.artifact_image_id 
 			JOIN registries r ON r.registry_id = i.image_registry_id 
@@ -478,7 +487,7 @@ func (t tagDao) GetAllArtifactsQueryByParentIDForOCI(
 			`(SELECT t.tag_id as id, ROW_NUMBER() OVER (PARTITION BY t.tag_registry_id, t.tag_image_name 
 			ORDER BY t.tag_updated_at DESC) AS rank FROM tags t 
 			JOIN registries r ON t.tag_registry_id = r.registry_id 
-			WHERE r.registry_parent_id = ? ) AS a 
+			WHERE r.registry_parent_id = ? AND t.tag_deleted_at IS NULL ) AS a 
 			ON t.tag_id = a.id`, parentID, // nolint:goconst
 		).
 			Where("a.rank = 1")
@@ -518,7 +527,7 @@ func (t tagDao) GetAllArtifactsByParentIDUntagged(
 		return nil, errors.Wrap(err, "Failed to convert core query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 	coreResults := []*artifactMetadataDB{}
 	if err = db.SelectContext(ctx, &coreResults, coreSQL, coreArgs...); err != nil {
 		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing core artifacts query")
@@ -579,7 +588,7 @@ func (t tagDao) getCoreArtifactsQuery(
 		From("artifacts ar").
 		Join("images i ON i.image_id = ar.artifact_image_id").
 		Join("registries r ON i.image_registry_id = r.registry_id").
-		Where("r.registry_parent_id = ?", parentID)
+		Where("r.registry_parent_id = ? AND ar.artifact_deleted_at IS NULL", parentID)
 
 	// Apply filters
 	if len(*registryIDs) > 0 {
@@ -615,8 +624,8 @@ func (t tagDao) getArtifactEnrichmentData(ctx context.Context, artifactIDs []int
 		return make(map[int64]enrichmentData), nil
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
-	driver := db.DriverName()
+	db := util.GetAccessor(ctx, t.db, t.limits)
+	driver := t.db.DriverName()
 
 	// Pick aggregation function and decode function depending on database
 	var tagAggExpr, decodeFunction string
@@ -713,8 +722,8 @@ func (t tagDao) getArtifactEnrichmentData(ctx context.Context, artifactIDs []int
     download_counts AS (
         SELECT 
             ds.download_stat_artifact_id AS artifact_id,
-            COUNT(ds.download_stat_id) AS download_count
-        FROM download_stats ds
+            SUM(ds.download_stat_count) AS download_count
+        FROM download_stat_counts ds
         WHERE ds.download_stat_artifact_id IN (%s)
         GROUP BY ds.download_stat_artifact_id
     ),
@@ -727,7 +736,7 @@ func (t tagDao) getArtifactEnrichmentData(ctx context.Context, artifactIDs []int
         JOIN manifests m ON m.manifest_registry_id = i.image_registry_id 
                          AND m.manifest_image_name = i.image_name
                          AND m.manifest_digest = %s
-        JOIN tags t ON t.tag_manifest_id = m.manifest_id
+        JOIN tags t ON t.tag_manifest_id = m.manifest_id AND t.tag_deleted_at IS NULL
         WHERE ar.artifact_id IN (%s)
         GROUP BY ar.artifact_id
     )
@@ -795,8 +804,8 @@ func (t tagDao) GetAllArtifactOnParentIDQueryForNonOCI(
 			"AND qp.quarantined_path_image_id = i.image_id) AND qp.quarantined_path_registry_id = r.registry_id").
 		LeftJoin(
 			`( SELECT a.artifact_id, SUM(COALESCE(t1.download_count, 0)) as download_count FROM 
-			( SELECT a.artifact_id, COUNT(d.download_stat_id) as download_count 
-			FROM artifacts a JOIN download_stats d ON d.download_stat_artifact_id = a.artifact_id 
+			( SELECT a.artifact_id, SUM(d.download_stat_count) as download_count 
+			FROM artifacts a JOIN download_stat_counts d ON d.download_stat_artifact_id = a.artifact_id 
 			GROUP BY a.artifact_id ) as t1 
             JOIN artifacts a ON a.artifact_id = t1.artifact_id 
 			JOIN images i ON i.image_id = a.artifact_image_id 
@@ -839,7 +848,7 @@ func (t tagDao) CountAllOCIArtifactsByParentID(
 	q := databaseg.Builder.Select("COUNT(*)").
 		From("tags t").
 		Join("registries r ON t.tag_registry_id = r.registry_id"). // nolint:goconst
-		Where("r.registry_parent_id = ?", parentID).
+		Where("r.registry_parent_id = ? AND t.tag_deleted_at IS NULL", parentID).
 		Join(
 			"images ar ON ar.image_registry_id = t.tag_registry_id" +
 				" AND ar.image_name = t.tag_image_name",
@@ -850,7 +859,7 @@ func (t tagDao) CountAllOCIArtifactsByParentID(
 			`(SELECT t.tag_id as id, ROW_NUMBER() OVER (PARTITION BY t.tag_registry_id, t.tag_image_name 
 			ORDER BY t.tag_updated_at DESC) AS rank FROM tags t 
 			JOIN registries r ON t.tag_registry_id = r.registry_id 
-			WHERE r.registry_parent_id = ? ) AS a 
+			WHERE r.registry_parent_id = ? AND t.tag_deleted_at IS NULL ) AS a 
 			ON t.tag_id = a.id`, parentID, // nolint:goconst
 		).Where("a.rank = 1")
 	}
@@ -870,10 +879,10 @@ func (t tagDao) CountAllOCIArtifactsByParentID(
 	if err != nil {
 		return -1, errors.Wrap(err, "Failed to convert query to sql")
 	}
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -922,10 +931,10 @@ func (t tagDao) CountAllArtifactsByParentID(
 	if err != nil {
 		return -1, errors.Wrap(err, "Failed to convert query to sql")
 	}
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -948,7 +957,7 @@ func (t tagDao) CountAllArtifactsByParentIDUntagged(
 		From("artifacts ar").
 		Join("images i ON i.image_id = ar.artifact_image_id").
 		Join("registries r ON i.image_registry_id = r.registry_id").
-		Where("r.registry_parent_id = ?", parentID)
+		Where("r.registry_parent_id = ? AND ar.artifact_deleted_at IS NULL", parentID)
 
 	// Apply filters
 	if len(*registryIDs) > 0 {
@@ -968,7 +977,7 @@ func (t tagDao) CountAllArtifactsByParentIDUntagged(
 		return 0, errors.Wrap(err, "Failed to convert count query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 	var count int64
 	if err := db.GetContext(ctx, &count, querySQL, queryArgs...); err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
@@ -991,7 +1000,7 @@ func (t tagDao) GetTagDetail(
         `).
 		From("tags AS t").
 		Where(
-			"t.tag_registry_id = ? AND t.tag_image_name = ? AND t.tag_name = ?",
+			"t.tag_registry_id = ? AND t.tag_image_name = ? AND t.tag_name = ? AND t.tag_deleted_at IS NULL",
 			repoID, imageName, name,
 		)
 
@@ -1000,7 +1009,7 @@ func (t tagDao) GetTagDetail(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	dst := new(tagDetailDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -1010,16 +1019,19 @@ func (t tagDao) GetTagDetail(
 	return t.mapToTagDetail(ctx, dst)
 }
 
+// DeleteTag soft-deletes a tag, it can be restored with RestoreTag until it is purged.
 func (t tagDao) DeleteTag(ctx context.Context, registryID int64, imageName string, name string) (err error) {
-	stmt := databaseg.Builder.Delete("tags").
-		Where("tag_registry_id = ? AND tag_image_name = ? AND tag_name = ?", registryID, imageName, name)
+	stmt := databaseg.Builder.Update("tags").
+		Set("tag_deleted_at", time.Now().UnixMilli()).
+		Where("tag_registry_id = ? AND tag_image_name = ? AND tag_name = ? AND tag_deleted_at IS NULL",
+			registryID, imageName, name)
 
 	sql, args, err := stmt.ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to convert purge tags query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	_, err = db.ExecContext(ctx, sql, args...)
 	if err != nil {
@@ -1028,6 +1040,99 @@ func (t tagDao) DeleteTag(ctx context.Context, registryID int64, imageName strin
 	return nil
 }
 
+// RestoreTag restores a soft-deleted tag and returns the ID of the manifest it points to.
+func (t tagDao) RestoreTag(ctx context.Context, registryID int64, imageName string, name string) (int64, error) {
+	stmt := databaseg.Builder.Update("tags").
+		Set("tag_deleted_at", nil).
+		Where("tag_registry_id = ? AND tag_image_name = ? AND tag_name = ? AND tag_deleted_at IS NOT NULL",
+			registryID, imageName, name).
+		Suffix("RETURNING tag_manifest_id")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert restore tag query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, t.db, t.limits)
+
+	var manifestID int64
+	if err = db.GetContext(ctx, &manifestID, sql, args...); err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "the restore query failed")
+	}
+	return manifestID, nil
+}
+
+// PurgeTag permanently deletes a soft-deleted tag. A boolean is returned to denote whether the tag was purged.
+func (t tagDao) PurgeTag(ctx context.Context, registryID int64, imageName string, name string) (bool, error) {
+	stmt := databaseg.Builder.Delete("tags").
+		Where("tag_registry_id = ? AND tag_image_name = ? AND tag_name = ? AND tag_deleted_at IS NOT NULL",
+			registryID, imageName, name)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to convert purge tag query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, t.db, t.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return false, databaseg.ProcessSQLErrorf(ctx, err, "the purge query failed")
+	}
+
+	count, _ := result.RowsAffected()
+	return count > 0, nil
+}
+
+// PurgeDeletedBefore permanently deletes up to limit tags of all registries which were soft-deleted before the
+// given time, it returns the number of purged tags.
+func (t tagDao) PurgeDeletedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	stmt := databaseg.Builder.Delete("tags").
+		Where(sq.Expr("tag_id IN (?)", sq.Select("tag_id").
+			From("tags").
+			Where("tag_deleted_at IS NOT NULL AND tag_deleted_at < ?", before.UnixMilli()).
+			OrderBy("tag_deleted_at ASC").
+			Limit(util.SafeIntToUInt64(limit))))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert purge tags query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, t.db, t.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "the purge query failed")
+	}
+
+	count, _ := result.RowsAffected()
+	return count, nil
+}
+
+// ListDeletedTags lists the soft-deleted tags of a registry, most recently deleted first.
+func (t tagDao) ListDeletedTags(ctx context.Context, registryID int64) (*[]types.TrashedOCIVersion, error) {
+	stmt := databaseg.Builder.
+		Select("t.tag_image_name AS image_name, t.tag_name AS name, m.manifest_digest, t.tag_deleted_at AS deleted_at").
+		From("tags t").
+		Join("manifests m ON t.tag_manifest_id = m.manifest_id").
+		Where("t.tag_registry_id = ? AND t.tag_deleted_at IS NOT NULL", registryID).
+		OrderBy("t.tag_deleted_at DESC")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := util.GetAccessor(ctx, t.db, t.limits)
+
+	dst := []*trashedOCIVersionDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list deleted tags")
+	}
+	return mapToTrashedOCIVersions(dst)
+}
+
 func (t tagDao) GetLatestTagMetadata(
 	ctx context.Context,
 	parentID int64,
@@ -1045,11 +1150,11 @@ func (t tagDao) GetLatestTagMetadata(
 		LEFT JOIN (
 			SELECT 
 				a.artifact_image_id, 
-				COUNT(d.download_stat_id) AS download_count
+				SUM(d.download_stat_count) AS download_count
 			FROM 
 				artifacts a
 			JOIN 
-				download_stats d ON d.download_stat_artifact_id = a.artifact_id
+				download_stat_counts d ON d.download_stat_artifact_id = a.artifact_id
 			GROUP BY 
 				a.artifact_image_id
 		) AS dc ON i.image_id = dc.artifact_image_id
@@ -1070,7 +1175,7 @@ func (t tagDao) GetLatestTagMetadata(
 			LeftJoin(fmt.Sprintf("(%s) AS dc_subquery ON dc_subquery.image_name = t.tag_image_name "+
 				"AND dc_subquery.image_registry_id = r.registry_id", downloadCountSubquery)).
 			Where(
-				"r.registry_parent_id = ? AND r.registry_name = ? AND t.tag_image_name = ?",
+				"r.registry_parent_id = ? AND r.registry_name = ? AND t.tag_image_name = ? AND t.tag_deleted_at IS NULL",
 				parentID, repoKey, imageName,
 			).
 			OrderBy("t.tag_updated_at DESC").Limit(1)
@@ -1090,7 +1195,7 @@ func (t tagDao) GetLatestTagMetadata(
 			Join("images ar ON ar.image_registry_id = t.tag_registry_id AND ar.image_name = t.tag_image_name").
 			LeftJoin(fmt.Sprintf("LATERAL (%s) AS t2 ON t.tag_image_name = t2.image_name", downloadCountSubquery)).
 			Where(
-				"r.registry_parent_id = ? AND r.registry_name = ? AND t.tag_image_name = ?",
+				"r.registry_parent_id = ? AND r.registry_name = ? AND t.tag_image_name = ? AND t.tag_deleted_at IS NULL",
 				parentID, repoKey, imageName,
 			).
 			OrderBy("t.tag_updated_at DESC").Limit(1)
@@ -1104,7 +1209,7 @@ func (t tagDao) GetLatestTagMetadata(
 	finalQuery := util.FormatQuery(sql, args)
 	log.Ctx(ctx).Debug().Str("sql", finalQuery).Msg("Executing GetLatestTagMetadata query")
 	// Execute query
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	dst := new(artifactMetadataDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -1124,7 +1229,7 @@ func (t tagDao) GetLatestTagName(
 		From("tags").
 		Join("registries ON tag_registry_id = registry_id").
 		Where(
-			"registry_parent_id = ? AND registry_name = ? AND tag_image_name = ?",
+			"registry_parent_id = ? AND registry_name = ? AND tag_image_name = ? AND tag_deleted_at IS NULL",
 			parentID, repoKey, imageName,
 		).
 		OrderBy("tag_updated_at DESC").Limit(1)
@@ -1134,10 +1239,10 @@ func (t tagDao) GetLatestTagName(
 		return "", errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	var tag string
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&tag)
+	err = db.GetContext(ctx, &tag, sql, args...)
 	if err != nil {
 		return tag, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing get tag name query")
 	}
@@ -1187,7 +1292,7 @@ func (t tagDao) GetTagMetadata(
 			"AND oa.version_digest = manifest_digest")).
 		Where(
 			"registry_parent_id = ? AND registry_name = ?"+
-				" AND tag_image_name = ? AND tag_name = ?", parentID, repoKey, imageName, name,
+				" AND tag_image_name = ? AND tag_name = ? AND tag_deleted_at IS NULL", parentID, repoKey, imageName, name,
 		)
 
 	withClause := fmt.Sprintf("WITH oci_artifacts AS (%s)", ociArtifactsSQL)
@@ -1200,7 +1305,7 @@ func (t tagDao) GetTagMetadata(
 	// nolint:gocritic
 	finalArgs := append(ociArtifactsArgs, args...)
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	dst := new(tagMetadataDB)
 	if err = db.GetContext(ctx, dst, finalQuery, finalArgs...); err != nil {
@@ -1257,7 +1362,8 @@ func (t tagDao) GetOCIVersionMetadata(
 			"AND oa.version_digest = manifest_digest")).
 		Where(
 			"registry_parent_id = ? AND registry_name = ?"+
-				" AND manifest_image_name = ? AND manifest_digest = ?", parentID, repoKey, imageName, digestBytes,
+				" AND manifest_image_name = ? AND manifest_digest = ? AND manifest_deleted_at IS NULL",
+			parentID, repoKey, imageName, digestBytes,
 		)
 
 	withClause := fmt.Sprintf("WITH oci_artifacts AS (%s)", ociArtifactsSQL)
@@ -1270,7 +1376,7 @@ func (t tagDao) GetOCIVersionMetadata(
 	// nolint:gocritic
 	finalArgs := append(ociArtifactsArgs, args...)
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	dst := new(ociVersionMetadataDB)
 	if err = db.GetContext(ctx, dst, finalQuery, finalArgs...); err != nil {
@@ -1284,10 +1390,10 @@ func (t tagDao) GetLatestTag(ctx context.Context, repoID int64, imageName string
 	stmt := databaseg.Builder.
 		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(tagDB{}), ",")).
 		From("tags").
-		Where("tag_registry_id = ? AND tag_image_name = ?", repoID, imageName).
+		Where("tag_registry_id = ? AND tag_image_name = ? AND tag_deleted_at IS NULL", repoID, imageName).
 		OrderBy("tag_updated_at DESC").Limit(1)
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	dst := new(tagDB)
 	sql, args, err := stmt.ToSql()
@@ -1305,39 +1411,46 @@ func (t tagDao) GetLatestTag(ctx context.Context, repoID int64, imageName string
 func (t tagDao) GetAllArtifactsByRepo(
 	ctx context.Context, parentID int64, repoKey string,
 	sortByField string, sortByOrder string, limit int, offset int, search string,
-	labels []string,
+	labels []string, includeDownloadCount bool,
 ) (*[]types.ArtifactMetadata, error) {
+	includeDownloadCount = includeDownloadCount || sortByField == downloadCount
+	downloadCountColumn := "0 as download_count"
+	if includeDownloadCount {
+		downloadCountColumn = "COALESCE(t2.download_count, 0) as download_count"
+	}
 	q := databaseg.Builder.Select(
 		`r.registry_name as repo_name, t.tag_image_name as name, 
 		r.registry_package_type as package_type, t.tag_name as latest_version, 
-		t.tag_updated_at as modified_at, ar.image_labels as labels, 
-		COALESCE(t2.download_count, 0) as download_count`,
+		t.tag_updated_at as modified_at, ar.image_labels as labels`,
 	).
+		Column(downloadCountColumn).
 		From("tags t").
 		Join(
 			`(SELECT t.tag_id as id, ROW_NUMBER() OVER (PARTITION BY t.tag_registry_id, t.tag_image_name 
 			ORDER BY t.tag_updated_at DESC) AS rank FROM tags t 
 			JOIN registries r ON t.tag_registry_id = r.registry_id  
-			WHERE r.registry_parent_id = ? AND r.registry_name = ? ) AS a 
+			WHERE r.registry_parent_id = ? AND r.registry_name = ? AND t.tag_deleted_at IS NULL ) AS a 
 			ON t.tag_id = a.id`, parentID, repoKey, // nolint:goconst
 		).
 		Join("registries r ON t.tag_registry_id = r.registry_id").
 		Join(
-			"images ar ON ar.image_registry_id = t.tag_registry_id"+
+			"images ar ON ar.image_registry_id = t.tag_registry_id" +
 				" AND ar.image_name = t.tag_image_name",
-		).
-		LeftJoin(
+		)
+	if includeDownloadCount {
+		q = q.LeftJoin(
 			`( SELECT i.image_id, SUM(COALESCE(t1.download_count, 0)) as download_count FROM 
-			( SELECT a.artifact_image_id, COUNT(d.download_stat_id) as download_count 
+			( SELECT a.artifact_image_id, SUM(d.download_stat_count) as download_count 
 			FROM artifacts a 
-			JOIN download_stats d ON d.download_stat_artifact_id = a.artifact_id GROUP BY 
+			JOIN download_stat_counts d ON d.download_stat_artifact_id = a.artifact_id GROUP BY 
 			a.artifact_image_id ) as t1 
 			JOIN images i ON i.image_id = t1.artifact_image_id 
 			JOIN registries r ON r.registry_id = i.image_registry_id 
 			WHERE r.registry_parent_id = ? AND r.registry_name = ? GROUP BY i.image_id) as t2 
 			ON ar.image_id = t2.image_id`, parentID, repoKey,
-		).
-		Where("a.rank = 1 ")
+		)
+	}
+	q = q.Where("a.rank = 1 ")
 
 	if search != "" {
 		q = q.Where("tag_image_name LIKE ?", sqlPartialMatch(search))
@@ -1361,7 +1474,7 @@ func (t tagDao) GetAllArtifactsByRepo(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	dst := []*artifactMetadataDB{}
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -1381,7 +1494,7 @@ func (t tagDao) CountAllArtifactsByRepo(
 			`(SELECT t.tag_id as id, ROW_NUMBER() OVER (PARTITION BY t.tag_registry_id, t.tag_image_name 
 			ORDER BY t.tag_updated_at DESC) AS rank FROM tags t 
 			JOIN registries r ON t.tag_registry_id = r.registry_id 
-			WHERE r.registry_parent_id = ? AND r.registry_name = ? ) AS a ON t.tag_id = a.id`, parentID, repoKey,
+			WHERE r.registry_parent_id = ? AND r.registry_name = ? AND t.tag_deleted_at IS NULL ) AS a ON t.tag_id = a.id`, parentID, repoKey,
 		).
 		Join("registries r ON t.tag_registry_id = r.registry_id").
 		Join(
@@ -1405,10 +1518,10 @@ func (t tagDao) CountAllArtifactsByRepo(
 	if err != nil {
 		return -1, errors.Wrap(err, "Failed to convert query to sql")
 	}
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -1418,13 +1531,14 @@ func (t tagDao) CountAllArtifactsByRepo(
 func (t tagDao) GetAllTagsByRepoAndImage(
 	ctx context.Context, parentID int64, repoKey string,
 	image string, sortByField string, sortByOrder string, limit int, offset int,
-	search string,
+	search string, opts ...types.QueryOption,
 ) (*[]types.OciVersionMetadata, error) {
+	options := types.MakeQueryOptions(opts...)
 	q := databaseg.Builder.Select(
 		`t.tag_name as name, m.manifest_total_size as size, 
 		r.registry_package_type as package_type, t.tag_updated_at as modified_at, 
 		m.manifest_schema_version, m.manifest_non_conformant, m.manifest_payload, 
-		mt.mt_media_type, m.manifest_digest`,
+		mt.mt_media_type, m.manifest_digest, t.tag_deleted_at as deleted_at`,
 	).
 		From("tags t").
 		Join("registries r ON t.tag_registry_id = r.registry_id").
@@ -1433,7 +1547,8 @@ func (t tagDao) GetAllTagsByRepoAndImage(
 		Where(
 			"r.registry_parent_id = ? AND r.registry_name = ? AND t.tag_image_name = ?",
 			parentID, repoKey, image,
-		)
+		).
+		Where(options.DeleteFilter.Condition("t.tag_deleted_at"))
 
 	if search != "" {
 		q = q.Where("tag_name LIKE ?", sqlPartialMatch(search))
@@ -1445,7 +1560,7 @@ func (t tagDao) GetAllTagsByRepoAndImage(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	dst := []*tagMetadataDB{}
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -1475,7 +1590,7 @@ func (t tagDao) GetQuarantineStatusForImages(
 		return nil, errors.Wrap(err, "Failed to convert quarantine status query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	type quarantineResult struct {
 		ImageName string `db:"image_name"`
@@ -1504,8 +1619,9 @@ func (t tagDao) GetQuarantineStatusForImages(
 func (t tagDao) GetAllOciVersionsByRepoAndImage(
 	ctx context.Context, parentID int64, repoKey string,
 	image string, sortByField string, sortByOrder string, limit int, offset int,
-	search string,
+	search string, opts ...types.QueryOption,
 ) (*[]types.OciVersionMetadata, error) {
+	options := types.MakeQueryOptions(opts...)
 	// Choose aggregation function based on database driver
 	var tagAggExpr string
 	if t.db.DriverName() == SQLITE3 {
@@ -1523,16 +1639,18 @@ func (t tagDao) GetAllOciVersionsByRepoAndImage(
 		"m.manifest_payload",
 		"mt.mt_media_type",
 		"m.manifest_digest",
+		"m.manifest_deleted_at AS deleted_at",
 		tagAggExpr+" AS tags",
 	).
 		From("manifests m").
-		LeftJoin("tags t ON m.manifest_id = t.tag_manifest_id"). //
+		LeftJoin("tags t ON m.manifest_id = t.tag_manifest_id AND t.tag_deleted_at IS NULL").
 		Join("registries r ON m.manifest_registry_id = r.registry_id").
 		Join("media_types mt ON mt.mt_id = m.manifest_media_type_id").
 		Where(
 			"r.registry_parent_id = ? AND r.registry_name = ? AND m.manifest_image_name = ?",
 			parentID, repoKey, image,
-		)
+		).
+		Where(options.DeleteFilter.Condition("m.manifest_deleted_at"))
 
 	if search != "" {
 		digestBytes, err := types.GetDigestBytes(digest.Digest(search))
@@ -1544,7 +1662,7 @@ func (t tagDao) GetAllOciVersionsByRepoAndImage(
 
 	q = q.GroupBy("m.manifest_total_size, r.registry_package_type, m.manifest_created_at, " +
 		"m.manifest_schema_version, m.manifest_non_conformant," +
-		" m.manifest_payload, mt.mt_media_type, m.manifest_digest")
+		" m.manifest_payload, mt.mt_media_type, m.manifest_digest, m.manifest_deleted_at")
 
 	var sortField string
 	switch sortByField {
@@ -1562,7 +1680,7 @@ func (t tagDao) GetAllOciVersionsByRepoAndImage(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	dst := []*ociVersionMetadataDB{}
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -1582,7 +1700,7 @@ func (t tagDao) GetOciTagsInfo(
 		From("tags t").
 		Join("manifests m ON t.tag_manifest_id = m.manifest_id").
 		Where(
-			"m.manifest_registry_id = ? AND m.manifest_image_name = ?",
+			"m.manifest_registry_id = ? AND m.manifest_image_name = ? AND t.tag_deleted_at IS NULL",
 			registryID, image,
 		)
 
@@ -1596,7 +1714,7 @@ func (t tagDao) GetOciTagsInfo(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	var dst []*tagInfoDB
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -1607,8 +1725,9 @@ func (t tagDao) GetOciTagsInfo(
 
 func (t tagDao) CountAllTagsByRepoAndImage(
 	ctx context.Context, parentID int64,
-	repoKey string, image string, search string,
+	repoKey string, image string, search string, opts ...types.QueryOption,
 ) (int64, error) {
+	options := types.MakeQueryOptions(opts...)
 	stmt := databaseg.Builder.Select("COUNT(*)").
 		From("tags").
 		Join("registries ON tag_registry_id = registry_id").
@@ -1616,7 +1735,8 @@ func (t tagDao) CountAllTagsByRepoAndImage(
 		Where(
 			"registry_parent_id = ? AND registry_name = ?"+
 				" AND tag_image_name = ?", parentID, repoKey, image,
-		)
+		).
+		Where(options.DeleteFilter.Condition("tag_deleted_at"))
 
 	if search != "" {
 		stmt = stmt.Where("tag_name LIKE ?", sqlPartialMatch(search))
@@ -1627,10 +1747,10 @@ func (t tagDao) CountAllTagsByRepoAndImage(
 		return -1, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -1669,7 +1789,7 @@ func (t tagDao) GetQuarantineInfoForArtifacts(
 		return nil, errors.Wrap(err, "Failed to convert quarantine query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	type quarantineResult struct {
 		ImageName    string `db:"image_name"`
@@ -1706,15 +1826,17 @@ func (t tagDao) GetQuarantineInfoForArtifacts(
 
 func (t tagDao) CountOciVersionByRepoAndImage(
 	ctx context.Context, parentID int64,
-	repoKey string, image string, search string,
+	repoKey string, image string, search string, opts ...types.QueryOption,
 ) (int64, error) {
+	options := types.MakeQueryOptions(opts...)
 	stmt := databaseg.Builder.Select("COUNT(*)").
 		From("manifests").
 		Join("registries ON manifest_registry_id = registry_id").
 		Where(
 			"registry_parent_id = ? AND registry_name = ?"+
 				" AND manifest_image_name = ?", parentID, repoKey, image,
-		)
+		).
+		Where(options.DeleteFilter.Condition("manifest_deleted_at"))
 
 	if search != "" {
 		digestBytes, err := types.GetDigestBytes(digest.Digest(search))
@@ -1729,10 +1851,10 @@ func (t tagDao) CountOciVersionByRepoAndImage(
 		return -1, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sql, args...)
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -1746,9 +1868,10 @@ func (t tagDao) FindTag(
 	stmt := databaseg.Builder.
 		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(tagDB{}), ",")).
 		From("tags").
-		Where("tag_registry_id = ? AND tag_image_name = ? AND tag_name = ?", repoID, imageName, name)
+		Where("tag_registry_id = ? AND tag_image_name = ? AND tag_name = ? AND tag_deleted_at IS NULL",
+			repoID, imageName, name)
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	dst := new(tagDB)
 	sql, args, err := stmt.ToSql()
@@ -1770,9 +1893,9 @@ func (t tagDao) GetTagsByManifestID(
 	stmt := databaseg.Builder.
 		Select("tag_name").
 		From("tags").
-		Where("tag_manifest_id = ?", manifestID)
+		Where("tag_manifest_id = ? AND tag_deleted_at IS NULL", manifestID)
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	dst := make([]string, 0)
 	sql, args, err := stmt.ToSql()
@@ -1800,7 +1923,7 @@ func (t tagDao) DeleteTagsByImageName(
 		return fmt.Errorf("failed to convert tag query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, t.db)
+	db := util.GetAccessor(ctx, t.db, t.limits)
 
 	_, err = db.ExecContext(ctx, toSQL, args...)
 	if err != nil {
@@ -1962,6 +2085,23 @@ func (t tagDao) mapToTagInfoList(
 	return &tagInfos, nil
 }
 
+func mapToTrashedOCIVersions(dst []*trashedOCIVersionDB) (*[]types.TrashedOCIVersion, error) {
+	versions := make([]types.TrashedOCIVersion, 0, len(dst))
+	for _, d := range dst {
+		dgst, err := types.Digest(util.GetHexEncodedString(d.Digest)).Parse()
+		if err != nil {
+			return nil, fmt.Errorf("invalid digest: %s, error: %w", util.GetHexEncodedString(d.Digest), err)
+		}
+		versions = append(versions, types.TrashedOCIVersion{
+			ImageName: d.ImageName,
+			Tag:       d.Name.String,
+			Digest:    string(dgst),
+			DeletedAt: time.UnixMilli(d.DeletedAt),
+		})
+	}
+	return &versions, nil
+}
+
 func (t tagDao) mapToOciVersions(
 	dst []*ociVersionMetadataDB,
 ) (*[]types.OciVersionMetadata, error) {
@@ -2000,6 +2140,9 @@ func (t tagDao) mapToTagMetadata(
 	if dst.ArtifactUUID.Valid {
 		tagMetadata.ArtifactUUID = dst.ArtifactUUID.String
 	}
+	if dst.DeletedAt.Valid {
+		tagMetadata.DeletedAt = time.UnixMilli(dst.DeletedAt.Int64)
+	}
 
 	return tagMetadata, nil
 }
@@ -2026,6 +2169,9 @@ func (t tagDao) mapToOciVersion(
 	if dst.ArtifactUUID.Valid {
 		ociVersion.ArtifactUUID = dst.ArtifactUUID.String
 	}
+	if dst.DeletedAt.Valid {
+		ociVersion.DeletedAt = time.UnixMilli(dst.DeletedAt.Int64)
+	}
 
 	dgst := types.Digest(util.GetHexEncodedString(dst.Digest))
 	ociVersion.Digest = string(dgst)
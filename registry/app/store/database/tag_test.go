@@ -0,0 +1,100 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagVersionsSoftDeleteFilter(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	manifestDao := database.NewManifestDao(db, nil, util.Limits{})
+	tagDao := database.NewTagDao(db, util.Limits{})
+
+	registryID := createRegistry(t, db, "docker")
+	live := createManifest(t, db, registryID, "app", digest.FromString("live"))
+	deleted := createManifest(t, db, registryID, "app", digest.FromString("deleted"))
+	createTag(t, db, registryID, "app", "v1", live)
+	createTag(t, db, registryID, "app", "v2", live)
+	createTag(t, db, registryID, "app", "v3", deleted)
+	require.NoError(t, tagDao.DeleteTag(ctx, registryID, "app", "v2"))
+	require.NoError(t, manifestDao.SoftDelete(ctx, registryID, deleted))
+
+	tests := []struct {
+		name      string
+		filter    types.SoftDeleteFilter
+		tags      []string
+		manifests []string
+	}{
+		{
+			name:      "exclude",
+			filter:    types.SoftDeleteFilterExclude,
+			tags:      []string{"v1"},
+			manifests: []string{version(t, digest.FromString("live"))},
+		},
+		{
+			name:   "include",
+			filter: types.SoftDeleteFilterInclude,
+			tags:   []string{"v1", "v2", "v3"},
+			manifests: []string{
+				version(t, digest.FromString("deleted")),
+				version(t, digest.FromString("live")),
+			},
+		},
+		{
+			name:      "only",
+			filter:    types.SoftDeleteFilterOnly,
+			tags:      []string{"v2", "v3"},
+			manifests: []string{version(t, digest.FromString("deleted"))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opt := types.WithSoftDeleteFilter(tt.filter)
+
+			count, err := tagDao.CountAllTagsByRepoAndImage(ctx, 1, "docker", "app", "", opt)
+			require.NoError(t, err)
+			require.Equal(t, int64(len(tt.tags)), count)
+			tags, err := tagDao.GetAllTagsByRepoAndImage(ctx, 1, "docker", "app", "name", "ASC", 10, 0, "", opt)
+			require.NoError(t, err)
+			names := make([]string, 0, len(*tags))
+			for _, tag := range *tags {
+				names = append(names, tag.Name)
+				require.Equal(t, tag.Name != "v1", !tag.DeletedAt.IsZero(), "only v1 is live")
+			}
+			require.Equal(t, tt.tags, names)
+
+			count, err = tagDao.CountOciVersionByRepoAndImage(ctx, 1, "docker", "app", "", opt)
+			require.NoError(t, err)
+			require.Equal(t, int64(len(tt.manifests)), count)
+			versions, err := tagDao.GetAllOciVersionsByRepoAndImage(ctx, 1, "docker", "app", "", "", 10, 0, "", opt)
+			require.NoError(t, err)
+			digests := make([]string, 0, len(*versions))
+			for _, v := range *versions {
+				digests = append(digests, v.Digest)
+			}
+			require.ElementsMatch(t, tt.manifests, digests)
+		})
+	}
+}
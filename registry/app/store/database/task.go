@@ -31,16 +31,18 @@ import (
 
 var _ store.TaskRepository = (*taskStore)(nil)
 
-func NewTaskStore(db *sqlx.DB, tx dbtx.Transactor) store.TaskRepository {
+func NewTaskStore(db *sqlx.DB, tx dbtx.Transactor, limits util.Limits) store.TaskRepository {
 	return &taskStore{
-		db: db,
-		tx: tx,
+		db:     db,
+		tx:     tx,
+		limits: limits,
 	}
 }
 
 type taskStore struct {
-	db *sqlx.DB
-	tx dbtx.Transactor
+	db     *sqlx.DB
+	tx     dbtx.Transactor
+	limits util.Limits
 }
 
 // Find returns a task by its key.
@@ -56,8 +58,10 @@ func (s *taskStore) Find(ctx context.Context, key string) (*types.Task, error) {
 		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
 
+	db := util.GetAccessor(ctx, s.db, s.limits)
+
 	taskDB := &TaskDB{}
-	if err := s.db.GetContext(ctx, taskDB, query, args...); err != nil {
+	if err := db.GetContext(ctx, taskDB, query, args...); err != nil {
 		return nil, fmt.Errorf("failed to find task with key %s: %w", key, err)
 	}
 	return taskDB.ToTask(), nil
@@ -128,7 +132,7 @@ func (s *taskStore) LockForUpdate(ctx context.Context, task *types.Task) (types.
 		return "", fmt.Errorf("failed to build lock for update query: %w", err)
 	}
 	var result string
-	err = s.db.QueryRowContext(ctx, query, args...).Scan(&result)
+	err = s.db.GetContext(ctx, &result, query, args...)
 	if err != nil {
 		return "", fmt.Errorf("failed to lock task with key %s: %w", task.Key, err)
 	}
@@ -155,7 +159,7 @@ func (s *taskStore) CompleteTask(
 		return false, fmt.Errorf("failed to build complete task query: %w", err)
 	}
 	var runAgain bool
-	err = s.db.QueryRowContext(ctx, query, args...).Scan(&runAgain)
+	err = s.db.GetContext(ctx, &runAgain, query, args...)
 	if err != nil {
 		return runAgain, fmt.Errorf("failed to complete task %s with status %s: %w", key, status, err)
 	}
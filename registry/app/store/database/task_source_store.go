@@ -110,6 +110,32 @@ func (s *taskSourceStore) ClaimSources(ctx context.Context, key string, runID st
 	return nil
 }
 
+// FindByRunID returns the sources claimed by a run.
+func (s *taskSourceStore) FindByRunID(ctx context.Context, runID string) ([]types.TaskSource, error) {
+	stmt := databaseg.Builder.
+		Select(
+			"registry_task_source_key", "registry_task_source_type", "registry_task_source_id",
+			"registry_task_source_status", "registry_task_source_error", "registry_task_source_run_id",
+			"registry_task_source_updated_at").
+		From("registry_task_sources").
+		Where("registry_task_source_run_id = ?", runID)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var dst []*TaskSourceDB
+	if err := s.db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to find sources of run %s: %w", runID, err)
+	}
+	sources := make([]types.TaskSource, 0, len(dst))
+	for _, src := range dst {
+		sources = append(sources, *src.ToTaskSource())
+	}
+	return sources, nil
+}
+
 // UpdateSourceStatus updates the status of sources for a specific run.
 func (s *taskSourceStore) UpdateSourceStatus(
 	ctx context.Context,
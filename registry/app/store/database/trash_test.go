@@ -0,0 +1,167 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	gitnesstypes "github.com/harness/gitness/types"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+)
+
+func createManifest(t *testing.T, db *sqlx.DB, registryID int64, imageName string, d digest.Digest) int64 {
+	t.Helper()
+	digestBytes, err := types.GetDigestBytes(d)
+	require.NoError(t, err)
+	now := time.Now().UnixMilli()
+	res, err := db.Exec(`INSERT INTO manifests (manifest_registry_id, manifest_schema_version, manifest_media_type_id,
+		manifest_total_size, manifest_digest, manifest_payload, manifest_image_name, manifest_created_at,
+		manifest_created_by, manifest_updated_at, manifest_updated_by, manifest_configuration_media_type,
+		manifest_annotations)
+		VALUES (?, 2, (SELECT mt_id FROM media_types WHERE mt_media_type = ?), 10, ?, ?, ?, ?, 1, ?, 1, '', '{}')`,
+		registryID, "application/vnd.oci.image.manifest.v1+json", digestBytes, []byte("{}"), imageName, now, now)
+	require.NoError(t, err)
+	id, _ := res.LastInsertId()
+	return id
+}
+
+func createTag(t *testing.T, db *sqlx.DB, registryID int64, imageName, name string, manifestID int64) {
+	t.Helper()
+	now := time.Now().UnixMilli()
+	_, err := db.Exec(`INSERT INTO tags (tag_name, tag_image_name, tag_registry_id, tag_manifest_id, tag_created_at,
+		tag_updated_at, tag_created_by, tag_updated_by) VALUES (?, ?, ?, ?, ?, ?, 1, 1)`,
+		name, imageName, registryID, manifestID, now, now)
+	require.NoError(t, err)
+}
+
+// version returns the artifact version of an OCI manifest.
+func version(t *testing.T, d digest.Digest) string {
+	t.Helper()
+	dgst, err := types.NewDigest(d)
+	require.NoError(t, err)
+	return dgst.String()
+}
+
+func TestTrashSoftDeleteAndRestore(t *testing.T) {
+	ctx := request.WithAuthSession(context.Background(), &auth.Session{Principal: gitnesstypes.Principal{ID: 1}})
+	db := setupDB(t)
+	manifestDao := database.NewManifestDao(db, nil, util.Limits{})
+	tagDao := database.NewTagDao(db, util.Limits{})
+	artifactDao := database.NewArtifactDao(db, nil, util.Limits{})
+
+	d := digest.FromString("app")
+	registryID := createRegistry(t, db, "docker")
+	imageID := createImage(t, db, registryID, "app")
+	manifestID := createManifest(t, db, registryID, "app", d)
+	createTag(t, db, registryID, "app", "v1", manifestID)
+	createTag(t, db, registryID, "app", "latest", manifestID)
+	_, err := db.Exec(`UPDATE artifacts SET artifact_metadata = ? WHERE artifact_id = ?`,
+		[]byte(`{"size":10}`), createArtifact(t, db, imageID, version(t, d)))
+	require.NoError(t, err)
+
+	require.NoError(t, manifestDao.SoftDelete(ctx, registryID, manifestID))
+	require.NoError(t, artifactDao.SoftDeleteByVersionAndImageName(ctx, "app", version(t, d), registryID))
+
+	count, err := manifestDao.CountByImageName(ctx, registryID, "app")
+	require.NoError(t, err)
+	require.Zero(t, count)
+	count, err = manifestDao.CountByImageName(ctx, registryID, "app",
+		types.WithSoftDeleteFilter(types.SoftDeleteFilterInclude))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	tags, err := tagDao.ListDeletedTags(ctx, registryID)
+	require.NoError(t, err)
+	require.Len(t, *tags, 2, "the tags are deleted along with the manifest")
+	artifacts, err := artifactDao.GetAllArtifactsByRepo(ctx, registryID, 10, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, *artifacts, "soft-deleted artifacts are not listed")
+	require.ErrorIs(t, manifestDao.SoftDelete(ctx, registryID, manifestID), gitnessstore.ErrResourceNotFound)
+
+	restored, err := manifestDao.Restore(ctx, registryID, manifestID)
+	require.NoError(t, err)
+	require.True(t, restored)
+	a, err := artifactDao.GetByRegistryImageAndVersion(ctx, registryID, "app", version(t, d))
+	require.NoError(t, err)
+	_, err = artifactDao.CreateOrUpdate(ctx, a)
+	require.NoError(t, err)
+
+	tags, err = tagDao.ListDeletedTags(ctx, registryID)
+	require.NoError(t, err)
+	require.Empty(t, *tags)
+	artifacts, err = artifactDao.GetAllArtifactsByRepo(ctx, registryID, 10, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, *artifacts, 1)
+	a, err = artifactDao.GetByRegistryImageAndVersion(ctx, registryID, "app", version(t, d))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"size":10}`, string(a.Metadata), "the restore keeps the metadata")
+
+	restored, err = manifestDao.Restore(ctx, registryID, manifestID)
+	require.NoError(t, err)
+	require.False(t, restored, "a manifest which isn't in the trash isn't restored")
+}
+
+func TestTrashExpiry(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	manifestDao := database.NewManifestDao(db, nil, util.Limits{})
+	tagDao := database.NewTagDao(db, util.Limits{})
+
+	registryID := createRegistry(t, db, "docker")
+	expired := createManifest(t, db, registryID, "app", digest.FromString("expired"))
+	recent := createManifest(t, db, registryID, "app", digest.FromString("recent"))
+	live := createManifest(t, db, registryID, "app", digest.FromString("live"))
+	createTag(t, db, registryID, "app", "old", live)
+	createTag(t, db, registryID, "app", "new", live)
+	createTag(t, db, registryID, "app", "latest", live)
+
+	now := time.Now()
+	old := now.Add(-48 * time.Hour).UnixMilli()
+	require.NoError(t, manifestDao.SoftDelete(ctx, registryID, expired))
+	require.NoError(t, manifestDao.SoftDelete(ctx, registryID, recent))
+	require.NoError(t, tagDao.DeleteTag(ctx, registryID, "app", "old"))
+	require.NoError(t, tagDao.DeleteTag(ctx, registryID, "app", "new"))
+	_, err := db.Exec(`UPDATE manifests SET manifest_deleted_at = ? WHERE manifest_id = ?`, old, expired)
+	require.NoError(t, err)
+	_, err = db.Exec(`UPDATE tags SET tag_deleted_at = ? WHERE tag_name = 'old'`, old)
+	require.NoError(t, err)
+
+	manifests, err := manifestDao.ListDeletedBefore(ctx, now.Add(-24*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, manifests, 1)
+	require.Equal(t, expired, manifests[0].ID)
+	require.Equal(t, "app", manifests[0].ImageName)
+
+	purged, err := tagDao.PurgeDeletedBefore(ctx, now.Add(-24*time.Hour), 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged)
+	tags, err := tagDao.ListDeletedTags(ctx, registryID)
+	require.NoError(t, err)
+	require.Len(t, *tags, 1)
+	require.Equal(t, "new", (*tags)[0].Tag)
+	_, err = tagDao.FindTag(ctx, registryID, "app", "latest")
+	require.NoError(t, err, "the tags which aren't in the trash are kept")
+}
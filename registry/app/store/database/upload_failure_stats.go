@@ -0,0 +1,165 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type UploadFailureStatsDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewUploadFailureStatsDao(db *sqlx.DB, limits util.Limits) store.UploadFailureStatsRepository {
+	return &UploadFailureStatsDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+type uploadFailureStatDB struct {
+	RegistryID   int64  `db:"registry_id"`
+	RegistryName string `db:"registry_name"`
+	PackageType  string `db:"package_type"`
+	ErrorClass   string `db:"error_class"`
+	Count        int64  `db:"count"`
+	LastFailedAt int64  `db:"last_failed_at"`
+}
+
+func (d UploadFailureStatsDao) Increment(
+	ctx context.Context,
+	registryID int64,
+	packageType artifact.PackageType,
+	errorClass types.UploadErrorClass,
+	failedAt time.Time,
+) error {
+	const sqlQuery = `
+		INSERT INTO registry_upload_failure_stats (
+			 registry_upload_failure_stat_registry_id
+			,registry_upload_failure_stat_package_type
+			,registry_upload_failure_stat_error_class
+			,registry_upload_failure_stat_day
+			,registry_upload_failure_stat_count
+			,registry_upload_failure_stat_last_failed_at
+		) VALUES ($1, $2, $3, $4, 1, $5)
+		ON CONFLICT (registry_upload_failure_stat_registry_id, registry_upload_failure_stat_package_type,
+			registry_upload_failure_stat_error_class, registry_upload_failure_stat_day)
+		DO UPDATE SET
+			 registry_upload_failure_stat_count = registry_upload_failure_stats.registry_upload_failure_stat_count + 1
+			,registry_upload_failure_stat_last_failed_at = EXCLUDED.registry_upload_failure_stat_last_failed_at`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	_, err := db.ExecContext(ctx, sqlQuery, registryID, string(packageType), string(errorClass),
+		uploadFailureDay(failedAt), failedAt.UnixMilli())
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to count failed upload")
+	}
+	return nil
+}
+
+func (d UploadFailureStatsDao) GetStats(
+	ctx context.Context,
+	rootParentID int64,
+	from time.Time,
+	to time.Time,
+) ([]types.UploadFailureStat, error) {
+	stmt := database.Builder.
+		Select(
+			"s.registry_upload_failure_stat_registry_id AS registry_id",
+			"r.registry_name AS registry_name",
+			"s.registry_upload_failure_stat_package_type AS package_type",
+			"s.registry_upload_failure_stat_error_class AS error_class",
+			"SUM(s.registry_upload_failure_stat_count) AS count",
+			"MAX(s.registry_upload_failure_stat_last_failed_at) AS last_failed_at",
+		).
+		From("registry_upload_failure_stats s").
+		Join("registries r ON r.registry_id = s.registry_upload_failure_stat_registry_id").
+		Where("r.registry_root_parent_id = ?", rootParentID).
+		Where("s.registry_upload_failure_stat_day >= ?", uploadFailureDay(from)).
+		Where("s.registry_upload_failure_stat_day < ?", to.UnixMilli()).
+		GroupBy(
+			"s.registry_upload_failure_stat_registry_id",
+			"r.registry_name",
+			"s.registry_upload_failure_stat_package_type",
+			"s.registry_upload_failure_stat_error_class",
+		).
+		OrderBy("count DESC", "registry_name ASC")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*uploadFailureStatDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to get upload failure stats")
+	}
+
+	stats := make([]types.UploadFailureStat, len(dst))
+	for i, s := range dst {
+		stats[i] = types.UploadFailureStat{
+			RegistryID:   s.RegistryID,
+			RegistryName: s.RegistryName,
+			PackageType:  artifact.PackageType(s.PackageType),
+			ErrorClass:   types.UploadErrorClass(s.ErrorClass),
+			Count:        s.Count,
+			LastFailedAt: time.UnixMilli(s.LastFailedAt),
+		}
+	}
+	return stats, nil
+}
+
+func (d UploadFailureStatsDao) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	stmt := database.Builder.
+		Delete("registry_upload_failure_stats").
+		Where("registry_upload_failure_stat_day < ?", uploadFailureDay(before))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Failed to delete upload failure stats")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	return count, nil
+}
+
+// uploadFailureDay returns the start of the UTC day of t in milliseconds, failures are counted by day.
+func uploadFailureDay(t time.Time) int64 {
+	return t.UTC().Truncate(24 * time.Hour).UnixMilli()
+}
@@ -0,0 +1,154 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+type UpstreamProvenanceDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewUpstreamProvenanceDao(db *sqlx.DB, limits util.Limits) store.UpstreamProvenanceRepository {
+	return &UpstreamProvenanceDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+const (
+	upstreamProvenanceColumns = `
+		 upstream_provenance_artifact_id
+		,upstream_provenance_file_name
+		,upstream_provenance_source_url
+		,upstream_provenance_checksums
+		,upstream_provenance_first_seen`
+)
+
+type upstreamProvenanceDB struct {
+	ArtifactID int64  `db:"upstream_provenance_artifact_id"`
+	FileName   string `db:"upstream_provenance_file_name"`
+	SourceURL  string `db:"upstream_provenance_source_url"`
+	Checksums  string `db:"upstream_provenance_checksums"`
+	FirstSeen  int64  `db:"upstream_provenance_first_seen"`
+}
+
+// Record stores the provenance of a file unless it's already stored, the provenance of the first time the file was
+// cached is kept.
+func (d UpstreamProvenanceDao) Record(ctx context.Context, provenance *types.UpstreamProvenance) error {
+	const sqlQuery = `
+		INSERT INTO upstream_provenances (
+			 upstream_provenance_artifact_id
+			,upstream_provenance_file_name
+			,upstream_provenance_source_url
+			,upstream_provenance_checksums
+			,upstream_provenance_first_seen
+		) values (
+			 :upstream_provenance_artifact_id
+			,:upstream_provenance_file_name
+			,:upstream_provenance_source_url
+			,:upstream_provenance_checksums
+			,:upstream_provenance_first_seen
+		) ON CONFLICT (upstream_provenance_artifact_id, upstream_provenance_file_name) DO NOTHING`
+
+	if provenance.FirstSeenAt.IsZero() {
+		provenance.FirstSeenAt = time.Now()
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToUpstreamProvenanceDB(provenance))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind upstream provenance object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (d UpstreamProvenanceDao) ListByArtifact(
+	ctx context.Context,
+	artifactID int64,
+) ([]*types.UpstreamProvenance, error) {
+	stmt := database.Builder.
+		Select(upstreamProvenanceColumns).
+		From("upstream_provenances").
+		Where("upstream_provenance_artifact_id = ?", artifactID).
+		OrderBy("upstream_provenance_first_seen ASC", "upstream_provenance_file_name ASC")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*upstreamProvenanceDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list upstream provenance of artifact")
+	}
+
+	provenances := make([]*types.UpstreamProvenance, len(dst))
+	for i, p := range dst {
+		provenances[i] = mapToUpstreamProvenance(ctx, p)
+	}
+	return provenances, nil
+}
+
+func mapToUpstreamProvenanceDB(provenance *types.UpstreamProvenance) *upstreamProvenanceDB {
+	checksums := "{}"
+	if len(provenance.Checksums) > 0 {
+		if b, err := json.Marshal(provenance.Checksums); err == nil {
+			checksums = string(b)
+		}
+	}
+	return &upstreamProvenanceDB{
+		ArtifactID: provenance.ArtifactID,
+		FileName:   provenance.FileName,
+		SourceURL:  provenance.SourceURL,
+		Checksums:  checksums,
+		FirstSeen:  provenance.FirstSeenAt.UnixMilli(),
+	}
+}
+
+func mapToUpstreamProvenance(ctx context.Context, dst *upstreamProvenanceDB) *types.UpstreamProvenance {
+	checksums := map[string]string{}
+	if err := json.Unmarshal([]byte(dst.Checksums), &checksums); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to parse the upstream checksums of file %s of artifact %d",
+			dst.FileName, dst.ArtifactID)
+	}
+	return &types.UpstreamProvenance{
+		ArtifactID:  dst.ArtifactID,
+		FileName:    dst.FileName,
+		SourceURL:   dst.SourceURL,
+		Checksums:   checksums,
+		FirstSeenAt: time.UnixMilli(dst.FirstSeen),
+	}
+}
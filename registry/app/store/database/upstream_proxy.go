@@ -29,7 +29,6 @@ import (
 	"github.com/harness/gitness/registry/types"
 	gitness_store "github.com/harness/gitness/store"
 	databaseg "github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
@@ -46,15 +45,17 @@ type UpstreamproxyDao struct {
 	registryDao store.RegistryRepository
 	db          *sqlx.DB
 	spaceFinder refcache.SpaceFinder
+	limits      util.Limits
 }
 
 func NewUpstreamproxyDao(
-	db *sqlx.DB, registryDao store.RegistryRepository, spaceFinder refcache.SpaceFinder,
+	db *sqlx.DB, registryDao store.RegistryRepository, spaceFinder refcache.SpaceFinder, limits util.Limits,
 ) store.UpstreamProxyConfigRepository {
 	return &UpstreamproxyDao{
 		registryDao: registryDao,
 		db:          db,
 		spaceFinder: spaceFinder,
+		limits:      limits,
 	}
 }
 
@@ -65,6 +66,7 @@ type upstreamProxyConfigDB struct {
 	Source                   string         `db:"upstream_proxy_config_source"`
 	URL                      string         `db:"upstream_proxy_config_url"`
 	FirewallMode             sql.NullString `db:"upstream_proxy_config_firewall_mode"`
+	FirewallDelayHours       int            `db:"upstream_proxy_config_firewall_delay_hours"`
 	AuthType                 string         `db:"upstream_proxy_config_auth_type"`
 	UserName                 string         `db:"upstream_proxy_config_user_name"`
 	SecretIdentifier         sql.NullString `db:"upstream_proxy_config_secret_identifier"`
@@ -94,6 +96,7 @@ type upstreamProxyDB struct {
 	RepoURL                  string               `db:"repo_url"`
 	RepoAuthType             string               `db:"repo_auth_type"`
 	FirewallMode             sql.NullString       `db:"firewall_mode"`
+	FirewallDelayHours       sql.NullInt64        `db:"firewall_delay_hours"`
 	UserName                 string               `db:"user_name"`
 	SecretIdentifier         sql.NullString       `db:"secret_identifier"`
 	SecretSpaceID            sql.NullInt32        `db:"secret_space_id"`
@@ -120,6 +123,7 @@ func getUpstreamProxyQuery() squirrel.SelectBuilder {
 			" r.registry_blocked_pattern as blocked_pattern," +
 			" r.registry_config as registry_config," +
 			" u.upstream_proxy_config_firewall_mode as firewall_mode," +
+			" u.upstream_proxy_config_firewall_delay_hours as firewall_delay_hours," +
 			" u.upstream_proxy_config_url as repo_url," +
 			" u.upstream_proxy_config_source as source," +
 			" u.upstream_proxy_config_auth_type as repo_auth_type," +
@@ -144,7 +148,7 @@ func (r UpstreamproxyDao) Get(ctx context.Context, id int64) (upstreamProxy *typ
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	dst := new(upstreamProxyDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -168,7 +172,7 @@ func (r UpstreamproxyDao) GetByRegistryIdentifier(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	dst := new(upstreamProxyDB)
 	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
@@ -190,7 +194,7 @@ func (r UpstreamproxyDao) GetByParentID(ctx context.Context, parentID string) (
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	dst := []*upstreamProxyDB{}
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -210,6 +214,7 @@ func (r UpstreamproxyDao) Create(
 			,upstream_proxy_config_source
 			,upstream_proxy_config_url
 			,upstream_proxy_config_firewall_mode
+			,upstream_proxy_config_firewall_delay_hours
 			,upstream_proxy_config_auth_type
 			,upstream_proxy_config_user_name
 			,upstream_proxy_config_secret_identifier
@@ -226,6 +231,7 @@ func (r UpstreamproxyDao) Create(
 			,:upstream_proxy_config_source
 			,:upstream_proxy_config_url
 			,:upstream_proxy_config_firewall_mode
+			,:upstream_proxy_config_firewall_delay_hours
 			,:upstream_proxy_config_auth_type
 			,:upstream_proxy_config_user_name
 			,:upstream_proxy_config_secret_identifier
@@ -239,14 +245,14 @@ func (r UpstreamproxyDao) Create(
 			,:upstream_proxy_config_updated_by
 		) RETURNING upstream_proxy_config_registry_id`
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	query, arg, err := db.BindNamed(sqlQuery, r.mapToInternalUpstreamProxy(ctx, upstreamproxyRecord))
 	if err != nil {
 		return -1, databaseg.ProcessSQLErrorf(ctx,
 			err, "Failed to bind upstream proxy object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&upstreamproxyRecord.ID); err != nil {
+	if err = db.GetContext(ctx, &upstreamproxyRecord.ID, query, arg...); err != nil {
 		return -1, databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
 	}
 
@@ -266,7 +272,7 @@ func (r UpstreamproxyDao) Update(
 	// update Version (used for optimistic locking) and Updated time
 	upstreamProxy.UpdatedAt = time.Now().UnixMilli()
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	query, arg, err := db.BindNamed(sqlQuery, upstreamProxy)
 	if err != nil {
@@ -322,7 +328,7 @@ func (r UpstreamproxyDao) GetAll(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	dst := []*upstreamProxyDB{}
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -354,10 +360,10 @@ func (r UpstreamproxyDao) CountAll(
 		return -1, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 
 	var total int64
-	if err = db.QueryRowContext(ctx, sql, args...).Scan(&total); err != nil {
+	if err = db.GetContext(ctx, &total, sql, args...); err != nil {
 		return -1, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get upstream proxy count")
 	}
 	return total, nil
@@ -372,7 +378,7 @@ func (r UpstreamproxyDao) UpdateSecretSpaceID(
 		Set("upstream_proxy_config_secret_space_id", targetSpaceID).
 		Where("upstream_proxy_config_secret_space_id = ?", srcSpaceID)
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	query, args, err := stmt.ToSql()
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "failed to bind query")
@@ -400,7 +406,7 @@ func (r UpstreamproxyDao) UpdateUserNameSecretSpaceID(
 		Set("upstream_proxy_config_user_name_secret_space_id", targetSpaceID).
 		Where("upstream_proxy_config_user_name_secret_space_id = ?", srcSpaceID)
 
-	db := dbtx.GetAccessor(ctx, r.db)
+	db := util.GetAccessor(ctx, r.db, r.limits)
 	query, args, err := stmt.ToSql()
 	if err != nil {
 		return 0, databaseg.ProcessSQLErrorf(ctx, err, "failed to bind query")
@@ -439,6 +445,7 @@ func (r UpstreamproxyDao) mapToInternalUpstreamProxy(
 		Source:                   in.Source,
 		URL:                      in.URL,
 		FirewallMode:             util.GetEmptySQLString(in.FirewallMode),
+		FirewallDelayHours:       in.FirewallDelayHours,
 		AuthType:                 in.AuthType,
 		UserName:                 in.UserName,
 		SecretIdentifier:         util.GetEmptySQLString(in.SecretIdentifier),
@@ -532,6 +539,7 @@ func (r UpstreamproxyDao) mapToUpstreamProxy(
 		RepoURL:                  dst.RepoURL,
 		RepoAuthType:             dst.RepoAuthType,
 		FirewallMode:             firewallMode,
+		FirewallDelayHours:       int(dst.FirewallDelayHours.Int64),
 		UserName:                 dst.UserName,
 		SecretIdentifier:         secretIdentifier,
 		SecretSpaceID:            secretSpaceID,
@@ -0,0 +1,161 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// QueryLimits bounds the queries of a category of statements.
+type QueryLimits struct {
+	// Timeout cancels statements running longer, 0 disables it.
+	Timeout time.Duration
+	// SlowThreshold logs statements running longer, 0 disables it.
+	SlowThreshold time.Duration
+}
+
+// Limits bounds the read and write statements of the registry DAOs, the zero value doesn't bound them.
+type Limits struct {
+	Reads  QueryLimits
+	Writes QueryLimits
+}
+
+func (l Limits) of(category queryCategory) QueryLimits {
+	if category == queryCategoryWrite {
+		return l.Writes
+	}
+	return l.Reads
+}
+
+type queryCategory string
+
+const (
+	queryCategoryRead  queryCategory = "read"
+	queryCategoryWrite queryCategory = "write"
+)
+
+// Accessor runs the statements of the registry DAOs. Each statement is run and its rows are read within a
+// single call, so the limits of its category bound all of it.
+type Accessor interface {
+	BindNamed(query string, arg any) (string, []any, error)
+	Rebind(query string) string
+
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error)
+}
+
+// GetAccessor returns the accessor of the transaction in the context, or of the database if there is none,
+// with the query limits applied.
+func GetAccessor(ctx context.Context, db *sqlx.DB, limits Limits) Accessor {
+	return limitedAccessor{accessor: dbtx.GetAccessor(ctx, db), limits: limits}
+}
+
+type limitedAccessor struct {
+	accessor dbtx.Accessor
+	limits   Limits
+}
+
+func (a limitedAccessor) BindNamed(query string, arg any) (string, []any, error) {
+	return a.accessor.BindNamed(query, arg)
+}
+
+func (a limitedAccessor) Rebind(query string) string {
+	return a.accessor.Rebind(query)
+}
+
+func (a limitedAccessor) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	return run(ctx, a.limits, categoryOf(query), query, args, func(ctx context.Context) error {
+		return a.accessor.GetContext(ctx, dest, query, args...)
+	})
+}
+
+func (a limitedAccessor) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	return run(ctx, a.limits, categoryOf(query), query, args, func(ctx context.Context) error {
+		return a.accessor.SelectContext(ctx, dest, query, args...)
+	})
+}
+
+func (a limitedAccessor) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	var result sql.Result
+	err := run(ctx, a.limits, queryCategoryWrite, query, args, func(ctx context.Context) error {
+		var err error
+		result, err = a.accessor.ExecContext(ctx, query, args...)
+		return err
+	})
+	return result, err
+}
+
+func (a limitedAccessor) NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error) {
+	var result sql.Result
+	err := run(ctx, a.limits, queryCategoryWrite, query, []any{arg}, func(ctx context.Context) error {
+		var err error
+		result, err = a.accessor.NamedExecContext(ctx, query, arg)
+		return err
+	})
+	return result, err
+}
+
+var writeKeywords = regexp.MustCompile(`\b(INSERT|UPDATE|DELETE)\b`)
+
+// categoryOf returns the category of statements which return rows, they are writes when they modify rows,
+// even from a common table expression, or lock the rows they read.
+func categoryOf(query string) queryCategory {
+	statement := strings.ToUpper(strings.TrimSpace(query))
+	if (strings.HasPrefix(statement, "SELECT") || strings.HasPrefix(statement, "WITH")) &&
+		!writeKeywords.MatchString(statement) {
+		return queryCategoryRead
+	}
+	return queryCategoryWrite
+}
+
+// WithBatchTimeout bounds a batch of a long scan, it fails right away when the scan was already cancelled
+// so no connection is taken for a batch nobody waits for. A timeout of 0 only checks the cancellation.
+func WithBatchTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc, error) {
+	if err := ctx.Err(); err != nil {
+		return ctx, func() {}, err
+	}
+	if timeout <= 0 {
+		return ctx, func() {}, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, nil
+}
+
+func run(
+	ctx context.Context,
+	limits Limits,
+	category queryCategory,
+	query string,
+	args []any,
+	fn func(ctx context.Context) error,
+) error {
+	if timeout := limits.of(category).Timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	defer logSlow(ctx, limits, category, query, args, time.Now())
+	return fn(ctx)
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/store/database/dbtx"
+)
+
+type blockingAccessor struct {
+	dbtx.Accessor
+}
+
+func (blockingAccessor) GetContext(ctx context.Context, _ any, _ string, _ ...any) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (blockingAccessor) ExecContext(ctx context.Context, _ string, _ ...any) (sql.Result, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestLimitedAccessorTimeout(t *testing.T) {
+	a := limitedAccessor{
+		accessor: blockingAccessor{},
+		limits:   Limits{Reads: QueryLimits{Timeout: 10 * time.Millisecond}},
+	}
+	err := a.GetContext(context.Background(), nil, "SELECT 1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the read to time out, got %v", err)
+	}
+}
+
+func TestLimitedAccessorWriteTimeout(t *testing.T) {
+	// the read timeout is longer than the test would run, only the write timeout can stop the statements.
+	a := limitedAccessor{
+		accessor: blockingAccessor{},
+		limits: Limits{
+			Reads:  QueryLimits{Timeout: time.Hour},
+			Writes: QueryLimits{Timeout: 10 * time.Millisecond},
+		},
+	}
+	err := a.GetContext(context.Background(), nil, "INSERT INTO tags (tag_name) VALUES ($1) RETURNING tag_id")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the insert to time out, got %v", err)
+	}
+	if _, err = a.ExecContext(context.Background(), "DELETE FROM tags"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the delete to time out, got %v", err)
+	}
+}
+
+func TestCategoryOf(t *testing.T) {
+	tests := map[string]queryCategory{
+		"SELECT * FROM registries":                            queryCategoryRead,
+		"\n\t\tselect count(*) FROM artifacts":                queryCategoryRead,
+		"WITH oci_artifacts AS (SELECT 1) SELECT 1":           queryCategoryRead,
+		"INSERT INTO tags (tag_name) VALUES ($1) RETURNING 1": queryCategoryWrite,
+		"UPDATE tags SET tag_name = $1 RETURNING tag_id":      queryCategoryWrite,
+		"SELECT tag_updated_at FROM tags":                     queryCategoryRead,
+		"SELECT 1 FROM tags WHERE tag_id = $1 FOR UPDATE":     queryCategoryWrite,
+		"WITH d AS (DELETE FROM tags RETURNING 1) SELECT 1":   queryCategoryWrite,
+	}
+	for query, want := range tests {
+		if got := categoryOf(query); got != want {
+			t.Errorf("categoryOf(%q) = %s, want %s", query, got, want)
+		}
+	}
+}
+
+func TestNormalizeQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{
+			query: "SELECT *\n\t\tFROM artifacts WHERE artifact_name = 'secret' AND artifact_id > 42",
+			want:  "SELECT * FROM artifacts WHERE artifact_name = ? AND artifact_id > ?",
+		},
+		{
+			query: "SELECT 1 FROM tags WHERE tag_id IN ($1, $2, $3) LIMIT 50",
+			want:  "SELECT ? FROM tags WHERE tag_id IN (...) LIMIT ?",
+		},
+		{
+			query: "UPDATE tags SET tag_name = :tag_name WHERE tag_id = :tag_id::bigint",
+			want:  "UPDATE tags SET tag_name = ? WHERE tag_id = ?::bigint",
+		},
+	}
+	for _, test := range tests {
+		if got := normalizeQuery(test.query); got != test.want {
+			t.Errorf("normalizeQuery(%q) = %q, want %q", test.query, got, test.want)
+		}
+	}
+
+	if fingerprintQuery(normalizeQuery("SELECT 1 FROM tags WHERE tag_id IN ($1, $2)")) !=
+		fingerprintQuery(normalizeQuery("SELECT 1 FROM tags WHERE tag_id IN ($1, $2, $3, $4)")) {
+		t.Error("expected queries differing in the length of an IN list to have the same fingerprint")
+	}
+}
+
+func TestRedactArgs(t *testing.T) {
+	got := redactArgs([]any{"token", int64(1), nil, []byte("abc")})
+	want := []string{"string(5)", "int64", "nil", "[]byte(3)"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("redactArgs()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"slices"
+
+	"github.com/harness/gitness/registry/types"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// SelectColumns returns the columns to select from the tags of the struct, the metadata column is left out if the
+// options skip it.
+func SelectColumns(s any, metadataColumn string, options types.QueryOptions) string {
+	columns := GetDBTagsFromStruct(s)
+	if options.SkipMetadata {
+		columns = slices.DeleteFunc(columns, func(column string) bool {
+			return column == metadataColumn
+		})
+	}
+	return ArrToStringByDelimiter(columns, ",")
+}
+
+// ApplyQueryOptions applies the row limit and the lock mode of the options to the query. Locks are skipped on
+// SQLite which doesn't support them.
+func ApplyQueryOptions(stmt sq.SelectBuilder, driverName string, options types.QueryOptions) sq.SelectBuilder {
+	if options.Limit > 0 {
+		stmt = stmt.Limit(SafeIntToUInt64(options.Limit))
+	}
+	if options.LockMode == types.LockModeForUpdate && driverName != "sqlite3" {
+		stmt = stmt.Suffix("FOR UPDATE")
+	}
+	return stmt
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+type queryOptionsRow struct {
+	ID       int64  `db:"row_id"`
+	Metadata []byte `db:"row_metadata"`
+}
+
+func TestApplyQueryOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		driver string
+		opts   []types.QueryOption
+		want   string
+	}{
+		{
+			name:   "defaults",
+			driver: "postgres",
+			want:   "SELECT row_id,row_metadata FROM rows",
+		},
+		{
+			name:   "projection, limit and lock",
+			driver: "postgres",
+			opts: []types.QueryOption{
+				types.WithoutMetadata(), types.WithLimit(10), types.WithLockMode(types.LockModeForUpdate),
+			},
+			want: "SELECT row_id FROM rows LIMIT 10 FOR UPDATE",
+		},
+		{
+			name:   "no lock on sqlite",
+			driver: "sqlite3",
+			opts:   []types.QueryOption{types.WithLockMode(types.LockModeForUpdate)},
+			want:   "SELECT row_id,row_metadata FROM rows",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := types.MakeQueryOptions(tt.opts...)
+			stmt := sq.Select(SelectColumns(queryOptionsRow{}, "row_metadata", options)).From("rows")
+			got, _, err := ApplyQueryOptions(stmt, tt.driver, options).ToSql()
+			if err != nil {
+				t.Fatalf("failed to build query: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got query %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+)
+
+var slowQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "registry",
+	Subsystem: "database",
+	Name:      "slow_queries_total",
+	Help:      "Number of registry queries exceeding the slow threshold, by query fingerprint.",
+}, []string{"category", "fingerprint"})
+
+var (
+	queryStringLiteral = regexp.MustCompile(`'(?:[^']|'')*'`)
+	queryNumberLiteral = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+	queryPlaceholder   = regexp.MustCompile(`\$\d+|\?`)
+	// named placeholders of sqlx, a preceding colon is a cast like ::bigint.
+	queryNamedParam     = regexp.MustCompile(`(^|[^:]):[A-Za-z_][A-Za-z0-9_]*`)
+	queryPlaceholderSet = regexp.MustCompile(`\(\s*\?(?:\s*,\s*\?)+\s*\)`)
+)
+
+// normalizeQuery collapses the whitespace of a query and replaces its literals and placeholders with ?,
+// lists of placeholders are collapsed to (...) so queries differing only in the length of an IN list
+// have the same fingerprint.
+func normalizeQuery(query string) string {
+	query = strings.Join(strings.Fields(query), " ")
+	query = queryStringLiteral.ReplaceAllString(query, "?")
+	query = queryPlaceholder.ReplaceAllString(query, "?")
+	query = queryNamedParam.ReplaceAllString(query, "${1}?")
+	query = queryNumberLiteral.ReplaceAllString(query, "?")
+	query = queryPlaceholderSet.ReplaceAllString(query, "(...)")
+	return query
+}
+
+// fingerprintQuery returns a short stable identifier of a normalized query.
+func fingerprintQuery(normalized string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(normalized))
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
+// redactArgs describes the bound parameters of a query by their type only, their values can be sensitive.
+func redactArgs(args []any) []string {
+	redacted := make([]string, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case nil:
+			redacted[i] = "nil"
+		case string:
+			redacted[i] = fmt.Sprintf("string(%d)", len(v))
+		case []byte:
+			redacted[i] = fmt.Sprintf("[]byte(%d)", len(v))
+		default:
+			redacted[i] = fmt.Sprintf("%T", arg)
+		}
+	}
+	return redacted
+}
+
+func logSlow(
+	ctx context.Context, limits Limits, category queryCategory, query string, args []any, started time.Time,
+) {
+	threshold := limits.of(category).SlowThreshold
+	if threshold <= 0 {
+		return
+	}
+	duration := time.Since(started)
+	if duration < threshold {
+		return
+	}
+
+	normalized := normalizeQuery(query)
+	fingerprint := fingerprintQuery(normalized)
+	slowQueries.WithLabelValues(string(category), fingerprint).Inc()
+
+	log.Ctx(ctx).Warn().
+		Str("category", string(category)).
+		Dur("duration", duration).
+		Str("fingerprint", fingerprint).
+		Str("query", normalized).
+		Strs("args", redactArgs(args)).
+		Msg("slow registry query")
+}
@@ -0,0 +1,408 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type VulnerabilityDao struct {
+	db     *sqlx.DB
+	limits util.Limits
+}
+
+func NewVulnerabilityDao(db *sqlx.DB, limits util.Limits) store.VulnerabilityRepository {
+	return &VulnerabilityDao{
+		db:     db,
+		limits: limits,
+	}
+}
+
+const (
+	vulnerabilityColumns = `
+		 vulnerability_id
+		,vulnerability_summary
+		,vulnerability_severity
+		,vulnerability_aliases
+		,vulnerability_modified
+		,vulnerability_updated`
+
+	vulnerableVersionColumns = `
+		 a.artifact_id
+		,r.registry_id
+		,r.registry_name
+		,r.registry_package_type
+		,i.image_name
+		,a.artifact_version`
+)
+
+type vulnerabilityDB struct {
+	ID       string `db:"vulnerability_id"`
+	Summary  string `db:"vulnerability_summary"`
+	Severity string `db:"vulnerability_severity"`
+	Aliases  string `db:"vulnerability_aliases"`
+	Modified int64  `db:"vulnerability_modified"`
+	Updated  int64  `db:"vulnerability_updated"`
+}
+
+type artifactVulnerabilityDB struct {
+	ArtifactID int64 `db:"artifact_vulnerability_artifact_id"`
+	vulnerabilityDB
+}
+
+type vulnerabilityCandidateDB struct {
+	ArtifactID  int64  `db:"artifact_id"`
+	ImageID     int64  `db:"artifact_image_id"`
+	RegistryID  int64  `db:"image_registry_id"`
+	PackageType string `db:"registry_package_type"`
+	Name        string `db:"image_name"`
+	Version     string `db:"artifact_version"`
+}
+
+type vulnerableVersionDB struct {
+	ArtifactID   int64  `db:"artifact_id"`
+	RegistryID   int64  `db:"registry_id"`
+	RegistryName string `db:"registry_name"`
+	PackageType  string `db:"registry_package_type"`
+	Name         string `db:"image_name"`
+	Version      string `db:"artifact_version"`
+}
+
+func (d VulnerabilityDao) Upsert(ctx context.Context, vulnerability *types.Vulnerability) error {
+	const sqlQuery = `
+		INSERT INTO vulnerabilities (
+			 vulnerability_id
+			,vulnerability_summary
+			,vulnerability_severity
+			,vulnerability_aliases
+			,vulnerability_modified
+			,vulnerability_updated
+		) values (
+			 :vulnerability_id
+			,:vulnerability_summary
+			,:vulnerability_severity
+			,:vulnerability_aliases
+			,:vulnerability_modified
+			,:vulnerability_updated
+		) ON CONFLICT (vulnerability_id) DO UPDATE SET
+			 vulnerability_summary = EXCLUDED.vulnerability_summary
+			,vulnerability_severity = EXCLUDED.vulnerability_severity
+			,vulnerability_aliases = EXCLUDED.vulnerability_aliases
+			,vulnerability_modified = EXCLUDED.vulnerability_modified
+			,vulnerability_updated = EXCLUDED.vulnerability_updated`
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToVulnerabilityDB(vulnerability))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind vulnerability object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Upsert query failed")
+	}
+	return nil
+}
+
+func (d VulnerabilityDao) Find(ctx context.Context, id string) (*types.Vulnerability, error) {
+	stmt := database.Builder.
+		Select(vulnerabilityColumns).
+		From("vulnerabilities").
+		Where("vulnerability_id = ?", id)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := new(vulnerabilityDB)
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to get vulnerability")
+	}
+	return mapToVulnerability(dst), nil
+}
+
+func (d VulnerabilityDao) ListCandidates(
+	ctx context.Context,
+	packageTypes []string,
+	afterArtifactID int64,
+	limit int,
+) ([]*types.VulnerabilityCandidate, error) {
+	stmt := database.Builder.
+		Select("a.artifact_id, a.artifact_image_id, i.image_registry_id, r.registry_package_type, "+
+			"i.image_name, a.artifact_version").
+		From("artifacts a").
+		Join("images i ON a.artifact_image_id = i.image_id").
+		Join("registries r ON r.registry_id = i.image_registry_id").
+		Where(sq.Eq{"r.registry_package_type": packageTypes}).
+		Where("a.artifact_id > ?", afterArtifactID).
+		OrderBy("a.artifact_id ASC").
+		Limit(util.SafeIntToUInt64(limit))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*vulnerabilityCandidateDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list vulnerability candidates")
+	}
+
+	candidates := make([]*types.VulnerabilityCandidate, len(dst))
+	for i, c := range dst {
+		candidates[i] = &types.VulnerabilityCandidate{
+			ArtifactID:  c.ArtifactID,
+			ImageID:     c.ImageID,
+			RegistryID:  c.RegistryID,
+			PackageType: c.PackageType,
+			Name:        c.Name,
+			Version:     c.Version,
+		}
+	}
+	return candidates, nil
+}
+
+func (d VulnerabilityDao) ReplaceMatches(
+	ctx context.Context,
+	artifactID int64,
+	vulnerabilityIDs []string,
+) ([]string, error) {
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	sql, args, err := database.Builder.
+		Select("artifact_vulnerability_vulnerability_id").
+		From("artifact_vulnerabilities").
+		Where("artifact_vulnerability_artifact_id = ?", artifactID).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var existing []string
+	if err = db.SelectContext(ctx, &existing, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list vulnerabilities of artifact")
+	}
+
+	deleteStmt := database.Builder.
+		Delete("artifact_vulnerabilities").
+		Where("artifact_vulnerability_artifact_id = ?", artifactID)
+	if len(vulnerabilityIDs) > 0 {
+		deleteStmt = deleteStmt.Where(sq.NotEq{"artifact_vulnerability_vulnerability_id": vulnerabilityIDs})
+	}
+	sql, args, err = deleteStmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+	if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to delete vulnerabilities of artifact")
+	}
+
+	known := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		known[id] = true
+	}
+	var added []string
+	insertStmt := database.Builder.
+		Insert("artifact_vulnerabilities").
+		Columns(
+			"artifact_vulnerability_artifact_id",
+			"artifact_vulnerability_vulnerability_id",
+			"artifact_vulnerability_created",
+		)
+	now := time.Now().UnixMilli()
+	for _, id := range vulnerabilityIDs {
+		if known[id] {
+			continue
+		}
+		known[id] = true
+		added = append(added, id)
+		insertStmt = insertStmt.Values(artifactID, id, now)
+	}
+	if len(added) == 0 {
+		return nil, nil
+	}
+
+	sql, args, err = insertStmt.Suffix("ON CONFLICT DO NOTHING").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+	if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to insert vulnerabilities of artifact")
+	}
+	return added, nil
+}
+
+func (d VulnerabilityDao) ListVulnerableVersions(
+	ctx context.Context,
+	spaceID int64,
+	severities []types.VulnerabilitySeverity,
+	search string,
+	limit int,
+	offset int,
+) ([]*types.VulnerableVersion, error) {
+	stmt := d.vulnerableVersionsQuery(vulnerableVersionColumns, spaceID, severities, search).
+		OrderBy("i.image_name ASC", "a.artifact_version ASC", "a.artifact_id ASC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*vulnerableVersionDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list vulnerable versions")
+	}
+
+	versions := make([]*types.VulnerableVersion, len(dst))
+	for i, v := range dst {
+		versions[i] = &types.VulnerableVersion{
+			ArtifactID:   v.ArtifactID,
+			RegistryID:   v.RegistryID,
+			RegistryName: v.RegistryName,
+			PackageType:  v.PackageType,
+			Name:         v.Name,
+			Version:      v.Version,
+		}
+	}
+	return versions, nil
+}
+
+func (d VulnerabilityDao) CountVulnerableVersions(
+	ctx context.Context,
+	spaceID int64,
+	severities []types.VulnerabilitySeverity,
+	search string,
+) (int64, error) {
+	sql, args, err := d.vulnerableVersionsQuery("COUNT(*)", spaceID, severities, search).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	var count int64
+	if err = db.GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Failed to count vulnerable versions")
+	}
+	return count, nil
+}
+
+func (d VulnerabilityDao) vulnerableVersionsQuery(
+	columns string,
+	spaceID int64,
+	severities []types.VulnerabilitySeverity,
+	search string,
+) sq.SelectBuilder {
+	stmt := database.Builder.
+		Select(columns).
+		From("artifacts a").
+		Join("images i ON a.artifact_image_id = i.image_id").
+		Join("registries r ON r.registry_id = i.image_registry_id").
+		Where("r.registry_parent_id = ?", spaceID).
+		Where(sq.Expr(`EXISTS (SELECT 1 FROM artifact_vulnerabilities
+			JOIN vulnerabilities ON vulnerability_id = artifact_vulnerability_vulnerability_id
+			WHERE artifact_vulnerability_artifact_id = a.artifact_id AND ?)`,
+			sq.Eq{"vulnerability_severity": severityStrings(severities)}))
+	if search != "" {
+		stmt = stmt.Where("LOWER(i.image_name) LIKE ?", sqlPartialMatch(strings.ToLower(search)))
+	}
+	return stmt
+}
+
+func (d VulnerabilityDao) ListForArtifacts(
+	ctx context.Context,
+	artifactIDs []int64,
+	severities []types.VulnerabilitySeverity,
+) (map[int64][]*types.Vulnerability, error) {
+	stmt := database.Builder.
+		Select("artifact_vulnerability_artifact_id," + vulnerabilityColumns).
+		From("artifact_vulnerabilities").
+		Join("vulnerabilities ON vulnerability_id = artifact_vulnerability_vulnerability_id").
+		Where(sq.Eq{"artifact_vulnerability_artifact_id": artifactIDs}).
+		Where(sq.Eq{"vulnerability_severity": severityStrings(severities)}).
+		OrderBy("vulnerability_id ASC")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := util.GetAccessor(ctx, d.db, d.limits)
+
+	dst := []*artifactVulnerabilityDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list vulnerabilities of artifacts")
+	}
+
+	vulnerabilities := make(map[int64][]*types.Vulnerability, len(artifactIDs))
+	for _, v := range dst {
+		vulnerabilities[v.ArtifactID] = append(vulnerabilities[v.ArtifactID], mapToVulnerability(&v.vulnerabilityDB))
+	}
+	return vulnerabilities, nil
+}
+
+func severityStrings(severities []types.VulnerabilitySeverity) []string {
+	result := make([]string, len(severities))
+	for i, severity := range severities {
+		result[i] = string(severity)
+	}
+	return result
+}
+
+func mapToVulnerabilityDB(vulnerability *types.Vulnerability) *vulnerabilityDB {
+	return &vulnerabilityDB{
+		ID:       vulnerability.ID,
+		Summary:  vulnerability.Summary,
+		Severity: string(vulnerability.Severity),
+		Aliases:  strings.Join(vulnerability.Aliases, ","),
+		Modified: vulnerability.ModifiedAt.UnixMilli(),
+		Updated:  vulnerability.UpdatedAt.UnixMilli(),
+	}
+}
+
+func mapToVulnerability(dst *vulnerabilityDB) *types.Vulnerability {
+	var aliases []string
+	if dst.Aliases != "" {
+		aliases = strings.Split(dst.Aliases, ",")
+	}
+	return &types.Vulnerability{
+		ID:         dst.ID,
+		Summary:    dst.Summary,
+		Severity:   types.VulnerabilitySeverity(dst.Severity),
+		Aliases:    aliases,
+		ModifiedAt: time.UnixMilli(dst.Modified),
+		UpdatedAt:  time.UnixMilli(dst.Updated),
+	}
+}
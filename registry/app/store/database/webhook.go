@@ -27,7 +27,6 @@ import (
 	"github.com/harness/gitness/registry/app/store/database/util"
 	gitnessstore "github.com/harness/gitness/store"
 	"github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 	gitnesstypes "github.com/harness/gitness/types"
 	gitnessenum "github.com/harness/gitness/types/enum"
 
@@ -62,9 +61,10 @@ var registryWebhooksFields = []string{
 	"registry_webhook_latest_execution_result",
 }
 
-func NewWebhookDao(db *sqlx.DB) store.WebhooksRepository {
+func NewWebhookDao(db *sqlx.DB, limits util.Limits) store.WebhooksRepository {
 	return &WebhookDao{
-		db: db,
+		db:     db,
+		limits: limits,
 	}
 }
 
@@ -92,7 +92,8 @@ type webhookDB struct {
 }
 
 type WebhookDao struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	limits util.Limits
 }
 
 func (w WebhookDao) Create(ctx context.Context, webhook *gitnesstypes.WebhookCore) error {
@@ -137,7 +138,7 @@ func (w WebhookDao) Create(ctx context.Context, webhook *gitnesstypes.WebhookCor
 			,:registry_webhook_scope
 		) RETURNING registry_webhook_id`
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	dbwebhook, err := mapToWebhookDB(webhook)
 	dbwebhook.Created = webhook.Created
@@ -151,7 +152,7 @@ func (w WebhookDao) Create(ctx context.Context, webhook *gitnesstypes.WebhookCor
 		return database.ProcessSQLErrorf(ctx, err, "Failed to registry bind webhook object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&webhook.ID); err != nil {
+	if err = db.GetContext(ctx, &webhook.ID, query, arg...); err != nil {
 		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
 	}
 
@@ -172,7 +173,7 @@ func (w WebhookDao) GetByRegistryAndIdentifier(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	dst := new(webhookDB)
 	if err = db.GetContext(ctx, dst, sqlQuery, args...); err != nil {
@@ -194,7 +195,7 @@ func (w WebhookDao) Find(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	dst := new(webhookDB)
 	if err = db.GetContext(ctx, dst, sqlQuery, args...); err != nil {
@@ -235,7 +236,7 @@ func (w WebhookDao) ListByRegistry(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	var dst []*webhookDB
 	if err = db.SelectContext(ctx, &dst, sqlQuery, args...); err != nil {
@@ -260,7 +261,7 @@ func (w WebhookDao) ListAllByRegistry(
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	var dst []*webhookDB
 	if err = db.SelectContext(ctx, &dst, sqlQuery, args...); err != nil {
@@ -288,10 +289,10 @@ func (w WebhookDao) CountAllByRegistry(
 		return -1, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	var count int64
-	err = db.QueryRowContext(ctx, sqlQuery, args...).Scan(&count)
+	err = db.GetContext(ctx, &count, sqlQuery, args...)
 	if err != nil {
 		return 0, database.ProcessSQLErrorf(ctx, err, "Failed executing count query")
 	}
@@ -319,7 +320,7 @@ func (w WebhookDao) Update(ctx context.Context, webhook *gitnesstypes.WebhookCor
 	}
 	dbWebhook.Updated = time.Now().UnixMilli()
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	query, arg, err := db.BindNamed(sqlQuery, dbWebhook)
 	if err != nil {
@@ -356,7 +357,7 @@ func (w WebhookDao) DeleteByRegistryAndIdentifier(
 		return fmt.Errorf("failed to convert purge registry_webhooks query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	_, err = db.ExecContext(ctx, query, args...)
 	if err != nil {
@@ -572,7 +573,7 @@ func (w WebhookDao) UpdateParentSpace(
 		Set("registry_webhook_space_id", targetSpaceID).
 		Where("registry_webhook_space_id = ?", srcSpaceID)
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 	query, args, err := stmt.ToSql()
 	if err != nil {
 		return 0, database.ProcessSQLErrorf(ctx, err, "failed to bind query")
@@ -600,7 +601,7 @@ func (w WebhookDao) UpdateSecretSpaceID(
 		Set("registry_webhook_secret_space_id", targetSpaceID).
 		Where("registry_webhook_secret_space_id = ?", srcSpaceID)
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 	query, args, err := stmt.ToSql()
 	if err != nil {
 		return 0, database.ProcessSQLErrorf(ctx, err, "failed to bind query")
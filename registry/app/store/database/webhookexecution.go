@@ -19,8 +19,8 @@ import (
 	"fmt"
 
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/store/database"
-	"github.com/harness/gitness/store/database/dbtx"
 	gitnesstypes "github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 
@@ -29,7 +29,8 @@ import (
 )
 
 type WebhookExecutionDao struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	limits util.Limits
 }
 
 const (
@@ -61,7 +62,7 @@ func (w WebhookExecutionDao) Find(ctx context.Context, id int64) (*gitnesstypes.
 	const sqlQuery = webhookExecutionSelectBase + `
 	WHERE registry_webhook_execution_id = $1`
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	dst := &webhookExecutionDB{}
 	if err := db.GetContext(ctx, dst, sqlQuery, id); err != nil {
@@ -109,7 +110,7 @@ func (w WebhookExecutionDao) Create(ctx context.Context, webhookExecution *gitne
             ,:registry_webhook_execution_response_body
 		) RETURNING registry_webhook_execution_id`
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	dbwebhookExecution := mapToWebhookExecutionDB(webhookExecution)
 
@@ -118,7 +119,7 @@ func (w WebhookExecutionDao) Create(ctx context.Context, webhookExecution *gitne
 		return database.ProcessSQLErrorf(ctx, err, "Failed to registry bind webhook object")
 	}
 
-	if err = db.QueryRowContext(ctx, query, arg...).Scan(&dbwebhookExecution.ID); err != nil {
+	if err = db.GetContext(ctx, &dbwebhookExecution.ID, query, arg...); err != nil {
 		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
 	}
 
@@ -148,7 +149,7 @@ func (w WebhookExecutionDao) ListForWebhook(
 		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	dst := []*webhookExecutionDB{}
 	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
@@ -169,7 +170,7 @@ func (w WebhookExecutionDao) CountForWebhook(ctx context.Context, webhookID int6
 		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
 	}
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	var count int64
 	if err = db.GetContext(ctx, &count, sql, args...); err != nil {
@@ -186,7 +187,7 @@ func (w WebhookExecutionDao) ListForTrigger(
 	const sqlQuery = webhookExecutionSelectBase + `
 	WHERE registry_webhook_execution_trigger_id = $1`
 
-	db := dbtx.GetAccessor(ctx, w.db)
+	db := util.GetAccessor(ctx, w.db, w.limits)
 
 	dst := []*webhookExecutionDB{}
 	if err := db.SelectContext(ctx, &dst, sqlQuery, triggerID); err != nil {
@@ -196,9 +197,38 @@ func (w WebhookExecutionDao) ListForTrigger(
 	return mapToWebhookExecutions(dst), nil
 }
 
-func NewWebhookExecutionDao(db *sqlx.DB) store.WebhooksExecutionRepository {
+func (w WebhookExecutionDao) PurgePayloads(ctx context.Context, createdBefore int64, limit int) (int64, error) {
+	const sqlQuery = `
+	UPDATE registry_webhook_executions
+	SET registry_webhook_execution_request_body = ''
+		,registry_webhook_execution_retriggerable = FALSE
+	WHERE registry_webhook_execution_id IN (
+		SELECT registry_webhook_execution_id
+		FROM registry_webhook_executions
+		WHERE registry_webhook_execution_created < $1
+			AND registry_webhook_execution_request_body <> ''
+		LIMIT $2
+	)`
+
+	db := util.GetAccessor(ctx, w.db, w.limits)
+
+	result, err := db.ExecContext(ctx, sqlQuery, createdBefore, limit)
+	if err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Failed to purge webhook execution payloads")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Failed to get number of purged webhook execution payloads")
+	}
+
+	return count, nil
+}
+
+func NewWebhookExecutionDao(db *sqlx.DB, limits util.Limits) store.WebhooksExecutionRepository {
 	return &WebhookExecutionDao{
-		db: db,
+		db:     db,
+		limits: limits,
 	}
 }
 
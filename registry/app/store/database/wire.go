@@ -17,7 +17,9 @@ package database
 import (
 	"github.com/harness/gitness/app/services/refcache"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
 
 	"github.com/google/wire"
 	"github.com/jmoiron/sqlx"
@@ -27,93 +29,116 @@ func ProvideUpstreamDao(
 	db *sqlx.DB,
 	registryDao store.RegistryRepository,
 	spaceFinder refcache.SpaceFinder,
+	limits util.Limits,
 ) store.UpstreamProxyConfigRepository {
-	return NewUpstreamproxyDao(db, registryDao, spaceFinder)
+	return NewUpstreamproxyDao(db, registryDao, spaceFinder, limits)
 }
 
-func ProvideMediaTypeDao(db *sqlx.DB) store.MediaTypesRepository {
-	return NewMediaTypesDao(db)
+func ProvideMediaTypeDao(db *sqlx.DB, limits util.Limits) store.MediaTypesRepository {
+	return NewMediaTypesDao(db, limits)
 }
 
-func ProvideBlobDao(db *sqlx.DB, mtRepository store.MediaTypesRepository) store.BlobRepository {
-	return NewBlobDao(db, mtRepository)
+func ProvideBlobDao(db *sqlx.DB, mtRepository store.MediaTypesRepository, limits util.Limits) store.BlobRepository {
+	return NewBlobDao(db, mtRepository, limits)
 }
 
-func ProvideRegistryBlobDao(db *sqlx.DB) store.RegistryBlobRepository {
-	return NewRegistryBlobDao(db)
+func ProvideRegistryBlobDao(db *sqlx.DB, limits util.Limits) store.RegistryBlobRepository {
+	return NewRegistryBlobDao(db, limits)
 }
 
-func ProvideImageDao(db *sqlx.DB) store.ImageRepository {
-	return NewImageDao(db)
+func ProvideImageDao(db *sqlx.DB, limits util.Limits) store.ImageRepository {
+	return NewImageDao(db, limits)
 }
 
-func ProvideArtifactDao(db *sqlx.DB) store.ArtifactRepository {
-	return NewArtifactDao(db)
+func ProvideArtifactDao(db *sqlx.DB, tx dbtx.Transactor, limits util.Limits) store.ArtifactRepository {
+	return NewArtifactDao(db, tx, limits)
 }
 
-func ProvideDownloadStatDao(db *sqlx.DB) store.DownloadStatRepository {
-	return NewDownloadStatDao(db)
+func ProvideDownloadStatDao(
+	db *sqlx.DB, modes store.DownloadStatModeResolver, limits util.Limits,
+) store.DownloadStatRepository {
+	return NewDownloadStatDao(db, modes, limits)
 }
 
-func ProvideBandwidthStatDao(db *sqlx.DB) store.BandwidthStatRepository {
-	return NewBandwidthStatDao(db)
+func ProvideBandwidthStatDao(db *sqlx.DB, limits util.Limits) store.BandwidthStatRepository {
+	return NewBandwidthStatDao(db, limits)
 }
 
-func ProvideTagDao(db *sqlx.DB) store.TagRepository {
-	return NewTagDao(db)
+func ProvideTagDao(db *sqlx.DB, limits util.Limits) store.TagRepository {
+	return NewTagDao(db, limits)
 }
 
-func ProvideManifestDao(sqlDB *sqlx.DB, mtRepository store.MediaTypesRepository) store.ManifestRepository {
-	return NewManifestDao(sqlDB, mtRepository)
+func ProvideManifestDao(
+	sqlDB *sqlx.DB, mtRepository store.MediaTypesRepository, limits util.Limits,
+) store.ManifestRepository {
+	return NewManifestDao(sqlDB, mtRepository, limits)
 }
 
-func ProvideWebhookDao(sqlDB *sqlx.DB) store.WebhooksRepository {
-	return NewWebhookDao(sqlDB)
+func ProvideWebhookDao(sqlDB *sqlx.DB, limits util.Limits) store.WebhooksRepository {
+	return NewWebhookDao(sqlDB, limits)
 }
 
-func ProvideWebhookExecutionDao(sqlDB *sqlx.DB) store.WebhooksExecutionRepository {
-	return NewWebhookExecutionDao(sqlDB)
+func ProvideWebhookExecutionDao(sqlDB *sqlx.DB, limits util.Limits) store.WebhooksExecutionRepository {
+	return NewWebhookExecutionDao(sqlDB, limits)
 }
 
-func ProvideManifestRefDao(db *sqlx.DB) store.ManifestReferenceRepository {
-	return NewManifestReferenceDao(db)
+func ProvideManifestRefDao(db *sqlx.DB, limits util.Limits) store.ManifestReferenceRepository {
+	return NewManifestReferenceDao(db, limits)
 }
 
-func ProvideOCIImageIndexMappingDao(db *sqlx.DB) store.OCIImageIndexMappingRepository {
-	return NewOCIImageIndexMappingDao(db)
+func ProvideOCIImageIndexMappingDao(db *sqlx.DB, limits util.Limits) store.OCIImageIndexMappingRepository {
+	return NewOCIImageIndexMappingDao(db, limits)
 }
 
-func ProvideLayerDao(db *sqlx.DB, mtRepository store.MediaTypesRepository) store.LayerRepository {
-	return NewLayersDao(db, mtRepository)
+func ProvideLayerDao(db *sqlx.DB, mtRepository store.MediaTypesRepository, limits util.Limits) store.LayerRepository {
+	return NewLayersDao(db, mtRepository, limits)
 }
 
-func ProvideCleanupPolicyDao(db *sqlx.DB, tx dbtx.Transactor) store.CleanupPolicyRepository {
-	return NewCleanupPolicyDao(db, tx)
+func ProvideCleanupPolicyDao(db *sqlx.DB, tx dbtx.Transactor, limits util.Limits) store.CleanupPolicyRepository {
+	return NewCleanupPolicyDao(db, tx, limits)
 }
 
-func ProvideNodeDao(db *sqlx.DB) store.NodesRepository {
-	return NewNodeDao(db)
+func ProvideNodeDao(db *sqlx.DB, limits util.Limits) store.NodesRepository {
+	return NewNodeDao(db, limits)
 }
 
-func ProvideQuarantineArtifactDao(db *sqlx.DB) store.QuarantineArtifactRepository {
-	return NewQuarantineArtifactDao(db)
+func ProvideQuarantineArtifactDao(db *sqlx.DB, limits util.Limits) store.QuarantineArtifactRepository {
+	return NewQuarantineArtifactDao(db, limits)
 }
 
-func ProvidePackageTagDao(db *sqlx.DB) store.PackageTagRepository {
-	return NewPackageTagDao(db)
+func ProvideQuarantineAccessAttemptDao(db *sqlx.DB, limits util.Limits) store.QuarantineAccessAttemptRepository {
+	return NewQuarantineAccessAttemptDao(db, limits)
 }
 
-func ProvideGenericBlobDao(db *sqlx.DB) store.GenericBlobRepository {
-	return NewGenericBlobDao(db)
+func ProvidePackageTagDao(db *sqlx.DB, limits util.Limits) store.PackageTagRepository {
+	return NewPackageTagDao(db, limits)
+}
+
+func ProvideGenericBlobDao(db *sqlx.DB, limits util.Limits) store.GenericBlobRepository {
+	return NewGenericBlobDao(db, limits)
+}
+
+// ProvideQueryLimits provides the limits of the statements of the registry DAOs.
+func ProvideQueryLimits(config *types.Config) util.Limits {
+	return util.Limits{
+		Reads: util.QueryLimits{
+			Timeout:       config.Registry.Database.ReadTimeout,
+			SlowThreshold: config.Registry.Database.SlowReadThreshold,
+		},
+		Writes: util.QueryLimits{
+			Timeout:       config.Registry.Database.WriteTimeout,
+			SlowThreshold: config.Registry.Database.SlowWriteThreshold,
+		},
+	}
 }
 
 func ProvideRegistryDao(
-	db *sqlx.DB, mtRepository store.MediaTypesRepository,
+	db *sqlx.DB, mtRepository store.MediaTypesRepository, limits util.Limits,
 ) store.RegistryRepository {
-	return NewRegistryDao(db, mtRepository)
+	return NewRegistryDao(db, mtRepository, limits)
 }
-func ProvideTaskRepository(db *sqlx.DB, tx dbtx.Transactor) store.TaskRepository {
-	return NewTaskStore(db, tx)
+func ProvideTaskRepository(db *sqlx.DB, tx dbtx.Transactor, limits util.Limits) store.TaskRepository {
+	return NewTaskStore(db, tx, limits)
 }
 func ProvideTaskSourceRepository(db *sqlx.DB, tx dbtx.Transactor) store.TaskSourceRepository {
 	return NewTaskSourceStore(db, tx)
@@ -121,12 +146,97 @@ func ProvideTaskSourceRepository(db *sqlx.DB, tx dbtx.Transactor) store.TaskSour
 func ProvideTaskEventRepository(db *sqlx.DB) store.TaskEventRepository {
 	return NewTaskEventStore(db)
 }
+func ProvideIndexBuildDao(db *sqlx.DB, limits util.Limits) store.IndexBuildRepository {
+	return NewIndexBuildDao(db, limits)
+}
+func ProvideArtifactMetadataHistoryDao(db *sqlx.DB, limits util.Limits) store.ArtifactMetadataHistoryRepository {
+	return NewArtifactMetadataHistoryDao(db, limits)
+}
+func ProvideGarbageDao(db *sqlx.DB, limits util.Limits) store.GarbageRepository {
+	return NewGarbageDao(db, limits)
+}
+func ProvideNotificationChannelDao(db *sqlx.DB, limits util.Limits) store.NotificationChannelRepository {
+	return NewNotificationChannelDao(db, limits)
+}
+func ProvideImageDescriptionDao(db *sqlx.DB, limits util.Limits) store.ImageDescriptionRepository {
+	return NewImageDescriptionDao(db, limits)
+}
+
+func ProvideImageStarDao(db *sqlx.DB, limits util.Limits) store.ImageStarRepository {
+	return NewImageStarDao(db, limits)
+}
+
+func ProvideRecentActivityDao(db *sqlx.DB, limits util.Limits) store.RecentActivityRepository {
+	return NewRecentActivityDao(db, limits)
+}
+
+func ProvidePackageDenylistEntryDao(db *sqlx.DB, limits util.Limits) store.PackageDenylistEntryRepository {
+	return NewPackageDenylistEntryDao(db, limits)
+}
+
+func ProvidePackageDenylistOverrideDao(db *sqlx.DB, limits util.Limits) store.PackageDenylistOverrideRepository {
+	return NewPackageDenylistOverrideDao(db, limits)
+}
+
+func ProvideFirewallApprovalDao(db *sqlx.DB, limits util.Limits) store.FirewallApprovalRepository {
+	return NewFirewallApprovalDao(db, limits)
+}
+
+func ProvideVulnerabilityDao(db *sqlx.DB, limits util.Limits) store.VulnerabilityRepository {
+	return NewVulnerabilityDao(db, limits)
+}
+
+func ProvideArtifactProvenanceDao(db *sqlx.DB, limits util.Limits) store.ArtifactProvenanceRepository {
+	return NewArtifactProvenanceDao(db, limits)
+}
+
+func ProvideUpstreamProvenanceDao(db *sqlx.DB, limits util.Limits) store.UpstreamProvenanceRepository {
+	return NewUpstreamProvenanceDao(db, limits)
+}
+
+func ProvideArtifactSearchDao(db *sqlx.DB, limits util.Limits) store.ArtifactSearchRepository {
+	return NewArtifactSearchDao(db, limits)
+}
+
+func ProvideDeletionRequestDao(db *sqlx.DB, limits util.Limits) store.DeletionRequestRepository {
+	return NewDeletionRequestDao(db, limits)
+}
+
+func ProvideScheduledDeletionDao(db *sqlx.DB, limits util.Limits) store.ScheduledDeletionRepository {
+	return NewScheduledDeletionDao(db, limits)
+}
+
+func ProvideEventOutboxDao(db *sqlx.DB, limits util.Limits) store.EventOutboxRepository {
+	return NewEventOutboxDao(db, limits)
+}
+
+func ProvideFailedUploadDao(db *sqlx.DB, limits util.Limits) store.FailedUploadRepository {
+	return NewFailedUploadDao(db, limits)
+}
+
+func ProvideUploadFailureStatsDao(db *sqlx.DB, limits util.Limits) store.UploadFailureStatsRepository {
+	return NewUploadFailureStatsDao(db, limits)
+}
+
+func ProvideRegistryJobDao(db *sqlx.DB, limits util.Limits) store.RegistryJobRepository {
+	return NewRegistryJobDao(db, limits)
+}
+
+func ProvideRegistryUsageSnapshotDao(db *sqlx.DB, limits util.Limits) store.RegistryUsageSnapshotRepository {
+	return NewRegistryUsageSnapshotDao(db, limits)
+}
+
+func ProvideRegistryStatsDao(db *sqlx.DB, limits util.Limits) store.RegistryStatsRepository {
+	return NewRegistryStatsDao(db, limits)
+}
 
 var WireSet = wire.NewSet(
+	ProvideQueryLimits,
 	ProvideUpstreamDao,
 	ProvideRegistryDao,
 	ProvideMediaTypeDao,
 	ProvideQuarantineArtifactDao,
+	ProvideQuarantineAccessAttemptDao,
 	ProvideBlobDao,
 	ProvideRegistryBlobDao,
 	ProvideTagDao,
@@ -147,4 +257,26 @@ var WireSet = wire.NewSet(
 	ProvideTaskRepository,
 	ProvideTaskSourceRepository,
 	ProvideTaskEventRepository,
+	ProvideIndexBuildDao,
+	ProvideArtifactMetadataHistoryDao,
+	ProvideGarbageDao,
+	ProvideNotificationChannelDao,
+	ProvideImageDescriptionDao,
+	ProvideImageStarDao,
+	ProvideRecentActivityDao,
+	ProvideDeletionRequestDao,
+	ProvideScheduledDeletionDao,
+	ProvidePackageDenylistEntryDao,
+	ProvidePackageDenylistOverrideDao,
+	ProvideFirewallApprovalDao,
+	ProvideVulnerabilityDao,
+	ProvideArtifactProvenanceDao,
+	ProvideUpstreamProvenanceDao,
+	ProvideArtifactSearchDao,
+	ProvideEventOutboxDao,
+	ProvideFailedUploadDao,
+	ProvideUploadFailureStatsDao,
+	ProvideRegistryJobDao,
+	ProvideRegistryUsageSnapshotDao,
+	ProvideRegistryStatsDao,
 )
@@ -0,0 +1,65 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/types"
+)
+
+// ArtifactBatchFetcher returns the batch of artifacts of a registry following artifactID, like
+// ArtifactRepository.GetAllArtifactsByRepo and ArtifactRepository.GetLatestArtifactsByRepo.
+type ArtifactBatchFetcher func(
+	ctx context.Context, registryID int64, batchSize int, artifactID int64, batchTimeout time.Duration,
+) (*[]types.ArtifactMetadata, error)
+
+// ScanArtifactBatches passes the artifacts of the registry following afterID to fn one batch at a time, each
+// batch is fetched within batchTimeout. The scan stops between batches once ctx is done. It returns the ID of
+// the last artifact handed to fn, which is where a scan that was cut short can be resumed from.
+func ScanArtifactBatches(
+	ctx context.Context,
+	fetch ArtifactBatchFetcher,
+	registryID int64,
+	batchSize int,
+	batchTimeout time.Duration,
+	afterID int64,
+	fn func(artifacts []types.ArtifactMetadata) error,
+) (int64, error) {
+	lastArtifactID := afterID
+	for {
+		if err := ctx.Err(); err != nil {
+			return lastArtifactID, fmt.Errorf("artifact scan of registry %d stopped after artifact %d: %w",
+				registryID, lastArtifactID, err)
+		}
+		artifacts, err := fetch(ctx, registryID, batchSize, lastArtifactID, batchTimeout)
+		if err != nil {
+			return lastArtifactID, err
+		}
+		if len(*artifacts) > 0 {
+			if err = fn(*artifacts); err != nil {
+				return lastArtifactID, err
+			}
+			for _, a := range *artifacts {
+				lastArtifactID = max(lastArtifactID, a.ID)
+			}
+		}
+		if len(*artifacts) < batchSize {
+			return lastArtifactID, nil
+		}
+	}
+}
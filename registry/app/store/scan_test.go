@@ -0,0 +1,59 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanArtifactBatches(t *testing.T) {
+	fetch := func(
+		_ context.Context, _ int64, batchSize int, artifactID int64, _ time.Duration,
+	) (*[]types.ArtifactMetadata, error) {
+		artifacts := []types.ArtifactMetadata{}
+		for id := artifactID + 1; id <= 5 && len(artifacts) < batchSize; id++ {
+			artifacts = append(artifacts, types.ArtifactMetadata{ID: id})
+		}
+		return &artifacts, nil
+	}
+
+	var seen []int64
+	lastID, err := ScanArtifactBatches(context.Background(), fetch, 1, 2, time.Second, 0,
+		func(artifacts []types.ArtifactMetadata) error {
+			for _, a := range artifacts {
+				seen = append(seen, a.ID)
+			}
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), lastID)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, seen)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lastID, err = ScanArtifactBatches(ctx, fetch, 1, 2, time.Second, 0,
+		func([]types.ArtifactMetadata) error {
+			cancel()
+			return nil
+		})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int64(2), lastID)
+}
@@ -34,12 +34,16 @@ import (
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/types"
 	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/rs/zerolog/log"
 )
 
 type RegistryHelper interface {
+	// UpdatePackageIndex updates the index of the package with the versions added and deleted since it was
+	// last built, it's rebuilt from all the versions when its stored state drifted from the registry.
 	UpdatePackageIndex(
 		ctx context.Context, principalID int64, rootParentID int64,
-		registryID int64, image string,
+		registryID int64, image string, deletedArtifactIDs []int64,
 	) error
 	UpdatePackageMetadata(
 		ctx context.Context, rootParentID int64,
@@ -70,28 +74,68 @@ func NewRegistryHelper(
 
 func (h *registryHelper) UpdatePackageIndex(
 	ctx context.Context, principalID int64, rootParentID int64,
-	registryID int64, image string,
+	registryID int64, image string, deletedArtifactIDs []int64,
 ) error {
 	rootSpace, err := h.spaceFinder.FindByID(ctx, rootParentID)
 	if err != nil {
 		return fmt.Errorf("failed to find root space by ID: %w", err)
 	}
-	versionList, err := h.regeneratePackageIndex(ctx, registryID, image)
+	registry, err := h.registryFinder.FindByID(ctx, registryID)
+	if err != nil {
+		return fmt.Errorf("failed to find registry by ID: %w", err)
+	}
+	state, err := h.updateIndexState(ctx, rootSpace.Identifier, registry, image, deletedArtifactIDs)
 	if err != nil {
 		return fmt.Errorf("failed to regenerate package index: %w", err)
 	}
-	return h.uploadIndexMetadata(
+	err = h.uploadIndexMetadata(
 		ctx, principalID, rootSpace.Identifier, rootParentID, registryID,
-		image, versionList,
+		image, state.versionList(),
 	)
+	if err != nil {
+		return err
+	}
+	return h.uploadIndexState(ctx, principalID, rootSpace.Identifier, registry, image, state)
+}
+
+// updateIndexState applies the added and deleted versions to the stored index state of the package. The
+// state is rebuilt from all the versions when it's missing or doesn't match the versions in the registry.
+func (h *registryHelper) updateIndexState(
+	ctx context.Context, rootIdentifier string, registry *types.Registry, image string,
+	deletedArtifactIDs []int64,
+) (*indexState, error) {
+	state, err := h.getIndexState(ctx, rootIdentifier, registry, image)
+	if err != nil {
+		log.Ctx(ctx).Info().Err(err).Msgf("no index state for package [%s], rebuilding index", image)
+		state = newIndexState()
+		return state, h.regeneratePackageIndex(ctx, registry.ID, image, state)
+	}
+
+	state.remove(deletedArtifactIDs)
+	err = h.regeneratePackageIndex(ctx, registry.ID, image, state)
+	if err != nil {
+		return nil, err
+	}
+	count, err := h.artifactDao.CountAllVersionsByRepoAndImage(ctx, registry.ParentID, registry.Name, image, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count versions: %w", err)
+	}
+	if count == int64(len(state.Versions)) {
+		return state, nil
+	}
+
+	log.Ctx(ctx).Info().Msgf("index state of package [%s] has %d versions but the registry has %d, "+
+		"rebuilding index", image, len(state.Versions), count)
+	state = newIndexState()
+	return state, h.regeneratePackageIndex(ctx, registry.ID, image, state)
 }
 
+// regeneratePackageIndex adds the versions of the artifacts above the last artifact of the state to it.
 func (h *registryHelper) regeneratePackageIndex(
-	ctx context.Context, registryID int64, image string,
-) ([]string, error) {
-	lastArtifactID := int64(0)
+	ctx context.Context, registryID int64, image string, state *indexState,
+) error {
+	lastArtifactID := state.LastArtifactID
 	artifactBatchLimit := 50
-	versionList := []string{}
 	for {
 		artifacts, err := h.artifactDao.GetArtifactsByRepoAndImageBatch(
 			ctx, registryID, image, artifactBatchLimit, lastArtifactID,
@@ -100,11 +144,11 @@ func (h *registryHelper) regeneratePackageIndex(
 			break
 		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to get artifacts: %w", err)
+			return fmt.Errorf("failed to get artifacts: %w", err)
 		}
 
 		for _, a := range *artifacts {
-			versionList = append(versionList, a.Version)
+			state.add(a.ID, a.Version)
 			if a.ID > lastArtifactID {
 				lastArtifactID = a.ID
 			}
@@ -113,7 +157,7 @@ func (h *registryHelper) regeneratePackageIndex(
 			break
 		}
 	}
-	return versionList, nil
+	return nil
 }
 
 func (h *registryHelper) uploadIndexMetadata(
@@ -0,0 +1,103 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gopackage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/harness/gitness/registry/app/pkg/gopackage/utils"
+	"github.com/harness/gitness/registry/types"
+)
+
+// indexState is stored next to the index of a package. It records the versions the index was built from, so
+// later builds can apply the added and deleted versions instead of listing all of them again.
+type indexState struct {
+	// Versions maps the IDs of the indexed artifacts to their versions.
+	Versions map[int64]string `json:"versions"`
+	// LastArtifactID is the highest indexed artifact ID, the artifacts above it were added since.
+	LastArtifactID int64 `json:"lastArtifactId"`
+}
+
+func newIndexState() *indexState {
+	return &indexState{Versions: make(map[int64]string)}
+}
+
+func getIndexStateFilePath(image string) string {
+	return utils.GetIndexFilePath(image) + ".state"
+}
+
+func (s *indexState) add(artifactID int64, version string) {
+	s.Versions[artifactID] = version
+	if artifactID > s.LastArtifactID {
+		s.LastArtifactID = artifactID
+	}
+}
+
+func (s *indexState) remove(artifactIDs []int64) {
+	for _, id := range artifactIDs {
+		delete(s.Versions, id)
+	}
+}
+
+// versionList returns the indexed versions in the order their artifacts were created.
+func (s *indexState) versionList() []string {
+	ids := make([]int64, 0, len(s.Versions))
+	for id := range s.Versions {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	versions := make([]string, 0, len(ids))
+	for _, id := range ids {
+		versions = append(versions, s.Versions[id])
+	}
+	return versions
+}
+
+func (h *registryHelper) getIndexState(
+	ctx context.Context, rootIdentifier string, registry *types.Registry, image string,
+) (*indexState, error) {
+	reader, _, _, err := h.fileManager.DownloadFileByPath(
+		ctx, getIndexStateFilePath(image), registry.ID, registry.Name, rootIdentifier, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	state := newIndexState()
+	if err := json.NewDecoder(reader).Decode(state); err != nil {
+		return nil, fmt.Errorf("failed to parse package index state: %w", err)
+	}
+	return state, nil
+}
+
+func (h *registryHelper) uploadIndexState(
+	ctx context.Context, principalID int64, rootIdentifier string, registry *types.Registry, image string,
+	state *indexState,
+) error {
+	content, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal package index state: %w", err)
+	}
+	_, err = h.fileManager.UploadFile(ctx, getIndexStateFilePath(image), registry.ID, registry.RootParentID,
+		rootIdentifier, nil, bytes.NewReader(content), principalID)
+	if err != nil {
+		return fmt.Errorf("failed to upload package index state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+
+	helmmetadata "github.com/harness/gitness/registry/app/metadata/helm"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ConfigMediaType          = "application/vnd.cncf.helm.config.v1+json"
+	ChartLayerMediaType      = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+	ProvenanceLayerMediaType = "application/vnd.cncf.helm.chart.provenance.v1.prov"
+
+	chartLockFile        = "Chart.lock"
+	requirementsLockFile = "requirements.lock"
+	maxChartLockSize     = 1 << 20
+)
+
+// ChartConfig is the content of the config blob of a Helm chart OCI manifest, which holds the
+// Chart.yaml of the chart.
+//
+//nolint:tagliatelle
+type ChartConfig struct {
+	APIVersion   string            `json:"apiVersion"`
+	Name         string            `json:"name"`
+	Version      string            `json:"version"`
+	AppVersion   string            `json:"appVersion,omitempty"`
+	Dependencies []ChartDependency `json:"dependencies,omitempty"`
+}
+
+type ChartDependency struct {
+	Name       string `json:"name" yaml:"name"`
+	Version    string `json:"version" yaml:"version"`
+	Repository string `json:"repository" yaml:"repository"`
+	Alias      string `json:"alias,omitempty" yaml:"alias,omitempty"`
+}
+
+// ChartLock is the content of Chart.lock, or requirements.lock for apiVersion v1 charts.
+type ChartLock struct {
+	Dependencies []ChartDependency `yaml:"dependencies"`
+	Digest       string            `yaml:"digest"`
+}
+
+func ParseChartConfig(content []byte) (*ChartConfig, error) {
+	config := &ChartConfig{}
+	if err := json.Unmarshal(content, config); err != nil {
+		return nil, fmt.Errorf("invalid helm chart config: %w", err)
+	}
+	if config.Name == "" || config.Version == "" {
+		return nil, fmt.Errorf("helm chart config is missing the chart name or version")
+	}
+	return config, nil
+}
+
+// ReadChartLock returns the lock file of the chart archive, or nil if the chart has no lock file.
+// Only the lock file of the chart itself is read, not the ones of vendored subcharts.
+func ReadChartLock(chart io.Reader, chartName string) (*ChartLock, error) {
+	gzipReader, err := gzip.NewReader(chart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open helm chart archive: %w", err)
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read helm chart archive: %w", err)
+		}
+		dir, name := path.Split(header.Name)
+		if dir != chartName+"/" || (name != chartLockFile && name != requirementsLockFile) {
+			continue
+		}
+		if header.Size > maxChartLockSize {
+			return nil, fmt.Errorf("%s exceeds the maximum size", header.Name)
+		}
+		content, err := io.ReadAll(io.LimitReader(tarReader, maxChartLockSize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", header.Name, err)
+		}
+		lock := &ChartLock{}
+		if err = yaml.Unmarshal(content, lock); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", header.Name, err)
+		}
+		return lock, nil
+	}
+}
+
+// GetChartMetadata merges the dependencies declared by the chart with the versions pinned in its lock file.
+func GetChartMetadata(config *ChartConfig, lock *ChartLock) helmmetadata.Metadata {
+	dependencies := make([]helmmetadata.Dependency, 0, len(config.Dependencies))
+	for _, dep := range config.Dependencies {
+		dependency := helmmetadata.Dependency{
+			Name:       dep.Name,
+			Alias:      dep.Alias,
+			Version:    dep.Version,
+			Repository: dep.Repository,
+		}
+		if lock != nil {
+			dependency.ResolvedVersion = getLockedVersion(lock, dep)
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return helmmetadata.Metadata{
+		Name:         config.Name,
+		Version:      config.Version,
+		APIVersion:   config.APIVersion,
+		AppVersion:   config.AppVersion,
+		Dependencies: dependencies,
+		Provenance: helmmetadata.Provenance{
+			Status: helmmetadata.ProvenanceStatusMissing,
+		},
+	}
+}
+
+// getLockedVersion prefers the lock entry of the same repository, as repository aliases are resolved to
+// URLs in the lock file.
+func getLockedVersion(lock *ChartLock, dep ChartDependency) string {
+	version := ""
+	for _, locked := range lock.Dependencies {
+		if locked.Name != dep.Name {
+			continue
+		}
+		if locked.Repository == dep.Repository {
+			return locked.Version
+		}
+		if version == "" {
+			version = locked.Version
+		}
+	}
+	return version
+}
@@ -0,0 +1,185 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	helmmetadata "github.com/harness/gitness/registry/app/metadata/helm"
+)
+
+func chartArchive(t *testing.T, files map[string]string) *bytes.Buffer {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		header := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+		if _, err := tarWriter.Write([]byte(content)); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		t.Fatalf("failed to close tar: %v", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("failed to close gzip: %v", err)
+	}
+	return buf
+}
+
+const chartLock = `dependencies:
+- name: postgresql
+  repository: https://charts.example.com/stable
+  version: 12.1.2
+- name: redis
+  repository: oci://registry.example.com/charts
+  version: 17.3.7
+digest: sha256:4f0e2f1c
+generated: "2024-01-01T00:00:00Z"
+`
+
+const subchartLock = `dependencies:
+- name: common
+  repository: https://charts.example.com/stable
+  version: 2.0.0
+`
+
+func TestReadChartLock(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		wantNames []string
+	}{
+		{
+			name: "Chart.lock",
+			files: map[string]string{
+				"demo/Chart.yaml":                   "apiVersion: v2\nname: demo\nversion: 0.1.0\n",
+				"demo/charts/postgresql/Chart.lock": subchartLock,
+				"demo/Chart.lock":                   chartLock,
+			},
+			wantNames: []string{"postgresql", "redis"},
+		},
+		{
+			name: "requirements.lock",
+			files: map[string]string{
+				"demo/Chart.yaml":        "apiVersion: v1\nname: demo\nversion: 0.1.0\n",
+				"demo/requirements.yaml": "dependencies: []\n",
+				"demo/requirements.lock": chartLock,
+			},
+			wantNames: []string{"postgresql", "redis"},
+		},
+		{
+			name: "no lock file",
+			files: map[string]string{
+				"demo/Chart.yaml":                   "apiVersion: v2\nname: demo\nversion: 0.1.0\n",
+				"demo/charts/postgresql/Chart.lock": subchartLock,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lock, err := ReadChartLock(chartArchive(t, tt.files), "demo")
+			if err != nil {
+				t.Fatalf("failed to read lock file: %v", err)
+			}
+			if tt.wantNames == nil {
+				if lock != nil {
+					t.Fatalf("expected no lock file, got %+v", lock)
+				}
+				return
+			}
+			if lock == nil || len(lock.Dependencies) != len(tt.wantNames) {
+				t.Fatalf("unexpected lock file %+v", lock)
+			}
+			for i, name := range tt.wantNames {
+				if lock.Dependencies[i].Name != name {
+					t.Errorf("dependency %d is %q, want %q", i, lock.Dependencies[i].Name, name)
+				}
+			}
+			if lock.Digest != "sha256:4f0e2f1c" {
+				t.Errorf("unexpected lock digest %q", lock.Digest)
+			}
+		})
+	}
+}
+
+func TestReadChartLockInvalid(t *testing.T) {
+	files := map[string]string{"demo/Chart.lock": "dependencies: {"}
+	if _, err := ReadChartLock(chartArchive(t, files), "demo"); err == nil {
+		t.Error("expected an error for an invalid lock file")
+	}
+	if _, err := ReadChartLock(bytes.NewBufferString("not a chart"), "demo"); err == nil {
+		t.Error("expected an error for an invalid chart archive")
+	}
+}
+
+func TestGetChartMetadata(t *testing.T) {
+	config, err := ParseChartConfig([]byte(`{"apiVersion":"v2","name":"demo","version":"0.1.0",
+		"dependencies":[
+			{"name":"postgresql","version":"12.x","repository":"@stable"},
+			{"name":"redis","version":"~17.3","repository":"oci://registry.example.com/charts","alias":"cache"},
+			{"name":"common","version":"2.x","repository":"https://charts.example.com/stable"}
+		]}`))
+	if err != nil {
+		t.Fatalf("failed to parse chart config: %v", err)
+	}
+	lock, err := ReadChartLock(chartArchive(t, map[string]string{"demo/Chart.lock": chartLock}), "demo")
+	if err != nil {
+		t.Fatalf("failed to read lock file: %v", err)
+	}
+
+	metadata := GetChartMetadata(config, lock)
+	if metadata.Provenance.Status != helmmetadata.ProvenanceStatusMissing {
+		t.Errorf("expected the provenance to be missing, got %q", metadata.Provenance.Status)
+	}
+	want := []helmmetadata.Dependency{
+		{Name: "postgresql", Version: "12.x", Repository: "@stable", ResolvedVersion: "12.1.2"},
+		{
+			Name: "redis", Alias: "cache", Version: "~17.3", Repository: "oci://registry.example.com/charts",
+			ResolvedVersion: "17.3.7",
+		},
+		{Name: "common", Version: "2.x", Repository: "https://charts.example.com/stable"},
+	}
+	if len(metadata.Dependencies) != len(want) {
+		t.Fatalf("unexpected dependencies %+v", metadata.Dependencies)
+	}
+	for i := range want {
+		if metadata.Dependencies[i] != want[i] {
+			t.Errorf("dependency %d is %+v, want %+v", i, metadata.Dependencies[i], want[i])
+		}
+	}
+
+	unlocked := GetChartMetadata(config, nil)
+	for _, dep := range unlocked.Dependencies {
+		if dep.ResolvedVersion != "" {
+			t.Errorf("expected no resolved version without a lock file, got %+v", dep)
+		}
+	}
+}
+
+func TestParseChartConfigInvalid(t *testing.T) {
+	for _, content := range []string{"{", `{"name":"demo"}`} {
+		if _, err := ParseChartConfig([]byte(content)); err == nil {
+			t.Errorf("expected an error for %s", content)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	helmmetadata "github.com/harness/gitness/registry/app/metadata/helm"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/clearsign"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/opencontainers/go-digest"
+	"gopkg.in/yaml.v3"
+)
+
+// provenanceFiles is the second document of the signed provenance message, following Chart.yaml.
+type provenanceFiles struct {
+	Files map[string]string `yaml:"files"`
+}
+
+// ReadTrustedKeys parses the armored public keys which are accepted for provenance signatures.
+func ReadTrustedKeys(armoredKeys []string) (openpgp.EntityList, error) {
+	var keyring openpgp.EntityList
+	for i, armoredKey := range armoredKeys {
+		entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armoredKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read trusted key %d: %w", i, err)
+		}
+		keyring = append(keyring, entities...)
+	}
+	return keyring, nil
+}
+
+// VerifyProvenance checks that the provenance file, as produced by `helm package --sign`, was created for
+// the chart archive with the given digest. The signature is verified against the keyring when one is
+// provided, otherwise the provenance is reported as unverified along with the ID of the signing key.
+func VerifyProvenance(
+	prov []byte, chartDigest digest.Digest, keyring openpgp.EntityList,
+) (helmmetadata.Provenance, error) {
+	block, _ := clearsign.Decode(prov)
+	if block == nil {
+		return helmmetadata.Provenance{}, fmt.Errorf("provenance file is not a signed message")
+	}
+
+	if err := checkProvenanceFiles(block.Plaintext, chartDigest); err != nil {
+		return helmmetadata.Provenance{}, err
+	}
+
+	if len(keyring) == 0 {
+		keyID, err := getIssuerKeyID(block)
+		if err != nil {
+			return helmmetadata.Provenance{}, err
+		}
+		return helmmetadata.Provenance{
+			Status: helmmetadata.ProvenanceStatusUnverified,
+			KeyID:  keyID,
+		}, nil
+	}
+
+	signer, err := block.VerifySignature(keyring, nil)
+	if err != nil {
+		return helmmetadata.Provenance{}, fmt.Errorf("provenance is not signed by a trusted key: %w", err)
+	}
+	provenance := helmmetadata.Provenance{
+		Status: helmmetadata.ProvenanceStatusVerified,
+		KeyID:  fmt.Sprintf("%X", signer.PrimaryKey.KeyId),
+	}
+	for name := range signer.Identities {
+		provenance.Signer = name
+		break
+	}
+	return provenance, nil
+}
+
+func checkProvenanceFiles(plaintext []byte, chartDigest digest.Digest) error {
+	// the message holds Chart.yaml and the files block as two YAML documents
+	parts := bytes.SplitN(plaintext, []byte("\n...\n"), 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("provenance file does not list the chart files")
+	}
+	files := &provenanceFiles{}
+	if err := yaml.Unmarshal(parts[1], files); err != nil {
+		return fmt.Errorf("invalid provenance files block: %w", err)
+	}
+	if len(files.Files) == 0 {
+		return fmt.Errorf("provenance file does not list the chart files")
+	}
+	for name, fileDigest := range files.Files {
+		if fileDigest != chartDigest.String() {
+			return fmt.Errorf("provenance digest %s of %s does not match the chart digest %s", fileDigest, name,
+				chartDigest)
+		}
+	}
+	return nil
+}
+
+func getIssuerKeyID(block *clearsign.Block) (string, error) {
+	p, err := packet.Read(block.ArmoredSignature.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read provenance signature: %w", err)
+	}
+	sig, ok := p.(*packet.Signature)
+	if !ok || sig.IssuerKeyId == nil {
+		return "", fmt.Errorf("provenance signature does not identify its key")
+	}
+	return fmt.Sprintf("%X", *sig.IssuerKeyId), nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	helmmetadata "github.com/harness/gitness/registry/app/metadata/helm"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/clearsign"
+	"github.com/opencontainers/go-digest"
+)
+
+func newSigner(t *testing.T, name string) *openpgp.Entity {
+	t.Helper()
+	entity, err := openpgp.NewEntity(name, "", name+"@example.com", nil)
+	if err != nil {
+		t.Fatalf("failed to create signing key: %v", err)
+	}
+	return entity
+}
+
+func armoredPublicKey(t *testing.T, entity *openpgp.Entity) string {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	w, err := armor.Encode(buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatalf("failed to armor public key: %v", err)
+	}
+	if err = entity.Serialize(w); err != nil {
+		t.Fatalf("failed to serialize public key: %v", err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("failed to armor public key: %v", err)
+	}
+	return buf.String()
+}
+
+// provenanceOf returns the provenance file `helm package --sign` writes for a chart archive.
+func provenanceOf(chartDigest digest.Digest) string {
+	return fmt.Sprintf("apiVersion: v2\nname: demo\nversion: 0.1.0\n\n...\nfiles:\n  demo-0.1.0.tgz: %s\n",
+		chartDigest)
+}
+
+func sign(t *testing.T, entity *openpgp.Entity, plaintext string) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	w, err := clearsign.Encode(buf, entity.PrivateKey, nil)
+	if err != nil {
+		t.Fatalf("failed to sign provenance: %v", err)
+	}
+	if _, err = w.Write([]byte(plaintext)); err != nil {
+		t.Fatalf("failed to sign provenance: %v", err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("failed to sign provenance: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestVerifyProvenance(t *testing.T) {
+	chartDigest := digest.FromString("chart")
+	otherDigest := digest.FromString("other chart")
+	trusted := newSigner(t, "trusted")
+	untrusted := newSigner(t, "untrusted")
+	keyring, err := ReadTrustedKeys([]string{armoredPublicKey(t, trusted)})
+	if err != nil {
+		t.Fatalf("failed to read trusted keys: %v", err)
+	}
+	signed := sign(t, trusted, provenanceOf(chartDigest))
+	trustedKeyID := fmt.Sprintf("%X", trusted.PrimaryKey.KeyId)
+
+	tests := []struct {
+		name       string
+		prov       []byte
+		keyring    openpgp.EntityList
+		wantStatus helmmetadata.ProvenanceStatus
+		wantErr    string
+	}{
+		{
+			name:       "signed by a trusted key",
+			prov:       signed,
+			keyring:    keyring,
+			wantStatus: helmmetadata.ProvenanceStatusVerified,
+		},
+		{
+			name:       "no trusted keys",
+			prov:       signed,
+			wantStatus: helmmetadata.ProvenanceStatusUnverified,
+		},
+		{
+			name:    "signed by another key",
+			prov:    sign(t, untrusted, provenanceOf(chartDigest)),
+			keyring: keyring,
+			wantErr: "not signed by a trusted key",
+		},
+		{
+			name:    "tampered after signing",
+			prov:    bytes.Replace(signed, []byte("version: 0.1.0"), []byte("version: 0.2.0"), 1),
+			keyring: keyring,
+			wantErr: "not signed by a trusted key",
+		},
+		{
+			name:    "of another chart",
+			prov:    sign(t, trusted, provenanceOf(otherDigest)),
+			keyring: keyring,
+			wantErr: "does not match the chart digest",
+		},
+		{
+			name:    "without files",
+			prov:    sign(t, trusted, "apiVersion: v2\nname: demo\nversion: 0.1.0\n"),
+			keyring: keyring,
+			wantErr: "does not list the chart files",
+		},
+		{
+			name:    "not signed",
+			prov:    []byte(provenanceOf(chartDigest)),
+			keyring: keyring,
+			wantErr: "not a signed message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provenance, err := VerifyProvenance(tt.prov, chartDigest, tt.keyring)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to verify provenance: %v", err)
+			}
+			if provenance.Status != tt.wantStatus || provenance.KeyID != trustedKeyID {
+				t.Errorf("unexpected provenance %+v", provenance)
+			}
+			if tt.wantStatus == helmmetadata.ProvenanceStatusVerified && !strings.Contains(provenance.Signer, "trusted") {
+				t.Errorf("unexpected signer %q", provenance.Signer)
+			}
+		})
+	}
+}
+
+func TestReadTrustedKeysInvalid(t *testing.T) {
+	if _, err := ReadTrustedKeys([]string{"not a key"}); err == nil {
+		t.Error("expected an error for an invalid key")
+	}
+}
@@ -0,0 +1,110 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/sassoftware/go-rpmutils"
+)
+
+const (
+	// RepoMdSignatureFile is the detached armored signature of repomd.xml, as expected by dnf/yum
+	// when repo_gpgcheck is enabled.
+	RepoMdSignatureFile = "repomd.xml.asc"
+	// RepoMdKeyFile is the armored public key which can be referenced by gpgkey in the .repo file.
+	RepoMdKeyFile = "repomd.xml.key"
+)
+
+var ErrPackageNotSigned = errors.New("package is not signed")
+
+// ReadSigningKey parses an armored private key and returns the first entity which can sign.
+func ReadSigningKey(armoredKey string) (*openpgp.Entity, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armoredKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+	for _, entity := range entities {
+		if entity.PrivateKey == nil {
+			continue
+		}
+		if entity.PrivateKey.Encrypted {
+			return nil, fmt.Errorf("signing key %X is protected by a passphrase", entity.PrimaryKey.KeyId)
+		}
+		return entity, nil
+	}
+	return nil, fmt.Errorf("no private key found in signing key")
+}
+
+// ReadTrustedKeys parses the armored public keys which are accepted for package signatures.
+func ReadTrustedKeys(armoredKeys []string) (openpgp.EntityList, error) {
+	var keyring openpgp.EntityList
+	for i, armoredKey := range armoredKeys {
+		entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armoredKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read trusted key %d: %w", i, err)
+		}
+		keyring = append(keyring, entities...)
+	}
+	return keyring, nil
+}
+
+// SignRepoMd creates the detached armored signature of repomd.xml.
+func SignRepoMd(signer *openpgp.Entity, repomd io.Reader) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := openpgp.ArmoredDetachSign(&buf, signer, repomd, nil); err != nil {
+		return nil, fmt.Errorf("failed to sign repomd.xml: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// ExportPublicKey returns the armored public key of the signer.
+func ExportPublicKey(signer *openpgp.Entity) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = signer.Serialize(w); err != nil {
+		return nil, fmt.Errorf("failed to export public key: %w", err)
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// VerifyPackageSignature checks that the package is signed and that every signature was made by
+// one of the trusted keys.
+func VerifyPackageSignature(r io.Reader, keyring openpgp.EntityList) error {
+	_, sigs, err := rpmutils.Verify(r, keyring)
+	if err != nil {
+		var keyNotFound rpmutils.KeyNotFoundError
+		if errors.As(err, &keyNotFound) {
+			return fmt.Errorf("package is signed with an untrusted key: %w", err)
+		}
+		return fmt.Errorf("invalid package signature: %w", err)
+	}
+	if len(sigs) == 0 {
+		return ErrPackageNotSigned
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpm
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSigningKey(t *testing.T) (*openpgp.Entity, string) {
+	t.Helper()
+	entity, err := openpgp.NewEntity("registry", "", "registry@example.com", nil)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.SerializePrivate(w, nil))
+	require.NoError(t, w.Close())
+	return entity, buf.String()
+}
+
+func TestSignRepoMd(t *testing.T) {
+	_, armoredKey := newTestSigningKey(t)
+	signer, err := ReadSigningKey(armoredKey)
+	require.NoError(t, err)
+
+	repomd := []byte(`<?xml version="1.0" encoding="UTF-8"?><repomd></repomd>`)
+	signature, err := SignRepoMd(signer, bytes.NewReader(repomd))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(signature), "-----BEGIN PGP SIGNATURE-----"))
+
+	publicKey, err := ExportPublicKey(signer)
+	require.NoError(t, err)
+	keyring, err := ReadTrustedKeys([]string{string(publicKey)})
+	require.NoError(t, err)
+	require.Len(t, keyring, 1)
+	require.Nil(t, keyring[0].PrivateKey, "only the public key is exported")
+
+	_, err = openpgp.CheckArmoredDetachedSignature(keyring, bytes.NewReader(repomd), bytes.NewReader(signature), nil)
+	require.NoError(t, err)
+
+	_, err = openpgp.CheckArmoredDetachedSignature(keyring, strings.NewReader("<repomd>changed</repomd>"),
+		bytes.NewReader(signature), nil)
+	require.Error(t, err, "the signature doesn't match another repomd.xml")
+}
+
+func TestReadSigningKey(t *testing.T) {
+	entity, _ := newTestSigningKey(t)
+	publicKey, err := ExportPublicKey(entity)
+	require.NoError(t, err)
+
+	_, err = ReadSigningKey(string(publicKey))
+	require.ErrorContains(t, err, "no private key")
+
+	_, err = ReadSigningKey("not a key")
+	require.Error(t, err)
+
+	encrypted, _ := newTestSigningKey(t)
+	require.NoError(t, encrypted.PrivateKey.Encrypt([]byte("passphrase")))
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, encrypted.SerializePrivateWithoutSigning(w, nil))
+	require.NoError(t, w.Close())
+	_, err = ReadSigningKey(buf.String())
+	require.ErrorContains(t, err, "passphrase")
+}
+
+func TestVerifyPackageSignatureInvalid(t *testing.T) {
+	err := VerifyPackageSignature(strings.NewReader("not an rpm"), nil)
+	require.Error(t, err)
+}
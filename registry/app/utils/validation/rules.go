@@ -0,0 +1,156 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// GetRules returns the validation rules of the registry, or nil if none are configured.
+func GetRules(registry types.Registry) *types.ValidationRulesConfig {
+	if registry.Config == nil {
+		return nil
+	}
+	return registry.Config.ValidationRules
+}
+
+// CheckRules verifies that the configured rules are well-formed.
+func CheckRules(rules *types.ValidationRulesConfig) error {
+	if rules == nil {
+		return nil
+	}
+	switch rules.VersionFormat {
+	case "", types.VersionFormatAny, types.VersionFormatSemver:
+		if rules.VersionPattern != "" {
+			return fmt.Errorf("version pattern is only supported with the %s version format", types.VersionFormatRegex)
+		}
+	case types.VersionFormatRegex:
+		if rules.VersionPattern == "" {
+			return fmt.Errorf("version pattern is required with the %s version format", types.VersionFormatRegex)
+		}
+		if _, err := regexp.Compile(rules.VersionPattern); err != nil {
+			return fmt.Errorf("invalid version pattern %q: %w", rules.VersionPattern, err)
+		}
+	default:
+		return fmt.Errorf("unsupported version format %q", rules.VersionFormat)
+	}
+	if rules.MaxFileSize < 0 {
+		return fmt.Errorf("max file size must not be negative")
+	}
+	for _, field := range rules.RequiredMetadataFields {
+		if strings.TrimSpace(field) == "" {
+			return fmt.Errorf("required metadata fields must not be empty")
+		}
+	}
+	return nil
+}
+
+// ValidateVersion checks the version against the version format of the registry. Empty versions are
+// skipped as some package types only learn the version from the uploaded file.
+func ValidateVersion(rules *types.ValidationRulesConfig, version string) error {
+	if rules == nil || version == "" {
+		return nil
+	}
+	switch rules.VersionFormat {
+	case types.VersionFormatSemver:
+		if _, err := semver.StrictNewVersion(version); err != nil {
+			return usererror.UnprocessableEntityf(
+				"Version %s is not a valid semantic version as required by the registry: %v", version, err)
+		}
+	case types.VersionFormatRegex:
+		pattern, err := regexp.Compile(rules.VersionPattern)
+		if err != nil {
+			return fmt.Errorf("invalid version pattern %q: %w", rules.VersionPattern, err)
+		}
+		if !pattern.MatchString(version) {
+			return usererror.UnprocessableEntityf(
+				"Version %s does not match the pattern %s required by the registry", version, rules.VersionPattern)
+		}
+	case "", types.VersionFormatAny:
+	}
+	return nil
+}
+
+// ValidateFileSize checks the size of an upload against the limit of the registry.
+func ValidateFileSize(rules *types.ValidationRulesConfig, size int64) error {
+	if rules == nil || rules.MaxFileSize <= 0 || size <= rules.MaxFileSize {
+		return nil
+	}
+	return usererror.UnprocessableEntityf(
+		"Upload of %d bytes exceeds the maximum file size of %d bytes allowed by the registry",
+		size, rules.MaxFileSize)
+}
+
+// ValidateMetadata checks that all required metadata fields are set on the package metadata.
+func ValidateMetadata(rules *types.ValidationRulesConfig, metadata any) error {
+	if rules == nil || len(rules.RequiredMetadataFields) == 0 {
+		return nil
+	}
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+	var fields map[string]any
+	if err = json.Unmarshal(raw, &fields); err != nil {
+		return fmt.Errorf("failed to unmarshal metadata: %w", err)
+	}
+
+	var missing []string
+	for _, field := range rules.RequiredMetadataFields {
+		if isEmpty(lookup(fields, field)) {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		return usererror.UnprocessableEntityf(
+			"Missing metadata fields required by the registry: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func lookup(fields map[string]any, path string) any {
+	var value any = fields
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func assertUnprocessable(t *testing.T, err error) {
+	t.Helper()
+	var userErr *usererror.Error
+	require.ErrorAs(t, err, &userErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, userErr.Status)
+}
+
+func TestCheckRules(t *testing.T) {
+	assert.NoError(t, CheckRules(nil))
+	assert.NoError(t, CheckRules(&types.ValidationRulesConfig{VersionFormat: types.VersionFormatSemver}))
+	assert.NoError(t, CheckRules(&types.ValidationRulesConfig{
+		VersionFormat: types.VersionFormatRegex, VersionPattern: `^\d+\.\d+$`,
+	}))
+	assert.Error(t, CheckRules(&types.ValidationRulesConfig{VersionFormat: types.VersionFormatRegex}))
+	assert.Error(t, CheckRules(&types.ValidationRulesConfig{
+		VersionFormat: types.VersionFormatRegex, VersionPattern: `(`,
+	}))
+	assert.Error(t, CheckRules(&types.ValidationRulesConfig{VersionFormat: "CALVER"}))
+	assert.Error(t, CheckRules(&types.ValidationRulesConfig{MaxFileSize: -1}))
+}
+
+func TestValidateVersion(t *testing.T) {
+	semverRules := &types.ValidationRulesConfig{VersionFormat: types.VersionFormatSemver}
+	assert.NoError(t, ValidateVersion(semverRules, "1.2.3-rc.1"))
+	assert.NoError(t, ValidateVersion(semverRules, ""))
+	assertUnprocessable(t, ValidateVersion(semverRules, "1.2"))
+
+	regexRules := &types.ValidationRulesConfig{VersionFormat: types.VersionFormatRegex, VersionPattern: `^\d+\.\d+$`}
+	assert.NoError(t, ValidateVersion(regexRules, "2024.1"))
+	assertUnprocessable(t, ValidateVersion(regexRules, "2024.1.1"))
+}
+
+func TestValidateFileSize(t *testing.T) {
+	rules := &types.ValidationRulesConfig{MaxFileSize: 10}
+	assert.NoError(t, ValidateFileSize(rules, 10))
+	assert.NoError(t, ValidateFileSize(nil, 100))
+	assertUnprocessable(t, ValidateFileSize(rules, 11))
+}
+
+func TestValidateMetadata(t *testing.T) {
+	rules := &types.ValidationRulesConfig{RequiredMetadataFields: []string{"license", "info.author"}}
+	metadata := map[string]any{"license": "MIT", "info": map[string]any{"author": "jane"}}
+	assert.NoError(t, ValidateMetadata(rules, metadata))
+
+	err := ValidateMetadata(rules, map[string]any{"license": " ", "info": map[string]any{}})
+	assertUnprocessable(t, err)
+	assert.Contains(t, err.Error(), "license, info.author")
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client provides helpers around the client generated from the registry
+// metadata API contracts (see artifact.ClientWithResponses), so automation doesn't
+// have to deal with the raw HTTP routes.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+const headerTokenPrefixBearer = "Bearer "
+
+// New returns a typed client for the registry metadata API which authenticates all requests
+// with the given token. The server is the API base URL, e.g. https://gitness.example.com/api/v1.
+func New(server string, token string, opts ...artifact.ClientOption) (*artifact.ClientWithResponses, error) {
+	if token != "" {
+		opts = append([]artifact.ClientOption{WithToken(token)}, opts...)
+	}
+	return artifact.NewClientWithResponses(server, opts...)
+}
+
+// WithToken authenticates all requests of the client with the given token,
+// which can be a personal access token or a service account token.
+func WithToken(token string) artifact.ClientOption {
+	return artifact.WithRequestEditorFn(BearerToken(token))
+}
+
+// BearerToken returns a request editor which sets the token as bearer token of the request.
+func BearerToken(token string) artifact.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", headerTokenPrefixBearer+token)
+		return nil
+	}
+}
+
+// Response is implemented by all responses of the generated client.
+type Response interface {
+	StatusCode() int
+	Status() string
+}
+
+// ResponseError is returned for responses which don't have a success status code.
+type ResponseError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *ResponseError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("registry api responded with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("registry api responded with status %d: %s", e.StatusCode, e.Message)
+}
+
+// CheckResponse returns a ResponseError if the response doesn't have a 2xx status code.
+// The body is the raw body of the response, it's used to extract the message of the error.
+func CheckResponse(rsp Response, body []byte) error {
+	code := rsp.StatusCode()
+	if code >= http.StatusOK && code < http.StatusMultipleChoices {
+		return nil
+	}
+
+	respErr := &ResponseError{StatusCode: code}
+
+	var apiErr artifact.Error
+	if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Message != "" {
+		respErr.Code = apiErr.Code
+		respErr.Message = apiErr.Message
+		return respErr
+	}
+
+	respErr.Message = strings.TrimSpace(string(body))
+	if respErr.Message == "" {
+		respErr.Message = rsp.Status()
+	}
+	return respErr
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+func TestListAllRegistries(t *testing.T) {
+	const total = 7
+	var requests int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(artifact.Error{Code: "401", Message: "unauthorized"})
+			return
+		}
+		if r.URL.Path != "/spaces/acc/registries" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		pageCount := int64((total + size - 1) / size)
+
+		registries := []artifact.RegistryMetadata{}
+		for i := page * size; i < total && i < (page+1)*size; i++ {
+			registries = append(registries, artifact.RegistryMetadata{Identifier: fmt.Sprintf("reg-%d", i)})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(artifact.ListRegistryResponse{
+			Data:   artifact.ListRegistry{Registries: registries, PageCount: &pageCount},
+			Status: artifact.StatusSUCCESS,
+		})
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	registries, err := ListAllRegistries(context.Background(), c, "acc", artifact.GetAllRegistriesParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(registries) != total {
+		t.Fatalf("expected %d registries, got %d", total, len(registries))
+	}
+	if registries[total-1].Identifier != "reg-6" {
+		t.Errorf("unexpected last registry %q", registries[total-1].Identifier)
+	}
+	if requests != 1 {
+		t.Errorf("expected a single request with the default page size, got %d", requests)
+	}
+
+	unauthenticated, err := New(srv.URL, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ListAllRegistries(context.Background(), unauthenticated, "acc", artifact.GetAllRegistriesParams{})
+	var respErr *ResponseError
+	if !errors.As(err, &respErr) {
+		t.Fatalf("expected a response error, got %v", err)
+	}
+	if respErr.StatusCode != http.StatusUnauthorized || respErr.Message != "unauthorized" {
+		t.Errorf("unexpected response error %+v", respErr)
+	}
+}
+
+func TestForEachStopsOnPageCount(t *testing.T) {
+	pageCount := int64(3)
+	var fetched []artifact.PageNumber
+
+	items, err := ListAll(context.Background(), 2,
+		func(_ context.Context, page artifact.PageNumber, _ artifact.PageSize) (Page[int], error) {
+			fetched = append(fetched, page)
+			return Page[int]{Items: []int{int(page) * 2, int(page)*2 + 1}, PageCount: &pageCount}, nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 6 || len(fetched) != 3 {
+		t.Fatalf("expected 6 items from 3 pages, got %d items from %d pages", len(items), len(fetched))
+	}
+}
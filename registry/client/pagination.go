@@ -0,0 +1,139 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+// DefaultPageSize is the page size used by the pagination helpers if none is provided.
+const DefaultPageSize = 50
+
+// Page is a single page of a list operation.
+type Page[T any] struct {
+	Items []T
+	// PageCount is the total number of pages as reported by the server, nil if unknown.
+	PageCount *int64
+}
+
+// PageFunc fetches a single page of a list operation. Pages are zero based.
+type PageFunc[T any] func(ctx context.Context, page artifact.PageNumber, size artifact.PageSize) (Page[T], error)
+
+// ForEach calls fn for every item of all pages returned by fetch, it stops at the first error.
+func ForEach[T any](ctx context.Context, size artifact.PageSize, fetch PageFunc[T], fn func(T) error) error {
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+
+	for page := artifact.PageNumber(0); ; page++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		p, err := fetch(ctx, page, size)
+		if err != nil {
+			return fmt.Errorf("failed to fetch page %d: %w", page, err)
+		}
+
+		for _, item := range p.Items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+
+		if len(p.Items) == 0 {
+			return nil
+		}
+		// without a page count, a short page is the last one.
+		if p.PageCount == nil && int64(len(p.Items)) < int64(size) {
+			return nil
+		}
+		if p.PageCount != nil && int64(page)+1 >= *p.PageCount {
+			return nil
+		}
+	}
+}
+
+// ListAll returns the items of all pages returned by fetch.
+func ListAll[T any](ctx context.Context, size artifact.PageSize, fetch PageFunc[T]) ([]T, error) {
+	var items []T
+	err := ForEach(ctx, size, fetch, func(item T) error {
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ListAllRegistries returns all registries of the space, the page and size of the params are ignored.
+func ListAllRegistries(
+	ctx context.Context,
+	c artifact.ClientWithResponsesInterface,
+	spaceRef artifact.SpaceRefPathParam,
+	params artifact.GetAllRegistriesParams,
+) ([]artifact.RegistryMetadata, error) {
+	return ListAll(ctx, DefaultPageSize,
+		func(ctx context.Context, page artifact.PageNumber, size artifact.PageSize) (Page[artifact.RegistryMetadata], error) {
+			params.Page = &page
+			params.Size = &size
+			rsp, err := c.GetAllRegistriesWithResponse(ctx, spaceRef, &params)
+			if err != nil {
+				return Page[artifact.RegistryMetadata]{}, err
+			}
+			if err = CheckResponse(rsp, rsp.Body); err != nil {
+				return Page[artifact.RegistryMetadata]{}, err
+			}
+			if rsp.JSON200 == nil {
+				return Page[artifact.RegistryMetadata]{}, nil
+			}
+			return Page[artifact.RegistryMetadata]{
+				Items:     rsp.JSON200.Data.Registries,
+				PageCount: rsp.JSON200.Data.PageCount,
+			}, nil
+		})
+}
+
+// ListAllRegistryJobs returns all jobs of the registry, the page and size of the params are ignored.
+func ListAllRegistryJobs(
+	ctx context.Context,
+	c artifact.ClientWithResponsesInterface,
+	registryRef artifact.RegistryRefPathParam,
+	params artifact.ListRegistryJobsParams,
+) ([]artifact.RegistryJob, error) {
+	return ListAll(ctx, DefaultPageSize,
+		func(ctx context.Context, page artifact.PageNumber, size artifact.PageSize) (Page[artifact.RegistryJob], error) {
+			params.Page = &page
+			params.Size = &size
+			rsp, err := c.ListRegistryJobsWithResponse(ctx, registryRef, &params)
+			if err != nil {
+				return Page[artifact.RegistryJob]{}, err
+			}
+			if err = CheckResponse(rsp, rsp.Body); err != nil {
+				return Page[artifact.RegistryJob]{}, err
+			}
+			if rsp.JSON200 == nil {
+				return Page[artifact.RegistryJob]{}, nil
+			}
+			return Page[artifact.RegistryJob]{
+				Items:     rsp.JSON200.Data.Jobs,
+				PageCount: rsp.JSON200.Data.PageCount,
+			}, nil
+		})
+}
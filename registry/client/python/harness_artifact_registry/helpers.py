@@ -0,0 +1,61 @@
+# Copyright 2023 Harness, Inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Helpers around the client generated from the registry metadata API contracts."""
+
+from harness_artifact_registry.api_client import ApiClient
+from harness_artifact_registry.configuration import Configuration
+
+DEFAULT_PAGE_SIZE = 50
+
+
+def new_client(server, token=None):
+    """Returns an API client for the given API base URL, e.g. https://gitness.example.com/api/v1.
+
+    All requests of the client are authenticated with the token if one is provided,
+    it can be a personal access token or a service account token.
+    """
+    client = ApiClient(Configuration(host=server))
+    if token:
+        client.set_default_header("Authorization", "Bearer " + token)
+    return client
+
+
+def iterate(list_fn, items_attr, page_size=DEFAULT_PAGE_SIZE, **kwargs):
+    """Yields the items of all pages of a list operation.
+
+    list_fn is a list operation of a generated API, e.g. RegistriesApi(client).get_all_registries,
+    and items_attr the name of the list in the data of its response, e.g. "registries".
+    Pages are zero based, the remaining keyword arguments are passed to every call.
+    """
+    page = 0
+    while True:
+        data = list_fn(page=page, size=page_size, **kwargs).data
+        items = getattr(data, items_attr) or []
+        yield from items
+
+        if not items:
+            return
+        page_count = getattr(data, "page_count", None)
+        # without a page count, a short page is the last one.
+        if page_count is None and len(items) < page_size:
+            return
+        if page_count is not None and page + 1 >= page_count:
+            return
+        page += 1
+
+
+def list_all(list_fn, items_attr, page_size=DEFAULT_PAGE_SIZE, **kwargs):
+    """Returns the items of all pages of a list operation, see iterate."""
+    return list(iterate(list_fn, items_attr, page_size, **kwargs))
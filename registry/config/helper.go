@@ -14,7 +14,12 @@
 
 package config
 
-import "github.com/harness/gitness/types"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/types"
+)
 
 func GetS3StorageParameters(c *types.Config) map[string]any {
 	s3Properties := make(map[string]any)
@@ -39,6 +44,29 @@ func GetS3StorageParameters(c *types.Config) map[string]any {
 	return s3Properties
 }
 
+// GetS3MirrorParameters returns the S3 storage parameters of each mirror bucket keyed by the region of the
+// mirror. Mirrors share the credentials and the settings of the main bucket.
+func GetS3MirrorParameters(c *types.Config) (map[string]map[string]any, error) {
+	mirrors := make(map[string]map[string]any)
+	for _, mirror := range strings.Split(c.Registry.Storage.S3Storage.Mirrors, ",") {
+		mirror = strings.TrimSpace(mirror)
+		if mirror == "" {
+			continue
+		}
+		region, bucket, ok := strings.Cut(mirror, "=")
+		region = strings.ToLower(strings.TrimSpace(region))
+		bucket = strings.TrimSpace(bucket)
+		if !ok || region == "" || bucket == "" {
+			return nil, fmt.Errorf("invalid mirror %q, expected region=bucket", mirror)
+		}
+		params := GetS3StorageParameters(c)
+		params["region"] = region
+		params["bucket"] = bucket
+		mirrors[region] = params
+	}
+	return mirrors, nil
+}
+
 func GetFilesystemParams(c *types.Config) map[string]any {
 	props := make(map[string]any)
 	props["maxthreads"] = c.Registry.Storage.FileSystemStorage.MaxThreads
@@ -0,0 +1,111 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/rs/zerolog/log"
+)
+
+const JobTypeDownloadStatsRollup = "registry_download_stats_rollup"
+
+// downloadStatsRollupDelay is how long after the end of a day its downloads are rolled up, so that the downloads
+// recorded right before midnight were committed.
+const downloadStatsRollupDelay = time.Hour
+
+// JobDownloadStatsRollup rolls up the downloads of the past days into the daily counters which the download counts
+// are read from, and creates the download stats partitions of the next months.
+type JobDownloadStatsRollup struct {
+	enabled         bool
+	cron            string
+	maxDur          time.Duration
+	scheduler       *job.Scheduler
+	tx              dbtx.Transactor
+	downloadStatDao store.DownloadStatRepository
+}
+
+func NewJobDownloadStatsRollup(
+	enabled bool,
+	cron string,
+	maxDur time.Duration,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	tx dbtx.Transactor,
+	downloadStatDao store.DownloadStatRepository,
+) (*JobDownloadStatsRollup, error) {
+	j := &JobDownloadStatsRollup{
+		enabled:         enabled,
+		cron:            cron,
+		maxDur:          maxDur,
+		scheduler:       scheduler,
+		tx:              tx,
+		downloadStatDao: downloadStatDao,
+	}
+	err := executor.Register(JobTypeDownloadStatsRollup, j)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *JobDownloadStatsRollup) Register(ctx context.Context) error {
+	if !j.enabled {
+		return nil
+	}
+
+	err := j.scheduler.AddRecurring(ctx, JobTypeDownloadStatsRollup, JobTypeDownloadStatsRollup, j.cron, j.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for registry download stats rollup: %w", err)
+	}
+
+	return nil
+}
+
+// Handle creates the partitions and rolls up the download stats. The roll up runs even if creating the partitions
+// failed, the errors of both steps are returned.
+func (j *JobDownloadStatsRollup) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	now := time.Now()
+	var errs []error
+
+	err := j.tx.WithTx(ctx, func(ctx context.Context) error {
+		return j.downloadStatDao.CreatePartitions(ctx, now)
+	})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to create download stats partitions: %w", err))
+	}
+
+	var counters int64
+	err = j.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		counters, err = j.downloadStatDao.RollUp(ctx, now.Add(-downloadStatsRollupDelay))
+		return err
+	})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to roll up download stats: %w", err))
+	}
+	if counters > 0 {
+		log.Ctx(ctx).Info().Msgf("rolled up downloads into %d daily counters", counters)
+	}
+
+	return "", errors.Join(errs...)
+}
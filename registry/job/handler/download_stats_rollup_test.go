@@ -0,0 +1,72 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTx struct {
+	calls int
+}
+
+func (f *fakeTx) WithTx(ctx context.Context, txFn func(ctx context.Context) error, _ ...any) error {
+	f.calls++
+	return txFn(ctx)
+}
+
+func newTestJobDownloadStatsRollup(t *testing.T) (*JobDownloadStatsRollup, *mocks.MockDownloadStatRepository, *fakeTx) {
+	dao := mocks.NewMockDownloadStatRepository(t)
+	tx := &fakeTx{}
+	return &JobDownloadStatsRollup{tx: tx, downloadStatDao: dao}, dao, tx
+}
+
+func TestJobDownloadStatsRollup(t *testing.T) {
+	j, dao, tx := newTestJobDownloadStatsRollup(t)
+	started := time.Now()
+
+	dao.EXPECT().CreatePartitions(mock.Anything, mock.Anything).Return(nil).Once()
+	dao.EXPECT().RollUp(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, until time.Time) (int64, error) {
+			require.WithinDuration(t, started.Add(-downloadStatsRollupDelay), until, time.Minute)
+			return 3, nil
+		}).Once()
+
+	_, err := j.Handle(context.Background(), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, tx.calls, "each step runs in its own transaction")
+}
+
+func TestJobDownloadStatsRollupErrors(t *testing.T) {
+	j, dao, _ := newTestJobDownloadStatsRollup(t)
+	errPartitions := errors.New("partitions failed")
+	errRollUp := errors.New("roll up failed")
+
+	// the roll up runs even though creating the partitions failed.
+	dao.EXPECT().CreatePartitions(mock.Anything, mock.Anything).Return(errPartitions).Once()
+	dao.EXPECT().RollUp(mock.Anything, mock.Anything).Return(0, errRollUp).Once()
+
+	_, err := j.Handle(context.Background(), "", nil)
+	require.ErrorIs(t, err, errPartitions)
+	require.ErrorIs(t, err, errRollUp)
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/services/outbox"
+
+	"github.com/rs/zerolog/log"
+)
+
+const JobTypeEventOutbox = "registry_event_outbox"
+
+// JobEventOutbox publishes the outbox events which weren't published right after their transaction committed,
+// e.g. because the instance crashed or the event stream wasn't reachable.
+type JobEventOutbox struct {
+	enabled   bool
+	cron      string
+	maxDur    time.Duration
+	batchSize int
+	scheduler *job.Scheduler
+	outbox    *outbox.Outbox
+}
+
+func NewJobEventOutbox(
+	enabled bool,
+	cron string,
+	maxDur time.Duration,
+	batchSize int,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	outbox *outbox.Outbox,
+) (*JobEventOutbox, error) {
+	j := &JobEventOutbox{
+		enabled:   enabled,
+		cron:      cron,
+		maxDur:    maxDur,
+		batchSize: batchSize,
+		scheduler: scheduler,
+		outbox:    outbox,
+	}
+	err := executor.Register(JobTypeEventOutbox, j)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *JobEventOutbox) Register(ctx context.Context) error {
+	if !j.enabled {
+		return nil
+	}
+
+	err := j.scheduler.AddRecurring(ctx, JobTypeEventOutbox, JobTypeEventOutbox, j.cron, j.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for registry event outbox: %w", err)
+	}
+
+	return nil
+}
+
+func (j *JobEventOutbox) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	published, err := j.outbox.PublishDue(ctx, j.batchSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to publish registry outbox events: %w", err)
+	}
+	if published > 0 {
+		log.Ctx(ctx).Info().Msgf("published %d registry outbox events", published)
+	}
+	return "", nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/pkg/failedupload"
+
+	"github.com/rs/zerolog/log"
+)
+
+const JobTypeFailedUploadsPurge = "registry_failed_uploads_purge"
+
+// JobFailedUploadsPurge deletes the failed uploads which expired along with their content, and the upload
+// failure stats which are older than their retention.
+type JobFailedUploadsPurge struct {
+	enabled   bool
+	cron      string
+	maxDur    time.Duration
+	batchSize int
+	scheduler *job.Scheduler
+	recorder  *failedupload.Recorder
+}
+
+func NewJobFailedUploadsPurge(
+	enabled bool,
+	cron string,
+	maxDur time.Duration,
+	batchSize int,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	recorder *failedupload.Recorder,
+) (*JobFailedUploadsPurge, error) {
+	j := &JobFailedUploadsPurge{
+		enabled:   enabled,
+		cron:      cron,
+		maxDur:    maxDur,
+		batchSize: batchSize,
+		scheduler: scheduler,
+		recorder:  recorder,
+	}
+	err := executor.Register(JobTypeFailedUploadsPurge, j)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *JobFailedUploadsPurge) Register(ctx context.Context) error {
+	if !j.enabled {
+		return nil
+	}
+
+	err := j.scheduler.AddRecurring(ctx, JobTypeFailedUploadsPurge, JobTypeFailedUploadsPurge, j.cron, j.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for registry failed uploads purge: %w", err)
+	}
+
+	return nil
+}
+
+func (j *JobFailedUploadsPurge) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	total := 0
+	for {
+		purged, err := j.recorder.PurgeExpired(ctx, time.Now(), j.batchSize)
+		total += purged
+		if err != nil {
+			return "", fmt.Errorf("failed to purge expired failed uploads: %w", err)
+		}
+		if purged < j.batchSize {
+			break
+		}
+	}
+	if total > 0 {
+		log.Ctx(ctx).Info().Msgf("purged %d expired failed uploads", total)
+	}
+
+	deleted, err := j.recorder.PurgeStats(ctx, time.Now())
+	if err != nil {
+		return "", fmt.Errorf("failed to purge upload failure stats: %w", err)
+	}
+	if deleted > 0 {
+		log.Ctx(ctx).Info().Msgf("purged %d daily upload failure stats", deleted)
+	}
+	return "", nil
+}
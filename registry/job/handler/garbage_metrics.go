@@ -0,0 +1,130 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/store"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+)
+
+const JobTypeGarbageMetrics = "registry_garbage_metrics"
+
+var (
+	garbageRows = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "registry",
+		Subsystem: "garbage",
+		Name:      "rows",
+		Help:      "Number of soft-deleted rows waiting to be purged.",
+	}, []string{"account", "kind", "age"})
+
+	garbageBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "registry",
+		Subsystem: "garbage",
+		Name:      "bytes",
+		Help:      "Storage of the soft-deleted manifests waiting to be purged.",
+	}, []string{"account", "kind", "age"})
+)
+
+// JobGarbageMetrics reports the soft-deleted tags and manifests of every account, grouped by how long ago
+// they were deleted.
+type JobGarbageMetrics struct {
+	enabled     bool
+	cron        string
+	maxDur      time.Duration
+	scheduler   *job.Scheduler
+	garbageDao  store.GarbageRepository
+	spaceFinder refcache.SpaceFinder
+}
+
+func NewJobGarbageMetrics(
+	enabled bool,
+	cron string,
+	maxDur time.Duration,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	garbageDao store.GarbageRepository,
+	spaceFinder refcache.SpaceFinder,
+) (*JobGarbageMetrics, error) {
+	j := &JobGarbageMetrics{
+		enabled:     enabled,
+		cron:        cron,
+		maxDur:      maxDur,
+		scheduler:   scheduler,
+		garbageDao:  garbageDao,
+		spaceFinder: spaceFinder,
+	}
+	err := executor.Register(JobTypeGarbageMetrics, j)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *JobGarbageMetrics) Register(ctx context.Context) error {
+	if !j.enabled {
+		return nil
+	}
+
+	err := j.scheduler.AddRecurring(ctx, JobTypeGarbageMetrics, JobTypeGarbageMetrics, j.cron, j.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for registry garbage metrics: %w", err)
+	}
+
+	return nil
+}
+
+func (j *JobGarbageMetrics) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	stats, err := j.garbageDao.GetStats(ctx, 0, time.Now())
+	if err != nil {
+		return "", fmt.Errorf("failed to get registry garbage stats: %w", err)
+	}
+
+	// accounts whose garbage got purged must not keep reporting their last values.
+	garbageRows.Reset()
+	garbageBytes.Reset()
+
+	accounts := map[int64]string{}
+	for _, s := range stats {
+		account, ok := accounts[s.RootParentID]
+		if !ok {
+			account = j.accountName(ctx, s.RootParentID)
+			accounts[s.RootParentID] = account
+		}
+		garbageRows.WithLabelValues(account, string(s.Kind), string(s.Age)).Set(float64(s.Count))
+		garbageBytes.WithLabelValues(account, string(s.Kind), string(s.Age)).Set(float64(s.Size))
+	}
+
+	return "", nil
+}
+
+// accountName returns the identifier of the root space, or its id when the space can't be found.
+func (j *JobGarbageMetrics) accountName(ctx context.Context, rootParentID int64) string {
+	space, err := j.spaceFinder.FindByID(ctx, rootParentID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to find root space %d for registry garbage metrics", rootParentID)
+		return strconv.FormatInt(rootParentID, 10)
+	}
+	return space.Identifier
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/services/deletion"
+
+	"github.com/rs/zerolog/log"
+)
+
+const JobTypeScheduledDeletions = "registry_scheduled_deletions"
+
+// JobScheduledDeletions executes the scheduled deletions of artifacts once their delay passed.
+type JobScheduledDeletions struct {
+	enabled         bool
+	cron            string
+	maxDur          time.Duration
+	batchSize       int
+	scheduler       *job.Scheduler
+	deletionService *deletion.Service
+}
+
+func NewJobScheduledDeletions(
+	enabled bool,
+	cron string,
+	maxDur time.Duration,
+	batchSize int,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	deletionService *deletion.Service,
+) (*JobScheduledDeletions, error) {
+	j := &JobScheduledDeletions{
+		enabled:         enabled,
+		cron:            cron,
+		maxDur:          maxDur,
+		batchSize:       batchSize,
+		scheduler:       scheduler,
+		deletionService: deletionService,
+	}
+	err := executor.Register(JobTypeScheduledDeletions, j)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *JobScheduledDeletions) Register(ctx context.Context) error {
+	if !j.enabled {
+		return nil
+	}
+
+	err := j.scheduler.AddRecurring(ctx, JobTypeScheduledDeletions, JobTypeScheduledDeletions, j.cron, j.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for registry scheduled deletions: %w", err)
+	}
+
+	return nil
+}
+
+func (j *JobScheduledDeletions) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	var total int
+	for {
+		executed, err := j.deletionService.ExecuteDue(ctx, j.batchSize)
+		total += executed
+		if err != nil {
+			return "", fmt.Errorf("failed to execute scheduled deletions: %w", err)
+		}
+		if executed < j.batchSize {
+			break
+		}
+	}
+	if total > 0 {
+		log.Ctx(ctx).Info().Msgf("executed %d scheduled deletions", total)
+	}
+	return "", nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+const JobTypeSearchIndex = "registry_search_index"
+
+// JobSearchIndex builds the search documents of the artifacts which have none, like the ones stored before search
+// was added. The documents of new or updated artifacts are built as they're written.
+type JobSearchIndex struct {
+	enabled    bool
+	cron       string
+	maxDur     time.Duration
+	batchSize  int
+	scheduler  *job.Scheduler
+	searchRepo store.ArtifactSearchRepository
+}
+
+func NewJobSearchIndex(
+	enabled bool,
+	cron string,
+	maxDur time.Duration,
+	batchSize int,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	searchRepo store.ArtifactSearchRepository,
+) (*JobSearchIndex, error) {
+	j := &JobSearchIndex{
+		enabled:    enabled,
+		cron:       cron,
+		maxDur:     maxDur,
+		batchSize:  batchSize,
+		scheduler:  scheduler,
+		searchRepo: searchRepo,
+	}
+	err := executor.Register(JobTypeSearchIndex, j)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *JobSearchIndex) Register(ctx context.Context) error {
+	if !j.enabled {
+		return nil
+	}
+
+	err := j.scheduler.AddRecurring(ctx, JobTypeSearchIndex, JobTypeSearchIndex, j.cron, j.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for registry search index: %w", err)
+	}
+
+	return nil
+}
+
+func (j *JobSearchIndex) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	var total int
+	for {
+		indexed, err := j.searchRepo.IndexMissing(ctx, j.batchSize)
+		total += indexed
+		if err != nil {
+			return "", fmt.Errorf("failed to index artifacts for search after %d artifacts: %w", total, err)
+		}
+		if indexed < j.batchSize || ctx.Err() != nil {
+			break
+		}
+	}
+	if total > 0 {
+		log.Ctx(ctx).Info().Msgf("indexed %d artifacts for search", total)
+	}
+	return "", nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/services/registrystats"
+
+	"github.com/rs/zerolog/log"
+)
+
+const JobTypeStatsRefresh = "registry_stats_refresh"
+
+// JobStatsRefresh refreshes the statistics of all registries read by the dashboards.
+type JobStatsRefresh struct {
+	enabled      bool
+	cron         string
+	maxDur       time.Duration
+	scheduler    *job.Scheduler
+	statsService *registrystats.Service
+}
+
+func NewJobStatsRefresh(
+	enabled bool,
+	cron string,
+	maxDur time.Duration,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	statsService *registrystats.Service,
+) (*JobStatsRefresh, error) {
+	j := &JobStatsRefresh{
+		enabled:      enabled,
+		cron:         cron,
+		maxDur:       maxDur,
+		scheduler:    scheduler,
+		statsService: statsService,
+	}
+	err := executor.Register(JobTypeStatsRefresh, j)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *JobStatsRefresh) Register(ctx context.Context) error {
+	if !j.enabled {
+		return nil
+	}
+
+	err := j.scheduler.AddRecurring(ctx, JobTypeStatsRefresh, JobTypeStatsRefresh, j.cron, j.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for registry stats refresh: %w", err)
+	}
+
+	return nil
+}
+
+func (j *JobStatsRefresh) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	count, err := j.statsService.RefreshAll(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh registry stats: %w", err)
+	}
+	log.Ctx(ctx).Info().Msgf("refreshed stats of %d registries", count)
+	return "", nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/services/trash"
+
+	"github.com/rs/zerolog/log"
+)
+
+const JobTypeTrashPurge = "registry_trash_purge"
+
+// JobTrashPurge purges the soft-deleted OCI tags and manifests which were kept in the trash for the retention.
+type JobTrashPurge struct {
+	enabled      bool
+	cron         string
+	maxDur       time.Duration
+	batchSize    int
+	scheduler    *job.Scheduler
+	trashService *trash.Service
+}
+
+func NewJobTrashPurge(
+	enabled bool,
+	cron string,
+	maxDur time.Duration,
+	batchSize int,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	trashService *trash.Service,
+) (*JobTrashPurge, error) {
+	j := &JobTrashPurge{
+		enabled:      enabled,
+		cron:         cron,
+		maxDur:       maxDur,
+		batchSize:    batchSize,
+		scheduler:    scheduler,
+		trashService: trashService,
+	}
+	err := executor.Register(JobTypeTrashPurge, j)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *JobTrashPurge) Register(ctx context.Context) error {
+	if !j.enabled {
+		return nil
+	}
+
+	err := j.scheduler.AddRecurring(ctx, JobTypeTrashPurge, JobTypeTrashPurge, j.cron, j.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for registry trash purge: %w", err)
+	}
+
+	return nil
+}
+
+func (j *JobTrashPurge) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	total := 0
+	for {
+		purged, err := j.trashService.PurgeExpired(ctx, time.Now(), j.batchSize)
+		total += purged
+		if err != nil {
+			return "", fmt.Errorf("failed to purge the expired trash: %w", err)
+		}
+		if purged < j.batchSize {
+			break
+		}
+	}
+	if total > 0 {
+		log.Ctx(ctx).Info().Msgf("purged %d expired tags and manifests from the trash", total)
+	}
+	return "", nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/services/registryusage"
+
+	"github.com/rs/zerolog/log"
+)
+
+const JobTypeUsageSnapshot = "registry_usage_snapshot"
+
+// JobUsageSnapshot persists the registry usage of every space as the usage of the current day.
+type JobUsageSnapshot struct {
+	enabled      bool
+	cron         string
+	maxDur       time.Duration
+	scheduler    *job.Scheduler
+	usageService *registryusage.Service
+}
+
+func NewJobUsageSnapshot(
+	enabled bool,
+	cron string,
+	maxDur time.Duration,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	usageService *registryusage.Service,
+) (*JobUsageSnapshot, error) {
+	j := &JobUsageSnapshot{
+		enabled:      enabled,
+		cron:         cron,
+		maxDur:       maxDur,
+		scheduler:    scheduler,
+		usageService: usageService,
+	}
+	err := executor.Register(JobTypeUsageSnapshot, j)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *JobUsageSnapshot) Register(ctx context.Context) error {
+	if !j.enabled {
+		return nil
+	}
+
+	err := j.scheduler.AddRecurring(ctx, JobTypeUsageSnapshot, JobTypeUsageSnapshot, j.cron, j.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for registry usage snapshots: %w", err)
+	}
+
+	return nil
+}
+
+func (j *JobUsageSnapshot) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	count, err := j.usageService.Snapshot(ctx, time.Now())
+	if err != nil {
+		return "", fmt.Errorf("failed to snapshot registry usage: %w", err)
+	}
+	log.Ctx(ctx).Info().Msgf("stored registry usage snapshots of %d spaces", count)
+	return "", nil
+}
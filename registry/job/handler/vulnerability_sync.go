@@ -0,0 +1,88 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/bootstrap"
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/services/vulnerability"
+
+	"github.com/rs/zerolog/log"
+)
+
+const JobTypeVulnerabilitySync = "registry_vulnerability_sync"
+
+// JobVulnerabilitySync matches the stored versions of packages with the advisories of OSV.
+type JobVulnerabilitySync struct {
+	enabled              bool
+	cron                 string
+	maxDur               time.Duration
+	batchSize            int
+	scheduler            *job.Scheduler
+	vulnerabilityService *vulnerability.Service
+}
+
+func NewJobVulnerabilitySync(
+	enabled bool,
+	cron string,
+	maxDur time.Duration,
+	batchSize int,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	vulnerabilityService *vulnerability.Service,
+) (*JobVulnerabilitySync, error) {
+	j := &JobVulnerabilitySync{
+		enabled:              enabled,
+		cron:                 cron,
+		maxDur:               maxDur,
+		batchSize:            batchSize,
+		scheduler:            scheduler,
+		vulnerabilityService: vulnerabilityService,
+	}
+	err := executor.Register(JobTypeVulnerabilitySync, j)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *JobVulnerabilitySync) Register(ctx context.Context) error {
+	if !j.enabled {
+		return nil
+	}
+
+	err := j.scheduler.AddRecurring(ctx, JobTypeVulnerabilitySync, JobTypeVulnerabilitySync, j.cron, j.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for registry vulnerability sync: %w", err)
+	}
+
+	return nil
+}
+
+func (j *JobVulnerabilitySync) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	ctx = request.WithAuthSession(ctx, bootstrap.NewSystemServiceSession())
+	result, err := j.vulnerabilityService.Sync(ctx, j.batchSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to sync vulnerabilities after checking %d versions: %w", result.Checked, err)
+	}
+	log.Ctx(ctx).Info().Msgf("checked %d versions for vulnerabilities, %d newly vulnerable, %d quarantined",
+		result.Checked, result.Matched, result.Quarantined)
+	return "", nil
+}
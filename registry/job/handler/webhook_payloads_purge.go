@@ -0,0 +1,100 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+const JobTypeWebhookPayloadsPurge = "registry_webhook_payloads_purge"
+
+// JobWebhookPayloadsPurge drops the request bodies of the webhook executions which are older than their retention,
+// the executions can't be replayed afterward.
+type JobWebhookPayloadsPurge struct {
+	enabled               bool
+	cron                  string
+	maxDur                time.Duration
+	batchSize             int
+	retention             time.Duration
+	scheduler             *job.Scheduler
+	webhookExecutionStore store.WebhooksExecutionRepository
+}
+
+func NewJobWebhookPayloadsPurge(
+	enabled bool,
+	cron string,
+	maxDur time.Duration,
+	batchSize int,
+	retention time.Duration,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	webhookExecutionStore store.WebhooksExecutionRepository,
+) (*JobWebhookPayloadsPurge, error) {
+	j := &JobWebhookPayloadsPurge{
+		enabled:               enabled,
+		cron:                  cron,
+		maxDur:                maxDur,
+		batchSize:             batchSize,
+		retention:             retention,
+		scheduler:             scheduler,
+		webhookExecutionStore: webhookExecutionStore,
+	}
+	err := executor.Register(JobTypeWebhookPayloadsPurge, j)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *JobWebhookPayloadsPurge) Register(ctx context.Context) error {
+	// payloads are kept forever without a retention.
+	if !j.enabled || j.retention <= 0 {
+		return nil
+	}
+
+	err := j.scheduler.AddRecurring(ctx, JobTypeWebhookPayloadsPurge, JobTypeWebhookPayloadsPurge, j.cron, j.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for registry webhook payloads purge: %w", err)
+	}
+
+	return nil
+}
+
+func (j *JobWebhookPayloadsPurge) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	createdBefore := time.Now().Add(-j.retention).UnixMilli()
+
+	var total int64
+	for {
+		purged, err := j.webhookExecutionStore.PurgePayloads(ctx, createdBefore, j.batchSize)
+		total += purged
+		if err != nil {
+			return "", fmt.Errorf("failed to purge webhook execution payloads: %w", err)
+		}
+		if purged < int64(j.batchSize) {
+			break
+		}
+	}
+	if total > 0 {
+		log.Ctx(ctx).Info().Msgf("purged the payloads of %d webhook executions", total)
+	}
+	return "", nil
+}
@@ -15,9 +15,20 @@
 package job
 
 import (
+	"github.com/harness/gitness/app/services/refcache"
 	"github.com/harness/gitness/job"
 	registrypostprocessingevents "github.com/harness/gitness/registry/app/events/asyncprocessing"
+	"github.com/harness/gitness/registry/app/pkg/failedupload"
+	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/job/handler"
+	"github.com/harness/gitness/registry/services/deletion"
+	"github.com/harness/gitness/registry/services/outbox"
+	"github.com/harness/gitness/registry/services/registrystats"
+	"github.com/harness/gitness/registry/services/registryusage"
+	"github.com/harness/gitness/registry/services/trash"
+	"github.com/harness/gitness/registry/services/vulnerability"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
 
 	"github.com/google/wire"
 )
@@ -25,6 +36,17 @@ import (
 // WireSet provides a wire set for this package.
 var WireSet = wire.NewSet(
 	ProvideJobRpmRegistryIndex,
+	ProvideJobTrashPurge,
+	ProvideJobGarbageMetrics,
+	ProvideJobEventOutbox,
+	ProvideJobFailedUploadsPurge,
+	ProvideJobUsageSnapshot,
+	ProvideJobStatsRefresh,
+	ProvideJobWebhookPayloadsPurge,
+	ProvideJobScheduledDeletions,
+	ProvideJobVulnerabilitySync,
+	ProvideJobSearchIndex,
+	ProvideJobDownloadStatsRollup,
 )
 
 func ProvideJobRpmRegistryIndex(
@@ -33,3 +55,191 @@ func ProvideJobRpmRegistryIndex(
 ) (*handler.JobRpmRegistryIndex, error) {
 	return handler.NewJobRpmRegistryIndex(postProcessingReporter, executor)
 }
+
+func ProvideJobTrashPurge(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	trashService *trash.Service,
+) (*handler.JobTrashPurge, error) {
+	return handler.NewJobTrashPurge(
+		config.Registry.Trash.Enabled,
+		config.Registry.Trash.CRON,
+		config.Registry.Trash.MaxDuration,
+		config.Registry.Trash.BatchSize,
+		scheduler,
+		executor,
+		trashService,
+	)
+}
+
+func ProvideJobGarbageMetrics(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	garbageDao store.GarbageRepository,
+	spaceFinder refcache.SpaceFinder,
+) (*handler.JobGarbageMetrics, error) {
+	return handler.NewJobGarbageMetrics(
+		config.Registry.GarbageMetrics.Enabled,
+		config.Registry.GarbageMetrics.CRON,
+		config.Registry.GarbageMetrics.MaxDuration,
+		scheduler,
+		executor,
+		garbageDao,
+		spaceFinder,
+	)
+}
+
+func ProvideJobEventOutbox(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	outbox *outbox.Outbox,
+) (*handler.JobEventOutbox, error) {
+	return handler.NewJobEventOutbox(
+		config.Registry.EventOutbox.Enabled,
+		config.Registry.EventOutbox.CRON,
+		config.Registry.EventOutbox.MaxDuration,
+		config.Registry.EventOutbox.BatchSize,
+		scheduler,
+		executor,
+		outbox,
+	)
+}
+
+func ProvideJobFailedUploadsPurge(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	recorder *failedupload.Recorder,
+) (*handler.JobFailedUploadsPurge, error) {
+	return handler.NewJobFailedUploadsPurge(
+		config.Registry.FailedUploads.Enabled,
+		config.Registry.FailedUploads.CRON,
+		config.Registry.FailedUploads.MaxDuration,
+		config.Registry.FailedUploads.BatchSize,
+		scheduler,
+		executor,
+		recorder,
+	)
+}
+
+func ProvideJobUsageSnapshot(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	usageService *registryusage.Service,
+) (*handler.JobUsageSnapshot, error) {
+	return handler.NewJobUsageSnapshot(
+		config.Registry.UsageSnapshot.Enabled,
+		config.Registry.UsageSnapshot.CRON,
+		config.Registry.UsageSnapshot.MaxDuration,
+		scheduler,
+		executor,
+		usageService,
+	)
+}
+
+func ProvideJobStatsRefresh(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	statsService *registrystats.Service,
+) (*handler.JobStatsRefresh, error) {
+	return handler.NewJobStatsRefresh(
+		config.Registry.Stats.Enabled,
+		config.Registry.Stats.CRON,
+		config.Registry.Stats.MaxDuration,
+		scheduler,
+		executor,
+		statsService,
+	)
+}
+
+func ProvideJobWebhookPayloadsPurge(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	webhookExecutionStore store.WebhooksExecutionRepository,
+) (*handler.JobWebhookPayloadsPurge, error) {
+	return handler.NewJobWebhookPayloadsPurge(
+		config.Registry.WebhookPayloads.Enabled,
+		config.Registry.WebhookPayloads.CRON,
+		config.Registry.WebhookPayloads.MaxDuration,
+		config.Registry.WebhookPayloads.BatchSize,
+		config.Registry.WebhookPayloads.Retention,
+		scheduler,
+		executor,
+		webhookExecutionStore,
+	)
+}
+
+func ProvideJobScheduledDeletions(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	deletionService *deletion.Service,
+) (*handler.JobScheduledDeletions, error) {
+	return handler.NewJobScheduledDeletions(
+		config.Registry.ScheduledDeletions.Enabled,
+		config.Registry.ScheduledDeletions.CRON,
+		config.Registry.ScheduledDeletions.MaxDuration,
+		config.Registry.ScheduledDeletions.BatchSize,
+		scheduler,
+		executor,
+		deletionService,
+	)
+}
+
+func ProvideJobVulnerabilitySync(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	vulnerabilityService *vulnerability.Service,
+) (*handler.JobVulnerabilitySync, error) {
+	return handler.NewJobVulnerabilitySync(
+		config.Registry.VulnerabilitySync.Enabled,
+		config.Registry.VulnerabilitySync.CRON,
+		config.Registry.VulnerabilitySync.MaxDuration,
+		config.Registry.VulnerabilitySync.BatchSize,
+		scheduler,
+		executor,
+		vulnerabilityService,
+	)
+}
+
+func ProvideJobSearchIndex(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	searchRepo store.ArtifactSearchRepository,
+) (*handler.JobSearchIndex, error) {
+	return handler.NewJobSearchIndex(
+		config.Registry.SearchIndex.Enabled,
+		config.Registry.SearchIndex.CRON,
+		config.Registry.SearchIndex.MaxDuration,
+		config.Registry.SearchIndex.BatchSize,
+		scheduler,
+		executor,
+		searchRepo,
+	)
+}
+
+func ProvideJobDownloadStatsRollup(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	tx dbtx.Transactor,
+	downloadStatDao store.DownloadStatRepository,
+) (*handler.JobDownloadStatsRollup, error) {
+	return handler.NewJobDownloadStatsRollup(
+		config.Registry.DownloadStatsRollup.Enabled,
+		config.Registry.DownloadStatsRollup.CRON,
+		config.Registry.DownloadStatsRollup.MaxDuration,
+		scheduler,
+		executor,
+		tx,
+		downloadStatDao,
+	)
+}
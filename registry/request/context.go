@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/types"
 
 	"github.com/rs/zerolog/log"
 )
@@ -27,6 +28,8 @@ type contextKey string
 const OriginalPathKey contextKey = "originalPath"
 const OriginalURLKey contextKey = "originalURL"
 const ArtifactInfoKey contextKey = "artifactInfo"
+const RevalidateKey contextKey = "revalidate"
+const PipelineExecutionKey contextKey = "pipelineExecution"
 
 // Functions for original PATH.
 func OriginalPathFrom(ctx context.Context) string {
@@ -54,6 +57,26 @@ func WithOriginalURL(parent context.Context, originalURL string) context.Context
 	return context.WithValue(parent, OriginalURLKey, originalURL)
 }
 
+// RevalidateFrom tells whether the client asked to bypass the cached upstream misses.
+func RevalidateFrom(ctx context.Context) bool {
+	revalidate, _ := ctx.Value(RevalidateKey).(bool)
+	return revalidate
+}
+
+func WithRevalidate(parent context.Context, revalidate bool) context.Context {
+	return context.WithValue(parent, RevalidateKey, revalidate)
+}
+
+// PipelineExecutionFrom returns the pipeline execution the client said the upload is made by, or nil.
+func PipelineExecutionFrom(ctx context.Context) *types.PipelineExecution {
+	pipeline, _ := ctx.Value(PipelineExecutionKey).(*types.PipelineExecution)
+	return pipeline
+}
+
+func WithPipelineExecution(parent context.Context, pipeline *types.PipelineExecution) context.Context {
+	return context.WithValue(parent, PipelineExecutionKey, pipeline)
+}
+
 func ArtifactInfoFrom(ctx context.Context) pkg.PackageArtifactInfo {
 	baseInfo, ok := ctx.Value(ArtifactInfoKey).(pkg.PackageArtifactInfo)
 	if !ok {
@@ -35,10 +35,12 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	"github.com/harness/gitness/registry/app/pkg/rpm"
 	"github.com/harness/gitness/registry/app/store"
+	rpmutil "github.com/harness/gitness/registry/app/utils/rpm"
 	rpmtypes "github.com/harness/gitness/registry/app/utils/rpm/types"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/secret"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/klauspost/compress/zstd"
 	"github.com/rs/zerolog/log"
 	"github.com/ulikunitz/xz"
@@ -51,14 +53,18 @@ const (
 	OtherFile            = "other.xml.gz"
 	FileListsFile        = "filelists.xml.gz"
 	artifactBatchLimit   = 50
+	artifactBatchTimeout = 30 * time.Second
 	packageStartElements = "package"
 )
 
 type RpmHelper interface {
+	// BuildRegistryFiles builds the repodata of the registry. The repodata of registries without upstreams
+	// is updated with the artifacts added and deleted since the last build when possible.
 	BuildRegistryFiles(
 		ctx context.Context,
 		registry types.Registry,
 		principalID int64,
+		deletedArtifactIDs []int64,
 	) error
 }
 
@@ -137,74 +143,96 @@ func (l *rpmHelper) BuildRegistryFiles(
 	ctx context.Context,
 	registry types.Registry,
 	principalID int64,
+	deletedArtifactIDs []int64,
 ) error {
 	rootSpace, err := l.spaceFinder.FindByID(ctx, registry.RootParentID)
 	if err != nil {
 		return fmt.Errorf("failed to find root space by ID: %w", err)
 	}
-	existingPackageInfos, err := l.getExistingArtifactInfos(ctx, registry.ID)
-	if err != nil {
-		return err
+	var signingConfig *types.RpmSigningConfig
+	if registry.Config != nil {
+		signingConfig = registry.Config.RpmSigning
 	}
 	if registry.Type == artifact.RegistryTypeUPSTREAM {
+		existingPackageInfos, err := l.getExistingArtifactInfos(ctx, registry.ID, 0, newRpmIndexState())
+		if err != nil {
+			return err
+		}
 		return l.buildForUpstream(ctx, registry.ID, registry.RootParentID, existingPackageInfos,
-			rootSpace.Identifier, principalID)
+			rootSpace.Identifier, signingConfig, principalID)
 	}
-	return l.buildForVirtual(ctx, registry.ID, registry.Name, registry.RootParentID, registry.ParentID,
-		rootSpace.Identifier, existingPackageInfos, principalID)
-}
 
-func (l *rpmHelper) buildForVirtual(
-	ctx context.Context,
-	registryID int64,
-	registryIdentifier string,
-	rootParentID int64,
-	parentID int64,
-	rootIdentifier string,
-	existingPackageInfos []*rpmtypes.PackageInfo,
-	principalID int64,
-) error {
-	registries, err := base.GetOrderedRepos(ctx, l.registryDao, registryIdentifier, parentID, true)
+	registries, err := base.GetOrderedRepos(ctx, l.registryDao, registry.Name, registry.ParentID, true)
 	if err != nil {
 		return err
 	}
+	// the repodata of upstreams changes independently, so it's only updated for registries without them
+	if len(registries) == 1 {
+		updated, err := l.updateIndex(ctx, registry, rootSpace.Identifier, deletedArtifactIDs, signingConfig,
+			principalID)
+		if err != nil || updated {
+			return err
+		}
+	}
 
-	var primary, fileLists, other *rpmtypes.RepoData
-
-	primaryRegistryData, err := l.getRegistryData(ctx, registries, rootIdentifier, "primary")
+	state := newRpmIndexState()
+	existingPackageInfos, err := l.getExistingArtifactInfos(ctx, registry.ID, 0, state)
 	if err != nil {
 		return err
 	}
-	primary, err = l.buildPrimary(ctx, existingPackageInfos, registryID, rootParentID, rootIdentifier,
-		registryIdentifier, false, primaryRegistryData, principalID)
-	if err != nil {
-		return err
+	repoData := make(map[string][]registryData, len(repoDataTypes))
+	for _, refType := range repoDataTypes {
+		repoData[refType], err = l.getRegistryData(ctx, registries, rootSpace.Identifier, refType)
+		if err != nil {
+			return err
+		}
 	}
-
-	fileListsRegistryData, err := l.getRegistryData(ctx, registries, rootIdentifier, "filelists")
-	if err != nil {
+	data, err := l.buildForVirtual(ctx, registry.ID, registry.Name, registry.RootParentID, rootSpace.Identifier,
+		existingPackageInfos, repoData, signingConfig, principalID)
+	if err != nil || len(registries) > 1 {
 		return err
 	}
-	fileLists, err = l.buildFileLists(ctx, existingPackageInfos, registryID, rootParentID,
-		rootIdentifier, fileListsRegistryData, principalID)
+	state.setRefs(data)
+	return l.saveIndexState(ctx, registry, rootSpace.Identifier, state, principalID)
+}
+
+// buildForVirtual builds the repodata of the registry from its artifacts and the repodata of its upstreams,
+// given by repodata type.
+func (l *rpmHelper) buildForVirtual(
+	ctx context.Context,
+	registryID int64,
+	registryIdentifier string,
+	rootParentID int64,
+	rootIdentifier string,
+	existingPackageInfos []*rpmtypes.PackageInfo,
+	repoData map[string][]registryData,
+	signingConfig *types.RpmSigningConfig,
+	principalID int64,
+) ([]*rpmtypes.RepoData, error) {
+	primary, err := l.buildPrimary(ctx, existingPackageInfos, registryID, rootParentID, rootIdentifier,
+		registryIdentifier, false, repoData["primary"], principalID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	otherRegistryData, err := l.getRegistryData(ctx, registries, rootIdentifier, "other")
+	fileLists, err := l.buildFileLists(ctx, existingPackageInfos, registryID, rootParentID,
+		rootIdentifier, repoData["filelists"], principalID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	other, err = l.buildOther(ctx, existingPackageInfos, registryID, rootParentID,
-		rootIdentifier, otherRegistryData, principalID)
+	other, err := l.buildOther(ctx, existingPackageInfos, registryID, rootParentID,
+		rootIdentifier, repoData["other"], principalID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = l.buildRepoMDFile(ctx, registryID, rootParentID,
-		primary, fileLists, other, rootIdentifier, principalID)
-	return err
+		primary, fileLists, other, rootIdentifier, signingConfig, principalID)
+	if err != nil {
+		return nil, err
+	}
+	return []*rpmtypes.RepoData{primary, fileLists, other}, nil
 }
 
 func (l *rpmHelper) buildForUpstream(
@@ -213,6 +241,7 @@ func (l *rpmHelper) buildForUpstream(
 	rootParentID int64,
 	existingPackageInfos []*rpmtypes.PackageInfo,
 	rootIdentifier string,
+	signingConfig *types.RpmSigningConfig,
 	principalID int64,
 ) error {
 	var primary, fileLists, other *rpmtypes.RepoData
@@ -267,7 +296,7 @@ func (l *rpmHelper) buildForUpstream(
 
 	// Build the repodata file
 	err = l.buildRepoMDFile(ctx, registryID, rootParentID, primary, fileLists,
-		other, rootIdentifier, principalID)
+		other, rootIdentifier, signingConfig, principalID)
 	if err != nil {
 		return err
 	}
@@ -300,39 +329,37 @@ func (l *rpmHelper) getRegistryData(
 	return rd, nil
 }
 
+// getExistingArtifactInfos returns the packages of the artifacts above lastArtifactID and adds them to the state.
 func (l *rpmHelper) getExistingArtifactInfos(
 	ctx context.Context,
 	registryID int64,
+	lastArtifactID int64,
+	state *rpmIndexState,
 ) ([]*rpmtypes.PackageInfo, error) {
-	lastArtifactID := int64(0)
 	var packageInfos []*rpmtypes.PackageInfo
-	for {
-		artifacts, err := l.artifactDao.GetAllArtifactsByRepo(ctx, registryID, artifactBatchLimit, lastArtifactID)
-		if err != nil {
-			return nil, err
-		}
-
-		for _, a := range *artifacts {
-			metadata := rpmmetadata.RpmMetadata{}
-			err := json.Unmarshal(a.Metadata, &metadata)
-			if err != nil {
-				return nil, err
-			}
+	_, err := store.ScanArtifactBatches(ctx, l.artifactDao.GetAllArtifactsByRepo, registryID,
+		artifactBatchLimit, artifactBatchTimeout, lastArtifactID,
+		func(artifacts []types.ArtifactMetadata) error {
+			for _, a := range artifacts {
+				metadata := rpmmetadata.RpmMetadata{}
+				err := json.Unmarshal(a.Metadata, &metadata)
+				if err != nil {
+					return err
+				}
 
-			packageInfos = append(packageInfos, &rpmtypes.PackageInfo{
-				Name:            a.Name,
-				Sha256:          metadata.GetFiles()[0].Sha256,
-				Size:            metadata.GetFiles()[0].Size,
-				VersionMetadata: &metadata.VersionMetadata,
-				FileMetadata:    &metadata.FileMetadata,
-			})
-			if a.ID > lastArtifactID {
-				lastArtifactID = a.ID
+				packageInfos = append(packageInfos, &rpmtypes.PackageInfo{
+					Name:            a.Name,
+					Sha256:          metadata.GetFiles()[0].Sha256,
+					Size:            metadata.GetFiles()[0].Size,
+					VersionMetadata: &metadata.VersionMetadata,
+					FileMetadata:    &metadata.FileMetadata,
+				})
+				state.add(a.ID, metadata.GetFiles()[0].Sha256)
 			}
-		}
-		if len(*artifacts) < artifactBatchLimit {
-			break
-		}
+			return nil
+		})
+	if err != nil {
+		return nil, err
 	}
 	return packageInfos, nil
 }
@@ -345,13 +372,14 @@ func (l *rpmHelper) buildRepoMDFile(
 	fileLists *rpmtypes.RepoData,
 	other *rpmtypes.RepoData,
 	rootIdentifier string,
+	signingConfig *types.RpmSigningConfig,
 	principalID int64,
 ) error {
 	err := l.buildRepomd(ctx, []*rpmtypes.RepoData{
 		primary,
 		fileLists,
 		other,
-	}, registryID, rootParentID, rootIdentifier, principalID)
+	}, registryID, rootParentID, rootIdentifier, signingConfig, principalID)
 	return err
 }
 
@@ -524,6 +552,10 @@ func (l *rpmHelper) buildPrimary(
 					return
 				}
 
+				if ird, ok := rd.(*indexedRepoData); ok && ird.isRemoved(pkg.Checksum.Checksum) {
+					continue
+				}
+
 				if overridePath {
 					packageVersion := fmt.Sprintf("%s-%s", pkg.Version.Version, pkg.Version.Release)
 					pkg.Location.Href = fmt.Sprintf("../../%s/rpm/package/%s/%s/%s/%s/%s", repoKey,
@@ -689,6 +721,9 @@ func (l *rpmHelper) buildOther(
 				if err := otherDecoder.DecodeElement(&pkg, &packageStartElement); err != nil {
 					pw.CloseWithError(fmt.Errorf("failed to decode other package: %w", err))
 				}
+				if ird, ok := rd.(*indexedRepoData); ok && ird.isRemoved(pkg.Pkgid) {
+					continue
+				}
 				if _, exists := set[pkg.Pkgid]; !exists {
 					if err := encoder.Encode(pkg); err != nil {
 						pw.CloseWithError(fmt.Errorf("failed to encode package: %w", err))
@@ -809,6 +844,9 @@ func (l *rpmHelper) buildFileLists(
 					pw.CloseWithError(fmt.Errorf("failed to decode filelists package: %w", err))
 					return
 				}
+				if ird, ok := rd.(*indexedRepoData); ok && ird.isRemoved(pkg.Pkgid) {
+					continue
+				}
 				if _, exists := set[pkg.Pkgid]; !exists {
 					if err := encoder.Encode(pkg); err != nil {
 						pw.CloseWithError(fmt.Errorf("failed to encode package: %w", err))
@@ -905,6 +943,7 @@ func (l *rpmHelper) buildRepomd(
 	registryID int64,
 	rootParentID int64,
 	rootIdentifier string,
+	signingConfig *types.RpmSigningConfig,
 	principalID int64,
 ) error {
 	var buf bytes.Buffer
@@ -916,7 +955,8 @@ func (l *rpmHelper) buildRepomd(
 	}); err != nil {
 		return err
 	}
-	repomdContent, _ := rpmtypes.CreateHashedBufferFromReader(&buf)
+	repomd := buf.Bytes()
+	repomdContent, _ := rpmtypes.CreateHashedBufferFromReader(bytes.NewReader(repomd))
 	defer repomdContent.Close()
 
 	_, err := l.fileManager.UploadFile(ctx, RepoDataPrefix+RepoMdFile, registryID, rootParentID, rootIdentifier,
@@ -924,9 +964,74 @@ func (l *rpmHelper) buildRepomd(
 	if err != nil {
 		return err
 	}
+	if signingConfig == nil || signingConfig.SigningKeySecretIdentifier == "" {
+		return l.removeRepomdSignature(ctx, registryID)
+	}
+	return l.signRepomd(ctx, repomd, registryID, rootParentID, rootIdentifier, signingConfig, principalID)
+}
+
+// removeRepomdSignature deletes the signature and public key left over from when the registry signed its
+// metadata, a stale signature would fail repo_gpgcheck against the rebuilt repomd.xml.
+func (l *rpmHelper) removeRepomdSignature(ctx context.Context, registryID int64) error {
+	for _, fileName := range []string{rpmutil.RepoMdSignatureFile, rpmutil.RepoMdKeyFile} {
+		if err := l.fileManager.DeleteLeafNode(ctx, registryID, RepoDataPrefix+fileName); err != nil {
+			return fmt.Errorf("failed to delete %s: %w", fileName, err)
+		}
+	}
 	return nil
 }
 
+// signRepomd uploads the detached signature of repomd.xml along with the public key of the signer,
+// so clients can enable repo_gpgcheck against the registry.
+func (l *rpmHelper) signRepomd(
+	ctx context.Context,
+	repomd []byte,
+	registryID int64,
+	rootParentID int64,
+	rootIdentifier string,
+	signingConfig *types.RpmSigningConfig,
+	principalID int64,
+) error {
+	signer, err := l.getSigningKey(ctx, signingConfig)
+	if err != nil {
+		return err
+	}
+	signature, err := rpmutil.SignRepoMd(signer, bytes.NewReader(repomd))
+	if err != nil {
+		return err
+	}
+	publicKey, err := rpmutil.ExportPublicKey(signer)
+	if err != nil {
+		return err
+	}
+	for fileName, content := range map[string][]byte{
+		rpmutil.RepoMdSignatureFile: signature,
+		rpmutil.RepoMdKeyFile:       publicKey,
+	} {
+		_, err = l.fileManager.UploadFile(ctx, RepoDataPrefix+fileName, registryID, rootParentID, rootIdentifier,
+			nil, bytes.NewReader(content), principalID)
+		if err != nil {
+			return fmt.Errorf("failed to upload %s: %w", fileName, err)
+		}
+	}
+	return nil
+}
+
+func (l *rpmHelper) getSigningKey(
+	ctx context.Context,
+	signingConfig *types.RpmSigningConfig,
+) (*openpgp.Entity, error) {
+	space, err := l.spaceFinder.FindByID(ctx, signingConfig.SigningKeySecretSpaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find signing key secret space: %w", err)
+	}
+	armoredKey, err := l.secretService.DecryptSecret(ctx, space.Path, signingConfig.SigningKeySecretIdentifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt signing key secret: %w", err)
+	}
+	return rpmutil.ReadSigningKey(armoredKey)
+}
+
 func getPrimaryPackage(pi *rpmtypes.PackageInfo, rootPackagePath string) (string, *rpmtypes.PrimaryPackage) {
 	files := make([]*rpmmetadata.File, 0, 3)
 	for _, f := range pi.FileMetadata.Files {
@@ -0,0 +1,114 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asyncprocessing
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"testing"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/app/store/cache"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	rpmutil "github.com/harness/gitness/registry/app/utils/rpm"
+	rpmtypes "github.com/harness/gitness/registry/app/utils/rpm/types"
+	"github.com/harness/gitness/registry/types"
+	gitnesstypes "github.com/harness/gitness/types"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFileManager struct {
+	filemanager.FileManager
+	files map[string][]byte
+}
+
+func (f *fakeFileManager) UploadFile(
+	_ context.Context, filePath string, _ int64, _ int64, _ string, _ multipart.File, fileReader io.Reader, _ int64,
+) (types.FileInfo, error) {
+	content, err := io.ReadAll(fileReader)
+	if err != nil {
+		return types.FileInfo{}, err
+	}
+	f.files[filePath] = content
+	return types.FileInfo{}, nil
+}
+
+func (f *fakeFileManager) DeleteLeafNode(_ context.Context, _ int64, filePath string) error {
+	delete(f.files, filePath)
+	return nil
+}
+
+type fakeSpaceCache struct{}
+
+func (fakeSpaceCache) Stats() (int64, int64) {
+	return 0, 0
+}
+
+func (fakeSpaceCache) Get(_ context.Context, id int64) (*gitnesstypes.SpaceCore, error) {
+	return &gitnesstypes.SpaceCore{ID: id, Path: "root"}, nil
+}
+
+func (fakeSpaceCache) Evict(context.Context, int64) {}
+
+type fakeSecretService map[string]string
+
+func (f fakeSecretService) DecryptSecret(_ context.Context, spacePath, secretIdentifier string) (string, error) {
+	return f[spacePath+"/"+secretIdentifier], nil
+}
+
+func newTestArmoredKey(t *testing.T) string {
+	t.Helper()
+	entity, err := openpgp.NewEntity("registry", "", "registry@example.com", nil)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.SerializePrivate(w, nil))
+	require.NoError(t, w.Close())
+	return buf.String()
+}
+
+func TestBuildRepomdSigning(t *testing.T) {
+	ctx := context.Background()
+	fileManager := &fakeFileManager{files: map[string][]byte{}}
+	l := &rpmHelper{
+		fileManager:   fileManager,
+		spaceFinder:   refcache.NewSpaceFinder(fakeSpaceCache{}, nil, nil, cache.Evictor[*gitnesstypes.SpaceCore]{}),
+		secretService: fakeSecretService{"root/rpm-key": newTestArmoredKey(t)},
+	}
+	data := []*rpmtypes.RepoData{{Type: "primary", Location: rpmtypes.RepoLocation{Href: "repodata/primary.xml.gz"}}}
+	signingConfig := &types.RpmSigningConfig{SigningKeySecretIdentifier: "rpm-key", SigningKeySecretSpaceID: 1}
+
+	require.NoError(t, l.buildRepomd(ctx, data, 1, 1, "root", signingConfig, 7))
+
+	repomd := fileManager.files[RepoDataPrefix+RepoMdFile]
+	require.NotEmpty(t, repomd)
+	keyring, err := rpmutil.ReadTrustedKeys([]string{string(fileManager.files[RepoDataPrefix+rpmutil.RepoMdKeyFile])})
+	require.NoError(t, err)
+	_, err = openpgp.CheckArmoredDetachedSignature(keyring, bytes.NewReader(repomd),
+		bytes.NewReader(fileManager.files[RepoDataPrefix+rpmutil.RepoMdSignatureFile]), nil)
+	require.NoError(t, err, "repomd.xml is signed by the published key")
+
+	// once signing is turned off the stale signature is removed along with the key.
+	require.NoError(t, l.buildRepomd(ctx, data, 1, 1, "root", &types.RpmSigningConfig{}, 7))
+	require.Contains(t, fileManager.files, RepoDataPrefix+RepoMdFile)
+	require.NotContains(t, fileManager.files, RepoDataPrefix+rpmutil.RepoMdSignatureFile)
+	require.NotContains(t, fileManager.files, RepoDataPrefix+rpmutil.RepoMdKeyFile)
+}
@@ -0,0 +1,199 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asyncprocessing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	rpmtypes "github.com/harness/gitness/registry/app/utils/rpm/types"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+const IndexStateFile = "index-state.json"
+
+// repoDataTypes are the types of the repodata files built for a registry.
+var repoDataTypes = []string{"primary", "filelists", "other"}
+
+// rpmIndexState is stored next to the repodata of a registry without upstreams. It records which artifacts the
+// repodata was built from, so later builds can apply the added and deleted artifacts instead of rebuilding it.
+type rpmIndexState struct {
+	// Packages maps the IDs of the indexed artifacts to their package IDs.
+	Packages map[int64]string `json:"packages"`
+	// LastArtifactID is the highest indexed artifact ID, the artifacts above it were added since.
+	LastArtifactID int64 `json:"lastArtifactId"`
+	// Refs maps the repodata types to the files built with the state, they no longer match once the
+	// repodata was rebuilt without updating the state.
+	Refs map[string]string `json:"refs"`
+}
+
+func newRpmIndexState() *rpmIndexState {
+	return &rpmIndexState{
+		Packages: make(map[int64]string),
+		Refs:     make(map[string]string),
+	}
+}
+
+func (s *rpmIndexState) add(artifactID int64, pkgid string) {
+	s.Packages[artifactID] = pkgid
+	if artifactID > s.LastArtifactID {
+		s.LastArtifactID = artifactID
+	}
+}
+
+// remove drops the deleted artifacts from the state and returns the package IDs to remove from the repodata.
+// Package IDs still used by another artifact are kept.
+func (s *rpmIndexState) remove(artifactIDs []int64) map[string]struct{} {
+	removed := make(map[string]struct{})
+	for _, id := range artifactIDs {
+		if pkgid, ok := s.Packages[id]; ok {
+			delete(s.Packages, id)
+			removed[pkgid] = struct{}{}
+		}
+	}
+	for _, pkgid := range s.Packages {
+		delete(removed, pkgid)
+	}
+	return removed
+}
+
+func (s *rpmIndexState) setRefs(data []*rpmtypes.RepoData) {
+	s.Refs = make(map[string]string, len(data))
+	for _, d := range data {
+		if d != nil {
+			s.Refs[d.Type] = d.Location.Href
+		}
+	}
+}
+
+// indexedRepoData is the repodata previously built for the registry itself, without the deleted packages.
+type indexedRepoData struct {
+	localRepoData
+	removed map[string]struct{}
+}
+
+func (d *indexedRepoData) isRemoved(pkgid string) bool {
+	_, ok := d.removed[pkgid]
+	return ok
+}
+
+func (l *rpmHelper) getIndexState(
+	ctx context.Context,
+	registry types.Registry,
+	rootIdentifier string,
+) (*rpmIndexState, error) {
+	reader, _, _, err := l.fileManager.DownloadFileByPath(
+		ctx, "/"+RepoDataPrefix+IndexStateFile, registry.ID, registry.Name, rootIdentifier, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	state := newRpmIndexState()
+	if err := json.NewDecoder(reader).Decode(state); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", IndexStateFile, err)
+	}
+	return state, nil
+}
+
+func (l *rpmHelper) saveIndexState(
+	ctx context.Context,
+	registry types.Registry,
+	rootIdentifier string,
+	state *rpmIndexState,
+	principalID int64,
+) error {
+	content, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", IndexStateFile, err)
+	}
+	_, err = l.fileManager.UploadFile(ctx, RepoDataPrefix+IndexStateFile, registry.ID, registry.RootParentID,
+		rootIdentifier, nil, bytes.NewReader(content), principalID)
+	if err != nil {
+		return fmt.Errorf("failed to upload %s: %w", IndexStateFile, err)
+	}
+	return nil
+}
+
+// updateIndex applies the artifacts added and deleted since the last build to the repodata of a registry
+// without upstreams. It returns false when the stored state drifted from the registry, the repodata has to
+// be rebuilt then.
+func (l *rpmHelper) updateIndex(
+	ctx context.Context,
+	registry types.Registry,
+	rootIdentifier string,
+	deletedArtifactIDs []int64,
+	signingConfig *types.RpmSigningConfig,
+	principalID int64,
+) (bool, error) {
+	state, err := l.getIndexState(ctx, registry, rootIdentifier)
+	if err != nil {
+		log.Ctx(ctx).Info().Err(err).Msgf("no index state for registry [%s], rebuilding repodata", registry.Name)
+		return false, nil
+	}
+	for _, refType := range repoDataTypes {
+		ref, err := l.getRefsForHarnessRepos(ctx, registry.ID, registry.Name, rootIdentifier, refType, registry.Type)
+		if err != nil {
+			return false, err
+		}
+		if ref == "" || ref != state.Refs[refType] {
+			log.Ctx(ctx).Info().Msgf("%s repodata of registry [%s] changed since the index state was saved, "+
+				"rebuilding repodata", refType, registry.Name)
+			return false, nil
+		}
+	}
+
+	removed := state.remove(deletedArtifactIDs)
+	added, err := l.getExistingArtifactInfos(ctx, registry.ID, state.LastArtifactID, state)
+	if err != nil {
+		return false, err
+	}
+	count, err := l.artifactDao.CountArtifactsByRepo(ctx, registry.ParentID, registry.Name, "", nil, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to count artifacts: %w", err)
+	}
+	if count != int64(len(state.Packages)) {
+		log.Ctx(ctx).Info().Msgf("index state of registry [%s] has %d packages but the registry has %d, "+
+			"rebuilding repodata", registry.Name, len(state.Packages), count)
+		return false, nil
+	}
+
+	repoData := make(map[string][]registryData, len(repoDataTypes))
+	for _, refType := range repoDataTypes {
+		repoData[refType] = []registryData{&indexedRepoData{
+			localRepoData: localRepoData{
+				fileManager:        l.fileManager,
+				fileRef:            state.Refs[refType],
+				registryID:         registry.ID,
+				registryIdentifier: registry.Name,
+				rootIdentifier:     rootIdentifier,
+			},
+			removed: removed,
+		}}
+	}
+	data, err := l.buildForVirtual(ctx, registry.ID, registry.Name, registry.RootParentID, rootIdentifier,
+		added, repoData, signingConfig, principalID)
+	if err != nil {
+		return false, err
+	}
+	state.setRefs(data)
+	log.Ctx(ctx).Debug().Msgf("updated repodata of registry [%s] with %d added and %d removed packages",
+		registry.Name, len(added), len(removed))
+	return true, l.saveIndexState(ctx, registry, rootIdentifier, state, principalID)
+}
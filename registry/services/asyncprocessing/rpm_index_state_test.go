@@ -0,0 +1,54 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asyncprocessing
+
+import (
+	"testing"
+)
+
+func TestRpmIndexStateRemove(t *testing.T) {
+	t.Run("returns the package IDs of the deleted artifacts", func(t *testing.T) {
+		state := newRpmIndexState()
+		state.add(1, "a")
+		state.add(2, "b")
+		removed := state.remove([]int64{1, 3})
+		if _, ok := removed["a"]; !ok || len(removed) != 1 {
+			t.Errorf("expected only package a to be removed, got %v", removed)
+		}
+		if len(state.Packages) != 1 || state.Packages[2] != "b" {
+			t.Errorf("expected only artifact 2 to remain, got %v", state.Packages)
+		}
+	})
+
+	t.Run("keeps package IDs used by another artifact", func(t *testing.T) {
+		state := newRpmIndexState()
+		state.add(1, "a")
+		state.add(2, "a")
+		removed := state.remove([]int64{1})
+		if len(removed) != 0 {
+			t.Errorf("expected no package to be removed, got %v", removed)
+		}
+	})
+
+	t.Run("tracks the last added artifact", func(t *testing.T) {
+		state := newRpmIndexState()
+		state.add(5, "a")
+		state.add(3, "b")
+		state.remove([]int64{5})
+		if state.LastArtifactID != 5 {
+			t.Errorf("expected last artifact ID 5, got %d", state.LastArtifactID)
+		}
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/harness/gitness/app/api/request"
@@ -27,11 +28,12 @@ import (
 	"github.com/harness/gitness/app/services/locker"
 	"github.com/harness/gitness/events"
 	"github.com/harness/gitness/registry/app/api/interfaces"
-	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/events/asyncprocessing"
+	"github.com/harness/gitness/registry/app/pkg/dispatch"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/app/utils/cargo"
 	"github.com/harness/gitness/registry/app/utils/gopackage"
+	"github.com/harness/gitness/registry/services/concurrency"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/stream"
@@ -58,10 +60,17 @@ type Service struct {
 	taskRepository          store.TaskRepository
 	taskSourceRepository    store.TaskSourceRepository
 	taskEventRepository     store.TaskEventRepository
+	indexBuildRepository    store.IndexBuildRepository
 	innerReporter           *events.GenericReporter
 	postProcessingReporter  *asyncprocessing.Reporter
 	packageWrapper          interfaces.PackageWrapper
 	taskHandlers            map[types.TaskKind]TaskHandler
+	limiter                 *concurrency.Limiter
+
+	// deferred holds the events of index tasks postponed because their account ran the maximum of concurrent
+	// index builds, they're sent again whenever an index build of the account completes.
+	deferredMu sync.Mutex
+	deferred   map[int64][]*asyncprocessing.ExecuteAsyncTaskPayload
 }
 
 func NewService(
@@ -77,9 +86,11 @@ func NewService(
 	taskRepository store.TaskRepository,
 	taskSourceRepository store.TaskSourceRepository,
 	taskEventRepository store.TaskEventRepository,
+	indexBuildRepository store.IndexBuildRepository,
 	eventsSystem *events.System,
 	postProcessingReporter *asyncprocessing.Reporter,
 	packageWrapper interfaces.PackageWrapper,
+	limiter *concurrency.Limiter,
 ) (*Service, error) {
 	if err := config.Prepare(); err != nil {
 		return nil, fmt.Errorf("provided postprocessing service config is invalid: %w", err)
@@ -98,10 +109,13 @@ func NewService(
 		taskRepository:          taskRepository,
 		taskSourceRepository:    taskSourceRepository,
 		taskEventRepository:     taskEventRepository,
+		indexBuildRepository:    indexBuildRepository,
 		innerReporter:           innerReporter,
 		postProcessingReporter:  postProcessingReporter,
 		packageWrapper:          packageWrapper,
 		taskHandlers:            make(map[types.TaskKind]TaskHandler),
+		limiter:                 limiter,
+		deferred:                make(map[int64][]*asyncprocessing.ExecuteAsyncTaskPayload),
 	}
 	_, err = artifactsReaderFactory.Launch(ctx, eventsReaderGroupName, config.EventReaderName,
 		func(r *asyncprocessing.Reader) error {
@@ -161,23 +175,44 @@ func (s *Service) handleEventExecuteAsyncTask(
 	ctx context.Context,
 	e *events.Event[*asyncprocessing.ExecuteAsyncTaskPayload],
 ) error {
-	unlock, err := s.locker.LockResource(ctx, e.Payload.TaskKey, timeout)
+	task, err := s.taskRepository.Find(ctx, e.Payload.TaskKey)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := s.lockTask(ctx, task)
 	if err != nil {
 		log.Ctx(ctx).Error().Msgf("failed acquire lock by key, eventID: %s, eventId:%s err: %v", e.Payload.TaskKey, e.ID, err)
 		return err
 	}
 	defer unlock()
 
-	task, err := s.taskRepository.Find(ctx, e.Payload.TaskKey)
+	// the task could have been completed by another instance while waiting for the lock
+	task, err = s.taskRepository.Find(ctx, e.Payload.TaskKey)
+	if err != nil {
+		return err
+	}
+	if isTaskDone(task) {
+		log.Ctx(ctx).Debug().Msgf("skipping task [%s] of event %s, it was already processed", task.Key, e.ID)
+		return nil
+	}
+
+	release, ok, err := s.acquireIndexBuildSlot(ctx, task, e.Payload)
 	if err != nil {
 		return err
 	}
+	if !ok {
+		return nil
+	}
+	defer release()
 
 	err = s.ProcessingStatusUpdate(ctx, task, e.ID)
 	if err != nil {
 		return fmt.Errorf("failed to update task status: %w", err)
 	}
 
+	build := s.startIndexBuild(ctx, task, e.ID)
+
 	var processingErr error
 	//nolint:nestif
 	switch task.Kind {
@@ -211,6 +246,7 @@ func (s *Service) handleEventExecuteAsyncTask(
 	if err != nil {
 		log.Ctx(ctx).Error().Msgf("failed to update final status for task [%s]: %v", task.Key, err)
 	}
+	s.completeIndexBuild(ctx, build, processingErr)
 
 	if runAgain {
 		eventID, err := events.ReporterSendEvent(s.innerReporter, ctx, asyncprocessing.ExecuteAsyncTask, e.Payload)
@@ -223,6 +259,141 @@ func (s *Service) handleEventExecuteAsyncTask(
 	return nil
 }
 
+// lockTask acquires the lock of a task. Index rebuilds are locked by registry and index type instead of by
+// task, so rebuilds of the same index triggered on different instances can't overwrite each other.
+func (s *Service) lockTask(ctx context.Context, task *types.Task) (func(), error) {
+	//nolint:exhaustive
+	switch task.Kind {
+	case types.TaskKindBuildRegistryIndex:
+		var payload types.BuildRegistryIndexTaskPayload
+		if err := json.Unmarshal(task.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal task payload: %w", err)
+		}
+		return s.locker.LockRegistryIndex(ctx, payload.RegistryID, string(types.IndexTypeRegistry), timeout)
+	case types.TaskKindBuildPackageIndex:
+		var payload types.BuildPackageIndexTaskPayload
+		if err := json.Unmarshal(task.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal task payload: %w", err)
+		}
+		return s.locker.LockRegistryIndex(ctx, payload.RegistryID,
+			string(types.IndexTypePackage)+"/"+payload.Image, timeout)
+	default:
+		return s.locker.LockResource(ctx, task.Key, timeout)
+	}
+}
+
+// acquireIndexBuildSlot acquires a slot of the account of the registry for index tasks, so a single account
+// can't occupy all workers with index builds. If the account has no slot left the task is deferred until one
+// of its index builds completes, and false is returned.
+func (s *Service) acquireIndexBuildSlot(
+	ctx context.Context,
+	task *types.Task,
+	payload *asyncprocessing.ExecuteAsyncTaskPayload,
+) (func(), bool, error) {
+	if !types.IsIndexTask(task.Kind) || s.limiter.Limit(concurrency.OperationIndexBuild) == 0 {
+		return func() {}, true, nil
+	}
+	var taskPayload types.BuildRegistryIndexTaskPayload
+	if err := json.Unmarshal(task.Payload, &taskPayload); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal task payload: %w", err)
+	}
+	registry, err := s.registryDao.Get(ctx, taskPayload.RegistryID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to find registry [%d]: %w", taskPayload.RegistryID, err)
+	}
+	accountID := registry.RootParentID
+
+	release, err := s.limiter.TryAcquire(accountID, concurrency.OperationIndexBuild)
+	var limitErr *concurrency.LimitError
+	if errors.As(err, &limitErr) {
+		s.deferredMu.Lock()
+		s.deferred[accountID] = append(s.deferred[accountID], payload)
+		s.deferredMu.Unlock()
+		log.Ctx(ctx).Info().Msgf("deferred task [%s], account [%d] runs the maximum of %d index builds",
+			task.Key, accountID, limitErr.Limit)
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return func() {
+		release()
+		s.resumeDeferred(ctx, accountID)
+	}, true, nil
+}
+
+// resumeDeferred sends the event of the oldest deferred index task of an account again.
+func (s *Service) resumeDeferred(ctx context.Context, accountID int64) {
+	s.deferredMu.Lock()
+	queue := s.deferred[accountID]
+	if len(queue) == 0 {
+		s.deferredMu.Unlock()
+		return
+	}
+	payload := queue[0]
+	if len(queue) == 1 {
+		delete(s.deferred, accountID)
+	} else {
+		s.deferred[accountID] = queue[1:]
+	}
+	s.deferredMu.Unlock()
+
+	if _, err := events.ReporterSendEvent(s.innerReporter, ctx, asyncprocessing.ExecuteAsyncTask, payload); err != nil {
+		log.Ctx(ctx).Error().Msgf("failed to resume deferred task [%s]: %v", payload.TaskKey, err)
+	}
+}
+
+// startIndexBuild records a run of an index task, so it shows up in the index build history of the registry.
+// Failures are only logged as the history must not prevent the index from being built.
+func (s *Service) startIndexBuild(ctx context.Context, task *types.Task, runID string) *types.IndexBuild {
+	if !types.IsIndexTask(task.Kind) {
+		return nil
+	}
+	// the payload of package index tasks is a superset of the one of registry index tasks.
+	var payload types.BuildPackageIndexTaskPayload
+	if err := json.Unmarshal(task.Payload, &payload); err != nil {
+		log.Ctx(ctx).Error().Msgf("failed to unmarshal task payload for task [%s]: %v", task.Key, err)
+		return nil
+	}
+	build := &types.IndexBuild{
+		RegistryID:  payload.RegistryID,
+		TaskKey:     task.Key,
+		Kind:        task.Kind,
+		Image:       payload.Image,
+		RunID:       runID,
+		Status:      types.TaskStatusProcessing,
+		PrincipalID: payload.PrincipalID,
+		StartedAt:   time.Now(),
+	}
+	if err := s.indexBuildRepository.Create(ctx, build); err != nil {
+		log.Ctx(ctx).Error().Msgf("failed to record index build for task [%s]: %v", task.Key, err)
+		return nil
+	}
+	return build
+}
+
+func (s *Service) completeIndexBuild(ctx context.Context, build *types.IndexBuild, processingErr error) {
+	if build == nil {
+		return
+	}
+	status := types.TaskStatusSuccess
+	errMsg := ""
+	if processingErr != nil {
+		status = types.TaskStatusFailure
+		errMsg = processingErr.Error()
+	}
+	err := s.indexBuildRepository.Complete(ctx, build.ID, status, errMsg, time.Since(build.StartedAt))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("failed to complete index build [%d]: %v", build.ID, err)
+	}
+}
+
+// isTaskDone returns true if the task was completed and no run was requested since, which happens when
+// several events were queued for the same task.
+func isTaskDone(task *types.Task) bool {
+	return (task.Status == types.TaskStatusSuccess || task.Status == types.TaskStatusFailure) && !task.RunAgain
+}
+
 func (s *Service) handleBuildRegistryIndex(ctx context.Context, task *types.Task, eventID string) error {
 	var processingErr error
 	var payload types.BuildRegistryIndexTaskPayload
@@ -238,9 +409,13 @@ func (s *Service) handleBuildRegistryIndex(ctx context.Context, task *types.Task
 		return fmt.Errorf("failed to get registry: %w", err)
 	}
 	//nolint:exhaustive
-	switch registry.PackageType {
-	case artifact.PackageTypeRPM:
-		err := s.rpmRegistryHelper.BuildRegistryFiles(ctx, *registry, payload.PrincipalID)
+	switch dispatch.For(registry.PackageType).Indexing {
+	case dispatch.IndexRPM:
+		deletedArtifactIDs, err := s.deletedArtifactIDs(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		err = s.rpmRegistryHelper.BuildRegistryFiles(ctx, *registry, payload.PrincipalID, deletedArtifactIDs)
 		if err != nil {
 			processingErr = fmt.Errorf("failed to build RPM registry files for registry [%d]: %w",
 				payload.RegistryID, err)
@@ -268,6 +443,22 @@ func (s *Service) handleBuildRegistryIndex(ctx context.Context, task *types.Task
 	return processingErr
 }
 
+// deletedArtifactIDs returns the IDs of the artifacts deleted since the index was last built, they are
+// reported as sources of the task and claimed by the run.
+func (s *Service) deletedArtifactIDs(ctx context.Context, runID string) ([]int64, error) {
+	sources, err := s.taskSourceRepository.FindByRunID(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sources of run %s: %w", runID, err)
+	}
+	var ids []int64
+	for _, src := range sources {
+		if src.SrcType == types.SourceTypeArtifactDeleted {
+			ids = append(ids, src.SrcID)
+		}
+	}
+	return ids, nil
+}
+
 func (s *Service) handleBuildPackageIndex(
 	ctx context.Context,
 	task *types.Task,
@@ -287,10 +478,14 @@ func (s *Service) handleBuildPackageIndex(
 		return fmt.Errorf("failed to get registry: %w", err)
 	}
 	//nolint:exhaustive
-	switch registry.PackageType {
-	case artifact.PackageTypeGO:
-		err := s.gopackageRegistryHelper.UpdatePackageIndex(
-			ctx, payload.PrincipalID, registry.RootParentID, registry.ID, payload.Image,
+	switch dispatch.For(registry.PackageType).Indexing {
+	case dispatch.IndexGo:
+		deletedArtifactIDs, err := s.deletedArtifactIDs(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		err = s.gopackageRegistryHelper.UpdatePackageIndex(
+			ctx, payload.PrincipalID, registry.RootParentID, registry.ID, payload.Image, deletedArtifactIDs,
 		)
 		if err != nil {
 			processingErr = fmt.Errorf("failed to build GO package index for registry [%d] package [%s]: %w",
@@ -331,8 +526,8 @@ func (s *Service) handleBuildPackageMetadata(
 		return fmt.Errorf("failed to get registry: %w", err)
 	}
 	//nolint:exhaustive
-	switch registry.PackageType {
-	case artifact.PackageTypeGO:
+	switch dispatch.For(registry.PackageType).Indexing {
+	case dispatch.IndexGo:
 		err := s.gopackageRegistryHelper.UpdatePackageMetadata(
 			ctx2, registry.RootParentID, registry.ID, payload.Image, payload.Version,
 		)
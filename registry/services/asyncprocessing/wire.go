@@ -26,6 +26,7 @@ import (
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/app/utils/cargo"
 	"github.com/harness/gitness/registry/app/utils/gopackage"
+	"github.com/harness/gitness/registry/services/concurrency"
 	"github.com/harness/gitness/secret"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/types"
@@ -53,9 +54,11 @@ func ProvideService(
 	taskRepository store.TaskRepository,
 	taskSourceRepository store.TaskSourceRepository,
 	taskEventRepository store.TaskEventRepository,
+	indexBuildRepository store.IndexBuildRepository,
 	eventsSystem *events.System,
 	postProcessingReporter *asyncprocessing.Reporter,
 	packageWrapper interfaces.PackageWrapper,
+	limiter *concurrency.Limiter,
 ) (*Service, error) {
 	return NewService(
 		ctx,
@@ -70,9 +73,11 @@ func ProvideService(
 		taskRepository,
 		taskSourceRepository,
 		taskEventRepository,
+		indexBuildRepository,
 		eventsSystem,
 		postProcessingReporter,
 		packageWrapper,
+		limiter,
 	)
 }
 
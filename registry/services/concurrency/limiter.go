@@ -0,0 +1,184 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Operation is an expensive operation whose concurrent runs are limited per account.
+type Operation string
+
+const (
+	OperationIndexBuild Operation = "index build"
+	OperationPurge      Operation = "purge"
+	OperationImport     Operation = "import"
+	OperationExport     Operation = "export"
+)
+
+// Config holds the limits of the operations, a limit of 0 leaves the operation unlimited.
+// Callers which don't get a slot wait in a queue of up to QueueSize callers for at most QueueTimeout.
+type Config struct {
+	Limits       map[Operation]int
+	QueueSize    int
+	QueueTimeout time.Duration
+}
+
+// LimitError is returned when an account runs the maximum number of concurrent operations of a kind and
+// no slot got available in time.
+type LimitError struct {
+	Operation Operation
+	Limit     int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("the account already runs the maximum of %d concurrent %s operations, retry later",
+		e.Limit, e.Operation)
+}
+
+type key struct {
+	accountID int64
+	operation Operation
+}
+
+type slots struct {
+	running int
+	waiting int
+	// released is closed and replaced whenever a slot is released, to wake up the waiting callers.
+	released chan struct{}
+}
+
+// Limiter is a semaphore per account and operation. The slots are kept in memory, so the limits apply
+// per instance.
+type Limiter struct {
+	config Config
+
+	mu    sync.Mutex
+	slots map[key]*slots
+}
+
+func NewLimiter(config Config) *Limiter {
+	return &Limiter{
+		config: config,
+		slots:  make(map[key]*slots),
+	}
+}
+
+// Limit returns the maximum number of concurrent runs of an operation per account, 0 if it's unlimited.
+func (l *Limiter) Limit(operation Operation) int {
+	if l == nil {
+		return 0
+	}
+	return max(l.config.Limits[operation], 0)
+}
+
+// Running returns the number of runs of an operation the account currently holds a slot for.
+func (l *Limiter) Running(accountID int64, operation Operation) int {
+	if l == nil {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if s, ok := l.slots[key{accountID: accountID, operation: operation}]; ok {
+		return s.running
+	}
+	return 0
+}
+
+// TryAcquire acquires a slot without waiting, it returns a LimitError if the account has no slot left.
+// The returned function releases the slot and has to be called once the operation is done.
+func (l *Limiter) TryAcquire(accountID int64, operation Operation) (func(), error) {
+	return l.acquire(context.Background(), accountID, operation, false)
+}
+
+// Acquire acquires a slot, waiting in the queue of the account if it has no slot left. It returns a
+// LimitError if the queue is full or no slot got available within the queue timeout.
+// The returned function releases the slot and has to be called once the operation is done.
+func (l *Limiter) Acquire(ctx context.Context, accountID int64, operation Operation) (func(), error) {
+	return l.acquire(ctx, accountID, operation, true)
+}
+
+func (l *Limiter) acquire(ctx context.Context, accountID int64, operation Operation, wait bool) (func(), error) {
+	limit := l.Limit(operation)
+	if limit == 0 {
+		return func() {}, nil
+	}
+	k := key{accountID: accountID, operation: operation}
+
+	l.mu.Lock()
+	s, ok := l.slots[k]
+	if !ok {
+		s = &slots{released: make(chan struct{})}
+		l.slots[k] = s
+	}
+	if s.running < limit {
+		s.running++
+		l.mu.Unlock()
+		return l.releaseFunc(k, s), nil
+	}
+	if !wait || s.waiting >= l.config.QueueSize || l.config.QueueTimeout <= 0 {
+		l.mu.Unlock()
+		return nil, &LimitError{Operation: operation, Limit: limit}
+	}
+	s.waiting++
+
+	timer := time.NewTimer(l.config.QueueTimeout)
+	defer timer.Stop()
+	for {
+		released := s.released
+		l.mu.Unlock()
+
+		var err error
+		select {
+		case <-released:
+		case <-timer.C:
+			err = &LimitError{Operation: operation, Limit: limit}
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+
+		l.mu.Lock()
+		if s.running < limit {
+			s.waiting--
+			s.running++
+			l.mu.Unlock()
+			return l.releaseFunc(k, s), nil
+		}
+		if err != nil {
+			s.waiting--
+			l.mu.Unlock()
+			return nil, err
+		}
+	}
+}
+
+func (l *Limiter) releaseFunc(k key, s *slots) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			s.running--
+			close(s.released)
+			s.released = make(chan struct{})
+			if s.running == 0 && s.waiting == 0 {
+				delete(l.slots, k)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestLimiter(queueSize int, queueTimeout time.Duration) *Limiter {
+	return NewLimiter(Config{
+		Limits:       map[Operation]int{OperationPurge: 1},
+		QueueSize:    queueSize,
+		QueueTimeout: queueTimeout,
+	})
+}
+
+func TestLimiter_TryAcquire(t *testing.T) {
+	l := newTestLimiter(0, 0)
+
+	release, err := l.TryAcquire(1, OperationPurge)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var limitErr *LimitError
+	if _, err = l.TryAcquire(1, OperationPurge); !errors.As(err, &limitErr) {
+		t.Fatalf("expected a limit error, got %v", err)
+	}
+	// the slots are counted per account
+	releaseOther, err := l.TryAcquire(2, OperationPurge)
+	if err != nil {
+		t.Fatalf("unexpected error for another account: %v", err)
+	}
+	releaseOther()
+
+	release()
+	release()
+	if running := l.Running(1, OperationPurge); running != 0 {
+		t.Fatalf("expected no running operation after release, got %d", running)
+	}
+	if _, err = l.TryAcquire(1, OperationPurge); err != nil {
+		t.Fatalf("unexpected error after release: %v", err)
+	}
+}
+
+func TestLimiter_Unlimited(t *testing.T) {
+	l := newTestLimiter(0, 0)
+	for range 3 {
+		if _, err := l.TryAcquire(1, OperationIndexBuild); err != nil {
+			t.Fatalf("unexpected error for an unlimited operation: %v", err)
+		}
+	}
+
+	var nilLimiter *Limiter
+	if _, err := nilLimiter.Acquire(context.Background(), 1, OperationPurge); err != nil {
+		t.Fatalf("unexpected error for a nil limiter: %v", err)
+	}
+}
+
+func TestLimiter_AcquireQueued(t *testing.T) {
+	l := newTestLimiter(1, time.Second)
+	release, err := l.TryAcquire(1, OperationPurge)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	acquired := make(chan error)
+	go func() {
+		releaseQueued, err := l.Acquire(context.Background(), 1, OperationPurge)
+		if err == nil {
+			releaseQueued()
+		}
+		acquired <- err
+	}()
+
+	// wait for the caller to be queued, the queue of one caller is full then.
+	for l.waiting(1, OperationPurge) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	var limitErr *LimitError
+	if _, err = l.Acquire(context.Background(), 1, OperationPurge); !errors.As(err, &limitErr) {
+		t.Fatalf("expected a limit error with a full queue, got %v", err)
+	}
+
+	release()
+	if err = <-acquired; err != nil {
+		t.Fatalf("expected the queued caller to get the slot, got %v", err)
+	}
+}
+
+func TestLimiter_AcquireTimeout(t *testing.T) {
+	l := newTestLimiter(1, 10*time.Millisecond)
+	release, err := l.TryAcquire(1, OperationPurge)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer release()
+
+	var limitErr *LimitError
+	if _, err = l.Acquire(context.Background(), 1, OperationPurge); !errors.As(err, &limitErr) {
+		t.Fatalf("expected a limit error after the queue timeout, got %v", err)
+	}
+	if waiting := l.waiting(1, OperationPurge); waiting != 0 {
+		t.Fatalf("expected no waiting caller after the timeout, got %d", waiting)
+	}
+}
+
+func (l *Limiter) waiting(accountID int64, operation Operation) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if s, ok := l.slots[key{accountID: accountID, operation: operation}]; ok {
+		return s.waiting
+	}
+	return 0
+}
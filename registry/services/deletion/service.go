@@ -0,0 +1,251 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/app/store"
+	registrystore "github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	coretypes "github.com/harness/gitness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// maxListed is the number of deletions returned by List.
+const maxListed = 100
+
+var (
+	// ErrNotScheduled is returned when a deletion which was already canceled or executed is canceled.
+	ErrNotScheduled = errors.New("deletion is not scheduled anymore")
+	// ErrInvalidDelay is returned when a deletion is scheduled with a delay which isn't allowed.
+	ErrInvalidDelay = errors.New("invalid deletion delay")
+
+	errNoExecutor = errors.New("no executor of scheduled deletions is registered")
+)
+
+// Executor deletes the artifact or version of a scheduled deletion on behalf of the principal who scheduled it.
+type Executor interface {
+	ExecuteScheduledDeletion(
+		ctx context.Context,
+		principal *coretypes.Principal,
+		deletion *types.ScheduledDeletion,
+	) error
+}
+
+// Service schedules deletions of artifacts to be executed after a delay, so they can be canceled until then.
+// The due deletions are executed by a job through the registered Executor.
+type Service struct {
+	deletionDao    registrystore.ScheduledDeletionRepository
+	principalStore store.PrincipalStore
+	defaultDelay   time.Duration
+	maxDelay       time.Duration
+
+	mu       sync.RWMutex
+	executor Executor
+}
+
+func NewService(
+	deletionDao registrystore.ScheduledDeletionRepository,
+	principalStore store.PrincipalStore,
+	defaultDelay time.Duration,
+	maxDelay time.Duration,
+) *Service {
+	return &Service{
+		deletionDao:    deletionDao,
+		principalStore: principalStore,
+		defaultDelay:   defaultDelay,
+		maxDelay:       maxDelay,
+	}
+}
+
+// Register sets the executor of the due deletions, they are kept scheduled until there is one.
+func (s *Service) Register(executor Executor) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.executor = executor
+}
+
+// Schedule returns the scheduled deletion of the version of the image, it's created if there isn't one. The
+// version is empty to delete the whole image and the default delay applies if delay is nil. created is false if
+// the deletion was already scheduled, its execution time is kept.
+func (s *Service) Schedule(
+	ctx context.Context,
+	registryID int64,
+	imageName string,
+	version string,
+	principalID int64,
+	delay *time.Duration,
+) (deletion *types.ScheduledDeletion, created bool, err error) {
+	after := s.defaultDelay
+	if delay != nil {
+		after = *delay
+	}
+	if after <= 0 || after > s.maxDelay {
+		return nil, false, fmt.Errorf("%w: it has to be positive and at most %s", ErrInvalidDelay, s.maxDelay)
+	}
+
+	deletion, err = s.deletionDao.FindScheduled(ctx, registryID, imageName, version)
+	if err == nil {
+		return deletion, false, nil
+	}
+	if !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, false, fmt.Errorf("failed to find scheduled deletion: %w", err)
+	}
+
+	now := time.Now()
+	deletion = &types.ScheduledDeletion{
+		RegistryID: registryID,
+		ImageName:  imageName,
+		Version:    version,
+		State:      types.ScheduledDeletionStateScheduled,
+		ExecuteAt:  now.Add(after),
+		CreatedBy:  principalID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	err = s.deletionDao.Create(ctx, deletion)
+	if errors.Is(err, gitnessstore.ErrDuplicate) {
+		// the same deletion was scheduled concurrently.
+		deletion, err = s.deletionDao.FindScheduled(ctx, registryID, imageName, version)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to find scheduled deletion: %w", err)
+		}
+		return deletion, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create scheduled deletion: %w", err)
+	}
+	return deletion, true, nil
+}
+
+// Find returns the deletion of the registry.
+func (s *Service) Find(ctx context.Context, registryID int64, id int64) (*types.ScheduledDeletion, error) {
+	deletion, err := s.deletionDao.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if deletion.RegistryID != registryID {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return deletion, nil
+}
+
+// List returns the latest deletions of the registry, newest first. All states are listed if state is nil.
+func (s *Service) List(
+	ctx context.Context,
+	registryID int64,
+	state *types.ScheduledDeletionState,
+) ([]*types.ScheduledDeletion, error) {
+	return s.deletionDao.List(ctx, registryID, state, maxListed)
+}
+
+// Cancel cancels the deletion if its execution didn't start yet.
+func (s *Service) Cancel(ctx context.Context, deletion *types.ScheduledDeletion, principalID int64) error {
+	if deletion.State != types.ScheduledDeletionStateScheduled {
+		return ErrNotScheduled
+	}
+
+	canceled := *deletion
+	canceled.State = types.ScheduledDeletionStateCanceled
+	canceled.CanceledBy = &principalID
+	canceled.UpdatedAt = time.Now()
+	err := s.deletionDao.UpdateState(ctx, &canceled, types.ScheduledDeletionStateScheduled)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return ErrNotScheduled
+	}
+	if err != nil {
+		return fmt.Errorf("failed to cancel scheduled deletion %d: %w", deletion.ID, err)
+	}
+	*deletion = canceled
+	return nil
+}
+
+// ExecuteDue executes up to limit deletions which are due, oldest first, and returns how many it went through.
+// A deletion which fails is marked as failed, it isn't retried.
+func (s *Service) ExecuteDue(ctx context.Context, limit int) (int, error) {
+	s.mu.RLock()
+	executor := s.executor
+	s.mu.RUnlock()
+	if executor == nil {
+		return 0, errNoExecutor
+	}
+
+	deletions, err := s.deletionDao.ListDue(ctx, time.Now().UnixMilli(), limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due deletions: %w", err)
+	}
+	for i, deletion := range deletions {
+		if ctx.Err() != nil {
+			return i, ctx.Err()
+		}
+		s.execute(ctx, executor, deletion)
+	}
+	return len(deletions), nil
+}
+
+func (s *Service) execute(ctx context.Context, executor Executor, deletion *types.ScheduledDeletion) {
+	// the deletion is claimed first, so it can't be canceled anymore once it's executed.
+	if err := s.moveTo(ctx, deletion, types.ScheduledDeletionStateRunning, "",
+		types.ScheduledDeletionStateScheduled); err != nil {
+		if !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to claim scheduled deletion %d", deletion.ID)
+		}
+		return
+	}
+
+	err := s.executeAs(ctx, executor, deletion)
+	state, message := types.ScheduledDeletionStateExecuted, ""
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to execute scheduled deletion %d of image %s",
+			deletion.ID, deletion.ImageName)
+		state, message = types.ScheduledDeletionStateFailed, err.Error()
+	}
+	if err = s.moveTo(ctx, deletion, state, message, types.ScheduledDeletionStateRunning); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to complete scheduled deletion %d", deletion.ID)
+	}
+}
+
+func (s *Service) executeAs(ctx context.Context, executor Executor, deletion *types.ScheduledDeletion) error {
+	principal, err := s.principalStore.Find(ctx, deletion.CreatedBy)
+	if err != nil {
+		return fmt.Errorf("failed to find principal %d who scheduled the deletion: %w", deletion.CreatedBy, err)
+	}
+	return executor.ExecuteScheduledDeletion(ctx, principal, deletion)
+}
+
+func (s *Service) moveTo(
+	ctx context.Context,
+	deletion *types.ScheduledDeletion,
+	state types.ScheduledDeletionState,
+	message string,
+	from types.ScheduledDeletionState,
+) error {
+	moved := *deletion
+	moved.State = state
+	moved.Error = message
+	moved.UpdatedAt = time.Now()
+	if err := s.deletionDao.UpdateState(ctx, &moved, from); err != nil {
+		return err
+	}
+	*deletion = moved
+	return nil
+}
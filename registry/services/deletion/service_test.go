@@ -0,0 +1,197 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletion
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	coretypes "github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePrincipalStore struct {
+	store.PrincipalStore
+}
+
+func (fakePrincipalStore) Find(_ context.Context, id int64) (*coretypes.Principal, error) {
+	return &coretypes.Principal{ID: id}, nil
+}
+
+type fakeExecutor struct {
+	executed []int64
+	err      error
+}
+
+func (f *fakeExecutor) ExecuteScheduledDeletion(
+	_ context.Context, principal *coretypes.Principal, deletion *types.ScheduledDeletion,
+) error {
+	if principal.ID != deletion.CreatedBy {
+		return errors.New("deletion should be executed on behalf of the principal who scheduled it")
+	}
+	f.executed = append(f.executed, deletion.ID)
+	return f.err
+}
+
+func TestSchedule(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockScheduledDeletionRepository(t)
+	s := NewService(dao, fakePrincipalStore{}, 24*time.Hour, 48*time.Hour)
+
+	dao.EXPECT().FindScheduled(ctx, int64(1), "img", "1.0.0").Return(nil, gitnessstore.ErrResourceNotFound).Once()
+	dao.EXPECT().Create(ctx, mock.AnythingOfType("*types.ScheduledDeletion")).
+		Run(func(_ context.Context, deletion *types.ScheduledDeletion) { deletion.ID = 1 }).Return(nil).Once()
+
+	deletion, created, err := s.Schedule(ctx, 1, "img", "1.0.0", 7, nil)
+	require.NoError(t, err)
+	require.True(t, created)
+	require.Equal(t, int64(1), deletion.ID)
+	require.Equal(t, types.ScheduledDeletionStateScheduled, deletion.State)
+	require.Equal(t, int64(7), deletion.CreatedBy)
+	require.WithinDuration(t, time.Now().Add(24*time.Hour), deletion.ExecuteAt, time.Minute)
+
+	dao.EXPECT().FindScheduled(ctx, int64(1), "img", "1.0.0").Return(deletion, nil).Once()
+	delay := time.Hour
+	again, created, err := s.Schedule(ctx, 1, "img", "1.0.0", 8, &delay)
+	require.NoError(t, err)
+	require.False(t, created, "a deletion which is already scheduled should be returned")
+	require.Equal(t, deletion, again)
+
+	for _, invalid := range []time.Duration{0, -time.Hour, 72 * time.Hour} {
+		_, _, err = s.Schedule(ctx, 1, "img", "", 7, &invalid)
+		require.ErrorIs(t, err, ErrInvalidDelay, "delay %s", invalid)
+	}
+}
+
+func TestScheduleConcurrently(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockScheduledDeletionRepository(t)
+	s := NewService(dao, fakePrincipalStore{}, time.Hour, time.Hour)
+
+	existing := &types.ScheduledDeletion{ID: 3, RegistryID: 1, ImageName: "img"}
+	dao.EXPECT().FindScheduled(ctx, int64(1), "img", "").Return(nil, gitnessstore.ErrResourceNotFound).Once()
+	dao.EXPECT().Create(ctx, mock.Anything).Return(gitnessstore.ErrDuplicate).Once()
+	dao.EXPECT().FindScheduled(ctx, int64(1), "img", "").Return(existing, nil).Once()
+
+	deletion, created, err := s.Schedule(ctx, 1, "img", "", 7, nil)
+	require.NoError(t, err)
+	require.False(t, created, "the deletion scheduled concurrently should be returned")
+	require.Equal(t, existing, deletion)
+}
+
+func TestFind(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockScheduledDeletionRepository(t)
+	s := NewService(dao, fakePrincipalStore{}, time.Hour, time.Hour)
+
+	dao.EXPECT().Find(ctx, int64(3)).Return(&types.ScheduledDeletion{ID: 3, RegistryID: 1}, nil)
+
+	deletion, err := s.Find(ctx, 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), deletion.ID)
+
+	_, err = s.Find(ctx, 2, 3)
+	require.ErrorIs(t, err, gitnessstore.ErrResourceNotFound, "deletions of other registries shouldn't be found")
+}
+
+func TestCancel(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockScheduledDeletionRepository(t)
+	s := NewService(dao, fakePrincipalStore{}, time.Hour, time.Hour)
+
+	deletion := &types.ScheduledDeletion{ID: 3, RegistryID: 1, State: types.ScheduledDeletionStateScheduled}
+	dao.EXPECT().UpdateState(ctx, mock.MatchedBy(func(d *types.ScheduledDeletion) bool {
+		return d.ID == 3 && d.State == types.ScheduledDeletionStateCanceled && *d.CanceledBy == 8
+	}), types.ScheduledDeletionStateScheduled).Return(nil).Once()
+
+	require.NoError(t, s.Cancel(ctx, deletion, 8))
+	require.Equal(t, types.ScheduledDeletionStateCanceled, deletion.State)
+	require.Equal(t, int64(8), *deletion.CanceledBy)
+	require.ErrorIs(t, s.Cancel(ctx, deletion, 8), ErrNotScheduled)
+
+	// the deletion was claimed by the job or canceled by someone else meanwhile.
+	claimed := &types.ScheduledDeletion{ID: 4, RegistryID: 1, State: types.ScheduledDeletionStateScheduled}
+	dao.EXPECT().UpdateState(ctx, mock.Anything, types.ScheduledDeletionStateScheduled).
+		Return(gitnessstore.ErrResourceNotFound).Once()
+	require.ErrorIs(t, s.Cancel(ctx, claimed, 8), ErrNotScheduled)
+	require.Equal(t, types.ScheduledDeletionStateScheduled, claimed.State)
+}
+
+func TestExecuteDue(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockScheduledDeletionRepository(t)
+	s := NewService(dao, fakePrincipalStore{}, time.Hour, time.Hour)
+
+	_, err := s.ExecuteDue(ctx, 10)
+	require.Error(t, err, "due deletions shouldn't be claimed without an executor")
+
+	executor := &fakeExecutor{}
+	s.Register(executor)
+
+	due := &types.ScheduledDeletion{ID: 1, ImageName: "img", CreatedBy: 7,
+		State: types.ScheduledDeletionStateScheduled}
+	canceled := &types.ScheduledDeletion{ID: 2, ImageName: "img", CreatedBy: 7,
+		State: types.ScheduledDeletionStateScheduled}
+	dao.EXPECT().ListDue(ctx, mock.AnythingOfType("int64"), 10).
+		Return([]*types.ScheduledDeletion{due, canceled}, nil).Once()
+	var states []types.ScheduledDeletionState
+	dao.EXPECT().UpdateState(ctx, mock.MatchedBy(func(d *types.ScheduledDeletion) bool { return d.ID == 1 }),
+		mock.Anything).
+		Run(func(_ context.Context, d *types.ScheduledDeletion, _ types.ScheduledDeletionState) {
+			states = append(states, d.State)
+		}).Return(nil).Twice()
+	// the deletion was canceled after it was listed, it can't be claimed.
+	dao.EXPECT().UpdateState(ctx, mock.MatchedBy(func(d *types.ScheduledDeletion) bool { return d.ID == 2 }),
+		types.ScheduledDeletionStateScheduled).Return(gitnessstore.ErrResourceNotFound).Once()
+
+	executed, err := s.ExecuteDue(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, executed)
+	require.Equal(t, []int64{due.ID}, executor.executed)
+	require.Equal(t, []types.ScheduledDeletionState{
+		types.ScheduledDeletionStateRunning, types.ScheduledDeletionStateExecuted,
+	}, states)
+	require.Equal(t, types.ScheduledDeletionStateExecuted, due.State)
+	require.Equal(t, types.ScheduledDeletionStateScheduled, canceled.State)
+}
+
+func TestExecuteDueFailure(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockScheduledDeletionRepository(t)
+	s := NewService(dao, fakePrincipalStore{}, time.Hour, time.Hour)
+	s.Register(&fakeExecutor{err: errors.New("version not found")})
+
+	deletion := &types.ScheduledDeletion{ID: 1, ImageName: "img", CreatedBy: 7,
+		State: types.ScheduledDeletionStateScheduled}
+	dao.EXPECT().ListDue(ctx, mock.AnythingOfType("int64"), 10).
+		Return([]*types.ScheduledDeletion{deletion}, nil).Once()
+	dao.EXPECT().UpdateState(ctx, mock.Anything, types.ScheduledDeletionStateScheduled).Return(nil).Once()
+	dao.EXPECT().UpdateState(ctx, mock.MatchedBy(func(d *types.ScheduledDeletion) bool {
+		return d.State == types.ScheduledDeletionStateFailed && d.Error == "version not found"
+	}), types.ScheduledDeletionStateRunning).Return(nil).Once()
+
+	_, err := s.ExecuteDue(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, types.ScheduledDeletionStateFailed, deletion.State)
+	require.Equal(t, "version not found", deletion.Error)
+}
@@ -0,0 +1,206 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletionapproval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/services/registrypolicy"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// maxListed is the number of requests returned by List.
+const maxListed = 100
+
+var (
+	// ErrNotPending is returned when a request which was already reviewed or expired is reviewed.
+	ErrNotPending = errors.New("deletion request is not pending")
+	// ErrSelfApproval is returned when the user who requested a deletion tries to approve it.
+	ErrSelfApproval = errors.New("deletion request can't be approved by the user who requested it")
+)
+
+// Service keeps the requests to delete artifacts of deletion protected registries whose policy asks for the
+// approval of a second user. The artifact is deleted by the caller once its request is approved.
+type Service struct {
+	requestDao store.DeletionRequestRepository
+	policies   *registrypolicy.Service
+	ttl        time.Duration
+}
+
+func NewService(
+	requestDao store.DeletionRequestRepository,
+	policies *registrypolicy.Service,
+	ttl time.Duration,
+) *Service {
+	return &Service{
+		requestDao: requestDao,
+		policies:   policies,
+		ttl:        ttl,
+	}
+}
+
+// Required returns true if deletes of artifacts of the registry need an approval. It only applies to
+// registries which are protected from deletes, the others delete right away.
+func (s *Service) Required(ctx context.Context, registry *types.Registry) (bool, error) {
+	if s == nil || !registry.IsDeletionProtected() {
+		return false, nil
+	}
+	effective, err := s.policies.Resolve(ctx, registry)
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve policy of registry %d: %w", registry.ID, err)
+	}
+	return effective.Policy.DeletionApproval != nil && *effective.Policy.DeletionApproval, nil
+}
+
+// Request returns the pending request to delete the version of the image, it's created if there isn't one.
+// The version is empty to delete the whole image. created is false if the request already existed.
+func (s *Service) Request(
+	ctx context.Context,
+	registryID int64,
+	imageName string,
+	version string,
+	principalID int64,
+) (request *types.DeletionRequest, created bool, err error) {
+	now := time.Now()
+	// an expired request would block a new one until it's marked as expired.
+	if _, err = s.requestDao.ExpireStale(ctx, registryID, now.UnixMilli()); err != nil {
+		return nil, false, fmt.Errorf("failed to expire deletion requests: %w", err)
+	}
+
+	request, err = s.requestDao.FindPending(ctx, registryID, imageName, version)
+	if err == nil {
+		return request, false, nil
+	}
+	if !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, false, fmt.Errorf("failed to find pending deletion request: %w", err)
+	}
+
+	request = &types.DeletionRequest{
+		RegistryID:  registryID,
+		ImageName:   imageName,
+		Version:     version,
+		State:       types.DeletionRequestStatePending,
+		RequestedBy: principalID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		ExpiresAt:   now.Add(s.ttl),
+	}
+	err = s.requestDao.Create(ctx, request)
+	if errors.Is(err, gitnessstore.ErrDuplicate) {
+		// the same deletion was requested concurrently.
+		request, err = s.requestDao.FindPending(ctx, registryID, imageName, version)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to find pending deletion request: %w", err)
+		}
+		return request, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create deletion request: %w", err)
+	}
+	return request, true, nil
+}
+
+// Find returns the request of the registry, a pending request which expired is returned as expired.
+func (s *Service) Find(ctx context.Context, registryID int64, id int64) (*types.DeletionRequest, error) {
+	request, err := s.requestDao.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.RegistryID != registryID {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	if request.IsExpired(time.Now()) {
+		request.State = types.DeletionRequestStateExpired
+	}
+	return request, nil
+}
+
+// List returns the latest requests of the registry, newest first. All states are listed if state is nil.
+func (s *Service) List(
+	ctx context.Context,
+	registryID int64,
+	state *types.DeletionRequestState,
+) ([]*types.DeletionRequest, error) {
+	if _, err := s.requestDao.ExpireStale(ctx, registryID, time.Now().UnixMilli()); err != nil {
+		return nil, fmt.Errorf("failed to expire deletion requests: %w", err)
+	}
+	return s.requestDao.List(ctx, registryID, state, maxListed)
+}
+
+// Approve marks the pending request as approved by the reviewer, the caller deletes the artifact after it and
+// calls Revert if the delete fails. Only one of concurrent reviews of a request succeeds.
+func (s *Service) Approve(ctx context.Context, request *types.DeletionRequest, reviewerID int64) error {
+	if reviewerID == request.RequestedBy {
+		return ErrSelfApproval
+	}
+	return s.review(ctx, request, types.DeletionRequestStateApproved, reviewerID, "")
+}
+
+// Reject marks the pending request as rejected by the reviewer, it's withdrawn if the reviewer is the user who
+// requested it.
+func (s *Service) Reject(
+	ctx context.Context,
+	request *types.DeletionRequest,
+	reviewerID int64,
+	comment string,
+) error {
+	return s.review(ctx, request, types.DeletionRequestStateRejected, reviewerID, comment)
+}
+
+// Revert moves an approved request back to pending, when the delete it was approved for failed.
+func (s *Service) Revert(ctx context.Context, request *types.DeletionRequest) error {
+	reverted := *request
+	reverted.State = types.DeletionRequestStatePending
+	reverted.ReviewedBy = nil
+	reverted.UpdatedAt = time.Now()
+	if err := s.requestDao.UpdateState(ctx, &reverted, types.DeletionRequestStateApproved); err != nil {
+		return fmt.Errorf("failed to revert deletion request %d: %w", request.ID, err)
+	}
+	*request = reverted
+	return nil
+}
+
+func (s *Service) review(
+	ctx context.Context,
+	request *types.DeletionRequest,
+	state types.DeletionRequestState,
+	reviewerID int64,
+	comment string,
+) error {
+	now := time.Now()
+	if request.State != types.DeletionRequestStatePending || request.IsExpired(now) {
+		return ErrNotPending
+	}
+
+	reviewed := *request
+	reviewed.State = state
+	reviewed.ReviewedBy = &reviewerID
+	reviewed.Comment = comment
+	reviewed.UpdatedAt = now
+	err := s.requestDao.UpdateState(ctx, &reviewed, types.DeletionRequestStatePending)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return ErrNotPending
+	}
+	if err != nil {
+		return fmt.Errorf("failed to review deletion request %d: %w", request.ID, err)
+	}
+	*request = reviewed
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletionapproval
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequest(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockDeletionRequestRepository(t)
+	s := NewService(dao, nil, time.Hour)
+
+	dao.EXPECT().ExpireStale(ctx, int64(1), mock.AnythingOfType("int64")).Return(0, nil)
+	dao.EXPECT().FindPending(ctx, int64(1), "img", "1.0.0").Return(nil, gitnessstore.ErrResourceNotFound).Once()
+	dao.EXPECT().Create(ctx, mock.AnythingOfType("*types.DeletionRequest")).
+		Run(func(_ context.Context, request *types.DeletionRequest) { request.ID = 1 }).Return(nil).Once()
+
+	first, created, err := s.Request(ctx, 1, "img", "1.0.0", 7)
+	require.NoError(t, err)
+	require.True(t, created)
+	require.Equal(t, int64(1), first.ID)
+	require.Equal(t, types.DeletionRequestStatePending, first.State)
+	require.Equal(t, int64(7), first.RequestedBy)
+	require.WithinDuration(t, time.Now().Add(time.Hour), first.ExpiresAt, time.Minute)
+
+	dao.EXPECT().FindPending(ctx, int64(1), "img", "1.0.0").Return(first, nil).Once()
+	again, created, err := s.Request(ctx, 1, "img", "1.0.0", 8)
+	require.NoError(t, err)
+	require.False(t, created, "a pending request of the same version should be returned")
+	require.Equal(t, first, again)
+}
+
+func TestRequestConcurrently(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockDeletionRequestRepository(t)
+	s := NewService(dao, nil, time.Hour)
+
+	existing := &types.DeletionRequest{ID: 3, RegistryID: 1, ImageName: "img"}
+	dao.EXPECT().ExpireStale(ctx, int64(1), mock.AnythingOfType("int64")).Return(1, nil).Once()
+	dao.EXPECT().FindPending(ctx, int64(1), "img", "").Return(nil, gitnessstore.ErrResourceNotFound).Once()
+	dao.EXPECT().Create(ctx, mock.Anything).Return(gitnessstore.ErrDuplicate).Once()
+	dao.EXPECT().FindPending(ctx, int64(1), "img", "").Return(existing, nil).Once()
+
+	request, created, err := s.Request(ctx, 1, "img", "", 7)
+	require.NoError(t, err)
+	require.False(t, created, "the request created concurrently should be returned")
+	require.Equal(t, existing, request)
+}
+
+func TestReview(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockDeletionRequestRepository(t)
+	s := NewService(dao, nil, time.Hour)
+
+	request := &types.DeletionRequest{ID: 1, RegistryID: 1, State: types.DeletionRequestStatePending,
+		RequestedBy: 7, ExpiresAt: time.Now().Add(time.Hour)}
+	require.ErrorIs(t, s.Approve(ctx, request, 7), ErrSelfApproval)
+
+	dao.EXPECT().UpdateState(ctx, mock.MatchedBy(func(r *types.DeletionRequest) bool {
+		return r.State == types.DeletionRequestStateApproved && *r.ReviewedBy == 8
+	}), types.DeletionRequestStatePending).Return(nil).Once()
+	require.NoError(t, s.Approve(ctx, request, 8))
+	require.Equal(t, types.DeletionRequestStateApproved, request.State)
+	require.Equal(t, int64(8), *request.ReviewedBy)
+	require.ErrorIs(t, s.Reject(ctx, request, 9, ""), ErrNotPending)
+
+	dao.EXPECT().UpdateState(ctx, mock.MatchedBy(func(r *types.DeletionRequest) bool {
+		return r.State == types.DeletionRequestStatePending && r.ReviewedBy == nil
+	}), types.DeletionRequestStateApproved).Return(nil).Once()
+	require.NoError(t, s.Revert(ctx, request))
+	require.Equal(t, types.DeletionRequestStatePending, request.State)
+	require.Nil(t, request.ReviewedBy)
+
+	dao.EXPECT().UpdateState(ctx, mock.MatchedBy(func(r *types.DeletionRequest) bool {
+		return r.State == types.DeletionRequestStateRejected && r.Comment == "not needed anymore"
+	}), types.DeletionRequestStatePending).Return(nil).Once()
+	require.NoError(t, s.Reject(ctx, request, 7, "not needed anymore"))
+	require.Equal(t, types.DeletionRequestStateRejected, request.State)
+}
+
+func TestReviewConcurrently(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockDeletionRequestRepository(t)
+	s := NewService(dao, nil, time.Hour)
+
+	request := &types.DeletionRequest{ID: 1, RegistryID: 1, State: types.DeletionRequestStatePending,
+		RequestedBy: 7, ExpiresAt: time.Now().Add(time.Hour)}
+	dao.EXPECT().UpdateState(ctx, mock.Anything, types.DeletionRequestStatePending).
+		Return(gitnessstore.ErrResourceNotFound).Once()
+
+	require.ErrorIs(t, s.Approve(ctx, request, 8), ErrNotPending, "only one of concurrent reviews should succeed")
+	require.Equal(t, types.DeletionRequestStatePending, request.State)
+}
+
+func TestFind(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockDeletionRequestRepository(t)
+	s := NewService(dao, nil, time.Hour)
+
+	dao.EXPECT().Find(ctx, int64(1)).Return(&types.DeletionRequest{ID: 1, RegistryID: 1,
+		State: types.DeletionRequestStatePending, ExpiresAt: time.Now().Add(-time.Minute)}, nil)
+
+	expired, err := s.Find(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.DeletionRequestStateExpired, expired.State)
+	require.ErrorIs(t, s.Approve(ctx, expired, 8), ErrNotPending)
+
+	_, err = s.Find(ctx, 2, 1)
+	require.ErrorIs(t, err, gitnessstore.ErrResourceNotFound, "requests of other registries shouldn't be found")
+}
+
+func TestRequired(t *testing.T) {
+	s := NewService(mocks.NewMockDeletionRequestRepository(t), nil, time.Hour)
+
+	required, err := s.Required(context.Background(), &types.Registry{ID: 1})
+	require.NoError(t, err)
+	require.False(t, required, "deletes of registries without deletion protection shouldn't need an approval")
+
+	var disabled *Service
+	required, err = disabled.Required(context.Background(), &types.Registry{ID: 1})
+	require.NoError(t, err)
+	require.False(t, required)
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package denylist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/registry/app/api/interfaces"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/services/refcache"
+
+	"github.com/rs/zerolog/log"
+)
+
+var _ interfaces.DependencyFirewallChecker = (*FirewallChecker)(nil)
+
+// FirewallChecker blocks the proxy pulls of packages denied by the denylists of the spaces of the registry.
+type FirewallChecker struct {
+	service        *Service
+	registryFinder refcache.RegistryFinder
+}
+
+func NewFirewallChecker(service *Service, registryFinder refcache.RegistryFinder) *FirewallChecker {
+	return &FirewallChecker{
+		service:        service,
+		registryFinder: registryFinder,
+	}
+}
+
+func (c *FirewallChecker) CheckPolicyViolation(
+	ctx context.Context,
+	registryID int64,
+	image string,
+	version string,
+	_ *artifact.ArtifactType,
+) error {
+	registry, err := c.registryFinder.FindByID(ctx, registryID)
+	if err != nil {
+		return fmt.Errorf("failed to find registry %d: %w", registryID, err)
+	}
+	entry, err := c.service.Find(ctx, registry, image, version)
+	if err != nil {
+		return fmt.Errorf("failed to check the denylist: %w", err)
+	}
+	if entry == nil {
+		return nil
+	}
+	log.Ctx(ctx).Info().Msgf("blocked pull of package [%s] version [%s] from registry [%s], it's denied by "+
+		"denylist entry %d: %s", image, version, registry.Name, entry.ID, entry.Reason)
+	return usererror.ErrArtifactBlocked
+}
@@ -0,0 +1,175 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package denylist
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+)
+
+// ecosystems maps the OSV ecosystems to the package types of their packages.
+var ecosystems = map[string]artifact.PackageType{
+	"npm":       artifact.PackageTypeNPM,
+	"PyPI":      artifact.PackageTypePYTHON,
+	"Maven":     artifact.PackageTypeMAVEN,
+	"NuGet":     artifact.PackageTypeNUGET,
+	"crates.io": artifact.PackageTypeCARGO,
+	"Go":        artifact.PackageTypeGO,
+}
+
+// Advisory is the part of an OSV advisory (https://ossf.github.io/osv-schema/) which is imported, like the
+// advisories of malicious packages published by the OpenSSF.
+type Advisory struct {
+	ID       string
+	Summary  string
+	Affected []AffectedPackage
+}
+
+// AffectedPackage is a package affected by an advisory, it's affected in all its versions if neither versions
+// nor ranges are listed.
+type AffectedPackage struct {
+	Ecosystem string
+	Name      string
+	Versions  []string
+	Ranges    []AffectedRange
+}
+
+// AffectedRange is a range of affected versions, described by the events which introduce and fix the issue.
+type AffectedRange struct {
+	Type   string
+	Events []RangeEvent
+}
+
+type RangeEvent struct {
+	Introduced   string
+	Fixed        string
+	LastAffected string
+}
+
+// EntriesFromAdvisory returns the denylist entries of the packages affected by the advisory, along with the number
+// of affected packages which can't be denied: their ecosystem isn't supported or only git ranges are listed.
+func EntriesFromAdvisory(advisory Advisory) ([]*types.PackageDenylistEntry, int) {
+	reason := advisory.Summary
+	if reason == "" {
+		reason = fmt.Sprintf("Affected by advisory %s", advisory.ID)
+	}
+
+	var entries []*types.PackageDenylistEntry
+	unsupported := 0
+	for _, affected := range advisory.Affected {
+		packageType, ok := ecosystems[affected.Ecosystem]
+		if !ok || affected.Name == "" {
+			unsupported++
+			continue
+		}
+		versionRanges, ok := versionRanges(affected)
+		if !ok {
+			unsupported++
+			continue
+		}
+		for _, versionRange := range versionRanges {
+			entries = append(entries, &types.PackageDenylistEntry{
+				PackageType:  string(packageType),
+				Name:         affected.Name,
+				VersionRange: versionRange,
+				Reason:       reason,
+				Source:       types.PackageDenylistSourceOSV,
+				ExternalID:   advisory.ID,
+			})
+		}
+	}
+	return entries, unsupported
+}
+
+// versionRanges converts the affected versions of the package to the version ranges of entries. A single empty
+// range is returned if all versions are affected, false is returned if the affected versions can't be described.
+func versionRanges(affected AffectedPackage) ([]string, bool) {
+	if len(affected.Versions) == 0 && len(affected.Ranges) == 0 {
+		return []string{""}, true
+	}
+
+	var ranges []string
+	seen := map[string]bool{}
+	add := func(versionRange string) {
+		if !seen[versionRange] {
+			seen[versionRange] = true
+			ranges = append(ranges, versionRange)
+		}
+	}
+	for _, r := range affected.Ranges {
+		// git ranges are described by commits, they can't be matched to versions.
+		if r.Type != "SEMVER" && r.Type != "ECOSYSTEM" {
+			continue
+		}
+		for _, versionRange := range rangeConstraints(r.Events) {
+			if versionRange == "" {
+				return []string{""}, true
+			}
+			add(versionRange)
+		}
+	}
+	for _, version := range affected.Versions {
+		add(version)
+	}
+	return ranges, len(ranges) > 0
+}
+
+// rangeConstraints converts the events of a range to semver constraints, one for each introduced version. An empty
+// constraint is returned for a range which is introduced in the first version and never fixed.
+func rangeConstraints(events []RangeEvent) []string {
+	var constraints []string
+	lower := ""
+	open := false
+	for _, event := range events {
+		switch {
+		case event.Introduced != "":
+			if open {
+				constraints = append(constraints, lowerBound(lower))
+			}
+			lower = event.Introduced
+			open = true
+		case event.Fixed != "" && open:
+			constraints = append(constraints, joinBounds(lowerBound(lower), "< "+event.Fixed))
+			open = false
+		case event.LastAffected != "" && open:
+			constraints = append(constraints, joinBounds(lowerBound(lower), "<= "+event.LastAffected))
+			open = false
+		}
+	}
+	if open {
+		constraints = append(constraints, lowerBound(lower))
+	}
+	return constraints
+}
+
+func lowerBound(introduced string) string {
+	if introduced == "0" {
+		return ""
+	}
+	return ">= " + introduced
+}
+
+func joinBounds(bounds ...string) string {
+	var nonEmpty []string
+	for _, bound := range bounds {
+		if bound != "" {
+			nonEmpty = append(nonEmpty, bound)
+		}
+	}
+	return strings.Join(nonEmpty, ", ")
+}
@@ -0,0 +1,338 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package denylist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/cache"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrystore "github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// entriesCacheDuration is how long the entries which apply to a package in a registry are cached for, changes of
+// the denylists of spaces apply to uploads and pulls once it expires.
+const entriesCacheDuration = time.Minute
+
+var (
+	// ErrInvalidEntry is returned when an entry without a name or with an unknown package type is created.
+	ErrInvalidEntry = errors.New("invalid denylist entry")
+	// ErrOverrideReasonRequired is returned when an entry is overridden without a reason.
+	ErrOverrideReasonRequired = errors.New("a reason is required to override a denylist entry")
+)
+
+// packageKey identifies the package of a registry whose applying entries are cached.
+type packageKey struct {
+	RegistryID  int64
+	ParentID    int64
+	PackageType string
+	Name        string
+}
+
+// Service keeps the denylists of spaces, their entries deny the uploads and proxy pulls of packages in all
+// registries of the space and of its subspaces, unless a registry overrides them.
+type Service struct {
+	entryDao    registrystore.PackageDenylistEntryRepository
+	overrideDao registrystore.PackageDenylistOverrideRepository
+	spaceFinder refcache.SpaceFinder
+	entries     *cache.TTLCache[packageKey, []*types.PackageDenylistEntry]
+}
+
+func NewService(
+	entryDao registrystore.PackageDenylistEntryRepository,
+	overrideDao registrystore.PackageDenylistOverrideRepository,
+	spaceFinder refcache.SpaceFinder,
+) *Service {
+	s := &Service{
+		entryDao:    entryDao,
+		overrideDao: overrideDao,
+		spaceFinder: spaceFinder,
+	}
+	s.entries = cache.New[packageKey, []*types.PackageDenylistEntry](entriesGetter{service: s}, entriesCacheDuration)
+	return s
+}
+
+// Find returns the entry which denies the version of the package in the registry, or nil if none does.
+func (s *Service) Find(
+	ctx context.Context,
+	registry *types.Registry,
+	name string,
+	version string,
+) (*types.PackageDenylistEntry, error) {
+	entries, err := s.entries.Get(ctx, newPackageKey(registry, name))
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.Matches(version) {
+			return entry, nil
+		}
+	}
+	return nil, nil
+}
+
+// CheckUpload returns a forbidden error if the version of the package is denied in the registry.
+func (s *Service) CheckUpload(ctx context.Context, registry *types.Registry, name string, version string) error {
+	entry, err := s.Find(ctx, registry, name, version)
+	if err != nil {
+		return fmt.Errorf("failed to check the denylist: %w", err)
+	}
+	if entry == nil {
+		return nil
+	}
+	return usererror.Forbidden(fmt.Sprintf("Package %s version %s is denied by the denylist: %s",
+		name, version, entry.Reason))
+}
+
+// Create adds the entry to the denylist of its space. It fails with store.ErrDuplicate if the space already
+// denies the version range of the package.
+func (s *Service) Create(ctx context.Context, entry *types.PackageDenylistEntry) error {
+	entry.Name = strings.ToLower(strings.TrimSpace(entry.Name))
+	entry.VersionRange = strings.TrimSpace(entry.VersionRange)
+	if entry.Name == "" {
+		return fmt.Errorf("%w: the package name is required", ErrInvalidEntry)
+	}
+	if entry.PackageType != "" && !isPackageType(entry.PackageType) {
+		return fmt.Errorf("%w: unknown package type %s", ErrInvalidEntry, entry.PackageType)
+	}
+	if entry.Source == "" {
+		entry.Source = types.PackageDenylistSourceManual
+	}
+	entry.CreatedAt = time.Now()
+	return s.entryDao.Create(ctx, entry)
+}
+
+// Import adds the entries of the OSV advisories to the denylist of the space. Entries the space already has are
+// skipped, as are the packages of ecosystems without a matching package type.
+func (s *Service) Import(
+	ctx context.Context,
+	spaceID int64,
+	principalID int64,
+	advisories []Advisory,
+) (imported int, skipped int, err error) {
+	for _, advisory := range advisories {
+		entries, unsupported := EntriesFromAdvisory(advisory)
+		skipped += unsupported
+		for _, entry := range entries {
+			entry.SpaceID = spaceID
+			entry.CreatedBy = principalID
+			err = s.Create(ctx, entry)
+			if errors.Is(err, gitnessstore.ErrDuplicate) {
+				skipped++
+				continue
+			}
+			if err != nil {
+				return imported, skipped, fmt.Errorf("failed to import advisory %s: %w", advisory.ID, err)
+			}
+			imported++
+		}
+	}
+	return imported, skipped, nil
+}
+
+// FindEntry returns the entry of the denylist of the space.
+func (s *Service) FindEntry(ctx context.Context, spaceID int64, id int64) (*types.PackageDenylistEntry, error) {
+	entry, err := s.entryDao.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entry.SpaceID != spaceID {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return entry, nil
+}
+
+// List lists the entries of the denylist of the space, along with their total count.
+func (s *Service) List(
+	ctx context.Context,
+	spaceID int64,
+	search string,
+	limit int,
+	offset int,
+) ([]*types.PackageDenylistEntry, int64, error) {
+	entries, err := s.entryDao.List(ctx, spaceID, search, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list denylist entries: %w", err)
+	}
+	count, err := s.entryDao.Count(ctx, spaceID, search)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count denylist entries: %w", err)
+	}
+	return entries, count, nil
+}
+
+// Delete removes the entry from the denylist of the space, along with its overrides.
+func (s *Service) Delete(ctx context.Context, spaceID int64, id int64) (*types.PackageDenylistEntry, error) {
+	entry, err := s.FindEntry(ctx, spaceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.entryDao.Delete(ctx, entry.ID); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// Override exempts the registry from an entry of the denylist of one of the spaces it's in.
+func (s *Service) Override(
+	ctx context.Context,
+	registry *types.Registry,
+	entryID int64,
+	principalID int64,
+	reason string,
+) (*types.PackageDenylistOverride, *types.PackageDenylistEntry, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, nil, ErrOverrideReasonRequired
+	}
+	entry, err := s.findApplyingEntry(ctx, registry, entryID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	override := &types.PackageDenylistOverride{
+		EntryID:    entry.ID,
+		RegistryID: registry.ID,
+		Reason:     reason,
+		CreatedBy:  principalID,
+		CreatedAt:  time.Now(),
+	}
+	if err = s.overrideDao.Create(ctx, override); err != nil {
+		return nil, nil, err
+	}
+	s.entries.Evict(ctx, newPackageKey(registry, entry.Name))
+	return override, entry, nil
+}
+
+// RemoveOverride removes the exemption of the registry from the entry, the entry denies the package again.
+func (s *Service) RemoveOverride(
+	ctx context.Context,
+	registry *types.Registry,
+	entryID int64,
+) (*types.PackageDenylistOverride, *types.PackageDenylistEntry, error) {
+	entry, err := s.findApplyingEntry(ctx, registry, entryID)
+	if err != nil {
+		return nil, nil, err
+	}
+	override, err := s.overrideDao.Find(ctx, entry.ID, registry.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = s.overrideDao.Delete(ctx, entry.ID, registry.ID); err != nil {
+		return nil, nil, err
+	}
+	s.entries.Evict(ctx, newPackageKey(registry, entry.Name))
+	return override, entry, nil
+}
+
+// ListOverrides lists the overrides of the registry, newest first, along with the entries they override by ID.
+func (s *Service) ListOverrides(
+	ctx context.Context,
+	registryID int64,
+) ([]*types.PackageDenylistOverride, map[int64]*types.PackageDenylistEntry, error) {
+	overrides, err := s.overrideDao.List(ctx, registryID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list denylist overrides: %w", err)
+	}
+	entries := make(map[int64]*types.PackageDenylistEntry, len(overrides))
+	for _, override := range overrides {
+		entry, err := s.entryDao.Find(ctx, override.EntryID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to find denylist entry %d: %w", override.EntryID, err)
+		}
+		entries[entry.ID] = entry
+	}
+	return overrides, entries, nil
+}
+
+// findApplyingEntry returns the entry if it's on the denylist of one of the spaces the registry is in.
+func (s *Service) findApplyingEntry(
+	ctx context.Context,
+	registry *types.Registry,
+	entryID int64,
+) (*types.PackageDenylistEntry, error) {
+	entry, err := s.entryDao.Find(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	spaceIDs, err := s.spaceIDs(ctx, registry.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	for _, spaceID := range spaceIDs {
+		if spaceID == entry.SpaceID {
+			return entry, nil
+		}
+	}
+	return nil, gitnessstore.ErrResourceNotFound
+}
+
+// spaceIDs returns the IDs of the space and of all spaces above it.
+func (s *Service) spaceIDs(ctx context.Context, spaceID int64) ([]int64, error) {
+	var spaceIDs []int64
+	for spaceID > 0 {
+		space, err := s.spaceFinder.FindByID(ctx, spaceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find space %d: %w", spaceID, err)
+		}
+		spaceIDs = append(spaceIDs, space.ID)
+		spaceID = space.ParentID
+	}
+	return spaceIDs, nil
+}
+
+func isPackageType(packageType string) bool {
+	switch artifact.PackageType(packageType) {
+	case artifact.PackageTypeCARGO, artifact.PackageTypeDOCKER, artifact.PackageTypeGENERIC, artifact.PackageTypeGO,
+		artifact.PackageTypeHELM, artifact.PackageTypeHUGGINGFACE, artifact.PackageTypeMAVEN, artifact.PackageTypeNPM,
+		artifact.PackageTypeNUGET, artifact.PackageTypePYTHON, artifact.PackageTypeRPM:
+		return true
+	default:
+		return false
+	}
+}
+
+func newPackageKey(registry *types.Registry, name string) packageKey {
+	return packageKey{
+		RegistryID:  registry.ID,
+		ParentID:    registry.ParentID,
+		PackageType: string(registry.PackageType),
+		Name:        strings.ToLower(name),
+	}
+}
+
+type entriesGetter struct {
+	service *Service
+}
+
+func (g entriesGetter) Find(ctx context.Context, key packageKey) ([]*types.PackageDenylistEntry, error) {
+	spaceIDs, err := g.service.spaceIDs(ctx, key.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := g.service.entryDao.ListForPackage(ctx, spaceIDs, key.RegistryID, key.PackageType, key.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the denylist entries of package %s: %w", key.Name, err)
+	}
+	return entries, nil
+}
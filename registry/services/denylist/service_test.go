@@ -0,0 +1,257 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package denylist_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/app/store/cache"
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/denylist"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	gitnesstypes "github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSpaceCache struct {
+	spaces map[int64]*gitnesstypes.SpaceCore
+}
+
+func (f fakeSpaceCache) Stats() (int64, int64) {
+	return 0, 0
+}
+
+func (f fakeSpaceCache) Get(_ context.Context, id int64) (*gitnesstypes.SpaceCore, error) {
+	space, ok := f.spaces[id]
+	if !ok {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return space, nil
+}
+
+func (f fakeSpaceCache) Evict(context.Context, int64) {}
+
+// newTestService returns a service for the spaces root (1) > team (2) and a sibling space other (3) of team.
+func newTestService(t *testing.T) (
+	*denylist.Service, *mocks.MockPackageDenylistEntryRepository, *mocks.MockPackageDenylistOverrideRepository,
+) {
+	entryDao := mocks.NewMockPackageDenylistEntryRepository(t)
+	overrideDao := mocks.NewMockPackageDenylistOverrideRepository(t)
+	spaces := fakeSpaceCache{spaces: map[int64]*gitnesstypes.SpaceCore{
+		1: {ID: 1},
+		2: {ID: 2, ParentID: 1},
+		3: {ID: 3, ParentID: 1},
+	}}
+	spaceFinder := refcache.NewSpaceFinder(spaces, nil, nil, cache.Evictor[*gitnesstypes.SpaceCore]{})
+	return denylist.NewService(entryDao, overrideDao, spaceFinder), entryDao, overrideDao
+}
+
+func TestPackageDenylistEntryMatches(t *testing.T) {
+	tests := []struct {
+		versionRange string
+		version      string
+		matches      bool
+	}{
+		{versionRange: "", version: "1.0.0", matches: true},
+		{versionRange: "", version: "", matches: true},
+		{versionRange: "1.2.3", version: "1.2.3", matches: true},
+		{versionRange: "1.2.3", version: "1.2.4", matches: false},
+		{versionRange: ">= 1.0.0, < 1.2.0", version: "1.1.5", matches: true},
+		{versionRange: ">= 1.0.0, < 1.2.0", version: "1.2.0", matches: false},
+		{versionRange: ">= 1.0.0, < 1.2.0", version: "", matches: false},
+		{versionRange: ">= 1.0.0", version: "latest", matches: false},
+		{versionRange: "latest", version: "latest", matches: true},
+	}
+	for _, tt := range tests {
+		entry := &types.PackageDenylistEntry{VersionRange: tt.versionRange}
+		require.Equal(t, tt.matches, entry.Matches(tt.version), "range %q, version %q", tt.versionRange, tt.version)
+	}
+}
+
+func TestEntriesFromAdvisory(t *testing.T) {
+	entries, unsupported := denylist.EntriesFromAdvisory(denylist.Advisory{
+		ID:      "MAL-2024-1",
+		Summary: "Malicious code in left-pad",
+		Affected: []denylist.AffectedPackage{
+			{
+				Ecosystem: "npm",
+				Name:      "left-pad",
+				Ranges: []denylist.AffectedRange{
+					{Type: "SEMVER", Events: []denylist.RangeEvent{{Introduced: "0"}}},
+				},
+			},
+			{
+				Ecosystem: "PyPI",
+				Name:      "requestz",
+				Ranges: []denylist.AffectedRange{
+					{Type: "ECOSYSTEM", Events: []denylist.RangeEvent{
+						{Introduced: "1.0"}, {Fixed: "1.2"}, {Introduced: "2.0"}, {LastAffected: "2.1"},
+					}},
+					{Type: "GIT", Events: []denylist.RangeEvent{{Introduced: "abc123"}}},
+				},
+				Versions: []string{"0.9"},
+			},
+			{Ecosystem: "Packagist", Name: "vendor/package"},
+			{
+				Ecosystem: "Go",
+				Name:      "example.com/module",
+				Ranges:    []denylist.AffectedRange{{Type: "GIT", Events: []denylist.RangeEvent{{Introduced: "abc123"}}}},
+			},
+		},
+	})
+
+	require.Equal(t, 2, unsupported, "Packagist and git only ranges can't be denied")
+	ranges := make(map[string][]string)
+	for _, entry := range entries {
+		require.Equal(t, "MAL-2024-1", entry.ExternalID)
+		require.Equal(t, "Malicious code in left-pad", entry.Reason)
+		require.Equal(t, types.PackageDenylistSourceOSV, entry.Source)
+		ranges[entry.PackageType+"/"+entry.Name] = append(ranges[entry.PackageType+"/"+entry.Name], entry.VersionRange)
+	}
+	require.Equal(t, map[string][]string{
+		string(artifact.PackageTypeNPM) + "/left-pad":    {""},
+		string(artifact.PackageTypePYTHON) + "/requestz": {">= 1.0, < 1.2", ">= 2.0, <= 2.1", "0.9"},
+	}, ranges)
+}
+
+func TestImportSkipsDuplicates(t *testing.T) {
+	ctx := context.Background()
+	s, entryDao, _ := newTestService(t)
+	advisories := []denylist.Advisory{{
+		ID: "MAL-2024-2",
+		Affected: []denylist.AffectedPackage{
+			{Ecosystem: "npm", Name: "Evil-Package"},
+			{Ecosystem: "Hex", Name: "evil"},
+		},
+	}}
+	isEntry := mock.MatchedBy(func(e *types.PackageDenylistEntry) bool {
+		return e.SpaceID == 1 && e.CreatedBy == 7 && e.Name == "evil-package" &&
+			e.PackageType == string(artifact.PackageTypeNPM) && e.Source == types.PackageDenylistSourceOSV
+	})
+
+	entryDao.EXPECT().Create(mock.Anything, isEntry).Return(nil).Once()
+	imported, skipped, err := s.Import(ctx, 1, 7, advisories)
+	require.NoError(t, err)
+	require.Equal(t, 1, imported)
+	require.Equal(t, 1, skipped)
+
+	entryDao.EXPECT().Create(mock.Anything, isEntry).Return(gitnessstore.ErrDuplicate).Once()
+	imported, skipped, err = s.Import(ctx, 1, 7, advisories)
+	require.NoError(t, err)
+	require.Equal(t, 0, imported)
+	require.Equal(t, 2, skipped, "the package is already denied")
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+	s, entryDao, _ := newTestService(t)
+
+	err := s.Create(ctx, &types.PackageDenylistEntry{SpaceID: 1, Name: " "})
+	require.ErrorIs(t, err, denylist.ErrInvalidEntry)
+	err = s.Create(ctx, &types.PackageDenylistEntry{SpaceID: 1, Name: "lodash", PackageType: "APT"})
+	require.ErrorIs(t, err, denylist.ErrInvalidEntry)
+
+	entryDao.EXPECT().Create(mock.Anything, mock.MatchedBy(func(e *types.PackageDenylistEntry) bool {
+		return e.Name == "lodash" && e.VersionRange == "< 4.17.21" && e.Source == types.PackageDenylistSourceManual
+	})).Return(nil).Once()
+	err = s.Create(ctx, &types.PackageDenylistEntry{SpaceID: 1, Name: " Lodash ", VersionRange: " < 4.17.21 "})
+	require.NoError(t, err)
+}
+
+func TestFind(t *testing.T) {
+	ctx := context.Background()
+	s, entryDao, _ := newTestService(t)
+	rootEntry := &types.PackageDenylistEntry{ID: 1, SpaceID: 1, Name: "lodash", VersionRange: "< 4.17.21",
+		Reason: "CVE"}
+	registry := &types.Registry{ID: 10, ParentID: 2, PackageType: artifact.PackageTypeNPM}
+
+	// the entries of the package are listed for the space of the registry and the spaces above it, once.
+	entryDao.EXPECT().ListForPackage(mock.Anything, []int64{2, 1}, int64(10), string(artifact.PackageTypeNPM),
+		"lodash").Return([]*types.PackageDenylistEntry{rootEntry}, nil).Once()
+	entryDao.EXPECT().ListForPackage(mock.Anything, []int64{2, 1}, int64(10), string(artifact.PackageTypeNPM),
+		"chalk").Return(nil, nil).Once()
+
+	entry, err := s.Find(ctx, registry, "lodash", "4.17.20")
+	require.NoError(t, err)
+	require.NotNil(t, entry, "entries of the parent spaces apply")
+	require.Equal(t, rootEntry.ID, entry.ID)
+
+	entry, err = s.Find(ctx, registry, "lodash", "4.17.21")
+	require.NoError(t, err)
+	require.Nil(t, entry)
+
+	entry, err = s.Find(ctx, registry, "chalk", "5.0.0")
+	require.NoError(t, err)
+	require.Nil(t, entry)
+
+	err = s.CheckUpload(ctx, registry, "LODASH", "4.0.0")
+	require.Error(t, err)
+}
+
+func TestOverride(t *testing.T) {
+	ctx := context.Background()
+	s, entryDao, overrideDao := newTestService(t)
+	entry := &types.PackageDenylistEntry{ID: 1, SpaceID: 1, Name: "lodash", Reason: "CVE"}
+	otherEntry := &types.PackageDenylistEntry{ID: 2, SpaceID: 3, Name: "chalk", Reason: "compromised"}
+	registry := &types.Registry{ID: 10, ParentID: 2, PackageType: artifact.PackageTypeNPM}
+	entryDao.EXPECT().Find(mock.Anything, int64(1)).Return(entry, nil)
+	entryDao.EXPECT().Find(mock.Anything, int64(2)).Return(otherEntry, nil)
+	listEntries := func(entries ...*types.PackageDenylistEntry) {
+		entryDao.EXPECT().ListForPackage(mock.Anything, []int64{2, 1}, int64(10), string(artifact.PackageTypeNPM),
+			"lodash").Return(entries, nil).Once()
+	}
+
+	// caches the entries of the package.
+	listEntries(entry)
+	found, err := s.Find(ctx, registry, "lodash", "1.0.0")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+
+	_, _, err = s.Override(ctx, registry, entry.ID, 7, " ")
+	require.ErrorIs(t, err, denylist.ErrOverrideReasonRequired)
+
+	_, _, err = s.Override(ctx, registry, otherEntry.ID, 7, "needed")
+	require.ErrorIs(t, err, gitnessstore.ErrResourceNotFound, "entries of sibling spaces can't be overridden")
+
+	overrideDao.EXPECT().Create(mock.Anything, mock.MatchedBy(func(o *types.PackageDenylistOverride) bool {
+		return o.EntryID == 1 && o.RegistryID == 10 && o.CreatedBy == 7
+	})).Return(nil).Once()
+	override, overridden, err := s.Override(ctx, registry, entry.ID, 7, "patched internally")
+	require.NoError(t, err)
+	require.Equal(t, entry.ID, overridden.ID)
+	require.Equal(t, "patched internally", override.Reason)
+
+	// the override applies right away as the cached entries are evicted.
+	listEntries()
+	found, err = s.Find(ctx, registry, "lodash", "1.0.0")
+	require.NoError(t, err)
+	require.Nil(t, found)
+
+	overrideDao.EXPECT().Find(mock.Anything, int64(1), int64(10)).Return(override, nil).Once()
+	overrideDao.EXPECT().Delete(mock.Anything, int64(1), int64(10)).Return(nil).Once()
+	_, _, err = s.RemoveOverride(ctx, registry, entry.ID)
+	require.NoError(t, err)
+
+	listEntries(entry)
+	found, err = s.Find(ctx, registry, "lodash", "1.0.0")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+}
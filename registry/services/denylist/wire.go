@@ -0,0 +1,45 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package denylist
+
+import (
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/registry/app/api/interfaces"
+	registryrefcache "github.com/harness/gitness/registry/app/services/refcache"
+	registrystore "github.com/harness/gitness/registry/app/store"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideService,
+	ProvideFirewallChecker,
+)
+
+func ProvideService(
+	entryDao registrystore.PackageDenylistEntryRepository,
+	overrideDao registrystore.PackageDenylistOverrideRepository,
+	spaceFinder refcache.SpaceFinder,
+) *Service {
+	return NewService(entryDao, overrideDao, spaceFinder)
+}
+
+func ProvideFirewallChecker(
+	service *Service,
+	registryFinder registryrefcache.RegistryFinder,
+) interfaces.DependencyFirewallChecker {
+	return NewFirewallChecker(service, registryFinder)
+}
@@ -0,0 +1,216 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firewalldelay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrystore "github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// MaxDelayHours bounds the firewall delay of an upstream proxy to a month.
+const MaxDelayHours = 30 * 24
+
+var (
+	// ErrInvalidDelay is returned when the firewall delay of an upstream proxy is out of bounds or isn't supported
+	// by its package type.
+	ErrInvalidDelay = errors.New("invalid firewall delay")
+	// ErrApprovalReasonRequired is returned when a version is approved without a reason.
+	ErrApprovalReasonRequired = errors.New("a reason is required to approve a version held by the firewall")
+	// ErrInvalidApproval is returned when a version is approved in a registry without a firewall delay.
+	ErrInvalidApproval = errors.New("invalid firewall approval")
+)
+
+// pythonNameSeparators are the runs of characters PEP 503 replaces with a dash to normalize a package name.
+var pythonNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+// Service holds the versions newly published upstream back from the consumers of the upstream proxies with a
+// firewall delay, so a compromised release is likely found and yanked before it's pulled. A version is served once
+// the delay since its publication has passed, or once it's approved for the registry.
+type Service struct {
+	approvalDao registrystore.FirewallApprovalRepository
+}
+
+func NewService(approvalDao registrystore.FirewallApprovalRepository) *Service {
+	return &Service{
+		approvalDao: approvalDao,
+	}
+}
+
+// Delay returns the firewall delay of the upstream proxy, it's zero if new versions are served right away.
+func Delay(upstream *types.UpstreamProxy) time.Duration {
+	return time.Duration(upstream.FirewallDelayHours) * time.Hour
+}
+
+// ValidateDelay checks the firewall delay of an upstream proxy of the package type, the publish times of the
+// versions are only known for npm and python upstreams.
+func ValidateDelay(packageType artifact.PackageType, hours int) error {
+	if hours == 0 {
+		return nil
+	}
+	if hours < 0 || hours > MaxDelayHours {
+		return fmt.Errorf("%w: it must be between 0 and %d hours", ErrInvalidDelay, MaxDelayHours)
+	}
+	if !supportsDelay(packageType) {
+		return fmt.Errorf("%w: it isn't supported for %s upstreams", ErrInvalidDelay, packageType)
+	}
+	return nil
+}
+
+func supportsDelay(packageType artifact.PackageType) bool {
+	return packageType == artifact.PackageTypeNPM || packageType == artifact.PackageTypePYTHON
+}
+
+// PackageName returns the name the approvals of the package are kept by. Python names are normalized the way PEP 503
+// does, pip requests a package by any spelling of its name.
+func PackageName(packageType artifact.PackageType, name string) string {
+	if packageType == artifact.PackageTypePYTHON {
+		return strings.ToLower(pythonNameSeparators.ReplaceAllString(name, "-"))
+	}
+	return name
+}
+
+// HeldVersions returns the versions of the package the upstream proxy holds back, with the time they're held until.
+// They're the versions published upstream within the firewall delay which aren't approved. Versions without a
+// publish time aren't held.
+func (s *Service) HeldVersions(
+	ctx context.Context,
+	upstream *types.UpstreamProxy,
+	pkg string,
+	published map[string]time.Time,
+) (map[string]time.Time, error) {
+	delay := Delay(upstream)
+	if delay <= 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+	held := make(map[string]time.Time)
+	for version, publishedAt := range published {
+		if publishedAt.IsZero() {
+			continue
+		}
+		if until := publishedAt.Add(delay); now.Before(until) {
+			held[version] = until
+		}
+	}
+	if len(held) == 0 {
+		return nil, nil
+	}
+
+	approvals, err := s.approvalDao.ListByPackage(ctx, upstream.RegistryID, PackageName(upstream.PackageType, pkg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list firewall approvals: %w", err)
+	}
+	for _, approval := range approvals {
+		delete(held, approval.Version)
+	}
+	return held, nil
+}
+
+// CheckPull returns a forbidden error if the upstream proxy holds the version of the package back.
+func (s *Service) CheckPull(
+	ctx context.Context,
+	upstream *types.UpstreamProxy,
+	pkg string,
+	version string,
+	publishedAt time.Time,
+) error {
+	held, err := s.HeldVersions(ctx, upstream, pkg, map[string]time.Time{version: publishedAt})
+	if err != nil {
+		return fmt.Errorf("failed to check the firewall delay: %w", err)
+	}
+	until, ok := held[version]
+	if !ok {
+		return nil
+	}
+	return usererror.Forbidden(fmt.Sprintf("Package %s version %s was published upstream at %s, the firewall "+
+		"holds it back until %s", pkg, version, publishedAt.UTC().Format(time.RFC3339),
+		until.UTC().Format(time.RFC3339)))
+}
+
+// Approve lets the version of the package through the firewall delay of the registry. It fails with
+// store.ErrDuplicate if the version is already approved.
+func (s *Service) Approve(
+	ctx context.Context,
+	upstream *types.UpstreamProxy,
+	pkg string,
+	version string,
+	principalID int64,
+	reason string,
+) (*types.FirewallApproval, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrApprovalReasonRequired
+	}
+	if Delay(upstream) <= 0 {
+		return nil, fmt.Errorf("%w: registry %s has no firewall delay", ErrInvalidApproval, upstream.RepoKey)
+	}
+
+	approval := &types.FirewallApproval{
+		RegistryID: upstream.RegistryID,
+		Package:    PackageName(upstream.PackageType, pkg),
+		Version:    version,
+		Reason:     reason,
+		CreatedBy:  principalID,
+		CreatedAt:  time.Now(),
+	}
+	if err := s.approvalDao.Create(ctx, approval); err != nil {
+		return nil, err
+	}
+	return approval, nil
+}
+
+// Revoke removes the approval of the version, it's held back again until the firewall delay has passed.
+func (s *Service) Revoke(
+	ctx context.Context,
+	upstream *types.UpstreamProxy,
+	pkg string,
+	version string,
+) (*types.FirewallApproval, error) {
+	pkg = PackageName(upstream.PackageType, pkg)
+	approvals, err := s.approvalDao.ListByPackage(ctx, upstream.RegistryID, pkg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list firewall approvals: %w", err)
+	}
+	for _, approval := range approvals {
+		if approval.Version != version {
+			continue
+		}
+		if err = s.approvalDao.Delete(ctx, upstream.RegistryID, pkg, version); err != nil {
+			return nil, err
+		}
+		return approval, nil
+	}
+	return nil, gitnessstore.ErrResourceNotFound
+}
+
+// List lists the approvals of the registry, newest first.
+func (s *Service) List(ctx context.Context, registryID int64) ([]*types.FirewallApproval, error) {
+	approvals, err := s.approvalDao.List(ctx, registryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list firewall approvals: %w", err)
+	}
+	return approvals, nil
+}
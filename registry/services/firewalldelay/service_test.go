@@ -0,0 +1,137 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firewalldelay_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/firewalldelay"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newUpstream(packageType artifact.PackageType, delayHours int) *types.UpstreamProxy {
+	return &types.UpstreamProxy{
+		RegistryID:         1,
+		RepoKey:            "proxy",
+		PackageType:        packageType,
+		FirewallDelayHours: delayHours,
+	}
+}
+
+func TestHeldVersions(t *testing.T) {
+	ctx := context.Background()
+	approvalDao := mocks.NewMockFirewallApprovalRepository(t)
+	s := firewalldelay.NewService(approvalDao)
+	now := time.Now()
+	published := map[string]time.Time{
+		"1.0.0": now.Add(-30 * 24 * time.Hour),
+		"1.1.0": now.Add(-72 * time.Hour),
+		"1.2.0": now.Add(-2 * time.Hour),
+		"1.3.0": now.Add(-time.Hour),
+		"2.0.0": {},
+	}
+	approvalDao.EXPECT().ListByPackage(mock.Anything, int64(1), "left-pad").
+		Return([]*types.FirewallApproval{{RegistryID: 1, Package: "left-pad", Version: "1.3.0"}}, nil).Once()
+
+	held, err := s.HeldVersions(ctx, newUpstream(artifact.PackageTypeNPM, 0), "left-pad", published)
+	require.NoError(t, err)
+	require.Empty(t, held, "a proxy without a firewall delay holds nothing back")
+
+	held, err = s.HeldVersions(ctx, newUpstream(artifact.PackageTypeNPM, 48), "left-pad", published)
+	require.NoError(t, err)
+	require.Len(t, held, 1, "an approved version isn't held back")
+	require.WithinDuration(t, published["1.2.0"].Add(48*time.Hour), held["1.2.0"], time.Second)
+}
+
+func TestApprove(t *testing.T) {
+	ctx := context.Background()
+	approvalDao := mocks.NewMockFirewallApprovalRepository(t)
+	s := firewalldelay.NewService(approvalDao)
+	upstream := newUpstream(artifact.PackageTypePYTHON, 48)
+
+	_, err := s.Approve(ctx, upstream, "Zope.Interface", "2.0", 7, " ")
+	require.ErrorIs(t, err, firewalldelay.ErrApprovalReasonRequired)
+	_, err = s.Approve(ctx, newUpstream(artifact.PackageTypePYTHON, 0), "zope-interface", "2.0", 7, "hotfix")
+	require.ErrorIs(t, err, firewalldelay.ErrInvalidApproval)
+
+	approvalDao.EXPECT().Create(mock.Anything, mock.MatchedBy(func(a *types.FirewallApproval) bool {
+		return a.RegistryID == 1 && a.Package == "zope-interface" && a.Version == "2.0" && a.CreatedBy == 7
+	})).Return(nil).Once()
+	approval, err := s.Approve(ctx, upstream, "Zope.Interface", "2.0", 7, "hotfix for CVE")
+	require.NoError(t, err)
+	require.Equal(t, "zope-interface", approval.Package)
+	require.Equal(t, "hotfix for CVE", approval.Reason)
+
+	approvalDao.EXPECT().Create(mock.Anything, mock.Anything).Return(gitnessstore.ErrDuplicate).Once()
+	_, err = s.Approve(ctx, upstream, "zope_interface", "2.0", 7, "again")
+	require.ErrorIs(t, err, gitnessstore.ErrDuplicate)
+}
+
+func TestRevoke(t *testing.T) {
+	ctx := context.Background()
+	approvalDao := mocks.NewMockFirewallApprovalRepository(t)
+	s := firewalldelay.NewService(approvalDao)
+	upstream := newUpstream(artifact.PackageTypePYTHON, 48)
+	approval := &types.FirewallApproval{RegistryID: 1, Package: "zope-interface", Version: "2.0", Reason: "hotfix"}
+
+	approvalDao.EXPECT().ListByPackage(mock.Anything, int64(1), "zope-interface").
+		Return([]*types.FirewallApproval{approval}, nil).Once()
+	approvalDao.EXPECT().Delete(mock.Anything, int64(1), "zope-interface", "2.0").Return(nil).Once()
+	revoked, err := s.Revoke(ctx, upstream, "Zope.Interface", "2.0")
+	require.NoError(t, err)
+	require.Equal(t, "hotfix", revoked.Reason)
+
+	approvalDao.EXPECT().ListByPackage(mock.Anything, int64(1), "zope-interface").Return(nil, nil).Once()
+	_, err = s.Revoke(ctx, upstream, "zope-interface", "2.0")
+	require.ErrorIs(t, err, gitnessstore.ErrResourceNotFound)
+}
+
+func TestCheckPull(t *testing.T) {
+	ctx := context.Background()
+	approvalDao := mocks.NewMockFirewallApprovalRepository(t)
+	s := firewalldelay.NewService(approvalDao)
+	upstream := newUpstream(artifact.PackageTypePYTHON, 48)
+	publishedAt := time.Now().Add(-time.Hour)
+
+	require.NoError(t, s.CheckPull(ctx, upstream, "zope-interface", "1.0", time.Now().Add(-72*time.Hour)))
+
+	approvalDao.EXPECT().ListByPackage(mock.Anything, int64(1), "zope-interface").
+		Return([]*types.FirewallApproval{{Version: "2.0"}}, nil).Once()
+	require.NoError(t, s.CheckPull(ctx, upstream, "zope.interface", "2.0", publishedAt))
+
+	approvalDao.EXPECT().ListByPackage(mock.Anything, int64(1), "zope-interface").Return(nil, nil).Once()
+	err := s.CheckPull(ctx, upstream, "zope-interface", "2.0", publishedAt)
+	var userErr *usererror.Error
+	require.ErrorAs(t, err, &userErr)
+	require.Equal(t, 403, userErr.Status)
+}
+
+func TestValidateDelay(t *testing.T) {
+	require.NoError(t, firewalldelay.ValidateDelay(artifact.PackageTypeDOCKER, 0))
+	require.NoError(t, firewalldelay.ValidateDelay(artifact.PackageTypeNPM, 48))
+	require.NoError(t, firewalldelay.ValidateDelay(artifact.PackageTypePYTHON, firewalldelay.MaxDelayHours))
+	require.ErrorIs(t, firewalldelay.ValidateDelay(artifact.PackageTypeNPM, -1), firewalldelay.ErrInvalidDelay)
+	require.ErrorIs(t, firewalldelay.ValidateDelay(artifact.PackageTypeNPM, firewalldelay.MaxDelayHours+1), firewalldelay.ErrInvalidDelay)
+	require.ErrorIs(t, firewalldelay.ValidateDelay(artifact.PackageTypeMAVEN, 48), firewalldelay.ErrInvalidDelay)
+}
@@ -0,0 +1,223 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/app/services/notification/mailer"
+	"github.com/harness/gitness/encrypt"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// maxInFlight is the maximum number of events delivered concurrently, further events are dropped.
+	maxInFlight = 16
+
+	deliveryTimeout = 30 * time.Second
+
+	// dedupTTL is how long a delivered DedupKey is remembered, it covers the monthly quota periods.
+	dedupTTL = 32 * 24 * time.Hour
+)
+
+// Dispatcher delivers high-signal registry events to the email, Slack and MS Teams channels of the registries.
+// Deliveries are best effort: they happen in the background and failures are only logged.
+// NOTE: the rate limits and the deduplication are kept in memory, so they apply per instance.
+type Dispatcher struct {
+	channelRepository store.NotificationChannelRepository
+	mailer            mailer.Mailer
+	encrypter         encrypt.Encrypter
+	httpClient        *http.Client
+
+	inFlight chan struct{}
+
+	mx       sync.Mutex
+	limiters map[int64]*channelLimiter
+	sent     map[string]time.Time
+}
+
+type channelLimiter struct {
+	limit   int
+	limiter *rate.Limiter
+}
+
+func NewDispatcher(
+	channelRepository store.NotificationChannelRepository,
+	mailer mailer.Mailer,
+	encrypter encrypt.Encrypter,
+	httpClient *http.Client,
+) *Dispatcher {
+	return &Dispatcher{
+		channelRepository: channelRepository,
+		mailer:            mailer,
+		encrypter:         encrypter,
+		httpClient:        httpClient,
+		inFlight:          make(chan struct{}, maxInFlight),
+		limiters:          make(map[int64]*channelLimiter),
+		sent:              make(map[string]time.Time),
+	}
+}
+
+// EncryptURL encrypts the incoming webhook URL of a Slack or MS Teams channel before it's stored.
+func (d *Dispatcher) EncryptURL(url string) (string, error) {
+	encrypted, err := d.encrypter.Encrypt(url)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt url: %w", err)
+	}
+	return string(encrypted), nil
+}
+
+// Notify delivers the event to the enabled channels of the registry which subscribed to it.
+func (d *Dispatcher) Notify(ctx context.Context, event *Event) {
+	select {
+	case d.inFlight <- struct{}{}:
+	default:
+		log.Ctx(ctx).Warn().Msgf("notification dispatcher is busy, dropping %s event of registry %d",
+			event.Type, event.RegistryID)
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() { <-d.inFlight }()
+		ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+		defer cancel()
+		d.notify(ctx, event)
+	}()
+}
+
+func (d *Dispatcher) notify(ctx context.Context, event *Event) {
+	channels, err := d.channelRepository.ListByRegistry(ctx, event.RegistryID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msgf("failed to list notification channels of registry %d", event.RegistryID)
+		return
+	}
+
+	for _, channel := range channels {
+		if !channel.Enabled || !slices.Contains(channel.Events, event.Type) {
+			continue
+		}
+		if !d.allow(channel, event) {
+			log.Ctx(ctx).Debug().Msgf("skipping %s event for notification channel %s of registry %d",
+				event.Type, channel.Identifier, event.RegistryID)
+			continue
+		}
+		if err := d.deliver(ctx, channel, event); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to deliver %s event to notification channel %s of registry %d",
+				event.Type, channel.Identifier, event.RegistryID)
+		}
+	}
+}
+
+// allow tells whether the event is neither a duplicate nor over the rate limit of the channel.
+func (d *Dispatcher) allow(channel *types.NotificationChannel, event *Event) bool {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	now := time.Now()
+	dedupKey := ""
+	if event.DedupKey != "" {
+		for key, expiry := range d.sent {
+			if now.After(expiry) {
+				delete(d.sent, key)
+			}
+		}
+		dedupKey = fmt.Sprintf("%d/%s", channel.ID, event.DedupKey)
+		if _, ok := d.sent[dedupKey]; ok {
+			return false
+		}
+	}
+
+	l, ok := d.limiters[channel.ID]
+	if !ok || l.limit != channel.RateLimit {
+		l = &channelLimiter{
+			limit:   channel.RateLimit,
+			limiter: rate.NewLimiter(rate.Every(time.Hour/time.Duration(max(channel.RateLimit, 1))), channel.RateLimit),
+		}
+		d.limiters[channel.ID] = l
+	}
+	if !l.limiter.AllowN(now, 1) {
+		return false
+	}
+
+	if dedupKey != "" {
+		d.sent[dedupKey] = now.Add(dedupTTL)
+	}
+	return true
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, channel *types.NotificationChannel, event *Event) error {
+	title, message, err := render(channel, event)
+	if err != nil {
+		return err
+	}
+
+	switch channel.Type {
+	case types.NotificationChannelTypeEmail:
+		return d.mailer.Send(ctx, mailer.Payload{
+			ToRecipients: channel.Recipients,
+			Subject:      title,
+			Body:         "<p>" + html.EscapeString(message) + "</p>",
+		})
+	case types.NotificationChannelTypeSlack:
+		return d.post(ctx, channel, map[string]string{"text": fmt.Sprintf("*%s*\n%s", title, message)})
+	case types.NotificationChannelTypeTeams:
+		return d.post(ctx, channel, map[string]string{"title": title, "text": message})
+	default:
+		return fmt.Errorf("unknown notification channel type %s", channel.Type)
+	}
+}
+
+// post sends the payload to the incoming webhook of a Slack or MS Teams channel.
+func (d *Dispatcher) post(ctx context.Context, channel *types.NotificationChannel, payload any) error {
+	url, err := d.encrypter.Decrypt([]byte(channel.URL))
+	if err != nil {
+		return fmt.Errorf("failed to decrypt url: %w", err)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+	return nil
+}
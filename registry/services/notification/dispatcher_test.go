@@ -0,0 +1,96 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+)
+
+func TestRender(t *testing.T) {
+	event := &Event{
+		Type:      types.NotificationEventQuarantine,
+		Registry:  "docker-local",
+		Artifact:  "app",
+		Version:   "1.0.0",
+		Principal: "Jane",
+		Reason:    "CVE-2024-0001",
+	}
+
+	t.Run("uses the default template of the event", func(t *testing.T) {
+		title, message, err := render(&types.NotificationChannel{}, event)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if title != "[docker-local] Artifact quarantined" {
+			t.Errorf("unexpected title: %s", title)
+		}
+		if message != "app:1.0.0 was quarantined in registry docker-local by Jane: CVE-2024-0001" {
+			t.Errorf("unexpected message: %s", message)
+		}
+	})
+
+	t.Run("uses the custom template of the channel", func(t *testing.T) {
+		channel := &types.NotificationChannel{Template: "{{.Artifact}} ({{.Reason}})"}
+		_, message, err := render(channel, event)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if message != "app (CVE-2024-0001)" {
+			t.Errorf("unexpected message: %s", message)
+		}
+	})
+
+	t.Run("fails on unknown fields", func(t *testing.T) {
+		channel := &types.NotificationChannel{Template: "{{.Unknown}}"}
+		if _, _, err := render(channel, event); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestDispatcherAllow(t *testing.T) {
+	t.Run("limits the notifications of a channel", func(t *testing.T) {
+		d := NewDispatcher(nil, nil, nil, nil)
+		channel := &types.NotificationChannel{ID: 1, RateLimit: 2}
+		other := &types.NotificationChannel{ID: 2, RateLimit: 2}
+		event := &Event{Type: types.NotificationEventProtectedDeletion}
+		if !d.allow(channel, event) || !d.allow(channel, event) {
+			t.Fatal("expected the first notifications to be allowed")
+		}
+		if d.allow(channel, event) {
+			t.Error("expected the notification to be rate limited")
+		}
+		if !d.allow(other, event) {
+			t.Error("expected other channels not to be rate limited")
+		}
+	})
+
+	t.Run("delivers an event once per channel", func(t *testing.T) {
+		d := NewDispatcher(nil, nil, nil, nil)
+		channel := &types.NotificationChannel{ID: 1, RateLimit: 10}
+		event := &Event{Type: types.NotificationEventQuotaBreach, DedupKey: "quota-1"}
+		if !d.allow(channel, event) {
+			t.Fatal("expected the first notification to be allowed")
+		}
+		if d.allow(channel, event) {
+			t.Error("expected the duplicate notification to be dropped")
+		}
+		if !d.allow(channel, &Event{Type: types.NotificationEventQuotaBreach, DedupKey: "quota-2"}) {
+			t.Error("expected another event to be allowed")
+		}
+	})
+}
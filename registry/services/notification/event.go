@@ -0,0 +1,95 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/harness/gitness/registry/types"
+)
+
+// Event is a registry event delivered to the notification channels of the registry.
+type Event struct {
+	Type       types.NotificationEventType
+	RegistryID int64
+	Registry   string
+	Artifact   string
+	Version    string
+	// Principal is the display name of the principal who caused the event.
+	Principal string
+	// Reason is the reason of a quarantine.
+	Reason string
+	// Quota is only set for quota breaches.
+	Quota *QuotaBreach
+	// DedupKey prevents the event from being delivered more than once per channel, it's optional.
+	DedupKey string
+}
+
+type QuotaBreach struct {
+	Resource  string
+	Threshold int
+	Usage     int64
+	Limit     int64
+}
+
+var titles = map[types.NotificationEventType]string{
+	types.NotificationEventQuarantine:        "Artifact quarantined",
+	types.NotificationEventProtectedDeletion: "Delete of protected artifact rejected",
+	types.NotificationEventQuotaBreach:       "Registry quota threshold crossed",
+}
+
+var defaultTemplates = map[types.NotificationEventType]*template.Template{
+	types.NotificationEventQuarantine: template.Must(template.New("quarantine").Parse(
+		`{{.Artifact}}{{if .Version}}:{{.Version}}{{end}} was quarantined in registry {{.Registry}} ` +
+			`by {{.Principal}}: {{.Reason}}`)),
+	types.NotificationEventProtectedDeletion: template.Must(template.New("protected_deletion").Parse(
+		`{{.Principal}} tried to delete {{.Artifact}}{{if .Version}}:{{.Version}}{{end}} ` +
+			`from registry {{.Registry}}, which is protected from deletion`)),
+	types.NotificationEventQuotaBreach: template.Must(template.New("quota_breach").Parse(
+		`The {{.Quota.Resource}} usage of registry {{.Registry}} crossed {{.Quota.Threshold}}% of its quota ` +
+			`({{.Quota.Usage}} of {{.Quota.Limit}} bytes)`)),
+}
+
+// ParseTemplate parses a custom message template of a channel.
+func ParseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("custom").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// render returns the title and the message of the event, the custom template of the channel wins
+// over the default one of the event.
+func render(channel *types.NotificationChannel, event *Event) (string, string, error) {
+	tmpl := defaultTemplates[event.Type]
+	if channel.Template != "" {
+		var err error
+		if tmpl, err = ParseTemplate(channel.Template); err != nil {
+			return "", "", err
+		}
+	}
+	if tmpl == nil {
+		return "", "", fmt.Errorf("no template for event %s", event.Type)
+	}
+
+	message := bytes.Buffer{}
+	if err := tmpl.Execute(&message, event); err != nil {
+		return "", "", fmt.Errorf("failed to execute template: %w", err)
+	}
+	return fmt.Sprintf("[%s] %s", event.Registry, titles[event.Type]), message.String(), nil
+}
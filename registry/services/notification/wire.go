@@ -0,0 +1,38 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"github.com/harness/gitness/app/services/notification/mailer"
+	gitnesswebhook "github.com/harness/gitness/app/services/webhook"
+	"github.com/harness/gitness/encrypt"
+	"github.com/harness/gitness/registry/app/store"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideDispatcher,
+)
+
+func ProvideDispatcher(
+	config gitnesswebhook.Config,
+	channelRepository store.NotificationChannelRepository,
+	mailer mailer.Mailer,
+	encrypter encrypt.Encrypter,
+) *Dispatcher {
+	return NewDispatcher(channelRepository, mailer, encrypter, gitnesswebhook.NewHTTPClient(config))
+}
@@ -0,0 +1,301 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/audit"
+	registryevents "github.com/harness/gitness/registry/app/events/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnesstypes "github.com/harness/gitness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// publishDelay is how long the recurring job leaves a new event to the publish which follows its commit.
+	publishDelay = 30 * time.Second
+
+	// leaseDuration is how long a claimed event is hidden from other publishers.
+	leaseDuration = time.Minute
+
+	minRetryDelay = 30 * time.Second
+	maxRetryDelay = time.Hour
+
+	maxErrorLength = 1024
+)
+
+// Outbox stores the webhook events and the audit logs of registry changes within the transaction of the change,
+// and publishes them once the transaction committed. Events are retried until they are published, so they are
+// delivered at least once.
+type Outbox struct {
+	store        store.EventOutboxRepository
+	reporter     *registryevents.Reporter
+	auditService audit.Service
+}
+
+func NewOutbox(
+	store store.EventOutboxRepository,
+	reporter *registryevents.Reporter,
+	auditService audit.Service,
+) *Outbox {
+	return &Outbox{
+		store:        store,
+		reporter:     reporter,
+		auditService: auditService,
+	}
+}
+
+// auditEvent is the stored form of an audit log, the diff objects are kept in their JSON form.
+type auditEvent struct {
+	User          gitnesstypes.Principal `json:"user"`
+	Resource      audit.Resource         `json:"resource"`
+	Action        audit.Action           `json:"action"`
+	SpacePath     string                 `json:"space_path"`
+	ID            string                 `json:"id,omitempty"`
+	OldObject     any                    `json:"old_object,omitempty"`
+	NewObject     any                    `json:"new_object,omitempty"`
+	ClientIP      string                 `json:"client_ip,omitempty"`
+	RequestMethod string                 `json:"request_method,omitempty"`
+	Data          map[string]string      `json:"data,omitempty"`
+	ActorChain    []audit.Actor          `json:"actor_chain,omitempty"`
+}
+
+// ArtifactCreated stores an artifact-created event, within the transaction of the context if there is one.
+func (o *Outbox) ArtifactCreated(
+	ctx context.Context,
+	payload *registryevents.ArtifactCreatedPayload,
+) (*types.OutboxEvent, error) {
+	return o.enqueueGob(ctx, types.OutboxEventKindArtifactCreated, payload)
+}
+
+// ArtifactDeleted stores an artifact-deleted event, within the transaction of the context if there is one.
+func (o *Outbox) ArtifactDeleted(
+	ctx context.Context,
+	payload *registryevents.ArtifactDeletedPayload,
+) (*types.OutboxEvent, error) {
+	if payload.ActorChain == nil {
+		payload.ActorChain = audit.GetActorChain(ctx)
+	}
+	return o.enqueueGob(ctx, types.OutboxEventKindArtifactDeleted, payload)
+}
+
+// ArtifactSoftDeleted stores an artifact-soft-deleted event, within the transaction of the context if there is one.
+func (o *Outbox) ArtifactSoftDeleted(
+	ctx context.Context,
+	payload *registryevents.ArtifactSoftDeletedPayload,
+) (*types.OutboxEvent, error) {
+	if payload.ActorChain == nil {
+		payload.ActorChain = audit.GetActorChain(ctx)
+	}
+	return o.enqueueGob(ctx, types.OutboxEventKindArtifactSoftDeleted, payload)
+}
+
+// ArtifactRestored stores an artifact-restored event, within the transaction of the context if there is one.
+func (o *Outbox) ArtifactRestored(
+	ctx context.Context,
+	payload *registryevents.ArtifactRestoredPayload,
+) (*types.OutboxEvent, error) {
+	if payload.ActorChain == nil {
+		payload.ActorChain = audit.GetActorChain(ctx)
+	}
+	return o.enqueueGob(ctx, types.OutboxEventKindArtifactRestored, payload)
+}
+
+// Audit stores an audit log, within the transaction of the context if there is one.
+// It takes the same arguments as audit.Service.Log.
+func (o *Outbox) Audit(
+	ctx context.Context,
+	user gitnesstypes.Principal,
+	resource audit.Resource,
+	action audit.Action,
+	spacePath string,
+	options ...audit.Option,
+) (*types.OutboxEvent, error) {
+	e := audit.Event{}
+	for _, option := range options {
+		option.Apply(&e)
+	}
+
+	payload, err := json.Marshal(auditEvent{
+		User:          user,
+		Resource:      resource,
+		Action:        action,
+		SpacePath:     spacePath,
+		ID:            e.ID,
+		OldObject:     e.DiffObject.OldObject,
+		NewObject:     e.DiffObject.NewObject,
+		ClientIP:      e.ClientIP,
+		RequestMethod: e.RequestMethod,
+		Data:          e.Data,
+		ActorChain:    e.ActorChain,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audit event: %w", err)
+	}
+	return o.enqueue(ctx, types.OutboxEventKindAudit, payload)
+}
+
+func (o *Outbox) enqueueGob(ctx context.Context, kind types.OutboxEventKind, payload any) (*types.OutboxEvent, error) {
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(payload); err != nil {
+		return nil, fmt.Errorf("failed to encode %s event: %w", kind, err)
+	}
+	return o.enqueue(ctx, kind, buf.Bytes())
+}
+
+func (o *Outbox) enqueue(ctx context.Context, kind types.OutboxEventKind, payload []byte) (*types.OutboxEvent, error) {
+	now := time.Now()
+	event := &types.OutboxEvent{
+		Kind:          kind,
+		Payload:       payload,
+		NextAttemptAt: now.Add(publishDelay),
+		CreatedAt:     now,
+	}
+	if err := o.store.Create(ctx, event); err != nil {
+		return nil, fmt.Errorf("failed to store %s event: %w", kind, err)
+	}
+	return event, nil
+}
+
+// Publish publishes events right after the transaction which stored them committed. Failures are only logged,
+// the events are left to the recurring job.
+func (o *Outbox) Publish(ctx context.Context, events ...*types.OutboxEvent) {
+	if o == nil {
+		return
+	}
+	for _, event := range events {
+		if err := o.publish(ctx, event); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to publish outbox event %d, it will be retried", event.ID)
+		}
+	}
+}
+
+// PublishDue publishes the events whose next attempt is due and returns how many were published.
+func (o *Outbox) PublishDue(ctx context.Context, limit int) (int, error) {
+	events, err := o.store.ListDue(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due outbox events: %w", err)
+	}
+
+	published := 0
+	for _, event := range events {
+		if err = ctx.Err(); err != nil {
+			return published, err
+		}
+		if err = o.publish(ctx, event); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to publish outbox event %d (attempt %d)",
+				event.ID, event.Attempts)
+			continue
+		}
+		published++
+	}
+	return published, nil
+}
+
+// publish claims the event, publishes it and deletes it. Failed attempts are retried with an exponential backoff.
+func (o *Outbox) publish(ctx context.Context, event *types.OutboxEvent) error {
+	claimed, err := o.store.Claim(ctx, event, time.Now().Add(leaseDuration))
+	if err != nil {
+		return fmt.Errorf("failed to claim event: %w", err)
+	}
+	if !claimed {
+		// somebody else is publishing it or already did
+		return nil
+	}
+
+	if err = o.send(ctx, event); err != nil {
+		lastError := err.Error()
+		if len(lastError) > maxErrorLength {
+			lastError = lastError[:maxErrorLength]
+		}
+		if fErr := o.store.Fail(ctx, event.ID, time.Now().Add(retryDelay(event.Attempts)), lastError); fErr != nil {
+			log.Ctx(ctx).Warn().Err(fErr).Msgf("failed to record failed attempt of outbox event %d", event.ID)
+		}
+		return err
+	}
+
+	if err = o.store.Delete(ctx, event.ID); err != nil {
+		// the event will be published again once its lease expired
+		return fmt.Errorf("failed to delete published event: %w", err)
+	}
+	return nil
+}
+
+func (o *Outbox) send(ctx context.Context, event *types.OutboxEvent) error {
+	switch event.Kind {
+	case types.OutboxEventKindArtifactCreated:
+		payload := &registryevents.ArtifactCreatedPayload{}
+		if err := gob.NewDecoder(bytes.NewReader(event.Payload)).Decode(payload); err != nil {
+			return fmt.Errorf("failed to decode %s event: %w", event.Kind, err)
+		}
+		_, err := o.reporter.SendArtifactCreated(ctx, payload)
+		return err
+	case types.OutboxEventKindArtifactDeleted:
+		payload := &registryevents.ArtifactDeletedPayload{}
+		if err := gob.NewDecoder(bytes.NewReader(event.Payload)).Decode(payload); err != nil {
+			return fmt.Errorf("failed to decode %s event: %w", event.Kind, err)
+		}
+		_, err := o.reporter.SendArtifactDeleted(ctx, payload)
+		return err
+	case types.OutboxEventKindArtifactSoftDeleted:
+		payload := &registryevents.ArtifactSoftDeletedPayload{}
+		if err := gob.NewDecoder(bytes.NewReader(event.Payload)).Decode(payload); err != nil {
+			return fmt.Errorf("failed to decode %s event: %w", event.Kind, err)
+		}
+		_, err := o.reporter.SendArtifactSoftDeleted(ctx, payload)
+		return err
+	case types.OutboxEventKindArtifactRestored:
+		payload := &registryevents.ArtifactRestoredPayload{}
+		if err := gob.NewDecoder(bytes.NewReader(event.Payload)).Decode(payload); err != nil {
+			return fmt.Errorf("failed to decode %s event: %w", event.Kind, err)
+		}
+		_, err := o.reporter.SendArtifactRestored(ctx, payload)
+		return err
+	case types.OutboxEventKindAudit:
+		e := auditEvent{}
+		if err := json.Unmarshal(event.Payload, &e); err != nil {
+			return fmt.Errorf("failed to decode %s event: %w", event.Kind, err)
+		}
+		return o.auditService.Log(ctx, e.User, e.Resource, e.Action, e.SpacePath, audit.FuncOption(
+			func(target *audit.Event) {
+				target.ID = e.ID
+				target.DiffObject = audit.DiffObject{OldObject: e.OldObject, NewObject: e.NewObject}
+				target.ClientIP = e.ClientIP
+				target.RequestMethod = e.RequestMethod
+				target.Data = e.Data
+				target.ActorChain = e.ActorChain
+			},
+		))
+	default:
+		return fmt.Errorf("unknown outbox event kind %q", event.Kind)
+	}
+}
+
+// retryDelay doubles the delay with every attempt.
+func retryDelay(attempts int) time.Duration {
+	delay := minRetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
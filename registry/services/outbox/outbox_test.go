@@ -0,0 +1,138 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/services/outbox"
+	"github.com/harness/gitness/registry/types"
+	gitnesstypes "github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeAudit struct {
+	err    error
+	logged []audit.Event
+}
+
+func (a *fakeAudit) Log(
+	_ context.Context,
+	user gitnesstypes.Principal,
+	resource audit.Resource,
+	action audit.Action,
+	spacePath string,
+	options ...audit.Option,
+) error {
+	if a.err != nil {
+		return a.err
+	}
+	e := audit.Event{User: user, Resource: resource, Action: action, SpacePath: spacePath}
+	for _, option := range options {
+		option.Apply(&e)
+	}
+	a.logged = append(a.logged, e)
+	return nil
+}
+
+// expectCreate stores the created event with the id.
+func expectCreate(store *mocks.MockEventOutboxRepository, id int64) {
+	store.EXPECT().Create(mock.Anything, mock.Anything).Run(func(_ context.Context, event *types.OutboxEvent) {
+		event.ID = id
+	}).Return(nil).Once()
+}
+
+func TestOutboxAudit(t *testing.T) {
+	ctx := context.Background()
+	principal := gitnesstypes.Principal{ID: 1, UID: "admin"}
+
+	t.Run("publishes the stored audit log", func(t *testing.T) {
+		store := mocks.NewMockEventOutboxRepository(t)
+		auditService := &fakeAudit{}
+		o := outbox.NewOutbox(store, nil, auditService)
+
+		expectCreate(store, 1)
+		event, err := o.Audit(ctx, principal, audit.NewResource(audit.ResourceTypeRegistryArtifact, "app"),
+			audit.ActionDeleted, "root", audit.WithData("registry name", "reg"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		store.EXPECT().Claim(mock.Anything, event, mock.Anything).Return(true, nil).Once()
+		store.EXPECT().Delete(mock.Anything, int64(1)).Return(nil).Once()
+		o.Publish(ctx, event)
+		if len(auditService.logged) != 1 {
+			t.Fatalf("expected one audit log, got %d", len(auditService.logged))
+		}
+		logged := auditService.logged[0]
+		if logged.User.UID != "admin" || logged.Resource.Identifier != "app" || logged.SpacePath != "root" ||
+			logged.Action != audit.ActionDeleted || logged.Data["registry name"] != "reg" {
+			t.Errorf("unexpected audit log: %+v", logged)
+		}
+	})
+
+	t.Run("keeps failed events for the next attempt", func(t *testing.T) {
+		store := mocks.NewMockEventOutboxRepository(t)
+		auditService := &fakeAudit{err: errors.New("unavailable")}
+		o := outbox.NewOutbox(store, nil, auditService)
+
+		expectCreate(store, 1)
+		event, err := o.Audit(ctx, principal, audit.NewResource(audit.ResourceTypeRegistryArtifact, "app"),
+			audit.ActionDeleted, "root")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		store.EXPECT().Claim(mock.Anything, event, mock.Anything).Return(true, nil).Once()
+		store.EXPECT().Fail(mock.Anything, int64(1), mock.Anything, "unavailable").Return(nil).Once()
+		o.Publish(ctx, event)
+
+		auditService.err = nil
+		store.EXPECT().ListDue(mock.Anything, mock.Anything, 10).Return([]*types.OutboxEvent{event}, nil).Once()
+		store.EXPECT().Claim(mock.Anything, event, mock.Anything).Return(true, nil).Once()
+		store.EXPECT().Delete(mock.Anything, int64(1)).Return(nil).Once()
+		published, err := o.PublishDue(ctx, 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if published != 1 || len(auditService.logged) != 1 {
+			t.Errorf("expected the event to be published on retry, published %d", published)
+		}
+	})
+
+	t.Run("skips events claimed by somebody else", func(t *testing.T) {
+		store := mocks.NewMockEventOutboxRepository(t)
+		auditService := &fakeAudit{}
+		o := outbox.NewOutbox(store, nil, auditService)
+
+		expectCreate(store, 1)
+		event, err := o.Audit(ctx, principal, audit.NewResource(audit.ResourceTypeRegistryArtifact, "app"),
+			audit.ActionDeleted, "root")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		store.EXPECT().Claim(mock.Anything, event, mock.Anything).Return(false, nil).Once()
+		o.Publish(ctx, event)
+		if len(auditService.logged) != 0 {
+			t.Errorf("expected the claimed event not to be published")
+		}
+	})
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recentactivity
+
+import (
+	"context"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// MaxPerKind is the number of activities of each kind kept for a principal, older ones are dropped.
+const MaxPerKind = 50
+
+// Service records the artifacts a principal recently viewed in the UI or pulled, so they can offer
+// a way back to them. Recording is best-effort and never fails the request it's recorded for.
+type Service struct {
+	activityDao store.RecentActivityRepository
+	modes       store.DownloadStatModeResolver
+}
+
+func NewService(
+	activityDao store.RecentActivityRepository,
+	modes store.DownloadStatModeResolver,
+) *Service {
+	return &Service{
+		activityDao: activityDao,
+		modes:       modes,
+	}
+}
+
+// RecordView records that the principal of the session viewed the artifact.
+func (s *Service) RecordView(
+	ctx context.Context,
+	registryID int64,
+	imageName string,
+	artifactType *artifact.ArtifactType,
+) {
+	s.record(ctx, &types.RecentActivity{
+		Kind:         types.RecentActivityViewed,
+		RegistryID:   registryID,
+		ImageName:    imageName,
+		ArtifactType: artifactType,
+	})
+}
+
+// RecordPull records that the principal of the session pulled the version of the artifact. Pulls
+// from registries which keep aggregated download stats only aren't recorded, as they would reveal
+// who downloaded what.
+func (s *Service) RecordPull(
+	ctx context.Context,
+	registryID int64,
+	imageName string,
+	artifactType *artifact.ArtifactType,
+	version string,
+) {
+	if s == nil {
+		return
+	}
+	if s.modes != nil {
+		mode, err := s.modes.DownloadStatMode(ctx, registryID)
+		if err != nil || mode == types.DownloadStatModeAggregated {
+			return
+		}
+	}
+	s.record(ctx, &types.RecentActivity{
+		Kind:         types.RecentActivityPulled,
+		RegistryID:   registryID,
+		ImageName:    imageName,
+		ArtifactType: artifactType,
+		Version:      version,
+	})
+}
+
+// List returns the latest activities of the principal, of the kind if it's set, newest first.
+func (s *Service) List(
+	ctx context.Context,
+	principalID int64,
+	kind *types.RecentActivityKind,
+) ([]*types.RecentActivity, error) {
+	limit := MaxPerKind
+	if kind == nil {
+		limit *= 2
+	}
+	return s.activityDao.ListForPrincipal(ctx, principalID, kind, limit)
+}
+
+func (s *Service) record(ctx context.Context, activity *types.RecentActivity) {
+	if s == nil {
+		return
+	}
+	session, ok := request.AuthSessionFrom(ctx)
+	if !ok || session == nil || auth.IsAnonymousSession(session) {
+		return
+	}
+	activity.PrincipalID = session.Principal.ID
+	activity.At = time.Now()
+
+	if err := s.activityDao.Upsert(ctx, activity); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to record %s activity of principal %d on image %s",
+			activity.Kind, activity.PrincipalID, activity.ImageName)
+		return
+	}
+	if err := s.activityDao.Trim(ctx, activity.PrincipalID, activity.Kind, MaxPerKind); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to trim %s activities of principal %d",
+			activity.Kind, activity.PrincipalID)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recentactivity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/types"
+	coretypes "github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeModes map[int64]types.DownloadStatMode
+
+func (f fakeModes) DownloadStatMode(_ context.Context, registryID int64) (types.DownloadStatMode, error) {
+	return f[registryID], nil
+}
+
+func TestRecord(t *testing.T) {
+	dao := mocks.NewMockRecentActivityRepository(t)
+	s := NewService(dao, fakeModes{1: types.DownloadStatModeDetailed, 2: types.DownloadStatModeAggregated})
+
+	user := request.WithAuthSession(context.Background(),
+		&auth.Session{Principal: coretypes.Principal{ID: 7, UID: "user"}})
+	anonymous := request.WithAuthSession(context.Background(), &auth.Session{Principal: auth.AnonymousPrincipal})
+
+	var upserted []types.RecentActivity
+	dao.EXPECT().Upsert(mock.Anything, mock.AnythingOfType("*types.RecentActivity")).
+		Run(func(_ context.Context, activity *types.RecentActivity) { upserted = append(upserted, *activity) }).
+		Return(nil).Times(2)
+	dao.EXPECT().Trim(mock.Anything, int64(7), types.RecentActivityViewed, MaxPerKind).
+		Return(nil).Once()
+	dao.EXPECT().Trim(mock.Anything, int64(7), types.RecentActivityPulled, MaxPerKind).
+		Return(nil).Once()
+
+	s.RecordView(user, 2, "img", nil)
+	s.RecordPull(user, 1, "img", nil, "1.0.0")
+	s.RecordPull(user, 2, "img", nil, "1.0.0")
+	s.RecordView(anonymous, 1, "img", nil)
+	s.RecordPull(context.Background(), 1, "img", nil, "1.0.0")
+
+	require.Len(t, upserted, 2, "only the view and the pull from the detailed registry should be recorded")
+	require.Equal(t, types.RecentActivityViewed, upserted[0].Kind)
+	require.Equal(t, int64(7), upserted[0].PrincipalID)
+	require.Equal(t, types.RecentActivityPulled, upserted[1].Kind)
+	require.Equal(t, int64(1), upserted[1].RegistryID)
+	require.Equal(t, "1.0.0", upserted[1].Version)
+
+	var nilService *Service
+	nilService.RecordView(user, 1, "img", nil)
+	nilService.RecordPull(user, 1, "img", nil, "1.0.0")
+}
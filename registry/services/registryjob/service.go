@@ -0,0 +1,270 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registryjob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobType = "registry_job"
+	// maxLogSize bounds the log excerpt of a job, the oldest lines are dropped first.
+	maxLogSize = 8 << 10
+)
+
+var (
+	// ErrUnknownKind is returned when a job of a kind without runner is started.
+	ErrUnknownKind = errors.New("unknown registry job kind")
+	// ErrJobCompleted is returned when a completed job is canceled.
+	ErrJobCompleted = errors.New("registry job is already completed")
+)
+
+// Runner runs the operation of a kind of registry job. It should return as soon as the context is done,
+// which happens when the job is canceled or exceeds its timeout.
+type Runner interface {
+	Run(ctx context.Context, job *types.RegistryJob, input string, progress *Progress) error
+}
+
+type registration struct {
+	runner  Runner
+	timeout time.Duration
+}
+
+// Service runs the long-running operations of registries as background jobs and tracks their state,
+// progress and log in the registry jobs, so they are reported the same way for all operations.
+type Service struct {
+	scheduler *job.Scheduler
+	store     store.RegistryJobRepository
+
+	mu      sync.RWMutex
+	runners map[types.RegistryJobKind]registration
+}
+
+func NewService(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	store store.RegistryJobRepository,
+) (*Service, error) {
+	s := &Service{
+		scheduler: scheduler,
+		store:     store,
+		runners:   make(map[types.RegistryJobKind]registration),
+	}
+	if err := executor.Register(jobType, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Register sets the runner of a kind of job, its runs are canceled after the timeout.
+func (s *Service) Register(kind types.RegistryJobKind, runner Runner, timeout time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runners[kind] = registration{runner: runner, timeout: timeout}
+}
+
+type jobInput struct {
+	RegistryID int64  `json:"registry_id"` //nolint:tagliatelle
+	UUID       string `json:"uuid"`
+	Input      string `json:"input"`
+}
+
+// Start queues a job for a registry, the input is passed as is to the runner of the kind.
+func (s *Service) Start(
+	ctx context.Context,
+	registryID int64,
+	kind types.RegistryJobKind,
+	principalID int64,
+	input string,
+) (*types.RegistryJob, error) {
+	s.mu.RLock()
+	reg, ok := s.runners[kind]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKind, kind)
+	}
+
+	now := time.Now()
+	j := &types.RegistryJob{
+		UUID:       uuid.NewString(),
+		RegistryID: registryID,
+		Kind:       kind,
+		State:      types.RegistryJobStateQueued,
+		CreatedBy:  principalID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.store.Create(ctx, j); err != nil {
+		return nil, fmt.Errorf("failed to create registry job: %w", err)
+	}
+
+	data, err := json.Marshal(jobInput{RegistryID: registryID, UUID: j.UUID, Input: input})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal registry job input: %w", err)
+	}
+	err = s.scheduler.RunJob(ctx, job.Definition{
+		UID:        jobUID(j.UUID),
+		Type:       jobType,
+		MaxRetries: 0,
+		Timeout:    reg.timeout,
+		Data:       string(data),
+	})
+	if err != nil {
+		s.complete(ctx, j, fmt.Errorf("failed to schedule job: %w", err))
+		return nil, fmt.Errorf("failed to schedule registry job: %w", err)
+	}
+	return j, nil
+}
+
+// Cancel cancels a queued or running job, the runner is stopped by canceling its context.
+func (s *Service) Cancel(ctx context.Context, j *types.RegistryJob) error {
+	if j.State.IsCompleted() {
+		return ErrJobCompleted
+	}
+	now := time.Now()
+	j.State = types.RegistryJobStateCanceled
+	j.UpdatedAt = now
+	j.FinishedAt = now
+	updated, err := s.store.Update(ctx, j)
+	if err != nil {
+		return fmt.Errorf("failed to cancel registry job: %w", err)
+	}
+	if !updated {
+		return ErrJobCompleted
+	}
+	if err = s.scheduler.CancelJob(ctx, jobUID(j.UUID)); err != nil {
+		return fmt.Errorf("failed to cancel job of registry job: %w", err)
+	}
+	return nil
+}
+
+// Handle runs a registry job, it implements job.Handler.
+func (s *Service) Handle(ctx context.Context, data string, _ job.ProgressReporter) (string, error) {
+	var in jobInput
+	if err := json.Unmarshal([]byte(data), &in); err != nil {
+		return "", fmt.Errorf("failed to unmarshal registry job input: %w", err)
+	}
+	j, err := s.store.GetByUUID(ctx, in.RegistryID, in.UUID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find registry job [%s]: %w", in.UUID, err)
+	}
+
+	s.mu.RLock()
+	reg, ok := s.runners[j.Kind]
+	s.mu.RUnlock()
+	if !ok {
+		s.complete(ctx, j, fmt.Errorf("%w: %s", ErrUnknownKind, j.Kind))
+		return "", nil
+	}
+
+	now := time.Now()
+	j.State = types.RegistryJobStateRunning
+	j.StartedAt = now
+	j.UpdatedAt = now
+	updated, err := s.store.Update(ctx, j)
+	if err != nil {
+		return "", fmt.Errorf("failed to start registry job [%s]: %w", j.UUID, err)
+	}
+	if !updated {
+		// the job was canceled before it got started.
+		return "", nil
+	}
+
+	runErr := reg.runner.Run(ctx, j, in.Input, &Progress{service: s, job: j})
+	if runErr == nil && ctx.Err() != nil {
+		runErr = ctx.Err()
+	}
+	// the context of the job is done when it was canceled or timed out, the final state is stored anyway.
+	s.complete(context.WithoutCancel(ctx), j, runErr)
+	return "", nil
+}
+
+// complete stores the final state of a job, jobs which were canceled meanwhile stay canceled.
+func (s *Service) complete(ctx context.Context, j *types.RegistryJob, runErr error) {
+	now := time.Now()
+	j.UpdatedAt = now
+	j.FinishedAt = now
+	switch {
+	case runErr == nil:
+		j.State = types.RegistryJobStateSucceeded
+		j.Progress = job.ProgressMax
+	case errors.Is(runErr, context.Canceled):
+		j.State = types.RegistryJobStateCanceled
+	case errors.Is(runErr, context.DeadlineExceeded):
+		j.State = types.RegistryJobStateFailed
+		j.Error = "the job exceeded its timeout"
+	default:
+		j.State = types.RegistryJobStateFailed
+		j.Error = runErr.Error()
+	}
+	if _, err := s.store.Update(ctx, j); err != nil {
+		log.Ctx(ctx).Error().Msgf("failed to complete registry job [%s]: %v", j.UUID, err)
+	}
+}
+
+func jobUID(jobUUID string) string {
+	return "registry-job-" + jobUUID
+}
+
+// Progress reports the progress of a running job and keeps the latest lines it logs.
+type Progress struct {
+	service *Service
+	job     *types.RegistryJob
+}
+
+// Report stores the progress of the job in percent along with the lines logged since the last report.
+func (p *Progress) Report(ctx context.Context, progress int) error {
+	p.job.Progress = min(max(progress, job.ProgressMin), job.ProgressMax)
+	p.job.UpdatedAt = time.Now()
+	updated, err := p.service.store.Update(ctx, p.job)
+	if err != nil {
+		return fmt.Errorf("failed to update progress of registry job: %w", err)
+	}
+	if !updated {
+		return ErrJobCompleted
+	}
+	return nil
+}
+
+// Logf adds a line to the log of the job, it's stored with the next report.
+func (p *Progress) Logf(format string, args ...any) {
+	p.job.Log = appendLog(p.job.Log, fmt.Sprintf(format, args...))
+}
+
+// appendLog appends a line to a log, dropping the oldest lines when it exceeds maxLogSize.
+func appendLog(logs string, line string) string {
+	logs += strings.TrimRight(line, "\n") + "\n"
+	for len(logs) > maxLogSize {
+		i := strings.IndexByte(logs, '\n')
+		if i < 0 || i == len(logs)-1 {
+			return logs[len(logs)-maxLogSize:]
+		}
+		logs = logs[i+1:]
+	}
+	return logs
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registryjob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type runnerFunc func(ctx context.Context, progress *Progress) error
+
+func (f runnerFunc) Run(ctx context.Context, _ *types.RegistryJob, _ string, progress *Progress) error {
+	return f(ctx, progress)
+}
+
+func runTestJob(t *testing.T, state types.RegistryJobState, runner Runner) types.RegistryJob {
+	t.Helper()
+	job := types.RegistryJob{UUID: "job", RegistryID: 1, Kind: "test", State: state}
+	dao := mocks.NewMockRegistryJobRepository(t)
+	dao.EXPECT().GetByUUID(mock.Anything, int64(1), "job").RunAndReturn(
+		func(context.Context, int64, string) (*types.RegistryJob, error) {
+			j := job
+			return &j, nil
+		})
+	dao.EXPECT().Update(mock.Anything, mock.AnythingOfType("*types.RegistryJob")).RunAndReturn(
+		func(_ context.Context, j *types.RegistryJob) (bool, error) {
+			if job.State.IsCompleted() {
+				return false, nil
+			}
+			job = *j
+			return true, nil
+		}).Maybe()
+	s := &Service{
+		store:   dao,
+		runners: map[types.RegistryJobKind]registration{"test": {runner: runner}},
+	}
+	data, _ := json.Marshal(jobInput{RegistryID: 1, UUID: "job"})
+	if _, err := s.Handle(context.Background(), string(data), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return job
+}
+
+func TestService_Handle(t *testing.T) {
+	j := runTestJob(t, types.RegistryJobStateQueued, runnerFunc(func(ctx context.Context, progress *Progress) error {
+		progress.Logf("half way")
+		return progress.Report(ctx, 50)
+	}))
+	if j.State != types.RegistryJobStateSucceeded || j.Progress != 100 || j.Log != "half way\n" {
+		t.Fatalf("unexpected job after success: %+v", j)
+	}
+	if j.StartedAt.IsZero() || j.FinishedAt.IsZero() {
+		t.Fatalf("expected start and finish times to be set: %+v", j)
+	}
+
+	j = runTestJob(t, types.RegistryJobStateQueued, runnerFunc(func(context.Context, *Progress) error {
+		return errors.New("boom")
+	}))
+	if j.State != types.RegistryJobStateFailed || j.Error != "boom" {
+		t.Fatalf("unexpected job after failure: %+v", j)
+	}
+
+	j = runTestJob(t, types.RegistryJobStateCanceled, runnerFunc(func(context.Context, *Progress) error {
+		t.Fatal("a canceled job must not run")
+		return nil
+	}))
+	if j.State != types.RegistryJobStateCanceled {
+		t.Fatalf("expected the job to stay canceled: %+v", j)
+	}
+}
+
+func TestAppendLog(t *testing.T) {
+	logs := ""
+	line := strings.Repeat("x", 1023)
+	for range 10 {
+		logs = appendLog(logs, line)
+	}
+	if len(logs) != maxLogSize {
+		t.Fatalf("expected log of %d bytes, got %d", maxLogSize, len(logs))
+	}
+	if !strings.HasPrefix(logs, line+"\n") {
+		t.Fatalf("expected the log to start with a whole line")
+	}
+
+	logs = appendLog("", strings.Repeat("y", 2*maxLogSize))
+	if len(logs) != maxLogSize {
+		t.Fatalf("expected a single long line to be truncated to %d bytes, got %d", maxLogSize, len(logs))
+	}
+}
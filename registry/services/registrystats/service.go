@@ -0,0 +1,147 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrystats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/events"
+	registryevents "github.com/harness/gitness/registry/app/events/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/stream"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	eventsReaderGroupName = "gitness:registry:stats"
+
+	// batchSize bounds the number of registries whose statistics are refreshed at once.
+	batchSize = 100
+)
+
+type Config struct {
+	EventReaderName string
+	Concurrency     int
+	MaxRetries      int
+}
+
+// Prepare validates the configuration.
+func (c *Config) Prepare() error {
+	if c == nil {
+		return errors.New("config is required")
+	}
+	if c.EventReaderName == "" {
+		return errors.New("config.EventReaderName is required")
+	}
+	if c.Concurrency < 1 {
+		return errors.New("config.Concurrency has to be a positive number")
+	}
+	if c.MaxRetries < 0 {
+		return errors.New("config.MaxRetries can't be negative")
+	}
+	return nil
+}
+
+// Service maintains the statistics of registries read by the dashboards. The statistics of all registries
+// are refreshed periodically, and the statistics of a registry are refreshed when its artifacts change,
+// download counts are only brought up to date by the periodic refresh.
+type Service struct {
+	statsDao store.RegistryStatsRepository
+}
+
+func NewService(
+	ctx context.Context,
+	config Config,
+	artifactsReaderFactory *events.ReaderFactory[*registryevents.Reader],
+	statsDao store.RegistryStatsRepository,
+) (*Service, error) {
+	if err := config.Prepare(); err != nil {
+		return nil, fmt.Errorf("provided registry stats service config is invalid: %w", err)
+	}
+
+	service := &Service{
+		statsDao: statsDao,
+	}
+
+	_, err := artifactsReaderFactory.Launch(ctx, eventsReaderGroupName, config.EventReaderName,
+		func(r *registryevents.Reader) error {
+			const idleTimeout = 1 * time.Minute
+			r.Configure(
+				stream.WithConcurrency(config.Concurrency),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(config.MaxRetries),
+				))
+
+			_ = r.RegisterArtifactCreated(service.handleEventArtifactCreated)
+			_ = r.RegisterArtifactDeleted(service.handleEventArtifactDeleted)
+
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to launch registry event reader for registry stats: %w", err)
+	}
+
+	return service, nil
+}
+
+// RefreshAll refreshes the statistics of all registries and returns the number of refreshed registries.
+func (s *Service) RefreshAll(ctx context.Context) (int, error) {
+	var count int
+	var afterID int64
+	for {
+		ids, err := s.statsDao.ListRegistryIDs(ctx, afterID, batchSize)
+		if err != nil {
+			return count, fmt.Errorf("failed to list registries after %d: %w", afterID, err)
+		}
+		if len(ids) == 0 {
+			return count, nil
+		}
+
+		if err = s.statsDao.Refresh(ctx, ids); err != nil {
+			return count, fmt.Errorf("failed to refresh stats of registries %d to %d: %w",
+				ids[0], ids[len(ids)-1], err)
+		}
+
+		count += len(ids)
+		afterID = ids[len(ids)-1]
+	}
+}
+
+func (s *Service) handleEventArtifactCreated(
+	ctx context.Context,
+	event *events.Event[*registryevents.ArtifactCreatedPayload],
+) error {
+	return s.refresh(ctx, event.Payload.RegistryID)
+}
+
+func (s *Service) handleEventArtifactDeleted(
+	ctx context.Context,
+	event *events.Event[*registryevents.ArtifactDeletedPayload],
+) error {
+	return s.refresh(ctx, event.Payload.RegistryID)
+}
+
+func (s *Service) refresh(ctx context.Context, registryID int64) error {
+	if err := s.statsDao.Refresh(ctx, []int64{registryID}); err != nil {
+		return fmt.Errorf("failed to refresh stats of registry %d: %w", registryID, err)
+	}
+	log.Ctx(ctx).Debug().Msgf("refreshed stats of registry %d", registryID)
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrystats
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshAll(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockRegistryStatsRepository(t)
+	s := &Service{statsDao: dao}
+
+	first := make([]int64, 0, batchSize)
+	for id := int64(1); id <= batchSize; id++ {
+		first = append(first, id)
+	}
+	second := []int64{101, 102, 103, 104, 105}
+	dao.EXPECT().ListRegistryIDs(ctx, int64(0), batchSize).Return(first, nil).Once()
+	dao.EXPECT().Refresh(ctx, first).Return(nil).Once()
+	dao.EXPECT().ListRegistryIDs(ctx, int64(batchSize), batchSize).Return(second, nil).Once()
+	dao.EXPECT().Refresh(ctx, second).Return(nil).Once()
+	dao.EXPECT().ListRegistryIDs(ctx, int64(105), batchSize).Return(nil, nil).Once()
+
+	count, err := s.RefreshAll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, batchSize+5, count)
+}
+
+func TestRefreshAllError(t *testing.T) {
+	ctx := context.Background()
+	dao := mocks.NewMockRegistryStatsRepository(t)
+	s := &Service{statsDao: dao}
+
+	refreshErr := errors.New("boom")
+	dao.EXPECT().ListRegistryIDs(ctx, int64(0), batchSize).Return([]int64{1, 2}, nil).Once()
+	dao.EXPECT().Refresh(ctx, mock.Anything).Return(refreshErr).Once()
+
+	count, err := s.RefreshAll(ctx)
+	require.ErrorIs(t, err, refreshErr)
+	require.Zero(t, count)
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrystats
+
+import (
+	"context"
+
+	"github.com/harness/gitness/events"
+	registryevents "github.com/harness/gitness/registry/app/events/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideConfig,
+	ProvideService,
+)
+
+func ProvideConfig(config *types.Config) Config {
+	return Config{
+		EventReaderName: config.InstanceID,
+		Concurrency:     config.Registry.Stats.Concurrency,
+		MaxRetries:      config.Registry.Stats.MaxRetries,
+	}
+}
+
+func ProvideService(
+	ctx context.Context,
+	config Config,
+	artifactsReaderFactory *events.ReaderFactory[*registryevents.Reader],
+	statsDao store.RegistryStatsRepository,
+) (*Service, error) {
+	return NewService(ctx, config, artifactsReaderFactory, statsDao)
+}
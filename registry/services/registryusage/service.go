@@ -0,0 +1,238 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registryusage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/registry/app/store"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// batchSize bounds the number of registries whose usage is queried at once.
+const batchSize = 500
+
+// Service rolls the storage and bandwidth usage of registries up the space hierarchy, so the usage of a space
+// includes the usage of all registries below it, and persists it daily.
+type Service struct {
+	registryDao      store.RegistryRepository
+	bandwidthStatDao store.BandwidthStatRepository
+	snapshotDao      store.RegistryUsageSnapshotRepository
+	spaceFinder      refcache.SpaceFinder
+}
+
+func NewService(
+	registryDao store.RegistryRepository,
+	bandwidthStatDao store.BandwidthStatRepository,
+	snapshotDao store.RegistryUsageSnapshotRepository,
+	spaceFinder refcache.SpaceFinder,
+) *Service {
+	return &Service{
+		registryDao:      registryDao,
+		bandwidthStatDao: bandwidthStatDao,
+		snapshotDao:      snapshotDao,
+		spaceFinder:      spaceFinder,
+	}
+}
+
+// RollUp returns the current usage of the space and of all spaces below it which contain registries,
+// ordered by space path. The bandwidth is counted from the start of the calendar month of now.
+func (s *Service) RollUp(
+	ctx context.Context,
+	space *types.SpaceCore,
+	now time.Time,
+) ([]registrytypes.RegistryUsage, error) {
+	rootSpaceID := space.ID
+	if space.ParentID > 0 {
+		// registries are listed by account, the usage of the other spaces of the account is dropped below.
+		root, err := s.rootSpace(ctx, space)
+		if err != nil {
+			return nil, err
+		}
+		rootSpaceID = root.ID
+	}
+
+	registries, err := s.registryDao.ListSpaces(ctx, rootSpaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list registries of space %d: %w", rootSpaceID, err)
+	}
+
+	usages, err := s.rollUp(ctx, registries, now)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]registrytypes.RegistryUsage, 0, len(usages))
+	for _, u := range usages {
+		if u.SpaceID == space.ID || strings.HasPrefix(u.SpacePath, space.Path+"/") {
+			result = append(result, u)
+		}
+	}
+	return result, nil
+}
+
+// Snapshot persists the usage of every space which contains registries as the usage of the day of now,
+// and returns the number of persisted snapshots. Accounts whose usage fails are skipped.
+func (s *Service) Snapshot(ctx context.Context, now time.Time) (int, error) {
+	registries, err := s.registryDao.ListSpaces(ctx, 0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list registries: %w", err)
+	}
+
+	byRoot := make(map[int64][]registrytypes.RegistrySpaces)
+	for _, r := range registries {
+		byRoot[r.RootParentID] = append(byRoot[r.RootParentID], r)
+	}
+
+	count := 0
+	for rootSpaceID, rootRegistries := range byRoot {
+		usages, err := s.rollUp(ctx, rootRegistries, now)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to roll up registry usage of space %d", rootSpaceID)
+			continue
+		}
+
+		snapshots := make([]registrytypes.RegistryUsageSnapshot, len(usages))
+		for i, u := range usages {
+			snapshots[i] = registrytypes.RegistryUsageSnapshot{
+				RegistryUsage: u,
+				Day:           now,
+				Created:       time.Now(),
+			}
+		}
+		if err = s.snapshotDao.Upsert(ctx, snapshots); err != nil {
+			return count, fmt.Errorf("failed to store registry usage snapshots of space %d: %w", rootSpaceID, err)
+		}
+		count += len(snapshots)
+	}
+
+	return count, nil
+}
+
+// History returns the persisted usage of the space for the days in [from, to).
+func (s *Service) History(
+	ctx context.Context,
+	space *types.SpaceCore,
+	from time.Time,
+	to time.Time,
+) ([]registrytypes.RegistryUsageSnapshot, error) {
+	snapshots, err := s.snapshotDao.List(ctx, space.ID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list registry usage snapshots of space %d: %w", space.ID, err)
+	}
+	for i := range snapshots {
+		snapshots[i].SpacePath = space.Path
+	}
+	return snapshots, nil
+}
+
+func (s *Service) rollUp(
+	ctx context.Context,
+	registries []registrytypes.RegistrySpaces,
+	now time.Time,
+) ([]registrytypes.RegistryUsage, error) {
+	monthStart := time.Date(now.UTC().Year(), now.UTC().Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	storage := make(map[int64]int64, len(registries))
+	bandwidth := make(map[int64]int64, len(registries))
+	for start := 0; start < len(registries); start += batchSize {
+		end := min(start+batchSize, len(registries))
+		ids := make([]int64, 0, end-start)
+		for _, r := range registries[start:end] {
+			ids = append(ids, r.RegistryID)
+		}
+
+		sizes, err := s.registryDao.GetStorageSizes(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get storage sizes: %w", err)
+		}
+		downloaded, err := s.bandwidthStatDao.GetTotalBytesByRegistries(
+			ctx, ids, registrytypes.BandwidthTypeDOWNLOAD, monthStart)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bandwidth usage: %w", err)
+		}
+		for _, id := range ids {
+			storage[id] = sizes[id]
+			bandwidth[id] = downloaded[id]
+		}
+	}
+
+	return rollUp(ctx, registries, storage, bandwidth, s.spaceFinder.FindByID)
+}
+
+// rollUp adds the usage of every registry to its space and to all spaces above it.
+func rollUp(
+	ctx context.Context,
+	registries []registrytypes.RegistrySpaces,
+	storage map[int64]int64,
+	bandwidth map[int64]int64,
+	findSpace func(ctx context.Context, spaceID int64) (*types.SpaceCore, error),
+) ([]registrytypes.RegistryUsage, error) {
+	usages := make(map[int64]*registrytypes.RegistryUsage)
+	spaces := make(map[int64]*types.SpaceCore)
+
+	for _, r := range registries {
+		for spaceID := r.ParentID; spaceID > 0; {
+			space, ok := spaces[spaceID]
+			if !ok {
+				var err error
+				space, err = findSpace(ctx, spaceID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to find space %d: %w", spaceID, err)
+				}
+				spaces[spaceID] = space
+			}
+
+			usage, ok := usages[spaceID]
+			if !ok {
+				usage = &registrytypes.RegistryUsage{SpaceID: space.ID, SpacePath: space.Path}
+				usages[spaceID] = usage
+			}
+			usage.RegistryCount++
+			usage.StorageBytes += storage[r.RegistryID]
+			usage.BandwidthBytes += bandwidth[r.RegistryID]
+
+			spaceID = space.ParentID
+		}
+	}
+
+	result := make([]registrytypes.RegistryUsage, 0, len(usages))
+	for _, u := range usages {
+		result = append(result, *u)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].SpacePath < result[j].SpacePath
+	})
+	return result, nil
+}
+
+func (s *Service) rootSpace(ctx context.Context, space *types.SpaceCore) (*types.SpaceCore, error) {
+	for space.ParentID > 0 {
+		parent, err := s.spaceFinder.FindByID(ctx, space.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find space %d: %w", space.ParentID, err)
+		}
+		space = parent
+	}
+	return space, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registryusage
+
+import (
+	"context"
+	"testing"
+
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollUp(t *testing.T) {
+	spaces := map[int64]*types.SpaceCore{
+		1: {ID: 1, Path: "acc"},
+		2: {ID: 2, ParentID: 1, Path: "acc/org"},
+		3: {ID: 3, ParentID: 2, Path: "acc/org/proj"},
+	}
+	var lookups int
+	findSpace := func(_ context.Context, spaceID int64) (*types.SpaceCore, error) {
+		lookups++
+		space, ok := spaces[spaceID]
+		if !ok {
+			return nil, store.ErrResourceNotFound
+		}
+		return space, nil
+	}
+
+	registries := []registrytypes.RegistrySpaces{
+		{RegistryID: 10, ParentID: 3, RootParentID: 1},
+		{RegistryID: 11, ParentID: 3, RootParentID: 1},
+		{RegistryID: 12, ParentID: 1, RootParentID: 1},
+	}
+	storage := map[int64]int64{10: 100, 11: 200, 12: 5}
+	bandwidth := map[int64]int64{10: 1, 12: 2}
+
+	usages, err := rollUp(context.Background(), registries, storage, bandwidth, findSpace)
+	require.NoError(t, err)
+	require.Equal(t, []registrytypes.RegistryUsage{
+		{SpaceID: 1, SpacePath: "acc", RegistryCount: 3, StorageBytes: 305, BandwidthBytes: 3},
+		{SpaceID: 2, SpacePath: "acc/org", RegistryCount: 2, StorageBytes: 300, BandwidthBytes: 1},
+		{SpaceID: 3, SpacePath: "acc/org/proj", RegistryCount: 2, StorageBytes: 300, BandwidthBytes: 1},
+	}, usages)
+	require.Equal(t, 3, lookups, "every space should be looked up once")
+
+	_, err = rollUp(context.Background(), []registrytypes.RegistrySpaces{{RegistryID: 13, ParentID: 4}},
+		storage, bandwidth, findSpace)
+	require.ErrorIs(t, err, store.ErrResourceNotFound)
+}
@@ -0,0 +1,156 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reindexing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registryevents "github.com/harness/gitness/registry/app/events/artifact"
+	"github.com/harness/gitness/registry/app/pkg/dispatch"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/stream"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	eventsReaderGroupName = "gitness:registry:reindexing"
+)
+
+type Config struct {
+	EventReaderName string
+	Concurrency     int
+	MaxRetries      int
+}
+
+// Prepare validates the configuration.
+func (c *Config) Prepare() error {
+	if c == nil {
+		return errors.New("config is required")
+	}
+	if c.EventReaderName == "" {
+		return errors.New("config.EventReaderName is required")
+	}
+	if c.Concurrency < 1 {
+		return errors.New("config.Concurrency has to be a positive number")
+	}
+	if c.MaxRetries < 0 {
+		return errors.New("config.MaxRetries can't be negative")
+	}
+	return nil
+}
+
+// indexBuilder schedules the index builds, it's implemented by the post processing reporter.
+type indexBuilder interface {
+	BuildRegistryIndexWithPrincipal(
+		ctx context.Context, registryID int64, sources []types.SourceRef, principalID int64,
+	)
+	BuildPackageIndexWithPrincipal(
+		ctx context.Context, registryID int64, image string, sources []types.SourceRef, principalID int64,
+	)
+}
+
+// Service keeps the package indexes accurate while versions are moved to the trash and restored from it.
+// The indexes which list the version are rebuilt, so clients neither resolve versions in the trash nor miss
+// restored ones.
+type Service struct {
+	indexBuilder indexBuilder
+}
+
+func NewService(
+	ctx context.Context,
+	config Config,
+	artifactsReaderFactory *events.ReaderFactory[*registryevents.Reader],
+	indexBuilder indexBuilder,
+) (*Service, error) {
+	if err := config.Prepare(); err != nil {
+		return nil, fmt.Errorf("provided reindexing service config is invalid: %w", err)
+	}
+
+	service := &Service{
+		indexBuilder: indexBuilder,
+	}
+
+	_, err := artifactsReaderFactory.Launch(ctx, eventsReaderGroupName, config.EventReaderName,
+		func(r *registryevents.Reader) error {
+			const idleTimeout = 1 * time.Minute
+			r.Configure(
+				stream.WithConcurrency(config.Concurrency),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(config.MaxRetries),
+				))
+
+			_ = r.RegisterArtifactSoftDeleted(service.handleEventArtifactSoftDeleted)
+			_ = r.RegisterArtifactRestored(service.handleEventArtifactRestored)
+
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to launch registry event reader for reindexing: %w", err)
+	}
+
+	return service, nil
+}
+
+func (s *Service) handleEventArtifactSoftDeleted(
+	ctx context.Context,
+	event *events.Event[*registryevents.ArtifactSoftDeletedPayload],
+) error {
+	p := event.Payload
+	s.reindex(ctx, p.RegistryID, p.ArtifactType, p.Image, p.PrincipalID)
+	return nil
+}
+
+func (s *Service) handleEventArtifactRestored(
+	ctx context.Context,
+	event *events.Event[*registryevents.ArtifactRestoredPayload],
+) error {
+	p := event.Payload
+	s.reindex(ctx, p.RegistryID, p.ArtifactType, p.Image, p.PrincipalID)
+	return nil
+}
+
+// reindex schedules the build of the index which lists the versions of the image. The builds are deduplicated
+// by the post processing, so a burst of events only rebuilds an index once.
+func (s *Service) reindex(
+	ctx context.Context,
+	registryID int64,
+	packageType artifact.PackageType,
+	image string,
+	principalID int64,
+) {
+	if !dispatch.IsRegistered(packageType) {
+		// plugins rebuild the index of the image, it's a no-op for the ones without indexes.
+		s.indexBuilder.BuildPackageIndexWithPrincipal(ctx, registryID, image, nil, principalID)
+		return
+	}
+
+	//nolint:exhaustive
+	switch dispatch.For(packageType).Indexing {
+	case dispatch.IndexRPM:
+		s.indexBuilder.BuildRegistryIndexWithPrincipal(ctx, registryID, nil, principalID)
+	case dispatch.IndexGo:
+		s.indexBuilder.BuildPackageIndexWithPrincipal(ctx, registryID, image, nil, principalID)
+	default:
+		log.Ctx(ctx).Debug().Msgf("package type %s has no index to rebuild for %s in registry %d",
+			packageType, image, registryID)
+	}
+}
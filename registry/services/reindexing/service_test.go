@@ -0,0 +1,94 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reindexing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registryevents "github.com/harness/gitness/registry/app/events/artifact"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeIndexBuilder struct {
+	registryIndexes []int64
+	packageIndexes  []string
+}
+
+func (f *fakeIndexBuilder) BuildRegistryIndexWithPrincipal(
+	_ context.Context, registryID int64, _ []types.SourceRef, _ int64,
+) {
+	f.registryIndexes = append(f.registryIndexes, registryID)
+}
+
+func (f *fakeIndexBuilder) BuildPackageIndexWithPrincipal(
+	_ context.Context, _ int64, image string, _ []types.SourceRef, _ int64,
+) {
+	f.packageIndexes = append(f.packageIndexes, image)
+}
+
+func TestReindex(t *testing.T) {
+	tests := []struct {
+		name            string
+		packageType     artifact.PackageType
+		registryIndexes []int64
+		packageIndexes  []string
+	}{
+		{name: "rpm rebuilds the registry index", packageType: artifact.PackageTypeRPM, registryIndexes: []int64{7}},
+		{name: "go rebuilds the module index", packageType: artifact.PackageTypeGO, packageIndexes: []string{"mod"}},
+		{name: "plugins rebuild the package index", packageType: "conda", packageIndexes: []string{"mod"}},
+		{name: "docker has no index", packageType: artifact.PackageTypeDOCKER},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := &fakeIndexBuilder{}
+			s := &Service{indexBuilder: builder}
+
+			err := s.handleEventArtifactSoftDeleted(context.Background(),
+				&events.Event[*registryevents.ArtifactSoftDeletedPayload]{
+					Payload: &registryevents.ArtifactSoftDeletedPayload{
+						RegistryID:   7,
+						ArtifactType: tt.packageType,
+						Image:        "mod",
+						Version:      "v1.0.0",
+					},
+				})
+			require.NoError(t, err)
+			require.Equal(t, tt.registryIndexes, builder.registryIndexes)
+			require.Equal(t, tt.packageIndexes, builder.packageIndexes)
+		})
+	}
+}
+
+func TestReindexRestored(t *testing.T) {
+	builder := &fakeIndexBuilder{}
+	s := &Service{indexBuilder: builder}
+
+	err := s.handleEventArtifactRestored(context.Background(),
+		&events.Event[*registryevents.ArtifactRestoredPayload]{
+			Payload: &registryevents.ArtifactRestoredPayload{
+				RegistryID:   7,
+				ArtifactType: artifact.PackageTypeRPM,
+				Image:        "pkg",
+				Version:      "1.0.0",
+			},
+		})
+	require.NoError(t, err)
+	require.Equal(t, []int64{7}, builder.registryIndexes)
+}
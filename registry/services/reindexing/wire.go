@@ -0,0 +1,49 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reindexing
+
+import (
+	"context"
+
+	"github.com/harness/gitness/events"
+	registryevents "github.com/harness/gitness/registry/app/events/artifact"
+	registrypostprocessingevents "github.com/harness/gitness/registry/app/events/asyncprocessing"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideConfig,
+	ProvideService,
+)
+
+func ProvideConfig(config *types.Config) Config {
+	return Config{
+		EventReaderName: config.InstanceID,
+		Concurrency:     config.Registry.Reindexing.Concurrency,
+		MaxRetries:      config.Registry.Reindexing.MaxRetries,
+	}
+}
+
+func ProvideService(
+	ctx context.Context,
+	config Config,
+	artifactsReaderFactory *events.ReaderFactory[*registryevents.Reader],
+	postProcessingReporter *registrypostprocessingevents.Reporter,
+) (*Service, error) {
+	return NewService(ctx, config, artifactsReaderFactory, postProcessingReporter)
+}
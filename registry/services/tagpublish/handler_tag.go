@@ -0,0 +1,209 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tagpublish
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	gitevents "github.com/harness/gitness/app/events/git"
+	"github.com/harness/gitness/app/paths"
+	"github.com/harness/gitness/app/services/settings"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/git/api"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	genericmetadata "github.com/harness/gitness/registry/app/metadata/generic"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/types/generic"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/rs/zerolog/log"
+)
+
+const refsTagPrefix = "refs/tags/"
+
+// handleEventTagCreated publishes the archive of the created tag, if the repository is configured to do so.
+func (s *Service) handleEventTagCreated(
+	ctx context.Context,
+	event *events.Event[*gitevents.TagCreatedPayload],
+) error {
+	repo, err := s.repoFinder.FindByID(ctx, event.Payload.RepoID)
+	if err != nil {
+		return fmt.Errorf("failed to find repo: %w", err)
+	}
+
+	pattern, err := settings.RepoGet(
+		ctx,
+		s.settings,
+		repo.ID,
+		settings.KeyArtifactPublishTagPattern,
+		settings.DefaultArtifactPublishTagPattern,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to check settings for artifact publish tag pattern: %w", err)
+	}
+
+	registryIdentifier, err := settings.RepoGet(
+		ctx,
+		s.settings,
+		repo.ID,
+		settings.KeyArtifactPublishRegistry,
+		settings.DefaultArtifactPublishRegistry,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to check settings for artifact publish registry: %w", err)
+	}
+
+	if pattern == "" || registryIdentifier == "" {
+		return nil
+	}
+
+	tagName := strings.TrimPrefix(event.Payload.Ref, refsTagPrefix)
+	if ok, _ := doublestar.Match(pattern, tagName); !ok {
+		return nil
+	}
+
+	logger := log.Ctx(ctx).With().
+		Str("repo_path", repo.Path).
+		Str("tag", tagName).
+		Str("registry", registryIdentifier).
+		Logger()
+
+	// the tag name is used as the version of the artifact, which can't hold a path.
+	if strings.Contains(tagName, "/") {
+		logger.Warn().Msg("skipped publishing the tag, tags containing '/' can't be used as artifact version")
+		return nil
+	}
+
+	principal, err := s.principalStore.Find(ctx, event.Payload.PrincipalID)
+	if err != nil {
+		return fmt.Errorf("failed to find principal who created the tag: %w", err)
+	}
+
+	// the artifact is published on behalf of the principal who pushed the tag.
+	ctx = request.WithAuthSession(ctx, &auth.Session{Principal: *principal})
+
+	info, err := s.getArtifactInfo(ctx, repo, registryIdentifier, tagName)
+	if err != nil {
+		logger.Warn().Err(err).Msg("skipped publishing the tag")
+		return nil
+	}
+
+	info.Source = &genericmetadata.Source{
+		RepoPath:  repo.Path,
+		Ref:       event.Payload.Ref,
+		CommitSHA: event.Payload.SHA,
+	}
+
+	if err = s.publish(ctx, repo, event.Payload.SHA, info); err != nil {
+		return fmt.Errorf("failed to publish tag %q of repo %q: %w", tagName, repo.Path, err)
+	}
+
+	logger.Info().Msg("published the tag as generic artifact")
+
+	return nil
+}
+
+// getArtifactInfo returns the info of the artifact for the tag of the repository,
+// after checking the principal of the session can upload to the registry.
+func (s *Service) getArtifactInfo(
+	ctx context.Context,
+	repo *types.RepositoryCore,
+	registryIdentifier string,
+	tagName string,
+) (generic.ArtifactInfo, error) {
+	rootRef, _, err := paths.DisectRoot(repo.Path)
+	if err != nil {
+		return generic.ArtifactInfo{}, fmt.Errorf("failed to get root space of repo: %w", err)
+	}
+
+	rootSpace, err := s.spaceFinder.FindByRef(ctx, rootRef)
+	if err != nil {
+		return generic.ArtifactInfo{}, fmt.Errorf("failed to find root space: %w", err)
+	}
+
+	registry, err := s.registryFinder.FindByRootParentID(ctx, rootSpace.ID, registryIdentifier)
+	if err != nil {
+		return generic.ArtifactInfo{}, fmt.Errorf("failed to find registry: %w", err)
+	}
+
+	if registry.PackageType != artifact.PackageTypeGENERIC || registry.Type != artifact.RegistryTypeVIRTUAL {
+		return generic.ArtifactInfo{}, fmt.Errorf("registry %q isn't a generic artifact registry", registry.Name)
+	}
+
+	fileName := fmt.Sprintf("%s-%s.%s", repo.Identifier, tagName, api.ArchiveFormatTarGz)
+
+	info := generic.ArtifactInfo{
+		ArtifactInfo: pkg.ArtifactInfo{
+			BaseInfo: &pkg.BaseInfo{
+				PathPackageType: registry.PackageType,
+				ParentID:        registry.ParentID,
+				RootIdentifier:  rootSpace.Identifier,
+				RootParentID:    rootSpace.ID,
+			},
+			RegIdentifier: registry.Name,
+			RegistryID:    registry.ID,
+			Registry:      *registry,
+			Image:         repo.Identifier,
+		},
+		FileName: fileName,
+		FilePath: fileName,
+		Version:  tagName,
+	}
+
+	err = pkg.GetRegistryCheckAccess(ctx, s.authorizer, s.spaceFinder, registry.ParentID, info.ArtifactInfo,
+		enum.PermissionArtifactsUpload)
+	if err != nil {
+		return generic.ArtifactInfo{}, err
+	}
+
+	return info, nil
+}
+
+// publish streams the archive of the commit to the registry.
+func (s *Service) publish(
+	ctx context.Context,
+	repo *types.RepositoryCore,
+	sha string,
+	info generic.ArtifactInfo,
+) error {
+	pr, pw := io.Pipe()
+
+	go func() {
+		err := s.git.Archive(ctx, git.ArchiveParams{
+			ReadParams: git.ReadParams{RepoUID: repo.GitUID},
+			ArchiveParams: api.ArchiveParams{
+				Format:  api.ArchiveFormatTarGz,
+				Prefix:  repo.Identifier + "-" + info.Version,
+				Treeish: sha,
+			},
+		}, pw)
+		_ = pw.CloseWithError(err)
+	}()
+
+	rsp := s.genericController.PutFile(ctx, info, pr, "application/gzip")
+
+	// unblocks the archive if the upload stopped reading early.
+	_ = pr.CloseWithError(io.ErrClosedPipe)
+
+	return rsp.GetError()
+}
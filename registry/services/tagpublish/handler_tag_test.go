@@ -0,0 +1,288 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tagpublish
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/authz"
+	gitevents "github.com/harness/gitness/app/events/git"
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/app/services/settings"
+	"github.com/harness/gitness/app/store"
+	appcache "github.com/harness/gitness/app/store/cache"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/git/api"
+	"github.com/harness/gitness/registry/app/api/controller/pkg/generic"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	genericartifact "github.com/harness/gitness/registry/app/pkg/types/generic"
+	registryrefcache "github.com/harness/gitness/registry/app/services/refcache"
+	registrytypes "github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCache[K comparable, V any] map[K]V
+
+func (f fakeCache[K, V]) Stats() (int64, int64) {
+	return 0, 0
+}
+
+func (f fakeCache[K, V]) Get(_ context.Context, key K) (V, error) {
+	v, ok := f[key]
+	if !ok {
+		return v, gitnessstore.ErrResourceNotFound
+	}
+	return v, nil
+}
+
+func (f fakeCache[K, V]) Evict(context.Context, K) {}
+
+type fakeSettingsStore struct {
+	store.SettingsStore
+	values map[string]json.RawMessage
+}
+
+func (f *fakeSettingsStore) Find(
+	_ context.Context, _ enum.SettingsScope, _ int64, key string,
+) (json.RawMessage, error) {
+	v, ok := f.values[key]
+	if !ok {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return v, nil
+}
+
+type fakeRegistryFinder struct {
+	registryrefcache.RegistryFinder
+	registry *registrytypes.Registry
+}
+
+func (f *fakeRegistryFinder) FindByRootParentID(
+	_ context.Context, rootParentID int64, regIdentifier string,
+) (*registrytypes.Registry, error) {
+	if rootParentID != f.registry.RootParentID || regIdentifier != f.registry.Name {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return f.registry, nil
+}
+
+type fakePrincipalStore struct {
+	store.PrincipalStore
+}
+
+func (f *fakePrincipalStore) Find(_ context.Context, id int64) (*types.Principal, error) {
+	return &types.Principal{ID: id}, nil
+}
+
+type fakeAuthorizer struct {
+	authz.Authorizer
+	allowed bool
+}
+
+func (f *fakeAuthorizer) CheckAll(context.Context, *auth.Session, ...types.PermissionCheck) (bool, error) {
+	return f.allowed, nil
+}
+
+type fakeGit struct {
+	git.Interface
+	params *git.ArchiveParams
+}
+
+func (f *fakeGit) Archive(_ context.Context, params git.ArchiveParams, w io.Writer) error {
+	f.params = &params
+	_, err := w.Write([]byte("archive"))
+	return err
+}
+
+type fakeUploader struct {
+	info    *genericartifact.ArtifactInfo
+	content string
+	err     error
+}
+
+func (f *fakeUploader) PutFile(
+	_ context.Context, info genericartifact.ArtifactInfo, reader io.ReadCloser, _ string,
+) *generic.PutArtifactResponse {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return &generic.PutArtifactResponse{BaseResponse: generic.BaseResponse{Error: err}}
+	}
+	f.info, f.content = &info, string(content)
+	return &generic.PutArtifactResponse{BaseResponse: generic.BaseResponse{Error: f.err}}
+}
+
+// newTestService returns a service for the repository root/app (7) publishing to the registry "releases" of the
+// root space (1).
+func newTestService(
+	settingValues map[string]string,
+	registry *registrytypes.Registry,
+	allowed bool,
+) (*Service, *fakeGit, *fakeUploader) {
+	values := map[string]json.RawMessage{}
+	for k, v := range settingValues {
+		raw, _ := json.Marshal(v)
+		values[k] = raw
+	}
+	repos := fakeCache[int64, *types.RepositoryCore]{
+		7: {ID: 7, Identifier: "app", Path: "root/app", GitUID: "git-uid"},
+	}
+	spaces := fakeCache[int64, *types.SpaceCore]{1: {ID: 1, Identifier: "root", Path: "root"}}
+	spacePaths := fakeCache[string, *types.SpacePath]{"root": {SpaceID: 1, Value: "root"}}
+
+	gitFake := &fakeGit{}
+	uploader := &fakeUploader{}
+	return &Service{
+		repoFinder: refcache.NewRepoFinder(nil, spacePaths, repos, nil,
+			appcache.Evictor[*types.RepositoryCore]{}),
+		spaceFinder: refcache.NewSpaceFinder(spaces, spacePaths, nil,
+			appcache.Evictor[*types.SpaceCore]{}),
+		registryFinder:    &fakeRegistryFinder{registry: registry},
+		principalStore:    &fakePrincipalStore{},
+		settings:          settings.NewService(&fakeSettingsStore{values: values}),
+		authorizer:        &fakeAuthorizer{allowed: allowed},
+		git:               gitFake,
+		genericController: uploader,
+	}, gitFake, uploader
+}
+
+func genericRegistry() *registrytypes.Registry {
+	return &registrytypes.Registry{
+		ID:           5,
+		Name:         "releases",
+		ParentID:     1,
+		RootParentID: 1,
+		PackageType:  artifact.PackageTypeGENERIC,
+		Type:         artifact.RegistryTypeVIRTUAL,
+	}
+}
+
+func tagCreated(tag string) *events.Event[*gitevents.TagCreatedPayload] {
+	return &events.Event[*gitevents.TagCreatedPayload]{
+		Payload: &gitevents.TagCreatedPayload{RepoID: 7, PrincipalID: 3, Ref: refsTagPrefix + tag, SHA: "abc123"},
+	}
+}
+
+func TestHandleEventTagCreated(t *testing.T) {
+	configured := map[string]string{
+		string(settings.KeyArtifactPublishTagPattern): "v*",
+		string(settings.KeyArtifactPublishRegistry):   "releases",
+	}
+	s, gitFake, uploader := newTestService(configured, genericRegistry(), true)
+
+	require.NoError(t, s.handleEventTagCreated(context.Background(), tagCreated("v1.2.0")))
+
+	require.NotNil(t, uploader.info, "the archive of the tag is published")
+	require.Equal(t, "archive", uploader.content)
+	require.Equal(t, "app", uploader.info.Image)
+	require.Equal(t, "v1.2.0", uploader.info.Version)
+	require.Equal(t, "app-v1.2.0.tar.gz", uploader.info.FileName)
+	require.Equal(t, "app-v1.2.0.tar.gz", uploader.info.FilePath)
+	require.Equal(t, int64(5), uploader.info.RegistryID)
+	require.Equal(t, "root", uploader.info.RootIdentifier)
+	require.Equal(t, "root/app", uploader.info.Source.RepoPath)
+	require.Equal(t, "refs/tags/v1.2.0", uploader.info.Source.Ref)
+	require.Equal(t, "abc123", uploader.info.Source.CommitSHA)
+
+	require.NotNil(t, gitFake.params)
+	require.Equal(t, "git-uid", gitFake.params.RepoUID)
+	require.Equal(t, api.ArchiveFormatTarGz, gitFake.params.Format)
+	require.Equal(t, "app-v1.2.0", gitFake.params.Prefix)
+	require.Equal(t, "abc123", gitFake.params.Treeish)
+}
+
+func TestHandleEventTagCreatedSkipped(t *testing.T) {
+	configured := map[string]string{
+		string(settings.KeyArtifactPublishTagPattern): "**",
+		string(settings.KeyArtifactPublishRegistry):   "releases",
+	}
+	upstream := genericRegistry()
+	upstream.Type = artifact.RegistryTypeUPSTREAM
+	docker := genericRegistry()
+	docker.PackageType = artifact.PackageTypeDOCKER
+
+	tests := []struct {
+		name     string
+		settings map[string]string
+		registry *registrytypes.Registry
+		allowed  bool
+		tag      string
+	}{
+		{name: "not configured", registry: genericRegistry(), allowed: true, tag: "v1"},
+		{
+			name: "no registry",
+			settings: map[string]string{
+				string(settings.KeyArtifactPublishTagPattern): "**",
+			},
+			registry: genericRegistry(),
+			allowed:  true,
+			tag:      "v1",
+		},
+		{
+			name: "tag not matching",
+			settings: map[string]string{
+				string(settings.KeyArtifactPublishTagPattern): "release-*",
+				string(settings.KeyArtifactPublishRegistry):   "releases",
+			},
+			registry: genericRegistry(),
+			allowed:  true,
+			tag:      "v1",
+		},
+		{name: "tag with a slash", settings: configured, registry: genericRegistry(), allowed: true, tag: "release/v1"},
+		{
+			name: "unknown registry",
+			settings: map[string]string{
+				string(settings.KeyArtifactPublishTagPattern): "**",
+				string(settings.KeyArtifactPublishRegistry):   "other",
+			},
+			registry: genericRegistry(),
+			allowed:  true,
+			tag:      "v1",
+		},
+		{name: "upstream registry", settings: configured, registry: upstream, allowed: true, tag: "v1"},
+		{name: "docker registry", settings: configured, registry: docker, allowed: true, tag: "v1"},
+		{name: "not allowed", settings: configured, registry: genericRegistry(), allowed: false, tag: "v1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, gitFake, uploader := newTestService(tt.settings, tt.registry, tt.allowed)
+
+			require.NoError(t, s.handleEventTagCreated(context.Background(), tagCreated(tt.tag)))
+			require.Nil(t, uploader.info, "the tag isn't published")
+			require.Nil(t, gitFake.params, "no archive is built")
+		})
+	}
+}
+
+func TestHandleEventTagCreatedUploadFailure(t *testing.T) {
+	configured := map[string]string{
+		string(settings.KeyArtifactPublishTagPattern): "v*",
+		string(settings.KeyArtifactPublishRegistry):   "releases",
+	}
+	s, _, uploader := newTestService(configured, genericRegistry(), true)
+	uploader.err = errors.New("storage down")
+
+	err := s.handleEventTagCreated(context.Background(), tagCreated("v1"))
+	require.ErrorContains(t, err, "storage down", "the event is retried when the upload fails")
+}
@@ -0,0 +1,138 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tagpublish
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/harness/gitness/app/auth/authz"
+	gitevents "github.com/harness/gitness/app/events/git"
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/app/services/settings"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/registry/app/api/controller/pkg/generic"
+	genericartifact "github.com/harness/gitness/registry/app/pkg/types/generic"
+	registryrefcache "github.com/harness/gitness/registry/app/services/refcache"
+	"github.com/harness/gitness/stream"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	eventsReaderGroupName = "gitness:registry:tagpublish"
+)
+
+type Config struct {
+	EventReaderName string
+	Concurrency     int
+	MaxRetries      int
+}
+
+// Prepare validates the configuration.
+func (c *Config) Prepare() error {
+	if c == nil {
+		return errors.New("config is required")
+	}
+	if c.EventReaderName == "" {
+		return errors.New("config.EventReaderName is required")
+	}
+	if c.Concurrency < 1 {
+		return errors.New("config.Concurrency has to be a positive number")
+	}
+	if c.MaxRetries < 0 {
+		return errors.New("config.MaxRetries can't be negative")
+	}
+	return nil
+}
+
+// fileUploader uploads the files of generic artifacts, it's implemented by the generic controller.
+type fileUploader interface {
+	PutFile(
+		ctx context.Context,
+		info genericartifact.ArtifactInfo,
+		reader io.ReadCloser,
+		contentType string,
+	) *generic.PutArtifactResponse
+}
+
+// Service publishes an archive of the created tags of a repository as a generic artifact,
+// if the tag matches the artifact publish tag pattern configured in the repository settings.
+type Service struct {
+	repoFinder        refcache.RepoFinder
+	spaceFinder       refcache.SpaceFinder
+	registryFinder    registryrefcache.RegistryFinder
+	principalStore    store.PrincipalStore
+	settings          *settings.Service
+	authorizer        authz.Authorizer
+	git               git.Interface
+	genericController fileUploader
+}
+
+func NewService(
+	ctx context.Context,
+	config Config,
+	gitReaderFactory *events.ReaderFactory[*gitevents.Reader],
+	repoFinder refcache.RepoFinder,
+	spaceFinder refcache.SpaceFinder,
+	registryFinder registryrefcache.RegistryFinder,
+	principalStore store.PrincipalStore,
+	settingsService *settings.Service,
+	authorizer authz.Authorizer,
+	git git.Interface,
+	genericController *generic.Controller,
+) (*Service, error) {
+	if err := config.Prepare(); err != nil {
+		return nil, fmt.Errorf("provided tag publish service config is invalid: %w", err)
+	}
+	log.Ctx(ctx).Info().Msgf("[tag publish service] event reader name: %s, concurrency: %d, maxRetries: %d",
+		config.EventReaderName, config.Concurrency, config.MaxRetries)
+
+	service := &Service{
+		repoFinder:        repoFinder,
+		spaceFinder:       spaceFinder,
+		registryFinder:    registryFinder,
+		principalStore:    principalStore,
+		settings:          settingsService,
+		authorizer:        authorizer,
+		git:               git,
+		genericController: genericController,
+	}
+
+	_, err := gitReaderFactory.Launch(ctx, eventsReaderGroupName, config.EventReaderName,
+		func(r *gitevents.Reader) error {
+			const idleTimeout = 5 * time.Minute
+			r.Configure(
+				stream.WithConcurrency(config.Concurrency),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(config.MaxRetries),
+				))
+
+			_ = r.RegisterTagCreated(service.handleEventTagCreated)
+
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to launch git events reader: %w", err)
+	}
+
+	return service, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tagpublish
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/auth/authz"
+	gitevents "github.com/harness/gitness/app/events/git"
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/app/services/settings"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/registry/app/api/controller/pkg/generic"
+	registryrefcache "github.com/harness/gitness/registry/app/services/refcache"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideConfig,
+	ProvideService,
+)
+
+func ProvideConfig(config *types.Config) Config {
+	return Config{
+		EventReaderName: config.InstanceID,
+		Concurrency:     config.Registry.TagPublish.Concurrency,
+		MaxRetries:      config.Registry.TagPublish.MaxRetries,
+	}
+}
+
+func ProvideService(
+	ctx context.Context,
+	config Config,
+	gitReaderFactory *events.ReaderFactory[*gitevents.Reader],
+	repoFinder refcache.RepoFinder,
+	spaceFinder refcache.SpaceFinder,
+	registryFinder registryrefcache.RegistryFinder,
+	principalStore store.PrincipalStore,
+	settingsService *settings.Service,
+	authorizer authz.Authorizer,
+	git git.Interface,
+	genericController *generic.Controller,
+) (*Service, error) {
+	return NewService(
+		ctx,
+		config,
+		gitReaderFactory,
+		repoFinder,
+		spaceFinder,
+		registryFinder,
+		principalStore,
+		settingsService,
+		authorizer,
+		git,
+		genericController,
+	)
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trash
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/manifest/manifestlist"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/gc"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	manifestDeleteGCReviewWindow = 1 * time.Hour
+	manifestDeleteGCLockTimeout  = 10 * time.Second
+)
+
+// Service purges the soft-deleted OCI tags and manifests from the trash, on request or once they were kept for
+// the retention.
+type Service struct {
+	tx          dbtx.Transactor
+	manifestDao store.ManifestRepository
+	tagDao      store.TagRepository
+	artifactDao store.ArtifactRepository
+	imageDao    store.ImageRepository
+	gcService   gc.Service
+	retention   time.Duration
+}
+
+func NewService(
+	tx dbtx.Transactor,
+	manifestDao store.ManifestRepository,
+	tagDao store.TagRepository,
+	artifactDao store.ArtifactRepository,
+	imageDao store.ImageRepository,
+	gcService gc.Service,
+	retention time.Duration,
+) *Service {
+	return &Service{
+		tx:          tx,
+		manifestDao: manifestDao,
+		tagDao:      tagDao,
+		artifactDao: artifactDao,
+		imageDao:    imageDao,
+		gcService:   gcService,
+		retention:   retention,
+	}
+}
+
+// PurgeManifest permanently deletes a soft-deleted manifest along with its tags and artifact, and the image once
+// it has no manifests left. The blobs it referenced are left to the garbage collector.
+func (s *Service) PurgeManifest(ctx context.Context, m *types.Manifest) error {
+	dgst, err := types.NewDigest(m.Digest)
+	if err != nil {
+		return err
+	}
+
+	return s.tx.WithTx(ctx, func(ctx context.Context) error {
+		if m.MediaType == manifestlist.MediaTypeManifestList || m.MediaType == v1.MediaTypeImageIndex {
+			if err := s.lockReferences(ctx, m); err != nil {
+				return err
+			}
+		}
+
+		if err := s.manifestDao.Delete(ctx, m.RegistryID, m.ID); err != nil {
+			return fmt.Errorf("failed to delete manifest: %w", err)
+		}
+		err := s.artifactDao.DeleteByVersionAndImageName(ctx, m.ImageName, dgst.String(), m.RegistryID)
+		if err != nil {
+			return fmt.Errorf("failed to delete artifact: %w", err)
+		}
+
+		// the image is kept as long as it has manifests, in the trash or not.
+		count, err := s.manifestDao.CountByImageName(ctx, m.RegistryID, m.ImageName,
+			types.WithSoftDeleteFilter(types.SoftDeleteFilterInclude))
+		if err != nil {
+			return fmt.Errorf("failed to count manifests of image: %w", err)
+		}
+		if count < 1 {
+			if err = s.imageDao.DeleteByImageNameAndRegID(ctx, m.RegistryID, m.ImageName); err != nil {
+				return fmt.Errorf("failed to delete image: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// lockReferences locks the review records of the manifests referenced by a manifest list, so the online GC
+// doesn't delete them while the list is being deleted.
+func (s *Service) lockReferences(ctx context.Context, m *types.Manifest) error {
+	mm, err := s.manifestDao.References(ctx, m)
+	if err != nil {
+		return err
+	}
+	if len(mm) == 0 {
+		log.Ctx(ctx).Error().Msgf("stored manifest list %d has no references", m.ID)
+		return nil
+	}
+	ids := make([]int64, 0, len(mm))
+	for _, ref := range mm {
+		ids = append(ids, ref.ID)
+	}
+
+	// the purge is aborted rather than holding the transaction open for too long if the GC holds the lock.
+	ctx, cancel := context.WithTimeout(ctx, manifestDeleteGCLockTimeout)
+	defer cancel()
+
+	_, err = s.gcService.ManifestFindAndLockNBefore(ctx, m.RegistryID, ids,
+		time.Now().Add(manifestDeleteGCReviewWindow))
+	return err
+}
+
+// PurgeExpired purges up to limit manifests and up to limit tags which were kept in the trash for longer than the
+// retention, it returns the number of purged entries. Nothing expires if the retention is zero.
+func (s *Service) PurgeExpired(ctx context.Context, now time.Time, limit int) (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	before := now.Add(-s.retention)
+
+	manifests, err := s.manifestDao.ListDeletedBefore(ctx, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired manifests: %w", err)
+	}
+	purged := 0
+	for _, m := range manifests {
+		if err = s.PurgeManifest(ctx, m); err != nil {
+			return purged, fmt.Errorf("failed to purge manifest %d: %w", m.ID, err)
+		}
+		purged++
+	}
+
+	tags, err := s.tagDao.PurgeDeletedBefore(ctx, before, limit)
+	purged += int(tags)
+	if err != nil {
+		return purged, fmt.Errorf("failed to purge expired tags: %w", err)
+	}
+	return purged, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trash_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/gc"
+	"github.com/harness/gitness/registry/services/trash"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testDigest = digest.Digest("sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b")
+
+type fakeTx struct{}
+
+func (fakeTx) WithTx(ctx context.Context, txFn func(ctx context.Context) error, _ ...any) error {
+	return txFn(ctx)
+}
+
+// fakeGC records the manifests whose review records were locked.
+type fakeGC struct {
+	gc.Noop
+	locked []int64
+}
+
+func (f *fakeGC) ManifestFindAndLockNBefore(
+	_ context.Context, _ int64, manifestIDs []int64, _ time.Time,
+) ([]*types.GCManifestTask, error) {
+	f.locked = append(f.locked, manifestIDs...)
+	return nil, nil
+}
+
+type testService struct {
+	*trash.Service
+	manifests *mocks.MockManifestRepository
+	tags      *mocks.MockTagRepository
+	artifacts *mocks.ArtifactRepository
+	images    *mocks.ImageRepository
+	gc        *fakeGC
+}
+
+func newTestService(t *testing.T, retention time.Duration) testService {
+	s := testService{
+		manifests: mocks.NewMockManifestRepository(t),
+		tags:      mocks.NewMockTagRepository(t),
+		artifacts: new(mocks.ArtifactRepository),
+		images:    new(mocks.ImageRepository),
+		gc:        &fakeGC{},
+	}
+	t.Cleanup(func() {
+		s.artifacts.AssertExpectations(t)
+		s.images.AssertExpectations(t)
+	})
+	s.Service = trash.NewService(fakeTx{}, s.manifests, s.tags, s.artifacts, s.images, s.gc, retention)
+	return s
+}
+
+// expectPurge sets up the deletes of the manifest and its artifact, count is the number of manifests the image
+// has left.
+func (s testService) expectPurge(m *types.Manifest, count int64) {
+	// the artifact versions of OCI images are the digests as stored in the database.
+	version, _ := types.NewDigest(m.Digest)
+	s.manifests.EXPECT().Delete(mock.Anything, m.RegistryID, m.ID).Return(nil).Once()
+	s.artifacts.On("DeleteByVersionAndImageName", mock.Anything, m.ImageName, version.String(), m.RegistryID).
+		Return(nil).Once()
+	s.manifests.EXPECT().CountByImageName(mock.Anything, m.RegistryID, m.ImageName, mock.Anything).
+		Return(count, nil).Once()
+}
+
+func TestPurgeManifest(t *testing.T) {
+	s := newTestService(t, time.Hour)
+	m := &types.Manifest{ID: 5, RegistryID: 1, ImageName: "app", Digest: testDigest, MediaType: v1.MediaTypeImageManifest}
+
+	// the image keeps the manifests left in the trash.
+	s.expectPurge(m, 1)
+	require.NoError(t, s.PurgeManifest(context.Background(), m))
+	require.Empty(t, s.gc.locked)
+
+	// the image is deleted along with its last manifest.
+	s.expectPurge(m, 0)
+	s.images.On("DeleteByImageNameAndRegID", mock.Anything, int64(1), "app").Return(nil).Once()
+	require.NoError(t, s.PurgeManifest(context.Background(), m))
+}
+
+func TestPurgeManifestListLocksReferences(t *testing.T) {
+	s := newTestService(t, time.Hour)
+	m := &types.Manifest{ID: 5, RegistryID: 1, ImageName: "app", Digest: testDigest, MediaType: v1.MediaTypeImageIndex}
+
+	s.manifests.EXPECT().References(mock.Anything, m).
+		Return(types.Manifests{{ID: 6}, {ID: 7}}, nil).Once()
+	s.expectPurge(m, 1)
+
+	require.NoError(t, s.PurgeManifest(context.Background(), m))
+	require.Equal(t, []int64{6, 7}, s.gc.locked)
+}
+
+func TestPurgeManifestFails(t *testing.T) {
+	s := newTestService(t, time.Hour)
+	m := &types.Manifest{ID: 5, RegistryID: 1, ImageName: "app", Digest: testDigest, MediaType: v1.MediaTypeImageManifest}
+
+	s.manifests.EXPECT().Delete(mock.Anything, int64(1), int64(5)).Return(errors.New("db down")).Once()
+
+	require.ErrorContains(t, s.PurgeManifest(context.Background(), m), "db down")
+}
+
+func TestPurgeExpired(t *testing.T) {
+	s := newTestService(t, time.Hour)
+	now := time.Now()
+	m := &types.Manifest{ID: 5, RegistryID: 1, ImageName: "app", Digest: testDigest, MediaType: v1.MediaTypeImageManifest}
+
+	s.manifests.EXPECT().ListDeletedBefore(mock.Anything, now.Add(-time.Hour), 10).
+		Return(types.Manifests{m}, nil).Once()
+	s.expectPurge(m, 1)
+	s.tags.EXPECT().PurgeDeletedBefore(mock.Anything, now.Add(-time.Hour), 10).Return(3, nil).Once()
+
+	purged, err := s.PurgeExpired(context.Background(), now, 10)
+	require.NoError(t, err)
+	require.Equal(t, 4, purged)
+}
+
+func TestPurgeExpiredWithoutRetention(t *testing.T) {
+	s := newTestService(t, 0)
+
+	// nothing expires, the mocks fail the test if they are called.
+	purged, err := s.PurgeExpired(context.Background(), time.Now(), 10)
+	require.NoError(t, err)
+	require.Zero(t, purged)
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trash
+
+import (
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/gc"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	tx dbtx.Transactor,
+	manifestDao store.ManifestRepository,
+	tagDao store.TagRepository,
+	artifactDao store.ArtifactRepository,
+	imageDao store.ImageRepository,
+	gcService gc.Service,
+	config *types.Config,
+) *Service {
+	return NewService(
+		tx,
+		manifestDao,
+		tagDao,
+		artifactDao,
+		imageDao,
+		gcService,
+		config.Registry.Trash.Retention,
+	)
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upstreamprovenance
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	registrystore "github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+)
+
+// Service records where the files cached by the upstream proxies were fetched from, when they were first cached
+// and the checksums the upstream advertised for them. Security reviews tell the proxied versions apart from the
+// versions published to the registries with it.
+type Service struct {
+	artifactDao   registrystore.ArtifactRepository
+	provenanceDao registrystore.UpstreamProvenanceRepository
+}
+
+func NewService(
+	artifactDao registrystore.ArtifactRepository,
+	provenanceDao registrystore.UpstreamProvenanceRepository,
+) *Service {
+	return &Service{
+		artifactDao:   artifactDao,
+		provenanceDao: provenanceDao,
+	}
+}
+
+// Record stores the provenance of a file of the version cached in the registry. The provenance of a file cached
+// again, after it was evicted for instance, isn't replaced.
+func (s *Service) Record(
+	ctx context.Context,
+	registryID int64,
+	image string,
+	version string,
+	fileName string,
+	sourceURL string,
+	checksums map[string]string,
+) error {
+	art, err := s.artifactDao.GetByRegistryImageAndVersion(ctx, registryID, image, version, types.WithoutMetadata())
+	if err != nil {
+		return fmt.Errorf("failed to get version %s of %s: %w", version, image, err)
+	}
+	err = s.provenanceDao.Record(ctx, &types.UpstreamProvenance{
+		ArtifactID:  art.ID,
+		FileName:    fileName,
+		SourceURL:   sourceURL,
+		Checksums:   checksums,
+		FirstSeenAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record the upstream provenance of %s: %w", fileName, err)
+	}
+	return nil
+}
+
+// List lists the provenance of the files of the version, the first cached first. It's empty for a version which
+// wasn't cached from an upstream.
+func (s *Service) List(ctx context.Context, artifactID int64) ([]*types.UpstreamProvenance, error) {
+	provenances, err := s.provenanceDao.ListByArtifact(ctx, artifactID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list upstream provenance: %w", err)
+	}
+	return provenances, nil
+}
+
+// IntegrityChecksums returns the hex digests of a subresource integrity string, like the integrity of the dist of
+// an npm version: "sha512-<base64>", with an entry per algorithm separated by spaces. Malformed entries are skipped.
+func IntegrityChecksums(integrity string) map[string]string {
+	checksums := map[string]string{}
+	for _, entry := range strings.Fields(integrity) {
+		algorithm, digest, ok := strings.Cut(entry, "-")
+		if !ok {
+			continue
+		}
+		// options follow the digest after a question mark.
+		digest, _, _ = strings.Cut(digest, "?")
+		decoded, err := base64.StdEncoding.DecodeString(digest)
+		if err != nil || len(decoded) == 0 {
+			continue
+		}
+		checksums[strings.ToLower(algorithm)] = hex.EncodeToString(decoded)
+	}
+	return checksums
+}
@@ -0,0 +1,34 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upstreamprovenance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrityChecksums(t *testing.T) {
+	sha512 := "5d1720961877a7694702ee20160ef98b9a30677feeb6d219875d622a3f96d9fa9ce08bbc3afc081e40d27ed6b0e20511a34580d9f4" +
+		"f6a20bb04dae070a12f027"
+	integrity := "sha512-XRcglhh3p2lHAu4gFg75i5owZ3/uttIZh11iKj+W2fqc4Iu8OvwIHkDSftaw4gURo0WA2fT2oguwTa4HChLwJw=="
+
+	require.Equal(t, map[string]string{"sha512": sha512}, IntegrityChecksums(integrity))
+	require.Equal(t,
+		map[string]string{"sha512": sha512, "sha1": "16c385a6cbd7c6ad06cd6a7195aafae4932fcf3d"},
+		IntegrityChecksums(integrity+"?opt SHA1-FsOFpsvXxq0GzWpxlar65JMvzz0="))
+	require.Empty(t, IntegrityChecksums(""))
+	require.Empty(t, IntegrityChecksums("sha512 sha256-not*base64"))
+}
@@ -0,0 +1,219 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulnerability
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+)
+
+const osvRequestTimeout = 30 * time.Second
+
+// ecosystems maps the package types to the OSV ecosystems of their packages.
+var ecosystems = map[artifact.PackageType]string{
+	artifact.PackageTypeNPM:    "npm",
+	artifact.PackageTypePYTHON: "PyPI",
+	artifact.PackageTypeMAVEN:  "Maven",
+	artifact.PackageTypeNUGET:  "NuGet",
+	artifact.PackageTypeCARGO:  "crates.io",
+	artifact.PackageTypeGO:     "Go",
+}
+
+// Query is a version of a package whose advisories are looked up.
+type Query struct {
+	Ecosystem string
+	Name      string
+	Version   string
+}
+
+// AdvisoryRef references an advisory which affects a package version.
+type AdvisoryRef struct {
+	ID       string
+	Modified time.Time
+}
+
+// Source looks up the advisories which affect versions of packages.
+type Source interface {
+	// Query returns the advisories which affect each of the queried versions, in the order of the queries.
+	Query(ctx context.Context, queries []Query) ([][]AdvisoryRef, error)
+
+	// Get returns the advisory with the ID.
+	Get(ctx context.Context, id string) (*types.Vulnerability, error)
+}
+
+// OSVClient is the Source of the advisories of the OSV API (https://google.github.io/osv.dev/api/).
+type OSVClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewOSVClient(baseURL string) *OSVClient {
+	return &OSVClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Timeout: osvRequestTimeout},
+	}
+}
+
+//nolint:tagliatelle
+type osvQuery struct {
+	Package   osvPackage `json:"package"`
+	Version   string     `json:"version"`
+	PageToken string     `json:"page_token,omitempty"`
+}
+
+type osvPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+//nolint:tagliatelle
+type osvBatchResponse struct {
+	Results []struct {
+		Vulns []struct {
+			ID       string    `json:"id"`
+			Modified time.Time `json:"modified"`
+		} `json:"vulns"`
+		NextPageToken string `json:"next_page_token"`
+	} `json:"results"`
+}
+
+//nolint:tagliatelle
+type osvVulnerability struct {
+	ID               string    `json:"id"`
+	Summary          string    `json:"summary"`
+	Aliases          []string  `json:"aliases"`
+	Modified         time.Time `json:"modified"`
+	DatabaseSpecific struct {
+		Severity string `json:"severity"`
+	} `json:"database_specific"`
+}
+
+func (c *OSVClient) Query(ctx context.Context, queries []Query) ([][]AdvisoryRef, error) {
+	results := make([][]AdvisoryRef, len(queries))
+
+	pending := make([]int, len(queries))
+	requests := make([]osvQuery, len(queries))
+	for i, q := range queries {
+		pending[i] = i
+		requests[i] = osvQuery{Package: osvPackage{Name: q.Name, Ecosystem: q.Ecosystem}, Version: q.Version}
+	}
+
+	// the advisories of a version are paginated, the versions with more pages are queried again with their token.
+	for len(pending) > 0 {
+		batch := make([]osvQuery, len(pending))
+		for i, idx := range pending {
+			batch[i] = requests[idx]
+		}
+
+		response := osvBatchResponse{}
+		err := c.do(ctx, http.MethodPost, "/v1/querybatch", map[string]any{"queries": batch}, &response)
+		if err != nil {
+			return nil, err
+		}
+		if len(response.Results) != len(batch) {
+			return nil, fmt.Errorf("osv returned %d results for %d queries", len(response.Results), len(batch))
+		}
+
+		var next []int
+		for i, result := range response.Results {
+			idx := pending[i]
+			for _, v := range result.Vulns {
+				results[idx] = append(results[idx], AdvisoryRef{ID: v.ID, Modified: v.Modified})
+			}
+			if result.NextPageToken != "" {
+				requests[idx].PageToken = result.NextPageToken
+				next = append(next, idx)
+			}
+		}
+		pending = next
+	}
+	return results, nil
+}
+
+func (c *OSVClient) Get(ctx context.Context, id string) (*types.Vulnerability, error) {
+	v := osvVulnerability{}
+	if err := c.do(ctx, http.MethodGet, "/v1/vulns/"+url.PathEscape(id), nil, &v); err != nil {
+		return nil, err
+	}
+	return &types.Vulnerability{
+		ID:         v.ID,
+		Summary:    v.Summary,
+		Severity:   severity(v),
+		Aliases:    v.Aliases,
+		ModifiedAt: v.Modified,
+	}, nil
+}
+
+func (c *OSVClient) do(ctx context.Context, method string, path string, in any, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to marshal osv request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create osv request: %w", err)
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call osv: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("osv %s %s returned status %d", method, path, resp.StatusCode)
+	}
+	if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode osv response: %w", err)
+	}
+	return nil
+}
+
+// severity returns the severity of the advisory. OSV has no common severity level, the one the GitHub advisory
+// database reports is used, and advisories of malicious packages are always critical.
+func severity(v osvVulnerability) types.VulnerabilitySeverity {
+	if strings.HasPrefix(v.ID, "MAL-") {
+		return types.VulnerabilitySeverityCritical
+	}
+	switch strings.ToUpper(v.DatabaseSpecific.Severity) {
+	case "LOW":
+		return types.VulnerabilitySeverityLow
+	case "MODERATE", "MEDIUM":
+		return types.VulnerabilitySeverityMedium
+	case "HIGH":
+		return types.VulnerabilitySeverityHigh
+	case "CRITICAL":
+		return types.VulnerabilitySeverityCritical
+	}
+	return types.VulnerabilitySeverityUnknown
+}
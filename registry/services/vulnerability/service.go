@@ -0,0 +1,294 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulnerability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/quarantine"
+	registrystore "github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+// PolicyResolver resolves the effective policy of a registry, it's implemented by registrypolicy.Service.
+type PolicyResolver interface {
+	Resolve(ctx context.Context, registry *types.Registry) (*types.EffectiveRegistryPolicy, error)
+}
+
+// SyncResult sums up a sync of the stored versions with the advisories.
+type SyncResult struct {
+	// Checked is the number of versions which were checked.
+	Checked int
+	// Matched is the number of versions found affected by advisories which didn't affect them before.
+	Matched int
+	// Quarantined is the number of versions quarantined by the auto quarantine policy of their registry.
+	Quarantined int
+}
+
+// Service matches the versions of the packages stored in the registries with the advisories of OSV. Versions
+// newly found vulnerable are quarantined when the policy of their registry asks for it.
+type Service struct {
+	vulnerabilityDao registrystore.VulnerabilityRepository
+	registryDao      registrystore.RegistryRepository
+	quarantineDao    registrystore.QuarantineArtifactRepository
+	quarantineFinder quarantine.Finder
+	policies         PolicyResolver
+	source           Source
+}
+
+func NewService(
+	vulnerabilityDao registrystore.VulnerabilityRepository,
+	registryDao registrystore.RegistryRepository,
+	quarantineDao registrystore.QuarantineArtifactRepository,
+	quarantineFinder quarantine.Finder,
+	policies PolicyResolver,
+	source Source,
+) *Service {
+	return &Service{
+		vulnerabilityDao: vulnerabilityDao,
+		registryDao:      registryDao,
+		quarantineDao:    quarantineDao,
+		quarantineFinder: quarantineFinder,
+		policies:         policies,
+		source:           source,
+	}
+}
+
+// Sync checks all stored versions of the packages of the ecosystems known to OSV, batchSize versions at a time.
+// Versions are quarantined on behalf of the principal of the session of the context.
+func (s *Service) Sync(ctx context.Context, batchSize int) (SyncResult, error) {
+	packageTypes := make([]string, 0, len(ecosystems))
+	for packageType := range ecosystems {
+		packageTypes = append(packageTypes, string(packageType))
+	}
+
+	run := &syncRun{
+		vulnerabilities: map[string]*types.Vulnerability{},
+		thresholds:      map[int64]types.VulnerabilitySeverity{},
+	}
+	var after int64
+	for {
+		candidates, err := s.vulnerabilityDao.ListCandidates(ctx, packageTypes, after, batchSize)
+		if err != nil {
+			return run.result, fmt.Errorf("failed to list versions to check: %w", err)
+		}
+		if len(candidates) == 0 {
+			return run.result, nil
+		}
+		if err = s.syncBatch(ctx, run, candidates); err != nil {
+			return run.result, err
+		}
+		after = candidates[len(candidates)-1].ArtifactID
+		if len(candidates) < batchSize {
+			return run.result, nil
+		}
+	}
+}
+
+// syncRun keeps the state of a sync across its batches.
+type syncRun struct {
+	result SyncResult
+	// vulnerabilities are the advisories which are known to be stored and up to date.
+	vulnerabilities map[string]*types.Vulnerability
+	// thresholds are the auto quarantine severities of the registries, keyed by registry ID.
+	thresholds map[int64]types.VulnerabilitySeverity
+}
+
+func (s *Service) syncBatch(ctx context.Context, run *syncRun, candidates []*types.VulnerabilityCandidate) error {
+	queries := make([]Query, len(candidates))
+	for i, candidate := range candidates {
+		queries[i] = Query{
+			Ecosystem: ecosystems[artifact.PackageType(candidate.PackageType)],
+			Name:      candidate.Name,
+			Version:   candidate.Version,
+		}
+	}
+
+	results, err := s.source.Query(ctx, queries)
+	if err != nil {
+		return fmt.Errorf("failed to query advisories: %w", err)
+	}
+
+	for i, candidate := range candidates {
+		ids := make([]string, 0, len(results[i]))
+		for _, ref := range results[i] {
+			if err = s.store(ctx, run, ref); err != nil {
+				return err
+			}
+			ids = append(ids, ref.ID)
+		}
+
+		added, err := s.vulnerabilityDao.ReplaceMatches(ctx, candidate.ArtifactID, ids)
+		if err != nil {
+			return fmt.Errorf("failed to store vulnerabilities of artifact %d: %w", candidate.ArtifactID, err)
+		}
+		run.result.Checked++
+		if len(added) == 0 {
+			continue
+		}
+		run.result.Matched++
+
+		vulnerabilities := make([]*types.Vulnerability, len(added))
+		for j, id := range added {
+			vulnerabilities[j] = run.vulnerabilities[id]
+		}
+		quarantined, err := s.autoQuarantine(ctx, run, candidate, vulnerabilities)
+		if err != nil {
+			// a failed quarantine is retried once the version is matched with other advisories.
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to quarantine vulnerable artifact %d", candidate.ArtifactID)
+			continue
+		}
+		if quarantined {
+			run.result.Quarantined++
+		}
+	}
+	return nil
+}
+
+// store makes sure the advisory is stored, it's fetched if it isn't stored yet or was modified since.
+func (s *Service) store(ctx context.Context, run *syncRun, ref AdvisoryRef) error {
+	if _, ok := run.vulnerabilities[ref.ID]; ok {
+		return nil
+	}
+
+	stored, err := s.vulnerabilityDao.Find(ctx, ref.ID)
+	if err != nil && !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return fmt.Errorf("failed to find vulnerability %s: %w", ref.ID, err)
+	}
+	if stored != nil && !stored.ModifiedAt.Before(ref.Modified) {
+		run.vulnerabilities[ref.ID] = stored
+		return nil
+	}
+
+	vulnerability, err := s.source.Get(ctx, ref.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get advisory %s: %w", ref.ID, err)
+	}
+	vulnerability.ID = ref.ID
+	vulnerability.UpdatedAt = time.Now()
+	if err = s.vulnerabilityDao.Upsert(ctx, vulnerability); err != nil {
+		return fmt.Errorf("failed to store vulnerability %s: %w", ref.ID, err)
+	}
+	run.vulnerabilities[ref.ID] = vulnerability
+	return nil
+}
+
+// autoQuarantine quarantines the version if the registry quarantines versions affected by vulnerabilities of
+// the severity of one of the new vulnerabilities.
+func (s *Service) autoQuarantine(
+	ctx context.Context,
+	run *syncRun,
+	candidate *types.VulnerabilityCandidate,
+	vulnerabilities []*types.Vulnerability,
+) (bool, error) {
+	threshold, ok := run.thresholds[candidate.RegistryID]
+	if !ok {
+		var err error
+		threshold, err = s.autoQuarantineSeverity(ctx, candidate.RegistryID)
+		if err != nil {
+			return false, err
+		}
+		run.thresholds[candidate.RegistryID] = threshold
+	}
+
+	var ids []string
+	for _, vulnerability := range vulnerabilities {
+		if vulnerability.Severity.AtLeast(threshold) {
+			ids = append(ids, fmt.Sprintf("%s (%s)", vulnerability.ID, vulnerability.Severity))
+		}
+	}
+	if len(ids) == 0 {
+		return false, nil
+	}
+
+	err := s.quarantineDao.Create(ctx, &types.QuarantineArtifact{
+		Reason:     "Affected by vulnerabilities " + strings.Join(ids, ", "),
+		RegistryID: candidate.RegistryID,
+		ArtifactID: candidate.ArtifactID,
+		ImageID:    candidate.ImageID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to quarantine artifact: %w", err)
+	}
+	s.quarantineFinder.EvictCache(ctx, candidate.RegistryID, candidate.Name, candidate.Version, nil)
+	return true, nil
+}
+
+func (s *Service) autoQuarantineSeverity(ctx context.Context, registryID int64) (types.VulnerabilitySeverity, error) {
+	registry, err := s.registryDao.Get(ctx, registryID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get registry %d: %w", registryID, err)
+	}
+	effective, err := s.policies.Resolve(ctx, registry)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve policy of registry %d: %w", registryID, err)
+	}
+	if effective.Policy.AutoQuarantineSeverity == nil {
+		return types.VulnerabilitySeverityNone, nil
+	}
+	return *effective.Policy.AutoQuarantineSeverity, nil
+}
+
+// ListVulnerableVersions lists the versions stored in the registries of the space which are affected by
+// vulnerabilities of minSeverity or above, along with the total number of such versions.
+func (s *Service) ListVulnerableVersions(
+	ctx context.Context,
+	spaceID int64,
+	minSeverity types.VulnerabilitySeverity,
+	search string,
+	limit int,
+	offset int,
+) ([]*types.VulnerableVersion, int64, error) {
+	severities := types.SeveritiesAtLeast(minSeverity)
+
+	count, err := s.vulnerabilityDao.CountVulnerableVersions(ctx, spaceID, severities, search)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count vulnerable versions: %w", err)
+	}
+	versions, err := s.vulnerabilityDao.ListVulnerableVersions(ctx, spaceID, severities, search, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list vulnerable versions: %w", err)
+	}
+	if len(versions) == 0 {
+		return versions, count, nil
+	}
+
+	artifactIDs := make([]int64, len(versions))
+	for i, version := range versions {
+		artifactIDs[i] = version.ArtifactID
+	}
+	vulnerabilities, err := s.vulnerabilityDao.ListForArtifacts(ctx, artifactIDs, severities)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list vulnerabilities of versions: %w", err)
+	}
+	for _, version := range versions {
+		version.Vulnerabilities = vulnerabilities[version.ArtifactID]
+		version.Severity = types.VulnerabilitySeverityUnknown
+		for _, vulnerability := range version.Vulnerabilities {
+			if vulnerability.Severity.AtLeast(version.Severity) {
+				version.Severity = vulnerability.Severity
+			}
+		}
+	}
+	return versions, count, nil
+}
@@ -0,0 +1,234 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulnerability
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/controller/mocks"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOSVClient(t *testing.T) {
+	modified := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/querybatch":
+			var body struct {
+				Queries []osvQuery `json:"queries"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			results := make([]map[string]any, len(body.Queries))
+			for i, q := range body.Queries {
+				switch {
+				case q.Package.Name == "lodash" && q.PageToken == "":
+					results[i] = map[string]any{
+						"vulns":           []map[string]any{{"id": "GHSA-1", "modified": modified}},
+						"next_page_token": "next",
+					}
+				case q.Package.Name == "lodash":
+					results[i] = map[string]any{"vulns": []map[string]any{{"id": "GHSA-2", "modified": modified}}}
+				default:
+					results[i] = map[string]any{}
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"results": results})
+		case "/v1/vulns/GHSA-1":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":                "GHSA-1",
+				"summary":           "Prototype pollution",
+				"aliases":           []string{"CVE-2024-1"},
+				"modified":          modified,
+				"database_specific": map[string]any{"severity": "MODERATE"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewOSVClient(server.URL + "/")
+
+	results, err := client.Query(context.Background(), []Query{
+		{Ecosystem: "npm", Name: "lodash", Version: "4.17.20"},
+		{Ecosystem: "npm", Name: "left-pad", Version: "1.0.0"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]AdvisoryRef{
+		{{ID: "GHSA-1", Modified: modified}, {ID: "GHSA-2", Modified: modified}},
+		nil,
+	}, results)
+
+	vulnerability, err := client.Get(context.Background(), "GHSA-1")
+	require.NoError(t, err)
+	assert.Equal(t, &types.Vulnerability{
+		ID:         "GHSA-1",
+		Summary:    "Prototype pollution",
+		Severity:   types.VulnerabilitySeverityMedium,
+		Aliases:    []string{"CVE-2024-1"},
+		ModifiedAt: modified,
+	}, vulnerability)
+
+	_, err = client.Get(context.Background(), "GHSA-3")
+	assert.Error(t, err)
+}
+
+func TestSeverity(t *testing.T) {
+	tests := map[string]struct {
+		vulnerability osvVulnerability
+		want          types.VulnerabilitySeverity
+	}{
+		"github severity": {vulnerability: withSeverity("GHSA-1", "HIGH"), want: types.VulnerabilitySeverityHigh},
+		"moderate":        {vulnerability: withSeverity("GHSA-1", "MODERATE"), want: types.VulnerabilitySeverityMedium},
+		"no severity":     {vulnerability: withSeverity("PYSEC-1", ""), want: types.VulnerabilitySeverityUnknown},
+		"malicious":       {vulnerability: withSeverity("MAL-1", ""), want: types.VulnerabilitySeverityCritical},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.want, severity(test.vulnerability))
+		})
+	}
+}
+
+func withSeverity(id string, severity string) osvVulnerability {
+	v := osvVulnerability{ID: id}
+	v.DatabaseSpecific.Severity = severity
+	return v
+}
+
+func TestSync(t *testing.T) {
+	ctx := context.Background()
+	modified := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	source := &fakeSource{
+		advisories: map[string][]AdvisoryRef{
+			"lodash@4.17.20": {{ID: "GHSA-high", Modified: modified}},
+			"lodash@4.17.21": {{ID: "GHSA-low", Modified: modified}},
+			"requests@2.0.0": {{ID: "GHSA-high", Modified: modified}},
+			"left-pad@1.0.0": nil,
+		},
+		vulnerabilities: map[string]*types.Vulnerability{
+			"GHSA-high": {Severity: types.VulnerabilitySeverityHigh, ModifiedAt: modified},
+			"GHSA-low":  {Severity: types.VulnerabilitySeverityLow, ModifiedAt: modified},
+		},
+	}
+	dao := mocks.NewMockVulnerabilityRepository(t)
+	registryDao := mocks.NewRegistryRepository(t)
+	quarantineDao := mocks.NewMockQuarantineArtifactRepository(t)
+	finder := &mocks.QuarantineFinder{}
+	policies := fakePolicies{
+		1: types.VulnerabilitySeverityHigh,
+		2: types.VulnerabilitySeverityNone,
+	}
+	s := NewService(dao, registryDao, quarantineDao, finder, policies, source)
+
+	supported := mock.MatchedBy(func(packageTypes []string) bool {
+		return len(packageTypes) == len(ecosystems) && !slices.Contains(packageTypes,
+			string(artifact.PackageTypeDOCKER))
+	})
+	candidates := func() {
+		dao.EXPECT().ListCandidates(ctx, supported, int64(0), 2).Return([]*types.VulnerabilityCandidate{
+			{ArtifactID: 1, RegistryID: 1, PackageType: string(artifact.PackageTypeNPM), Name: "lodash",
+				Version: "4.17.20"},
+			{ArtifactID: 2, RegistryID: 1, PackageType: string(artifact.PackageTypeNPM), Name: "lodash",
+				Version: "4.17.21"},
+		}, nil).Once()
+		dao.EXPECT().ListCandidates(ctx, supported, int64(2), 2).Return([]*types.VulnerabilityCandidate{
+			{ArtifactID: 3, RegistryID: 2, PackageType: string(artifact.PackageTypePYTHON), Name: "requests",
+				Version: "2.0.0"},
+			{ArtifactID: 4, RegistryID: 1, PackageType: string(artifact.PackageTypeNPM), Name: "left-pad",
+				Version: "1.0.0"},
+		}, nil).Once()
+		dao.EXPECT().ListCandidates(ctx, supported, int64(4), 2).Return(nil, nil).Once()
+	}
+
+	candidates()
+	for _, id := range []string{"GHSA-high", "GHSA-low"} {
+		dao.EXPECT().Find(ctx, id).Return(nil, gitnessstore.ErrResourceNotFound).Once()
+		dao.EXPECT().Upsert(ctx, mock.MatchedBy(func(v *types.Vulnerability) bool { return v.ID == id })).
+			Return(nil).Once()
+	}
+	dao.EXPECT().ReplaceMatches(ctx, int64(1), []string{"GHSA-high"}).Return([]string{"GHSA-high"}, nil).Once()
+	dao.EXPECT().ReplaceMatches(ctx, int64(2), []string{"GHSA-low"}).Return([]string{"GHSA-low"}, nil).Once()
+	dao.EXPECT().ReplaceMatches(ctx, int64(3), []string{"GHSA-high"}).Return([]string{"GHSA-high"}, nil).Once()
+	dao.EXPECT().ReplaceMatches(ctx, int64(4), []string{}).Return(nil, nil).Once()
+	registryDao.EXPECT().Get(ctx, int64(1)).Return(&types.Registry{ID: 1}, nil).Once()
+	registryDao.EXPECT().Get(ctx, int64(2)).Return(&types.Registry{ID: 2}, nil).Once()
+	quarantineDao.EXPECT().Create(ctx, &types.QuarantineArtifact{
+		Reason:     "Affected by vulnerabilities GHSA-high (HIGH)",
+		RegistryID: 1,
+		ArtifactID: 1,
+	}).Return(nil).Once()
+	finder.On("EvictCache", ctx, int64(1), "lodash", "4.17.20", (*artifact.ArtifactType)(nil)).Return().Once()
+
+	result, err := s.Sync(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, SyncResult{Checked: 4, Matched: 3, Quarantined: 1}, result)
+	assert.Equal(t, 2, source.gets)
+	finder.AssertExpectations(t)
+
+	// versions which are already known to be vulnerable aren't quarantined again, and advisories which weren't
+	// modified aren't fetched again.
+	candidates()
+	for _, id := range []string{"GHSA-high", "GHSA-low"} {
+		dao.EXPECT().Find(ctx, id).Return(&types.Vulnerability{ID: id, ModifiedAt: modified}, nil).Once()
+	}
+	dao.EXPECT().ReplaceMatches(ctx, mock.Anything, mock.Anything).Return(nil, nil).Times(4)
+
+	result, err = s.Sync(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, SyncResult{Checked: 4}, result)
+	assert.Equal(t, 2, source.gets)
+}
+
+type fakeSource struct {
+	advisories      map[string][]AdvisoryRef
+	vulnerabilities map[string]*types.Vulnerability
+	gets            int
+}
+
+func (f *fakeSource) Query(_ context.Context, queries []Query) ([][]AdvisoryRef, error) {
+	results := make([][]AdvisoryRef, len(queries))
+	for i, q := range queries {
+		results[i] = f.advisories[q.Name+"@"+q.Version]
+	}
+	return results, nil
+}
+
+func (f *fakeSource) Get(_ context.Context, id string) (*types.Vulnerability, error) {
+	f.gets++
+	v := *f.vulnerabilities[id]
+	return &v, nil
+}
+
+type fakePolicies map[int64]types.VulnerabilitySeverity
+
+func (f fakePolicies) Resolve(_ context.Context, registry *types.Registry) (*types.EffectiveRegistryPolicy, error) {
+	severity := f[registry.ID]
+	return &types.EffectiveRegistryPolicy{
+		Policy: types.RegistryPolicy{AutoQuarantineSeverity: &severity},
+	}, nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulnerability
+
+import (
+	"github.com/harness/gitness/registry/app/pkg/quarantine"
+	"github.com/harness/gitness/registry/app/services/registrypolicy"
+	registrystore "github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	vulnerabilityDao registrystore.VulnerabilityRepository,
+	registryDao registrystore.RegistryRepository,
+	quarantineDao registrystore.QuarantineArtifactRepository,
+	quarantineFinder quarantine.Finder,
+	policyService *registrypolicy.Service,
+	config *types.Config,
+) *Service {
+	return NewService(
+		vulnerabilityDao,
+		registryDao,
+		quarantineDao,
+		quarantineFinder,
+		policyService,
+		NewOSVClient(config.Registry.VulnerabilitySync.URL),
+	)
+}
@@ -17,14 +17,18 @@ package webhook
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	gitnesswebhook "github.com/harness/gitness/app/services/webhook"
+	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/events"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	registryevents "github.com/harness/gitness/registry/app/events/artifact"
 	registrytypes "github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
 )
 
 // ArtifactEventPayload describes the payload of Artifact related webhook triggers.
@@ -33,13 +37,17 @@ type ArtifactEventPayload struct {
 	Registry     RegistryInfo                 `json:"registry"`
 	Principal    gitnesswebhook.PrincipalInfo `json:"principal"`
 	ArtifactInfo *registryevents.ArtifactInfo `json:"artifact_info"`
+	// ActorChain lists the real actors followed by the principal, it's only set for delete operations.
+	ActorChain []audit.Actor `json:"actor_chain,omitempty"`
 }
 
 type RegistryInfo struct {
-	ID          int64  `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	URL         string `json:"url"`
+	ID          int64                        `json:"id"`
+	UUID        string                       `json:"uuid"`
+	Name        string                       `json:"name"`
+	Description string                       `json:"description"`
+	URL         string                       `json:"url"`
+	Scope       registryevents.ResourceScope `json:"scope"`
 }
 
 // handleEventArtifactCreated handles branch created events
@@ -48,35 +56,19 @@ func (s *Service) handleEventArtifactCreated(
 	ctx context.Context,
 	event *events.Event[*registryevents.ArtifactCreatedPayload],
 ) error {
+	// quota alerts are best effort, they must not cause the artifact created webhooks to be retried
+	if err := s.checkRegistryQuota(ctx, event); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to check quota of registry %d", event.Payload.RegistryID)
+	}
+
 	return s.triggerForEventWithArtifact(ctx, enum.WebhookTriggerArtifactCreated,
 		event.ID, event.Payload.PrincipalID, event.Payload.RegistryID,
 		func(
 			principal *types.Principal,
 			registry *registrytypes.Registry,
 		) (any, error) {
-			space, err := s.spaceFinder.FindByID(ctx, registry.ParentID)
-			if err != nil {
-				return nil, err
-			}
-			return &ArtifactEventPayload{
-				Trigger: enum.WebhookTriggerArtifactCreated,
-				Registry: RegistryInfo{
-					ID:          registry.ID,
-					Name:        registry.Name,
-					Description: registry.Description,
-					URL:         s.urlProvider.GenerateUIRegistryURL(ctx, space.Path, registry.Name),
-				},
-				Principal: gitnesswebhook.PrincipalInfo{
-					ID:          principal.ID,
-					UID:         principal.UID,
-					DisplayName: principal.DisplayName,
-					Email:       principal.Email,
-					Type:        principal.Type,
-					Created:     principal.Created,
-					Updated:     principal.Updated,
-				},
-				ArtifactInfo: getArtifactInfo(event.Payload.Artifact),
-			}, nil
+			return s.getArtifactEventPayload(ctx, enum.WebhookTriggerArtifactCreated, principal, registry,
+				event.Payload.Artifact)
 		})
 }
 
@@ -92,32 +84,59 @@ func (s *Service) handleEventArtifactDeleted(
 			principal *types.Principal,
 			registry *registrytypes.Registry,
 		) (any, error) {
-			space, err := s.spaceFinder.FindByID(ctx, registry.ParentID)
+			payload, err := s.getArtifactEventPayload(ctx, enum.WebhookTriggerArtifactDeleted, principal, registry,
+				event.Payload.Artifact)
 			if err != nil {
 				return nil, err
 			}
-			return &ArtifactEventPayload{
-				Trigger: enum.WebhookTriggerArtifactDeleted,
-				Registry: RegistryInfo{
-					ID:          registry.ID,
-					Name:        registry.Name,
-					Description: registry.Description,
-					URL:         s.urlProvider.GenerateUIRegistryURL(ctx, space.Path, registry.Name),
-				},
-				Principal: gitnesswebhook.PrincipalInfo{
-					ID:          principal.ID,
-					UID:         principal.UID,
-					DisplayName: principal.DisplayName,
-					Email:       principal.Email,
-					Type:        principal.Type,
-					Created:     principal.Created,
-					Updated:     principal.Updated,
-				},
-				ArtifactInfo: getArtifactInfo(event.Payload.Artifact),
-			}, nil
+			payload.ActorChain = append(slices.Clone(event.Payload.ActorChain), audit.ActorFromPrincipal(*principal))
+			return payload, nil
 		})
 }
 
+func (s *Service) getArtifactEventPayload(
+	ctx context.Context,
+	triggerType enum.WebhookTrigger,
+	principal *types.Principal,
+	registry *registrytypes.Registry,
+	eventArtifact registryevents.Artifact,
+) (*ArtifactEventPayload, error) {
+	registryInfo, err := s.getRegistryInfo(ctx, registry)
+	if err != nil {
+		return nil, err
+	}
+	return &ArtifactEventPayload{
+		Trigger:  triggerType,
+		Registry: registryInfo,
+		Principal: gitnesswebhook.PrincipalInfo{
+			ID:          principal.ID,
+			UID:         principal.UID,
+			DisplayName: principal.DisplayName,
+			Email:       principal.Email,
+			Type:        principal.Type,
+			Created:     principal.Created,
+			Updated:     principal.Updated,
+		},
+		ArtifactInfo: getArtifactInfo(eventArtifact),
+	}, nil
+}
+
+// getRegistryInfo describes the registry in webhook payloads, along with the scope it belongs to.
+func (s *Service) getRegistryInfo(ctx context.Context, registry *registrytypes.Registry) (RegistryInfo, error) {
+	space, err := s.spaceFinder.FindByID(ctx, registry.ParentID)
+	if err != nil {
+		return RegistryInfo{}, err
+	}
+	return RegistryInfo{
+		ID:          registry.ID,
+		UUID:        registry.UUID,
+		Name:        registry.Name,
+		Description: registry.Description,
+		URL:         s.urlProvider.GenerateUIRegistryURL(ctx, space.Path, registry.Name),
+		Scope:       parseResourceScope(space.Path),
+	}, nil
+}
+
 func getArtifactInfo(eventArtifact registryevents.Artifact) *registryevents.ArtifactInfo {
 	artifactInfo := registryevents.ArtifactInfo{}
 	if dockerArtifact, ok := eventArtifact.(*registryevents.DockerArtifact); ok {
@@ -0,0 +1,228 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gitnesswebhook "github.com/harness/gitness/app/services/webhook"
+	"github.com/harness/gitness/events"
+	registryevents "github.com/harness/gitness/registry/app/events/artifact"
+	"github.com/harness/gitness/registry/services/notification"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// quotaTopContributors is the number of images listed as top contributors of a quota alert.
+const quotaTopContributors = 5
+
+type QuotaResource string
+
+const (
+	QuotaResourceStorage   QuotaResource = "storage"
+	QuotaResourceBandwidth QuotaResource = "bandwidth"
+)
+
+// RegistryQuotaEventPayload describes the payload of the registry quota threshold webhook trigger.
+type RegistryQuotaEventPayload struct {
+	Trigger   enum.WebhookTrigger          `json:"trigger"`
+	Registry  RegistryInfo                 `json:"registry"`
+	Principal gitnesswebhook.PrincipalInfo `json:"principal"`
+	Quota     QuotaInfo                    `json:"quota"`
+}
+
+type QuotaInfo struct {
+	Resource QuotaResource `json:"resource"`
+	// Threshold is the highest threshold in percent the usage crossed.
+	Threshold       int                `json:"threshold"`
+	Limit           int64              `json:"limit"`
+	Usage           int64              `json:"usage"`
+	UsagePercent    float64            `json:"usage_percent"`
+	TopContributors []QuotaContributor `json:"top_contributors"`
+}
+
+type QuotaContributor struct {
+	Image string `json:"image"`
+	Bytes int64  `json:"bytes"`
+}
+
+type quotaUsage struct {
+	resource QuotaResource
+	limit    int64
+	usage    int64
+	// contributorsFn lists the top contributors, it's only called when a threshold was crossed.
+	contributorsFn func() ([]registrytypes.ImageUsage, error)
+}
+
+// checkRegistryQuota raises the registry quota threshold webhook in case the storage or the bandwidth usage
+// of the registry crossed one of the thresholds of its quota. An alert is raised once per resource,
+// threshold and calendar month.
+func (s *Service) checkRegistryQuota(
+	ctx context.Context,
+	event *events.Event[*registryevents.ArtifactCreatedPayload],
+) error {
+	registry, err := s.registryRepository.Get(ctx, event.Payload.RegistryID)
+	if err != nil {
+		return fmt.Errorf("failed to get registry: %w", err)
+	}
+	// the quota may be inherited from the spaces of the registry
+	policy, err := s.registryPolicyService.Resolve(ctx, registry)
+	if err != nil {
+		return fmt.Errorf("failed to resolve registry policy: %w", err)
+	}
+	if policy.Policy.Quota == nil {
+		return nil
+	}
+	quota := policy.Policy.Quota
+
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	var usages []quotaUsage
+	if quota.StorageLimit > 0 {
+		size, err := s.registryRepository.GetStorageSize(ctx, registry.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get storage size: %w", err)
+		}
+		usages = append(usages, quotaUsage{
+			resource: QuotaResourceStorage,
+			limit:    quota.StorageLimit,
+			usage:    size,
+			contributorsFn: func() ([]registrytypes.ImageUsage, error) {
+				return s.manifestRepository.GetTopImagesBySize(ctx, registry.ID, quotaTopContributors)
+			},
+		})
+	}
+	if quota.BandwidthLimit > 0 {
+		downloaded, err := s.bandwidthStatRepository.GetTotalBytesByRegistry(
+			ctx, registry.ID, registrytypes.BandwidthTypeDOWNLOAD, monthStart)
+		if err != nil {
+			return fmt.Errorf("failed to get bandwidth usage: %w", err)
+		}
+		usages = append(usages, quotaUsage{
+			resource: QuotaResourceBandwidth,
+			limit:    quota.BandwidthLimit,
+			usage:    downloaded,
+			contributorsFn: func() ([]registrytypes.ImageUsage, error) {
+				return s.bandwidthStatRepository.GetTopImagesByRegistry(
+					ctx, registry.ID, registrytypes.BandwidthTypeDOWNLOAD, monthStart, quotaTopContributors)
+			},
+		})
+	}
+
+	for _, u := range usages {
+		quotaInfo, err := quotaAlert(quota.GetThresholds(), u)
+		if err != nil {
+			return err
+		}
+		if quotaInfo == nil {
+			continue
+		}
+		threshold := quotaInfo.Threshold
+
+		// the event ID makes the trigger ID deterministic, so webhooks are executed once per period
+		eventID := quotaEventID(registry.ID, u.resource, threshold, monthStart)
+		s.notificationDispatcher.Notify(ctx, &notification.Event{
+			Type:       registrytypes.NotificationEventQuotaBreach,
+			RegistryID: registry.ID,
+			Registry:   registry.Name,
+			Quota: &notification.QuotaBreach{
+				Resource:  string(u.resource),
+				Threshold: threshold,
+				Usage:     u.usage,
+				Limit:     u.limit,
+			},
+			DedupKey: eventID,
+		})
+		err = s.triggerForEventWithArtifact(ctx, enum.WebhookTriggerRegistryQuotaThreshold,
+			eventID, event.Payload.PrincipalID, registry.ID,
+			func(
+				principal *types.Principal,
+				registry *registrytypes.Registry,
+			) (any, error) {
+				registryInfo, err := s.getRegistryInfo(ctx, registry)
+				if err != nil {
+					return nil, err
+				}
+				return &RegistryQuotaEventPayload{
+					Trigger:  enum.WebhookTriggerRegistryQuotaThreshold,
+					Registry: registryInfo,
+					Principal: gitnesswebhook.PrincipalInfo{
+						ID:          principal.ID,
+						UID:         principal.UID,
+						DisplayName: principal.DisplayName,
+						Email:       principal.Email,
+						Type:        principal.Type,
+						Created:     principal.Created,
+						Updated:     principal.Updated,
+					},
+					Quota: *quotaInfo,
+				}, nil
+			})
+		if err != nil {
+			return err
+		}
+		log.Ctx(ctx).Debug().Msgf("registry %s crossed %d%% of its %s quota", registry.Name, threshold, u.resource)
+	}
+	return nil
+}
+
+// quotaAlert returns the quota alert of the usage, or nil if the usage didn't cross any of the thresholds.
+func quotaAlert(thresholds []int, u quotaUsage) (*QuotaInfo, error) {
+	threshold := crossedThreshold(thresholds, u.usage, u.limit)
+	if threshold == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	contributors, err := u.contributorsFn()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top contributors of %s usage: %w", u.resource, err)
+	}
+	quotaInfo := &QuotaInfo{
+		Resource:        u.resource,
+		Threshold:       threshold,
+		Limit:           u.limit,
+		Usage:           u.usage,
+		UsagePercent:    float64(u.usage) * 100 / float64(u.limit),
+		TopContributors: make([]QuotaContributor, 0, len(contributors)),
+	}
+	for _, c := range contributors {
+		quotaInfo.TopContributors = append(quotaInfo.TopContributors, QuotaContributor{
+			Image: c.ImageName,
+			Bytes: c.Bytes,
+		})
+	}
+	return quotaInfo, nil
+}
+
+// quotaEventID returns the ID of the alert of a quota threshold, which is the same for the whole month.
+func quotaEventID(registryID int64, resource QuotaResource, threshold int, monthStart time.Time) string {
+	return fmt.Sprintf("registry-quota-%d-%s-%d-%s", registryID, resource, threshold, monthStart.Format("2006-01"))
+}
+
+// crossedThreshold returns the highest threshold the usage crossed, or 0 if none was crossed.
+func crossedThreshold(thresholds []int, usage int64, limit int64) int {
+	crossed := 0
+	for _, t := range thresholds {
+		if usage*100 >= int64(t)*limit && t > crossed {
+			crossed = t
+		}
+	}
+	return crossed
+}
@@ -0,0 +1,140 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrossedThreshold(t *testing.T) {
+	tests := []struct {
+		name       string
+		thresholds []int
+		usage      int64
+		want       int
+	}{
+		{name: "below all thresholds", thresholds: []int{80, 90}, usage: 799, want: 0},
+		{name: "exactly at a threshold", thresholds: []int{80, 90}, usage: 800, want: 80},
+		{name: "highest crossed threshold", thresholds: []int{80, 90}, usage: 950, want: 90},
+		{name: "unsorted thresholds", thresholds: []int{90, 50, 80}, usage: 850, want: 80},
+		{name: "above the limit", thresholds: []int{100}, usage: 1200, want: 100},
+		{name: "no thresholds", usage: 1000, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, crossedThreshold(tt.thresholds, tt.usage, 1000))
+		})
+	}
+}
+
+func TestQuotaAlert(t *testing.T) {
+	contributors := func() ([]registrytypes.ImageUsage, error) {
+		return []registrytypes.ImageUsage{{ImageName: "app", Bytes: 600}, {ImageName: "base", Bytes: 300}}, nil
+	}
+
+	t.Run("usage crossed a threshold", func(t *testing.T) {
+		alert, err := quotaAlert(registrytypes.DefaultQuotaThresholds, quotaUsage{
+			resource: QuotaResourceStorage, limit: 1000, usage: 900, contributorsFn: contributors,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &QuotaInfo{
+			Resource:     QuotaResourceStorage,
+			Threshold:    90,
+			Limit:        1000,
+			Usage:        900,
+			UsagePercent: 90,
+			TopContributors: []QuotaContributor{
+				{Image: "app", Bytes: 600},
+				{Image: "base", Bytes: 300},
+			},
+		}, alert)
+	})
+
+	t.Run("usage below the thresholds", func(t *testing.T) {
+		alert, err := quotaAlert(registrytypes.DefaultQuotaThresholds, quotaUsage{
+			resource: QuotaResourceBandwidth, limit: 1000, usage: 100,
+			contributorsFn: func() ([]registrytypes.ImageUsage, error) {
+				t.Fatal("the contributors are only listed once a threshold was crossed")
+				return nil, nil
+			},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, alert)
+	})
+
+	t.Run("listing the contributors fails", func(t *testing.T) {
+		_, err := quotaAlert([]int{50}, quotaUsage{
+			resource: QuotaResourceBandwidth, limit: 1000, usage: 500,
+			contributorsFn: func() ([]registrytypes.ImageUsage, error) {
+				return nil, errors.New("db down")
+			},
+		})
+		assert.ErrorContains(t, err, "failed to get top contributors of bandwidth usage")
+	})
+}
+
+func TestQuotaEventID(t *testing.T) {
+	march := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	id := quotaEventID(3, QuotaResourceStorage, 80, march)
+	assert.Equal(t, "registry-quota-3-storage-80-2026-03", id)
+	assert.Equal(t, id, quotaEventID(3, QuotaResourceStorage, 80, march), "an alert is raised once per month")
+	for _, other := range []string{
+		quotaEventID(3, QuotaResourceStorage, 90, march),
+		quotaEventID(3, QuotaResourceBandwidth, 80, march),
+		quotaEventID(4, QuotaResourceStorage, 80, march),
+		quotaEventID(3, QuotaResourceStorage, 80, april),
+	} {
+		assert.NotEqual(t, id, other)
+	}
+}
+
+func TestRegistryQuotaEventPayload(t *testing.T) {
+	payload := RegistryQuotaEventPayload{
+		Trigger: enum.WebhookTriggerRegistryQuotaThreshold,
+		Quota: QuotaInfo{
+			Resource:        QuotaResourceBandwidth,
+			Threshold:       80,
+			Limit:           1000,
+			Usage:           850,
+			UsagePercent:    85,
+			TopContributors: []QuotaContributor{{Image: "app", Bytes: 850}},
+		},
+	}
+	raw, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(raw, &got))
+	assert.Equal(t, string(enum.WebhookTriggerRegistryQuotaThreshold), got["trigger"])
+	assert.Equal(t, map[string]any{
+		"resource":         "bandwidth",
+		"threshold":        float64(80),
+		"limit":            float64(1000),
+		"usage":            float64(850),
+		"usage_percent":    float64(85),
+		"top_contributors": []any{map[string]any{"image": "app", "bytes": float64(850)}},
+	}, got["quota"])
+}
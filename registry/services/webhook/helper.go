@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/harness/gitness/app/paths"
 	urlprovider "github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	registryevents "github.com/harness/gitness/registry/app/events/artifact"
@@ -74,6 +75,8 @@ func GetArtifactDeletedPayload(
 	ctx context.Context,
 	principalID int64,
 	registryID int64,
+	registryUUID string,
+	spacePath string,
 	regIdentifier string,
 	version string,
 	digest string,
@@ -83,8 +86,11 @@ func GetArtifactDeletedPayload(
 	urlProvider urlprovider.Provider,
 	isUntaggedImagesEnabled bool,
 ) registryevents.ArtifactDeletedPayload {
+	scope := parseResourceScope(spacePath)
 	payload := registryevents.ArtifactDeletedPayload{
 		RegistryID:   registryID,
+		RegistryUUID: registryUUID,
+		Scope:        &scope,
 		PrincipalID:  principalID,
 		ArtifactType: packageType,
 	}
@@ -168,6 +174,24 @@ func GetArtifactDeletedPayloadForCommonArtifacts(
 	}
 }
 
+// parseResourceScope splits the path of the space of a registry into the identifiers of its account, organization
+// and project, e.g. acc/org/project. Spaces nested below projects belong to the project.
+func parseResourceScope(spacePath string) registryevents.ResourceScope {
+	var scope registryevents.ResourceScope
+	if spacePath == "" {
+		return scope
+	}
+	segments := paths.Segments(spacePath)
+	scope.AccountIdentifier = segments[0]
+	if len(segments) > 1 {
+		scope.OrgIdentifier = segments[1]
+	}
+	if len(segments) > 2 {
+		scope.ProjectIdentifier = segments[2]
+	}
+	return scope
+}
+
 func GetRepoURLWithoutProtocol(ctx context.Context, registryURL string) string {
 	repoURL := registryURL
 	parsedURL, err := url.Parse(repoURL)
@@ -0,0 +1,49 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"testing"
+
+	registryevents "github.com/harness/gitness/registry/app/events/artifact"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseResourceScope(t *testing.T) {
+	tests := []struct {
+		path string
+		want registryevents.ResourceScope
+	}{
+		{path: "", want: registryevents.ResourceScope{}},
+		{path: "acc", want: registryevents.ResourceScope{AccountIdentifier: "acc"}},
+		{path: "acc/org", want: registryevents.ResourceScope{AccountIdentifier: "acc", OrgIdentifier: "org"}},
+		{
+			path: "acc/org/project",
+			want: registryevents.ResourceScope{
+				AccountIdentifier: "acc", OrgIdentifier: "org", ProjectIdentifier: "project",
+			},
+		},
+		{
+			path: "/acc/org/project/child/",
+			want: registryevents.ResourceScope{
+				AccountIdentifier: "acc", OrgIdentifier: "org", ProjectIdentifier: "project",
+			},
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, parseResourceScope(tt.path), tt.path)
+	}
+}
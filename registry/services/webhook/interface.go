@@ -18,9 +18,25 @@ import (
 	"context"
 
 	gitnesswebhook "github.com/harness/gitness/app/services/webhook"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
 )
 
 // ServiceInterface interface for webhook operations.
 type ServiceInterface interface {
 	ReTriggerWebhookExecution(ctx context.Context, webhookExecutionID int64) (*gitnesswebhook.TriggerResult, error)
+	ReplayWebhookExecution(
+		ctx context.Context,
+		webhookExecutionID int64,
+		url string,
+	) (*gitnesswebhook.TriggerResult, error)
+	OpenPayloads(executions []*types.WebhookExecutionCore) error
+	TestWebhook(
+		ctx context.Context,
+		webhook *types.WebhookCore,
+		registry *registrytypes.Registry,
+		principal *types.Principal,
+		triggerType enum.WebhookTrigger,
+	) (*gitnesswebhook.TriggerResult, error)
 }
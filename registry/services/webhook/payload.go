@@ -0,0 +1,94 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/encrypt"
+	"github.com/harness/gitness/types"
+)
+
+// encryptedPayloadPrefix marks the stored request bodies which are encrypted, bodies stored before encryption was
+// enabled are kept as they are.
+const encryptedPayloadPrefix = "encrypted:"
+
+// PayloadSealer limits the size of the request bodies of webhook executions which are stored for replays and
+// encrypts them at rest.
+type PayloadSealer struct {
+	encrypter encrypt.Encrypter
+	maxSize   int
+	encrypt   bool
+}
+
+func NewPayloadSealer(encrypter encrypt.Encrypter, maxSize int, encrypt bool) *PayloadSealer {
+	return &PayloadSealer{
+		encrypter: encrypter,
+		maxSize:   maxSize,
+		encrypt:   encrypt,
+	}
+}
+
+// Seal prepares the request body of the execution for storage. Bodies larger than the size limit are dropped, the
+// execution can't be retriggered then.
+func (p *PayloadSealer) Seal(execution *types.WebhookExecutionCore) error {
+	body := execution.Request.Body
+	if len(body) > p.maxSize {
+		execution.Request.Body = ""
+		execution.Retriggerable = false
+		return nil
+	}
+	if body == "" || !p.encrypt {
+		return nil
+	}
+
+	encrypted, err := p.encrypter.Encrypt(body)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt webhook execution payload: %w", err)
+	}
+	execution.Request.Body = encryptedPayloadPrefix + base64.StdEncoding.EncodeToString(encrypted)
+	return nil
+}
+
+// Open restores the request body of a stored execution.
+func (p *PayloadSealer) Open(execution *types.WebhookExecutionCore) error {
+	encoded, ok := strings.CutPrefix(execution.Request.Body, encryptedPayloadPrefix)
+	if !ok {
+		return nil
+	}
+
+	encrypted, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("failed to decode webhook execution payload: %w", err)
+	}
+	body, err := p.encrypter.Decrypt(encrypted)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt webhook execution payload: %w", err)
+	}
+	execution.Request.Body = body
+	return nil
+}
+
+// OpenAll restores the request bodies of the stored executions.
+func (p *PayloadSealer) OpenAll(executions []*types.WebhookExecutionCore) error {
+	for _, execution := range executions {
+		if err := p.Open(execution); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/encrypt"
+	"github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadSealer(t *testing.T) {
+	encrypter, err := encrypt.New("12345678901234567890123456789012", false)
+	require.NoError(t, err)
+	sealer := NewPayloadSealer(encrypter, 32, true)
+
+	execution := &types.WebhookExecutionCore{
+		Retriggerable: true,
+		Request:       types.WebhookExecutionRequest{Body: `{"trigger":"artifact_created"}`},
+	}
+	require.NoError(t, sealer.Seal(execution))
+	assert.True(t, strings.HasPrefix(execution.Request.Body, encryptedPayloadPrefix))
+	assert.True(t, execution.Retriggerable)
+
+	require.NoError(t, sealer.Open(execution))
+	assert.JSONEq(t, `{"trigger":"artifact_created"}`, execution.Request.Body)
+
+	// payloads stored before encryption was enabled are returned as they are.
+	plain := &types.WebhookExecutionCore{Request: types.WebhookExecutionRequest{Body: `{}`}}
+	require.NoError(t, sealer.Open(plain))
+	assert.Equal(t, `{}`, plain.Request.Body)
+
+	large := &types.WebhookExecutionCore{
+		Retriggerable: true,
+		Request:       types.WebhookExecutionRequest{Body: strings.Repeat("x", 33)},
+	}
+	require.NoError(t, sealer.Seal(large))
+	assert.Empty(t, large.Request.Body)
+	assert.False(t, large.Retriggerable)
+}
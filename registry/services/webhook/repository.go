@@ -24,10 +24,18 @@ import (
 type RegistryWebhookExecutorStore struct {
 	webhookStore          registrystore.WebhooksRepository
 	webhookExecutionStore registrystore.WebhooksExecutionRepository
+	payloadSealer         *PayloadSealer
 }
 
 func (s *RegistryWebhookExecutorStore) Find(ctx context.Context, id int64) (*types.WebhookExecutionCore, error) {
-	return s.webhookExecutionStore.Find(ctx, id)
+	execution, err := s.webhookExecutionStore.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.payloadSealer.Open(execution); err != nil {
+		return nil, err
+	}
+	return execution, nil
 }
 
 func (s *RegistryWebhookExecutorStore) ListWebhooks(
@@ -41,14 +49,27 @@ func (s *RegistryWebhookExecutorStore) ListForTrigger(
 	ctx context.Context,
 	triggerID string,
 ) ([]*types.WebhookExecutionCore, error) {
-	return s.webhookExecutionStore.ListForTrigger(ctx, triggerID)
+	executions, err := s.webhookExecutionStore.ListForTrigger(ctx, triggerID)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.payloadSealer.OpenAll(executions); err != nil {
+		return nil, err
+	}
+	return executions, nil
 }
 
 func (s *RegistryWebhookExecutorStore) CreateWebhookExecution(
 	ctx context.Context,
 	hook *types.WebhookExecutionCore,
 ) error {
-	return s.webhookExecutionStore.Create(ctx, hook)
+	// seal a copy, the caller keeps working with the plain request body.
+	sealed := *hook
+	if err := s.payloadSealer.Seal(&sealed); err != nil {
+		return err
+	}
+	hook.Retriggerable = sealed.Retriggerable
+	return s.webhookExecutionStore.Create(ctx, &sealed)
 }
 
 func (s *RegistryWebhookExecutorStore) UpdateOptLock(
@@ -26,10 +26,13 @@ import (
 	"github.com/harness/gitness/encrypt"
 	"github.com/harness/gitness/events"
 	events2 "github.com/harness/gitness/registry/app/events/artifact"
+	"github.com/harness/gitness/registry/app/services/registrypolicy"
 	registrystore "github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/notification"
 	"github.com/harness/gitness/secret"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/stream"
+	"github.com/harness/gitness/types"
 )
 
 const (
@@ -50,6 +53,12 @@ type Service struct {
 	spacePathStore     store.SpacePathStore
 	registryRepository registrystore.RegistryRepository
 	spaceFinder        refcache.SpaceFinder
+
+	manifestRepository      registrystore.ManifestRepository
+	bandwidthStatRepository registrystore.BandwidthStatRepository
+	registryPolicyService   *registrypolicy.Service
+	notificationDispatcher  *notification.Dispatcher
+	payloadSealer           *PayloadSealer
 }
 
 func NewService(
@@ -68,6 +77,11 @@ func NewService(
 	registryRepository registrystore.RegistryRepository,
 	encrypter encrypt.Encrypter,
 	spaceFinder refcache.SpaceFinder,
+	manifestRepository registrystore.ManifestRepository,
+	bandwidthStatRepository registrystore.BandwidthStatRepository,
+	registryPolicyService *registrypolicy.Service,
+	notificationDispatcher *notification.Dispatcher,
+	payloadSealer *PayloadSealer,
 ) (*Service, error) {
 	if err := config.Prepare(); err != nil {
 		return nil, fmt.Errorf("provided webhook service config is invalid: %w", err)
@@ -75,6 +89,7 @@ func NewService(
 	webhookExecutorStore := &RegistryWebhookExecutorStore{
 		webhookStore:          webhookStore,
 		webhookExecutionStore: webhookExecutionStore,
+		payloadSealer:         payloadSealer,
 	}
 	executor := gitnesswebhook.NewWebhookExecutor(config, webhookURLProvider, encrypter, spacePathStore,
 		secretService, principalStore, webhookExecutorStore, gitnesswebhook.ArtifactRegistryTrigger)
@@ -89,6 +104,12 @@ func NewService(
 		spacePathStore:     spacePathStore,
 		registryRepository: registryRepository,
 		spaceFinder:        spaceFinder,
+
+		manifestRepository:      manifestRepository,
+		bandwidthStatRepository: bandwidthStatRepository,
+		registryPolicyService:   registryPolicyService,
+		notificationDispatcher:  notificationDispatcher,
+		payloadSealer:           payloadSealer,
 	}
 
 	_, err := artifactsReaderFactory.Launch(ctx, eventsReaderGroupName, config.EventReaderName,
@@ -120,3 +141,18 @@ func (s *Service) ReTriggerWebhookExecution(
 ) (*gitnesswebhook.TriggerResult, error) {
 	return s.WebhookExecutor.RetriggerWebhookExecution(ctx, webhookExecutionID)
 }
+
+// ReplayWebhookExecution sends the stored request body of a past webhook execution again, to url instead of the url
+// of the webhook if it isn't empty.
+func (s *Service) ReplayWebhookExecution(
+	ctx context.Context,
+	webhookExecutionID int64,
+	url string,
+) (*gitnesswebhook.TriggerResult, error) {
+	return s.WebhookExecutor.ReplayWebhookExecution(ctx, webhookExecutionID, url)
+}
+
+// OpenPayloads restores the stored request bodies of the webhook executions.
+func (s *Service) OpenPayloads(executions []*types.WebhookExecutionCore) error {
+	return s.payloadSealer.OpenAll(executions)
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gitnesswebhook "github.com/harness/gitness/app/services/webhook"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registryevents "github.com/harness/gitness/registry/app/events/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/google/uuid"
+)
+
+const (
+	testArtifactName    = "sample-artifact"
+	testArtifactVersion = "1.0.0"
+	testArtifactDigest  = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+	// testTriggerIDPrefix prefixes the trigger IDs of test deliveries to tell them apart in the execution history.
+	testTriggerIDPrefix = "test-"
+)
+
+// ErrTestTriggerNotSupported is returned when a test delivery is requested for a trigger without synthetic payload.
+var ErrTestTriggerNotSupported = errors.New("test deliveries are only supported for artifact triggers")
+
+// TestWebhook sends a synthetic payload of the trigger to the webhook, which allows to validate the wiring of
+// the endpoint and its signature verification without publishing real artifacts. The webhook is executed even
+// if it's disabled or not registered for the trigger.
+func (s *Service) TestWebhook(
+	ctx context.Context,
+	webhook *types.WebhookCore,
+	registry *registrytypes.Registry,
+	principal *types.Principal,
+	triggerType enum.WebhookTrigger,
+) (*gitnesswebhook.TriggerResult, error) {
+	if triggerType != enum.WebhookTriggerArtifactCreated && triggerType != enum.WebhookTriggerArtifactDeleted {
+		return nil, ErrTestTriggerNotSupported
+	}
+
+	rootSpace, err := s.spaceFinder.FindByID(ctx, registry.RootParentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find root space %d: %w", registry.RootParentID, err)
+	}
+
+	payload, err := s.getArtifactEventPayload(ctx, triggerType, principal, registry,
+		s.getTestArtifact(ctx, rootSpace.Identifier, registry))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create test payload: %w", err)
+	}
+
+	return s.WebhookExecutor.TriggerWebhook(ctx, webhook, testTriggerIDPrefix+uuid.NewString(), triggerType,
+		payload), nil
+}
+
+func (s *Service) getTestArtifact(
+	ctx context.Context,
+	rootIdentifier string,
+	registry *registrytypes.Registry,
+) registryevents.Artifact {
+	baseArtifact := registryevents.BaseArtifact{
+		Name: testArtifactName,
+		Ref:  fmt.Sprintf("%s:%s", testArtifactName, testArtifactVersion),
+	}
+	artifactURL := s.urlProvider.RegistryURL(ctx, rootIdentifier, registry.Name) + "/" + baseArtifact.Ref
+	//nolint:exhaustive
+	switch registry.PackageType {
+	case artifact.PackageTypeDOCKER:
+		return &registryevents.DockerArtifact{
+			BaseArtifact: baseArtifact,
+			Tag:          testArtifactVersion,
+			URL:          GetRepoURLWithoutProtocol(ctx, artifactURL),
+			Digest:       testArtifactDigest,
+		}
+	case artifact.PackageTypeHELM:
+		return &registryevents.HelmArtifact{
+			BaseArtifact: baseArtifact,
+			Tag:          testArtifactVersion,
+			URL:          ociPrefix + GetRepoURLWithoutProtocol(ctx, artifactURL),
+			Digest:       testArtifactDigest,
+		}
+	default:
+		return &registryevents.CommonArtifact{
+			BaseArtifact: baseArtifact,
+			Type:         registry.PackageType,
+			Version:      testArtifactVersion,
+		}
+	}
+}
@@ -25,9 +25,12 @@ import (
 	"github.com/harness/gitness/encrypt"
 	"github.com/harness/gitness/events"
 	"github.com/harness/gitness/registry/app/events/artifact"
+	"github.com/harness/gitness/registry/app/services/registrypolicy"
 	registrystore "github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/notification"
 	"github.com/harness/gitness/secret"
 	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
 
 	"github.com/google/wire"
 )
@@ -35,6 +38,7 @@ import (
 // WireSet provides a wire set for this package.
 var WireSet = wire.NewSet(
 	ProvideService,
+	ProvidePayloadSealer,
 )
 
 func ProvideService(
@@ -53,6 +57,11 @@ func ProvideService(
 	registryRepository registrystore.RegistryRepository,
 	encrypter encrypt.Encrypter,
 	spaceFinder refcache.SpaceFinder,
+	manifestRepository registrystore.ManifestRepository,
+	bandwidthStatRepository registrystore.BandwidthStatRepository,
+	registryPolicyService *registrypolicy.Service,
+	notificationDispatcher *notification.Dispatcher,
+	payloadSealer *PayloadSealer,
 ) (*Service, error) {
 	gob.Register(&artifact.DockerArtifact{})
 	gob.Register(&artifact.HelmArtifact{})
@@ -72,5 +81,18 @@ func ProvideService(
 		registryRepository,
 		encrypter,
 		spaceFinder,
+		manifestRepository,
+		bandwidthStatRepository,
+		registryPolicyService,
+		notificationDispatcher,
+		payloadSealer,
+	)
+}
+
+func ProvidePayloadSealer(config *types.Config, encrypter encrypt.Encrypter) *PayloadSealer {
+	return NewPayloadSealer(
+		encrypter,
+		config.Registry.WebhookPayloads.MaxSize,
+		config.Registry.WebhookPayloads.Encrypt,
 	)
 }
@@ -0,0 +1,32 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ArtifactMetadataChange is a revision of the metadata of an artifact.
+type ArtifactMetadataChange struct {
+	ID         int64
+	ArtifactID int64
+	// Revision numbers the changes of the metadata of an artifact, starting at 1.
+	Revision int64
+	// Diff holds the top level fields of the metadata which changed, keyed by field.
+	Diff      json.RawMessage
+	CreatedBy int64
+	CreatedAt time.Time
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// PipelineExecution references the execution of a CI pipeline which published artifacts.
+type PipelineExecution struct {
+	// System is the CI system which ran the pipeline, like "harness" or "github-actions".
+	System     string
+	PipelineID string
+	// RunURL is the URL of the execution, it identifies the run.
+	RunURL    string
+	CommitSHA string
+}
+
+// IsEmpty returns true if no field of the reference is set.
+func (p PipelineExecution) IsEmpty() bool {
+	return p.System == "" && p.PipelineID == "" && p.RunURL == "" && p.CommitSHA == ""
+}
+
+// HasReference returns true if a field identifying the pipeline, its run or the commit it built is set, the system
+// alone does not identify anything.
+func (p PipelineExecution) HasReference() bool {
+	return p.PipelineID != "" || p.RunURL != "" || p.CommitSHA != ""
+}
+
+// ArtifactProvenance links a version to the pipeline execution which published it.
+type ArtifactProvenance struct {
+	ArtifactID int64
+	Pipeline   PipelineExecution
+	CreatedBy  int64
+	CreatedAt  time.Time
+}
+
+// ProvenanceArtifact is a version published by a pipeline execution.
+type ProvenanceArtifact struct {
+	ArtifactID   int64
+	RegistryName string
+	PackageType  string
+	Name         string
+	Version      string
+	Provenance   ArtifactProvenance
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// ArtifactSearchField is a field of the search document of a version.
+type ArtifactSearchField string
+
+const (
+	ArtifactSearchFieldName         ArtifactSearchField = "name"
+	ArtifactSearchFieldDescription  ArtifactSearchField = "description"
+	ArtifactSearchFieldKeywords     ArtifactSearchField = "keywords"
+	ArtifactSearchFieldAuthors      ArtifactSearchField = "author"
+	ArtifactSearchFieldDependencies ArtifactSearchField = "dependency"
+)
+
+// ArtifactSearchQuery searches the documents of the versions stored in the registries of a space.
+type ArtifactSearchQuery struct {
+	SpaceID int64
+	// Terms are the lowercase words to search, a document matches when it holds words starting with each of them.
+	Terms []string
+	// Field restricts the search to a field of the documents, all fields are searched when it's empty.
+	Field        ArtifactSearchField
+	PackageTypes []string
+	Limit        int
+	Offset       int
+}
+
+// ArtifactSearchResult is a version matching a search, a higher rank is a better match.
+type ArtifactSearchResult struct {
+	ArtifactID   int64
+	RegistryName string
+	PackageType  string
+	Name         string
+	Version      string
+	Description  string
+	Rank         float64
+}
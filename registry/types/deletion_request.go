@@ -0,0 +1,53 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// DeletionRequestState is the state of a request to delete an artifact.
+type DeletionRequestState string
+
+const (
+	// DeletionRequestStatePending is the state of a request which waits for a review.
+	DeletionRequestStatePending DeletionRequestState = "PENDING"
+	// DeletionRequestStateApproved is the state of a request which was approved, the artifact is deleted.
+	DeletionRequestStateApproved DeletionRequestState = "APPROVED"
+	// DeletionRequestStateRejected is the state of a request which was rejected or withdrawn.
+	DeletionRequestStateRejected DeletionRequestState = "REJECTED"
+	// DeletionRequestStateExpired is the state of a request which wasn't reviewed in time.
+	DeletionRequestStateExpired DeletionRequestState = "EXPIRED"
+)
+
+// DeletionRequest is a request to delete an artifact, or a version of it, from a registry which needs the
+// approval of a second user before its artifacts are deleted.
+type DeletionRequest struct {
+	ID         int64
+	RegistryID int64
+	ImageName  string
+	// Version is the version to delete, it's empty if the whole artifact is deleted.
+	Version     string
+	State       DeletionRequestState
+	RequestedBy int64
+	ReviewedBy  *int64
+	Comment     string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// IsExpired returns true if the request is pending and can't be approved anymore.
+func (r *DeletionRequest) IsExpired(now time.Time) bool {
+	return r.State == DeletionRequestStatePending && !now.Before(r.ExpiresAt)
+}
@@ -18,13 +18,68 @@ import (
 	"time"
 )
 
+// DownloadStatMode tells how the downloads of a registry are recorded.
+type DownloadStatMode string
+
+const (
+	// DownloadStatModeDetailed records every download along with the principal which downloaded.
+	DownloadStatModeDetailed DownloadStatMode = "DETAILED"
+	// DownloadStatModeAggregated only counts the downloads of each version per day, without the principals.
+	// It's used for the registries of spaces in download stats privacy mode.
+	DownloadStatModeAggregated DownloadStatMode = "AGGREGATED"
+)
+
 // DownloadStat DTO object.
 type DownloadStat struct {
 	ID         int64
 	ArtifactID int64
+	// RegistryID is the registry of the artifact, it selects how the download is recorded.
+	RegistryID int64
 	Timestamp  time.Time
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 	CreatedBy  int64
 	UpdatedBy  int64
 }
+
+// DownloadStatInterval is the length of the periods downloads are counted by in a time series.
+type DownloadStatInterval string
+
+const (
+	DownloadStatIntervalDay   DownloadStatInterval = "DAY"
+	DownloadStatIntervalWeek  DownloadStatInterval = "WEEK"
+	DownloadStatIntervalMonth DownloadStatInterval = "MONTH"
+)
+
+// BucketStart returns the start of the period of t in UTC. Weeks start on Monday.
+func (i DownloadStatInterval) BucketStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch i {
+	case DownloadStatIntervalWeek:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case DownloadStatIntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// Next returns the start of the period following the one starting at start.
+func (i DownloadStatInterval) Next(start time.Time) time.Time {
+	switch i {
+	case DownloadStatIntervalWeek:
+		return start.AddDate(0, 0, 7)
+	case DownloadStatIntervalMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// DownloadStatBucket is the number of downloads of a version within the period starting at Start.
+type DownloadStatBucket struct {
+	Version string
+	Start   time.Time
+	Count   int64
+}
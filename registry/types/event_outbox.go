@@ -0,0 +1,41 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// OutboxEventKind tells how the payload of an outbox event is published.
+type OutboxEventKind string
+
+const (
+	OutboxEventKindArtifactCreated     OutboxEventKind = "artifact-created"
+	OutboxEventKindArtifactDeleted     OutboxEventKind = "artifact-deleted"
+	OutboxEventKindArtifactSoftDeleted OutboxEventKind = "artifact-soft-deleted"
+	OutboxEventKindArtifactRestored    OutboxEventKind = "artifact-restored"
+	OutboxEventKindAudit               OutboxEventKind = "audit"
+)
+
+// OutboxEvent is an event which is stored in the transaction of the change it reports and published once
+// the transaction committed. Events are deleted once published, so they are published at least once.
+type OutboxEvent struct {
+	ID      int64
+	Kind    OutboxEventKind
+	Payload []byte
+	// Attempts counts the publish attempts, NextAttemptAt is when the event can be claimed for the next one.
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+// FailedUpload is an upload whose package couldn't be parsed. Its raw content is kept in the file tree of the
+// registry until ExpiresAt, so the publisher can download it along with the parse error.
+type FailedUpload struct {
+	ID          int64
+	UUID        string
+	RegistryID  int64
+	PackageType artifact.PackageType
+	Filename    string
+	Sha256      string
+	Size        int64
+	Error       string
+	CreatedBy   int64
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// UploadErrorClass groups the errors of failed uploads, so recurring problems stand out in the upload failure
+// stats.
+type UploadErrorClass string
+
+const (
+	// UploadErrorClassInvalidArchive is used when the uploaded file isn't a readable archive of its format.
+	UploadErrorClassInvalidArchive UploadErrorClass = "invalid_archive"
+	// UploadErrorClassInvalidMetadata is used when the metadata of the package can't be decoded.
+	UploadErrorClassInvalidMetadata  UploadErrorClass = "invalid_metadata"
+	UploadErrorClassInvalidVersion   UploadErrorClass = "invalid_version"
+	UploadErrorClassInvalidSignature UploadErrorClass = "invalid_signature"
+	UploadErrorClassUnknown          UploadErrorClass = "unknown"
+)
+
+// UploadFailureStat counts the failed uploads of a registry with the same package type and error class.
+type UploadFailureStat struct {
+	RegistryID   int64
+	RegistryName string
+	PackageType  artifact.PackageType
+	ErrorClass   UploadErrorClass
+	Count        int64
+	LastFailedAt time.Time
+}
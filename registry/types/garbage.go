@@ -0,0 +1,56 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// GarbageKind is the kind of soft-deleted rows which wait to be purged.
+type GarbageKind string
+
+const (
+	GarbageKindTag      GarbageKind = "TAG"
+	GarbageKindManifest GarbageKind = "MANIFEST"
+)
+
+// GarbageAge buckets soft-deleted rows by how long ago they were deleted.
+type GarbageAge string
+
+const (
+	GarbageAgeLessThanDay   GarbageAge = "LT_1D"
+	GarbageAgeLessThanWeek  GarbageAge = "LT_7D"
+	GarbageAgeLessThanMonth GarbageAge = "LT_30D"
+	GarbageAgeOlder         GarbageAge = "GTE_30D"
+)
+
+// GarbageAgeBounds are the upper bounds of the age buckets, all older rows fall in GarbageAgeOlder.
+var GarbageAgeBounds = []struct {
+	Age    GarbageAge
+	MaxAge time.Duration
+}{
+	{Age: GarbageAgeLessThanDay, MaxAge: 24 * time.Hour},
+	{Age: GarbageAgeLessThanWeek, MaxAge: 7 * 24 * time.Hour},
+	{Age: GarbageAgeLessThanMonth, MaxAge: 30 * 24 * time.Hour},
+}
+
+// GarbageStat is the volume of the soft-deleted rows of a kind and age in an account.
+type GarbageStat struct {
+	RootParentID int64
+	Kind         GarbageKind
+	Age          GarbageAge
+	Count        int64
+	// Size is the total size of the soft-deleted manifests, blobs shared with other manifests are included.
+	// Tags don't hold storage of their own.
+	Size int64
+}
@@ -0,0 +1,35 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+// StarredImage is an image starred by a principal, along with the registry it belongs to.
+type StarredImage struct {
+	ImageID          int64
+	ImageName        string
+	ArtifactType     *artifact.ArtifactType
+	RegistryID       int64
+	RegistryName     string
+	RegistryParentID int64
+	PackageType      artifact.PackageType
+	// StarCount is the number of principals which starred the image.
+	StarCount int64
+	StarredAt time.Time
+}
@@ -0,0 +1,39 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// IndexBuild is a run of a task which rebuilds an index of a registry.
+type IndexBuild struct {
+	ID         int64
+	RegistryID int64
+	TaskKey    string
+	Kind       TaskKind
+	// Image is the package whose index is rebuilt, it's empty for registry index builds.
+	Image       string
+	RunID       string
+	Status      TaskStatus
+	Error       string
+	PrincipalID int64
+	StartedAt   time.Time
+	// Duration is only set once the build finished.
+	Duration time.Duration
+}
+
+// IsIndexTask returns true for the kinds of tasks which rebuild an index.
+func IsIndexTask(kind TaskKind) bool {
+	return kind == TaskKindBuildRegistryIndex || kind == TaskKindBuildPackageIndex
+}
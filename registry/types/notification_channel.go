@@ -0,0 +1,60 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// NotificationChannelType is the kind of destination registry notifications are delivered to.
+type NotificationChannelType string
+
+const (
+	NotificationChannelTypeEmail NotificationChannelType = "EMAIL"
+	NotificationChannelTypeSlack NotificationChannelType = "SLACK"
+	NotificationChannelTypeTeams NotificationChannelType = "TEAMS"
+)
+
+// NotificationEventType is a high-signal registry event channels can subscribe to.
+type NotificationEventType string
+
+const (
+	// NotificationEventQuarantine is raised when an artifact, a version or a file gets quarantined.
+	NotificationEventQuarantine NotificationEventType = "QUARANTINE"
+	// NotificationEventProtectedDeletion is raised when a delete is rejected by the deletion protection.
+	NotificationEventProtectedDeletion NotificationEventType = "PROTECTED_DELETION"
+	// NotificationEventQuotaBreach is raised when the usage of the registry crosses a quota threshold.
+	NotificationEventQuotaBreach NotificationEventType = "QUOTA_BREACH"
+)
+
+// NotificationChannel delivers the selected events of a registry by email, or to a Slack or MS Teams channel.
+type NotificationChannel struct {
+	ID         int64
+	RegistryID int64
+	Identifier string
+	Type       NotificationChannelType
+	// Recipients are the email addresses of EMAIL channels.
+	Recipients []string
+	// URL is the encrypted incoming webhook URL of SLACK and TEAMS channels.
+	URL    string
+	Events []NotificationEventType
+	// Template overrides the default message of the events, it's a text/template executed on the event.
+	Template string
+	// RateLimit is the maximum number of notifications delivered per hour, the others are dropped.
+	RateLimit int
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	CreatedBy int64
+	UpdatedBy int64
+}
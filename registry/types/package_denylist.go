@@ -0,0 +1,82 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// PackageDenylistSource is where a denylist entry comes from.
+type PackageDenylistSource string
+
+const (
+	// PackageDenylistSourceManual is the source of entries added through the API.
+	PackageDenylistSourceManual PackageDenylistSource = "MANUAL"
+	// PackageDenylistSourceOSV is the source of entries imported from OSV advisories, like the ones of
+	// malicious packages.
+	PackageDenylistSourceOSV PackageDenylistSource = "OSV"
+)
+
+// PackageDenylistEntry denies the uploads and proxy pulls of a package, or of a range of its versions, in all
+// registries of a space and of its subspaces.
+type PackageDenylistEntry struct {
+	ID      int64
+	SpaceID int64
+	// PackageType is the package type the entry applies to, it applies to all of them if it's empty.
+	PackageType string
+	// Name is the lower case name of the package.
+	Name string
+	// VersionRange is a semver constraint like ">= 1.0.0, < 1.2.0" or a single version, all versions are denied
+	// if it's empty.
+	VersionRange string
+	Reason       string
+	Source       PackageDenylistSource
+	// ExternalID is the ID of the advisory the entry was imported from.
+	ExternalID string
+	CreatedBy  int64
+	CreatedAt  time.Time
+}
+
+// Matches returns true if the entry denies the version. Versions which aren't semantic versions are only
+// matched by entries for all versions or for exactly that version.
+func (e *PackageDenylistEntry) Matches(version string) bool {
+	if e.VersionRange == "" || e.VersionRange == version {
+		return true
+	}
+	if version == "" {
+		return false
+	}
+	constraint, err := semver.NewConstraint(e.VersionRange)
+	if err != nil {
+		return false
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	return constraint.Check(v)
+}
+
+// PackageDenylistOverride exempts a registry from a denylist entry.
+type PackageDenylistOverride struct {
+	ID         int64
+	EntryID    int64
+	RegistryID int64
+	Reason     string
+	CreatedBy  int64
+	CreatedAt  time.Time
+}
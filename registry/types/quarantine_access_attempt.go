@@ -0,0 +1,37 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"time"
+)
+
+// QuarantineAccessAttempt is a pull of a quarantined artifact which was blocked.
+type QuarantineAccessAttempt struct {
+	ID         int64
+	RegistryID int64
+	ImageName  string
+	Version    string
+	// PrincipalID is the principal who attempted the pull, it's nil for anonymous pulls.
+	PrincipalID *int64
+	CreatedAt   time.Time
+}
+
+// QuarantineAccessSummary sums up the blocked pulls of a quarantined artifact.
+type QuarantineAccessSummary struct {
+	Count int64
+	// LastAttemptedAt is nil if no pull was blocked.
+	LastAttemptedAt *time.Time
+}
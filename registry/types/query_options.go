@@ -0,0 +1,100 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// SoftDeleteFilter selects the soft-deleted entities a query returns.
+type SoftDeleteFilter int
+
+const (
+	// SoftDeleteFilterExclude leaves the soft-deleted entities out, it's the default.
+	SoftDeleteFilterExclude SoftDeleteFilter = iota
+	SoftDeleteFilterInclude
+	SoftDeleteFilterOnly
+)
+
+// Condition returns the SQL condition which applies the filter to the given deleted_at column.
+func (f SoftDeleteFilter) Condition(column string) string {
+	switch f {
+	case SoftDeleteFilterInclude:
+		return "1 = 1"
+	case SoftDeleteFilterOnly:
+		return column + " IS NOT NULL"
+	case SoftDeleteFilterExclude:
+		return column + " IS NULL"
+	}
+	return column + " IS NULL"
+}
+
+// LockMode selects the row lock a query takes.
+type LockMode int
+
+const (
+	LockModeNone LockMode = iota
+	// LockModeForUpdate locks the selected rows until the transaction ends. SQLite doesn't support row locks, its
+	// writes lock the whole database.
+	LockModeForUpdate
+)
+
+// QueryOptions holds the variations callers ask the DAOs to apply to their queries.
+type QueryOptions struct {
+	DeleteFilter SoftDeleteFilter
+	// SkipMetadata leaves the metadata blob of the entities out of the selected columns.
+	SkipMetadata bool
+	// Limit caps the number of rows a listing returns, 0 means no limit.
+	Limit    int
+	LockMode LockMode
+}
+
+// QueryOption sets an option of a DAO query.
+type QueryOption func(*QueryOptions)
+
+// WithSoftDeleteFilter selects the soft-deleted entities the query returns.
+func WithSoftDeleteFilter(filter SoftDeleteFilter) QueryOption {
+	return func(o *QueryOptions) {
+		o.DeleteFilter = filter
+	}
+}
+
+// WithoutMetadata skips loading the metadata blob, for callers which only need the other columns.
+func WithoutMetadata() QueryOption {
+	return func(o *QueryOptions) {
+		o.SkipMetadata = true
+	}
+}
+
+// WithLimit caps the number of rows a listing returns.
+func WithLimit(limit int) QueryOption {
+	return func(o *QueryOptions) {
+		o.Limit = limit
+	}
+}
+
+// WithLockMode locks the selected rows, the query must run in a transaction.
+func WithLockMode(mode LockMode) QueryOption {
+	return func(o *QueryOptions) {
+		o.LockMode = mode
+	}
+}
+
+// MakeQueryOptions applies the options over the defaults.
+func MakeQueryOptions(opts ...QueryOption) QueryOptions {
+	options := QueryOptions{
+		DeleteFilter: SoftDeleteFilterExclude,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "testing"
+
+func TestMakeQueryOptions_SoftDeleteFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts []QueryOption
+		want string
+	}{
+		{name: "default", want: "deleted_at IS NULL"},
+		{name: "include", opts: []QueryOption{WithSoftDeleteFilter(SoftDeleteFilterInclude)}, want: "1 = 1"},
+		{name: "only", opts: []QueryOption{WithSoftDeleteFilter(SoftDeleteFilterOnly)}, want: "deleted_at IS NOT NULL"},
+		{
+			name: "last wins",
+			opts: []QueryOption{
+				WithSoftDeleteFilter(SoftDeleteFilterOnly),
+				WithSoftDeleteFilter(SoftDeleteFilterExclude),
+			},
+			want: "deleted_at IS NULL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := MakeQueryOptions(tt.opts...).DeleteFilter.Condition("deleted_at"); got != tt.want {
+				t.Fatalf("Condition() mismatch: got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+// RecentActivityKind is the kind of interaction of a principal with an image.
+type RecentActivityKind string
+
+const (
+	// RecentActivityViewed is recorded when the artifact is opened in the UI.
+	RecentActivityViewed RecentActivityKind = "VIEWED"
+	// RecentActivityPulled is recorded when a version of the artifact is downloaded.
+	RecentActivityPulled RecentActivityKind = "PULLED"
+)
+
+// RecentActivity is the latest interaction of a kind of a principal with an image.
+type RecentActivity struct {
+	ID           int64
+	PrincipalID  int64
+	Kind         RecentActivityKind
+	RegistryID   int64
+	ImageName    string
+	ArtifactType *artifact.ArtifactType
+	// Version is the version which was pulled, it's empty for views.
+	Version string
+	At      time.Time
+
+	// The registry of the image, they're only set on listed activities.
+	RegistryName     string
+	RegistryParentID int64
+	PackageType      artifact.PackageType
+}
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
 )
 
 // RegistryConfig holds configuration settings for a registry.
@@ -25,6 +26,122 @@ type RegistryConfig struct {
 	// RemoteUrlSuffix is the suffix to append to remote URLs for this registry
 	// keeping it Url instead of URL coz body param with Url is cleaner
 	RemoteUrlSuffix string `json:"remoteUrlSuffix,omitempty"` //nolint:staticcheck,revive,tagliatelle
+	// RpmSigning holds the GPG settings of RPM registries.
+	RpmSigning *RpmSigningConfig `json:"rpmSigning,omitempty"` //nolint:tagliatelle
+	// HelmProvenance holds the provenance verification settings of Helm registries.
+	HelmProvenance *HelmProvenanceConfig `json:"helmProvenance,omitempty"` //nolint:tagliatelle
+	// DefaultArtifactType is used for requests which do not specify an artifact type.
+	DefaultArtifactType *artifact.ArtifactType `json:"defaultArtifactType,omitempty"` //nolint:tagliatelle
+	// ValidationRules holds the rules every upload to the registry has to satisfy.
+	ValidationRules *ValidationRulesConfig `json:"validationRules,omitempty"` //nolint:tagliatelle
+	// Quota holds the storage and bandwidth limits usage alerts of the registry are raised against.
+	Quota *QuotaConfig `json:"quota,omitempty"`
+	// Policy overrides the registry policies inherited from the spaces, the quota falls back to Quota.
+	Policy *RegistryPolicy `json:"policy,omitempty"`
+	// DeletionProtection makes deletes of the registry and its artifacts fail until it's disabled.
+	DeletionProtection bool `json:"deletionProtection,omitempty"` //nolint:tagliatelle
+	// DownloadRateLimit caps the bandwidth of each download from the registry.
+	DownloadRateLimit *DownloadRateLimitConfig `json:"downloadRateLimit,omitempty"` //nolint:tagliatelle
+	// ListPreferences holds the defaults of the lists of the registry.
+	ListPreferences *ListPreferencesConfig `json:"listPreferences,omitempty"` //nolint:tagliatelle
+}
+
+// RpmSigningConfig configures signing of the RPM repository metadata and verification of uploaded packages.
+//
+//nolint:tagliatelle
+type RpmSigningConfig struct {
+	// SigningKeySecretIdentifier references the secret holding the armored private key used to sign repomd.xml.
+	SigningKeySecretIdentifier string `json:"signingKeySecretIdentifier,omitempty"`
+	SigningKeySecretSpaceID    int64  `json:"signingKeySecretSpaceId,omitempty"`
+	// VerifyPackageSignatures rejects uploads which are not signed by one of the TrustedKeys.
+	VerifyPackageSignatures bool `json:"verifyPackageSignatures,omitempty"`
+	// TrustedKeys are the armored public keys accepted for package signatures.
+	TrustedKeys []string `json:"trustedKeys,omitempty"`
+}
+
+// HelmProvenanceConfig configures verification of the provenance files pushed alongside Helm charts.
+type HelmProvenanceConfig struct {
+	// TrustedKeys are the armored public keys accepted for chart provenance signatures. When empty the
+	// provenance is only checked against the chart digest.
+	TrustedKeys []string `json:"trustedKeys,omitempty"` //nolint:tagliatelle
+}
+
+// VersionFormat restricts the versions accepted by a registry.
+type VersionFormat string
+
+const (
+	VersionFormatAny    VersionFormat = "ANY"
+	VersionFormatSemver VersionFormat = "SEMVER"
+	VersionFormatRegex  VersionFormat = "REGEX"
+)
+
+// ValidationRulesConfig configures the validation applied to every upload to a registry.
+type ValidationRulesConfig struct {
+	// RequiredMetadataFields are the metadata fields which must be set on uploaded packages. Nested
+	// fields are addressed with dots, e.g. "info.license".
+	RequiredMetadataFields []string `json:"requiredMetadataFields,omitempty"` //nolint:tagliatelle
+	// VersionFormat restricts the accepted versions, VersionPattern holds the regex for VersionFormatRegex.
+	VersionFormat  VersionFormat `json:"versionFormat,omitempty"`  //nolint:tagliatelle
+	VersionPattern string        `json:"versionPattern,omitempty"` //nolint:tagliatelle
+	// MaxFileSize is the maximum size in bytes of a single upload, 0 means unlimited.
+	MaxFileSize int64 `json:"maxFileSize,omitempty"` //nolint:tagliatelle
+}
+
+// DefaultQuotaThresholds are the usage percentages alerts are raised at when none are configured.
+var DefaultQuotaThresholds = []int{80, 90}
+
+// QuotaConfig configures the usage limits of a registry. Crossing one of the thresholds raises a
+// registry quota threshold webhook.
+type QuotaConfig struct {
+	// StorageLimit is the maximum size in bytes of the blobs stored in the registry, 0 means unlimited.
+	StorageLimit int64 `json:"storageLimit,omitempty"` //nolint:tagliatelle
+	// BandwidthLimit is the maximum number of bytes downloaded from the registry per calendar month,
+	// 0 means unlimited.
+	BandwidthLimit int64 `json:"bandwidthLimit,omitempty"` //nolint:tagliatelle
+	// Thresholds are the usage percentages of a limit at which alerts are raised.
+	Thresholds []int `json:"thresholds,omitempty"`
+}
+
+// GetThresholds returns the configured thresholds, or the DefaultQuotaThresholds if none are configured.
+func (c *QuotaConfig) GetThresholds() []int {
+	if len(c.Thresholds) == 0 {
+		return DefaultQuotaThresholds
+	}
+	return c.Thresholds
+}
+
+// DownloadRateLimitConfig caps the bandwidth of each download in bytes per second, 0 means unlimited.
+// The cap of the type of the principal wins over the default one.
+//
+//nolint:tagliatelle
+type DownloadRateLimitConfig struct {
+	Default        int64 `json:"default,omitempty"`
+	Anonymous      int64 `json:"anonymous,omitempty"`
+	User           int64 `json:"user,omitempty"`
+	ServiceAccount int64 `json:"serviceAccount,omitempty"`
+}
+
+// Limit returns the cap of the downloads of the principal type.
+func (c *DownloadRateLimitConfig) Limit(principalType enum.PrincipalType, anonymous bool) int64 {
+	limit := int64(0)
+	switch {
+	case anonymous:
+		limit = c.Anonymous
+	case principalType == enum.PrincipalTypeUser:
+		limit = c.User
+	case principalType == enum.PrincipalTypeServiceAccount:
+		limit = c.ServiceAccount
+	}
+	if limit == 0 {
+		limit = c.Default
+	}
+	return limit
+}
+
+// ImageUsage is the number of bytes an image contributes to the usage of a registry.
+type ImageUsage struct {
+	ImageName string
+	Bytes     int64
 }
 
 // Registry DTO object.
@@ -50,3 +167,29 @@ type Registry struct {
 }
 
 func (r Registry) Identifier() int64 { return r.ID }
+
+// IsDeletionProtected returns true if deletes of the registry and its artifacts have to be rejected.
+func (r Registry) IsDeletionProtected() bool {
+	return r.Config != nil && r.Config.DeletionProtection
+}
+
+// ListSort is the order the versions of an artifact are listed in by default.
+type ListSort string
+
+const (
+	// ListSortSemver lists the highest semantic version first.
+	ListSortSemver ListSort = "SEMVER"
+	// ListSortRecency lists the most recently modified version first.
+	ListSortRecency ListSort = "RECENCY"
+)
+
+// MaxListPageSize is the upper bound of the default page size of a registry.
+const MaxListPageSize = 100
+
+// ListPreferencesConfig holds the defaults applied to list requests which don't set a sort or page size.
+//
+//nolint:tagliatelle
+type ListPreferencesConfig struct {
+	DefaultSort ListSort `json:"defaultSort,omitempty"`
+	PageSize    int      `json:"pageSize,omitempty"`
+}
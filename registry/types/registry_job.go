@@ -0,0 +1,54 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// RegistryJobState is the state of a long-running operation of a registry.
+type RegistryJobState string
+
+const (
+	RegistryJobStateQueued    RegistryJobState = "queued"
+	RegistryJobStateRunning   RegistryJobState = "running"
+	RegistryJobStateSucceeded RegistryJobState = "succeeded"
+	RegistryJobStateFailed    RegistryJobState = "failed"
+	RegistryJobStateCanceled  RegistryJobState = "canceled"
+)
+
+// IsCompleted returns true if the job can't change anymore.
+func (s RegistryJobState) IsCompleted() bool {
+	return s == RegistryJobStateSucceeded || s == RegistryJobStateFailed || s == RegistryJobStateCanceled
+}
+
+// RegistryJobKind identifies the operation run by a registry job.
+type RegistryJobKind string
+
+// RegistryJob tracks a long-running operation of a registry, so its status can be reported the same way for
+// all operations. Log holds the latest lines logged by the operation.
+type RegistryJob struct {
+	ID         int64
+	UUID       string
+	RegistryID int64
+	Kind       RegistryJobKind
+	State      RegistryJobState
+	Progress   int
+	Log        string
+	Error      string
+	CreatedBy  int64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
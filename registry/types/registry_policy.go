@@ -0,0 +1,78 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// RegistryPolicy holds the policies of a registry. Spaces define defaults for the registries below them,
+// a value which isn't set is inherited from the closest ancestor which sets it.
+//
+//nolint:tagliatelle
+type RegistryPolicy struct {
+	// RetentionDays is the number of days versions are kept for, 0 keeps them forever.
+	RetentionDays *int64 `json:"retentionDays,omitempty"`
+	// Immutable prevents existing versions from being overwritten.
+	Immutable *bool `json:"immutable,omitempty"`
+	// RequireSignatures rejects uploads of packages which aren't signed.
+	RequireSignatures *bool        `json:"requireSignatures,omitempty"`
+	Quota             *QuotaConfig `json:"quota,omitempty"`
+	// DownloadStatsPrivacy only keeps aggregated daily download counters, without recording who downloaded.
+	DownloadStatsPrivacy *bool `json:"downloadStatsPrivacy,omitempty"`
+	// DeletionApproval makes deletes of artifacts wait for the approval of a second user.
+	DeletionApproval *bool `json:"deletionApproval,omitempty"`
+	// AutoQuarantineSeverity quarantines the versions found affected by vulnerabilities of this severity or above,
+	// VulnerabilitySeverityNone turns it off.
+	AutoQuarantineSeverity *VulnerabilitySeverity `json:"autoQuarantineSeverity,omitempty"`
+}
+
+type RegistryPolicyField string
+
+const (
+	RegistryPolicyFieldRetentionDays          RegistryPolicyField = "retentionDays"
+	RegistryPolicyFieldImmutable              RegistryPolicyField = "immutable"
+	RegistryPolicyFieldRequireSignatures      RegistryPolicyField = "requireSignatures"
+	RegistryPolicyFieldQuota                  RegistryPolicyField = "quota"
+	RegistryPolicyFieldDownloadStatsPrivacy   RegistryPolicyField = "downloadStatsPrivacy"
+	RegistryPolicyFieldDeletionApproval       RegistryPolicyField = "deletionApproval"
+	RegistryPolicyFieldAutoQuarantineSeverity RegistryPolicyField = "autoQuarantineSeverity"
+)
+
+// RegistryPolicySourceType tells where the effective value of a policy comes from.
+type RegistryPolicySourceType string
+
+const (
+	RegistryPolicySourceRegistry RegistryPolicySourceType = "REGISTRY"
+	RegistryPolicySourceSpace    RegistryPolicySourceType = "SPACE"
+	RegistryPolicySourceDefault  RegistryPolicySourceType = "DEFAULT"
+)
+
+type RegistryPolicySource struct {
+	Field RegistryPolicyField
+	Type  RegistryPolicySourceType
+	// SpacePath is the path of the space the value is inherited from, it's only set for SPACE sources.
+	SpacePath string
+}
+
+// EffectiveRegistryPolicy is the policy which applies to a registry once inheritance is resolved.
+type EffectiveRegistryPolicy struct {
+	Policy  RegistryPolicy
+	Sources []RegistryPolicySource
+}
+
+// RegistrySetting is the effective value of a setting of a registry.
+type RegistrySetting struct {
+	Key     string
+	Value   any
+	Default any
+	Source  RegistryPolicySource
+}
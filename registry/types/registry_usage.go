@@ -0,0 +1,42 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// RegistrySpaces holds the spaces a registry belongs to.
+type RegistrySpaces struct {
+	RegistryID   int64
+	ParentID     int64
+	RootParentID int64
+}
+
+// RegistryUsage is the usage of the registries of a space and of all spaces below it.
+type RegistryUsage struct {
+	SpaceID       int64
+	SpacePath     string
+	RegistryCount int64
+	// StorageBytes is the size of the blobs stored in the registries.
+	StorageBytes int64
+	// BandwidthBytes is the number of bytes downloaded from the registries in the current calendar month.
+	BandwidthBytes int64
+}
+
+// RegistryUsageSnapshot is the registry usage of a space persisted at the end of a day.
+type RegistryUsageSnapshot struct {
+	RegistryUsage
+	Day     time.Time
+	Created time.Time
+}
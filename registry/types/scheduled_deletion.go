@@ -0,0 +1,51 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// ScheduledDeletionState is the state of a scheduled deletion of an artifact.
+type ScheduledDeletionState string
+
+const (
+	// ScheduledDeletionStateScheduled is the state of a deletion which waits for its execution, it can be canceled.
+	ScheduledDeletionStateScheduled ScheduledDeletionState = "SCHEDULED"
+	// ScheduledDeletionStateRunning is the state of a deletion which is being executed.
+	ScheduledDeletionStateRunning ScheduledDeletionState = "RUNNING"
+	// ScheduledDeletionStateCanceled is the state of a deletion which was canceled before its execution.
+	ScheduledDeletionStateCanceled ScheduledDeletionState = "CANCELED"
+	// ScheduledDeletionStateExecuted is the state of a deletion which was executed, the artifact is deleted.
+	ScheduledDeletionStateExecuted ScheduledDeletionState = "EXECUTED"
+	// ScheduledDeletionStateFailed is the state of a deletion whose execution failed.
+	ScheduledDeletionStateFailed ScheduledDeletionState = "FAILED"
+)
+
+// ScheduledDeletion is a deletion of an artifact, or a version of it, which is executed once ExecuteAt passed.
+// It can be canceled until then, which gives hard deletes a window to undo them.
+type ScheduledDeletion struct {
+	ID         int64
+	RegistryID int64
+	ImageName  string
+	// Version is the version to delete, it's empty if the whole artifact is deleted.
+	Version    string
+	State      ScheduledDeletionState
+	ExecuteAt  time.Time
+	CreatedBy  int64
+	CanceledBy *int64
+	// Error is the error of a failed execution.
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
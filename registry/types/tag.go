@@ -41,6 +41,7 @@ type ArtifactMetadata struct {
 	Name             string
 	RepoName         string
 	DownloadCount    int64
+	StarCount        int64
 	PackageType      artifact.PackageType
 	Labels           []string
 	LatestVersion    string
@@ -64,8 +65,13 @@ type ImageMetadata struct {
 	PackageType   artifact.PackageType
 	ArtifactType  *artifact.ArtifactType
 	LatestVersion string
-	CreatedAt     time.Time
-	ModifiedAt    time.Time
+	// Description is the latest markdown description of the image.
+	Description string
+	StarCount   int64
+	// Starred tells whether the current user starred the image.
+	Starred    bool
+	CreatedAt  time.Time
+	ModifiedAt time.Time
 }
 
 type OciVersionMetadata struct {
@@ -84,6 +90,8 @@ type OciVersionMetadata struct {
 	IsQuarantined    bool
 	QuarantineReason string
 	ArtifactUUID     string
+	// DeletedAt is the time the version was soft-deleted, zero if it wasn't.
+	DeletedAt time.Time
 }
 
 type TagDetail struct {
@@ -99,6 +107,14 @@ type TagInfo struct {
 	Digest string
 }
 
+// TrashedOCIVersion is a soft-deleted tag, or a soft-deleted manifest when Tag is empty.
+type TrashedOCIVersion struct {
+	ImageName string
+	Tag       string
+	Digest    string
+	DeletedAt time.Time
+}
+
 type QuarantineInfo struct {
 	Reason    string
 	CreatedAt int64
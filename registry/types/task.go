@@ -36,11 +36,22 @@ const (
 	TaskKindBuildPackageMetadata TaskKind = "build_package_metadata"
 )
 
+// IndexType is the type of the registry index a task rebuilds.
+type IndexType string
+
+const (
+	IndexTypeRegistry IndexType = "registry"
+	IndexTypePackage  IndexType = "package"
+)
+
 type SourceType string
 
 const (
 	SourceTypeRegistry SourceType = "Registry"
 	SourceTypeArtifact SourceType = "Artifact"
+	// SourceTypeArtifactDeleted reports an artifact deleted from the registry, so its entry can be removed
+	// from the index without rebuilding it.
+	SourceTypeArtifactDeleted SourceType = "ArtifactDeleted"
 )
 
 type Task struct {
@@ -0,0 +1,30 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// UpstreamProvenance records where a file of a version cached by an upstream proxy was fetched from. It's kept as
+// it was when the file was first cached, so proxied content can be told apart from the content published to the
+// registries and checked against the upstream.
+type UpstreamProvenance struct {
+	ArtifactID int64
+	FileName   string
+	// SourceURL is the URL the file was downloaded from.
+	SourceURL string
+	// Checksums are the hex digests of the file advertised by the upstream, keyed by algorithm like "sha256".
+	Checksums   map[string]string
+	FirstSeenAt time.Time
+}
@@ -27,6 +27,7 @@ type UpstreamProxyConfig struct {
 	Source                   string
 	URL                      string
 	FirewallMode             string
+	FirewallDelayHours       int
 	AuthType                 string
 	UserName                 string
 	UserNameSecretIdentifier string
@@ -57,6 +58,7 @@ type UpstreamProxy struct {
 	RepoURL                  string
 	RepoAuthType             string
 	FirewallMode             *string
+	FirewallDelayHours       int
 	UserName                 string
 	UserNameSecretIdentifier string
 	UserNameSecretSpaceID    int64
@@ -0,0 +1,108 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// VulnerabilitySeverity is the severity of a vulnerability. It's also the threshold of the auto quarantine policy,
+// where VulnerabilitySeverityNone turns auto quarantine off.
+type VulnerabilitySeverity string
+
+const (
+	VulnerabilitySeverityNone     VulnerabilitySeverity = "NONE"
+	VulnerabilitySeverityUnknown  VulnerabilitySeverity = "UNKNOWN"
+	VulnerabilitySeverityLow      VulnerabilitySeverity = "LOW"
+	VulnerabilitySeverityMedium   VulnerabilitySeverity = "MEDIUM"
+	VulnerabilitySeverityHigh     VulnerabilitySeverity = "HIGH"
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "CRITICAL"
+)
+
+// vulnerabilitySeverities lists the severities of vulnerabilities from the lowest to the highest.
+var vulnerabilitySeverities = []VulnerabilitySeverity{
+	VulnerabilitySeverityUnknown,
+	VulnerabilitySeverityLow,
+	VulnerabilitySeverityMedium,
+	VulnerabilitySeverityHigh,
+	VulnerabilitySeverityCritical,
+}
+
+// IsValid returns true if the severity is one of the known severities.
+func (s VulnerabilitySeverity) IsValid() bool {
+	return s == VulnerabilitySeverityNone || s.rank() > 0
+}
+
+// AtLeast returns true if the severity is at or above the threshold. Nothing is at or above
+// VulnerabilitySeverityNone.
+func (s VulnerabilitySeverity) AtLeast(threshold VulnerabilitySeverity) bool {
+	rank := threshold.rank()
+	return rank > 0 && s.rank() >= rank
+}
+
+func (s VulnerabilitySeverity) rank() int {
+	for i, severity := range vulnerabilitySeverities {
+		if severity == s {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// SeveritiesAtLeast returns the severities at or above the threshold.
+func SeveritiesAtLeast(threshold VulnerabilitySeverity) []VulnerabilitySeverity {
+	var severities []VulnerabilitySeverity
+	for _, severity := range vulnerabilitySeverities {
+		if severity.AtLeast(threshold) {
+			severities = append(severities, severity)
+		}
+	}
+	return severities
+}
+
+// Vulnerability is an advisory synced from OSV.
+type Vulnerability struct {
+	// ID is the ID of the advisory in OSV, like GHSA-xxxx-xxxx-xxxx.
+	ID       string
+	Summary  string
+	Severity VulnerabilitySeverity
+	// Aliases are the IDs of the same vulnerability in other databases, like its CVE.
+	Aliases []string
+	// ModifiedAt is when the advisory was last modified in OSV.
+	ModifiedAt time.Time
+	// UpdatedAt is when the advisory was last synced.
+	UpdatedAt time.Time
+}
+
+// VulnerabilityCandidate is a version of a package stored in the instance which is checked for vulnerabilities.
+type VulnerabilityCandidate struct {
+	ArtifactID  int64
+	ImageID     int64
+	RegistryID  int64
+	PackageType string
+	Name        string
+	Version     string
+}
+
+// VulnerableVersion is a version of a package stored in a registry which is affected by vulnerabilities.
+type VulnerableVersion struct {
+	ArtifactID   int64
+	RegistryID   int64
+	RegistryName string
+	PackageType  string
+	Name         string
+	Version      string
+	// Severity is the highest severity of the vulnerabilities of the version.
+	Severity        VulnerabilitySeverity
+	Vulnerabilities []*Vulnerability
+}
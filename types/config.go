@@ -117,6 +117,10 @@ type Config struct {
 			// Duration defines cache duration of last commit.
 			Duration time.Duration `envconfig:"GITNESS_GIT_LAST_COMMIT_CACHE_DURATION" default:"12h"`
 		}
+
+		// SecretScanningRules (optional) is a JSON list of rules used in addition to the default rules when
+		// scanning pushes for secrets, e.g. [{"id":"my-token","regex":"mytk_([a-z0-9]{32})","secret_group":1}].
+		SecretScanningRules string `envconfig:"GITNESS_GIT_SECRET_SCANNING_RULES"`
 	}
 
 	// Encrypter defines the parameters for the encrypter
@@ -132,6 +136,26 @@ type Config struct {
 		Proto string `envconfig:"GITNESS_HTTP_PROTO" default:"http"`
 	}
 
+	// Health defines the configuration of the auxiliary health server exposing /healthz and /readyz.
+	Health struct {
+		Enabled bool   `envconfig:"GITNESS_HEALTH_ENABLED" default:"false"`
+		Host    string `envconfig:"GITNESS_HEALTH_HOST"`
+		Port    int    `envconfig:"GITNESS_HEALTH_PORT"    default:"3001"`
+		// CheckTimeout is the max time a single dependency check may take.
+		CheckTimeout time.Duration `envconfig:"GITNESS_HEALTH_CHECK_TIMEOUT" default:"2s"`
+		// DrainDelay is the time between failing the readiness and shutting down the http server,
+		// it should be longer than the period of the readiness probe.
+		DrainDelay time.Duration `envconfig:"GITNESS_HEALTH_DRAIN_DELAY" default:"5s"`
+	}
+
+	// Diagnostics defines the configuration of the auxiliary diagnostics server exposing pprof,
+	// goroutine dumps and the registry operations in flight. Only admins can access it.
+	Diagnostics struct {
+		Enabled bool   `envconfig:"GITNESS_DIAGNOSTICS_ENABLED" default:"false"`
+		Host    string `envconfig:"GITNESS_DIAGNOSTICS_HOST"    default:"localhost"`
+		Port    int    `envconfig:"GITNESS_DIAGNOSTICS_PORT"    default:"3002"`
+	}
+
 	// Acme defines Acme configuration parameters.
 	Acme struct {
 		Enabled bool   `envconfig:"GITNESS_ACME_ENABLED"`
@@ -180,6 +204,15 @@ type Config struct {
 	Database struct {
 		Driver     string `envconfig:"GITNESS_DATABASE_DRIVER" default:"sqlite3"`
 		Datasource string `envconfig:"GITNESS_DATABASE_DATASOURCE" default:"database.sqlite3"`
+
+		// MaxOpenConns is the maximum number of open connections of the pool, 0 means unlimited.
+		MaxOpenConns int `envconfig:"GITNESS_DATABASE_MAX_OPEN_CONNS" default:"0"`
+		// MaxIdleConns is the maximum number of idle connections kept in the pool.
+		MaxIdleConns int `envconfig:"GITNESS_DATABASE_MAX_IDLE_CONNS" default:"2"`
+		// ConnMaxLifetime closes connections older than it, 0 keeps them forever.
+		ConnMaxLifetime time.Duration `envconfig:"GITNESS_DATABASE_CONN_MAX_LIFETIME" default:"0"`
+		// ConnMaxIdleTime closes connections idle for longer than it, 0 keeps them forever.
+		ConnMaxIdleTime time.Duration `envconfig:"GITNESS_DATABASE_CONN_MAX_IDLE_TIME" default:"0"`
 	}
 
 	// BlobStore defines the blob storage configuration parameters.
@@ -543,6 +576,9 @@ type Config struct {
 				Delete                      bool   `envconfig:"GITNESS_REGISTRY_S3_DELETE_ENABLED" default:"true"`
 				Redirect                    bool   `envconfig:"GITNESS_REGISTRY_S3_STORAGE_REDIRECT" default:"false"`
 				Provider                    string `envconfig:"GITNESS_REGISTRY_S3_PROVIDER" default:"cloudflare"`
+				// Mirrors lists the buckets the blobs are replicated to as comma separated `region=bucket` pairs.
+				// When redirects are enabled, downloads are redirected to the mirror in the region of the client.
+				Mirrors string `envconfig:"GITNESS_REGISTRY_S3_MIRRORS"`
 			}
 		}
 
@@ -564,13 +600,210 @@ type Config struct {
 			TransactionTimeoutDuration  time.Duration `envconfig:"GITNESS_REGISTRY_GARBAGE_COLLECTION_TRANSACTION_TIMEOUT_DURATION" default:"10s"` //nolint:lll
 			BlobsStorageTimeoutDuration time.Duration `envconfig:"GITNESS_REGISTRY_GARBAGE_COLLECTION_BLOB_STORAGE_TIMEOUT_DURATION" default:"5s"` //nolint:lll
 		}
+
+		// Trash keeps the soft-deleted OCI tags and manifests for Retention before the job purges them, zero keeps
+		// them until they are purged through the API.
+		Trash struct {
+			Retention   time.Duration `envconfig:"GITNESS_REGISTRY_TRASH_RETENTION" default:"720h"`
+			Enabled     bool          `envconfig:"GITNESS_REGISTRY_TRASH_PURGE_ENABLED" default:"true"`
+			CRON        string        `envconfig:"GITNESS_REGISTRY_TRASH_PURGE_CRON" default:"45 * * * *"`
+			MaxDuration time.Duration `envconfig:"GITNESS_REGISTRY_TRASH_PURGE_MAX_DURATION" default:"10m"`
+			BatchSize   int           `envconfig:"GITNESS_REGISTRY_TRASH_PURGE_BATCH_SIZE" default:"100"`
+		}
+
+		// GarbageMetrics periodically reports the soft-deleted tags and manifests which wait to be purged.
+		GarbageMetrics struct {
+			Enabled     bool          `envconfig:"GITNESS_REGISTRY_GARBAGE_METRICS_ENABLED" default:"true"`
+			CRON        string        `envconfig:"GITNESS_REGISTRY_GARBAGE_METRICS_CRON" default:"*/15 * * * *"`
+			MaxDuration time.Duration `envconfig:"GITNESS_REGISTRY_GARBAGE_METRICS_MAX_DURATION" default:"5m"`
+		}
+
+		// EventOutbox periodically publishes the webhook events and audit logs which weren't published right after
+		// the transaction which stored them.
+		EventOutbox struct {
+			Enabled     bool          `envconfig:"GITNESS_REGISTRY_EVENT_OUTBOX_ENABLED" default:"true"`
+			CRON        string        `envconfig:"GITNESS_REGISTRY_EVENT_OUTBOX_CRON" default:"* * * * *"`
+			MaxDuration time.Duration `envconfig:"GITNESS_REGISTRY_EVENT_OUTBOX_MAX_DURATION" default:"1m"`
+			BatchSize   int           `envconfig:"GITNESS_REGISTRY_EVENT_OUTBOX_BATCH_SIZE" default:"500"`
+		}
+
+		// FailedUploads keeps the content of uploads whose package couldn't be parsed for TTL, so publishers can
+		// download it along with the parse error. Nothing is kept when TTL is zero, the job purges expired uploads.
+		//nolint:lll
+		FailedUploads struct {
+			TTL         time.Duration `envconfig:"GITNESS_REGISTRY_FAILED_UPLOADS_TTL" default:"72h"`
+			Enabled     bool          `envconfig:"GITNESS_REGISTRY_FAILED_UPLOADS_PURGE_ENABLED" default:"true"`
+			CRON        string        `envconfig:"GITNESS_REGISTRY_FAILED_UPLOADS_PURGE_CRON" default:"15 * * * *"`
+			MaxDuration time.Duration `envconfig:"GITNESS_REGISTRY_FAILED_UPLOADS_PURGE_MAX_DURATION" default:"5m"`
+			BatchSize   int           `envconfig:"GITNESS_REGISTRY_FAILED_UPLOADS_PURGE_BATCH_SIZE" default:"500"`
+		}
+
+		// WebhookPayloads keeps the request bodies of registry webhook executions for Retention so past deliveries
+		// can be replayed, zero keeps them forever. Bodies larger than MaxSize aren't kept, the kept bodies are
+		// encrypted at rest with the encrypter of the instance if Encrypt is set. The job drops expired bodies.
+		//nolint:lll
+		WebhookPayloads struct {
+			Retention   time.Duration `envconfig:"GITNESS_REGISTRY_WEBHOOK_PAYLOADS_RETENTION" default:"720h"`
+			MaxSize     int           `envconfig:"GITNESS_REGISTRY_WEBHOOK_PAYLOADS_MAX_SIZE" default:"262144"`
+			Encrypt     bool          `envconfig:"GITNESS_REGISTRY_WEBHOOK_PAYLOADS_ENCRYPT" default:"true"`
+			Enabled     bool          `envconfig:"GITNESS_REGISTRY_WEBHOOK_PAYLOADS_PURGE_ENABLED" default:"true"`
+			CRON        string        `envconfig:"GITNESS_REGISTRY_WEBHOOK_PAYLOADS_PURGE_CRON" default:"30 * * * *"`
+			MaxDuration time.Duration `envconfig:"GITNESS_REGISTRY_WEBHOOK_PAYLOADS_PURGE_MAX_DURATION" default:"5m"`
+			BatchSize   int           `envconfig:"GITNESS_REGISTRY_WEBHOOK_PAYLOADS_PURGE_BATCH_SIZE" default:"500"`
+		}
+
+		// DeletionApproval configures the requests to delete artifacts of registries whose deletes need the approval
+		// of a second user, a request which isn't reviewed within TTL expires.
+		DeletionApproval struct {
+			TTL time.Duration `envconfig:"GITNESS_REGISTRY_DELETION_APPROVAL_TTL" default:"72h"`
+		}
+
+		// ScheduledDeletions configures the deletions of artifacts which are executed after a delay, DefaultDelay
+		// applies to the deletions scheduled without a delay and MaxDelay bounds the delay. The job executes the
+		// due deletions.
+		//nolint:lll
+		ScheduledDeletions struct {
+			DefaultDelay time.Duration `envconfig:"GITNESS_REGISTRY_SCHEDULED_DELETIONS_DEFAULT_DELAY" default:"24h"`
+			MaxDelay     time.Duration `envconfig:"GITNESS_REGISTRY_SCHEDULED_DELETIONS_MAX_DELAY" default:"720h"`
+			Enabled      bool          `envconfig:"GITNESS_REGISTRY_SCHEDULED_DELETIONS_ENABLED" default:"true"`
+			CRON         string        `envconfig:"GITNESS_REGISTRY_SCHEDULED_DELETIONS_CRON" default:"*/5 * * * *"`
+			MaxDuration  time.Duration `envconfig:"GITNESS_REGISTRY_SCHEDULED_DELETIONS_MAX_DURATION" default:"30m"`
+			BatchSize    int           `envconfig:"GITNESS_REGISTRY_SCHEDULED_DELETIONS_BATCH_SIZE" default:"100"`
+		}
+
+		// VulnerabilitySync matches the stored versions of packages with the advisories of OSV, for the auto
+		// quarantine policy and the report of vulnerable versions. BatchSize is the number of versions queried at
+		// once, OSV accepts up to 1000.
+		//nolint:lll
+		VulnerabilitySync struct {
+			Enabled     bool          `envconfig:"GITNESS_REGISTRY_VULNERABILITY_SYNC_ENABLED" default:"false"`
+			URL         string        `envconfig:"GITNESS_REGISTRY_VULNERABILITY_SYNC_URL" default:"https://api.osv.dev"`
+			CRON        string        `envconfig:"GITNESS_REGISTRY_VULNERABILITY_SYNC_CRON" default:"0 */6 * * *"`
+			MaxDuration time.Duration `envconfig:"GITNESS_REGISTRY_VULNERABILITY_SYNC_MAX_DURATION" default:"2h"`
+			BatchSize   int           `envconfig:"GITNESS_REGISTRY_VULNERABILITY_SYNC_BATCH_SIZE" default:"500"`
+		}
+
+		// SearchIndex builds the search documents of the artifacts stored before search was added, the
+		// documents of the artifacts written since are built as they're written.
+		//nolint:lll
+		SearchIndex struct {
+			Enabled     bool          `envconfig:"GITNESS_REGISTRY_SEARCH_INDEX_ENABLED" default:"true"`
+			CRON        string        `envconfig:"GITNESS_REGISTRY_SEARCH_INDEX_CRON" default:"30 * * * *"`
+			MaxDuration time.Duration `envconfig:"GITNESS_REGISTRY_SEARCH_INDEX_MAX_DURATION" default:"30m"`
+			BatchSize   int           `envconfig:"GITNESS_REGISTRY_SEARCH_INDEX_BATCH_SIZE" default:"500"`
+		}
+
+		// UsageSnapshot persists the storage and bandwidth usage of the registries of every space once a day,
+		// including the usage of the spaces below it.
+		//nolint:lll
+		UsageSnapshot struct {
+			Enabled     bool          `envconfig:"GITNESS_REGISTRY_USAGE_SNAPSHOT_ENABLED" default:"true"`
+			CRON        string        `envconfig:"GITNESS_REGISTRY_USAGE_SNAPSHOT_CRON" default:"45 23 * * *"`
+			MaxDuration time.Duration `envconfig:"GITNESS_REGISTRY_USAGE_SNAPSHOT_MAX_DURATION" default:"30m"`
+		}
+
+		// DownloadStatsRollup rolls up the downloads of the past days into the daily counters the download counts
+		// are read from, and creates the download stats partitions of the next months.
+		//nolint:lll
+		DownloadStatsRollup struct {
+			Enabled     bool          `envconfig:"GITNESS_REGISTRY_DOWNLOAD_STATS_ROLLUP_ENABLED" default:"true"`
+			CRON        string        `envconfig:"GITNESS_REGISTRY_DOWNLOAD_STATS_ROLLUP_CRON" default:"10 * * * *"`
+			MaxDuration time.Duration `envconfig:"GITNESS_REGISTRY_DOWNLOAD_STATS_ROLLUP_MAX_DURATION" default:"30m"`
+		}
+
+		// Stats maintains the registry statistics read by the dashboards. The statistics of all registries are
+		// refreshed periodically, the statistics of a registry are also refreshed when its artifacts change.
+		//nolint:lll
+		Stats struct {
+			Enabled     bool          `envconfig:"GITNESS_REGISTRY_STATS_ENABLED" default:"true"`
+			CRON        string        `envconfig:"GITNESS_REGISTRY_STATS_CRON" default:"*/15 * * * *"`
+			MaxDuration time.Duration `envconfig:"GITNESS_REGISTRY_STATS_MAX_DURATION" default:"10m"`
+			Concurrency int           `envconfig:"GITNESS_REGISTRY_STATS_CONCURRENCY" default:"2"`
+			MaxRetries  int           `envconfig:"GITNESS_REGISTRY_STATS_MAX_RETRIES" default:"3"`
+		}
+
+		// Import bounds the OCI image layouts and docker save archives imported into registries, they are
+		// extracted to a temporary directory while they are imported.
+		Import struct {
+			MaxArchiveSize int64 `envconfig:"GITNESS_REGISTRY_IMPORT_MAX_ARCHIVE_SIZE" default:"10737418240"`
+		}
+
+		// Reindexing rebuilds the package indexes which list the versions moved to the trash or restored from it.
+		Reindexing struct {
+			Concurrency int `envconfig:"GITNESS_REGISTRY_REINDEXING_CONCURRENCY" default:"2"`
+			MaxRetries  int `envconfig:"GITNESS_REGISTRY_REINDEXING_MAX_RETRIES" default:"3"`
+		}
+
+		// ConcurrencyLimits bounds the expensive operations an account can run at the same time on an instance,
+		// so a single account can't keep the workers shared by all accounts busy. A limit of 0 disables it.
+		//nolint:lll
+		ConcurrencyLimits struct {
+			IndexBuilds  int           `envconfig:"GITNESS_REGISTRY_CONCURRENCY_LIMITS_INDEX_BUILDS" default:"2"`
+			Purges       int           `envconfig:"GITNESS_REGISTRY_CONCURRENCY_LIMITS_PURGES" default:"2"`
+			Imports      int           `envconfig:"GITNESS_REGISTRY_CONCURRENCY_LIMITS_IMPORTS" default:"2"`
+			Exports      int           `envconfig:"GITNESS_REGISTRY_CONCURRENCY_LIMITS_EXPORTS" default:"4"`
+			QueueSize    int           `envconfig:"GITNESS_REGISTRY_CONCURRENCY_LIMITS_QUEUE_SIZE" default:"8"`
+			QueueTimeout time.Duration `envconfig:"GITNESS_REGISTRY_CONCURRENCY_LIMITS_QUEUE_TIMEOUT" default:"10s"`
+		}
+
+		// FaultInjection delays and fails storage and upstream calls for reliability testing, it must never be
+		// enabled in production. Rules is a JSON array of rules, e.g.
+		// [{"target":"storage","path":"^/docker/","latency":"500ms","errorRate":0.1},
+		//  {"target":"upstream","path":"/v2/","errorRate":0.5,"status":503}].
+		FaultInjection struct {
+			Enabled bool   `envconfig:"GITNESS_REGISTRY_FAULT_INJECTION_ENABLED" default:"false"`
+			Rules   string `envconfig:"GITNESS_REGISTRY_FAULT_INJECTION_RULES"`
+		}
 		SetupDetailsAuthHeaderPrefix string `envconfig:"SETUP_DETAILS_AUTH_PREFIX" default:"Authorization: Bearer"`
 
+		// Database limits the statements of the registry DAOs, reads are the statements which don't modify rows.
+		// The read timeout keeps runaway searches from holding the connections of the pool.
+		//nolint:lll
+		Database struct {
+			ReadTimeout        time.Duration `envconfig:"GITNESS_REGISTRY_DATABASE_READ_TIMEOUT" default:"30s"`
+			WriteTimeout       time.Duration `envconfig:"GITNESS_REGISTRY_DATABASE_WRITE_TIMEOUT" default:"1m"`
+			SlowReadThreshold  time.Duration `envconfig:"GITNESS_REGISTRY_DATABASE_SLOW_READ_THRESHOLD" default:"2s"`
+			SlowWriteThreshold time.Duration `envconfig:"GITNESS_REGISTRY_DATABASE_SLOW_WRITE_THRESHOLD" default:"2s"`
+		}
+
+		// UpstreamHedging sends small metadata requests of proxy registries to an equivalent mirror of the
+		// upstream as well, if the upstream didn't respond within the delay. Mirrors is a JSON object mapping
+		// upstream URLs to their mirrors, e.g. {"https://registry.npmjs.org":["https://registry.npmmirror.com"]}.
+		// Mirrors never get the credentials of the upstream.
+		UpstreamHedging struct {
+			Enabled bool          `envconfig:"GITNESS_REGISTRY_UPSTREAM_HEDGING_ENABLED" default:"false"`
+			Delay   time.Duration `envconfig:"GITNESS_REGISTRY_UPSTREAM_HEDGING_DELAY"   default:"300ms"`
+			Mirrors string        `envconfig:"GITNESS_REGISTRY_UPSTREAM_HEDGING_MIRRORS"`
+		}
+
+		// UpstreamClient is the policy shared by the calls to the upstreams of proxy registries and to remote
+		// integrations. An attempt fails if the upstream doesn't send the response headers within the response
+		// timeout, failed idempotent requests are retried with a jittered exponential backoff and the calls in
+		// flight to a single host are limited, a limit of 0 disables it.
+		//nolint:lll
+		UpstreamClient struct {
+			ResponseTimeout      time.Duration `envconfig:"GITNESS_REGISTRY_UPSTREAM_CLIENT_RESPONSE_TIMEOUT" default:"30s"`
+			MaxRetries           int           `envconfig:"GITNESS_REGISTRY_UPSTREAM_CLIENT_MAX_RETRIES" default:"2"`
+			RetryBaseDelay       time.Duration `envconfig:"GITNESS_REGISTRY_UPSTREAM_CLIENT_RETRY_BASE_DELAY" default:"200ms"`
+			RetryMaxDelay        time.Duration `envconfig:"GITNESS_REGISTRY_UPSTREAM_CLIENT_RETRY_MAX_DELAY" default:"5s"`
+			MaxConcurrentPerHost int           `envconfig:"GITNESS_REGISTRY_UPSTREAM_CLIENT_MAX_CONCURRENT_PER_HOST" default:"64"`
+		}
+
+		// UpstreamNotFoundCacheTTL is how long a path the upstream of a proxy registry didn't find is answered
+		// with a not found without asking the upstream again, 0 disables the cache.
+		UpstreamNotFoundCacheTTL time.Duration `envconfig:"GITNESS_REGISTRY_UPSTREAM_NOT_FOUND_CACHE_TTL" default:"1m"`
+
 		PostProcessing struct {
 			Concurrency   int  `envconfig:"GITNESS_REGISTRY_POST_PROCESSING_CONCURRENCY" default:"4"`
 			MaxRetries    int  `envconfig:"GITNESS_REGISTRY_POST_PROCESSING_MAX_RETRIES" default:"3"`
 			AllowLoopback bool `envconfig:"GITNESS_REGISTRY_POST_PROCESSING_ALLOW_LOOPBACK" default:"false"`
 		}
+
+		// TagPublish configures the publishing of repository tags as generic artifacts.
+		TagPublish struct {
+			Concurrency int `envconfig:"GITNESS_REGISTRY_TAG_PUBLISH_CONCURRENCY" default:"2"`
+			MaxRetries  int `envconfig:"GITNESS_REGISTRY_TAG_PUBLISH_MAX_RETRIES" default:"3"`
+		}
 	}
 
 	Auth struct {
@@ -28,6 +28,9 @@ var (
 	// SettingsScopeRepo defines settings stored on a repo level.
 	SettingsScopeRepo SettingsScope = "repo"
 
+	// SettingsScopeRegistry defines settings stored on an artifact registry level.
+	SettingsScopeRegistry SettingsScope = "registry"
+
 	// SettingsScopeSystem defines settings stored on a system.
 	SettingsScopeSystem SettingsScope = "system"
 )
@@ -36,6 +39,7 @@ func GetAllSettingsScopes() []SettingsScope {
 	return []SettingsScope{
 		SettingsScopeSpace,
 		SettingsScopeRepo,
+		SettingsScopeRegistry,
 		SettingsScopeSystem,
 	}
 }
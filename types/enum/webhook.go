@@ -201,6 +201,8 @@ const (
 	WebhookTriggerArtifactCreated WebhookTrigger = "artifact_created"
 	// WebhookTriggerArtifactDeleted gets triggered when an artifact gets deleted.
 	WebhookTriggerArtifactDeleted WebhookTrigger = "artifact_deleted"
+	// WebhookTriggerRegistryQuotaThreshold gets triggered when the usage of a registry crosses a quota threshold.
+	WebhookTriggerRegistryQuotaThreshold WebhookTrigger = "registry_quota_threshold"
 )
 
 var webhookTriggers = sortEnum([]WebhookTrigger{
@@ -224,4 +226,5 @@ var webhookTriggers = sortEnum([]WebhookTrigger{
 	WebhookTriggerPullReqTargetBranchChanged,
 	WebhookTriggerArtifactCreated,
 	WebhookTriggerArtifactDeleted,
+	WebhookTriggerRegistryQuotaThreshold,
 })
@@ -265,6 +265,7 @@ export type EnumWebhookTrigger =
   | 'pullreq_review_submitted'
   | 'pullreq_target_branch_changed'
   | 'pullreq_updated'
+  | 'registry_quota_threshold'
   | 'tag_created'
   | 'tag_deleted'
   | 'tag_updated'
@@ -578,6 +579,8 @@ export interface OpenapiFileViewAddPullReqRequest {
 }
 
 export interface OpenapiGeneralSettingsRequest {
+  artifact_publish_registry?: string | null
+  artifact_publish_tag_pattern?: string | null
   /**
    * file size limit in bytes
    */
@@ -873,7 +876,11 @@ export interface ProtectionDefPullReq {
 
 export interface ProtectionDefPush {
   file_size_limit?: number
+  object_count_limit?: number
   principal_committer_match?: boolean
+  push_size_limit?: number
+  require_signed_commits?: boolean
+  restricted_paths?: string[] | null
   secret_scanning_enabled?: boolean
 }
 
@@ -1036,6 +1043,8 @@ export interface RepoSymlinkContent {
 }
 
 export interface ReposettingsGeneralSettings {
+  artifact_publish_registry?: string | null
+  artifact_publish_tag_pattern?: string | null
   /**
    * file size limit in bytes
    */